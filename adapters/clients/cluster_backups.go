@@ -0,0 +1,98 @@
+//	_       _
+//
+// __      _____  __ ___   ___  __ _| |_ ___
+//
+//	\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//	 \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//	  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//	 Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//	 CONTACT: hello@semi.technology
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+)
+
+// ClusterBackups asks other nodes to back up or restore the shards they own.
+// Requests block until all files have been transferred, so the http client
+// must not have a short timeout.
+type ClusterBackups struct {
+	client *http.Client
+}
+
+func NewClusterBackups(httpClient *http.Client) *ClusterBackups {
+	return &ClusterBackups{client: httpClient}
+}
+
+func (c *ClusterBackups) BackupShards(ctx context.Context, host string,
+	req *backup.NodeRequest) ([]backup.ShardDescriptor, error) {
+	res, err := c.send(ctx, host, "/backups/shards/backup", req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	var shards []backup.ShardDescriptor
+	if err := json.NewDecoder(res.Body).Decode(&shards); err != nil {
+		return nil, errors.Wrap(err, "decode response")
+	}
+
+	return shards, nil
+}
+
+func (c *ClusterBackups) RestoreShards(ctx context.Context, host string,
+	req *backup.NodeRequest) error {
+	res, err := c.send(ctx, host, "/backups/shards/restore", req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		body, _ := ioutil.ReadAll(res.Body)
+		return errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	return nil
+}
+
+func (c *ClusterBackups) send(ctx context.Context, host, path string,
+	payload *backup.NodeRequest) (*http.Response, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: path}
+
+	jsonBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(),
+		bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	return res, nil
+}
@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
@@ -42,6 +43,9 @@ func (c *ClusterClassifications) OpenTransaction(ctx context.Context, host strin
 		ID:      tx.ID,
 		Payload: tx.Payload,
 	}
+	if !tx.Deadline.IsZero() {
+		pl.TTLMilli = time.Until(tx.Deadline).Milliseconds()
+	}
 
 	jsonBytes, err := json.Marshal(pl)
 	if err != nil {
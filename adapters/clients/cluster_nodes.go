@@ -0,0 +1,122 @@
+//	_       _
+//
+// __      _____  __ ___   ___  __ _| |_ ___
+//
+//	\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//	 \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//	  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//	 Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//	 CONTACT: hello@semi.technology
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/nodes"
+)
+
+// ClusterNodes requests the status and the config of other nodes and asks
+// them to drain themselves through the cluster API
+type ClusterNodes struct {
+	client *http.Client
+}
+
+func NewClusterNodes(httpClient *http.Client) *ClusterNodes {
+	return &ClusterNodes{client: httpClient}
+}
+
+func (c *ClusterNodes) NodeStatus(ctx context.Context,
+	host string) (*models.NodeStatus, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: "/nodes/status"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	var status models.NodeStatus
+	if err := json.NewDecoder(res.Body).Decode(&status); err != nil {
+		return nil, errors.Wrap(err, "decode response")
+	}
+
+	return &status, nil
+}
+
+// DrainNode asks the node to move its shards to the other nodes and to leave
+// the cluster. It only returns once the node has been drained.
+func (c *ClusterNodes) DrainNode(ctx context.Context,
+	host string) (*models.NodeDrainResponse, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: "/nodes/drain"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	var drained models.NodeDrainResponse
+	if err := json.NewDecoder(res.Body).Decode(&drained); err != nil {
+		return nil, errors.Wrap(err, "decode response")
+	}
+
+	return &drained, nil
+}
+
+// NodeConfig requests the effective configuration of the node
+func (c *ClusterNodes) NodeConfig(ctx context.Context,
+	host string) (*nodes.NodeConfig, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: "/nodes/config"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	var cfg nodes.NodeConfig
+	if err := json.NewDecoder(res.Body).Decode(&cfg); err != nil {
+		return nil, errors.Wrap(err, "decode response")
+	}
+
+	return &cfg, nil
+}
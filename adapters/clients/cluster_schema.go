@@ -18,9 +18,11 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
+	schemauc "github.com/semi-technologies/weaviate/usecases/schema"
 )
 
 type ClusterSchema struct {
@@ -42,6 +44,9 @@ func (c *ClusterSchema) OpenTransaction(ctx context.Context, host string,
 		ID:      tx.ID,
 		Payload: tx.Payload,
 	}
+	if !tx.Deadline.IsZero() {
+		pl.TTLMilli = time.Until(tx.Deadline).Milliseconds()
+	}
 
 	jsonBytes, err := json.Marshal(pl)
 	if err != nil {
@@ -123,8 +128,44 @@ func (c *ClusterSchema) CommitTransaction(ctx context.Context, host string,
 	return nil
 }
 
+func (c *ClusterSchema) GetSchema(ctx context.Context,
+	host string) (schemauc.State, error) {
+	path := "/schema/state"
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: host, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return schemauc.State{}, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return schemauc.State{}, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return schemauc.State{}, errors.Errorf("unexpected status code %d (%s)",
+			res.StatusCode, body)
+	}
+
+	var state schemauc.State
+	if err := json.NewDecoder(res.Body).Decode(&state); err != nil {
+		return schemauc.State{}, errors.Wrap(err, "decode response")
+	}
+
+	return state, nil
+}
+
 type txPayload struct {
 	Type    cluster.TransactionType `json:"type"`
 	ID      string                  `json:"id"`
 	Payload interface{}             `json:"payload"`
+
+	// TTLMilli is the time left until the deadline of the transaction. It is
+	// sent as a duration rather than a point in time, so the clocks of the
+	// nodes don't need to be in sync.
+	TTLMilli int64 `json:"ttlMilli,omitempty"`
 }
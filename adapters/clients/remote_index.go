@@ -21,14 +21,17 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 )
@@ -67,8 +70,7 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return errortypes.FromHTTPStatus(res.StatusCode, body)
 	}
 
 	return nil
@@ -87,6 +89,9 @@ func (c *RemoteIndex) BatchPutObjects(ctx context.Context, hostName, indexName,
 	path := fmt.Sprintf("/indices/%s/shards/%s/objects", indexName, shardName)
 	method := http.MethodPost
 	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+	if objects.AtomicShardBatches(ctx) {
+		url.RawQuery = "atomic=true"
+	}
 
 	marshalled, err := clusterapi.IndicesPayloads.ObjectList.Marshal(objs)
 	if err != nil {
@@ -109,8 +114,7 @@ func (c *RemoteIndex) BatchPutObjects(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(res.Body)
-		return duplicateErr(errors.Errorf("unexpected status code %d (%s)",
-			res.StatusCode, body), len(objs))
+		return duplicateErr(errortypes.FromHTTPStatus(res.StatusCode, body), len(objs))
 	}
 
 	if ct, ok := clusterapi.IndicesPayloads.ErrorList.
@@ -154,8 +158,7 @@ func (c *RemoteIndex) BatchAddReferences(ctx context.Context, hostName, indexNam
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(res.Body)
-		return duplicateErr(errors.Errorf("unexpected status code %d (%s)",
-			res.StatusCode, body), len(refs))
+		return duplicateErr(errortypes.FromHTTPStatus(res.StatusCode, body), len(refs))
 	}
 
 	if ct, ok := clusterapi.IndicesPayloads.ErrorList.
@@ -215,8 +218,7 @@ func (c *RemoteIndex) GetObject(ctx context.Context, hostName, indexName,
 
 	if res.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(res.Body)
-		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
 	}
 
 	ct, ok := clusterapi.IndicesPayloads.SingleObject.CheckContentTypeHeader(res)
@@ -265,13 +267,37 @@ func (c *RemoteIndex) Exists(ctx context.Context, hostName, indexName,
 
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := ioutil.ReadAll(res.Body)
-		return false, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return false, errortypes.FromHTTPStatus(res.StatusCode, body)
 	}
 
 	return true, nil
 }
 
+func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID) error {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/%s", indexName, shardName, id)
+	method := http.MethodDelete
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		body, _ := ioutil.ReadAll(res.Body)
+		return errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	return nil
+}
+
 func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 	shardName string, ids []strfmt.UUID) ([]*storobj.Object, error) {
 	idsBytes, err := json.Marshal(ids)
@@ -307,8 +333,7 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 
 	if res.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(res.Body)
-		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
 	}
 
 	ct, ok := clusterapi.IndicesPayloads.ObjectList.CheckContentTypeHeader(res)
@@ -330,10 +355,14 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
+	shardName string, vector []float32, vectors [][]float32,
+	keywordRanking *searchparams.KeywordRanking,
+	sparseRanking *searchparams.SparseRanking, limit int,
+	filters *filters.LocalFilter, cursor *filters.Cursor, sort []filters.Sort,
 	additional additional.Properties) ([]*storobj.Object, []float32, error) {
 	paramsBytes, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, additional)
+		Marshal(vector, vectors, keywordRanking, sparseRanking, limit, filters,
+			cursor, sort, additional)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "marshal request payload")
 	}
@@ -357,8 +386,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(res.Body)
-		return nil, nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return nil, nil, errortypes.FromHTTPStatus(res.StatusCode, body)
 	}
 
 	resBytes, err := io.ReadAll(res.Body)
@@ -405,8 +433,7 @@ func (c *RemoteIndex) Aggregate(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(res.Body)
-		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
 	}
 
 	resBytes, err := io.ReadAll(res.Body)
@@ -426,3 +453,289 @@ func (c *RemoteIndex) Aggregate(ctx context.Context, hostName, indexName,
 
 	return aggRes, nil
 }
+
+func (c *RemoteIndex) FindDocIDs(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter) ([]uint64, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.FindDocIDsParams.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_find", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.FindDocIDsParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.FindDocIDsResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	docIDs, err := clusterapi.IndicesPayloads.FindDocIDsResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return docIDs, nil
+}
+
+func (c *RemoteIndex) DeleteObjectBatch(ctx context.Context, hostName, indexName,
+	shardName string, docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.BatchDeleteParams.
+		Marshal(docIDs, dryRun)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects", indexName, shardName)
+	method := http.MethodDelete
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.BatchDeleteParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.BatchDeleteResult.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	results, err := clusterapi.IndicesPayloads.BatchDeleteResult.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return results, nil
+}
+
+func (c *RemoteIndex) ScrollShard(ctx context.Context, hostName, indexName,
+	shardName, id string, ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, string, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.ScrollParams.
+		Marshal(id, ttl, limit, additional)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_scroll", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.ScrollParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, "", errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.ScrollResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, "", errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	objs, next, err := clusterapi.IndicesPayloads.ScrollResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "unmarshal body")
+	}
+
+	return objs, next, nil
+}
+
+func (c *RemoteIndex) FacetedSearch(ctx context.Context, hostName, indexName,
+	shardName string, params aggregation.FacetParams) ([]*storobj.Object,
+	*aggregation.FacetResult, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.FacetParams.Marshal(params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_facets", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.FacetParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.FacetResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	objs, facets, err := clusterapi.IndicesPayloads.FacetResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return objs, facets, nil
+}
+
+func (c *RemoteIndex) EstimateFilter(ctx context.Context, hostName, indexName,
+	shardName string, params filters.EstimateParams) (*filters.Estimate, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.EstimateParams.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_estimate", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.EstimateParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.EstimateResult.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	estimate, err := clusterapi.IndicesPayloads.EstimateResult.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return estimate, nil
+}
+
+func (c *RemoteIndex) ContinuousAggregate(ctx context.Context, hostName, indexName,
+	shardName string, params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.ContinuousParams.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_continuous", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.ContinuousParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, errortypes.FromHTTPStatus(res.StatusCode, body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.ContinuousResult.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	groups, err := clusterapi.IndicesPayloads.ContinuousResult.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,135 @@
+//	_       _
+//
+// __      _____  __ ___   ___  __ _| |_ ___
+//
+//	\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//	 \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//	  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//	 Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//	 CONTACT: hello@semi.technology
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Shadow mirrors writes to a shadow class on another Weaviate instance
+// through its public REST API. Objects are written through the batch
+// endpoint, as it replaces existing objects with the same ID.
+type Shadow struct {
+	client *http.Client
+}
+
+func NewShadow(httpClient *http.Client) *Shadow {
+	return &Shadow{client: httpClient}
+}
+
+func (s *Shadow) PutObjects(ctx context.Context, endpoint string,
+	objects []*models.Object) error {
+	u, err := shadowURL(endpoint, "/v1/batch/objects")
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"objects": objects})
+	if err != nil {
+		return errors.Wrap(err, "marshal request body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u,
+		bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "open http request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			strings.TrimSpace(string(body)))
+	}
+
+	var results []*models.ObjectsGetResponse
+	if err := json.NewDecoder(res.Body).Decode(&results); err != nil {
+		return errors.Wrap(err, "decode response")
+	}
+
+	var failed []string
+	for _, result := range results {
+		if result.Result == nil || result.Result.Errors == nil {
+			continue
+		}
+
+		for _, e := range result.Result.Errors.Error {
+			failed = append(failed, fmt.Sprintf("%s: %s", result.ID, e.Message))
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("%d objects failed: %s", len(failed),
+			strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// DeleteObject deletes the object from the remote instance. Objects are
+// deleted by their ID alone, so the class is not part of the request. An
+// object which does not exist there is not an error, it might never have been
+// mirrored.
+func (s *Shadow) DeleteObject(ctx context.Context, endpoint, className string,
+	id strfmt.UUID) error {
+	u, err := shadowURL(endpoint, "/v1/objects/"+id.String())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return errors.Wrap(err, "open http request")
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
+		body, _ := ioutil.ReadAll(res.Body)
+		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+func shadowURL(endpoint, route string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "parse shadow endpoint")
+	}
+
+	u.Path = path.Join(u.Path, route)
+	return u.String(), nil
+}
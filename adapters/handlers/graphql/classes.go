@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package graphql
+
+import (
+	"sort"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// QueriedClasses returns the names of the classes the query selects in Get
+// or Aggregate, in alphabetical order. Classes which are only reached
+// through references are not included. A query which cannot be parsed
+// returns an error, just like it would fail when it is resolved.
+func QueriedClasses(query string) ([]string, error) {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil, err
+	}
+
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	classes := map[string]struct{}{}
+	for _, def := range doc.Definitions {
+		operation, ok := def.(*ast.OperationDefinition)
+		if !ok || operation.Operation != ast.OperationTypeQuery {
+			continue
+		}
+
+		for _, field := range selectedFields(operation.SelectionSet, fragments,
+			map[string]bool{}) {
+			if field.Name.Value != "Get" && field.Name.Value != "Aggregate" {
+				continue
+			}
+
+			for _, class := range selectedFields(field.SelectionSet, fragments,
+				map[string]bool{}) {
+				classes[class.Name.Value] = struct{}{}
+			}
+		}
+	}
+
+	out := make([]string, 0, len(classes))
+	for class := range classes {
+		out = append(out, class)
+	}
+	sort.Strings(out)
+
+	return out, nil
+}
+
+// selectedFields resolves the fields of the selection set, including those
+// selected through fragments. visited prevents following cyclic fragments,
+// which are rejected once the query is validated.
+func selectedFields(set *ast.SelectionSet,
+	fragments map[string]*ast.FragmentDefinition,
+	visited map[string]bool) []*ast.Field {
+	if set == nil {
+		return nil
+	}
+
+	var out []*ast.Field
+	for _, selection := range set.Selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if s.Name != nil {
+				out = append(out, s)
+			}
+		case *ast.InlineFragment:
+			out = append(out, selectedFields(s.SelectionSet, fragments, visited)...)
+		case *ast.FragmentSpread:
+			if s.Name == nil || visited[s.Name.Value] {
+				continue
+			}
+			visited[s.Name.Value] = true
+
+			if fragment, ok := fragments[s.Name.Value]; ok {
+				out = append(out, selectedFields(fragment.SelectionSet, fragments,
+					visited)...)
+			}
+		}
+	}
+
+	return out
+}
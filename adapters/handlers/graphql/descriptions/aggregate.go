@@ -20,6 +20,8 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const ObjectLimit = "Specify the maximum number of objects closest to the near<Media> search to aggregate over"
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
@@ -37,6 +39,16 @@ const (
 	AggregateGroupedBy = "Indicates the group of returned data"
 )
 
+const AggregatePercentile = "Aggregate on a percentile of numeric property values, " +
+	"e.g. p: 90 for the value which 90% of the values are less than or equal to"
+
+const (
+	AggregateDateMedian = "Aggregate on the median of date property values, formatted as RFC3339"
+	AggregateDateMode   = "Aggregate on the most frequent of date property values, formatted as RFC3339"
+	AggregateDateMin    = "Aggregate on the earliest of date property values, formatted as RFC3339"
+	AggregateDateMax    = "Aggregate on the latest of date property values, formatted as RFC3339"
+)
+
 const AggregateNumericObj = "An object containing the %s of numeric properties"
 
 const AggregateCountObj = "An object containing countable properties"
@@ -21,9 +21,18 @@ const (
 	Limit                = "Limit the results set (usually fewer results mean faster queries)"
 	Offset               = "Offset of the results set (usually fewer results mean faster queries)"
 	Certainty            = "Desired Certainty. The higher the value the stricter the search becomes, the lower the value the fuzzier the search becomes"
+	DistanceThreshold    = "Desired maximum distance in the distance metric of the vector index. The lower the value the stricter the search becomes, the higher the value the fuzzier the search becomes. Conflicts with certainty"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
-	Distance             = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
+	Distance             = "Raw distance between the result item and the search vector in the distance metric of the vector index, lower values are closer"
+	ResultCertainty      = "Distance between the result item and the search vector normalized to a certainty between 0 (perfect opposite) and 1 (identical vectors), independently of the distance metric"
+	KeywordRanking       = "Rank the results by the BM25 relevance of their text and string properties for keywords, without involving any vector"
+	KeywordQuery         = "The keywords to search for, they are tokenized like the values of each searched property"
+	KeywordProperties    = "The text and string properties to search, all indexed ones if not set"
+	MultiVector          = "Multiple query vectors, e.g. one per token, which are matched against the token vectors of the objects by late interaction. Replaces vector, requires multiVector to be enabled in the vectorIndexConfig of the class"
+	SparseRanking        = "Rank the results by the dot product of their sparse vectors with the sparse vector of a query, which the sparse vectorizer module of the class produces. Requires sparse to be enabled in the vectorIndexConfig of the class"
+	SparseQuery          = "The text to search for, it is vectorized by the sparse vectorizer module of the class"
+	Score                = "BM25 relevance of the result item for the keywords of a bm25 search or the dot product of its sparse vector with the query of a sparse search, higher values are more relevant"
 )
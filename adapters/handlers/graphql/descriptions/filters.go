@@ -18,6 +18,11 @@ const (
 	GetWhereInpObj = "An object containing filter options for a local Get query, used to convert the result to the specified filters"
 )
 
+const (
+	ExploreWhere       = "Filter options for a local Explore query, applied to every class which has all of the filtered properties"
+	ExploreWhereInpObj = "An object containing filter options for a local Explore query, applied to every class which has all of the filtered properties"
+)
+
 const (
 	LocalMetaWhere       = "Filter options for a local Meta query, used to convert the result to the specified filters"
 	LocalMetaWhereInpObj = "An object containing filter options for a local Meta query, used to convert the result to the specified filters"
@@ -134,4 +139,13 @@ const NetworkTimeout = "Specify the time in seconds after which an unresolved re
 const (
 	First = "Show the first x results (pagination option)"
 	After = "Show the results after the first x results (pagination option)"
+
+	AfterCursor = "Show the results after the object with this id, results are ordered by id. Use the id of the last result as the cursor for the next page"
+)
+
+// Sort filter elements
+const (
+	Sort      = "Sort the results by the creation or last update time, requires the class to have invertedIndexConfig.indexTimestamps enabled"
+	SortPath  = "Specify the path to sort by, either [\"_creationTimeUnix\"] or [\"_lastUpdateTimeUnix\"]"
+	SortOrder = "Specify the sort order, either ascending (asc) or descending (desc), defaults to asc"
 )
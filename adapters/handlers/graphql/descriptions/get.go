@@ -26,6 +26,19 @@ const GetObjectsActionsObj = "An object used to get %ss on a local Weaviate"
 
 const GetClassUUID = "The UUID of a Object, assigned by its local Weaviate"
 
+const GetAutocut = "Cut off the results after the specified number of jumps in their distance or bm25 or sparse score, e.g. 1 only keeps the results before the first significant jump"
+
+const Tenant = "Specify the tenant of a class with multi-tenancy enabled, the query is limited to the objects of that tenant"
+
+// GroupBy
+const (
+	GetGroupBy                = "Group the results by the value of a property, every result is the top hit of a group and lists the objects of its group in _additional { group }"
+	GetGroupByPath            = "Specify the path of the property to group by, e.g. [\"category\"]"
+	GetGroupByGroups          = "Specify the maximum number of groups to return"
+	GetGroupByObjectsPerGroup = "Specify the maximum number of objects to return per group"
+	AdditionalGroup           = "The group of objects sharing the value of the groupBy property, only set if the groupBy argument is used"
+)
+
 // Network
 const (
 	NetworkGet    = "Get Objects from a Weaviate in a network"
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func nearVectorArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		// Description: descriptions.GetExplore,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearVectorInpObj", prefix),
+				Fields: nearVectorFields(prefix),
+			},
+		),
+	}
+}
+
+func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+func nearObjectArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearObjectInpObj", prefix),
+				Fields: nearObjectFields(prefix),
+			},
+		),
+	}
+}
+
+func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ID,
+			Type:        graphql.String,
+		},
+		"beacon": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Beacon,
+			Type:        graphql.String,
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
+	}
+}
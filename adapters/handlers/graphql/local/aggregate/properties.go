@@ -13,6 +13,7 @@ package aggregate
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
@@ -58,6 +59,18 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 			Type:        graphql.Float,
 			Resolve:     makeResolveNumericFieldAggregator("median"),
 		},
+		"percentile": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sPercentile", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePercentile,
+			Type:        graphql.Float,
+			Resolve:     resolvePercentile,
+			Args: graphql.FieldConfigArgument{
+				"p": &graphql.ArgumentConfig{
+					Description: descriptions.AggregatePercentile,
+					Type:        graphql.NewNonNull(graphql.Float),
+				},
+			},
+		},
 		"count": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sCount", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -86,24 +99,92 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 	})
 }
 
-func nonNumericPropertyFields(class *models.Class,
+func datePropertyFields(class *models.Class,
 	property *models.Property, prefix string) *graphql.Object {
-	getMetaPointingFields := graphql.Fields{
+	getMetaDateFields := graphql.Fields{
 		"count": &graphql.Field{
-			Name:        fmt.Sprintf("%s%sCount", prefix, class.Class),
+			Name:        fmt.Sprintf("%s%s%sCount", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
 			Type:        graphql.Int,
-			Resolve:     makeResolveNumericFieldAggregator("count"),
+			Resolve:     dateResolver(func(d aggregation.Date) interface{} { return d.Count }),
+		},
+		"minimum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMinimum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMin,
+			Type:        graphql.String,
+			Resolve:     dateValueResolver(func(d aggregation.Date) int64 { return d.Minimum }),
+		},
+		"maximum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMaximum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMax,
+			Type:        graphql.String,
+			Resolve:     dateValueResolver(func(d aggregation.Date) int64 { return d.Maximum }),
+		},
+		"median": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMedian", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMedian,
+			Type:        graphql.String,
+			Resolve:     dateValueResolver(func(d aggregation.Date) int64 { return d.Median }),
+		},
+		"mode": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMode", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMode,
+			Type:        graphql.String,
+			Resolve:     dateValueResolver(func(d aggregation.Date) int64 { return d.Mode }),
+		},
+		"type": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePropertyType,
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				prop, ok := p.Source.(aggregation.Property)
+				if !ok {
+					return nil, fmt.Errorf("date: type: expected aggregation.Property, got %T", p.Source)
+				}
+
+				return prop.SchemaType, nil
+			},
 		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        fmt.Sprintf("%s%s%sObj", prefix, class.Class, property.Name),
-		Fields:      getMetaPointingFields,
+		Fields:      getMetaDateFields,
 		Description: descriptions.AggregatePropertyObject,
 	})
 }
 
+type dateExtractorFunc func(aggregation.Date) interface{}
+
+func dateResolver(extractor dateExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		property, ok := p.Source.(aggregation.Property)
+		if !ok {
+			return nil, fmt.Errorf("date: %s: expected aggregation.Property, got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		if property.Type != aggregation.PropertyTypeDate {
+			return nil, fmt.Errorf("date: %s: expected property to be of type date, got %s",
+				p.Info.FieldName, property.Type)
+		}
+
+		return extractor(property.DateAggregation), nil
+	}
+}
+
+// dateValueResolver formats the date as RFC3339 in UTC. Without any dates
+// there is no value, so null is returned instead of the unix epoch.
+func dateValueResolver(extractor func(aggregation.Date) int64) func(p graphql.ResolveParams) (interface{}, error) {
+	return dateResolver(func(d aggregation.Date) interface{} {
+		if d.Count == 0 {
+			return nil
+		}
+
+		return time.Unix(0, extractor(d)).UTC().Format(time.RFC3339Nano)
+	})
+}
+
 func referencePropertyFields(class *models.Class,
 	property *models.Property, prefix string) *graphql.Object {
 	getMetaPointingFields := graphql.Fields{
@@ -410,6 +491,21 @@ func makeResolveNumericFieldAggregator(aggregator string) func(p graphql.Resolve
 	}
 }
 
+func resolvePercentile(p graphql.ResolveParams) (interface{}, error) {
+	num, err := extractNumericAggregation(p.Source)
+	if err != nil {
+		return nil, fmt.Errorf("numerical aggregator percentile: %v", err)
+	}
+
+	percentile, ok := p.Args["p"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("numerical aggregator percentile: p must be a float, "+
+			"instead got: %#v", p.Args["p"])
+	}
+
+	return num[aggregation.PercentileKey(percentile)], nil
+}
+
 func extractNumericAggregation(source interface{}) (map[string]float64, error) {
 	property, ok := source.(aggregation.Property)
 	if !ok {
@@ -21,6 +21,7 @@ import (
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/language/ast"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/parallel"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -45,7 +46,7 @@ type RequestsLog interface {
 	Register(requestType string, identifier string)
 }
 
-func makeResolveClass() graphql.FieldResolveFn {
+func makeResolveClass(modulesProvider ModulesProvider) graphql.FieldResolveFn {
 	return func(p graphql.ResolveParams) (interface{}, error) {
 		className := schema.ClassName(p.Info.FieldName)
 		source, ok := p.Source.(map[string]interface{})
@@ -84,6 +85,11 @@ func makeResolveClass() graphql.FieldResolveFn {
 			return nil, fmt.Errorf("could not extract filters: %s", err)
 		}
 
+		objectLimit, err := extractObjectLimit(p.Args)
+		if err != nil {
+			return nil, fmt.Errorf("could not extract objectLimit: %s", err)
+		}
+
 		params := &aggregation.Params{
 			Filters:          filters,
 			ClassName:        className,
@@ -91,19 +97,43 @@ func makeResolveClass() graphql.FieldResolveFn {
 			GroupBy:          groupBy,
 			IncludeMetaCount: includeMeta,
 			Limit:            limit,
+			ObjectLimit:      objectLimit,
 		}
 
-		res, err := resolver.Aggregate(p.Context, principalFromContext(p.Context), params)
-		if err != nil {
-			return nil, err
+		if tenant, ok := p.Args["tenant"]; ok {
+			params.Tenant = tenant.(string)
 		}
 
-		switch parsed := res.(type) {
-		case *aggregation.Result:
-			return parsed.Groups, nil
-		default:
-			return res, nil
+		if nearVector, ok := p.Args["nearVector"]; ok {
+			p := common_filters.ExtractNearVector(nearVector.(map[string]interface{}))
+			params.NearVector = &p
 		}
+
+		if nearObject, ok := p.Args["nearObject"]; ok {
+			p := common_filters.ExtractNearObject(nearObject.(map[string]interface{}))
+			params.NearObject = &p
+		}
+
+		if modulesProvider != nil {
+			extractedParams := modulesProvider.ExtractSearchParams(p.Args, className.String())
+			if len(extractedParams) > 0 {
+				params.ModuleParams = extractedParams
+			}
+		}
+
+		return parallel.FromSource(p.Source).Resolve(func() (interface{}, error) {
+			res, err := resolver.Aggregate(p.Context, principalFromContext(p.Context), params)
+			if err != nil {
+				return nil, err
+			}
+
+			switch parsed := res.(type) {
+			case *aggregation.Result:
+				return parsed.Groups, nil
+			default:
+				return res, nil
+			}
+		}), nil
 	}
 }
 
@@ -171,6 +201,14 @@ func extractAggregators(selections *ast.SelectionSet) ([]aggregation.Aggregator,
 			}
 		}
 
+		if property.Type == aggregation.PercentileType {
+			percentile, err := extractPercentileFromArgs(field.Arguments)
+			if err != nil {
+				return nil, err
+			}
+			property.Percentile = &percentile
+		}
+
 		analyses = append(analyses, property)
 	}
 
@@ -216,6 +254,20 @@ func extractLimit(args map[string]interface{}) (*int, error) {
 	return &limitInt, nil
 }
 
+func extractObjectLimit(args map[string]interface{}) (*int, error) {
+	objectLimit, ok := args["objectLimit"]
+	if !ok {
+		return nil, nil
+	}
+
+	objectLimitInt, ok := objectLimit.(int)
+	if !ok {
+		return nil, fmt.Errorf("objectLimit must be a int, instead got: %#v", objectLimit)
+	}
+
+	return &objectLimitInt, nil
+}
+
 func extractLimitFromArgs(args []*ast.Argument) *int {
 	for _, arg := range args {
 		if arg.Name.Value != "limit" {
@@ -231,3 +283,30 @@ func extractLimitFromArgs(args []*ast.Argument) *int {
 
 	return nil
 }
+
+func extractPercentileFromArgs(args []*ast.Argument) (float64, error) {
+	for _, arg := range args {
+		if arg.Name.Value != "p" {
+			continue
+		}
+
+		v, ok := arg.Value.GetValue().(string)
+		if !ok {
+			return 0, fmt.Errorf("percentile: p must be a number, instead got: %#v",
+				arg.Value.GetValue())
+		}
+
+		p, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("percentile: p must be a number: %v", err)
+		}
+
+		if p < 0 || p > 100 {
+			return 0, fmt.Errorf("percentile: p must be between 0 and 100, got %v", p)
+		}
+
+		return p, nil
+	}
+
+	return 0, fmt.Errorf("percentile: argument p is required")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/stretchr/testify/assert"
 )
@@ -31,6 +32,9 @@ type testCase struct {
 	expectedWhereFilter      *filters.LocalFilter
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
+	expectedObjectLimit      *int
+	expectedNearVector       *searchparams.NearVector
+	expectedNearObject       *searchparams.NearObject
 }
 
 type testCases []testCase
@@ -578,6 +582,51 @@ func Test_Resolve(t *testing.T) {
 			},
 		},
 
+		testCase{
+			name:  "int prop with percentiles",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { horsepower { p90: percentile(p: 90), p99: percentile(p: 99.5) } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.NewPercentileAggregator(90),
+						aggregation.NewPercentileAggregator(99.5),
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+					Properties: map[string]aggregation.Property{
+						"horsepower": aggregation.Property{
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{
+								"percentile_90":   480.0,
+								"percentile_99.5": 610.0,
+							},
+						},
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{
+				{
+					pathToField: []string{"Aggregate", "Car"},
+					expectedValue: []interface{}{
+						map[string]interface{}{
+							"horsepower": map[string]interface{}{
+								"p90": 480.0,
+								"p99": 610.0,
+							},
+						},
+					},
+				},
+			},
+		},
+
 		testCase{
 			name:  "single prop: string",
 			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { modelName { count } } } }`,
@@ -615,6 +664,145 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+
+		testCase{
+			name:  "all date props",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { startOfProduction { count, minimum, maximum, median, mode } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "startOfProduction",
+					Aggregators: []aggregation.Aggregator{aggregation.CountAggregator, aggregation.MinimumAggregator, aggregation.MaximumAggregator, aggregation.MedianAggregator, aggregation.ModeAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+					Properties: map[string]aggregation.Property{
+						"startOfProduction": aggregation.Property{
+							Type: aggregation.PropertyTypeDate,
+							DateAggregation: aggregation.Date{
+								Count:      5,
+								Minimum:    946684800000000000,  // 2000-01-01
+								Maximum:    1609459199500000000, // 2020-12-31, with a fraction of a second
+								Median:     1276603200000000000, // 2010-06-15
+								Mode:       1276603200000000000,
+								ModeOccurs: 3,
+							},
+						},
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"startOfProduction": map[string]interface{}{
+							"count":   5,
+							"minimum": "2000-01-01T00:00:00Z",
+							"maximum": "2020-12-31T23:59:59.5Z",
+							"median":  "2010-06-15T12:00:00Z",
+							"mode":    "2010-06-15T12:00:00Z",
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name:  "date prop without any dates",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { startOfProduction { count, minimum, median } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "startOfProduction",
+					Aggregators: []aggregation.Aggregator{aggregation.CountAggregator, aggregation.MinimumAggregator, aggregation.MedianAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+					Properties: map[string]aggregation.Property{
+						"startOfProduction": aggregation.Property{
+							Type: aggregation.PropertyTypeDate,
+						},
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"startOfProduction": map[string]interface{}{
+							"count":   0,
+							"minimum": nil,
+							"median":  nil,
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "with nearVector and objectLimit",
+			query: `{ Aggregate { Car(nearVector:{vector:[0.1, 0.2], certainty:0.7},
+				objectLimit:5) { meta { count } } } }`,
+			expectedProps:            []aggregation.ParamProperty{},
+			expectedIncludeMetaCount: true,
+			expectedNearVector: &searchparams.NearVector{
+				Vector:    []float32{0.1, 0.2},
+				Certainty: 0.7,
+			},
+			expectedObjectLimit: ptInt(5),
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Count: 4,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"meta": map[string]interface{}{
+							"count": 4,
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "with nearObject",
+			query: `{ Aggregate { Car(nearObject:{id:"123", certainty:0.8}) {
+				meta { count } } } }`,
+			expectedProps:            []aggregation.ParamProperty{},
+			expectedIncludeMetaCount: true,
+			expectedNearObject: &searchparams.NearObject{
+				ID:        "123",
+				Certainty: 0.8,
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Count: 2,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"meta": map[string]interface{}{
+							"count": 2,
+						},
+					},
+				},
+			}},
+		},
 	}
 
 	tests.AssertExtraction(t, "Car")
@@ -632,6 +820,9 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				Filters:          testCase.expectedWhereFilter,
 				IncludeMetaCount: testCase.expectedIncludeMetaCount,
 				Limit:            testCase.expectedLimit,
+				ObjectLimit:      testCase.expectedObjectLimit,
+				NearVector:       testCase.expectedNearVector,
+				NearObject:       testCase.expectedNearObject,
 			}
 
 			resolver.On("Aggregate", expectedParams).
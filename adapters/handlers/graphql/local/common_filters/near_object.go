@@ -15,7 +15,7 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
-// ExtractNearObject arguments, such as "vector" and "certainty"
+// ExtractNearObject arguments, such as "vector" and "certainty" or "distance"
 func ExtractNearObject(source map[string]interface{}) traverser.NearObjectParams {
 	var args traverser.NearObjectParams
 
@@ -34,5 +34,11 @@ func ExtractNearObject(source map[string]interface{}) traverser.NearObjectParams
 		args.Certainty = certainty.(float64)
 	}
 
+	distance, ok := source["distance"]
+	if ok {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
 	return args
 }
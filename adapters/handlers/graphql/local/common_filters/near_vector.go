@@ -15,15 +15,21 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
-// ExtractNearVector arguments, such as "vector" and "certainty"
+// ExtractNearVector arguments, such as "vector" or "vectors" and "certainty"
+// or "distance"
 func ExtractNearVector(source map[string]interface{}) traverser.NearVectorParams {
 	var args traverser.NearVectorParams
 
-	// vector is a required argument, so we don't need to check for its existing
-	vector := source["vector"].([]interface{})
-	args.Vector = make([]float32, len(vector))
-	for i, value := range vector {
-		args.Vector[i] = float32(value.(float64))
+	if vector, ok := source["vector"].([]interface{}); ok {
+		args.Vector = extractVector(vector)
+	}
+
+	if vectors, ok := source["vectors"].([]interface{}); ok {
+		args.Vectors = make([][]float32, len(vectors))
+		for i, vector := range vectors {
+			asSlice, _ := vector.([]interface{})
+			args.Vectors[i] = extractVector(asSlice)
+		}
 	}
 
 	certainty, ok := source["certainty"]
@@ -31,5 +37,20 @@ func ExtractNearVector(source map[string]interface{}) traverser.NearVectorParams
 		args.Certainty = certainty.(float64)
 	}
 
+	distance, ok := source["distance"]
+	if ok {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
 	return args
 }
+
+func extractVector(values []interface{}) []float32 {
+	out := make([]float32, len(values))
+	for i, value := range values {
+		out[i] = float32(value.(float64))
+	}
+
+	return out
+}
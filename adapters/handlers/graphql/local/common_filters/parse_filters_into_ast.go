@@ -71,6 +71,8 @@ func parseClause(args map[string]interface{}, rootClass string) (*filters.Clause
 		clause, err = parseCompareOp(args, filters.OperatorLessThanEqual, rootClass)
 	case "WithinGeoRange":
 		clause, err = parseCompareOp(args, filters.OperatorWithinGeoRange, rootClass)
+	case "IsNull":
+		clause, err = parseCompareOp(args, filters.OperatorIsNull, rootClass)
 	default:
 		err = fmt.Errorf("Unknown operator '%s' in clause %s", operator, jsonify(args))
 	}
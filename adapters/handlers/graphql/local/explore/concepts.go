@@ -16,6 +16,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
 )
@@ -44,6 +45,7 @@ func Build(schema *models.Schema, modulesProvider ModulesProvider) *graphql.Fiel
 
 			"nearVector": nearVectorArgument(),
 			"nearObject": nearObjectArgument(),
+			"where":      whereArgument(),
 		},
 	}
 
@@ -87,8 +89,8 @@ func exploreObject() *graphql.Object {
 		},
 
 		"certainty": &graphql.Field{
-			Name:        "ExploreBeacon",
-			Description: descriptions.Distance,
+			Name:        "ExploreCertainty",
+			Description: descriptions.ResultCertainty,
 			Type:        graphql.Float,
 			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 				vsr, ok := p.Source.(search.Result)
@@ -99,6 +101,20 @@ func exploreObject() *graphql.Object {
 				return vsr.Certainty, nil
 			},
 		},
+
+		"distance": &graphql.Field{
+			Name:        "ExploreDistance",
+			Description: descriptions.Distance,
+			Type:        graphql.Float,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				vsr, ok := p.Source.(search.Result)
+				if !ok {
+					return nil, fmt.Errorf("unknown type %T in Explore..distance resolver", p.Source)
+				}
+
+				return vsr.Dist, nil
+			},
+		},
 	}
 
 	getLocalExploreFieldsObject := graphql.ObjectConfig{
@@ -110,6 +126,21 @@ func exploreObject() *graphql.Object {
 	return graphql.NewObject(getLocalExploreFieldsObject)
 }
 
+// whereArgument is not bound to a class, it is applied to every candidate
+// class of the exploration which has all of the filtered properties
+func whereArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.ExploreWhere,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        "ExploreWhereInpObj",
+				Fields:      common_filters.BuildNew("Explore"),
+				Description: descriptions.ExploreWhereInpObj,
+			},
+		),
+	}
+}
+
 func nearVectorArgument() *graphql.ArgumentConfig {
 	return &graphql.ArgumentConfig{
 		// Description: descriptions.GetExplore,
@@ -132,6 +163,10 @@ func nearVectorFields() graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
 		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
 	}
 }
 
@@ -160,5 +195,9 @@ func nearObjectFields() graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
 		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
 	}
 }
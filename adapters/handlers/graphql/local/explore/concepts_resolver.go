@@ -17,6 +17,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
@@ -82,6 +83,12 @@ func (r *resolver) resolve(p graphql.ResolveParams) (interface{}, error) {
 		params.NearObject = &extracted
 	}
 
+	where, err := common_filters.ExtractFilters(p.Args, filters.CrossClassRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract filters: %s", err)
+	}
+	params.Filters = where
+
 	if param, ok := p.Args["offset"]; ok {
 		params.Offset = param.(int)
 	}
@@ -14,6 +14,8 @@ package explore
 import (
 	"testing"
 
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/stretchr/testify/assert"
@@ -420,6 +422,52 @@ func Test_ResolveExplore(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name: "with nearVector and a where filter",
+			query: `
+			{
+					Explore(
+						nearVector: {vector: [0, 1, 0.8]}
+						where: {path: ["language"], operator: Equal, valueString: "en"}
+					) {
+							beacon className
+					}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				NearVector: &traverser.NearVectorParams{
+					Vector: []float32{0, 1, 0.8},
+				},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorEqual,
+						On: &filters.Path{
+							Class:    schema.ClassName(filters.CrossClassRoot),
+							Property: "language",
+						},
+						Value: &filters.Value{
+							Value: "en",
+							Type:  schema.DataTypeString,
+						},
+					},
+				},
+			},
+			resolverReturn: []search.Result{
+				{
+					Beacon:    "weaviate://localhost/some-uuid",
+					ClassName: "bestClass",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/some-uuid",
+						"className": "bestClass",
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "Resolve Explore with nearObject and beacon set",
 			query: `
@@ -124,8 +124,11 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties := graphql.Fields{}
 	additionalProperties["classification"] = b.additionalClassificationField(class)
 	additionalProperties["certainty"] = b.additionalCertaintyField(class)
+	additionalProperties["distance"] = b.additionalDistanceField(class)
+	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["vector"] = b.additionalVectorField(class)
 	additionalProperties["id"] = b.additionalIDField()
+	additionalProperties["group"] = b.additionalGroupField(class)
 	// module specific additional properties
 	if b.modulesProvider != nil {
 		for name, field := range b.modulesProvider.GetAdditionalFields(class) {
@@ -164,7 +167,50 @@ func (b *classBuilder) additionalClassificationField(class *models.Class) *graph
 
 func (b *classBuilder) additionalCertaintyField(class *models.Class) *graphql.Field {
 	return &graphql.Field{
-		Type: graphql.Float,
+		Description: descriptions.ResultCertainty,
+		Type:        graphql.Float,
+	}
+}
+
+func (b *classBuilder) additionalDistanceField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Distance,
+		Type:        graphql.Float,
+	}
+}
+
+func (b *classBuilder) additionalScoreField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Score,
+		Type:        graphql.Float,
+	}
+}
+
+// additionalGroupField lists the objects of a group as objects of the class
+// itself. It is only resolved from within the fields thunk of the class
+// object, at which point the class object is known.
+func (b *classBuilder) additionalGroupField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AdditionalGroup,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalGroup", class.Class),
+			Fields: graphql.Fields{
+				"id": &graphql.Field{Type: graphql.Int},
+				"groupedBy": &graphql.Field{
+					Type: graphql.NewObject(graphql.ObjectConfig{
+						Name: fmt.Sprintf("%sAdditionalGroupGroupedBy", class.Class),
+						Fields: graphql.Fields{
+							"path":  &graphql.Field{Type: graphql.NewList(graphql.String)},
+							"value": &graphql.Field{Type: graphql.String},
+						},
+					}),
+				},
+				"count":       &graphql.Field{Type: graphql.Int},
+				"minDistance": &graphql.Field{Type: graphql.Float},
+				"maxDistance": &graphql.Field{Type: graphql.Float},
+				"hits":        &graphql.Field{Type: graphql.NewList(b.knownClasses[class.Class])},
+			},
+		}),
 	}
 }
 
@@ -19,11 +19,13 @@ import (
 
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/parallel"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 
 	"github.com/graphql-go/graphql"
@@ -209,11 +211,27 @@ func buildGetClassField(classObject *graphql.Object,
 				Description: descriptions.After,
 				Type:        graphql.Int,
 			},
+			"after": &graphql.ArgumentConfig{
+				Description: descriptions.AfterCursor,
+				Type:        graphql.String,
+			},
+			"tenant": &graphql.ArgumentConfig{
+				Description: descriptions.Tenant,
+				Type:        graphql.String,
+			},
+			"autocut": &graphql.ArgumentConfig{
+				Description: descriptions.GetAutocut,
+				Type:        graphql.Int,
+			},
 
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"bm25":       bm25Argument(class.Class),
+			"sparse":     sparseArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
+			"groupBy":    groupByArgument(class.Class),
+			"sort":       sortArgument(class.Class),
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -222,6 +240,9 @@ func buildGetClassField(classObject *graphql.Object,
 		for name, argument := range modulesProvider.GetArguments(class) {
 			field.Args[name] = argument
 		}
+		for name, argument := range modulesProvider.TransformArguments(class) {
+			field.Args[name] = argument
+		}
 	}
 
 	return field
@@ -304,6 +325,9 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			return nil, err
 		}
 
+		cursor := filters.ExtractCursorFromArgs(p.Args)
+		sort := filters.ExtractSortFromArgs(p.Args)
+
 		// There can only be exactly one ast.Field; it is the class name.
 		if len(p.Info.FieldASTs) != 1 {
 			panic("Only one Field expected here")
@@ -333,32 +357,84 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			nearObjectParams = &p
 		}
 
+		var keywordRanking *searchparams.KeywordRanking
+		if bm25, ok := p.Args["bm25"]; ok {
+			p := extractBM25(bm25.(map[string]interface{}))
+			keywordRanking = &p
+		}
+
+		var sparseRanking *searchparams.SparseRanking
+		if sparse, ok := p.Args["sparse"]; ok {
+			sparseRanking = &searchparams.SparseRanking{
+				Query: sparse.(map[string]interface{})["query"].(string),
+			}
+		}
+
 		var moduleParams map[string]interface{}
+		var transformParams map[string]interface{}
 		if r.modulesProvider != nil {
 			extractedParams := r.modulesProvider.ExtractSearchParams(p.Args, className)
 			if len(extractedParams) > 0 {
 				moduleParams = extractedParams
 			}
+
+			extractedParams = r.modulesProvider.ExtractTransformParams(p.Args, className)
+			if len(extractedParams) > 0 {
+				transformParams = extractedParams
+			}
 		}
 
 		group := extractGroup(p.Args)
+		groupBy := extractGroupBy(p.Args)
+
+		var tenant string
+		if t, ok := p.Args["tenant"]; ok {
+			tenant = t.(string)
+		}
+
+		var autocut int
+		if a, ok := p.Args["autocut"]; ok {
+			autocut = a.(int)
+		}
 
 		params := traverser.GetParams{
 			Filters:              filters,
 			ClassName:            className,
 			Pagination:           pagination,
+			Cursor:               cursor,
+			Sort:                 sort,
 			Properties:           properties,
 			NearVector:           nearVectorParams,
 			NearObject:           nearObjectParams,
+			KeywordRanking:       keywordRanking,
+			SparseRanking:        sparseRanking,
 			Group:                group,
+			GroupBy:              groupBy,
 			ModuleParams:         moduleParams,
+			TransformParams:      transformParams,
 			AdditionalProperties: additional,
+			Tenant:               tenant,
+			Autocut:              autocut,
 		}
 
-		return func() (interface{}, error) {
+		return parallel.FromSource(p.Source).Resolve(func() (interface{}, error) {
 			return resolver.GetClass(p.Context, principalFromContext(p.Context), params)
-		}, nil
+		}), nil
+	}
+}
+
+func extractBM25(source map[string]interface{}) searchparams.KeywordRanking {
+	var args searchparams.KeywordRanking
+
+	args.Query = source["query"].(string)
+	if props, ok := source["properties"].([]interface{}); ok {
+		args.Properties = make([]string, len(props))
+		for i, prop := range props {
+			args.Properties[i] = prop.(string)
+		}
 	}
+
+	return args
 }
 
 func extractGroup(args map[string]interface{}) *traverser.GroupParams {
@@ -409,7 +485,8 @@ type additionalCheck struct {
 }
 
 func (ac *additionalCheck) isAdditional(name string) bool {
-	if name == "classification" || name == "certainty" || name == "id" || name == "vector" {
+	if name == "classification" || name == "certainty" || name == "distance" ||
+		name == "score" || name == "id" || name == "vector" || name == "group" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -478,6 +555,14 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.Certainty = true
 							continue
 						}
+						if additionalProperty == "distance" {
+							additionalProps.Distance = true
+							continue
+						}
+						if additionalProperty == "score" {
+							additionalProps.Score = true
+							continue
+						}
 						if additionalProperty == "id" {
 							additionalProps.ID = true
 							continue
@@ -486,6 +571,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.Vector = true
 							continue
 						}
+						if additionalProperty == "group" {
+							additionalProps.Group = true
+							continue
+						}
 						if modulesProvider != nil {
 							if additionalCheck.isModuleAdditional(additionalProperty) {
 								additionalProps.ModuleParams = getModuleParams(additionalProps.ModuleParams)
@@ -35,12 +35,20 @@ func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"vector": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Certainty,
-			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+			Type:        graphql.NewList(graphql.Float),
+		},
+		"vectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MultiVector,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
 		},
 		"certainty": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
 		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
 	}
 }
 
@@ -70,5 +78,53 @@ func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
 		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+func bm25Argument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.KeywordRanking,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sBm25InpObj", prefix),
+				Fields: bm25Fields(prefix),
+			},
+		),
+	}
+}
+
+func sparseArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.SparseRanking,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSparseInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"query": &graphql.InputObjectFieldConfig{
+						Description: descriptions.SparseQuery,
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+				},
+			},
+		),
+	}
+}
+
+func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"query": &graphql.InputObjectFieldConfig{
+			Description: descriptions.KeywordQuery,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"properties": &graphql.InputObjectFieldConfig{
+			Description: descriptions.KeywordProperties,
+			Type:        graphql.NewList(graphql.String),
+		},
 	}
 }
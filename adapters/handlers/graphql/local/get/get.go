@@ -25,6 +25,8 @@ import (
 type ModulesProvider interface {
 	GetArguments(class *models.Class) map[string]*graphql.ArgumentConfig
 	ExtractSearchParams(arguments map[string]interface{}, className string) map[string]interface{}
+	TransformArguments(class *models.Class) map[string]*graphql.ArgumentConfig
+	ExtractTransformParams(arguments map[string]interface{}, className string) map[string]interface{}
 	GetAdditionalFields(class *models.Class) map[string]*graphql.Field
 	ExtractAdditionalField(className, name string, params []*ast.Argument) interface{}
 	GraphQLAdditionalFieldNames() []string
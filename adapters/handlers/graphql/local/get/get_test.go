@@ -23,6 +23,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -809,6 +810,91 @@ func TestNearVectorRanker(t *testing.T) {
 
 		resolver.AssertResolve(t, query)
 	})
+
+	t.Run("for things with optional distance set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+							  vector: [0.123, 0.984]
+								distance: 0.6
+        			}) { intField } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &traverser.NearVectorParams{
+				Vector:       []float32{0.123, 0.984},
+				Distance:     0.6,
+				WithDistance: true,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestBM25WithoutVectors(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("on all properties", func(t *testing.T) {
+		query := `{ Get { SomeThing(bm25: {query: "quick fox"}) {
+			intField _additional { score } } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:      "SomeThing",
+			Properties:     []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &searchparams.KeywordRanking{Query: "quick fox"},
+			AdditionalProperties: additional.Properties{
+				Score: true,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("on selected properties", func(t *testing.T) {
+		query := `{ Get { SomeThing(bm25: {query: "fox", properties: ["name"]}) {
+			intField } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:      "fox",
+				Properties: []string{"name"},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestSparseWithoutVectors(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ Get { SomeThing(sparse: {query: "quick fox"}) {
+		intField _additional { score } } } }`
+
+	expectedParams := traverser.GetParams{
+		ClassName:     "SomeThing",
+		Properties:    []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		SparseRanking: &searchparams.SparseRanking{Query: "quick fox"},
+		AdditionalProperties: additional.Properties{
+			Score: true,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
 }
 
 func TestExtractPagination(t *testing.T) {
@@ -873,6 +959,52 @@ func TestExtractPaginationWithOnlyOffset(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractCursor(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Pagination: &filters.Pagination{
+			Limit: 10,
+		},
+		Cursor: &filters.Cursor{
+			After: "0ab5f9ce-4f84-4b5b-a8a4-c1e7e1a0b1d2",
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ Get { SomeAction(after: "0ab5f9ce-4f84-4b5b-a8a4-c1e7e1a0b1d2" limit: 10) { intField } } }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractSort(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Sort: []filters.Sort{
+			{
+				Path:  []string{"_lastUpdateTimeUnix"},
+				Order: "desc",
+			},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ Get { SomeAction(sort: [{path: ["_lastUpdateTimeUnix"], order: desc}]) { intField } } }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestExtractGroupParams(t *testing.T) {
 	t.Parallel()
 
@@ -894,6 +1026,51 @@ func TestExtractGroupParams(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractGroupByParams(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		GroupBy: &traverser.GroupByParams{
+			Path:            []string{"intField"},
+			Groups:          3,
+			ObjectsPerGroup: 5,
+		},
+		AdditionalProperties: additional.Properties{Group: true},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ Get { SomeAction(groupBy: {path: ["intField"], groups: 3, objectsPerGroup: 5}) {
+		intField _additional { group { id count groupedBy { value } hits { intField } } } } } }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractAutocut(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		NearVector: &traverser.NearVectorParams{
+			Vector: []float32{0.123, 0.984},
+		},
+		Autocut: 2,
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ Get { SomeAction(nearVector: {vector: [0.123, 0.984]}, autocut: 2) { intField } } }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestGetRelation(t *testing.T) {
 	t.Parallel()
 
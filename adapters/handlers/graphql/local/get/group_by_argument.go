@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+func groupByArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GetGroupBy,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sGroupByInpObj", prefix),
+				Fields:      groupByFields(),
+				Description: descriptions.GetGroupBy,
+			},
+		),
+	}
+}
+
+func groupByFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"path": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GetGroupByPath,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+		},
+		"groups": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GetGroupByGroups,
+			Type:        graphql.NewNonNull(graphql.Int),
+		},
+		"objectsPerGroup": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GetGroupByObjectsPerGroup,
+			Type:        graphql.NewNonNull(graphql.Int),
+		},
+	}
+}
+
+func extractGroupBy(args map[string]interface{}) *traverser.GroupByParams {
+	groupBy, ok := args["groupBy"]
+	if !ok {
+		return nil
+	}
+
+	asMap := groupBy.(map[string]interface{}) // guaranteed by graphql
+	var path []string
+	for _, segment := range asMap["path"].([]interface{}) {
+		path = append(path, segment.(string))
+	}
+
+	return &traverser.GroupByParams{
+		Path:            path,
+		Groups:          asMap["groups"].(int),
+		ObjectsPerGroup: asMap["objectsPerGroup"].(int),
+	}
+}
@@ -503,6 +503,14 @@ func (p *fakeModulesProvider) ExtractSearchParams(arguments map[string]interface
 	return exractedParams
 }
 
+func (p *fakeModulesProvider) TransformArguments(class *models.Class) map[string]*graphql.ArgumentConfig {
+	return map[string]*graphql.ArgumentConfig{}
+}
+
+func (p *fakeModulesProvider) ExtractTransformParams(arguments map[string]interface{}, className string) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
 func (p *fakeModulesProvider) GetAdditionalFields(class *models.Class) map[string]*graphql.Field {
 	additionalProperties := map[string]*graphql.Field{}
 	for name, additionalProperty := range p.nearCustomTextModule.AdditionalProperties() {
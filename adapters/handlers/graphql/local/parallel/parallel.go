@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package parallel resolves the top-level Get and Aggregate selections of a
+// single GraphQL query concurrently.
+//
+// graphql-go first calls the resolvers of all fields and only afterwards
+// evaluates the thunks they returned. A resolver which starts its work in
+// the background and returns a thunk waiting for the result therefore runs
+// in parallel to the resolvers of all other selections of the query.
+package parallel
+
+import (
+	"fmt"
+)
+
+// SourceKey is the key of the Limiter in the root object of a query
+const SourceKey = "Limiter"
+
+// Limiter caps the number of selections of a single query which are
+// resolved at the same time. A new Limiter is required for every query.
+type Limiter struct {
+	slots chan struct{}
+}
+
+// NewLimiter which resolves at most n selections at the same time
+func NewLimiter(n int) *Limiter {
+	if n < 1 {
+		n = 1
+	}
+
+	return &Limiter{slots: make(chan struct{}, n)}
+}
+
+// Go starts resolve as soon as a slot is free and returns a thunk which
+// blocks until resolve has completed
+func (l *Limiter) Go(resolve func() (interface{}, error)) func() (interface{}, error) {
+	var res interface{}
+	var err error
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer func() {
+			// a panicking resolver must not take the whole server down, it has
+			// to be reported as an error like graphql-go does for resolvers
+			// which are called synchronously
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v", r)
+			}
+		}()
+
+		l.slots <- struct{}{}
+		defer func() { <-l.slots }()
+
+		res, err = resolve()
+	}()
+
+	return func() (interface{}, error) {
+		<-done
+		return res, err
+	}
+}
+
+// FromSource returns the Limiter of the query. Queries without a Limiter,
+// for example in tests, resolve every selection lazily once graphql-go
+// evaluates its thunk, which is sequential.
+func FromSource(source interface{}) *Limiter {
+	asMap, ok := source.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	l, _ := asMap[SourceKey].(*Limiter)
+	return l
+}
+
+// Resolve runs resolve with the Limiter. Without a Limiter, resolve is only
+// run once the thunk is evaluated.
+func (l *Limiter) Resolve(resolve func() (interface{}, error)) func() (interface{}, error) {
+	if l == nil {
+		return resolve
+	}
+
+	return l.Go(resolve)
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package parallel
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Run("resolves concurrently up to the limit", func(t *testing.T) {
+		l := NewLimiter(2)
+
+		var running, maxRunning int32
+		var mu sync.Mutex
+		resolve := func() (interface{}, error) {
+			n := atomic.AddInt32(&running, 1)
+			mu.Lock()
+			if n > maxRunning {
+				maxRunning = n
+			}
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return "ok", nil
+		}
+
+		thunks := make([]func() (interface{}, error), 5)
+		for i := range thunks {
+			thunks[i] = l.Resolve(resolve)
+		}
+
+		for _, thunk := range thunks {
+			res, err := thunk()
+			require.Nil(t, err)
+			assert.Equal(t, "ok", res)
+		}
+
+		assert.Equal(t, int32(2), maxRunning)
+	})
+
+	t.Run("reports errors and panics", func(t *testing.T) {
+		l := NewLimiter(1)
+
+		_, err := l.Resolve(func() (interface{}, error) {
+			return nil, errors.New("failed")
+		})()
+		assert.EqualError(t, err, "failed")
+
+		_, err = l.Resolve(func() (interface{}, error) {
+			panic("boom")
+		})()
+		assert.EqualError(t, err, "boom")
+	})
+
+	t.Run("without a limiter resolves lazily", func(t *testing.T) {
+		var l *Limiter
+		called := false
+		thunk := l.Resolve(func() (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+
+		assert.False(t, called)
+		thunk()
+		assert.True(t, called)
+	})
+
+	t.Run("is taken from the source of the query", func(t *testing.T) {
+		l := NewLimiter(1)
+		assert.Equal(t, l, FromSource(map[string]interface{}{SourceKey: l}))
+		assert.Nil(t, FromSource(map[string]interface{}{}))
+		assert.Nil(t, FromSource(nil))
+	})
+}
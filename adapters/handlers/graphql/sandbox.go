@@ -0,0 +1,250 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+// The caps a sandboxed query can hit, as reported to the user
+const (
+	SandboxCapTime       = "time"
+	SandboxCapMemory     = "memory"
+	SandboxCapResultSize = "resultSize"
+)
+
+const (
+	// sandboxGracePeriod is how long a query which hit the time cap gets to
+	// return its partial results before it is abandoned
+	sandboxGracePeriod = time.Second
+
+	sandboxMemoryInterval = 20 * time.Millisecond
+	heapObjectsMetric     = "/memory/classes/heap/objects:bytes"
+)
+
+// SandboxCaps are the hard limits of a sandboxed query
+type SandboxCaps struct {
+	Timeout        time.Duration
+	MaxMemoryBytes uint64
+	MaxResultBytes int
+}
+
+// SandboxResult is the result of a sandboxed query. If a cap was hit, the
+// result contains whatever could be resolved within the caps.
+type SandboxResult struct {
+	*graphql.Result
+	CapsHit []string
+}
+
+type capsHit struct {
+	sync.Mutex
+	caps []string
+}
+
+func (c *capsHit) add(name string) {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, existing := range c.caps {
+		if existing == name {
+			return
+		}
+	}
+	c.caps = append(c.caps, name)
+}
+
+func (c *capsHit) list() []string {
+	c.Lock()
+	defer c.Unlock()
+
+	return append([]string{}, c.caps...)
+}
+
+// ResolveSandboxed resolves the query with hard caps on its runtime, the
+// heap growth while it runs and the size of its result. Rather than failing
+// once a cap is hit, the query is stopped and the results which are present
+// at that point are returned. Lists of objects are cut short to fit into
+// the result size cap.
+func ResolveSandboxed(ctx context.Context, gql GraphQL, caps SandboxCaps,
+	query, operationName string, variables map[string]interface{}) SandboxResult {
+	hit := &capsHit{}
+
+	ctx, cancel := context.WithTimeout(ctx, caps.Timeout)
+	defer cancel()
+
+	stopMonitor := monitorHeapGrowth(caps.MaxMemoryBytes, func() {
+		hit.add(SandboxCapMemory)
+		cancel()
+	})
+	defer stopMonitor()
+
+	resolved := make(chan *graphql.Result, 1)
+	go func() {
+		resolved <- gql.Resolve(ctx, query, operationName, variables)
+	}()
+
+	var result *graphql.Result
+	select {
+	case result = <-resolved:
+	case <-time.After(caps.Timeout + sandboxGracePeriod):
+		// the resolvers did not stop in time, nothing they found can be
+		// returned
+		result = &graphql.Result{}
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		hit.add(SandboxCapTime)
+	}
+
+	data, trimmed := trimToSize(result.Data, caps.MaxResultBytes)
+	if trimmed {
+		hit.add(SandboxCapResultSize)
+		result = &graphql.Result{
+			Data:       data,
+			Errors:     result.Errors,
+			Extensions: result.Extensions,
+		}
+	}
+
+	out := SandboxResult{Result: result, CapsHit: hit.list()}
+	for _, name := range out.CapsHit {
+		out.Errors = append(out.Errors, gqlerrors.FormattedError{
+			Message: fmt.Sprintf("query sandbox: %s cap hit, results are partial", name),
+		})
+	}
+
+	return out
+}
+
+// monitorHeapGrowth calls onExceeded once the heap has grown by more than
+// max bytes since the monitor was started. Since the heap is shared, this
+// includes allocations of everything else running on the node at the same
+// time. The returned func stops the monitor.
+func monitorHeapGrowth(max uint64, onExceeded func()) func() {
+	baseline := heapObjectBytes()
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(sandboxMemoryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if current := heapObjectBytes(); current > baseline && current-baseline > max {
+					onExceeded()
+					return
+				}
+			}
+		}
+	}()
+
+	return func() { close(stop) }
+}
+
+func heapObjectBytes() uint64 {
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+
+	return sample[0].Value.Uint64()
+}
+
+// trimToSize cuts the lists of objects returned by Get and Aggregate short,
+// so the marshalled data fits into maxBytes. All lists are cut to the same
+// length. If the data does not fit even without any objects, nothing is
+// returned. The second return value indicates whether the data was trimmed.
+func trimToSize(data interface{}, maxBytes int) (interface{}, bool) {
+	if data == nil || jsonSize(data) <= maxBytes {
+		return data, false
+	}
+
+	root, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, true
+	}
+
+	longest := 0
+	for _, operation := range root {
+		classes, ok := operation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, objects := range classes {
+			if list, ok := objects.([]interface{}); ok && len(list) > longest {
+				longest = len(list)
+			}
+		}
+	}
+
+	// the largest length for which the data fits
+	low, high := -1, longest
+	for low < high {
+		mid := (low + high + 1) / 2
+		if jsonSize(truncateLists(root, mid)) <= maxBytes {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+
+	if low < 0 {
+		return nil, true
+	}
+
+	return truncateLists(root, low), true
+}
+
+// truncateLists returns a copy of the data with every list of objects cut to
+// at most length entries, the data itself is not changed
+func truncateLists(root map[string]interface{}, length int) map[string]interface{} {
+	out := make(map[string]interface{}, len(root))
+	for name, operation := range root {
+		classes, ok := operation.(map[string]interface{})
+		if !ok {
+			out[name] = operation
+			continue
+		}
+
+		trimmed := make(map[string]interface{}, len(classes))
+		for class, objects := range classes {
+			if list, ok := objects.([]interface{}); ok && len(list) > length {
+				objects = list[:length]
+			}
+			trimmed[class] = objects
+		}
+		out[name] = trimmed
+	}
+
+	return out
+}
+
+func jsonSize(data interface{}) int {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return 0
+	}
+
+	return len(bytes)
+}
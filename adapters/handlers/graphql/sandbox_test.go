@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSandboxed(t *testing.T) {
+	caps := SandboxCaps{
+		Timeout:        50 * time.Millisecond,
+		MaxMemoryBytes: 1024 * 1024 * 1024,
+		MaxResultBytes: 1024 * 1024,
+	}
+
+	t.Run("a query within the caps", func(t *testing.T) {
+		gql := &fakeSandboxGraphQL{data: getData(3)}
+
+		res := ResolveSandboxed(context.Background(), gql, caps, "{}", "", nil)
+		assert.Equal(t, []string{}, res.CapsHit)
+		assert.Empty(t, res.Errors)
+		assert.Equal(t, getData(3), res.Data)
+	})
+
+	t.Run("a query exceeding the result size", func(t *testing.T) {
+		gql := &fakeSandboxGraphQL{data: getData(100)}
+		caps := caps
+		caps.MaxResultBytes = 500
+
+		res := ResolveSandboxed(context.Background(), gql, caps, "{}", "", nil)
+		assert.Equal(t, []string{SandboxCapResultSize}, res.CapsHit)
+		require.Len(t, res.Errors, 1)
+
+		objects := res.Data.(map[string]interface{})["Get"].(map[string]interface{})["Article"]
+		assert.NotEmpty(t, objects)
+		assert.Less(t, len(objects.([]interface{})), 100)
+		assert.LessOrEqual(t, jsonSize(res.Data), 500)
+	})
+
+	t.Run("a query exceeding the time", func(t *testing.T) {
+		gql := &fakeSandboxGraphQL{data: getData(3), waitForCancel: true}
+
+		res := ResolveSandboxed(context.Background(), gql, caps, "{}", "", nil)
+		assert.Equal(t, []string{SandboxCapTime}, res.CapsHit)
+		assert.Equal(t, getData(3), res.Data, "partial results are returned")
+	})
+
+	t.Run("a query exceeding the memory", func(t *testing.T) {
+		gql := &fakeSandboxGraphQL{data: getData(3), waitForCancel: true}
+		caps := caps
+		caps.Timeout = 5 * time.Second
+		caps.MaxMemoryBytes = 1024 * 1024
+		gql.allocate = 16 * 1024 * 1024
+
+		res := ResolveSandboxed(context.Background(), gql, caps, "{}", "", nil)
+		assert.Equal(t, []string{SandboxCapMemory}, res.CapsHit)
+	})
+}
+
+func getData(count int) map[string]interface{} {
+	objects := make([]interface{}, count)
+	for i := range objects {
+		objects[i] = map[string]interface{}{"title": fmt.Sprintf("article %d", i)}
+	}
+
+	return map[string]interface{}{
+		"Get": map[string]interface{}{"Article": objects},
+	}
+}
+
+type fakeSandboxGraphQL struct {
+	data          map[string]interface{}
+	waitForCancel bool
+	allocate      int
+	allocated     []byte
+}
+
+func (f *fakeSandboxGraphQL) Resolve(ctx context.Context, query string,
+	operationName string, variables map[string]interface{}) *graphql.Result {
+	if f.allocate > 0 {
+		f.allocated = make([]byte, f.allocate)
+	}
+
+	if f.waitForCancel {
+		<-ctx.Done()
+	}
+
+	return &graphql.Result{Data: f.data}
+}
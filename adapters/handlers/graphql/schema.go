@@ -18,8 +18,11 @@ import (
 	"runtime/debug"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/get"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/parallel"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/modules"
@@ -67,17 +70,47 @@ func Build(schema *schema.Schema, traverser Traverser,
 
 // Resolve at query time
 func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	result := graphql.Do(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
 			"Resolver": g.traverser,
 			"Config":   g.config,
+			// the top-level selections of a query share the limiter
+			parallel.SourceKey: parallel.NewLimiter(g.config.QueryParallelism),
 		},
 		RequestString:  query,
 		OperationName:  operationName,
 		VariableValues: variables,
 		Context:        context,
 	})
+
+	addErrorKinds(result.Errors)
+	return result
+}
+
+// addErrorKinds exposes the kind of each error in its extensions, so clients
+// can tell apart errors worth retrying. Errors without an original error
+// stem from parsing or validating the query itself.
+func addErrorKinds(errs []gqlerrors.FormattedError) {
+	for i := range errs {
+		if errs[i].Extensions != nil {
+			continue
+		}
+
+		original := errs[i].OriginalError()
+		if located, ok := original.(*gqlerrors.Error); ok {
+			original = located.OriginalError
+		}
+
+		if original == nil {
+			errs[i].Extensions = map[string]interface{}{
+				"code": string(errortypes.KindValidation),
+			}
+			continue
+		}
+
+		errs[i].Extensions = errortypes.Extensions(original)
+	}
 }
 
 func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+type tokenValidator interface {
+	ValidateAndExtract(token string, scopes []string) (*models.Principal, error)
+}
+
+// composeTokenAuth builds the go-swagger middleware for Bearer tokens. Both
+// API keys and OIDC tokens are sent as a Bearer token. If only one scheme is
+// enabled, all tokens are validated by it. If both are enabled, tokens which
+// have the shape of a JWT are validated by OIDC and all others are considered
+// API keys.
+func composeTokenAuth(cfg config.Authentication, apiKey,
+	oidc tokenValidator) func(token string, scopes []string) (*models.Principal, error) {
+	return func(token string, scopes []string) (*models.Principal, error) {
+		if cfg.APIKey.Enabled && (!cfg.OIDC.Enabled || !looksLikeJWT(token)) {
+			return apiKey.ValidateAndExtract(token, scopes)
+		}
+
+		return oidc.ValidateAndExtract(token, scopes)
+	}
+}
+
+// looksLikeJWT only checks the structure of the token: a JWT consists of a
+// header, payload and signature separated by dots
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,117 @@
+//	_       _
+//
+// __      _____  __ ___   ___  __ _| |_ ___
+//
+//	\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//	 \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//	  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//	 Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//	 CONTACT: hello@semi.technology
+package clusterapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+)
+
+type backupShards interface {
+	BackupShards(ctx context.Context,
+		req *backup.NodeRequest) ([]backup.ShardDescriptor, error)
+	RestoreShards(ctx context.Context, req *backup.NodeRequest) error
+}
+
+type backups struct {
+	shards backupShards
+}
+
+func NewBackups(shards backupShards) *backups {
+	return &backups{shards: shards}
+}
+
+// Shards serves the requests of a backup coordinator on another node to back
+// up or restore the shards owned by this node. Both block until all files
+// have been transferred.
+func (b *backups) Shards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/backups/shards/backup":
+			b.backupShards().ServeHTTP(w, r)
+		case "/backups/shards/restore":
+			b.restoreShards().ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func (b *backups) backupShards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, ok := decodeNodeRequest(w, r)
+		if !ok {
+			return
+		}
+
+		shards, err := b.shards.BackupShards(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := json.Marshal(shards)
+		if err != nil {
+			http.Error(w, errors.Wrap(err, "marshal response").Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(resBytes)
+	})
+}
+
+func (b *backups) restoreShards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, ok := decodeNodeRequest(w, r)
+		if !ok {
+			return
+		}
+
+		if err := b.shards.RestoreShards(r.Context(), req); err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func decodeNodeRequest(w http.ResponseWriter,
+	r *http.Request) (*backup.NodeRequest, bool) {
+	defer r.Body.Close()
+
+	if r.Header.Get("content-type") != "application/json" {
+		http.Error(w, "415 Unsupported Media Type", http.StatusUnsupportedMediaType)
+		return nil, false
+	}
+
+	var req backup.NodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, errors.Wrap(err, "decode body").Error(),
+			http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &req, true
+}
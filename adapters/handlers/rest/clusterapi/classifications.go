@@ -15,8 +15,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
 )
@@ -101,14 +103,13 @@ func (s *classifications) incomingTransaction() http.Handler {
 			Type:    payload.Type,
 			Payload: txPayload,
 		}
+		if payload.TTLMilli > 0 {
+			tx.Deadline = time.Now().Add(time.Duration(payload.TTLMilli) * time.Millisecond)
+		}
 
 		if err := s.txManager.IncomingBeginTransaction(r.Context(), tx); err != nil {
-			status := http.StatusInternalServerError
-			if errors.Is(err, cluster.ErrConcurrentTransaction) {
-				status = http.StatusConflict
-			}
-
-			http.Error(w, errors.Wrap(err, "open transaction").Error(), status)
+			http.Error(w, errors.Wrap(err, "open transaction").Error(),
+				errortypes.HTTPStatus(err))
 			return
 		}
 
@@ -145,12 +146,8 @@ func (s *classifications) incomingCommitTransaction() http.Handler {
 		}
 
 		if err := s.txManager.IncomingCommitTransaction(r.Context(), tx); err != nil {
-			status := http.StatusInternalServerError
-			if errors.Is(err, cluster.ErrConcurrentTransaction) {
-				status = http.StatusConflict
-			}
-
-			http.Error(w, errors.Wrap(err, "open transaction").Error(), status)
+			http.Error(w, errors.Wrap(err, "open transaction").Error(),
+				errortypes.HTTPStatus(err))
 			return
 		}
 		w.WriteHeader(http.StatusNoContent)
@@ -50,6 +50,10 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
+func (f fakeVectorConfig) DistanceName() string {
+	return "fake"
+}
+
 func dummyParseVectorConfig(in interface{}) (schemaent.VectorIndexConfig, error) {
 	return fakeVectorConfig(in.(map[string]interface{})), nil
 }
@@ -164,3 +168,34 @@ func (n *NilMigrator) ValidateVectorIndexConfigUpdate(ctx context.Context, old,
 func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className string, updated schemaent.VectorIndexConfig) error {
 	return nil
 }
+
+func (n *NilMigrator) AddTenants(ctx context.Context, className string, tenants []*models.Tenant) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdateTenants(ctx context.Context, className string, tenants []*models.Tenant) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdateShards(ctx context.Context, className string) error {
+	return nil
+}
+
+func (n *NilMigrator) CleanupVectorIndex(ctx context.Context, className,
+	shardName string) (*models.VectorIndexCleanupResponse, error) {
+	return &models.VectorIndexCleanupResponse{}, nil
+}
+
+func (n *NilMigrator) ClassStatus(ctx context.Context,
+	className string) (*models.ClassStatus, error) {
+	return &models.ClassStatus{Class: className}, nil
+}
+
+func (n *NilMigrator) StartVectorIndexAdvisor(ctx context.Context, className string,
+	params *models.VectorIndexAdviceRequest) (*models.ClassStatus, error) {
+	return &models.ClassStatus{Class: className}, nil
+}
@@ -19,13 +19,16 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 )
@@ -35,6 +38,11 @@ type indices struct {
 	regexpObjects             *regexp.Regexp
 	regexpObjectsSearch       *regexp.Regexp
 	regexpObjectsAggregations *regexp.Regexp
+	regexpObjectsFind         *regexp.Regexp
+	regexpObjectsScroll       *regexp.Regexp
+	regexpObjectsFacets       *regexp.Regexp
+	regexpObjectsEstimate     *regexp.Regexp
+	regexpObjectsContinuous   *regexp.Regexp
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 }
@@ -46,6 +54,16 @@ const (
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_search`
 	urlPatternObjectsAggregations = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_aggregations`
+	urlPatternObjectsFind = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_find`
+	urlPatternObjectsScroll = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_scroll`
+	urlPatternObjectsFacets = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_facets`
+	urlPatternObjectsEstimate = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_estimate`
+	urlPatternObjectsContinuous = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_continuous`
 	urlPatternObject = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/([A-Za-z0-9_+-]+)`
 	urlPatternReferences = `\/indices\/([A-Za-z0-9_+-]+)` +
@@ -64,13 +82,32 @@ type shards interface {
 		additional additional.Properties) (*storobj.Object, error)
 	Exists(ctx context.Context, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
+	DeleteObject(ctx context.Context, indexName, shardName string,
+		id strfmt.UUID) error
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, limit int, filters *filters.LocalFilter,
+		vector []float32, vectors [][]float32,
+		keywordRanking *searchparams.KeywordRanking,
+		sparseRanking *searchparams.SparseRanking,
+		limit int, filters *filters.LocalFilter,
+		cursor *filters.Cursor, sort []filters.Sort,
 		additional additional.Properties) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	FindDocIDs(ctx context.Context, indexName, shardName string,
+		filters *filters.LocalFilter) ([]uint64, error)
+	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
+		docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error)
+	Scroll(ctx context.Context, indexName, shardName, id string,
+		ttl time.Duration, limit int,
+		additional additional.Properties) ([]*storobj.Object, string, error)
+	FacetedSearch(ctx context.Context, indexName, shardName string,
+		params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error)
+	EstimateFilter(ctx context.Context, indexName, shardName string,
+		params filters.EstimateParams) (*filters.Estimate, error)
+	ContinuousAggregate(ctx context.Context, indexName, shardName string,
+		params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error)
 }
 
 func NewIndices(shards shards) *indices {
@@ -78,6 +115,11 @@ func NewIndices(shards shards) *indices {
 		regexpObjects:             regexp.MustCompile(urlPatternObjects),
 		regexpObjectsSearch:       regexp.MustCompile(urlPatternObjectsSearch),
 		regexpObjectsAggregations: regexp.MustCompile(urlPatternObjectsAggregations),
+		regexpObjectsFind:         regexp.MustCompile(urlPatternObjectsFind),
+		regexpObjectsScroll:       regexp.MustCompile(urlPatternObjectsScroll),
+		regexpObjectsFacets:       regexp.MustCompile(urlPatternObjectsFacets),
+		regexpObjectsEstimate:     regexp.MustCompile(urlPatternObjectsEstimate),
+		regexpObjectsContinuous:   regexp.MustCompile(urlPatternObjectsContinuous),
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		shards:                    shards,
@@ -104,13 +146,56 @@ func (i *indices) Indices() http.Handler {
 
 			i.postAggregateObjects().ServeHTTP(w, r)
 			return
-		case i.regexpObject.MatchString(path):
-			if r.Method != http.MethodGet {
+		case i.regexpObjectsFind.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postFindDocIDs().ServeHTTP(w, r)
+			return
+		case i.regexpObjectsScroll.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postScrollObjects().ServeHTTP(w, r)
+			return
+		case i.regexpObjectsFacets.MatchString(path):
+			if r.Method != http.MethodPost {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 				return
 			}
 
-			i.getObject().ServeHTTP(w, r)
+			i.postFacetedSearch().ServeHTTP(w, r)
+			return
+		case i.regexpObjectsEstimate.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postEstimateFilter().ServeHTTP(w, r)
+			return
+		case i.regexpObjectsContinuous.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postContinuousAggregate().ServeHTTP(w, r)
+			return
+		case i.regexpObject.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getObject().ServeHTTP(w, r)
+				return
+			}
+			if r.Method == http.MethodDelete {
+				i.deleteObject().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
 		case i.regexpObjects.MatchString(path):
@@ -122,6 +207,10 @@ func (i *indices) Indices() http.Handler {
 				i.postObject().ServeHTTP(w, r)
 				return
 			}
+			if r.Method == http.MethodDelete {
+				i.deleteObjects().ServeHTTP(w, r)
+				return
+			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
@@ -181,12 +270,12 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 
 	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
 	if err := i.shards.PutObject(r.Context(), index, shard, obj); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errortypes.HTTPStatus(err))
 		return
 	}
 
@@ -203,11 +292,16 @@ func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 
 	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	errs := i.shards.BatchPutObjects(r.Context(), index, shard, objs)
+	ctx := r.Context()
+	if r.URL.Query().Get("atomic") != "" {
+		ctx = objects.WithAtomicShardBatches(ctx)
+	}
+
+	errs := i.shards.BatchPutObjects(ctx, index, shard, objs)
 	errsJSON, err := IndicesPayloads.ErrorList.Marshal(errs)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -281,7 +375,8 @@ func (i *indices) getObject() http.Handler {
 		obj, err := i.shards.GetObject(r.Context(), index, shard, strfmt.UUID(id),
 			selectProperties, additional)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
 		}
 
 		if obj == nil {
@@ -294,6 +389,7 @@ func (i *indices) getObject() http.Handler {
 		objBytes, err := IndicesPayloads.SingleObject.Marshal(obj)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
 		}
 
 		IndicesPayloads.SingleObject.SetContentTypeHeader(w)
@@ -305,7 +401,8 @@ func (i *indices) checkExists(w http.ResponseWriter, r *http.Request,
 	index, shard, id string) {
 	ok, err := i.shards.Exists(r.Context(), index, shard, strfmt.UUID(id))
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+		return
 	}
 
 	if ok {
@@ -315,6 +412,28 @@ func (i *indices) checkExists(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+func (i *indices) deleteObject() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObject.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard, id := args[1], args[2], args[3]
+
+		defer r.Body.Close()
+
+		err := i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id))
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *indices) getObjectsMulti() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpObjects.FindStringSubmatch(r.URL.Path)
@@ -351,7 +470,7 @@ func (i *indices) getObjectsMulti() http.Handler {
 
 		objs, err := i.shards.MultiGetObjects(r.Context(), index, shard, ids)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
 		}
 
 		objsBytes, err := IndicesPayloads.ObjectList.Marshal(objs)
@@ -388,7 +507,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, limit, filters, additional, err := IndicesPayloads.SearchParams.
+		vector, vectors, keywordRanking, sparseRanking, limit, filters, cursor, sort, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -397,9 +516,10 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, limit, filters, additional)
+			vector, vectors, keywordRanking, sparseRanking, limit, filters, cursor, sort,
+			additional)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
 			return
 		}
 
@@ -414,6 +534,200 @@ func (i *indices) postSearchObjects() http.Handler {
 	})
 }
 
+func (i *indices) postScrollObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsScroll.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.ScrollParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		id, ttl, limit, additional, err := IndicesPayloads.ScrollParams.
+			Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal scroll params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, next, err := i.shards.Scroll(r.Context(), index, shard, id, ttl,
+			limit, additional)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := IndicesPayloads.ScrollResults.Marshal(results, next)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ScrollResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) postFacetedSearch() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsFacets.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.FacetParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		params, err := IndicesPayloads.FacetParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal facet params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		objs, res, err := i.shards.FacetedSearch(r.Context(), index, shard, params)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := IndicesPayloads.FacetResults.Marshal(objs, res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.FacetResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) postEstimateFilter() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsEstimate.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.EstimateParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		params, err := IndicesPayloads.EstimateParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal estimate params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		res, err := i.shards.EstimateFilter(r.Context(), index, shard, params)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := IndicesPayloads.EstimateResult.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.EstimateResult.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) postContinuousAggregate() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsContinuous.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.ContinuousParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		params, err := IndicesPayloads.ContinuousParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal continuous aggregate params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		res, err := i.shards.ContinuousAggregate(r.Context(), index, shard, params)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := IndicesPayloads.ContinuousResult.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ContinuousResult.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) postReferences() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpReferences.FindStringSubmatch(r.URL.Path)
@@ -492,7 +806,7 @@ func (i *indices) postAggregateObjects() http.Handler {
 
 		aggRes, err := i.shards.Aggregate(r.Context(), index, shard, params)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
 			return
 		}
 
@@ -506,3 +820,102 @@ func (i *indices) postAggregateObjects() http.Handler {
 		w.Write(aggResBytes)
 	})
 }
+
+func (i *indices) postFindDocIDs() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsFind.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.FindDocIDsParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		filters, err := IndicesPayloads.FindDocIDsParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal find doc ids params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		docIDs, err := i.shards.FindDocIDs(r.Context(), index, shard, filters)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := IndicesPayloads.FindDocIDsResults.Marshal(docIDs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.FindDocIDsResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) deleteObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjects.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.BatchDeleteParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		docIDs, dryRun, err := IndicesPayloads.BatchDeleteParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal batch delete params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.DeleteObjectBatch(r.Context(), index, shard,
+			docIDs, dryRun)
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := IndicesPayloads.BatchDeleteResult.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.BatchDeleteResult.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
@@ -18,11 +18,14 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 )
@@ -38,6 +41,18 @@ type indicesPayloads struct {
 	ReferenceList     referenceListPayload
 	AggregationParams aggregationParamsPayload
 	AggregationResult aggregationResultPayload
+	FindDocIDsParams  findDocIDsParamsPayload
+	FindDocIDsResults findDocIDsResultsPayload
+	BatchDeleteParams batchDeleteParamsPayload
+	BatchDeleteResult batchDeleteResultPayload
+	ScrollParams      scrollParamsPayload
+	ScrollResults     scrollResultsPayload
+	FacetParams       facetParamsPayload
+	FacetResults      facetResultsPayload
+	EstimateParams    estimateParamsPayload
+	EstimateResult    estimateResultPayload
+	ContinuousParams  continuousParamsPayload
+	ContinuousResult  continuousResultPayload
 }
 
 type errorListPayload struct{}
@@ -187,30 +202,48 @@ func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
 
 type searchParamsPayload struct{}
 
-func (p searchParamsPayload) Marshal(vector []float32, limit int,
-	filter *filters.LocalFilter, addP additional.Properties) ([]byte, error) {
+func (p searchParamsPayload) Marshal(vector []float32, vectors [][]float32,
+	keywordRanking *searchparams.KeywordRanking,
+	sparseRanking *searchparams.SparseRanking, limit int,
+	filter *filters.LocalFilter, cursor *filters.Cursor, sort []filters.Sort,
+	addP additional.Properties) ([]byte, error) {
 	type params struct {
-		SearchVector []float32             `json:"searchVector"`
-		Limit        int                   `json:"limit"`
-		Filters      *filters.LocalFilter  `json:"filters"`
-		Additional   additional.Properties `json:"additional"`
+		SearchVector   []float32                    `json:"searchVector"`
+		SearchVectors  [][]float32                  `json:"searchVectors,omitempty"`
+		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking,omitempty"`
+		SparseRanking  *searchparams.SparseRanking  `json:"sparseRanking,omitempty"`
+		Limit          int                          `json:"limit"`
+		Filters        *filters.LocalFilter         `json:"filters"`
+		Cursor         *filters.Cursor              `json:"cursor,omitempty"`
+		Sort           []filters.Sort               `json:"sort,omitempty"`
+		Additional     additional.Properties        `json:"additional"`
 	}
 
-	par := params{vector, limit, filter, addP}
+	par := params{vector, vectors, keywordRanking, sparseRanking, limit, filter,
+		cursor, sort, addP}
 	return json.Marshal(par)
 }
 
-func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, int,
-	*filters.LocalFilter, additional.Properties, error) {
+func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, [][]float32,
+	*searchparams.KeywordRanking, *searchparams.SparseRanking, int,
+	*filters.LocalFilter, *filters.Cursor,
+	[]filters.Sort, additional.Properties, error) {
 	type searchParametersPayload struct {
-		SearchVector []float32             `json:"searchVector"`
-		Limit        int                   `json:"limit"`
-		Filters      *filters.LocalFilter  `json:"filters"`
-		Additional   additional.Properties `json:"additional"`
+		SearchVector   []float32                    `json:"searchVector"`
+		SearchVectors  [][]float32                  `json:"searchVectors,omitempty"`
+		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking,omitempty"`
+		SparseRanking  *searchparams.SparseRanking  `json:"sparseRanking,omitempty"`
+		Limit          int                          `json:"limit"`
+		Filters        *filters.LocalFilter         `json:"filters"`
+		Cursor         *filters.Cursor              `json:"cursor,omitempty"`
+		Sort           []filters.Sort               `json:"sort,omitempty"`
+		Additional     additional.Properties        `json:"additional"`
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
-	return par.SearchVector, par.Limit, par.Filters, par.Additional, err
+	return par.SearchVector, par.SearchVectors, par.KeywordRanking,
+		par.SparseRanking, par.Limit, par.Filters,
+		par.Cursor, par.Sort, par.Additional, err
 }
 
 func (p searchParamsPayload) MIME() string {
@@ -381,3 +414,422 @@ func (p aggregationResultPayload) Unmarshal(in []byte) (*aggregation.Result, err
 	err := json.Unmarshal(in, &out)
 	return &out, err
 }
+
+type findDocIDsParamsPayload struct{}
+
+func (p findDocIDsParamsPayload) Marshal(filter *filters.LocalFilter) ([]byte, error) {
+	type params struct {
+		Filters *filters.LocalFilter `json:"filters"`
+	}
+
+	return json.Marshal(params{filter})
+}
+
+func (p findDocIDsParamsPayload) Unmarshal(in []byte) (*filters.LocalFilter, error) {
+	type findDocIDsParametersPayload struct {
+		Filters *filters.LocalFilter `json:"filters"`
+	}
+	var par findDocIDsParametersPayload
+	err := json.Unmarshal(in, &par)
+	return par.Filters, err
+}
+
+func (p findDocIDsParamsPayload) MIME() string {
+	return "vnd.weaviate.finddocidsparams+json"
+}
+
+func (p findDocIDsParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p findDocIDsParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type findDocIDsResultsPayload struct{}
+
+func (p findDocIDsResultsPayload) Marshal(in []uint64) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p findDocIDsResultsPayload) Unmarshal(in []byte) ([]uint64, error) {
+	var out []uint64
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p findDocIDsResultsPayload) MIME() string {
+	return "vnd.weaviate.finddocidsresults+json"
+}
+
+func (p findDocIDsResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p findDocIDsResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type batchDeleteParamsPayload struct{}
+
+func (p batchDeleteParamsPayload) Marshal(docIDs []uint64, dryRun bool) ([]byte, error) {
+	type params struct {
+		DocIDs []uint64 `json:"docIDs"`
+		DryRun bool     `json:"dryRun"`
+	}
+
+	return json.Marshal(params{docIDs, dryRun})
+}
+
+func (p batchDeleteParamsPayload) Unmarshal(in []byte) ([]uint64, bool, error) {
+	type batchDeleteParametersPayload struct {
+		DocIDs []uint64 `json:"docIDs"`
+		DryRun bool     `json:"dryRun"`
+	}
+	var par batchDeleteParametersPayload
+	err := json.Unmarshal(in, &par)
+	return par.DocIDs, par.DryRun, err
+}
+
+func (p batchDeleteParamsPayload) MIME() string {
+	return "vnd.weaviate.batchdeleteparams+json"
+}
+
+func (p batchDeleteParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p batchDeleteParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type batchDeleteResultPayload struct{}
+
+// errors do not survive a json round trip, so they are transferred as their
+// message, an empty message meaning the delete succeeded
+type batchDeleteResultItem struct {
+	UUID strfmt.UUID `json:"uuid"`
+	Err  string      `json:"err,omitempty"`
+}
+
+func (p batchDeleteResultPayload) Marshal(in objects.BatchSimpleObjects) ([]byte, error) {
+	converted := make([]batchDeleteResultItem, len(in))
+	for i, obj := range in {
+		converted[i].UUID = obj.UUID
+		if obj.Err != nil {
+			converted[i].Err = obj.Err.Error()
+		}
+	}
+
+	return json.Marshal(converted)
+}
+
+func (p batchDeleteResultPayload) Unmarshal(in []byte) (objects.BatchSimpleObjects, error) {
+	var converted []batchDeleteResultItem
+	if err := json.Unmarshal(in, &converted); err != nil {
+		return nil, err
+	}
+
+	out := make(objects.BatchSimpleObjects, len(converted))
+	for i, obj := range converted {
+		out[i].UUID = obj.UUID
+		if obj.Err != "" {
+			out[i].Err = errors.New(obj.Err)
+		}
+	}
+
+	return out, nil
+}
+
+func (p batchDeleteResultPayload) MIME() string {
+	return "vnd.weaviate.batchdeleteresult+json"
+}
+
+func (p batchDeleteResultPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p batchDeleteResultPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type scrollParamsPayload struct{}
+
+type scrollParameters struct {
+	ID         string                `json:"id"`
+	TTL        time.Duration         `json:"ttl"`
+	Limit      int                   `json:"limit"`
+	Additional additional.Properties `json:"additional"`
+}
+
+func (p scrollParamsPayload) Marshal(id string, ttl time.Duration, limit int,
+	addP additional.Properties) ([]byte, error) {
+	return json.Marshal(scrollParameters{id, ttl, limit, addP})
+}
+
+func (p scrollParamsPayload) Unmarshal(in []byte) (string, time.Duration, int,
+	additional.Properties, error) {
+	var par scrollParameters
+	err := json.Unmarshal(in, &par)
+	return par.ID, par.TTL, par.Limit, par.Additional, err
+}
+
+func (p scrollParamsPayload) MIME() string {
+	return "vnd.weaviate.scrollparams+json"
+}
+
+func (p scrollParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p scrollParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type scrollResultsPayload struct{}
+
+// Marshal the id for the next page, prefixed by its length, followed by the
+// objects of the page
+func (p scrollResultsPayload) Marshal(objs []*storobj.Object,
+	next string) ([]byte, error) {
+	objsBytes, err := IndicesPayloads.ObjectList.Marshal(objs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 8, 8+len(next)+len(objsBytes))
+	binary.LittleEndian.PutUint64(out, uint64(len(next)))
+	out = append(out, next...)
+	out = append(out, objsBytes...)
+
+	return out, nil
+}
+
+func (p scrollResultsPayload) Unmarshal(in []byte) ([]*storobj.Object, string, error) {
+	if len(in) < 8 {
+		return nil, "", errors.Errorf("corrupt read: payload too short")
+	}
+
+	nextLength := binary.LittleEndian.Uint64(in[:8])
+	if uint64(len(in)) < 8+nextLength {
+		return nil, "", errors.Errorf("corrupt read: payload too short")
+	}
+
+	next := string(in[8 : 8+nextLength])
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[8+nextLength:])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return objs, next, nil
+}
+
+func (p scrollResultsPayload) MIME() string {
+	return "application/vnd.weaviate.shardscrollresults+octet-stream"
+}
+
+func (p scrollResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p scrollResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type facetParamsPayload struct{}
+
+func (p facetParamsPayload) Marshal(params aggregation.FacetParams) ([]byte, error) {
+	return json.Marshal(params)
+}
+
+func (p facetParamsPayload) Unmarshal(in []byte) (aggregation.FacetParams, error) {
+	var out aggregation.FacetParams
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p facetParamsPayload) MIME() string {
+	return "application/vnd.weaviate.facets.params+json"
+}
+
+func (p facetParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p facetParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type facetResultsPayload struct{}
+
+// Marshal the json-encoded facets, prefixed by their length, followed by the
+// objects of the search
+func (p facetResultsPayload) Marshal(objs []*storobj.Object,
+	res *aggregation.FacetResult) ([]byte, error) {
+	resBytes, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	objsBytes, err := IndicesPayloads.ObjectList.Marshal(objs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 8, 8+len(resBytes)+len(objsBytes))
+	binary.LittleEndian.PutUint64(out, uint64(len(resBytes)))
+	out = append(out, resBytes...)
+	out = append(out, objsBytes...)
+
+	return out, nil
+}
+
+func (p facetResultsPayload) Unmarshal(in []byte) ([]*storobj.Object,
+	*aggregation.FacetResult, error) {
+	if len(in) < 8 {
+		return nil, nil, errors.Errorf("corrupt read: payload too short")
+	}
+
+	resLength := binary.LittleEndian.Uint64(in[:8])
+	if uint64(len(in)) < 8+resLength {
+		return nil, nil, errors.Errorf("corrupt read: payload too short")
+	}
+
+	var res aggregation.FacetResult
+	if err := json.Unmarshal(in[8:8+resLength], &res); err != nil {
+		return nil, nil, err
+	}
+
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[8+resLength:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, &res, nil
+}
+
+func (p facetResultsPayload) MIME() string {
+	return "application/vnd.weaviate.shardfacetresults+octet-stream"
+}
+
+func (p facetResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p facetResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type estimateParamsPayload struct{}
+
+func (p estimateParamsPayload) Marshal(params filters.EstimateParams) ([]byte, error) {
+	return json.Marshal(params)
+}
+
+func (p estimateParamsPayload) Unmarshal(in []byte) (filters.EstimateParams, error) {
+	var out filters.EstimateParams
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p estimateParamsPayload) MIME() string {
+	return "application/vnd.weaviate.estimate.params+json"
+}
+
+func (p estimateParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p estimateParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type estimateResultPayload struct{}
+
+func (p estimateResultPayload) Marshal(res *filters.Estimate) ([]byte, error) {
+	return json.Marshal(res)
+}
+
+func (p estimateResultPayload) Unmarshal(in []byte) (*filters.Estimate, error) {
+	var out filters.Estimate
+	if err := json.Unmarshal(in, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (p estimateResultPayload) MIME() string {
+	return "application/vnd.weaviate.estimate.result+json"
+}
+
+func (p estimateResultPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p estimateResultPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type continuousParamsPayload struct{}
+
+func (p continuousParamsPayload) Marshal(params aggregation.ContinuousParams) ([]byte, error) {
+	return json.Marshal(params)
+}
+
+func (p continuousParamsPayload) Unmarshal(in []byte) (aggregation.ContinuousParams, error) {
+	var out aggregation.ContinuousParams
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p continuousParamsPayload) MIME() string {
+	return "application/vnd.weaviate.continuous.params+json"
+}
+
+func (p continuousParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p continuousParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type continuousResultPayload struct{}
+
+func (p continuousResultPayload) Marshal(res []aggregation.ContinuousGroup) ([]byte, error) {
+	return json.Marshal(res)
+}
+
+func (p continuousResultPayload) Unmarshal(in []byte) ([]aggregation.ContinuousGroup, error) {
+	var out []aggregation.ContinuousGroup
+	if err := json.Unmarshal(in, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (p continuousResultPayload) MIME() string {
+	return "application/vnd.weaviate.continuous.result+json"
+}
+
+func (p continuousResultPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p continuousResultPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
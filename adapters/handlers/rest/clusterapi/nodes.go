@@ -0,0 +1,112 @@
+//	_       _
+//
+// __      _____  __ ___   ___  __ _| |_ ___
+//
+//	\ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//	 \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//	  \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//	 Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//	 CONTACT: hello@semi.technology
+package clusterapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	nodesuc "github.com/semi-technologies/weaviate/usecases/nodes"
+)
+
+type localNodeStatus interface {
+	LocalNodeStatus(ctx context.Context) *models.NodeStatus
+	DrainLocalNode(ctx context.Context) (*models.NodeDrainResponse, error)
+	LocalNodeConfig() (*nodesuc.NodeConfig, error)
+}
+
+type nodes struct {
+	local localNodeStatus
+}
+
+func NewNodes(local localNodeStatus) *nodes {
+	return &nodes{local: local}
+}
+
+// Status serves the status of this node to the nodes manager of another node
+// which aggregates the status of the entire cluster
+func (n *nodes) Status() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		resBytes, err := json.Marshal(n.local.LocalNodeStatus(r.Context()))
+		if err != nil {
+			http.Error(w, errors.Wrap(err, "marshal response").Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(resBytes)
+	})
+}
+
+// Drain moves the shards of this node to the other nodes of the cluster on
+// behalf of the node which received the drain request from the user
+func (n *nodes) Drain() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		res, err := n.local.DrainLocalNode(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+			return
+		}
+
+		resBytes, err := json.Marshal(res)
+		if err != nil {
+			http.Error(w, errors.Wrap(err, "marshal response").Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(resBytes)
+	})
+}
+
+// Config serves the effective configuration of this node to the node which
+// compares the configs of the entire cluster
+func (n *nodes) Config() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		cfg, err := n.local.LocalNodeConfig()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(cfg)
+		if err != nil {
+			http.Error(w, errors.Wrap(err, "marshal response").Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(resBytes)
+	})
+}
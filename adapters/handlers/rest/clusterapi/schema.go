@@ -16,8 +16,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
 	schemauc "github.com/semi-technologies/weaviate/usecases/schema"
 )
@@ -28,12 +30,17 @@ type txManager interface {
 	IncomingAbortTransaction(ctx context.Context, tx *cluster.Transaction)
 }
 
+type schemaStateGetter interface {
+	CurrentState() schemauc.State
+}
+
 type schema struct {
 	txManager txManager
+	state     schemaStateGetter
 }
 
-func NewSchema(manager txManager) *schema {
-	return &schema{txManager: manager}
+func NewSchema(manager txManager, state schemaStateGetter) *schema {
+	return &schema{txManager: manager, state: state}
 }
 
 // TODO: move out of schema, this is not schema specific
@@ -85,6 +92,27 @@ func (s *schema) Transactions() http.Handler {
 	})
 }
 
+// State serves the local schema, so that a starting node can reconcile its
+// own schema with the rest of the cluster
+func (s *schema) State() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		stateJSON, err := json.Marshal(s.state.CurrentState())
+		if err != nil {
+			http.Error(w, errors.Wrap(err, "marshal schema").Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(stateJSON)
+	})
+}
+
 func (s *schema) incomingTransaction() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
@@ -123,14 +151,13 @@ func (s *schema) incomingTransaction() http.Handler {
 			Type:    payload.Type,
 			Payload: txPayload,
 		}
+		if payload.TTLMilli > 0 {
+			tx.Deadline = time.Now().Add(time.Duration(payload.TTLMilli) * time.Millisecond)
+		}
 
 		if err := s.txManager.IncomingBeginTransaction(r.Context(), tx); err != nil {
-			status := http.StatusInternalServerError
-			if errors.Is(err, cluster.ErrConcurrentTransaction) {
-				status = http.StatusConflict
-			}
-
-			http.Error(w, errors.Wrap(err, "open transaction").Error(), status)
+			http.Error(w, errors.Wrap(err, "open transaction").Error(),
+				errortypes.HTTPStatus(err))
 			return
 		}
 
@@ -167,12 +194,8 @@ func (s *schema) incomingCommitTransaction() http.Handler {
 		}
 
 		if err := s.txManager.IncomingCommitTransaction(r.Context(), tx); err != nil {
-			status := http.StatusInternalServerError
-			if errors.Is(err, cluster.ErrConcurrentTransaction) {
-				status = http.StatusConflict
-			}
-
-			http.Error(w, errors.Wrap(err, "open transaction").Error(), status)
+			http.Error(w, errors.Wrap(err, "open transaction").Error(),
+				errortypes.HTTPStatus(err))
 			return
 		}
 		w.WriteHeader(http.StatusNoContent)
@@ -180,7 +203,8 @@ func (s *schema) incomingCommitTransaction() http.Handler {
 }
 
 type txPayload struct {
-	ID      string
-	Type    cluster.TransactionType
-	Payload json.RawMessage
+	ID       string
+	Type     cluster.TransactionType
+	Payload  json.RawMessage
+	TTLMilli int64
 }
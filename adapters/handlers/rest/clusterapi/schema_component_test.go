@@ -21,7 +21,6 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/clients"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/semi-technologies/weaviate/entities/models"
-	"github.com/semi-technologies/weaviate/usecases/cluster"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	schemauc "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -112,14 +111,67 @@ func TestComponentCluster(t *testing.T) {
 	})
 }
 
+func TestComponentClusterStartupSync(t *testing.T) {
+	ctx := context.Background()
+
+	// two nodes which have committed a transaction, which the third node - the
+	// one that is starting up - missed
+	client := clients.NewClusterSchema(&http.Client{})
+	participant := newSchemaManagerWithClusterStateAndClient(
+		&fakeClusterState{hosts: []string{}}, nil)
+	participantHost := serveSchemaManager(t, participant)
+	coordinator := newSchemaManagerWithClusterStateAndClient(
+		&fakeClusterState{hosts: []string{participantHost}}, client)
+	coordinatorHost := serveSchemaManager(t, coordinator)
+	require.Nil(t, coordinator.AddClass(ctx, nil, testClass()))
+	hosts := []string{coordinatorHost, participantHost}
+
+	t.Run("the majority of the cluster has the class", func(t *testing.T) {
+		state := &fakeClusterState{hosts: hosts}
+		localManager := newSchemaManagerWithClusterStateAndClient(state, client)
+
+		_, err := localManager.GetClass(ctx, nil, testClass().Class)
+		require.Nil(t, err)
+		localSchema, err := localManager.GetSchema(nil)
+		require.Nil(t, err)
+		assert.Len(t, localSchema.Objects.Classes, 1)
+	})
+
+	t.Run("there is no majority", func(t *testing.T) {
+		// one of three nodes is unreachable, the only reachable one is not
+		// enough for a majority
+		state := &fakeClusterState{hosts: []string{hosts[0], "localhost:1"}}
+		localManager := newSchemaManagerWithClusterStateAndClient(state, client)
+
+		localSchema, err := localManager.GetSchema(nil)
+		require.Nil(t, err)
+		assert.Len(t, localSchema.Objects.Classes, 0)
+	})
+}
+
+func serveSchemaManager(t *testing.T, manager *schemauc.Manager) string {
+	schemaHandlers := clusterapi.NewSchema(manager.TxManager(), manager)
+	mux := http.NewServeMux()
+	mux.Handle("/schema/transactions/", http.StripPrefix("/schema/transactions/",
+		schemaHandlers.Transactions()))
+	mux.Handle("/schema/state", schemaHandlers.State())
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	parsedURL, err := url.Parse(server.URL)
+	require.Nil(t, err)
+	return parsedURL.Host
+}
+
 func setupManagers(t *testing.T) (*schemauc.Manager, *schemauc.Manager) {
 	remoteManager := newSchemaManagerWithClusterStateAndClient(
 		&fakeClusterState{hosts: []string{}}, nil)
 
-	schemaHandlers := clusterapi.NewSchema(remoteManager.TxManager())
+	schemaHandlers := clusterapi.NewSchema(remoteManager.TxManager(), remoteManager)
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/", http.StripPrefix("/schema/transactions/",
 		schemaHandlers.Transactions()))
+	mux.Handle("/schema/state", schemaHandlers.State())
 	server := httptest.NewServer(mux)
 
 	client := clients.NewClusterSchema(&http.Client{})
@@ -153,7 +205,7 @@ func testProperty() *models.Property {
 
 // New Local Schema *Manager
 func newSchemaManagerWithClusterStateAndClient(clusterState *fakeClusterState,
-	client cluster.Client) *schemauc.Manager {
+	client schemauc.ClusterClient) *schemauc.Manager {
 	logger, _ := test.NewNullLogger()
 	vectorizerValidator := &fakeVectorizerValidator{
 		valid: []string{"text2vec-contextionary", "model1", "model2"},
@@ -28,18 +28,25 @@ func Serve(appState *state.State) {
 		WithField("action", "cluster_api_startup").
 		Debugf("serving cluster api on port %d", port)
 
-	schema := NewSchema(appState.SchemaManager.TxManager())
+	schema := NewSchema(appState.SchemaManager.TxManager(), appState.SchemaManager)
 	indices := NewIndices(appState.RemoteIncoming)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager())
+	backups := NewBackups(appState.BackupShards)
+	nodes := NewNodes(appState.NodesManager)
 
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/",
 		http.StripPrefix("/schema/transactions/", schema.Transactions()))
+	mux.Handle("/schema/state", schema.State())
 	mux.Handle("/classifications/transactions/",
 		http.StripPrefix("/classifications/transactions/",
 			classifications.Transactions()))
 
 	mux.Handle("/indices/", indices.Indices())
+	mux.Handle("/backups/", backups.Shards())
+	mux.Handle("/nodes/status", nodes.Status())
+	mux.Handle("/nodes/drain", nodes.Drain())
+	mux.Handle("/nodes/config", nodes.Config())
 	mux.Handle("/", schema.index())
 	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
 }
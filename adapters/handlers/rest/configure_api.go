@@ -13,46 +13,68 @@ package rest
 
 import (
 	"context"
-	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"strings"
 	"time"
 
-	_ "net/http/pprof"
-
 	openapierrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/clients"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/diagnostics"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/adapters/repos/classifications"
+	"github.com/semi-technologies/weaviate/adapters/repos/clusterings"
 	"github.com/semi-technologies/weaviate/adapters/repos/db"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	modulestorage "github.com/semi-technologies/weaviate/adapters/repos/modules"
+	"github.com/semi-technologies/weaviate/adapters/repos/querylog"
 	schemarepo "github.com/semi-technologies/weaviate/adapters/repos/schema"
-	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/adapters/repos/seed"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
 	"github.com/semi-technologies/weaviate/entities/search"
+	modbackupfs "github.com/semi-technologies/weaviate/modules/backup-filesystem"
+	moddatavalidator "github.com/semi-technologies/weaviate/modules/data-validator"
+	modgenerativedummy "github.com/semi-technologies/weaviate/modules/generative-dummy"
 	modimage "github.com/semi-technologies/weaviate/modules/img2vec-neural"
+	modimportfs "github.com/semi-technologies/weaviate/modules/import-filesystem"
 	modclip "github.com/semi-technologies/weaviate/modules/multi2vec-clip"
 	modner "github.com/semi-technologies/weaviate/modules/ner-transformers"
 	modqna "github.com/semi-technologies/weaviate/modules/qna-transformers"
+	modreranker "github.com/semi-technologies/weaviate/modules/reranker-transformers"
+	modsum "github.com/semi-technologies/weaviate/modules/sum-transformers"
+	modchunker "github.com/semi-technologies/weaviate/modules/text-chunker"
+	modlangrouter "github.com/semi-technologies/weaviate/modules/text-language-router"
+	modpiimasker "github.com/semi-technologies/weaviate/modules/text-pii-masker"
 	modspellcheck "github.com/semi-technologies/weaviate/modules/text-spellcheck"
+	modtext2sparsedummy "github.com/semi-technologies/weaviate/modules/text2sparse-dummy"
 	modcontextionary "github.com/semi-technologies/weaviate/modules/text2vec-contextionary"
 	modtransformers "github.com/semi-technologies/weaviate/modules/text2vec-transformers"
+	"github.com/semi-technologies/weaviate/usecases/admission"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/backup"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
+	"github.com/semi-technologies/weaviate/usecases/clustering"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/imports"
 	"github.com/semi-technologies/weaviate/usecases/modules"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/semi-technologies/weaviate/usecases/nodes"
 	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
+	"github.com/semi-technologies/weaviate/usecases/runtimeconfig"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/semi-technologies/weaviate/usecases/usage"
 	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus"
 )
@@ -71,6 +93,7 @@ type vectorRepo interface {
 	objects.BatchVectorRepo
 	traverser.VectorSearcher
 	classification.VectorRepo
+	clustering.VectorRepo
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(ctx context.Context) error
 	Shutdown(ctx context.Context) error
@@ -79,19 +102,24 @@ type vectorRepo interface {
 type explorer interface {
 	GetClass(ctx context.Context, params traverser.GetParams) ([]interface{}, error)
 	Concepts(ctx context.Context, params traverser.ExploreParams) ([]search.Result, error)
+	NearParamsVector(ctx context.Context, className string,
+		nearVector *traverser.NearVectorParams, nearObject *traverser.NearObjectParams,
+		moduleParams map[string]interface{}) ([]float32, traverser.SimilarityThreshold, error)
 	SetSchemaGetter(schemaUC.SchemaGetter)
 }
 
 func configureAPI(api *operations.WeaviateAPI) http.Handler {
-	go func() {
-		fmt.Println(http.ListenAndServe(":6060", nil))
-	}()
 	ctx := context.Background()
 	ctx, cancel := context.WithTimeout(ctx, 60*time.Minute)
 	defer cancel()
 
 	appState := startupRoutine(ctx)
 
+	diagnosticsHandler := diagnostics.NewHandler(appState.ServerConfig.Config,
+		appState.LogBuffer, appState.Logger)
+	go diagnostics.Serve(appState.ServerConfig.Config.Diagnostics,
+		diagnosticsHandler, appState.Logger)
+
 	err := registerModules(appState)
 	if err != nil {
 		appState.Logger.
@@ -111,9 +139,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
-	api.OidcAuth = func(token string, scopes []string) (*models.Principal, error) {
-		return appState.OIDC.ValidateAndExtract(token, scopes)
-	}
+	api.OidcAuth = composeTokenAuth(
+		appState.ServerConfig.Config.Authentication, appState.APIKey, appState.OIDC)
 
 	api.Logger = func(msg string, args ...interface{}) {
 		appState.Logger.WithField("action", "restapi_management").Infof(msg, args...)
@@ -128,17 +155,34 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	var schemaRepo schemaUC.Repo
 	// var classifierRepo classification.Repo
 
+	cipher, err := encryptionCipher(ctx, appState)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not set up encryption at rest")
+	}
+
 	// TODO: configure http transport for efficient intra-cluster comm
 	remoteIndexClient := clients.NewRemoteIndex(clusterHttpClient)
 	repo := db.New(appState.Logger, db.Config{
-		RootPath:            appState.ServerConfig.Config.Persistence.DataPath,
-		QueryLimit:          appState.ServerConfig.Config.QueryDefaults.Limit,
-		QueryMaximumResults: appState.ServerConfig.Config.QueryMaximumResults,
+		RootPath:                   appState.ServerConfig.Config.Persistence.DataPath,
+		QueryLimit:                 appState.ServerConfig.Config.QueryDefaults.Limit,
+		QueryMaximumResults:        appState.ServerConfig.Config.QueryMaximumResults,
+		RowCacheMaxSize:            uint64(appState.ServerConfig.Config.Runtime.RowCacheMaxSize),
+		MaxOpenSegments:            appState.ServerConfig.Config.Persistence.MaxOpenSegments,
+		MemtablesMaxMemory:         uint64(appState.ServerConfig.Config.Persistence.MemtablesMaxMemoryBytes),
+		BackgroundIOBytesPerSecond: appState.ServerConfig.Config.Persistence.BackgroundIOBytesPerSecond,
+		HNSWMaxLogSize:             appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
+		CompactionPolicy:           compactionPolicy(appState.ServerConfig.Config.Persistence),
+		SegmentCompression:         appState.ServerConfig.Config.Persistence.SegmentCompression,
+		WriteCoalescingWindow:      time.Duration(appState.ServerConfig.Config.Persistence.WriteCoalescingWindowMs) * time.Millisecond,
+		Encryption:                 cipher,
 	}, remoteIndexClient, appState.Cluster) // TODO client
+	appState.DB = repo
 	vectorMigrator = db.NewMigrator(repo, appState.Logger)
 	vectorRepo = repo
 	migrator = vectorMigrator
-	explorer = traverser.NewExplorer(repo, libvectorizer.NormalizedDistance,
+	explorer = traverser.NewExplorer(repo, libvectorizer.CosineDistance,
 		appState.Logger, appState.Modules)
 	schemaRepo, err = schemarepo.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
@@ -161,9 +205,18 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	// TODO: configure http transport for efficient intra-cluster comm
 	classificationsTxClient := clients.NewClusterClassifications(clusterHttpClient)
 	classifierRepo := classifications.NewDistributeRepo(classificationsTxClient,
-		appState.Cluster, localClassifierRepo)
+		appState.Cluster, localClassifierRepo, appState.Logger)
 	appState.ClassificationRepo = classifierRepo
 
+	clusteringRepo, err := clusterings.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize clusterings repo")
+		os.Exit(1)
+	}
+
 	// TODO: configure http transport for efficient intra-cluster comm
 	schemaTxClient := clients.NewClusterSchema(clusterHttpClient)
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
@@ -179,6 +232,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	appState.SchemaManager = schemaManager
 
 	appState.RemoteIncoming = sharding.NewRemoteIndexIncoming(repo)
+	appState.BackupShards = backup.NewShards(repo, appState.Modules,
+		appState.ServerConfig.Config.Persistence.DataPath, repo.IOThrottle(),
+		appState.Logger)
+	appState.NodesManager = nodes.NewManager(appState.Authorizer,
+		appState.Cluster, repo, clients.NewClusterNodes(clusterHttpClient),
+		schemaManager, serverVersion(), config.GitHash, appState.Logger)
+	appState.NodesManager.SetConfig(appState.ServerConfig.Config,
+		moduleNames(appState.Modules))
+	diagnosticsHandler.SetClusterConfig(appState.NodesManager)
+	diagnosticsHandler.SetCompactions(repo.Compactions())
 
 	go clusterapi.Serve(appState)
 
@@ -199,24 +262,105 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Modules, vectorRepo, appState.Modules)
 	batchKindsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
-		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer)
+		appState.Modules, appState.Locks, schemaManager, appState.ServerConfig,
+		appState.Logger, appState.Authorizer)
 
-	kindsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
+	quotas := objects.NewQuotas(appState.ServerConfig.Config.Quotas, repo,
+		appState.Logger)
+	kindsManager.SetQuotas(quotas)
+	batchKindsManager.SetQuotas(quotas)
+	appState.Quotas = quotas
+
+	shadower := objects.NewShadower(schemaManager, vectorRepo, appState.Modules,
+		clients.NewShadow(&http.Client{}), appState.Logger)
+	kindsManager.SetShadower(shadower)
+	batchKindsManager.SetShadower(shadower)
+	kindsManager.SetRouter(appState.Modules)
+	batchKindsManager.SetRouter(appState.Modules)
+	kindsManager.SetMasker(appState.Modules)
+	batchKindsManager.SetMasker(appState.Modules)
+	kindsManager.SetRuleValidator(appState.Modules)
+	batchKindsManager.SetRuleValidator(appState.Modules)
+
+	kindsTraverser := traverser.NewTraverser(appState.ServerConfig,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager)
 
+	admissionController := admission.New(appState.ServerConfig.Config.QueryAdmission)
+	kindsManager.SetAdmission(admissionController)
+	batchKindsManager.SetAdmission(admissionController)
+	kindsTraverser.SetAdmission(admissionController)
+	kindsTraverser.SetMasker(appState.Modules)
+	appState.Admission = admissionController
+
+	appState.Metrics = monitoring.NewRegistry()
+	appState.Metrics.Register(appState.Modules.Usage())
+	appState.Metrics.Register(appState.Modules.InferenceQueue())
+	appState.Metrics.Register(quotas)
+	appState.Metrics.Register(admissionController)
+	appState.Metrics.Register(repo)
+	diagnosticsHandler.SetMetrics(appState.Metrics)
+
+	runtimeConfig := runtimeconfig.New(appState.Authorizer, appState.Logger,
+		appState.ReadOnly, repo, kindsTraverser,
+		runtimeInferenceQueue(appState.Modules.InferenceQueue()))
+	if err := runtimeConfig.Init(appState.ServerConfig.Config); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid runtime config")
+	}
+	reloadConfigOnSIGHUP(appState, runtimeConfig)
+	diagnosticsHandler.SetRuntimeConfig(runtimeConfig)
+
+	if queryLogConfig := appState.ServerConfig.Config.QueryLog; queryLogConfig.SampleRate > 0 {
+		queryLogRepo, err := querylog.NewRepo(
+			appState.ServerConfig.Config.Persistence.DataPath,
+			queryLogConfig.MaxEntries, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize query log repo")
+			os.Exit(1)
+		}
+		kindsTraverser.SetQueryLog(queryLogRepo, queryLogConfig.SampleRate)
+		diagnosticsHandler.SetQueryLog(queryLogRepo, kindsTraverser)
+	}
+
+	if propertyUsage := propertyusage.New(appState.ServerConfig.Config.PropertyUsage); propertyUsage != nil {
+		kindsTraverser.SetPropertyUsage(propertyUsage)
+		batchKindsManager.SetPropertyUsage(propertyUsage)
+		diagnosticsHandler.SetPropertyUsage(propertyUsage, schemaManager)
+	}
+
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
 		appState.Logger, appState.Modules)
+	clusterer := clustering.New(schemaManager, clusteringRepo, vectorRepo, appState.Authorizer,
+		appState.Logger)
+	importer := imports.New(schemaManager, appState.Modules, batchKindsManager,
+		appState.Authorizer, appState.Logger)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, kindsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
 
+	// TODO: configure http transport for efficient intra-cluster comm
+	backupsClient := clients.NewClusterBackups(clusterHttpClient)
+	backupCoordinator := backup.NewCoordinator(appState.Authorizer, schemaManager,
+		appState.Cluster, appState.Modules, appState.BackupShards, backupsClient,
+		appState.Logger)
+
 	setupSchemaHandlers(api, schemaManager)
 	setupKindHandlers(api, kindsManager, appState.ServerConfig.Config, appState.Logger, appState.Modules)
 	setupKindBatchHandlers(api, batchKindsManager)
-	setupGraphQLHandlers(api, appState)
+	setupGraphQLHandlers(api, appState, repo, appState.Logger)
+	setupGraphQLSandboxHandlers(api, appState, appState.ServerConfig.Config.QuerySandbox)
 	setupMiscHandlers(api, appState.ServerConfig, schemaManager, appState.Modules)
 	setupClassificationHandlers(api, classifier)
+	setupClusteringHandlers(api, clusterer)
+	setupImportHandlers(api, importer)
+	setupRuntimeConfigHandlers(api, runtimeConfig)
+	setupBackupHandlers(api, backupCoordinator)
+	setupNodesHandlers(api, appState.NodesManager)
+	setupUsageHandlers(api, usage.NewManager(appState.Authorizer,
+		appState.Modules.Usage()))
 
 	api.ServerShutdown = func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
@@ -256,6 +400,8 @@ func startupRoutine(ctx context.Context) *state.State {
 
 	logger := logger()
 	appState.Logger = logger
+	appState.LogBuffer = diagnostics.NewLogBuffer(diagnostics.DefaultLogBufferSize)
+	logger.AddHook(appState.LogBuffer)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("created startup context, nothing done so far")
@@ -269,6 +415,10 @@ func startupRoutine(ctx context.Context) *state.State {
 		logger.Exit(1)
 	}
 
+	if connectorOptionGroup.Options.(*config.Flags).ValidateConfig {
+		validateConfigAndExit(appState)
+	}
+
 	logger.WithFields(logrus.Fields{
 		"action":                    "startup",
 		"default_vectorizer_module": serverConfig.Config.DefaultVectorizerModule,
@@ -284,12 +434,24 @@ func startupRoutine(ctx context.Context) *state.State {
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("config loaded")
 
+	if source := serverConfig.Config.Persistence.SeedSnapshotURL; source != "" {
+		_, err := seed.New(serverConfig.Config.Persistence.DataPath, nil, logger).
+			Seed(ctx, source)
+		if err != nil {
+			logger.WithField("action", "startup").WithError(err).
+				Error("could not seed data path from snapshot")
+			logger.Exit(1)
+		}
+	}
+
 	appState.OIDC = configureOIDC(appState)
+	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
-	appState.Authorizer = configureAuthorizer(appState)
+	appState.ReadOnly = authorization.NewReadOnly(configureAuthorizer(appState))
+	appState.Authorizer = appState.ReadOnly
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
-		Debug("configured OIDC and anonymous access client")
+		Debug("configured OIDC, API key and anonymous access client")
 
 	appState.Locks = &dummyLock{}
 
@@ -341,10 +503,39 @@ func (d *dummyLock) LockSchema() (func() error, error) {
 	return func() error { return nil }, nil
 }
 
+// validateConfigAndExit runs the module dependent validation, which needs the
+// modules to be registered, but not initialized, and prints the effective
+// config with all secrets redacted. It never starts the server.
+func validateConfigAndExit(appState *state.State) {
+	logger := appState.Logger.WithField("action", "validate_config")
+
+	if err := registerModules(appState); err != nil {
+		logger.WithError(err).Error("modules didn't load")
+		appState.Logger.Exit(1)
+	}
+
+	if err := appState.ServerConfig.Config.Validate(appState.Modules); err != nil {
+		logger.WithError(err).Error("invalid config")
+		appState.Logger.Exit(1)
+	}
+
+	// the output tends to end up in CI logs, so secrets are never printed
+	if err := appState.ServerConfig.Config.Redacted().WriteYAML(os.Stdout); err != nil {
+		logger.WithError(err).Error("could not print config")
+		appState.Logger.Exit(1)
+	}
+
+	appState.Logger.Exit(0)
+}
+
 // everything hard-coded right now, to be made dynmaic (from go plugins later)
 func registerModules(appState *state.State) error {
 	appState.Modules = modules.NewProvider()
 
+	queueCfg := appState.ServerConfig.Config.InferenceQueue
+	appState.Modules.SetInferenceQueue(modules.NewInferenceQueue(
+		queueCfg.MaxConcurrency, queueCfg.InteractiveWeight, queueCfg.ImportWeight))
+
 	enabledModules := map[string]bool{}
 	if len(appState.ServerConfig.Config.EnableModules) > 0 {
 		modules := strings.Split(appState.ServerConfig.Config.EnableModules, ",")
@@ -373,6 +564,14 @@ func registerModules(appState *state.State) error {
 		appState.Modules.Register(modner.New())
 	}
 
+	if _, ok := enabledModules["reranker-transformers"]; ok {
+		appState.Modules.Register(modreranker.New())
+	}
+
+	if _, ok := enabledModules["sum-transformers"]; ok {
+		appState.Modules.Register(modsum.New())
+	}
+
 	if _, ok := enabledModules["text-spellcheck"]; ok {
 		appState.Modules.Register(modspellcheck.New())
 	}
@@ -381,9 +580,62 @@ func registerModules(appState *state.State) error {
 		appState.Modules.Register(modclip.New())
 	}
 
+	if _, ok := enabledModules["text-chunker"]; ok {
+		appState.Modules.Register(modchunker.New())
+	}
+
+	if _, ok := enabledModules["text-language-router"]; ok {
+		appState.Modules.Register(modlangrouter.New())
+	}
+
+	if _, ok := enabledModules["text-pii-masker"]; ok {
+		appState.Modules.Register(modpiimasker.New())
+	}
+
+	if _, ok := enabledModules["data-validator"]; ok {
+		appState.Modules.Register(moddatavalidator.New())
+	}
+
+	if _, ok := enabledModules["backup-filesystem"]; ok {
+		appState.Modules.Register(modbackupfs.New())
+	}
+
+	if _, ok := enabledModules["generative-dummy"]; ok {
+		appState.Modules.Register(modgenerativedummy.New())
+	}
+
+	if _, ok := enabledModules["import-filesystem"]; ok {
+		appState.Modules.Register(modimportfs.New())
+	}
+
+	if _, ok := enabledModules["text2sparse-dummy"]; ok {
+		appState.Modules.Register(modtext2sparsedummy.New())
+	}
+
 	return nil
 }
 
+// moduleNames lists the registered modules, so the nodes of a cluster can
+// compare them
+// compactionPolicy leaves the unset parts of the policy to the defaults of
+// lsmkv.NewCompactions
+func compactionPolicy(p config.Persistence) lsmkv.CompactionPolicy {
+	return lsmkv.CompactionPolicy{
+		Interval:    time.Duration(p.CompactionIntervalSeconds) * time.Second,
+		MinSegments: p.CompactionMinSegments,
+		MaxSegments: p.CompactionMaxSegments,
+	}
+}
+
+func moduleNames(provider *modules.Provider) []string {
+	all := provider.GetAll()
+	out := make([]string, len(all))
+	for i, mod := range all {
+		out[i] = mod.Name()
+	}
+	return out
+}
+
 func initModules(ctx context.Context, appState *state.State) error {
 	storageProvider, err := modulestorage.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
@@ -403,6 +655,40 @@ func initModules(ctx context.Context, appState *state.State) error {
 	return nil
 }
 
+// encryptionCipher returns the cipher for the data at rest, the key is either
+// configured directly or provided by a module. It returns nil if encryption
+// is not enabled.
+func encryptionCipher(ctx context.Context,
+	appState *state.State) (*encryption.Cipher, error) {
+	persistence := appState.ServerConfig.Config.Persistence
+
+	var key []byte
+	switch {
+	case persistence.EncryptionKey != "":
+		decoded, err := persistence.DecodedEncryptionKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "encryption key")
+		}
+		key = decoded
+	case persistence.EncryptionKeyProvider != "":
+		provider, err := appState.Modules.EncryptionKeyProvider(
+			persistence.EncryptionKeyProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err = provider.EncryptionKey(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get encryption key from module %q",
+				persistence.EncryptionKeyProvider)
+		}
+	default:
+		return nil, nil
+	}
+
+	return encryption.New(key)
+}
+
 func reasonableHttpClient() *http.Client {
 	t := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// bundle collects profiles, the config and the recent logs into a single
+// tar.gz archive
+type bundle struct {
+	config        config.Config
+	runtimeConfig runtimeConfigProvider
+	logs          *LogBuffer
+	cpuProfile    time.Duration
+}
+
+type bundleFile struct {
+	name  string
+	write func(w io.Writer) error
+}
+
+func (b *bundle) write(ctx context.Context, w io.Writer, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	for _, file := range b.files(ctx) {
+		// every file is buffered, as the size is part of the tar header
+		buf := &bytes.Buffer{}
+		if err := file.write(buf); err != nil {
+			return errors.Wrapf(err, "collect %s", file.name)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(dir, file.name),
+			Mode:    0o644,
+			Size:    int64(buf.Len()),
+			ModTime: now,
+		}); err != nil {
+			return errors.Wrapf(err, "write header of %s", file.name)
+		}
+
+		if _, err := io.Copy(tw, buf); err != nil {
+			return errors.Wrapf(err, "write %s", file.name)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, "close tar")
+	}
+
+	return gz.Close()
+}
+
+func (b *bundle) files(ctx context.Context) []bundleFile {
+	files := []bundleFile{
+		{"info.json", b.writeInfo},
+		{"config.yaml", b.config.WriteYAML},
+	}
+
+	if b.runtimeConfig != nil {
+		files = append(files, bundleFile{"runtime_config.json", b.writeRuntimeConfig})
+	}
+
+	if b.logs != nil {
+		files = append(files, bundleFile{"logs.txt", func(w io.Writer) error {
+			_, err := b.logs.WriteTo(w)
+			return err
+		}})
+	}
+
+	files = append(files,
+		bundleFile{"goroutines.txt", writeProfile("goroutine", 2)},
+		bundleFile{"heap.pprof", func(w io.Writer) error {
+			runtime.GC()
+			return pprof.Lookup("heap").WriteTo(w, 0)
+		}},
+		bundleFile{"allocs.pprof", writeProfile("allocs", 0)},
+		bundleFile{"block.pprof", writeProfile("block", 0)},
+		bundleFile{"mutex.pprof", writeProfile("mutex", 0)},
+		bundleFile{"threadcreate.pprof", writeProfile("threadcreate", 0)},
+	)
+
+	if b.cpuProfile > 0 {
+		files = append(files, bundleFile{"cpu.pprof", func(w io.Writer) error {
+			return writeCPUProfile(ctx, w, b.cpuProfile)
+		}})
+	}
+
+	return files
+}
+
+func writeProfile(name string, debug int) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		return pprof.Lookup(name).WriteTo(w, debug)
+	}
+}
+
+// writeCPUProfile profiles for the given duration or until the context is
+// cancelled
+func writeCPUProfile(ctx context.Context, w io.Writer, duration time.Duration) error {
+	if err := pprof.StartCPUProfile(w); err != nil {
+		// most likely another CPU profile is already running
+		return err
+	}
+
+	select {
+	case <-time.After(duration):
+	case <-ctx.Done():
+	}
+
+	pprof.StopCPUProfile()
+	return nil
+}
+
+func (b *bundle) writeInfo(w io.Writer) error {
+	hostname, _ := os.Hostname()
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	info := map[string]interface{}{
+		"capturedAt":   time.Now().UTC().Format(time.RFC3339),
+		"hostname":     hostname,
+		"goVersion":    runtime.Version(),
+		"goos":         runtime.GOOS,
+		"goarch":       runtime.GOARCH,
+		"numCPU":       runtime.NumCPU(),
+		"gomaxprocs":   runtime.GOMAXPROCS(0),
+		"numGoroutine": runtime.NumGoroutine(),
+		"memStats":     mem,
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(info)
+}
+
+func (b *bundle) writeRuntimeConfig(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(b.runtimeConfig.Current())
+}
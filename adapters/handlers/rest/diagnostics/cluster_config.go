@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/usecases/nodes"
+)
+
+type clusterConfigs interface {
+	ClusterConfig(ctx context.Context) (*nodes.ConfigReport, error)
+}
+
+// SetClusterConfig serves the comparison of the configs of all nodes. It is
+// set once the nodes manager is available.
+func (h *Handler) SetClusterConfig(configs clusterConfigs) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.clusterConfigs = configs
+}
+
+// clusterConfig reports the settings which differ between the nodes of the
+// cluster, e.g. modules which are only enabled on some of them
+func (h *Handler) clusterConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.Lock()
+	configs := h.clusterConfigs
+	h.Unlock()
+	if configs == nil {
+		http.Error(w, "the cluster is not initialized yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	report, err := configs.ClusterConfig(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
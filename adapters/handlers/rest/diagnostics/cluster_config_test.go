@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/nodes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerClusterConfig(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewHandler(config.Defaults(), nil, logger)
+
+	request := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/debug/cluster/config", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("before the cluster is initialized", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable, request().Code)
+	})
+
+	handler.SetClusterConfig(&fakeClusterConfigs{report: &nodes.ConfigReport{
+		Nodes: []*nodes.NodeConfig{{Name: "node1"}, {Name: "node2"}},
+		Divergences: []nodes.ConfigDivergence{{
+			Setting: "enable_modules",
+			Values: map[string]interface{}{
+				"node1": "text2vec-openai",
+				"node2": "",
+			},
+		}},
+	}})
+
+	t.Run("reporting the divergences", func(t *testing.T) {
+		res := request()
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var report nodes.ConfigReport
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&report))
+		assert.False(t, report.Consistent)
+		require.Len(t, report.Divergences, 1)
+		assert.Equal(t, "enable_modules", report.Divergences[0].Setting)
+	})
+}
+
+type fakeClusterConfigs struct {
+	report *nodes.ConfigReport
+}
+
+func (f *fakeClusterConfigs) ClusterConfig(ctx context.Context) (*nodes.ConfigReport, error) {
+	return f.report, nil
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+)
+
+type compactionControl interface {
+	Pause()
+	Resume()
+	Stats() lsmkv.CompactionStats
+}
+
+// SetCompactions allows pausing and resuming the lsmkv compactions of all
+// shards of this node, e.g. to take load off the disks during a bulk import.
+// It is set once the database is available.
+func (h *Handler) SetCompactions(compactions compactionControl) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.compactions = compactions
+}
+
+// compactionStats reports whether compactions are paused and how many have
+// run since startup
+func (h *Handler) compactionStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.withCompactions(w, func(compactions compactionControl) {})
+}
+
+// pauseCompactions responds once all running compactions have completed
+func (h *Handler) pauseCompactions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.withCompactions(w, func(compactions compactionControl) {
+		compactions.Pause()
+		h.logger.WithField("action", "diagnostics_compactions").
+			Info("paused lsmkv compactions")
+	})
+}
+
+func (h *Handler) resumeCompactions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.withCompactions(w, func(compactions compactionControl) {
+		compactions.Resume()
+		h.logger.WithField("action", "diagnostics_compactions").
+			Info("resumed lsmkv compactions")
+	})
+}
+
+// withCompactions responds with the stats after calling fn, or with an error
+// if the database is not available yet
+func (h *Handler) withCompactions(w http.ResponseWriter,
+	fn func(compactions compactionControl)) {
+	h.Lock()
+	compactions := h.compactions
+	h.Unlock()
+	if compactions == nil {
+		http.Error(w, "the database is not initialized yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	fn(compactions)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(compactions.Stats())
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerCompactions(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewHandler(config.Defaults(), nil, logger)
+
+	request := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	stats := func(t *testing.T, res *httptest.ResponseRecorder) lsmkv.CompactionStats {
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var stats lsmkv.CompactionStats
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&stats))
+		return stats
+	}
+
+	t.Run("before the database is initialized", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable,
+			request(http.MethodGet, "/debug/compaction").Code)
+	})
+
+	handler.SetCompactions(lsmkv.NewCompactions(lsmkv.CompactionPolicy{}))
+
+	t.Run("pausing and resuming", func(t *testing.T) {
+		assert.False(t, stats(t, request(http.MethodGet, "/debug/compaction")).Paused)
+
+		assert.True(t, stats(t, request(http.MethodPost, "/debug/compaction/pause")).Paused)
+		assert.True(t, stats(t, request(http.MethodGet, "/debug/compaction")).Paused)
+
+		assert.False(t, stats(t, request(http.MethodPost, "/debug/compaction/resume")).Paused)
+	})
+
+	t.Run("pausing requires a post", func(t *testing.T) {
+		assert.Equal(t, http.StatusMethodNotAllowed,
+			request(http.MethodGet, "/debug/compaction/pause").Code)
+	})
+}
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package diagnostics serves pprof profiles, goroutine and heap dumps,
+// diagnostics bundles which collect everything needed for a support case in
+// a single archive, the export and replay of the query log, the report of
+// the properties which are never filtered on, the comparison of the configs
+// of all nodes and pausing the lsmkv compactions. It is served on a separate
+// address, see config.Diagnostics.
+package diagnostics
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
+	"github.com/sirupsen/logrus"
+)
+
+// maxCPUProfileSeconds limits how long a bundle request can block
+const maxCPUProfileSeconds = 60
+
+const defaultCPUProfileSeconds = 10
+
+type runtimeConfigProvider interface {
+	Current() *models.RuntimeConfig
+}
+
+type Handler struct {
+	sync.Mutex
+	config        config.Config
+	runtimeConfig runtimeConfigProvider
+	logs          *LogBuffer
+	logger        logrus.FieldLogger
+	mux           *http.ServeMux
+
+	// queryLog and replayer are nil if the query log is disabled
+	queryLog queryLog
+	replayer queryReplayer
+
+	// propertyUsage is nil if the property usage tracking is disabled
+	propertyUsage *propertyusage.Tracker
+	schemaGetter  schemaGetter
+
+	// clusterConfigs is nil until the nodes manager is available
+	clusterConfigs clusterConfigs
+
+	// compactions is nil until the database is available
+	compactions compactionControl
+
+	// metrics is nil until all components which report metrics are available
+	metrics http.Handler
+}
+
+// NewHandler serves all diagnostics endpoints. The config is included in
+// bundles with secrets redacted, logs are optional.
+func NewHandler(cfg config.Config, logs *LogBuffer,
+	logger logrus.FieldLogger) *Handler {
+	h := &Handler{
+		config: cfg,
+		logs:   logs,
+		logger: logger,
+		mux:    http.NewServeMux(),
+	}
+
+	// the pprof handlers are registered explicitly, so they are never exposed
+	// through http.DefaultServeMux
+	h.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	h.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	h.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	h.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	h.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	h.mux.HandleFunc("/debug/dump/goroutines", h.dumpGoroutines)
+	h.mux.HandleFunc("/debug/dump/heap", h.dumpHeap)
+	h.mux.HandleFunc("/debug/bundle", h.bundle)
+	h.mux.HandleFunc("/debug/querylog", h.queryLogEntries)
+	h.mux.HandleFunc("/debug/querylog/replay", h.replayQueryLog)
+	h.mux.HandleFunc("/debug/properties/unused", h.unusedProperties)
+	h.mux.HandleFunc("/debug/cluster/config", h.clusterConfig)
+	h.mux.HandleFunc("/debug/compaction", h.compactionStats)
+	h.mux.HandleFunc("/debug/compaction/pause", h.pauseCompactions)
+	h.mux.HandleFunc("/debug/compaction/resume", h.resumeCompactions)
+	h.mux.HandleFunc("/metrics", h.serveMetrics)
+
+	return h
+}
+
+// SetRuntimeConfig includes the runtime settings in effect in bundles. It is
+// set once they are available, as diagnostics are served from early on in
+// the startup, so that a slow startup can be profiled as well.
+func (h *Handler) SetRuntimeConfig(runtimeConfig runtimeConfigProvider) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.runtimeConfig = runtimeConfig
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "missing or invalid diagnostics token", http.StatusUnauthorized)
+		return
+	}
+
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) authorized(r *http.Request) bool {
+	token := h.config.Diagnostics.Token
+	if token == "" {
+		return true
+	}
+
+	given := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+// dumpGoroutines writes the stack traces of all goroutines in the same
+// format as an unrecovered panic
+func (h *Handler) dumpGoroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err := runtimepprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+		h.logger.WithField("action", "diagnostics_dump").WithError(err).
+			Error("could not dump goroutines")
+	}
+}
+
+// dumpHeap runs a garbage collection, so that the profile is up to date, and
+// writes the heap profile
+func (h *Handler) dumpHeap(w http.ResponseWriter, r *http.Request) {
+	runtime.GC()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="heap.pprof"`)
+	if err := runtimepprof.Lookup("heap").WriteTo(w, 0); err != nil {
+		h.logger.WithField("action", "diagnostics_dump").WithError(err).
+			Error("could not dump heap")
+	}
+}
+
+func (h *Handler) bundle(w http.ResponseWriter, r *http.Request) {
+	cpuSeconds := defaultCPUProfileSeconds
+	if v := r.URL.Query().Get("seconds"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 0 || parsed > maxCPUProfileSeconds {
+			http.Error(w, fmt.Sprintf("seconds must be an integer between 0 and %d",
+				maxCPUProfileSeconds), http.StatusBadRequest)
+			return
+		}
+		cpuSeconds = parsed
+	}
+
+	name := fmt.Sprintf("weaviate-diagnostics-%s",
+		time.Now().UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="%s.tar.gz"`, name))
+
+	h.Lock()
+	runtimeConfig := h.runtimeConfig
+	h.Unlock()
+
+	b := &bundle{
+		config:        h.config.Redacted(),
+		runtimeConfig: runtimeConfig,
+		logs:          h.logs,
+		cpuProfile:    time.Duration(cpuSeconds) * time.Second,
+	}
+	if err := b.write(r.Context(), w, name); err != nil {
+		// the headers are already sent, all we can do is log the error
+		h.logger.WithField("action", "diagnostics_bundle").WithError(err).
+			Error("could not write diagnostics bundle")
+	}
+}
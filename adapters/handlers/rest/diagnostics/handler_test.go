@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	logs := NewLogBuffer(10)
+	logger.AddHook(logs)
+	logger.Info("a recent log entry")
+
+	cfg := config.Defaults()
+	cfg.Diagnostics.Token = "secret-token"
+	cfg.Persistence.EncryptionKey = "secret-key"
+	cfg.Authentication.APIKey = config.APIKey{
+		Enabled:     true,
+		AllowedKeys: []string{"secret-api-key-1", "secret-api-key-2"},
+		Users:       []string{"jane"},
+	}
+	handler := NewHandler(cfg, logs, logger)
+	handler.SetRuntimeConfig(&fakeRuntimeConfig{})
+
+	request := func(target, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("without a token", func(t *testing.T) {
+		res := request("/debug/dump/goroutines", "")
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("with an invalid token", func(t *testing.T) {
+		res := request("/debug/dump/goroutines", "wrong-token")
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("dumping goroutines", func(t *testing.T) {
+		res := request("/debug/dump/goroutines", "secret-token")
+		require.Equal(t, http.StatusOK, res.Code)
+		assert.Contains(t, res.Body.String(), "goroutine ")
+	})
+
+	t.Run("pprof index", func(t *testing.T) {
+		res := request("/debug/pprof/", "secret-token")
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("bundle with an invalid cpu profile duration", func(t *testing.T) {
+		res := request("/debug/bundle?seconds=3600", "secret-token")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("capturing a bundle", func(t *testing.T) {
+		res := request("/debug/bundle?seconds=0", "secret-token")
+		require.Equal(t, http.StatusOK, res.Code)
+
+		files := untar(t, res.Body)
+		for _, name := range []string{
+			"info.json", "config.yaml", "runtime_config.json", "logs.txt",
+			"goroutines.txt", "heap.pprof", "allocs.pprof",
+		} {
+			assert.Contains(t, files, name)
+		}
+		assert.NotContains(t, files, "cpu.pprof")
+
+		assert.Contains(t, files["logs.txt"], "a recent log entry")
+		assert.Contains(t, files["config.yaml"], "<redacted>")
+		for name, content := range files {
+			assert.NotContains(t, content, "secret-token", name)
+			assert.NotContains(t, content, "secret-key", name)
+			assert.NotContains(t, content, "secret-api-key", name)
+		}
+	})
+}
+
+func untar(t *testing.T, r io.Reader) map[string]string {
+	gz, err := gzip.NewReader(r)
+	require.Nil(t, err)
+
+	files := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+
+		contents, err := ioutil.ReadAll(tr)
+		require.Nil(t, err)
+		files[path.Base(hdr.Name)] = string(contents)
+	}
+
+	return files
+}
+
+type fakeRuntimeConfig struct{}
+
+func (f *fakeRuntimeConfig) Current() *models.RuntimeConfig {
+	return &models.RuntimeConfig{LogLevel: "info"}
+}
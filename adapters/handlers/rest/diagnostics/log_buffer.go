@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultLogBufferSize is the number of log entries kept for diagnostics
+// bundles
+const DefaultLogBufferSize = 2000
+
+// LogBuffer is a logrus hook which keeps the most recent log entries in
+// memory, so they can be included in a diagnostics bundle. Only entries
+// which pass the level of the logger the hook is added to are kept.
+type LogBuffer struct {
+	sync.Mutex
+	entries []string
+	next    int
+	full    bool
+}
+
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{entries: make([]string, size)}
+}
+
+// Levels makes the hook fire for every level
+func (b *LogBuffer) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire stores the formatted entry, evicting the oldest entry if the buffer
+// is full
+func (b *LogBuffer) Fire(entry *logrus.Entry) error {
+	line, err := entry.String()
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.entries[b.next] = line
+	b.next++
+	if b.next == len(b.entries) {
+		b.next = 0
+		b.full = true
+	}
+
+	return nil
+}
+
+// WriteTo writes all buffered entries from oldest to newest
+func (b *LogBuffer) WriteTo(w io.Writer) (int64, error) {
+	b.Lock()
+	var lines []string
+	if b.full {
+		lines = append(lines, b.entries[b.next:]...)
+	}
+	lines = append(lines, b.entries[:b.next]...)
+	b.Unlock()
+
+	var written int64
+	for _, line := range lines {
+		n, err := io.WriteString(w, line)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
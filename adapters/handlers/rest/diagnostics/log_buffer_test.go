@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogBuffer(t *testing.T) {
+	buffer := NewLogBuffer(3)
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.AddHook(buffer)
+
+	logged := func(t *testing.T) []string {
+		buf := &bytes.Buffer{}
+		_, err := buffer.WriteTo(buf)
+		require.Nil(t, err)
+		return strings.Split(strings.TrimSpace(buf.String()), "\n")
+	}
+
+	t.Run("before the buffer is full", func(t *testing.T) {
+		logger.Info("entry 0")
+		logger.Info("entry 1")
+
+		lines := logged(t)
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], "entry 0")
+		assert.Contains(t, lines[1], "entry 1")
+	})
+
+	t.Run("the oldest entries are evicted", func(t *testing.T) {
+		for i := 2; i < 5; i++ {
+			logger.Info(fmt.Sprintf("entry %d", i))
+		}
+
+		lines := logged(t)
+		require.Len(t, lines, 3)
+		assert.Contains(t, lines[0], "entry 2")
+		assert.Contains(t, lines[1], "entry 3")
+		assert.Contains(t, lines[2], "entry 4")
+	})
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"net/http"
+)
+
+// SetMetrics serves the prometheus metrics of this node on /metrics. They
+// are served by the diagnostics server instead of the public API, as they
+// contain per-class usage which must only be visible to operators. It is set
+// once all components which report metrics are available.
+func (h *Handler) SetMetrics(metrics http.Handler) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.metrics = metrics
+}
+
+func (h *Handler) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	h.Lock()
+	metrics := h.metrics
+	h.Unlock()
+
+	if metrics == nil {
+		http.Error(w, "metrics are not available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	metrics.ServeHTTP(w, r)
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerMetrics(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	cfg := config.Defaults()
+	cfg.Diagnostics.Token = "secret-token"
+	handler := NewHandler(cfg, nil, logger)
+
+	request := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("before the metrics are available", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable, request("secret-token").Code)
+	})
+
+	handler.SetMetrics(monitoring.NewRegistry())
+
+	t.Run("without a token", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request("").Code)
+	})
+
+	t.Run("with the token", func(t *testing.T) {
+		res := request("secret-token")
+		require.Equal(t, http.StatusOK, res.Code)
+		assert.Contains(t, res.Header().Get("Content-Type"), "text/plain")
+	})
+}
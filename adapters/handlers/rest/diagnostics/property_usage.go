@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
+)
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// SetPropertyUsage serves the report of the indexed properties which are
+// never filtered on. It is set once the schema is available and only if the
+// property usage tracking is enabled.
+func (h *Handler) SetPropertyUsage(tracker *propertyusage.Tracker,
+	schemaGetter schemaGetter) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.propertyUsage = tracker
+	h.schemaGetter = schemaGetter
+}
+
+// unusedProperties reports the indexed properties which no sampled query
+// filtered on within the window, which defaults to the configured one and
+// can be set as a duration, e.g. ?window=72h
+func (h *Handler) unusedProperties(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.Lock()
+	tracker, schemaGetter := h.propertyUsage, h.schemaGetter
+	h.Unlock()
+	if tracker == nil {
+		http.Error(w, "the property usage tracking is disabled", http.StatusNotFound)
+		return
+	}
+
+	var window time.Duration
+	if v := r.URL.Query().Get("window"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "window must be a positive duration, e.g. 72h",
+				http.StatusBadRequest)
+			return
+		}
+		window = parsed
+	}
+
+	report := tracker.Report(schemaGetter.GetSchemaSkipAuth().Objects, window)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerPropertyUsage(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewHandler(config.Defaults(), nil, logger)
+
+	request := func(method, target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("while the tracking is disabled", func(t *testing.T) {
+		res := request(http.MethodGet, "/debug/properties/unused")
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	tracker := propertyusage.New(config.PropertyUsage{SampleRate: 1, WindowHours: 24})
+	tracker.Record(propertyusage.Query{Class: "Article", Returned: []string{"title"}})
+	handler.SetPropertyUsage(tracker, &fakeSchemaGetter{schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+			},
+		}}},
+	}})
+
+	t.Run("reporting the unused properties", func(t *testing.T) {
+		res := request(http.MethodGet, "/debug/properties/unused?window=1h")
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var report propertyusage.Report
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&report))
+		assert.Equal(t, int64(3600), report.WindowSeconds)
+		assert.False(t, report.Complete)
+		require.Len(t, report.Unused, 1)
+		assert.Equal(t, "title", report.Unused[0].Property)
+		assert.True(t, report.Unused[0].ReturnedInWindow)
+	})
+
+	t.Run("with an invalid window", func(t *testing.T) {
+		res := request(http.MethodGet, "/debug/properties/unused?window=-1h")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("with an invalid method", func(t *testing.T) {
+		res := request(http.MethodPost, "/debug/properties/unused")
+		assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+	})
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// maxReplayConcurrency limits how many queries a replay runs at the same time
+const maxReplayConcurrency = 64
+
+type queryLog interface {
+	Iterate(fn func(entry traverser.QueryLogEntry) error) error
+	Clear() error
+}
+
+type queryReplayer interface {
+	Replay(ctx context.Context, className string,
+		entries []traverser.QueryLogEntry, concurrency int) (*traverser.ReplayReport, error)
+}
+
+// SetQueryLog serves the recorded queries and replays them. It is set once
+// the traverser is available and only if the query log is enabled.
+func (h *Handler) SetQueryLog(log queryLog, replayer queryReplayer) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.queryLog = log
+	h.replayer = replayer
+}
+
+func (h *Handler) getQueryLog() (queryLog, queryReplayer) {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.queryLog, h.replayer
+}
+
+// queryLogEntries exports the recorded queries as newline-delimited JSON on
+// GET and removes them on DELETE
+func (h *Handler) queryLogEntries(w http.ResponseWriter, r *http.Request) {
+	log, _ := h.getQueryLog()
+	if log == nil {
+		http.Error(w, "the query log is disabled", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		enc := json.NewEncoder(w)
+		err := log.Iterate(func(entry traverser.QueryLogEntry) error {
+			return enc.Encode(entry)
+		})
+		if err != nil {
+			// the headers are already sent, all we can do is log the error
+			h.logger.WithField("action", "diagnostics_query_log").WithError(err).
+				Error("could not export query log")
+		}
+	case http.MethodDelete:
+		if err := log.Clear(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.Header().Set("Allow", "GET, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// replayQueryLog replays the workload sent as newline-delimited JSON, as
+// exported from the query log, or the recorded queries if the body is
+// empty. It responds once the replay is done.
+func (h *Handler) replayQueryLog(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	log, replayer := h.getQueryLog()
+	if replayer == nil {
+		http.Error(w, "the query log is disabled", http.StatusNotFound)
+		return
+	}
+
+	concurrency := 1
+	if v := r.URL.Query().Get("concurrency"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 || parsed > maxReplayConcurrency {
+			http.Error(w, fmt.Sprintf("concurrency must be an integer between 1 and %d",
+				maxReplayConcurrency), http.StatusBadRequest)
+			return
+		}
+		concurrency = parsed
+	}
+
+	entries, err := readQueryLogEntries(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(entries) == 0 {
+		err := log.Iterate(func(entry traverser.QueryLogEntry) error {
+			entries = append(entries, entry)
+			return nil
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	report, err := replayer.Replay(r.Context(), r.URL.Query().Get("class"),
+		entries, concurrency)
+	if err != nil {
+		http.Error(w, err.Error(), errortypes.HTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+func readQueryLogEntries(body io.Reader) ([]traverser.QueryLogEntry, error) {
+	var entries []traverser.QueryLogEntry
+	scanner := bufio.NewScanner(body)
+	// a single entry holds a whole vector, which exceeds the default limit
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var entry traverser.QueryLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
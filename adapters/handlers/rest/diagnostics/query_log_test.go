@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerQueryLog(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewHandler(config.Defaults(), nil, logger)
+
+	request := func(method, target string, body io.Reader) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, body)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("while the query log is disabled", func(t *testing.T) {
+		res := request(http.MethodGet, "/debug/querylog", nil)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	log := &fakeQueryLog{entries: []traverser.QueryLogEntry{
+		{ClassName: "Foo", Vector: []float32{1, 2}, Limit: 10},
+		{ClassName: "Foo", Vector: []float32{3, 4}, Limit: 10},
+	}}
+	replayer := &fakeReplayer{}
+	handler.SetQueryLog(log, replayer)
+
+	t.Run("exporting the query log", func(t *testing.T) {
+		res := request(http.MethodGet, "/debug/querylog", nil)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		entries, err := readQueryLogEntries(res.Body)
+		require.Nil(t, err)
+		assert.Equal(t, log.entries, entries)
+	})
+
+	t.Run("replaying the recorded queries", func(t *testing.T) {
+		res := request(http.MethodPost, "/debug/querylog/replay?class=Bar&concurrency=4", nil)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var report traverser.ReplayReport
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&report))
+		assert.Equal(t, 2, report.Queries)
+		assert.Equal(t, "Bar", replayer.className)
+		assert.Equal(t, 4, replayer.concurrency)
+	})
+
+	t.Run("replaying an exported workload", func(t *testing.T) {
+		body := `{"className":"Foo","vector":[5,6],"limit":3}` + "\n"
+		res := request(http.MethodPost, "/debug/querylog/replay", strings.NewReader(body))
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Len(t, replayer.entries, 1)
+		assert.Equal(t, []float32{5, 6}, replayer.entries[0].Vector)
+		assert.Equal(t, 1, replayer.concurrency)
+	})
+
+	t.Run("replaying an invalid workload", func(t *testing.T) {
+		res := request(http.MethodPost, "/debug/querylog/replay", strings.NewReader("{"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("replaying with an invalid concurrency", func(t *testing.T) {
+		res := request(http.MethodPost, "/debug/querylog/replay?concurrency=1000", nil)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("clearing the query log", func(t *testing.T) {
+		res := request(http.MethodDelete, "/debug/querylog", nil)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+		assert.Len(t, log.entries, 0)
+	})
+}
+
+type fakeQueryLog struct {
+	entries []traverser.QueryLogEntry
+}
+
+func (f *fakeQueryLog) Iterate(fn func(entry traverser.QueryLogEntry) error) error {
+	for _, entry := range f.entries {
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeQueryLog) Clear() error {
+	f.entries = nil
+	return nil
+}
+
+type fakeReplayer struct {
+	className   string
+	entries     []traverser.QueryLogEntry
+	concurrency int
+}
+
+func (f *fakeReplayer) Replay(ctx context.Context, className string,
+	entries []traverser.QueryLogEntry, concurrency int) (*traverser.ReplayReport, error) {
+	f.className = className
+	f.entries = entries
+	f.concurrency = concurrency
+	return &traverser.ReplayReport{Queries: len(entries)}, nil
+}
@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package diagnostics
+
+import (
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+)
+
+// Serve blocks while serving the diagnostics endpoints on the configured
+// address. It returns immediately if diagnostics are disabled.
+func Serve(cfg config.Diagnostics, handler http.Handler, logger logrus.FieldLogger) {
+	if !cfg.Enabled {
+		return
+	}
+
+	logger.WithField("action", "diagnostics_startup").
+		WithField("address", cfg.BindAddress).
+		WithField("token_required", cfg.Token != "").
+		Debugf("serving diagnostics on %s", cfg.BindAddress)
+
+	if err := http.ListenAndServe(cfg.BindAddress, handler); err != nil {
+		logger.WithField("action", "diagnostics_startup").WithError(err).
+			Error("could not serve diagnostics")
+	}
+}
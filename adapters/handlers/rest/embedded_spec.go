@@ -133,56 +133,32 @@ func init() {
         }
       }
     },
-    "/batch/objects": {
+    "/backups/{backend}": {
       "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
-        "tags": [
-          "batch",
-          "objects"
-        ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "description": "Snapshots the schema and the shard files of the classes and writes them to the backend. The backup runs in the background, poll its status to find out when it has completed.",
+        "operationId": "backups.create",
         "parameters": [
           {
-            "name": "body",
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
+            "required": true,
+            "type": "string"
+          },
+          {
             "in": "body",
+            "name": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
+              "$ref": "#/definitions/BackupCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Backup successfully started.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -195,7 +171,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -207,44 +183,42 @@ func init() {
             }
           }
         },
+        "summary": "Starts a backup of a set of classes.",
+        "tags": [
+          "backups"
+        ],
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
-        "tags": [
-          "batch",
-          "references"
-        ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns whether the backup is still running, has completed successfully or has failed.",
+        "operationId": "backups.create.status",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
             "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
+            "type": "string"
+          },
+          {
+            "description": "The ID of the backup",
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "string"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Backup status successfully returned.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -256,8 +230,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup status request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -269,43 +249,42 @@ func init() {
             }
           }
         },
+        "summary": "Returns the status of a backup.",
+        "tags": [
+          "backups"
+        ],
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/classifications/": {
-      "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
-        "tags": [
-          "classifications"
-        ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns whether the restore is still running, has completed successfully or has failed.",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string"
+          },
+          {
+            "description": "The ID of the backup",
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "string"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+          "200": {
+            "description": "Restore status successfully returned.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -317,6 +296,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Restore does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid restore status request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -324,33 +315,48 @@ func init() {
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.classifications.post"
-        ]
-      }
-    },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "summary": "Returns the status of a restore.",
         "tags": [
-          "classifications"
+          "backups"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Restores the schema and the shard files of the classes from the backend. None of the classes may exist. The restore runs in the background, poll its status to find out when it has completed.",
+        "operationId": "backups.restore",
         "parameters": [
           {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "description": "The backend the backup is stored in, e.g. filesystem",
             "in": "path",
-            "required": true
+            "name": "backend",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "description": "The ID of the backup",
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Restore successfully started.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -363,7 +369,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid restore request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -372,35 +387,71 @@ func init() {
             }
           }
         },
+        "summary": "Starts restoring classes from a backup.",
+        "tags": [
+          "backups"
+        ],
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/graphql": {
+    "/batch/objects": {
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
         "tags": [
-          "graphql"
+          "batch",
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "type": "object",
+              "properties": {
+                "atomicPerShard": {
+                  "description": "If true, every shard imports its part of the batch all-or-nothing. The sub-batch is journaled before it is applied, so that a crash can never leave only some of its objects imported. Defaults to false.",
+                  "type": "boolean"
+                },
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
             }
           },
           "401": {
@@ -428,37 +479,32 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.add"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
         "tags": [
-          "graphql"
+          "batch",
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
             "in": "body",
+            "name": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
+              "$ref": "#/definitions/BatchDelete"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -486,26 +532,41 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
         "tags": [
-          "meta"
+          "batch",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
           "401": {
@@ -517,6 +578,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -527,38 +594,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.add"
         ]
       }
     },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -572,9 +639,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -582,34 +646,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.classifications.post"
         ]
-      },
-      "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -621,11 +684,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -634,34 +694,42 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.classifications.get"
         ]
       }
     },
-    "/objects/validate": {
+    "/clusterings/": {
       "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "description": "Trigger a clustering of the vectors of a class based on the specified params. Clusterings will run in the background, use GET /clusterings/\u003cid\u003e to retrieve the status and the centroids of your clustering. The cluster of each object is stored in an int property of the class, which can be used to filter or group objects by cluster.",
         "tags": [
-          "objects"
+          "clusterings"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Starts a clustering.",
+        "operationId": "clusterings.post",
         "parameters": [
           {
-            "name": "body",
+            "description": "parameters to start a clustering",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Clustering"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "201": {
+            "description": "Successfully started clustering.",
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -672,12 +740,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -685,45 +747,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.clusterings.post"
         ]
       }
     },
-    "/objects/{id}": {
+    "/clusterings/{id}": {
       "get": {
-        "description": "Lists Objects.",
+        "description": "Get status, centroids and metadata of a previously created clustering",
         "tags": [
-          "objects"
+          "clusterings"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
+        "summary": "View previously created clustering",
+        "operationId": "clusterings.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "clustering id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Found the clustering, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Clustering"
             }
           },
           "401": {
@@ -736,7 +786,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Clustering does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -745,42 +795,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.clusterings.get"
         ]
-      },
+      }
+    },
+    "/config/runtime": {
       "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Changes the settings which can be updated without a restart, such as the log level or read-only mode. The request replaces all runtime settings, the settings in effect afterwards are returned.",
         "tags": [
-          "objects"
+          "meta"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
+        "summary": "Updates the runtime config.",
+        "operationId": "runtime.config.update",
         "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/RuntimeConfig"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "The runtime config was applied.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/RuntimeConfig"
             }
           },
           "401": {
@@ -792,11 +834,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. See the ErrorResponse for the invalid setting.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -811,29 +850,35 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -844,8 +889,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -854,43 +902,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -901,11 +947,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -920,46 +963,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/graphql/sandbox": {
+      "post": {
+        "description": "Resolves a GraphQL query in a sandbox meant for ad-hoc exploration. The query runs with hard caps on its duration, the memory it allocates and the size of its result, which are configured independently of the limits of regular queries. A query which hits a cap is not failed, instead the results which were complete at that point are returned along with the caps which were hit.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
+        "summary": "Run a GraphQL query with resource caps.",
+        "operationId": "graphql.sandbox",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Successful query, the results may be partial if a cap was hit.",
+            "schema": {
+              "$ref": "#/definitions/GraphQLSandboxResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -971,7 +1006,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -986,44 +1021,41 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      },
+      }
+    },
+    "/imports/": {
       "post": {
-        "description": "Add a single reference to a class-property.",
+        "description": "Trigger an import of the records of an external source into a class based on the specified params. The records are read by a source connector module and added through the batch API. Imports will run in the background, use GET /imports/\u003cid\u003e to retrieve the status of your import.",
         "tags": [
-          "objects"
+          "imports"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
+        "summary": "Starts an import.",
+        "operationId": "imports.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start an import",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Import"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully added the reference."
+          "201": {
+            "description": "Successfully started import.",
+            "schema": {
+              "$ref": "#/definitions/Import"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1034,12 +1066,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1047,47 +1073,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.imports.post"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/imports/{id}": {
+      "get": {
+        "description": "Get status and metadata of an import previously started on this node",
         "tags": [
-          "objects"
+          "imports"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
+        "summary": "View previously created import",
+        "operationId": "imports.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "import id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Found the import, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Import"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1099,10 +1112,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Not Found - Import does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1111,25 +1121,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.imports.get"
         ]
       }
     },
-    "/schema": {
+    "/meta": {
       "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "schema"
+          "meta"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -1148,31 +1157,26 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.query.meta"
         ]
-      },
-      "post": {
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns the status of every node of the cluster, including the shards it hosts and the number of objects they contain. Nodes which cannot be reached are reported as unavailable.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
+          "nodes"
         ],
+        "summary": "Returns the status of the nodes of the cluster.",
+        "operationId": "nodes.get",
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -1184,12 +1188,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Object class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1197,31 +1195,35 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/schema/{className}": {
-      "get": {
+    "/nodes/{name}/drain": {
+      "post": {
+        "description": "Moves every shard replica held by the node to the remaining nodes of the cluster, copies their data and then makes the node leave the cluster, so it can be shut down without losing data.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Drains a node and removes it from the cluster.",
+        "operationId": "nodes.drain",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The name of the node to drain.",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "The node was drained and has left the cluster.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/NodeDrainResponse"
             }
           },
           "401": {
@@ -1234,7 +1236,13 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "The node is not part of the cluster."
+          },
+          "422": {
+            "description": "The node cannot be drained, for example because no other node can take over its shards.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1243,38 +1251,73 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "description": "The class to list the objects of. If set the objects are ordered by id, use after to continue after the last object of the previous page.",
+            "name": "class",
+            "in": "query"
           },
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "description": "A cursor to iterate over all objects of a class. The results are ordered by id and start right after the object with this id. Requires class to be set and can not be combined with offset.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Open or continue a scroll, which iterates over a snapshot of all objects of a class. Objects written while the scroll is open are neither skipped nor returned twice. The value is the duration to keep the scroll open until the next page is requested, e.g. 1m, at most 1h. A new scroll requires class to be set and can not be combined with offset or after.",
+            "name": "scroll",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The scrollId returned with the previous page of a scroll. Requires scroll to be set.",
+            "name": "scrollId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Keywords to rank the objects of a class by their BM25 relevance in all indexed text and string properties, without involving any vector. Requires class to be set and can not be combined with after or scroll.",
+            "name": "bm25",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1287,13 +1330,62 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1305,32 +1397,45 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/objects/aggregates": {
+      "get": {
+        "description": "Reads the current groups of a continuous aggregate of a class, see the continuousAggregates of the invertedIndexConfig. The aggregate is updated on every write, so reading it does not scan any Objects.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Read the current groups of a continuous aggregate.",
+        "operationId": "objects.aggregates",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
+            "description": "The class of the aggregate.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the aggregate.",
+            "name": "name",
+            "in": "query",
             "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ContinuousAggregateResponse"
             }
           },
           "401": {
@@ -1342,6 +1447,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class or the aggregate does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1349,39 +1463,108 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query"
         ]
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
+    "/objects/duplicates": {
+      "get": {
+        "description": "Finds groups of Objects of a class whose vectors are near-identical, e.g. to clean up a dataset after importing it twice. Every Object is compared to its nearest neighbors in the vector index, neighbors which are closer than the given distance are linked and linked Objects form a group. All Objects of the class are compared, so this can take a while on large classes.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Find groups of near-duplicate Objects.",
+        "operationId": "objects.duplicates",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
+            "description": "The class to search for duplicates.",
+            "name": "class",
+            "in": "query",
             "required": true
           },
+          {
+            "type": "number",
+            "format": "float",
+            "default": 0.05,
+            "description": "The maximum cosine distance between two Objects to consider them duplicates. Defaults to 0.05.",
+            "name": "distance",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximum number of groups to return, the largest groups are returned first. Defaults to 100.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/DuplicatesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
+    "/objects/estimate": {
+      "post": {
+        "description": "Dry runs a where filter on a class and returns an upper bound of the number of matching Objects together with the indexes which serve each clause of the filter, and optionally also the exact number of matches. No Objects are read or changed, so e.g. the filter of a retention policy or of a batch delete can be validated before it is run.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Estimate the number of Objects matching a filter.",
+        "operationId": "objects.estimate",
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/FilterEstimateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/FilterEstimateResponse"
             }
           },
           "401": {
@@ -1393,8 +1576,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class does not exist."
+          },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1406,1328 +1592,5731 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query"
         ]
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
-      }
     },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+    "/objects/facets": {
+      "post": {
+        "description": "Returns the first Objects of a class matching the filter together with the number of matching Objects for the most common values of each faceted property. The filter is only resolved once for both, so the Objects and the facets are always consistent with one another, and a faceted search UI does not need to run the same filter as a Get and an Aggregate query.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Search Objects and count the values of their properties.",
+        "operationId": "objects.facets",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FacetedSearchRequest"
             }
           }
-        }
-      ]
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
-          "type": "string"
-        },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/FacetedSearchResponse"
+            }
           },
-          "word": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "404": {
+            "description": "The class does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
             }
           }
-        },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
-          }
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
-      "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
-        },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "example": [
-            "inCountry"
-          ]
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
-        },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
-        },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "type": {
-          "description": "which algorythim to use for classifications",
-          "type": "string"
-        }
-      }
-    },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
-      "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
-        },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
-        },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "Deprecation": {
-      "type": "object",
-      "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
           }
-        },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
-        },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
-        },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
-        },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
-        }
-      }
-    },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
             }
           }
-        },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
-          "x-omitempty": true
-        }
-      }
-    },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
-      "type": "object",
-      "properties": {
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
-        }
-      }
-    },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
-      "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
-        },
-        "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
-        },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
-        }
-      }
-    },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "Object": {
-      "type": "object",
-      "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
-      }
-    },
-    "ObjectsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
-        },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
+    "/schema/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
             }
           }
-        }
-      ]
-    },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
-      "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
+        ],
+        "responses": {
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "objects": {
-          "description": "The actual list of Objects.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Object"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+    "/schema/{className}/shards/{shardName}/vector-index/cleanup": {
+      "post": {
+        "description": "Removes the tombstones of deleted vectors from the vector index of a shard right away instead of waiting for the periodic cleanup configured with cleanupIntervalSeconds. The shard has to be located on the node receiving the request.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Clean up the vector index of a shard",
+        "operationId": "schema.shards.vectorIndex.cleanup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The vector index of the shard was cleaned up.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexCleanupResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or shard does not exist."
+          },
+          "422": {
+            "description": "The shard is not located on this node or its vector index can not be cleaned up.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
+    "/schema/{className}/status": {
+      "get": {
+        "description": "Runtime information about a class which is not part of the schema, such as the state of the most recent vector index advisor run on the node receiving the request.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the runtime status of a class",
+        "operationId": "schema.objects.status",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The runtime status of the class.",
+            "schema": {
+              "$ref": "#/definitions/ClassStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
-        }
-      }
-    },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "PhoneNumber": {
-      "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
-        },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
-        },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
-        },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
-        },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
-      }
-    },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/status/vector-index-advice": {
+      "post": {
+        "description": "Explores a grid of hnsw parameters on a sample of the vectors in the local shards of the class in the background. Use GET /schema/{className}/status to retrieve the report. Only one run per class can be active at a time. The recommendation is never applied automatically.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Start a vector index advisor run",
+        "operationId": "schema.objects.status.vectorIndexAdvice",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "parameters of the advisor run, send an empty object to use the defaults",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VectorIndexAdviceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The advisor run was started.",
+            "schema": {
+              "$ref": "#/definitions/ClassStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist."
+          },
+          "409": {
+            "description": "An advisor run is already active for this class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid advisor parameters or the class has no hnsw vector index.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/tenants": {
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "delete tenants from a specific class",
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuratino specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get all tenants of a class",
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "put": {
+        "description": "Update the activity status of the specified tenants of a class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a tenant.",
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size euqals k",
-          "type": "number",
-          "format": "int64"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/usage": {
+      "get": {
+        "description": "Returns the number of calls, errors and tokens of every module operation on this node, grouped by class. Calls which do not belong to a class, such as vectorizing a query, have an empty class.",
+        "tags": [
+          "usage"
+        ],
+        "summary": "Returns the module usage of this node.",
+        "operationId": "usage.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/UsageResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes.",
+      "properties": {
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and unique within the backend.",
+          "type": "string"
         },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "include": {
+          "description": "List of classes to include in the backup. If empty, all classes are included.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The status of a backup.",
+      "properties": {
+        "backend": {
+          "description": "The backend the backup is stored in.",
+          "type": "string"
         },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
+        "classes": {
+          "description": "The classes which are included in the backup.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
         },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
+        "error": {
+          "description": "The reason the backup failed, if any.",
+          "type": "string"
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "id": {
+          "description": "The ID of the backup.",
+          "type": "string"
+        },
+        "path": {
+          "description": "Where the backup is stored, as reported by the backend.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The phase of the backup.",
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ],
+          "type": "string"
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup.",
+      "properties": {
+        "include": {
+          "description": "List of classes to restore from the backup. If empty, all classes of the backup are restored.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The status of the restore of a backup.",
+      "properties": {
+        "backend": {
+          "description": "The backend the backup is restored from.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The classes which are restored.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "error": {
+          "description": "The reason the restore failed, if any.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup.",
+          "type": "string"
+        },
+        "path": {
+          "description": "Where the backup is stored, as reported by the backend.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The phase of the restore.",
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ],
+          "type": "string"
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals BATCH_DELETE_MAXIMUM_OBJECTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  },
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shadowConfig": {
+          "$ref": "#/definitions/ShadowConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "templateConfig": {
+          "$ref": "#/definitions/TemplateConfig"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        }
+      }
+    },
+    "ClassStatus": {
+      "description": "Runtime information about a class which is not part of the schema, such as the outcome of background jobs. It is purely informative, nothing in here is ever applied automatically.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "name of the class",
+          "type": "string"
+        },
+        "vectorIndexAdvice": {
+          "$ref": "#/definitions/VectorIndexAdvice",
+          "description": "the most recent run of the vector index advisor on this node"
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorythim to use for classifications",
+          "type": "string"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Clustering": {
+      "description": "Group the objects of a class into k clusters of similar vectors, trigger clusterings and view status and centroids of past clusterings.",
+      "type": "object",
+      "properties": {
+        "centroids": {
+          "description": "the centers of the clusters, ordered by cluster number. Only set once the clustering has completed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusteringCentroid"
+          }
+        },
+        "class": {
+          "description": "class (name) whose objects are clustered",
+          "type": "string",
+          "example": "Article"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "cluster xzy: something went wrong"
+        },
+        "id": {
+          "description": "ID to uniquely identify this clustering run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "indexProperty": {
+          "description": "Whether the property holding the cluster should be indexed in the inverted index, so objects can be filtered by cluster. Only used if the property does not exist yet. Defaults to true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "k": {
+          "description": "number of clusters",
+          "type": "integer",
+          "example": 8
+        },
+        "maxIterations": {
+          "description": "maximum number of iterations to refine the clusters. Defaults to 100.",
+          "type": "integer",
+          "example": 100
+        },
+        "meta": {
+          "description": "additional meta information about the clustering",
+          "type": "object",
+          "$ref": "#/definitions/ClusteringMeta"
+        },
+        "property": {
+          "description": "Name of the int property which holds the cluster number of each object. It is added to the class if it does not exist yet. Defaults to 'cluster'.",
+          "type": "string",
+          "example": "cluster"
+        },
+        "status": {
+          "description": "status of this clustering",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        }
+      }
+    },
+    "ClusteringCentroid": {
+      "description": "The center of a single cluster",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "number of the cluster, as stored in the property of its objects",
+          "type": "integer",
+          "example": 0,
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "number of objects in this cluster",
+          "type": "integer",
+          "example": 147
+        },
+        "vector": {
+          "description": "the mean vector of all objects in this cluster",
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "ClusteringMeta": {
+      "description": "Additional information to a specific clustering",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this clustering finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were assigned to a cluster",
+          "type": "integer",
+          "example": 147
+        },
+        "iterations": {
+          "description": "number of iterations until the clusters converged or maxIterations was reached",
+          "type": "integer",
+          "example": 12
+        },
+        "started": {
+          "description": "time when this clustering was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "ContinuousAggregate": {
+      "description": "The count and the sums of properties of the Objects of a class per value of a property. It is updated on every write, so it can be read without scanning the Objects.",
+      "type": "object",
+      "properties": {
+        "groupBy": {
+          "description": "The property whose values the Objects are grouped by. Without it, all Objects are counted in a single group.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name by which the aggregate is read.",
+          "type": "string"
+        },
+        "sum": {
+          "description": "The number or int properties which are summed up per group.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ContinuousAggregateGroup": {
+      "description": "The count and the sums of the Objects with one value of the grouped by property.",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "The number of Objects in the group.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sums": {
+          "description": "The sum of each summed up property over the Objects in the group.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "value": {
+          "description": "The value of the grouped by property, empty if the aggregate is not grouped.",
+          "type": "string"
+        }
+      }
+    },
+    "ContinuousAggregateResponse": {
+      "description": "The current groups of a continuous aggregate.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the aggregate.",
+          "type": "string"
+        },
+        "groups": {
+          "description": "The groups of the aggregate, ordered by their value.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ContinuousAggregateGroup"
+          }
+        },
+        "name": {
+          "description": "The name of the aggregate.",
+          "type": "string"
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
+        }
+      }
+    },
+    "DuplicateGroup": {
+      "description": "A group of Objects whose vectors are near-identical.",
+      "type": "object",
+      "properties": {
+        "ids": {
+          "description": "The ids of the Objects in the group, ordered by id.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "maxDistance": {
+          "description": "The largest distance of two Objects which were linked into this group.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "DuplicatesResponse": {
+      "description": "Groups of near-duplicate Objects of a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which was searched for duplicates.",
+          "type": "string"
+        },
+        "distance": {
+          "description": "The maximum distance which was used to link two Objects.",
+          "type": "number",
+          "format": "float"
+        },
+        "groups": {
+          "description": "The groups of near-duplicate Objects, largest groups first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DuplicateGroup"
+          }
+        },
+        "totalObjects": {
+          "description": "The number of Objects which were compared.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              },
+              "module": {
+                "description": "The module whose rule the object violates, only set for data quality rule violations.",
+                "type": "string"
+              },
+              "property": {
+                "description": "The property which violates a rule, only set for data quality rule violations.",
+                "type": "string"
+              },
+              "rule": {
+                "description": "The name of the violated rule, only set for data quality rule violations.",
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Facet": {
+      "description": "The most common values of a property among the Objects matching a faceted search.",
+      "type": "object",
+      "properties": {
+        "property": {
+          "description": "The name of the faceted property.",
+          "type": "string"
+        },
+        "values": {
+          "description": "The most common values of the property, most common first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FacetValue"
+          }
+        }
+      }
+    },
+    "FacetValue": {
+      "description": "A value of a faceted property and the number of matching Objects which have it.",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "The number of matching Objects with this value.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "The value of the property."
+        }
+      }
+    },
+    "FacetedSearchRequest": {
+      "description": "A search which returns the first Objects matching a filter together with the number of matching Objects for the most common values of the faceted properties.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class to search.",
+          "type": "string"
+        },
+        "facetLimit": {
+          "description": "The maximum number of values per faceted property. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "facets": {
+          "description": "The properties to count the values of. Only text, string, int, number and boolean properties and their arrays can be faceted.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "limit": {
+          "description": "The maximum number of Objects to return. Defaults to the default query limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "description": "The tenant to search, required for classes with multi-tenancy.",
+          "type": "string"
+        },
+        "where": {
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "FacetedSearchResponse": {
+      "description": "The result of a faceted search. The Objects and the facets are based on the same matches of the filter.",
+      "type": "object",
+      "properties": {
+        "facets": {
+          "description": "One facet per faceted property, in the order they were requested.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Facet"
+          }
+        },
+        "objects": {
+          "description": "The first Objects matching the filter.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
+        },
+        "totalResults": {
+          "description": "The number of Objects matching the filter.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "FilterEstimateRequest": {
+      "description": "A dry run of a where filter on a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class to run the filter on.",
+          "type": "string"
+        },
+        "exact": {
+          "description": "Additionally count the matching Objects exactly. This resolves the filter just like a search would, so it is more expensive than the estimate.",
+          "type": "boolean"
+        },
+        "tenant": {
+          "description": "The tenant to run the filter on, required for classes with multi-tenancy.",
+          "type": "string"
+        },
+        "where": {
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "FilterEstimateResponse": {
+      "description": "The result of a dry run of a where filter.",
+      "type": "object",
+      "properties": {
+        "estimatedCount": {
+          "description": "An upper bound of the number of matching Objects. Every clause of the filter is counted on its own, the counts of an And operator are combined by their minimum and the counts of an Or operator by their sum.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "exactCount": {
+          "description": "The exact number of matching Objects, only set if it was requested.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "indexes": {
+          "description": "The indexes which serve the clauses of the filter.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FilterIndexUsage"
+          }
+        }
+      }
+    },
+    "FilterIndexUsage": {
+      "description": "The index which serves a clause of a where filter.",
+      "type": "object",
+      "properties": {
+        "index": {
+          "description": "The kind of index, one of inverted, composite, shared, count, nullState or geo.",
+          "type": "string"
+        },
+        "operator": {
+          "description": "The operator of the clause.",
+          "type": "string"
+        },
+        "properties": {
+          "description": "The properties the index covers, more than one for a composite index.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "extensions": {
+          "description": "Machine-readable details about the error. Contains the kind of the error in 'code', one of NOT_FOUND, CONFLICT, VALIDATION, OVERLOADED, or INTERNAL.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "consistentSnapshot": {
+          "description": "Read all classes the query refers to as of the same point in time. Writes which happen while the query runs are not visible to it, so e.g. a Get and an Aggregate of the same class agree with each other.",
+          "type": "boolean"
+        },
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "GraphQLSandboxResponse": {
+      "description": "GraphQL based response of a query in the sandbox.",
+      "properties": {
+        "capsHit": {
+          "description": "The caps the query hit, its results are partial if any are listed.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "time",
+              "memory",
+              "resultSize"
+            ]
+          }
+        },
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "Import": {
+      "description": "Import the records of an external source into a class through a source connector module, trigger imports and view the status of past imports.",
+      "type": "object",
+      "properties": {
+        "batchSize": {
+          "description": "number of objects which are imported at once. Defaults to 100.",
+          "type": "integer",
+          "example": 100
+        },
+        "class": {
+          "description": "class (name) the records are imported into",
+          "type": "string",
+          "example": "Article"
+        },
+        "connector": {
+          "description": "name of the source connector module which reads the records, e.g. import-filesystem",
+          "type": "string",
+          "example": "import-filesystem"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "read source: file articles.csv does not exist"
+        },
+        "id": {
+          "description": "ID to uniquely identify this import",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "idField": {
+          "description": "Field of a record which holds the UUID of its object. If not set, every object is assigned a random UUID.",
+          "type": "string",
+          "example": "uuid"
+        },
+        "mapping": {
+          "description": "Maps the name of each property of the class to the field of a record which holds its value. Fields of nested records can be addressed with dots. If not set, every field is mapped to the property of the same name.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "example": {
+            "author": "meta.author",
+            "title": "headline"
+          }
+        },
+        "meta": {
+          "description": "additional meta information about the import",
+          "type": "object",
+          "$ref": "#/definitions/ImportMeta"
+        },
+        "source": {
+          "description": "settings which describe the source to read from, they depend on the connector",
+          "type": "object",
+          "example": {
+            "path": "articles.csv"
+          }
+        },
+        "status": {
+          "description": "status of this import",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        }
+      }
+    },
+    "ImportMeta": {
+      "description": "Additional information to a specific import",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this import finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "errors": {
+          "description": "errors of the first records which could not be imported",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "number of records which could not be imported",
+          "type": "integer",
+          "example": 3
+        },
+        "imported": {
+          "description": "number of objects which were imported successfully",
+          "type": "integer",
+          "example": 147
+        },
+        "read": {
+          "description": "number of records read from the source",
+          "type": "integer",
+          "example": 150
+        },
+        "started": {
+          "description": "time when this import was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
+      "properties": {
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
+        },
+        "compositeIndexes": {
+          "description": "Property combinations which are frequently filtered together with the Equal operator. Each is indexed with a single composite key, so such filters can be served by a single lookup",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "continuousAggregates": {
+          "description": "Counts and sums per group which are updated on every write, so they can be read without an Aggregate scan of all Objects",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ContinuousAggregate"
+          }
+        },
+        "indexNullState": {
+          "description": "Index the null state of each property, which is required to filter with the IsNull operator",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index the creation and last update time of each object, which is required to filter and sort by _creationTimeUnix and _lastUpdateTimeUnix",
+          "type": "boolean"
+        },
+        "strictTypes": {
+          "description": "Reject writes with property values which do not match the data type of their property or which set a property that is not part of the schema, instead of coercing or skipping them",
+          "type": "boolean"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
+      }
+    },
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class. Every tenant is stored in a shard of its own and every request to the class has to specify a tenant. Cannot be changed after the class was created.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NodeDrainResponse": {
+      "description": "The result of draining a node.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the drained node.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The shards which were moved to the remaining nodes of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDrainShard"
+          }
+        }
+      }
+    },
+    "NodeDrainShard": {
+      "description": "A shard which was moved off a drained node.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the class the shard belongs to.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects which were copied to the target node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "target": {
+          "description": "The node which now holds the replica of the shard.",
+          "type": "string"
+        }
+      }
+    },
+    "NodeShardStatus": {
+      "description": "The status of a shard hosted on a node.",
+      "type": "object",
+      "properties": {
+        "atomicBatchError": {
+          "description": "The reason the pending atomic batch of the shard could not be applied in full yet, empty unless atomicBatchPending is set.",
+          "type": "string"
+        },
+        "atomicBatchPending": {
+          "description": "Whether an atomic batch was committed to the shard, but has not been applied in full yet. It is rolled forward before the next atomic batch of the shard is imported.",
+          "type": "boolean"
+        },
+        "class": {
+          "description": "The name of the class the shard belongs to.",
+          "type": "string"
+        },
+        "lsmStoreBytes": {
+          "description": "The size in bytes of the disk segments of the shard.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in the shard.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stalledWrites": {
+          "description": "The number of writes to the shard which currently wait for a memtable to be flushed to disk.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexCommitLogBytes": {
+          "description": "The size in bytes of the commit logs of the vector index of the shard.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexTombstones": {
+          "description": "The number of deleted vectors which are still part of the vector index of the shard, because the tombstone cleanup has not removed them yet.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "walBytes": {
+          "description": "The size in bytes of the write-ahead logs of the shard, which hold the writes that have not been flushed to disk segments yet.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "writeStalled": {
+          "description": "Whether writes to the shard currently wait for a memtable to be flushed to disk, because the shard receives writes faster than it can flush them.",
+          "type": "boolean"
+        }
+      }
+    },
+    "NodeStats": {
+      "description": "The summary of the shards hosted on a node.",
+      "type": "object",
+      "properties": {
+        "lsmStoreBytes": {
+          "description": "The size in bytes of the disk segments across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectCount": {
+          "description": "The number of objects across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardCount": {
+          "description": "The number of shards hosted on the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexCommitLogBytes": {
+          "description": "The size in bytes of the vector index commit logs across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "walBytes": {
+          "description": "The size in bytes of the write-ahead logs across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "NodeStatus": {
+      "description": "The status of a node of the cluster.",
+      "type": "object",
+      "properties": {
+        "gitHash": {
+          "description": "The git commit the node was built from.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The shards hosted on the node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Whether the node could be reached and reported its status.",
+          "type": "string",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
+          ]
+        },
+        "version": {
+          "description": "The version of Weaviate the node is running.",
+          "type": "string"
+        }
+      }
+    },
+    "NodesStatusResponse": {
+      "description": "The status of all nodes of the cluster.",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
+        },
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "multiVector": {
+          "description": "Token-level vectors of the Object, which are searched by late interaction. Only indexed if multiVector is enabled in the vectorIndexConfig of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yVector"
+          },
+          "x-omitempty": true
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "sparseVector": {
+          "description": "Sparse term weights of the Object, which are searched by their dot product with a sparse query. Only indexed if sparse is enabled in the vectorIndexConfig of the class.",
+          "$ref": "#/definitions/SparseVector"
+        },
+        "tenant": {
+          "description": "Name of the tenant the object belongs to. Required for classes with multi-tenancy enabled, must not be set otherwise.",
+          "type": "string"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        }
+      }
+    },
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
+        },
+        "scrollId": {
+          "description": "The id to pass as scrollId to get the next page of a scroll. Empty once all objects have been returned.",
+          "type": "string"
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
+    },
+    "Property": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "invertedIndexStorage": {
+          "description": "Optional. Determines how the inverted index of a boolean property or a string property with \"field\" tokenization is stored. \"dedicated\" stores it in buckets of its own, \"shared\" stores it in buckets shared with all other properties of the class using this option, which reduces the number of open files for classes with many low-cardinality properties. Defaults to \"dedicated\"",
+          "type": "string",
+          "enum": [
+            "dedicated",
+            "shared"
+          ]
+        },
+        "moduleConfig": {
+          "description": "Configuratino specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Optional. Determines how the value of a string or text property is split into tokens for the inverted index. \"word\" splits on any non-alphanumerical character and lowercases, \"lowercase\" splits on whitespace and lowercases, \"whitespace\" splits on whitespace only and \"field\" indexes the entire trimmed value as a single token. Defaults to \"word\" for text and \"whitespace\" for string properties",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size euqals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how many copies of each shard are kept in the cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of copies of each shard, including the primary. Defaults to 1, which means no replication. Cannot be larger than the number of nodes in the cluster.",
+          "type": "integer"
+        }
+      }
+    },
+    "RuntimeConfig": {
+      "description": "The settings which can be changed without restarting Weaviate.",
+      "properties": {
+        "logLevel": {
+          "description": "One of \"panic\", \"fatal\", \"error\", \"warning\", \"info\", \"debug\", \"trace\". If empty, the current log level is kept.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "slowQueryThresholdMs": {
+          "description": "Queries taking longer than this many milliseconds are logged. 0 disables slow query logging.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "rowCacheMaxSize": {
+          "description": "Size of the inverted index row cache of each shard in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "readOnly": {
+          "description": "If true, every request which would alter data or the schema is rejected.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "inferenceMaxConcurrency": {
+          "description": "Maximum number of concurrent module calls. Must be greater than 0 if the inference queue was enabled on startup and 0 otherwise, enabling or disabling the queue requires a restart.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        },
+        "templates": {
+          "description": "Templates other classes can be derived from, see TemplateConfig",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ShadowConfig": {
+      "description": "Mirror the traffic of a class to another class or Weaviate instance, e.g. to test a new vectorizer or index configuration against production traffic before switching over",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class the traffic is mirrored to. If endpoint is set, this is the class on the remote instance and defaults to the name of the shadowed class. Otherwise, it has to be another class of this instance.",
+          "type": "string"
+        },
+        "endpoint": {
+          "description": "Base URL of a remote Weaviate instance the writes are mirrored to, e.g. http://shadow:8080. If not set, the traffic is mirrored to a class of this instance.",
+          "type": "string"
+        },
+        "readSampleRate": {
+          "description": "Share of the Get queries on the class which are also run against the shadow class, between 0 and 1. Their results are discarded. Reads can only be mirrored to a class of this instance.",
+          "type": "number"
+        }
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the refernced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "SparseVector": {
+      "description": "Term weights of a learned sparse representation, keyed by the term.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "TemplateConfig": {
+      "description": "Derive classes from a template, so that many similarly shaped classes, e.g. one per customer or language, share their properties, module config and index settings",
+      "type": "object",
+      "properties": {
+        "isTemplate": {
+          "description": "The class is a template for other classes. Templates are part of the schema, but have no index and cannot hold any objects.",
+          "type": "boolean"
+        },
+        "propagateUpdates": {
+          "description": "Properties which are added to the template later on are added to this class as well",
+          "type": "boolean"
+        },
+        "template": {
+          "description": "Name of the template the class is derived from. Properties, module config and index settings which are not set on the class itself are taken from the template when the class is created.",
+          "type": "string"
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Only the shards of HOT tenants are loaded and can be queried. Defaults to HOT.",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "COLD"
+          ]
+        },
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "UsageRecord": {
+      "description": "The usage of one operation of a module on behalf of a class.",
+      "type": "object",
+      "properties": {
+        "calls": {
+          "description": "number of calls since the node was started",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "name of the class, empty if the call did not belong to a class",
+          "type": "string"
+        },
+        "errors": {
+          "description": "number of calls which failed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "module": {
+          "description": "name of the module",
+          "type": "string"
+        },
+        "operation": {
+          "description": "operation of the module, such as vectorize or vectorizeQuery",
+          "type": "string"
+        },
+        "tokens": {
+          "description": "number of tokens reported by the module, 0 if the module does not report them",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "UsageResponse": {
+      "description": "The module usage of this node.",
+      "type": "object",
+      "properties": {
+        "modules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UsageRecord"
+          }
+        }
+      }
+    },
+    "VectorIndexAdvice": {
+      "description": "The state of a run of the vector index advisor, which explores a grid of hnsw parameters on a sample of the vectors of a class. Once completed, the report contains the Pareto-optimal settings and a recommendation which can be applied through a regular class update.",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this run finished",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "error message if status == FAILED",
+          "type": "string"
+        },
+        "report": {
+          "description": "the measured recall and latency of every explored setting, the Pareto-optimal ones and the recommended one. Only set once the run has completed.",
+          "type": "object"
+        },
+        "started": {
+          "description": "time when this run was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "status of this run",
+          "type": "string",
+          "enum": [
+            "RUNNING",
+            "COMPLETED",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "VectorIndexAdviceRequest": {
+      "description": "Parameters of a vector index advisor run. Every parameter is optional.",
+      "type": "object",
+      "properties": {
+        "k": {
+          "description": "number of nearest neighbors the recall is measured for. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sampleSize": {
+          "description": "number of vectors sampled from the local shards of the class. Defaults to 2000.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "targetRecall": {
+          "description": "recall the recommended setting has to reach. Defaults to 0.95.",
+          "type": "number",
+          "format": "float64"
+        }
+      }
+    },
+    "VectorIndexCleanupResponse": {
+      "description": "The number of tombstones, i.e. deleted vectors which are still part of the graph, in the vector index of a shard before and after a cleanup",
+      "type": "object",
+      "properties": {
+        "tombstonesAfter": {
+          "description": "Number of tombstones left after the cleanup. These belong to vectors which were deleted while the cleanup was running.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tombstonesBefore": {
+          "description": "Number of tombstones when the cleanup started",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "Not",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueString": {
+          "description": "value as string",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueText": {
+          "description": "value as text (on text props)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Default value is 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    },
+    {
+      "description": "These operations report the status of the nodes of the cluster.",
+      "name": "nodes"
+    },
+    {
+      "description": "These operations report the module usage of this node.",
+      "name": "usage"
+    },
+    {
+      "description": "These operations allow to back up classes to a storage backend and to restore them.",
+      "name": "backups"
+    },
+    {
+      "description": "These operations allow to group the objects of a class into clusters of similar vectors.",
+      "name": "clusterings"
+    },
+    {
+      "description": "These operations allow to import the records of external sources into a class through source connector modules.",
+      "name": "imports"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/semi-technologies/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Cloud-native, modular vector search engine",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/semi-technologies",
+      "email": "hello@semi.technology"
+    },
+    "version": "1.9.1-rc.0"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/backups/{backend}": {
+      "post": {
+        "description": "Snapshots the schema and the shard files of the classes and writes them to the backend. The backup runs in the background, poll its status to find out when it has completed.",
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "summary": "Starts a backup of a set of classes.",
+        "tags": [
+          "backups"
+        ],
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns whether the backup is still running, has completed successfully or has failed.",
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "description": "The ID of the backup",
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup status successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup status request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "summary": "Returns the status of a backup.",
+        "tags": [
+          "backups"
+        ],
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns whether the restore is still running, has completed successfully or has failed.",
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "description": "The ID of the backup",
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Restore status successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Restore does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid restore status request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "summary": "Returns the status of a restore.",
+        "tags": [
+          "backups"
+        ],
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Restores the schema and the shard files of the classes from the backend. None of the classes may exist. The restore runs in the background, poll its status to find out when it has completed.",
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "description": "The backend the backup is stored in, e.g. filesystem",
+            "in": "path",
+            "name": "backend",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "description": "The ID of the backup",
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Restore successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid restore request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "summary": "Starts restoring classes from a backup.",
+        "tags": [
+          "backups"
+        ],
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/batch/objects": {
+      "post": {
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "atomicPerShard": {
+                  "description": "If true, every shard imports its part of the batch all-or-nothing. The sub-batch is journaled before it is applied, so that a crash can never leave only some of its objects imported. Defaults to false.",
+                  "type": "boolean"
+                },
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "tags": [
+          "batch",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/clusterings/": {
+      "post": {
+        "description": "Trigger a clustering of the vectors of a class based on the specified params. Clusterings will run in the background, use GET /clusterings/\u003cid\u003e to retrieve the status and the centroids of your clustering. The cluster of each object is stored in an int property of the class, which can be used to filter or group objects by cluster.",
+        "tags": [
+          "clusterings"
+        ],
+        "summary": "Starts a clustering.",
+        "operationId": "clusterings.post",
+        "parameters": [
+          {
+            "description": "parameters to start a clustering",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started clustering.",
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.clusterings.post"
+        ]
+      }
+    },
+    "/clusterings/{id}": {
+      "get": {
+        "description": "Get status, centroids and metadata of a previously created clustering",
+        "tags": [
+          "clusterings"
+        ],
+        "summary": "View previously created clustering",
+        "operationId": "clusterings.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "clustering id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the clustering, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Clustering does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.clusterings.get"
+        ]
+      }
+    },
+    "/config/runtime": {
+      "put": {
+        "description": "Changes the settings which can be updated without a restart, such as the log level or read-only mode. The request replaces all runtime settings, the settings in effect afterwards are returned.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Updates the runtime config.",
+        "operationId": "runtime.config.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RuntimeConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The runtime config was applied.",
+            "schema": {
+              "$ref": "#/definitions/RuntimeConfig"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. See the ErrorResponse for the invalid setting.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
+        "parameters": [
+          {
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/graphql/sandbox": {
+      "post": {
+        "description": "Resolves a GraphQL query in a sandbox meant for ad-hoc exploration. The query runs with hard caps on its duration, the memory it allocates and the size of its result, which are configured independently of the limits of regular queries. A query which hits a cap is not failed, instead the results which were complete at that point are returned along with the caps which were hit.",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Run a GraphQL query with resource caps.",
+        "operationId": "graphql.sandbox",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query, the results may be partial if a cap was hit.",
+            "schema": {
+              "$ref": "#/definitions/GraphQLSandboxResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
+    "/imports/": {
+      "post": {
+        "description": "Trigger an import of the records of an external source into a class based on the specified params. The records are read by a source connector module and added through the batch API. Imports will run in the background, use GET /imports/\u003cid\u003e to retrieve the status of your import.",
+        "tags": [
+          "imports"
+        ],
+        "summary": "Starts an import.",
+        "operationId": "imports.post",
+        "parameters": [
+          {
+            "description": "parameters to start an import",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Import"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started import.",
+            "schema": {
+              "$ref": "#/definitions/Import"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.imports.post"
+        ]
+      }
+    },
+    "/imports/{id}": {
+      "get": {
+        "description": "Get status and metadata of an import previously started on this node",
+        "tags": [
+          "imports"
+        ],
+        "summary": "View previously created import",
+        "operationId": "imports.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "import id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the import, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Import"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Import does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.imports.get"
+        ]
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns the status of every node of the cluster, including the shards it hosts and the number of objects they contain. Nodes which cannot be reached are reported as unavailable.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Returns the status of the nodes of the cluster.",
+        "operationId": "nodes.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes/{name}/drain": {
+      "post": {
+        "description": "Moves every shard replica held by the node to the remaining nodes of the cluster, copies their data and then makes the node leave the cluster, so it can be shut down without losing data.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Drains a node and removes it from the cluster.",
+        "operationId": "nodes.drain",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the node to drain.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The node was drained and has left the cluster.",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The node is not part of the cluster."
+          },
+          "422": {
+            "description": "The node cannot be drained, for example because no other node can take over its shards.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Default value is 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The class to list the objects of. If set the objects are ordered by id, use after to continue after the last object of the previous page.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A cursor to iterate over all objects of a class. The results are ordered by id and start right after the object with this id. Requires class to be set and can not be combined with offset.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Open or continue a scroll, which iterates over a snapshot of all objects of a class. Objects written while the scroll is open are neither skipped nor returned twice. The value is the duration to keep the scroll open until the next page is requested, e.g. 1m, at most 1h. A new scroll requires class to be set and can not be combined with offset or after.",
+            "name": "scroll",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The scrollId returned with the previous page of a scroll. Requires scroll to be set.",
+            "name": "scrollId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Keywords to rank the objects of a class by their BM25 relevance in all indexed text and string properties, without involving any vector. Requires class to be set and can not be combined with after or scroll.",
+            "name": "bm25",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/objects/aggregates": {
+      "get": {
+        "description": "Reads the current groups of a continuous aggregate of a class, see the continuousAggregates of the invertedIndexConfig. The aggregate is updated on every write, so reading it does not scan any Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Read the current groups of a continuous aggregate.",
+        "operationId": "objects.aggregates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class of the aggregate.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the aggregate.",
+            "name": "name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ContinuousAggregateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or the aggregate does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
+    "/objects/duplicates": {
+      "get": {
+        "description": "Finds groups of Objects of a class whose vectors are near-identical, e.g. to clean up a dataset after importing it twice. Every Object is compared to its nearest neighbors in the vector index, neighbors which are closer than the given distance are linked and linked Objects form a group. All Objects of the class are compared, so this can take a while on large classes.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Find groups of near-duplicate Objects.",
+        "operationId": "objects.duplicates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class to search for duplicates.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "float",
+            "default": 0.05,
+            "description": "The maximum cosine distance between two Objects to consider them duplicates. Defaults to 0.05.",
+            "name": "distance",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximum number of groups to return, the largest groups are returned first. Defaults to 100.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/DuplicatesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the refernced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
+    "/objects/estimate": {
+      "post": {
+        "description": "Dry runs a where filter on a class and returns an upper bound of the number of matching Objects together with the indexes which serve each clause of the filter, and optionally also the exact number of matches. No Objects are read or changed, so e.g. the filter of a retention policy or of a batch delete can be validated before it is run.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Estimate the number of Objects matching a filter.",
+        "operationId": "objects.estimate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FilterEstimateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/FilterEstimateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+    "/objects/facets": {
+      "post": {
+        "description": "Returns the first Objects of a class matching the filter together with the number of matching Objects for the most common values of each faceted property. The filter is only resolved once for both, so the Objects and the facets are always consistent with one another, and a faceted search UI does not need to run the same filter as a Get and an Aggregate query.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Search Objects and count the values of their properties.",
+        "operationId": "objects.facets",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FacetedSearchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/FacetedSearchResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class does not exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "Not",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
-        },
-        "valueString": {
-          "description": "value as string",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        },
-        "valueText": {
-          "description": "value as text (on text props)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Default value is 0.",
-      "name": "offset",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/semi-technologies/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Cloud-native, modular vector search engine",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/semi-technologies",
-      "email": "hello@semi.technology"
     },
-    "version": "1.9.1-rc.0"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
+    "/objects/{id}": {
       "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Weaviate is alive and ready to serve content",
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Object"
             }
           }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Not found, no oidc provider present"
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          "204": {
+            "description": "Successfully applied. No content provided."
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
         "tags": [
-          "batch",
           "objects"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2739,7 +7328,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2754,42 +7343,44 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/batch/references": {
+      },
       "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "description": "Add a single reference to a class-property.",
         "tags": [
-          "batch",
-          "references"
+          "objects"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/SingleRef"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2801,7 +7392,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2816,41 +7407,44 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/classifications/": {
-      "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/SingleRef"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2861,6 +7455,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2868,33 +7468,25 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/schema": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "classifications"
-        ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "schema"
         ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Successfully dumped the database schema.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Schema"
             }
           },
           "401": {
@@ -2906,9 +7498,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2917,34 +7506,30 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.query.meta"
         ]
-      }
-    },
-    "/graphql": {
+      },
       "post": {
-        "description": "Get an object based on GraphQL",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -2957,7 +7542,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid Object class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2969,40 +7554,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/schema/{className}": {
+      "get": {
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Class was updated successfully",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3014,11 +7590,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3027,29 +7600,38 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.get.meta"
         ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
         "tags": [
-          "meta"
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Class was updated successfully",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3061,6 +7643,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3068,53 +7662,30 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+      },
+      "delete": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
-          {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Default value is 0.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
+            "description": "Removed the Object class from the schema."
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Could not delete the Object class.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3128,9 +7699,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3138,34 +7706,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/schema/{className}/properties": {
       "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
+            "description": "Added the property.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -3178,7 +7751,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3190,34 +7763,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/validate": {
+    "/schema/{className}/shards/{shardName}/vector-index/cleanup": {
       "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "description": "Removes the tombstones of deleted vectors from the vector index of a shard right away instead of waiting for the periodic cleanup configured with cleanupIntervalSeconds. The shard has to be located on the node receiving the request.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Clean up the vector index of a shard",
+        "operationId": "schema.shards.vectorIndex.cleanup",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "The vector index of the shard was cleaned up.",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexCleanupResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3228,8 +7806,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The class or shard does not exist."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The shard is not located on this node or its vector index can not be cleaned up.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3241,48 +7822,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/{id}": {
+    "/schema/{className}/status": {
       "get": {
-        "description": "Lists Objects.",
+        "description": "Runtime information about a class which is not part of the schema, such as the state of the most recent vector index advisor run on the node receiving the request.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
+        "summary": "Get the runtime status of a class",
+        "operationId": "schema.objects.status",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The runtime status of the class.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ClassStatus"
             }
           },
           "401": {
@@ -3295,7 +7860,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "This class does not exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3304,42 +7869,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query.meta"
         ]
-      },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/{className}/status/vector-index-advice": {
+      "post": {
+        "description": "Explores a grid of hnsw parameters on a sample of the vectors in the local shards of the class in the background. Use GET /schema/{className}/status to retrieve the report. Only one run per class can be active at a time. The recommendation is never applied automatically.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
+        "summary": "Start a vector index advisor run",
+        "operationId": "schema.objects.status.vectorIndexAdvice",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "description": "parameters of the advisor run, send an empty object to use the defaults",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/VectorIndexAdviceRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
+          "202": {
+            "description": "The advisor run was started.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ClassStatus"
             }
           },
           "401": {
@@ -3352,10 +7916,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "This class does not exist."
+          },
+          "409": {
+            "description": "An advisor run is already active for this class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid advisor parameters or the class has no hnsw vector index.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3367,32 +7937,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/schema/{className}/tenants": {
       "delete": {
-        "description": "Deletes an Object from the system.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
+        "summary": "delete tenants from a specific class",
+        "operationId": "tenants.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Deleted tenants from specified class."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3403,8 +7981,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3413,43 +7994,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "get": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
+        "summary": "Get all tenants of a class",
+        "operationId": "tenants.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3460,11 +8031,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3475,50 +8043,46 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        },
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "name": "tenants",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3530,7 +8094,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3542,47 +8106,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      "put": {
+        "description": "Update the activity status of the specified tenants of a class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
+        "summary": "Update a tenant.",
+        "operationId": "tenants.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "name": "tenants",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3594,7 +8156,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3606,47 +8168,25 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/usage": {
+      "get": {
+        "description": "Returns the number of calls, errors and tokens of every module operation on this node, grouped by class. Calls which do not belong to a class, such as vectorizing a query, have an empty class.",
         "tags": [
-          "objects"
+          "usage"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Returns the module usage of this node.",
+        "operationId": "usage.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/UsageResponse"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3657,687 +8197,1376 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes.",
+      "properties": {
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and unique within the backend.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup. If empty, all classes are included.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The status of a backup.",
+      "properties": {
+        "backend": {
+          "description": "The backend the backup is stored in.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The classes which are included in the backup.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "error": {
+          "description": "The reason the backup failed, if any.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup.",
+          "type": "string"
+        },
+        "path": {
+          "description": "Where the backup is stored, as reported by the backend.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The phase of the backup.",
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ],
+          "type": "string"
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup.",
+      "properties": {
+        "include": {
+          "description": "List of classes to restore from the backup. If empty, all classes of the backup are restored.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The status of the restore of a backup.",
+      "properties": {
+        "backend": {
+          "description": "The backend the backup is restored from.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The classes which are restored.",
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "error": {
+          "description": "The reason the restore failed, if any.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup.",
+          "type": "string"
+        },
+        "path": {
+          "description": "Where the backup is stored, as reported by the backend.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The phase of the restore.",
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ],
+          "type": "string"
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals BATCH_DELETE_MAXIMUM_OBJECTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  },
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
             }
           }
+        }
+      ]
+    },
+    "BatchReferenceResponseAO1Result": {
+      "description": "Results for this specific reference.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "PENDING",
+            "FAILED"
+          ]
+        }
       }
     },
-    "/schema": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
-        "responses": {
-          "200": {
-            "description": "Successfully dumped the database schema.",
-            "schema": {
-              "$ref": "#/definitions/Schema"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/C11yNearestNeighborsItems0"
+      }
+    },
+    "C11yNearestNeighborsItems0": {
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "number",
+          "format": "float"
+        },
+        "word": {
+          "type": "string"
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/C11yVectorBasedQuestionItems0"
+      }
+    },
+    "C11yVectorBasedQuestionItems0": {
+      "type": "object",
+      "properties": {
+        "classProps": {
+          "description": "Vectorized properties.",
+          "type": "array",
+          "maxItems": 300,
+          "minItems": 300,
+          "items": {
+            "$ref": "#/definitions/C11yVectorBasedQuestionItems0ClassPropsItems0"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
-      },
-      "post": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+        "classVectors": {
+          "description": "Vectorized classname.",
+          "type": "array",
+          "maxItems": 300,
+          "minItems": 300,
+          "items": {
+            "type": "number",
+            "format": "float"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added the new Object class to the schema.",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Object class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        }
+      }
+    },
+    "C11yVectorBasedQuestionItems0ClassPropsItems0": {
+      "type": "object",
+      "properties": {
+        "propsVectors": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        },
+        "value": {
+          "description": "String with valuename.",
+          "type": "string"
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.add.meta"
-        ]
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yWordsResponseIndividualWordsItems0"
+          }
+        }
       }
     },
-    "/schema/{className}": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "This class does not exist"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "C11yWordsResponseConcatenatedWord": {
+      "description": "Weighted results for all words",
+      "type": "object",
+      "properties": {
+        "concatenatedNearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
         },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
-        "tags": [
-          "schema"
-        ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+        "concatenatedVector": {
+          "$ref": "#/definitions/C11yVector"
+        },
+        "concatenatedWord": {
+          "type": "string"
+        },
+        "singleWords": {
+          "type": "array",
+          "items": {
+            "format": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        }
+      }
+    },
+    "C11yWordsResponseIndividualWordsItems0": {
+      "type": "object",
+      "properties": {
+        "info": {
+          "type": "object",
+          "properties": {
+            "nearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "vector": {
+              "$ref": "#/definitions/C11yVector"
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
-      },
-      "delete": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+        "present": {
+          "type": "boolean"
+        },
+        "word": {
+          "type": "string"
+        }
+      }
+    },
+    "C11yWordsResponseIndividualWordsItems0Info": {
+      "type": "object",
+      "properties": {
+        "nearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
+        },
+        "vector": {
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shadowConfig": {
+          "$ref": "#/definitions/ShadowConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "templateConfig": {
+          "$ref": "#/definitions/TemplateConfig"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        }
+      }
+    },
+    "ClassStatus": {
+      "description": "Runtime information about a class which is not part of the schema, such as the outcome of background jobs. It is purely informative, nothing in here is ever applied automatically.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "name of the class",
+          "type": "string"
+        },
+        "vectorIndexAdvice": {
+          "$ref": "#/definitions/VectorIndexAdvice",
+          "description": "the most recent run of the vector index advisor on this node"
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorythim to use for classifications",
+          "type": "string"
+        }
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid property.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "ClassificationFilters": {
+      "type": "object",
+      "properties": {
+        "sourceWhere": {
+          "description": "limit the objects to be classified",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "targetWhere": {
+          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "trainingSetWhere": {
+          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Clustering": {
+      "description": "Group the objects of a class into k clusters of similar vectors, trigger clusterings and view status and centroids of past clusterings.",
+      "type": "object",
+      "properties": {
+        "centroids": {
+          "description": "the centers of the clusters, ordered by cluster number. Only set once the clustering has completed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusteringCentroid"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "class": {
+          "description": "class (name) whose objects are clustered",
+          "type": "string",
+          "example": "Article"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "cluster xzy: something went wrong"
+        },
+        "id": {
+          "description": "ID to uniquely identify this clustering run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "indexProperty": {
+          "description": "Whether the property holding the cluster should be indexed in the inverted index, so objects can be filtered by cluster. Only used if the property does not exist yet. Defaults to true.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "k": {
+          "description": "number of clusters",
+          "type": "integer",
+          "example": 8
+        },
+        "maxIterations": {
+          "description": "maximum number of iterations to refine the clusters. Defaults to 100.",
+          "type": "integer",
+          "example": 100
+        },
+        "meta": {
+          "description": "additional meta information about the clustering",
+          "type": "object",
+          "$ref": "#/definitions/ClusteringMeta"
+        },
+        "property": {
+          "description": "Name of the int property which holds the cluster number of each object. It is added to the class if it does not exist yet. Defaults to 'cluster'.",
+          "type": "string",
+          "example": "cluster"
+        },
+        "status": {
+          "description": "status of this clustering",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        }
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
+    },
+    "ClusteringCentroid": {
+      "description": "The center of a single cluster",
       "type": "object",
-      "additionalProperties": {
-        "type": "object"
+      "properties": {
+        "cluster": {
+          "description": "number of the cluster, as stored in the property of its objects",
+          "type": "integer",
+          "example": 0,
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "number of objects in this cluster",
+          "type": "integer",
+          "example": 147
+        },
+        "vector": {
+          "description": "the mean vector of all objects in this cluster",
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "ClusteringMeta": {
+      "description": "Additional information to a specific clustering",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this clustering finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were assigned to a cluster",
+          "type": "integer",
+          "example": 147
+        },
+        "iterations": {
+          "description": "number of iterations until the clusters converged or maxIterations was reached",
+          "type": "integer",
+          "example": 12
+        },
+        "started": {
+          "description": "time when this clustering was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
       }
     },
-    "BatchReference": {
+    "ContinuousAggregate": {
+      "description": "The count and the sums of properties of the Objects of a class per value of a property. It is updated on every write, so it can be read without scanning the Objects.",
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        "groupBy": {
+          "description": "The property whose values the Objects are grouped by. Without it, all Objects are counted in a single group.",
+          "type": "string"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        "name": {
+          "description": "The name by which the aggregate is read.",
+          "type": "string"
+        },
+        "sum": {
+          "description": "The number or int properties which are summed up per group.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "BatchReferenceResponse": {
+    "ContinuousAggregateGroup": {
+      "description": "The count and the sums of the Objects with one value of the grouped by property.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
+      "properties": {
+        "count": {
+          "description": "The number of Objects in the group.",
+          "type": "integer",
+          "format": "int64"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
+        "sums": {
+          "description": "The sum of each summed up property over the Objects in the group.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
           }
+        },
+        "value": {
+          "description": "The value of the grouped by property, empty if the aggregate is not grouped.",
+          "type": "string"
         }
-      ]
+      }
     },
-    "BatchReferenceResponseAO1Result": {
-      "description": "Results for this specific reference.",
-      "format": "object",
+    "ContinuousAggregateResponse": {
+      "description": "The current groups of a continuous aggregate.",
+      "type": "object",
       "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
+        "class": {
+          "description": "The class of the aggregate.",
+          "type": "string"
         },
-        "status": {
-          "type": "string",
-          "default": "SUCCESS",
-          "enum": [
-            "SUCCESS",
-            "PENDING",
-            "FAILED"
-          ]
+        "groups": {
+          "description": "The groups of the aggregate, ordered by their value.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ContinuousAggregateGroup"
+          }
+        },
+        "name": {
+          "description": "The name of the aggregate.",
+          "type": "string"
         }
       }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "Deprecation": {
+      "type": "object",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
           "type": "string",
-          "example": "foobarium"
+          "x-nullable": true
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
           "type": "string"
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
         }
       }
     },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/C11yNearestNeighborsItems0"
+    "DuplicateGroup": {
+      "description": "A group of Objects whose vectors are near-identical.",
+      "type": "object",
+      "properties": {
+        "ids": {
+          "description": "The ids of the Objects in the group, ordered by id.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "maxDistance": {
+          "description": "The largest distance of two Objects which were linked into this group.",
+          "type": "number",
+          "format": "float"
+        }
       }
     },
-    "C11yNearestNeighborsItems0": {
+    "DuplicatesResponse": {
+      "description": "Groups of near-duplicate Objects of a class.",
       "type": "object",
       "properties": {
+        "class": {
+          "description": "The class which was searched for duplicates.",
+          "type": "string"
+        },
         "distance": {
+          "description": "The maximum distance which was used to link two Objects.",
           "type": "number",
           "format": "float"
         },
-        "word": {
+        "groups": {
+          "description": "The groups of near-duplicate Objects, largest groups first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DuplicateGroup"
+          }
+        },
+        "totalObjects": {
+          "description": "The number of Objects which were compared.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ErrorResponseErrorItems0"
+          }
+        }
+      }
+    },
+    "ErrorResponseErrorItems0": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "module": {
+          "description": "The module whose rule the object violates, only set for data quality rule violations.",
+          "type": "string"
+        },
+        "property": {
+          "description": "The property which violates a rule, only set for data quality rule violations.",
+          "type": "string"
+        },
+        "rule": {
+          "description": "The name of the violated rule, only set for data quality rule violations.",
           "type": "string"
         }
       }
     },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
+    "Facet": {
+      "description": "The most common values of a property among the Objects matching a faceted search.",
+      "type": "object",
+      "properties": {
+        "property": {
+          "description": "The name of the faceted property.",
+          "type": "string"
+        },
+        "values": {
+          "description": "The most common values of the property, most common first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FacetValue"
+          }
+        }
       }
     },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/C11yVectorBasedQuestionItems0"
+    "FacetValue": {
+      "description": "A value of a faceted property and the number of matching Objects which have it.",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "The number of matching Objects with this value.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "The value of the property."
+        }
       }
     },
-    "C11yVectorBasedQuestionItems0": {
+    "FacetedSearchRequest": {
+      "description": "A search which returns the first Objects matching a filter together with the number of matching Objects for the most common values of the faceted properties.",
       "type": "object",
       "properties": {
-        "classProps": {
-          "description": "Vectorized properties.",
+        "class": {
+          "description": "The class to search.",
+          "type": "string"
+        },
+        "facetLimit": {
+          "description": "The maximum number of values per faceted property. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "facets": {
+          "description": "The properties to count the values of. Only text, string, int, number and boolean properties and their arrays can be faceted.",
           "type": "array",
-          "maxItems": 300,
-          "minItems": 300,
           "items": {
-            "$ref": "#/definitions/C11yVectorBasedQuestionItems0ClassPropsItems0"
+            "type": "string"
           }
         },
-        "classVectors": {
-          "description": "Vectorized classname.",
+        "limit": {
+          "description": "The maximum number of Objects to return. Defaults to the default query limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "description": "The tenant to search, required for classes with multi-tenancy.",
+          "type": "string"
+        },
+        "where": {
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "FacetedSearchResponse": {
+      "description": "The result of a faceted search. The Objects and the facets are based on the same matches of the filter.",
+      "type": "object",
+      "properties": {
+        "facets": {
+          "description": "One facet per faceted property, in the order they were requested.",
           "type": "array",
-          "maxItems": 300,
-          "minItems": 300,
           "items": {
-            "type": "number",
-            "format": "float"
+            "$ref": "#/definitions/Facet"
+          }
+        },
+        "objects": {
+          "description": "The first Objects matching the filter.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
           }
+        },
+        "totalResults": {
+          "description": "The number of Objects matching the filter.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "C11yVectorBasedQuestionItems0ClassPropsItems0": {
+    "FilterEstimateRequest": {
+      "description": "A dry run of a where filter on a class.",
       "type": "object",
       "properties": {
-        "propsVectors": {
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float"
-          }
+        "class": {
+          "description": "The class to run the filter on.",
+          "type": "string"
         },
-        "value": {
-          "description": "String with valuename.",
+        "exact": {
+          "description": "Additionally count the matching Objects exactly. This resolves the filter just like a search would, so it is more expensive than the estimate.",
+          "type": "boolean"
+        },
+        "tenant": {
+          "description": "The tenant to run the filter on, required for classes with multi-tenancy.",
           "type": "string"
+        },
+        "where": {
+          "$ref": "#/definitions/WhereFilter"
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "FilterEstimateResponse": {
+      "description": "The result of a dry run of a where filter.",
+      "type": "object",
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
-          }
+        "estimatedCount": {
+          "description": "An upper bound of the number of matching Objects. Every clause of the filter is counted on its own, the counts of an And operator are combined by their minimum and the counts of an Or operator by their sum.",
+          "type": "integer",
+          "format": "int64"
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
+        "exactCount": {
+          "description": "The exact number of matching Objects, only set if it was requested.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "indexes": {
+          "description": "The indexes which serve the clauses of the filter.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/C11yWordsResponseIndividualWordsItems0"
+            "$ref": "#/definitions/FilterIndexUsage"
           }
         }
       }
     },
-    "C11yWordsResponseConcatenatedWord": {
-      "description": "Weighted results for all words",
+    "FilterIndexUsage": {
+      "description": "The index which serves a clause of a where filter.",
       "type": "object",
       "properties": {
-        "concatenatedNearestNeighbors": {
-          "$ref": "#/definitions/C11yNearestNeighbors"
-        },
-        "concatenatedVector": {
-          "$ref": "#/definitions/C11yVector"
+        "index": {
+          "description": "The kind of index, one of inverted, composite, shared, count, nullState or geo.",
+          "type": "string"
         },
-        "concatenatedWord": {
+        "operator": {
+          "description": "The operator of the clause.",
           "type": "string"
         },
-        "singleWords": {
+        "properties": {
+          "description": "The properties the index covers, more than one for a composite index.",
           "type": "array",
           "items": {
-            "format": "string"
+            "type": "string"
           }
         }
       }
     },
-    "C11yWordsResponseIndividualWordsItems0": {
-      "type": "object",
+    "GeoCoordinates": {
       "properties": {
-        "info": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "extensions": {
+          "description": "Machine-readable details about the error. Contains the kind of the error in 'code', one of NOT_FOUND, CONFLICT, VALIDATION, OVERLOADED, or INTERNAL.",
           "type": "object",
-          "properties": {
-            "nearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "vector": {
-              "$ref": "#/definitions/C11yVector"
-            }
+          "additionalProperties": {
+            "type": "object"
           }
         },
-        "present": {
-          "type": "boolean"
+        "locations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLErrorLocationsItems0"
+          }
         },
-        "word": {
+        "message": {
           "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "C11yWordsResponseIndividualWordsItems0Info": {
+    "GraphQLErrorLocationsItems0": {
       "type": "object",
       "properties": {
-        "nearestNeighbors": {
-          "$ref": "#/definitions/C11yNearestNeighbors"
+        "column": {
+          "type": "integer",
+          "format": "int64"
         },
-        "vector": {
-          "$ref": "#/definitions/C11yVector"
+        "line": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "Class": {
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
       "type": "object",
       "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
+        "consistentSnapshot": {
+          "description": "Read all classes the query refers to as of the same point in time. Writes which happen while the query runs are not visible to it, so e.g. a Get and an Aggregate of the same class agree with each other.",
+          "type": "boolean"
         },
-        "description": {
-          "description": "Description of the class.",
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
           "type": "string"
         },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
         },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
+        "variables": {
+          "description": "Additional variables for the query.",
           "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         },
-        "properties": {
-          "description": "The properties of the class.",
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Property"
-          }
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
-        },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "GraphQLSandboxResponse": {
+      "description": "GraphQL based response of a query in the sandbox.",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
+        "capsHit": {
+          "description": "The caps the query hit, its results are partial if any are listed.",
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
+            "type": "string",
+            "enum": [
+              "time",
+              "memory",
+              "resultSize"
+            ]
+          }
         },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/GraphQLError"
           },
-          "example": [
-            "inCountry"
-          ]
+          "x-omitempty": true
+        }
+      }
+    },
+    "Import": {
+      "description": "Import the records of an external source into a class through a source connector module, trigger imports and view the status of past imports.",
+      "type": "object",
+      "properties": {
+        "batchSize": {
+          "description": "number of objects which are imported at once. Defaults to 100.",
+          "type": "integer",
+          "example": 100
         },
-        "error": {
-          "description": "error message if status == failed",
+        "class": {
+          "description": "class (name) the records are imported into",
           "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
+          "example": "Article"
         },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "connector": {
+          "description": "name of the source connector module which reads the records, e.g. import-filesystem",
+          "type": "string",
+          "example": "import-filesystem"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "read source: file articles.csv does not exist"
         },
         "id": {
-          "description": "ID to uniquely identify this classification run",
+          "description": "ID to uniquely identify this import",
           "type": "string",
           "format": "uuid",
           "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
         },
+        "idField": {
+          "description": "Field of a record which holds the UUID of its object. If not set, every object is assigned a random UUID.",
+          "type": "string",
+          "example": "uuid"
+        },
+        "mapping": {
+          "description": "Maps the name of each property of the class to the field of a record which holds its value. Fields of nested records can be addressed with dots. If not set, every field is mapped to the property of the same name.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "example": {
+            "author": "meta.author",
+            "title": "headline"
+          }
+        },
         "meta": {
-          "description": "additional meta information about the classification",
+          "description": "additional meta information about the import",
           "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+          "$ref": "#/definitions/ImportMeta"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "source": {
+          "description": "settings which describe the source to read from, they depend on the connector",
+          "type": "object",
+          "example": {
+            "path": "articles.csv"
+          }
         },
         "status": {
-          "description": "status of this classification",
+          "description": "status of this import",
           "type": "string",
           "enum": [
             "running",
@@ -4345,303 +9574,325 @@ func init() {
             "failed"
           ],
           "example": "running"
-        },
-        "type": {
-          "description": "which algorythim to use for classifications",
-          "type": "string"
-        }
-      }
-    },
-    "ClassificationFilters": {
-      "type": "object",
-      "properties": {
-        "sourceWhere": {
-          "description": "limit the objects to be classified",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "targetWhere": {
-          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "trainingSetWhere": {
-          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
+    "ImportMeta": {
+      "description": "Additional information to a specific import",
       "type": "object",
       "properties": {
         "completed": {
-          "description": "time when this classification finished",
+          "description": "time when this import finished",
           "type": "string",
           "format": "date-time",
           "example": "2017-07-21T17:32:28Z"
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
+        "errors": {
+          "description": "errors of the first records which could not be imported",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "number of records which could not be imported",
           "type": "integer",
-          "example": 147
+          "example": 3
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
+        "imported": {
+          "description": "number of objects which were imported successfully",
           "type": "integer",
-          "example": 7
+          "example": 147
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
+        "read": {
+          "description": "number of records read from the source",
           "type": "integer",
-          "example": 140
+          "example": 150
         },
         "started": {
-          "description": "time when this classification was started",
+          "description": "time when this import was started",
           "type": "string",
           "format": "date-time",
           "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "Deprecation": {
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
       "type": "object",
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
         },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
+        "compositeIndexes": {
+          "description": "Property combinations which are frequently filtered together with the Equal operator. Each is indexed with a single composite key, so such filters can be served by a single lookup",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
         },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
+        "continuousAggregates": {
+          "description": "Counts and sums per group which are updated on every write, so they can be read without an Aggregate scan of all Objects",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/ContinuousAggregate"
           }
         },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
+        "indexNullState": {
+          "description": "Index the null state of each property, which is required to filter with the IsNull operator",
+          "type": "boolean"
         },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
+        "indexTimestamps": {
+          "description": "Index the creation and last update time of each object, which is required to filter and sort by _creationTimeUnix and _lastUpdateTimeUnix",
+          "type": "boolean"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+        "strictTypes": {
+          "description": "Reject writes with property values which do not match the data type of their property or which set a property that is not part of the schema, instead of coercing or skipping them",
+          "type": "boolean"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
           "type": "string"
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
+        "href": {
+          "description": "target of the link",
+          "type": "string"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
+        "name": {
+          "description": "human readable name of the resource group",
           "type": "string"
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
           "type": "string"
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
       "type": "object",
       "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ErrorResponseErrorItems0"
-          }
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
         }
       }
     },
-    "ErrorResponseErrorItems0": {
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
       "type": "object",
       "properties": {
-        "message": {
-          "type": "string"
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class. Every tenant is stored in a shard of its own and every request to the class has to specify a tenant. Cannot be changed after the class was created.",
+          "type": "boolean",
+          "x-omitempty": false
         }
       }
     },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
+    "NodeDrainResponse": {
+      "description": "The result of draining a node.",
+      "type": "object",
       "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLErrorLocationsItems0"
-          }
-        },
-        "message": {
+        "name": {
+          "description": "The name of the drained node.",
           "type": "string"
         },
-        "path": {
+        "shards": {
+          "description": "The shards which were moved to the remaining nodes of the cluster.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/NodeDrainShard"
           }
         }
       }
     },
-    "GraphQLErrorLocationsItems0": {
+    "NodeDrainShard": {
+      "description": "A shard which was moved off a drained node.",
       "type": "object",
       "properties": {
-        "column": {
-          "type": "integer",
-          "format": "int64"
+        "class": {
+          "description": "The name of the class the shard belongs to.",
+          "type": "string"
         },
-        "line": {
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects which were copied to the target node.",
           "type": "integer",
           "format": "int64"
+        },
+        "target": {
+          "description": "The node which now holds the replica of the shard.",
+          "type": "string"
         }
       }
     },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+    "NodeShardStatus": {
+      "description": "The status of a shard hosted on a node.",
       "type": "object",
       "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
+        "atomicBatchError": {
+          "description": "The reason the pending atomic batch of the shard could not be applied in full yet, empty unless atomicBatchPending is set.",
           "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "atomicBatchPending": {
+          "description": "Whether an atomic batch was committed to the shard, but has not been applied in full yet. It is rolled forward before the next atomic batch of the shard is imported.",
+          "type": "boolean"
+        },
+        "class": {
+          "description": "The name of the class the shard belongs to.",
           "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
+        "lsmStoreBytes": {
+          "description": "The size in bytes of the disk segments of the shard.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in the shard.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stalledWrites": {
+          "description": "The number of writes to the shard which currently wait for a memtable to be flushed to disk.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexCommitLogBytes": {
+          "description": "The size in bytes of the commit logs of the vector index of the shard.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexTombstones": {
+          "description": "The number of deleted vectors which are still part of the vector index of the shard, because the tombstone cleanup has not removed them yet.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "walBytes": {
+          "description": "The size in bytes of the write-ahead logs of the shard, which hold the writes that have not been flushed to disk segments yet.",
+          "type": "integer",
+          "format": "int64"
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
+        "writeStalled": {
+          "description": "Whether writes to the shard currently wait for a memtable to be flushed to disk, because the shard receives writes faster than it can flush them.",
+          "type": "boolean"
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
+    "NodeStats": {
+      "description": "The summary of the shards hosted on a node.",
       "type": "object",
       "properties": {
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
+        "lsmStoreBytes": {
+          "description": "The size in bytes of the disk segments across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectCount": {
+          "description": "The number of objects across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardCount": {
+          "description": "The number of shards hosted on the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexCommitLogBytes": {
+          "description": "The size in bytes of the vector index commit logs across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "walBytes": {
+          "description": "The size in bytes of the write-ahead logs across all shards of the node.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
+    "NodeStatus": {
+      "description": "The status of a node of the cluster.",
       "type": "object",
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
+        "gitHash": {
+          "description": "The git commit the node was built from.",
           "type": "string"
         },
         "name": {
-          "description": "human readable name of the resource group",
+          "description": "The name of the node.",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
-        }
-      }
-    },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
+        "shards": {
+          "description": "The shards hosted on the node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
         },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
+        "stats": {
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Whether the node could be reached and reported its status.",
+          "type": "string",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
+          ]
         },
         "version": {
-          "description": "Version of weaviate you are currently running",
+          "description": "The version of Weaviate the node is running.",
           "type": "string"
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+    "NodesStatusResponse": {
+      "description": "The status of all nodes of the cluster.",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
       }
     },
     "Object": {
@@ -4669,9 +9920,25 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "multiVector": {
+          "description": "Token-level vectors of the Object, which are searched by late interaction. Only indexed if multiVector is enabled in the vectorIndexConfig of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yVector"
+          },
+          "x-omitempty": true
+        },
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "sparseVector": {
+          "description": "Sparse term weights of the Object, which are searched by their dot product with a sparse query. Only indexed if sparse is enabled in the vectorIndexConfig of the class.",
+          "$ref": "#/definitions/SparseVector"
+        },
+        "tenant": {
+          "description": "Name of the tenant the object belongs to. Required for classes with multi-tenancy enabled, must not be set otherwise.",
+          "type": "string"
+        },
         "vector": {
           "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
           "$ref": "#/definitions/C11yVector"
@@ -4756,6 +10023,10 @@ func init() {
             "$ref": "#/definitions/Object"
           }
         },
+        "scrollId": {
+          "description": "The id to pass as scrollId to get the next page of a scroll. Empty once all objects have been returned.",
+          "type": "string"
+        },
         "totalResults": {
           "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
           "type": "integer",
@@ -4933,6 +10204,14 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "invertedIndexStorage": {
+          "description": "Optional. Determines how the inverted index of a boolean property or a string property with \"field\" tokenization is stored. \"dedicated\" stores it in buckets of its own, \"shared\" stores it in buckets shared with all other properties of the class using this option, which reduces the number of open files for classes with many low-cardinality properties. Defaults to \"dedicated\"",
+          "type": "string",
+          "enum": [
+            "dedicated",
+            "shared"
+          ]
+        },
         "moduleConfig": {
           "description": "Configuratino specific to modules this Weaviate instance has installed",
           "type": "object"
@@ -4940,6 +10219,16 @@ func init() {
         "name": {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
+        },
+        "tokenization": {
+          "description": "Optional. Determines how the value of a string or text property is split into tokens for the inverted index. \"word\" splits on any non-alphanumerical character and lowercases, \"lowercase\" splits on whitespace and lowercases, \"whitespace\" splits on whitespace only and \"field\" indexes the entire trimmed value as a single token. Defaults to \"word\" for text and \"whitespace\" for string properties",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
         }
       }
     },
@@ -5005,6 +10294,49 @@ func init() {
         }
       }
     },
+    "ReplicationConfig": {
+      "description": "Configure how many copies of each shard are kept in the cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of copies of each shard, including the primary. Defaults to 1, which means no replication. Cannot be larger than the number of nodes in the cluster.",
+          "type": "integer"
+        }
+      }
+    },
+    "RuntimeConfig": {
+      "description": "The settings which can be changed without restarting Weaviate.",
+      "properties": {
+        "logLevel": {
+          "description": "One of \"panic\", \"fatal\", \"error\", \"warning\", \"info\", \"debug\", \"trace\". If empty, the current log level is kept.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "slowQueryThresholdMs": {
+          "description": "Queries taking longer than this many milliseconds are logged. 0 disables slow query logging.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "rowCacheMaxSize": {
+          "description": "Size of the inverted index row cache of each shard in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "readOnly": {
+          "description": "If true, every request which would alter data or the schema is rejected.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "inferenceMaxConcurrency": {
+          "description": "Maximum number of concurrent module calls. Must be greater than 0 if the inference queue was enabled on startup and 0 otherwise, enabling or disabling the queue requires a restart.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "Schema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
       "type": "object",
@@ -5024,6 +10356,13 @@ func init() {
         "name": {
           "description": "Name of the schema.",
           "type": "string"
+        },
+        "templates": {
+          "description": "Templates other classes can be derived from, see TemplateConfig",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
         }
       }
     },
@@ -5031,6 +10370,24 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "ShadowConfig": {
+      "description": "Mirror the traffic of a class to another class or Weaviate instance, e.g. to test a new vectorizer or index configuration against production traffic before switching over",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class the traffic is mirrored to. If endpoint is set, this is the class on the remote instance and defaults to the name of the shadowed class. Otherwise, it has to be another class of this instance.",
+          "type": "string"
+        },
+        "endpoint": {
+          "description": "Base URL of a remote Weaviate instance the writes are mirrored to, e.g. http://shadow:8080. If not set, the traffic is mirrored to a class of this instance.",
+          "type": "string"
+        },
+        "readSampleRate": {
+          "description": "Share of the Get queries on the class which are also run against the shadow class, between 0 and 1. Their results are discarded. Reads can only be mirrored to a class of this instance.",
+          "type": "number"
+        }
+      }
+    },
     "SingleRef": {
       "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
@@ -5059,6 +10416,167 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "Term weights of a learned sparse representation, keyed by the term.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "TemplateConfig": {
+      "description": "Derive classes from a template, so that many similarly shaped classes, e.g. one per customer or language, share their properties, module config and index settings",
+      "type": "object",
+      "properties": {
+        "isTemplate": {
+          "description": "The class is a template for other classes. Templates are part of the schema, but have no index and cannot hold any objects.",
+          "type": "boolean"
+        },
+        "propagateUpdates": {
+          "description": "Properties which are added to the template later on are added to this class as well",
+          "type": "boolean"
+        },
+        "template": {
+          "description": "Name of the template the class is derived from. Properties, module config and index settings which are not set on the class itself are taken from the template when the class is created.",
+          "type": "string"
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Only the shards of HOT tenants are loaded and can be queried. Defaults to HOT.",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "COLD"
+          ]
+        },
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "UsageRecord": {
+      "description": "The usage of one operation of a module on behalf of a class.",
+      "type": "object",
+      "properties": {
+        "calls": {
+          "description": "number of calls since the node was started",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "name of the class, empty if the call did not belong to a class",
+          "type": "string"
+        },
+        "errors": {
+          "description": "number of calls which failed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "module": {
+          "description": "name of the module",
+          "type": "string"
+        },
+        "operation": {
+          "description": "operation of the module, such as vectorize or vectorizeQuery",
+          "type": "string"
+        },
+        "tokens": {
+          "description": "number of tokens reported by the module, 0 if the module does not report them",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "UsageResponse": {
+      "description": "The module usage of this node.",
+      "type": "object",
+      "properties": {
+        "modules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UsageRecord"
+          }
+        }
+      }
+    },
+    "VectorIndexAdvice": {
+      "description": "The state of a run of the vector index advisor, which explores a grid of hnsw parameters on a sample of the vectors of a class. Once completed, the report contains the Pareto-optimal settings and a recommendation which can be applied through a regular class update.",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this run finished",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "error message if status == FAILED",
+          "type": "string"
+        },
+        "report": {
+          "description": "the measured recall and latency of every explored setting, the Pareto-optimal ones and the recommended one. Only set once the run has completed.",
+          "type": "object"
+        },
+        "started": {
+          "description": "time when this run was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "status of this run",
+          "type": "string",
+          "enum": [
+            "RUNNING",
+            "COMPLETED",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "VectorIndexAdviceRequest": {
+      "description": "Parameters of a vector index advisor run. Every parameter is optional.",
+      "type": "object",
+      "properties": {
+        "k": {
+          "description": "number of nearest neighbors the recall is measured for. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sampleSize": {
+          "description": "number of vectors sampled from the local shards of the class. Defaults to 2000.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "targetRecall": {
+          "description": "recall the recommended setting has to reach. Defaults to 0.95.",
+          "type": "number",
+          "format": "float64"
+        }
+      }
+    },
+    "VectorIndexCleanupResponse": {
+      "description": "The number of tombstones, i.e. deleted vectors which are still part of the graph, in the vector index of a shard before and after a cleanup",
+      "type": "object",
+      "properties": {
+        "tombstonesAfter": {
+          "description": "Number of tombstones left after the cleanup. These belong to vectors which were deleted while the cleanup was running.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tombstonesBefore": {
+          "description": "Number of tombstones when the cleanup started",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -5088,7 +10606,8 @@ func init() {
             "GreaterThanEqual",
             "LessThan",
             "LessThanEqual",
-            "WithinGeoRange"
+            "WithinGeoRange",
+            "IsNull"
           ],
           "example": "GreaterThanEqual"
         },
@@ -5200,6 +10719,12 @@ func init() {
       "description": "The starting index of the result window. Default value is 0.",
       "name": "offset",
       "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
     }
   },
   "securityDefinitions": {
@@ -5240,6 +10765,26 @@ func init() {
     {
       "description": "These operations enable manipulation of the schema in Weaviate schema.",
       "name": "schema"
+    },
+    {
+      "description": "These operations report the status of the nodes of the cluster.",
+      "name": "nodes"
+    },
+    {
+      "description": "These operations report the module usage of this node.",
+      "name": "usage"
+    },
+    {
+      "description": "These operations allow to back up classes to a storage backend and to restore them.",
+      "name": "backups"
+    },
+    {
+      "description": "These operations allow to group the objects of a class into clusters of similar vectors.",
+      "name": "clusterings"
+    },
+    {
+      "description": "These operations allow to import the records of external sources into a class through source connector modules.",
+      "name": "imports"
     }
   ],
   "externalDocs": {
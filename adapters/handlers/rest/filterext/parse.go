@@ -133,6 +133,8 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNotEqual, nil
 	case models.WhereFilterOperatorWithinGeoRange:
 		return filters.OperatorWithinGeoRange, nil
+	case models.WhereFilterOperatorIsNull:
+		return filters.OperatorIsNull, nil
 	case models.WhereFilterOperatorAnd:
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/backups"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+)
+
+func setupBackupHandlers(api *operations.WeaviateAPI,
+	coordinator *backup.Coordinator) {
+	api.BackupsBackupsCreateHandler = backups.BackupsCreateHandlerFunc(
+		func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
+			res, err := coordinator.Backup(params.HTTPRequest.Context(), principal,
+				params.Backend, params.Body)
+			if err != nil {
+				switch {
+				case isForbidden(err):
+					return backups.NewBackupsCreateForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindValidation),
+					errortypes.Is(err, errortypes.KindConflict):
+					return backups.NewBackupsCreateUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsCreateInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsCreateOK().WithPayload(res)
+		})
+
+	api.BackupsBackupsCreateStatusHandler = backups.BackupsCreateStatusHandlerFunc(
+		func(params backups.BackupsCreateStatusParams, principal *models.Principal) middleware.Responder {
+			res, err := coordinator.BackupStatus(params.HTTPRequest.Context(), principal,
+				params.Backend, params.ID)
+			if err != nil {
+				switch {
+				case isForbidden(err):
+					return backups.NewBackupsCreateStatusForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindNotFound):
+					return backups.NewBackupsCreateStatusNotFound().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindValidation):
+					return backups.NewBackupsCreateStatusUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsCreateStatusInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsCreateStatusOK().WithPayload(res)
+		})
+
+	api.BackupsBackupsRestoreHandler = backups.BackupsRestoreHandlerFunc(
+		func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
+			res, err := coordinator.Restore(params.HTTPRequest.Context(), principal,
+				params.Backend, params.ID, params.Body)
+			if err != nil {
+				switch {
+				case isForbidden(err):
+					return backups.NewBackupsRestoreForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindNotFound):
+					return backups.NewBackupsRestoreNotFound().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindValidation),
+					errortypes.Is(err, errortypes.KindConflict):
+					return backups.NewBackupsRestoreUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsRestoreInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsRestoreOK().WithPayload(res)
+		})
+
+	api.BackupsBackupsRestoreStatusHandler = backups.BackupsRestoreStatusHandlerFunc(
+		func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
+			res, err := coordinator.RestoreStatus(params.HTTPRequest.Context(), principal,
+				params.Backend, params.ID)
+			if err != nil {
+				switch {
+				case isForbidden(err):
+					return backups.NewBackupsRestoreStatusForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindNotFound):
+					return backups.NewBackupsRestoreStatusNotFound().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindValidation):
+					return backups.NewBackupsRestoreStatusUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsRestoreStatusInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsRestoreStatusOK().WithPayload(res)
+		})
+}
+
+func isForbidden(err error) bool {
+	_, ok := err.(errors.Forbidden)
+	return ok
+}
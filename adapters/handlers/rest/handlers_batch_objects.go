@@ -27,7 +27,12 @@ type batchObjectHandlers struct {
 
 func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	principal *models.Principal) middleware.Responder {
-	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
+	ctx := params.HTTPRequest.Context()
+	if params.Body.AtomicPerShard {
+		ctx = objects.WithAtomicShardBatches(ctx)
+	}
+
+	objs, err := h.manager.AddObjects(ctx, principal,
 		params.Body.Objects, params.Body.Fields)
 	if err != nil {
 		switch err.(type) {
@@ -38,8 +43,7 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 			return batch.NewBatchObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return batch.NewBatchObjectsCreateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -79,8 +83,7 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 			return batch.NewBatchReferencesCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return batch.NewBatchReferencesCreateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -115,6 +118,75 @@ func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences)
 	return response
 }
 
+func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.DeleteObjects(params.HTTPRequest.Context(), principal,
+		params.Body.Match, params.Body.DryRun, params.Body.Output)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batch.NewBatchObjectsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return batch.NewBatchObjectsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return errResponder(err)
+		}
+	}
+
+	return batch.NewBatchObjectsDeleteOK().
+		WithPayload(h.objectsDeleteResponse(res))
+}
+
+func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteResponse) *models.BatchDeleteResponse {
+	var successful, failed int64
+	output := input.Output
+	var items []*models.BatchDeleteResponseResultsObjectsItems0
+	for _, obj := range input.Result.Objects {
+		var errorResponse *models.ErrorResponse
+
+		status := models.BatchDeleteResponseResultsObjectsItems0StatusSUCCESS
+		if obj.Err != nil {
+			status = models.BatchDeleteResponseResultsObjectsItems0StatusFAILED
+			errorResponse = errPayloadFromSingleErr(obj.Err)
+			failed += 1
+		} else if input.DryRun {
+			status = models.BatchDeleteResponseResultsObjectsItems0StatusDRYRUN
+		} else {
+			successful += 1
+		}
+
+		// minimal output only lists the objects which could not be deleted
+		if output == objects.OutputMinimal &&
+			status != models.BatchDeleteResponseResultsObjectsItems0StatusFAILED {
+			continue
+		}
+
+		items = append(items, &models.BatchDeleteResponseResultsObjectsItems0{
+			ID:     obj.UUID,
+			Status: &status,
+			Errors: errorResponse,
+		})
+	}
+
+	return &models.BatchDeleteResponse{
+		Match: &models.BatchDeleteResponseMatch{
+			Class: input.Match.Class,
+			Where: input.Match.Where,
+		},
+		DryRun: &input.DryRun,
+		Output: &output,
+		Results: &models.BatchDeleteResponseResults{
+			Matches:    input.Result.Matches,
+			Limit:      input.Result.Limit,
+			Successful: successful,
+			Failed:     failed,
+			Objects:    items,
+		},
+	}
+}
+
 func setupKindBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager) {
 	h := &batchObjectHandlers{manager}
 
@@ -122,4 +194,6 @@ func setupKindBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchM
 		BatchObjectsCreateHandlerFunc(h.addObjects)
 	api.BatchBatchReferencesCreateHandler = batch.
 		BatchReferencesCreateHandlerFunc(h.addReferences)
+	api.BatchBatchObjectsDeleteHandler = batch.
+		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
 }
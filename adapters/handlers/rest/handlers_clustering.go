@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/clusterings"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/clustering"
+)
+
+func setupClusteringHandlers(api *operations.WeaviateAPI,
+	clusterer *clustering.Clusterer) {
+	api.ClusteringsClusteringsGetHandler = clusterings.ClusteringsGetHandlerFunc(
+		func(params clusterings.ClusteringsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := clusterer.Get(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			if err != nil {
+				return clusterings.NewClusteringsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			if res == nil {
+				return clusterings.NewClusteringsGetNotFound()
+			}
+
+			return clusterings.NewClusteringsGetOK().WithPayload(res)
+		},
+	)
+
+	api.ClusteringsClusteringsPostHandler = clusterings.ClusteringsPostHandlerFunc(
+		func(params clusterings.ClusteringsPostParams, principal *models.Principal) middleware.Responder {
+			res, err := clusterer.Schedule(params.HTTPRequest.Context(), principal, *params.Params)
+			if err != nil {
+				return clusterings.NewClusteringsPostBadRequest().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return clusterings.NewClusteringsPostCreated().WithPayload(res)
+		},
+	)
+}
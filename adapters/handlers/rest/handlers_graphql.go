@@ -17,12 +17,16 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	middleware "github.com/go-openapi/runtime/middleware"
 	libgraphql "github.com/semi-technologies/weaviate/adapters/handlers/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/graphql"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/readview"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
 )
 
 const error422 string = "The request is well-formed but was unable to be followed due to semantic errors."
@@ -36,7 +40,14 @@ type graphQLProvider interface {
 	GetGraphQL() libgraphql.GraphQL
 }
 
-func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider) {
+// readViewPinner pins the data of the classes a query selects, so a query
+// with a consistent snapshot reads all of them as of the same point in time
+type readViewPinner interface {
+	PinReadViews(ctx context.Context, classes []string) error
+}
+
+func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider,
+	readViews readViewPinner, logger logrus.FieldLogger) {
 	api.GraphqlGraphqlPostHandler = graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 		errorResponse := &models.ErrorResponse{}
 
@@ -74,6 +85,16 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 		ctx := params.HTTPRequest.Context()
 		ctx = context.WithValue(ctx, "principal", principal)
 
+		if params.Body.ConsistentSnapshot {
+			snapshotCtx, release, err := withReadViews(ctx, readViews, logger, query)
+			if err != nil {
+				return graphql.NewGraphqlPostInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			defer release()
+			ctx = snapshotCtx
+		}
+
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
 
@@ -130,7 +151,7 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 		// Generate a goroutine for each separate request
 		for requestIndex, unbatchedRequest := range params.Body {
 			wg.Add(1)
-			go handleUnbatchedGraphQLRequest(ctx, wg, graphQL, unbatchedRequest, requestIndex, &requestResults)
+			go handleUnbatchedGraphQLRequest(ctx, wg, graphQL, readViews, logger, unbatchedRequest, requestIndex, &requestResults)
 		}
 
 		wg.Wait()
@@ -148,8 +169,65 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 	})
 }
 
+// setupGraphQLSandboxHandlers serves queries with the hard caps of the query
+// sandbox. Rather than failing, a query which hits a cap returns its partial
+// results together with the caps it hit.
+func setupGraphQLSandboxHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider,
+	cfg config.QuerySandbox) {
+	api.GraphqlGraphqlSandboxHandler = graphql.GraphqlSandboxHandlerFunc(func(params graphql.GraphqlSandboxParams, principal *models.Principal) middleware.Responder {
+		if !cfg.Enabled {
+			return graphql.NewGraphqlSandboxUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("query sandbox is disabled")))
+		}
+
+		query := params.Body.Query
+		if query == "" {
+			return graphql.NewGraphqlSandboxUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("query cannot be empty")))
+		}
+
+		var variables map[string]interface{}
+		if params.Body.Variables != nil {
+			variables = params.Body.Variables.(map[string]interface{})
+		}
+
+		graphQL := gqlProvider.GetGraphQL()
+		if graphQL == nil {
+			return graphql.NewGraphqlSandboxUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("no graphql provider present, " +
+					"this is most likely because no schema is present. Import a schema first!")))
+		}
+
+		ctx := params.HTTPRequest.Context()
+		ctx = context.WithValue(ctx, "principal", principal)
+
+		caps := libgraphql.SandboxCaps{
+			Timeout:        time.Duration(cfg.TimeoutMs) * time.Millisecond,
+			MaxMemoryBytes: uint64(cfg.MaxMemoryMB) * 1024 * 1024,
+			MaxResultBytes: cfg.MaxResultBytes,
+		}
+		result := libgraphql.ResolveSandboxed(ctx, graphQL, caps, query,
+			params.Body.OperationName, variables)
+
+		resultJSON, err := json.Marshal(result.Result)
+		if err != nil {
+			return graphql.NewGraphqlSandboxUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("couldn't marshal json: %s", err)))
+		}
+
+		response := &models.GraphQLSandboxResponse{}
+		if err := json.Unmarshal(resultJSON, response); err != nil {
+			return graphql.NewGraphqlSandboxUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("couldn't unmarshal json: %s", err)))
+		}
+		response.CapsHit = result.CapsHit
+
+		return graphql.NewGraphqlSandboxOK().WithPayload(response)
+	})
+}
+
 // Handle a single unbatched GraphQL request, return a tuple containing the index of the request in the batch and either the response or an error
-func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse) {
+func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, readViews readViewPinner, logger logrus.FieldLogger, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse) {
 	defer wg.Done()
 
 	// Get all input from the body of the request
@@ -175,6 +253,21 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 			variables = unbatchedRequest.Variables.(map[string]interface{})
 		}
 
+		// every query of the batch has its own snapshot
+		if unbatchedRequest.ConsistentSnapshot {
+			snapshotCtx, release, err := withReadViews(ctx, readViews, logger, query)
+			if err != nil {
+				errors := []*models.GraphQLError{{Message: err.Error()}}
+				*requestResults <- gqlUnbatchedRequestResponse{
+					requestIndex,
+					&models.GraphQLResponse{Data: nil, Errors: errors},
+				}
+				return
+			}
+			defer release()
+			ctx = snapshotCtx
+		}
+
 		result := graphQL.Resolve(ctx, query, operationName, variables)
 
 		// Marshal the JSON
@@ -216,3 +309,34 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 		}
 	}
 }
+
+// withReadViews makes the query read from a consistent snapshot. The classes
+// selected in Get and Aggregate are pinned right away, classes which are only
+// reached through references are pinned when they are first read. The
+// returned func releases the snapshot once the query has been resolved.
+func withReadViews(ctx context.Context, readViews readViewPinner,
+	logger logrus.FieldLogger, query string) (context.Context, func(), error) {
+	classes, err := libgraphql.QueriedClasses(query)
+	if err != nil {
+		// there is nothing to read from an invalid query, resolving it reports
+		// the error
+		return ctx, func() {}, nil
+	}
+
+	set := readview.NewSet()
+	release := func() {
+		if err := set.Release(); err != nil {
+			logger.WithField("action", "graphql_release_snapshot").
+				WithError(err).
+				Error("failed to release consistent snapshot")
+		}
+	}
+
+	ctx = readview.NewContext(ctx, set)
+	if err := readViews.PinReadViews(ctx, classes); err != nil {
+		release()
+		return nil, nil, fmt.Errorf("pin consistent snapshot: %v", err)
+	}
+
+	return ctx, release, nil
+}
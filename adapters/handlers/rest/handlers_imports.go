@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/imports"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	importsUC "github.com/semi-technologies/weaviate/usecases/imports"
+)
+
+func setupImportHandlers(api *operations.WeaviateAPI,
+	importer *importsUC.Importer) {
+	api.ImportsImportsGetHandler = imports.ImportsGetHandlerFunc(
+		func(params imports.ImportsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := importer.Get(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			if err != nil {
+				if isForbidden(err) {
+					return imports.NewImportsGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+				}
+				return imports.NewImportsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			if res == nil {
+				return imports.NewImportsGetNotFound()
+			}
+
+			return imports.NewImportsGetOK().WithPayload(res)
+		},
+	)
+
+	api.ImportsImportsPostHandler = imports.ImportsPostHandlerFunc(
+		func(params imports.ImportsPostParams, principal *models.Principal) middleware.Responder {
+			res, err := importer.Schedule(params.HTTPRequest.Context(), principal, *params.Params)
+			if err != nil {
+				switch {
+				case isForbidden(err):
+					return imports.NewImportsPostForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindValidation):
+					return imports.NewImportsPostBadRequest().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return imports.NewImportsPostInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return imports.NewImportsPostCreated().WithPayload(res)
+		},
+	)
+}
@@ -35,18 +35,26 @@ type swaggerJSON struct {
 	} `json:"info"`
 }
 
-func setupMiscHandlers(api *operations.WeaviateAPI, serverConfig *config.WeaviateConfig,
-	schemaManager schemaManager, modulesProvider ModulesProvider) {
+// serverVersion is the version of the API as specified in the swagger spec
+func serverVersion() string {
 	var swj swaggerJSON
 	err := json.Unmarshal(SwaggerJSON, &swj)
 	if err != nil {
 		panic(err)
 	}
 
+	return swj.Info.Version
+}
+
+func setupMiscHandlers(api *operations.WeaviateAPI, serverConfig *config.WeaviateConfig,
+	schemaManager schemaManager, modulesProvider ModulesProvider) {
+	version := serverVersion()
+
 	api.MetaMetaGetHandler = meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 		metaInfos := map[string]interface{}{}
 
 		if modulesProvider != nil {
+			var err error
 			metaInfos, err = modulesProvider.GetMeta()
 			if err != nil {
 				return meta.NewMetaGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
@@ -55,7 +63,7 @@ func setupMiscHandlers(api *operations.WeaviateAPI, serverConfig *config.Weaviat
 
 		res := &models.Meta{
 			Hostname: serverConfig.GetHostAddress(),
-			Version:  swj.Info.Version,
+			Version:  version,
 			Modules:  metaInfos,
 		}
 		return meta.NewMetaGetOK().WithPayload(res)
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/nodes"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	nodesUC "github.com/semi-technologies/weaviate/usecases/nodes"
+)
+
+func setupNodesHandlers(api *operations.WeaviateAPI, manager *nodesUC.Manager) {
+	api.NodesNodesGetHandler = nodes.NodesGetHandlerFunc(
+		func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.GetNodeStatus(params.HTTPRequest.Context(), principal)
+			if err != nil {
+				if isForbidden(err) {
+					return nodes.NewNodesGetForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+				return nodes.NewNodesGetInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return nodes.NewNodesGetOK().
+				WithPayload(&models.NodesStatusResponse{Nodes: res})
+		})
+	api.NodesNodesDrainHandler = nodes.NodesDrainHandlerFunc(
+		func(params nodes.NodesDrainParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.DrainNode(params.HTTPRequest.Context(), principal,
+				params.Name)
+			if err != nil {
+				switch {
+				case isForbidden(err):
+					return nodes.NewNodesDrainForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				case errortypes.Is(err, errortypes.KindNotFound):
+					return nodes.NewNodesDrainNotFound()
+				case errortypes.Is(err, errortypes.KindValidation),
+					errortypes.Is(err, errortypes.KindConflict):
+					return nodes.NewNodesDrainUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return nodes.NewNodesDrainInternalServerError().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return nodes.NewNodesDrainOK().WithPayload(res)
+		})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	usecasesObjects "github.com/semi-technologies/weaviate/usecases/objects"
@@ -47,6 +48,13 @@ type objectsManager interface {
 	ValidateObject(context.Context, *models.Principal, *models.Object) error
 	GetObject(context.Context, *models.Principal, strfmt.UUID, additional.Properties) (*models.Object, error)
 	GetObjects(context.Context, *models.Principal, *int64, *int64, additional.Properties) ([]*models.Object, error)
+	GetObjectsAfter(context.Context, *models.Principal, string, *string, *int64, additional.Properties) ([]*models.Object, error)
+	GetObjectsByKeywords(context.Context, *models.Principal, string, string, *int64, *int64, additional.Properties) ([]*models.Object, error)
+	ScrollObjects(context.Context, *models.Principal, *string, *string, string, *int64, additional.Properties) ([]*models.Object, string, error)
+	FindDuplicates(context.Context, *models.Principal, string, float32, int64) (*models.DuplicatesResponse, error)
+	FacetedSearch(context.Context, *models.Principal, *models.FacetedSearchRequest) (*models.FacetedSearchResponse, error)
+	EstimateFilter(context.Context, *models.Principal, *models.FilterEstimateRequest) (*models.FilterEstimateResponse, error)
+	GetContinuousAggregate(context.Context, *models.Principal, string, string, string) (*models.ContinuousAggregateResponse, error)
 	UpdateObject(context.Context, *models.Principal, strfmt.UUID, *models.Object) (*models.Object, error)
 	MergeObject(context.Context, *models.Principal, strfmt.UUID, *models.Object) error
 	DeleteObject(context.Context, *models.Principal, strfmt.UUID) error
@@ -68,8 +76,7 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 			return objects.NewObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsCreateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -93,8 +100,7 @@ func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 			return objects.NewObjectsValidateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsValidateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -104,6 +110,7 @@ func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 func (h *objectHandlers) getObject(params objects.ObjectsGetParams,
 	principal *models.Principal) middleware.Responder {
 	var additional additional.Properties
+	ctx := tenantContext(params.HTTPRequest.Context(), params.Tenant)
 
 	// The process to extract additional params depends on knowing the schema
 	// which in turn requires a preflight load of the object. We can save this
@@ -112,7 +119,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsGetParams,
 	// non-module specific params are contained and decide then, but we do not
 	// know if this path is critical enough for this level of optimization.
 	if params.Include != nil {
-		class, err := h.manager.GetObjectsClass(params.HTTPRequest.Context(), principal, params.ID)
+		class, err := h.manager.GetObjectsClass(ctx, principal, params.ID)
 		if err != nil {
 			return objects.NewObjectsGetBadRequest().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -125,7 +132,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsGetParams,
 		}
 	}
 
-	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal, params.ID, additional)
+	object, err := h.manager.GetObject(ctx, principal, params.ID, additional)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -134,8 +141,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsGetParams,
 		case usecasesObjects.ErrNotFound:
 			return objects.NewObjectsGetNotFound()
 		default:
-			return objects.NewObjectsGetInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -147,6 +153,16 @@ func (h *objectHandlers) getObject(params objects.ObjectsGetParams,
 	return objects.NewObjectsGetOK().WithPayload(object)
 }
 
+// tenantContext scopes the request to the tenant of the optional query
+// parameter
+func tenantContext(ctx context.Context, name *string) context.Context {
+	if name == nil {
+		return ctx
+	}
+
+	return tenant.NewContext(ctx, *name)
+}
+
 func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 	principal *models.Principal) middleware.Responder {
 	additional, err := parseIncludeParam(params.Include, h.modulesProvider, h.shouldIncludeGetObjectsModuleParams(), nil)
@@ -157,15 +173,24 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 
 	var deprecationsRes []*models.Deprecation
 
-	list, err := h.manager.GetObjects(params.HTTPRequest.Context(), principal, params.Offset, params.Limit, additional)
+	var list []*models.Object
+	var scrollID string
+	if params.Bm25 != nil {
+		list, err = h.listObjectsByKeywords(params, principal, additional)
+	} else if params.Scroll != nil || params.ScrollID != nil {
+		list, scrollID, err = h.scrollObjects(params, principal, additional)
+	} else if params.Class != nil || params.After != nil {
+		list, err = h.listObjectsAfter(params, principal, additional)
+	} else {
+		list, err = h.manager.GetObjects(params.HTTPRequest.Context(), principal, params.Offset, params.Limit, additional)
+	}
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return objects.NewObjectsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsListInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -180,10 +205,155 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 		WithPayload(&models.ObjectsListResponse{
 			Objects:      list,
 			TotalResults: int64(len(list)),
+			ScrollID:     scrollID,
 			Deprecations: deprecationsRes,
 		})
 }
 
+// listObjectsAfter lists a single class in the order of the object ids, which
+// lets clients iterate over the entire class using the after cursor
+func (h *objectHandlers) listObjectsAfter(params objects.ObjectsListParams,
+	principal *models.Principal, additional additional.Properties) ([]*models.Object, error) {
+	if params.Class == nil {
+		return nil, usecasesObjects.NewErrInvalidUserInput("after requires class to be set")
+	}
+
+	if params.Offset != nil && *params.Offset != 0 {
+		return nil, usecasesObjects.NewErrInvalidUserInput(
+			"offset can not be combined with class or after, use after to paginate")
+	}
+
+	return h.manager.GetObjectsAfter(params.HTTPRequest.Context(), principal,
+		*params.Class, params.After, params.Limit, additional)
+}
+
+// scrollObjects returns the next page of a scroll, which iterates over a
+// snapshot of a single class
+// listObjectsByKeywords ranks a single class by the bm25 keywords, it is the
+// REST equivalent of a Get query with only the bm25 argument
+func (h *objectHandlers) listObjectsByKeywords(params objects.ObjectsListParams,
+	principal *models.Principal, additional additional.Properties) ([]*models.Object, error) {
+	if params.Class == nil {
+		return nil, usecasesObjects.NewErrInvalidUserInput("bm25 requires class to be set")
+	}
+
+	if params.After != nil || params.Scroll != nil || params.ScrollID != nil {
+		return nil, usecasesObjects.NewErrInvalidUserInput(
+			"bm25 can not be combined with after or scroll, use offset to paginate")
+	}
+
+	return h.manager.GetObjectsByKeywords(params.HTTPRequest.Context(), principal,
+		*params.Class, *params.Bm25, params.Offset, params.Limit, additional)
+}
+
+func (h *objectHandlers) scrollObjects(params objects.ObjectsListParams,
+	principal *models.Principal, additional additional.Properties) ([]*models.Object, string, error) {
+	if params.Scroll == nil {
+		return nil, "", usecasesObjects.NewErrInvalidUserInput("scrollId requires scroll to be set")
+	}
+
+	if params.After != nil || (params.Offset != nil && *params.Offset != 0) {
+		return nil, "", usecasesObjects.NewErrInvalidUserInput(
+			"scroll can not be combined with offset or after, use scrollId to paginate")
+	}
+
+	return h.manager.ScrollObjects(params.HTTPRequest.Context(), principal,
+		params.Class, params.ScrollID, *params.Scroll, params.Limit, additional)
+}
+
+func (h *objectHandlers) findDuplicates(params objects.ObjectsDuplicatesParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.FindDuplicates(params.HTTPRequest.Context(), principal,
+		params.Class, *params.Distance, *params.Limit)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return objects.NewObjectsDuplicatesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case usecasesObjects.ErrNotFound:
+			return objects.NewObjectsDuplicatesNotFound()
+		case usecasesObjects.ErrInvalidUserInput:
+			return objects.NewObjectsDuplicatesUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return errResponder(err)
+		}
+	}
+
+	return objects.NewObjectsDuplicatesOK().WithPayload(res)
+}
+
+func (h *objectHandlers) getContinuousAggregate(params objects.ObjectsAggregatesParams,
+	principal *models.Principal) middleware.Responder {
+	var tenantName string
+	if params.Tenant != nil {
+		tenantName = *params.Tenant
+	}
+
+	res, err := h.manager.GetContinuousAggregate(params.HTTPRequest.Context(), principal,
+		params.Class, params.Name, tenantName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return objects.NewObjectsAggregatesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case usecasesObjects.ErrNotFound:
+			return objects.NewObjectsAggregatesNotFound()
+		case usecasesObjects.ErrInvalidUserInput:
+			return objects.NewObjectsAggregatesUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return errResponder(err)
+		}
+	}
+
+	return objects.NewObjectsAggregatesOK().WithPayload(res)
+}
+
+func (h *objectHandlers) facetedSearch(params objects.ObjectsFacetsParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.FacetedSearch(params.HTTPRequest.Context(), principal,
+		params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return objects.NewObjectsFacetsForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case usecasesObjects.ErrNotFound:
+			return objects.NewObjectsFacetsNotFound()
+		case usecasesObjects.ErrInvalidUserInput:
+			return objects.NewObjectsFacetsUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return errResponder(err)
+		}
+	}
+
+	return objects.NewObjectsFacetsOK().WithPayload(res)
+}
+
+func (h *objectHandlers) estimateFilter(params objects.ObjectsEstimateParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.EstimateFilter(params.HTTPRequest.Context(), principal,
+		params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return objects.NewObjectsEstimateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case usecasesObjects.ErrNotFound:
+			return objects.NewObjectsEstimateNotFound()
+		case usecasesObjects.ErrInvalidUserInput:
+			return objects.NewObjectsEstimateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return errResponder(err)
+		}
+	}
+
+	return objects.NewObjectsEstimateOK().WithPayload(res)
+}
+
 func (h *objectHandlers) updateObject(params objects.ObjectsUpdateParams,
 	principal *models.Principal) middleware.Responder {
 	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(), principal, params.ID, params.Body)
@@ -196,8 +366,7 @@ func (h *objectHandlers) updateObject(params objects.ObjectsUpdateParams,
 			return objects.NewObjectsUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsUpdateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -211,7 +380,8 @@ func (h *objectHandlers) updateObject(params objects.ObjectsUpdateParams,
 
 func (h *objectHandlers) deleteObject(params objects.ObjectsDeleteParams,
 	principal *models.Principal) middleware.Responder {
-	err := h.manager.DeleteObject(params.HTTPRequest.Context(), principal, params.ID)
+	ctx := tenantContext(params.HTTPRequest.Context(), params.Tenant)
+	err := h.manager.DeleteObject(ctx, principal, params.ID)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -220,8 +390,7 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsDeleteParams,
 		case usecasesObjects.ErrNotFound:
 			return objects.NewObjectsDeleteNotFound()
 		default:
-			return objects.NewObjectsDeleteInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -239,8 +408,7 @@ func (h *objectHandlers) patchObject(params objects.ObjectsPatchParams, principa
 			return objects.NewObjectsUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsUpdateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -259,8 +427,7 @@ func (h *objectHandlers) addObjectReference(params objects.ObjectsReferencesCrea
 			return objects.NewObjectsReferencesCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsReferencesCreateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -279,8 +446,7 @@ func (h *objectHandlers) updateObjectReferences(params objects.ObjectsReferences
 			return objects.NewObjectsReferencesUpdateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsReferencesUpdateInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -299,8 +465,7 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsReferencesD
 			return objects.NewObjectsReferencesDeleteNotFound().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
-			return objects.NewObjectsReferencesDeleteInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+			return errResponder(err)
 		}
 	}
 
@@ -322,6 +487,14 @@ func setupKindHandlers(api *operations.WeaviateAPI,
 		ObjectsDeleteHandlerFunc(h.deleteObject)
 	api.ObjectsObjectsListHandler = objects.
 		ObjectsListHandlerFunc(h.getObjects)
+	api.ObjectsObjectsDuplicatesHandler = objects.
+		ObjectsDuplicatesHandlerFunc(h.findDuplicates)
+	api.ObjectsObjectsAggregatesHandler = objects.
+		ObjectsAggregatesHandlerFunc(h.getContinuousAggregate)
+	api.ObjectsObjectsFacetsHandler = objects.
+		ObjectsFacetsHandlerFunc(h.facetedSearch)
+	api.ObjectsObjectsEstimateHandler = objects.
+		ObjectsEstimateHandlerFunc(h.estimateFilter)
 	api.ObjectsObjectsUpdateHandler = objects.
 		ObjectsUpdateHandlerFunc(h.updateObject)
 	api.ObjectsObjectsPatchHandler = objects.
@@ -395,6 +568,10 @@ func parseIncludeParam(in *string, modulesProvider ModulesProvider, includeModul
 			out.Vector = true
 			continue
 		}
+		if prop == "score" {
+			out.Score = true
+			continue
+		}
 		if includeModuleParams && modulesProvider != nil {
 			moduleParams := modulesProvider.RestApiAdditionalProperties(prop, class)
 			if len(moduleParams) > 0 {
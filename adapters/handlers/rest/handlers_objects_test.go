@@ -682,6 +682,34 @@ func (f *fakeManager) GetObjects(_ context.Context, _ *models.Principal, _ *int6
 	return f.getObjectsReturn, nil
 }
 
+func (f *fakeManager) GetObjectsAfter(_ context.Context, _ *models.Principal, _ string, _ *string, _ *int64, _ additional.Properties) ([]*models.Object, error) {
+	return f.getObjectsReturn, nil
+}
+
+func (f *fakeManager) GetObjectsByKeywords(_ context.Context, _ *models.Principal, _ string, _ string, _ *int64, _ *int64, _ additional.Properties) ([]*models.Object, error) {
+	return f.getObjectsReturn, nil
+}
+
+func (f *fakeManager) FindDuplicates(_ context.Context, _ *models.Principal, className string, distance float32, _ int64) (*models.DuplicatesResponse, error) {
+	return &models.DuplicatesResponse{Class: className, Distance: distance}, nil
+}
+
+func (f *fakeManager) FacetedSearch(_ context.Context, _ *models.Principal, _ *models.FacetedSearchRequest) (*models.FacetedSearchResponse, error) {
+	return &models.FacetedSearchResponse{}, nil
+}
+
+func (f *fakeManager) EstimateFilter(_ context.Context, _ *models.Principal, _ *models.FilterEstimateRequest) (*models.FilterEstimateResponse, error) {
+	return &models.FilterEstimateResponse{}, nil
+}
+
+func (f *fakeManager) GetContinuousAggregate(_ context.Context, _ *models.Principal, _, _, _ string) (*models.ContinuousAggregateResponse, error) {
+	return &models.ContinuousAggregateResponse{}, nil
+}
+
+func (f *fakeManager) ScrollObjects(_ context.Context, _ *models.Principal, _ *string, _ *string, _ string, _ *int64, _ additional.Properties) ([]*models.Object, string, error) {
+	return f.getObjectsReturn, "", nil
+}
+
 func (f *fakeManager) UpdateObject(_ context.Context, _ *models.Principal, _ strfmt.UUID, object *models.Object) (*models.Object, error) {
 	return object, nil
 }
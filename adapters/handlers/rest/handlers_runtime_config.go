@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/modules"
+	"github.com/semi-technologies/weaviate/usecases/runtimeconfig"
+)
+
+func setupRuntimeConfigHandlers(api *operations.WeaviateAPI,
+	manager *runtimeconfig.Manager) {
+	api.MetaRuntimeConfigUpdateHandler = meta.RuntimeConfigUpdateHandlerFunc(
+		func(params meta.RuntimeConfigUpdateParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Update(principal, params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return meta.NewRuntimeConfigUpdateForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+
+				if errortypes.Is(err, errortypes.KindValidation) {
+					return meta.NewRuntimeConfigUpdateUnprocessableEntity().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+
+				return meta.NewRuntimeConfigUpdateInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return meta.NewRuntimeConfigUpdateOK().WithPayload(res)
+		})
+}
+
+// reloadConfigOnSIGHUP re-reads the config file and the environment whenever
+// the process receives SIGHUP and applies the runtime settings. If the config
+// is invalid, the error is logged and the settings in effect are kept.
+func reloadConfigOnSIGHUP(appState *state.State, manager *runtimeconfig.Manager) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	go func() {
+		for range sighup {
+			logger := appState.Logger.WithField("action", "config_reload")
+
+			reloaded := &config.WeaviateConfig{}
+			if err := reloaded.LoadConfig(connectorOptionGroup, logger); err != nil {
+				logger.WithError(err).Error("could not reload config")
+				continue
+			}
+
+			if err := manager.Reload(reloaded.Config); err != nil {
+				logger.WithError(err).Error("could not apply runtime config")
+				continue
+			}
+
+			logger.Info("reloaded runtime config")
+		}
+	}()
+}
+
+// runtimeInferenceQueue returns nil if the inference queue is disabled, so
+// that the runtime config does not try to resize it
+func runtimeInferenceQueue(queue *modules.InferenceQueue) interface{ SetSlots(int) } {
+	if queue == nil {
+		return nil
+	}
+
+	return queue
+}
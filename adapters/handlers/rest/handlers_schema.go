@@ -18,6 +18,7 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/models"
 )
 
@@ -134,6 +135,167 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	return schema.NewSchemaDumpOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.AddTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewTenantsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case isInvalidTenantRequest(err):
+			return schema.NewTenantsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsCreateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) updateTenants(params schema.TenantsUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.UpdateTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewTenantsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case isInvalidTenantRequest(err):
+			return schema.NewTenantsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsUpdateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) deleteTenants(params schema.TenantsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.DeleteTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Tenants)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewTenantsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case isInvalidTenantRequest(err):
+			return schema.NewTenantsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsDeleteOK()
+}
+
+func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
+	principal *models.Principal) middleware.Responder {
+	tenants, err := s.manager.GetTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewTenantsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case isInvalidTenantRequest(err):
+			return schema.NewTenantsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsGetOK().WithPayload(tenants)
+}
+
+func (s *schemaHandlers) cleanupVectorIndex(params schema.SchemaShardsVectorIndexCleanupParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := s.manager.CleanupVectorIndex(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewSchemaShardsVectorIndexCleanupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errortypes.Is(err, errortypes.KindNotFound):
+			return schema.NewSchemaShardsVectorIndexCleanupNotFound()
+		case errortypes.Is(err, errortypes.KindValidation):
+			return schema.NewSchemaShardsVectorIndexCleanupUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaShardsVectorIndexCleanupInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaShardsVectorIndexCleanupOK().WithPayload(res)
+}
+
+// isInvalidTenantRequest covers requests for classes which do not exist or
+// do not have multi-tenancy enabled as well as invalid tenants
+func isInvalidTenantRequest(err error) bool {
+	return errortypes.Is(err, errortypes.KindNotFound) ||
+		errortypes.Is(err, errortypes.KindValidation) ||
+		errortypes.Is(err, errortypes.KindConflict)
+}
+
+func (s *schemaHandlers) getClassStatus(params schema.SchemaObjectsStatusParams,
+	principal *models.Principal) middleware.Responder {
+	status, err := s.manager.GetClassStatus(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewSchemaObjectsStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errortypes.Is(err, errortypes.KindNotFound):
+			return schema.NewSchemaObjectsStatusNotFound()
+		default:
+			return schema.NewSchemaObjectsStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsStatusOK().WithPayload(status)
+}
+
+func (s *schemaHandlers) startVectorIndexAdvisor(params schema.SchemaObjectsStatusVectorIndexAdviceParams,
+	principal *models.Principal) middleware.Responder {
+	status, err := s.manager.StartVectorIndexAdvisor(params.HTTPRequest.Context(),
+		principal, params.ClassName, params.Params)
+	if err != nil {
+		switch {
+		case isForbidden(err):
+			return schema.NewSchemaObjectsStatusVectorIndexAdviceForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errortypes.Is(err, errortypes.KindNotFound):
+			return schema.NewSchemaObjectsStatusVectorIndexAdviceNotFound()
+		case errortypes.Is(err, errortypes.KindConflict):
+			return schema.NewSchemaObjectsStatusVectorIndexAdviceConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errortypes.Is(err, errortypes.KindValidation):
+			return schema.NewSchemaObjectsStatusVectorIndexAdviceUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsStatusVectorIndexAdviceInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsStatusVectorIndexAdviceAccepted().WithPayload(status)
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
 	h := &schemaHandlers{manager}
 
@@ -151,4 +313,21 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsGetHandlerFunc(h.getClass)
 	api.SchemaSchemaDumpHandler = schema.
 		SchemaDumpHandlerFunc(h.getSchema)
+
+	api.SchemaTenantsCreateHandler = schema.
+		TenantsCreateHandlerFunc(h.createTenants)
+	api.SchemaTenantsUpdateHandler = schema.
+		TenantsUpdateHandlerFunc(h.updateTenants)
+	api.SchemaTenantsDeleteHandler = schema.
+		TenantsDeleteHandlerFunc(h.deleteTenants)
+	api.SchemaTenantsGetHandler = schema.
+		TenantsGetHandlerFunc(h.getTenants)
+
+	api.SchemaSchemaShardsVectorIndexCleanupHandler = schema.
+		SchemaShardsVectorIndexCleanupHandlerFunc(h.cleanupVectorIndex)
+
+	api.SchemaSchemaObjectsStatusHandler = schema.
+		SchemaObjectsStatusHandlerFunc(h.getClassStatus)
+	api.SchemaSchemaObjectsStatusVectorIndexAdviceHandler = schema.
+		SchemaObjectsStatusVectorIndexAdviceHandlerFunc(h.startVectorIndexAdvisor)
 }
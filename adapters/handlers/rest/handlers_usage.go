@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/usage"
+	"github.com/semi-technologies/weaviate/entities/models"
+	usageUC "github.com/semi-technologies/weaviate/usecases/usage"
+)
+
+func setupUsageHandlers(api *operations.WeaviateAPI, manager *usageUC.Manager) {
+	api.UsageUsageGetHandler = usage.UsageGetHandlerFunc(
+		func(params usage.UsageGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.GetUsage(params.HTTPRequest.Context(), principal)
+			if err != nil {
+				if isForbidden(err) {
+					return usage.NewUsageGetForbidden().
+						WithPayload(errPayloadFromSingleErr(err))
+				}
+				return usage.NewUsageGetInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return usage.NewUsageGetOK().WithPayload(res)
+		})
+}
@@ -12,9 +12,15 @@
 package rest
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/models"
+	usecasesObjects "github.com/semi-technologies/weaviate/usecases/objects"
 )
 
 // createErrorResponseObject is a common function to create an error response
@@ -33,7 +39,42 @@ func createErrorResponseObject(messages ...string) *models.ErrorResponse {
 }
 
 func errPayloadFromSingleErr(err error) *models.ErrorResponse {
+	var violations usecasesObjects.ErrRuleViolations
+	if errors.As(err, &violations) {
+		return errPayloadFromRuleViolations(violations)
+	}
+
 	return &models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{
 		Message: fmt.Sprintf("%s", err),
 	}}}
 }
+
+// errPayloadFromRuleViolations has an item for every violated rule, so
+// clients can tell which property violates which rule
+func errPayloadFromRuleViolations(err usecasesObjects.ErrRuleViolations) *models.ErrorResponse {
+	er := &models.ErrorResponse{}
+	msgs := err.Messages()
+	for i, v := range err.Violations {
+		er.Error = append(er.Error, &models.ErrorResponseErrorItems0{
+			Message:  msgs[i],
+			Module:   v.Module,
+			Property: v.Property,
+			Rule:     v.Rule,
+		})
+	}
+
+	return er
+}
+
+// errResponder responds with the status code matching the kind of err, see
+// errortypes.HTTPStatus. It covers errors that have no dedicated response in
+// the spec, such as conflicts or an overloaded node, and falls back to 500 for
+// errors of unknown kind.
+func errResponder(err error) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		rw.WriteHeader(errortypes.HTTPStatus(err))
+		if err := p.Produce(rw, errPayloadFromSingleErr(err)); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	})
+}
@@ -12,6 +12,7 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -88,7 +89,7 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		handler = swagger_middleware.AddMiddleware([]byte(SwaggerJSON), handler)
 		handler = makeAddLogging(appState.Logger)(handler)
 		handler = addPreflight(handler)
-		handler = addLiveAndReadyness(handler)
+		handler = makeAddLiveAndReadyness(appState.Modules)(handler)
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
 
@@ -122,18 +123,43 @@ func addPreflight(next http.Handler) http.Handler {
 	})
 }
 
-func addLiveAndReadyness(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.String() == "/v1/.well-known/live" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+type readinessProvider interface {
+	Readiness() []modules.DependencyStatus
+}
 
-		if r.URL.String() == "/v1/.well-known/ready" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+// makeAddLiveAndReadyness reports the node as not ready as long as any module
+// dependency is still blocking. The blocking dependencies are listed in the
+// response body, so an operator can see which container to look at.
+func makeAddLiveAndReadyness(readiness readinessProvider) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.String() == "/v1/.well-known/live" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
 
-		next.ServeHTTP(w, r)
-	})
+			if r.URL.String() == "/v1/.well-known/ready" {
+				var blocking []modules.DependencyStatus
+				for _, dep := range readiness.Readiness() {
+					if !dep.Ready {
+						blocking = append(blocking, dep)
+					}
+				}
+
+				if len(blocking) > 0 {
+					w.Header().Set("content-type", "application/json")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"blocking": blocking,
+					})
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
 }
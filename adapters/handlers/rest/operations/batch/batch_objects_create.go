@@ -96,6 +96,9 @@ func (o *BatchObjectsCreate) ServeHTTP(rw http.ResponseWriter, r *http.Request)
 // swagger:model BatchObjectsCreateBody
 type BatchObjectsCreateBody struct {
 
+	// If true, every shard imports its part of the batch all-or-nothing. The sub-batch is journaled before it is applied, so that a crash can never leave only some of its objects imported. Defaults to false.
+	AtomicPerShard bool `yaml:"atomicPerShard,omitempty" json:"atomicPerShard,omitempty"`
+
 	// Define which fields need to be returned. Default value is ALL
 	Fields []*string `yaml:"fields" json:"fields"`
 
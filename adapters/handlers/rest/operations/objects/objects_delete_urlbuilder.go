@@ -29,6 +29,8 @@ import (
 type ObjectsDeleteURL struct {
 	ID strfmt.UUID
 
+	Tenant *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -68,6 +70,18 @@ func (o *ObjectsDeleteURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
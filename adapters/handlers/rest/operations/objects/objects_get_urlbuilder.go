@@ -30,6 +30,7 @@ type ObjectsGetURL struct {
 	ID strfmt.UUID
 
 	Include *string
+	Tenant  *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -80,6 +81,14 @@ func (o *ObjectsGetURL) Build() (*url.URL, error) {
 		qs.Set("include", includeQ)
 	}
 
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
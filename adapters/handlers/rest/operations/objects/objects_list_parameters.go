@@ -50,6 +50,18 @@ type ObjectsListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*A cursor to iterate over all objects of a class. The results are ordered by id and start right after the object with this id. Requires class to be set and can not be combined with offset.
+	  In: query
+	*/
+	After *string
+	/*Keywords to rank the objects of a class by their BM25 relevance in all indexed text and string properties, without involving any vector. Requires class to be set and can not be combined with after or scroll.
+	  In: query
+	*/
+	Bm25 *string
+	/*The class to list the objects of. If set the objects are ordered by id, use after to continue after the last object of the previous page.
+	  In: query
+	*/
+	Class *string
 	/*Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation
 	  In: query
 	*/
@@ -63,6 +75,14 @@ type ObjectsListParams struct {
 	  Default: 0
 	*/
 	Offset *int64
+	/*Open or continue a scroll, which iterates over a snapshot of all objects of a class. Objects written while the scroll is open are neither skipped nor returned twice. The value is the duration to keep the scroll open until the next page is requested, e.g. 1m, at most 1h. A new scroll requires class to be set and can not be combined with offset or after.
+	  In: query
+	*/
+	Scroll *string
+	/*The scrollId returned with the previous page of a scroll. Requires scroll to be set.
+	  In: query
+	*/
+	ScrollID *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -76,6 +96,21 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAfter, qhkAfter, _ := qs.GetOK("after")
+	if err := o.bindAfter(qAfter, qhkAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qBm25, qhkBm25, _ := qs.GetOK("bm25")
+	if err := o.bindBm25(qBm25, qhkBm25, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qClass, qhkClass, _ := qs.GetOK("class")
+	if err := o.bindClass(qClass, qhkClass, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qInclude, qhkInclude, _ := qs.GetOK("include")
 	if err := o.bindInclude(qInclude, qhkInclude, route.Formats); err != nil {
 		res = append(res, err)
@@ -91,12 +126,76 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qScroll, qhkScroll, _ := qs.GetOK("scroll")
+	if err := o.bindScroll(qScroll, qhkScroll, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qScrollID, qhkScrollID, _ := qs.GetOK("scrollId")
+	if err := o.bindScrollID(qScrollID, qhkScrollID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAfter binds and validates parameter After from query.
+func (o *ObjectsListParams) bindAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.After = &raw
+
+	return nil
+}
+
+// bindBm25 binds and validates parameter Bm25 from query.
+func (o *ObjectsListParams) bindBm25(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Bm25 = &raw
+
+	return nil
+}
+
+// bindClass binds and validates parameter Class from query.
+func (o *ObjectsListParams) bindClass(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Class = &raw
+
+	return nil
+}
+
 // bindInclude binds and validates parameter Include from query.
 func (o *ObjectsListParams) bindInclude(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -159,3 +258,39 @@ func (o *ObjectsListParams) bindOffset(rawData []string, hasKey bool, formats st
 
 	return nil
 }
+
+// bindScroll binds and validates parameter Scroll from query.
+func (o *ObjectsListParams) bindScroll(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Scroll = &raw
+
+	return nil
+}
+
+// bindScrollID binds and validates parameter ScrollID from query.
+func (o *ObjectsListParams) bindScrollID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ScrollID = &raw
+
+	return nil
+}
@@ -26,9 +26,14 @@ import (
 
 // ObjectsListURL generates an URL for the objects list operation
 type ObjectsListURL struct {
-	Include *string
-	Limit   *int64
-	Offset  *int64
+	After    *string
+	Bm25     *string
+	Class    *string
+	Include  *string
+	Limit    *int64
+	Offset   *int64
+	Scroll   *string
+	ScrollID *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -64,6 +69,30 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var afterQ string
+	if o.After != nil {
+		afterQ = *o.After
+	}
+	if afterQ != "" {
+		qs.Set("after", afterQ)
+	}
+
+	var bm25Q string
+	if o.Bm25 != nil {
+		bm25Q = *o.Bm25
+	}
+	if bm25Q != "" {
+		qs.Set("bm25", bm25Q)
+	}
+
+	var classQ string
+	if o.Class != nil {
+		classQ = *o.Class
+	}
+	if classQ != "" {
+		qs.Set("class", classQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -88,6 +117,22 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("offset", offsetQ)
 	}
 
+	var scrollQ string
+	if o.Scroll != nil {
+		scrollQ = *o.Scroll
+	}
+	if scrollQ != "" {
+		qs.Set("scroll", scrollQ)
+	}
+
+	var scrollIDQ string
+	if o.ScrollID != nil {
+		scrollIDQ = *o.ScrollID
+	}
+	if scrollIDQ != "" {
+		qs.Set("scrollId", scrollIDQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
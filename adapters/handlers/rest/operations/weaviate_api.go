@@ -31,12 +31,17 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/classifications"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/clusterings"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/imports"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/objects"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/usage"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/well_known"
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -66,9 +71,24 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
+		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
+		}),
+		BackupsBackupsCreateStatusHandler: backups.BackupsCreateStatusHandlerFunc(func(params backups.BackupsCreateStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsCreateStatus has not yet been implemented")
+		}),
+		BackupsBackupsRestoreHandler: backups.BackupsRestoreHandlerFunc(func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsRestore has not yet been implemented")
+		}),
+		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
+		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
@@ -78,21 +98,57 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusteringsClusteringsGetHandler: clusterings.ClusteringsGetHandlerFunc(func(params clusterings.ClusteringsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation clusterings.ClusteringsGet has not yet been implemented")
+		}),
+		ClusteringsClusteringsPostHandler: clusterings.ClusteringsPostHandlerFunc(func(params clusterings.ClusteringsPostParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation clusterings.ClusteringsPost has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlPost has not yet been implemented")
 		}),
+		GraphqlGraphqlSandboxHandler: graphql.GraphqlSandboxHandlerFunc(func(params graphql.GraphqlSandboxParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlSandbox has not yet been implemented")
+		}),
+		ImportsImportsGetHandler: imports.ImportsGetHandlerFunc(func(params imports.ImportsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation imports.ImportsGet has not yet been implemented")
+		}),
+		ImportsImportsPostHandler: imports.ImportsPostHandlerFunc(func(params imports.ImportsPostParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation imports.ImportsPost has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		MetaRuntimeConfigUpdateHandler: meta.RuntimeConfigUpdateHandlerFunc(func(params meta.RuntimeConfigUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation meta.RuntimeConfigUpdate has not yet been implemented")
+		}),
+		NodesNodesDrainHandler: nodes.NodesDrainHandlerFunc(func(params nodes.NodesDrainParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrain has not yet been implemented")
+		}),
+		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
+		}),
+		ObjectsObjectsAggregatesHandler: objects.ObjectsAggregatesHandlerFunc(func(params objects.ObjectsAggregatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsAggregates has not yet been implemented")
+		}),
 		ObjectsObjectsCreateHandler: objects.ObjectsCreateHandlerFunc(func(params objects.ObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsCreate has not yet been implemented")
 		}),
 		ObjectsObjectsDeleteHandler: objects.ObjectsDeleteHandlerFunc(func(params objects.ObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsDelete has not yet been implemented")
 		}),
+		ObjectsObjectsDuplicatesHandler: objects.ObjectsDuplicatesHandlerFunc(func(params objects.ObjectsDuplicatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsDuplicates has not yet been implemented")
+		}),
+		ObjectsObjectsEstimateHandler: objects.ObjectsEstimateHandlerFunc(func(params objects.ObjectsEstimateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsEstimate has not yet been implemented")
+		}),
+		ObjectsObjectsFacetsHandler: objects.ObjectsFacetsHandlerFunc(func(params objects.ObjectsFacetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsFacets has not yet been implemented")
+		}),
 		ObjectsObjectsGetHandler: objects.ObjectsGetHandlerFunc(func(params objects.ObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsGet has not yet been implemented")
 		}),
@@ -132,9 +188,33 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsStatusHandler: schema.SchemaObjectsStatusHandlerFunc(func(params schema.SchemaObjectsStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStatus has not yet been implemented")
+		}),
+		SchemaSchemaObjectsStatusVectorIndexAdviceHandler: schema.SchemaObjectsStatusVectorIndexAdviceHandlerFunc(func(params schema.SchemaObjectsStatusVectorIndexAdviceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStatusVectorIndexAdvice has not yet been implemented")
+		}),
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaShardsVectorIndexCleanupHandler: schema.SchemaShardsVectorIndexCleanupHandlerFunc(func(params schema.SchemaShardsVectorIndexCleanupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaShardsVectorIndexCleanup has not yet been implemented")
+		}),
+		SchemaTenantsCreateHandler: schema.TenantsCreateHandlerFunc(func(params schema.TenantsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsCreate has not yet been implemented")
+		}),
+		SchemaTenantsDeleteHandler: schema.TenantsDeleteHandlerFunc(func(params schema.TenantsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsDelete has not yet been implemented")
+		}),
+		SchemaTenantsGetHandler: schema.TenantsGetHandlerFunc(func(params schema.TenantsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsGet has not yet been implemented")
+		}),
+		SchemaTenantsUpdateHandler: schema.TenantsUpdateHandlerFunc(func(params schema.TenantsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsUpdate has not yet been implemented")
+		}),
+		UsageUsageGetHandler: usage.UsageGetHandlerFunc(func(params usage.UsageGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation usage.UsageGet has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -195,24 +275,58 @@ type WeaviateAPI struct {
 
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
+	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
+	BackupsBackupsCreateHandler backups.BackupsCreateHandler
+	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
+	BackupsBackupsCreateStatusHandler backups.BackupsCreateStatusHandler
+	// BackupsBackupsRestoreHandler sets the operation handler for the backups restore operation
+	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
+	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
+	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
+	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
+	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusteringsClusteringsGetHandler sets the operation handler for the clusterings get operation
+	ClusteringsClusteringsGetHandler clusterings.ClusteringsGetHandler
+	// ClusteringsClusteringsPostHandler sets the operation handler for the clusterings post operation
+	ClusteringsClusteringsPostHandler clusterings.ClusteringsPostHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// GraphqlGraphqlSandboxHandler sets the operation handler for the graphql sandbox operation
+	GraphqlGraphqlSandboxHandler graphql.GraphqlSandboxHandler
+	// ImportsImportsGetHandler sets the operation handler for the imports get operation
+	ImportsImportsGetHandler imports.ImportsGetHandler
+	// ImportsImportsPostHandler sets the operation handler for the imports post operation
+	ImportsImportsPostHandler imports.ImportsPostHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// MetaRuntimeConfigUpdateHandler sets the operation handler for the runtime config update operation
+	MetaRuntimeConfigUpdateHandler meta.RuntimeConfigUpdateHandler
+	// NodesNodesDrainHandler sets the operation handler for the nodes drain operation
+	NodesNodesDrainHandler nodes.NodesDrainHandler
+	// NodesNodesGetHandler sets the operation handler for the nodes get operation
+	NodesNodesGetHandler nodes.NodesGetHandler
+	// ObjectsObjectsAggregatesHandler sets the operation handler for the objects aggregates operation
+	ObjectsObjectsAggregatesHandler objects.ObjectsAggregatesHandler
 	// ObjectsObjectsCreateHandler sets the operation handler for the objects create operation
 	ObjectsObjectsCreateHandler objects.ObjectsCreateHandler
 	// ObjectsObjectsDeleteHandler sets the operation handler for the objects delete operation
 	ObjectsObjectsDeleteHandler objects.ObjectsDeleteHandler
+	// ObjectsObjectsDuplicatesHandler sets the operation handler for the objects duplicates operation
+	ObjectsObjectsDuplicatesHandler objects.ObjectsDuplicatesHandler
+	// ObjectsObjectsEstimateHandler sets the operation handler for the objects estimate operation
+	ObjectsObjectsEstimateHandler objects.ObjectsEstimateHandler
+	// ObjectsObjectsFacetsHandler sets the operation handler for the objects facets operation
+	ObjectsObjectsFacetsHandler objects.ObjectsFacetsHandler
 	// ObjectsObjectsGetHandler sets the operation handler for the objects get operation
 	ObjectsObjectsGetHandler objects.ObjectsGetHandler
 	// ObjectsObjectsListHandler sets the operation handler for the objects list operation
@@ -239,8 +353,24 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsStatusHandler sets the operation handler for the schema objects status operation
+	SchemaSchemaObjectsStatusHandler schema.SchemaObjectsStatusHandler
+	// SchemaSchemaObjectsStatusVectorIndexAdviceHandler sets the operation handler for the schema objects status vector index advice operation
+	SchemaSchemaObjectsStatusVectorIndexAdviceHandler schema.SchemaObjectsStatusVectorIndexAdviceHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaShardsVectorIndexCleanupHandler sets the operation handler for the schema shards vector index cleanup operation
+	SchemaSchemaShardsVectorIndexCleanupHandler schema.SchemaShardsVectorIndexCleanupHandler
+	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
+	SchemaTenantsCreateHandler schema.TenantsCreateHandler
+	// SchemaTenantsDeleteHandler sets the operation handler for the tenants delete operation
+	SchemaTenantsDeleteHandler schema.TenantsDeleteHandler
+	// SchemaTenantsGetHandler sets the operation handler for the tenants get operation
+	SchemaTenantsGetHandler schema.TenantsGetHandler
+	// SchemaTenantsUpdateHandler sets the operation handler for the tenants update operation
+	SchemaTenantsUpdateHandler schema.TenantsUpdateHandler
+	// UsageUsageGetHandler sets the operation handler for the usage get operation
+	UsageUsageGetHandler usage.UsageGetHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -323,9 +453,24 @@ func (o *WeaviateAPI) Validate() error {
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
+	if o.BackupsBackupsCreateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsCreateHandler")
+	}
+	if o.BackupsBackupsCreateStatusHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsCreateStatusHandler")
+	}
+	if o.BackupsBackupsRestoreHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsRestoreHandler")
+	}
+	if o.BackupsBackupsRestoreStatusHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
+	if o.BatchBatchObjectsDeleteHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
@@ -335,21 +480,57 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusteringsClusteringsGetHandler == nil {
+		unregistered = append(unregistered, "clusterings.ClusteringsGetHandler")
+	}
+	if o.ClusteringsClusteringsPostHandler == nil {
+		unregistered = append(unregistered, "clusterings.ClusteringsPostHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
 	if o.GraphqlGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
+	if o.GraphqlGraphqlSandboxHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlSandboxHandler")
+	}
+	if o.ImportsImportsGetHandler == nil {
+		unregistered = append(unregistered, "imports.ImportsGetHandler")
+	}
+	if o.ImportsImportsPostHandler == nil {
+		unregistered = append(unregistered, "imports.ImportsPostHandler")
+	}
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.MetaRuntimeConfigUpdateHandler == nil {
+		unregistered = append(unregistered, "meta.RuntimeConfigUpdateHandler")
+	}
+	if o.NodesNodesDrainHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainHandler")
+	}
+	if o.NodesNodesGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesGetHandler")
+	}
+	if o.ObjectsObjectsAggregatesHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsAggregatesHandler")
+	}
 	if o.ObjectsObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsCreateHandler")
 	}
 	if o.ObjectsObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsDeleteHandler")
 	}
+	if o.ObjectsObjectsDuplicatesHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsDuplicatesHandler")
+	}
+	if o.ObjectsObjectsEstimateHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsEstimateHandler")
+	}
+	if o.ObjectsObjectsFacetsHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsFacetsHandler")
+	}
 	if o.ObjectsObjectsGetHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsGetHandler")
 	}
@@ -389,9 +570,33 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsStatusHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStatusHandler")
+	}
+	if o.SchemaSchemaObjectsStatusVectorIndexAdviceHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStatusVectorIndexAdviceHandler")
+	}
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaShardsVectorIndexCleanupHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaShardsVectorIndexCleanupHandler")
+	}
+	if o.SchemaTenantsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsCreateHandler")
+	}
+	if o.SchemaTenantsDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsDeleteHandler")
+	}
+	if o.SchemaTenantsGetHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsGetHandler")
+	}
+	if o.SchemaTenantsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsUpdateHandler")
+	}
+	if o.UsageUsageGetHandler == nil {
+		unregistered = append(unregistered, "usage.UsageGetHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -508,7 +713,27 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/backups/{backend}"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{backend}/{id}"] = backups.NewBackupsCreateStatus(o.context, o.BackupsBackupsCreateStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/backups/{backend}/{id}/restore"] = backups.NewBackupsRestore(o.context, o.BackupsBackupsRestoreHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{backend}/{id}/restore"] = backups.NewBackupsRestoreStatus(o.context, o.BackupsBackupsRestoreStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/objects"] = batch.NewBatchObjectsCreate(o.context, o.BatchBatchObjectsCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/batch/objects"] = batch.NewBatchObjectsDelete(o.context, o.BatchBatchObjectsDeleteHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -521,6 +746,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/classifications"] = classifications.NewClassificationsPost(o.context, o.ClassificationsClassificationsPostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusterings/{id}"] = clusterings.NewClusteringsGet(o.context, o.ClusteringsClusteringsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusterings"] = clusterings.NewClusteringsPost(o.context, o.ClusteringsClusteringsPostHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -529,10 +762,38 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/graphql"] = graphql.NewGraphqlPost(o.context, o.GraphqlGraphqlPostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/graphql/sandbox"] = graphql.NewGraphqlSandbox(o.context, o.GraphqlGraphqlSandboxHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/imports/{id}"] = imports.NewImportsGet(o.context, o.ImportsImportsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/imports"] = imports.NewImportsPost(o.context, o.ImportsImportsPostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/meta"] = meta.NewMetaGet(o.context, o.MetaMetaGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/config/runtime"] = meta.NewRuntimeConfigUpdate(o.context, o.MetaRuntimeConfigUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/{name}/drain"] = nodes.NewNodesDrain(o.context, o.NodesNodesDrainHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes"] = nodes.NewNodesGet(o.context, o.NodesNodesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/aggregates"] = objects.NewObjectsAggregates(o.context, o.ObjectsObjectsAggregatesHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -544,6 +805,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/objects/duplicates"] = objects.NewObjectsDuplicates(o.context, o.ObjectsObjectsDuplicatesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects/estimate"] = objects.NewObjectsEstimate(o.context, o.ObjectsObjectsEstimateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects/facets"] = objects.NewObjectsFacets(o.context, o.ObjectsObjectsFacetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/objects/{id}"] = objects.NewObjectsGet(o.context, o.ObjectsObjectsGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -593,10 +866,42 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/status"] = schema.NewSchemaObjectsStatus(o.context, o.SchemaSchemaObjectsStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/status/vector-index-advice"] = schema.NewSchemaObjectsStatusVectorIndexAdvice(o.context, o.SchemaSchemaObjectsStatusVectorIndexAdviceHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shards/{shardName}/vector-index/cleanup"] = schema.NewSchemaShardsVectorIndexCleanup(o.context, o.SchemaSchemaShardsVectorIndexCleanupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants"] = schema.NewTenantsCreate(o.context, o.SchemaTenantsCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/tenants"] = schema.NewTenantsDelete(o.context, o.SchemaTenantsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants"] = schema.NewTenantsGet(o.context, o.SchemaTenantsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/tenants"] = schema.NewTenantsUpdate(o.context, o.SchemaTenantsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/usage"] = usage.NewUsageGet(o.context, o.UsageUsageGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
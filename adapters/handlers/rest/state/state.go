@@ -13,14 +13,22 @@ package state
 
 import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/diagnostics"
 	"github.com/semi-technologies/weaviate/adapters/repos/classifications"
+	"github.com/semi-technologies/weaviate/adapters/repos/db"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/backup"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/locks"
 	"github.com/semi-technologies/weaviate/usecases/modules"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/semi-technologies/weaviate/usecases/nodes"
+	"github.com/semi-technologies/weaviate/usecases/objects"
 	"github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
 	"github.com/sirupsen/logrus"
@@ -32,16 +40,25 @@ import (
 type State struct {
 	OIDC               *oidc.Client
 	AnonymousAccess    *anonymous.Client
+	APIKey             *apikey.Client
 	Authorizer         authorization.Authorizer
+	ReadOnly           *authorization.ReadOnly
 	ServerConfig       *config.WeaviateConfig
 	Locks              locks.ConnectorSchemaLock
 	Logger             *logrus.Logger
+	LogBuffer          *diagnostics.LogBuffer
 	GraphQL            graphql.GraphQL
 	Modules            *modules.Provider
 	SchemaManager      *schema.Manager
 	Cluster            *cluster.State
 	RemoteIncoming     *sharding.RemoteIndexIncoming
+	BackupShards       *backup.Shards
+	NodesManager       *nodes.Manager
 	ClassificationRepo *classifications.DistributedRepo
+	DB                 *db.DB
+	Quotas             *objects.Quotas
+	Admission          *admission.Controller
+	Metrics            *monitoring.Registry
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
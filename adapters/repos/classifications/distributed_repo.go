@@ -20,6 +20,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
+	"github.com/sirupsen/logrus"
 )
 
 type DistributedRepo struct {
@@ -34,9 +35,10 @@ type localRepo interface {
 }
 
 func NewDistributeRepo(remoteClient cluster.Client,
-	memberLister cluster.MemberLister, localRepo localRepo) *DistributedRepo {
+	memberLister cluster.MemberLister, localRepo localRepo,
+	logger logrus.FieldLogger) *DistributedRepo {
 	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
-	txRemote := cluster.NewTxManager(broadcaster)
+	txRemote := cluster.NewTxManager(broadcaster, logger)
 	repo := &DistributedRepo{
 		txRemote:  txRemote,
 		localRepo: localRepo,
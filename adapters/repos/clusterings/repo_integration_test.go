@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package clusterings
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ClusteringsRepo(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+	_ = r
+
+	t.Run("asking for a non-existing clustering", func(t *testing.T) {
+		res, err := r.Get(context.Background(), "wrong-id")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("storing clusterings", func(t *testing.T) {
+		err := r.Put(context.Background(), exampleOne())
+		require.Nil(t, err)
+
+		err = r.Put(context.Background(), exampleTwo())
+		require.Nil(t, err)
+	})
+
+	t.Run("retrieveing stored clusterings", func(t *testing.T) {
+		expectedOne := exampleOne()
+		expectedTwo := exampleTwo()
+
+		res, err := r.Get(context.Background(), expectedOne.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedOne, res)
+
+		res, err = r.Get(context.Background(), expectedTwo.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedTwo, res)
+	})
+}
+
+func exampleOne() models.Clustering {
+	return models.Clustering{
+		ID:       "01ed111a-919c-4dd5-ab9e-7b247b11e18c",
+		Class:    "ExampleClassOne",
+		K:        3,
+		Property: "cluster",
+		Status:   models.ClusteringStatusRunning,
+	}
+}
+
+func exampleTwo() models.Clustering {
+	return models.Clustering{
+		ID:       "4fbaebf3-41a9-414b-ac1d-433d74d4ef2c",
+		Class:    "ExampleClassTwo",
+		K:        2,
+		Property: "cluster",
+		Status:   models.ClusteringStatusCompleted,
+		Centroids: []*models.ClusteringCentroid{
+			{Cluster: 0, Size: 2, Vector: []float32{1, 0}},
+			{Cluster: 1, Size: 1, Vector: []float32{0, 1}},
+		},
+	}
+}
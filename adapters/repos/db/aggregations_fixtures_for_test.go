@@ -83,6 +83,26 @@ var arrayTypesClass = &models.Class{
 	},
 }
 
+var datesClass = &models.Class{
+	Class:               "AggregationsTestDates",
+	VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+	InvertedIndexConfig: invertedConfig(),
+	Properties: []*models.Property{
+		{
+			Name:     "sector",
+			DataType: []string{"string"},
+		},
+		{
+			Name:     "founded",
+			DataType: []string{"date"},
+		},
+		{
+			Name:     "dates",
+			DataType: []string{"date[]"},
+		},
+	},
+}
+
 var products = []map[string]interface{}{
 	{
 		"name": "Superbread",
@@ -174,3 +194,29 @@ var arrayTypes = []map[string]interface{}{
 		"numbers": []float64{1.0, 2.0},
 	},
 }
+
+var dates = []map[string]interface{}{
+	{
+		"sector":  "Financials",
+		"founded": "2000-01-01T00:00:00Z",
+		"dates":   []string{"2000-01-01T00:00:00Z"},
+	},
+	{
+		"sector":  "Financials",
+		"founded": "2000-01-01T00:00:00Z",
+		"dates":   []string{"2010-06-15T12:00:00Z", "2010-06-15T12:00:00Z"},
+	},
+	{
+		"sector":  "Food",
+		"founded": "2010-06-15T12:00:00Z",
+		"dates":   []string{"2010-06-15T12:00:00Z", "2020-12-31T23:59:59Z"},
+	},
+	{
+		"sector":  "Food",
+		"founded": "2020-12-31T23:59:59Z",
+	},
+	{
+		"sector":  "Food",
+		"founded": "2020-12-31T23:59:59Z",
+	},
+}
@@ -66,6 +66,8 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("numerical aggregations without grouping (formerly Meta)",
 		testNumericalAggregationsWithoutGrouping(repo, true))
 
+	t.Run("date aggregations", testDateAggregations(repo))
+
 	// t.Run("clean up",
 	// 	cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -98,6 +100,8 @@ func Test_Aggregations_MultiShard(t *testing.T) {
 	t.Run("numerical aggregations without grouping (formerly Meta)",
 		testNumericalAggregationsWithoutGrouping(repo, false))
 
+	t.Run("date aggregations", testDateAggregations(repo))
+
 	// t.Run("clean up",
 	// 	cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -111,6 +115,7 @@ func prepareCompanyTestSchemaAndData(repo *DB,
 					productClass,
 					companyClass,
 					arrayTypesClass,
+					datesClass,
 				},
 			},
 		}
@@ -122,6 +127,8 @@ func prepareCompanyTestSchemaAndData(repo *DB,
 				migrator.AddClass(context.Background(), companyClass, schemaGetter.shardState))
 			require.Nil(t,
 				migrator.AddClass(context.Background(), arrayTypesClass, schemaGetter.shardState))
+			require.Nil(t,
+				migrator.AddClass(context.Background(), datesClass, schemaGetter.shardState))
 		})
 
 		schemaGetter.schema = schema
@@ -171,6 +178,23 @@ func prepareCompanyTestSchemaAndData(repo *DB,
 				})
 			}
 		})
+
+		t.Run("import dates", func(t *testing.T) {
+			// import everything 4 times, so the dates are spread across shards
+			for j := 0; j < 4; j++ {
+				for i, schema := range dates {
+					t.Run(fmt.Sprintf("importing dates %d", i), func(t *testing.T) {
+						fixture := models.Object{
+							Class:      datesClass.Class,
+							ID:         strfmt.UUID(uuid.Must(uuid.NewRandom()).String()),
+							Properties: schema,
+						}
+						require.Nil(t,
+							repo.PutObject(context.Background(), &fixture, []float32{0.1, 0.1, 0.1, 0.1}))
+					})
+				}
+			}
+		})
 	}
 }
 
@@ -1049,6 +1073,131 @@ func testNumericalAggregationsWithoutGrouping(repo *DB,
 			assert.Equal(t, expectedResult.Groups, res.Groups)
 		})
 
+		t.Run("only meta count, with a single Equal filter", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				Filters:          sectorEqualsFoodFilter(),
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 60, res.Groups[0].Count)
+		})
+
+		t.Run("only meta count, with nested filters", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorAnd,
+						Operands: []filters.Clause{
+							*sectorEqualsFoodFilter().Root,
+							{
+								Operator: filters.OperatorEqual,
+								On: &filters.Path{
+									Class:    "Company",
+									Property: "location",
+								},
+								Value: &filters.Value{
+									Value: "Atlanta",
+									Type:  schema.DataTypeText,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 20, res.Groups[0].Count)
+		})
+
+		t.Run("only meta count, with a search vector and an objectLimit", func(t *testing.T) {
+			objectLimit := 20
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				SearchVector:     []float32{0.1, 0.1, 0.1, 0.1},
+				ObjectLimit:      &objectLimit,
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+
+			// the objectLimit applies to the class as a whole, not to each shard
+			assert.Equal(t, 20, res.Groups[0].Count)
+		})
+
+		t.Run("only meta count, with a search vector, an objectLimit and a certainty", func(t *testing.T) {
+			objectLimit := 200
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				SearchVector:     []float32{0.1, 0.1, 0.1, 0.1},
+				ObjectLimit:      &objectLimit,
+				Certainty:        0.9,
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 90, res.Groups[0].Count)
+		})
+
+		t.Run("a property, with a search vector and an objectLimit", func(t *testing.T) {
+			objectLimit := 20
+			params := aggregation.Params{
+				ClassName:    schema.ClassName(companyClass.Class),
+				SearchVector: []float32{0.1, 0.1, 0.1, 0.1},
+				ObjectLimit:  &objectLimit,
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        schema.PropertyName("dividendYield"),
+						Aggregators: []aggregation.Aggregator{aggregation.CountAggregator},
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, float64(20), res.Groups[0].Properties["dividendYield"].
+				NumericalAggregations["count"])
+		})
+
+		t.Run("only meta count, with a search vector and a certainty", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				SearchVector:     []float32{0.1, 0.1, 0.1, 0.1},
+				Certainty:        0.9,
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 90, res.Groups[0].Count)
+
+			params.SearchVector = []float32{-0.1, -0.1, -0.1, -0.1}
+			res, err = repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 0, res.Groups[0].Count)
+		})
+
 		t.Run("single field, single aggregator", func(t *testing.T) {
 			params := aggregation.Params{
 				ClassName: schema.ClassName(companyClass.Class),
@@ -1811,6 +1960,189 @@ func testNumericalAggregationsWithoutGrouping(repo *DB,
 	}
 }
 
+// testDateAggregations expects the same results with a single and with
+// multiple shards, since the aggregations of dates are combined exactly
+func testDateAggregations(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		var (
+			y2000 = dateNanos("2000-01-01T00:00:00Z")
+			y2010 = dateNanos("2010-06-15T12:00:00Z")
+			y2020 = dateNanos("2020-12-31T23:59:59Z")
+		)
+
+		dateAggregators := []aggregation.Aggregator{
+			aggregation.CountAggregator,
+			aggregation.MinimumAggregator,
+			aggregation.MaximumAggregator,
+			aggregation.MedianAggregator,
+			aggregation.ModeAggregator,
+		}
+
+		t.Run("date field", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(datesClass.Class),
+				Properties: []aggregation.ParamProperty{
+					aggregation.ParamProperty{
+						Name:        schema.PropertyName("founded"),
+						Aggregators: dateAggregators,
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			// the mode is the earliest one of the two most common dates
+			expected := aggregation.Date{
+				Count:      20,
+				Minimum:    y2000,
+				Maximum:    y2020,
+				Median:     y2010,
+				Mode:       y2000,
+				ModeOccurs: 8,
+				Occurrences: []aggregation.DateOccurrence{
+					{Value: y2000, Occurs: 8},
+					{Value: y2010, Occurs: 4},
+					{Value: y2020, Occurs: 8},
+				},
+			}
+
+			require.Len(t, res.Groups, 1)
+			founded := res.Groups[0].Properties["founded"]
+			assert.Equal(t, aggregation.PropertyTypeDate, founded.Type)
+			assert.Equal(t, expected, founded.DateAggregation)
+		})
+
+		t.Run("date array field", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(datesClass.Class),
+				Properties: []aggregation.ParamProperty{
+					aggregation.ParamProperty{
+						Name:        schema.PropertyName("dates"),
+						Aggregators: dateAggregators,
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			// a date which occurs several times in the same array is counted
+			// every time
+			expected := aggregation.Date{
+				Count:      20,
+				Minimum:    y2000,
+				Maximum:    y2020,
+				Median:     y2010,
+				Mode:       y2010,
+				ModeOccurs: 12,
+				Occurrences: []aggregation.DateOccurrence{
+					{Value: y2000, Occurs: 4},
+					{Value: y2010, Occurs: 12},
+					{Value: y2020, Occurs: 4},
+				},
+			}
+
+			require.Len(t, res.Groups, 1)
+			dates := res.Groups[0].Properties["dates"]
+			assert.Equal(t, aggregation.PropertyTypeDate, dates.Type)
+			assert.Equal(t, expected, dates.DateAggregation)
+		})
+
+		t.Run("date field, single-level filter", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(datesClass.Class),
+				Filters:   sectorEqualsFoodFilter(),
+				Properties: []aggregation.ParamProperty{
+					aggregation.ParamProperty{
+						Name:        schema.PropertyName("founded"),
+						Aggregators: dateAggregators,
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			expected := aggregation.Date{
+				Count:      12,
+				Minimum:    y2010,
+				Maximum:    y2020,
+				Median:     y2020,
+				Mode:       y2020,
+				ModeOccurs: 8,
+				Occurrences: []aggregation.DateOccurrence{
+					{Value: y2010, Occurs: 4},
+					{Value: y2020, Occurs: 8},
+				},
+			}
+
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, expected, res.Groups[0].Properties["founded"].DateAggregation)
+		})
+
+		t.Run("date field, grouped by string", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(datesClass.Class),
+				GroupBy: &filters.Path{
+					Class:    schema.ClassName(datesClass.Class),
+					Property: schema.PropertyName("sector"),
+				},
+				IncludeMetaCount: true,
+				Properties: []aggregation.ParamProperty{
+					aggregation.ParamProperty{
+						Name:        schema.PropertyName("founded"),
+						Aggregators: dateAggregators,
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			require.Len(t, res.Groups, 2)
+
+			assert.Equal(t, 12, res.Groups[0].Count)
+			assert.Equal(t, "Food", res.Groups[0].GroupedBy.Value)
+			assert.Equal(t, aggregation.Date{
+				Count:      12,
+				Minimum:    y2010,
+				Maximum:    y2020,
+				Median:     y2020,
+				Mode:       y2020,
+				ModeOccurs: 8,
+				Occurrences: []aggregation.DateOccurrence{
+					{Value: y2010, Occurs: 4},
+					{Value: y2020, Occurs: 8},
+				},
+			}, res.Groups[0].Properties["founded"].DateAggregation)
+
+			assert.Equal(t, 8, res.Groups[1].Count)
+			assert.Equal(t, "Financials", res.Groups[1].GroupedBy.Value)
+			assert.Equal(t, aggregation.Date{
+				Count:      8,
+				Minimum:    y2000,
+				Maximum:    y2000,
+				Median:     y2000,
+				Mode:       y2000,
+				ModeOccurs: 8,
+				Occurrences: []aggregation.DateOccurrence{
+					{Value: y2000, Occurs: 8},
+				},
+			}, res.Groups[1].Properties["founded"].DateAggregation)
+		})
+	}
+}
+
+func dateNanos(in string) int64 {
+	asTime, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		panic(err)
+	}
+
+	return asTime.UnixNano()
+}
+
 func ptInt(in int) *int {
 	return &in
 }
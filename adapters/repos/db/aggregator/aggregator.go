@@ -30,12 +30,14 @@ type Aggregator struct {
 	invertedRowCache *inverted.RowCacher
 	classSearcher    inverted.ClassSearcher // to support ref-filters
 	deletedDocIDs    inverted.DeletedDocIDChecker
+	vectorIndex      vectorIndex // to support near params
 }
 
 func New(store *lsmkv.Store, params aggregation.Params,
 	getSchema schemaUC.SchemaGetter, cache *inverted.RowCacher,
 	classSearcher inverted.ClassSearcher,
-	deletedDocIDs inverted.DeletedDocIDChecker) *Aggregator {
+	deletedDocIDs inverted.DeletedDocIDChecker,
+	vectorIndex vectorIndex) *Aggregator {
 	return &Aggregator{
 		store:            store,
 		params:           params,
@@ -43,6 +45,7 @@ func New(store *lsmkv.Store, params aggregation.Params,
 		invertedRowCache: cache,
 		classSearcher:    classSearcher,
 		deletedDocIDs:    deletedDocIDs,
+		vectorIndex:      vectorIndex,
 	}
 }
 
@@ -51,13 +54,20 @@ func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
 		return newGroupedAggregator(a).Do(ctx)
 	}
 
-	if a.params.Filters != nil {
+	if a.filtered() {
 		return newFilteredAggregator(a).Do(ctx)
 	}
 
 	return newUnfilteredAggregator(a).Do(ctx)
 }
 
+// filtered is whether only some objects of the shard are part of the
+// aggregation
+func (a *Aggregator) filtered() bool {
+	return a.params.Filters != nil || a.params.SearchVector != nil ||
+		a.params.ObjectIDs != nil
+}
+
 func (a *Aggregator) aggTypeOfProperty(
 	name schema.PropertyName) (aggregation.PropertyType, schema.DataType, error) {
 	s := a.getSchema.GetSchemaSkipAuth()
@@ -78,6 +88,8 @@ func (a *Aggregator) aggTypeOfProperty(
 		return aggregation.PropertyTypeBoolean, dt, nil
 	case schema.DataTypeText, schema.DataTypeString, schema.DataTypeTextArray, schema.DataTypeStringArray:
 		return aggregation.PropertyTypeText, dt, nil
+	case schema.DataTypeDate, schema.DataTypeDateArray:
+		return aggregation.PropertyTypeDate, dt, nil
 	case schema.DataTypeGeoCoordinates:
 		return "", "", fmt.Errorf("dataType geoCoordinates can't be aggregated")
 	case schema.DataTypePhoneNumber:
@@ -86,3 +98,15 @@ func (a *Aggregator) aggTypeOfProperty(
 		return "", "", fmt.Errorf("unrecoginzed dataType %v", schemaProp.DataType[0])
 	}
 }
+
+// sharedStorage returns whether the inverted index of the prop is stored in
+// the buckets shared with other props, see inverted.SharedStorage
+func (a *Aggregator) sharedStorage(name schema.PropertyName) bool {
+	s := a.getSchema.GetSchemaSkipAuth()
+	schemaProp, err := s.GetProperty(a.params.ClassName, name)
+	if err != nil {
+		return false
+	}
+
+	return inverted.SharedStorage(schemaProp)
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"sort"
+	"time"
+
+	"github.com/buger/jsonparser"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+func newDateAggregator() *dateAggregator {
+	return &dateAggregator{valueCounter: map[int64]uint64{}}
+}
+
+// dateAggregator counts the occurrences of every date, all aggregations are
+// derived from those counts. Contrary to the numericalAggregator, rows read
+// from the inverted index and individual values are counted the same way.
+type dateAggregator struct {
+	valueCounter map[int64]uint64
+}
+
+func (a *dateAggregator) AddTimestamp(nanos int64, count uint64) {
+	if count == 0 {
+		return
+	}
+
+	a.valueCounter[nanos] += count
+}
+
+func (a *dateAggregator) AddTimestampRow(date []byte, count uint64) error {
+	nanos, err := inverted.ParseLexicographicallySortableInt64(date)
+	if err != nil {
+		return errors.Wrap(err, "read int64")
+	}
+
+	a.AddTimestamp(nanos, count)
+	return nil
+}
+
+// AddDate adds a date as found in the properties of an object. Values which
+// are not a valid date are skipped.
+func (a *dateAggregator) AddDate(value interface{}) {
+	switch typed := value.(type) {
+	case time.Time:
+		a.AddTimestamp(typed.UnixNano(), 1)
+	case string:
+		asTime, err := time.Parse(time.RFC3339, typed)
+		if err != nil {
+			return
+		}
+		a.AddTimestamp(asTime.UnixNano(), 1)
+	case []string:
+		for _, elem := range typed {
+			a.AddDate(elem)
+		}
+	case []interface{}:
+		for _, elem := range typed {
+			a.AddDate(elem)
+		}
+	}
+}
+
+func (a *Aggregator) parseAndAddDateArrayRow(agg *dateAggregator,
+	v []byte, propName schema.PropertyName) error {
+	items, ok, err := storobj.ParseAndExtractTextProp(v, propName.String())
+	if err == jsonparser.KeyPathNotFoundError {
+		// the object has no dates for this prop
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "parse and extract prop")
+	}
+
+	if !ok {
+		return nil
+	}
+
+	for i := range items {
+		agg.AddDate(items[i])
+	}
+	return nil
+}
+
+// Res calculates all aggregations from the occurrences of the dates, see
+// dateFromOccurrences
+func (a *dateAggregator) Res() aggregation.Date {
+	occurrences := make([]aggregation.DateOccurrence, 0, len(a.valueCounter))
+	for date, count := range a.valueCounter {
+		occurrences = append(occurrences, aggregation.DateOccurrence{
+			Value:  date,
+			Occurs: int(count),
+		})
+	}
+
+	return dateFromOccurrences(occurrences)
+}
+
+// dateFromOccurrences calculates all aggregations at once, they are all cheap
+// once the dates are sorted. Like for numerical props, the median is the
+// lower one of the two middle values if the count is even. Ties of the mode
+// are resolved in favor of the earliest date.
+func dateFromOccurrences(occurrences []aggregation.DateOccurrence) aggregation.Date {
+	out := aggregation.Date{}
+	if len(occurrences) == 0 {
+		return out
+	}
+
+	sort.Slice(occurrences, func(x, y int) bool {
+		return occurrences[x].Value < occurrences[y].Value
+	})
+
+	for _, occ := range occurrences {
+		out.Count += occ.Occurs
+	}
+	out.Occurrences = occurrences
+	out.Minimum = occurrences[0].Value
+	out.Maximum = occurrences[len(occurrences)-1].Value
+
+	index := out.Count/2 + out.Count%2
+	medianFound := false
+	for _, occ := range occurrences {
+		if occ.Occurs > out.ModeOccurs {
+			out.Mode = occ.Value
+			out.ModeOccurs = occ.Occurs
+		}
+
+		if !medianFound {
+			if index <= occ.Occurs {
+				out.Median = occ.Value
+				medianFound = true
+			} else {
+				index -= occ.Occurs
+			}
+		}
+	}
+
+	return out
+}
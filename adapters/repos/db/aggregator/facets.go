@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// FacetCounter counts the values of the faceted properties of the objects it
+// is handed. It is used for the properties whose values cannot be counted
+// from the inverted index, see inverted.FacetIndexed. Every property is
+// counted by its own grouper.
+type FacetCounter struct {
+	props    []schema.PropertyName
+	groupers []*grouper
+}
+
+func NewFacetCounter(className schema.ClassName,
+	props []schema.PropertyName, limit int) *FacetCounter {
+	groupers := make([]*grouper, len(props))
+	for i, prop := range props {
+		agg := &Aggregator{params: aggregation.Params{
+			ClassName: className,
+			GroupBy:   &filters.Path{Class: className, Property: prop},
+		}}
+		groupers[i] = newGrouper(agg, limit)
+	}
+
+	return &FacetCounter{props: props, groupers: groupers}
+}
+
+func (fc *FacetCounter) Add(obj *storobj.Object) error {
+	for _, g := range fc.groupers {
+		if err := g.addElement(obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Facets returns the most common values of every faceted property
+func (fc *FacetCounter) Facets() ([]aggregation.Facet, error) {
+	out := make([]aggregation.Facet, len(fc.props))
+	for i, g := range fc.groupers {
+		groups, err := g.aggregateAndSelect()
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = aggregation.Facet{
+			Property: fc.props[i].String(),
+			Groups:   make([]aggregation.Group, len(groups)),
+		}
+		for j := range groups {
+			out[i].Groups[j] = groups[j].res
+		}
+	}
+
+	return out, nil
+}
+
+// CombineFacets merges the facet results of several shards. The counts of
+// the same value are added up and only the limit most common values of each
+// property are kept. Like the groups of a grouped aggregation, every shard
+// only contributes its own most common values, so a value which is rare on
+// every single shard may be missing from the combined facet.
+func CombineFacets(results []*aggregation.FacetResult,
+	limit int) *aggregation.FacetResult {
+	out := &aggregation.FacetResult{}
+	if len(results) == 0 {
+		return out
+	}
+
+	out.Facets = make([]aggregation.Facet, len(results[0].Facets))
+	for i := range out.Facets {
+		counts := map[interface{}]int{}
+		for _, res := range results {
+			for _, group := range res.Facets[i].Groups {
+				counts[group.GroupedBy.Value] += group.Count
+			}
+		}
+
+		out.Facets[i] = FacetFromCounts(results[0].Facets[i].Property, counts, limit)
+	}
+
+	for _, res := range results {
+		out.Count += res.Count
+	}
+
+	return out
+}
+
+// FacetFromCounts builds the facet of a property from the number of objects
+// holding each of its values, keeping only the limit most common values
+func FacetFromCounts(property string, counts map[interface{}]int,
+	limit int) aggregation.Facet {
+	groups := make([]aggregation.Group, 0, len(counts))
+	for value, count := range counts {
+		groups = append(groups, aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{
+				Path:  []string{property},
+				Value: value,
+			},
+			Count: count,
+		})
+	}
+
+	// ties are ordered by value, so the facet does not depend on the order of
+	// the counts or in which the shards responded
+	sort.Slice(groups, func(a, b int) bool {
+		if groups[a].Count != groups[b].Count {
+			return groups[a].Count > groups[b].Count
+		}
+		return fmt.Sprint(groups[a].GroupedBy.Value) <
+			fmt.Sprint(groups[b].GroupedBy.Value)
+	})
+
+	if len(groups) > limit {
+		groups = groups[:limit]
+	}
+
+	return aggregation.Facet{Property: property, Groups: groups}
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
-	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storobj"
@@ -38,21 +37,26 @@ func (fa *filteredAggregator) Do(ctx context.Context) (*aggregation.Result, erro
 	// without grouping there is always exactly one group
 	out.Groups = make([]aggregation.Group, 1)
 
-	s := fa.getSchema.GetSchemaSkipAuth()
-	ids, err := inverted.NewSearcher(fa.store, s, fa.invertedRowCache, nil,
-		fa.Aggregator.classSearcher, fa.deletedDocIDs).
-		DocIDs(ctx, fa.params.Filters, additional.Properties{},
-			fa.params.ClassName)
+	if fa.countOnly() {
+		count, err := fa.count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Groups[0].Count = count
+		return &out, nil
+	}
+
+	ids, err := fa.docIDs(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "retrieve doc IDs from searcher")
+		return nil, err
 	}
 
 	if fa.params.IncludeMetaCount {
 		out.Groups[0].Count = len(ids)
 	}
 
-	idsList := flattenAllowList(ids)
-	props, err := fa.properties(ctx, idsList)
+	props, err := fa.properties(ctx, ids)
 	if err != nil {
 		return nil, errors.Wrap(err, "aggregate properties")
 	}
@@ -62,6 +66,26 @@ func (fa *filteredAggregator) Do(ctx context.Context) (*aggregation.Result, erro
 	return &out, nil
 }
 
+// countOnly is true if only the count of the objects matching the filters
+// is requested, in which case there is no need to retrieve the doc ids
+func (fa *filteredAggregator) countOnly() bool {
+	return fa.params.IncludeMetaCount && len(fa.params.Properties) == 0 &&
+		fa.params.SearchVector == nil && fa.params.ObjectIDs == nil &&
+		fa.params.Filters != nil
+}
+
+func (fa *filteredAggregator) count(ctx context.Context) (int, error) {
+	s := fa.getSchema.GetSchemaSkipAuth()
+	count, err := inverted.NewSearcher(fa.store, s, fa.invertedRowCache, nil,
+		fa.classSearcher, fa.deletedDocIDs).
+		Count(ctx, fa.params.Filters, fa.params.ClassName)
+	if err != nil {
+		return 0, errors.Wrap(err, "count matches in searcher")
+	}
+
+	return count, nil
+}
+
 func (fa *filteredAggregator) properties(ctx context.Context,
 	ids []uint64) (map[string]aggregation.Property, error) {
 	propAggs, err := fa.prepareAggregatorsForProps()
@@ -76,7 +100,7 @@ func (fa *filteredAggregator) properties(ctx context.Context,
 		return true, nil
 	}
 
-	err = docid.ScanObjectsLSM(fa.store, ids, scan)
+	err = docid.ScanObjectsLSM(fa.store, ids, scan, storobj.DecodePropertiesOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "properties view tx")
 	}
@@ -126,6 +150,8 @@ func (fa *filteredAggregator) addPropValue(prop propAgg, value interface{}) {
 			return
 		}
 		prop.textAgg.AddText(asString)
+	case aggregation.PropertyTypeDate:
+		prop.dateAgg.AddDate(value)
 	default:
 	}
 }
@@ -143,10 +169,11 @@ type propAgg struct {
 	// use aggType to chose with agg to use
 	aggType aggregation.PropertyType
 
-	// only one of the following three would ever best
+	// only one of the following four would ever be set
 	boolAgg      *boolAggregator
 	textAgg      *textAggregator
 	numericalAgg *numericalAggregator
+	dateAgg      *dateAggregator
 }
 
 // propAggs groups propAgg helpers by prop name
@@ -161,6 +188,8 @@ func (pa *propAgg) initAggregator() {
 		pa.boolAgg = newBoolAggregator()
 	case aggregation.PropertyTypeNumerical:
 		pa.numericalAgg = newNumericalAggregator()
+	case aggregation.PropertyTypeDate:
+		pa.dateAgg = newDateAggregator()
 	default:
 	}
 }
@@ -188,6 +217,10 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 				prop.numericalAgg)
 			out[prop.name.String()] = aggProp
 
+		case aggregation.PropertyTypeDate:
+			aggProp.DateAggregation = prop.dateAgg.Res()
+			out[prop.name.String()] = aggProp
+
 		default:
 		}
 	}
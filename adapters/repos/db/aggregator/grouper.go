@@ -18,9 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
-	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	bolt "go.etcd.io/bbolt"
@@ -49,7 +47,7 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 		return nil, fmt.Errorf("grouping by cross-refs not supported")
 	}
 
-	if g.params.Filters == nil {
+	if !g.filtered() {
 		return g.groupAll(ctx)
 	} else {
 		return g.groupFiltered(ctx)
@@ -59,7 +57,7 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 	err := ScanAllLSM(g.store, func(obj *storobj.Object) (bool, error) {
 		return true, g.addElement(obj)
-	})
+	}, storobj.DecodePropertiesOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "group all (unfiltered)")
 	}
@@ -68,19 +66,15 @@ func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 }
 
 func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
-	s := g.getSchema.GetSchemaSkipAuth()
-	ids, err := inverted.NewSearcher(g.store, s, g.invertedRowCache, nil,
-		g.classSearcher, g.deletedDocIDs).
-		DocIDs(ctx, g.params.Filters, additional.Properties{},
-			g.params.ClassName)
+	ids, err := g.docIDs(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "retrieve doc IDs from searcher")
+		return nil, err
 	}
 
-	if err := docid.ScanObjectsLSM(g.store, flattenAllowList(ids),
+	if err := docid.ScanObjectsLSM(g.store, ids,
 		func(obj *storobj.Object) (bool, error) {
 			return true, g.addElement(obj)
-		}); err != nil {
+		}, storobj.DecodePropertiesOnly); err != nil {
 		return nil, err
 	}
 
@@ -196,8 +190,10 @@ func ScanAll(tx *bolt.Tx, scan docid.ObjectScanFn) error {
 	return nil
 }
 
-// ScanAllLSM iterates over every row in the object buckets
-func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
+// ScanAllLSM iterates over every row in the object buckets, decoding the
+// objects according to opts
+func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn,
+	opts storobj.DecodeOptions) error {
 	b := store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return fmt.Errorf("objects bucket not found")
@@ -206,8 +202,12 @@ func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
 	c := b.Cursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		elem, err := storobj.FromBinary(v)
+		elem, err := storobj.FromBinaryWithOptions(v, opts)
 		if err != nil {
 			return errors.Wrapf(err, "unmarshal data object")
 		}
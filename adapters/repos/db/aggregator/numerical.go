@@ -27,6 +27,12 @@ func addNumericalAggregations(prop *aggregation.Property,
 	}
 
 	for _, aProp := range aggs {
+		if aProp.Type == aggregation.PercentileType && aProp.Percentile != nil {
+			prop.NumericalAggregations[aggregation.PercentileKey(*aProp.Percentile)] =
+				agg.Percentile(*aProp.Percentile)
+			continue
+		}
+
 		switch aProp {
 		case aggregation.MeanAggregator:
 			prop.NumericalAggregations[aProp.String()] = agg.Mean()
@@ -202,3 +208,28 @@ func (a *numericalAggregator) Median() float64 {
 
 	return median
 }
+
+// Percentile returns the smallest value which is greater than or equal to p
+// percent of all values (nearest-rank method). Like Median, it requires a
+// call of buildPairsFromCounts() if it was built using individual objects.
+func (a *numericalAggregator) Percentile(p float64) float64 {
+	if a.count == 0 {
+		return 0
+	}
+
+	rank := uint64(math.Ceil(p / 100 * float64(a.count)))
+	if rank < 1 {
+		rank = 1
+	}
+
+	var percentile float64
+	for _, pair := range a.pairs {
+		percentile = pair.value
+		if rank <= pair.count {
+			break
+		}
+		rank -= pair.count
+	}
+
+	return percentile
+}
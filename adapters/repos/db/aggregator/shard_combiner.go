@@ -13,6 +13,7 @@ package aggregator
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 )
@@ -105,6 +106,9 @@ func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregatio
 		case aggregation.PropertyTypeText:
 			combinedProp.TextAggregation = sc.mergeTextProp(
 				combinedProp.TextAggregation, prop.TextAggregation)
+		case aggregation.PropertyTypeDate:
+			combinedProp.DateAggregation = sc.mergeDateProp(
+				combinedProp.DateAggregation, prop.DateAggregation)
 		}
 		combinedGroups[pos].Properties[propName] = combinedProp
 
@@ -138,7 +142,12 @@ func (sc ShardCombiner) mergeNumericalProp(combined, source map[string]float64)
 			combined["sum"] = combined["sum"] + value
 
 		default:
-			panic("unkwnon prop type: " + propType)
+			if !isPercentileKey(propType) {
+				panic("unkwnon prop type: " + propType)
+			}
+			// like the median, percentiles can only be approximated from the
+			// percentiles of the individual shards
+			combined[propType] = combined[propType] + value
 		}
 	}
 
@@ -157,10 +166,19 @@ func (sc ShardCombiner) finalizeNumerical(combined map[string]float64) map[strin
 	if _, ok := combined["median"]; ok {
 		combined["median"] = combined["median"] / combined["_rounds"]
 	}
+	for key := range combined {
+		if isPercentileKey(key) {
+			combined[key] = combined[key] / combined["_rounds"]
+		}
+	}
 	delete(combined, "_rounds")
 	return combined
 }
 
+func isPercentileKey(key string) bool {
+	return strings.HasPrefix(key, aggregation.PercentileType+"_")
+}
+
 func (sc ShardCombiner) mergeBooleanProp(combined,
 	source aggregation.Boolean) aggregation.Boolean {
 	combined.Count += source.Count
@@ -198,6 +216,32 @@ func (sc ShardCombiner) finalizeText(combined aggregation.Text) aggregation.Text
 	return combined
 }
 
+// mergeDateProp collects the occurrences of the dates of all shards, so that
+// the aggregations can be calculated exactly in finalizeDate
+func (sc ShardCombiner) mergeDateProp(combined,
+	source aggregation.Date) aggregation.Date {
+	combined.Count += source.Count
+	combined.Occurrences = append(combined.Occurrences, source.Occurrences...)
+	return combined
+}
+
+func (sc ShardCombiner) finalizeDate(combined aggregation.Date) aggregation.Date {
+	counts := map[int64]int{}
+	for _, occ := range combined.Occurrences {
+		counts[occ.Value] += occ.Occurs
+	}
+
+	occurrences := make([]aggregation.DateOccurrence, 0, len(counts))
+	for date, count := range counts {
+		occurrences = append(occurrences, aggregation.DateOccurrence{
+			Value:  date,
+			Occurs: count,
+		})
+	}
+
+	return dateFromOccurrences(occurrences)
+}
+
 func getPosOfTextOcc(haystack []aggregation.TextOccurrence, needle string) int {
 	for i, elem := range haystack {
 		if elem.Value == needle {
@@ -217,6 +261,8 @@ func (sc ShardCombiner) finalizeGroup(group *aggregation.Group) {
 			prop.BooleanAggregation = sc.finalizeBoolean(prop.BooleanAggregation)
 		case aggregation.PropertyTypeText:
 			prop.TextAggregation = sc.finalizeText(prop.TextAggregation)
+		case aggregation.PropertyTypeDate:
+			prop.DateAggregation = sc.finalizeDate(prop.DateAggregation)
 		}
 		group.Properties[propName] = prop
 	}
@@ -72,6 +72,10 @@ func (ua *unfilteredAggregator) addMetaCount(ctx context.Context,
 	c := b.Cursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	// TODO: can this be optimized?
 	for k, _ := c.First(); k != nil; k, _ = c.Next() {
 		count++
@@ -136,6 +140,13 @@ func (ua unfilteredAggregator) property(ctx context.Context,
 		}
 	case aggregation.PropertyTypeText:
 		return ua.textProperty(ctx, prop)
+	case aggregation.PropertyTypeDate:
+		switch dt {
+		case schema.DataTypeDateArray:
+			return ua.dateArrayProperty(ctx, prop)
+		default:
+			return ua.dateProperty(ctx, prop)
+		}
 	case aggregation.PropertyTypeReference:
 		// ignore, as this is handled outside the repo in the uc
 		return nil, nil
@@ -12,6 +12,7 @@
 package aggregator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -39,7 +40,16 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 		Type: aggregation.PropertyTypeBoolean,
 	}
 
-	b := ua.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name.String()))
+	// a bool prop with the "shared" invertedIndexStorage is stored in the
+	// shared bucket, where all of its keys start with the same prefix
+	bucketName := helpers.BucketFromPropNameLSM(prop.Name.String())
+	var prefix []byte
+	if ua.sharedStorage(prop.Name) {
+		bucketName = helpers.BucketFromPropNameLSM(helpers.PropertyNameShared)
+		prefix = helpers.SharedPropPrefix(prop.Name.String())
+	}
+
+	b := ua.store.Bucket(bucketName)
 	if b == nil {
 		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
 	}
@@ -49,8 +59,17 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 	c := b.SetCursor() // bool never has a frequency, so it's always a Set
 	defer c.Close()
 
-	for k, v := c.First(); k != nil; k, v = c.Next() {
-		err := parseFn(agg, k, v)
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
+	k, v := c.First()
+	if prefix != nil {
+		k, v = c.Seek(prefix)
+	}
+
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		err := parseFn(agg, k[len(prefix):], v)
 		if err != nil {
 			return nil, err
 		}
@@ -107,6 +126,10 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 	c := b.SetCursor() // flat never has a frequency, so it's always a Set
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		if err := ua.parseAndAddFloatRow(agg, k, v); err != nil {
 			return nil, err
@@ -135,6 +158,10 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 	c := b.SetCursor() // int never has a frequency, so it's always a Set
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		if err := ua.parseAndAddIntRow(agg, k, v); err != nil {
 			return nil, err
@@ -220,6 +247,10 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	c := b.Cursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		if err := ua.parseAndAddTextRow(agg, v, prop.Name); err != nil {
 			return nil, err
@@ -248,6 +279,10 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 	c := b.Cursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		if err := ua.parseAndAddNumberArrayRow(agg, v, prop.Name); err != nil {
 			return nil, err
@@ -258,3 +293,73 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 
 	return &out, nil
 }
+
+func (ua unfilteredAggregator) dateProperty(ctx context.Context,
+	prop aggregation.ParamProperty) (*aggregation.Property, error) {
+	out := aggregation.Property{
+		Type: aggregation.PropertyTypeDate,
+	}
+
+	b := ua.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name.String()))
+	if b == nil {
+		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
+	}
+
+	agg := newDateAggregator()
+
+	c := b.SetCursor() // dates never have a frequency, so it's always a Set
+	defer c.Close()
+
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 {
+			// dates are indexed as int64 unix nanoseconds
+			return nil, fmt.Errorf("unexpected key length on inverted index, "+
+				"expected 8: got %d", len(k))
+		}
+
+		if err := agg.AddTimestampRow(k, uint64(len(v))); err != nil {
+			return nil, err
+		}
+	}
+
+	out.DateAggregation = agg.Res()
+
+	return &out, nil
+}
+
+func (ua unfilteredAggregator) dateArrayProperty(ctx context.Context,
+	prop aggregation.ParamProperty) (*aggregation.Property, error) {
+	out := aggregation.Property{
+		Type: aggregation.PropertyTypeDate,
+	}
+
+	// the inverted index only holds each date once per object, so the
+	// objects are read instead to count dates which occur several times
+	b := ua.store.Bucket(helpers.ObjectsBucketLSM)
+	if b == nil {
+		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
+	}
+
+	agg := newDateAggregator()
+
+	c := b.Cursor()
+	defer c.Close()
+
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ua.parseAndAddDateArrayRow(agg, v, prop.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	out.DateAggregation = agg.Res()
+
+	return &out, nil
+}
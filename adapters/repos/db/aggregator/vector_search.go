@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// initialVectorSearchLimit is the limit of the first vector search if no
+// objectLimit is set. It is doubled until the threshold cutoff is reached.
+const initialVectorSearchLimit = 100
+
+type vectorIndex interface {
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// docIDs returns the ids of all objects which are part of the aggregation:
+// The objects matching the filters, which are further restricted to the
+// closest neighbors of the search vector if one is set
+func (a *Aggregator) docIDs(ctx context.Context) ([]uint64, error) {
+	if a.params.ObjectIDs != nil {
+		return a.docIDsOfObjects(a.params.ObjectIDs)
+	}
+
+	var allow helpers.AllowList
+	if a.params.Filters != nil {
+		s := a.getSchema.GetSchemaSkipAuth()
+		list, err := inverted.NewSearcher(a.store, s, a.invertedRowCache, nil,
+			a.classSearcher, a.deletedDocIDs).
+			DocIDs(ctx, a.params.Filters, additional.Properties{},
+				a.params.ClassName)
+		if err != nil {
+			return nil, errors.Wrap(err, "retrieve doc IDs from searcher")
+		}
+
+		allow = list
+	}
+
+	if a.params.SearchVector == nil {
+		return flattenAllowList(allow), nil
+	}
+
+	return a.vectorSearchDocIDs(allow)
+}
+
+// docIDsOfObjects returns the doc ids of those objects which are stored in
+// this shard, all others are skipped
+func (a *Aggregator) docIDsOfObjects(ids []strfmt.UUID) ([]uint64, error) {
+	bucket := a.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil, errors.Errorf("objects bucket not found")
+	}
+
+	out := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := bucket.Get(idBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "object %s", id)
+		}
+
+		if data == nil {
+			continue
+		}
+
+		docID, err := storobj.DocIDFromBinary(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "object %s", id)
+		}
+
+		out = append(out, docID)
+	}
+
+	return out, nil
+}
+
+func (a *Aggregator) vectorSearchDocIDs(allow helpers.AllowList) ([]uint64, error) {
+	var ids []uint64
+	matching, err := SearchWithinThreshold(a.params, a.getSchema.GetSchemaSkipAuth(),
+		func(limit int) ([]float32, error) {
+			var dists []float32
+			var err error
+			ids, dists, err = a.vectorIndex.SearchByVector(a.params.SearchVector,
+				limit, allow)
+			if err != nil {
+				return nil, errors.Wrap(err, "vector search")
+			}
+
+			return dists, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids[:matching], nil
+}
+
+// SearchWithinThreshold runs a vector search limited to the objectLimit of
+// the params. Without an objectLimit only the certainty or distance limits the
+// results, so the search is repeated with a growing limit until either some
+// results fall outside the threshold or there are no more objects. The search
+// returns the distances of its results ordered by distance, the number of
+// results of its last call which are within the threshold is returned.
+func SearchWithinThreshold(params aggregation.Params, s schema.Schema,
+	search func(limit int) ([]float32, error)) (int, error) {
+	if params.ObjectLimit != nil {
+		dists, err := search(*params.ObjectLimit)
+		if err != nil {
+			return 0, err
+		}
+
+		return cutOffByThreshold(params, s, dists)
+	}
+
+	limit := initialVectorSearchLimit
+	for {
+		dists, err := search(limit)
+		if err != nil {
+			return 0, err
+		}
+
+		matching, err := cutOffByThreshold(params, s, dists)
+		if err != nil {
+			return 0, err
+		}
+
+		if matching < len(dists) || len(dists) < limit {
+			return matching, nil
+		}
+
+		limit *= 2
+	}
+}
+
+// cutOffByThreshold returns how many results are within the requested
+// distance or certainty. The results of a vector search are ordered by
+// distance, so everything after the first result outside the threshold can
+// be skipped.
+func cutOffByThreshold(params aggregation.Params, s schema.Schema,
+	dists []float32) (int, error) {
+	if params.WithDistance {
+		for i, dist := range dists {
+			if float64(dist) > params.Distance {
+				return i, nil
+			}
+		}
+
+		return len(dists), nil
+	}
+
+	if params.Certainty == 0 {
+		// nothing to cut off, this also allows aggregating over the results of
+		// metrics which don't support certainty
+		return len(dists), nil
+	}
+
+	metric := traverser.DistanceMetric(s, params.ClassName.String())
+	for i, dist := range dists {
+		certainty, err := traverser.CertaintyFromDistance(metric, dist)
+		if err != nil {
+			return 0, err
+		}
+
+		if float64(certainty) < params.Certainty {
+			return i, nil
+		}
+	}
+
+	return len(dists), nil
+}
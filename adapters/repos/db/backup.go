@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/geo"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// BeginShardBackup prepares a local shard for a backup and returns the
+// paths of all files which make up the shard, relative to the root path.
+// Until EndShardBackup is called, those files are neither changed nor
+// removed by background maintenance, such as compactions. Data written after
+// the call is not part of the listed files.
+func (d *DB) BeginShardBackup(ctx context.Context, className,
+	shardName string) ([]string, error) {
+	shard, err := d.localShardForBackup(className, shardName)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := shard.beginBackup(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %q", shardName)
+	}
+
+	out := make([]string, len(files))
+	for i, file := range files {
+		rel, err := filepath.Rel(d.config.RootPath, file)
+		if err != nil {
+			shard.endBackup()
+			return nil, errors.Wrapf(err, "shard %q: file %q", shardName, file)
+		}
+		out[i] = filepath.ToSlash(rel)
+	}
+
+	return out, nil
+}
+
+// EndShardBackup resumes the background maintenance of a shard after a
+// backup, see BeginShardBackup
+func (d *DB) EndShardBackup(className, shardName string) error {
+	shard, err := d.localShardForBackup(className, shardName)
+	if err != nil {
+		return err
+	}
+
+	shard.endBackup()
+	return nil
+}
+
+func (d *DB) localShardForBackup(className, shardName string) (*Shard, error) {
+	index := d.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return nil, errortypes.New(errortypes.KindNotFound,
+			"class %q does not exist", className)
+	}
+
+	shard, ok := index.localShard(shardName)
+	if !ok {
+		return nil, errortypes.New(errortypes.KindNotFound,
+			"shard %q of class %q does not exist on this node", shardName,
+			className)
+	}
+
+	return shard, nil
+}
+
+// beginBackup pauses all maintenance which could change or remove files and
+// then makes sure all data written so far is contained in files which are no
+// longer written to. The vector index is switched before the LSM store is
+// flushed, so that the backup never contains a vector for an object which
+// isn't contained in the backup as well.
+func (s *Shard) beginBackup(ctx context.Context) ([]string, error) {
+	if !atomic.CompareAndSwapInt32(&s.backupInProgress, 0, 1) {
+		return nil, errortypes.New(errortypes.KindConflict,
+			"a backup of this shard is already in progress")
+	}
+
+	s.vectorIndex.PauseMaintenance()
+	s.backupGeoIndices = map[string]*geo.Index{}
+	for propName, propIndex := range s.propertyIndices {
+		propIndex.GeoIndex.PauseMaintenance()
+		s.backupGeoIndices[propName] = propIndex.GeoIndex
+	}
+	s.store.PauseCompaction()
+
+	files, err := s.listBackupFiles()
+	if err != nil {
+		s.endBackup()
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func (s *Shard) listBackupFiles() ([]string, error) {
+	if err := s.vectorIndex.SwitchCommitLogs(); err != nil {
+		return nil, errors.Wrap(err, "switch vector index commit logs")
+	}
+
+	files, err := s.vectorIndex.ListFiles()
+	if err != nil {
+		return nil, errors.Wrap(err, "list vector index files")
+	}
+
+	for propName, geoIndex := range s.backupGeoIndices {
+		if err := geoIndex.SwitchCommitLogs(); err != nil {
+			return nil, errors.Wrapf(err, "switch commit logs of property %q", propName)
+		}
+
+		propFiles, err := geoIndex.ListFiles()
+		if err != nil {
+			return nil, errors.Wrapf(err, "list files of property %q", propName)
+		}
+		files = append(files, propFiles...)
+	}
+
+	if err := s.store.FlushMemtables(); err != nil {
+		return nil, errors.Wrap(err, "flush memtables")
+	}
+
+	files = append(files, s.store.ListFiles()...)
+	files = append(files, s.counter.FileName())
+	if s.multiVectors != nil {
+		files = append(files, s.multiVectors.counter.FileName())
+	}
+
+	return files, nil
+}
+
+func (s *Shard) endBackup() {
+	if !atomic.CompareAndSwapInt32(&s.backupInProgress, 1, 0) {
+		return
+	}
+
+	s.store.ResumeCompaction()
+	for _, geoIndex := range s.backupGeoIndices {
+		geoIndex.ResumeMaintenance()
+	}
+	s.backupGeoIndices = nil
+	s.vectorIndex.ResumeMaintenance()
+}
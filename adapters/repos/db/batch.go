@@ -13,7 +13,9 @@ package db
 
 import (
 	"context"
+	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
@@ -86,3 +88,54 @@ func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchRe
 
 	return references, nil
 }
+
+// BatchDeleteObjects deletes the objects of a class matching the filter
+// across all shards. Matches counts all objects matching the filter, but only
+// the first params.Limit of them are deleted. Shards are visited in a stable
+// order so repeated calls delete the remaining matches.
+func (db *DB) BatchDeleteObjects(ctx context.Context,
+	params objects.BatchDeleteParams) (objects.BatchDeleteResult, error) {
+	idx := db.GetIndex(params.ClassName)
+	if idx == nil {
+		return objects.BatchDeleteResult{}, errors.Errorf("cannot delete objects "+
+			"from non-existing index for %s", params.ClassName)
+	}
+
+	shardDocIDs, err := idx.findDocIDs(ctx, params.Filters)
+	if err != nil {
+		return objects.BatchDeleteResult{}, errors.Wrapf(err,
+			"cannot find objects to delete in %s", params.ClassName)
+	}
+
+	shardNames := make([]string, 0, len(shardDocIDs))
+	for shardName := range shardDocIDs {
+		shardNames = append(shardNames, shardName)
+	}
+	sort.Strings(shardNames)
+
+	toDelete := make(map[string][]uint64, len(shardDocIDs))
+	matches := int64(0)
+	for _, shardName := range shardNames {
+		docIDs := shardDocIDs[shardName]
+		if remaining := params.Limit - matches; remaining > 0 {
+			if int64(len(docIDs)) > remaining {
+				toDelete[shardName] = docIDs[:remaining]
+			} else {
+				toDelete[shardName] = docIDs
+			}
+		}
+		matches += int64(len(docIDs))
+	}
+
+	deleted, err := idx.batchDeleteObjects(ctx, toDelete, params.DryRun)
+	if err != nil {
+		return objects.BatchDeleteResult{}, errors.Wrapf(err,
+			"cannot delete objects in %s", params.ClassName)
+	}
+
+	return objects.BatchDeleteResult{
+		Matches: matches,
+		Limit:   params.Limit,
+		Objects: deleted,
+	}, nil
+}
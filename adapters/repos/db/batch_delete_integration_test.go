@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchDeleteObjects(t *testing.T) {
+	className := "BatchDeleteTestClass"
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "boolProp",
+			DataType: []string{string(schema.DataTypeBoolean)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: multiShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	toDelete := map[strfmt.UUID]struct{}{}
+	t.Run("importing objects", func(t *testing.T) {
+		for i := 0; i < 40; i++ {
+			obj := &models.Object{
+				Class: className,
+				ID:    mustNewUUID(),
+				Properties: map[string]interface{}{
+					"boolProp": i%2 == 0,
+				},
+				Vector: []float32{0.1},
+			}
+
+			if i%2 == 0 {
+				toDelete[obj.ID] = struct{}{}
+			}
+
+			require.Nil(t, repo.PutObject(context.Background(), obj, obj.Vector))
+		}
+	})
+
+	params := func(dryRun bool, limit int64) objects.BatchDeleteParams {
+		return objects.BatchDeleteParams{
+			ClassName: schema.ClassName(className),
+			Filters:   buildFilter("boolProp", true, eq, dtBool),
+			DryRun:    dryRun,
+			Limit:     limit,
+		}
+	}
+
+	countObjects := func(t *testing.T) int {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 100},
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+
+	t.Run("a dry run lists, but does not delete the matches", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), params(true, 100))
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(20), res.Matches)
+		assert.Equal(t, int64(100), res.Limit)
+		require.Len(t, res.Objects, 20)
+		for _, obj := range res.Objects {
+			assert.Nil(t, obj.Err)
+			assert.Contains(t, toDelete, obj.UUID)
+		}
+
+		assert.Equal(t, 40, countObjects(t))
+	})
+
+	t.Run("deleting at most the limit of the matches", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), params(false, 15))
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(20), res.Matches)
+		assert.Equal(t, int64(15), res.Limit)
+		require.Len(t, res.Objects, 15)
+		for _, obj := range res.Objects {
+			assert.Nil(t, obj.Err)
+			assert.Contains(t, toDelete, obj.UUID)
+		}
+
+		assert.Equal(t, 25, countObjects(t))
+	})
+
+	t.Run("deleting the remaining matches", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), params(false, 15))
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(5), res.Matches)
+		require.Len(t, res.Objects, 5)
+
+		assert.Equal(t, 20, countObjects(t))
+	})
+
+	t.Run("the deleted objects can no longer be found", func(t *testing.T) {
+		for id := range toDelete {
+			res, err := repo.ObjectByID(context.Background(), id, nil,
+				additional.Properties{})
+			require.Nil(t, err)
+			assert.Nil(t, res)
+		}
+
+		res, err := repo.BatchDeleteObjects(context.Background(), params(false, 15))
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), res.Matches)
+		assert.Len(t, res.Objects, 0)
+	})
+}
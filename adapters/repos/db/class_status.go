@@ -0,0 +1,282 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+const (
+	AdvisorStatusRunning   = "RUNNING"
+	AdvisorStatusCompleted = "COMPLETED"
+	AdvisorStatusFailed    = "FAILED"
+
+	DefaultAdvisorSampleSize = 2000
+)
+
+// ClassStatus contains runtime information about a class which is not part
+// of the schema, such as the outcome of background jobs. It is purely
+// informative, nothing in here is ever applied automatically.
+type ClassStatus struct {
+	Class             string
+	VectorIndexAdvice *VectorIndexAdvice
+}
+
+// VectorIndexAdvice is the state of the most recent vector index advisor run
+// for a class. Once completed, Report.Recommended contains the settings an
+// operator can apply through a regular class update.
+type VectorIndexAdvice struct {
+	Status      string
+	StartedAt   time.Time
+	CompletedAt *time.Time
+	Error       string
+	Report      *hnsw.TuningReport
+}
+
+type AdvisorParams struct {
+	SampleSize   int
+	TargetRecall float64
+	K            int
+}
+
+func (s ClassStatus) toModel() *models.ClassStatus {
+	out := &models.ClassStatus{Class: s.Class}
+	if s.VectorIndexAdvice == nil {
+		return out
+	}
+
+	advice := s.VectorIndexAdvice
+	out.VectorIndexAdvice = &models.VectorIndexAdvice{
+		Status:  advice.Status,
+		Started: strfmt.DateTime(advice.StartedAt),
+		Error:   advice.Error,
+	}
+	if advice.CompletedAt != nil {
+		out.VectorIndexAdvice.Completed = strfmt.DateTime(*advice.CompletedAt)
+	}
+	if advice.Report != nil {
+		out.VectorIndexAdvice.Report = advice.Report
+	}
+
+	return out
+}
+
+type classStatus struct {
+	sync.Mutex
+	vectorIndexAdvice *VectorIndexAdvice
+}
+
+func (s *classStatus) get(className string) ClassStatus {
+	s.Lock()
+	defer s.Unlock()
+
+	out := ClassStatus{Class: className}
+	if s.vectorIndexAdvice != nil {
+		advice := *s.vectorIndexAdvice
+		out.VectorIndexAdvice = &advice
+	}
+
+	return out
+}
+
+func (s *classStatus) setAdvice(advice VectorIndexAdvice) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.vectorIndexAdvice = &advice
+}
+
+// ClassStatus returns the runtime status of the specified class on this node
+func (d *DB) ClassStatus(className schema.ClassName) (ClassStatus, error) {
+	index := d.GetIndex(className)
+	if index == nil {
+		return ClassStatus{}, errortypes.New(errortypes.KindNotFound,
+			"class %q not found", className)
+	}
+
+	return index.status.get(className.String()), nil
+}
+
+// StartVectorIndexAdvisor samples vectors from all local shards of the class
+// and explores a grid of hnsw parameters on them in the background. The
+// outcome is written to the class status. Only one advisor run per class can
+// be active at a time, as every run builds several in-memory indexes of the
+// sample.
+func (d *DB) StartVectorIndexAdvisor(className schema.ClassName,
+	params AdvisorParams) error {
+	index := d.GetIndex(className)
+	if index == nil {
+		return errortypes.New(errortypes.KindNotFound,
+			"class %q not found", className)
+	}
+
+	if err := params.validate(); err != nil {
+		return err
+	}
+
+	cfg, ok := index.vectorIndexUserConfig.(hnsw.UserConfig)
+	if !ok {
+		return errortypes.New(errortypes.KindValidation,
+			"class %q does not use an hnsw vector index", className)
+	}
+
+	distanceProvider, err := distancer.ProviderForMetric(cfg.Distance)
+	if err != nil {
+		return errortypes.Wrap(errortypes.KindValidation, err)
+	}
+
+	index.status.Lock()
+	if current := index.status.vectorIndexAdvice; current != nil &&
+		current.Status == AdvisorStatusRunning {
+		index.status.Unlock()
+		return errortypes.New(errortypes.KindConflict,
+			"vector index advisor for class %q is already running", className)
+	}
+	index.status.vectorIndexAdvice = &VectorIndexAdvice{
+		Status:    AdvisorStatusRunning,
+		StartedAt: time.Now(),
+	}
+	index.status.Unlock()
+
+	go index.runVectorIndexAdvisor(params, distanceProvider)
+	return nil
+}
+
+func (p AdvisorParams) validate() error {
+	if p.SampleSize < 0 {
+		return errortypes.New(errortypes.KindValidation,
+			"sampleSize must not be negative, got %d", p.SampleSize)
+	}
+
+	if p.K < 0 {
+		return errortypes.New(errortypes.KindValidation,
+			"k must not be negative, got %d", p.K)
+	}
+
+	if p.TargetRecall < 0 || p.TargetRecall > 1 {
+		return errortypes.New(errortypes.KindValidation,
+			"targetRecall must be between 0 and 1, got %v", p.TargetRecall)
+	}
+
+	return nil
+}
+
+func (i *Index) runVectorIndexAdvisor(params AdvisorParams,
+	distanceProvider distancer.Provider) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	advice := i.status.get(i.Config.ClassName.String()).VectorIndexAdvice
+	report, err := i.adviseVectorIndex(ctx, params, distanceProvider)
+	completed := time.Now()
+	advice.CompletedAt = &completed
+	if err != nil {
+		i.logger.WithField("action", "vector_index_advisor").
+			WithField("class", i.Config.ClassName).
+			WithError(err).Error("vector index advisor failed")
+		advice.Status = AdvisorStatusFailed
+		advice.Error = err.Error()
+	} else {
+		advice.Status = AdvisorStatusCompleted
+		advice.Report = report
+	}
+
+	i.status.setAdvice(*advice)
+}
+
+func (i *Index) adviseVectorIndex(ctx context.Context, params AdvisorParams,
+	distanceProvider distancer.Provider) (*hnsw.TuningReport, error) {
+	if params.SampleSize <= 0 {
+		params.SampleSize = DefaultAdvisorSampleSize
+	}
+
+	vectors, err := i.sampleVectors(ctx, params.SampleSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "sample vectors")
+	}
+
+	if len(vectors) == 0 {
+		return nil, errors.Errorf("class has no vectors to sample")
+	}
+
+	advisor, err := hnsw.NewTuningAdvisor(hnsw.TuningAdvisorConfig{
+		Vectors:          vectors,
+		K:                params.K,
+		TargetRecall:     params.TargetRecall,
+		DistanceProvider: distanceProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return advisor.Run(ctx)
+}
+
+// sampleVectors draws a uniform sample of at most n vectors across all local
+// shards using reservoir sampling, so the entire class never has to be held
+// in memory
+func (i *Index) sampleVectors(ctx context.Context, n int) ([][]float32, error) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sample := make([][]float32, 0, n)
+	seen := 0
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for name, shard := range i.Shards {
+		cursor := shard.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+		if err := cursor.Err(); err != nil {
+			cursor.Close()
+			return nil, errors.Wrapf(err, "shard %s", name)
+		}
+
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if err := ctx.Err(); err != nil {
+				cursor.Close()
+				return nil, err
+			}
+
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				cursor.Close()
+				return nil, errors.Wrapf(err, "shard %s: unmarshal object", name)
+			}
+
+			if len(obj.Vector) == 0 {
+				continue
+			}
+
+			seen++
+			if len(sample) < n {
+				sample = append(sample, obj.Vector)
+			} else if pos := r.Intn(seen); pos < n {
+				sample[pos] = obj.Vector
+			}
+		}
+		cursor.Close()
+	}
+
+	return sample, nil
+}
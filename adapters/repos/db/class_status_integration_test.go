@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassStatus_VectorIndexAdvisor(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer os.RemoveAll(dirName)
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "AdvisedClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	require.Nil(t,
+		migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	t.Run("status of a class which does not exist", func(t *testing.T) {
+		_, err := migrator.ClassStatus(context.Background(), "NotAClass")
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+
+	t.Run("status before any advisor run", func(t *testing.T) {
+		status, err := migrator.ClassStatus(context.Background(), "AdvisedClass")
+		require.Nil(t, err)
+		assert.Equal(t, "AdvisedClass", status.Class)
+		assert.Nil(t, status.VectorIndexAdvice)
+	})
+
+	t.Run("invalid advisor params", func(t *testing.T) {
+		_, err := migrator.StartVectorIndexAdvisor(context.Background(), "AdvisedClass",
+			&models.VectorIndexAdviceRequest{TargetRecall: 1.5})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+
+	t.Run("only one run per class at a time", func(t *testing.T) {
+		index := repo.GetIndex("AdvisedClass")
+		index.status.setAdvice(VectorIndexAdvice{
+			Status:    AdvisorStatusRunning,
+			StartedAt: time.Now(),
+		})
+
+		_, err := migrator.StartVectorIndexAdvisor(context.Background(), "AdvisedClass",
+			&models.VectorIndexAdviceRequest{})
+		assert.True(t, errortypes.Is(err, errortypes.KindConflict))
+
+		status, err := migrator.ClassStatus(context.Background(), "AdvisedClass")
+		require.Nil(t, err)
+		require.NotNil(t, status.VectorIndexAdvice)
+		assert.Equal(t, models.VectorIndexAdviceStatusRUNNING, status.VectorIndexAdvice.Status)
+	})
+
+	t.Run("a run on a class without vectors fails", func(t *testing.T) {
+		index := repo.GetIndex("AdvisedClass")
+		index.status.setAdvice(VectorIndexAdvice{Status: AdvisorStatusCompleted})
+
+		status, err := migrator.StartVectorIndexAdvisor(context.Background(), "AdvisedClass",
+			&models.VectorIndexAdviceRequest{})
+		require.Nil(t, err)
+		require.NotNil(t, status.VectorIndexAdvice)
+
+		assert.Eventually(t, func() bool {
+			status, err := migrator.ClassStatus(context.Background(), "AdvisedClass")
+			return err == nil && status.VectorIndexAdvice.Status ==
+				models.VectorIndexAdviceStatusFAILED
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
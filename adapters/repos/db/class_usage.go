@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+)
+
+// ClassUsage returns the number of objects and the size on disk of all shards
+// of the class which are held by this node. A class which does not exist
+// (yet) has no usage. Both numbers are determined by scanning the shards, so
+// the result should be cached by the caller.
+func (d *DB) ClassUsage(ctx context.Context,
+	className string) (objects.ClassUsage, error) {
+	var out objects.ClassUsage
+
+	index := d.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return out, nil
+	}
+
+	index.shardsLock.RLock()
+	defer index.shardsLock.RUnlock()
+
+	for name, shard := range index.Shards {
+		count, err := shard.objectCount(ctx)
+		if err != nil {
+			return out, errors.Wrapf(err, "shard %s: count objects", name)
+		}
+
+		size, err := shard.diskUsage()
+		if err != nil {
+			return out, errors.Wrapf(err, "shard %s: disk usage", name)
+		}
+
+		out.Objects += count
+		out.DiskBytes += size
+	}
+
+	return out, nil
+}
+
+func (s *Shard) objectCount(ctx context.Context) (int64, error) {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	if err := cursor.Err(); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		count++
+		if count%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// diskUsage sums up the sizes of all files of the shard. They all share the
+// shard ID as a prefix, e.g. the lsm store, the commit logs of the vector
+// index and of the geo indices, and the index counter.
+func (s *Shard) diskUsage() (int64, error) {
+	matches, err := filepath.Glob(filepath.Join(s.index.Config.RootPath, s.ID()) + "[._]*")
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, match := range matches {
+		err := filepath.Walk(match, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					// removed by a concurrent compaction or condensing
+					return nil
+				}
+				return err
+			}
+
+			if !info.IsDir() {
+				size += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, errors.Wrapf(err, "walk %s", match)
+		}
+	}
+
+	return size, nil
+}
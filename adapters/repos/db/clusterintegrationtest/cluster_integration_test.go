@@ -18,7 +18,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
@@ -224,6 +223,70 @@ func testDistributed(t *testing.T, dirName string, batch bool) {
 		}
 	})
 
+	t.Run("paginate filtered vector searches", func(t *testing.T) {
+		// every page is retrieved from a random node, the concatenated pages must
+		// match the ground truth exactly, without any duplicates or gaps
+		// between pages. Filtered searches on this small dataset are below the
+		// flat search cutoff, so each shard's search is exact.
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorLessThan,
+				On: &filters.Path{
+					Class:    "Distributed",
+					Property: "index",
+				},
+				Value: &filters.Value{
+					Value: float64(150),
+					Type:  schema.DataTypeNumber,
+				},
+			},
+		}
+
+		var filtered []*models.Object
+		for _, obj := range data {
+			if obj.Properties.(map[string]interface{})["index"].(float64) < 150 {
+				filtered = append(filtered, obj)
+			}
+		}
+
+		runs := 5
+		pageSize := 10
+		pages := 6
+
+		for i := 0; i < runs; i++ {
+			query := make([]float32, vectorDims)
+			for i := range query {
+				query[i] = rand.Float32()
+			}
+
+			groundTruth := bruteForceObjectsByQuery(filtered, query)
+			seen := map[strfmt.UUID]struct{}{}
+
+			for page := 0; page < pages; page++ {
+				node := nodes[rand.Intn(len(nodes))]
+				res, err := node.repo.VectorClassSearch(context.Background(), traverser.GetParams{
+					SearchVector: query,
+					Pagination: &filters.Pagination{
+						Offset: page * pageSize,
+						Limit:  pageSize,
+					},
+					Filters:   filter,
+					ClassName: "Distributed",
+				})
+				require.Nil(t, err)
+				require.Len(t, res, pageSize)
+
+				for j, obj := range res {
+					pos := page*pageSize + j
+					_, duplicate := seen[obj.ID]
+					assert.False(t, duplicate, fmt.Sprintf("duplicate at pos %d", pos))
+					seen[obj.ID] = struct{}{}
+					assert.Equal(t, groundTruth[pos].ID, obj.ID, fmt.Sprintf("at pos %d", pos))
+				}
+			}
+		}
+	})
+
 	t.Run("query individually and resolve references", func(t *testing.T) {
 		for _, obj := range refData {
 			// if i == 5 {
@@ -289,6 +352,37 @@ func testDistributed(t *testing.T, dirName string, batch bool) {
 
 		assert.Equal(t, expectedResult, res)
 	})
+
+	t.Run("delete individually using random nodes", func(t *testing.T) {
+		deleted := data[:20]
+		for _, obj := range deleted {
+			node := nodes[rand.Intn(len(nodes))]
+
+			err := node.repo.DeleteObject(context.Background(), obj.Class, obj.ID)
+			require.Nil(t, err)
+		}
+
+		for _, obj := range deleted {
+			node := nodes[rand.Intn(len(nodes))]
+
+			ok, err := node.repo.Exists(context.Background(), obj.ID)
+			require.Nil(t, err)
+			assert.False(t, ok)
+
+			res, err := node.repo.ObjectByID(context.Background(), obj.ID,
+				search.SelectProperties{}, additional.Properties{})
+			require.Nil(t, err)
+			assert.Nil(t, res)
+		}
+
+		for _, obj := range data[len(deleted):] {
+			node := nodes[rand.Intn(len(nodes))]
+
+			ok, err := node.repo.Exists(context.Background(), obj.ID)
+			require.Nil(t, err)
+			assert.True(t, ok)
+		}
+	})
 }
 
 func setupDirectory() (string, func()) {
@@ -420,7 +514,7 @@ func multiShardState(nodeCount int) *sharding.State {
 	}
 
 	s, err := sharding.InitState("multi-shard-test-index", config,
-		fakeNodes{nodeList})
+		fakeNodes{nodeList}, 1)
 	if err != nil {
 		panic(err)
 	}
@@ -488,6 +582,10 @@ func class() *models.Class {
 				Name:     "description",
 				DataType: []string{string(schema.DataTypeText)},
 			},
+			{
+				Name:     "index",
+				DataType: []string{string(schema.DataTypeNumber)},
+			},
 		},
 	}
 }
@@ -531,6 +629,7 @@ func exampleData(size int) []*models.Object {
 			ID:    strfmt.UUID(uuid.New().String()),
 			Properties: map[string]interface{}{
 				"description": fmt.Sprintf("object-%d", i),
+				"index":       float64(i),
 			},
 			Vector: vec,
 		}
@@ -568,6 +667,10 @@ func exampleDataWithRefs(size int, refCount int, targetObjs []*models.Object) []
 	return out
 }
 
+// bruteForceObjectsByQuery orders the objects exactly like a vector search
+// of a class with the default cosine distance: The distance is calculated
+// with the same distancer on vectors normalized the same way, ties are broken
+// by id. The vectors of the objects are not modified.
 func bruteForceObjectsByQuery(objs []*models.Object,
 	query []float32) []*models.Object {
 	type distanceAndObj struct {
@@ -576,10 +679,12 @@ func bruteForceObjectsByQuery(objs []*models.Object,
 	}
 
 	distProv := distancer.NewDotProductProvider()
+	normalizedQuery := distancer.Normalize(query)
 	distances := make([]distanceAndObj, len(objs))
 
 	for i := range objs {
-		dist, _, _ := distProv.SingleDist(normalize(query), normalize(objs[i].Vector))
+		dist, _, _ := distProv.SingleDist(distancer.Normalize(objs[i].Vector),
+			normalizedQuery)
 		distances[i] = distanceAndObj{
 			distance: dist,
 			obj:      objs[i],
@@ -587,7 +692,11 @@ func bruteForceObjectsByQuery(objs []*models.Object,
 	}
 
 	sort.Slice(distances, func(a, b int) bool {
-		return distances[a].distance < distances[b].distance
+		if distances[a].distance != distances[b].distance {
+			return distances[a].distance < distances[b].distance
+		}
+
+		return distances[a].obj.ID < distances[b].obj.ID
 	})
 
 	out := make([]*models.Object, len(objs))
@@ -598,20 +707,6 @@ func bruteForceObjectsByQuery(objs []*models.Object,
 	return out
 }
 
-func normalize(v []float32) []float32 {
-	var norm float32
-	for i := range v {
-		norm += v[i] * v[i]
-	}
-
-	norm = float32(math.Sqrt(float64(norm)))
-	for i := range v {
-		v[i] = v[i] / norm
-	}
-
-	return v
-}
-
 func manuallyResolveRef(t *testing.T, obj *models.Object,
 	possibleTargets []*models.Object, localPropName,
 	referencedPropName string) []map[string]interface{} {
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// commitLogStatser is implemented by the vector indexes which persist their
+// graph in commit logs
+type commitLogStatser interface {
+	CommitLogStats() hnsw.CommitLogStats
+}
+
+func (d *DB) collectCommitLogMetrics() []*monitoring.Family {
+	files := monitoring.NewGauge("weaviate_vector_index_commit_log_files",
+		"Number of commit log files of the vector index of a shard, all of them are read on startup",
+		"class", "shard")
+	bytes := monitoring.NewGauge("weaviate_vector_index_commit_log_bytes",
+		"Size of the commit log files of the vector index of a shard, all of them are read on startup",
+		"class", "shard")
+	condensings := monitoring.NewCounter("weaviate_vector_index_commit_log_condensings_total",
+		"Number of commit log files of the vector index of a shard which were condensed since startup",
+		"class", "shard")
+	condensedInput := monitoring.NewCounter("weaviate_vector_index_commit_log_condensed_input_bytes_total",
+		"Size of the commit log files of the vector index of a shard before they were condensed",
+		"class", "shard")
+	condensedOutput := monitoring.NewCounter("weaviate_vector_index_commit_log_condensed_output_bytes_total",
+		"Size of the commit log files of the vector index of a shard after they were condensed",
+		"class", "shard")
+	combinings := monitoring.NewCounter("weaviate_vector_index_commit_log_combinings_total",
+		"Number of times two condensed commit log files of the vector index of a shard were combined since startup",
+		"class", "shard")
+
+	for _, index := range d.indices {
+		class := index.Config.ClassName.String()
+		index.shardsLock.RLock()
+		for name, shard := range index.Shards {
+			statser, ok := shard.vectorIndex.(commitLogStatser)
+			if !ok {
+				continue
+			}
+
+			stats := statser.CommitLogStats()
+			files.Add(float64(stats.Files), class, name)
+			bytes.Add(float64(stats.Bytes), class, name)
+			condensings.Add(float64(stats.Condensings), class, name)
+			condensedInput.Add(float64(stats.CondensedInputBytes), class, name)
+			condensedOutput.Add(float64(stats.CondensedOutputBytes), class, name)
+			combinings.Add(float64(stats.Combinings), class, name)
+		}
+		index.shardsLock.RUnlock()
+	}
+
+	return []*monitoring.Family{
+		files, bytes, condensings, condensedInput, condensedOutput, combinings,
+	}
+}
@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -26,6 +27,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 )
 
@@ -99,30 +101,53 @@ func (d *DB) MultiGet(ctx context.Context,
 	return out, nil
 }
 
-// ObjectByID checks every index of the particular kind for the ID
+// ObjectByID checks every index for the ID. Only the shard owning the ID is
+// queried in each index. Indexes where that shard is local are checked first,
+// as they do not require any network calls, the remaining ones are queried in
+// parallel on their owning nodes.
 func (d *DB) ObjectByID(ctx context.Context, id strfmt.UUID,
 	props search.SelectProperties,
 	additional additional.Properties) (*search.Result, error) {
-	var result *search.Result
-	// TODO: Search in parallel, rather than sequentially or this will be
-	// painfully slow on large schemas
-	for _, index := range d.indices {
+	local, remote, err := d.indicesByShardLocality(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj *storobj.Object
+	for _, index := range local {
 		res, err := index.objectByID(ctx, id, props, additional)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
 
 		if res != nil {
-			result = res.SearchResult(additional)
+			obj = res
 			break
 		}
 	}
 
-	if result == nil {
+	if obj == nil {
+		remoteResults := make([]*storobj.Object, len(remote))
+		pos, err := firstHit(ctx, remote, func(ctx context.Context, pos int,
+			index *Index) (bool, error) {
+			res, err := index.objectByID(ctx, id, props, additional)
+			remoteResults[pos] = res
+			return res != nil, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if pos >= 0 {
+			obj = remoteResults[pos]
+		}
+	}
+
+	if obj == nil {
 		return nil, nil
 	}
 
-	return d.enrichRefsForSingle(ctx, result, props, additional)
+	return d.enrichRefsForSingle(ctx, obj.SearchResult(additional), props, additional)
 }
 
 func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
@@ -136,10 +161,15 @@ func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 	return &res[0], nil
 }
 
+// Exists checks every index for the ID, see ObjectByID for how the owning
+// shards are queried
 func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	// TODO: Search in parallel, rather than sequentially or this will be
-	// painfully slow on large schemas
-	for _, index := range d.indices {
+	local, remote, err := d.indicesByShardLocality(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	for _, index := range local {
 		ok, err := index.exists(ctx, id)
 		if err != nil {
 			return false, errors.Wrapf(err, "search index %s", index.ID())
@@ -149,7 +179,96 @@ func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 		}
 	}
 
-	return false, nil
+	pos, err := firstHit(ctx, remote, func(ctx context.Context, pos int,
+		index *Index) (bool, error) {
+		return index.exists(ctx, id)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return pos >= 0, nil
+}
+
+// indicesByShardLocality splits all indexes into those where the shard owning
+// the ID is hosted on this node and those where it is hosted on another node.
+// Indexes which cannot serve the tenant of the request are skipped.
+func (d *DB) indicesByShardLocality(ctx context.Context,
+	id strfmt.UUID) ([]*Index, []*Index, error) {
+	var local, remote []*Index
+	for _, index := range d.indices {
+		if !index.acceptsTenant(tenant.FromContext(ctx)) {
+			continue
+		}
+
+		ok, err := index.ownsLocally(ctx, id)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "index %s", index.ID())
+		}
+
+		if ok {
+			local = append(local, index)
+		} else {
+			remote = append(remote, index)
+		}
+	}
+
+	return local, remote, nil
+}
+
+// firstHit runs check against all indexes in parallel and returns the
+// position of the first index reporting a hit, or -1 if there was none. As
+// soon as there is a hit, the context passed to the remaining checks is
+// cancelled and their errors are ignored.
+func firstHit(ctx context.Context, indices []*Index,
+	check func(ctx context.Context, pos int, index *Index) (bool, error)) (int, error) {
+	if len(indices) == 0 {
+		return -1, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	mutex := &sync.Mutex{}
+	hit := -1
+	var firstErr error
+
+	for pos, index := range indices {
+		wg.Add(1)
+		go func(pos int, index *Index) {
+			defer wg.Done()
+
+			ok, err := check(ctx, pos, index)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if hit >= 0 {
+				return
+			}
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "search index %s", index.ID())
+				}
+				return
+			}
+
+			if ok {
+				hit = pos
+				cancel()
+			}
+		}(pos, index)
+	}
+
+	wg.Wait()
+
+	if hit >= 0 {
+		return hit, nil
+	}
+
+	return -1, firstErr
 }
 
 func (d *DB) AddReference(ctx context.Context,
@@ -163,7 +282,7 @@ func (d *DB) AddReference(ctx context.Context,
 	return d.Merge(ctx, objects.MergeDocument{
 		Class:      className,
 		ID:         source,
-		UpdateTime: time.Now().UnixNano(),
+		UpdateTime: time.Now().UnixNano() / int64(time.Millisecond),
 		References: objects.BatchReferences{
 			objects.BatchReference{
 				From: crossref.NewSource(schema.ClassName(className),
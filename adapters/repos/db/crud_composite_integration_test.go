@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_CompositeIndexes(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:             "ClassWithCompositeIndex",
+		VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			CompositeIndexes:       [][]string{{"tenant", "status"}},
+		},
+		Properties: []*models.Property{
+			{
+				Name:     "tenant",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			{
+				Name:     "status",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			{
+				Name:     "priority",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	first := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	second := strfmt.UUID("0f6d3e1c-7b3a-4b57-9d1f-0b9a2f2d5c11")
+	third := strfmt.UUID("5a1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+	fourth := strfmt.UUID("c2a8a1f4-1f3e-4c36-a1a4-6b5f5d3e2c10")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:    first,
+			Class: "ClassWithCompositeIndex",
+			Properties: map[string]interface{}{
+				"tenant":   "tenantA",
+				"status":   "open",
+				"priority": int64(1),
+			},
+		}, {
+			ID:    second,
+			Class: "ClassWithCompositeIndex",
+			Properties: map[string]interface{}{
+				"tenant":   "tenantA",
+				"status":   "closed",
+				"priority": int64(2),
+			},
+		}, {
+			ID:    third,
+			Class: "ClassWithCompositeIndex",
+			Properties: map[string]interface{}{
+				"tenant":   "tenantB",
+				"status":   "open",
+				"priority": int64(1),
+			},
+		}, {
+			// no status, so it is not part of the composite index
+			ID:    fourth,
+			Class: "ClassWithCompositeIndex",
+			Properties: map[string]interface{}{
+				"tenant":   "tenantA",
+				"priority": int64(2),
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	compositeCount := func(t *testing.T, tenant, status string) int {
+		index := repo.GetIndex("ClassWithCompositeIndex")
+		require.NotNil(t, index)
+
+		key := inverted.CompositeKey([][]byte{[]byte(tenant), []byte(status)})
+		count := 0
+		for _, shard := range index.Shards {
+			bucket := shard.store.Bucket(helpers.BucketFromPropNameLSM(
+				helpers.MetaCompositeProp([]string{"tenant", "status"})))
+			require.NotNil(t, bucket)
+
+			docIDs, err := bucket.SetList(key)
+			require.Nil(t, err)
+			count += len(docIDs)
+		}
+		return count
+	}
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  "ClassWithCompositeIndex",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("the composite index contains the combined values", func(t *testing.T) {
+		assert.Equal(t, 1, compositeCount(t, "tenantA", "open"))
+		assert.Equal(t, 1, compositeCount(t, "tenantA", "closed"))
+		assert.Equal(t, 1, compositeCount(t, "tenantB", "open"))
+		assert.Equal(t, 0, compositeCount(t, "tenantB", "closed"))
+	})
+
+	t.Run("filtering by the combined props", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{first}, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "open", eq, dtString),
+		)))
+		assert.ElementsMatch(t, []strfmt.UUID{third}, search(t, filterAnd(
+			buildFilter("status", "open", eq, dtString),
+			buildFilter("tenant", "tenantB", eq, dtString),
+		)))
+		assert.Empty(t, search(t, filterAnd(
+			buildFilter("tenant", "tenantB", eq, dtString),
+			buildFilter("status", "closed", eq, dtString),
+		)))
+	})
+
+	t.Run("filtering by the combined props and another prop", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{second}, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "closed", eq, dtString),
+			buildFilter("priority", 2, eq, dtInt),
+		)))
+		assert.Empty(t, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "closed", eq, dtString),
+			buildFilter("priority", 1, eq, dtInt),
+		)))
+	})
+
+	t.Run("filters that cannot be served by the composite index", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{first, second, third, fourth}, search(t, filterOr(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "open", eq, dtString),
+		)))
+		assert.ElementsMatch(t, []strfmt.UUID{second, fourth}, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("priority", 2, eq, dtInt),
+		)))
+	})
+
+	t.Run("updating an object", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    first,
+			Class: "ClassWithCompositeIndex",
+			Properties: map[string]interface{}{
+				"tenant":   "tenantA",
+				"status":   "closed",
+				"priority": int64(1),
+			},
+		}, []float32{1, 3, 5, 0.4})
+		require.Nil(t, err)
+
+		assert.Equal(t, 0, compositeCount(t, "tenantA", "open"))
+		assert.Empty(t, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "open", eq, dtString),
+		)))
+		assert.ElementsMatch(t, []strfmt.UUID{first, second}, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "closed", eq, dtString),
+		)))
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		err := repo.DeleteObject(context.Background(), "ClassWithCompositeIndex", second)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []strfmt.UUID{first}, search(t, filterAnd(
+			buildFilter("tenant", "tenantA", eq, dtString),
+			buildFilter("status", "closed", eq, dtString),
+		)))
+	})
+}
@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_ContinuousAggregates(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:             "ClassWithContinuousAggregates",
+		VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			ContinuousAggregates: []*models.ContinuousAggregate{
+				{Name: "byCategory", GroupBy: "category", Sum: []string{"wordCount", "rating"}},
+				{Name: "total"},
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:     "category",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			{
+				Name:     "wordCount",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+			{
+				Name:     "rating",
+				DataType: []string{string(schema.DataTypeNumber)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	first := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	second := strfmt.UUID("0f6d3e1c-7b3a-4b57-9d1f-0b9a2f2d5c11")
+	third := strfmt.UUID("5a1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+	fourth := strfmt.UUID("c2a8a1f4-1f3e-4c36-a1a4-6b5f5d3e2c10")
+
+	aggregate := func(t *testing.T, name string) []aggregation.ContinuousGroup {
+		groups, err := repo.ContinuousAggregate(context.Background(),
+			aggregation.ContinuousParams{
+				ClassName: "ClassWithContinuousAggregates",
+				Name:      name,
+			})
+		require.Nil(t, err)
+		return groups
+	}
+
+	t.Run("before adding any objects", func(t *testing.T) {
+		assert.Empty(t, aggregate(t, "byCategory"))
+		assert.Empty(t, aggregate(t, "total"))
+	})
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:    first,
+			Class: "ClassWithContinuousAggregates",
+			Properties: map[string]interface{}{
+				"category":  "news",
+				"wordCount": int64(400),
+				"rating":    4.5,
+			},
+		}, {
+			ID:    second,
+			Class: "ClassWithContinuousAggregates",
+			Properties: map[string]interface{}{
+				"category":  "news",
+				"wordCount": int64(800),
+				"rating":    3.5,
+			},
+		}, {
+			// no rating, so it only adds to the word count
+			ID:    third,
+			Class: "ClassWithContinuousAggregates",
+			Properties: map[string]interface{}{
+				"category":  "sports",
+				"wordCount": int64(300),
+			},
+		}, {
+			// no category, so it is only part of the total
+			ID:    fourth,
+			Class: "ClassWithContinuousAggregates",
+			Properties: map[string]interface{}{
+				"wordCount": int64(100),
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	t.Run("the aggregates contain all objects", func(t *testing.T) {
+		assert.Equal(t, []aggregation.ContinuousGroup{
+			{Value: "news", Count: 2, Sums: []float64{1200, 8}},
+			{Value: "sports", Count: 1, Sums: []float64{300, 0}},
+		}, aggregate(t, "byCategory"))
+		assert.Equal(t, []aggregation.ContinuousGroup{
+			{Value: "", Count: 4, Sums: []float64{}},
+		}, aggregate(t, "total"))
+	})
+
+	t.Run("updating an object moves it to another group", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    second,
+			Class: "ClassWithContinuousAggregates",
+			Properties: map[string]interface{}{
+				"category":  "sports",
+				"wordCount": int64(500),
+				"rating":    2.0,
+			},
+		}, []float32{1, 3, 5, 0.4})
+		require.Nil(t, err)
+
+		assert.Equal(t, []aggregation.ContinuousGroup{
+			{Value: "news", Count: 1, Sums: []float64{400, 4.5}},
+			{Value: "sports", Count: 2, Sums: []float64{800, 2}},
+		}, aggregate(t, "byCategory"))
+		assert.Equal(t, int64(4), aggregate(t, "total")[0].Count)
+	})
+
+	t.Run("deleting the last object of a group removes the group", func(t *testing.T) {
+		err := repo.DeleteObject(context.Background(), "ClassWithContinuousAggregates", first)
+		require.Nil(t, err)
+
+		assert.Equal(t, []aggregation.ContinuousGroup{
+			{Value: "sports", Count: 2, Sums: []float64{800, 2}},
+		}, aggregate(t, "byCategory"))
+		assert.Equal(t, int64(3), aggregate(t, "total")[0].Count)
+	})
+}
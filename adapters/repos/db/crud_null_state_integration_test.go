@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_NullState(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	nullStateClass := &models.Class{
+		Class:             "ClassWithNullState",
+		VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			IndexNullState:         true,
+		},
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:     "tags",
+			DataType: []string{string(schema.DataTypeStringArray)},
+		}},
+	}
+	plainClass := &models.Class{
+		Class:               "ClassWithoutNullState",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the classes", func(t *testing.T) {
+		for _, class := range []*models.Class{nullStateClass, plainClass} {
+			require.Nil(t,
+				migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		}
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{nullStateClass, plainClass},
+			},
+		}
+	})
+
+	allSet := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	noName := strfmt.UUID("0f6d3e1c-7b3a-4b57-9d1f-0b9a2f2d5c11")
+	emptyTags := strfmt.UUID("5a1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:    allSet,
+			Class: "ClassWithNullState",
+			Properties: map[string]interface{}{
+				"name": "all set",
+				"tags": []interface{}{"foo"},
+			},
+		}, {
+			ID:    noName,
+			Class: "ClassWithNullState",
+			Properties: map[string]interface{}{
+				"tags": []interface{}{"bar"},
+			},
+		}, {
+			ID:    emptyTags,
+			Class: "ClassWithNullState",
+			Properties: map[string]interface{}{
+				"name": "empty tags",
+				"tags": []interface{}{},
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(t *testing.T, className, propName string, value bool) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    buildFilter(propName, value, filters.OperatorIsNull, dtBool),
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("filtering by null state", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{noName},
+			search(t, "ClassWithNullState", "name", true))
+		assert.ElementsMatch(t, []strfmt.UUID{allSet, emptyTags},
+			search(t, "ClassWithNullState", "name", false))
+		assert.ElementsMatch(t, []strfmt.UUID{emptyTags},
+			search(t, "ClassWithNullState", "tags", true))
+		assert.ElementsMatch(t, []strfmt.UUID{allSet, noName},
+			search(t, "ClassWithNullState", "tags", false))
+	})
+
+	t.Run("setting a prop which was null", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    noName,
+			Class: "ClassWithNullState",
+			Properties: map[string]interface{}{
+				"name": "now with a name",
+				"tags": []interface{}{"bar"},
+			},
+		}, []float32{1, 3, 5, 0.4})
+		require.Nil(t, err)
+
+		assert.Empty(t, search(t, "ClassWithNullState", "name", true))
+		assert.ElementsMatch(t, []strfmt.UUID{allSet, noName, emptyTags},
+			search(t, "ClassWithNullState", "name", false))
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		err := repo.DeleteObject(context.Background(), "ClassWithNullState", emptyTags)
+		require.Nil(t, err)
+
+		assert.Empty(t, search(t, "ClassWithNullState", "tags", true))
+	})
+
+	t.Run("filtering a class without null state", func(t *testing.T) {
+		_, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  "ClassWithoutNullState",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    buildFilter("name", true, filters.OperatorIsNull, dtBool),
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "prop \"name\" has no null state index")
+	})
+}
@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_SharedInvertedIndexStorage(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:             "ClassWithSharedStorage",
+		VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+		},
+		Properties: []*models.Property{
+			{
+				Name:                 "active",
+				DataType:             []string{string(schema.DataTypeBoolean)},
+				InvertedIndexStorage: models.PropertyInvertedIndexStorageShared,
+			},
+			{
+				Name:                 "archived",
+				DataType:             []string{string(schema.DataTypeBoolean)},
+				InvertedIndexStorage: models.PropertyInvertedIndexStorageShared,
+			},
+			{
+				Name:                 "status",
+				DataType:             []string{string(schema.DataTypeString)},
+				Tokenization:         models.PropertyTokenizationField,
+				InvertedIndexStorage: models.PropertyInvertedIndexStorageShared,
+			},
+			{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	t.Run("shared props have no buckets of their own", func(t *testing.T) {
+		index := repo.GetIndex("ClassWithSharedStorage")
+		require.NotNil(t, index)
+
+		for _, shard := range index.Shards {
+			assert.NotNil(t, shard.store.Bucket(
+				helpers.BucketFromPropNameLSM(helpers.PropertyNameShared)))
+			assert.NotNil(t, shard.store.Bucket(
+				helpers.HashBucketFromPropNameLSM(helpers.PropertyNameShared)))
+			assert.NotNil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("name")))
+
+			for _, propName := range []string{"active", "archived", "status"} {
+				assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM(propName)))
+				assert.Nil(t, shard.store.Bucket(helpers.HashBucketFromPropNameLSM(propName)))
+			}
+		}
+	})
+
+	first := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	second := strfmt.UUID("0f6d3e1c-7b3a-4b57-9d1f-0b9a2f2d5c11")
+	third := strfmt.UUID("5a1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:    first,
+			Class: "ClassWithSharedStorage",
+			Properties: map[string]interface{}{
+				"active":   true,
+				"archived": false,
+				"status":   "open",
+				"name":     "first",
+			},
+		}, {
+			ID:    second,
+			Class: "ClassWithSharedStorage",
+			Properties: map[string]interface{}{
+				"active":   false,
+				"archived": false,
+				"status":   "closed",
+				"name":     "second",
+			},
+		}, {
+			ID:    third,
+			Class: "ClassWithSharedStorage",
+			Properties: map[string]interface{}{
+				"active":   true,
+				"archived": true,
+				"status":   "in progress",
+				"name":     "third",
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  "ClassWithSharedStorage",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("filtering by shared props", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{first, third},
+			search(t, buildFilter("active", true, eq, dtBool)))
+		assert.ElementsMatch(t, []strfmt.UUID{third},
+			search(t, buildFilter("archived", true, eq, dtBool)))
+		assert.ElementsMatch(t, []strfmt.UUID{first, second},
+			search(t, buildFilter("archived", true, neq, dtBool)))
+		assert.ElementsMatch(t, []strfmt.UUID{third},
+			search(t, buildFilter("status", "in progress", eq, dtString)))
+		assert.ElementsMatch(t, []strfmt.UUID{first, third},
+			search(t, buildFilter("status", "closed", neq, dtString)))
+		assert.ElementsMatch(t, []strfmt.UUID{first},
+			search(t, buildFilter("status", "o*", like, dtString)))
+	})
+
+	t.Run("combining shared and dedicated props", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{first}, search(t, filterAnd(
+			buildFilter("active", true, eq, dtBool),
+			buildFilter("archived", false, eq, dtBool),
+		)))
+		assert.ElementsMatch(t, []strfmt.UUID{second, third}, search(t, filterOr(
+			buildFilter("name", "second", eq, dtString),
+			buildFilter("archived", true, eq, dtBool),
+		)))
+	})
+
+	t.Run("updating an object", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    first,
+			Class: "ClassWithSharedStorage",
+			Properties: map[string]interface{}{
+				"active":   false,
+				"archived": false,
+				"status":   "closed",
+				"name":     "first",
+			},
+		}, []float32{1, 3, 5, 0.4})
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []strfmt.UUID{third},
+			search(t, buildFilter("active", true, eq, dtBool)))
+		assert.ElementsMatch(t, []strfmt.UUID{first, second},
+			search(t, buildFilter("status", "closed", eq, dtString)))
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		err := repo.DeleteObject(context.Background(), "ClassWithSharedStorage", second)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []strfmt.UUID{first},
+			search(t, buildFilter("status", "closed", eq, dtString)))
+		assert.ElementsMatch(t, []strfmt.UUID{first},
+			search(t, buildFilter("active", false, eq, dtBool)))
+	})
+}
@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_Timestamps(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	timestampsClass := &models.Class{
+		Class:             "ClassWithTimestamps",
+		VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			IndexTimestamps:        true,
+		},
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	plainClass := &models.Class{
+		Class:               "ClassWithoutTimestamps",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the classes", func(t *testing.T) {
+		for _, class := range []*models.Class{timestampsClass, plainClass} {
+			require.Nil(t,
+				migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		}
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{timestampsClass, plainClass},
+			},
+		}
+	})
+
+	first := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	second := strfmt.UUID("0f6d3e1c-7b3a-4b57-9d1f-0b9a2f2d5c11")
+	third := strfmt.UUID("5a1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:                 first,
+			Class:              "ClassWithTimestamps",
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: 5000,
+			Properties: map[string]interface{}{
+				"name": "first",
+			},
+		}, {
+			ID:                 second,
+			Class:              "ClassWithTimestamps",
+			CreationTimeUnix:   2000,
+			LastUpdateTimeUnix: 2000,
+			Properties: map[string]interface{}{
+				"name": "second",
+			},
+		}, {
+			ID:                 third,
+			Class:              "ClassWithTimestamps",
+			CreationTimeUnix:   3000,
+			LastUpdateTimeUnix: 4000,
+			Properties: map[string]interface{}{
+				"name": "third",
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(t *testing.T, params traverser.GetParams) []strfmt.UUID {
+		params.ClassName = "ClassWithTimestamps"
+		params.Pagination = &filters.Pagination{Limit: 10}
+		res, err := repo.ClassSearch(context.Background(), params)
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("filtering by timestamps", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{second, third}, search(t, traverser.GetParams{
+			Filters: buildFilter("_creationTimeUnix", "1500", filters.OperatorGreaterThan, dtString),
+		}))
+		assert.ElementsMatch(t, []strfmt.UUID{first, third}, search(t, traverser.GetParams{
+			Filters: buildFilter("_lastUpdateTimeUnix", "4000", filters.OperatorGreaterThanEqual, dtString),
+		}))
+		assert.ElementsMatch(t, []strfmt.UUID{first}, search(t, traverser.GetParams{
+			Filters: buildFilter("_creationTimeUnix", time.Unix(1, 500000000),
+				filters.OperatorLessThan, dtDate),
+		}))
+	})
+
+	t.Run("sorting by timestamps", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{first, second, third}, search(t, traverser.GetParams{
+			Sort: []filters.Sort{{Path: []string{"_creationTimeUnix"}, Order: "asc"}},
+		}))
+		assert.Equal(t, []strfmt.UUID{first, third, second}, search(t, traverser.GetParams{
+			Sort: []filters.Sort{{Path: []string{"_lastUpdateTimeUnix"}, Order: "desc"}},
+		}))
+		assert.Equal(t, []strfmt.UUID{third, second}, search(t, traverser.GetParams{
+			Filters: buildFilter("_creationTimeUnix", "1500", filters.OperatorGreaterThan, dtString),
+			Sort:    []filters.Sort{{Path: []string{"_creationTimeUnix"}, Order: "desc"}},
+		}))
+	})
+
+	t.Run("merging into an object", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class:      "ClassWithTimestamps",
+			ID:         second,
+			UpdateTime: 6000,
+			PrimitiveSchema: map[string]interface{}{
+				"name": "second, updated",
+			},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []strfmt.UUID{second, first, third}, search(t, traverser.GetParams{
+			Sort: []filters.Sort{{Path: []string{"_lastUpdateTimeUnix"}, Order: "desc"}},
+		}))
+		assert.Empty(t, search(t, traverser.GetParams{
+			Filters: buildFilter("_lastUpdateTimeUnix", "2000", filters.OperatorEqual, dtString),
+		}))
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		err := repo.DeleteObject(context.Background(), "ClassWithTimestamps", first)
+		require.Nil(t, err)
+
+		assert.Equal(t, []strfmt.UUID{second, third}, search(t, traverser.GetParams{
+			Sort: []filters.Sort{{Path: []string{"_creationTimeUnix"}, Order: "asc"}},
+		}))
+	})
+
+	t.Run("filtering a class without timestamps", func(t *testing.T) {
+		_, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  "ClassWithoutTimestamps",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    buildFilter("_creationTimeUnix", "1500", filters.OperatorGreaterThan, dtString),
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "prop \"_creationTimeUnix\" is not indexed")
+	})
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_PropertyTokenization(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ClassWithTokenization",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "city",
+				DataType:     []string{string(schema.DataTypeString)},
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:         "email",
+				DataType:     []string{string(schema.DataTypeString)},
+				Tokenization: models.PropertyTokenizationLowercase,
+			},
+			{
+				Name:     "description",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	newYork := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	york := strfmt.UUID("0f6d3e1c-7b3a-4b57-9d1f-0b9a2f2d5c11")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:    newYork,
+			Class: "ClassWithTokenization",
+			Properties: map[string]interface{}{
+				"city":        "New York",
+				"email":       "John@Doe.com",
+				"description": "The Big Apple",
+			},
+		}, {
+			ID:    york,
+			Class: "ClassWithTokenization",
+			Properties: map[string]interface{}{
+				"city":        "York",
+				"email":       "jane@doe.com",
+				"description": "A city in the north of England",
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  "ClassWithTokenization",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("field tokenization matches the entire value only", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{newYork}, search(t,
+			buildFilter("city", "New York", eq, dtString)))
+		assert.ElementsMatch(t, []strfmt.UUID{york}, search(t,
+			buildFilter("city", "York", eq, dtString)))
+		assert.Empty(t, search(t, buildFilter("city", "New", eq, dtString)))
+	})
+
+	t.Run("field tokenization with a text value", func(t *testing.T) {
+		// the prop's tokenization takes precedence over the type of the value
+		assert.ElementsMatch(t, []strfmt.UUID{newYork}, search(t,
+			buildFilter("city", "New York", eq, schema.DataTypeText)))
+	})
+
+	t.Run("field tokenization with a like operator", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{newYork}, search(t,
+			buildFilter("city", "New Y*", filters.OperatorLike, dtString)))
+	})
+
+	t.Run("lowercase tokenization is case insensitive", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{newYork}, search(t,
+			buildFilter("email", "JOHN@doe.com", eq, dtString)))
+	})
+
+	t.Run("default tokenization of a text prop is unchanged", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{newYork}, search(t,
+			buildFilter("description", "apple", eq, schema.DataTypeText)))
+	})
+}
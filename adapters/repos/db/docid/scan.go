@@ -28,24 +28,29 @@ type ObjectScanFn func(obj *storobj.Object) (bool, error)
 // ScanObjectsLSM calls the provided scanFn on each object for the
 // specified pointer. If a pointer does not resolve to an object-id, the item
 // will be skipped. The number of times scanFn is called can therefore be
-// smaller than the input length of pointers.
-func ScanObjectsLSM(store *lsmkv.Store, pointers []uint64, scan ObjectScanFn) error {
-	return newObjectScannerLSM(store, pointers, scan).Do()
+// smaller than the input length of pointers. The objects are decoded
+// according to opts, scans which only look at the properties should use
+// storobj.DecodePropertiesOnly.
+func ScanObjectsLSM(store *lsmkv.Store, pointers []uint64, scan ObjectScanFn,
+	opts storobj.DecodeOptions) error {
+	return newObjectScannerLSM(store, pointers, scan, opts).Do()
 }
 
 type objectScannerLSM struct {
 	store         *lsmkv.Store
 	pointers      []uint64
 	scanFn        ObjectScanFn
+	opts          storobj.DecodeOptions
 	objectsBucket *lsmkv.Bucket
 }
 
 func newObjectScannerLSM(store *lsmkv.Store, pointers []uint64,
-	scan ObjectScanFn) *objectScannerLSM {
+	scan ObjectScanFn, opts storobj.DecodeOptions) *objectScannerLSM {
 	return &objectScannerLSM{
 		store:    store,
 		pointers: pointers,
 		scanFn:   scan,
+		opts:     opts,
 	}
 }
 
@@ -85,7 +90,7 @@ func (os *objectScannerLSM) scan() error {
 			continue
 		}
 
-		elem, err := storobj.FromBinary(res)
+		elem, err := storobj.FromBinaryWithOptions(res, os.opts)
 		if err != nil {
 			return errors.Wrapf(err, "unmarshal data object")
 		}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package encryption seals the files of the lsmkv stores and the vector
+// indices with AES-GCM, so deployments which cannot rely on an encrypted
+// disk do not store any object data in plain text.
+//
+// An encrypted file starts with a fixed magic value, followed by blocks of up
+// to BlockSize bytes of plain text. Each block is sealed on its own with a
+// random nonce and its position as additional data, so blocks can neither be
+// altered nor reordered unnoticed. The last block of a file is always shorter
+// than BlockSize, if need be empty, and is marked as final in its additional
+// data. A file which lost whole blocks at its end therefore no longer ends
+// with its final block, which is detected just like an altered block. Files
+// without the magic value are read as plain text, which allows to enable
+// encryption on an existing data path: new files are encrypted, existing ones
+// are replaced over time by compactions and commit log rotations.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// BlockSize is the size of the plain text of a full block
+	BlockSize = 64 * 1024
+
+	magic      = "WVENC001"
+	headerSize = int64(len(magic))
+	nonceSize  = 12
+	tagSize    = 16
+	overhead   = nonceSize + tagSize
+
+	// diskBlockSize is the size of a full block on disk
+	diskBlockSize = int64(BlockSize + overhead)
+)
+
+var (
+	// ErrNoKey is returned when an encrypted file is read without a key
+	ErrNoKey = errors.New("file is encrypted, but no encryption key is configured")
+
+	// ErrCorrupt is returned when a block fails authentication, i.e. it was
+	// altered, the key is wrong or the file does not end with its final block
+	ErrCorrupt = errors.New("encrypted block failed authentication")
+)
+
+// Cipher seals and opens the blocks of encrypted files. It is safe for
+// concurrent use. A nil *Cipher means that encryption is disabled.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// New creates a cipher for a 16, 24 or 32 byte key, which selects AES-128,
+// AES-192 or AES-256 respectively
+func New(key []byte) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "init aes")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "init gcm")
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// seal encrypts the block at index, a block shorter than BlockSize is the
+// final block of its file
+func (c *Cipher) seal(index int64, plain []byte) ([]byte, error) {
+	out := make([]byte, nonceSize, nonceSize+len(plain)+tagSize)
+	if _, err := rand.Read(out); err != nil {
+		return nil, errors.Wrap(err, "generate nonce")
+	}
+
+	return c.aead.Seal(out, out, plain, blockPosition(index, len(plain) < BlockSize)), nil
+}
+
+// open decrypts the block at index, final must be set if the block is
+// expected to be the final block of its file
+func (c *Cipher) open(index int64, sealed []byte, final bool) ([]byte, error) {
+	if len(sealed) < overhead {
+		return nil, ErrCorrupt
+	}
+
+	plain, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:],
+		blockPosition(index, final))
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+
+	return plain, nil
+}
+
+func blockPosition(index int64, final bool) []byte {
+	out := make([]byte, 9)
+	binary.LittleEndian.PutUint64(out, uint64(index))
+	if final {
+		out[8] = 1
+	}
+	return out
+}
+
+func blockOffset(index int64) int64 {
+	return headerSize + index*diskBlockSize
+}
+
+// IsEncrypted returns true if the contents start like an encrypted file
+func IsEncrypted(contents []byte) bool {
+	return len(contents) >= len(magic) && string(contents[:len(magic)]) == magic
+}
+
+// IsEncryptedFile returns true if the file read through r is encrypted
+func IsEncryptedFile(r io.ReaderAt) (bool, error) {
+	prefix := make([]byte, len(magic))
+	n, err := r.ReadAt(prefix, 0)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	return IsEncrypted(prefix[:n]), nil
+}
+
+// Decrypt returns the plain text of the complete contents of an encrypted
+// file. Just like for NewReader, a file which does not end with its final
+// block is corrupt.
+func (c *Cipher) Decrypt(contents []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	if !IsEncrypted(contents) {
+		return nil, errors.New("not an encrypted file")
+	}
+
+	sealed := contents[headerSize:]
+	blocks := (int64(len(sealed)) + diskBlockSize - 1) / diskBlockSize
+	if blocks == 0 {
+		return nil, errors.Wrap(ErrCorrupt, "final block missing")
+	}
+
+	size := int64(len(sealed)) - blocks*overhead
+	if size < 0 {
+		size = 0
+	}
+	out := bytes.NewBuffer(make([]byte, 0, size))
+	for i := int64(0); i < blocks; i++ {
+		end := (i + 1) * diskBlockSize
+		if end > int64(len(sealed)) {
+			end = int64(len(sealed))
+		}
+
+		plain, err := c.open(i, sealed[i*diskBlockSize:end], i == blocks-1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "block %d", i)
+		}
+		out.Write(plain)
+	}
+
+	return out.Bytes(), nil
+}
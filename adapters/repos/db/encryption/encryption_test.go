@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	c, err := New(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "segment")
+	data := make([]byte, 3*BlockSize+123)
+	rand.Read(data)
+
+	read := func(t *testing.T,
+		newReader func(io.Reader, *Cipher) (io.Reader, error)) ([]byte, error) {
+		f, err := os.Open(path)
+		require.Nil(t, err)
+		defer f.Close()
+
+		r, err := newReader(f, c)
+		require.Nil(t, err)
+		return ioutil.ReadAll(r)
+	}
+
+	readAll := func(t *testing.T) ([]byte, error) {
+		return read(t, NewReader)
+	}
+
+	readAt := func(t *testing.T) (*ReaderAt, error) {
+		raw, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		return NewReaderAt(bytes.NewReader(raw), int64(len(raw)), c, 2)
+	}
+
+	t.Run("keys of an invalid length are rejected", func(t *testing.T) {
+		_, err := New([]byte("too short"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("writing with a header which is written last", func(t *testing.T) {
+		f, err := os.Create(path)
+		require.Nil(t, err)
+
+		w, err := NewWriter(f, c)
+		require.Nil(t, err)
+
+		_, err = w.Write(make([]byte, 16))
+		require.Nil(t, err)
+		_, err = w.Write(data[16:100000])
+		require.Nil(t, err)
+		require.Nil(t, w.Flush())
+		_, err = w.Write(data[100000:])
+		require.Nil(t, err)
+
+		_, err = w.Seek(0, io.SeekStart)
+		require.Nil(t, err)
+		_, err = w.Write(data[:16])
+		require.Nil(t, err)
+
+		require.Nil(t, w.Close())
+		require.Nil(t, f.Close())
+	})
+
+	t.Run("the file does not contain the plain text", func(t *testing.T) {
+		raw, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		assert.True(t, IsEncrypted(raw))
+		assert.False(t, bytes.Contains(raw, data[:64]))
+	})
+
+	t.Run("decrypting the complete file", func(t *testing.T) {
+		raw, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+
+		plain, err := c.Decrypt(raw)
+		require.Nil(t, err)
+		assert.Equal(t, data, plain)
+
+		var noCipher *Cipher
+		_, err = noCipher.Decrypt(raw)
+		assert.Equal(t, ErrNoKey, err)
+	})
+
+	t.Run("reading the file", func(t *testing.T) {
+		plain, err := readAll(t)
+		require.Nil(t, err)
+		assert.Equal(t, data, plain)
+	})
+
+	t.Run("reading the file at random positions", func(t *testing.T) {
+		r, err := readAt(t)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(data)), r.Size())
+
+		for i := 0; i < 100; i++ {
+			start := rand.Intn(len(data))
+			end := start + rand.Intn(2*BlockSize)
+			if end > len(data) {
+				end = len(data)
+			}
+
+			buf := make([]byte, end-start)
+			_, err := r.ReadAt(buf, int64(start))
+			require.Nil(t, err)
+			assert.Equal(t, data[start:end], buf)
+		}
+
+		buf := make([]byte, 10)
+		n, err := r.ReadAt(buf, int64(len(data)-4))
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, data[len(data)-4:], buf[:n])
+	})
+
+	t.Run("appending to the file", func(t *testing.T) {
+		f, err := os.OpenFile(path, os.O_RDWR, 0o666)
+		require.Nil(t, err)
+
+		w, err := OpenWriter(f, c)
+		require.Nil(t, err)
+		_, err = w.Write([]byte("tail"))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+		require.Nil(t, f.Close())
+
+		data = append(data, []byte("tail")...)
+		plain, err := readAll(t)
+		require.Nil(t, err)
+		assert.Equal(t, data, plain)
+	})
+
+	t.Run("dropping whole blocks at the end is detected", func(t *testing.T) {
+		raw, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		complete := raw[:blockOffset(2)]
+
+		_, err = c.Decrypt(complete)
+		assert.True(t, errors.Is(err, ErrCorrupt))
+
+		r, err := NewReader(bytes.NewReader(complete), c)
+		require.Nil(t, err)
+		_, err = ioutil.ReadAll(r)
+		assert.True(t, errors.Is(err, ErrCorrupt))
+
+		_, err = NewReaderAt(bytes.NewReader(complete), int64(len(complete)), c, 2)
+		assert.True(t, errors.Is(err, ErrCorrupt))
+
+		// a log may end with a complete block written before its final block
+		r, err = NewLogReader(bytes.NewReader(complete), c)
+		require.Nil(t, err)
+		plain, err := ioutil.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, data[:2*BlockSize], plain)
+	})
+
+	t.Run("a torn last block", func(t *testing.T) {
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+		require.Nil(t, os.Truncate(path, info.Size()-3))
+
+		_, err = readAll(t)
+		assert.True(t, errors.Is(err, ErrCorrupt))
+
+		_, err = readAt(t)
+		assert.True(t, errors.Is(err, ErrCorrupt))
+
+		// only a log ends unexpectedly
+		plain, err := read(t, NewLogReader)
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+		assert.Equal(t, data[:3*BlockSize], plain)
+	})
+
+	t.Run("truncating the file", func(t *testing.T) {
+		require.Nil(t, Truncate(path, c, 2*BlockSize))
+
+		plain, err := readAll(t)
+		require.Nil(t, err)
+		assert.Equal(t, data[:2*BlockSize], plain)
+
+		require.Nil(t, Truncate(path, c, BlockSize+5))
+
+		plain, err = readAll(t)
+		require.Nil(t, err)
+		assert.Equal(t, data[:BlockSize+5], plain)
+	})
+
+	t.Run("an empty file", func(t *testing.T) {
+		emptyPath := filepath.Join(t.TempDir(), "empty")
+		f, err := os.Create(emptyPath)
+		require.Nil(t, err)
+		w, err := NewWriter(f, c)
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+		require.Nil(t, f.Close())
+
+		raw, err := ioutil.ReadFile(emptyPath)
+		require.Nil(t, err)
+		plain, err := c.Decrypt(raw)
+		require.Nil(t, err)
+		assert.Len(t, plain, 0)
+	})
+
+	t.Run("an altered block is detected", func(t *testing.T) {
+		raw, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		raw[headerSize+20] ^= 1
+
+		_, err = c.Decrypt(raw)
+		assert.NotNil(t, err)
+
+		r, err := NewReader(bytes.NewReader(raw), c)
+		require.Nil(t, err)
+		_, err = ioutil.ReadAll(r)
+		assert.True(t, errors.Is(err, ErrCorrupt))
+	})
+
+	t.Run("plain text is read as it is", func(t *testing.T) {
+		r, err := NewReader(bytes.NewReader([]byte("plain text")), nil)
+		require.Nil(t, err)
+
+		plain, err := ioutil.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("plain text"), plain)
+	})
+}
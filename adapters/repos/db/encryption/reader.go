@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package encryption
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// NewReader returns a reader of the plain text of the file read through r.
+// Plain text files are read as they are, encrypted files are decrypted,
+// which requires a cipher. An encrypted file which does not end with its
+// final block is corrupt, as it is either torn or lost blocks at its end.
+func NewReader(r io.Reader, c *Cipher) (io.Reader, error) {
+	return newReader(r, c, false)
+}
+
+// NewLogReader is like NewReader, but for logs which are appended to and
+// were possibly torn when the process crashed: A last block which fails
+// authentication is reported as io.ErrUnexpectedEOF, just like a plain text
+// file which ends abruptly. A complete last block which was written before
+// the final block following it is read as the end of the file.
+func NewLogReader(r io.Reader, c *Cipher) (io.Reader, error) {
+	return newReader(r, c, true)
+}
+
+func newReader(r io.Reader, c *Cipher, tornTail bool) (io.Reader, error) {
+	buffered := bufio.NewReaderSize(r, int(diskBlockSize))
+	prefix, err := buffered.Peek(len(magic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "read header")
+	}
+
+	if !IsEncrypted(prefix) {
+		return buffered, nil
+	}
+
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	if _, err := buffered.Discard(len(magic)); err != nil {
+		return nil, err
+	}
+
+	return &reader{
+		source:   buffered,
+		cipher:   c,
+		sealed:   make([]byte, diskBlockSize),
+		tornTail: tornTail,
+	}, nil
+}
+
+type reader struct {
+	source   *bufio.Reader
+	cipher   *Cipher
+	sealed   []byte
+	tornTail bool
+	block    int64
+	plain    []byte
+	err      error
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.next()
+	}
+
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+// next decrypts the next block, the last block of the file must be its final
+// block unless the reader tolerates a torn tail
+func (r *reader) next() {
+	n, err := io.ReadFull(r.source, r.sealed)
+	if err == io.EOF {
+		// there are no blocks at all, only the header was written
+		if r.tornTail {
+			r.err = io.EOF
+		} else {
+			r.err = errors.Wrap(ErrCorrupt, "final block missing")
+		}
+		return
+	}
+	if err != nil && err != io.ErrUnexpectedEOF {
+		r.err = err
+		return
+	}
+
+	last := err == io.ErrUnexpectedEOF
+	if !last {
+		if _, err := r.source.Peek(1); err == io.EOF {
+			last = true
+		}
+	}
+
+	plain, err := r.cipher.open(r.block, r.sealed[:n], last)
+	if err != nil && last && r.tornTail {
+		plain, err = r.cipher.open(r.block, r.sealed[:n], false)
+		if err != nil {
+			r.err = io.ErrUnexpectedEOF
+			return
+		}
+	}
+	if err != nil {
+		r.err = errors.Wrapf(err, "block %d", r.block)
+		return
+	}
+
+	r.block++
+	r.plain = plain
+	if last {
+		r.err = io.EOF
+	}
+}
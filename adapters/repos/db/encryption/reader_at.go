@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package encryption
+
+import (
+	"container/list"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ReaderAt reads the plain text of a complete encrypted file at random
+// positions. Blocks are decrypted on demand, so the plain text is never held
+// in memory as a whole. The most recently read blocks are cached, as reads
+// of neighboring positions, e.g. by a cursor, usually hit the same block. It
+// is safe for concurrent use.
+type ReaderAt struct {
+	source io.ReaderAt
+	cipher *Cipher
+	size   int64 // plain text size
+	blocks int64
+
+	sync.Mutex
+	cache      map[int64]*list.Element
+	recent     *list.List // of cachedBlock, most recently used first
+	cacheLimit int
+}
+
+type cachedBlock struct {
+	index int64
+	plain []byte
+}
+
+// NewReaderAt opens the encrypted file of diskSize bytes read through r and
+// caches up to cacheBlocks decrypted blocks. The final block is verified
+// right away, so a file which lost blocks at its end is detected before any
+// of it is read.
+func NewReaderAt(r io.ReaderAt, diskSize int64, c *Cipher,
+	cacheBlocks int) (*ReaderAt, error) {
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	encrypted, err := IsEncryptedFile(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "read header")
+	}
+	if !encrypted {
+		return nil, errors.New("not an encrypted file")
+	}
+
+	sealed := diskSize - headerSize
+	full, rest := sealed/diskBlockSize, sealed%diskBlockSize
+	if rest == 0 {
+		// a final block is always shorter than a full one
+		return nil, errors.Wrap(ErrCorrupt, "final block missing")
+	}
+
+	ra := &ReaderAt{
+		source:     r,
+		cipher:     c,
+		size:       full*BlockSize + rest - overhead,
+		blocks:     full + 1,
+		cache:      map[int64]*list.Element{},
+		recent:     list.New(),
+		cacheLimit: cacheBlocks,
+	}
+
+	if _, err := ra.block(full); err != nil {
+		return nil, err
+	}
+
+	return ra, nil
+}
+
+// Size is the size of the plain text
+func (r *ReaderAt) Size() int64 {
+	return r.size
+}
+
+// ReadAt reads len(p) bytes of plain text starting at off. Just like for any
+// io.ReaderAt, fewer bytes are only read at the end of the file, in which
+// case io.EOF is returned.
+func (r *ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.Errorf("negative offset %d", off)
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		if pos >= r.size {
+			return n, io.EOF
+		}
+
+		plain, err := r.block(pos / BlockSize)
+		if err != nil {
+			return n, err
+		}
+
+		n += copy(p[n:], plain[pos%BlockSize:])
+	}
+
+	return n, nil
+}
+
+// block returns the plain text of the block at index, which must not be
+// modified
+func (r *ReaderAt) block(index int64) ([]byte, error) {
+	r.Lock()
+	if elem, ok := r.cache[index]; ok {
+		r.recent.MoveToFront(elem)
+		r.Unlock()
+		return elem.Value.(cachedBlock).plain, nil
+	}
+	r.Unlock()
+
+	length := diskBlockSize
+	if index == r.blocks-1 {
+		length = r.size - index*BlockSize + overhead
+	}
+
+	sealed := make([]byte, length)
+	if _, err := r.source.ReadAt(sealed, blockOffset(index)); err != nil {
+		return nil, errors.Wrapf(err, "read block %d", index)
+	}
+
+	plain, err := r.cipher.open(index, sealed, index == r.blocks-1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "block %d", index)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.cache[index]; !ok && r.cacheLimit > 0 {
+		r.cache[index] = r.recent.PushFront(cachedBlock{index: index, plain: plain})
+		if r.recent.Len() > r.cacheLimit {
+			oldest := r.recent.Remove(r.recent.Back()).(cachedBlock)
+			delete(r.cache, oldest.index)
+		}
+	}
+
+	return plain, nil
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package encryption
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Writer encrypts everything written to it into a file. It buffers the
+// block at the current position, which is sealed and written once the
+// position moves on to another block, or on Flush. Seeking back into
+// previously written parts of the file is supported, as the lsmkv compactors
+// write the segment header last. The file always ends with its final block:
+// A full block at the end of the file is followed by an empty final block,
+// which is replaced once the file grows. Appending to a file reseals its last
+// block, so a crash while a block is rewritten may tear it. Only a reader of
+// a log, see NewLogReader, tolerates a torn last block.
+type Writer struct {
+	file   *os.File
+	cipher *Cipher
+
+	size  int64 // plain text size of the file
+	pos   int64 // plain text position
+	block int64 // index of the buffered block, -1 if none
+	buf   []byte
+	dirty bool
+}
+
+// NewWriter starts a new encrypted file, f must be empty. An empty final
+// block is written right away, so even an empty file is complete.
+func NewWriter(f *os.File, c *Cipher) (*Writer, error) {
+	if _, err := f.WriteAt([]byte(magic), 0); err != nil {
+		return nil, errors.Wrap(err, "write header")
+	}
+
+	w := &Writer{file: f, cipher: c, block: 0, dirty: true}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// OpenWriter continues writing at the end of the file, which must either be
+// empty or encrypted. A torn last block, e.g. of a commit log which was
+// written when the process crashed, is dropped. So is a torn full block at the
+// end of a file which lacks its final block, as the final block is only
+// written after it.
+func OpenWriter(f *os.File, c *Cipher) (*Writer, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat file")
+	}
+
+	if info.Size() == 0 {
+		return NewWriter(f, c)
+	}
+
+	encrypted, err := IsEncryptedFile(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "read header")
+	}
+	if !encrypted {
+		return nil, errors.Errorf("cannot append encrypted data to plain text file %s",
+			f.Name())
+	}
+
+	w := &Writer{file: f, cipher: c, block: -1}
+
+	sealed := info.Size() - headerSize
+	full, rest := sealed/diskBlockSize, sealed%diskBlockSize
+	w.size = full * BlockSize
+	if rest > 0 {
+		last := make([]byte, rest)
+		if _, err := f.ReadAt(last, blockOffset(full)); err != nil {
+			return nil, errors.Wrap(err, "read last block")
+		}
+
+		plain, err := c.open(full, last, true)
+		if err != nil {
+			if err := f.Truncate(blockOffset(full)); err != nil {
+				return nil, errors.Wrap(err, "drop torn last block")
+			}
+		} else {
+			w.size += int64(len(plain))
+			w.block, w.buf = full, plain
+		}
+	} else if full > 0 {
+		last := make([]byte, diskBlockSize)
+		if _, err := f.ReadAt(last, blockOffset(full-1)); err != nil {
+			return nil, errors.Wrap(err, "read last block")
+		}
+
+		if _, err := c.open(full-1, last, false); err != nil {
+			if err := f.Truncate(blockOffset(full - 1)); err != nil {
+				return nil, errors.Wrap(err, "drop torn last block")
+			}
+			w.size -= BlockSize
+		}
+	}
+
+	w.pos = w.size
+	return w, nil
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if err := w.load(w.pos / BlockSize); err != nil {
+			return written, err
+		}
+
+		offset := int(w.pos % BlockSize)
+		chunk := BlockSize - offset
+		if chunk > len(p) {
+			chunk = len(p)
+		}
+
+		if end := offset + chunk; end > len(w.buf) {
+			w.buf = append(w.buf, make([]byte, end-len(w.buf))...)
+		}
+		copy(w.buf[offset:], p[:chunk])
+		w.dirty = true
+
+		p = p[chunk:]
+		written += chunk
+		w.pos += int64(chunk)
+		if w.pos > w.size {
+			w.size = w.pos
+		}
+	}
+
+	return written, nil
+}
+
+// Seek moves the position within the plain text, it can not move past the
+// end of the file
+func (w *Writer) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = w.pos + offset
+	case io.SeekEnd:
+		pos = w.size + offset
+	default:
+		return w.pos, errors.Errorf("invalid whence %d", whence)
+	}
+
+	if pos < 0 || pos > w.size {
+		return w.pos, errors.Errorf("seek to %d outside of file of size %d", pos, w.size)
+	}
+
+	w.pos = pos
+	return pos, nil
+}
+
+// Flush writes the buffered block to the file, it does not sync the file
+func (w *Writer) Flush() error {
+	if !w.dirty {
+		return nil
+	}
+
+	if err := w.writeBlock(w.block, w.buf); err != nil {
+		return err
+	}
+
+	// a full block is never final, so one at the end of the file is followed
+	// by an empty final block until the next block replaces it
+	if len(w.buf) == BlockSize && (w.block+1)*BlockSize == w.size {
+		if err := w.writeBlock(w.block+1, nil); err != nil {
+			return err
+		}
+	}
+
+	w.dirty = false
+	return nil
+}
+
+func (w *Writer) writeBlock(index int64, plain []byte) error {
+	sealed, err := w.cipher.seal(index, plain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.file.WriteAt(sealed, blockOffset(index)); err != nil {
+		return errors.Wrapf(err, "write block %d", index)
+	}
+
+	return nil
+}
+
+// Close flushes the buffered block, the file itself is not closed
+func (w *Writer) Close() error {
+	return w.Flush()
+}
+
+// load makes the block at index the buffered one
+func (w *Writer) load(index int64) error {
+	if index == w.block {
+		return nil
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	w.block, w.buf = index, nil
+	if index*BlockSize >= w.size {
+		// a new block at the end of the file
+		return nil
+	}
+
+	length := w.size - index*BlockSize
+	if length > BlockSize {
+		length = BlockSize
+	}
+
+	sealed := make([]byte, length+overhead)
+	if _, err := w.file.ReadAt(sealed, blockOffset(index)); err != nil {
+		return errors.Wrapf(err, "read block %d", index)
+	}
+
+	plain, err := w.cipher.open(index, sealed, length < BlockSize)
+	if err != nil {
+		return errors.Wrapf(err, "block %d", index)
+	}
+
+	w.buf = plain
+	return nil
+}
+
+// Truncate cuts the encrypted file at path to size bytes of plain text. The
+// block containing the new end is resealed as the final block.
+func Truncate(path string, c *Cipher, size int64) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0o666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w, err := OpenWriter(f, c)
+	if err != nil {
+		return err
+	}
+
+	if size > w.size {
+		return errors.Errorf("cannot truncate %s of size %d to %d", path, w.size, size)
+	}
+
+	index, keep := size/BlockSize, size%BlockSize
+	if err := w.load(index); err != nil {
+		return err
+	}
+
+	if err := f.Truncate(blockOffset(index)); err != nil {
+		return err
+	}
+
+	w.buf, w.size, w.pos, w.dirty = w.buf[:keep], size, size, true
+	return w.Flush()
+}
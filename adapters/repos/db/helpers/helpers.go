@@ -11,10 +11,24 @@
 
 package helpers
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+)
 
 const (
 	PropertyNameID = "_id"
+
+	// PropertyNameShared is the internally used propName of the buckets shared
+	// by all props with the "shared" invertedIndexStorage
+	PropertyNameShared = "_shared"
+
+	// The timestamps are internal props that are only indexed if the class
+	// has indexTimestamps enabled
+	PropertyNameCreationTimeUnix   = filters.InternalPropCreationTimeUnix
+	PropertyNameLastUpdateTimeUnix = filters.InternalPropLastUpdateTimeUnix
 )
 
 var (
@@ -22,6 +36,19 @@ var (
 	ObjectsBucketLSM        = "objects"
 	DocIDBucket      []byte = []byte("doc_ids")
 	DocIDBucketLSM          = "doc_ids"
+
+	// The multi vector buckets only exist if the class has multiVector
+	// enabled, they map the doc ids to their token ids and back
+	MultiVectorDocsBucketLSM   = "multi_vector_docs"
+	MultiVectorTokensBucketLSM = "multi_vector_tokens"
+
+	// The sparse vector bucket only exists if the class has sparse enabled,
+	// it holds the impact-ordered postings of each term of the sparse vectors
+	SparseVectorPostingsBucketLSM = "sparse_vector_postings"
+
+	// The continuous aggregates bucket only exists if the class has
+	// continuous aggregates, it holds one map of groups per aggregate
+	ContinuousAggregatesBucketLSM = "continuous_aggregates"
 )
 
 // BucketFromPropName creates the byte-representation used as the bucket name
@@ -37,6 +64,36 @@ func MetaCountProp(propName string) string {
 	return fmt.Sprintf("%s__meta_count", propName)
 }
 
+// MetaNullStateProp creates the internally used propName for the null state
+// of a prop. It is only indexed if the class has indexNullState enabled.
+func MetaNullStateProp(propName string) string {
+	return fmt.Sprintf("%s__meta_null_state", propName)
+}
+
+// MetaCompositeProp creates the internally used propName for a composite
+// index over multiple props. It is only indexed if the class lists the props
+// in its compositeIndexes.
+func MetaCompositeProp(propNames []string) string {
+	return fmt.Sprintf("%s__meta_composite", strings.Join(propNames, "__"))
+}
+
+// SharedPropKey creates the key of a value of a prop in the shared buckets.
+// The value is prefixed with the name of the prop and a null byte, which can
+// never be part of a prop name, so all keys of the prop are adjacent and
+// sorted by their value.
+func SharedPropKey(propName string, value []byte) []byte {
+	prefix := SharedPropPrefix(propName)
+	out := make([]byte, len(prefix)+len(value))
+	copy(out, prefix)
+	copy(out[len(prefix):], value)
+	return out
+}
+
+// SharedPropPrefix is the prefix of all keys of a prop in the shared buckets
+func SharedPropPrefix(propName string) []byte {
+	return append([]byte(propName), 0)
+}
+
 // BucketFromPropName creates the byte-representation used as the bucket name
 // for a partiular prop in the inverted index
 func BucketFromPropNameLSM(propName string) string {
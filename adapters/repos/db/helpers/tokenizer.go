@@ -14,6 +14,8 @@ package helpers
 import (
 	"strings"
 	"unicode"
+
+	"github.com/semi-technologies/weaviate/entities/models"
 )
 
 // TokenizeString only splits on spaces, it does not alter casing
@@ -49,3 +51,49 @@ func TokenizeTextKeepWildcards(in string) []string {
 
 	return parts
 }
+
+// TokenizeLowercase only splits on spaces and lowercases the words
+func TokenizeLowercase(in string) []string {
+	parts := TokenizeString(in)
+	for i, part := range parts {
+		parts[i] = strings.ToLower(part)
+	}
+
+	return parts
+}
+
+// TokenizeField does not split at all, the entire value without surrounding
+// whitespace is a single token
+func TokenizeField(in string) []string {
+	trimmed := strings.TrimSpace(in)
+	if trimmed == "" {
+		return []string{}
+	}
+
+	return []string{trimmed}
+}
+
+// Tokenize splits the input according to the tokenization of a property
+func Tokenize(tokenization string, in string) []string {
+	switch tokenization {
+	case models.PropertyTokenizationWord:
+		return TokenizeText(in)
+	case models.PropertyTokenizationLowercase:
+		return TokenizeLowercase(in)
+	case models.PropertyTokenizationField:
+		return TokenizeField(in)
+	default:
+		return TokenizeString(in)
+	}
+}
+
+// TokenizeKeepWildcards is the equivalent of Tokenize for a Like operator. Only
+// the word tokenization would remove wildcard symbols, all others keep them
+// anyway.
+func TokenizeKeepWildcards(tokenization string, in string) []string {
+	if tokenization == models.PropertyTokenizationWord {
+		return TokenizeTextKeepWildcards(in)
+	}
+
+	return Tokenize(tokenization, in)
+}
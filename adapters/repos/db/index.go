@@ -13,26 +13,36 @@ package db
 
 import (
 	"context"
+	"math"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/aggregator"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/multi"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 )
@@ -43,18 +53,34 @@ import (
 type Index struct {
 	classSearcher         inverted.ClassSearcher // to allow for nested by-references searches
 	Shards                map[string]*Shard
+	shardsLock            sync.RWMutex // tenants add and remove shards at runtime
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
 	invertedIndexConfig   *models.InvertedIndexConfig
 	getSchema             schemaUC.SchemaGetter
 	logger                logrus.FieldLogger
 	remote                *sharding.RemoteIndex
+	status                *classStatus
+
+	// physicalID is the prefix of all files of this index on disk, see
+	// sharding.State.PhysicalID
+	physicalID string
 }
 
 func (i Index) ID() string {
 	return indexID(i.Config.ClassName)
 }
 
+// physicalIDFromState falls back to the class-based naming of previous
+// versions for states that have not been assigned a physical ID
+func physicalIDFromState(class schema.ClassName, shardState *sharding.State) string {
+	if shardState.PhysicalID == "" {
+		return indexID(class)
+	}
+
+	return shardState.PhysicalID
+}
+
 type nodeResolver interface {
 	NodeHostname(nodeName string) (string, bool)
 }
@@ -75,12 +101,18 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		invertedIndexConfig:   invertedIndexConfig,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
 			nodeResolver, remoteClient),
+		status:     &classStatus{},
+		physicalID: physicalIDFromState(config.ClassName, shardState),
 	}
 
 	if err := index.checkSingleShardMigration(shardState); err != nil {
 		return nil, errors.Wrap(err, "migrating sharding state from previous version")
 	}
 
+	if err := index.checkPhysicalIDMigration(shardState); err != nil {
+		return nil, errors.Wrap(err, "migrating files to physical index id")
+	}
+
 	for _, shardName := range shardState.AllPhysicalShards() {
 
 		if !shardState.IsShardLocal(shardName) {
@@ -88,6 +120,11 @@ func NewIndex(ctx context.Context, config IndexConfig,
 			continue
 		}
 
+		if shardState.Physical[shardName].ActivityStatus() != models.TenantActivityStatusHOT {
+			// inactive tenants are only loaded once they are activated
+			continue
+		}
+
 		shard, err := NewShard(ctx, shardName, index)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %s of index %s", shardName, index.ID())
@@ -100,6 +137,9 @@ func NewIndex(ctx context.Context, config IndexConfig,
 }
 
 func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addProperty(ctx, prop); err != nil {
 			return errors.Wrapf(err, "add property to shard %q", name)
@@ -110,6 +150,9 @@ func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
 }
 
 func (i *Index) addUUIDProperty(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addIDProperty(ctx); err != nil {
 			return errors.Wrapf(err, "add id property to shard %q", name)
@@ -119,8 +162,37 @@ func (i *Index) addUUIDProperty(ctx context.Context) error {
 	return nil
 }
 
+func (i *Index) addTimestampProperties(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for name, shard := range i.Shards {
+		if err := shard.addTimestampProperties(ctx); err != nil {
+			return errors.Wrapf(err, "add timestamp properties to shard %q", name)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) addCompositeIndexes(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for name, shard := range i.Shards {
+		if err := shard.addCompositeIndexes(ctx); err != nil {
+			return errors.Wrapf(err, "add composite indexes to shard %q", name)
+		}
+	}
+
+	return nil
+}
+
 func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	updated schema.VectorIndexConfig) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	// an updated is not specific to one shard, but rather all
 	for name, shard := range i.Shards {
 		// At the moment, we don't do anything in an update that could fail, but
@@ -136,15 +208,59 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 }
 
 type IndexConfig struct {
-	RootPath  string
-	ClassName schema.ClassName
+	RootPath        string
+	ClassName       schema.ClassName
+	RowCacheMaxSize uint64
+	HandleBudget    *lsmkv.HandleBudget
+	MemtableBudget  *lsmkv.MemtableBudget
+	IOThrottle      *iothrottle.Throttle
+	Compactions     *lsmkv.Compactions
+	Encryption      *encryption.Cipher
+	HNSWMaxLogSize  int64
+
+	// SegmentCompression is the algorithm the segments of the objects and
+	// inverted buckets are compressed with, see lsmkv.WithCompression
+	SegmentCompression string
+
+	// WriteCoalescingWindow is how long puts wait for later puts of the same
+	// object to be merged with, 0 disables coalescing
+	WriteCoalescingWindow time.Duration
+}
+
+func (i *Index) setRowCacheMaxSize(size uint64) {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	i.Config.RowCacheMaxSize = size
+	for _, shard := range i.Shards {
+		shard.invertedRowCache.SetMaxSize(size)
+	}
 }
 
 func indexID(class schema.ClassName) string {
 	return strings.ToLower(string(class))
 }
 
+func (i *Index) shardState() *sharding.State {
+	return i.getSchema.ShardingState(i.Config.ClassName.String())
+}
+
+// localShard returns the shard if it is loaded on this node
+func (i *Index) localShard(name string) (*Shard, bool) {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	shard, ok := i.Shards[name]
+	return shard, ok
+}
+
 func (i *Index) shardFromUUID(in strfmt.UUID) (string, error) {
+	shardState := i.shardState()
+	if shardState.PartitioningEnabled {
+		return "", errors.Errorf("class %s has multi-tenancy enabled, objects "+
+			"can only be accessed through their tenant", i.Config.ClassName)
+	}
+
 	uuid, err := uuid.Parse(in.String())
 	if err != nil {
 		return "", errors.Wrap(err, "parse id as uuid")
@@ -152,8 +268,121 @@ func (i *Index) shardFromUUID(in strfmt.UUID) (string, error) {
 
 	uuidBytes, _ := uuid.MarshalBinary() // cannot error
 
-	return i.getSchema.ShardingState(i.Config.ClassName.String()).
-		PhysicalShard(uuidBytes), nil
+	return shardState.PhysicalShard(uuidBytes), nil
+}
+
+// shardForTenant determines the shard of an object of the tenant. Objects of
+// classes with multi-tenancy are stored in the shard named after their
+// tenant, all others are distributed by their ID.
+func (i *Index) shardForTenant(name string, id strfmt.UUID) (string, error) {
+	if err := i.shardState().ValidateTenant(name); err != nil {
+		return "", err
+	}
+
+	if name != "" {
+		return name, nil
+	}
+
+	return i.shardFromUUID(id)
+}
+
+// shardFromContext determines the shard of an object which is accessed by
+// its ID, using the tenant the request is scoped to
+func (i *Index) shardFromContext(ctx context.Context,
+	id strfmt.UUID) (string, error) {
+	return i.shardForTenant(tenant.FromContext(ctx), id)
+}
+
+// targetShards are all shards a search or an aggregation has to consider,
+// for classes with multi-tenancy this is only the shard of the tenant the
+// request is scoped to
+func (i *Index) targetShards(ctx context.Context) ([]string, error) {
+	shardState := i.shardState()
+	name := tenant.FromContext(ctx)
+	if err := shardState.ValidateTenant(name); err != nil {
+		return nil, err
+	}
+
+	if name != "" {
+		return []string{name}, nil
+	}
+
+	return shardState.AllPhysicalShards(), nil
+}
+
+// acceptsTenant indicates whether the index can serve a request scoped to the
+// tenant. Requests which are not limited to a single class skip all indexes
+// which do not.
+func (i *Index) acceptsTenant(name string) bool {
+	return i.shardState().ValidateTenant(name) == nil
+}
+
+// projection configured for the vectors of the class, nil if vectors are
+// indexed with the dimensions the vectorizer produced
+func (i *Index) projection() *vectorizer.Projection {
+	cfg, ok := i.vectorIndexUserConfig.(hnsw.UserConfig)
+	if !ok {
+		return nil
+	}
+
+	return cfg.Projection
+}
+
+func (i *Index) projectVector(vector []float32) ([]float32, error) {
+	projected, err := i.projection().Project(vector)
+	if err != nil {
+		return nil, errortypes.Wrap(errortypes.KindValidation, err)
+	}
+
+	return projected, nil
+}
+
+// validateMultiVector makes sure objects only have token vectors if the class
+// indexes them
+func (i *Index) validateMultiVector(multiVector [][]float32) error {
+	if len(multiVector) == 0 {
+		return nil
+	}
+
+	cfg, ok := i.vectorIndexUserConfig.(hnsw.UserConfig)
+	if !ok || !cfg.MultiVector {
+		return errortypes.New(errortypes.KindValidation,
+			"object has a multi vector, but multiVector is not enabled in the "+
+				"vectorIndexConfig of class %s", i.Config.ClassName)
+	}
+
+	return nil
+}
+
+// validateSparseVector makes sure objects only have sparse vectors if the
+// class indexes them. The weights must be positive, so that the postings are
+// in impact order, see inverted.SparsePostingKey.
+func (i *Index) validateSparseVector(sparseVector map[string]float32) error {
+	if len(sparseVector) == 0 {
+		return nil
+	}
+
+	cfg, ok := i.vectorIndexUserConfig.(hnsw.UserConfig)
+	if !ok || !cfg.Sparse {
+		return errortypes.New(errortypes.KindValidation,
+			"object has a sparse vector, but sparse is not enabled in the "+
+				"vectorIndexConfig of class %s", i.Config.ClassName)
+	}
+
+	for term, weight := range sparseVector {
+		if term == "" || len(term) > math.MaxUint16 {
+			return errortypes.New(errortypes.KindValidation,
+				"sparse vector has a term of invalid length %d", len(term))
+		}
+
+		if !(weight > 0) || math.IsInf(float64(weight), 0) {
+			return errortypes.New(errortypes.KindValidation,
+				"sparse vector weights must be positive and finite, got %v for "+
+					"term %q", weight, term)
+		}
+	}
+
+	return nil
 }
 
 func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
@@ -162,23 +391,35 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 			object.Class(), i.Config.ClassName)
 	}
 
-	shardName, err := i.shardFromUUID(object.ID())
+	if err := i.validateMultiVector(object.MultiVector); err != nil {
+		return err
+	}
+
+	if err := i.validateSparseVector(object.SparseVector); err != nil {
+		return err
+	}
+
+	vector, err := i.projectVector(object.Vector)
 	if err != nil {
 		return err
 	}
+	object.Vector = vector
 
-	localShard, ok := i.Shards[shardName]
-	if !ok {
-		// this must be a remote shard, try sending it remotely
-		if err := i.remote.PutObject(ctx, shardName, object); err != nil {
-			return errors.Wrap(err, "send to remote shard")
-		}
+	shardName, err := i.shardForTenant(object.Object.Tenant, object.ID())
+	if err != nil {
+		return err
+	}
 
-		return nil
+	if localShard, ok := i.localShard(shardName); ok {
+		if err := localShard.putObject(ctx, object); err != nil {
+			return errors.Wrapf(err, "shard %s", localShard.ID())
+		}
 	}
 
-	if err := localShard.putObject(ctx, object); err != nil {
-		return errors.Wrapf(err, "shard %s", localShard.ID())
+	// send to every replica on another node, this is a no-op if the local node
+	// holds the only replica
+	if err := i.remote.PutObject(ctx, shardName, object); err != nil {
+		return errors.Wrap(err, "send to remote shard")
 	}
 
 	return nil
@@ -186,7 +427,7 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 
 func (i *Index) IncomingPutObject(ctx context.Context, shardName string,
 	object *storobj.Object) error {
-	localShard, ok := i.Shards[shardName]
+	localShard, ok := i.localShard(shardName)
 	if !ok {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -211,7 +452,24 @@ func (i *Index) putObjectBatch(ctx context.Context,
 	out := make([]error, len(objects))
 
 	for pos, obj := range objects {
-		shardName, err := i.shardFromUUID(obj.ID())
+		if err := i.validateMultiVector(obj.MultiVector); err != nil {
+			out[pos] = err
+			continue
+		}
+
+		if err := i.validateSparseVector(obj.SparseVector); err != nil {
+			out[pos] = err
+			continue
+		}
+
+		vector, err := i.projectVector(obj.Vector)
+		if err != nil {
+			out[pos] = err
+			continue
+		}
+		obj.Vector = vector
+
+		shardName, err := i.shardForTenant(obj.Object.Tenant, obj.ID())
 		if err != nil {
 			out[pos] = err
 			continue
@@ -230,17 +488,12 @@ func (i *Index) putObjectBatch(ctx context.Context,
 		go func(shardName string, group objsAndPos) {
 			defer wg.Done()
 
-			local := i.getSchema.
-				ShardingState(i.Config.ClassName.String()).
-				IsShardLocal(shardName)
-
 			var errs []error
-			if !local {
-				errs = i.remote.BatchPutObjects(ctx, shardName, group.objects)
-			} else {
-				shard := i.Shards[shardName]
+			if shard, ok := i.localShard(shardName); ok {
 				errs = shard.putObjectBatch(ctx, group.objects)
 			}
+			errs = combineErrs(errs,
+				i.remote.BatchPutObjects(ctx, shardName, group.objects))
 			for i, err := range errs {
 				desiredPos := group.pos[i]
 				out[desiredPos] = err
@@ -262,9 +515,34 @@ func duplicateErr(in error, count int) []error {
 	return out
 }
 
+// combineErrs merges the per-item errors of the local and the remote
+// replicas of a shard. An item is only successful if it succeeded on every
+// replica, otherwise the local error takes precedence. The local errors may
+// omit the successful items at the end, such as when the whole batch
+// succeeded.
+func combineErrs(local, remote []error) []error {
+	if local == nil {
+		return remote
+	}
+
+	if len(local) < len(remote) {
+		grown := make([]error, len(remote))
+		copy(grown, local)
+		local = grown
+	}
+
+	for i, err := range remote {
+		if local[i] == nil {
+			local[i] = err
+		}
+	}
+
+	return local
+}
+
 func (i *Index) IncomingBatchPutObjects(ctx context.Context, shardName string,
 	objects []*storobj.Object) []error {
-	localShard, ok := i.Shards[shardName]
+	localShard, ok := i.localShard(shardName)
 	if !ok {
 		return duplicateErr(errors.Errorf("shard %q does not exist locally",
 			shardName), len(objects))
@@ -298,17 +576,12 @@ func (i *Index) addReferencesBatch(ctx context.Context,
 	}
 
 	for shardName, group := range byShard {
-		local := i.getSchema.
-			ShardingState(i.Config.ClassName.String()).
-			IsShardLocal(shardName)
-
 		var errs []error
-		if !local {
-			errs = i.remote.BatchAddReferences(ctx, shardName, group.refs)
-		} else {
-			shard := i.Shards[shardName]
+		if shard, ok := i.localShard(shardName); ok {
 			errs = shard.addReferencesBatch(ctx, group.refs)
 		}
+		errs = combineErrs(errs,
+			i.remote.BatchAddReferences(ctx, shardName, group.refs))
 		for i, err := range errs {
 			desiredPos := group.pos[i]
 			out[desiredPos] = err
@@ -320,7 +593,7 @@ func (i *Index) addReferencesBatch(ctx context.Context,
 
 func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string,
 	refs objects.BatchReferences) []error {
-	localShard, ok := i.Shards[shardName]
+	localShard, ok := i.localShard(shardName)
 	if !ok {
 		return duplicateErr(errors.Errorf("shard %q does not exist locally",
 			shardName), len(refs))
@@ -331,21 +604,17 @@ func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string
 
 func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
-	shardName, err := i.shardFromUUID(id)
+	shardName, err := i.shardFromContext(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	local := i.getSchema.
-		ShardingState(i.Config.ClassName.String()).
-		IsShardLocal(shardName)
-
-	if !local {
+	shard, ok := i.localShard(shardName)
+	if !ok {
 		remote, err := i.remote.GetObject(ctx, shardName, id, props, additional)
 		return remote, err
 	}
 
-	shard := i.Shards[shardName]
 	obj, err := shard.objectByID(ctx, id, props, additional)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -357,7 +626,7 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties) (*storobj.Object, error) {
-	shard, ok := i.Shards[shardName]
+	shard, ok := i.localShard(shardName)
 	if !ok {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -372,7 +641,7 @@ func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 
 func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID) ([]*storobj.Object, error) {
-	shard, ok := i.Shards[shardName]
+	shard, ok := i.localShard(shardName)
 	if !ok {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -395,7 +664,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 	byShard := map[string]idsAndPos{}
 
 	for pos, id := range query {
-		shardName, err := i.shardFromUUID(strfmt.UUID(id.ID))
+		shardName, err := i.shardFromContext(ctx, strfmt.UUID(id.ID))
 		if err != nil {
 			return nil, err
 		}
@@ -409,15 +678,10 @@ func (i *Index) multiObjectByID(ctx context.Context,
 	out := make([]*storobj.Object, len(query))
 
 	for shardName, group := range byShard {
-		local := i.getSchema.
-			ShardingState(i.Config.ClassName.String()).
-			IsShardLocal(shardName)
-
 		var objects []*storobj.Object
 		var err error
 
-		if local {
-			shard := i.Shards[shardName]
+		if shard, ok := i.localShard(shardName); ok {
 			objects, err = shard.multiObjectByID(ctx, group.ids)
 			if err != nil {
 				return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -458,19 +722,26 @@ func wrapIDsInMulti(in []strfmt.UUID) []multi.Identifier {
 	return out
 }
 
-func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	shardName, err := i.shardFromUUID(id)
+// ownsLocally returns whether the shard owning the specified id is hosted on
+// this node, in which case it can be read without any network calls
+func (i *Index) ownsLocally(ctx context.Context, id strfmt.UUID) (bool, error) {
+	shardName, err := i.shardFromContext(ctx, id)
 	if err != nil {
 		return false, err
 	}
 
-	local := i.getSchema.
-		ShardingState(i.Config.ClassName.String()).
-		IsShardLocal(shardName)
+	_, ok := i.localShard(shardName)
+	return ok, nil
+}
+
+func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
+	shardName, err := i.shardFromContext(ctx, id)
+	if err != nil {
+		return false, err
+	}
 
 	var ok bool
-	if local {
-		shard := i.Shards[shardName]
+	if shard, local := i.localShard(shardName); local {
 		ok, err = shard.exists(ctx, id)
 	} else {
 		ok, err = i.remote.Exists(ctx, shardName, id)
@@ -484,7 +755,7 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 
 func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	id strfmt.UUID) (bool, error) {
-	shard, ok := i.Shards[shardName]
+	shard, ok := i.localShard(shardName)
 	if !ok {
 		return false, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -497,30 +768,33 @@ func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	return ok, nil
 }
 
+// objectSearch merges the results of all shards. For a cursor every shard
+// lists its first limit objects after the cursor position, so the first limit
+// objects of the merged list - ordered by UUID again - are exactly the next
+// page of the whole class. The same holds for a sort, as every shard lists its
+// first limit objects in the order of the sort.
 func (i *Index) objectSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter,
+	filters *filters.LocalFilter, cursor *filters.Cursor, sort []filters.Sort,
 	additional additional.Properties) ([]*storobj.Object, error) {
-	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
-		AllPhysicalShards()
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	out := make([]*storobj.Object, 0, len(shardNames)*limit)
 	for _, shardName := range shardNames {
-		local := i.getSchema.
-			ShardingState(i.Config.ClassName.String()).
-			IsShardLocal(shardName)
-
 		var res []*storobj.Object
 		var err error
 
-		if local {
-			shard := i.Shards[shardName]
-			res, err = shard.objectSearch(ctx, limit, filters, additional)
+		if shard, ok := i.localShard(shardName); ok {
+			res, err = shard.objectSearch(ctx, limit, filters, cursor, sort, additional)
 			if err != nil {
 				return nil, errors.Wrapf(err, "shard %s", shard.ID())
 			}
 
 		} else {
-			res, _, err = i.remote.SearchShard(ctx, shardName, nil, limit, filters, additional)
+			res, _, err = i.remote.SearchShard(ctx, shardName, nil, nil, nil, nil, limit,
+				filters, cursor, sort, additional)
 			if err != nil {
 				return nil, errors.Wrapf(err, "remote shard %s", shardName)
 			}
@@ -528,6 +802,14 @@ func (i *Index) objectSearch(ctx context.Context, limit int,
 		out = append(out, res...)
 	}
 
+	if cursor != nil {
+		sortByUUID(out)
+	}
+
+	if len(sort) > 0 {
+		sortByTimestamp(out, sort[0])
+	}
+
 	if len(out) > limit {
 		out = out[:limit]
 	}
@@ -535,11 +817,27 @@ func (i *Index) objectSearch(ctx context.Context, limit int,
 	return out, nil
 }
 
+// objectVectorSearch ranks the results of all shards globally. Every shard is
+// asked for the full limit, which for a paginated query must already include
+// the offset, so that any window [offset, offset+limit) of the merged list
+// is exactly the window a single shard holding all objects would have
+// returned. Consecutive pages are therefore free of duplicates and gaps as
+// long as each shard's own search is exact, which is always the case for
+// filtered searches below the flat search cutoff. For HNSW searches the same
+// holds within the recall of the index, as a larger limit can surface
+// results that a smaller one missed.
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	limit int, filters *filters.LocalFilter,
 	additional additional.Properties) ([]*storobj.Object, []float32, error) {
-	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
-		AllPhysicalShards()
+	searchVector, err := i.projectVector(searchVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	errgrp := &errgroup.Group{}
 	m := &sync.Mutex{}
@@ -549,23 +847,19 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	for _, shardName := range shardNames {
 		shardName := shardName
 		errgrp.Go(func() error {
-			local := i.getSchema.
-				ShardingState(i.Config.ClassName.String()).
-				IsShardLocal(shardName)
-
 			var res []*storobj.Object
 			var resDists []float32
 			var err error
 
-			if local {
-				shard := i.Shards[shardName]
+			if shard, ok := i.localShard(shardName); ok {
 				res, resDists, err = shard.objectVectorSearch(ctx, searchVector, limit, filters, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 
 			} else {
-				res, resDists, err = i.remote.SearchShard(ctx, shardName, searchVector, limit, filters, additional)
+				res, resDists, err = i.remote.SearchShard(ctx, shardName, searchVector,
+					nil, nil, nil, limit, filters, nil, nil, additional)
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
 				}
@@ -584,8 +878,74 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 		return nil, nil, err
 	}
 
-	if len(shardNames) == 1 {
-		return out, dists, nil
+	// even a single shard is re-sorted, so that ties are always broken the
+	// same way regardless of the sharding setup
+	sbd := sortObjsByDist{out, dists}
+	sort.Sort(sbd)
+	if len(sbd.objects) > limit {
+		sbd.objects = sbd.objects[:limit]
+		sbd.distances = sbd.distances[:limit]
+	}
+
+	return sbd.objects, sbd.distances, nil
+}
+
+// objectMultiVectorSearch ranks the results of all shards by their late
+// interaction distance to the query vectors. Just like for a vector search
+// every shard is asked for the full limit.
+func (i *Index) objectMultiVectorSearch(ctx context.Context,
+	searchVectors [][]float32, limit int, filters *filters.LocalFilter,
+	additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	cfg, ok := i.vectorIndexUserConfig.(hnsw.UserConfig)
+	if !ok || !cfg.MultiVector {
+		return nil, nil, errortypes.New(errortypes.KindValidation,
+			"searching by multiple vectors requires multiVector to be enabled in "+
+				"the vectorIndexConfig of class %s", i.Config.ClassName)
+	}
+
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errgrp := &errgroup.Group{}
+	m := &sync.Mutex{}
+
+	out := make([]*storobj.Object, 0, len(shardNames)*limit)
+	dists := make([]float32, 0, len(shardNames)*limit)
+	for _, shardName := range shardNames {
+		shardName := shardName
+		errgrp.Go(func() error {
+			var res []*storobj.Object
+			var resDists []float32
+			var err error
+
+			if shard, ok := i.localShard(shardName); ok {
+				res, resDists, err = shard.objectMultiVectorSearch(ctx, searchVectors,
+					limit, filters, additional)
+				if err != nil {
+					return errors.Wrapf(err, "shard %s", shard.ID())
+				}
+
+			} else {
+				res, resDists, err = i.remote.SearchShard(ctx, shardName, nil,
+					searchVectors, nil, nil, limit, filters, nil, nil, additional)
+				if err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
+			}
+
+			m.Lock()
+			out = append(out, res...)
+			dists = append(dists, resDists...)
+			m.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, nil, err
 	}
 
 	sbd := sortObjsByDist{out, dists}
@@ -598,16 +958,185 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	return sbd.objects, sbd.distances, nil
 }
 
+// objectKeywordSearch ranks the results of all shards by their BM25 score.
+// Just like for a vector search every shard is asked for the full limit. The
+// scores of different shards are based on their own statistics, so the merged
+// order is only an approximation of a single shard holding all objects.
+func (i *Index) objectKeywordSearch(ctx context.Context,
+	keywordRanking *searchparams.KeywordRanking, limit int,
+	filters *filters.LocalFilter,
+	additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errgrp := &errgroup.Group{}
+	m := &sync.Mutex{}
+
+	out := make([]*storobj.Object, 0, len(shardNames)*limit)
+	scores := make([]float32, 0, len(shardNames)*limit)
+	for _, shardName := range shardNames {
+		shardName := shardName
+		errgrp.Go(func() error {
+			var res []*storobj.Object
+			var resScores []float32
+			var err error
+
+			if shard, ok := i.localShard(shardName); ok {
+				res, resScores, err = shard.objectKeywordSearch(ctx, keywordRanking,
+					limit, filters, additional)
+				if err != nil {
+					return errors.Wrapf(err, "shard %s", shard.ID())
+				}
+
+			} else {
+				res, resScores, err = i.remote.SearchShard(ctx, shardName, nil,
+					nil, keywordRanking, nil, limit, filters, nil, nil, additional)
+				if err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
+			}
+
+			m.Lock()
+			out = append(out, res...)
+			scores = append(scores, resScores...)
+			m.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	sbs := sortObjsByScore{out, scores}
+	sort.Sort(sbs)
+	if len(sbs.objects) > limit {
+		sbs.objects = sbs.objects[:limit]
+		sbs.scores = sbs.scores[:limit]
+	}
+
+	return sbs.objects, sbs.scores, nil
+}
+
+// objectSparseSearch ranks the results of all shards by the dot product of
+// their sparse vectors with the sparse query vector. Unlike BM25 scores, the
+// scores do not depend on any statistics of the shards, so they are directly
+// comparable.
+func (i *Index) objectSparseSearch(ctx context.Context,
+	sparseRanking *searchparams.SparseRanking, limit int,
+	filters *filters.LocalFilter,
+	additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	cfg, ok := i.vectorIndexUserConfig.(hnsw.UserConfig)
+	if !ok || !cfg.Sparse {
+		return nil, nil, errortypes.New(errortypes.KindValidation,
+			"a sparse search requires sparse to be enabled in the "+
+				"vectorIndexConfig of class %s", i.Config.ClassName)
+	}
+
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errgrp := &errgroup.Group{}
+	m := &sync.Mutex{}
+
+	out := make([]*storobj.Object, 0, len(shardNames)*limit)
+	scores := make([]float32, 0, len(shardNames)*limit)
+	for _, shardName := range shardNames {
+		shardName := shardName
+		errgrp.Go(func() error {
+			var res []*storobj.Object
+			var resScores []float32
+			var err error
+
+			if shard, ok := i.localShard(shardName); ok {
+				res, resScores, err = shard.objectSparseSearch(ctx, sparseRanking,
+					limit, filters, additional)
+				if err != nil {
+					return errors.Wrapf(err, "shard %s", shard.ID())
+				}
+
+			} else {
+				res, resScores, err = i.remote.SearchShard(ctx, shardName, nil,
+					nil, nil, sparseRanking, limit, filters, nil, nil, additional)
+				if err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
+			}
+
+			m.Lock()
+			out = append(out, res...)
+			scores = append(scores, resScores...)
+			m.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	sbs := sortObjsByScore{out, scores}
+	sort.Sort(sbs)
+	if len(sbs.objects) > limit {
+		sbs.objects = sbs.objects[:limit]
+		sbs.scores = sbs.scores[:limit]
+	}
+
+	return sbs.objects, sbs.scores, nil
+}
+
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, searchVectors [][]float32,
+	keywordRanking *searchparams.KeywordRanking,
+	sparseRanking *searchparams.SparseRanking,
+	limit int, filters *filters.LocalFilter, cursor *filters.Cursor,
+	sort []filters.Sort,
 	additional additional.Properties) ([]*storobj.Object, []float32, error) {
-	shard, ok := i.Shards[shardName]
+	shard, ok := i.localShard(shardName)
 	if !ok {
 		return nil, nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
+	if keywordRanking != nil {
+		// scores are returned in place of the distances
+		res, resScores, err := shard.objectKeywordSearch(ctx, keywordRanking,
+			limit, filters, additional)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		return res, resScores, nil
+	}
+
+	if sparseRanking != nil {
+		// scores are returned in place of the distances
+		res, resScores, err := shard.objectSparseSearch(ctx, sparseRanking,
+			limit, filters, additional)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		return res, resScores, nil
+	}
+
+	if searchVectors != nil {
+		res, resDists, err := shard.objectMultiVectorSearch(ctx, searchVectors,
+			limit, filters, additional)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		return res, resDists, nil
+	}
+
 	if searchVector == nil {
-		res, err := shard.objectSearch(ctx, limit, filters, additional)
+		res, err := shard.objectSearch(ctx, limit, filters, cursor, sort, additional)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 		}
@@ -625,12 +1154,31 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 }
 
 func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
-	shardName, err := i.shardFromUUID(id)
+	shardName, err := i.shardFromContext(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	shard := i.Shards[shardName]
+	if shard, ok := i.localShard(shardName); ok {
+		if err := shard.deleteObject(ctx, id); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	if err := i.remote.DeleteObject(ctx, shardName, id); err != nil {
+		return errors.Wrapf(err, "remote shard %s", shardName)
+	}
+
+	return nil
+}
+
+func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
+	id strfmt.UUID) error {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
 	if err := shard.deleteObject(ctx, id); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -638,36 +1186,187 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	return nil
 }
 
+// findDocIDs returns the doc ids matching the filter for every shard of the
+// index. Doc ids are only unique within a shard, so they are keyed by shard.
+func (i *Index) findDocIDs(ctx context.Context,
+	filters *filters.LocalFilter) (map[string][]uint64, error) {
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string][]uint64, len(shardNames))
+	for _, shardName := range shardNames {
+		var res []uint64
+		var err error
+
+		if shard, ok := i.localShard(shardName); ok {
+			res, err = shard.findDocIDs(ctx, filters)
+		} else {
+			res, err = i.remote.FindDocIDs(ctx, shardName, filters)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		out[shardName] = res
+	}
+
+	return out, nil
+}
+
+func (i *Index) IncomingFindDocIDs(ctx context.Context, shardName string,
+	filters *filters.LocalFilter) ([]uint64, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	docIDs, err := shard.findDocIDs(ctx, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return docIDs, nil
+}
+
+// batchDeleteObjects deletes the specified doc ids in every shard and
+// returns the outcome of all shards combined. Doc ids are specific to a
+// replica, so the doc ids of a local shard are only deleted locally and the
+// remote replicas delete the same objects by their UUID.
+func (i *Index) batchDeleteObjects(ctx context.Context,
+	shardDocIDs map[string][]uint64, dryRun bool) (objects.BatchSimpleObjects, error) {
+	var out objects.BatchSimpleObjects
+	for shardName, docIDs := range shardDocIDs {
+		if len(docIDs) == 0 {
+			continue
+		}
+
+		var res objects.BatchSimpleObjects
+		if shard, ok := i.localShard(shardName); ok {
+			res = shard.deleteObjectBatch(ctx, docIDs, dryRun)
+			if !dryRun {
+				i.replicateBatchDelete(ctx, shardName, res)
+			}
+		} else {
+			var err error
+			res, err = i.remote.DeleteObjectBatch(ctx, shardName, docIDs, dryRun)
+			if err != nil {
+				return nil, errors.Wrapf(err, "remote shard %s", shardName)
+			}
+		}
+
+		out = append(out, res...)
+	}
+
+	return out, nil
+}
+
+// replicateBatchDelete deletes the objects which were deleted from the local
+// replica of a shard from all remote replicas, a failure is reported as the
+// error of the object
+func (i *Index) replicateBatchDelete(ctx context.Context, shardName string,
+	res objects.BatchSimpleObjects) {
+	for j := range res {
+		if res[j].Err != nil {
+			continue
+		}
+
+		if err := i.remote.DeleteObject(ctx, shardName, res[j].UUID); err != nil {
+			res[j].Err = errors.Wrapf(err, "remote shard %s", shardName)
+		}
+	}
+}
+
+func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
+	docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.deleteObjectBatch(ctx, docIDs, dryRun), nil
+}
+
 func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
-	shardName, err := i.shardFromUUID(merge.ID)
+	shardName, err := i.shardForTenant(merge.Tenant, merge.ID)
 	if err != nil {
 		return err
 	}
 
-	shard := i.Shards[shardName]
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	if merge.Vector, err = i.projectVector(merge.Vector); err != nil {
+		return err
+	}
+
+	if err := i.validateMultiVector(merge.MultiVector); err != nil {
+		return err
+	}
+
+	if err := i.validateSparseVector(merge.SparseVector); err != nil {
+		return err
+	}
+
 	if err := shard.mergeObject(ctx, merge); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	remoteNodes := i.shardState().RemoteNodes(shardName)
+	if len(remoteNodes) == 0 {
+		return nil
+	}
+
+	// a merge is only applied once, the other replicas receive the merged
+	// object as a whole
+	merged, err := shard.objectByID(ctx, merge.ID, nil, additional.Properties{})
+	if err != nil {
+		return errors.Wrapf(err, "shard %s: read merged object", shard.ID())
+	}
+	if merged == nil {
+		return errors.Errorf("shard %s: merged object %s not found", shard.ID(),
+			merge.ID)
+	}
+
+	if err := i.remote.PutObject(ctx, shardName, merged); err != nil {
+		return errors.Wrapf(err, "remote shard %s", shardName)
+	}
+
 	return nil
 }
 
 func (i *Index) aggregate(ctx context.Context,
 	params aggregation.Params) (*aggregation.Result, error) {
-	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
-	shardNames := shardState.AllPhysicalShards()
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.SearchVector != nil && len(shardNames) > 1 {
+		// the objectLimit and the threshold apply to the class as a whole, so
+		// the closest objects are picked across all shards before any shard
+		// aggregates over its share of them
+		ids, err := i.aggregationVectorSearch(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		params.ObjectIDs = ids
+		params.SearchVector = nil
+		params.Filters = nil
+	}
 
 	results := make([]*aggregation.Result, len(shardNames))
 	for j, shardName := range shardNames {
-		local := shardState.IsShardLocal(shardName)
-
 		var err error
 		var res *aggregation.Result
-		if !local {
-			res, err = i.remote.Aggregate(ctx, shardName, params)
-		} else {
-			shard := i.Shards[shardName]
+		if shard, ok := i.localShard(shardName); ok {
 			res, err = shard.aggregate(ctx, params)
+		} else {
+			res, err = i.remote.Aggregate(ctx, shardName, params)
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "shard %s", shardName)
@@ -683,9 +1382,35 @@ func (i *Index) aggregate(ctx context.Context,
 	return results[0], nil
 }
 
+// aggregationVectorSearch returns the ids of the objects closest to the
+// search vector of the aggregation across all shards, limited by its
+// objectLimit and its threshold
+func (i *Index) aggregationVectorSearch(ctx context.Context,
+	params aggregation.Params) ([]strfmt.UUID, error) {
+	var objs []*storobj.Object
+	matching, err := aggregator.SearchWithinThreshold(params,
+		i.getSchema.GetSchemaSkipAuth(), func(limit int) ([]float32, error) {
+			var dists []float32
+			var err error
+			objs, dists, err = i.objectVectorSearch(ctx, params.SearchVector,
+				limit, params.Filters, additional.Properties{})
+			return dists, err
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "vector search")
+	}
+
+	ids := make([]strfmt.UUID, matching)
+	for j := range ids {
+		ids[j] = objs[j].ID()
+	}
+
+	return ids, nil
+}
+
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 	params aggregation.Params) (*aggregation.Result, error) {
-	shard, ok := i.Shards[shardName]
+	shard, ok := i.localShard(shardName)
 	if !ok {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -699,9 +1424,22 @@ func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 }
 
 func (i *Index) drop() error {
-	for _, name := range i.getSchema.ShardingState(i.Config.ClassName.String()).
-		AllPhysicalShards() {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	shardState := i.shardState()
+	for _, name := range shardState.AllPhysicalShards() {
 		shard, ok := i.Shards[name]
+		if !ok && shardState.PartitioningEnabled && shardState.IsShardLocal(name) {
+			// the shards of inactive tenants are not loaded, but their files
+			// need to be removed all the same
+			var err error
+			shard, err = NewShard(context.TODO(), name, i)
+			if err != nil {
+				return errors.Wrapf(err, "load shard %s", name)
+			}
+			ok = true
+		}
 		if !ok {
 			// skip non-local, but do delete evertying that exists - even if it
 			// shouldn't
@@ -711,12 +1449,16 @@ func (i *Index) drop() error {
 		if err != nil {
 			return errors.Wrapf(err, "delete shard %s", shard.ID())
 		}
+		delete(i.Shards, name)
 	}
 
 	return nil
 }
 
 func (i *Index) Shutdown(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for id, shard := range i.Shards {
 		if err := shard.shutdown(ctx); err != nil {
 			return errors.Wrapf(err, "shutdown shard %q", id)
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+)
+
+// continuousAggregate combines the groups of the continuous aggregate of all
+// shards
+func (i *Index) continuousAggregate(ctx context.Context,
+	params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]aggregation.ContinuousGroup, len(shardNames))
+	for j, shardName := range shardNames {
+		var res []aggregation.ContinuousGroup
+		var err error
+
+		if shard, ok := i.localShard(shardName); ok {
+			res, err = shard.continuousAggregate(ctx, params)
+		} else {
+			res, err = i.remote.ContinuousAggregate(ctx, shardName, params)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		results[j] = res
+	}
+
+	return aggregation.CombineContinuous(results), nil
+}
+
+func (i *Index) IncomingContinuousAggregate(ctx context.Context, shardName string,
+	params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	res, err := shard.continuousAggregate(ctx, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return res, nil
+}
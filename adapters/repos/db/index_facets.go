@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/aggregator"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// facetedSearch merges the results of all shards. As with objectSearch, the
+// objects are cut off at the limit after merging, while the counts and facets
+// of all shards are combined.
+func (i *Index) facetedSearch(ctx context.Context,
+	params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error) {
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]*storobj.Object, 0, len(shardNames)*params.Limit)
+	results := make([]*aggregation.FacetResult, len(shardNames))
+	for j, shardName := range shardNames {
+		var objs []*storobj.Object
+		var res *aggregation.FacetResult
+		var err error
+
+		if shard, ok := i.localShard(shardName); ok {
+			objs, res, err = shard.facetedSearch(ctx, params)
+		} else {
+			objs, res, err = i.remote.FacetedSearch(ctx, shardName, params)
+		}
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		out = append(out, objs...)
+		results[j] = res
+	}
+
+	if len(out) > params.Limit {
+		out = out[:params.Limit]
+	}
+
+	return out, aggregator.CombineFacets(results, params.FacetLimit), nil
+}
+
+func (i *Index) IncomingFacetedSearch(ctx context.Context, shardName string,
+	params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return nil, nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	objs, res, err := shard.facetedSearch(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return objs, res, nil
+}
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/filters"
+)
+
+// estimateFilter combines the estimates of all shards
+func (i *Index) estimateFilter(ctx context.Context,
+	params filters.EstimateParams) (*filters.Estimate, error) {
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*filters.Estimate, len(shardNames))
+	for j, shardName := range shardNames {
+		var res *filters.Estimate
+		var err error
+
+		if shard, ok := i.localShard(shardName); ok {
+			res, err = shard.estimateFilter(ctx, params)
+		} else {
+			res, err = i.remote.EstimateFilter(ctx, shardName, params)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		results[j] = res
+	}
+
+	return filters.CombineEstimates(results), nil
+}
+
+func (i *Index) IncomingEstimateFilter(ctx context.Context, shardName string,
+	params filters.EstimateParams) (*filters.Estimate, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	res, err := shard.estimateFilter(ctx, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return res, nil
+}
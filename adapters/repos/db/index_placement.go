@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+const (
+	// shardCopyBatchSize is the number of objects sent to the new replica of a
+	// shard at once
+	shardCopyBatchSize = 100
+	// shardCopyScrollTTL only needs to cover sending a single batch
+	shardCopyScrollTTL = time.Minute
+)
+
+// CopyShard copies all objects of the local shard to the replica of the
+// shard on the specified node and returns the number of copied objects. The
+// replica must have been placed on the node before, so that writes which
+// happen during the copy already reach it.
+//
+// The objects are read from a snapshot of the shard, so an object which is
+// changed while the copy is in progress may be overwritten with its previous
+// version on the new replica.
+func (d *DB) CopyShard(ctx context.Context, className, shardName,
+	node string) (int64, error) {
+	idx := d.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return 0, errors.Errorf("class %s does not exist", className)
+	}
+
+	return idx.copyShard(ctx, shardName, node)
+}
+
+// updateShardPlacement brings the loaded shards in line with the sharding
+// state after replicas were moved between nodes. Shards which are now placed
+// on this node are created, shards which were moved off it are shut down.
+// Their files are kept on disk, so a node which was drained by mistake does
+// not lose any data.
+func (i *Index) updateShardPlacement(ctx context.Context) error {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	shardState := i.shardState()
+	for name, shard := range i.Shards {
+		if shardState.IsShardLocal(name) {
+			continue
+		}
+
+		if err := shard.shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shutdown shard %s", name)
+		}
+
+		delete(i.Shards, name)
+	}
+
+	for _, name := range shardState.AllLocalPhysicalShards() {
+		if _, ok := i.Shards[name]; ok {
+			continue
+		}
+
+		if shardState.Physical[name].ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+
+		shard, err := NewShard(ctx, name, i)
+		if err != nil {
+			return errors.Wrapf(err, "init shard %s of index %s", name, i.ID())
+		}
+
+		i.Shards[name] = shard
+	}
+
+	return nil
+}
+
+func (i *Index) copyShard(ctx context.Context, shardName,
+	node string) (int64, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return 0, errors.Errorf("shard %q is not loaded on this node", shardName)
+	}
+
+	var count int64
+	id := ""
+	for {
+		objs, next, err := shard.scroll(ctx, id, shardCopyScrollTTL,
+			shardCopyBatchSize, additional.Properties{Vector: true})
+		if err != nil {
+			return count, errors.Wrapf(err, "shard %s: read objects", shardName)
+		}
+
+		if len(objs) > 0 {
+			for _, err := range i.remote.BatchPutObjectsOnNode(ctx, shardName, node, objs) {
+				if err != nil {
+					return count, errors.Wrapf(err, "shard %s: copy objects to node %s",
+						shardName, node)
+				}
+			}
+			count += int64(len(objs))
+		}
+
+		if next == "" {
+			return count, nil
+		}
+		id = next
+	}
+}
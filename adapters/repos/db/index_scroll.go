@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// scrollPosition is encoded in the scroll id handed out to the user. The
+// scroll of a shard is held by a single node, so the id contains that node
+// and any node of the cluster can route the request for the next page there.
+// The shards of a class are scrolled one after another in the order of their
+// names, every shard is snapshotted when the scroll reaches it.
+type scrollPosition struct {
+	Class string `json:"class"`
+	Shard string `json:"shard"`
+	Node  string `json:"node"`
+	ID    string `json:"id"`
+}
+
+func (p scrollPosition) encode() string {
+	// marshalling a struct of strings cannot fail
+	b, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parseScrollID(in string) (scrollPosition, error) {
+	var pos scrollPosition
+	b, err := base64.RawURLEncoding.DecodeString(in)
+	if err == nil {
+		err = json.Unmarshal(b, &pos)
+	}
+	if err != nil || pos.Class == "" || pos.Shard == "" || pos.ID == "" {
+		return pos, errortypes.New(errortypes.KindValidation,
+			"invalid scroll id %q", in)
+	}
+
+	return pos, nil
+}
+
+// scroll returns the next page of up to limit objects, continuing at pos or
+// at the first shard if pos is nil. The returned position is nil once all
+// shards are exhausted.
+func (i *Index) scroll(ctx context.Context, pos *scrollPosition,
+	ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, *scrollPosition, error) {
+	shardNames, err := i.targetShards(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start, node, id := 0, "", ""
+	if pos != nil {
+		start = -1
+		for s, name := range shardNames {
+			if name == pos.Shard {
+				start = s
+				break
+			}
+		}
+
+		if start == -1 {
+			return nil, nil, errortypes.New(errortypes.KindNotFound,
+				"shard %q of the scroll no longer exists", pos.Shard)
+		}
+
+		node, id = pos.Node, pos.ID
+	}
+
+	out := make([]*storobj.Object, 0, limit)
+	for _, shardName := range shardNames[start:] {
+		res, next, nextNode, err := i.scrollShard(ctx, shardName, node, id, ttl,
+			limit-len(out), additional)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		out = append(out, res...)
+		if next != "" {
+			return out, &scrollPosition{
+				Class: i.Config.ClassName.String(),
+				Shard: shardName,
+				Node:  nextNode,
+				ID:    next,
+			}, nil
+		}
+
+		// the shard is exhausted, the next one opens a new scroll
+		node, id = "", ""
+	}
+
+	return out, nil, nil
+}
+
+// scrollShard continues the scroll with the given id on the node holding it.
+// An empty id opens a new scroll, on this node if it holds a replica of the
+// shard or on a remote replica otherwise. The name of the node holding the
+// scroll is returned alongside the id for the next page.
+func (i *Index) scrollShard(ctx context.Context, shardName, node, id string,
+	ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, string, string, error) {
+	localName := i.shardState().LocalName()
+	if node == "" || node == localName {
+		if shard, ok := i.localShard(shardName); ok {
+			res, next, err := shard.scroll(ctx, id, ttl, limit, additional)
+			return res, next, localName, err
+		}
+
+		if node != "" {
+			return nil, "", "", errortypes.New(errortypes.KindNotFound,
+				"shard %q of the scroll is no longer held by node %q", shardName, node)
+		}
+	}
+
+	return i.remote.ScrollShard(ctx, shardName, node, id, ttl, limit, additional)
+}
+
+func (i *Index) IncomingScroll(ctx context.Context, shardName, id string,
+	ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, string, error) {
+	shard, ok := i.localShard(shardName)
+	if !ok {
+		return nil, "", errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.scroll(ctx, id, ttl, limit, additional)
+}
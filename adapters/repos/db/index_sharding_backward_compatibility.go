@@ -40,7 +40,7 @@ func (i *Index) checkSingleShardMigration(shardState *sharding.State) error {
 		}
 
 		shardName := shards[0]
-		newName := i.ID() + "_" + shardName + strings.TrimPrefix(entry.Name(), i.ID()+"_single")
+		newName := i.physicalID + "_" + shardName + strings.TrimPrefix(entry.Name(), i.ID()+"_single")
 		oldPath := filepath.Join(i.Config.RootPath, entry.Name())
 		newPath := filepath.Join(i.Config.RootPath, newName)
 
@@ -57,3 +57,45 @@ func (i *Index) checkSingleShardMigration(shardState *sharding.State) error {
 
 	return nil
 }
+
+// checkPhysicalIDMigration moves the files of shards created with a previous
+// version, which were named after the class, to their physical ID. It runs
+// after checkSingleShardMigration, which may still produce class-based names.
+func (i *Index) checkPhysicalIDMigration(shardState *sharding.State) error {
+	if i.physicalID == i.ID() {
+		// the state has no physical id, keep the class-based names
+		return nil
+	}
+
+	res, err := os.ReadDir(i.Config.RootPath)
+	if err != nil {
+		return err
+	}
+
+	for _, shardName := range shardState.AllLocalPhysicalShards() {
+		oldPrefix := i.ID() + "_" + shardName
+		newPrefix := i.physicalID + "_" + shardName
+
+		for _, entry := range res {
+			if !strings.HasPrefix(entry.Name(), oldPrefix) {
+				continue
+			}
+
+			newName := newPrefix + strings.TrimPrefix(entry.Name(), oldPrefix)
+			oldPath := filepath.Join(i.Config.RootPath, entry.Name())
+			newPath := filepath.Join(i.Config.RootPath, newName)
+
+			if err := os.Rename(oldPath, newPath); err != nil {
+				return errors.Wrapf(err, "migrate shard %q to %q", oldPath, newPath)
+			}
+
+			i.logger.WithField("action", "index_startup_migrate_physical_id_successful").
+				WithField("old_shard", oldPath).
+				WithField("new_shard", newPath).
+				Infof("successfully migrated shard file %q (named after the class in "+
+					"an earlier version) to %q", oldPath, newPath)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// loadTenantShards loads the local shards of the specified tenants if they
+// are active. Shards which do not exist yet are created.
+func (i *Index) loadTenantShards(ctx context.Context, names []string) error {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	shardState := i.shardState()
+	for _, name := range names {
+		if _, ok := i.Shards[name]; ok {
+			continue
+		}
+
+		if !shardState.IsShardLocal(name) ||
+			shardState.Physical[name].ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+
+		shard, err := NewShard(ctx, name, i)
+		if err != nil {
+			return errors.Wrapf(err, "init shard %s of index %s", name, i.ID())
+		}
+
+		i.Shards[name] = shard
+	}
+
+	return nil
+}
+
+// unloadTenantShards shuts down the local shards of the specified tenants if
+// they are inactive, their files are kept on disk
+func (i *Index) unloadTenantShards(ctx context.Context, names []string) error {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	shardState := i.shardState()
+	for _, name := range names {
+		shard, ok := i.Shards[name]
+		if !ok {
+			continue
+		}
+
+		if shardState.Physical[name].ActivityStatus() == models.TenantActivityStatusHOT {
+			continue
+		}
+
+		if err := shard.shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shutdown shard %s", name)
+		}
+
+		delete(i.Shards, name)
+	}
+
+	return nil
+}
+
+// dropTenantShards deletes the local shards of the specified tenants
+// including all their files. It must be called while the tenants are still
+// part of the sharding state, as the shards of inactive tenants are not
+// loaded and have to be looked up in the state.
+func (i *Index) dropTenantShards(ctx context.Context, names []string) error {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	shardState := i.shardState()
+	for _, name := range names {
+		shard, ok := i.Shards[name]
+		if !ok {
+			if !shardState.IsShardLocal(name) {
+				continue
+			}
+
+			var err error
+			shard, err = NewShard(ctx, name, i)
+			if err != nil {
+				return errors.Wrapf(err, "load shard %s", name)
+			}
+		}
+
+		if err := shard.drop(); err != nil {
+			return errors.Wrapf(err, "delete shard %s", name)
+		}
+
+		delete(i.Shards, name)
+	}
+
+	return nil
+}
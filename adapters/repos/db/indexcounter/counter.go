@@ -54,10 +54,15 @@ func New(shardID string, rootPath string) (*Counter, error) {
 }
 
 func (c *Counter) GetAndInc() (uint64, error) {
+	return c.GetAndIncBy(1)
+}
+
+// GetAndIncBy reserves n consecutive ids and returns the first one
+func (c *Counter) GetAndIncBy(n uint64) (uint64, error) {
 	c.Lock()
 	defer c.Unlock()
 	before := c.count
-	c.count++
+	c.count += n
 	c.f.Seek(0, 0)
 	err := binary.Write(c.f, binary.LittleEndian, &c.count)
 	if err != nil {
@@ -67,6 +72,11 @@ func (c *Counter) GetAndInc() (uint64, error) {
 	return before, nil
 }
 
+// FileName is the path of the file the counter is persisted in
+func (c *Counter) FileName() string {
+	return c.f.Name()
+}
+
 func (c *Counter) Drop() error {
 	c.Lock()
 	defer c.Unlock()
@@ -46,8 +46,17 @@ func (d *DB) init(ctx context.Context) error {
 			}
 
 			idx, err := NewIndex(ctx, IndexConfig{
-				ClassName: schema.ClassName(class.Class),
-				RootPath:  d.config.RootPath,
+				ClassName:             schema.ClassName(class.Class),
+				RootPath:              d.config.RootPath,
+				RowCacheMaxSize:       d.config.RowCacheMaxSize,
+				HandleBudget:          d.handles,
+				MemtableBudget:        d.memtables,
+				IOThrottle:            d.throttle,
+				Compactions:           d.compactions,
+				Encryption:            d.config.Encryption,
+				HNSWMaxLogSize:        d.config.HNSWMaxLogSize,
+				SegmentCompression:    d.config.SegmentCompression,
+				WriteCoalescingWindow: d.config.WriteCoalescingWindow,
 			}, d.schemaGetter.ShardingState(class.Class), invertedConfig,
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
 				d.schemaGetter, d, d.logger, d.nodeResolver, d.remoteClient)
@@ -14,7 +14,6 @@ package inverted
 import (
 	"bytes"
 	"encoding/binary"
-	"strings"
 
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -29,43 +28,51 @@ type Property struct {
 	Name         string
 	Items        []Countable
 	HasFrequency bool
+
+	// Shared props are stored in the buckets shared with the other props of
+	// the class that have the "shared" invertedIndexStorage
+	Shared bool
+
+	// Length is the number of tokens of a prop with frequency, it is stored
+	// alongside the term frequencies to rank keyword searches
+	Length int
 }
 
-type Analyzer struct{}
+type Analyzer struct {
+	// strictTypes rejects values which would have to be coerced into the data
+	// type of their prop as well as values of props which are not part of the
+	// schema, see NewStrictAnalyzer
+	strictTypes bool
+}
 
 // Text removes non alpha-numeric and splits into words, then aggregates
 // duplicates
 func (a *Analyzer) Text(in string) []Countable {
-	parts := helpers.TokenizeText(in)
-	terms := map[string]uint64{}
-	total := 0
-	for _, word := range parts {
-		word = strings.ToLower(word)
-		count, ok := terms[word]
-		if !ok {
-			terms[word] = 0
-		}
-		terms[word] = count + 1
-		total++
-	}
-
-	out := make([]Countable, len(terms))
-	i := 0
-	for term, count := range terms {
-		out[i] = Countable{
-			Data:          []byte(term),
-			TermFrequency: float64(count) / float64(total),
-		}
-		i++
-	}
-
-	return out
+	return countTerms(helpers.TokenizeText(in))
 }
 
 // String splits only on spaces and does not lowercase, then aggregates
 // duplicates
 func (a *Analyzer) String(in string) []Countable {
-	parts := helpers.TokenizeString(in)
+	return countTerms(helpers.TokenizeString(in))
+}
+
+// Tokenized splits each of the values according to the tokenization of a
+// property, then aggregates duplicates across all values
+func (a *Analyzer) Tokenized(tokenization string, in []string) []Countable {
+	return countTerms(tokenize(tokenization, in))
+}
+
+func tokenize(tokenization string, in []string) []string {
+	var parts []string
+	for _, value := range in {
+		parts = append(parts, helpers.Tokenize(tokenization, value)...)
+	}
+
+	return parts
+}
+
+func countTerms(parts []string) []Countable {
 	terms := map[string]uint64{}
 	total := 0
 	for _, word := range parts {
@@ -214,3 +221,11 @@ func (a *Analyzer) Ref(in models.MultipleRef) ([]Countable, error) {
 func NewAnalyzer() *Analyzer {
 	return &Analyzer{}
 }
+
+// NewStrictAnalyzer returns an analyzer for classes with strictTypes set in
+// their invertedIndexConfig. Contrary to the regular analyzer, it errors on a
+// write which would have to be coerced, such as a float with a fraction for an
+// int prop, or which sets a prop that is not part of the schema.
+func NewStrictAnalyzer() *Analyzer {
+	return &Analyzer{strictTypes: true}
+}
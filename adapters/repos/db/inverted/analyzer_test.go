@@ -101,6 +101,74 @@ func TestAnalyzer(t *testing.T) {
 		})
 	})
 
+	t.Run("with tokenization", func(t *testing.T) {
+		in := []string{"Hello World", " hello-world "}
+
+		t.Run("word", func(t *testing.T) {
+			res := a.Tokenized(models.PropertyTokenizationWord, in)
+			assert.ElementsMatch(t, res, []Countable{
+				{
+					Data:          []byte("hello"),
+					TermFrequency: float64(2) / 4,
+				},
+				{
+					Data:          []byte("world"),
+					TermFrequency: float64(2) / 4,
+				},
+			})
+		})
+
+		t.Run("lowercase", func(t *testing.T) {
+			res := a.Tokenized(models.PropertyTokenizationLowercase, in)
+			assert.ElementsMatch(t, res, []Countable{
+				{
+					Data:          []byte("hello"),
+					TermFrequency: float64(1) / 3,
+				},
+				{
+					Data:          []byte("world"),
+					TermFrequency: float64(1) / 3,
+				},
+				{
+					Data:          []byte("hello-world"),
+					TermFrequency: float64(1) / 3,
+				},
+			})
+		})
+
+		t.Run("whitespace", func(t *testing.T) {
+			res := a.Tokenized(models.PropertyTokenizationWhitespace, in)
+			assert.ElementsMatch(t, res, []Countable{
+				{
+					Data:          []byte("Hello"),
+					TermFrequency: float64(1) / 3,
+				},
+				{
+					Data:          []byte("World"),
+					TermFrequency: float64(1) / 3,
+				},
+				{
+					Data:          []byte("hello-world"),
+					TermFrequency: float64(1) / 3,
+				},
+			})
+		})
+
+		t.Run("field", func(t *testing.T) {
+			res := a.Tokenized(models.PropertyTokenizationField, in)
+			assert.ElementsMatch(t, res, []Countable{
+				{
+					Data:          []byte("Hello World"),
+					TermFrequency: float64(1) / 2,
+				},
+				{
+					Data:          []byte("hello-world"),
+					TermFrequency: float64(1) / 2,
+				},
+			})
+		})
+	})
+
 	t.Run("with int it stays sortable", func(t *testing.T) {
 		getData := func(in []Countable, err error) []byte {
 			require.Nil(t, err)
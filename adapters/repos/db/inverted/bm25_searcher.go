@@ -0,0 +1,266 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+)
+
+// the usual BM25 defaults for term frequency saturation and length
+// normalization
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// FrequencyValue is the value a term is stored with in the inverted index of
+// a prop with frequency: the number of occurrences of the term in the prop as
+// well as the length of the prop, both as float32. Values written before the
+// length was stored decode to a count close to zero, so those objects are only
+// ranked once they are written again.
+func FrequencyValue(item Countable, propLength int) []byte {
+	out := make([]byte, 8)
+	count := math.Round(item.TermFrequency * float64(propLength))
+	binary.LittleEndian.PutUint32(out[:4], math.Float32bits(float32(count)))
+	binary.LittleEndian.PutUint32(out[4:], math.Float32bits(float32(propLength)))
+	return out
+}
+
+type bm25Posting struct {
+	docID  uint64
+	count  float64
+	length float64
+}
+
+func parseBM25Posting(pair lsmkv.MapPair) (bm25Posting, bool) {
+	if pair.Tombstone || len(pair.Key) != 8 || len(pair.Value) != 8 {
+		return bm25Posting{}, false
+	}
+
+	return bm25Posting{
+		docID:  binary.LittleEndian.Uint64(pair.Key),
+		count:  float64(math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[:4]))),
+		length: float64(math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:]))),
+	}, true
+}
+
+// BM25Searcher ranks the objects of a shard by the relevance of their text
+// and string props for a keyword query. It only reads the inverted index and
+// never involves the vector index.
+type BM25Searcher struct {
+	store         *lsmkv.Store
+	schema        schema.Schema
+	deletedDocIDs DeletedDocIDChecker
+}
+
+func NewBM25Searcher(store *lsmkv.Store, schema schema.Schema,
+	deletedDocIDs DeletedDocIDChecker) *BM25Searcher {
+	return &BM25Searcher{
+		store:         store,
+		schema:        schema,
+		deletedDocIDs: deletedDocIDs,
+	}
+}
+
+// Search returns the doc ids of the limit highest scoring objects together
+// with their scores in descending order. If an allowList is set, only the
+// objects on it are ranked. The inverse document frequencies are based on the
+// objectCount of the shard, the average prop length is approximated by the
+// lengths of the props which contain any of the query terms.
+func (b *BM25Searcher) Search(ctx context.Context, className schema.ClassName,
+	ranking searchparams.KeywordRanking, allowList helpers.AllowList,
+	limit int, objectCount int64) ([]uint64, []float32, error) {
+	class := b.schema.GetClass(className)
+	if class == nil {
+		return nil, nil, errors.Errorf("class %q not found in schema", className)
+	}
+
+	props, err := KeywordProperties(class, ranking.Properties)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scores := map[uint64]float64{}
+	for _, prop := range props {
+		if err := b.scoreProp(ctx, prop, ranking.Query, allowList, objectCount,
+			scores); err != nil {
+			return nil, nil, errors.Wrapf(err, "score prop %q", prop.Name)
+		}
+	}
+
+	ids, idScores := topScores(scores, limit)
+	return ids, idScores, nil
+}
+
+func (b *BM25Searcher) scoreProp(ctx context.Context, prop *models.Property,
+	query string, allowList helpers.AllowList, objectCount int64,
+	scores map[uint64]float64) error {
+	bucket := b.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
+	if bucket == nil {
+		return errors.Errorf("no bucket for prop '%s' found", prop.Name)
+	}
+
+	terms := uniqueTerms(helpers.Tokenize(PropertyTokenization(prop), query))
+	postings := make([][]bm25Posting, len(terms))
+	docFrequencies := make([]int64, len(terms))
+	var lengthSum float64
+	var lengthCount int
+
+	for i, term := range terms {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pairs, err := bucket.MapList([]byte(term))
+		if err != nil {
+			return errors.Wrapf(err, "read postings of term %q", term)
+		}
+
+		for _, pair := range pairs {
+			posting, ok := parseBM25Posting(pair)
+			if !ok || b.deletedDocIDs.Contains(posting.docID) {
+				continue
+			}
+
+			docFrequencies[i]++
+			lengthSum += posting.length
+			lengthCount++
+
+			if allowList != nil && !allowList.Contains(posting.docID) {
+				continue
+			}
+
+			postings[i] = append(postings[i], posting)
+		}
+	}
+
+	avgLength := 0.0
+	if lengthCount > 0 {
+		avgLength = lengthSum / float64(lengthCount)
+	}
+
+	for i := range terms {
+		n := float64(docFrequencies[i])
+		total := math.Max(float64(objectCount), n)
+		idf := math.Log(1 + (total-n+0.5)/(n+0.5))
+
+		for _, posting := range postings[i] {
+			scores[posting.docID] += idf * bm25TermFrequency(posting, avgLength)
+		}
+	}
+
+	return nil
+}
+
+func bm25TermFrequency(posting bm25Posting, avgLength float64) float64 {
+	norm := 1.0
+	if posting.length > 0 && avgLength > 0 {
+		norm = 1 - bm25B + bm25B*posting.length/avgLength
+	}
+
+	return posting.count * (bm25K1 + 1) / (posting.count + bm25K1*norm)
+}
+
+func uniqueTerms(in []string) []string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(in))
+	for _, term := range in {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		out = append(out, term)
+	}
+
+	return out
+}
+
+// topScores sorts by score descending, ties are broken by doc id so that the
+// order is stable
+func topScores(scores map[uint64]float64, limit int) ([]uint64, []float32) {
+	ids := make([]uint64, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(a, b int) bool {
+		if scores[ids[a]] != scores[ids[b]] {
+			return scores[ids[a]] > scores[ids[b]]
+		}
+		return ids[a] < ids[b]
+	})
+
+	if limit >= 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	out := make([]float32, len(ids))
+	for i, id := range ids {
+		out[i] = float32(scores[id])
+	}
+
+	return ids, out
+}
+
+// KeywordProperties returns the props of the class a keyword search ranks.
+// Named props must be indexed text or string props which are not stored in
+// the shared buckets, without names all such props of the class are used.
+func KeywordProperties(class *models.Class, names []string) ([]*models.Property, error) {
+	if len(names) == 0 {
+		var out []*models.Property
+		for _, prop := range class.Properties {
+			if keywordSearchable(prop) {
+				out = append(out, prop)
+			}
+		}
+		return out, nil
+	}
+
+	out := make([]*models.Property, len(names))
+	for i, name := range names {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !keywordSearchable(prop) {
+			return nil, errors.Errorf("property %q can not be searched by keywords, "+
+				"only indexed text and string properties can", name)
+		}
+
+		out[i] = prop
+	}
+
+	return out, nil
+}
+
+func keywordSearchable(prop *models.Property) bool {
+	if len(prop.DataType) != 1 || !HasFrequency(schema.DataType(prop.DataType[0])) {
+		return false
+	}
+
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return false
+	}
+
+	return !SharedStorage(prop)
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrequencyValue(t *testing.T) {
+	docID := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docID, 7)
+
+	// "fox" occurs 2 times in a prop of 6 tokens
+	value := FrequencyValue(Countable{Data: []byte("fox"), TermFrequency: 2.0 / 6.0}, 6)
+	require.Len(t, value, 8)
+
+	posting, ok := parseBM25Posting(lsmkv.MapPair{Key: docID, Value: value})
+	require.True(t, ok)
+	assert.Equal(t, bm25Posting{docID: 7, count: 2, length: 6}, posting)
+
+	_, ok = parseBM25Posting(lsmkv.MapPair{Key: docID, Tombstone: true})
+	assert.False(t, ok)
+}
+
+func TestBM25TermFrequency(t *testing.T) {
+	t.Run("more occurrences score higher", func(t *testing.T) {
+		once := bm25TermFrequency(bm25Posting{count: 1, length: 10}, 10)
+		twice := bm25TermFrequency(bm25Posting{count: 2, length: 10}, 10)
+		assert.Greater(t, twice, once)
+		assert.Less(t, twice, 2*once, "term frequency saturates")
+	})
+
+	t.Run("shorter props score higher", func(t *testing.T) {
+		short := bm25TermFrequency(bm25Posting{count: 1, length: 5}, 10)
+		long := bm25TermFrequency(bm25Posting{count: 1, length: 20}, 10)
+		assert.Greater(t, short, long)
+	})
+
+	t.Run("without a length there is no normalization", func(t *testing.T) {
+		assert.InDelta(t, 1.0, bm25TermFrequency(bm25Posting{count: 1}, 0), 1e-9)
+	})
+}
+
+func TestTopScores(t *testing.T) {
+	scores := map[uint64]float64{1: 0.5, 2: 2.5, 3: 0.5, 4: 1.5}
+
+	ids, res := topScores(scores, 3)
+	assert.Equal(t, []uint64{2, 4, 1}, ids)
+	assert.Equal(t, []float32{2.5, 1.5, 0.5}, res)
+}
+
+func TestKeywordProperties(t *testing.T) {
+	notIndexed := false
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"string"}},
+			{Name: "body", DataType: []string{"text"}},
+			{Name: "tags", DataType: []string{"text[]"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "internal", DataType: []string{"text"}, IndexInverted: &notIndexed},
+			{
+				Name: "tenant", DataType: []string{"string"},
+				InvertedIndexStorage: models.PropertyInvertedIndexStorageShared,
+			},
+		},
+	}
+
+	names := func(props []*models.Property) []string {
+		out := make([]string, len(props))
+		for i, prop := range props {
+			out[i] = prop.Name
+		}
+		return out
+	}
+
+	t.Run("all searchable props by default", func(t *testing.T) {
+		props, err := KeywordProperties(class, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"title", "body", "tags"}, names(props))
+	})
+
+	t.Run("named props", func(t *testing.T) {
+		props, err := KeywordProperties(class, []string{"body"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"body"}, names(props))
+	})
+
+	for _, name := range []string{"wordCount", "internal", "tenant"} {
+		t.Run("unsearchable prop "+name, func(t *testing.T) {
+			_, err := KeywordProperties(class, []string{name})
+			assert.NotNil(t, err)
+		})
+	}
+
+	t.Run("unknown prop", func(t *testing.T) {
+		_, err := KeywordProperties(class, []string{"author"})
+		assert.NotNil(t, err)
+	})
+}
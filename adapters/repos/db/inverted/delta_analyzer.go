@@ -52,6 +52,8 @@ func Delta(previous, next []Property) DeltaResults {
 				Name:         nextProp.Name,
 				Items:        toAdd,
 				HasFrequency: nextProp.HasFrequency,
+				Shared:       nextProp.Shared,
+				Length:       nextProp.Length,
 			})
 		}
 		if len(toDelete) > 0 {
@@ -59,6 +61,8 @@ func Delta(previous, next []Property) DeltaResults {
 				Name:         nextProp.Name,
 				Items:        toDelete,
 				HasFrequency: nextProp.HasFrequency,
+				Shared:       nextProp.Shared,
+				Length:       prev.Length,
 			})
 		}
 	}
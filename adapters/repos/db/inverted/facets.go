@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// FacetIndexed returns whether the values of the prop can be counted from its
+// rows in the inverted index. This requires every row key to be an entire
+// value, which is the case for numbers and booleans and for strings with the
+// field tokenization. Boolean arrays are not, as every row of a boolean array
+// holds the encoding of the entire array.
+func FacetIndexed(prop *models.Property) bool {
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return false
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean,
+		schema.DataTypeIntArray, schema.DataTypeNumberArray:
+		return true
+	case schema.DataTypeText, schema.DataTypeString,
+		schema.DataTypeTextArray, schema.DataTypeStringArray:
+		return PropertyTokenization(prop) == models.PropertyTokenizationField
+	default:
+		return false
+	}
+}
+
+// FacetCounts counts how many of the allowed docs hold each value of the
+// prop, see FacetIndexed for the props this is possible for. Every row of the
+// prop is read once and only the doc ids of its posting list are checked
+// against the allow list, no object is ever read. A nil allow list allows
+// every doc. The values have the same types as the group values of an
+// aggregation, i.e. float64 for all numbers.
+func (f *Searcher) FacetCounts(ctx context.Context, prop *models.Property,
+	allow helpers.AllowList) (map[interface{}]int, error) {
+	dt := schema.DataType(prop.DataType[0])
+	counts := map[interface{}]int{}
+
+	add := func(k []byte, count int) error {
+		if count == 0 {
+			return nil
+		}
+
+		value, err := facetValue(dt, k)
+		if err != nil {
+			return errors.Wrapf(err, "prop %s", prop.Name)
+		}
+
+		counts[value] += count
+		return nil
+	}
+
+	if SharedStorage(prop) || !HasFrequency(dt) {
+		propName := prop.Name
+		if SharedStorage(prop) {
+			propName = helpers.PropertyNameShared
+		}
+
+		b := f.store.Bucket(helpers.BucketFromPropNameLSM(propName))
+		if b == nil {
+			return nil, errors.Errorf("bucket for prop %s not found - is it indexed?",
+				prop.Name)
+		}
+
+		var c setCursor
+		if SharedStorage(prop) {
+			c = &prefixedSetCursor{cursor: b.SetCursor(),
+				prefix: helpers.SharedPropPrefix(prop.Name)}
+		} else {
+			c = b.SetCursor()
+		}
+		defer c.Close()
+
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
+
+		for k, ids := c.First(); k != nil; k, ids = c.Next() {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			count := 0
+			for _, id := range ids {
+				if f.allowed(binary.LittleEndian.Uint64(id), allow) {
+					count++
+				}
+			}
+
+			if err := add(k, count); err != nil {
+				return nil, err
+			}
+		}
+
+		return counts, nil
+	}
+
+	b := f.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
+	if b == nil {
+		return nil, errors.Errorf("bucket for prop %s not found - is it indexed?",
+			prop.Name)
+	}
+
+	c := b.MapCursor()
+	defer c.Close()
+
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
+	for k, pairs := c.First(); k != nil; k, pairs = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// the value of each pair is the frequency, the doc is counted once
+		// no matter how often it contains the value
+		count := 0
+		for _, pair := range pairs {
+			if f.allowed(binary.LittleEndian.Uint64(pair.Key), allow) {
+				count++
+			}
+		}
+
+		if err := add(k, count); err != nil {
+			return nil, err
+		}
+	}
+
+	return counts, nil
+}
+
+// CountAll counts the docs of the class by reading the row of every id in
+// the inverted index of the id prop, which unlike the objects bucket only
+// holds the doc ids
+func (f *Searcher) CountAll(ctx context.Context) (int, error) {
+	b := f.store.Bucket(helpers.BucketFromPropNameLSM(helpers.PropertyNameID))
+	if b == nil {
+		return 0, errors.Errorf("bucket for prop %s not found", helpers.PropertyNameID)
+	}
+
+	c := b.SetCursor()
+	defer c.Close()
+
+	if err := c.Err(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for k, ids := c.First(); k != nil; k, ids = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		for _, id := range ids {
+			if f.allowed(binary.LittleEndian.Uint64(id), nil) {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func (f *Searcher) allowed(docID uint64, allow helpers.AllowList) bool {
+	if f.deletedDocIDs != nil && f.deletedDocIDs.Contains(docID) {
+		return false
+	}
+
+	return allow == nil || allow.Contains(docID)
+}
+
+// facetValue decodes a row key of the inverted index back into the value it
+// was created from by the Analyzer
+func facetValue(dt schema.DataType, k []byte) (interface{}, error) {
+	switch dt {
+	case schema.DataTypeInt, schema.DataTypeIntArray:
+		asInt, err := ParseLexicographicallySortableInt64(k)
+		if err != nil {
+			return nil, err
+		}
+		return float64(asInt), nil
+	case schema.DataTypeNumber, schema.DataTypeNumberArray:
+		return ParseLexicographicallySortableFloat64(k)
+	case schema.DataTypeBoolean:
+		if len(k) != 1 {
+			return nil, errors.Errorf("invalid boolean row key of length %d", len(k))
+		}
+		return k[0] != 0, nil
+	default:
+		return string(k), nil
+	}
+}
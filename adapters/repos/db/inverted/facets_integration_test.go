@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FacetCounts(t *testing.T) {
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, logger)
+	require.Nil(t, err)
+	defer store.Shutdown(context.Background())
+
+	ctx := context.Background()
+	category := &models.Property{
+		Name:         "category",
+		DataType:     []string{string(schema.DataTypeString)},
+		Tokenization: models.PropertyTokenizationField,
+	}
+	wordCount := &models.Property{
+		Name:     "wordCount",
+		DataType: []string{string(schema.DataTypeInt)},
+	}
+	label := &models.Property{
+		Name:                 "label",
+		DataType:             []string{string(schema.DataTypeString)},
+		Tokenization:         models.PropertyTokenizationField,
+		InvertedIndexStorage: models.PropertyInvertedIndexStorageShared,
+	}
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(category.Name),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(wordCount.Name),
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(helpers.PropertyNameShared),
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(helpers.PropertyNameID),
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+
+	t.Run("import data", func(t *testing.T) {
+		categories := store.Bucket(helpers.BucketFromPropNameLSM(category.Name))
+		for value, ids := range map[string][]uint64{
+			"news":   {1, 2, 3, 4},
+			"sports": {5, 6},
+			"tech":   {7},
+		} {
+			require.Nil(t, categories.MapSetMulti([]byte(value), idsToBinaryMapValues(ids)))
+		}
+
+		wordCounts := store.Bucket(helpers.BucketFromPropNameLSM(wordCount.Name))
+		for value, ids := range map[int64][]uint64{
+			300: {1, 5, 7},
+			500: {2, 3, 4, 6},
+		} {
+			key, err := LexicographicallySortableInt64(value)
+			require.Nil(t, err)
+			require.Nil(t, wordCounts.SetAdd(key, idsToBinaryList(ids)))
+		}
+
+		shared := store.Bucket(helpers.BucketFromPropNameLSM(helpers.PropertyNameShared))
+		require.Nil(t, shared.SetAdd(helpers.SharedPropKey(label.Name, []byte("a")),
+			idsToBinaryList([]uint64{1, 2})))
+		require.Nil(t, shared.SetAdd(helpers.SharedPropKey(label.Name, []byte("b")),
+			idsToBinaryList([]uint64{3})))
+		// a prop sorted after the label must not be counted for it
+		require.Nil(t, shared.SetAdd(helpers.SharedPropKey("other", []byte("a")),
+			idsToBinaryList([]uint64{4, 5, 6})))
+
+		ids := store.Bucket(helpers.BucketFromPropNameLSM(helpers.PropertyNameID))
+		for id := uint64(1); id <= 7; id++ {
+			require.Nil(t, ids.SetAdd([]byte(fmt.Sprintf("id-%d", id)),
+				idsToBinaryList([]uint64{id})))
+		}
+	})
+
+	deleted := fakeDeletedDocIDs{6: struct{}{}}
+	searcher := NewSearcher(store, schema.Schema{}, nil, nil, nil, deleted)
+
+	t.Run("without an allow list", func(t *testing.T) {
+		counts, err := searcher.FacetCounts(ctx, category, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[interface{}]int{"news": 4, "sports": 1, "tech": 1}, counts)
+
+		counts, err = searcher.FacetCounts(ctx, wordCount, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[interface{}]int{300.0: 3, 500.0: 3}, counts)
+
+		counts, err = searcher.FacetCounts(ctx, label, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[interface{}]int{"a": 2, "b": 1}, counts)
+	})
+
+	t.Run("with an allow list", func(t *testing.T) {
+		allow := allowList(1, 2, 5, 6)
+
+		counts, err := searcher.FacetCounts(ctx, category, allow)
+		require.Nil(t, err)
+		assert.Equal(t, map[interface{}]int{"news": 2, "sports": 1}, counts)
+
+		counts, err = searcher.FacetCounts(ctx, wordCount, allow)
+		require.Nil(t, err)
+		assert.Equal(t, map[interface{}]int{300.0: 2, 500.0: 1}, counts)
+	})
+
+	t.Run("counting all docs", func(t *testing.T) {
+		count, err := searcher.CountAll(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 6, count)
+	})
+}
+
+type fakeDeletedDocIDs map[uint64]struct{}
+
+func (f fakeDeletedDocIDs) Contains(id uint64) bool {
+	_, ok := f[id]
+	return ok
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFacetIndexed(t *testing.T) {
+	notIndexed := false
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		expected bool
+	}{
+		{
+			name:     "int",
+			prop:     &models.Property{DataType: []string{"int"}},
+			expected: true,
+		},
+		{
+			name:     "number array",
+			prop:     &models.Property{DataType: []string{"number[]"}},
+			expected: true,
+		},
+		{
+			name:     "boolean",
+			prop:     &models.Property{DataType: []string{"boolean"}},
+			expected: true,
+		},
+		{
+			name:     "boolean array",
+			prop:     &models.Property{DataType: []string{"boolean[]"}},
+			expected: false,
+		},
+		{
+			name:     "string with the default tokenization",
+			prop:     &models.Property{DataType: []string{"string"}},
+			expected: false,
+		},
+		{
+			name:     "text with the word tokenization",
+			prop:     &models.Property{DataType: []string{"text"}, Tokenization: "word"},
+			expected: false,
+		},
+		{
+			name:     "text with the field tokenization",
+			prop:     &models.Property{DataType: []string{"text"}, Tokenization: "field"},
+			expected: true,
+		},
+		{
+			name: "int without an inverted index",
+			prop: &models.Property{
+				DataType: []string{"int"}, IndexInverted: &notIndexed,
+			},
+			expected: false,
+		},
+		{
+			name:     "date",
+			prop:     &models.Property{DataType: []string{"date"}},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, FacetIndexed(test.prop))
+		})
+	}
+}
+
+func TestFacetValue(t *testing.T) {
+	a := NewAnalyzer()
+
+	t.Run("int", func(t *testing.T) {
+		items, err := a.Int(-17)
+		require.Nil(t, err)
+
+		value, err := facetValue(schema.DataTypeInt, items[0].Data)
+		require.Nil(t, err)
+		assert.Equal(t, -17.0, value)
+	})
+
+	t.Run("number", func(t *testing.T) {
+		items, err := a.Float(2.5)
+		require.Nil(t, err)
+
+		value, err := facetValue(schema.DataTypeNumberArray, items[0].Data)
+		require.Nil(t, err)
+		assert.Equal(t, 2.5, value)
+	})
+
+	t.Run("boolean", func(t *testing.T) {
+		items, err := a.Bool(true)
+		require.Nil(t, err)
+
+		value, err := facetValue(schema.DataTypeBoolean, items[0].Data)
+		require.Nil(t, err)
+		assert.Equal(t, true, value)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		value, err := facetValue(schema.DataTypeString, []byte("New York"))
+		require.Nil(t, err)
+		assert.Equal(t, "New York", value)
+	})
+}
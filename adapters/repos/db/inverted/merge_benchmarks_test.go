@@ -17,96 +17,45 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/semi-technologies/weaviate/adapters/repos/db/roaring"
 	"github.com/semi-technologies/weaviate/entities/filters"
 )
 
-func BenchmarkAnd10k1m_Old(b *testing.B) {
+func BenchmarkAnd10k1m(b *testing.B) {
 	b.StopTimer()
 
-	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e4),
-			checksum: []byte{0x01},
-		},
-		operator: filters.OperatorEqual,
-	}
-
-	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e6),
-			checksum: []byte{0x02},
-		},
-		operator: filters.OperatorEqual,
-	}
+	list1 := randomValuePair(1e4, 1e7, 0x01)
+	list2 := randomValuePair(1e6, 1e7, 0x02)
 
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		mergeAnd([]*propValuePair{&list1, &list2}, false)
+		mergeAnd([]*propValuePair{list1, list2})
 	}
 }
 
-func BenchmarkAnd10k1m_Optimized(b *testing.B) {
+func BenchmarkOr10k1m(b *testing.B) {
 	b.StopTimer()
 
-	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e4),
-			checksum: []byte{0x01},
-		},
-		operator: filters.OperatorEqual,
-	}
-
-	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e6),
-			checksum: []byte{0x02},
-		},
-		operator: filters.OperatorEqual,
-	}
+	list1 := randomValuePair(1e4, 1e7, 0x01)
+	list2 := randomValuePair(1e6, 1e7, 0x02)
 
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		mergeAndOptimized([]*propValuePair{&list1, &list2}, false)
+		mergeOr([]*propValuePair{list1, list2})
 	}
 }
 
-func BenchmarkMultipleListsOf20k_Old(b *testing.B) {
+func BenchmarkMultipleListsOf20k(b *testing.B) {
 	b.StopTimer()
 
 	lists := make([]*propValuePair, 10)
 	for i := range lists {
-		lists[i] = &propValuePair{
-			docIDs: docPointers{
-				docIDs:   randomIDs(2e4),
-				checksum: []byte{uint8(i)},
-			},
-			operator: filters.OperatorEqual,
-		}
+		lists[i] = randomValuePair(2e4, 1e6, uint8(i))
 	}
 
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		mergeAnd(lists, false)
-	}
-}
-
-func BenchmarkMultipleListsOf20k_Optimized(b *testing.B) {
-	b.StopTimer()
-
-	lists := make([]*propValuePair, 10)
-	for i := range lists {
-		lists[i] = &propValuePair{
-			docIDs: docPointers{
-				docIDs:   randomIDs(2e4),
-				checksum: []byte{uint8(i)},
-			},
-			operator: filters.OperatorEqual,
-		}
-	}
-
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		mergeAndOptimized(lists, false)
+		mergeAnd(lists)
 	}
 }
 
@@ -117,7 +66,7 @@ func BenchmarkSort10k(b *testing.B) {
 		b.StartTimer()
 
 		sort.Slice(list, func(a, b int) bool {
-			return list[a].id < list[b].id
+			return list[a] < list[b]
 		})
 	}
 }
@@ -131,7 +80,7 @@ func BenchmarkUnsortedLinearSearch(b *testing.B) {
 		b.StartTimer()
 
 		for i := range searchTargets {
-			linearSearchUnsorted(list, searchTargets[i].id)
+			linearSearchUnsorted(list, searchTargets[i])
 		}
 	}
 }
@@ -145,11 +94,11 @@ func BenchmarkSortedBinarySearch(b *testing.B) {
 		b.StartTimer()
 
 		sort.Slice(list, func(a, b int) bool {
-			return list[a].id < list[b].id
+			return list[a] < list[b]
 		})
 
 		for i := range searchTargets {
-			binarySearch(list, searchTargets[i].id)
+			binarySearch(list, searchTargets[i])
 		}
 	}
 }
@@ -164,28 +113,59 @@ func BenchmarkHashmap(b *testing.B) {
 
 		lookup := make(map[uint64]struct{}, len(list))
 		for i := range list {
-			lookup[list[i].id] = struct{}{}
+			lookup[list[i]] = struct{}{}
 		}
 
 		for i := range searchTargets {
-			_, ok := lookup[searchTargets[i].id]
+			_, ok := lookup[searchTargets[i]]
 			_ = ok
 		}
 	}
 }
 
-func randomIDs(count int) []docPointer {
-	out := make([]docPointer, count)
+func BenchmarkRoaringBitmap(b *testing.B) {
+	searchTargets := randomIDs(1e6)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := randomIDs(1e4)
+		b.StartTimer()
+
+		lookup := roaring.FromSlice(list)
+
+		for i := range searchTargets {
+			lookup.Contains(searchTargets[i])
+		}
+	}
+}
+
+func randomValuePair(count int, maxID int64, checksum uint8) *propValuePair {
+	ids := make([]uint64, count)
+	for i := range ids {
+		ids[i] = uint64(rand.Int63n(maxID))
+	}
+
+	return &propValuePair{
+		docIDs: docBitmap{
+			docIDs:   roaring.FromSlice(ids),
+			checksum: []byte{checksum},
+		},
+		operator: filters.OperatorEqual,
+	}
+}
+
+func randomIDs(count int) []uint64 {
+	out := make([]uint64, count)
 	for i := range out {
-		out[i] = docPointer{id: rand.Uint64()}
+		out[i] = rand.Uint64()
 	}
 
 	return out
 }
 
-func linearSearchUnsorted(in []docPointer, needle uint64) bool {
+func linearSearchUnsorted(in []uint64, needle uint64) bool {
 	for i := range in {
-		if in[i].id == needle {
+		if in[i] == needle {
 			return true
 		}
 	}
@@ -206,15 +186,15 @@ func linearSearchUnsorted(in []docPointer, needle uint64) bool {
 //             return m
 //     return unsuccessful
 
-func binarySearch(in []docPointer, needle uint64) bool {
+func binarySearch(in []uint64, needle uint64) bool {
 	left := 0
 	right := len(in) - 1
 
 	for left <= right {
 		m := int(math.Floor(float64((left + right)) / float64(2)))
-		if in[m].id < needle {
+		if in[m] < needle {
 			left = m + 1
-		} else if in[m].id > needle {
+		} else if in[m] > needle {
 			right = m - 1
 		} else {
 			return true
@@ -14,141 +14,98 @@ package inverted
 import (
 	"testing"
 
+	"github.com/semi-technologies/weaviate/adapters/repos/db/roaring"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestMergeAnd_Old(t *testing.T) {
-	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 7},
-				{id: 8},
-				{id: 9},
-				{id: 10},
-				{id: 11},
-			},
-			checksum: []byte{0x01},
+func valuePair(checksum byte, ids ...uint64) *propValuePair {
+	return &propValuePair{
+		docIDs: docBitmap{
+			docIDs:   roaring.FromSlice(ids),
+			checksum: []byte{checksum},
 		},
 		operator: filters.OperatorEqual,
 	}
+}
 
-	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 1},
-				{id: 3},
-				{id: 5},
-				{id: 7},
-				{id: 9},
-				{id: 11},
-			},
-			checksum: []byte{0x02},
-		},
-		operator: filters.OperatorEqual,
-	}
+func TestMergeAnd(t *testing.T) {
+	list1 := valuePair(0x01, 7, 8, 9, 10, 11)
+	list2 := valuePair(0x02, 1, 3, 5, 7, 9, 11)
+	list3 := valuePair(0x03, 1, 3, 5, 7, 9)
+	list4 := valuePair(0x04, 1, 3, 5, 7)
 
-	list3 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 1},
-				{id: 3},
-				{id: 5},
-				{id: 7},
-				{id: 9},
-			},
-			checksum: []byte{0x03},
-		},
-		operator: filters.OperatorEqual,
-	}
+	res, err := mergeAnd([]*propValuePair{list1, list2, list3, list4})
+	require.Nil(t, err)
 
-	list4 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 1},
-				{id: 3},
-				{id: 5},
-				{id: 7},
-			},
-			checksum: []byte{0x04},
-		},
-		operator: filters.OperatorEqual,
-	}
+	assert.Equal(t, []uint64{7}, res.IDs(0))
 
-	res, err := mergeAnd([]*propValuePair{&list1, &list2, &list3, &list4}, false)
-	require.Nil(t, err)
+	t.Run("inputs are not modified", func(t *testing.T) {
+		assert.Equal(t, []uint64{7, 8, 9, 10, 11}, list1.docIDs.IDs(0))
+	})
+}
 
-	expectedPointers := []docPointer{
-		{id: 7},
-	}
+func TestMergeOr(t *testing.T) {
+	list1 := valuePair(0x01, 7, 8, 9)
+	list2 := valuePair(0x02, 1, 3, 7, 9)
+
+	res, err := mergeOr([]*propValuePair{list1, list2})
+	require.Nil(t, err)
 
-	assert.ElementsMatch(t, expectedPointers, res.docIDs)
+	assert.Equal(t, []uint64{1, 3, 7, 8, 9}, res.IDs(0))
+	assert.Equal(t, []uint64{1, 3, 7}, res.IDs(3))
 }
 
-func TestMergeAnd_Optimized(t *testing.T) {
-	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 7},
-				{id: 8},
-				{id: 9},
-				{id: 10},
-				{id: 11},
+func TestMergeNested(t *testing.T) {
+	// (a OR b) AND c
+	pv := &propValuePair{
+		operator: filters.OperatorAnd,
+		children: []*propValuePair{
+			{
+				operator: filters.OperatorOr,
+				children: []*propValuePair{
+					valuePair(0x01, 1, 2, 3),
+					valuePair(0x02, 1<<40, 4),
+				},
 			},
-			checksum: []byte{0x01},
+			valuePair(0x03, 2, 4, 5, 1<<40),
 		},
-		operator: filters.OperatorEqual,
 	}
 
-	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 1},
-				{id: 3},
-				{id: 5},
-				{id: 7},
-				{id: 9},
-				{id: 11},
-			},
-			checksum: []byte{0x02},
-		},
-		operator: filters.OperatorEqual,
-	}
+	res, err := pv.mergeDocIDs()
+	require.Nil(t, err)
 
-	list3 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 1},
-				{id: 3},
-				{id: 5},
-				{id: 7},
-				{id: 9},
-			},
-			checksum: []byte{0x03},
-		},
-		operator: filters.OperatorEqual,
-	}
+	assert.Equal(t, []uint64{2, 4, 1 << 40}, res.IDs(0))
+}
 
-	list4 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []docPointer{
-				{id: 1},
-				{id: 3},
-				{id: 5},
-				{id: 7},
-			},
-			checksum: []byte{0x04},
-		},
-		operator: filters.OperatorEqual,
-	}
+func TestMergeIdenticalChecksums(t *testing.T) {
+	list1 := valuePair(0x01, 1, 2, 3)
+	list2 := valuePair(0x01, 1, 2, 3)
 
-	res, err := mergeAndOptimized([]*propValuePair{&list1, &list2, &list3, &list4}, false)
+	res, err := mergeAnd([]*propValuePair{list1, list2})
 	require.Nil(t, err)
 
-	expectedPointers := []docPointer{
-		{id: 7},
+	assert.Equal(t, &list1.docIDs, res, "identical sets are not merged")
+}
+
+func TestDocBitmapReadOrder(t *testing.T) {
+	d := newDocBitmap()
+	for _, id := range []uint64{9, 2, 7, 2, 5} {
+		d.add(id)
 	}
 
-	assert.ElementsMatch(t, expectedPointers, res.docIDs)
+	assert.Equal(t, 4, d.count())
+	assert.Equal(t, []uint64{9, 2, 7, 5}, d.IDs(0))
+	assert.Equal(t, []uint64{9, 2}, d.IDs(2))
+
+	t.Run("a merged bitmap is in ascending order", func(t *testing.T) {
+		res, err := mergeOr([]*propValuePair{
+			{docIDs: d, operator: filters.OperatorEqual},
+			valuePair(0x02, 3),
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []uint64{2, 3, 5, 7, 9}, res.IDs(0))
+	})
 }
@@ -12,9 +12,8 @@
 package inverted
 
 import (
+	"encoding/binary"
 	"fmt"
-	"strings"
-	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -35,6 +34,15 @@ func (a *Analyzer) Object(input map[string]interface{}, props []*models.Property
 		return nil, errors.Wrap(err, "analyze props")
 	}
 
+	for i := range properties {
+		if prop, ok := propsMap[properties[i].Name]; ok && SharedStorage(prop) {
+			// the shared buckets are sets, the frequency is not needed as the
+			// value of a shared prop is never tokenized
+			properties[i].Shared = true
+			properties[i].HasFrequency = false
+		}
+	}
+
 	property, err := a.analyzeIDProp(uuid)
 	if err != nil {
 		return nil, errors.Wrap(err, "analyze uuid prop")
@@ -45,8 +53,158 @@ func (a *Analyzer) Object(input map[string]interface{}, props []*models.Property
 	return properties, nil
 }
 
+// NullState analyzes whether each of the props is null, so objects can be
+// filtered with the IsNull operator. A prop counts as null if it is not set
+// at all or set to an empty array or an empty list of references.
+func (a *Analyzer) NullState(input map[string]interface{},
+	props []*models.Property) ([]Property, error) {
+	var out []Property
+	for _, prop := range props {
+		if prop.IndexInverted != nil && !*prop.IndexInverted {
+			continue
+		}
+
+		if schema.IsBlobDataType(prop.DataType) {
+			continue
+		}
+
+		items, err := a.Bool(isNullValue(input[prop.Name]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyze null state of property %s", prop.Name)
+		}
+
+		out = append(out, Property{
+			Name:         helpers.MetaNullStateProp(prop.Name),
+			Items:        items,
+			HasFrequency: false,
+		})
+	}
+
+	return out, nil
+}
+
+// Timestamps analyzes the creation and last update time of an object, so
+// objects can be filtered and sorted by them. Both are stored as
+// lexicographically sortable ints of the milliseconds since the epoch.
+func (a *Analyzer) Timestamps(creationTimeUnix,
+	lastUpdateTimeUnix int64) ([]Property, error) {
+	creationItems, err := a.Int(creationTimeUnix)
+	if err != nil {
+		return nil, errors.Wrap(err, "analyze creation time")
+	}
+
+	lastUpdateItems, err := a.Int(lastUpdateTimeUnix)
+	if err != nil {
+		return nil, errors.Wrap(err, "analyze last update time")
+	}
+
+	return []Property{
+		{
+			Name:         helpers.PropertyNameCreationTimeUnix,
+			Items:        creationItems,
+			HasFrequency: false,
+		},
+		{
+			Name:         helpers.PropertyNameLastUpdateTimeUnix,
+			Items:        lastUpdateItems,
+			HasFrequency: false,
+		},
+	}, nil
+}
+
+// Composite combines the already analyzed props into the composite indexes
+// of the class. An object is indexed with one key per combination of the
+// values of the props, so that an Equal filter on each of them can be served
+// by a single lookup. Composites with a prop that has no value are skipped.
+func (a *Analyzer) Composite(props []Property, composites [][]string) []Property {
+	propsByName := map[string]Property{}
+	for _, prop := range props {
+		propsByName[prop.Name] = prop
+	}
+
+	var out []Property
+	for _, composite := range composites {
+		combinations := [][][]byte{{}}
+		for _, propName := range composite {
+			prop, ok := propsByName[propName]
+			if !ok || len(prop.Items) == 0 {
+				combinations = nil
+				break
+			}
+
+			next := make([][][]byte, 0, len(combinations)*len(prop.Items))
+			for _, combination := range combinations {
+				for _, item := range prop.Items {
+					extended := make([][]byte, len(combination), len(combination)+1)
+					copy(extended, combination)
+					next = append(next, append(extended, item.Data))
+				}
+			}
+			combinations = next
+		}
+
+		if len(combinations) == 0 {
+			continue
+		}
+
+		items := make([]Countable, len(combinations))
+		for i, combination := range combinations {
+			items[i] = Countable{Data: CompositeKey(combination)}
+		}
+
+		out = append(out, Property{
+			Name:         helpers.MetaCompositeProp(composite),
+			Items:        items,
+			HasFrequency: false,
+		})
+	}
+
+	return out
+}
+
+// CompositeKey concatenates the analyzed values of the props of a composite
+// index. Each value is prefixed with its length, so that different
+// combinations can never result in the same key.
+func CompositeKey(values [][]byte) []byte {
+	size := 0
+	for _, value := range values {
+		size += 4 + len(value)
+	}
+
+	out := make([]byte, 0, size)
+	for _, value := range values {
+		length := make([]byte, 4)
+		binary.BigEndian.PutUint32(length, uint32(len(value)))
+		out = append(out, length...)
+		out = append(out, value...)
+	}
+
+	return out
+}
+
+func isNullValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case models.MultipleRef:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
 func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 	input map[string]interface{}) ([]Property, error) {
+	if a.strictTypes {
+		for key := range input {
+			if _, ok := propsMap[key]; !ok {
+				return nil, fmt.Errorf("property %q is not part of the schema", key)
+			}
+		}
+	}
+
 	var out []Property
 	for key, prop := range propsMap {
 		if len(prop.DataType) < 1 {
@@ -103,15 +261,14 @@ func (a *Analyzer) extendPropertiesWithArrayType(properties *[]Property,
 		return nil
 	}
 
-	values, ok := value.([]interface{})
-	if !ok {
-		// skip any primitive prop that's not set
-		errors.New("analyze array prop: expected array prop")
+	values, err := a.arrayValues(prop, value)
+	if err != nil {
+		return errors.Wrap(err, "analyze array prop")
 	}
 
 	property, err := a.analyzeArrayProp(prop, values)
 	if err != nil {
-		errors.Wrap(err, "analyze primitive prop")
+		return errors.Wrap(err, "analyze array prop")
 	}
 	if property == nil {
 		return nil
@@ -135,7 +292,7 @@ func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
 	}
 	property, err = a.analyzePrimitiveProp(prop, value)
 	if err != nil {
-		errors.Wrap(err, "analyze primitive prop")
+		return errors.Wrap(err, "analyze primitive prop")
 	}
 	if property == nil {
 		return nil
@@ -157,35 +314,25 @@ func HasFrequency(dt schema.DataType) bool {
 func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{}) (*Property, error) {
 	var hasFrequency bool
 	var items []Countable
+	var length int
 	dt := schema.DataType(prop.DataType[0])
 	switch dt {
-	case schema.DataTypeTextArray:
+	case schema.DataTypeTextArray, schema.DataTypeStringArray:
 		hasFrequency = HasFrequency(dt)
-		value, err := a.stringValFromArray(prop, values)
+		in, err := a.stringsFromArray(prop, values)
 		if err != nil {
 			return nil, err
 		}
-		items = a.Text(value)
-	case schema.DataTypeStringArray:
-		hasFrequency = HasFrequency(dt)
-		value, err := a.stringValFromArray(prop, values)
-		if err != nil {
-			return nil, err
-		}
-		items = a.String(value)
+		tokens := tokenize(PropertyTokenization(prop), in)
+		items = countTerms(tokens)
+		length = len(tokens)
 	case schema.DataTypeIntArray:
 		hasFrequency = HasFrequency(dt)
 		in := make([]int64, len(values))
 		for i, value := range values {
-			if asFloat, ok := value.(float64); ok {
-				// unmarshaling from json into a dynamic schema will assume every number
-				// is a float64
-				value = int64(asFloat)
-			}
-
-			asInt, ok := value.(int64)
-			if !ok {
-				return nil, fmt.Errorf("expected property %s to be of type int64, but got %T", prop.Name, value)
+			asInt, err := a.intValue(prop, value)
+			if err != nil {
+				return nil, err
 			}
 			in[i] = asInt
 		}
@@ -199,9 +346,9 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{})
 		hasFrequency = HasFrequency(dt)
 		in := make([]float64, len(values))
 		for i, value := range values {
-			asFloat, ok := value.(float64)
-			if !ok {
-				return nil, fmt.Errorf("expected property %s to be of type float64, but got %T", prop.Name, value)
+			asFloat, err := a.numberValue(prop, value)
+			if err != nil {
+				return nil, err
 			}
 			in[i] = asFloat
 		}
@@ -215,9 +362,9 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{})
 		hasFrequency = HasFrequency(dt)
 		in := make([]bool, len(values))
 		for i, value := range values {
-			asBool, ok := value.(bool)
-			if !ok {
-				return nil, fmt.Errorf("expected property %s to be of type bool, but got %T", prop.Name, value)
+			asBool, err := a.boolValue(prop, value)
+			if err != nil {
+				return nil, err
 			}
 			in[i] = asBool
 		}
@@ -231,11 +378,11 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{})
 		hasFrequency = HasFrequency(dt)
 		in := make([]int64, len(values))
 		for i, value := range values {
-			asTime, ok := value.(time.Time)
-			if !ok {
-				return nil, fmt.Errorf("expected property %s to be time.Time, but got %T", prop.Name, value)
+			asNanos, err := a.dateValue(prop, value)
+			if err != nil {
+				return nil, err
 			}
-			in[i] = asTime.UnixNano()
+			in[i] = asNanos
 		}
 
 		var err error
@@ -253,94 +400,101 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{})
 		Name:         prop.Name,
 		Items:        items,
 		HasFrequency: hasFrequency,
+		Length:       length,
 	}, nil
 }
 
-func (a *Analyzer) stringValFromArray(prop *models.Property, values []interface{}) (string, error) {
-	var value strings.Builder
+func (a *Analyzer) stringsFromArray(prop *models.Property, values []interface{}) ([]string, error) {
+	out := make([]string, len(values))
 	for i := range values {
-		asString, ok := values[i].(string)
-		if !ok {
-			return "", fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, values[i])
-		}
-		value.WriteString(asString)
-		if i != len(values)-1 {
-			value.WriteString(" ")
+		asString, err := a.stringValue(prop, values[i])
+		if err != nil {
+			return nil, err
 		}
+		out[i] = asString
+	}
+	return out, nil
+}
+
+// SharedStorage returns whether the inverted index of the prop is stored in
+// the buckets shared by all props of the class with the "shared"
+// invertedIndexStorage, see helpers.SharedPropKey
+func SharedStorage(prop *models.Property) bool {
+	return prop.InvertedIndexStorage == models.PropertyInvertedIndexStorageShared
+}
+
+// PropertyTokenization returns how the values of a string or text property are
+// tokenized. Properties without an explicit tokenization use the default of
+// their data type.
+func PropertyTokenization(prop *models.Property) string {
+	if prop.Tokenization != "" {
+		return prop.Tokenization
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		return models.PropertyTokenizationWord
+	default:
+		return models.PropertyTokenizationWhitespace
 	}
-	return value.String(), nil
 }
 
 func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}) (*Property, error) {
 	var hasFrequency bool
 	var items []Countable
+	var length int
 	dt := schema.DataType(prop.DataType[0])
 	switch dt {
-	case schema.DataTypeText:
-		hasFrequency = HasFrequency(dt)
-		asString, ok := value.(string)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
-		}
-		items = a.Text(asString)
-	case schema.DataTypeString:
+	case schema.DataTypeText, schema.DataTypeString:
 		hasFrequency = HasFrequency(dt)
-		asString, ok := value.(string)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
+		asString, err := a.stringValue(prop, value)
+		if err != nil {
+			return nil, err
 		}
-		items = a.String(asString)
+		tokens := tokenize(PropertyTokenization(prop), []string{asString})
+		items = countTerms(tokens)
+		length = len(tokens)
 	case schema.DataTypeInt:
 		hasFrequency = HasFrequency(dt)
-		if asFloat, ok := value.(float64); ok {
-			// unmarshaling from json into a dynamic schema will assume every number
-			// is a float64
-			value = int64(asFloat)
-		}
-
-		asInt, ok := value.(int64)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be of type int64, but got %T", prop.Name, value)
+		asInt, err := a.intValue(prop, value)
+		if err != nil {
+			return nil, err
 		}
 
-		var err error
 		items, err = a.Int(asInt)
 		if err != nil {
 			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
 		}
 	case schema.DataTypeNumber:
 		hasFrequency = HasFrequency(dt)
-		asFloat, ok := value.(float64)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be of type float64, but got %T", prop.Name, value)
+		asFloat, err := a.numberValue(prop, value)
+		if err != nil {
+			return nil, err
 		}
 
-		var err error
 		items, err = a.Float(asFloat) // convert to int before analyzing
 		if err != nil {
 			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
 		}
 	case schema.DataTypeBoolean:
 		hasFrequency = HasFrequency(dt)
-		asBool, ok := value.(bool)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be of type bool, but got %T", prop.Name, value)
+		asBool, err := a.boolValue(prop, value)
+		if err != nil {
+			return nil, err
 		}
 
-		var err error
 		items, err = a.Bool(asBool) // convert to int before analyzing
 		if err != nil {
 			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
 		}
 	case schema.DataTypeDate:
 		hasFrequency = HasFrequency(dt)
-		asTime, ok := value.(time.Time)
-		if !ok {
-			return nil, fmt.Errorf("expected property %s to be time.Time, but got %T", prop.Name, value)
+		asNanos, err := a.dateValue(prop, value)
+		if err != nil {
+			return nil, err
 		}
 
-		var err error
-		items, err = a.Int(asTime.UnixNano())
+		items, err = a.Int(asNanos)
 		if err != nil {
 			return nil, errors.Wrapf(err, "analyze property %s", prop.Name)
 		}
@@ -354,6 +508,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		Name:         prop.Name,
 		Items:        items,
 		HasFrequency: hasFrequency,
+		Length:       length,
 	}, nil
 }
 
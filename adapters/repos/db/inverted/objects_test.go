@@ -12,7 +12,9 @@
 package inverted
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
@@ -97,6 +99,51 @@ func TestAnalyzeObject(t *testing.T) {
 		assert.ElementsMatch(t, expectedUUID, actualUUID, res)
 	})
 
+	t.Run("with explicit tokenization", func(t *testing.T) {
+		schema := map[string]interface{}{
+			"description": "I am great!",
+			"email":       "John@Doe.com",
+			"tags":        []interface{}{"New York", "San Francisco"},
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{
+				Name:         "description",
+				DataType:     []string{"text"},
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:         "email",
+				DataType:     []string{"string"},
+				Tokenization: models.PropertyTokenizationLowercase,
+			},
+			{
+				Name:         "tags",
+				DataType:     []string{"string[]"},
+				Tokenization: models.PropertyTokenizationField,
+			},
+		}
+		res, err := a.Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		actual := map[string][]Countable{}
+		for _, elem := range res {
+			actual[elem.Name] = elem.Items
+		}
+
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("I am great!"), TermFrequency: 1},
+		}, actual["description"])
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("john@doe.com"), TermFrequency: 1},
+		}, actual["email"])
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("New York"), TermFrequency: float64(1) / 2},
+			{Data: []byte("San Francisco"), TermFrequency: float64(1) / 2},
+		}, actual["tags"])
+	})
+
 	t.Run("with refProps", func(t *testing.T) {
 		t.Run("with a single ref set in the object schema", func(t *testing.T) {
 			beacon := strfmt.URI(
@@ -391,6 +438,213 @@ func TestAnalyzeObject(t *testing.T) {
 	})
 }
 
+func TestAnalyzeObjectValueTypes(t *testing.T) {
+	uuid := strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501")
+	props := []*models.Property{
+		{Name: "count", DataType: []string{"int"}},
+		{Name: "price", DataType: []string{"number"}},
+		{Name: "released", DataType: []string{"date"}},
+		{Name: "counts", DataType: []string{"int[]"}},
+		{Name: "prices", DataType: []string{"number[]"}},
+		{Name: "dates", DataType: []string{"date[]"}},
+		{Name: "names", DataType: []string{"string[]"}},
+	}
+
+	itemsOf := func(t *testing.T, res []Property, name string) []Countable {
+		for _, prop := range res {
+			if prop.Name == name {
+				return prop.Items
+			}
+		}
+
+		t.Fatalf("no property %q in %v", name, res)
+		return nil
+	}
+
+	date := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("values of a write and values read from disk are equivalent", func(t *testing.T) {
+		fromWrite := map[string]interface{}{
+			"count":    int64(3),
+			"price":    float64(1.5),
+			"released": date,
+			"counts":   []interface{}{json.Number("1"), 2},
+			"prices":   []interface{}{json.Number("1.5"), json.Number("2")},
+			"dates":    []interface{}{date.Format(time.RFC3339)},
+			"names":    []interface{}{"alice", "bob"},
+		}
+
+		fromDisk := map[string]interface{}{
+			"count":    float64(3),
+			"price":    float64(1.5),
+			"released": date.Format(time.RFC3339Nano),
+			"counts":   []float64{1, 2},
+			"prices":   []float64{1.5, 2},
+			"dates":    []string{date.Format(time.RFC3339Nano)},
+			"names":    []string{"alice", "bob"},
+		}
+
+		for _, a := range []*Analyzer{NewAnalyzer(), NewStrictAnalyzer()} {
+			resWrite, err := a.Object(fromWrite, props, uuid)
+			require.Nil(t, err)
+
+			resDisk, err := a.Object(fromDisk, props, uuid)
+			require.Nil(t, err)
+
+			for _, prop := range props {
+				assert.ElementsMatch(t, itemsOf(t, resWrite, prop.Name),
+					itemsOf(t, resDisk, prop.Name), prop.Name)
+			}
+
+			assert.ElementsMatch(t, []Countable{{Data: mustGetByteIntNumber(3)}},
+				itemsOf(t, resWrite, "count"))
+			assert.ElementsMatch(t, []Countable{{Data: mustGetByteIntNumber(int(date.UnixNano()))}},
+				itemsOf(t, resWrite, "dates"))
+		}
+	})
+
+	t.Run("an empty array read from disk is equivalent to its write", func(t *testing.T) {
+		for _, a := range []*Analyzer{NewAnalyzer(), NewStrictAnalyzer()} {
+			resWrite, err := a.Object(map[string]interface{}{"names": []interface{}{}},
+				props, uuid)
+			require.Nil(t, err)
+
+			// the type of an empty array is lost on disk
+			resDisk, err := a.Object(map[string]interface{}{"names": models.MultipleRef{}},
+				props, uuid)
+			require.Nil(t, err)
+
+			assert.Equal(t, resWrite, resDisk)
+		}
+	})
+
+	t.Run("lossy values are coerced", func(t *testing.T) {
+		input := map[string]interface{}{
+			"count":  float64(3.7),
+			"counts": []interface{}{json.Number("1.2")},
+			"price":  int64(2),
+		}
+
+		res, err := NewAnalyzer().Object(input, props, uuid)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []Countable{{Data: mustGetByteIntNumber(3)}},
+			itemsOf(t, res, "count"))
+		assert.ElementsMatch(t, []Countable{{Data: mustGetByteIntNumber(1)}},
+			itemsOf(t, res, "counts"))
+		assert.ElementsMatch(t, []Countable{{Data: mustGetByteFloatNumber(2)}},
+			itemsOf(t, res, "price"))
+	})
+
+	t.Run("lossy values are rejected with strict types", func(t *testing.T) {
+		inputs := []map[string]interface{}{
+			{"count": float64(3.7)},
+			{"counts": []interface{}{json.Number("1.2")}},
+			{"price": int64(2)},
+		}
+
+		for _, input := range inputs {
+			_, err := NewStrictAnalyzer().Object(input, props, uuid)
+			require.NotNil(t, err, input)
+			assert.Contains(t, err.Error(), "expects a value of type")
+		}
+	})
+
+	t.Run("unknown properties are rejected with strict types", func(t *testing.T) {
+		input := map[string]interface{}{"color": "red"}
+
+		_, err := NewAnalyzer().Object(input, props, uuid)
+		require.Nil(t, err)
+
+		_, err = NewStrictAnalyzer().Object(input, props, uuid)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `property "color" is not part of the schema`)
+	})
+
+	t.Run("values of the wrong type are never indexed", func(t *testing.T) {
+		inputs := []map[string]interface{}{
+			{"count": "three"},
+			{"released": "yesterday"},
+			{"counts": "1,2"},
+			{"names": []interface{}{"alice", 7}},
+		}
+
+		for _, input := range inputs {
+			_, err := NewAnalyzer().Object(input, props, uuid)
+			assert.NotNil(t, err, input)
+		}
+
+		_, err := NewAnalyzer().Object(map[string]interface{}{"count": "three"},
+			props, uuid)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(),
+			`property "count" expects a value of type int, but got string`)
+	})
+}
+
+func TestAnalyzeNullState(t *testing.T) {
+	a := NewAnalyzer()
+	noIndex := false
+
+	props := []*models.Property{
+		{
+			Name:     "set",
+			DataType: []string{"string"},
+		},
+		{
+			Name:     "unset",
+			DataType: []string{"string"},
+		},
+		{
+			Name:     "emptyArray",
+			DataType: []string{"string[]"},
+		},
+		{
+			Name:     "emptyRefs",
+			DataType: []string{"SomeClass"},
+		},
+		{
+			Name:     "refs",
+			DataType: []string{"SomeClass"},
+		},
+		{
+			Name:          "notIndexed",
+			DataType:      []string{"string"},
+			IndexInverted: &noIndex,
+		},
+		{
+			Name:     "image",
+			DataType: []string{"blob"},
+		},
+	}
+
+	input := map[string]interface{}{
+		"set":        "foo",
+		"emptyArray": []interface{}{},
+		"emptyRefs":  models.MultipleRef{},
+		"refs": models.MultipleRef{
+			&models.SingleRef{Beacon: "weaviate://localhost/8e555f0d-8590-48c2-a9a6-70772ed14c0a"},
+		},
+	}
+
+	res, err := a.NullState(input, props)
+	require.Nil(t, err)
+
+	isNull, err := a.Bool(true)
+	require.Nil(t, err)
+	isNotNull, err := a.Bool(false)
+	require.Nil(t, err)
+
+	expected := []Property{
+		{Name: helpers.MetaNullStateProp("set"), Items: isNotNull},
+		{Name: helpers.MetaNullStateProp("unset"), Items: isNull},
+		{Name: helpers.MetaNullStateProp("emptyArray"), Items: isNull},
+		{Name: helpers.MetaNullStateProp("emptyRefs"), Items: isNull},
+		{Name: helpers.MetaNullStateProp("refs"), Items: isNotNull},
+	}
+	assert.Equal(t, expected, res)
+}
+
 func mustGetByteIntNumber(in int) []byte {
 	out, err := LexicographicallySortableInt64(int64(in))
 	if err != nil {
@@ -406,3 +660,90 @@ func mustGetByteFloatNumber(in float64) []byte {
 	}
 	return out
 }
+
+func TestAnalyzeTimestamps(t *testing.T) {
+	a := NewAnalyzer()
+
+	res, err := a.Timestamps(1000, 2000)
+	require.Nil(t, err)
+
+	creation, err := LexicographicallySortableInt64(1000)
+	require.Nil(t, err)
+	lastUpdate, err := LexicographicallySortableInt64(2000)
+	require.Nil(t, err)
+
+	expected := []Property{
+		{
+			Name:  helpers.PropertyNameCreationTimeUnix,
+			Items: []Countable{{Data: creation}},
+		},
+		{
+			Name:  helpers.PropertyNameLastUpdateTimeUnix,
+			Items: []Countable{{Data: lastUpdate}},
+		},
+	}
+	assert.Equal(t, expected, res)
+}
+
+func TestAnalyzeComposite(t *testing.T) {
+	a := NewAnalyzer()
+
+	props := []Property{
+		{
+			Name:         "tenant",
+			Items:        []Countable{{Data: []byte("tenantA"), TermFrequency: 1}},
+			HasFrequency: true,
+		},
+		{
+			Name: "tags",
+			Items: []Countable{
+				{Data: []byte("red"), TermFrequency: 0.5},
+				{Data: []byte("blue"), TermFrequency: 0.5},
+			},
+			HasFrequency: true,
+		},
+		{
+			Name:  "empty",
+			Items: []Countable{},
+		},
+	}
+
+	t.Run("with a single value per prop", func(t *testing.T) {
+		res := a.Composite(props[:1], [][]string{{"tenant", "tenant"}})
+
+		expected := []Property{{
+			Name: helpers.MetaCompositeProp([]string{"tenant", "tenant"}),
+			Items: []Countable{{
+				Data: CompositeKey([][]byte{[]byte("tenantA"), []byte("tenantA")}),
+			}},
+		}}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("with multiple values per prop", func(t *testing.T) {
+		res := a.Composite(props, [][]string{{"tenant", "tags"}})
+
+		expected := []Property{{
+			Name: helpers.MetaCompositeProp([]string{"tenant", "tags"}),
+			Items: []Countable{
+				{Data: CompositeKey([][]byte{[]byte("tenantA"), []byte("red")})},
+				{Data: CompositeKey([][]byte{[]byte("tenantA"), []byte("blue")})},
+			},
+		}}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("with a missing or empty prop", func(t *testing.T) {
+		res := a.Composite(props, [][]string{
+			{"tenant", "status"},
+			{"tenant", "empty"},
+		})
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("keys are unambiguous", func(t *testing.T) {
+		assert.NotEqual(t,
+			CompositeKey([][]byte{[]byte("ab"), []byte("c")}),
+			CompositeKey([][]byte{[]byte("a"), []byte("bc")}))
+	})
+}
@@ -32,41 +32,43 @@ type propValuePair struct {
 	// byte value from an inverted index
 	valueGeoRange *filters.GeoRange
 	hasFrequency  bool
-	docIDs        docPointers
+	docIDs        docBitmap
 	children      []*propValuePair
+
+	// shared is set if the prop is stored in the shared buckets, see
+	// Searcher.useSharedStorage
+	shared bool
 }
 
-func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int,
-	tolerateDuplicates bool) error {
+func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
-		id := helpers.BucketFromPropNameLSM(pv.prop)
 		if pv.prop == "id" {
 			// the user-specified ID prop has a special internal name
-			id = helpers.BucketFromPropNameLSM(helpers.PropertyNameID)
 			pv.prop = helpers.PropertyNameID
 			pv.hasFrequency = false
 		}
+		id := pv.bucketName()
 		b := s.store.Bucket(id)
 		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
 			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
-			// .docPointers()
+			// .docBitmap()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
-		pointers, err := s.docPointers(id, b, limit, pv, tolerateDuplicates)
+		docIDs, err := s.docBitmap(id, b, limit, pv)
 		if err != nil {
 			return err
 		}
 
-		pv.docIDs = pointers
+		pv.docIDs = docIDs
 	} else {
 		for i, child := range pv.children {
 			// Explicitly set the limit to 0 (=unlimited) as this is a nested filter,
 			// otherwise we run into situations where each subfilter on their own
 			// runs into the limit, possibly yielding in "less than limit" results
 			// after merging.
-			err := child.fetchDocIDs(s, 0, tolerateDuplicates)
+			err := child.fetchDocIDs(s, 0)
 			if err != nil {
 				return errors.Wrapf(err, "nested child %d", i)
 			}
@@ -76,129 +78,24 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int,
 	return nil
 }
 
-// if duplicates are acceptable, simpler (and faster) algorithms can be used
-// for merging
-func (pv *propValuePair) mergeDocIDs(acceptDuplicates bool) (*docPointers, error) {
+// mergeDocIDs combines the doc ids of all children according to the
+// operator. As bitmaps are sets, the result never contains duplicates.
+func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	if pv.operator.OnValue() {
 		return &pv.docIDs, nil
 	}
 
 	switch pv.operator {
 	case filters.OperatorAnd:
-		return mergeAndOptimized(pv.children, acceptDuplicates)
+		return mergeAnd(pv.children)
 	case filters.OperatorOr:
-		return mergeOr(pv.children, acceptDuplicates)
+		return mergeOr(pv.children)
 	default:
 		return nil, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
 	}
 }
 
-// TODO: Delete?
-// This is only left so we can use it as a control or baselines in tests and
-// benchmkarks against the newer optimized version.
-func mergeAnd(children []*propValuePair, acceptDuplicates bool) (*docPointers, error) {
-	sets := make([]*docPointers, len(children))
-
-	// retrieve child IDs
-	for i, child := range children {
-		docIDs, err := child.mergeDocIDs(acceptDuplicates)
-		if err != nil {
-			return nil, errors.Wrapf(err, "retrieve doc ids of child %d", i)
-		}
-
-		sets[i] = docIDs
-	}
-
-	if checksumsIdentical(sets) {
-		// all children are identical, no need to merge, simply return the first
-		// set
-		return sets[0], nil
-	}
-
-	// merge AND
-	found := map[uint64]uint64{} // map[id]count
-	for _, set := range sets {
-		for _, pointer := range set.docIDs {
-			count := found[pointer.id]
-			count++
-			found[pointer.id] = count
-		}
-	}
-
-	var out docPointers
-	var idsForChecksum []uint64
-	for id, count := range found {
-		if count != uint64(len(sets)) {
-			continue
-		}
-
-		// TODO: optimize to use fixed length slice and cut off (should be
-		// considerably cheaper on very long lists, such as we encounter during
-		// large classification cases
-		out.docIDs = append(out.docIDs, docPointer{
-			id: id,
-		})
-		idsForChecksum = append(idsForChecksum, id)
-	}
-
-	checksum, err := docPointerChecksum(idsForChecksum)
-	if err != nil {
-		return nil, errors.Wrapf(err, "calculate checksum")
-	}
-
-	out.checksum = checksum
-	return &out, nil
-}
-
-func mergeOr(children []*propValuePair, acceptDuplicates bool) (*docPointers, error) {
-	sets := make([]*docPointers, len(children))
-
-	// retrieve child IDs
-	for i, child := range children {
-		docIDs, err := child.mergeDocIDs(acceptDuplicates)
-		if err != nil {
-			return nil, errors.Wrapf(err, "retrieve doc ids of child %d", i)
-		}
-
-		sets[i] = docIDs
-	}
-
-	if checksumsIdentical(sets) {
-		// all children are identical, no need to merge, simply return the first
-		// set
-		return sets[0], nil
-	}
-
-	if acceptDuplicates {
-		return mergeOrAcceptDuplicates(sets)
-	}
-
-	// merge OR
-	var checksums [][]byte
-	found := map[uint64]uint64{} // map[id]count
-	for _, set := range sets {
-		for _, pointer := range set.docIDs {
-			count := found[pointer.id]
-			count++
-			found[pointer.id] = count
-		}
-		checksums = append(checksums, set.checksum)
-	}
-
-	var out docPointers
-	for id := range found {
-		// TODO: improve score if item was contained more often
-
-		out.docIDs = append(out.docIDs, docPointer{
-			id: id,
-		})
-	}
-
-	out.checksum = combineChecksums(checksums, filters.OperatorOr)
-	return &out, nil
-}
-
-func checksumsIdentical(sets []*docPointers) bool {
+func checksumsIdentical(sets []*docBitmap) bool {
 	if len(sets) == 0 {
 		return false
 	}
@@ -45,8 +45,7 @@ func (pv *propValuePair) fetchHashes(s *Searcher) error {
 			pv.hasFrequency = false
 		}
 
-		bucketName := helpers.HashBucketFromPropNameLSM(pv.prop)
-		b := s.store.Bucket(bucketName)
+		b := s.store.Bucket(pv.hashBucketName())
 		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
 			return errors.Errorf("hash bucket for prop %s not found - is it indexed?", pv.prop)
 		}
@@ -54,7 +53,7 @@ func (pv *propValuePair) fetchHashes(s *Searcher) error {
 		var hash []byte
 		var err error
 		if pv.operator == filters.OperatorEqual {
-			hash, err = b.Get(pv.value)
+			hash, err = b.Get(pv.rowKey(pv.value))
 			if err != nil {
 				return err
 			}
@@ -85,8 +84,7 @@ func (pv *propValuePair) fetchHashes(s *Searcher) error {
 
 func (pv *propValuePair) hashForNonEqualOp(store *lsmkv.Store,
 	hashBucket *lsmkv.Bucket) ([]byte, error) {
-	bucketName := helpers.BucketFromPropNameLSM(pv.prop)
-	propBucket := store.Bucket(bucketName)
+	propBucket := store.Bucket(pv.bucketName())
 	if propBucket == nil && pv.operator != filters.OperatorWithinGeoRange {
 		return nil, errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 	}
@@ -99,7 +97,7 @@ func (pv *propValuePair) hashForNonEqualOp(store *lsmkv.Store,
 
 func (pv *propValuePair) hashForNonEqualOpWithoutFrequency(propBucket,
 	hashBucket *lsmkv.Bucket) ([]byte, error) {
-	rr := NewRowReader(propBucket, pv.value, pv.operator, true)
+	rr := pv.newRowReader(propBucket, true)
 
 	var keys [][]byte
 	if err := rr.Read(context.TODO(), func(k []byte, ids [][]byte) (bool, error) {
@@ -111,7 +109,7 @@ func (pv *propValuePair) hashForNonEqualOpWithoutFrequency(propBucket,
 
 	hashes := make([][]byte, len(keys))
 	for i, key := range keys {
-		h, err := hashBucket.Get(key)
+		h, err := hashBucket.Get(pv.rowKey(key))
 		if err != nil {
 			return nil, errors.Wrapf(err, "get hash for key %v", key)
 		}
@@ -36,17 +36,22 @@ func NewRowCacher(maxSize uint64) *RowCacher {
 type CacheEntry struct {
 	Type      CacheEntryType
 	Hash      []byte
-	Partial   *docPointers
+	Partial   *docBitmap
 	AllowList helpers.AllowList
 }
 
 // Size cannot be determined accurately since a golang map does not have fixed
 // size per elements. However, through experimentation we have found that a
 // map[uint64]struct{} rarely exceeds 25 bytes per entry, so we are using this
-// as an estimate. In addition, we know that the partial content uses an array
-// where we can assume full efficiency, i.e. 8 bytes per entry.
+// as an estimate. The partial content is a bitmap which can estimate its own
+// size.
 func (ce *CacheEntry) Size() uint64 {
-	return uint64(25*len(ce.AllowList) + 8*len(ce.Partial.docIDs))
+	size := 25 * len(ce.AllowList)
+	if ce.Partial != nil && ce.Partial.docIDs != nil {
+		size += ce.Partial.docIDs.SizeInBytes()
+	}
+
+	return uint64(size)
 }
 
 type CacheEntryType uint8
@@ -69,17 +74,29 @@ const (
 
 func (rc *RowCacher) Store(id []byte, row *CacheEntry) {
 	size := row.Size()
-	if size > rc.maxSize {
+	maxSize := atomic.LoadUint64(&rc.maxSize)
+	if size > maxSize {
 		return
 	}
 
-	if atomic.LoadUint64(&rc.currentSize)+size > rc.maxSize {
+	if atomic.LoadUint64(&rc.currentSize)+size > maxSize {
 		rc.deleteExistingEntries(size)
 	}
 	rc.rowStore.Store(string(id), row)
 	atomic.AddUint64(&rc.currentSize, size)
 }
 
+// SetMaxSize changes the size limit of the cache at runtime. If the cache is
+// shrunk, existing entries are evicted until it fits the new limit.
+func (rc *RowCacher) SetMaxSize(maxSize uint64) {
+	atomic.StoreUint64(&rc.maxSize, maxSize)
+
+	current := atomic.LoadUint64(&rc.currentSize)
+	if current > maxSize {
+		rc.deleteExistingEntries(current - maxSize)
+	}
+}
+
 func (rc *RowCacher) deleteExistingEntries(sizeToDelete uint64) {
 	var deleted uint64
 	rc.rowStore.Range(func(key, value interface{}) bool {
@@ -29,6 +29,9 @@ type RowReader struct {
 	operator filters.Operator
 
 	keyOnly bool
+
+	// prefix is only set for a prop in a shared bucket, see NewSharedRowReader
+	prefix []byte
 }
 
 // If keyOnly is set, the RowReader will request key-only cursors wherever
@@ -44,6 +47,17 @@ func NewRowReader(bucket *lsmkv.Bucket, value []byte,
 	}
 }
 
+// NewSharedRowReader reads the rows of a single prop from a bucket shared
+// with other props, in which all keys of the prop start with the specified
+// prefix. The prefix is stripped from the keys passed to the ReadFn, so they
+// are the same as if the prop was stored in a bucket of its own.
+func NewSharedRowReader(bucket *lsmkv.Bucket, prefix, value []byte,
+	operator filters.Operator, keyOnly bool) *RowReader {
+	rr := NewRowReader(bucket, value, operator, keyOnly)
+	rr.prefix = prefix
+	return rr
+}
+
 // ReadFn will be called 1..n times per match. This means it will also be
 // called on a non-match, in this case v == nil.
 // It is up to the caller to decide if that is an error case or not.
@@ -92,7 +106,7 @@ func (rr *RowReader) equal(ctx context.Context, readFn ReadFn) error {
 		return err
 	}
 
-	v, err := rr.bucket.SetList(rr.value)
+	v, err := rr.bucket.SetList(append(append([]byte{}, rr.prefix...), rr.value...))
 	if err != nil {
 		return err
 	}
@@ -108,6 +122,10 @@ func (rr *RowReader) greaterThan(ctx context.Context, readFn ReadFn,
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -138,6 +156,10 @@ func (rr *RowReader) lessThan(ctx context.Context, readFn ReadFn,
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) != 1; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -166,6 +188,10 @@ func (rr *RowReader) notEqual(ctx context.Context, readFn ReadFn) error {
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -197,6 +223,10 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	var (
 		initialK []byte
 		initialV [][]byte
@@ -244,11 +274,63 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 }
 
 // newCursor will either return a regular cursor - or a key-only cursor if
-// keyOnly==true
-func (rr *RowReader) newCursor() *lsmkv.CursorSet {
+// keyOnly==true. On a shared bucket the cursor only covers the keys with the
+// prefix of the prop.
+func (rr *RowReader) newCursor() setCursor {
+	var c *lsmkv.CursorSet
 	if rr.keyOnly {
-		return rr.bucket.SetCursorKeyOnly()
+		c = rr.bucket.SetCursorKeyOnly()
+	} else {
+		c = rr.bucket.SetCursor()
+	}
+
+	if rr.prefix == nil {
+		return c
+	}
+
+	return &prefixedSetCursor{cursor: c, prefix: rr.prefix}
+}
+
+type setCursor interface {
+	First() ([]byte, [][]byte)
+	Seek(key []byte) ([]byte, [][]byte)
+	Next() ([]byte, [][]byte)
+	Close()
+	Err() error
+}
+
+// prefixedSetCursor iterates over the keys of a shared bucket which start with
+// the prefix and strips the prefix from each key. It is exhausted once the
+// underlying cursor moves past the last key with the prefix.
+type prefixedSetCursor struct {
+	cursor *lsmkv.CursorSet
+	prefix []byte
+}
+
+func (c *prefixedSetCursor) First() ([]byte, [][]byte) {
+	return c.strip(c.cursor.Seek(c.prefix))
+}
+
+func (c *prefixedSetCursor) Seek(key []byte) ([]byte, [][]byte) {
+	return c.strip(c.cursor.Seek(append(append([]byte{}, c.prefix...), key...)))
+}
+
+func (c *prefixedSetCursor) Next() ([]byte, [][]byte) {
+	return c.strip(c.cursor.Next())
+}
+
+func (c *prefixedSetCursor) Close() {
+	c.cursor.Close()
+}
+
+func (c *prefixedSetCursor) Err() error {
+	return c.cursor.Err()
+}
+
+func (c *prefixedSetCursor) strip(k []byte, v [][]byte) ([]byte, [][]byte) {
+	if k == nil || !bytes.HasPrefix(k, c.prefix) {
+		return nil, nil
 	}
 
-	return rr.bucket.SetCursor()
+	return k[len(c.prefix):], v
 }
@@ -103,6 +103,10 @@ func (rr *RowReaderFrequency) greaterThan(ctx context.Context, readFn ReadFnFreq
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -133,6 +137,10 @@ func (rr *RowReaderFrequency) lessThan(ctx context.Context, readFn ReadFnFrequen
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) != 1; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -161,6 +169,10 @@ func (rr *RowReaderFrequency) notEqual(ctx context.Context, readFn ReadFnFrequen
 	c := rr.newCursor()
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	for k, v := c.First(); k != nil; k, v = c.Next() {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -194,6 +206,10 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
 	defer c.Close()
 
+	if err := c.Err(); err != nil {
+		return err
+	}
+
 	var (
 		initialK []byte
 		initialV []lsmkv.MapPair
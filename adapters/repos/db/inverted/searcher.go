@@ -16,14 +16,18 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/notimplemented"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/roaring"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 )
@@ -67,25 +71,21 @@ func (f *Searcher) Object(ctx context.Context, limit int,
 	if err != nil {
 		return nil, err
 	}
+	f.useCompositeIndexes(pv, className)
+	f.useSharedStorage(pv, className)
 
 	var out []*storobj.Object
-	// we assume that when retrieving objects, we can not tolerate duplicates as
-	// they would have a direct impact on the user
-	if err := pv.fetchDocIDs(f, limit, false); err != nil {
+	if err := pv.fetchDocIDs(f, limit); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
-	pointers, err := pv.mergeDocIDs(false)
+	docIDs, err := pv.mergeDocIDs()
 	if err != nil {
 		return nil, errors.Wrap(err, "merge doc ids by operator")
 	}
 
 	// cutoff if required, e.g. after merging unlimted filters
-	if len(pointers.docIDs) > limit {
-		pointers.docIDs = pointers.docIDs[:limit]
-	}
-
-	res, err := f.objectsByDocID(pointers.IDs(), additional)
+	res, err := f.objectsByDocID(docIDs.IDs(limit), additional)
 	if err != nil {
 		return nil, errors.Wrap(err, "resolve doc ids to objects")
 	}
@@ -146,6 +146,8 @@ func (f *Searcher) DocIDs(ctx context.Context, filter *filters.LocalFilter,
 	if err != nil {
 		return nil, err
 	}
+	f.useCompositeIndexes(pv, className)
+	f.useSharedStorage(pv, className)
 
 	cacheable := pv.cacheable()
 	if !cacheable {
@@ -160,27 +162,28 @@ func (f *Searcher) DocIDs(ctx context.Context, filter *filters.LocalFilter,
 		}
 	}
 
-	// when building an allow list (which is a set anyway) we can skip the costly
-	// deduplication, as it doesn't matter
-	if err := pv.fetchDocIDs(f, -1, true); err != nil {
+	if err := pv.fetchDocIDs(f, -1); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
-	pointers, err := pv.mergeDocIDs(true)
+	docIDs, err := pv.mergeDocIDs()
 	if err != nil {
 		return nil, errors.Wrap(err, "merge doc ids by operator")
 	}
 
-	out := make(helpers.AllowList, len(pointers.docIDs))
-	for _, p := range pointers.docIDs {
-		out.Insert(p.id)
-	}
+	out := make(helpers.AllowList, docIDs.count())
+	docIDs.docIDs.Iterate(func(id uint64) bool {
+		out.Insert(id)
+		return true
+	})
 
 	if cacheable {
+		// the order of the ids is not needed for an allow list
+		partial := &docBitmap{docIDs: pv.docIDs.docIDs, checksum: pv.docIDs.checksum}
 		f.rowCache.Store(pv.docIDs.checksum, &CacheEntry{
 			Type:      CacheTypeAllowList,
 			AllowList: out,
-			Partial:   &pv.docIDs,
+			Partial:   partial,
 			Hash:      pv.docIDs.checksum,
 		})
 	}
@@ -213,6 +216,10 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 	}
 	// we are on a value element
 
+	if filter.Operator == filters.OperatorIsNull {
+		return fs.extractNullState(props[0], filter.Value.Value)
+	}
+
 	if fs.onRefProp(className, props[0]) && filter.Value.Type == schema.DataTypeInt {
 		// ref prop and int type is a special case, the user is looking for the
 		// reference count as opposed to the content
@@ -228,9 +235,15 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 		return fs.extractIDProp(filter.Value.Value, filter.Operator)
 	}
 
-	if fs.onMultiWordPropValue(filter.Operator, filter.Value.Value, filter.Value.Type) {
-		return fs.extractMultiWordProp(props[0], filter.Value.Type, filter.Value.Value,
-			filter.Operator)
+	if filters.IsTimestampProp(props[0]) {
+		return fs.extractTimestampProp(props[0], filter.Value.Type,
+			filter.Value.Value, filter.Operator)
+	}
+
+	if filter.Value.Type == schema.DataTypeString ||
+		filter.Value.Type == schema.DataTypeText {
+		return fs.extractTokenizedProp(className, props[0], filter.Value.Type,
+			filter.Value.Value, filter.Operator)
 	}
 
 	return fs.extractPrimitiveProp(props[0], filter.Value.Type, filter.Value.Value,
@@ -248,18 +261,6 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	var extractValueFn func(in interface{}) ([]byte, error)
 	var hasFrequency bool
 	switch dt {
-	case schema.DataTypeText:
-		if operator == filters.OperatorLike {
-			// if the operator is like, we cannot apply the regular text-splitting
-			// logic as it would remove all wildcard symbols
-			extractValueFn = fs.extractTextValueKeepWildcards
-		} else {
-			extractValueFn = fs.extractTextValue
-		}
-		hasFrequency = true
-	case schema.DataTypeString:
-		extractValueFn = fs.extractStringValue
-		hasFrequency = true
 	case schema.DataTypeBoolean:
 		extractValueFn = fs.extractBoolValue
 		hasFrequency = false
@@ -307,6 +308,30 @@ func (fs *Searcher) extractReferenceCount(propName string, value interface{},
 	}, nil
 }
 
+// extractNullState serves an IsNull filter from the null state of the prop,
+// which is only indexed if the class has indexNullState enabled
+func (fs *Searcher) extractNullState(propName string,
+	value interface{}) (*propValuePair, error) {
+	nullStateProp := helpers.MetaNullStateProp(propName)
+	if fs.store.Bucket(helpers.BucketFromPropNameLSM(nullStateProp)) == nil {
+		return nil, fmt.Errorf("prop %q has no null state index: IsNull filters "+
+			"require the class to be created with invertedIndexConfig.indexNullState",
+			propName)
+	}
+
+	byteValue, err := fs.extractBoolValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         nullStateProp,
+		operator:     filters.OperatorEqual,
+	}, nil
+}
+
 func (fs *Searcher) extractGeoFilter(propName string, value interface{},
 	valueType schema.DataType, operator filters.Operator) (*propValuePair, error) {
 	if valueType != schema.DataTypeGeoCoordinates {
@@ -340,33 +365,122 @@ func (fs *Searcher) extractIDProp(value interface{},
 	}, nil
 }
 
-func (fs *Searcher) extractMultiWordProp(propName string, dt schema.DataType,
+// extractTimestampProp serves a filter on the creation or last update time,
+// which are only indexed if the class has indexTimestamps enabled. The value
+// is either a date or a string of the milliseconds since the epoch.
+func (fs *Searcher) extractTimestampProp(propName string, dt schema.DataType,
 	value interface{}, operator filters.Operator) (*propValuePair, error) {
-	var out propValuePair
-	var parts []string
+	if fs.store.Bucket(helpers.BucketFromPropNameLSM(propName)) == nil {
+		return nil, fmt.Errorf("prop %q is not indexed: filtering by timestamps "+
+			"requires the class to be created with invertedIndexConfig.indexTimestamps",
+			propName)
+	}
+
+	var unixMilli int64
 	switch dt {
+	case schema.DataTypeDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected value to be time.Time, got %T", value)
+		}
+		unixMilli = v.UnixNano() / int64(time.Millisecond)
 	case schema.DataTypeString:
-		parts = helpers.TokenizeString(value.(string))
-	case schema.DataTypeText:
-		parts = helpers.TokenizeText(value.(string))
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected value to be string, got %T", value)
+		}
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %q as unix milliseconds", v)
+		}
+		unixMilli = parsed
 	default:
-		return nil, fmt.Errorf("expected value type to be string or text, got %T", dt)
+		return nil, fmt.Errorf("prop %q can only be filtered with valueDate "+
+			"or valueString, got %q", propName, dt)
 	}
 
-	out.children = make([]*propValuePair, len(parts))
+	byteValue, err := LexicographicallySortableInt64(unixMilli)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         propName,
+		operator:     operator,
+	}, nil
+}
+
+// extractTokenizedProp splits the value of a string or text filter with the
+// same tokenization that was used to index the prop. A value which results in
+// multiple tokens matches only if all of them match.
+func (fs *Searcher) extractTokenizedProp(className schema.ClassName,
+	propName string, dt schema.DataType, value interface{},
+	operator filters.Operator) (*propValuePair, error) {
+	asString, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected value to be string, got %T", value)
+	}
 
+	tokenization := fs.filterTokenization(className, propName, dt)
+	var parts []string
+	if operator == filters.OperatorLike {
+		// if the operator is like, we cannot apply the regular text-splitting
+		// logic as it would remove all wildcard symbols
+		parts = helpers.TokenizeKeepWildcards(tokenization, asString)
+	} else {
+		parts = helpers.Tokenize(tokenization, asString)
+	}
+
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("expected at least one search term, got %q", asString)
+	}
+
+	if len(parts) == 1 {
+		return &propValuePair{
+			value:        []byte(parts[0]),
+			hasFrequency: true,
+			prop:         propName,
+			operator:     operator,
+		}, nil
+	}
+
+	var out propValuePair
+	out.children = make([]*propValuePair, len(parts))
 	for i, part := range parts {
-		child, err := fs.extractPrimitiveProp(propName, dt, part, operator)
-		if err != nil {
-			return nil, errors.Wrapf(err, "multi word at pos %d", i)
+		out.children[i] = &propValuePair{
+			value:        []byte(part),
+			hasFrequency: true,
+			prop:         propName,
+			operator:     operator,
 		}
-		out.children[i] = child
 	}
 	out.operator = filters.OperatorAnd
 
 	return &out, nil
 }
 
+// filterTokenization returns the tokenization of the filtered prop. If the
+// prop does not set one explicitly, the type of the filter value determines
+// it, so valueText and valueString keep working the way they always did.
+func (fs *Searcher) filterTokenization(className schema.ClassName,
+	propName string, dt schema.DataType) string {
+	if c := fs.schema.FindClassByName(className); c != nil {
+		for _, prop := range c.Properties {
+			if prop.Name == propName && prop.Tokenization != "" {
+				return prop.Tokenization
+			}
+		}
+	}
+
+	if dt == schema.DataTypeText {
+		return models.PropertyTokenizationWord
+	}
+
+	return models.PropertyTokenizationWhitespace
+}
+
 // TODO: repeated calls to on... aren't too efficient because we iterate over
 // the schema each time, might be smarter to have a single method that
 // determines the type and then we switch based on the result. However, the
@@ -415,62 +529,71 @@ func (fs *Searcher) onIDProp(propName string) bool {
 	return propName == helpers.PropertyNameID
 }
 
-func (fs *Searcher) onMultiWordPropValue(operator filters.Operator,
-	value interface{}, valueType schema.DataType) bool {
-	switch valueType {
-	case schema.DataTypeString:
-		parts := helpers.TokenizeString(value.(string))
-		return len(parts) > 1
-	case schema.DataTypeText:
-		var parts []string
-		if operator == filters.OperatorLike {
-			// if the operator is like, we cannot apply the regular text-splitting
-			// logic as it would remove all wildcard symbols
-			parts = helpers.TokenizeTextKeepWildcards(value.(string))
-		} else {
-			parts = helpers.TokenizeText(value.(string))
-		}
-		return len(parts) > 1
-	default:
-		return false
-	}
+// docBitmap is the set of doc ids matching (part of) a filter. The postings
+// are read from the existing on-disk formats - plain doc ids in set buckets
+// and doc id/frequency pairs in map buckets - so indexes written prior to the
+// introduction of bitmaps can be served without a migration.
+//
+// A bitmap read for a single clause also keeps the order in which the index
+// returned the ids, e.g. by distance for a geo range or by value for a range
+// on the inverted index, so the results of a filter with just this clause are
+// served in this order. Merged bitmaps are in ascending order.
+type docBitmap struct {
+	docIDs   *roaring.Bitmap
+	order    []uint64
+	checksum []byte // helps us judge if a cached read is still fresh
 }
 
-type docPointers struct {
-	count    uint64
-	docIDs   []docPointer
-	checksum []byte // helps us judge if a cached read is still fresh
+func newDocBitmap() docBitmap {
+	return docBitmap{docIDs: roaring.New()}
 }
 
-type docPointer struct {
-	id        uint64
-	frequency *float64
+// add adds an id which was read from an index and keeps track of its order.
+// An id which is read more than once, e.g. from several rows of an array
+// prop, keeps its first position.
+func (d *docBitmap) add(id uint64) {
+	if d.docIDs.Contains(id) {
+		return
+	}
+
+	d.docIDs.Add(id)
+	d.order = append(d.order, id)
 }
 
-func (d docPointers) IDs() []uint64 {
-	out := make([]uint64, len(d.docIDs))
-	for i, elem := range d.docIDs {
-		out[i] = elem.id
+func (d docBitmap) count() int {
+	if d.docIDs == nil {
+		return 0
 	}
-	return out
+
+	return d.docIDs.Cardinality()
 }
 
-func (d *docPointers) removeDuplicates() {
-	counts := make(map[uint64]uint16, len(d.docIDs))
-	for _, id := range d.docIDs {
-		counts[id.id]++
+// IDs returns the doc ids in the order they were read from the index, or in
+// ascending order for merged bitmaps. A limit <= 0 returns all ids.
+func (d docBitmap) IDs(limit int) []uint64 {
+	if d.docIDs == nil {
+		return []uint64{}
 	}
 
-	updated := make([]docPointer, len(d.docIDs))
-	i := 0
-	for _, id := range d.docIDs {
-		if counts[id.id] == 1 {
-			updated[i] = id
-			i++
+	if d.order != nil {
+		if limit <= 0 || limit > len(d.order) {
+			limit = len(d.order)
 		}
 
-		counts[id.id]--
+		out := make([]uint64, limit)
+		copy(out, d.order)
+		return out
+	}
+
+	if limit <= 0 {
+		return d.docIDs.ToArray()
 	}
 
-	d.docIDs = updated[:i]
+	out := make([]uint64, 0, limit)
+	d.docIDs.Iterate(func(id uint64) bool {
+		out = append(out, id)
+		return len(out) < limit
+	})
+
+	return out
 }
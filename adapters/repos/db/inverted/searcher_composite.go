@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// useCompositeIndexes rewrites the Equal filters within an And operator to
+// a single lookup in a composite index, if the class has a composite index
+// which covers all of their props. This avoids reading and merging the
+// (possibly very large) doc id sets of each of the props individually.
+func (fs *Searcher) useCompositeIndexes(pv *propValuePair,
+	className schema.ClassName) {
+	c := fs.schema.FindClassByName(className)
+	if c == nil || c.InvertedIndexConfig == nil ||
+		len(c.InvertedIndexConfig.CompositeIndexes) == 0 {
+		return
+	}
+
+	fs.rewriteToComposites(pv, c.InvertedIndexConfig.CompositeIndexes)
+}
+
+func (fs *Searcher) rewriteToComposites(pv *propValuePair,
+	composites [][]string) {
+	for _, child := range pv.children {
+		fs.rewriteToComposites(child, composites)
+	}
+
+	if pv.operator != filters.OperatorAnd {
+		return
+	}
+
+	// only single-value Equal filters can be combined, the position of the
+	// first such filter per prop is used
+	equalPos := map[string]int{}
+	for i, child := range pv.children {
+		if child.operator != filters.OperatorEqual || child.value == nil {
+			continue
+		}
+
+		if _, ok := equalPos[child.prop]; !ok {
+			equalPos[child.prop] = i
+		}
+	}
+
+	replaced := map[int]struct{}{}
+	var compositeChildren []*propValuePair
+	for _, composite := range composites {
+		if !fs.coversComposite(composite, equalPos, replaced) {
+			continue
+		}
+
+		values := make([][]byte, len(composite))
+		for i, propName := range composite {
+			pos := equalPos[propName]
+			values[i] = pv.children[pos].value
+			replaced[pos] = struct{}{}
+		}
+
+		compositeChildren = append(compositeChildren, &propValuePair{
+			prop:         helpers.MetaCompositeProp(composite),
+			operator:     filters.OperatorEqual,
+			value:        CompositeKey(values),
+			hasFrequency: false,
+		})
+	}
+
+	if len(compositeChildren) == 0 {
+		return
+	}
+
+	children := make([]*propValuePair, 0,
+		len(pv.children)-len(replaced)+len(compositeChildren))
+	for i, child := range pv.children {
+		if _, ok := replaced[i]; ok {
+			continue
+		}
+		children = append(children, child)
+	}
+
+	pv.children = append(children, compositeChildren...)
+}
+
+// coversComposite checks whether there is an Equal filter that is not yet
+// replaced for every prop of the composite and that the composite index
+// exists in this shard
+func (fs *Searcher) coversComposite(composite []string,
+	equalPos map[string]int, replaced map[int]struct{}) bool {
+	for _, propName := range composite {
+		pos, ok := equalPos[propName]
+		if !ok {
+			return false
+		}
+
+		if _, ok := replaced[pos]; ok {
+			return false
+		}
+	}
+
+	bucketName := helpers.BucketFromPropNameLSM(helpers.MetaCompositeProp(composite))
+	return fs.store.Bucket(bucketName) != nil
+}
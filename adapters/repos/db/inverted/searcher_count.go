@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// Count returns the number of objects matching the filter. Contrary to
+// DocIDs, the matching doc ids are never materialized into an allow list: A
+// single Equal filter is answered by the length of its posting list, any
+// other filter by the cardinality of the merged bitmaps.
+func (f *Searcher) Count(ctx context.Context, filter *filters.LocalFilter,
+	className schema.ClassName) (int, error) {
+	pv, err := f.extractPropValuePair(filter.Root, className)
+	if err != nil {
+		return 0, err
+	}
+	f.useCompositeIndexes(pv, className)
+	f.useSharedStorage(pv, className)
+
+	if pv.operator == filters.OperatorEqual && pv.value != nil {
+		return pv.postingListLength(f)
+	}
+
+	if pv.cacheable() {
+		if err := pv.fetchHashes(f); err != nil {
+			return 0, errors.Wrap(err, "fetch row hashes to check for cache eligibility")
+		}
+
+		res, ok := f.rowCache.Load(pv.docIDs.checksum)
+		if ok && res.Type == CacheTypeAllowList {
+			return len(res.AllowList), nil
+		}
+	}
+
+	if err := pv.fetchDocIDs(f, -1); err != nil {
+		return 0, errors.Wrap(err, "fetch doc ids for prop/value pair")
+	}
+
+	docIDs, err := pv.mergeDocIDs()
+	if err != nil {
+		return 0, errors.Wrap(err, "merge doc ids by operator")
+	}
+
+	return docIDs.count(), nil
+}
+
+// postingListLength counts the doc ids of a single row in the inverted index
+// without reading them into a bitmap
+func (pv *propValuePair) postingListLength(s *Searcher) (int, error) {
+	if pv.prop == "id" {
+		// the user-specified ID prop has a special internal name
+		pv.prop = helpers.PropertyNameID
+		pv.hasFrequency = false
+	}
+
+	b := s.store.Bucket(pv.bucketName())
+	if b == nil {
+		return 0, errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
+	}
+
+	if pv.hasFrequency {
+		pairs, err := b.MapList(pv.value)
+		if err != nil {
+			return 0, errors.Wrapf(err, "read row of prop %s", pv.prop)
+		}
+		return len(pairs), nil
+	}
+
+	ids, err := b.SetList(pv.rowKey(pv.value))
+	if err != nil {
+		return 0, errors.Wrapf(err, "read row of prop %s", pv.prop)
+	}
+	return len(ids), nil
+}
@@ -16,7 +16,6 @@ import (
 	"context"
 	"encoding/binary"
 	"hash/crc64"
-	"math"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
@@ -24,100 +23,81 @@ import (
 	"github.com/semi-technologies/weaviate/entities/filters"
 )
 
-func (fs *Searcher) docPointers(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool) (docPointers, error) {
+func (fs *Searcher) docBitmap(prop string, b *lsmkv.Bucket, limit int,
+	pv *propValuePair) (docBitmap, error) {
 	if pv.operator == filters.OperatorWithinGeoRange {
 		// geo props cannot be served by the inverted index and they require an
 		// external index. So, instead of trying to serve this chunk of the filter
 		// request internally, we can pass it to an external geo index
-		return fs.docPointersGeo(pv)
+		return fs.docBitmapGeo(pv)
 	} else {
 		// all other operators perform operations on the inverted index which we
 		// can serve directly
-		return fs.docPointersInverted(prop, b, limit, pv, tolerateDuplicates)
+		return fs.docBitmapInverted(prop, b, limit, pv)
 	}
 }
 
-func (fs *Searcher) docPointersInverted(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool) (docPointers, error) {
+func (fs *Searcher) docBitmapInverted(prop string, b *lsmkv.Bucket, limit int,
+	pv *propValuePair) (docBitmap, error) {
 	if pv.hasFrequency {
-		return fs.docPointersInvertedFrequency(prop, b, limit, pv, tolerateDuplicates)
+		return fs.docBitmapInvertedFrequency(prop, b, limit, pv)
 	}
 
-	return fs.docPointersInvertedNoFrequency(prop, b, limit, pv, tolerateDuplicates)
+	return fs.docBitmapInvertedNoFrequency(prop, b, limit, pv)
 }
 
-func (fs *Searcher) docPointersInvertedNoFrequency(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool) (docPointers, error) {
-	rr := NewRowReader(b, pv.value, pv.operator, false)
+func (fs *Searcher) docBitmapInvertedNoFrequency(prop string, b *lsmkv.Bucket, limit int,
+	pv *propValuePair) (docBitmap, error) {
+	rr := pv.newRowReader(b, false)
 
-	var pointers docPointers
+	out := newDocBitmap()
 	var hashes [][]byte
 
 	if err := rr.Read(context.TODO(), func(k []byte, ids [][]byte) (bool, error) {
-		currentDocIDs := make([]docPointer, len(ids))
-		for i, asBytes := range ids {
-			currentDocIDs[i].id = binary.LittleEndian.Uint64(asBytes)
+		for _, asBytes := range ids {
+			out.add(binary.LittleEndian.Uint64(asBytes))
 		}
 
-		pointers.count += uint64(len(ids))
-		pointers.docIDs = append(pointers.docIDs, currentDocIDs...)
-
-		hashBucket := fs.store.Bucket(helpers.HashBucketFromPropNameLSM(pv.prop))
+		hashBucket := fs.store.Bucket(pv.hashBucketName())
 		if hashBucket == nil {
 			return false, errors.Errorf("no hash bucket for prop '%s' found", pv.prop)
 		}
 
-		currHash, err := hashBucket.Get(k)
+		currHash, err := hashBucket.Get(pv.rowKey(k))
 		if err != nil {
 			return false, errors.Wrap(err, "get hash")
 		}
 
 		hashes = append(hashes, currHash)
-		if limit > 0 && pointers.count >= uint64(limit) {
+		if limit > 0 && out.count() >= limit {
 			return false, nil
 		}
 
 		return true, nil
 	}); err != nil {
-		return pointers, errors.Wrap(err, "read row")
+		return out, errors.Wrap(err, "read row")
 	}
 
-	pointers.checksum = combineChecksums(hashes, pv.operator)
-	if !tolerateDuplicates {
-		pointers.removeDuplicates()
-	}
-
-	return pointers, nil
+	out.checksum = combineChecksums(hashes, pv.operator)
+	return out, nil
 }
 
-func (fs *Searcher) docPointersInvertedFrequency(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool) (docPointers, error) {
+func (fs *Searcher) docBitmapInvertedFrequency(prop string, b *lsmkv.Bucket, limit int,
+	pv *propValuePair) (docBitmap, error) {
 	rr := NewRowReaderFrequency(b, pv.value, pv.operator, false)
 
-	var pointers docPointers
+	out := newDocBitmap()
 	var hashes [][]byte
 
 	if err := rr.Read(context.TODO(), func(k []byte, pairs []lsmkv.MapPair) (bool, error) {
-		currentDocIDs := make([]docPointer, len(pairs))
-		// beforePairs := time.Now()
-		for i, pair := range pairs {
-			currentDocIDs[i].id = binary.LittleEndian.Uint64(pair.Key)
-			freqBits := binary.LittleEndian.Uint64(pair.Value)
-			freq := math.Float64frombits(freqBits)
-			currentDocIDs[i].frequency = &freq
-		}
-		// fmt.Printf("loop through pairs took %s\n", time.Since(beforePairs))
-
-		pointers.count += uint64(len(pairs))
-		if len(pointers.docIDs) > 0 {
-			pointers.docIDs = append(pointers.docIDs, currentDocIDs...)
-		} else {
-			pointers.docIDs = currentDocIDs
+		// the value of each pair is the frequency, which is not needed to
+		// determine whether a doc matches
+		for _, pair := range pairs {
+			out.add(binary.LittleEndian.Uint64(pair.Key))
 		}
 
 		hashBucket := fs.store.Bucket(helpers.HashBucketFromPropNameLSM(pv.prop))
-		if b == nil {
+		if hashBucket == nil {
 			return false, errors.Errorf("no hash bucket for prop '%s' found", pv.prop)
 		}
 
@@ -129,26 +109,22 @@ func (fs *Searcher) docPointersInvertedFrequency(prop string, b *lsmkv.Bucket, l
 		}
 
 		hashes = append(hashes, currHash)
-		if limit > 0 && pointers.count >= uint64(limit) {
+		if limit > 0 && out.count() >= limit {
 			return false, nil
 		}
 
 		return true, nil
 	}); err != nil {
-		return pointers, errors.Wrap(err, "read row")
+		return out, errors.Wrap(err, "read row")
 	}
 
-	pointers.checksum = combineChecksums(hashes, pv.operator)
-
-	if !tolerateDuplicates {
-		pointers.removeDuplicates()
-	}
-	return pointers, nil
+	out.checksum = combineChecksums(hashes, pv.operator)
+	return out, nil
 }
 
-func (fs *Searcher) docPointersGeo(pv *propValuePair) (docPointers, error) {
+func (fs *Searcher) docBitmapGeo(pv *propValuePair) (docBitmap, error) {
 	propIndex, ok := fs.propIndices.ByProp(pv.prop)
-	out := docPointers{}
+	out := newDocBitmap()
 	if !ok {
 		return out, nil
 	}
@@ -159,11 +135,11 @@ func (fs *Searcher) docPointersGeo(pv *propValuePair) (docPointers, error) {
 		return out, errors.Wrapf(err, "geo index range search on prop %q", pv.prop)
 	}
 
-	out.docIDs = make([]docPointer, len(res))
-	for i, id := range res {
-		out.docIDs[i] = docPointer{id: id}
+	// the ids are added one by one to keep the order of the results, which
+	// are sorted by distance
+	for _, id := range res {
+		out.add(id)
 	}
-	out.count = uint64(len(res))
 
 	// we can not use the checksum in the same fashion as with the inverted
 	// index, i.e. it can not prevent a search as the underlying index does not
@@ -207,7 +183,7 @@ func combineChecksums(checksums [][]byte, operator filters.Operator) []byte {
 	return buf
 }
 
-func combineSetChecksums(sets []*docPointers, operator filters.Operator) []byte {
+func combineSetChecksums(sets []*docBitmap, operator filters.Operator) []byte {
 	if len(sets) == 1 {
 		return sets[0].checksum
 	}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// Estimate dry runs the filter. Every clause is counted on its own, just
+// like Count counts a single clause: An Equal clause by the length of its
+// posting list, any other by the cardinality of its bitmap. The bitmaps of
+// the clauses are never merged, the counts are combined instead, see
+// filters.Estimate. The filter is rewritten to use composite indexes and the
+// shared storage first, so the reported indexes are the ones a search would
+// use.
+func (f *Searcher) Estimate(ctx context.Context, filter *filters.LocalFilter,
+	className schema.ClassName) (*filters.Estimate, error) {
+	pv, err := f.extractPropValuePair(filter.Root, className)
+	if err != nil {
+		return nil, err
+	}
+	f.useCompositeIndexes(pv, className)
+	f.useSharedStorage(pv, className)
+
+	indexes := pv.indexUsage(nil)
+
+	count, err := pv.estimate(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filters.Estimate{Count: count, Indexes: indexes}, nil
+}
+
+func (pv *propValuePair) estimate(ctx context.Context, s *Searcher) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if pv.operator.OnValue() {
+		if pv.operator == filters.OperatorEqual && pv.value != nil {
+			return pv.postingListLength(s)
+		}
+
+		if err := pv.fetchDocIDs(s, 0); err != nil {
+			return 0, errors.Wrap(err, "fetch doc ids for prop/value pair")
+		}
+
+		return pv.docIDs.count(), nil
+	}
+
+	counts := make([]int, len(pv.children))
+	for i, child := range pv.children {
+		count, err := child.estimate(ctx, s)
+		if err != nil {
+			return 0, errors.Wrapf(err, "nested child %d", i)
+		}
+		counts[i] = count
+	}
+
+	switch pv.operator {
+	case filters.OperatorAnd:
+		out := 0
+		for i, count := range counts {
+			if i == 0 || count < out {
+				out = count
+			}
+		}
+		return out, nil
+	case filters.OperatorOr:
+		out := 0
+		for _, count := range counts {
+			out += count
+		}
+		return out, nil
+	default:
+		return 0, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
+	}
+}
+
+// indexUsage lists the index of every clause, the internal props of the meta
+// indexes are translated back to the props of the class
+func (pv *propValuePair) indexUsage(out []filters.IndexUsage) []filters.IndexUsage {
+	if !pv.operator.OnValue() {
+		for _, child := range pv.children {
+			out = child.indexUsage(out)
+		}
+		return out
+	}
+
+	usage := filters.IndexUsage{
+		Properties: []string{pv.prop},
+		Operator:   pv.operator.Name(),
+		Index:      filters.IndexInverted,
+	}
+
+	compositeSuffix := helpers.MetaCompositeProp(nil)
+	countSuffix := helpers.MetaCountProp("")
+	nullStateSuffix := helpers.MetaNullStateProp("")
+
+	switch {
+	case pv.operator == filters.OperatorWithinGeoRange:
+		usage.Index = filters.IndexGeo
+	case strings.HasSuffix(pv.prop, compositeSuffix):
+		usage.Properties = strings.Split(strings.TrimSuffix(pv.prop, compositeSuffix), "__")
+		usage.Index = filters.IndexComposite
+	case strings.HasSuffix(pv.prop, countSuffix):
+		usage.Properties = []string{strings.TrimSuffix(pv.prop, countSuffix)}
+		usage.Index = filters.IndexCount
+	case strings.HasSuffix(pv.prop, nullStateSuffix):
+		usage.Properties = []string{strings.TrimSuffix(pv.prop, nullStateSuffix)}
+		usage.Index = filters.IndexNullState
+	case pv.prop == helpers.PropertyNameID:
+		usage.Properties = []string{"id"}
+	case pv.shared:
+		usage.Index = filters.IndexShared
+	}
+
+	return append(out, usage)
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EstimateFilter(t *testing.T) {
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, logger)
+	require.Nil(t, err)
+	defer store.Shutdown(context.Background())
+
+	ctx := context.Background()
+	require.Nil(t, store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM("category"),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM("wordCount"),
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+	for _, propName := range []string{"category", "wordCount"} {
+		require.Nil(t, store.CreateOrLoadBucket(ctx,
+			helpers.HashBucketFromPropNameLSM(propName),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace)))
+	}
+
+	t.Run("import data", func(t *testing.T) {
+		categories := store.Bucket(helpers.BucketFromPropNameLSM("category"))
+		for value, ids := range map[string][]uint64{
+			"news":   {1, 2, 3, 4},
+			"sports": {5, 6},
+		} {
+			require.Nil(t, categories.MapSetMulti([]byte(value), idsToBinaryMapValues(ids)))
+		}
+
+		wordCounts := store.Bucket(helpers.BucketFromPropNameLSM("wordCount"))
+		for value, ids := range map[int64][]uint64{
+			300: {1, 5},
+			500: {2, 3, 4, 6},
+		} {
+			key, err := LexicographicallySortableInt64(value)
+			require.Nil(t, err)
+			require.Nil(t, wordCounts.SetAdd(key, idsToBinaryList(ids)))
+		}
+	})
+
+	searcher := NewSearcher(store, schema.Schema{}, nil, nil, nil, nil)
+
+	category := func(value string) *filters.Clause {
+		return &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Article", Property: "category"},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeString},
+		}
+	}
+	wordCount := &filters.Clause{
+		Operator: filters.OperatorGreaterThan,
+		On:       &filters.Path{Class: "Article", Property: "wordCount"},
+		Value:    &filters.Value{Value: 400, Type: schema.DataTypeInt},
+	}
+
+	tests := []struct {
+		name            string
+		root            *filters.Clause
+		expectedCount   int
+		expectedIndexes []filters.IndexUsage
+	}{
+		{
+			name:          "a single clause",
+			root:          category("news"),
+			expectedCount: 4,
+			expectedIndexes: []filters.IndexUsage{
+				{Properties: []string{"category"}, Operator: "Equal", Index: "inverted"},
+			},
+		},
+		{
+			name: "an And operator is bounded by its smallest clause",
+			root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{*category("sports"), *wordCount},
+			},
+			expectedCount: 2,
+			expectedIndexes: []filters.IndexUsage{
+				{Properties: []string{"category"}, Operator: "Equal", Index: "inverted"},
+				{Properties: []string{"wordCount"}, Operator: "GreaterThan", Index: "inverted"},
+			},
+		},
+		{
+			name: "an Or operator is bounded by the sum of its clauses",
+			root: &filters.Clause{
+				Operator: filters.OperatorOr,
+				Operands: []filters.Clause{*category("news"), *wordCount},
+			},
+			expectedCount: 8,
+			expectedIndexes: []filters.IndexUsage{
+				{Properties: []string{"category"}, Operator: "Equal", Index: "inverted"},
+				{Properties: []string{"wordCount"}, Operator: "GreaterThan", Index: "inverted"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := searcher.Estimate(ctx, &filters.LocalFilter{Root: test.root},
+				"Article")
+			require.Nil(t, err)
+
+			assert.Equal(t, test.expectedCount, res.Count)
+			assert.Nil(t, res.ExactCount)
+			assert.Equal(t, test.expectedIndexes, res.Indexes)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// useSharedStorage marks the filters on props with the "shared"
+// invertedIndexStorage, so they are served from the buckets shared by those
+// props instead of a bucket of their own
+func (fs *Searcher) useSharedStorage(pv *propValuePair,
+	className schema.ClassName) {
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return
+	}
+
+	shared := map[string]struct{}{}
+	for _, prop := range c.Properties {
+		if SharedStorage(prop) {
+			shared[prop.Name] = struct{}{}
+		}
+	}
+
+	if len(shared) == 0 {
+		return
+	}
+
+	pv.markShared(shared)
+}
+
+func (pv *propValuePair) markShared(shared map[string]struct{}) {
+	for _, child := range pv.children {
+		child.markShared(shared)
+	}
+
+	if !pv.operator.OnValue() {
+		return
+	}
+
+	if _, ok := shared[pv.prop]; ok {
+		pv.shared = true
+		pv.hasFrequency = false
+	}
+}
+
+// bucketName is the name of the bucket the prop of the filter is indexed in
+func (pv *propValuePair) bucketName() string {
+	if pv.shared {
+		return helpers.BucketFromPropNameLSM(helpers.PropertyNameShared)
+	}
+
+	return helpers.BucketFromPropNameLSM(pv.prop)
+}
+
+// hashBucketName is the name of the bucket holding the row hashes of the
+// prop of the filter
+func (pv *propValuePair) hashBucketName() string {
+	if pv.shared {
+		return helpers.HashBucketFromPropNameLSM(helpers.PropertyNameShared)
+	}
+
+	return helpers.HashBucketFromPropNameLSM(pv.prop)
+}
+
+// rowKey is the key a value of the prop of the filter is stored with, i.e. the
+// value itself or the value prefixed with the prop name in a shared bucket
+func (pv *propValuePair) rowKey(value []byte) []byte {
+	if pv.shared {
+		return helpers.SharedPropKey(pv.prop, value)
+	}
+
+	return value
+}
+
+// newRowReader reads the rows matching the filter from the bucket of the prop
+func (pv *propValuePair) newRowReader(b *lsmkv.Bucket, keyOnly bool) *RowReader {
+	if pv.shared {
+		return NewSharedRowReader(b, helpers.SharedPropPrefix(pv.prop), pv.value,
+			pv.operator, keyOnly)
+	}
+
+	return NewRowReader(b, pv.value, pv.operator, keyOnly)
+}
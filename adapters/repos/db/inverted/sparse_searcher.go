@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+)
+
+// SparsePostingKey is the map key of a doc in the postings of a term in the
+// sparse vector bucket. The inverted weight comes first, so that the postings
+// of a term sorted by their keys are ordered by descending weight (impact
+// order), the doc id only breaks ties. The value is the weight itself, see
+// SparsePostingValue.
+func SparsePostingKey(weight float32, docID uint64) []byte {
+	out := make([]byte, 12)
+	binary.BigEndian.PutUint32(out[:4], ^math.Float32bits(weight))
+	binary.BigEndian.PutUint64(out[4:], docID)
+	return out
+}
+
+// SparsePostingValue is the value a doc is stored with in the postings of a
+// term in the sparse vector bucket
+func SparsePostingValue(weight float32) []byte {
+	out := make([]byte, 4)
+	binary.LittleEndian.PutUint32(out, math.Float32bits(weight))
+	return out
+}
+
+type sparsePosting struct {
+	docID  uint64
+	weight float64
+}
+
+func parseSparsePosting(pair lsmkv.MapPair) (sparsePosting, bool) {
+	if pair.Tombstone || len(pair.Key) != 12 || len(pair.Value) != 4 {
+		return sparsePosting{}, false
+	}
+
+	return sparsePosting{
+		docID:  binary.BigEndian.Uint64(pair.Key[4:]),
+		weight: float64(math.Float32frombits(binary.LittleEndian.Uint32(pair.Value))),
+	}, true
+}
+
+// SparseSearcher ranks the objects of a shard by the dot product of their
+// sparse vectors with a sparse query vector. It only reads the impact-ordered
+// postings of the query terms and never involves the vector index.
+type SparseSearcher struct {
+	store         *lsmkv.Store
+	deletedDocIDs DeletedDocIDChecker
+}
+
+func NewSparseSearcher(store *lsmkv.Store,
+	deletedDocIDs DeletedDocIDChecker) *SparseSearcher {
+	return &SparseSearcher{
+		store:         store,
+		deletedDocIDs: deletedDocIDs,
+	}
+}
+
+// Search returns the doc ids of the limit highest scoring objects together
+// with their scores in descending order. If an allowList is set, only the
+// objects on it are ranked. Terms with a weight of zero or less in the query
+// can not contribute to any score and are ignored.
+func (s *SparseSearcher) Search(ctx context.Context, query map[string]float32,
+	allowList helpers.AllowList, limit int) ([]uint64, []float32, error) {
+	bucket := s.store.Bucket(helpers.SparseVectorPostingsBucketLSM)
+	if bucket == nil {
+		return nil, nil, errors.Errorf("no bucket %q found",
+			helpers.SparseVectorPostingsBucketLSM)
+	}
+
+	var terms []sparseTerm
+	for term, weight := range query {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		if !(weight > 0) {
+			continue
+		}
+
+		pairs, err := bucket.MapList([]byte(term))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "read postings of term %q", term)
+		}
+
+		postings := make([]sparsePosting, 0, len(pairs))
+		for _, pair := range pairs {
+			posting, ok := parseSparsePosting(pair)
+			if !ok || s.deletedDocIDs.Contains(posting.docID) {
+				continue
+			}
+
+			if allowList != nil && !allowList.Contains(posting.docID) {
+				continue
+			}
+
+			postings = append(postings, posting)
+		}
+
+		if len(postings) == 0 {
+			continue
+		}
+
+		terms = append(terms, newSparseTerm(float64(weight), postings))
+	}
+
+	ids, scores := topScores(scoreSparseTerms(terms, limit), limit)
+	return ids, scores, nil
+}
+
+type sparseTerm struct {
+	queryWeight float64
+	// postings in impact order, i.e. by descending weight
+	postings []sparsePosting
+}
+
+func newSparseTerm(queryWeight float64, postings []sparsePosting) sparseTerm {
+	sort.Slice(postings, func(a, b int) bool {
+		if postings[a].weight != postings[b].weight {
+			return postings[a].weight > postings[b].weight
+		}
+		return postings[a].docID < postings[b].docID
+	})
+
+	return sparseTerm{queryWeight: queryWeight, postings: postings}
+}
+
+// maxImpact is the highest contribution of the term to any score
+func (t sparseTerm) maxImpact() float64 {
+	return t.queryWeight * t.postings[0].weight
+}
+
+// scoreSparseTerms accumulates the exact dot products term at a time, the
+// terms with the highest impacts first. Once the contribution of a posting
+// plus the highest possible contributions of all remaining terms can no
+// longer beat the current limit-th best score, docs which were not scored yet
+// can not make it into the top results. The remaining postings of the term,
+// which are only lower in impact order, then only update existing scores.
+func scoreSparseTerms(terms []sparseTerm, limit int) map[uint64]float64 {
+	sort.Slice(terms, func(a, b int) bool {
+		return terms[a].maxImpact() > terms[b].maxImpact()
+	})
+
+	remaining := 0.0
+	for _, term := range terms {
+		remaining += term.maxImpact()
+	}
+
+	scores := map[uint64]float64{}
+	for _, term := range terms {
+		remaining -= term.maxImpact()
+		threshold := kthHighestScore(scores, limit)
+
+		admitting := true
+		for _, posting := range term.postings {
+			contribution := term.queryWeight * posting.weight
+			if admitting && threshold > 0 && contribution+remaining <= threshold {
+				admitting = false
+			}
+
+			if _, ok := scores[posting.docID]; ok || admitting {
+				scores[posting.docID] += contribution
+			}
+		}
+	}
+
+	return scores
+}
+
+// kthHighestScore is the limit-th highest of the (partial) scores, or 0 if
+// there are not that many scores yet or the limit is not set
+func kthHighestScore(scores map[uint64]float64, limit int) float64 {
+	if limit <= 0 || len(scores) < limit {
+		return 0
+	}
+
+	all := make([]float64, 0, len(scores))
+	for _, score := range scores {
+		all = append(all, score)
+	}
+	sort.Float64s(all)
+	return all[len(all)-limit]
+}
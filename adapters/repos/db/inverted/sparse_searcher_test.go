@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparsePostings(t *testing.T) {
+	t.Run("keys sort by descending weight", func(t *testing.T) {
+		keys := [][]byte{
+			SparsePostingKey(0.5, 1),
+			SparsePostingKey(2.5, 3),
+			SparsePostingKey(0.5, 0),
+			SparsePostingKey(1, 2),
+		}
+		sort.Slice(keys, func(a, b int) bool {
+			return bytes.Compare(keys[a], keys[b]) < 0
+		})
+
+		var docIDs []uint64
+		for _, key := range keys {
+			posting, ok := parseSparsePosting(lsmkv.MapPair{
+				Key: key, Value: SparsePostingValue(1),
+			})
+			require.True(t, ok)
+			docIDs = append(docIDs, posting.docID)
+		}
+		assert.Equal(t, []uint64{3, 2, 0, 1}, docIDs)
+	})
+
+	t.Run("the value is the weight", func(t *testing.T) {
+		posting, ok := parseSparsePosting(lsmkv.MapPair{
+			Key: SparsePostingKey(1.5, 7), Value: SparsePostingValue(1.5),
+		})
+		require.True(t, ok)
+		assert.Equal(t, sparsePosting{docID: 7, weight: 1.5}, posting)
+
+		_, ok = parseSparsePosting(lsmkv.MapPair{
+			Key: SparsePostingKey(1.5, 7), Tombstone: true,
+		})
+		assert.False(t, ok)
+	})
+}
+
+func TestScoreSparseTerms(t *testing.T) {
+	t.Run("dot products", func(t *testing.T) {
+		terms := []sparseTerm{
+			newSparseTerm(2, []sparsePosting{{docID: 1, weight: 1}, {docID: 2, weight: 0.5}}),
+			newSparseTerm(1, []sparsePosting{{docID: 2, weight: 3}, {docID: 3, weight: 0.25}}),
+		}
+
+		ids, scores := topScores(scoreSparseTerms(terms, -1), -1)
+		assert.Equal(t, []uint64{2, 1, 3}, ids)
+		assert.Equal(t, []float32{4, 2, 0.25}, scores)
+	})
+
+	t.Run("pruning keeps the exact top results", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		for run := 0; run < 50; run++ {
+			var terms []sparseTerm
+			exact := map[uint64]float64{}
+			for i := 0; i < 1+r.Intn(8); i++ {
+				queryWeight := r.Float64() * 2
+				var postings []sparsePosting
+				for docID := uint64(0); docID < 200; docID++ {
+					if r.Intn(3) != 0 {
+						continue
+					}
+					weight := float64(float32(r.ExpFloat64()))
+					postings = append(postings, sparsePosting{docID: docID, weight: weight})
+					exact[docID] += queryWeight * weight
+				}
+				if len(postings) > 0 {
+					terms = append(terms, newSparseTerm(queryWeight, postings))
+				}
+			}
+
+			limit := 1 + r.Intn(10)
+			expectedIDs, expectedScores := topScores(exact, limit)
+			ids, scores := topScores(scoreSparseTerms(terms, limit), limit)
+			assert.Equal(t, expectedIDs, ids)
+			assert.Equal(t, expectedScores, scores)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// The values of a prop are either of the types the validation of a write
+// produces or of the types an object read from disk is unmarshalled into,
+// e.g. a date is either a time.Time or an RFC3339 string and an int either an
+// int64 or a float64. Converting between those is always possible without
+// loss. Any other conversion is a coercion, which is rejected if the analyzer
+// has strict types.
+
+// typeError is the error for a value which cannot be converted into the data
+// type of its prop
+func typeError(prop *models.Property, value interface{}) error {
+	return fmt.Errorf("property %q expects a value of type %s, but got %T",
+		prop.Name, prop.DataType[0], value)
+}
+
+// coercionError is the error for a value which can only be converted into the
+// data type of its prop with a loss
+func coercionError(prop *models.Property, value interface{}) error {
+	return fmt.Errorf("property %q expects a value of type %s, but got %v "+
+		"which can only be coerced with strict types disabled",
+		prop.Name, prop.DataType[0], value)
+}
+
+func (a *Analyzer) intValue(prop *models.Property, value interface{}) (int64, error) {
+	switch typed := value.(type) {
+	case int64:
+		return typed, nil
+	case int:
+		// objects which are built in go rather than unmarshalled from json may
+		// contain plain ints
+		return int64(typed), nil
+	case float64:
+		// unmarshaling from json into a dynamic schema will assume every number
+		// is a float64
+		if typed != math.Trunc(typed) && a.strictTypes {
+			return 0, coercionError(prop, value)
+		}
+		return int64(typed), nil
+	case json.Number:
+		if asInt, err := typed.Int64(); err == nil {
+			return asInt, nil
+		}
+
+		asFloat, err := typed.Float64()
+		if err != nil {
+			return 0, typeError(prop, value)
+		}
+		if a.strictTypes {
+			return 0, coercionError(prop, value)
+		}
+		return int64(asFloat), nil
+	default:
+		return 0, typeError(prop, value)
+	}
+}
+
+func (a *Analyzer) numberValue(prop *models.Property, value interface{}) (float64, error) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, nil
+	case json.Number:
+		asFloat, err := typed.Float64()
+		if err != nil {
+			return 0, typeError(prop, value)
+		}
+		return asFloat, nil
+	case int64:
+		if a.strictTypes {
+			return 0, coercionError(prop, value)
+		}
+		return float64(typed), nil
+	case int:
+		if a.strictTypes {
+			return 0, coercionError(prop, value)
+		}
+		return float64(typed), nil
+	default:
+		return 0, typeError(prop, value)
+	}
+}
+
+func (a *Analyzer) boolValue(prop *models.Property, value interface{}) (bool, error) {
+	asBool, ok := value.(bool)
+	if !ok {
+		return false, typeError(prop, value)
+	}
+
+	return asBool, nil
+}
+
+func (a *Analyzer) stringValue(prop *models.Property, value interface{}) (string, error) {
+	asString, ok := value.(string)
+	if !ok {
+		return "", typeError(prop, value)
+	}
+
+	return asString, nil
+}
+
+// dateValue returns the date as nanoseconds since the epoch
+func (a *Analyzer) dateValue(prop *models.Property, value interface{}) (int64, error) {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.UnixNano(), nil
+	case string:
+		asTime, err := time.Parse(time.RFC3339, typed)
+		if err != nil {
+			return 0, typeError(prop, value)
+		}
+		return asTime.UnixNano(), nil
+	default:
+		return 0, typeError(prop, value)
+	}
+}
+
+// arrayValues returns the elements of the value of an array prop. Arrays of
+// objects read from disk have typed elements, see storobj.Object.
+func (a *Analyzer) arrayValues(prop *models.Property, value interface{}) ([]interface{}, error) {
+	switch typed := value.(type) {
+	case []interface{}:
+		return typed, nil
+	case []string:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []float64:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []int64:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []bool:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case models.MultipleRef:
+		// the type of an empty array is lost on disk, it is read back as an
+		// empty list of references
+		if len(typed) == 0 {
+			return []interface{}{}, nil
+		}
+		return nil, typeError(prop, value)
+	default:
+		return nil, typeError(prop, value)
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package iothrottle
+
+import (
+	"context"
+	"io"
+)
+
+// Writer waits for the budget of its throttle before every write
+type Writer struct {
+	ctx      context.Context
+	w        io.Writer
+	throttle *Throttle
+	priority Priority
+}
+
+func NewWriter(ctx context.Context, w io.Writer, throttle *Throttle,
+	priority Priority) *Writer {
+	return &Writer{ctx: ctx, w: w, throttle: throttle, priority: priority}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	if err := w.throttle.Wait(w.ctx, w.priority, len(p)); err != nil {
+		return 0, err
+	}
+
+	return w.w.Write(p)
+}
+
+// WriteSeeker is a Writer for targets which need to be seeked in, seeking is
+// not throttled
+type WriteSeeker struct {
+	*Writer
+	s io.Seeker
+}
+
+func NewWriteSeeker(ctx context.Context, ws io.WriteSeeker,
+	throttle *Throttle, priority Priority) *WriteSeeker {
+	return &WriteSeeker{
+		Writer: NewWriter(ctx, ws, throttle, priority),
+		s:      ws,
+	}
+}
+
+func (w *WriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	return w.s.Seek(offset, whence)
+}
+
+// Reader charges the budget of its throttle for every read. As the number of
+// bytes is only known afterwards, the wait happens after the read, so the
+// following read is delayed instead.
+type Reader struct {
+	ctx      context.Context
+	r        io.Reader
+	throttle *Throttle
+	priority Priority
+}
+
+func NewReader(ctx context.Context, r io.Reader, throttle *Throttle,
+	priority Priority) *Reader {
+	return &Reader{ctx: ctx, r: r, throttle: throttle, priority: priority}
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if waitErr := r.throttle.Wait(r.ctx, r.priority, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
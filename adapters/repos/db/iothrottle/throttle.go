@@ -0,0 +1,225 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package iothrottle limits the disk throughput of background work, such as
+// compactions, HNSW commit log maintenance, tombstone cleanups and backups,
+// so that it cannot saturate the disk that foreground queries depend on.
+package iothrottle
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Priority decides which background work is allowed to use the budget first
+// if several are waiting for it. Work with a lower priority only proceeds
+// once no work with a higher priority is waiting anymore.
+type Priority int
+
+const (
+	// PriorityBackup is the lowest priority, as nothing else depends on a
+	// backup completing quickly
+	PriorityBackup Priority = iota
+	// PriorityCleanup is used for removing deleted data, such as the
+	// tombstones of a vector index
+	PriorityCleanup
+	// PriorityHNSW is used for condensing and combining the commit logs of a
+	// vector index
+	PriorityHNSW
+	// PriorityCompaction is the highest priority, as every compaction which
+	// falls behind increases the number of segments each read has to go
+	// through
+	PriorityCompaction
+
+	priorityCount
+)
+
+// Priorities returns all priorities, the highest one first
+func Priorities() []Priority {
+	return []Priority{PriorityCompaction, PriorityHNSW, PriorityCleanup,
+		PriorityBackup}
+}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityBackup:
+		return "backup"
+	case PriorityCleanup:
+		return "cleanup"
+	case PriorityHNSW:
+		return "hnsw_maintenance"
+	case PriorityCompaction:
+		return "compaction"
+	default:
+		return "unknown"
+	}
+}
+
+// minDelay is how long a waiter sleeps at least before it checks the budget
+// again, so that waiters which are blocked by a higher priority do not spin
+const minDelay = 10 * time.Millisecond
+
+// Throttle is a budget of bytes per second which is shared by all background
+// work of a node. The budget refills continuously and can be saved up for at
+// most one second. A single request may exceed the remaining budget, in which
+// case the following requests wait until the debt is paid off, so requests of
+// any size are possible while the rate is still honored on average.
+//
+// A nil *Throttle or one with a rate of 0 does not limit anything, but the
+// latter still keeps track of the bytes used.
+type Throttle struct {
+	sync.Mutex
+	rate       int64
+	available  float64
+	lastRefill time.Time
+
+	waiting [priorityCount]int
+	bytes   [priorityCount]int64
+	waited  [priorityCount]time.Duration
+}
+
+// Stats describes the current state of a Throttle
+type Stats struct {
+	// Rate is the budget in bytes per second, 0 means unlimited
+	Rate int64
+	// Priorities are the stats of every priority, the highest one first
+	Priorities []PriorityStats
+}
+
+// PriorityStats describes the usage of a Throttle by the work of a single
+// priority
+type PriorityStats struct {
+	Priority Priority
+	// Bytes is the number of bytes read or written since startup
+	Bytes int64
+	// Waited is the total time spent waiting for the budget since startup
+	Waited time.Duration
+	// Waiting is the number of requests currently waiting for the budget
+	Waiting int
+}
+
+func New(bytesPerSecond int64) *Throttle {
+	if bytesPerSecond < 0 {
+		bytesPerSecond = 0
+	}
+
+	return &Throttle{
+		rate:       bytesPerSecond,
+		available:  float64(bytesPerSecond),
+		lastRefill: time.Now(),
+	}
+}
+
+// Wait blocks until n bytes may be read or written with the priority. It
+// only returns an error if the context expires first.
+func (t *Throttle) Wait(ctx context.Context, priority Priority, n int) error {
+	if t == nil {
+		return nil
+	}
+
+	start := time.Now()
+
+	t.Lock()
+	t.waiting[priority]++
+	for !t.mayProceed(priority) {
+		delay := t.delay()
+		t.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			t.Lock()
+			t.waiting[priority]--
+			t.Unlock()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		t.Lock()
+	}
+
+	t.waiting[priority]--
+	if t.rate > 0 {
+		t.available -= float64(n)
+	}
+	t.bytes[priority] += int64(n)
+	t.waited[priority] += time.Since(start)
+	t.Unlock()
+
+	return nil
+}
+
+// mayProceed must be called with the lock held
+func (t *Throttle) mayProceed(priority Priority) bool {
+	if t.rate == 0 {
+		return true
+	}
+
+	t.refill()
+	if t.available < 0 {
+		return false
+	}
+
+	for higher := priority + 1; higher < priorityCount; higher++ {
+		if t.waiting[higher] > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// refill must be called with the lock held
+func (t *Throttle) refill() {
+	now := time.Now()
+	t.available += now.Sub(t.lastRefill).Seconds() * float64(t.rate)
+	t.lastRefill = now
+
+	if t.available > float64(t.rate) {
+		t.available = float64(t.rate)
+	}
+}
+
+// delay is how long it takes until the debt is paid off. If there is no debt
+// the waiter is blocked by a higher priority, which uses the budget as soon
+// as it wakes up. delay must be called with the lock held.
+func (t *Throttle) delay() time.Duration {
+	delay := time.Duration(-t.available / float64(t.rate) * float64(time.Second))
+	if delay < minDelay {
+		return minDelay
+	}
+
+	return delay
+}
+
+// Stats returns the current rate and usage of the throttle
+func (t *Throttle) Stats() Stats {
+	if t == nil {
+		return Stats{}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	out := Stats{Rate: t.rate}
+	for _, p := range Priorities() {
+		out.Priorities = append(out.Priorities, PriorityStats{
+			Priority: p,
+			Bytes:    t.bytes[p],
+			Waited:   t.waited[p],
+			Waiting:  t.waiting[p],
+		})
+	}
+
+	return out
+}
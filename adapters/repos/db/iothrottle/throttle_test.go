@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package iothrottle
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a nil throttle does not limit anything", func(t *testing.T) {
+		var throttle *Throttle
+		require.Nil(t, throttle.Wait(ctx, PriorityBackup, 1<<30))
+		assert.Equal(t, Stats{}, throttle.Stats())
+	})
+
+	t.Run("a throttle without a rate only counts", func(t *testing.T) {
+		throttle := New(0)
+		start := time.Now()
+		for i := 0; i < 10; i++ {
+			require.Nil(t, throttle.Wait(ctx, PriorityCompaction, 1<<30))
+		}
+
+		assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+		assert.Equal(t, int64(10<<30), statsOf(throttle, PriorityCompaction).Bytes)
+	})
+
+	t.Run("the budget is paid off at the rate", func(t *testing.T) {
+		throttle := New(1000)
+
+		start := time.Now()
+		// the first second of budget is available immediately, the request
+		// exceeding it is allowed, but the next one has to wait for the debt
+		require.Nil(t, throttle.Wait(ctx, PriorityBackup, 1300))
+		assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+		require.Nil(t, throttle.Wait(ctx, PriorityBackup, 1))
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(250*time.Millisecond))
+
+		stats := statsOf(throttle, PriorityBackup)
+		assert.Equal(t, int64(1301), stats.Bytes)
+		assert.Greater(t, int64(stats.Waited), int64(0))
+	})
+
+	t.Run("higher priorities go first", func(t *testing.T) {
+		throttle := New(1000)
+		require.Nil(t, throttle.Wait(ctx, PriorityBackup, 1200))
+
+		var lock sync.Mutex
+		var order []Priority
+		var wg sync.WaitGroup
+
+		for _, p := range []Priority{PriorityBackup, PriorityCleanup, PriorityCompaction} {
+			wg.Add(1)
+			go func(p Priority) {
+				defer wg.Done()
+				require.Nil(t, throttle.Wait(ctx, p, 300))
+				lock.Lock()
+				order = append(order, p)
+				lock.Unlock()
+			}(p)
+
+			// make sure each waiter is registered before the next one
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		wg.Wait()
+		assert.Equal(t, []Priority{PriorityCompaction, PriorityCleanup, PriorityBackup},
+			order)
+	})
+
+	t.Run("waiting stops when the context expires", func(t *testing.T) {
+		throttle := New(10)
+		require.Nil(t, throttle.Wait(ctx, PriorityBackup, 1000))
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		err := throttle.Wait(ctx, PriorityBackup, 1)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Equal(t, 0, statsOf(throttle, PriorityBackup).Waiting)
+	})
+}
+
+func TestThrottledIO(t *testing.T) {
+	ctx := context.Background()
+	throttle := New(0)
+	data := bytes.Repeat([]byte("a"), 1000)
+
+	read, err := ioutil.ReadAll(NewReader(ctx, bytes.NewReader(data), throttle,
+		PriorityBackup))
+	require.Nil(t, err)
+	assert.Equal(t, data, read)
+	assert.Equal(t, int64(1000), statsOf(throttle, PriorityBackup).Bytes)
+
+	var buf bytes.Buffer
+	n, err := NewWriter(ctx, &buf, throttle, PriorityHNSW).Write(data)
+	require.Nil(t, err)
+	assert.Equal(t, 1000, n)
+	assert.Equal(t, data, buf.Bytes())
+	assert.Equal(t, int64(1000), statsOf(throttle, PriorityHNSW).Bytes)
+}
+
+func statsOf(throttle *Throttle, priority Priority) PriorityStats {
+	for _, stats := range throttle.Stats().Priorities {
+		if stats.Priority == priority {
+			return stats
+		}
+	}
+
+	return PriorityStats{}
+}
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeywordSearch(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "KeywordSearchClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			{
+				Name:     "description",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	foxOnce := strfmt.UUID("6f3a7b1e-3c1e-4f54-9a43-9f5c1f4a0a01")
+	foxTwice := strfmt.UUID("6f3a7b1e-3c1e-4f54-9a43-9f5c1f4a0a02")
+	foxTitle := strfmt.UUID("6f3a7b1e-3c1e-4f54-9a43-9f5c1f4a0a03")
+	noFox := strfmt.UUID("6f3a7b1e-3c1e-4f54-9a43-9f5c1f4a0a04")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:    foxOnce,
+			Class: "KeywordSearchClass",
+			Properties: map[string]interface{}{
+				"title":       "animals",
+				"description": "the quick brown fox jumps over the lazy dog",
+			},
+		}, {
+			ID:    foxTwice,
+			Class: "KeywordSearchClass",
+			Properties: map[string]interface{}{
+				"title":       "animals",
+				"description": "a fox chases another fox",
+			},
+		}, {
+			ID:    foxTitle,
+			Class: "KeywordSearchClass",
+			Properties: map[string]interface{}{
+				"title":       "fox",
+				"description": "a story about a clever animal",
+			},
+		}, {
+			ID:    noFox,
+			Class: "KeywordSearchClass",
+			Properties: map[string]interface{}{
+				"title":       "animals",
+				"description": "the lazy dog sleeps all day",
+			},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	rank := func(t *testing.T, ranking *searchparams.KeywordRanking,
+		filter *filters.LocalFilter) []search.Result {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:      "KeywordSearchClass",
+			Pagination:     &filters.Pagination{Limit: 10},
+			KeywordRanking: ranking,
+			Filters:        filter,
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	ids := func(res []search.Result) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			out[i] = obj.ID
+		}
+		return out
+	}
+
+	t.Run("ranking the description", func(t *testing.T) {
+		res := rank(t, &searchparams.KeywordRanking{
+			Query:      "fox",
+			Properties: []string{"description"},
+		}, nil)
+
+		// the shorter description with more occurrences ranks first
+		assert.Equal(t, []strfmt.UUID{foxTwice, foxOnce}, ids(res))
+		assert.Greater(t, res[0].Score, res[1].Score)
+		assert.Greater(t, res[1].Score, float32(0))
+	})
+
+	t.Run("ranking all searchable props", func(t *testing.T) {
+		res := rank(t, &searchparams.KeywordRanking{Query: "fox"}, nil)
+		assert.ElementsMatch(t, []strfmt.UUID{foxOnce, foxTwice, foxTitle}, ids(res))
+	})
+
+	t.Run("ranking with a filter", func(t *testing.T) {
+		res := rank(t, &searchparams.KeywordRanking{Query: "fox"},
+			buildFilter("title", "animals", eq, dtString))
+		assert.Equal(t, []strfmt.UUID{foxTwice, foxOnce}, ids(res))
+	})
+
+	t.Run("ranking through the objects api with an offset", func(t *testing.T) {
+		res, err := repo.ObjectKeywordSearch(context.Background(), "KeywordSearchClass",
+			searchparams.KeywordRanking{Query: "fox", Properties: []string{"description"}},
+			1, 10, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{foxOnce}, ids(res))
+	})
+
+	t.Run("deleted objects are not ranked", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), "KeywordSearchClass", foxTwice))
+
+		res := rank(t, &searchparams.KeywordRanking{
+			Query:      "fox",
+			Properties: []string{"description"},
+		}, nil)
+		assert.Equal(t, []strfmt.UUID{foxOnce}, ids(res))
+	})
+}
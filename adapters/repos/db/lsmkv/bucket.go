@@ -20,6 +20,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/sirupsen/logrus"
 )
 
@@ -34,31 +36,70 @@ type Bucket struct {
 	// normal operation
 	flushLock sync.RWMutex
 
+	// switchLock makes sure only a single FlushAndSwitch can run at a time,
+	// as both the flush cycle and an explicit flush (e.g. for a backup) can
+	// trigger one
+	switchLock sync.Mutex
+
 	memTableThreshold uint64
 	strategy          string
 	secondaryIndices  uint16
 
 	stopFlushCycle chan struct{}
+
+	// flushed is closed once the flush of the current flushing memtable has
+	// completed, stalled writes wait for it
+	flushed chan struct{}
+	stalls  WriteStalls
+
+	// flushes is shared by all buckets of a store, a bucket without a store
+	// flushes whenever it needs to
+	flushes *flushScheduler
+
+	// handles limits the number of mapped disk segments, it is shared with
+	// other buckets and may be nil
+	handles *HandleBudget
+
+	// throttle limits the disk throughput of compactions, it is shared with
+	// other buckets and may be nil
+	throttle *iothrottle.Throttle
+
+	// cipher encrypts new segments and write-ahead logs, it may be nil
+	cipher *encryption.Cipher
+
+	// compression is the algorithm new segments are compressed with, segments
+	// are not compressed if it is empty
+	compression string
+
+	// memtables sizes the memtable threshold, it is shared with other buckets
+	// and may be nil. It is ignored once the threshold was set explicitly.
+	memtables      *MemtableBudget
+	fixedThreshold bool
+
+	// compactions decides when the segments are compacted, it is shared with
+	// other buckets and may be nil, in which case the default policy is used
+	compactions *Compactions
+
+	// readOnly is set for the buckets of a store view, their memtables do
+	// not have a commit log and are never flushed
+	readOnly bool
+
+	// mapLocks serialize the read-modify-write updates of map entries, see
+	// MapSetIf
+	mapLocks mapKeyLocks
 }
 
 func NewBucket(ctx context.Context, dir string, logger logrus.FieldLogger,
 	opts ...BucketOption) (*Bucket, error) {
-	defaultThreshold := uint64(10 * 1024 * 1024)
 	defaultStrategy := StrategyReplace
 
 	if err := os.MkdirAll(dir, 0o700); err != nil {
 		return nil, err
 	}
 
-	sg, err := newSegmentGroup(dir, 3*time.Second, logger)
-	if err != nil {
-		return nil, errors.Wrap(err, "init disk segments")
-	}
-
 	b := &Bucket{
 		dir:               dir,
-		disk:              sg,
-		memTableThreshold: defaultThreshold,
+		memTableThreshold: defaultMemtableThreshold,
 		strategy:          defaultStrategy,
 		stopFlushCycle:    make(chan struct{}),
 		logger:            logger,
@@ -70,6 +111,13 @@ func NewBucket(ctx context.Context, dir string, logger logrus.FieldLogger,
 		}
 	}
 
+	sg, err := newSegmentGroup(dir, b.compactions, logger, b.handles,
+		b.throttle, b.cipher, b.compression)
+	if err != nil {
+		return nil, errors.Wrap(err, "init disk segments")
+	}
+	b.disk = sg
+
 	if err := b.setNewActiveMemtable(); err != nil {
 		return nil, err
 	}
@@ -78,6 +126,10 @@ func NewBucket(ctx context.Context, dir string, logger logrus.FieldLogger,
 		return nil, err
 	}
 
+	if b.memtables != nil {
+		b.memtables.register(b)
+	}
+
 	b.initFlushCycle()
 
 	return b, nil
@@ -85,6 +137,17 @@ func NewBucket(ctx context.Context, dir string, logger logrus.FieldLogger,
 
 func (b *Bucket) SetMemtableThreshold(size uint64) {
 	b.memTableThreshold = size
+	b.fixedThreshold = true
+}
+
+// threshold is the size at which the active memtable is flushed, it is
+// either set explicitly or the share of the memtable budget of the bucket
+func (b *Bucket) threshold() uint64 {
+	if b.memtables == nil || b.fixedThreshold {
+		return b.memTableThreshold
+	}
+
+	return b.memtables.threshold()
 }
 
 func (b *Bucket) Get(key []byte) ([]byte, error) {
@@ -209,6 +272,8 @@ func (b *Bucket) SetList(key []byte) ([][]byte, error) {
 }
 
 func (b *Bucket) Put(key, value []byte, opts ...SecondaryKeyOption) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -216,6 +281,8 @@ func (b *Bucket) Put(key, value []byte, opts ...SecondaryKeyOption) error {
 }
 
 func (b *Bucket) SetAdd(key []byte, values [][]byte) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -223,6 +290,8 @@ func (b *Bucket) SetAdd(key []byte, values [][]byte) error {
 }
 
 func (b *Bucket) SetDeleteSingle(key []byte, valueToDelete []byte) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -292,6 +361,8 @@ func (b *Bucket) MapList(key []byte, cfgs ...MapListOption) ([]MapPair, error) {
 }
 
 func (b *Bucket) MapSet(rowKey []byte, kv MapPair) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -304,6 +375,8 @@ func (b *Bucket) MapSet(rowKey []byte, kv MapPair) error {
 }
 
 func (b *Bucket) MapSetMulti(rowKey []byte, kvs []MapPair) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -316,6 +389,8 @@ func (b *Bucket) MapSetMulti(rowKey []byte, kvs []MapPair) error {
 }
 
 func (b *Bucket) MapDeleteKey(rowKey, mapKey []byte) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -333,6 +408,8 @@ func (b *Bucket) MapDeleteKey(rowKey, mapKey []byte) error {
 }
 
 func (b *Bucket) Delete(key []byte, opts ...SecondaryKeyOption) error {
+	b.waitForFlush()
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -343,7 +420,8 @@ func (b *Bucket) Delete(key []byte, opts ...SecondaryKeyOption) error {
 // lock on its own
 func (b *Bucket) setNewActiveMemtable() error {
 	mt, err := newMemtable(filepath.Join(b.dir, fmt.Sprintf("segment-%d",
-		time.Now().UnixNano())), b.strategy, b.secondaryIndices)
+		time.Now().UnixNano())), b.strategy, b.secondaryIndices, b.cipher,
+		b.compression)
 	if err != nil {
 		return err
 	}
@@ -353,6 +431,10 @@ func (b *Bucket) setNewActiveMemtable() error {
 }
 
 func (b *Bucket) Shutdown(ctx context.Context) error {
+	if b.memtables != nil {
+		b.memtables.unregister(b)
+	}
+
 	if err := b.disk.shutdown(ctx); err != nil {
 		return err
 	}
@@ -393,9 +475,9 @@ func (b *Bucket) initFlushCycle() {
 			case <-b.stopFlushCycle:
 				return
 			case <-t:
-				b.flushLock.Lock()
-				shouldSwitch := b.active.Size() >= b.memTableThreshold
-				b.flushLock.Unlock()
+				b.flushLock.RLock()
+				shouldSwitch := b.active.Size() >= b.threshold()
+				b.flushLock.RUnlock()
 				if shouldSwitch {
 					if err := b.FlushAndSwitch(); err != nil {
 						b.logger.WithField("action", "lsm_memtable_flush").
@@ -413,6 +495,13 @@ func (b *Bucket) initFlushCycle() {
 // calling, but there are some situations where this might be intended, such as
 // in test scenarios or when a force flush is desired.
 func (b *Bucket) FlushAndSwitch() error {
+	if b.readOnly {
+		return ErrReadOnly
+	}
+
+	b.switchLock.Lock()
+	defer b.switchLock.Unlock()
+
 	before := time.Now()
 
 	b.logger.WithField("action", "lsm_memtable_flush_start").
@@ -421,6 +510,14 @@ func (b *Bucket) FlushAndSwitch() error {
 	if err := b.atomicallySwitchMemtable(); err != nil {
 		return errors.Wrap(err, "switch active memtable")
 	}
+	// writes which stalled on this flush continue even if it fails, as they
+	// would otherwise wait forever
+	defer close(b.flushed)
+
+	if b.flushes != nil {
+		b.flushes.acquire(b)
+		defer b.flushes.release()
+	}
 
 	if err := b.flushing.flush(); err != nil {
 		return errors.Wrap(err, "flush")
@@ -443,6 +540,52 @@ func (b *Bucket) FlushAndSwitch() error {
 	return nil
 }
 
+// FlushMemtable flushes the active memtable to a disk segment, unless it is
+// empty. After a successful flush all data written so far is contained in
+// the files returned by ListFiles.
+func (b *Bucket) FlushMemtable() error {
+	b.flushLock.RLock()
+	empty := b.active.Size() == 0
+	b.flushLock.RUnlock()
+
+	if empty {
+		return nil
+	}
+
+	return b.FlushAndSwitch()
+}
+
+// PauseCompaction prevents any compaction from changing the disk segments
+// until ResumeCompaction is called. It blocks until a running compaction has
+// completed.
+func (b *Bucket) PauseCompaction() {
+	b.disk.pauseCompaction()
+}
+
+func (b *Bucket) ResumeCompaction() {
+	b.disk.resumeCompaction()
+}
+
+// VerifyIntegrity verifies all disk segments of the bucket against the
+// checksums written along with them. Corrupt segments are quarantined and
+// listed in the report. Without them, older values and deleted entries
+// could reappear, so from then on reads fail with ErrQuarantined, as does
+// loading the bucket again. Writes are still accepted. It blocks while
+// compactions are paused, see PauseCompaction.
+func (b *Bucket) VerifyIntegrity(ctx context.Context) (IntegrityReport, error) {
+	if b.readOnly {
+		return IntegrityReport{}, ErrReadOnly
+	}
+
+	return b.disk.verifyIntegrity(ctx)
+}
+
+// ListFiles returns the paths of all disk segments of this bucket. Data still
+// in the memtable is not included, see FlushMemtable.
+func (b *Bucket) ListFiles() []string {
+	return b.disk.listFiles()
+}
+
 func (b *Bucket) atomicallyAddDiskSegmentAndRemoveFlushing() error {
 	b.flushLock.Lock()
 	defer b.flushLock.Unlock()
@@ -461,7 +604,12 @@ func (b *Bucket) atomicallySwitchMemtable() error {
 	defer b.flushLock.Unlock()
 
 	b.flushing = b.active
-	return b.setNewActiveMemtable()
+	if err := b.setNewActiveMemtable(); err != nil {
+		return err
+	}
+
+	b.flushed = make(chan struct{})
+	return nil
 }
 
 func (b *Bucket) Strategy() string {
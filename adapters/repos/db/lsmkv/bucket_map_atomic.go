@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"hash/fnv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// mapKeyLockStripes is the number of locks the entries of all maps of a
+// bucket are spread across. Two entries only contend if they happen to share
+// a stripe.
+const mapKeyLockStripes = 256
+
+// mapKeyLocks are striped locks for the entries of the maps of a bucket. The
+// zero value is ready to use.
+type mapKeyLocks [mapKeyLockStripes]sync.Mutex
+
+func (l *mapKeyLocks) lock(rowKey, mapKey []byte) func() {
+	h := fnv.New32a()
+	h.Write(rowKey)
+	h.Write([]byte{0})
+	h.Write(mapKey)
+
+	m := &l[h.Sum32()%mapKeyLockStripes]
+	m.Lock()
+	return m.Unlock
+}
+
+// MapGet returns the current value of the mapKey in the map of the rowKey,
+// ok is false if there is no such entry or it was deleted
+func (b *Bucket) MapGet(rowKey, mapKey []byte) (value []byte, ok bool, err error) {
+	if b.strategy != StrategyMapCollection {
+		return nil, false, errors.Errorf("MapGet only possible with strategy %q",
+			StrategyMapCollection)
+	}
+
+	pairs, err := b.MapList(rowKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, pair := range pairs {
+		if bytes.Equal(pair.Key, mapKey) {
+			return pair.Value, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// MapSetIf sets the entry kv in the map of the rowKey if cond holds for its
+// current value, ok is false if the entry does not exist yet. It returns
+// whether the entry was set. Reading the current value and setting the new
+// one is atomic with respect to any other MapSetIf or MapUpdate of the same
+// entry, so e.g. a counter stored in a map does not need a lock of its own.
+// Writes through MapSet, MapSetMulti or MapDeleteKey are not serialized
+// with it, all writers of an entry which must be updated atomically have to
+// go through MapSetIf or MapUpdate.
+func (b *Bucket) MapSetIf(rowKey []byte, kv MapPair,
+	cond func(current []byte, ok bool) bool) (bool, error) {
+	unlock := b.mapLocks.lock(rowKey, kv.Key)
+	defer unlock()
+
+	current, ok, err := b.MapGet(rowKey, kv.Key)
+	if err != nil {
+		return false, errors.Wrap(err, "read current value")
+	}
+
+	if !cond(current, ok) {
+		return false, nil
+	}
+
+	if err := b.MapSet(rowKey, kv); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// MapUpdate replaces the value of the mapKey in the map of the rowKey with
+// the one returned by update for its current value, ok is false if the entry
+// does not exist yet. If update returns a nil value the entry is deleted
+// instead. It is atomic with respect to any other MapSetIf or MapUpdate of
+// the same entry, see MapSetIf. The new value is returned.
+func (b *Bucket) MapUpdate(rowKey, mapKey []byte,
+	update func(current []byte, ok bool) ([]byte, error)) ([]byte, error) {
+	unlock := b.mapLocks.lock(rowKey, mapKey)
+	defer unlock()
+
+	current, ok, err := b.MapGet(rowKey, mapKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "read current value")
+	}
+
+	next, err := update(current, ok)
+	if err != nil {
+		return nil, err
+	}
+
+	if next == nil {
+		if !ok {
+			return nil, nil
+		}
+		return nil, b.MapDeleteKey(rowKey, mapKey)
+	}
+
+	if err := b.MapSet(rowKey, MapPair{Key: mapKey, Value: next}); err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapCollectionStrategy_AtomicUpdates(t *testing.T) {
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	b, err := NewBucket(testCtx(), dirName, nullLogger(),
+		WithStrategy(StrategyMapCollection))
+	require.Nil(t, err)
+	defer b.Shutdown(testCtx())
+
+	// so big it effectively never triggers as part of this test
+	b.SetMemtableThreshold(1e9)
+
+	rowKey := []byte("row")
+
+	t.Run("set if the entry does not exist", func(t *testing.T) {
+		absent := func(current []byte, ok bool) bool { return !ok }
+
+		set, err := b.MapSetIf(rowKey, MapPair{Key: []byte("a"), Value: []byte("1")}, absent)
+		require.Nil(t, err)
+		assert.True(t, set)
+
+		set, err = b.MapSetIf(rowKey, MapPair{Key: []byte("a"), Value: []byte("2")}, absent)
+		require.Nil(t, err)
+		assert.False(t, set)
+
+		value, ok, err := b.MapGet(rowKey, []byte("a"))
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []byte("1"), value)
+	})
+
+	t.Run("compare and set after a flush", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+
+		equals := func(expected string) func([]byte, bool) bool {
+			return func(current []byte, ok bool) bool {
+				return ok && string(current) == expected
+			}
+		}
+
+		set, err := b.MapSetIf(rowKey, MapPair{Key: []byte("a"), Value: []byte("3")}, equals("2"))
+		require.Nil(t, err)
+		assert.False(t, set)
+
+		set, err = b.MapSetIf(rowKey, MapPair{Key: []byte("a"), Value: []byte("3")}, equals("1"))
+		require.Nil(t, err)
+		assert.True(t, set)
+
+		value, _, err := b.MapGet(rowKey, []byte("a"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("3"), value)
+	})
+
+	t.Run("concurrent increments of a counter", func(t *testing.T) {
+		increment := func(current []byte, ok bool) ([]byte, error) {
+			count := uint64(0)
+			if ok {
+				count = binary.LittleEndian.Uint64(current)
+			}
+			out := make([]byte, 8)
+			binary.LittleEndian.PutUint64(out, count+1)
+			return out, nil
+		}
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_, err := b.MapUpdate(rowKey, []byte("counter"), increment)
+					assert.Nil(t, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		value, ok, err := b.MapGet(rowKey, []byte("counter"))
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(1000), binary.LittleEndian.Uint64(value))
+	})
+
+	t.Run("delete an entry through an update", func(t *testing.T) {
+		_, err := b.MapUpdate(rowKey, []byte("a"), func([]byte, bool) ([]byte, error) {
+			return nil, nil
+		})
+		require.Nil(t, err)
+
+		_, ok, err := b.MapGet(rowKey, []byte("a"))
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -11,7 +11,11 @@
 
 package lsmkv
 
-import "github.com/pkg/errors"
+import (
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
+)
 
 type BucketOption func(b *Bucket) error
 
@@ -31,6 +35,7 @@ func WithStrategy(strategy string) BucketOption {
 func WithMemtableThreshold(threshold uint64) BucketOption {
 	return func(b *Bucket) error {
 		b.memTableThreshold = threshold
+		b.fixedThreshold = true
 		return nil
 	}
 }
@@ -42,6 +47,75 @@ func WithSecondaryIndicies(count uint16) BucketOption {
 	}
 }
 
+// withFlushScheduler makes the bucket share the flush slots of its store
+func withFlushScheduler(s *flushScheduler) BucketOption {
+	return func(b *Bucket) error {
+		b.flushes = s
+		return nil
+	}
+}
+
+// withHandleBudget makes the disk segments of the bucket count against the
+// handle budget of its store
+func withHandleBudget(h *HandleBudget) BucketOption {
+	return func(b *Bucket) error {
+		b.handles = h
+		return nil
+	}
+}
+
+// withMemtableBudget makes the memtable threshold of the bucket a share of
+// the memtable budget of its store
+func withMemtableBudget(m *MemtableBudget) BucketOption {
+	return func(b *Bucket) error {
+		b.memtables = m
+		return nil
+	}
+}
+
+// withIOThrottle makes the compactions of the bucket use the disk throughput
+// budget of its store
+func withIOThrottle(t *iothrottle.Throttle) BucketOption {
+	return func(b *Bucket) error {
+		b.throttle = t
+		return nil
+	}
+}
+
+// withEncryption makes the bucket encrypt its segments and write-ahead logs
+// with the cipher of its store
+func withEncryption(c *encryption.Cipher) BucketOption {
+	return func(b *Bucket) error {
+		b.cipher = c
+		return nil
+	}
+}
+
+// WithCompression makes the bucket write its segments in blocks compressed
+// with the algorithm, one of CompressionNone, CompressionSnappy or
+// CompressionZstd. Existing segments are read regardless of their
+// compression and only take on the one of the bucket once they are
+// compacted.
+func WithCompression(algorithm string) BucketOption {
+	return func(b *Bucket) error {
+		if _, _, err := compressionAlgorithmFromString(algorithm); err != nil {
+			return err
+		}
+
+		b.compression = algorithm
+		return nil
+	}
+}
+
+// withCompactions makes the bucket compact its segments according to the
+// policy of its store
+func withCompactions(c *Compactions) BucketOption {
+	return func(b *Bucket) error {
+		b.compactions = c
+		return nil
+	}
+}
+
 type secondaryIndexKeys [][]byte
 
 type SecondaryKeyOption func(s secondaryIndexKeys) error
@@ -17,10 +17,11 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 )
 
 type commitLogger struct {
-	file   *os.File
+	file   *segmentFile
 	writer *bufio.Writer
 	path   string
 
@@ -39,12 +40,12 @@ const (
 	CommitTypeCollection
 )
 
-func newCommitLogger(path string) (*commitLogger, error) {
+func newCommitLogger(path string, cipher *encryption.Cipher) (*commitLogger, error) {
 	out := &commitLogger{
 		path: path + ".wal",
 	}
 
-	f, err := os.Create(out.path)
+	f, err := createSegmentFile(out.path, cipher)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +99,7 @@ func (cl *commitLogger) close() error {
 		return err
 	}
 
-	return cl.file.Close()
+	return cl.file.close()
 }
 
 func (cl *commitLogger) pause() {
@@ -114,5 +115,9 @@ func (cl *commitLogger) delete() error {
 }
 
 func (cl *commitLogger) flushBuffers() error {
-	return cl.writer.Flush()
+	if err := cl.writer.Flush(); err != nil {
+		return err
+	}
+
+	return cl.file.flush()
 }
@@ -18,6 +18,7 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 )
 
 type commitloggerParser struct {
@@ -36,7 +37,12 @@ func (p *commitloggerParser) Do() error {
 		return err
 	}
 
-	p.reader = bufio.NewReader(f)
+	plain, err := encryption.NewLogReader(f, p.memtable.cipher)
+	if err != nil {
+		return errors.Wrap(err, "read commit log")
+	}
+
+	p.reader = bufio.NewReader(plain)
 
 	for {
 		var commitType CommitType
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -629,6 +630,74 @@ func Test_CompactionReplaceStrategy_RemoveUnnecessaryUpdates(t *testing.T) {
 	})
 }
 
+func Test_CompactionReplaceStrategy_OpenCursor(t *testing.T) {
+	// a cursor which is opened before a compaction keeps reading from the
+	// segments it was opened on, they are only deleted once it is closed
+	size := 4
+
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	bucket, err := NewBucket(testCtx(), dirName, nullLogger(), WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+
+	// so big it effectively never triggers as part of this test
+	bucket.SetMemtableThreshold(1e9)
+
+	obsoleteFiles := func() []string {
+		matches, err := filepath.Glob(filepath.Join(dirName, "*"+obsoleteSegmentExt))
+		require.Nil(t, err)
+		return matches
+	}
+
+	t.Run("write segments", func(t *testing.T) {
+		for i := 0; i < size; i++ {
+			key := []byte(fmt.Sprintf("key-%d", i))
+			require.Nil(t, bucket.Put(key, []byte(fmt.Sprintf("value-%d", i))))
+			require.Nil(t, bucket.FlushAndSwitch())
+		}
+	})
+
+	c := bucket.Cursor()
+
+	t.Run("compact while the cursor is open", func(t *testing.T) {
+		require.True(t, bucket.disk.eligbleForCompaction())
+		for bucket.disk.eligbleForCompaction() {
+			require.Nil(t, bucket.disk.compactOnce())
+		}
+
+		assert.Len(t, bucket.disk.segments, 1)
+		assert.Len(t, obsoleteFiles(), size)
+	})
+
+	t.Run("the cursor reads from the old segments", func(t *testing.T) {
+		i := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, []byte(fmt.Sprintf("key-%d", i)), k)
+			assert.Equal(t, []byte(fmt.Sprintf("value-%d", i)), v)
+			i++
+		}
+		assert.Equal(t, size, i)
+	})
+
+	t.Run("closing the cursor deletes the old segments", func(t *testing.T) {
+		c.Close()
+		assert.Len(t, obsoleteFiles(), 0)
+	})
+
+	t.Run("the compacted segment contains all keys", func(t *testing.T) {
+		for i := 0; i < size; i++ {
+			v, err := bucket.Get([]byte(fmt.Sprintf("key-%d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("value-%d", i)), v)
+		}
+	})
+}
+
 func Test_CompactionSetStrategy(t *testing.T) {
 	size := 30
 
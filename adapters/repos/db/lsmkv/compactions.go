@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"sync"
+	"time"
+)
+
+// CompactionPolicy decides when the disk segments of a bucket are compacted.
+// Only adjacent segments of the same level are merged, the merged segment
+// has the next level.
+type CompactionPolicy struct {
+	// Interval is how often every bucket checks for segments to compact
+	Interval time.Duration
+
+	// MinSegments is the number of adjacent segments of the same level which
+	// triggers a compaction, it must be at least 2
+	MinSegments int
+
+	// MaxSegments limits how many segments are merged into one at once, more
+	// segments mean fewer, but longer compactions
+	MaxSegments int
+}
+
+const (
+	DefaultCompactionInterval    = 3 * time.Second
+	DefaultCompactionMinSegments = 2
+	DefaultCompactionMaxSegments = 4
+)
+
+// DefaultCompactionPolicy is used by buckets which are not part of a store
+// with a policy of its own, see WithCompactions
+func DefaultCompactionPolicy() CompactionPolicy {
+	return CompactionPolicy{
+		Interval:    DefaultCompactionInterval,
+		MinSegments: DefaultCompactionMinSegments,
+		MaxSegments: DefaultCompactionMaxSegments,
+	}
+}
+
+// Compactions is shared by all stores of a node. It holds the policy by
+// which their buckets compact their segments and allows pausing all
+// compactions at once, e.g. during a period of heavy load. This is
+// independent of pausing the compactions of a single store, see
+// Store.PauseCompaction.
+type Compactions struct {
+	policy CompactionPolicy
+
+	// lock is held for reading by every running compaction, so pausing can
+	// wait for them to complete
+	lock   sync.RWMutex
+	paused bool
+
+	statsLock sync.Mutex
+	stats     CompactionStats
+}
+
+// CompactionStats describes the compactions of all stores sharing the same
+// Compactions since startup
+type CompactionStats struct {
+	Paused bool `json:"paused"`
+	// Completed is the number of compactions which have completed
+	Completed int64 `json:"completed"`
+	// MergedSegments is the number of segments which were merged into others
+	MergedSegments int64 `json:"mergedSegments"`
+	// Emptied is the number of compactions which did not produce a new
+	// segment, because everything in the merged segments had been deleted
+	Emptied int64 `json:"emptied"`
+	// Failed is the number of compactions which failed
+	Failed int64 `json:"failed"`
+}
+
+// NewCompactions uses the defaults for any unset parts of the policy
+func NewCompactions(policy CompactionPolicy) *Compactions {
+	defaults := DefaultCompactionPolicy()
+	if policy.Interval <= 0 {
+		policy.Interval = defaults.Interval
+	}
+	if policy.MinSegments <= 0 {
+		policy.MinSegments = defaults.MinSegments
+	}
+	if policy.MaxSegments <= 0 {
+		policy.MaxSegments = defaults.MaxSegments
+	}
+	if policy.MaxSegments < policy.MinSegments {
+		policy.MaxSegments = policy.MinSegments
+	}
+
+	return &Compactions{policy: policy}
+}
+
+// Policy returns the default policy if c is nil
+func (c *Compactions) Policy() CompactionPolicy {
+	if c == nil {
+		return DefaultCompactionPolicy()
+	}
+
+	return c.policy
+}
+
+// Pause blocks until all running compactions have completed and prevents new
+// ones from starting until Resume is called
+func (c *Compactions) Pause() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.paused = true
+}
+
+func (c *Compactions) Resume() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.paused = false
+}
+
+func (c *Compactions) Stats() CompactionStats {
+	c.statsLock.Lock()
+	stats := c.stats
+	c.statsLock.Unlock()
+
+	c.lock.RLock()
+	stats.Paused = c.paused
+	c.lock.RUnlock()
+
+	return stats
+}
+
+// begin returns false if compactions are paused. Otherwise the compaction
+// may run until end is called. Both, like record, are no-ops if c is nil.
+func (c *Compactions) begin() bool {
+	if c == nil {
+		return true
+	}
+
+	c.lock.RLock()
+	if c.paused {
+		c.lock.RUnlock()
+		return false
+	}
+
+	return true
+}
+
+// end allows pausing again once all compactions started with begin have
+// ended
+func (c *Compactions) end() {
+	if c == nil {
+		return
+	}
+
+	c.lock.RUnlock()
+}
+
+// record adds the outcome of a compaction to the stats. merged is the number
+// of segments which were merged, emptied is set if no new segment had to be
+// written.
+func (c *Compactions) record(merged int, emptied bool, err error) {
+	if c == nil {
+		return
+	}
+
+	c.statsLock.Lock()
+	defer c.statsLock.Unlock()
+
+	if err != nil {
+		c.stats.Failed++
+		return
+	}
+
+	c.stats.Completed++
+	c.stats.MergedSegments += int64(merged)
+	if emptied {
+		c.stats.Emptied++
+	}
+}
@@ -0,0 +1,257 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactionOfMultipleSegments(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+
+	newBucket := func(t *testing.T, strategy string,
+		compactions *Compactions) (*Bucket, func()) {
+		dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+		os.MkdirAll(dirName, 0o777)
+
+		b, err := NewBucket(testCtx(), dirName, nullLogger(),
+			WithStrategy(strategy), withCompactions(compactions))
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+
+		return b, func() {
+			require.Nil(t, b.Shutdown(testCtx()))
+			os.RemoveAll(dirName)
+		}
+	}
+
+	levels := func(b *Bucket) []uint16 {
+		var out []uint16
+		for _, seg := range b.disk.segments {
+			out = append(out, seg.level)
+		}
+		return out
+	}
+
+	// the cycle never runs as part of these tests, they compact explicitly
+	policy := CompactionPolicy{Interval: time.Hour, MinSegments: 3, MaxSegments: 4}
+
+	t.Run("replace: the oldest segments are merged with deletions applied", func(t *testing.T) {
+		compactions := NewCompactions(policy)
+		b, cleanup := newBucket(t, StrategyReplace, compactions)
+		defer cleanup()
+
+		// every segment overwrites key-0, adds a key of its own and deletes the
+		// key of the previous segment
+		for i := 0; i < 4; i++ {
+			require.Nil(t, b.Put([]byte("key-0"), []byte(fmt.Sprintf("value-%d", i))))
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i+1)), []byte("value")))
+			if i > 0 {
+				require.Nil(t, b.Delete([]byte(fmt.Sprintf("key-%d", i))))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+
+			if i == 1 {
+				assert.False(t, b.disk.eligbleForCompaction(),
+					"two segments do not trigger a compaction")
+			}
+		}
+
+		require.True(t, b.disk.eligbleForCompaction())
+		require.Nil(t, b.disk.compactOnce())
+		assert.Equal(t, []uint16{1}, levels(b))
+
+		value, err := b.Get([]byte("key-0"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value-3"), value)
+
+		for i := 1; i < 4; i++ {
+			value, err := b.Get([]byte(fmt.Sprintf("key-%d", i)))
+			require.Nil(t, err)
+			assert.Nil(t, value)
+		}
+
+		value, err = b.Get([]byte("key-4"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		// neither superseded values nor tombstones are left
+		seg := b.disk.segments[0]
+		count := 0
+		cursor := seg.newCursor()
+		for node, err := cursor.firstWithAllKeys(); node.primaryKey != nil; node, err = cursor.nextWithAllKeys() {
+			assert.Nil(t, err)
+			count++
+		}
+		assert.Equal(t, 2, count)
+
+		assert.Equal(t, CompactionStats{Completed: 1, MergedSegments: 4},
+			compactions.Stats())
+	})
+
+	t.Run("replace: tombstones are kept if there are older segments", func(t *testing.T) {
+		b, cleanup := newBucket(t, StrategyReplace, NewCompactions(policy))
+		defer cleanup()
+
+		for i := 0; i < 3; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+			require.Nil(t, b.FlushAndSwitch())
+		}
+		require.Nil(t, b.disk.compactOnce())
+
+		// a run of segments of level 0 after the compacted one
+		require.Nil(t, b.Delete([]byte("key-0")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("key-3"), []byte("value")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("key-4"), []byte("value")))
+		require.Nil(t, b.FlushAndSwitch())
+		assert.Equal(t, []uint16{1, 0, 0, 0}, levels(b))
+
+		require.Nil(t, b.disk.compactOnce())
+		assert.Equal(t, []uint16{1, 1}, levels(b))
+
+		value, err := b.Get([]byte("key-0"))
+		require.Nil(t, err)
+		assert.Nil(t, value, "the tombstone must still hide the older value")
+
+		for i := 1; i < 5; i++ {
+			value, err := b.Get([]byte(fmt.Sprintf("key-%d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, []byte("value"), value)
+		}
+	})
+
+	t.Run("replace: nothing is written if everything was deleted", func(t *testing.T) {
+		compactions := NewCompactions(policy)
+		b, cleanup := newBucket(t, StrategyReplace, compactions)
+		defer cleanup()
+
+		require.Nil(t, b.Put([]byte("key-0"), []byte("value")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("key-1"), []byte("value")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Delete([]byte("key-0")))
+		require.Nil(t, b.Delete([]byte("key-1")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.Nil(t, b.disk.compactOnce())
+		assert.Len(t, b.disk.segments, 0)
+		assert.Equal(t, CompactionStats{Completed: 1, MergedSegments: 3, Emptied: 1},
+			compactions.Stats())
+
+		value, err := b.Get([]byte("key-0"))
+		require.Nil(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("set: values are deduplicated and deletions applied", func(t *testing.T) {
+		b, cleanup := newBucket(t, StrategySetCollection, NewCompactions(policy))
+		defer cleanup()
+
+		require.Nil(t, b.SetAdd([]byte("key"), [][]byte{[]byte("a"), []byte("b")}))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.SetAdd([]byte("key"), [][]byte{[]byte("b"), []byte("c")}))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.SetDeleteSingle([]byte("key"), []byte("a")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.Nil(t, b.disk.compactOnce())
+		assert.Equal(t, []uint16{1}, levels(b))
+
+		values, err := b.SetList([]byte("key"))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, [][]byte{[]byte("b"), []byte("c")}, values)
+
+		cursor := b.disk.segments[0].newCollectionCursor()
+		_, raw, err := cursor.first()
+		require.Nil(t, err)
+		assert.Len(t, raw, 2, "neither duplicates nor tombstones are left")
+	})
+
+	t.Run("map: superseded entries are dropped and deletions applied", func(t *testing.T) {
+		b, cleanup := newBucket(t, StrategyMapCollection, NewCompactions(policy))
+		defer cleanup()
+
+		for i := 0; i < 3; i++ {
+			require.Nil(t, b.MapSet([]byte("row"), MapPair{
+				Key:   []byte("a"),
+				Value: []byte(fmt.Sprintf("a-%d", i)),
+			}))
+			require.Nil(t, b.MapSet([]byte("row"), MapPair{
+				Key:   []byte(fmt.Sprintf("b-%d", i)),
+				Value: []byte("b"),
+			}))
+			if i > 0 {
+				require.Nil(t, b.MapDeleteKey([]byte("row"),
+					[]byte(fmt.Sprintf("b-%d", i-1))))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+		}
+
+		require.Nil(t, b.disk.compactOnce())
+		assert.Equal(t, []uint16{1}, levels(b))
+
+		pairs, err := b.MapList([]byte("row"))
+		require.Nil(t, err)
+		assert.Equal(t, []MapPair{
+			{Key: []byte("a"), Value: []byte("a-2")},
+			{Key: []byte("b-2"), Value: []byte("b")},
+		}, pairs)
+
+		cursor := b.disk.segments[0].newCollectionCursor()
+		_, raw, err := cursor.first()
+		require.Nil(t, err)
+		assert.Len(t, raw, 2)
+	})
+
+	t.Run("paused compactions do not run", func(t *testing.T) {
+		compactions := NewCompactions(CompactionPolicy{
+			Interval:    10 * time.Millisecond,
+			MinSegments: 2,
+			MaxSegments: 2,
+		})
+		compactions.Pause()
+
+		b, cleanup := newBucket(t, StrategyReplace, compactions)
+		defer cleanup()
+
+		for i := 0; i < 2; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+			require.Nil(t, b.FlushAndSwitch())
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		b.disk.maintenanceLock.RLock()
+		assert.Len(t, b.disk.segments, 2)
+		b.disk.maintenanceLock.RUnlock()
+
+		compactions.Resume()
+		assert.Eventually(t, func() bool {
+			b.disk.maintenanceLock.RLock()
+			defer b.disk.maintenanceLock.RUnlock()
+			return len(b.disk.segments) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
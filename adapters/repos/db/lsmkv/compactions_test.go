@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactionsPolicy(t *testing.T) {
+	t.Run("without compactions the default policy is used", func(t *testing.T) {
+		var c *Compactions
+		assert.Equal(t, DefaultCompactionPolicy(), c.Policy())
+	})
+
+	t.Run("unset parts of the policy are defaulted", func(t *testing.T) {
+		c := NewCompactions(CompactionPolicy{MinSegments: 6})
+		assert.Equal(t, CompactionPolicy{
+			Interval:    DefaultCompactionInterval,
+			MinSegments: 6,
+			MaxSegments: 6,
+		}, c.Policy())
+	})
+}
+
+func TestCompactionsPause(t *testing.T) {
+	c := NewCompactions(CompactionPolicy{})
+
+	// a running compaction
+	assert.True(t, c.begin())
+
+	paused := make(chan struct{})
+	go func() {
+		c.Pause()
+		close(paused)
+	}()
+
+	select {
+	case <-paused:
+		t.Fatal("pause must wait for the running compaction")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	c.end()
+	<-paused
+
+	assert.True(t, c.Stats().Paused)
+	assert.False(t, c.begin(), "no compaction can start while paused")
+
+	c.Resume()
+	assert.False(t, c.Stats().Paused)
+	assert.True(t, c.begin())
+	c.end()
+}
+
+func TestCompactionsStats(t *testing.T) {
+	c := NewCompactions(CompactionPolicy{})
+	c.record(4, false, nil)
+	c.record(2, true, nil)
+	c.record(3, false, assert.AnError)
+
+	assert.Equal(t, CompactionStats{
+		Completed:      2,
+		MergedSegments: 6,
+		Emptied:        1,
+		Failed:         1,
+	}, c.Stats())
+
+	// nil compactions are used by buckets without a store
+	var none *Compactions
+	assert.True(t, none.begin())
+	none.end()
+	none.record(2, false, nil)
+}
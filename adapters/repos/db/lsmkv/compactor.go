@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+// compactor merges the segments of a compaction into a single one, see
+// SegmentGroup.compactOnce. All compactors receive the cursors of the
+// segments ordered from the oldest to the newest, so when there is a conflict
+// the newest one wins. With cleanup set, which is only safe if the oldest
+// segment of the bucket is among them, deletions are applied and not written
+// to the new segment. A collection key whose values were all deleted is
+// still written without any values, so cursors return the same before and
+// after a compaction.
+type compactor interface {
+	// do returns false if nothing was left to write
+	do() (bool, error)
+}
+
+// mergeCollectionCursors calls fn for every key present in any of the
+// cursors in ascending order. The values of all cursors containing the key
+// are passed in the order of the cursors.
+func mergeCollectionCursors(cursors []*segmentCursorCollection,
+	fn func(key []byte, values []value) error) error {
+	keys := make([][]byte, len(cursors))
+	values := make([][]value, len(cursors))
+
+	for i, c := range cursors {
+		key, vals, err := c.first()
+		if err != nil && err != NotFound {
+			return errors.Wrapf(err, "read first key of segment %d", i)
+		}
+		keys[i], values[i] = key, vals
+	}
+
+	for {
+		var smallest []byte
+		for _, key := range keys {
+			if key != nil && (smallest == nil || bytes.Compare(key, smallest) < 0) {
+				smallest = key
+			}
+		}
+
+		if smallest == nil {
+			return nil
+		}
+
+		var merged []value
+		for i, key := range keys {
+			if bytes.Equal(key, smallest) {
+				merged = append(merged, values[i]...)
+			}
+		}
+
+		if err := fn(smallest, merged); err != nil {
+			return err
+		}
+
+		for i, c := range cursors {
+			if !bytes.Equal(keys[i], smallest) {
+				continue
+			}
+
+			key, vals, err := c.next()
+			if err != nil && err != NotFound {
+				return errors.Wrapf(err, "read next key of segment %d", i)
+			}
+			keys[i], values[i] = key, vals
+		}
+	}
+}
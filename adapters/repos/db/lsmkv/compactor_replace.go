@@ -20,61 +20,67 @@ import (
 )
 
 type compactorReplace struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorReplace
-	c2 *segmentCursorReplace
+	// cursors are ordered from the oldest to the newest segment, so when
+	// there is a conflict the later one wins (because of the replace strategy)
+	cursors []*segmentCursorReplace
 
 	// the level matching those of the cursors
 	currentLevel uint16
 
 	secondaryIndexCount uint16
 
+	// cleanup drops deleted keys instead of writing their tombstones
+	cleanup bool
+
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
 }
 
 func newCompactorReplace(w io.WriteSeeker,
-	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
-	scratchSpacePath string) *compactorReplace {
+	cursors []*segmentCursorReplace, level, secondaryIndexCount uint16,
+	scratchSpacePath string, cleanup bool) *compactorReplace {
 	return &compactorReplace{
-		c1:                  c1,
-		c2:                  c2,
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
+		cleanup:             cleanup,
 		scratchSpacePath:    scratchSpacePath,
 	}
 }
 
-func (c *compactorReplace) do() error {
+func (c *compactorReplace) do() (bool, error) {
 	if err := c.init(); err != nil {
-		return errors.Wrap(err, "init")
+		return false, errors.Wrap(err, "init")
 	}
 
 	kis, err := c.writeKeys()
 	if err != nil {
-		return errors.Wrap(err, "write keys")
+		return false, errors.Wrap(err, "write keys")
+	}
+
+	if len(kis) == 0 {
+		return false, nil
 	}
 
 	if err := c.writeIndices(kis); err != nil {
-		return errors.Wrap(err, "write indices")
+		return false, errors.Wrap(err, "write indices")
 	}
 
 	// flush buffered, so we can safely seek on underlying writer
 	if err := c.bufw.Flush(); err != nil {
-		return errors.Wrap(err, "flush buffered")
+		return false, errors.Wrap(err, "flush buffered")
 	}
 
 	dataEnd := uint64(kis[len(kis)-1].valueEnd)
 
 	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount, dataEnd); err != nil {
-		return errors.Wrap(err, "write header")
+		return false, errors.Wrap(err, "write header")
 	}
 
-	return nil
+	return true, nil
 }
 
 func (c *compactorReplace) init() error {
@@ -90,8 +96,15 @@ func (c *compactorReplace) init() error {
 }
 
 func (c *compactorReplace) writeKeys() ([]keyIndex, error) {
-	res1, err1 := c.c1.firstWithAllKeys()
-	res2, err2 := c.c2.firstWithAllKeys()
+	nodes := make([]segmentReplaceNode, len(c.cursors))
+	deleted := make([]bool, len(c.cursors))
+	for i, cursor := range c.cursors {
+		node, err := cursor.firstWithAllKeys()
+		if err != nil && err != Deleted && err != NotFound {
+			return nil, errors.Wrapf(err, "read first key of segment %d", i)
+		}
+		nodes[i], deleted[i] = node, err == Deleted
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := SegmentHeaderSize
@@ -99,48 +112,48 @@ func (c *compactorReplace) writeKeys() ([]keyIndex, error) {
 	var kis []keyIndex
 
 	for {
-		if res1.primaryKey == nil && res2.primaryKey == nil {
-			break
-		}
-		if bytes.Equal(res1.primaryKey, res2.primaryKey) {
-			ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
-				res2.secondaryKeys, err2 == Deleted)
-			if err != nil {
-				return nil, errors.Wrap(err, "write individual node (equal keys)")
+		// the cursor with the smallest key, of those with the same key the
+		// newest one
+		newest := -1
+		for i := range nodes {
+			if nodes[i].primaryKey == nil {
+				continue
 			}
 
-			offset = ki.valueEnd
-			kis = append(kis, ki)
+			if newest == -1 ||
+				bytes.Compare(nodes[i].primaryKey, nodes[newest].primaryKey) <= 0 {
+				newest = i
+			}
+		}
 
-			// advance both!
-			res1, err1 = c.c1.nextWithAllKeys()
-			res2, err2 = c.c2.nextWithAllKeys()
-			continue
+		if newest == -1 {
+			break
 		}
 
-		if (res1.primaryKey != nil && bytes.Compare(res1.primaryKey, res2.primaryKey) == -1) || res2.primaryKey == nil {
-			// key 1 is smaller
-			ki, err := c.writeIndividualNode(offset, res1.primaryKey, res1.value,
-				res1.secondaryKeys, err1 == Deleted)
+		key := nodes[newest].primaryKey
+		if !(c.cleanup && deleted[newest]) {
+			ki, err := c.writeIndividualNode(offset, key, nodes[newest].value,
+				nodes[newest].secondaryKeys, deleted[newest])
 			if err != nil {
-				return nil, errors.Wrap(err, "write individual node (res1.primaryKey smaller)")
+				return nil, errors.Wrap(err, "write individual node")
 			}
 
 			offset = ki.valueEnd
 			kis = append(kis, ki)
-			res1, err1 = c.c1.nextWithAllKeys()
-		} else {
-			// key 2 is smaller
-			ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
-				res2.secondaryKeys, err2 == Deleted)
-			if err != nil {
-				return nil, errors.Wrap(err, "write individual node (res2.primaryKey smaller)")
-			}
+		}
 
-			offset = ki.valueEnd
-			kis = append(kis, ki)
+		// advance all cursors with the same key, the older values are
+		// superseded
+		for i, cursor := range c.cursors {
+			if nodes[i].primaryKey == nil || !bytes.Equal(nodes[i].primaryKey, key) {
+				continue
+			}
 
-			res2, err2 = c.c2.nextWithAllKeys()
+			node, err := cursor.nextWithAllKeys()
+			if err != nil && err != Deleted && err != NotFound {
+				return nil, errors.Wrapf(err, "read next key of segment %d", i)
+			}
+			nodes[i], deleted[i] = node, err == Deleted
 		}
 	}
 
@@ -13,22 +13,23 @@ package lsmkv
 
 import (
 	"bufio"
-	"bytes"
 	"io"
 
 	"github.com/pkg/errors"
 )
 
 type compactorSet struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorCollection
-	c2 *segmentCursorCollection
+	// cursors are ordered from the oldest to the newest segment, so when
+	// there is a conflict the later one wins
+	cursors []*segmentCursorCollection
 
 	// the level matching those of the cursors
 	currentLevel        uint16
 	secondaryIndexCount uint16
 
+	// cleanup drops tombstones instead of writing them
+	cleanup bool
+
 	w    io.WriteSeeker
 	bufw *bufio.Writer
 
@@ -36,46 +37,50 @@ type compactorSet struct {
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
-	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
-	scratchSpacePath string) *compactorSet {
+	cursors []*segmentCursorCollection, level, secondaryIndexCount uint16,
+	scratchSpacePath string, cleanup bool) *compactorSet {
 	return &compactorSet{
-		c1:                  c1,
-		c2:                  c2,
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
+		cleanup:             cleanup,
 		scratchSpacePath:    scratchSpacePath,
 	}
 }
 
-func (c *compactorSet) do() error {
+func (c *compactorSet) do() (bool, error) {
 	if err := c.init(); err != nil {
-		return errors.Wrap(err, "init")
+		return false, errors.Wrap(err, "init")
 	}
 
 	kis, err := c.writeKeys()
 	if err != nil {
-		return errors.Wrap(err, "write keys")
+		return false, errors.Wrap(err, "write keys")
+	}
+
+	if len(kis) == 0 {
+		return false, nil
 	}
 
 	if err := c.writeIndices(kis); err != nil {
-		return errors.Wrap(err, "write index")
+		return false, errors.Wrap(err, "write index")
 	}
 
 	// flush buffered, so we can safely seek on underlying writer
 	if err := c.bufw.Flush(); err != nil {
-		return errors.Wrap(err, "flush buffered")
+		return false, errors.Wrap(err, "flush buffered")
 	}
 
 	dataEnd := uint64(kis[len(kis)-1].valueEnd)
 
 	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount,
 		dataEnd); err != nil {
-		return errors.Wrap(err, "write header")
+		return false, errors.Wrap(err, "write header")
 	}
 
-	return nil
+	return true, nil
 }
 
 func (c *compactorSet) init() error {
@@ -91,63 +96,46 @@ func (c *compactorSet) init() error {
 }
 
 func (c *compactorSet) writeKeys() ([]keyIndex, error) {
-	key1, value1, _ := c.c1.first()
-	key2, value2, _ := c.c2.first()
-
 	// the (dummy) header was already written, this is our initial offset
 	offset := SegmentHeaderSize
 
 	var kis []keyIndex
 
-	for {
-		if key1 == nil && key2 == nil {
-			break
-		}
-		if bytes.Equal(key1, key2) {
-			values := append(value1, value2...)
-			valuesMerged := newSetDecoder().DoPartial(values)
-
-			ki, err := c.writeIndividualNode(offset, key2, valuesMerged)
-			if err != nil {
-				return nil, errors.Wrap(err, "write individual node (equal keys)")
-			}
-
-			offset = ki.valueEnd
-			kis = append(kis, ki)
-
-			// advance both!
-			key1, value1, _ = c.c1.next()
-			key2, value2, _ = c.c2.next()
-			continue
+	err := mergeCollectionCursors(c.cursors, func(key []byte, values []value) error {
+		// this also removes duplicates within a single segment
+		merged := newSetDecoder().DoPartial(values)
+		if c.cleanup {
+			merged = withoutTombstones(merged)
 		}
 
-		if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
-			// key 1 is smaller
-			ki, err := c.writeIndividualNode(offset, key1, value1)
-			if err != nil {
-				return nil, errors.Wrap(err, "write individual node (key1 smaller)")
-			}
-
-			offset = ki.valueEnd
-			kis = append(kis, ki)
-			key1, value1, _ = c.c1.next()
-		} else {
-			// key 2 is smaller
-			ki, err := c.writeIndividualNode(offset, key2, value2)
-			if err != nil {
-				return nil, errors.Wrap(err, "write individual node (key2 smaller)")
-			}
-
-			offset = ki.valueEnd
-			kis = append(kis, ki)
-
-			key2, value2, _ = c.c2.next()
+		ki, err := c.writeIndividualNode(offset, key, merged)
+		if err != nil {
+			return errors.Wrap(err, "write individual node")
 		}
+
+		offset = ki.valueEnd
+		kis = append(kis, ki)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return kis, nil
 }
 
+// withoutTombstones filters the values in place
+func withoutTombstones(values []value) []value {
+	out := values[:0]
+	for _, v := range values {
+		if !v.tombstone {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
 func (c *compactorSet) writeIndividualNode(offset int, key []byte,
 	values []value) (keyIndex, error) {
 	return (&segmentCollectionNode{
@@ -22,6 +22,7 @@ type CursorReplace struct {
 	state        []cursorStateReplace
 	unlock       func()
 	serveCache   cursorStateReplace
+	err          error
 }
 
 type innerCursorReplace interface {
@@ -38,6 +39,10 @@ type cursorStateReplace struct {
 
 // Cursor holds a RLock for the flushing state. It needs to be closed using the
 // .Close() methods or otherwise the lock will never be relased
+//
+// If the segments of the bucket cannot be read, the cursor does not return
+// any keys and .Err() returns the reason. Readers need to check .Err() to
+// tell this apart from an empty bucket.
 func (b *Bucket) Cursor() *CursorReplace {
 	b.flushLock.RLock()
 
@@ -45,7 +50,13 @@ func (b *Bucket) Cursor() *CursorReplace {
 		panic("Cursor() called on strategy other than 'replace'")
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newCursors()
+	if err != nil {
+		return &CursorReplace{
+			unlock: b.flushLock.RUnlock,
+			err:    errors.Wrap(err, "open cursor"),
+		}
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -71,6 +82,12 @@ func (c *CursorReplace) Close() {
 	c.unlock()
 }
 
+// Err returns the error which prevented the cursor from reading the bucket,
+// if any
+func (c *CursorReplace) Err() error {
+	return c.err
+}
+
 func (c *CursorReplace) seekAll(target []byte) {
 	state := make([]cursorStateReplace, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
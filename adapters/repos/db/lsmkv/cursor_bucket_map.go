@@ -23,8 +23,11 @@ type CursorMap struct {
 	unlock       func()
 	listCfg      MapListOptionConfig
 	keyOnly      bool
+	err          error
 }
 
+// MapCursor holds a RLock for the flushing state just like Cursor, and
+// .Err() needs to be checked just the same.
 func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
 	b.flushLock.RLock()
 
@@ -33,7 +36,14 @@ func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
 		cfg(&c)
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newCollectionCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newCollectionCursors()
+	if err != nil {
+		return &CursorMap{
+			unlock:  b.flushLock.RUnlock,
+			listCfg: c,
+			err:     errors.Wrap(err, "open cursor"),
+		}
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -84,6 +94,12 @@ func (c *CursorMap) Close() {
 	c.unlock()
 }
 
+// Err returns the error which prevented the cursor from reading the bucket,
+// if any
+func (c *CursorMap) Err() error {
+	return c.err
+}
+
 func (c *CursorMap) seekAll(target []byte) {
 	state := make([]cursorStateCollection, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
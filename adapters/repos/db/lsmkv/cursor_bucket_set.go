@@ -22,6 +22,7 @@ type CursorSet struct {
 	state        []cursorStateCollection
 	unlock       func()
 	keyOnly      bool
+	err          error
 }
 
 type innerCursorCollection interface {
@@ -38,6 +39,9 @@ type cursorStateCollection struct {
 
 // SetCursor holds a RLock for the flushing state. It needs to be closed using the
 // .Close() methods or otherwise the lock will never be relased
+//
+// Just like for Cursor, .Err() needs to be checked to tell a bucket which
+// cannot be read apart from an empty one.
 func (b *Bucket) SetCursor() *CursorSet {
 	b.flushLock.RLock()
 
@@ -45,7 +49,13 @@ func (b *Bucket) SetCursor() *CursorSet {
 		panic("SetCursor() called on strategy other than 'set'")
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newCollectionCursors()
+	innerCursors, unlockSegmentGroup, err := b.disk.newCollectionCursors()
+	if err != nil {
+		return &CursorSet{
+			unlock: b.flushLock.RUnlock,
+			err:    errors.Wrap(err, "open cursor"),
+		}
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -97,6 +107,12 @@ func (c *CursorSet) Close() {
 	c.unlock()
 }
 
+// Err returns the error which prevented the cursor from reading the bucket,
+// if any
+func (c *CursorSet) Err() error {
+	return c.err
+}
+
 func (c *CursorSet) seekAll(target []byte) {
 	state := make([]cursorStateCollection, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
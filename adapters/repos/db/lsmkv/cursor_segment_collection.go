@@ -26,15 +26,23 @@ func (s *segment) newCollectionCursor() *segmentCursorCollection {
 	}
 }
 
-func (s *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func()) {
-	s.maintenanceLock.RLock()
-	out := make([]innerCursorCollection, len(s.segments))
+// newCollectionCursors pins the current segments just like newCursors
+func (s *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func(), error) {
+	if err := s.unavailableErr(); err != nil {
+		return nil, nil, err
+	}
+
+	segments, err := s.pinSegments()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for i, segment := range s.segments {
+	out := make([]innerCursorCollection, len(segments))
+	for i, segment := range segments {
 		out[i] = segment.newCollectionCursor()
 	}
 
-	return out, s.maintenanceLock.RUnlock
+	return out, func() { s.unpinSegments(segments) }, nil
 }
 
 func (s *segmentCursorCollection) seek(key []byte) ([]byte, []value, error) {
@@ -48,7 +56,7 @@ func (s *segmentCursorCollection) seek(key []byte) ([]byte, []value, error) {
 	}
 
 	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[node.Start:node.End])
+		node.Start, node.End)
 	if err != nil {
 		return parsed.primaryKey, nil, err
 	}
@@ -64,7 +72,7 @@ func (s *segmentCursorCollection) next() ([]byte, []value, error) {
 	}
 
 	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+		s.nextOffset, s.segment.dataEndPos)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -80,7 +88,7 @@ func (s *segmentCursorCollection) next() ([]byte, []value, error) {
 func (s *segmentCursorCollection) first() ([]byte, []value, error) {
 	s.nextOffset = s.segment.dataStartPos
 	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+		s.nextOffset, s.segment.dataEndPos)
 	if err != nil {
 		return parsed.primaryKey, nil, err
 	}
@@ -28,15 +28,25 @@ func (s *segment) newCursor() *segmentCursorReplace {
 	}
 }
 
-func (s *SegmentGroup) newCursors() ([]innerCursorReplace, func()) {
-	out := make([]innerCursorReplace, len(s.segments))
+// newCursors pins the current segments, so a compaction which completes
+// while the cursors are open does not unmap them. The returned func unpins
+// them again.
+func (s *SegmentGroup) newCursors() ([]innerCursorReplace, func(), error) {
+	if err := s.unavailableErr(); err != nil {
+		return nil, nil, err
+	}
+
+	segments, err := s.pinSegments()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	s.maintenanceLock.RLock()
-	for i, segment := range s.segments {
+	out := make([]innerCursorReplace, len(segments))
+	for i, segment := range segments {
 		out[i] = segment.newCursor()
 	}
 
-	return out, s.maintenanceLock.RUnlock
+	return out, func() { s.unpinSegments(segments) }, nil
 }
 
 func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
@@ -50,7 +60,7 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 	}
 
 	err = s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[node.Start:node.End], s.reusableNode)
+		node.Start, node.End, s.reusableNode)
 	if err != nil {
 		return s.reusableNode.primaryKey, nil, err
 	}
@@ -66,7 +76,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 	}
 
 	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+		s.nextOffset, s.segment.dataEndPos, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -82,7 +92,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 	s.nextOffset = s.segment.dataStartPos
 	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+		s.nextOffset, s.segment.dataEndPos, s.reusableNode)
 	if err != nil {
 		return s.reusableNode.primaryKey, nil, err
 	}
@@ -99,7 +109,7 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 	}
 
 	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+		s.nextOffset, s.segment.dataEndPos)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -115,7 +125,7 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
 	s.nextOffset = s.segment.dataStartPos
 	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+		s.nextOffset, s.segment.dataEndPos)
 	if err != nil {
 		return parsed, err
 	}
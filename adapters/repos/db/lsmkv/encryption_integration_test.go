@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedStore(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	cipher, err := encryption.New(bytes.Repeat([]byte{7}, 32))
+	require.Nil(t, err)
+
+	t.Run("writing a segment and a write-ahead log", func(t *testing.T) {
+		store, err := New(dirName, nullLogger(), WithEncryption(cipher))
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket",
+			WithStrategy(StrategyReplace)))
+
+		b := store.Bucket("bucket")
+		require.Nil(t, b.Put([]byte("flushed"), []byte("flushed-secret")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("logged"), []byte("logged-secret")))
+		require.Nil(t, b.WriteWAL())
+
+		// the store is not shut down, so the next one has to recover from the
+		// write-ahead log
+	})
+
+	t.Run("no file contains plain text", func(t *testing.T) {
+		files, err := filepath.Glob(filepath.Join(dirName, "bucket", "segment-*"))
+		require.Nil(t, err)
+		// the segment, its bloom filter, its checksums and the write-ahead log
+		require.Len(t, files, 4)
+
+		for _, file := range files {
+			contents, err := ioutil.ReadFile(file)
+			require.Nil(t, err)
+			// the checksums are calculated over the encrypted segment, they are
+			// not encrypted themselves
+			if filepath.Ext(file) != checksumExt {
+				assert.True(t, encryption.IsEncrypted(contents))
+			}
+			assert.False(t, bytes.Contains(contents, []byte("secret")))
+		}
+	})
+
+	t.Run("the files can not be read without the key", func(t *testing.T) {
+		store, err := New(dirName, nullLogger())
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "bucket",
+			WithStrategy(StrategyReplace))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("reading with the key", func(t *testing.T) {
+		store, err := New(dirName, nullLogger(), WithEncryption(cipher))
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket",
+			WithStrategy(StrategyReplace)))
+
+		b := store.Bucket("bucket")
+		value, err := b.Get([]byte("flushed"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("flushed-secret"), value)
+
+		value, err = b.Get([]byte("logged"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("logged-secret"), value)
+
+		require.Nil(t, store.Shutdown(testCtx()))
+	})
+}
+
+func TestEncryptedSegmentsAreDecryptedOnDemand(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	cipher, err := encryption.New(bytes.Repeat([]byte{7}, 32))
+	require.Nil(t, err)
+
+	// the values span many encrypted blocks
+	amount := 2000
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%05d", i)) }
+	value := func(i int) []byte { return bytes.Repeat([]byte{byte(i)}, 500) }
+
+	t.Run("writing a segment", func(t *testing.T) {
+		store, err := New(dirName, nullLogger(), WithEncryption(cipher))
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket",
+			WithStrategy(StrategyReplace), WithSecondaryIndicies(1)))
+
+		b := store.Bucket("bucket")
+		for i := 0; i < amount; i++ {
+			require.Nil(t, b.Put(key(i), value(i),
+				WithSecondaryKey(0, []byte(fmt.Sprintf("secondary-%05d", i)))))
+		}
+		require.Nil(t, store.Shutdown(testCtx()))
+	})
+
+	t.Run("reading the segment", func(t *testing.T) {
+		store, err := New(dirName, nullLogger(), WithEncryption(cipher))
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket",
+			WithStrategy(StrategyReplace), WithSecondaryIndicies(1)))
+
+		b := store.Bucket("bucket")
+		require.Len(t, b.disk.segments, 1)
+		seg := b.disk.segments[0]
+		assert.Nil(t, seg.contents, "segment must not be held on the heap")
+		assert.NotNil(t, seg.encrypted)
+
+		for _, i := range []int{0, 1, amount / 2, amount - 1} {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.Equal(t, value(i), v)
+
+			v, err = b.GetBySecondary(0, []byte(fmt.Sprintf("secondary-%05d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, value(i), v)
+		}
+
+		c := b.Cursor()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, key(count), k)
+			assert.Equal(t, value(count), v)
+			count++
+		}
+		c.Close()
+		assert.Equal(t, amount, count)
+
+		require.Nil(t, store.Shutdown(testCtx()))
+	})
+
+	t.Run("a segment which lost blocks at its end is not loaded", func(t *testing.T) {
+		paths, err := filepath.Glob(filepath.Join(dirName, "bucket", "segment-*.db"))
+		require.Nil(t, err)
+		require.Len(t, paths, 1)
+
+		info, err := os.Stat(paths[0])
+		require.Nil(t, err)
+		// drop the final block and the full block before it, an encrypted file
+		// has an 8 byte header and every block has an overhead of 28 bytes
+		diskBlockSize := int64(encryption.BlockSize + 28)
+		finalBlockSize := (info.Size() - 8) % diskBlockSize
+		require.Nil(t, os.Truncate(paths[0], info.Size()-finalBlockSize-diskBlockSize))
+		// without checksums, only the encryption can tell the file is incomplete
+		require.Nil(t, os.Remove(checksumPath(paths[0])))
+
+		store, err := New(dirName, nullLogger(), WithEncryption(cipher))
+		require.Nil(t, err)
+		err = store.CreateOrLoadBucket(testCtx(), "bucket",
+			WithStrategy(StrategyReplace), WithSecondaryIndicies(1))
+		assert.NotNil(t, err)
+	})
+}
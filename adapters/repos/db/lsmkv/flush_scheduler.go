@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import "sync"
+
+// defaultMaxConcurrentFlushes limits how many buckets of a store flush their
+// memtables at the same time
+const defaultMaxConcurrentFlushes = 2
+
+// flushScheduler limits the number of concurrent flushes of the buckets of a
+// store. If more buckets are waiting to flush than there are free slots, the
+// bucket with the most stalled writes goes first, ties are broken by the
+// order in which the buckets started waiting.
+type flushScheduler struct {
+	sync.Mutex
+	cond    *sync.Cond
+	max     int
+	running int
+	waiting []*Bucket
+}
+
+func newFlushScheduler(max int) *flushScheduler {
+	s := &flushScheduler{max: max}
+	s.cond = sync.NewCond(s)
+	return s
+}
+
+// acquire blocks until the bucket may flush, it must call release once the
+// flush has completed
+func (s *flushScheduler) acquire(b *Bucket) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.waiting = append(s.waiting, b)
+	for s.running >= s.max || s.next() != b {
+		s.cond.Wait()
+	}
+
+	for i, waiting := range s.waiting {
+		if waiting == b {
+			s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+			break
+		}
+	}
+	s.running++
+
+	// a slot may still be free for the next bucket in line
+	s.cond.Broadcast()
+}
+
+func (s *flushScheduler) release() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.running--
+	s.cond.Broadcast()
+}
+
+// next is the waiting bucket which is blocking the most writes. The number
+// of stalled writes can change at any time, so it is evaluated every time a
+// slot frees up.
+func (s *flushScheduler) next() *Bucket {
+	var out *Bucket
+	var maxStalled int64 = -1
+	for _, b := range s.waiting {
+		if stalled := b.stalledWrites(); stalled > maxStalled {
+			out, maxStalled = b, stalled
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlushScheduler(t *testing.T) {
+	s := newFlushScheduler(1)
+
+	blocking := &Bucket{}
+	calm := &Bucket{}
+	stalled := &Bucket{stalls: WriteStalls{Stalled: 3}}
+
+	// occupy the only slot, so the other buckets have to wait
+	s.acquire(blocking)
+
+	var order []*Bucket
+	var orderLock sync.Mutex
+	wg := sync.WaitGroup{}
+	for _, b := range []*Bucket{calm, stalled} {
+		wg.Add(1)
+		go func(b *Bucket) {
+			defer wg.Done()
+			s.acquire(b)
+			orderLock.Lock()
+			order = append(order, b)
+			orderLock.Unlock()
+			s.release()
+		}(b)
+	}
+
+	// wait for both buckets to queue up
+	for {
+		s.Lock()
+		waiting := len(s.waiting)
+		s.Unlock()
+		if waiting == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	s.release()
+	wg.Wait()
+
+	// the bucket blocking writes flushes first, regardless of the order in
+	// which they queued up
+	assert.Equal(t, []*Bucket{stalled, calm}, order)
+}
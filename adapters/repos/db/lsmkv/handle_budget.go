@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"container/list"
+	"sync"
+)
+
+// HandleBudget limits the number of disk segments which are mapped into
+// memory at the same time across all stores sharing the budget. Each mapped
+// segment counts against the OS limits of open files and memory maps, which
+// large deployments with thousands of buckets would otherwise run into.
+//
+// Once the budget is exceeded, the least recently used segments which are
+// not currently read from are unmapped. A segment is mapped again as soon as
+// it is read from the next time. A budget with a max of 0 or less does not
+// unmap any segments, but still keeps track of them.
+type HandleBudget struct {
+	sync.Mutex
+	max int
+
+	// lru contains the mapped segments, the most recently used first
+	lru      *list.List
+	elements map[*segment]*list.Element
+
+	reopens   int64
+	evictions int64
+}
+
+// HandleBudgetStats describes the current state of a HandleBudget
+type HandleBudgetStats struct {
+	// Open is the number of segments which are currently mapped
+	Open int
+	// Max is the number of segments which may be mapped at the same time, 0
+	// means unlimited
+	Max int
+	// Reopens is the number of times a segment had to be mapped again since
+	// startup, because it was unmapped to stay within the budget
+	Reopens int64
+	// Evictions is the number of times a segment was unmapped since startup
+	Evictions int64
+}
+
+func NewHandleBudget(max int) *HandleBudget {
+	if max < 0 {
+		max = 0
+	}
+
+	return &HandleBudget{
+		max:      max,
+		lru:      list.New(),
+		elements: map[*segment]*list.Element{},
+	}
+}
+
+// touch marks the segment as the most recently used one and unmaps the least
+// recently used segments if the budget is exceeded. Segments which are still
+// pinned cannot be unmapped, they stay in the list instead, so the budget may
+// be exceeded temporarily.
+func (h *HandleBudget) touch(seg *segment) {
+	h.Lock()
+	if elem, ok := h.elements[seg]; ok {
+		h.lru.MoveToFront(elem)
+	} else {
+		h.elements[seg] = h.lru.PushFront(seg)
+	}
+
+	var candidates []*segment
+	if h.max > 0 {
+		for elem := h.lru.Back(); elem != nil && h.lru.Len() > h.max; {
+			prev := elem.Prev()
+			if candidate := elem.Value.(*segment); candidate != seg {
+				h.lru.Remove(elem)
+				delete(h.elements, candidate)
+				candidates = append(candidates, candidate)
+			}
+			elem = prev
+		}
+	}
+	h.Unlock()
+
+	// the segments are unmapped without holding the lock of the budget, as
+	// each segment needs to be locked to check whether it is pinned and
+	// segments in turn call the budget while they are locked
+	for _, candidate := range candidates {
+		if !candidate.evict() {
+			h.readd(candidate)
+		}
+	}
+}
+
+// readd puts a segment which could not be unmapped back into the list. It is
+// treated as recently used, as it is still pinned.
+func (h *HandleBudget) readd(seg *segment) {
+	h.Lock()
+	defer h.Unlock()
+
+	if _, ok := h.elements[seg]; ok {
+		return
+	}
+
+	h.elements[seg] = h.lru.PushFront(seg)
+}
+
+// forget removes a segment which has been closed for good
+func (h *HandleBudget) forget(seg *segment) {
+	h.Lock()
+	defer h.Unlock()
+
+	if elem, ok := h.elements[seg]; ok {
+		h.lru.Remove(elem)
+		delete(h.elements, seg)
+	}
+}
+
+func (h *HandleBudget) countReopen() {
+	h.Lock()
+	defer h.Unlock()
+
+	h.reopens++
+}
+
+func (h *HandleBudget) countEviction() {
+	h.Lock()
+	defer h.Unlock()
+
+	h.evictions++
+}
+
+func (h *HandleBudget) Stats() HandleBudgetStats {
+	h.Lock()
+	defer h.Unlock()
+
+	return HandleBudgetStats{
+		Open:      h.lru.Len(),
+		Max:       h.max,
+		Reopens:   h.reopens,
+		Evictions: h.evictions,
+	}
+}
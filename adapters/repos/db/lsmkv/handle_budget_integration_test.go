@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleBudget(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	budget := NewHandleBudget(2)
+	store, err := New(dirName, nullLogger(), WithHandleBudget(budget))
+	require.Nil(t, err)
+
+	bucketNames := []string{"replace1", "replace2", "replace3", "set1", "set2"}
+
+	t.Run("write one segment per bucket", func(t *testing.T) {
+		for _, name := range bucketNames {
+			strategy := StrategyReplace
+			if name[:3] == "set" {
+				strategy = StrategySetCollection
+			}
+
+			require.Nil(t, store.CreateOrLoadBucket(testCtx(), name,
+				WithStrategy(strategy)))
+
+			b := store.Bucket(name)
+			if strategy == StrategyReplace {
+				require.Nil(t, b.Put([]byte("key"), []byte(name)))
+			} else {
+				require.Nil(t, b.SetAdd([]byte("key"), [][]byte{[]byte(name)}))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	})
+
+	t.Run("only the most recently used segments remain mapped", func(t *testing.T) {
+		stats := budget.Stats()
+		assert.Equal(t, 2, stats.Open)
+		assert.Equal(t, 2, stats.Max)
+		assert.Equal(t, int64(3), stats.Evictions)
+		assert.Equal(t, int64(0), stats.Reopens)
+	})
+
+	t.Run("reading from cold segments maps them again", func(t *testing.T) {
+		for _, name := range bucketNames {
+			b := store.Bucket(name)
+			if name[:3] == "set" {
+				values, err := b.SetList([]byte("key"))
+				require.Nil(t, err)
+				assert.Equal(t, [][]byte{[]byte(name)}, values)
+			} else {
+				value, err := b.Get([]byte("key"))
+				require.Nil(t, err)
+				assert.Equal(t, []byte(name), value)
+			}
+		}
+
+		stats := budget.Stats()
+		assert.Equal(t, 2, stats.Open)
+		assert.Equal(t, int64(5), stats.Reopens)
+	})
+
+	t.Run("a pinned segment is not unmapped", func(t *testing.T) {
+		c := store.Bucket("replace1").Cursor()
+
+		for _, name := range bucketNames[1:] {
+			var err error
+			if name[:3] == "set" {
+				_, err = store.Bucket(name).SetList([]byte("key"))
+			} else {
+				_, err = store.Bucket(name).Get([]byte("key"))
+			}
+			require.Nil(t, err)
+		}
+
+		k, v := c.First()
+		assert.Equal(t, []byte("key"), k)
+		assert.Equal(t, []byte("replace1"), v)
+		c.Close()
+	})
+
+	t.Run("shutting down releases all segments", func(t *testing.T) {
+		require.Nil(t, store.Shutdown(context.Background()))
+		assert.Equal(t, 0, budget.Stats().Open)
+	})
+}
+
+func TestHandleBudgetRemapFailure(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	budget := NewHandleBudget(1)
+	store, err := New(dirName, nullLogger(), WithHandleBudget(budget))
+	require.Nil(t, err)
+
+	for _, name := range []string{"cold", "hot"} {
+		require.Nil(t, store.CreateOrLoadBucket(testCtx(), name,
+			WithStrategy(StrategyReplace)))
+		b := store.Bucket(name)
+		require.Nil(t, b.Put([]byte("key"), []byte(name)))
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	cold := store.Bucket("cold")
+	seg := cold.disk.segments[0]
+
+	t.Run("the cold segment was evicted", func(t *testing.T) {
+		assert.False(t, seg.mapped)
+	})
+
+	// the segment can no longer be mapped again once its file is gone
+	require.Nil(t, os.Remove(seg.path))
+
+	t.Run("a getter returns the error", func(t *testing.T) {
+		_, err := cold.Get([]byte("key"))
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, seg.refs)
+	})
+
+	t.Run("a cursor returns the error and no keys", func(t *testing.T) {
+		c := cold.Cursor()
+		k, _ := c.First()
+		assert.Nil(t, k)
+		assert.NotNil(t, c.Err())
+		c.Close()
+		assert.Equal(t, 0, seg.refs)
+	})
+
+	t.Run("a view returns the error", func(t *testing.T) {
+		_, err := store.View()
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, seg.refs)
+		assert.Equal(t, 0, store.Bucket("hot").disk.segments[0].refs)
+	})
+
+	t.Run("the bucket can still be written to", func(t *testing.T) {
+		require.Nil(t, cold.Put([]byte("other"), []byte("value")))
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 )
 
 type Memtable struct {
@@ -28,11 +29,20 @@ type Memtable struct {
 	strategy           string
 	secondaryIndices   uint16
 	secondaryToPrimary []map[string][]byte
+	readOnly           bool
+
+	// cipher encrypts the commit log and the flushed segment, it may be nil
+	cipher *encryption.Cipher
+
+	// compression is the algorithm the flushed segment is compressed with, it
+	// is not compressed if it is empty
+	compression string
 }
 
 func newMemtable(path string, strategy string,
-	secondaryIndices uint16) (*Memtable, error) {
-	cl, err := newCommitLogger(path)
+	secondaryIndices uint16, cipher *encryption.Cipher,
+	compression string) (*Memtable, error) {
+	cl, err := newCommitLogger(path, cipher)
 	if err != nil {
 		return nil, errors.Wrap(err, "init commit logger")
 	}
@@ -45,6 +55,8 @@ func newMemtable(path string, strategy string,
 		path:             path,
 		strategy:         strategy,
 		secondaryIndices: secondaryIndices,
+		cipher:           cipher,
+		compression:      compression,
 	}
 
 	if m.secondaryIndices > 0 {
@@ -102,6 +114,10 @@ func (l *Memtable) getBySecondary(pos int, key []byte) ([]byte, error) {
 }
 
 func (l *Memtable) put(key, value []byte, opts ...SecondaryKeyOption) error {
+	if l.readOnly {
+		return ErrReadOnly
+	}
+
 	if l.strategy != StrategyReplace {
 		return errors.Errorf("put only possible with strategy 'replace'")
 	}
@@ -141,6 +157,10 @@ func (l *Memtable) put(key, value []byte, opts ...SecondaryKeyOption) error {
 }
 
 func (l *Memtable) setTombstone(key []byte, opts ...SecondaryKeyOption) error {
+	if l.readOnly {
+		return ErrReadOnly
+	}
+
 	if l.strategy != "replace" {
 		return errors.Errorf("setTombstone only possible with strategy 'replace'")
 	}
@@ -192,6 +212,10 @@ func (l *Memtable) getCollection(key []byte) ([]value, error) {
 }
 
 func (l *Memtable) append(key []byte, values []value) error {
+	if l.readOnly {
+		return ErrReadOnly
+	}
+
 	if l.strategy != StrategySetCollection && l.strategy != StrategyMapCollection {
 		return errors.Errorf("append only possible with strategies %q, %q",
 			StrategySetCollection, StrategyMapCollection)
@@ -229,6 +253,10 @@ func (l *Memtable) Size() uint64 {
 // that the WAL is written before a successful response is returned to the
 // user.
 func (l *Memtable) writeWAL() error {
+	if l.readOnly {
+		return ErrReadOnly
+	}
+
 	l.Lock()
 	defer l.Unlock()
 
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// defaultMemtableThreshold is the size at which the active memtable of a
+	// bucket is flushed if there is no memtable budget
+	defaultMemtableThreshold = uint64(10 * 1024 * 1024)
+
+	// minMemtableThreshold and maxMemtableThreshold bound the share of a
+	// memtable budget a single bucket gets. Smaller memtables would result in
+	// a flood of tiny segments, larger ones in long flushes.
+	minMemtableThreshold = uint64(512 * 1024)
+	maxMemtableThreshold = uint64(64 * 1024 * 1024)
+)
+
+// MemtableBudget sizes the memtables of all buckets sharing the budget, so
+// their combined size stays within a fixed amount of memory no matter how
+// many classes and shards there are. Each bucket is given an equal share of
+// the budget as the threshold at which its memtable is flushed, see
+// minMemtableThreshold and maxMemtableThreshold for the bounds of a share.
+// The shares shrink as buckets are created and grow again as they are shut
+// down.
+//
+// A bucket which is flushing holds its previous memtable in addition to the
+// active one, so the memory used may temporarily exceed the budget. A budget
+// with a total of 0 does not size the memtables, every bucket uses the
+// default threshold instead.
+type MemtableBudget struct {
+	sync.Mutex
+	total   uint64
+	buckets map[*Bucket]struct{}
+
+	// share is the current threshold of every bucket, it is read on every
+	// write and thus not protected by the lock
+	share uint64
+}
+
+// MemtableBudgetStats describes the current state of a MemtableBudget
+type MemtableBudgetStats struct {
+	// Total is the memory in bytes shared by the memtables, 0 means the
+	// memtables are not sized by the budget
+	Total uint64
+	// Buckets is the number of buckets sharing the budget
+	Buckets int
+	// Threshold is the size in bytes at which the memtable of each bucket is
+	// currently flushed
+	Threshold uint64
+}
+
+func NewMemtableBudget(total uint64) *MemtableBudget {
+	m := &MemtableBudget{
+		total:   total,
+		buckets: map[*Bucket]struct{}{},
+	}
+	m.resize()
+	return m
+}
+
+// register adds a bucket to the ones sharing the budget, registering the
+// same bucket twice has no effect
+func (m *MemtableBudget) register(b *Bucket) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.buckets[b] = struct{}{}
+	m.resize()
+}
+
+// unregister removes a bucket which has been shut down, so the remaining
+// buckets get larger shares
+func (m *MemtableBudget) unregister(b *Bucket) {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.buckets, b)
+	m.resize()
+}
+
+// resize recalculates the share of every bucket, it must be called with the
+// lock held
+func (m *MemtableBudget) resize() {
+	atomic.StoreUint64(&m.share, memtableShare(m.total, len(m.buckets)))
+}
+
+func memtableShare(total uint64, buckets int) uint64 {
+	if total == 0 {
+		return defaultMemtableThreshold
+	}
+
+	if buckets < 1 {
+		buckets = 1
+	}
+
+	share := total / uint64(buckets)
+	if share < minMemtableThreshold {
+		return minMemtableThreshold
+	}
+	if share > maxMemtableThreshold {
+		return maxMemtableThreshold
+	}
+
+	return share
+}
+
+// threshold is the size at which the memtables of the buckets sharing the
+// budget are currently flushed
+func (m *MemtableBudget) threshold() uint64 {
+	return atomic.LoadUint64(&m.share)
+}
+
+func (m *MemtableBudget) Stats() MemtableBudgetStats {
+	m.Lock()
+	defer m.Unlock()
+
+	return MemtableBudgetStats{
+		Total:     m.total,
+		Buckets:   len(m.buckets),
+		Threshold: m.threshold(),
+	}
+}
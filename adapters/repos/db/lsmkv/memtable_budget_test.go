@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemtableBudget(t *testing.T) {
+	const mb = uint64(1024 * 1024)
+
+	t.Run("without a total", func(t *testing.T) {
+		m := NewMemtableBudget(0)
+		for i := 0; i < 100; i++ {
+			m.register(&Bucket{})
+		}
+
+		assert.Equal(t, defaultMemtableThreshold, m.threshold())
+	})
+
+	t.Run("splits the total across the buckets", func(t *testing.T) {
+		m := NewMemtableBudget(100 * mb)
+		buckets := []*Bucket{{}, {}, {}, {}}
+		for _, b := range buckets {
+			m.register(b)
+		}
+		m.register(buckets[0])
+
+		assert.Equal(t, 25*mb, m.threshold())
+		assert.Equal(t, MemtableBudgetStats{
+			Total: 100 * mb, Buckets: 4, Threshold: 25 * mb,
+		}, m.Stats())
+
+		m.unregister(buckets[0])
+		m.unregister(buckets[1])
+		assert.Equal(t, 50*mb, m.threshold())
+	})
+
+	t.Run("bounds the share of a bucket", func(t *testing.T) {
+		m := NewMemtableBudget(1024 * mb)
+		m.register(&Bucket{})
+		assert.Equal(t, maxMemtableThreshold, m.threshold())
+
+		for i := 0; i < 10000; i++ {
+			m.register(&Bucket{})
+		}
+		assert.Equal(t, minMemtableThreshold, m.threshold())
+	})
+
+	t.Run("an explicit threshold takes precedence", func(t *testing.T) {
+		m := NewMemtableBudget(20 * mb)
+		b := &Bucket{memtables: m}
+		m.register(b)
+		assert.Equal(t, 20*mb, b.threshold())
+
+		b.SetMemtableThreshold(mb)
+		assert.Equal(t, mb, b.threshold())
+	})
+}
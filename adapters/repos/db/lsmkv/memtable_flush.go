@@ -14,7 +14,6 @@ package lsmkv
 import (
 	"bufio"
 	"io"
-	"os"
 
 	"github.com/pkg/errors"
 )
@@ -37,11 +36,16 @@ func (l *Memtable) flush() error {
 		return l.commitlog.delete()
 	}
 
-	f, err := os.Create(l.path + ".db")
+	f, err := createSegmentFile(l.path+".db", l.cipher)
 	if err != nil {
 		return err
 	}
 
+	if err := f.compress(l.compression); err != nil {
+		f.close()
+		return errors.Wrap(err, "compress segment")
+	}
+
 	w := bufio.NewWriterSize(f, int(float64(l.size)*1.3)) // calculate 30% overhead for disk representation
 
 	var keys []keyIndex
@@ -72,10 +76,14 @@ func (l *Memtable) flush() error {
 		return err
 	}
 
-	if err := f.Close(); err != nil {
+	if err := f.close(); err != nil {
 		return err
 	}
 
+	if err := writeSegmentChecksums(l.path + ".db"); err != nil {
+		return errors.Wrap(err, "write segment checksums")
+	}
+
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
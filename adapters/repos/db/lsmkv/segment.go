@@ -13,11 +13,15 @@ package lsmkv
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 	"os"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/sirupsen/logrus"
 	"github.com/willf/bloom"
@@ -44,8 +48,35 @@ type segment struct {
 	index                 diskIndex
 	secondaryIndices      []diskIndex
 	logger                logrus.FieldLogger
+
+	// refs counts the cursors and snapshots reading from the segment. A
+	// segment which is retired while it is still referenced, e.g. because it
+	// was compacted, stays mapped until the last reader releases it. Only then
+	// is an obsolete segment deleted from disk.
+	refLock  sync.Mutex
+	refs     int
+	retired  bool
+	obsolete bool
+
+	// mapped is false if the segment was unmapped to stay within the handle
+	// budget, it is mapped again the next time it is pinned
+	mapped  bool
+	handles *HandleBudget
+
+	// cipher decrypts an encrypted segment file. Encrypted segments can't be
+	// mapped, they are kept open and read through encrypted instead, which
+	// decrypts their blocks on demand. Compressed segments are decompressed
+	// onto the heap as a whole, in which case onHeap is set.
+	cipher    *encryption.Cipher
+	encrypted *encryption.ReaderAt
+	file      *os.File
+	onHeap    bool
 }
 
+// encryptedSegmentCacheBlocks is the number of decrypted blocks cached per
+// encrypted segment
+const encryptedSegmentCacheBlocks = 4
+
 type diskIndex interface {
 	// Get return segmentindex.NotFound in case no node can be found
 	Get(key []byte) (segmentindex.Node, error)
@@ -59,124 +90,368 @@ type diskIndex interface {
 	AllKeys() ([][]byte, error)
 }
 
-func newSegment(path string, logger logrus.FieldLogger) (*segment, error) {
-	file, err := os.Open(path)
+func newSegment(path string, logger logrus.FieldLogger,
+	handles *HandleBudget, cipher *encryption.Cipher) (*segment, error) {
+	ind := &segment{
+		path:    path,
+		logger:  logger,
+		handles: handles,
+		cipher:  cipher,
+	}
+
+	if err := ind.mmap(); err != nil {
+		return nil, err
+	}
+
+	if ind.secondaryIndexCount > 0 {
+		ind.secondaryBloomFilters = make([]*bloom.BloomFilter, ind.secondaryIndexCount)
+		for i := range ind.secondaryIndices {
+			if err := ind.initSecondaryBloomFilter(i); err != nil {
+				return nil, errors.Wrapf(err, "init bloom filter for secondary index at %d", i)
+			}
+		}
+	}
+
+	if err := ind.initBloomFilter(); err != nil {
+		return nil, err
+	}
+
+	if ind.handles != nil {
+		ind.handles.touch(ind)
+	}
+
+	return ind, nil
+}
+
+// mmap maps the segment file into memory and parses its header and indices,
+// which point into the mapped contents. The bloom filters are kept in memory
+// independently of the contents, so they survive an unmapped segment. The
+// file itself is closed right away, the mapping remains valid without it.
+// Encrypted segments are not mapped, but kept open and decrypted block by
+// block whenever they are read. Compressed segments are decompressed onto the
+// heap instead of being mapped.
+func (ind *segment) mmap() error {
+	file, err := os.Open(ind.path)
 	if err != nil {
-		return nil, errors.Wrap(err, "open file")
+		return errors.Wrap(err, "open file")
 	}
 
+	// only an encrypted segment which is read on demand keeps its file open
+	keepOpen := false
+	defer func() {
+		if !keepOpen {
+			file.Close()
+		}
+	}()
+
 	file_info, err := file.Stat()
 	if err != nil {
-		return nil, errors.Wrap(err, "stat file")
+		return errors.Wrap(err, "stat file")
 	}
 
-	content, err := syscall.Mmap(int(file.Fd()), 0, int(file_info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	isEncrypted, err := encryption.IsEncryptedFile(file)
 	if err != nil {
-		return nil, errors.Wrap(err, "mmap file")
+		return errors.Wrap(err, "check for encryption")
+	}
+
+	var content []byte
+	var encrypted *encryption.ReaderAt
+	if isEncrypted {
+		encrypted, err = encryption.NewReaderAt(file, file_info.Size(), ind.cipher,
+			encryptedSegmentCacheBlocks)
+		if err != nil {
+			return errors.Wrapf(err, "decrypt segment %s", ind.path)
+		}
+
+		prefix := make([]byte, len(compressedSegmentMagic))
+		n, err := encrypted.ReadAt(prefix, 0)
+		if err != nil && err != io.EOF {
+			return errors.Wrapf(err, "decrypt segment %s", ind.path)
+		}
+
+		if isCompressedSegment(prefix[:n]) {
+			plain := make([]byte, encrypted.Size())
+			if _, err := encrypted.ReadAt(plain, 0); err != nil {
+				return errors.Wrapf(err, "decrypt segment %s", ind.path)
+			}
+
+			content, encrypted = plain, nil
+		}
+	} else {
+		content, err = syscall.Mmap(int(file.Fd()), 0, int(file_info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+		if err != nil {
+			return errors.Wrap(err, "mmap file")
+		}
+	}
+
+	compressed := encrypted == nil && isCompressedSegment(content)
+	if compressed {
+		plain, err := decompressSegment(content)
+		if !isEncrypted {
+			syscall.Munmap(content)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "decompress segment %s", ind.path)
+		}
+
+		content = plain
+	}
+
+	var source io.ReaderAt
+	var size uint64
+	if encrypted != nil {
+		source, size = encrypted, uint64(encrypted.Size())
+	} else {
+		source, size = bytes.NewReader(content), uint64(len(content))
 	}
 
-	header, err := parseSegmentHeader(bytes.NewReader(content[:SegmentHeaderSize]))
+	header, err := parseSegmentHeader(io.NewSectionReader(source, 0, SegmentHeaderSize))
 	if err != nil {
-		return nil, errors.Wrap(err, "parse header")
+		return errors.Wrap(err, "parse header")
 	}
 
 	switch header.strategy {
 	case SegmentStrategyReplace, SegmentStrategySetCollection,
 		SegmentStrategyMapCollection:
 	default:
-		return nil, errors.Errorf("unsupported strategy in segment")
+		return errors.Errorf("unsupported strategy in segment")
 	}
 
-	primaryIndex, err := header.PrimaryIndex(content)
+	primaryStart, primaryEnd, err := header.PrimaryIndex(source, size)
 	if err != nil {
-		return nil, errors.Wrap(err, "extract primary index position")
+		return errors.Wrap(err, "extract primary index position")
 	}
 
-	primaryDiskIndex := segmentindex.NewDiskTree(primaryIndex)
-
-	ind := &segment{
-		level:               header.level,
-		path:                path,
-		contents:            content,
-		version:             header.version,
-		secondaryIndexCount: header.secondaryIndices,
-		segmentStartPos:     header.indexStart,
-		segmentEndPos:       uint64(len(content)),
-		strategy:            header.strategy,
-		dataStartPos:        SegmentHeaderSize, // fixed value that's the same for all strategies
-		dataEndPos:          header.indexStart,
-		index:               primaryDiskIndex,
-		logger:              logger,
+	ind.level = header.level
+	ind.contents = content
+	ind.encrypted = encrypted
+	ind.version = header.version
+	ind.secondaryIndexCount = header.secondaryIndices
+	ind.segmentStartPos = header.indexStart
+	ind.segmentEndPos = size
+	ind.strategy = header.strategy
+	ind.dataStartPos = SegmentHeaderSize // fixed value that's the same for all strategies
+	ind.dataEndPos = header.indexStart
+	ind.index = newDiskIndex(source, primaryStart, primaryEnd)
+	ind.mapped = true
+	ind.onHeap = compressed
+	if encrypted != nil {
+		ind.file = file
+		keepOpen = true
 	}
 
 	if ind.secondaryIndexCount > 0 {
 		ind.secondaryIndices = make([]diskIndex, ind.secondaryIndexCount)
-		ind.secondaryBloomFilters = make([]*bloom.BloomFilter, ind.secondaryIndexCount)
 		for i := range ind.secondaryIndices {
-			secondary, err := header.SecondaryIndex(content, uint16(i))
+			start, end, err := header.SecondaryIndex(source, size, uint16(i))
 			if err != nil {
-				return nil, errors.Wrapf(err, "get position for secondary index at %d", i)
+				return errors.Wrapf(err, "get position for secondary index at %d", i)
 			}
 
-			ind.secondaryIndices[i] = segmentindex.NewDiskTree(secondary)
-			if err := ind.initSecondaryBloomFilter(i); err != nil {
-				return nil, errors.Wrapf(err, "init bloom filter for secondary index at %d", i)
-			}
+			ind.secondaryIndices[i] = newDiskIndex(source, start, end)
 		}
 	}
 
-	if err := ind.initBloomFilter(); err != nil {
-		return nil, err
+	return nil
+}
+
+func newDiskIndex(source io.ReaderAt, start, end uint64) diskIndex {
+	return segmentindex.NewDiskTreeAt(
+		io.NewSectionReader(source, int64(start), int64(end-start)), int64(end-start))
+}
+
+func (ind *segment) close() error {
+	if !ind.mapped {
+		return nil
 	}
 
-	return ind, nil
+	ind.mapped = false
+	ind.index = nil
+	ind.secondaryIndices = nil
+	if ind.encrypted != nil {
+		ind.encrypted = nil
+		file := ind.file
+		ind.file = nil
+		return file.Close()
+	}
+
+	if ind.onHeap {
+		ind.contents = nil
+		return nil
+	}
+
+	return syscall.Munmap(ind.contents)
 }
 
-func (ind *segment) initBloomFilter() error {
-	before := time.Now()
-	keys, err := ind.index.AllKeys()
-	if err != nil {
-		return err
+// contentBytes returns the plain contents in [start, end). Mapped contents
+// are sliced and must not be used once the segment is unpinned, encrypted
+// contents are decrypted into a new slice.
+func (ind *segment) contentBytes(start, end uint64) ([]byte, error) {
+	if ind.encrypted == nil {
+		return ind.contents[start:end], nil
+	}
+
+	out := make([]byte, end-start)
+	if _, err := ind.encrypted.ReadAt(out, int64(start)); err != nil {
+		return nil, errors.Wrapf(err, "read segment %s", ind.path)
 	}
 
-	ind.bloomFilter = bloom.NewWithEstimates(uint(len(keys)), 0.001)
-	for _, key := range keys {
-		ind.bloomFilter.Add(key)
+	return out, nil
+}
+
+// contentReader reads the plain contents in [start, end)
+func (ind *segment) contentReader(start, end uint64) io.Reader {
+	if ind.encrypted == nil {
+		return bytes.NewReader(ind.contents[start:end])
+	}
+
+	return io.NewSectionReader(ind.encrypted, int64(start), int64(end-start))
+}
+
+// pin makes sure the segment is mapped and stays mapped until it is unpinned
+// again. Any read from the contents of the segment must happen while it is
+// pinned. If an evicted segment cannot be mapped again, e.g. because it can
+// no longer be decrypted or decompressed, the segment is not pinned and must
+// not be unpinned.
+func (ind *segment) pin() error {
+	ind.refLock.Lock()
+	ind.refs++
+	if !ind.mapped && !ind.retired {
+		if err := ind.mmap(); err != nil {
+			ind.refs--
+			ind.refLock.Unlock()
+			return errors.Wrapf(err, "remap evicted segment %s", ind.path)
+		}
+
+		if ind.handles != nil {
+			ind.handles.countReopen()
+		}
+	}
+	ind.refLock.Unlock()
+
+	// the budget is called without holding the lock of the segment, as it may
+	// lock other segments to evict them
+	if ind.handles != nil {
+		ind.handles.touch(ind)
 	}
 
-	took := time.Since(before)
-	ind.logger.WithField("action", "lsm_init_disk_segment_build_bloom_filter_primary").
-		WithField("path", ind.path).
-		WithField("took", took).
-		Debugf("building bloom filter took %s\n", took)
 	return nil
 }
 
-func (ind *segment) initSecondaryBloomFilter(pos int) error {
-	before := time.Now()
-	keys, err := ind.secondaryIndices[pos].AllKeys()
-	if err != nil {
-		return err
+// evict unmaps the segment to stay within the handle budget, unless it is
+// currently pinned. The segment is mapped again the next time it is pinned.
+// It returns false if the segment is still mapped.
+func (ind *segment) evict() bool {
+	ind.refLock.Lock()
+	defer ind.refLock.Unlock()
+
+	if ind.refs > 0 {
+		return false
+	}
+
+	if !ind.mapped {
+		// already closed, e.g. because it was retired in the meantime
+		return true
+	}
+
+	if err := ind.close(); err != nil {
+		ind.logger.WithField("action", "lsm_segment_evict").
+			WithField("path", ind.path).
+			WithError(err).
+			Error("failed to unmap segment")
+		return false
+	}
+
+	if ind.handles != nil {
+		ind.handles.countEviction()
+	}
+
+	return true
+}
+
+func (ind *segment) unpin() error {
+	ind.refLock.Lock()
+	defer ind.refLock.Unlock()
+
+	ind.refs--
+	if ind.refs == 0 && ind.retired {
+		return ind.release()
+	}
+
+	return nil
+}
+
+// unpinAndLog is unpin for readers which cannot return an error when they
+// are done, such as cursors
+func (ind *segment) unpinAndLog() {
+	if err := ind.unpin(); err != nil {
+		ind.logger.WithField("action", "lsm_segment_unpin").
+			WithField("path", ind.path).
+			WithError(err).
+			Error("failed to release segment")
 	}
+}
+
+// retire closes the segment once it is no longer pinned
+func (ind *segment) retire() error {
+	ind.refLock.Lock()
+	defer ind.refLock.Unlock()
 
-	ind.secondaryBloomFilters[pos] = bloom.NewWithEstimates(uint(len(keys)), 0.001)
-	for _, key := range keys {
-		ind.secondaryBloomFilters[pos].Add(key)
+	ind.retired = true
+	if ind.refs == 0 {
+		return ind.release()
 	}
-	took := time.Since(before)
 
-	ind.logger.WithField("action", "lsm_init_disk_segment_build_bloom_filter_secondary").
-		WithField("secondary_index_position", pos).
-		WithField("path", ind.path).
-		WithField("took", took).
-		Debugf("building bloom filter took %s\n", took)
 	return nil
 }
 
-func (ind *segment) close() error {
-	return syscall.Munmap(ind.contents)
+// retireObsolete retires a segment which has been replaced, e.g. by
+// compaction, and deletes it once it is no longer pinned. The file is moved
+// out of the way right away, so its path can be reused by the replacement.
+// As the replacement can become obsolete while the original is still
+// pinned, the new name is made unique.
+func (ind *segment) retireObsolete() error {
+	ind.refLock.Lock()
+	defer ind.refLock.Unlock()
+
+	// the persisted bloom filters and checksums are deleted first, the
+	// replacement which reuses the path must never pick them up
+	if err := deleteBloomFilters(ind.path); err != nil {
+		return err
+	}
+
+	if err := deleteSegmentChecksums(ind.path); err != nil {
+		return err
+	}
+
+	obsoletePath := fmt.Sprintf("%s.%d%s", ind.path, time.Now().UnixNano(),
+		obsoleteSegmentExt)
+	if err := os.Rename(ind.path, obsoletePath); err != nil {
+		return errors.Wrapf(err, "move obsolete segment %s", ind.path)
+	}
+
+	ind.path = obsoletePath
+	ind.retired = true
+	ind.obsolete = true
+	if ind.refs == 0 {
+		return ind.release()
+	}
+
+	return nil
 }
 
-func (ind *segment) drop() error {
+func (ind *segment) release() error {
+	if ind.handles != nil {
+		ind.handles.forget(ind)
+	}
+
+	if err := ind.close(); err != nil {
+		return err
+	}
+
+	if !ind.obsolete {
+		return nil
+	}
+
 	return os.Remove(ind.path)
 }
@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/willf/bloom"
+)
+
+// bloomFilterExt is the extension of the files the bloom filters of a segment
+// are persisted in, so they don't have to be rebuilt from all keys of the
+// segment every time it is loaded. A file is only used if it was built for a
+// segment of the same size and its checksum matches, otherwise the filter is
+// rebuilt and the file replaced. This also covers segments written before the
+// filters were persisted.
+const bloomFilterExt = ".bloom"
+
+func bloomFilterPath(segmentPath string) string {
+	return strings.TrimSuffix(segmentPath, ".db") + bloomFilterExt
+}
+
+func secondaryBloomFilterPath(segmentPath string, pos int) string {
+	return fmt.Sprintf("%s.secondary.%d%s", strings.TrimSuffix(segmentPath, ".db"),
+		pos, bloomFilterExt)
+}
+
+func (ind *segment) initBloomFilter() error {
+	path := bloomFilterPath(ind.path)
+	if bf, ok := ind.loadBloomFilter(path); ok {
+		ind.bloomFilter = bf
+		return nil
+	}
+
+	before := time.Now()
+	keys, err := ind.index.AllKeys()
+	if err != nil {
+		return err
+	}
+
+	ind.bloomFilter = bloom.NewWithEstimates(uint(len(keys)), 0.001)
+	for _, key := range keys {
+		ind.bloomFilter.Add(key)
+	}
+
+	took := time.Since(before)
+	ind.logger.WithField("action", "lsm_init_disk_segment_build_bloom_filter_primary").
+		WithField("path", ind.path).
+		WithField("took", took).
+		Debugf("building bloom filter took %s\n", took)
+
+	ind.storeBloomFilter(path, ind.bloomFilter)
+	return nil
+}
+
+func (ind *segment) initSecondaryBloomFilter(pos int) error {
+	path := secondaryBloomFilterPath(ind.path, pos)
+	if bf, ok := ind.loadBloomFilter(path); ok {
+		ind.secondaryBloomFilters[pos] = bf
+		return nil
+	}
+
+	before := time.Now()
+	keys, err := ind.secondaryIndices[pos].AllKeys()
+	if err != nil {
+		return err
+	}
+
+	ind.secondaryBloomFilters[pos] = bloom.NewWithEstimates(uint(len(keys)), 0.001)
+	for _, key := range keys {
+		ind.secondaryBloomFilters[pos].Add(key)
+	}
+	took := time.Since(before)
+
+	ind.logger.WithField("action", "lsm_init_disk_segment_build_bloom_filter_secondary").
+		WithField("secondary_index_position", pos).
+		WithField("path", ind.path).
+		WithField("took", took).
+		Debugf("building bloom filter took %s\n", took)
+
+	ind.storeBloomFilter(path, ind.secondaryBloomFilters[pos])
+	return nil
+}
+
+// loadBloomFilter reads a persisted bloom filter of the segment. It returns
+// false if there is none, or if it can't be used, in which case the filter
+// has to be rebuilt.
+func (ind *segment) loadBloomFilter(path string) (*bloom.BloomFilter, bool) {
+	bf, err := readBloomFilter(path, ind.cipher, ind.segmentEndPos)
+	if err != nil {
+		if !os.IsNotExist(errors.Cause(err)) {
+			ind.logger.WithField("action", "lsm_init_disk_segment_load_bloom_filter").
+				WithField("path", path).
+				WithError(err).
+				Warn("discarding persisted bloom filter, it will be rebuilt")
+		}
+		return nil, false
+	}
+
+	return bf, true
+}
+
+// storeBloomFilter persists a freshly built bloom filter of the segment. The
+// filter is only an optimization, so a failure is logged, but does not
+// prevent the segment from being used.
+func (ind *segment) storeBloomFilter(path string, bf *bloom.BloomFilter) {
+	if err := writeBloomFilter(path, ind.cipher, ind.segmentEndPos, bf); err != nil {
+		ind.logger.WithField("action", "lsm_init_disk_segment_store_bloom_filter").
+			WithField("path", path).
+			WithError(err).
+			Warn("failed to persist bloom filter")
+	}
+}
+
+// deleteBloomFilters removes the persisted bloom filters of the segment at
+// the path, the filters of a loaded segment in memory are not affected
+func deleteBloomFilters(segmentPath string) error {
+	secondary, err := filepath.Glob(strings.TrimSuffix(segmentPath, ".db") +
+		".secondary.*" + bloomFilterExt)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range append([]string{bloomFilterPath(segmentPath)}, secondary...) {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "delete bloom filter %s", path)
+		}
+	}
+
+	return nil
+}
+
+// bloomFilterSegmentPath returns the path of the segment the persisted bloom
+// filter at the path belongs to
+func bloomFilterSegmentPath(path string) string {
+	base := strings.TrimSuffix(path, bloomFilterExt)
+	if i := strings.LastIndex(base, ".secondary."); i >= 0 {
+		base = base[:i]
+	}
+
+	return base + ".db"
+}
+
+// writeBloomFilter persists the bloom filter of a segment. The layout is:
+//
+//	size of the segment contents the filter was built for,
+//	filter as written by bloom.BloomFilter.WriteTo,
+//	crc32 checksum of everything before
+func writeBloomFilter(path string, c *encryption.Cipher, segmentSize uint64,
+	bf *bloom.BloomFilter) error {
+	buf := &bytes.Buffer{}
+	if err := binary.Write(buf, binary.LittleEndian, segmentSize); err != nil {
+		return err
+	}
+
+	if _, err := bf.WriteTo(buf); err != nil {
+		return errors.Wrap(err, "serialize bloom filter")
+	}
+
+	if err := binary.Write(buf, binary.LittleEndian,
+		crc32.ChecksumIEEE(buf.Bytes())); err != nil {
+		return err
+	}
+
+	f, err := createSegmentFile(path, c)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.file.Close()
+		return err
+	}
+
+	return f.close()
+}
+
+func readBloomFilter(path string, c *encryption.Cipher,
+	segmentSize uint64) (*bloom.BloomFilter, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if encryption.IsEncrypted(contents) {
+		contents, err = c.Decrypt(contents)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypt bloom filter")
+		}
+	}
+
+	if len(contents) < 12 {
+		return nil, errors.Errorf("bloom filter file too short")
+	}
+
+	body := contents[:len(contents)-4]
+	checksum := binary.LittleEndian.Uint32(contents[len(contents)-4:])
+	if crc32.ChecksumIEEE(body) != checksum {
+		return nil, errors.Errorf("checksum mismatch")
+	}
+
+	if size := binary.LittleEndian.Uint64(body[:8]); size != segmentSize {
+		return nil, errors.Errorf("built for segment of size %d, but segment has %d",
+			size, segmentSize)
+	}
+
+	bf := &bloom.BloomFilter{}
+	if _, err := bf.ReadFrom(bytes.NewReader(body[8:])); err != nil {
+		return nil, errors.Wrap(err, "deserialize bloom filter")
+	}
+
+	return bf, nil
+}
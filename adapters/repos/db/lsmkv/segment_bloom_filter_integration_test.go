@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/willf/bloom"
+)
+
+func TestPersistedBloomFilters(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	openBucket := func(t *testing.T) *Bucket {
+		b, err := NewBucket(testCtx(), dirName, nullLogger(),
+			WithStrategy(StrategyReplace), WithSecondaryIndicies(1))
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	importSegment := func(t *testing.T, b *Bucket, prefix string) {
+		for i := 0; i < 100; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("%s-key-%03d", prefix, i)),
+				[]byte(fmt.Sprintf("%s-value-%03d", prefix, i)),
+				WithSecondaryKey(0, []byte(fmt.Sprintf("%s-secondary-%03d", prefix, i)))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	verify := func(t *testing.T, b *Bucket, prefixes ...string) {
+		for _, prefix := range prefixes {
+			for i := 0; i < 100; i++ {
+				value := []byte(fmt.Sprintf("%s-value-%03d", prefix, i))
+
+				res, err := b.Get([]byte(fmt.Sprintf("%s-key-%03d", prefix, i)))
+				require.Nil(t, err)
+				assert.Equal(t, value, res)
+
+				res, err = b.GetBySecondary(0,
+					[]byte(fmt.Sprintf("%s-secondary-%03d", prefix, i)))
+				require.Nil(t, err)
+				assert.Equal(t, value, res)
+			}
+		}
+
+		res, err := b.Get([]byte("missing"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	}
+
+	segmentPaths := func(t *testing.T) []string {
+		paths, err := filepath.Glob(filepath.Join(dirName, "*.db"))
+		require.Nil(t, err)
+		return paths
+	}
+
+	// persistedFilters reads the persisted filters of the only segment, they
+	// must be valid for it
+	persistedFilters := func(t *testing.T) (*bloom.BloomFilter, *bloom.BloomFilter) {
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+
+		info, err := os.Stat(paths[0])
+		require.Nil(t, err)
+
+		primary, err := readBloomFilter(bloomFilterPath(paths[0]), nil,
+			uint64(info.Size()))
+		require.Nil(t, err)
+
+		secondary, err := readBloomFilter(secondaryBloomFilterPath(paths[0], 0), nil,
+			uint64(info.Size()))
+		require.Nil(t, err)
+
+		return primary, secondary
+	}
+
+	t.Run("flushing a segment persists its bloom filters", func(t *testing.T) {
+		b := openBucket(t)
+		importSegment(t, b, "first")
+		verify(t, b, "first")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		primary, secondary := persistedFilters(t)
+		assert.True(t, primary.Test([]byte("first-key-000")))
+		assert.True(t, secondary.Test([]byte("first-secondary-000")))
+	})
+
+	t.Run("the persisted filters are loaded instead of being rebuilt", func(t *testing.T) {
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+
+		// a filter which contains none of the keys proves that the persisted
+		// one is used
+		info, err := os.Stat(paths[0])
+		require.Nil(t, err)
+		require.Nil(t, writeBloomFilter(bloomFilterPath(paths[0]), nil,
+			uint64(info.Size()), bloom.NewWithEstimates(100, 0.001)))
+
+		b := openBucket(t)
+		res, err := b.Get([]byte("first-key-000"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+		require.Nil(t, b.Shutdown(testCtx()))
+	})
+
+	t.Run("filters of a different segment are rebuilt", func(t *testing.T) {
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+
+		require.Nil(t, writeBloomFilter(bloomFilterPath(paths[0]), nil, 1,
+			bloom.NewWithEstimates(100, 0.001)))
+
+		b := openBucket(t)
+		verify(t, b, "first")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		primary, _ := persistedFilters(t)
+		assert.True(t, primary.Test([]byte("first-key-000")))
+	})
+
+	t.Run("corrupt filters are rebuilt", func(t *testing.T) {
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+
+		path := bloomFilterPath(paths[0])
+		contents, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		contents[len(contents)/2] ^= 0xff
+		require.Nil(t, ioutil.WriteFile(path, contents, 0o666))
+
+		b := openBucket(t)
+		verify(t, b, "first")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		persistedFilters(t)
+	})
+
+	t.Run("missing filters of legacy segments are rebuilt", func(t *testing.T) {
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+		require.Nil(t, deleteBloomFilters(paths[0]))
+
+		b := openBucket(t)
+		verify(t, b, "first")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		persistedFilters(t)
+	})
+
+	t.Run("compaction replaces the filters of the old segments", func(t *testing.T) {
+		b := openBucket(t)
+		importSegment(t, b, "second")
+		require.Len(t, segmentPaths(t), 2)
+
+		for b.disk.eligbleForCompaction() {
+			require.Nil(t, b.disk.compactOnce())
+		}
+		verify(t, b, "first", "second")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		primary, secondary := persistedFilters(t)
+		assert.True(t, primary.Test([]byte("first-key-000")))
+		assert.True(t, primary.Test([]byte("second-key-000")))
+		assert.True(t, secondary.Test([]byte("first-secondary-000")))
+		assert.True(t, secondary.Test([]byte("second-secondary-000")))
+
+		filters, err := filepath.Glob(filepath.Join(dirName, "*"+bloomFilterExt))
+		require.Nil(t, err)
+		assert.Len(t, filters, 2)
+
+		b = openBucket(t)
+		verify(t, b, "first", "second")
+		require.Nil(t, b.Shutdown(testCtx()))
+	})
+
+	t.Run("filters of segments which no longer exist are removed", func(t *testing.T) {
+		orphan := filepath.Join(dirName, "segment-123"+bloomFilterExt)
+		require.Nil(t, writeBloomFilter(orphan, nil, 1,
+			bloom.NewWithEstimates(100, 0.001)))
+
+		b := openBucket(t)
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		_, err := os.Stat(orphan)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,385 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
+	"github.com/sirupsen/logrus"
+)
+
+// checksumExt is the extension of the files holding the crc32 checksums of
+// the blocks of a segment file. They are written whenever a segment is
+// flushed or compacted and verified when the segment is loaded, or on demand
+// using Bucket.VerifyIntegrity. The checksums are calculated over the file as
+// it is on disk, so they cover encrypted and compressed segments alike.
+// Segments without checksums, e.g. because they were written before
+// checksums were introduced, get them the first time they are verified.
+const checksumExt = ".crc"
+
+// corruptSegmentExt is appended to segments which failed verification. They
+// are kept on disk for inspection, and a bucket with such a segment is not
+// loaded until it has either been restored or deleted.
+const corruptSegmentExt = ".corrupt"
+
+// ErrQuarantined is the cause of the errors of reads from a bucket with a
+// quarantined segment, as well as of loading such a bucket
+var ErrQuarantined = errors.Errorf("bucket has a quarantined corrupt segment")
+
+// errQuarantinedSegment explains how to deal with the quarantined segment at
+// the path
+func errQuarantinedSegment(path string) error {
+	return errors.Wrapf(ErrQuarantined, "%s: restore the segment from a backup, "+
+		"or delete it to accept the loss of its data", path)
+}
+
+const checksumBlockSize = 64 * 1024
+
+func checksumPath(segmentPath string) string {
+	return strings.TrimSuffix(segmentPath, ".db") + checksumExt
+}
+
+// checksumSegmentPath returns the path of the segment the checksum file at
+// the path belongs to
+func checksumSegmentPath(path string) string {
+	return strings.TrimSuffix(path, checksumExt) + ".db"
+}
+
+// IntegrityReport is the result of verifying the disk segments of a bucket or
+// store against their checksums
+type IntegrityReport struct {
+	// Segments is the number of segments which were verified
+	Segments int
+
+	// Corrupt are the segments which failed verification, they have been
+	// quarantined
+	Corrupt []CorruptSegment
+}
+
+func (r *IntegrityReport) merge(other IntegrityReport) {
+	r.Segments += other.Segments
+	r.Corrupt = append(r.Corrupt, other.Corrupt...)
+}
+
+// CorruptSegment is a segment which failed verification and was moved out of
+// the way, the bucket it belonged to no longer serves reads
+type CorruptSegment struct {
+	// Path is where the segment was quarantined
+	Path   string
+	Reason string
+}
+
+// corruptionError is returned by verifySegment if the segment does not match
+// its checksums, as opposed to the verification itself failing
+type corruptionError struct {
+	msg string
+}
+
+func (e corruptionError) Error() string {
+	return e.msg
+}
+
+func isCorruption(err error) bool {
+	_, ok := errors.Cause(err).(corruptionError)
+	return ok
+}
+
+type segmentChecksums struct {
+	size   uint64
+	blocks []uint32
+}
+
+// checksumSegment calculates the checksums of the segment file at the path,
+// reading it with the priority from the throttle, which may be nil
+func checksumSegment(ctx context.Context, path string,
+	throttle *iothrottle.Throttle, priority iothrottle.Priority) (*segmentChecksums, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := iothrottle.NewReader(ctx, f, throttle, priority)
+	buf := make([]byte, checksumBlockSize)
+	out := &segmentChecksums{}
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			out.blocks = append(out.blocks, crc32.ChecksumIEEE(buf[:n]))
+			out.size += uint64(n)
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return out, nil
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "read segment %s", path)
+		}
+	}
+}
+
+// writeTo persists the checksums. The layout is:
+//
+//	size of the segment file, block size,
+//	crc32 checksum of every block,
+//	crc32 checksum of everything before
+func (c *segmentChecksums) writeTo(path string) error {
+	buf := bytes.NewBuffer(make([]byte, 0, 8+4+4*len(c.blocks)+4))
+	binary.Write(buf, binary.LittleEndian, c.size)
+	binary.Write(buf, binary.LittleEndian, uint32(checksumBlockSize))
+	binary.Write(buf, binary.LittleEndian, c.blocks)
+	binary.Write(buf, binary.LittleEndian, crc32.ChecksumIEEE(buf.Bytes()))
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func readSegmentChecksums(path string) (*segmentChecksums, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contents) < 16 || (len(contents)-16)%4 != 0 {
+		return nil, errors.Errorf("checksum file has invalid length %d", len(contents))
+	}
+
+	body := contents[:len(contents)-4]
+	if crc32.ChecksumIEEE(body) != binary.LittleEndian.Uint32(contents[len(body):]) {
+		return nil, errors.Errorf("checksum of checksum file does not match")
+	}
+
+	if blockSize := binary.LittleEndian.Uint32(body[8:]); blockSize != checksumBlockSize {
+		return nil, errors.Errorf("unsupported block size %d", blockSize)
+	}
+
+	out := &segmentChecksums{
+		size:   binary.LittleEndian.Uint64(body),
+		blocks: make([]uint32, (len(body)-12)/4),
+	}
+	for i := range out.blocks {
+		out.blocks[i] = binary.LittleEndian.Uint32(body[12+4*i:])
+	}
+
+	return out, nil
+}
+
+// writeSegmentChecksums calculates and persists the checksums of a newly
+// written segment
+func writeSegmentChecksums(segmentPath string) error {
+	checksums, err := checksumSegment(context.Background(), segmentPath, nil,
+		iothrottle.PriorityCompaction)
+	if err != nil {
+		return err
+	}
+
+	return checksums.writeTo(checksumPath(segmentPath))
+}
+
+// verifySegment compares the segment file at the path to its checksums. A
+// corruptionError is returned if they don't match. If there are no usable
+// checksums, they are created from the segment as it is.
+func verifySegment(ctx context.Context, segmentPath string,
+	throttle *iothrottle.Throttle, logger logrus.FieldLogger) error {
+	path := checksumPath(segmentPath)
+	expected, err := readSegmentChecksums(path)
+	if err != nil && !os.IsNotExist(err) {
+		logger.WithField("action", "lsm_verify_segment").
+			WithField("path", path).
+			WithError(err).
+			Warn("discarding unreadable segment checksums, they will be recreated")
+	}
+
+	// nothing depends on the verification, it has the lowest priority just
+	// like backups
+	actual, err := checksumSegment(ctx, segmentPath, throttle,
+		iothrottle.PriorityBackup)
+	if err != nil {
+		return err
+	}
+
+	if expected == nil {
+		if err := actual.writeTo(path); err != nil {
+			return errors.Wrapf(err, "write checksums of segment %s", segmentPath)
+		}
+
+		return nil
+	}
+
+	if actual.size != expected.size {
+		return corruptionError{fmt.Sprintf("segment has %d bytes, but %d were written",
+			actual.size, expected.size)}
+	}
+
+	for i := range expected.blocks {
+		if actual.blocks[i] != expected.blocks[i] {
+			return corruptionError{fmt.Sprintf("checksum mismatch in block %d at "+
+				"offset %d", i, i*checksumBlockSize)}
+		}
+	}
+
+	return nil
+}
+
+func deleteSegmentChecksums(segmentPath string) error {
+	path := checksumPath(segmentPath)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "delete checksums %s", path)
+	}
+
+	return nil
+}
+
+// quarantineSegment moves a corrupt segment file out of the way, so it is no
+// longer loaded. Its bloom filters and checksums are derived from the corrupt
+// contents and are deleted.
+func quarantineSegment(segmentPath string, reason error,
+	logger logrus.FieldLogger) (CorruptSegment, error) {
+	if err := deleteBloomFilters(segmentPath); err != nil {
+		return CorruptSegment{}, err
+	}
+
+	if err := deleteSegmentChecksums(segmentPath); err != nil {
+		return CorruptSegment{}, err
+	}
+
+	corruptPath := segmentPath + corruptSegmentExt
+	if err := os.Rename(segmentPath, corruptPath); err != nil {
+		return CorruptSegment{}, errors.Wrapf(err, "quarantine corrupt segment %s",
+			segmentPath)
+	}
+
+	logger.WithField("action", "lsm_verify_segment").
+		WithField("path", segmentPath).
+		WithField("quarantine_path", corruptPath).
+		WithError(reason).
+		Error("segment is corrupt and was quarantined, the bucket no longer serves reads")
+
+	return CorruptSegment{Path: corruptPath, Reason: reason.Error()}, nil
+}
+
+// quarantine retires a loaded segment which failed verification. Readers
+// which still have it pinned keep reading from the memory it was loaded into.
+func (ind *segment) quarantine(reason error) (CorruptSegment, error) {
+	ind.refLock.Lock()
+	defer ind.refLock.Unlock()
+
+	out, err := quarantineSegment(ind.path, reason, ind.logger)
+	if err != nil {
+		return out, err
+	}
+
+	ind.path = out.Path
+	ind.retired = true
+	if ind.refs == 0 {
+		return out, ind.release()
+	}
+
+	return out, nil
+}
+
+// unavailableErr is the error reads fail with, if a segment was quarantined
+func (ig *SegmentGroup) unavailableErr() error {
+	ig.maintenanceLock.RLock()
+	defer ig.maintenanceLock.RUnlock()
+
+	return ig.unavailable
+}
+
+// verifyIntegrity verifies all segments against their checksums and
+// quarantines the corrupt ones, which makes the segment group unavailable.
+// Compactions are paused in the meantime, so the segments remain stable.
+func (ig *SegmentGroup) verifyIntegrity(ctx context.Context) (IntegrityReport, error) {
+	ig.compactionLock.Lock()
+	defer ig.compactionLock.Unlock()
+
+	segments, err := ig.pinSegments()
+	if err != nil {
+		return IntegrityReport{}, err
+	}
+	defer ig.unpinSegments(segments)
+
+	var report IntegrityReport
+	var corrupt []*segment
+	var reasons []error
+	for _, seg := range segments {
+		err := verifySegment(ctx, seg.path, ig.throttle, ig.logger)
+		if err != nil && !isCorruption(err) {
+			return report, errors.Wrapf(err, "verify segment %s", seg.path)
+		}
+
+		report.Segments++
+		if err != nil {
+			corrupt = append(corrupt, seg)
+			reasons = append(reasons, err)
+		}
+	}
+
+	if len(corrupt) == 0 {
+		return report, nil
+	}
+
+	ig.maintenanceLock.Lock()
+	defer ig.maintenanceLock.Unlock()
+
+	isCorrupt := map[*segment]bool{}
+	for _, seg := range corrupt {
+		isCorrupt[seg] = true
+	}
+
+	remaining := make([]*segment, 0, len(ig.segments)-len(corrupt))
+	for _, seg := range ig.segments {
+		if !isCorrupt[seg] {
+			remaining = append(remaining, seg)
+		}
+	}
+	ig.segments = remaining
+
+	for i, seg := range corrupt {
+		quarantined, err := seg.quarantine(reasons[i])
+		if err != nil {
+			return report, err
+		}
+
+		report.Corrupt = append(report.Corrupt, quarantined)
+		if ig.unavailable == nil {
+			ig.unavailable = errQuarantinedSegment(quarantined.Path)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentChecksums(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	openBucket := func(t *testing.T) *Bucket {
+		b, err := NewBucket(testCtx(), dirName, nullLogger(),
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	importSegment := func(t *testing.T, b *Bucket, prefix string) {
+		for i := 0; i < 1000; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("%s-key-%03d", prefix, i)),
+				[]byte(fmt.Sprintf("%s-value-%03d", prefix, i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	verify := func(t *testing.T, b *Bucket, prefix string, present bool) {
+		for i := 0; i < 1000; i++ {
+			res, err := b.Get([]byte(fmt.Sprintf("%s-key-%03d", prefix, i)))
+			require.Nil(t, err)
+			if present {
+				assert.Equal(t, []byte(fmt.Sprintf("%s-value-%03d", prefix, i)), res)
+			} else {
+				assert.Nil(t, res)
+			}
+		}
+	}
+
+	segmentPaths := func(t *testing.T) []string {
+		paths, err := filepath.Glob(filepath.Join(dirName, "*.db"))
+		require.Nil(t, err)
+		return paths
+	}
+
+	assertValidChecksums := func(t *testing.T, segmentPath string) {
+		_, err := readSegmentChecksums(checksumPath(segmentPath))
+		require.Nil(t, err)
+		assert.Nil(t, verifySegment(testCtx(), segmentPath, nil, nullLogger()))
+	}
+
+	// corrupt flips a byte in the middle of the segment in place, so a
+	// mapped segment is not truncated while it is read
+	corrupt := func(t *testing.T, segmentPath string) {
+		f, err := os.OpenFile(segmentPath, os.O_RDWR, 0o666)
+		require.Nil(t, err)
+		defer f.Close()
+
+		info, err := f.Stat()
+		require.Nil(t, err)
+
+		b := make([]byte, 1)
+		_, err = f.ReadAt(b, info.Size()/2)
+		require.Nil(t, err)
+		b[0] ^= 0xff
+		_, err = f.WriteAt(b, info.Size()/2)
+		require.Nil(t, err)
+	}
+
+	t.Run("flushing a segment writes its checksums", func(t *testing.T) {
+		b := openBucket(t)
+		importSegment(t, b, "first")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+		assertValidChecksums(t, paths[0])
+	})
+
+	t.Run("missing checksums of legacy segments are created", func(t *testing.T) {
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+		require.Nil(t, deleteSegmentChecksums(paths[0]))
+
+		b := openBucket(t)
+		verify(t, b, "first", true)
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		assertValidChecksums(t, paths[0])
+	})
+
+	t.Run("compaction writes the checksums of the compacted segment", func(t *testing.T) {
+		b := openBucket(t)
+		importSegment(t, b, "second")
+		require.Len(t, segmentPaths(t), 2)
+
+		for b.disk.eligbleForCompaction() {
+			require.Nil(t, b.disk.compactOnce())
+		}
+		verify(t, b, "first", true)
+		verify(t, b, "second", true)
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		paths := segmentPaths(t)
+		require.Len(t, paths, 1)
+		assertValidChecksums(t, paths[0])
+
+		checksums, err := filepath.Glob(filepath.Join(dirName, "*"+checksumExt))
+		require.Nil(t, err)
+		assert.Len(t, checksums, 1)
+	})
+
+	t.Run("a corrupt segment is quarantined on startup", func(t *testing.T) {
+		b := openBucket(t)
+		importSegment(t, b, "third")
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		paths := segmentPaths(t)
+		require.Len(t, paths, 2)
+		corrupt(t, paths[0])
+
+		// without the older segment the values it holds would be gone, while
+		// those it had overwritten or deleted in even older ones would return
+		_, err := NewBucket(testCtx(), dirName, nullLogger(),
+			WithStrategy(StrategyReplace))
+		require.NotNil(t, err)
+		assert.Equal(t, ErrQuarantined, errors.Cause(err))
+		assert.Contains(t, err.Error(), paths[0]+corruptSegmentExt)
+
+		assert.Equal(t, []string{paths[1]}, segmentPaths(t))
+		_, err = os.Stat(paths[0] + corruptSegmentExt)
+		assert.Nil(t, err)
+
+		t.Run("the bucket is not loaded until the segment is dealt with", func(t *testing.T) {
+			_, err := NewBucket(testCtx(), dirName, nullLogger(),
+				WithStrategy(StrategyReplace))
+			require.NotNil(t, err)
+			assert.Equal(t, ErrQuarantined, errors.Cause(err))
+		})
+
+		t.Run("deleting the quarantined segment accepts the loss", func(t *testing.T) {
+			require.Nil(t, os.Remove(paths[0]+corruptSegmentExt))
+
+			b := openBucket(t)
+			verify(t, b, "first", false)
+			verify(t, b, "second", false)
+			verify(t, b, "third", true)
+			require.Nil(t, b.Shutdown(testCtx()))
+		})
+	})
+
+	t.Run("verifying a loaded bucket quarantines corrupt segments", func(t *testing.T) {
+		b := openBucket(t)
+		importSegment(t, b, "fourth")
+
+		report, err := b.VerifyIntegrity(testCtx())
+		require.Nil(t, err)
+		assert.Equal(t, 2, report.Segments)
+		assert.Len(t, report.Corrupt, 0)
+
+		paths := segmentPaths(t)
+		require.Len(t, paths, 2)
+		corrupt(t, paths[1])
+
+		report, err = b.VerifyIntegrity(testCtx())
+		require.Nil(t, err)
+		assert.Equal(t, 2, report.Segments)
+		require.Len(t, report.Corrupt, 1)
+		assert.Equal(t, paths[1]+corruptSegmentExt, report.Corrupt[0].Path)
+		assert.Contains(t, report.Corrupt[0].Reason, "checksum mismatch")
+
+		// the older segment alone would serve outdated values
+		_, err = b.Get([]byte("third-key-000"))
+		assert.Equal(t, ErrQuarantined, errors.Cause(err))
+		_, _, err = b.disk.newCursors()
+		assert.Equal(t, ErrQuarantined, errors.Cause(err))
+		assert.False(t, b.disk.eligbleForCompaction())
+
+		// writes are still accepted
+		require.Nil(t, b.Put([]byte("fifth-key"), []byte("fifth-value")))
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		assert.Equal(t, []string{paths[0]}, segmentPaths(t)[:1])
+		_, err = NewBucket(testCtx(), dirName, nullLogger(),
+			WithStrategy(StrategyReplace))
+		assert.Equal(t, ErrQuarantined, errors.Cause(err))
+
+		require.Nil(t, os.Remove(paths[1]+corruptSegmentExt))
+	})
+
+	t.Run("checksums of segments which no longer exist are removed", func(t *testing.T) {
+		orphan := filepath.Join(dirName, "segment-123"+checksumExt)
+		require.Nil(t, (&segmentChecksums{}).writeTo(orphan))
+
+		b := openBucket(t)
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		_, err := os.Stat(orphan)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
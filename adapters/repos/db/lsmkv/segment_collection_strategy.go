@@ -12,7 +12,6 @@
 package lsmkv
 
 import (
-	"bytes"
 	"encoding/binary"
 
 	"github.com/pkg/errors"
@@ -30,6 +29,11 @@ func (i *segment) getCollection(key []byte) ([]value, error) {
 		return nil, NotFound
 	}
 
+	if err := i.pin(); err != nil {
+		return nil, err
+	}
+	defer i.unpinAndLog()
+
 	node, err := i.index.Get(key)
 	if err != nil {
 		if err == segmentindex.NotFound {
@@ -39,7 +43,12 @@ func (i *segment) getCollection(key []byte) ([]value, error) {
 		}
 	}
 
-	return i.collectionStratParseData(i.contents[node.Start:node.End])
+	data, err := i.contentBytes(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.collectionStratParseData(data)
 }
 
 func (i *segment) collectionStratParseData(in []byte) ([]value, error) {
@@ -61,7 +70,10 @@ func (i *segment) collectionStratParseData(in []byte) ([]value, error) {
 		valueLen := binary.LittleEndian.Uint64(in[offset : offset+8])
 		offset += 8
 
-		values[valueIndex].value = in[offset : offset+int(valueLen)]
+		// the value is copied, as the segment may be unmapped once the read
+		// has completed
+		values[valueIndex].value = make([]byte, valueLen)
+		copy(values[valueIndex].value, in[offset:offset+int(valueLen)])
 		offset += int(valueLen)
 
 		valueIndex++
@@ -70,12 +82,11 @@ func (i *segment) collectionStratParseData(in []byte) ([]value, error) {
 	return values, nil
 }
 
-func (i *segment) collectionStratParseDataWithKey(in []byte) (segmentCollectionNode, error) {
-	r := bytes.NewReader(in)
-
-	if len(in) == 0 {
+func (i *segment) collectionStratParseDataWithKey(start,
+	end uint64) (segmentCollectionNode, error) {
+	if start >= end {
 		return segmentCollectionNode{}, NotFound
 	}
 
-	return ParseCollectionNode(r)
+	return ParseCollectionNode(i.contentReader(start, end))
 }
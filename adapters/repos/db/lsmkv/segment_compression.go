@@ -0,0 +1,380 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// The data and indices of a segment can be compressed in blocks, see
+// WithCompression. The header of the segment is kept uncompressed, as it is
+// only known and written once everything else has been written. A compressed
+// segment file is laid out as follows:
+//
+//	magic, algorithm, block size,
+//	segment header,
+//	compressed blocks,
+//	for every block: offset of the compressed block, its compressed size,
+//	uncompressed size of the segment, block count, offset of the block index
+//
+// Compressed segments are decompressed onto the heap when they are loaded,
+// just like encrypted ones, so everything else can read them like any other
+// segment. The block index allows for decompressing them without having to
+// parse the compressed stream. If a bucket is encrypted, its compressed
+// segments are encrypted as a whole.
+const (
+	CompressionNone   = "none"
+	CompressionSnappy = "snappy"
+	CompressionZstd   = "zstd"
+)
+
+const (
+	compressedSegmentMagic = "WVLSMZ01"
+
+	// compressedPrefixSize is the size of the magic, the algorithm and the
+	// block size, which precede the segment header
+	compressedPrefixSize = len(compressedSegmentMagic) + 1 + 4
+
+	// compressedTrailerSize is the size of the uncompressed size, the block
+	// count and the offset of the block index
+	compressedTrailerSize = 8 + 4 + 8
+
+	compressedBlockIndexEntrySize = 8 + 4
+
+	defaultCompressionBlockSize = 64 * 1024
+)
+
+type compressionAlgorithm uint8
+
+const (
+	compressionAlgorithmSnappy compressionAlgorithm = iota + 1
+	compressionAlgorithmZstd
+)
+
+func compressionAlgorithmFromString(in string) (compressionAlgorithm, bool, error) {
+	switch in {
+	case "", CompressionNone:
+		return 0, false, nil
+	case CompressionSnappy:
+		return compressionAlgorithmSnappy, true, nil
+	case CompressionZstd:
+		return compressionAlgorithmZstd, true, nil
+	default:
+		return 0, false, errors.Errorf("unrecognized compression %q", in)
+	}
+}
+
+// the zstd encoder and decoder are safe for concurrent use when compressing
+// and decompressing entire blocks, they are only created once needed
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+
+	return zstdErr
+}
+
+func (a compressionAlgorithm) compress(dst, src []byte) ([]byte, error) {
+	switch a {
+	case compressionAlgorithmSnappy:
+		return snappy.Encode(dst, src), nil
+	case compressionAlgorithmZstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	default:
+		return nil, errors.Errorf("unsupported compression algorithm %d", a)
+	}
+}
+
+func (a compressionAlgorithm) decompress(dst, src []byte) ([]byte, error) {
+	switch a {
+	case compressionAlgorithmSnappy:
+		return snappy.Decode(dst[:cap(dst)], src)
+	case compressionAlgorithmZstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	default:
+		return nil, errors.Errorf("unsupported compression algorithm %d", a)
+	}
+}
+
+type compressedBlock struct {
+	offset uint64
+	size   uint32
+}
+
+// compressingWriter compresses everything written after the segment header
+// in blocks. The header can be overwritten at any time, as the compactors
+// write it last, everything else can only be appended.
+type compressingWriter struct {
+	w         io.WriteSeeker
+	algorithm compressionAlgorithm
+	blockSize int
+
+	pos  int64 // position within the uncompressed segment
+	size int64 // size of the uncompressed segment
+
+	block      []byte // uncompressed contents of the current block
+	compressed []byte // reused for compressing blocks
+	blocks     []compressedBlock
+	fileOffset int64 // where the next block is written to
+}
+
+func newCompressingWriter(w io.WriteSeeker, algorithm compressionAlgorithm,
+	blockSize int) (*compressingWriter, error) {
+	prefix := make([]byte, compressedPrefixSize+SegmentHeaderSize)
+	copy(prefix, compressedSegmentMagic)
+	prefix[len(compressedSegmentMagic)] = byte(algorithm)
+	binary.LittleEndian.PutUint32(prefix[len(compressedSegmentMagic)+1:],
+		uint32(blockSize))
+
+	if _, err := w.Write(prefix); err != nil {
+		return nil, errors.Wrap(err, "write compressed segment prefix")
+	}
+
+	return &compressingWriter{
+		w:          w,
+		algorithm:  algorithm,
+		blockSize:  blockSize,
+		block:      make([]byte, 0, blockSize),
+		fileOffset: int64(len(prefix)),
+	}, nil
+}
+
+func (c *compressingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if c.pos < SegmentHeaderSize {
+			n := int(SegmentHeaderSize - c.pos)
+			if n > len(p) {
+				n = len(p)
+			}
+
+			if err := c.writeHeader(p[:n]); err != nil {
+				return written, err
+			}
+
+			written += n
+			p = p[n:]
+			continue
+		}
+
+		if c.pos != c.size {
+			return written, errors.Errorf("compressed segments can only be appended to")
+		}
+
+		n := c.blockSize - len(c.block)
+		if n > len(p) {
+			n = len(p)
+		}
+
+		c.block = append(c.block, p[:n]...)
+		c.pos += int64(n)
+		c.size = c.pos
+		written += n
+		p = p[n:]
+
+		if len(c.block) == c.blockSize {
+			if err := c.flushBlock(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// writeHeader writes p into the uncompressed header at the current position
+func (c *compressingWriter) writeHeader(p []byte) error {
+	if _, err := c.w.Seek(int64(compressedPrefixSize)+c.pos, io.SeekStart); err != nil {
+		return errors.Wrap(err, "seek to segment header")
+	}
+
+	if _, err := c.w.Write(p); err != nil {
+		return errors.Wrap(err, "write segment header")
+	}
+
+	if _, err := c.w.Seek(c.fileOffset, io.SeekStart); err != nil {
+		return errors.Wrap(err, "seek to end of compressed segment")
+	}
+
+	c.pos += int64(len(p))
+	if c.pos > c.size {
+		c.size = c.pos
+	}
+
+	return nil
+}
+
+// Seek supports moving to the header and back to the end of the segment
+func (c *compressingWriter) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += c.pos
+	case io.SeekEnd:
+		offset += c.size
+	default:
+		return c.pos, errors.Errorf("invalid whence %d", whence)
+	}
+
+	if offset < 0 || (offset > SegmentHeaderSize && offset != c.size) {
+		return c.pos, errors.Errorf("compressed segments can only be seeked to " +
+			"the header or the end")
+	}
+
+	c.pos = offset
+	return c.pos, nil
+}
+
+func (c *compressingWriter) flushBlock() error {
+	if len(c.block) == 0 {
+		return nil
+	}
+
+	compressed, err := c.algorithm.compress(c.compressed[:0], c.block)
+	if err != nil {
+		return errors.Wrap(err, "compress block")
+	}
+	c.compressed = compressed
+
+	if _, err := c.w.Write(compressed); err != nil {
+		return errors.Wrap(err, "write compressed block")
+	}
+
+	c.blocks = append(c.blocks, compressedBlock{
+		offset: uint64(c.fileOffset),
+		size:   uint32(len(compressed)),
+	})
+	c.fileOffset += int64(len(compressed))
+	c.block = c.block[:0]
+	return nil
+}
+
+// finish writes the last block and the block index, nothing can be written
+// afterwards
+func (c *compressingWriter) finish() error {
+	if err := c.flushBlock(); err != nil {
+		return err
+	}
+
+	buf := make([]byte, len(c.blocks)*compressedBlockIndexEntrySize+
+		compressedTrailerSize)
+	for i, block := range c.blocks {
+		binary.LittleEndian.PutUint64(buf[i*compressedBlockIndexEntrySize:],
+			block.offset)
+		binary.LittleEndian.PutUint32(buf[i*compressedBlockIndexEntrySize+8:],
+			block.size)
+	}
+
+	trailer := buf[len(c.blocks)*compressedBlockIndexEntrySize:]
+	binary.LittleEndian.PutUint64(trailer, uint64(c.size))
+	binary.LittleEndian.PutUint32(trailer[8:], uint32(len(c.blocks)))
+	binary.LittleEndian.PutUint64(trailer[12:], uint64(c.fileOffset))
+
+	if _, err := c.w.Write(buf); err != nil {
+		return errors.Wrap(err, "write block index")
+	}
+
+	c.fileOffset += int64(len(buf))
+	return nil
+}
+
+func isCompressedSegment(contents []byte) bool {
+	return len(contents) >= len(compressedSegmentMagic) &&
+		bytes.Equal(contents[:len(compressedSegmentMagic)], []byte(compressedSegmentMagic))
+}
+
+// decompressSegment returns the plain segment of the contents of a
+// compressed segment file
+func decompressSegment(contents []byte) ([]byte, error) {
+	if len(contents) < compressedPrefixSize+SegmentHeaderSize+compressedTrailerSize {
+		return nil, errors.Errorf("compressed segment is too short")
+	}
+
+	algorithm := compressionAlgorithm(contents[len(compressedSegmentMagic)])
+	blockSize := int(binary.LittleEndian.Uint32(contents[len(compressedSegmentMagic)+1:]))
+
+	trailer := contents[len(contents)-compressedTrailerSize:]
+	size := binary.LittleEndian.Uint64(trailer)
+	blockCount := int(binary.LittleEndian.Uint32(trailer[8:]))
+	indexOffset := binary.LittleEndian.Uint64(trailer[12:])
+
+	indexEnd := indexOffset + uint64(blockCount*compressedBlockIndexEntrySize)
+	if indexEnd != uint64(len(contents)-compressedTrailerSize) {
+		return nil, errors.Errorf("invalid block index of compressed segment")
+	}
+
+	if size < SegmentHeaderSize ||
+		uint64(blockCount) != (size-SegmentHeaderSize+uint64(blockSize)-1)/uint64(blockSize) {
+		return nil, errors.Errorf("compressed segment of %d bytes can not have %d "+
+			"blocks of %d bytes", size, blockCount, blockSize)
+	}
+
+	out := make([]byte, size)
+	copy(out, contents[compressedPrefixSize:compressedPrefixSize+SegmentHeaderSize])
+
+	index := contents[indexOffset:indexEnd]
+	for i := 0; i < blockCount; i++ {
+		offset := binary.LittleEndian.Uint64(index[i*compressedBlockIndexEntrySize:])
+		blockLen := uint64(binary.LittleEndian.Uint32(index[i*compressedBlockIndexEntrySize+8:]))
+		if offset+blockLen > indexOffset {
+			return nil, errors.Errorf("block %d exceeds the compressed segment", i)
+		}
+
+		start := SegmentHeaderSize + i*blockSize
+		end := start + blockSize
+		if end > len(out) {
+			end = len(out)
+		}
+
+		block, err := algorithm.decompress(out[start:start:end],
+			contents[offset:offset+blockLen])
+		if err != nil {
+			return nil, errors.Wrapf(err, "decompress block %d", i)
+		}
+
+		if len(block) != end-start {
+			return nil, errors.Errorf("block %d has %d bytes, expected %d", i,
+				len(block), end-start)
+		}
+
+		if &block[0] != &out[start] {
+			// the decompressor did not decompress in place
+			copy(out[start:end], block)
+		}
+	}
+
+	return out, nil
+}
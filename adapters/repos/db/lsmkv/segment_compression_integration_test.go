@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedSegments(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+
+	// the cycle never runs as part of these tests, they compact explicitly
+	policy := CompactionPolicy{Interval: time.Hour, MinSegments: 2, MaxSegments: 4}
+
+	newBucket := func(t *testing.T, dirName, strategy string,
+		opts ...BucketOption) *Bucket {
+		opts = append([]BucketOption{
+			WithStrategy(strategy),
+			withCompactions(NewCompactions(policy)),
+		}, opts...)
+		b, err := NewBucket(testCtx(), dirName, nullLogger(), opts...)
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	newDir := func() (string, func()) {
+		dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+		os.MkdirAll(dirName, 0o777)
+		return dirName, func() { os.RemoveAll(dirName) }
+	}
+
+	// repetitive values, so they compress well
+	value := func(i int) []byte {
+		return bytes.Repeat([]byte(fmt.Sprintf("value-%d;", i)), 20)
+	}
+
+	segmentSize := func(t *testing.T, dirName string) int64 {
+		files, err := filepath.Glob(filepath.Join(dirName, "segment-*.db"))
+		require.Nil(t, err)
+
+		var size int64
+		for _, file := range files {
+			info, err := os.Stat(file)
+			require.Nil(t, err)
+			size += info.Size()
+		}
+		return size
+	}
+
+	for _, compression := range []string{CompressionSnappy, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			t.Run("replace", func(t *testing.T) {
+				dirName, cleanup := newDir()
+				defer cleanup()
+
+				b := newBucket(t, dirName, StrategyReplace, WithCompression(compression))
+				for s := 0; s < 2; s++ {
+					for i := s * 5000; i < (s+1)*5000; i++ {
+						require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%06d", i)), value(i)))
+					}
+					require.Nil(t, b.FlushAndSwitch())
+				}
+
+				check := func(t *testing.T, b *Bucket) {
+					for i := 0; i < 10000; i += 997 {
+						v, err := b.Get([]byte(fmt.Sprintf("key-%06d", i)))
+						require.Nil(t, err)
+						assert.Equal(t, value(i), v)
+					}
+
+					count := 0
+					c := b.Cursor()
+					for k, _ := c.First(); k != nil; k, _ = c.Next() {
+						count++
+					}
+					c.Close()
+					assert.Equal(t, 10000, count)
+				}
+
+				check(t, b)
+
+				require.Nil(t, b.disk.compactOnce())
+				require.Len(t, b.disk.segments, 1)
+				check(t, b)
+
+				require.Nil(t, b.Shutdown(testCtx()))
+
+				b = newBucket(t, dirName, StrategyReplace, WithCompression(compression))
+				check(t, b)
+				require.Nil(t, b.Shutdown(testCtx()))
+			})
+
+			t.Run("set", func(t *testing.T) {
+				dirName, cleanup := newDir()
+				defer cleanup()
+
+				b := newBucket(t, dirName, StrategySetCollection, WithCompression(compression))
+				for s := 0; s < 2; s++ {
+					for i := 0; i < 1000; i++ {
+						require.Nil(t, b.SetAdd([]byte(fmt.Sprintf("key-%04d", i)),
+							[][]byte{value(s), value(i)}))
+					}
+					require.Nil(t, b.FlushAndSwitch())
+				}
+				require.Nil(t, b.disk.compactOnce())
+
+				list, err := b.SetList([]byte("key-0042"))
+				require.Nil(t, err)
+				assert.ElementsMatch(t, [][]byte{value(0), value(1), value(42)}, list)
+				require.Nil(t, b.Shutdown(testCtx()))
+			})
+
+			t.Run("map", func(t *testing.T) {
+				dirName, cleanup := newDir()
+				defer cleanup()
+
+				b := newBucket(t, dirName, StrategyMapCollection, WithCompression(compression))
+				for s := 0; s < 2; s++ {
+					for i := 0; i < 1000; i++ {
+						require.Nil(t, b.MapSet([]byte(fmt.Sprintf("key-%04d", i)), MapPair{
+							Key:   []byte(fmt.Sprintf("map-key-%d", s)),
+							Value: value(i),
+						}))
+					}
+					require.Nil(t, b.FlushAndSwitch())
+				}
+				require.Nil(t, b.disk.compactOnce())
+
+				pairs, err := b.MapList([]byte("key-0042"))
+				require.Nil(t, err)
+				assert.Equal(t, []MapPair{
+					{Key: []byte("map-key-0"), Value: value(42)},
+					{Key: []byte("map-key-1"), Value: value(42)},
+				}, pairs)
+				require.Nil(t, b.Shutdown(testCtx()))
+			})
+
+			t.Run("compressed segments are smaller", func(t *testing.T) {
+				plainDir, cleanupPlain := newDir()
+				defer cleanupPlain()
+				compressedDir, cleanupCompressed := newDir()
+				defer cleanupCompressed()
+
+				for _, dir := range []string{plainDir, compressedDir} {
+					var opts []BucketOption
+					if dir == compressedDir {
+						opts = append(opts, WithCompression(compression))
+					}
+
+					b := newBucket(t, dir, StrategyReplace, opts...)
+					for i := 0; i < 5000; i++ {
+						require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%06d", i)), value(i)))
+					}
+					require.Nil(t, b.FlushAndSwitch())
+					require.Nil(t, b.Shutdown(testCtx()))
+				}
+
+				assert.Less(t, segmentSize(t, compressedDir)*2,
+					segmentSize(t, plainDir))
+			})
+		})
+	}
+
+	t.Run("existing segments migrate through compactions", func(t *testing.T) {
+		dirName, cleanup := newDir()
+		defer cleanup()
+
+		b := newBucket(t, dirName, StrategyReplace)
+		require.Nil(t, b.Put([]byte("plain"), value(1)))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		b = newBucket(t, dirName, StrategyReplace, WithCompression(CompressionZstd))
+		require.Nil(t, b.Put([]byte("compressed"), value(2)))
+		require.Nil(t, b.FlushAndSwitch())
+
+		// a plain and a compressed segment can be read side by side
+		assert.False(t, isCompressedSegment(b.disk.segments[0].readRaw(t)))
+		assert.True(t, isCompressedSegment(b.disk.segments[1].readRaw(t)))
+
+		require.Nil(t, b.disk.compactOnce())
+		require.Len(t, b.disk.segments, 1)
+		assert.True(t, isCompressedSegment(b.disk.segments[0].readRaw(t)))
+
+		v, err := b.Get([]byte("plain"))
+		require.Nil(t, err)
+		assert.Equal(t, value(1), v)
+		v, err = b.Get([]byte("compressed"))
+		require.Nil(t, err)
+		assert.Equal(t, value(2), v)
+		require.Nil(t, b.Shutdown(testCtx()))
+	})
+
+	t.Run("encrypted and compressed", func(t *testing.T) {
+		dirName, cleanup := newDir()
+		defer cleanup()
+
+		cipher, err := encryption.New(bytes.Repeat([]byte{7}, 32))
+		require.Nil(t, err)
+
+		b := newBucket(t, dirName, StrategyReplace, withEncryption(cipher),
+			WithCompression(CompressionSnappy))
+		require.Nil(t, b.Put([]byte("key"), []byte("secret-value")))
+		require.Nil(t, b.FlushAndSwitch())
+		contents := b.disk.segments[0].readRaw(t)
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		assert.True(t, encryption.IsEncrypted(contents))
+		assert.False(t, bytes.Contains(contents, []byte("secret")))
+
+		b = newBucket(t, dirName, StrategyReplace, withEncryption(cipher),
+			WithCompression(CompressionSnappy))
+		v, err := b.Get([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("secret-value"), v)
+		require.Nil(t, b.Shutdown(testCtx()))
+	})
+
+	t.Run("an unknown compression is rejected", func(t *testing.T) {
+		dirName, cleanup := newDir()
+		defer cleanup()
+
+		_, err := NewBucket(testCtx(), dirName, nullLogger(),
+			WithCompression("lz4"))
+		assert.NotNil(t, err)
+	})
+}
+
+// readRaw returns the contents of the segment file as they are on disk
+func (ind *segment) readRaw(t *testing.T) []byte {
+	contents, err := ioutil.ReadFile(ind.path)
+	require.Nil(t, err)
+	return contents
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressingWriter(t *testing.T) {
+	plain := make([]byte, SegmentHeaderSize+10*1000+17)
+	rand.New(rand.NewSource(7)).Read(plain[SegmentHeaderSize:])
+	copy(plain, "the real header!")
+
+	for _, algorithm := range []compressionAlgorithm{
+		compressionAlgorithmSnappy, compressionAlgorithmZstd,
+	} {
+		f, err := ioutil.TempFile("", "compressed-segment")
+		require.Nil(t, err)
+		defer os.Remove(f.Name())
+
+		w, err := newCompressingWriter(f, algorithm, 1000)
+		require.Nil(t, err)
+
+		// like the compactors, write a placeholder header first and the real
+		// one once everything else is written
+		_, err = w.Write(make([]byte, SegmentHeaderSize))
+		require.Nil(t, err)
+		for rest := plain[SegmentHeaderSize:]; len(rest) > 0; {
+			n := 333
+			if n > len(rest) {
+				n = len(rest)
+			}
+			_, err := w.Write(rest[:n])
+			require.Nil(t, err)
+			rest = rest[n:]
+		}
+
+		_, err = w.Seek(0, io.SeekStart)
+		require.Nil(t, err)
+		_, err = w.Write(plain[:SegmentHeaderSize])
+		require.Nil(t, err)
+
+		_, err = w.Seek(100, io.SeekStart)
+		assert.NotNil(t, err, "seeking into the compressed data is not possible")
+
+		require.Nil(t, w.finish())
+		require.Nil(t, f.Close())
+
+		contents, err := ioutil.ReadFile(f.Name())
+		require.Nil(t, err)
+		require.True(t, isCompressedSegment(contents))
+
+		out, err := decompressSegment(contents)
+		require.Nil(t, err)
+		assert.True(t, bytes.Equal(plain, out))
+
+		_, err = decompressSegment(contents[:len(contents)-1])
+		assert.NotNil(t, err, "a truncated segment is detected")
+	}
+
+	assert.False(t, isCompressedSegment(plain))
+}
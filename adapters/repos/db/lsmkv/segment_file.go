@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"io"
+	"os"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+)
+
+// segmentFile is a newly created segment or commit log file. Its contents are
+// encrypted if the bucket has a cipher, otherwise they are written to the
+// file as they are. Segments can additionally be compressed, see compress.
+type segmentFile struct {
+	io.WriteSeeker
+	file       *os.File
+	encrypted  *encryption.Writer
+	compressed *compressingWriter
+}
+
+func createSegmentFile(path string, c *encryption.Cipher) (*segmentFile, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if c == nil {
+		return &segmentFile{WriteSeeker: f, file: f}, nil
+	}
+
+	w, err := encryption.NewWriter(f, c)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &segmentFile{WriteSeeker: w, file: f, encrypted: w}, nil
+}
+
+// compress makes the segment be written in compressed blocks, it must be
+// called before anything is written. Commit logs are never compressed.
+func (f *segmentFile) compress(algorithm string) error {
+	a, ok, err := compressionAlgorithmFromString(algorithm)
+	if err != nil || !ok {
+		return err
+	}
+
+	w, err := newCompressingWriter(f.WriteSeeker, a, defaultCompressionBlockSize)
+	if err != nil {
+		return err
+	}
+
+	f.WriteSeeker = w
+	f.compressed = w
+	return nil
+}
+
+// flush hands the buffered block of an encrypted file to the os, plain text
+// files are not buffered
+func (f *segmentFile) flush() error {
+	if f.encrypted == nil {
+		return nil
+	}
+
+	return f.encrypted.Flush()
+}
+
+func (f *segmentFile) close() error {
+	if f.compressed != nil {
+		if err := f.compressed.finish(); err != nil {
+			f.file.Close()
+			return err
+		}
+	}
+
+	if err := f.flush(); err != nil {
+		f.file.Close()
+		return err
+	}
+
+	return f.file.Close()
+}
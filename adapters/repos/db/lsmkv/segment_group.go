@@ -13,19 +13,24 @@ package lsmkv
 
 import (
 	"context"
-	"fmt"
 	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/sirupsen/logrus"
 )
 
+// obsoleteSegmentExt is appended to segments which have been replaced, but
+// are still read from. Such files are left over if the node stopped before
+// the last reader released them.
+const obsoleteSegmentExt = ".obsolete"
+
 type SegmentGroup struct {
 	segments []*segment
 
@@ -34,27 +39,100 @@ type SegmentGroup struct {
 	maintenanceLock sync.RWMutex
 	dir             string
 
+	// stopCompactionCycle is nil if there is no compaction cycle
 	stopCompactionCycle chan struct{}
 
+	// compactions holds the policy by which the segments are compacted and
+	// allows pausing the compactions of all stores at once, it may be nil
+	compactions *Compactions
+
+	// compactionLock is held for the duration of a single compaction, holding
+	// it from the outside (e.g. for a backup) pauses compactions without
+	// having to stop the cycle
+	compactionLock sync.Mutex
+
 	logger logrus.FieldLogger
+
+	// handles is shared by all segment groups of the stores using the same
+	// budget, it may be nil
+	handles *HandleBudget
+
+	// throttle limits the disk throughput of compactions, it may be nil
+	throttle *iothrottle.Throttle
+
+	// cipher encrypts compacted segments and decrypts encrypted ones, it may
+	// be nil
+	cipher *encryption.Cipher
+
+	// compression is the algorithm compacted segments are compressed with,
+	// they are not compressed if it is empty
+	compression string
+
+	// unavailable is set once a segment was quarantined while the bucket is
+	// loaded. The remaining segments may hold values and deletions the
+	// quarantined one had overwritten, so reads fail from then on instead of
+	// serving them. It is guarded by the maintenanceLock.
+	unavailable error
 }
 
-func newSegmentGroup(dir string,
-	compactionCycle time.Duration, logger logrus.FieldLogger) (*SegmentGroup, error) {
+func newSegmentGroup(dir string, compactions *Compactions,
+	logger logrus.FieldLogger, handles *HandleBudget,
+	throttle *iothrottle.Throttle, cipher *encryption.Cipher,
+	compression string) (*SegmentGroup, error) {
 	list, err := ioutil.ReadDir(dir)
 	if err != nil {
 		return nil, err
 	}
 
 	out := &SegmentGroup{
-		segments:            make([]*segment, len(list)),
-		dir:                 dir,
-		logger:              logger,
-		stopCompactionCycle: make(chan struct{}),
+		segments:    make([]*segment, len(list)),
+		dir:         dir,
+		logger:      logger,
+		handles:     handles,
+		throttle:    throttle,
+		cipher:      cipher,
+		compactions: compactions,
+		compression: compression,
 	}
 
 	segmentIndex := 0
 	for _, fileInfo := range list {
+		if filepath.Ext(fileInfo.Name()) == obsoleteSegmentExt {
+			if err := os.Remove(filepath.Join(dir, fileInfo.Name())); err != nil {
+				return nil, errors.Wrapf(err, "delete obsolete segment %s", fileInfo.Name())
+			}
+
+			continue
+		}
+
+		if ext := filepath.Ext(fileInfo.Name()); ext == bloomFilterExt || ext == checksumExt {
+			// the bloom filters and checksums of existing segments are loaded
+			// with them, only those of segments which no longer exist are removed
+			path := filepath.Join(dir, fileInfo.Name())
+			segmentPath := bloomFilterSegmentPath(path)
+			if ext == checksumExt {
+				segmentPath = checksumSegmentPath(path)
+			}
+
+			ok, err := fileExists(segmentPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "check for segment of %s",
+					fileInfo.Name())
+			}
+
+			if !ok {
+				if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+					return nil, errors.Wrapf(err, "delete %s", fileInfo.Name())
+				}
+			}
+
+			continue
+		}
+
+		if filepath.Ext(fileInfo.Name()) == corruptSegmentExt {
+			return nil, errQuarantinedSegment(filepath.Join(dir, fileInfo.Name()))
+		}
+
 		if filepath.Ext(fileInfo.Name()) != ".db" {
 			// skip, this could be commit log, etc.
 			continue
@@ -75,6 +153,16 @@ func newSegmentGroup(dir string,
 				return nil, errors.Wrapf(err, "delete corrupt segment %s", fileInfo.Name())
 			}
 
+			if err := deleteBloomFilters(filepath.Join(dir, fileInfo.Name())); err != nil {
+				return nil, errors.Wrapf(err, "delete bloom filters of corrupt segment %s",
+					fileInfo.Name())
+			}
+
+			if err := deleteSegmentChecksums(filepath.Join(dir, fileInfo.Name())); err != nil {
+				return nil, errors.Wrapf(err, "delete checksums of corrupt segment %s",
+					fileInfo.Name())
+			}
+
 			logger.WithField("action", "lsm_segment_init").
 				WithField("path", filepath.Join(dir, fileInfo.Name())).
 				WithField("wal_path", potentialWALFileName).
@@ -88,7 +176,24 @@ func newSegmentGroup(dir string,
 			continue
 		}
 
-		segment, err := newSegment(filepath.Join(dir, fileInfo.Name()), logger)
+		// a corrupt segment would otherwise only be noticed once a read runs
+		// into it. It is quarantined and the bucket is not loaded, as the
+		// remaining segments would bring back what it had overwritten.
+		path := filepath.Join(dir, fileInfo.Name())
+		if err := verifySegment(context.Background(), path, nil, logger); err != nil {
+			if !isCorruption(err) {
+				return nil, errors.Wrapf(err, "verify segment %s", fileInfo.Name())
+			}
+
+			quarantined, qerr := quarantineSegment(path, err, logger)
+			if qerr != nil {
+				return nil, qerr
+			}
+
+			return nil, errQuarantinedSegment(quarantined.Path)
+		}
+
+		segment, err := newSegment(path, logger, handles, cipher)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init segment %s", fileInfo.Name())
 		}
@@ -99,7 +204,7 @@ func newSegmentGroup(dir string,
 
 	out.segments = out.segments[:segmentIndex]
 
-	out.initCompactionCycle(compactionCycle)
+	out.initCompactionCycle()
 	return out, nil
 }
 
@@ -107,7 +212,7 @@ func (ig *SegmentGroup) add(path string) error {
 	ig.maintenanceLock.Lock()
 	defer ig.maintenanceLock.Unlock()
 
-	segment, err := newSegment(path, ig.logger)
+	segment, err := newSegment(path, ig.logger, ig.handles, ig.cipher)
 	if err != nil {
 		return errors.Wrapf(err, "init segment %s", path)
 	}
@@ -116,10 +221,73 @@ func (ig *SegmentGroup) add(path string) error {
 	return nil
 }
 
+// pinSegments returns all current segments, every one of them is pinned
+// until it is unpinned again. If any segment cannot be pinned, none of them
+// remain pinned.
+func (ig *SegmentGroup) pinSegments() ([]*segment, error) {
+	ig.maintenanceLock.RLock()
+	defer ig.maintenanceLock.RUnlock()
+
+	return pinAll(ig.segments)
+}
+
+// pinAll pins the specified segments. On failure the segments pinned so far
+// are unpinned again.
+func pinAll(segments []*segment) ([]*segment, error) {
+	out := make([]*segment, len(segments))
+	for i, seg := range segments {
+		if err := seg.pin(); err != nil {
+			for _, pinned := range out[:i] {
+				pinned.unpinAndLog()
+			}
+			return nil, err
+		}
+		out[i] = seg
+	}
+
+	return out, nil
+}
+
+// unpinSegments is the counterpart to pinSegments for readers which cannot
+// return an error when they are done, such as cursors
+func (ig *SegmentGroup) unpinSegments(segments []*segment) {
+	for _, seg := range segments {
+		seg.unpinAndLog()
+	}
+}
+
+// pauseCompaction blocks until a running compaction (if any) has completed
+// and prevents new compactions from starting until resumeCompaction is
+// called. This makes sure the list of segment files remains stable.
+func (ig *SegmentGroup) pauseCompaction() {
+	ig.compactionLock.Lock()
+}
+
+func (ig *SegmentGroup) resumeCompaction() {
+	ig.compactionLock.Unlock()
+}
+
+// listFiles returns the paths of all segments currently on disk
+func (ig *SegmentGroup) listFiles() []string {
+	ig.maintenanceLock.RLock()
+	defer ig.maintenanceLock.RUnlock()
+
+	out := make([]string, len(ig.segments))
+	for i, seg := range ig.segments {
+		out[i] = seg.path
+	}
+
+	return out
+}
+
 func (ig *SegmentGroup) get(key []byte) ([]byte, error) {
 	ig.maintenanceLock.RLock()
 	defer ig.maintenanceLock.RUnlock()
 
+	if ig.unavailable != nil {
+		return nil, ig.unavailable
+	}
+
 	// assumes "replace" strategy
 
 	// start with latest and exit as soon as something is found, thus making sure
@@ -135,7 +303,7 @@ func (ig *SegmentGroup) get(key []byte) ([]byte, error) {
 				return nil, nil
 			}
 
-			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
+			return nil, err
 		}
 
 		return v, nil
@@ -148,6 +316,10 @@ func (ig *SegmentGroup) getBySecondary(pos int, key []byte) ([]byte, error) {
 	ig.maintenanceLock.RLock()
 	defer ig.maintenanceLock.RUnlock()
 
+	if ig.unavailable != nil {
+		return nil, ig.unavailable
+	}
+
 	// assumes "replace" strategy
 
 	// start with latest and exit as soon as something is found, thus making sure
@@ -163,7 +335,7 @@ func (ig *SegmentGroup) getBySecondary(pos int, key []byte) ([]byte, error) {
 				return nil, nil
 			}
 
-			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
+			return nil, err
 		}
 
 		return v, nil
@@ -176,6 +348,10 @@ func (ig *SegmentGroup) getCollection(key []byte) ([]value, error) {
 	ig.maintenanceLock.RLock()
 	defer ig.maintenanceLock.RUnlock()
 
+	if ig.unavailable != nil {
+		return nil, ig.unavailable
+	}
+
 	var out []value
 
 	// start with first and do not exit
@@ -200,13 +376,17 @@ func (ig *SegmentGroup) getCollection(key []byte) ([]value, error) {
 }
 
 func (ig *SegmentGroup) shutdown(ctx context.Context) error {
+	// the cycle is stopped before taking the maintenance lock, which a running
+	// compaction needs to complete
+	if ig.stopCompactionCycle != nil {
+		ig.stopCompactionCycle <- struct{}{}
+	}
+
 	ig.maintenanceLock.Lock()
 	defer ig.maintenanceLock.Unlock()
 
-	ig.stopCompactionCycle <- struct{}{}
-
 	for i, seg := range ig.segments {
-		if err := seg.close(); err != nil {
+		if err := seg.retire(); err != nil {
 			return err
 		}
 
@@ -12,180 +12,225 @@
 package lsmkv
 
 import (
+	"context"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 )
 
 func (ig *SegmentGroup) eligbleForCompaction() bool {
-	ig.maintenanceLock.RLock()
-	defer ig.maintenanceLock.RUnlock()
-
-	// if there are at least two segments of the same level a regular compaction
-	// can be performed
-
-	levels := map[uint16]int{}
-
-	for _, segment := range ig.segments {
-		levels[segment.level]++
-		if levels[segment.level] > 1 {
-			return true
-		}
-	}
-
-	return false
+	_, segments := ig.compactionCandidates()
+	return segments != nil
 }
 
-func (ig *SegmentGroup) bestCompactionCandidatePair() []int {
+// compactionCandidates returns the adjacent segments which are compacted
+// next and the position of the first of them. These are the oldest run of at
+// least policy.MinSegments segments of the lowest level which has such a run,
+// but no more than policy.MaxSegments of them. Only adjacent segments can be
+// merged, otherwise the merged segment would end up on the wrong side of the
+// segments in between, which may contain more recent writes of the same keys.
+func (ig *SegmentGroup) compactionCandidates() (int, []*segment) {
 	ig.maintenanceLock.RLock()
 	defer ig.maintenanceLock.RUnlock()
 
-	// first determine the lowest level with candidates
-	levels := map[uint16]int{}
-
-	for _, segment := range ig.segments {
-		levels[segment.level]++
+	if ig.unavailable != nil {
+		// the segments are left as they are, until the quarantined one was
+		// dealt with
+		return -1, nil
 	}
 
-	currLowestLevel := uint16(math.MaxUint16)
-	found := false
-	for level, count := range levels {
-		if count < 2 {
-			continue
+	policy := ig.compactions.Policy()
+
+	start, end := -1, -1
+	for i := 0; i < len(ig.segments); {
+		j := i + 1
+		for j < len(ig.segments) && ig.segments[j].level == ig.segments[i].level {
+			j++
 		}
 
-		if level < currLowestLevel {
-			currLowestLevel = level
-			found = true
+		if j-i >= policy.MinSegments &&
+			(start == -1 || ig.segments[i].level < ig.segments[start].level) {
+			start, end = i, j
 		}
-	}
 
-	if !found {
-		return nil
+		i = j
 	}
 
-	// now pick any two segements which match the level
-	var res []int
-
-	for i, segment := range ig.segments {
-		if len(res) >= 2 {
-			break
-		}
+	if start == -1 {
+		return -1, nil
+	}
 
-		if segment.level == currLowestLevel {
-			res = append(res, i)
-		}
+	if end-start > policy.MaxSegments {
+		end = start + policy.MaxSegments
 	}
 
-	return res
+	out := make([]*segment, end-start)
+	copy(out, ig.segments[start:end])
+	return start, out
 }
 
+// compactOnce merges the next compaction candidates into a single segment. If
+// the oldest segment is among them, deletions no longer have to be recorded,
+// as there are no older segments which could contain the deleted entries.
+// The new segment then only contains what is left after applying them, if
+// nothing is left no new segment is written at all.
 func (ig *SegmentGroup) compactOnce() error {
-	pair := ig.bestCompactionCandidatePair()
-	if pair == nil {
+	start, segments := ig.compactionCandidates()
+	if segments == nil {
 		// nothing to do
 		return nil
 	}
 
-	path := fmt.Sprintf("%s.tmp", ig.segments[pair[1]].path)
-	f, err := os.Create(path)
+	emptied, err := ig.compact(start, segments)
+	ig.compactions.record(len(segments), emptied, err)
+	return err
+}
+
+func (ig *SegmentGroup) compact(start int, segments []*segment) (bool, error) {
+	// the segments must stay mapped while they are compacted, they are only
+	// released once the compacted segment has replaced them
+	if _, err := pinAll(segments); err != nil {
+		return false, errors.Wrap(err, "pin segments to compact")
+	}
+	defer ig.unpinSegments(segments)
+
+	newest := segments[len(segments)-1]
+	path := fmt.Sprintf("%s.tmp", newest.path)
+	f, err := createSegmentFile(path, ig.cipher)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	scratchSpacePath := ig.segments[pair[1]].path + "compaction.scratch.d"
+	// compacted segments always take on the compression of the bucket, which
+	// is how existing segments migrate to a changed compression
+	if err := f.compress(ig.compression); err != nil {
+		f.close()
+		return false, errors.Wrap(err, "compress compacted segment")
+	}
 
-	// the assumption is that both pairs are of the same level, so we can just
-	// take either value. If we want to support asymmetric compaction, then we
-	// might have to choose this value more intelligently
-	level := ig.segments[pair[0]].level
-	secondaryIndices := ig.segments[pair[0]].secondaryIndexCount
+	// the compactors only write through w, the file itself is still closed
+	// directly
+	w := iothrottle.NewWriteSeeker(context.Background(), f, ig.throttle,
+		iothrottle.PriorityCompaction)
 
-	strategy := ig.segments[pair[0]].strategy
-	switch strategy {
-	case SegmentStrategyReplace:
-		c := newCompactorReplace(f, ig.segments[pair[0]].newCursor(),
-			ig.segments[pair[1]].newCursor(), level, secondaryIndices, scratchSpacePath)
+	scratchSpacePath := newest.path + "compaction.scratch.d"
 
-		if err := c.do(); err != nil {
-			return err
-		}
-	case SegmentStrategySetCollection:
-		c := newCompactorSetCollection(f, ig.segments[pair[0]].newCollectionCursor(),
-			ig.segments[pair[1]].newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath)
+	// all candidates are of the same level, the new segment has the next one
+	level := segments[0].level
+	secondaryIndices := segments[0].secondaryIndexCount
+	cleanup := start == 0
 
-		if err := c.do(); err != nil {
-			return err
+	var c compactor
+	switch strategy := segments[0].strategy; strategy {
+	case SegmentStrategyReplace:
+		cursors := make([]*segmentCursorReplace, len(segments))
+		for i, seg := range segments {
+			cursors[i] = seg.newCursor()
 		}
+		c = newCompactorReplace(w, cursors, level, secondaryIndices,
+			scratchSpacePath, cleanup)
+	case SegmentStrategySetCollection:
+		c = newCompactorSetCollection(w, collectionCursors(segments), level,
+			secondaryIndices, scratchSpacePath, cleanup)
 	case SegmentStrategyMapCollection:
-		c := newCompactorMapCollection(f, ig.segments[pair[0]].newCollectionCursor(),
-			ig.segments[pair[1]].newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath)
+		c = newCompactorMapCollection(w, collectionCursors(segments), level,
+			secondaryIndices, scratchSpacePath, cleanup)
+	default:
+		f.close()
+		return false, errors.Errorf("unrecognized strategy %v", strategy)
+	}
 
-		if err := c.do(); err != nil {
-			return err
-		}
+	written, err := c.do()
+	if err != nil {
+		f.close()
+		return false, err
+	}
 
-	default:
-		return errors.Errorf("unrecognized strategy %v", strategy)
+	if err := f.close(); err != nil {
+		return false, errors.Wrap(err, "close compacted segment file")
 	}
 
-	if err := f.Close(); err != nil {
-		return errors.Wrap(err, "close compacted segment file")
+	var checksums *segmentChecksums
+	if written {
+		// the checksums are calculated before the maintenance lock is taken,
+		// they can only be persisted once the segment has been renamed
+		checksums, err = checksumSegment(context.Background(), path, ig.throttle,
+			iothrottle.PriorityCompaction)
+		if err != nil {
+			return false, errors.Wrap(err, "checksum compacted segment")
+		}
+	} else {
+		if err := os.Remove(path); err != nil {
+			return false, errors.Wrap(err, "remove empty compacted segment")
+		}
+		path = ""
 	}
 
-	if err := ig.replaceCompactedSegments(pair[0], pair[1], path); err != nil {
-		return errors.Wrap(err, "replace compacted segments")
+	if err := ig.replaceCompactedSegments(start, len(segments), path,
+		checksums); err != nil {
+		return false, errors.Wrap(err, "replace compacted segments")
 	}
 
-	return nil
+	return !written, nil
 }
 
-func (ig *SegmentGroup) replaceCompactedSegments(old1, old2 int,
-	newPathTmp string) error {
-	ig.maintenanceLock.Lock()
-	defer ig.maintenanceLock.Unlock()
-
-	if err := ig.segments[old1].close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
+func collectionCursors(segments []*segment) []*segmentCursorCollection {
+	out := make([]*segmentCursorCollection, len(segments))
+	for i, seg := range segments {
+		out[i] = seg.newCollectionCursor()
 	}
 
-	if err := ig.segments[old2].close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
-	}
+	return out
+}
 
-	if err := ig.segments[old1].drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
-	}
+// replaceCompactedSegments replaces the count segments starting at start with
+// the compacted segment, which carries the name of the newest of them. An
+// empty newPathTmp means that nothing was left after the compaction, the
+// segments are then removed without replacement. Otherwise checksums are
+// those of the compacted segment.
+func (ig *SegmentGroup) replaceCompactedSegments(start, count int,
+	newPathTmp string, checksums *segmentChecksums) error {
+	ig.maintenanceLock.Lock()
+	defer ig.maintenanceLock.Unlock()
 
-	if err := ig.segments[old2].drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
+	// cursors and snapshots may still read from the old segments, they are
+	// only deleted once the last of them is released
+	for _, seg := range ig.segments[start : start+count] {
+		if err := seg.retireObsolete(); err != nil {
+			return errors.Wrap(err, "retire disk segment")
+		}
 	}
 
-	ig.segments[old1] = nil
-	ig.segments[old2] = nil
+	var replacement []*segment
+	if newPathTmp != "" {
+		// the old segments have been moved out of the way, we can now safely
+		// remove the .tmp extension from the new segment which carried the name
+		// of the newest old segment
+		newPath, err := ig.stripTmpExtension(newPathTmp)
+		if err != nil {
+			return errors.Wrap(err, "strip .tmp extension of new segment")
+		}
 
-	// the old segments have been deletd, we can now safely remove the .tmp
-	// extension from the new segment which carried the name of the second old
-	// segment
-	newPath, err := ig.stripTmpExtension(newPathTmp)
-	if err != nil {
-		return errors.Wrap(err, "strip .tmp extension of new segment")
-	}
+		if err := checksums.writeTo(checksumPath(newPath)); err != nil {
+			return errors.Wrap(err, "write checksums of new segment")
+		}
 
-	seg, err := newSegment(newPath, ig.logger)
-	if err != nil {
-		return errors.Wrap(err, "create new segment")
+		seg, err := newSegment(newPath, ig.logger, ig.handles, ig.cipher)
+		if err != nil {
+			return errors.Wrap(err, "create new segment")
+		}
+		replacement = []*segment{seg}
 	}
 
-	ig.segments[old2] = seg
-
-	ig.segments = append(ig.segments[:old1], ig.segments[old1+1:]...)
+	segments := make([]*segment, 0, len(ig.segments)-count+len(replacement))
+	segments = append(segments, ig.segments[:start]...)
+	segments = append(segments, replacement...)
+	segments = append(segments, ig.segments[start+count:]...)
+	ig.segments = segments
 
 	return nil
 }
@@ -204,11 +249,13 @@ func (ig *SegmentGroup) stripTmpExtension(oldPath string) (string, error) {
 	return newPath, nil
 }
 
-func (ig *SegmentGroup) initCompactionCycle(interval time.Duration) {
-	if interval == 0 {
+func (ig *SegmentGroup) initCompactionCycle() {
+	interval := ig.compactions.Policy().Interval
+	if interval <= 0 {
 		return
 	}
 
+	ig.stopCompactionCycle = make(chan struct{})
 	go func() {
 		t := time.Tick(interval)
 		for {
@@ -219,19 +266,33 @@ func (ig *SegmentGroup) initCompactionCycle(interval time.Duration) {
 					Debug("stop compaction cycle")
 				return
 			case <-t:
-				if ig.eligbleForCompaction() {
-					if err := ig.compactOnce(); err != nil {
-						ig.logger.WithField("action", "lsm_compaction").
-							WithField("path", ig.dir).
-							WithError(err).
-							Errorf("compaction failed")
-					}
+				ig.compactionLock.Lock()
+				if ig.compactions.begin() {
+					ig.compactIfEligible()
+					ig.compactions.end()
 				} else {
 					ig.logger.WithField("action", "lsm_compaction").
 						WithField("path", ig.dir).
-						Trace("no segment eligble for compaction")
+						Trace("compactions are paused")
 				}
+				ig.compactionLock.Unlock()
 			}
 		}
 	}()
 }
+
+func (ig *SegmentGroup) compactIfEligible() {
+	if !ig.eligbleForCompaction() {
+		ig.logger.WithField("action", "lsm_compaction").
+			WithField("path", ig.dir).
+			Trace("no segment eligble for compaction")
+		return
+	}
+
+	if err := ig.compactOnce(); err != nil {
+		ig.logger.WithField("action", "lsm_compaction").
+			WithField("path", ig.dir).
+			WithError(err).
+			Errorf("compaction failed")
+	}
+}
@@ -28,6 +28,13 @@ func (i *segment) get(key []byte) ([]byte, error) {
 		return nil, NotFound
 	}
 
+	// pinned only after the bloom filter ruled out a miss, so an unmapped
+	// segment is not mapped again just to find out it does not contain the key
+	if err := i.pin(); err != nil {
+		return nil, err
+	}
+	defer i.unpinAndLog()
+
 	node, err := i.index.Get(key)
 	if err != nil {
 		if err == segmentindex.NotFound {
@@ -37,7 +44,12 @@ func (i *segment) get(key []byte) ([]byte, error) {
 		}
 	}
 
-	return i.replaceStratParseData(i.contents[node.Start:node.End])
+	data, err := i.contentBytes(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.replaceStratParseData(data)
 }
 
 func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
@@ -53,6 +65,11 @@ func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
 		return nil, NotFound
 	}
 
+	if err := i.pin(); err != nil {
+		return nil, err
+	}
+	defer i.unpinAndLog()
+
 	node, err := i.secondaryIndices[pos].Get(key)
 	if err != nil {
 		if err == segmentindex.NotFound {
@@ -62,7 +79,12 @@ func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
 		}
 	}
 
-	return i.replaceStratParseData(i.contents[node.Start:node.End])
+	data, err := i.contentBytes(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.replaceStratParseData(data)
 }
 
 func (i *segment) replaceStratParseData(in []byte) ([]byte, error) {
@@ -89,14 +111,13 @@ func (i *segment) replaceStratParseData(in []byte) ([]byte, error) {
 	return data, nil
 }
 
-func (i *segment) replaceStratParseDataWithKey(in []byte) (segmentReplaceNode, error) {
-	if len(in) == 0 {
+func (i *segment) replaceStratParseDataWithKey(start,
+	end uint64) (segmentReplaceNode, error) {
+	if start >= end {
 		return segmentReplaceNode{}, NotFound
 	}
 
-	r := bytes.NewReader(in)
-
-	out, err := ParseReplaceNode(r, i.secondaryIndexCount)
+	out, err := ParseReplaceNode(i.contentReader(start, end), i.secondaryIndexCount)
 	if err != nil {
 		return out, err
 	}
@@ -108,15 +129,13 @@ func (i *segment) replaceStratParseDataWithKey(in []byte) (segmentReplaceNode, e
 	return out, nil
 }
 
-func (i *segment) replaceStratParseDataWithKeyInto(in []byte,
+func (i *segment) replaceStratParseDataWithKeyInto(start, end uint64,
 	node *segmentReplaceNode) error {
-	if len(in) == 0 {
+	if start >= end {
 		return NotFound
 	}
 
-	r := bytes.NewReader(in)
-
-	err := ParseReplaceNodeInto(r, i.secondaryIndexCount, node)
+	err := ParseReplaceNodeInto(i.contentReader(start, end), i.secondaryIndexCount, node)
 	if err != nil {
 		return err
 	}
@@ -52,57 +52,59 @@ func (h *segmentHeader) WriteTo(w io.Writer) (int64, error) {
 	return int64(SegmentHeaderSize), nil
 }
 
-func (h *segmentHeader) PrimaryIndex(source []byte) ([]byte, error) {
+// PrimaryIndex returns the start and end position of the primary index
+// within the contents of size bytes read through r
+func (h *segmentHeader) PrimaryIndex(r io.ReaderAt, size uint64) (uint64, uint64, error) {
 	if h.secondaryIndices == 0 {
-		return source[h.indexStart:], nil
+		return h.indexStart, size, nil
 	}
 
-	offsets, err := h.parseSecondaryIndexOffsets(
-		source[h.indexStart:h.secondaryIndexOffsetsEnd()])
+	offsets, err := h.parseSecondaryIndexOffsets(r)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	// the beginning of the first secondary is also the end of the primary
-	end := offsets[0]
-	return source[h.secondaryIndexOffsetsEnd():end], nil
+	return h.secondaryIndexOffsetsEnd(), offsets[0], nil
 }
 
 func (h *segmentHeader) secondaryIndexOffsetsEnd() uint64 {
 	return h.indexStart + (uint64(h.secondaryIndices) * 8)
 }
 
-func (h *segmentHeader) parseSecondaryIndexOffsets(source []byte) ([]uint64, error) {
-	r := bytes.NewReader(source)
+func (h *segmentHeader) parseSecondaryIndexOffsets(r io.ReaderAt) ([]uint64, error) {
+	section := io.NewSectionReader(r, int64(h.indexStart),
+		int64(h.secondaryIndexOffsetsEnd()-h.indexStart))
 
 	offsets := make([]uint64, h.secondaryIndices)
-	if err := binary.Read(r, binary.LittleEndian, &offsets); err != nil {
+	if err := binary.Read(section, binary.LittleEndian, &offsets); err != nil {
 		return nil, err
 	}
 
 	return offsets, nil
 }
 
-func (h *segmentHeader) SecondaryIndex(source []byte, indexID uint16) ([]byte, error) {
+// SecondaryIndex returns the start and end position of the secondary index
+// within the contents of size bytes read through r
+func (h *segmentHeader) SecondaryIndex(r io.ReaderAt, size uint64,
+	indexID uint16) (uint64, uint64, error) {
 	if indexID >= h.secondaryIndices {
-		return nil, errors.Errorf("retrieve index %d with len %d",
+		return 0, 0, errors.Errorf("retrieve index %d with len %d",
 			indexID, h.secondaryIndices)
 	}
 
-	offsets, err := h.parseSecondaryIndexOffsets(
-		source[h.indexStart:h.secondaryIndexOffsetsEnd()])
+	offsets, err := h.parseSecondaryIndexOffsets(r)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	start := offsets[indexID]
 	if indexID == h.secondaryIndices-1 {
 		// this is the last index, return until EOF
-		return source[start:], nil
+		return start, size, nil
 	}
 
-	end := offsets[indexID+1]
-	return source[start:end], nil
+	return start, offsets[indexID+1], nil
 }
 
 func parseSegmentHeader(r io.Reader) (*segmentHeader, error) {
@@ -26,7 +26,8 @@ var NotFound = errors.Errorf("not found")
 // thus perfectly suited as an index for an (immutable) LSM disk segment, but
 // pretty much useless for anything else
 type DiskTree struct {
-	data []byte
+	data io.ReaderAt
+	size int64
 }
 
 type dtNode struct {
@@ -38,13 +39,21 @@ type dtNode struct {
 }
 
 func NewDiskTree(data []byte) *DiskTree {
+	return NewDiskTreeAt(bytes.NewReader(data), int64(len(data)))
+}
+
+// NewDiskTreeAt reads the marshalled tree of size bytes through r whenever it
+// is searched, so it doesn't have to be held in memory, e.g. for an encrypted
+// segment which is decrypted on demand
+func NewDiskTreeAt(r io.ReaderAt, size int64) *DiskTree {
 	return &DiskTree{
-		data: data,
+		data: r,
+		size: size,
 	}
 }
 
 func (t *DiskTree) Get(key []byte) (Node, error) {
-	if len(t.data) == 0 {
+	if t.size == 0 {
 		return Node{}, NotFound
 	}
 
@@ -81,10 +90,7 @@ func (t *DiskTree) getAt(offset int64, key []byte) (Node, error) {
 }
 
 func (t *DiskTree) readNodeAt(offset int64) (dtNode, error) {
-	r := bytes.NewReader(t.data)
-	r.Seek(offset, io.SeekStart)
-
-	return t.readNode(r)
+	return t.readNode(io.NewSectionReader(t.data, offset, t.size-offset))
 }
 
 func (t *DiskTree) readNode(r io.Reader) (dtNode, error) {
@@ -114,7 +120,7 @@ func (t *DiskTree) readNode(r io.Reader) (dtNode, error) {
 }
 
 func (t *DiskTree) Seek(key []byte) (Node, error) {
-	if len(t.data) == 0 {
+	if t.size == 0 {
 		return Node{}, NotFound
 	}
 
@@ -171,7 +177,7 @@ func (t *DiskTree) seekAt(offset int64, key []byte) (Node, error) {
 // for use cases who don't require a specific order, such as building a
 // bloom filter.
 func (t *DiskTree) AllKeys() ([][]byte, error) {
-	r := bytes.NewReader(t.data)
+	r := io.NewSectionReader(t.data, 0, t.size)
 	var out [][]byte
 	for {
 		node, err := t.readNode(r)
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Snapshot is a consistent, read-only view of a bucket with the 'replace'
+// strategy at the time it was taken. Writes, flushes and compactions which
+// happen afterwards are not visible to it. The snapshot pins the disk
+// segments it was taken from, so they are not unmapped while it is in use;
+// the memtables are copied. It must be released using .Release() or the
+// pinned segments are never unmapped.
+type Snapshot struct {
+	segments  []*segment
+	memtables [][]*binarySearchNode
+
+	releaseOnce sync.Once
+}
+
+func (b *Bucket) Snapshot() (*Snapshot, error) {
+	if b.strategy != StrategyReplace {
+		panic("Snapshot() called on strategy other than 'replace'")
+	}
+
+	// holding the flush-RLock guarantees that no segment is added and no
+	// memtable is switched while the snapshot is taken
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	segments, err := b.disk.pinSegments()
+	if err != nil {
+		return nil, errors.Wrap(err, "snapshot bucket")
+	}
+
+	s := &Snapshot{segments: segments}
+
+	// memtables in order from oldest to newest, just like for the cursor
+	if b.flushing != nil {
+		s.memtables = append(s.memtables, b.flushing.frozenCopy())
+	}
+	s.memtables = append(s.memtables, b.active.frozenCopy())
+
+	return s, nil
+}
+
+// Cursor over the snapshot. It does not hold any locks, so it can be kept
+// for as long as the snapshot. Closing the cursor does not release the
+// snapshot.
+func (s *Snapshot) Cursor() *CursorReplace {
+	innerCursors := make([]innerCursorReplace, 0,
+		len(s.segments)+len(s.memtables))
+	for _, seg := range s.segments {
+		innerCursors = append(innerCursors, seg.newCursor())
+	}
+
+	for _, data := range s.memtables {
+		innerCursors = append(innerCursors, &memtableCursor{
+			data:   data,
+			lock:   func() {},
+			unlock: func() {},
+		})
+	}
+
+	return &CursorReplace{
+		innerCursors: innerCursors,
+		unlock:       func() {},
+	}
+}
+
+// Release unpins the segments of the snapshot. It is safe to call Release
+// more than once.
+func (s *Snapshot) Release() error {
+	var err error
+	s.releaseOnce.Do(func() {
+		for _, seg := range s.segments {
+			if unpinErr := seg.unpin(); unpinErr != nil && err == nil {
+				err = errors.Wrapf(unpinErr, "unpin segment %s", seg.path)
+			}
+		}
+		s.segments = nil
+		s.memtables = nil
+	})
+
+	return err
+}
+
+// frozenCopy flattens the memtable into a list of copied nodes, so later
+// writes, which update existing nodes in place, do not alter the copy
+func (l *Memtable) frozenCopy() []*binarySearchNode {
+	l.RLock()
+	defer l.RUnlock()
+
+	nodes := l.key.flattenInOrder()
+	out := make([]*binarySearchNode, len(nodes))
+	for i, node := range nodes {
+		out[i] = &binarySearchNode{
+			key:       node.key,
+			value:     node.value,
+			tombstone: node.tombstone,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceStrategy_Snapshot(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	b, err := NewBucket(testCtx(), dirName, nullLogger(), WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+
+	// so big it effectively never triggers as part of this test
+	b.SetMemtableThreshold(1e9)
+
+	t.Run("import into two segments and the memtable", func(t *testing.T) {
+		require.Nil(t, b.Put([]byte("key-1"), []byte("value-1")))
+		require.Nil(t, b.Put([]byte("key-2"), []byte("value-2")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.Nil(t, b.Put([]byte("key-3"), []byte("value-3")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.Nil(t, b.Put([]byte("key-4"), []byte("value-4")))
+	})
+
+	snapshot, err := b.Snapshot()
+	require.Nil(t, err)
+
+	t.Run("write, flush and compact after the snapshot", func(t *testing.T) {
+		require.Nil(t, b.Put([]byte("key-2"), []byte("updated-2")))
+		require.Nil(t, b.Delete([]byte("key-3")))
+		require.Nil(t, b.Put([]byte("key-4"), []byte("updated-4")))
+		require.Nil(t, b.Put([]byte("key-5"), []byte("value-5")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		for b.disk.eligbleForCompaction() {
+			require.Nil(t, b.disk.compactOnce())
+		}
+	})
+
+	t.Run("the snapshot is not affected", func(t *testing.T) {
+		expectedKeys := [][]byte{
+			[]byte("key-1"), []byte("key-2"), []byte("key-3"), []byte("key-4"),
+		}
+		expectedValues := [][]byte{
+			[]byte("value-1"), []byte("value-2"), []byte("value-3"), []byte("value-4"),
+		}
+
+		var keys, values [][]byte
+		c := snapshot.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			keys = append(keys, copyBytes(k))
+			values = append(values, copyBytes(v))
+		}
+		c.Close()
+
+		assert.Equal(t, expectedKeys, keys)
+		assert.Equal(t, expectedValues, values)
+	})
+
+	t.Run("the bucket contains the latest state", func(t *testing.T) {
+		expectedKeys := [][]byte{
+			[]byte("key-1"), []byte("key-2"), []byte("key-4"), []byte("key-5"),
+		}
+
+		var keys [][]byte
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			keys = append(keys, copyBytes(k))
+		}
+		c.Close()
+
+		assert.Equal(t, expectedKeys, keys)
+	})
+
+	t.Run("release the snapshot", func(t *testing.T) {
+		require.Nil(t, snapshot.Release())
+		// releasing twice is a no-op
+		require.Nil(t, snapshot.Release())
+	})
+}
+
+func copyBytes(in []byte) []byte {
+	out := make([]byte, len(in))
+	copy(out, in)
+	return out
+}
@@ -17,6 +17,8 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/sirupsen/logrus"
 )
 
@@ -26,13 +28,76 @@ type Store struct {
 	rootDir       string
 	bucketsByName map[string]*Bucket
 	logger        logrus.FieldLogger
+	flushes       *flushScheduler
+	handles       *HandleBudget
+	memtables     *MemtableBudget
+	throttle      *iothrottle.Throttle
+	cipher        *encryption.Cipher
+	compactions   *Compactions
+
+	// pausedBuckets are the buckets which were present when compactions were
+	// paused, buckets created afterwards are not paused
+	pausedBuckets []*Bucket
+
+	// view is set for read-only copies of a store, see View
+	view bool
 }
 
-func New(rootDir string, logger logrus.FieldLogger) (*Store, error) {
+type StoreOption func(s *Store)
+
+// WithHandleBudget makes the disk segments of all buckets of the store count
+// against the budget, which is typically shared by all stores of a node
+func WithHandleBudget(h *HandleBudget) StoreOption {
+	return func(s *Store) {
+		s.handles = h
+	}
+}
+
+// WithMemtableBudget sizes the memtables of all buckets of the store as
+// shares of the budget, which is typically shared by all stores of a node
+func WithMemtableBudget(m *MemtableBudget) StoreOption {
+	return func(s *Store) {
+		s.memtables = m
+	}
+}
+
+// WithIOThrottle makes the compactions of all buckets of the store use the
+// disk throughput budget, which is typically shared by all background work of
+// a node
+func WithIOThrottle(t *iothrottle.Throttle) StoreOption {
+	return func(s *Store) {
+		s.throttle = t
+	}
+}
+
+// WithEncryption encrypts the segments and write-ahead logs written by all
+// buckets of the store. Existing plain text files remain readable.
+func WithEncryption(c *encryption.Cipher) StoreOption {
+	return func(s *Store) {
+		s.cipher = c
+	}
+}
+
+// WithCompactions makes all buckets of the store compact their segments
+// according to the policy of c, which is typically shared by all stores of a
+// node, so their compactions can be paused at once
+func WithCompactions(c *Compactions) StoreOption {
+	return func(s *Store) {
+		s.compactions = c
+	}
+}
+
+func New(rootDir string, logger logrus.FieldLogger,
+	opts ...StoreOption) (*Store, error) {
 	s := &Store{
 		rootDir:       rootDir,
 		bucketsByName: map[string]*Bucket{},
 		logger:        logger,
+		flushes:       newFlushScheduler(defaultMaxConcurrentFlushes),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
 
 	return s, s.init()
@@ -60,6 +125,10 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 		return nil
 	}
 
+	opts = append(opts, withFlushScheduler(s.flushes), withHandleBudget(s.handles),
+		withMemtableBudget(s.memtables),
+		withIOThrottle(s.throttle), withEncryption(s.cipher),
+		withCompactions(s.compactions))
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.logger, opts...)
 	if err != nil {
 		return err
@@ -88,3 +157,56 @@ func (s *Store) WriteWALs() error {
 
 	return nil
 }
+
+// PauseCompaction pauses compactions in all buckets, see
+// Bucket.PauseCompaction
+func (s *Store) PauseCompaction() {
+	for _, bucket := range s.bucketsByName {
+		bucket.PauseCompaction()
+		s.pausedBuckets = append(s.pausedBuckets, bucket)
+	}
+}
+
+func (s *Store) ResumeCompaction() {
+	for _, bucket := range s.pausedBuckets {
+		bucket.ResumeCompaction()
+	}
+	s.pausedBuckets = nil
+}
+
+// FlushMemtables flushes the active memtables of all buckets, so that all
+// data is contained in disk segments
+func (s *Store) FlushMemtables() error {
+	for name, bucket := range s.bucketsByName {
+		if err := bucket.FlushMemtable(); err != nil {
+			return errors.Wrapf(err, "flush memtable of bucket %q", name)
+		}
+	}
+
+	return nil
+}
+
+// VerifyIntegrity verifies the disk segments of all buckets, see
+// Bucket.VerifyIntegrity
+func (s *Store) VerifyIntegrity(ctx context.Context) (IntegrityReport, error) {
+	var report IntegrityReport
+	for name, bucket := range s.bucketsByName {
+		bucketReport, err := bucket.VerifyIntegrity(ctx)
+		report.merge(bucketReport)
+		if err != nil {
+			return report, errors.Wrapf(err, "verify bucket %q", name)
+		}
+	}
+
+	return report, nil
+}
+
+// ListFiles returns the paths of the disk segments of all buckets
+func (s *Store) ListFiles() []string {
+	var out []string
+	for _, bucket := range s.bucketsByName {
+		out = append(out, bucket.ListFiles()...)
+	}
+
+	return out
+}
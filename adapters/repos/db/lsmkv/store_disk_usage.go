@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// DiskUsage is the size of the files of a store
+type DiskUsage struct {
+	// SegmentBytes is the size of the disk segments of all buckets including
+	// their bloom filters, checksums and any compaction in progress
+	SegmentBytes int64
+	// WALBytes is the size of the write-ahead logs of the memtables which
+	// have not been flushed yet
+	WALBytes int64
+}
+
+// DiskUsage sums up the sizes of all files in the folder of the store. Files
+// which are removed while the folder is walked, e.g. by a compaction, are
+// skipped.
+func (s *Store) DiskUsage() (DiskUsage, error) {
+	var usage DiskUsage
+	err := filepath.Walk(s.rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		if filepath.Ext(path) == ".wal" {
+			usage.WALBytes += info.Size()
+		} else {
+			usage.SegmentBytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return usage, errors.Wrapf(err, "walk %s", s.rootDir)
+	}
+
+	return usage, nil
+}
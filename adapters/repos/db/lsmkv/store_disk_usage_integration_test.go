@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreDiskUsage(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	store, err := New(dirName, nullLogger())
+	require.Nil(t, err)
+	require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket",
+		WithStrategy(StrategyReplace)))
+	b := store.Bucket("bucket")
+
+	t.Run("an empty store", func(t *testing.T) {
+		usage, err := store.DiskUsage()
+		require.Nil(t, err)
+		assert.Equal(t, DiskUsage{}, usage)
+	})
+
+	t.Run("unflushed writes are in the write-ahead log", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+		}
+		require.Nil(t, store.WriteWALs())
+
+		usage, err := store.DiskUsage()
+		require.Nil(t, err)
+		assert.Greater(t, usage.WALBytes, int64(0))
+		assert.Equal(t, int64(0), usage.SegmentBytes)
+	})
+
+	t.Run("flushed writes are in the segments", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+
+		usage, err := store.DiskUsage()
+		require.Nil(t, err)
+		assert.Greater(t, usage.SegmentBytes, int64(0))
+	})
+}
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrReadOnly is returned for writes to a bucket of a store view
+var ErrReadOnly = errors.Errorf("bucket is read-only")
+
+// View returns a read-only copy of the store with the state of all of its
+// buckets at the time the view was taken. Just like a Snapshot, it pins the
+// disk segments and copies the memtables, so later writes, flushes and
+// compactions are not visible to it. Other than a Snapshot, it supports all
+// strategies and the regular read methods and cursors, so it can be used in
+// place of the store itself by any reader.
+//
+// Every bucket is copied on its own, so writes which span several buckets
+// need to be prevented from the outside while the view is taken, if they
+// should either be visible entirely or not at all. The view must be released
+// using .ReleaseView() or the pinned segments are never unmapped.
+func (s *Store) View() (*Store, error) {
+	out := &Store{
+		rootDir:       s.rootDir,
+		bucketsByName: make(map[string]*Bucket, len(s.bucketsByName)),
+		logger:        s.logger,
+		view:          true,
+	}
+
+	for name, bucket := range s.bucketsByName {
+		copied, err := bucket.readOnlyCopy()
+		if err != nil {
+			// the buckets copied so far must not stay pinned
+			out.ReleaseView()
+			return nil, errors.Wrapf(err, "bucket %q", name)
+		}
+		out.bucketsByName[name] = copied
+	}
+
+	return out, nil
+}
+
+// ReleaseView unpins the segments of a store created with View. The view
+// must not be read from afterwards.
+func (s *Store) ReleaseView() error {
+	if !s.view {
+		return errors.Errorf("store at %s is not a view", s.rootDir)
+	}
+
+	var err error
+	for name, bucket := range s.bucketsByName {
+		for _, seg := range bucket.disk.segments {
+			if unpinErr := seg.unpin(); unpinErr != nil && err == nil {
+				err = errors.Wrapf(unpinErr, "bucket %q: unpin segment %s", name,
+					seg.path)
+			}
+		}
+		bucket.disk.segments = nil
+	}
+
+	return err
+}
+
+func (b *Bucket) readOnlyCopy() (*Bucket, error) {
+	// holding the flush-RLock guarantees that no segment is added and no
+	// memtable is switched while the copy is made
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	segments, err := b.disk.pinSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Bucket{
+		dir:               b.dir,
+		logger:            b.logger,
+		memTableThreshold: b.threshold(),
+		strategy:          b.strategy,
+		secondaryIndices:  b.secondaryIndices,
+		readOnly:          true,
+		disk: &SegmentGroup{
+			segments: segments,
+			dir:      b.disk.dir,
+			logger:   b.disk.logger,
+			handles:  b.disk.handles,
+		},
+		active: b.active.readOnlyCopy(),
+	}
+
+	if b.flushing != nil {
+		out.flushing = b.flushing.readOnlyCopy()
+	}
+
+	return out, nil
+}
+
+// readOnlyCopy copies the memtable without its commit log. Its trees are
+// rebuilt from the copied nodes, so later writes, which update existing nodes
+// in place, do not alter the copy.
+func (l *Memtable) readOnlyCopy() *Memtable {
+	l.RLock()
+	defer l.RUnlock()
+
+	out := &Memtable{
+		key:              l.key.readOnlyCopy(),
+		keyMulti:         l.keyMulti.readOnlyCopy(),
+		primaryIndex:     &binarySearchTree{},
+		size:             l.size,
+		path:             l.path,
+		strategy:         l.strategy,
+		secondaryIndices: l.secondaryIndices,
+		readOnly:         true,
+	}
+
+	if l.secondaryToPrimary != nil {
+		out.secondaryToPrimary = make([]map[string][]byte, len(l.secondaryToPrimary))
+		for i, index := range l.secondaryToPrimary {
+			out.secondaryToPrimary[i] = make(map[string][]byte, len(index))
+			for secondary, primary := range index {
+				out.secondaryToPrimary[i][secondary] = primary
+			}
+		}
+	}
+
+	return out
+}
+
+func (t *binarySearchTree) readOnlyCopy() *binarySearchTree {
+	nodes := t.flattenInOrder()
+	copied := make([]*binarySearchNode, len(nodes))
+	for i, node := range nodes {
+		copied[i] = &binarySearchNode{
+			key:           node.key,
+			value:         node.value,
+			secondaryKeys: node.secondaryKeys,
+			tombstone:     node.tombstone,
+		}
+	}
+
+	return &binarySearchTree{root: balancedTree(copied)}
+}
+
+// balancedTree links the sorted nodes into a balanced tree. Inserting them
+// one by one would degrade the tree into a list.
+func balancedTree(nodes []*binarySearchNode) *binarySearchNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	mid := len(nodes) / 2
+	root := nodes[mid]
+	root.left = balancedTree(nodes[:mid])
+	root.right = balancedTree(nodes[mid+1:])
+	return root
+}
+
+func (t *binarySearchTreeMulti) readOnlyCopy() *binarySearchTreeMulti {
+	nodes := t.flattenInOrder()
+	copied := make([]*binarySearchNodeMulti, len(nodes))
+	for i, node := range nodes {
+		// appending to the values of a node never alters the values which
+		// are already present, so the copy can share them
+		copied[i] = &binarySearchNodeMulti{
+			key:    node.key,
+			values: node.values[:len(node.values):len(node.values)],
+		}
+	}
+
+	return &binarySearchTreeMulti{root: balancedTreeMulti(copied)}
+}
+
+func balancedTreeMulti(nodes []*binarySearchNodeMulti) *binarySearchNodeMulti {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	mid := len(nodes) / 2
+	root := nodes[mid]
+	root.left = balancedTreeMulti(nodes[:mid])
+	root.right = balancedTreeMulti(nodes[mid+1:])
+	return root
+}
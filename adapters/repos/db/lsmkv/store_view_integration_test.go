@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreView(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	store, err := New(dirName, nullLogger())
+	require.Nil(t, err)
+
+	require.Nil(t, store.CreateOrLoadBucket(testCtx(), "objects",
+		WithStrategy(StrategyReplace), WithSecondaryIndicies(1)))
+	require.Nil(t, store.CreateOrLoadBucket(testCtx(), "inverted",
+		WithStrategy(StrategySetCollection)))
+
+	objects := store.Bucket("objects")
+	inverted := store.Bucket("inverted")
+
+	// so big it effectively never triggers as part of this test
+	objects.SetMemtableThreshold(1e9)
+	inverted.SetMemtableThreshold(1e9)
+
+	t.Run("import into a segment and the memtable", func(t *testing.T) {
+		require.Nil(t, objects.Put([]byte("key-1"), []byte("value-1"),
+			WithSecondaryKey(0, []byte("doc-1"))))
+		require.Nil(t, inverted.SetAdd([]byte("row"), [][]byte{[]byte("doc-1")}))
+		require.Nil(t, objects.FlushAndSwitch())
+		require.Nil(t, inverted.FlushAndSwitch())
+
+		require.Nil(t, objects.Put([]byte("key-2"), []byte("value-2"),
+			WithSecondaryKey(0, []byte("doc-2"))))
+		require.Nil(t, inverted.SetAdd([]byte("row"), [][]byte{[]byte("doc-2")}))
+	})
+
+	view, err := store.View()
+	require.Nil(t, err)
+
+	t.Run("write, flush and compact after the view", func(t *testing.T) {
+		require.Nil(t, objects.Put([]byte("key-2"), []byte("updated-2"),
+			WithSecondaryKey(0, []byte("doc-2"))))
+		require.Nil(t, objects.Put([]byte("key-3"), []byte("value-3"),
+			WithSecondaryKey(0, []byte("doc-3"))))
+		require.Nil(t, objects.Delete([]byte("key-1")))
+		require.Nil(t, inverted.SetAdd([]byte("row"), [][]byte{[]byte("doc-3")}))
+		require.Nil(t, inverted.SetDeleteSingle([]byte("row"), []byte("doc-1")))
+		require.Nil(t, objects.FlushAndSwitch())
+		require.Nil(t, inverted.FlushAndSwitch())
+
+		for objects.disk.eligbleForCompaction() {
+			require.Nil(t, objects.disk.compactOnce())
+		}
+		for inverted.disk.eligbleForCompaction() {
+			require.Nil(t, inverted.disk.compactOnce())
+		}
+	})
+
+	t.Run("the view is not affected", func(t *testing.T) {
+		b := view.Bucket("objects")
+
+		v, err := b.Get([]byte("key-1"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value-1"), v)
+
+		v, err = b.GetBySecondary(0, []byte("doc-2"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value-2"), v)
+
+		v, err = b.Get([]byte("key-3"))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+
+		list, err := view.Bucket("inverted").SetList([]byte("row"))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, [][]byte{[]byte("doc-1"), []byte("doc-2")}, list)
+	})
+
+	t.Run("the store contains the latest state", func(t *testing.T) {
+		v, err := objects.Get([]byte("key-1"))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+
+		v, err = objects.GetBySecondary(0, []byte("doc-2"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("updated-2"), v)
+
+		list, err := inverted.SetList([]byte("row"))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, [][]byte{[]byte("doc-2"), []byte("doc-3")}, list)
+	})
+
+	t.Run("the view cannot be written to", func(t *testing.T) {
+		err := view.Bucket("objects").Put([]byte("key-4"), []byte("value-4"))
+		assert.Equal(t, ErrReadOnly, err)
+
+		err = view.Bucket("inverted").SetAdd([]byte("row"), [][]byte{[]byte("doc-4")})
+		assert.Equal(t, ErrReadOnly, err)
+	})
+
+	t.Run("release the view", func(t *testing.T) {
+		require.Nil(t, view.ReleaseView())
+		assert.NotNil(t, store.ReleaseView(), "the store itself is not a view")
+	})
+}
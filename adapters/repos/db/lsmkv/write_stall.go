@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// writeStallFactor is the multiple of the memtable threshold the active
+// memtable may grow to while the previous memtable is still being flushed.
+// Beyond that, writes stall until the flush has completed, so a bucket which
+// cannot flush fast enough does not grow its memtable without bounds.
+const writeStallFactor = 4
+
+// WriteStalls describes the writes of a bucket or a store which had to wait
+// for a flush to complete
+type WriteStalls struct {
+	// Stalled is the number of writes which are currently waiting
+	Stalled int64
+	// Total is the number of writes which had to wait since startup
+	Total int64
+	// Duration is the accumulated time all writes spent waiting
+	Duration time.Duration
+}
+
+func (w WriteStalls) add(other WriteStalls) WriteStalls {
+	return WriteStalls{
+		Stalled:  w.Stalled + other.Stalled,
+		Total:    w.Total + other.Total,
+		Duration: w.Duration + other.Duration,
+	}
+}
+
+// waitForFlush stalls the write if the active memtable has grown beyond
+// writeStallFactor times the threshold while the previous memtable is still
+// being flushed. It must be called before the flush-RLock is obtained for
+// the write.
+func (b *Bucket) waitForFlush() {
+	if b.readOnly {
+		// the memtables of a view are never flushed, the write fails right
+		// away instead
+		return
+	}
+
+	b.flushLock.RLock()
+	stalled := b.flushing != nil &&
+		b.active.Size() >= writeStallFactor*b.threshold()
+	flushed := b.flushed
+	b.flushLock.RUnlock()
+
+	if !stalled {
+		return
+	}
+
+	before := time.Now()
+	atomic.AddInt64(&b.stalls.Stalled, 1)
+	atomic.AddInt64(&b.stalls.Total, 1)
+
+	<-flushed
+
+	atomic.AddInt64(&b.stalls.Stalled, -1)
+	atomic.AddInt64((*int64)(&b.stalls.Duration), int64(time.Since(before)))
+}
+
+func (b *Bucket) stalledWrites() int64 {
+	return atomic.LoadInt64(&b.stalls.Stalled)
+}
+
+// WriteStalls returns the writes which waited or are waiting for the memtable
+// of the bucket to be flushed
+func (b *Bucket) WriteStalls() WriteStalls {
+	return WriteStalls{
+		Stalled:  atomic.LoadInt64(&b.stalls.Stalled),
+		Total:    atomic.LoadInt64(&b.stalls.Total),
+		Duration: time.Duration(atomic.LoadInt64((*int64)(&b.stalls.Duration))),
+	}
+}
+
+// WriteStalls sums up the write stalls of all buckets of the store
+func (s *Store) WriteStalls() WriteStalls {
+	var out WriteStalls
+	for _, bucket := range s.bucketsByName {
+		out = out.add(bucket.WriteStalls())
+	}
+
+	return out
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// Collect reports the write stalls, coalesced puts, object counts, lsmkv
+// store sizes, vector cache sizes and vector index commit log sizes of every
+// shard loaded on this node, the usage of the segment handle budget, the
+// memtable budget, the lsmkv compactions and the usage of the background I/O
+// budget to the monitoring registry
+func (d *DB) Collect() []*monitoring.Family {
+	if d == nil {
+		return nil
+	}
+
+	var families []*monitoring.Family
+	families = append(families, d.collectWriteStallMetrics()...)
+	families = append(families, d.collectShardStatsMetrics()...)
+	families = append(families, d.collectVectorCacheMetrics()...)
+	families = append(families, d.collectCommitLogMetrics()...)
+	families = append(families, d.collectHandleBudgetMetrics()...)
+	families = append(families, d.collectMemtableBudgetMetrics()...)
+	families = append(families, d.collectCompactionMetrics()...)
+	families = append(families, d.collectIOThrottleMetrics()...)
+	return families
+}
+
+func (d *DB) collectHandleBudgetMetrics() []*monitoring.Family {
+	stats := d.handles.Stats()
+
+	return []*monitoring.Family{
+		monitoring.NewGauge("weaviate_lsm_open_segments",
+			"Number of lsmkv disk segments currently mapped into memory").
+			Add(float64(stats.Open)),
+		monitoring.NewGauge("weaviate_lsm_open_segments_limit",
+			"Number of lsmkv disk segments which may be mapped at the same time, 0 means unlimited").
+			Add(float64(stats.Max)),
+		monitoring.NewCounter("weaviate_lsm_segment_evictions_total",
+			"Number of times a cold lsmkv disk segment was unmapped to stay within the limit").
+			Add(float64(stats.Evictions)),
+		monitoring.NewCounter("weaviate_lsm_segment_reopens_total",
+			"Number of times an unmapped lsmkv disk segment had to be mapped again").
+			Add(float64(stats.Reopens)),
+	}
+}
+
+func (d *DB) collectMemtableBudgetMetrics() []*monitoring.Family {
+	stats := d.memtables.Stats()
+
+	return []*monitoring.Family{
+		monitoring.NewGauge("weaviate_lsm_memtables_max_memory_bytes",
+			"Memory shared by the lsmkv memtables of all shards, 0 means memtables are flushed at a fixed size").
+			Add(float64(stats.Total)),
+		monitoring.NewGauge("weaviate_lsm_memtables_buckets",
+			"Number of lsmkv buckets sharing the memtable memory").
+			Add(float64(stats.Buckets)),
+		monitoring.NewGauge("weaviate_lsm_memtable_threshold_bytes",
+			"Size at which the memtable of each lsmkv bucket is currently flushed").
+			Add(float64(stats.Threshold)),
+	}
+}
+
+func (d *DB) collectCompactionMetrics() []*monitoring.Family {
+	stats := d.compactions.Stats()
+
+	paused := 0.0
+	if stats.Paused {
+		paused = 1
+	}
+
+	return []*monitoring.Family{
+		monitoring.NewGauge("weaviate_lsm_compactions_paused",
+			"1 if the lsmkv compactions of all shards are paused, 0 otherwise").
+			Add(paused),
+		monitoring.NewCounter("weaviate_lsm_compactions_total",
+			"Number of completed lsmkv compactions").
+			Add(float64(stats.Completed)),
+		monitoring.NewCounter("weaviate_lsm_compacted_segments_total",
+			"Number of lsmkv disk segments which were merged by compactions").
+			Add(float64(stats.MergedSegments)),
+		monitoring.NewCounter("weaviate_lsm_compactions_emptied_total",
+			"Number of lsmkv compactions which left nothing to write, as everything had been deleted").
+			Add(float64(stats.Emptied)),
+		monitoring.NewCounter("weaviate_lsm_compactions_failed_total",
+			"Number of failed lsmkv compactions").
+			Add(float64(stats.Failed)),
+	}
+}
+
+func (d *DB) collectIOThrottleMetrics() []*monitoring.Family {
+	stats := d.throttle.Stats()
+
+	bytes := monitoring.NewCounter("weaviate_background_io_bytes_total",
+		"Number of bytes read or written by background work", "priority")
+	waited := monitoring.NewCounter("weaviate_background_io_wait_seconds_total",
+		"Time background work spent waiting for the disk throughput budget", "priority")
+	waiting := monitoring.NewGauge("weaviate_background_io_waiting",
+		"Number of background requests currently waiting for the disk throughput budget",
+		"priority")
+	for _, priority := range stats.Priorities {
+		name := priority.Priority.String()
+		bytes.Add(float64(priority.Bytes), name)
+		waited.Add(priority.Waited.Seconds(), name)
+		waiting.Add(float64(priority.Waiting), name)
+	}
+
+	return []*monitoring.Family{
+		monitoring.NewGauge("weaviate_background_io_limit_bytes_per_second",
+			"Disk throughput budget of the background work of this node, 0 means unlimited").
+			Add(float64(stats.Rate)),
+		bytes, waited, waiting,
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer os.RemoveAll(dirName)
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "MeasuredClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	require.Nil(t,
+		migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	for i := 0; i < 3; i++ {
+		obj := &models.Object{
+			Class: "MeasuredClass",
+			ID:    strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f50697%d", i)),
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, float32(i)}))
+	}
+
+	registry := monitoring.NewRegistry()
+	registry.Register(repo)
+	buf := &bytes.Buffer{}
+	require.Nil(t, registry.WriteText(buf))
+
+	shardName := schemaGetter.shardState.AllPhysicalShards()[0]
+	assert.Contains(t, buf.String(), fmt.Sprintf(
+		"weaviate_shard_objects{class=\"MeasuredClass\",shard=%q} 3\n", shardName))
+	assert.Contains(t, buf.String(), fmt.Sprintf(
+		"weaviate_vector_cache_objects{class=\"MeasuredClass\",shard=%q} ", shardName))
+	assert.Contains(t, buf.String(), "# TYPE weaviate_shard_write_stalls_total counter\n")
+	assert.Contains(t, buf.String(), "# TYPE weaviate_lsm_open_segments gauge\n")
+	assert.Contains(t, buf.String(), "# TYPE weaviate_background_io_bytes_total counter\n")
+}
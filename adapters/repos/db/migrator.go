@@ -31,8 +31,17 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 	shardState *sharding.State) error {
 	idx, err := NewIndex(ctx,
 		IndexConfig{
-			ClassName: schema.ClassName(class.Class),
-			RootPath:  m.db.config.RootPath,
+			ClassName:             schema.ClassName(class.Class),
+			RootPath:              m.db.config.RootPath,
+			RowCacheMaxSize:       m.db.config.RowCacheMaxSize,
+			HandleBudget:          m.db.handles,
+			MemtableBudget:        m.db.memtables,
+			IOThrottle:            m.db.throttle,
+			Compactions:           m.db.compactions,
+			Encryption:            m.db.config.Encryption,
+			HNSWMaxLogSize:        m.db.config.HNSWMaxLogSize,
+			SegmentCompression:    m.db.config.SegmentCompression,
+			WriteCoalescingWindow: m.db.config.WriteCoalescingWindow,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
@@ -49,6 +58,16 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		return errors.Wrapf(err, "extend idx '%s' with uuid property", idx.ID())
 	}
 
+	err = idx.addTimestampProperties(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "extend idx '%s' with timestamp properties", idx.ID())
+	}
+
+	err = idx.addCompositeIndexes(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "extend idx '%s' with composite indexes", idx.ID())
+	}
+
 	for _, prop := range class.Properties {
 		if prop.IndexInverted != nil && !*prop.IndexInverted {
 			continue
@@ -125,3 +144,104 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	// used for now.
 	return hnsw.ValidateUserConfigUpdate(old, updated)
 }
+
+func (m *Migrator) AddTenants(ctx context.Context, className string,
+	tenants []*models.Tenant) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot add tenants to a non-existing index for %s", className)
+	}
+
+	return idx.loadTenantShards(ctx, tenantNames(tenants))
+}
+
+// UpdateTenants loads the shards of activated tenants and unloads the shards
+// of deactivated ones
+func (m *Migrator) UpdateTenants(ctx context.Context, className string,
+	tenants []*models.Tenant) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update tenants of a non-existing index for %s", className)
+	}
+
+	names := tenantNames(tenants)
+	if err := idx.unloadTenantShards(ctx, names); err != nil {
+		return err
+	}
+
+	return idx.loadTenantShards(ctx, names)
+}
+
+func (m *Migrator) DeleteTenants(ctx context.Context, className string,
+	tenants []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot delete tenants of a non-existing index for %s", className)
+	}
+
+	return idx.dropTenantShards(ctx, tenants)
+}
+
+// UpdateShards loads the shards which were newly placed on this node and
+// shuts down the ones which were moved off it
+func (m *Migrator) UpdateShards(ctx context.Context, className string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update shards of a non-existing index for %s", className)
+	}
+
+	return idx.updateShardPlacement(ctx)
+}
+
+// CleanupVectorIndex removes the tombstones from the vector index of a shard
+// on this node right away instead of waiting for the periodic cleanup
+func (m *Migrator) CleanupVectorIndex(ctx context.Context, className,
+	shardName string) (*models.VectorIndexCleanupResponse, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot clean up vector index of non-existing index for %s", className)
+	}
+
+	return idx.cleanupVectorIndex(ctx, shardName)
+}
+
+func tenantNames(tenants []*models.Tenant) []string {
+	names := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		names[i] = tenant.Name
+	}
+
+	return names
+}
+
+// ClassStatus returns the runtime status of the class on this node
+func (m *Migrator) ClassStatus(ctx context.Context,
+	className string) (*models.ClassStatus, error) {
+	status, err := m.db.ClassStatus(schema.ClassName(className))
+	if err != nil {
+		return nil, err
+	}
+
+	return status.toModel(), nil
+}
+
+// StartVectorIndexAdvisor starts a vector index advisor run for the local
+// shards of the class in the background
+func (m *Migrator) StartVectorIndexAdvisor(ctx context.Context, className string,
+	params *models.VectorIndexAdviceRequest) (*models.ClassStatus, error) {
+	var advisorParams AdvisorParams
+	if params != nil {
+		advisorParams = AdvisorParams{
+			SampleSize:   int(params.SampleSize),
+			TargetRecall: params.TargetRecall,
+			K:            int(params.K),
+		}
+	}
+
+	err := m.db.StartVectorIndexAdvisor(schema.ClassName(className), advisorParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.ClassStatus(ctx, className)
+}
@@ -298,6 +298,58 @@ func makeTestRetrievingBaseClass(repo *DB, data []*models.Object,
 			})
 		})
 
+		t.Run("iterate over the class with a cursor", func(t *testing.T) {
+			expected := make([]string, len(data))
+			for i, obj := range data {
+				expected[i] = obj.ID.String()
+			}
+			sort.Strings(expected)
+
+			do := func(t *testing.T, limit int) {
+				var found []string
+				cursor := filters.Cursor{}
+				for {
+					res, err := repo.ObjectCursorSearch(context.Background(), "TestClass",
+						cursor, limit, additional.Properties{})
+					require.Nil(t, err)
+					if len(res) == 0 {
+						break
+					}
+
+					require.LessOrEqual(t, len(res), limit)
+					for _, obj := range res {
+						found = append(found, obj.ID.String())
+					}
+					cursor.After = res[len(res)-1].ID.String()
+				}
+
+				assert.Equal(t, expected, found)
+			}
+
+			t.Run("with high limit", func(t *testing.T) {
+				do(t, 100)
+			})
+
+			t.Run("with low limit", func(t *testing.T) {
+				do(t, 3)
+			})
+
+			t.Run("through class search", func(t *testing.T) {
+				res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+					Cursor: &filters.Cursor{After: expected[4]},
+					Pagination: &filters.Pagination{
+						Limit: 5,
+					},
+					ClassName: "TestClass",
+				})
+				require.Nil(t, err)
+				require.Len(t, res, 5)
+				for i, obj := range res {
+					assert.Equal(t, expected[5+i], obj.ID.String())
+				}
+			})
+		})
+
 		t.Run("retrieve through class-level vector search", func(t *testing.T) {
 			do := func(t *testing.T, limit, expected int) {
 				res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
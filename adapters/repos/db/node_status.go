@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// LocalNodeShards reports the shards which are loaded on this node including
+// their object counts, their sizes on disk and the tombstones in their vector
+// indexes, sorted by class and shard name. Counting iterates over all objects,
+// so the result should not be requested frequently.
+func (d *DB) LocalNodeShards(ctx context.Context) ([]*models.NodeShardStatus, error) {
+	var out []*models.NodeShardStatus
+	for _, index := range d.indices {
+		shards, err := index.localShardStatus(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "index %s", index.ID())
+		}
+
+		out = append(out, shards...)
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].Class != out[b].Class {
+			return out[a].Class < out[b].Class
+		}
+		return out[a].Name < out[b].Name
+	})
+
+	return out, nil
+}
+
+func (i *Index) localShardStatus(ctx context.Context) ([]*models.NodeShardStatus, error) {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	out := make([]*models.NodeShardStatus, 0, len(i.Shards))
+	for name, shard := range i.Shards {
+		stats, err := shard.Stats(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", name)
+		}
+
+		stalls := shard.writeStalls()
+		atomicBatchPending, atomicBatchErr := shard.atomicBatchStatus.get()
+		status := &models.NodeShardStatus{
+			Class:                     i.Config.ClassName.String(),
+			Name:                      name,
+			ObjectCount:               stats.ObjectCount,
+			WriteStalled:              stalls.Stalled > 0,
+			StalledWrites:             stalls.Stalled,
+			VectorIndexTombstones:     shard.vectorIndexTombstones(),
+			LsmStoreBytes:             stats.LSMStoreBytes,
+			WalBytes:                  stats.WALBytes,
+			VectorIndexCommitLogBytes: stats.VectorIndexCommitLogBytes,
+			AtomicBatchPending:        atomicBatchPending,
+		}
+		if atomicBatchErr != nil {
+			status.AtomicBatchError = atomicBatchErr.Error()
+		}
+		out = append(out, status)
+	}
+
+	return out, nil
+}
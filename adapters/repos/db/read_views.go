@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/readview"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// PinReadViews takes the read views of all local shards of the classes for
+// the read view set of the context. Otherwise each shard would be pinned on
+// its first read, so the classes of a request could be read in different
+// states. The writes to all of these shards are paused while the views are
+// taken, so they all reflect the same point in time. Without a set in the
+// context, this is a no-op.
+//
+// Shards on other nodes are not part of the views and are always read in
+// their latest state.
+func (d *DB) PinReadViews(ctx context.Context, classes []string) error {
+	set := readview.FromContext(ctx)
+	if set == nil {
+		return nil
+	}
+
+	var shards []*Shard
+	for _, class := range classes {
+		index := d.GetIndex(schema.ClassName(class))
+		if index == nil {
+			// unknown classes fail once they are read
+			continue
+		}
+
+		index.shardsLock.RLock()
+		for _, shard := range index.Shards {
+			shards = append(shards, shard)
+		}
+		index.shardsLock.RUnlock()
+	}
+
+	// the locks are always obtained in the same order, so two requests pinning
+	// overlapping shards cannot block each other
+	sort.Slice(shards, func(a, b int) bool {
+		return shards[a].ID() < shards[b].ID()
+	})
+	shards = uniqueShards(shards)
+
+	for _, shard := range shards {
+		shard.readViewLock.Lock()
+	}
+	defer func() {
+		for _, shard := range shards {
+			shard.readViewLock.Unlock()
+		}
+	}()
+
+	for _, shard := range shards {
+		shard := shard
+		if _, err := set.Get(shard.ID(), func() (readview.View, error) {
+			return shard.newReadViewLocked()
+		}); err != nil {
+			return errors.Wrapf(err, "pin read view of shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+// uniqueShards removes shards which are listed more than once, e.g. because
+// a class was named twice, from the sorted list
+func uniqueShards(shards []*Shard) []*Shard {
+	out := shards[:0]
+	for i, shard := range shards {
+		if i > 0 && shard == shards[i-1] {
+			continue
+		}
+		out = append(out, shard)
+	}
+
+	return out
+}
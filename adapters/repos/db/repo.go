@@ -13,8 +13,12 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
@@ -28,6 +32,18 @@ type DB struct {
 	indices      map[string]*Index
 	remoteClient sharding.RemoteIndexClient
 	nodeResolver nodeResolver
+
+	// handles is shared by the lsmkv stores of all local shards
+	handles *lsmkv.HandleBudget
+
+	// memtables is shared by the lsmkv stores of all local shards
+	memtables *lsmkv.MemtableBudget
+
+	// throttle is shared by the background work of all local shards
+	throttle *iothrottle.Throttle
+
+	// compactions is shared by the lsmkv stores of all local shards
+	compactions *lsmkv.Compactions
 }
 
 func (d *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
@@ -46,6 +62,10 @@ func New(logger logrus.FieldLogger, config Config,
 		indices:      map[string]*Index{},
 		remoteClient: remoteClient,
 		nodeResolver: nodeResolver,
+		handles:      lsmkv.NewHandleBudget(config.MaxOpenSegments),
+		memtables:    lsmkv.NewMemtableBudget(config.MemtablesMaxMemory),
+		throttle:     iothrottle.New(config.BackgroundIOBytesPerSecond),
+		compactions:  lsmkv.NewCompactions(config.CompactionPolicy),
 	}
 }
 
@@ -53,6 +73,73 @@ type Config struct {
 	RootPath            string
 	QueryLimit          int64
 	QueryMaximumResults int64
+
+	// RowCacheMaxSize is the size of the inverted row cache per shard in bytes,
+	// the default is used if not set
+	RowCacheMaxSize uint64
+
+	// MaxOpenSegments limits the number of lsmkv disk segments which are
+	// mapped at the same time across all shards of this node. The least
+	// recently used segments are unmapped and mapped again on demand. 0 means
+	// unlimited.
+	MaxOpenSegments int
+
+	// MemtablesMaxMemory is the memory in bytes shared by the lsmkv memtables
+	// of all shards of this node. Each bucket flushes its memtable once it
+	// reaches its share, so many classes do not add up to more memory. 0
+	// flushes every memtable at a fixed size instead.
+	MemtablesMaxMemory uint64
+
+	// BackgroundIOBytesPerSecond limits the disk throughput of compactions,
+	// vector index maintenance, tombstone cleanups and backups across all
+	// shards of this node. 0 means unlimited.
+	BackgroundIOBytesPerSecond int64
+
+	// CompactionPolicy decides when the segments of the lsmkv stores of all
+	// shards are compacted, unset parts use the defaults
+	CompactionPolicy lsmkv.CompactionPolicy
+
+	// HNSWMaxLogSize is the size up to which the condensed commit logs of the
+	// vector indexes are combined, 0 uses the default
+	HNSWMaxLogSize int64
+
+	// SegmentCompression is the algorithm the segments of the objects and
+	// inverted buckets of all shards are compressed with, one of the
+	// lsmkv.Compression* algorithms. Empty disables compression.
+	SegmentCompression string
+
+	// WriteCoalescingWindow is how long a put of an object waits for later
+	// puts of the same object, so a burst of updates results in a single
+	// update of the inverted and vector indices. 0 disables coalescing.
+	WriteCoalescingWindow time.Duration
+
+	// Encryption encrypts the lsmkv segments and write-ahead logs and the
+	// vector index commit logs of all shards of this node. nil disables
+	// encryption, files written while it was enabled can then not be read.
+	Encryption *encryption.Cipher
+}
+
+// IOThrottle is the disk throughput budget shared by all background work of
+// this node
+func (d *DB) IOThrottle() *iothrottle.Throttle {
+	return d.throttle
+}
+
+// Compactions allows pausing the compactions of the lsmkv stores of all local
+// shards at once
+func (d *DB) Compactions() *lsmkv.Compactions {
+	return d.compactions
+}
+
+const defaultRowCacheMaxSize = uint64(500 * 1024 * 1024)
+
+// SetRowCacheMaxSize changes the size of the inverted row caches of all local
+// shards at runtime. Indices created later on use the new size as well.
+func (d *DB) SetRowCacheMaxSize(size uint64) {
+	d.config.RowCacheMaxSize = size
+	for _, index := range d.indices {
+		index.setRowCacheMaxSize(size)
+	}
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
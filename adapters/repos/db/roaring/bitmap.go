@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package roaring implements compressed bitmaps of uint64 doc ids following
+// the roaring bitmap design: The upper 48 bits of an id select a container,
+// the lower 16 bits are stored in the container. Sparse containers are sorted
+// arrays, dense containers are plain bitmaps of fixed size. Intersections and
+// unions work container by container, so their cost depends on the number of
+// containers rather than the number of ids in them, and the memory of a dense
+// set is bounded by 8KB per 65536 ids.
+package roaring
+
+import "sort"
+
+// Bitmap is a set of uint64 values. The zero value is not usable, use New.
+// Set operations such as And and Or never modify their inputs.
+type Bitmap struct {
+	keys       []uint64
+	containers []*container
+}
+
+func New() *Bitmap {
+	return &Bitmap{}
+}
+
+// FromSlice creates a bitmap containing all the values, duplicates are
+// allowed. Inserting in ascending order is considerably faster.
+func FromSlice(values []uint64) *Bitmap {
+	b := New()
+	for _, v := range values {
+		b.Add(v)
+	}
+
+	return b
+}
+
+func (b *Bitmap) Add(v uint64) {
+	key, low := split(v)
+
+	// fast path: values are typically inserted in ascending order, i.e. into
+	// the last container
+	last := len(b.keys) - 1
+	if last >= 0 && b.keys[last] == key {
+		b.containers[last].add(low)
+		return
+	}
+
+	pos := b.search(key)
+	if pos < len(b.keys) && b.keys[pos] == key {
+		b.containers[pos].add(low)
+		return
+	}
+
+	b.keys = append(b.keys, 0)
+	copy(b.keys[pos+1:], b.keys[pos:])
+	b.keys[pos] = key
+
+	b.containers = append(b.containers, nil)
+	copy(b.containers[pos+1:], b.containers[pos:])
+	b.containers[pos] = newArrayContainer()
+	b.containers[pos].add(low)
+}
+
+func (b *Bitmap) Contains(v uint64) bool {
+	key, low := split(v)
+	pos := b.search(key)
+	if pos >= len(b.keys) || b.keys[pos] != key {
+		return false
+	}
+
+	return b.containers[pos].contains(low)
+}
+
+func (b *Bitmap) Cardinality() int {
+	card := 0
+	for _, c := range b.containers {
+		card += c.card
+	}
+
+	return card
+}
+
+func (b *Bitmap) IsEmpty() bool {
+	return len(b.containers) == 0
+}
+
+// Iterate calls fn for every value in ascending order until fn returns false
+func (b *Bitmap) Iterate(fn func(v uint64) bool) {
+	for i, c := range b.containers {
+		high := b.keys[i] << 16
+		if !c.iterate(func(low uint16) bool {
+			return fn(high | uint64(low))
+		}) {
+			return
+		}
+	}
+}
+
+// ToArray returns all values in ascending order
+func (b *Bitmap) ToArray() []uint64 {
+	out := make([]uint64, 0, b.Cardinality())
+	b.Iterate(func(v uint64) bool {
+		out = append(out, v)
+		return true
+	})
+
+	return out
+}
+
+// SizeInBytes estimates the memory used by the bitmap
+func (b *Bitmap) SizeInBytes() int {
+	size := 0
+	for _, c := range b.containers {
+		// key, container pointer and header
+		size += 8 + 8 + 32 + c.sizeInBytes()
+	}
+
+	return size
+}
+
+func (b *Bitmap) Clone() *Bitmap {
+	out := &Bitmap{
+		keys:       make([]uint64, len(b.keys)),
+		containers: make([]*container, len(b.containers)),
+	}
+
+	copy(out.keys, b.keys)
+	for i, c := range b.containers {
+		out.containers[i] = c.clone()
+	}
+
+	return out
+}
+
+// And returns the intersection of b and other
+func (b *Bitmap) And(other *Bitmap) *Bitmap {
+	out := New()
+
+	i, j := 0, 0
+	for i < len(b.keys) && j < len(other.keys) {
+		switch {
+		case b.keys[i] < other.keys[j]:
+			i++
+		case b.keys[i] > other.keys[j]:
+			j++
+		default:
+			c := b.containers[i].and(other.containers[j])
+			if c.card > 0 {
+				out.keys = append(out.keys, b.keys[i])
+				out.containers = append(out.containers, c)
+			}
+			i++
+			j++
+		}
+	}
+
+	return out
+}
+
+// Or returns the union of b and other
+func (b *Bitmap) Or(other *Bitmap) *Bitmap {
+	out := &Bitmap{
+		keys:       make([]uint64, 0, len(b.keys)+len(other.keys)),
+		containers: make([]*container, 0, len(b.keys)+len(other.keys)),
+	}
+
+	i, j := 0, 0
+	for i < len(b.keys) || j < len(other.keys) {
+		switch {
+		case j >= len(other.keys) || (i < len(b.keys) && b.keys[i] < other.keys[j]):
+			out.keys = append(out.keys, b.keys[i])
+			out.containers = append(out.containers, b.containers[i].clone())
+			i++
+		case i >= len(b.keys) || b.keys[i] > other.keys[j]:
+			out.keys = append(out.keys, other.keys[j])
+			out.containers = append(out.containers, other.containers[j].clone())
+			j++
+		default:
+			out.keys = append(out.keys, b.keys[i])
+			out.containers = append(out.containers, b.containers[i].or(other.containers[j]))
+			i++
+			j++
+		}
+	}
+
+	return out
+}
+
+func (b *Bitmap) search(key uint64) int {
+	return sort.Search(len(b.keys), func(i int) bool {
+		return b.keys[i] >= key
+	})
+}
+
+func split(v uint64) (uint64, uint16) {
+	return v >> 16, uint16(v)
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package roaring
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitmap(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		b := New()
+		assert.True(t, b.IsEmpty())
+		assert.Equal(t, 0, b.Cardinality())
+		assert.False(t, b.Contains(7))
+		assert.Equal(t, []uint64{}, b.ToArray())
+	})
+
+	t.Run("unordered inserts with duplicates", func(t *testing.T) {
+		b := FromSlice([]uint64{7, 1 << 40, 3, 7, 65536, 3, 0})
+		assert.Equal(t, 5, b.Cardinality())
+		assert.Equal(t, []uint64{0, 3, 7, 65536, 1 << 40}, b.ToArray())
+		assert.True(t, b.Contains(1<<40))
+		assert.False(t, b.Contains(8))
+	})
+
+	t.Run("iterate stops early", func(t *testing.T) {
+		b := FromSlice([]uint64{1, 2, 3, 4})
+		var seen []uint64
+		b.Iterate(func(v uint64) bool {
+			seen = append(seen, v)
+			return len(seen) < 2
+		})
+		assert.Equal(t, []uint64{1, 2}, seen)
+	})
+}
+
+func TestBitmapSetOperations(t *testing.T) {
+	// the sizes are chosen to cover both sparse (array) and dense (bitmap)
+	// containers, as well as conversions between the two
+	sizes := []struct {
+		name     string
+		count    int
+		maxValue uint64
+	}{
+		{"sparse", 1000, 1 << 24},
+		{"dense", 50000, 1 << 17},
+		{"mixed", 20000, 1 << 20},
+	}
+
+	for _, a := range sizes {
+		for _, b := range sizes {
+			t.Run(a.name+" and "+b.name, func(t *testing.T) {
+				valuesA := randomValues(a.count, a.maxValue)
+				valuesB := randomValues(b.count, b.maxValue)
+				bitmapA := FromSlice(valuesA)
+				bitmapB := FromSlice(valuesB)
+
+				assert.Equal(t, sortedSet(valuesA), bitmapA.ToArray())
+				assert.Equal(t, intersection(valuesA, valuesB),
+					bitmapA.And(bitmapB).ToArray())
+				assert.Equal(t, union(valuesA, valuesB),
+					bitmapA.Or(bitmapB).ToArray())
+
+				// inputs are not modified
+				assert.Equal(t, sortedSet(valuesA), bitmapA.ToArray())
+				assert.Equal(t, sortedSet(valuesB), bitmapB.ToArray())
+			})
+		}
+	}
+
+	t.Run("clone is independent", func(t *testing.T) {
+		original := FromSlice([]uint64{1, 2, 3})
+		clone := original.Clone()
+		clone.Add(4)
+		assert.Equal(t, 3, original.Cardinality())
+		assert.Equal(t, 4, clone.Cardinality())
+	})
+}
+
+// TestBitmapProperties compares random bitmaps and the results of set
+// operations on them to a map-based set. The sizes of the containers, their
+// intersections and their unions are chosen around arrayMaxSize, so adding
+// values, intersections and unions convert containers between arrays and
+// bitmaps in both directions.
+func TestBitmapProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 50; i++ {
+		valuesA, valuesB := propertyValues(r)
+		bitmapA, bitmapB := FromSlice(valuesA), FromSlice(valuesB)
+		setA, setB := toSet(valuesA), toSet(valuesB)
+
+		and := bitmapA.And(bitmapB)
+		or := bitmapA.Or(bitmapB)
+
+		andSet, orSet := map[uint64]struct{}{}, map[uint64]struct{}{}
+		for v := range setA {
+			orSet[v] = struct{}{}
+			if _, ok := setB[v]; ok {
+				andSet[v] = struct{}{}
+			}
+		}
+		for v := range setB {
+			orSet[v] = struct{}{}
+		}
+
+		for _, tc := range []struct {
+			name     string
+			bitmap   *Bitmap
+			expected map[uint64]struct{}
+		}{
+			{"a", bitmapA, setA},
+			{"b", bitmapB, setB},
+			{"a and b", and, andSet},
+			{"b and a", bitmapB.And(bitmapA), andSet},
+			{"a or b", or, orSet},
+			{"b or a", bitmapB.Or(bitmapA), orSet},
+		} {
+			if !assertMatchesSet(t, tc.bitmap, tc.expected, r) {
+				t.Fatalf("iteration %d: %s does not match the set", i, tc.name)
+			}
+		}
+	}
+}
+
+// propertyValues returns two sets of values spread over a few containers in
+// random order. Per container, the number of values both sets share, as well
+// as the size of their union, is one of the interesting cardinalities.
+func propertyValues(r *rand.Rand) ([]uint64, []uint64) {
+	cardinality := func() int {
+		options := []int{
+			0, 1, r.Intn(100), arrayMaxSize - 1, arrayMaxSize, arrayMaxSize + 1,
+			arrayMaxSize - 50 + r.Intn(100), 1<<15 + r.Intn(1<<14),
+		}
+		return options[r.Intn(len(options))]
+	}
+
+	var a, b []uint64
+	for _, key := range []uint64{0, 1, uint64(r.Int63n(1 << 47))} {
+		shared, onlyA := cardinality(), cardinality()
+		onlyB := cardinality()
+		if union := cardinality(); union > shared+onlyA {
+			onlyB = union - shared - onlyA
+		}
+		if excess := shared + onlyA + onlyB - 1<<16; excess > 0 {
+			// a container can't hold more values
+			onlyB -= excess
+			if onlyB < 0 {
+				onlyA, onlyB = onlyA+onlyB, 0
+			}
+		}
+
+		lows := r.Perm(1 << 16)
+		for i, low := range lows[:shared+onlyA+onlyB] {
+			v := key<<16 | uint64(low)
+			if i < shared+onlyA {
+				a = append(a, v)
+			}
+			if i < shared || i >= shared+onlyA {
+				b = append(b, v)
+			}
+		}
+	}
+
+	return withDuplicates(r, a), withDuplicates(r, b)
+}
+
+// withDuplicates adds some of the values a second time, as they must be
+// ignored, and shuffles them
+func withDuplicates(r *rand.Rand, values []uint64) []uint64 {
+	for i, n := 0, len(values)/10; i < n; i++ {
+		values = append(values, values[r.Intn(len(values))])
+	}
+	r.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+
+	return values
+}
+
+func toSet(values []uint64) map[uint64]struct{} {
+	out := make(map[uint64]struct{}, len(values))
+	for _, v := range values {
+		out[v] = struct{}{}
+	}
+	return out
+}
+
+// assertMatchesSet checks the bitmap holds exactly the values of the set and
+// that its containers are in the representation their cardinality requires
+func assertMatchesSet(t *testing.T, b *Bitmap, set map[uint64]struct{},
+	r *rand.Rand) bool {
+	expected := make([]uint64, 0, len(set))
+	for v := range set {
+		expected = append(expected, v)
+	}
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+
+	ok := assert.Equal(t, expected, b.ToArray())
+	ok = assert.Equal(t, len(set), b.Cardinality()) && ok
+	ok = assert.Equal(t, len(set) == 0, b.IsEmpty()) && ok
+
+	for i := 0; i < 100; i++ {
+		v := uint64(r.Int63n(4 << 16))
+		_, present := set[v]
+		ok = assert.Equal(t, present, b.Contains(v), "contains %d", v) && ok
+	}
+
+	ok = assert.True(t, sort.SliceIsSorted(b.keys, func(i, j int) bool {
+		return b.keys[i] < b.keys[j]
+	}), "keys are sorted") && ok
+	for i, c := range b.containers {
+		count := 0
+		c.iterate(func(uint16) bool {
+			count++
+			return true
+		})
+		ok = assert.Equal(t, count, c.card, "cardinality of container %d", i) && ok
+		ok = assert.NotZero(t, c.card, "container %d is empty", i) && ok
+		ok = assert.Equal(t, c.card > arrayMaxSize, c.isBitmap(),
+			"representation of container %d with %d values", i, c.card) && ok
+	}
+
+	return ok
+}
+
+func randomValues(count int, maxValue uint64) []uint64 {
+	out := make([]uint64, count)
+	for i := range out {
+		out[i] = uint64(rand.Int63n(int64(maxValue)))
+	}
+	return out
+}
+
+func sortedSet(in []uint64) []uint64 {
+	seen := map[uint64]struct{}{}
+	out := []uint64{}
+	for _, v := range in {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a] < out[b] })
+	return out
+}
+
+func intersection(a, b []uint64) []uint64 {
+	lookup := map[uint64]struct{}{}
+	for _, v := range b {
+		lookup[v] = struct{}{}
+	}
+
+	var out []uint64
+	for _, v := range a {
+		if _, ok := lookup[v]; ok {
+			out = append(out, v)
+		}
+	}
+	return sortedSet(out)
+}
+
+func union(a, b []uint64) []uint64 {
+	return sortedSet(append(append([]uint64{}, a...), b...))
+}
@@ -0,0 +1,269 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package roaring
+
+import (
+	"math/bits"
+	"sort"
+)
+
+const (
+	// arrayMaxSize is the cardinality at which a sorted array of uint16 takes up
+	// as much space as a bitmap, above it a container is stored as a bitmap
+	arrayMaxSize = 4096
+	bitmapWords  = (1 << 16) / 64
+)
+
+// container holds the lower 16 bits of all values sharing the same upper 48
+// bits. Exactly one of array and bitmap is set.
+type container struct {
+	array  []uint16
+	bitmap []uint64
+	card   int
+}
+
+func newArrayContainer() *container {
+	return &container{array: []uint16{}}
+}
+
+func newBitmapContainer() *container {
+	return &container{bitmap: make([]uint64, bitmapWords)}
+}
+
+func (c *container) isBitmap() bool {
+	return c.bitmap != nil
+}
+
+func (c *container) add(v uint16) {
+	if c.isBitmap() {
+		word, mask := v/64, uint64(1)<<(v%64)
+		if c.bitmap[word]&mask == 0 {
+			c.bitmap[word] |= mask
+			c.card++
+		}
+		return
+	}
+
+	// fast path for ascending inserts
+	if n := len(c.array); n == 0 || c.array[n-1] < v {
+		c.appendToArray(v)
+		return
+	}
+
+	pos := c.searchArray(v)
+	if c.array[pos] == v {
+		return
+	}
+
+	if c.card >= arrayMaxSize {
+		c.toBitmap()
+		c.add(v)
+		return
+	}
+
+	c.array = append(c.array, 0)
+	copy(c.array[pos+1:], c.array[pos:])
+	c.array[pos] = v
+	c.card++
+}
+
+func (c *container) appendToArray(v uint16) {
+	if c.card >= arrayMaxSize {
+		c.toBitmap()
+		c.add(v)
+		return
+	}
+
+	c.array = append(c.array, v)
+	c.card++
+}
+
+func (c *container) contains(v uint16) bool {
+	if c.isBitmap() {
+		return c.bitmap[v/64]&(uint64(1)<<(v%64)) != 0
+	}
+
+	pos := c.searchArray(v)
+	return pos < len(c.array) && c.array[pos] == v
+}
+
+func (c *container) searchArray(v uint16) int {
+	return sort.Search(len(c.array), func(i int) bool {
+		return c.array[i] >= v
+	})
+}
+
+func (c *container) iterate(fn func(v uint16) bool) bool {
+	if !c.isBitmap() {
+		for _, v := range c.array {
+			if !fn(v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for i, word := range c.bitmap {
+		for word != 0 {
+			v := uint16(i*64 + bits.TrailingZeros64(word))
+			if !fn(v) {
+				return false
+			}
+			word &= word - 1
+		}
+	}
+
+	return true
+}
+
+func (c *container) toBitmap() {
+	bitmap := make([]uint64, bitmapWords)
+	for _, v := range c.array {
+		bitmap[v/64] |= uint64(1) << (v % 64)
+	}
+
+	c.bitmap = bitmap
+	c.array = nil
+}
+
+// toArrayIfSparse converts a bitmap container whose cardinality dropped to or
+// below arrayMaxSize back into an array container
+func (c *container) toArrayIfSparse() *container {
+	if !c.isBitmap() || c.card > arrayMaxSize {
+		return c
+	}
+
+	array := make([]uint16, 0, c.card)
+	c.iterate(func(v uint16) bool {
+		array = append(array, v)
+		return true
+	})
+
+	return &container{array: array, card: len(array)}
+}
+
+func (c *container) sizeInBytes() int {
+	if c.isBitmap() {
+		return bitmapWords * 8
+	}
+
+	return len(c.array) * 2
+}
+
+func (c *container) clone() *container {
+	if c.isBitmap() {
+		out := &container{bitmap: make([]uint64, bitmapWords), card: c.card}
+		copy(out.bitmap, c.bitmap)
+		return out
+	}
+
+	out := &container{array: make([]uint16, len(c.array)), card: c.card}
+	copy(out.array, c.array)
+	return out
+}
+
+func (c *container) and(other *container) *container {
+	switch {
+	case c.isBitmap() && other.isBitmap():
+		out := newBitmapContainer()
+		for i := range out.bitmap {
+			out.bitmap[i] = c.bitmap[i] & other.bitmap[i]
+			out.card += bits.OnesCount64(out.bitmap[i])
+		}
+		return out.toArrayIfSparse()
+
+	case c.isBitmap():
+		return other.and(c)
+
+	case other.isBitmap():
+		out := &container{array: make([]uint16, 0, len(c.array))}
+		for _, v := range c.array {
+			if other.contains(v) {
+				out.array = append(out.array, v)
+			}
+		}
+		out.card = len(out.array)
+		return out
+
+	default:
+		out := &container{array: make([]uint16, 0, minInt(len(c.array), len(other.array)))}
+		i, j := 0, 0
+		for i < len(c.array) && j < len(other.array) {
+			switch {
+			case c.array[i] < other.array[j]:
+				i++
+			case c.array[i] > other.array[j]:
+				j++
+			default:
+				out.array = append(out.array, c.array[i])
+				i++
+				j++
+			}
+		}
+		out.card = len(out.array)
+		return out
+	}
+}
+
+func (c *container) or(other *container) *container {
+	switch {
+	case c.isBitmap() && other.isBitmap():
+		out := newBitmapContainer()
+		for i := range out.bitmap {
+			out.bitmap[i] = c.bitmap[i] | other.bitmap[i]
+			out.card += bits.OnesCount64(out.bitmap[i])
+		}
+		return out
+
+	case c.isBitmap():
+		return other.or(c)
+
+	case other.isBitmap():
+		out := other.clone()
+		for _, v := range c.array {
+			out.add(v)
+		}
+		return out
+
+	default:
+		out := &container{array: make([]uint16, 0, len(c.array)+len(other.array))}
+		i, j := 0, 0
+		for i < len(c.array) || j < len(other.array) {
+			switch {
+			case j >= len(other.array) || (i < len(c.array) && c.array[i] < other.array[j]):
+				out.array = append(out.array, c.array[i])
+				i++
+			case i >= len(c.array) || c.array[i] > other.array[j]:
+				out.array = append(out.array, other.array[j])
+				j++
+			default:
+				out.array = append(out.array, c.array[i])
+				i++
+				j++
+			}
+		}
+		out.card = len(out.array)
+
+		if out.card > arrayMaxSize {
+			out.toBitmap()
+		}
+		return out
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -16,15 +16,20 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/refcache"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -58,8 +63,34 @@ func (db *DB) ClassSearch(ctx context.Context,
 		return nil, errors.Wrapf(err, "invalid pagination params")
 	}
 
+	if params.KeywordRanking != nil {
+		res, scores, err := idx.objectKeywordSearch(ctx, params.KeywordRanking,
+			totalLimit, params.Filters, params.AdditionalProperties)
+		if err != nil {
+			return nil, errors.Wrapf(err, "object keyword search at index %s", idx.ID())
+		}
+
+		return db.enrichRefsForList(ctx,
+			storobj.SearchResultsWithScores(db.getStoreObjects(res, params.Pagination),
+				params.AdditionalProperties, db.getDists(scores, params.Pagination)),
+			params.Properties, params.AdditionalProperties)
+	}
+
+	if params.SparseRanking != nil {
+		res, scores, err := idx.objectSparseSearch(ctx, params.SparseRanking,
+			totalLimit, params.Filters, params.AdditionalProperties)
+		if err != nil {
+			return nil, errors.Wrapf(err, "object sparse search at index %s", idx.ID())
+		}
+
+		return db.enrichRefsForList(ctx,
+			storobj.SearchResultsWithScores(db.getStoreObjects(res, params.Pagination),
+				params.AdditionalProperties, db.getDists(scores, params.Pagination)),
+			params.Properties, params.AdditionalProperties)
+	}
+
 	res, err := idx.objectSearch(ctx, totalLimit,
-		params.Filters, params.AdditionalProperties)
+		params.Filters, params.Cursor, params.Sort, params.AdditionalProperties)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object search at index %s", idx.ID())
 	}
@@ -71,6 +102,10 @@ func (db *DB) ClassSearch(ctx context.Context,
 
 func (db *DB) VectorClassSearch(ctx context.Context,
 	params traverser.GetParams) ([]search.Result, error) {
+	if params.SearchVectors != nil {
+		return db.multiVectorClassSearch(ctx, params)
+	}
+
 	if params.SearchVector == nil {
 		return db.ClassSearch(ctx, params)
 	}
@@ -96,6 +131,31 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 			db.getDists(dists, params.Pagination)), params.Properties, params.AdditionalProperties)
 }
 
+// multiVectorClassSearch ranks the objects of the class by the late
+// interaction of their token vectors with the search vectors
+func (db *DB) multiVectorClassSearch(ctx context.Context,
+	params traverser.GetParams) ([]search.Result, error) {
+	totalLimit, err := db.getTotalLimit(params.Pagination)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pagination params")
+	}
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	res, dists, err := idx.objectMultiVectorSearch(ctx, params.SearchVectors,
+		totalLimit, params.Filters, params.AdditionalProperties)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object multi vector search at index %s", idx.ID())
+	}
+
+	return db.enrichRefsForList(ctx,
+		storobj.SearchResultsWithDists(db.getStoreObjects(res, params.Pagination), params.AdditionalProperties,
+			db.getDists(dists, params.Pagination)), params.Properties, params.AdditionalProperties)
+}
+
 func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit int,
 	filters *filters.LocalFilter) ([]search.Result, error) {
 	var found search.Results
@@ -112,11 +172,20 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit
 		Vector: true,
 	}
 	for _, index := range db.indices {
+		if !index.acceptsTenant(tenant.FromContext(ctx)) {
+			continue
+		}
+
+		indexFilters, ok := db.crossClassFilters(filters, index.Config.ClassName)
+		if !ok {
+			continue
+		}
+
 		wg.Add(1)
 		go func(index *Index, wg *sync.WaitGroup) {
 			defer wg.Done()
 
-			res, _, err := index.objectVectorSearch(ctx, vector, totalLimit, filters, emptyAdditional)
+			res, _, err := index.objectVectorSearch(ctx, vector, totalLimit, indexFilters, emptyAdditional)
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -153,6 +222,40 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit
 	return db.getSearchResults(found, offset, limit), nil
 }
 
+// crossClassFilters binds a class-independent filter to the specified class.
+// A class which lacks any of the filtered properties can never match, so it
+// is not considered a candidate at all.
+func (db *DB) crossClassFilters(in *filters.LocalFilter,
+	className schema.ClassName) (*filters.LocalFilter, bool) {
+	if in == nil {
+		return nil, true
+	}
+
+	sch := db.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(className)
+	if class == nil {
+		return nil, false
+	}
+
+	for _, prop := range in.RootProperties() {
+		if !classHasProperty(class, prop) {
+			return nil, false
+		}
+	}
+
+	return in.ForClass(className), true
+}
+
+func classHasProperty(class *models.Class, prop schema.PropertyName) bool {
+	for _, classProp := range class.Properties {
+		if classProp.Name == prop.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (d *DB) ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 	additional additional.Properties) (search.Results, error) {
 	return d.objectSearch(ctx, offset, limit, filters, additional)
@@ -167,8 +270,12 @@ func (d *DB) objectSearch(ctx context.Context, offset, limit int,
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
 	for _, index := range d.indices {
+		if !index.acceptsTenant(tenant.FromContext(ctx)) {
+			continue
+		}
+
 		// TODO support all additional props
-		res, err := index.objectSearch(ctx, totalLimit, filters, additional)
+		res, err := index.objectSearch(ctx, totalLimit, filters, nil, nil, additional)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
@@ -183,6 +290,170 @@ func (d *DB) objectSearch(ctx context.Context, offset, limit int,
 	return d.getSearchResults(found, offset, limit), nil
 }
 
+// ObjectCursorSearch lists the objects of a single class in ascending order
+// of their UUID, starting right after the cursor position
+func (d *DB) ObjectCursorSearch(ctx context.Context, className string,
+	cursor filters.Cursor, limit int,
+	additional additional.Properties) (search.Results, error) {
+	idx := d.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", className)
+	}
+
+	if limit > int(d.config.QueryMaximumResults) {
+		return nil, errors.New("query maximum results exceeded")
+	}
+
+	res, err := idx.objectSearch(ctx, limit, nil, &cursor, nil, additional)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cursor search at index %s", idx.ID())
+	}
+
+	return storobj.SearchResults(res, additional), nil
+}
+
+// ObjectKeywordSearch ranks the objects of a single class by their BM25
+// relevance for the keyword ranking, without involving the vector index
+func (d *DB) ObjectKeywordSearch(ctx context.Context, className string,
+	keywordRanking searchparams.KeywordRanking, offset, limit int,
+	additional additional.Properties) (search.Results, error) {
+	idx := d.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", className)
+	}
+
+	totalLimit := offset + limit
+	if totalLimit > int(d.config.QueryMaximumResults) {
+		return nil, errors.New("query maximum results exceeded")
+	}
+
+	res, scores, err := idx.objectKeywordSearch(ctx, &keywordRanking, totalLimit,
+		nil, additional)
+	if err != nil {
+		return nil, errors.Wrapf(err, "keyword search at index %s", idx.ID())
+	}
+
+	return d.getSearchResults(storobj.SearchResultsWithScores(res, additional, scores),
+		offset, limit), nil
+}
+
+// ObjectScroll returns the next page of up to limit objects of a scroll. An
+// empty scrollID opens a new scroll on the class. The returned scroll id must
+// be used for the next page, it is empty once all objects have been returned.
+// The scroll is kept open for ttl after every page.
+func (d *DB) ObjectScroll(ctx context.Context, className, scrollID string,
+	ttl time.Duration, limit int,
+	additional additional.Properties) (search.Results, string, error) {
+	var pos *scrollPosition
+	if scrollID != "" {
+		parsed, err := parseScrollID(scrollID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if className != "" && className != parsed.Class {
+			return nil, "", errortypes.New(errortypes.KindValidation,
+				"scroll id belongs to class %q, not %q", parsed.Class, className)
+		}
+
+		pos = &parsed
+		className = parsed.Class
+	}
+
+	idx := d.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, "", fmt.Errorf("tried to browse non-existing index for %s", className)
+	}
+
+	if limit > int(d.config.QueryMaximumResults) {
+		return nil, "", errors.New("query maximum results exceeded")
+	}
+
+	res, next, err := idx.scroll(ctx, pos, ttl, limit, additional)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "scroll at index %s", idx.ID())
+	}
+
+	if next == nil {
+		return storobj.SearchResults(res, additional), "", nil
+	}
+
+	return storobj.SearchResults(res, additional), next.encode(), nil
+}
+
+// FacetedSearch returns the first objects of a class matching the filters
+// together with the facets of all matching objects, see
+// aggregation.FacetParams
+func (d *DB) FacetedSearch(ctx context.Context,
+	params aggregation.FacetParams) (search.Results, *aggregation.FacetResult, error) {
+	idx := d.GetIndex(params.ClassName)
+	if idx == nil {
+		return nil, nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	if params.Limit > int(d.config.QueryMaximumResults) {
+		return nil, nil, errors.New("query maximum results exceeded")
+	}
+
+	res, facets, err := idx.facetedSearch(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "faceted search at index %s", idx.ID())
+	}
+
+	return storobj.SearchResults(res, params.Additional), facets, nil
+}
+
+// ContinuousAggregate returns the current groups of a continuous aggregate
+// of a class, see aggregation.ContinuousParams
+func (d *DB) ContinuousAggregate(ctx context.Context,
+	params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	idx := d.GetIndex(params.ClassName)
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	res, err := idx.continuousAggregate(ctx, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "continuous aggregate at index %s", idx.ID())
+	}
+
+	return res, nil
+}
+
+// EstimateFilter dry runs the filters on a class, see filters.Estimate
+func (d *DB) EstimateFilter(ctx context.Context,
+	params filters.EstimateParams) (*filters.Estimate, error) {
+	idx := d.GetIndex(params.ClassName)
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	res, err := idx.estimateFilter(ctx, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "estimate filter at index %s", idx.ID())
+	}
+
+	return res, nil
+}
+
+// ObjectNeighbors returns the nearest neighbors of the vector among the
+// objects of a single class. The results contain their distance to the vector.
+func (d *DB) ObjectNeighbors(ctx context.Context, className string,
+	vector []float32, limit int) (search.Results, error) {
+	idx := d.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", className)
+	}
+
+	res, dists, err := idx.objectVectorSearch(ctx, vector, limit, nil,
+		additional.Properties{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "neighbor search at index %s", idx.ID())
+	}
+
+	return storobj.SearchResultsWithDists(res, additional.Properties{}, dists), nil
+}
+
 func (d *DB) enrichRefsForList(ctx context.Context, objs search.Results,
 	props search.SelectProperties, additional additional.Properties) (search.Results, error) {
 	res, err := refcache.NewResolver(refcache.NewCacher(d, d.logger)).
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -24,9 +25,11 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/geo"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/noop"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/segmented"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/sirupsen/logrus"
@@ -47,18 +50,54 @@ type Shard struct {
 	deletedDocIDs    *docid.InMemDeletedTracker
 	cleanupInterval  time.Duration
 	cleanupCancel    chan struct{}
+	atomicBatchLock  sync.Mutex
+
+	// atomicBatchStatus is whether the journal of a committed atomic batch is
+	// still waiting to be rolled forward, see rollForwardBatchJournal
+	atomicBatchStatus atomicBatchStatus
+
+	// readViewLock is held for reading by every write of an object and for
+	// writing while a read view of the shard is taken, see newReadView
+	readViewLock sync.RWMutex
+
+	// backupInProgress is set while the files of the shard are being backed
+	// up, see beginBackup. backupGeoIndices are the property-specific indices
+	// which were paused for the backup.
+	backupInProgress int32
+	backupGeoIndices map[string]*geo.Index
+
+	scrolls *shardScrolls
+
+	// keywordObjectCount caches the object count keyword searches are ranked
+	// with, see objectCountForKeywordSearch
+	keywordObjectCount keywordObjectCount
+
+	// coalescer merges bursts of puts of the same object, it is nil if write
+	// coalescing is disabled
+	coalescer *writeCoalescer
+
+	// multiVectors keeps track of the token ids of the objects, it is nil
+	// unless the class has multiVector enabled
+	multiVectors *multiVectorStore
 }
 
 func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, error) {
+	rowCacheMaxSize := index.Config.RowCacheMaxSize
+	if rowCacheMaxSize == 0 {
+		rowCacheMaxSize = defaultRowCacheMaxSize
+	}
+
 	s := &Shard{
 		index:            index,
 		name:             shardName,
-		invertedRowCache: inverted.NewRowCacher(500 * 1024 * 1024),
+		invertedRowCache: inverted.NewRowCacher(rowCacheMaxSize),
 		metrics:          NewMetrics(index.logger),
 		deletedDocIDs:    docid.NewInMemDeletedTracker(),
 		cleanupInterval: time.Duration(index.invertedIndexConfig.
 			CleanupIntervalSeconds) * time.Second,
 		cleanupCancel: make(chan struct{}),
+		scrolls:       newShardScrolls(),
+		coalescer:     newWriteCoalescer(index.Config.WriteCoalescingWindow),
 	}
 
 	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnsw.UserConfig)
@@ -69,24 +108,28 @@ func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, erro
 
 	if hnswUserConfig.Skip {
 		s.vectorIndex = noop.NewIndex()
-	} else {
-		vi, err := hnsw.New(hnsw.Config{
-			Logger:   index.logger,
-			RootPath: s.index.Config.RootPath,
-			ID:       s.ID(),
-			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-				return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), 10*time.Second,
-					index.logger)
-			},
-			VectorForIDThunk: s.vectorByIndexID,
-			DistanceProvider: distancer.NewDotProductProvider(),
-		}, hnswUserConfig)
+	} else if hnswUserConfig.Segments <= 1 {
+		vi, err := s.initHnswIndex(s.ID(), hnswUserConfig, s.vectorByIndexID)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 		}
 		s.vectorIndex = vi
 
 		defer vi.PostStartup()
+	} else {
+		segments := make([]segmented.Segment, hnswUserConfig.Segments)
+		for i := range segments {
+			id := fmt.Sprintf("%s_segment_%d", s.ID(), i)
+			vi, err := s.initHnswIndex(id, hnswUserConfig, s.vectorByIndexID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "init shard %q: hnsw index segment %d",
+					s.ID(), i)
+			}
+			segments[i] = vi
+
+			defer vi.PostStartup()
+		}
+		s.vectorIndex = segmented.New(segments)
 	}
 
 	err := s.initDBFile(ctx)
@@ -101,15 +144,76 @@ func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, erro
 
 	s.counter = counter
 
+	if hnswUserConfig.MultiVector {
+		tokens, err := s.initMultiVectorIndex(ctx, hnswUserConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: multi vector index", s.ID())
+		}
+
+		defer tokens.PostStartup()
+	}
+
+	if hnswUserConfig.Sparse {
+		if err := s.initSparseVectorPostings(ctx); err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: sparse vector postings", s.ID())
+		}
+	}
+
+	if len(index.invertedIndexConfig.ContinuousAggregates) > 0 {
+		if err := s.initContinuousAggregates(ctx); err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: continuous aggregates", s.ID())
+		}
+	}
+
 	if err := s.initProperties(); err != nil {
 		return nil, errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
 	}
 
+	s.replayBatchJournal()
+
 	return s, nil
 }
 
+// ID is the name of the shard on disk, it is based on the immutable physical
+// ID of the index rather than the class name
 func (s *Shard) ID() string {
-	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
+	return fmt.Sprintf("%s_%s", s.index.physicalID, s.name)
+}
+
+type startableVectorIndex interface {
+	VectorIndex
+	PostStartup()
+}
+
+// initHnswIndex creates a single hnsw graph. The id determines the location
+// of its commit logs, so it has to be stable across restarts.
+func (s *Shard) initHnswIndex(id string, uc hnsw.UserConfig,
+	vectorForID hnsw.VectorForID) (startableVectorIndex, error) {
+	distProv, err := distancer.ProviderForMetric(uc.Distance)
+	if err != nil {
+		return nil, errors.Wrap(err, "init vector index")
+	}
+	if uc.Binary {
+		// binary vectors are held bit-packed, so their hamming distance is
+		// counted on the packed bits
+		distProv = distancer.NewHammingBinaryProvider()
+	}
+
+	return hnsw.New(hnsw.Config{
+		Logger:   s.index.logger,
+		RootPath: s.index.Config.RootPath,
+		ID:       id,
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id, 10*time.Second,
+				s.index.logger, hnsw.WithIOThrottle(s.index.Config.IOThrottle),
+				hnsw.WithEncryption(s.index.Config.Encryption),
+				hnsw.WithMaxLogSize(s.index.Config.HNSWMaxLogSize))
+		},
+		VectorForIDThunk: vectorForID,
+		DistanceProvider: distProv,
+		IOThrottle:       s.index.Config.IOThrottle,
+		Encryption:       s.index.Config.Encryption,
+	}, uc)
 }
 
 func (s *Shard) DBPathLSM() string {
@@ -122,14 +226,20 @@ func (s *Shard) initDBFile(ctx context.Context) error {
 		"index": s.index.ID(),
 		"class": s.index.Config.ClassName,
 	})
-	store, err := lsmkv.New(s.DBPathLSM(), annotatedLogger)
+	store, err := lsmkv.New(s.DBPathLSM(), annotatedLogger,
+		lsmkv.WithHandleBudget(s.index.Config.HandleBudget),
+		lsmkv.WithMemtableBudget(s.index.Config.MemtableBudget),
+		lsmkv.WithIOThrottle(s.index.Config.IOThrottle),
+		lsmkv.WithCompactions(s.index.Config.Compactions),
+		lsmkv.WithEncryption(s.index.Config.Encryption))
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.DBPathLSM())
 	}
 
 	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
-		lsmkv.WithSecondaryIndicies(1))
+		lsmkv.WithSecondaryIndicies(1),
+		lsmkv.WithCompression(s.index.Config.SegmentCompression))
 	if err != nil {
 		return errors.Wrap(err, "create objects bucket")
 	}
@@ -143,6 +253,10 @@ func (s *Shard) drop() error {
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
 	defer cancel()
 
+	if err := s.scrolls.releaseAll(); err != nil {
+		return errors.Wrap(err, "release scrolls")
+	}
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
@@ -158,6 +272,11 @@ func (s *Shard) drop() error {
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
+	if s.multiVectors != nil {
+		if err := s.multiVectors.counter.Drop(); err != nil {
+			return errors.Wrapf(err, "remove token indexcount at %s", s.DBPathLSM())
+		}
+	}
 	// remove vector index
 	err = s.vectorIndex.Drop()
 	if err != nil {
@@ -171,6 +290,9 @@ func (s *Shard) drop() error {
 		return errors.Wrapf(err, "remove property specific indices at %s", s.DBPathLSM())
 	}
 
+	// remove a journal of an atomic batch which could not be rolled forward
+	os.Remove(s.batchJournalPath())
+
 	return nil
 }
 
@@ -192,6 +314,58 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 	return nil
 }
 
+// addTimestampProperties creates the buckets for the creation and last
+// update time, which are only indexed if the class has indexTimestamps enabled
+func (s *Shard) addTimestampProperties(ctx context.Context) error {
+	if !s.index.invertedIndexConfig.IndexTimestamps {
+		return nil
+	}
+
+	for _, propName := range []string{
+		helpers.PropertyNameCreationTimeUnix,
+		helpers.PropertyNameLastUpdateTimeUnix,
+	} {
+		err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketFromPropNameLSM(propName),
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection)) // timestamps are ints -> Set
+		if err != nil {
+			return err
+		}
+
+		err = s.store.CreateOrLoadBucket(ctx,
+			helpers.HashBucketFromPropNameLSM(propName),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addCompositeIndexes creates the buckets for each of the composite indexes
+// configured in the inverted index config of the class
+func (s *Shard) addCompositeIndexes(ctx context.Context) error {
+	for _, composite := range s.index.invertedIndexConfig.CompositeIndexes {
+		propName := helpers.MetaCompositeProp(composite)
+		err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketFromPropNameLSM(propName),
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection)) // composites do not have frequencies -> Set
+		if err != nil {
+			return err
+		}
+
+		err = s.store.CreateOrLoadBucket(ctx,
+			helpers.HashBucketFromPropNameLSM(propName),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 	if schema.IsRefDataType(prop.DataType) {
 		err := s.store.CreateOrLoadBucket(ctx,
@@ -209,17 +383,38 @@ func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 		}
 	}
 
+	if s.index.invertedIndexConfig.IndexNullState {
+		err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketFromPropNameLSM(helpers.MetaNullStateProp(prop.Name)),
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection)) // null state is a bool -> Set
+		if err != nil {
+			return err
+		}
+
+		err = s.store.CreateOrLoadBucket(ctx,
+			helpers.HashBucketFromPropNameLSM(helpers.MetaNullStateProp(prop.Name)),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		if err != nil {
+			return err
+		}
+	}
+
 	if schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates {
 		return s.initGeoProp(prop)
 	}
 
+	if inverted.SharedStorage(prop) {
+		return s.addSharedProperty(ctx)
+	}
+
 	strategy := lsmkv.StrategySetCollection
 	if inverted.HasFrequency(schema.DataType(prop.DataType[0])) {
 		strategy = lsmkv.StrategyMapCollection
 	}
 
 	err := s.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM(prop.Name),
-		lsmkv.WithStrategy(strategy))
+		lsmkv.WithStrategy(strategy),
+		lsmkv.WithCompression(s.index.Config.SegmentCompression))
 	if err != nil {
 		return err
 	}
@@ -239,5 +434,9 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 }
 
 func (s *Shard) shutdown(ctx context.Context) error {
+	if err := s.scrolls.releaseAll(); err != nil {
+		return errors.Wrap(err, "release scrolls")
+	}
+
 	return s.store.Shutdown(ctx)
 }
@@ -20,6 +20,11 @@ import (
 
 func (s *Shard) aggregate(ctx context.Context,
 	params aggregation.Params) (*aggregation.Result, error) {
-	return aggregator.New(s.store, params, s.index.getSchema, s.invertedRowCache,
-		s.index.classSearcher, s.deletedDocIDs).Do(ctx)
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregator.New(view.store, params, s.index.getSchema, s.invertedRowCache,
+		s.index.classSearcher, view.deletedDocIDs, s.vectorIndex).Do(ctx)
 }
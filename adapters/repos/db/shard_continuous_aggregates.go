@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// initContinuousAggregates creates the bucket which holds the groups of the
+// continuous aggregates of the class. The row key is the name of the
+// aggregate, every group is an entry of its map. The aggregates can only be
+// configured when the class is created, so every object of the shard is
+// always counted.
+func (s *Shard) initContinuousAggregates(ctx context.Context) error {
+	return s.store.CreateOrLoadBucket(ctx, helpers.ContinuousAggregatesBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
+}
+
+// updateContinuousAggregatesLSM adds the object to its group of every
+// continuous aggregate of the class for a delta of 1, or removes it again for
+// a delta of -1. A group is deleted once it no longer counts any objects. It
+// is a no-op if the class does not have continuous aggregates.
+func (s *Shard) updateContinuousAggregatesLSM(object *storobj.Object,
+	delta int64) error {
+	bucket := s.store.Bucket(helpers.ContinuousAggregatesBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	props, _ := object.Properties().(map[string]interface{})
+	for _, agg := range s.index.invertedIndexConfig.ContinuousAggregates {
+		value, ok := continuousGroupValue(props, agg.GroupBy)
+		if !ok {
+			// objects without a value for the grouped by property are not part
+			// of any group
+			continue
+		}
+
+		sums := make([]float64, len(agg.Sum))
+		for i, propName := range agg.Sum {
+			if n, ok := continuousNumber(props[propName]); ok {
+				sums[i] = float64(delta) * n
+			}
+		}
+
+		_, err := bucket.MapUpdate([]byte(agg.Name), continuousGroupKey(value),
+			func(current []byte, ok bool) ([]byte, error) {
+				group := aggregation.ContinuousGroup{Sums: make([]float64, len(sums))}
+				if ok {
+					decoded, err := decodeContinuousGroup(current)
+					if err != nil {
+						return nil, err
+					}
+					group = decoded
+				}
+
+				group.Count += delta
+				if group.Count <= 0 {
+					return nil, nil
+				}
+
+				for i := range sums {
+					if i < len(group.Sums) {
+						group.Sums[i] += sums[i]
+					}
+				}
+
+				return encodeContinuousGroup(group), nil
+			})
+		if err != nil {
+			return errors.Wrapf(err, "update continuous aggregate %q", agg.Name)
+		}
+	}
+
+	return nil
+}
+
+// continuousAggregate returns the current groups of the continuous aggregate
+// of the shard, read from the bucket without scanning any objects
+func (s *Shard) continuousAggregate(ctx context.Context,
+	params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := view.store.Bucket(helpers.ContinuousAggregatesBucketLSM)
+	if bucket == nil {
+		return nil, errors.Errorf("class %s does not have continuous aggregates",
+			params.ClassName)
+	}
+
+	pairs, err := bucket.MapList([]byte(params.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read continuous aggregate %q", params.Name)
+	}
+
+	out := make([]aggregation.ContinuousGroup, len(pairs))
+	for i, pair := range pairs {
+		group, err := decodeContinuousGroup(pair.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "continuous aggregate %q", params.Name)
+		}
+
+		// the key is prefixed, so the group of an aggregate without groupBy has
+		// a non-empty key, too
+		group.Value = string(pair.Key[1:])
+		out[i] = group
+	}
+
+	return out, nil
+}
+
+func continuousGroupKey(value string) []byte {
+	return append([]byte{0}, value...)
+}
+
+// continuousGroupValue returns the group of an object, every object is part
+// of the single group "" of an aggregate without groupBy
+func continuousGroupValue(props map[string]interface{},
+	groupBy string) (string, bool) {
+	if groupBy == "" {
+		return "", true
+	}
+
+	switch v := props[groupBy].(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case time.Time:
+		// the same representation as a date read back from disk
+		return v.Format(time.RFC3339Nano), true
+	default:
+		// numbers are formatted from their float64 value, so e.g. the
+		// json.Number "3.0" and the float64 3 end up in the same group
+		if n, ok := continuousNumber(v); ok {
+			return strconv.FormatFloat(n, 'f', -1, 64), true
+		}
+		return fmt.Sprint(v), true
+	}
+}
+
+func continuousNumber(in interface{}) (float64, bool) {
+	switch v := in.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case json.Number:
+		n, err := v.Float64()
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// encodeContinuousGroup encodes the count of a group followed by its sums,
+// the value of the group is the key of its entry
+func encodeContinuousGroup(group aggregation.ContinuousGroup) []byte {
+	out := make([]byte, 8+8*len(group.Sums))
+	binary.LittleEndian.PutUint64(out, uint64(group.Count))
+	for i, sum := range group.Sums {
+		binary.LittleEndian.PutUint64(out[8+8*i:], math.Float64bits(sum))
+	}
+
+	return out
+}
+
+func decodeContinuousGroup(in []byte) (aggregation.ContinuousGroup, error) {
+	if len(in) < 8 || len(in)%8 != 0 {
+		return aggregation.ContinuousGroup{}, errors.Errorf(
+			"corrupt continuous aggregate group of length %d", len(in))
+	}
+
+	group := aggregation.ContinuousGroup{
+		Count: int64(binary.LittleEndian.Uint64(in)),
+		Sums:  make([]float64, (len(in)-8)/8),
+	}
+	for i := range group.Sums {
+		group.Sums[i] = math.Float64frombits(binary.LittleEndian.Uint64(in[8+8*i:]))
+	}
+
+	return group, nil
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/aggregator"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// facetedSearch resolves the filters to doc ids once. The facets of the
+// properties which are indexed by their entire values are counted from their
+// posting lists in the inverted index, which only requires checking the doc
+// ids against the allow list. Only if a property is tokenized, e.g. a text
+// with the word tokenization, are the matching objects scanned to count its
+// values. The results are the first limit matching objects, ordered by doc id
+// just like those of a regular filtered search, or by id without filters.
+func (s *Shard) facetedSearch(ctx context.Context,
+	params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error) {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.FindClassByName(params.ClassName)
+	if class == nil {
+		return nil, nil, errors.Errorf("class %s not found in schema", params.ClassName)
+	}
+
+	indexed := map[schema.PropertyName]*models.Property{}
+	var scanned []schema.PropertyName
+	for _, propName := range params.Properties {
+		prop, err := schema.GetPropertyByName(class, propName.String())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if inverted.FacetIndexed(prop) {
+			indexed[propName] = prop
+		} else {
+			scanned = append(scanned, propName)
+		}
+	}
+
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searcher := inverted.NewSearcher(view.store, sch, s.invertedRowCache,
+		s.propertyIndices, s.index.classSearcher, view.deletedDocIDs)
+
+	var allowList helpers.AllowList
+	var ids []uint64
+	var count int
+	if params.Filters != nil {
+		list, err := searcher.DocIDs(ctx, params.Filters, params.Additional,
+			params.ClassName)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+		allowList = list
+
+		ids = make([]uint64, 0, len(allowList))
+		for id := range allowList {
+			if !view.deletedDocIDs.Contains(id) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		count = len(ids)
+	} else {
+		all, err := searcher.CountAll(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "count objects")
+		}
+		count = all
+	}
+
+	var out []*storobj.Object
+	if params.Filters != nil {
+		limit := params.Limit
+		if limit > len(ids) {
+			limit = len(ids)
+		}
+		out, err = s.objectsByDocID(ctx, ids[:limit], params.Additional)
+	} else {
+		out, err = s.objectList(ctx, params.Limit, params.Additional)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "list objects")
+	}
+
+	scannedFacets, err := s.scanFacets(ctx, params, scanned, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	facets := make([]aggregation.Facet, len(params.Properties))
+	for i, propName := range params.Properties {
+		prop, ok := indexed[propName]
+		if !ok {
+			facets[i] = scannedFacets[propName]
+			continue
+		}
+
+		counts, err := searcher.FacetCounts(ctx, prop, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "count facets from inverted index")
+		}
+
+		facets[i] = aggregator.FacetFromCounts(propName.String(), counts,
+			params.FacetLimit)
+	}
+
+	return out, &aggregation.FacetResult{Count: count, Facets: facets}, nil
+}
+
+// scanFacets counts the values of the props by scanning the objects with the
+// doc ids, or all objects without filters
+func (s *Shard) scanFacets(ctx context.Context, params aggregation.FacetParams,
+	props []schema.PropertyName,
+	ids []uint64) (map[schema.PropertyName]aggregation.Facet, error) {
+	if len(props) == 0 {
+		return nil, nil
+	}
+
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counter := aggregator.NewFacetCounter(params.ClassName, props,
+		params.FacetLimit)
+	scan := func(obj *storobj.Object) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		return true, counter.Add(obj)
+	}
+
+	if params.Filters == nil {
+		if err := aggregator.ScanAllLSM(view.store, scan,
+			storobj.DecodePropertiesOnly); err != nil {
+			return nil, errors.Wrap(err, "scan all objects")
+		}
+	} else {
+		if err := docid.ScanObjectsLSM(view.store, ids, scan,
+			storobj.DecodePropertiesOnly); err != nil {
+			return nil, errors.Wrap(err, "scan matching objects")
+		}
+	}
+
+	facets, err := counter.Facets()
+	if err != nil {
+		return nil, errors.Wrap(err, "count facets")
+	}
+
+	out := make(map[schema.PropertyName]aggregation.Facet, len(facets))
+	for i, propName := range props {
+		out[propName] = facets[i]
+	}
+
+	return out, nil
+}
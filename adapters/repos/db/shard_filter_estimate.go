@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/filters"
+)
+
+// estimateFilter dry runs the filter against the inverted indexes of the
+// shard, see inverted.Searcher.Estimate. No objects are read.
+func (s *Shard) estimateFilter(ctx context.Context,
+	params filters.EstimateParams) (*filters.Estimate, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searcher := inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
+		s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
+		view.deletedDocIDs)
+
+	res, err := searcher.Estimate(ctx, params.Filters, s.index.Config.ClassName)
+	if err != nil {
+		return nil, errors.Wrap(err, "estimate filter")
+	}
+
+	if params.Exact {
+		count, err := searcher.Count(ctx, params.Filters, s.index.Config.ClassName)
+		if err != nil {
+			return nil, errors.Wrap(err, "count filter matches")
+		}
+		res.ExactCount = &count
+	}
+
+	return res, nil
+}
@@ -30,6 +30,8 @@ func (s *Shard) initGeoProp(prop *models.Property) error {
 		CoordinatesForID:   s.makeCoordinatesForID(prop.Name),
 		DisablePersistence: false,
 		Logger:             s.index.logger,
+		IOThrottle:         s.index.Config.IOThrottle,
+		Encryption:         s.index.Config.Encryption,
 	})
 	if err != nil {
 		return errors.Wrapf(err, "create geo index for prop %q", prop.Name)
@@ -47,5 +47,13 @@ func (s *Shard) initProperties() error {
 	if err := s.addIDProperty(context.TODO()); err != nil {
 		return errors.Wrap(err, "init id property")
 	}
+
+	if err := s.addTimestampProperties(context.TODO()); err != nil {
+		return errors.Wrap(err, "init timestamp properties")
+	}
+
+	if err := s.addCompositeIndexes(context.TODO()); err != nil {
+		return errors.Wrap(err, "init composite indexes")
+	}
 	return nil
 }
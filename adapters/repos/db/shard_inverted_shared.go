@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+)
+
+// addSharedProperty creates the buckets shared by all props of the class
+// with the "shared" invertedIndexStorage. They are created once, regardless of
+// how many props use them, which keeps the number of buckets and their files
+// constant for classes with hundreds of boolean flags.
+func (s *Shard) addSharedProperty(ctx context.Context) error {
+	err := s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(helpers.PropertyNameShared),
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection)) // shared props do not have frequencies -> Set
+	if err != nil {
+		return err
+	}
+
+	err = s.store.CreateOrLoadBucket(ctx,
+		helpers.HashBucketFromPropNameLSM(helpers.PropertyNameShared),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// invertedBucketsLSM returns the bucket and hash bucket the prop is indexed
+// in, as well as the items with the keys they are stored with. The keys of
+// a shared prop are prefixed with its name, see helpers.SharedPropKey.
+func (s *Shard) invertedBucketsLSM(prop inverted.Property) (*lsmkv.Bucket,
+	*lsmkv.Bucket, []inverted.Countable, error) {
+	propName := prop.Name
+	items := prop.Items
+	if prop.Shared {
+		propName = helpers.PropertyNameShared
+		items = make([]inverted.Countable, len(prop.Items))
+		for i, item := range prop.Items {
+			items[i] = inverted.Countable{
+				Data:          helpers.SharedPropKey(prop.Name, item.Data),
+				TermFrequency: item.TermFrequency,
+			}
+		}
+	}
+
+	b := s.store.Bucket(helpers.BucketFromPropNameLSM(propName))
+	if b == nil {
+		return nil, nil, nil, errors.Errorf("no bucket for prop '%s' found", prop.Name)
+	}
+
+	hashBucket := s.store.Bucket(helpers.HashBucketFromPropNameLSM(propName))
+	if hashBucket == nil {
+		return nil, nil, nil, errors.Errorf("no hash bucket for prop '%s' found", prop.Name)
+	}
+
+	return b, hashBucket, items, nil
+}
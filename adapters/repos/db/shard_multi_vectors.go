@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/indexcounter"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/multivector"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// multiVectorIndex is the vector index of a shard with multiVector enabled,
+// see multivector.Index
+type multiVectorIndex interface {
+	VectorIndex
+	AddMulti(id uint64, vectors [][]float32) error
+	SearchByMultiVector(queries [][]float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// initMultiVectorIndex wraps the vector index of the shard, so that the token
+// vectors of the objects are indexed in a graph of their own. The returned
+// token graph must be started once the shard is initialized.
+func (s *Shard) initMultiVectorIndex(ctx context.Context,
+	uc hnsw.UserConfig) (startableVectorIndex, error) {
+	for _, bucketName := range []string{
+		helpers.MultiVectorDocsBucketLSM,
+		helpers.MultiVectorTokensBucketLSM,
+	} {
+		err := s.store.CreateOrLoadBucket(ctx, bucketName,
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		if err != nil {
+			return nil, errors.Wrapf(err, "create bucket %s", bucketName)
+		}
+	}
+
+	id := s.ID() + "_multi_vector"
+	counter, err := indexcounter.New(id, s.index.Config.RootPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "token index counter")
+	}
+
+	tokens, err := s.initHnswIndex(id, uc, s.vectorByTokenID)
+	if err != nil {
+		return nil, errors.Wrap(err, "token hnsw index")
+	}
+
+	distProv, err := distancer.ProviderForMetric(uc.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	s.multiVectors = &multiVectorStore{store: s.store, counter: counter}
+	s.vectorIndex = multivector.New(s.vectorIndex, tokens, s.multiVectors,
+		distProv)
+
+	return tokens, nil
+}
+
+// updateMultiVectors replaces the token vectors of the object. Those of its
+// previous doc id are removed along with its regular vector, see
+// updateVectorIndex.
+func (s *Shard) updateMultiVectors(vectors [][]float32,
+	status objectInsertStatus) error {
+	index, ok := s.vectorIndex.(multiVectorIndex)
+	if !ok {
+		// the index validates that only multi vector classes have them
+		return nil
+	}
+
+	if err := index.AddMulti(status.docID, vectors); err != nil {
+		return errors.Wrapf(err, "insert doc id %d to multi vector index", status.docID)
+	}
+
+	return nil
+}
+
+// objectMultiVectorSearch is the counterpart of objectVectorSearch for
+// multiple query vectors, which are matched against the token vectors of the
+// objects by late interaction
+func (s *Shard) objectMultiVectorSearch(ctx context.Context,
+	searchVectors [][]float32, limit int, filters *filters.LocalFilter,
+	additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	index, ok := s.vectorIndex.(multiVectorIndex)
+	if !ok {
+		return nil, nil, errors.Errorf("multiVector is not enabled for shard %s", s.ID())
+	}
+
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		list, err := inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
+			s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
+			view.deletedDocIDs).
+			DocIDs(ctx, filters, additional, s.index.Config.ClassName)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	ids, dists, err := index.SearchByMultiVector(searchVectors, limit, allowList)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "multi vector search")
+	}
+
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	objs, err := s.objectsByDocID(ctx, ids, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, dists, nil
+}
+
+// vectorByTokenID is the counterpart of vectorByIndexID for the token graph.
+// The token vectors are stored as part of their object.
+func (s *Shard) vectorByTokenID(ctx context.Context, tokenID uint64) ([]float32, error) {
+	docID, pos, ok, err := s.multiVectors.token(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(tokenID, "token does not exist")
+	}
+
+	vectors, err := s.multiVectors.Vectors(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pos >= len(vectors) {
+		return nil, storobj.NewErrNotFoundf(tokenID,
+			"token %d of doc id %d does not exist", pos, docID)
+	}
+
+	return vectors[pos], nil
+}
+
+// multiVectorStore keeps track of the token ids of the objects of a shard,
+// see multivector.Store. The token ids of an object are allocated
+// consecutively, so the docs bucket only stores the first one and their
+// number. The tokens bucket maps every token id back to its doc id and its
+// position within the multi vector of the object.
+type multiVectorStore struct {
+	store   *lsmkv.Store
+	counter *indexcounter.Counter
+}
+
+func multiVectorKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, id)
+	return key
+}
+
+func (m *multiVectorStore) AllocateTokens(docID uint64, count int) (uint64, error) {
+	first, err := m.counter.GetAndIncBy(uint64(count))
+	if err != nil {
+		return 0, err
+	}
+
+	tokens := m.store.Bucket(helpers.MultiVectorTokensBucketLSM)
+	for pos := 0; pos < count; pos++ {
+		value := make([]byte, 12)
+		binary.LittleEndian.PutUint64(value[0:8], docID)
+		binary.LittleEndian.PutUint32(value[8:12], uint32(pos))
+		if err := tokens.Put(multiVectorKey(first+uint64(pos)), value); err != nil {
+			return 0, errors.Wrapf(err, "put token %d", pos)
+		}
+	}
+
+	value := make([]byte, 12)
+	binary.LittleEndian.PutUint64(value[0:8], first)
+	binary.LittleEndian.PutUint32(value[8:12], uint32(count))
+	if err := m.store.Bucket(helpers.MultiVectorDocsBucketLSM).
+		Put(multiVectorKey(docID), value); err != nil {
+		return 0, errors.Wrap(err, "put doc")
+	}
+
+	return first, nil
+}
+
+func (m *multiVectorStore) Tokens(docID uint64) (uint64, int, error) {
+	value, err := m.store.Bucket(helpers.MultiVectorDocsBucketLSM).
+		Get(multiVectorKey(docID))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(value) == 0 {
+		return 0, 0, nil
+	}
+
+	return binary.LittleEndian.Uint64(value[0:8]),
+		int(binary.LittleEndian.Uint32(value[8:12])), nil
+}
+
+func (m *multiVectorStore) DeleteTokens(docID uint64) error {
+	first, count, err := m.Tokens(docID)
+	if err != nil {
+		return err
+	}
+
+	tokens := m.store.Bucket(helpers.MultiVectorTokensBucketLSM)
+	for pos := 0; pos < count; pos++ {
+		if err := tokens.Delete(multiVectorKey(first + uint64(pos))); err != nil {
+			return errors.Wrapf(err, "delete token %d", pos)
+		}
+	}
+
+	return m.store.Bucket(helpers.MultiVectorDocsBucketLSM).
+		Delete(multiVectorKey(docID))
+}
+
+func (m *multiVectorStore) DocID(tokenID uint64) (uint64, bool, error) {
+	docID, _, ok, err := m.token(tokenID)
+	return docID, ok, err
+}
+
+// token returns the doc id of the token and its position within the multi
+// vector of the object
+func (m *multiVectorStore) token(tokenID uint64) (uint64, int, bool, error) {
+	value, err := m.store.Bucket(helpers.MultiVectorTokensBucketLSM).
+		Get(multiVectorKey(tokenID))
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	if len(value) == 0 {
+		return 0, 0, false, nil
+	}
+
+	return binary.LittleEndian.Uint64(value[0:8]),
+		int(binary.LittleEndian.Uint32(value[8:12])), true, nil
+}
+
+func (m *multiVectorStore) Vectors(docID uint64) ([][]float32, error) {
+	data, err := m.store.Bucket(helpers.ObjectsBucketLSM).
+		GetBySecondary(0, multiVectorKey(docID))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	// an outdated doc id can still point to the object in older segments
+	current, err := storobj.DocIDFromBinary(data)
+	if err != nil {
+		return nil, err
+	}
+	if current != docID {
+		return nil, nil
+	}
+
+	return storobj.MultiVectorFromBinary(data)
+}
@@ -38,7 +38,12 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, err
 	}
 
-	bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := view.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +74,12 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 		ids[i] = idBytes
 	}
 
-	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := view.store.Bucket(helpers.ObjectsBucketLSM)
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
 		if err != nil {
@@ -156,25 +166,44 @@ func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64) ([]float32,
 }
 
 func (s *Shard) objectSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, additional additional.Properties) ([]*storobj.Object, error) {
+	filters *filters.LocalFilter, cursor *filters.Cursor, sort []filters.Sort,
+	additional additional.Properties) ([]*storobj.Object, error) {
+	if cursor != nil {
+		return s.cursorObjectList(ctx, limit, cursor, additional)
+	}
+
+	if len(sort) > 0 {
+		return s.sortedObjectList(ctx, limit, filters, sort[0], additional)
+	}
+
 	if filters == nil {
 		return s.objectList(ctx, limit, additional)
 	}
 
-	return inverted.NewSearcher(s.store, s.index.getSchema.GetSchemaSkipAuth(),
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
 		s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
-		s.deletedDocIDs).
+		view.deletedDocIDs).
 		Object(ctx, limit, filters, additional, s.index.Config.ClassName)
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 	limit int, filters *filters.LocalFilter, additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var allowList helpers.AllowList
 	beforeAll := time.Now()
 	if filters != nil {
-		list, err := inverted.NewSearcher(s.store, s.index.getSchema.GetSchemaSkipAuth(),
+		list, err := inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
 			s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
-			s.deletedDocIDs).
+			view.deletedDocIDs).
 			DocIDs(ctx, filters, additional, s.index.Config.ClassName)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "build inverted filter allow list")
@@ -195,7 +224,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 	hnswTook := time.Since(beforeVector)
 	beforeObjects := time.Now()
 
-	objs, err := s.objectsByDocID(ids, additional)
+	objs, err := s.objectsByDocID(ctx, ids, additional)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -211,11 +240,16 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 	return objs, dists, nil
 }
 
-func (s *Shard) objectsByDocID(ids []uint64,
+func (s *Shard) objectsByDocID(ctx context.Context, ids []uint64,
 	additional additional.Properties) ([]*storobj.Object, error) {
 	out := make([]*storobj.Object, len(ids))
 
-	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := view.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
 		return nil, errors.Errorf("objects bucket not found")
 	}
@@ -249,11 +283,20 @@ func (s *Shard) objectsByDocID(ids []uint64,
 
 func (s *Shard) objectList(ctx context.Context, limit int,
 	additional additional.Properties) ([]*storobj.Object, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	out := make([]*storobj.Object, limit)
 	i := 0
-	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	cursor := view.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
 	defer cursor.Close()
 
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
 	for k, v := cursor.First(); k != nil && i < limit; k, v = cursor.Next() {
 		obj, err := storobj.FromBinary(v)
 		if err != nil {
@@ -266,3 +309,128 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 
 	return out[:i], nil
 }
+
+// cursorObjectList lists the objects in the order of their UUID, which is the
+// order of the keys in the objects bucket. The cursor therefore only needs to
+// seek to its position instead of skipping over all previous objects.
+func (s *Shard) cursorObjectList(ctx context.Context, limit int,
+	c *filters.Cursor, additional additional.Properties) ([]*storobj.Object, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := view.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	var k, v []byte
+	if c.After == "" {
+		k, v = cursor.First()
+	} else {
+		parsed, err := uuid.Parse(c.After)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse cursor")
+		}
+
+		after, err := parsed.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		k, v = cursor.Seek(after)
+		if bytes.Equal(k, after) {
+			k, v = cursor.Next()
+		}
+	}
+
+	out := make([]*storobj.Object, 0, limit)
+	for ; k != nil && len(out) < limit; k, v = cursor.Next() {
+		obj, err := storobj.FromBinaryOptional(v, additional)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal item %d", len(out))
+		}
+
+		out = append(out, obj)
+	}
+
+	return out, nil
+}
+
+// sortedObjectList lists the objects in the order of their timestamp, which
+// is the order of the keys in the bucket of the timestamp. An ascending list
+// can stop after limit matches, whereas a descending list needs to collect
+// all matches first, as the cursor can only move forward.
+func (s *Shard) sortedObjectList(ctx context.Context, limit int,
+	filter *filters.LocalFilter, sort filters.Sort,
+	additional additional.Properties) ([]*storobj.Object, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	propName := sort.Path[0]
+	bucket := view.store.Bucket(helpers.BucketFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, errors.Errorf("prop %q is not indexed: sorting by timestamps "+
+			"requires the class to be created with invertedIndexConfig.indexTimestamps",
+			propName)
+	}
+
+	var allowList helpers.AllowList
+	if filter != nil {
+		list, err := inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
+			s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
+			view.deletedDocIDs).
+			DocIDs(ctx, filter, additional, s.index.Config.ClassName)
+		if err != nil {
+			return nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	desc := sort.Order == filters.SortOrderDesc
+
+	cursor := bucket.SetCursor()
+	defer cursor.Close()
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	var docIDs []uint64
+	for k, ids := cursor.First(); k != nil; k, ids = cursor.Next() {
+		for _, id := range ids {
+			docID := binary.LittleEndian.Uint64(id)
+			if view.deletedDocIDs.Contains(docID) {
+				continue
+			}
+
+			if allowList != nil && !allowList.Contains(docID) {
+				continue
+			}
+
+			docIDs = append(docIDs, docID)
+		}
+
+		if !desc && len(docIDs) >= limit {
+			break
+		}
+	}
+
+	if desc {
+		for i, j := 0, len(docIDs)-1; i < j; i, j = i+1, j-1 {
+			docIDs[i], docIDs[j] = docIDs[j], docIDs[i]
+		}
+	}
+
+	if len(docIDs) > limit {
+		docIDs = docIDs[:limit]
+	}
+
+	return s.objectsByDocID(ctx, docIDs, additional)
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// the object count only influences the inverse document frequencies of a
+// keyword search, a slightly outdated count barely changes the scores
+const keywordObjectCountMaxAge = 30 * time.Second
+
+type keywordObjectCount struct {
+	sync.Mutex
+	count     int64
+	countedAt time.Time
+}
+
+// objectKeywordSearch ranks the objects of the shard by the BM25 score of the
+// keyword ranking. The scores are returned in place of the distances of a
+// vector search, the vector index is never touched.
+func (s *Shard) objectKeywordSearch(ctx context.Context,
+	keywordRanking *searchparams.KeywordRanking, limit int,
+	filters *filters.LocalFilter,
+	additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		list, err := inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
+			s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
+			view.deletedDocIDs).
+			DocIDs(ctx, filters, additional, s.index.Config.ClassName)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	objectCount, err := s.objectCountForKeywordSearch(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "count objects")
+	}
+
+	ids, scores, err := inverted.NewBM25Searcher(view.store,
+		s.index.getSchema.GetSchemaSkipAuth(), view.deletedDocIDs).
+		Search(ctx, s.index.Config.ClassName, *keywordRanking, allowList, limit,
+			objectCount)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "keyword search")
+	}
+
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	objs, err := s.objectsByDocID(ctx, ids, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, scores, nil
+}
+
+func (s *Shard) objectCountForKeywordSearch(ctx context.Context) (int64, error) {
+	c := &s.keywordObjectCount
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.countedAt.IsZero() && time.Since(c.countedAt) < keywordObjectCountMaxAge {
+		return c.count, nil
+	}
+
+	count, err := s.objectCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	c.count = count
+	c.countedAt = time.Now()
+	return count, nil
+}
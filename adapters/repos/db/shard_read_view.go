@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/readview"
+)
+
+// shardReadView is the state of the shard a read operates on. Outside of a
+// consistent read, see readview.Set, this is simply the latest state of the
+// shard.
+type shardReadView struct {
+	store         *lsmkv.Store
+	deletedDocIDs *docid.InMemDeletedTracker
+}
+
+// Release unpins the disk segments of the view
+func (v *shardReadView) Release() error {
+	return v.store.ReleaseView()
+}
+
+// readView returns the view of the shard which belongs to the read view set
+// of the context. It is created on first use, so all reads of the same
+// request see the shard in the same state. Without a set in the context, the
+// latest state is read.
+//
+// Only the object store, the inverted indices and the deleted doc ids are
+// part of the view. A vector search still finds its candidates in the latest
+// state of the vector index. Objects which were added after the view was
+// taken are skipped when they are resolved from the view, but objects which
+// were updated or deleted since may be missing from its results.
+func (s *Shard) readView(ctx context.Context) (*shardReadView, error) {
+	set := readview.FromContext(ctx)
+	if set == nil {
+		return &shardReadView{store: s.store, deletedDocIDs: s.deletedDocIDs}, nil
+	}
+
+	view, err := set.Get(s.ID(), func() (readview.View, error) {
+		return s.newReadView()
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "read view of shard %s", s.ID())
+	}
+
+	return view.(*shardReadView), nil
+}
+
+func (s *Shard) newReadView() (*shardReadView, error) {
+	// every write of a single object holds the RLock while it updates the
+	// buckets, so the view contains either all or none of its changes
+	s.readViewLock.Lock()
+	defer s.readViewLock.Unlock()
+
+	return s.newReadViewLocked()
+}
+
+// newReadViewLocked must be called while holding the readViewLock
+func (s *Shard) newReadViewLocked() (*shardReadView, error) {
+	store, err := s.store.View()
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := docid.NewInMemDeletedTracker()
+	deleted.BulkAdd(s.deletedDocIDs.GetAll())
+
+	return &shardReadView{
+		store:         store,
+		deletedDocIDs: deleted,
+	}, nil
+}
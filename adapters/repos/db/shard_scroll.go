@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// shardScrolls are the open scrolls of a shard. Every scroll iterates over a
+// snapshot of the objects bucket, so it sees the objects exactly as they were
+// when the scroll was opened, regardless of any writes that happen while it
+// is open. A scroll is released once it is exhausted or when it has not been
+// used for its TTL.
+type shardScrolls struct {
+	sync.Mutex
+	scrolls map[string]*shardScroll
+}
+
+type shardScroll struct {
+	sync.Mutex
+	snapshot *lsmkv.Snapshot
+	cursor   *lsmkv.CursorReplace
+	started  bool
+	closed   bool
+	expiry   *time.Timer
+}
+
+func newShardScrolls() *shardScrolls {
+	return &shardScrolls{scrolls: map[string]*shardScroll{}}
+}
+
+// scroll returns the next page of up to limit objects of the scroll with the
+// given id and extends its TTL. An empty id opens a new scroll. The returned
+// id must be used for the next page, it is empty once the scroll is
+// exhausted.
+func (s *Shard) scroll(ctx context.Context, id string, ttl time.Duration,
+	limit int, additional additional.Properties) ([]*storobj.Object, string, error) {
+	if id == "" {
+		snapshot, err := s.store.Bucket(helpers.ObjectsBucketLSM).Snapshot()
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "open scroll on shard %s", s.ID())
+		}
+		id = s.scrolls.open(snapshot, ttl)
+	}
+
+	sc, ok := s.scrolls.get(id, ttl)
+	if !ok {
+		return nil, "", errortypes.New(errortypes.KindNotFound,
+			"scroll %q does not exist or has expired", id)
+	}
+
+	out, exhausted, err := sc.nextPage(limit, additional)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !exhausted {
+		return out, id, nil
+	}
+
+	// there is no need to wait for the TTL of an exhausted scroll
+	if err := s.scrolls.release(id); err != nil {
+		return nil, "", err
+	}
+
+	return out, "", nil
+}
+
+func (sc *shardScroll) nextPage(limit int,
+	additional additional.Properties) ([]*storobj.Object, bool, error) {
+	sc.Lock()
+	defer sc.Unlock()
+
+	if sc.closed {
+		// the scroll expired after it was retrieved
+		return nil, false, errortypes.New(errortypes.KindNotFound,
+			"scroll has expired")
+	}
+
+	out := make([]*storobj.Object, 0, limit)
+	var k, v []byte
+	if !sc.started {
+		k, v = sc.cursor.First()
+		sc.started = true
+	} else {
+		k, v = sc.cursor.Next()
+	}
+
+	for ; k != nil; k, v = sc.cursor.Next() {
+		obj, err := storobj.FromBinaryOptional(v, additional)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "unmarshal item %d", len(out))
+		}
+
+		out = append(out, obj)
+		if len(out) == limit {
+			return out, false, nil
+		}
+	}
+
+	return out, true, nil
+}
+
+func (ss *shardScrolls) open(snapshot *lsmkv.Snapshot, ttl time.Duration) string {
+	ss.Lock()
+	defer ss.Unlock()
+
+	id := uuid.New().String()
+	ss.scrolls[id] = &shardScroll{
+		snapshot: snapshot,
+		cursor:   snapshot.Cursor(),
+		expiry: time.AfterFunc(ttl, func() {
+			ss.release(id)
+		}),
+	}
+
+	return id
+}
+
+// get the scroll and extend its TTL
+func (ss *shardScrolls) get(id string, ttl time.Duration) (*shardScroll, bool) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	sc, ok := ss.scrolls[id]
+	if !ok {
+		return nil, false
+	}
+
+	sc.expiry.Reset(ttl)
+	return sc, true
+}
+
+func (ss *shardScrolls) release(id string) error {
+	ss.Lock()
+	sc, ok := ss.scrolls[id]
+	delete(ss.scrolls, id)
+	ss.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	sc.expiry.Stop()
+	return sc.close()
+}
+
+// releaseAll is called when the shard shuts down or is dropped
+func (ss *shardScrolls) releaseAll() error {
+	ss.Lock()
+	scrolls := ss.scrolls
+	ss.scrolls = map[string]*shardScroll{}
+	ss.Unlock()
+
+	for id, sc := range scrolls {
+		sc.expiry.Stop()
+		if err := sc.close(); err != nil {
+			return errors.Wrapf(err, "release scroll %s", id)
+		}
+	}
+
+	return nil
+}
+
+// close waits for a page which is currently being read to complete
+func (sc *shardScroll) close() error {
+	sc.Lock()
+	defer sc.Unlock()
+
+	sc.closed = true
+	sc.cursor.Close()
+	return sc.snapshot.Release()
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// initSparseVectorPostings creates the bucket which holds the impact-ordered
+// postings of the terms of the sparse vectors
+func (s *Shard) initSparseVectorPostings(ctx context.Context) error {
+	return s.store.CreateOrLoadBucket(ctx, helpers.SparseVectorPostingsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
+}
+
+// extendSparseVectorPostingsLSM adds the doc id to the postings of every term
+// of the sparse vector. It is a no-op if the class does not have sparse
+// enabled, the index validates that only such classes have sparse vectors.
+func (s *Shard) extendSparseVectorPostingsLSM(vector map[string]float32,
+	docID uint64) error {
+	bucket := s.store.Bucket(helpers.SparseVectorPostingsBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	for term, weight := range vector {
+		err := bucket.MapSet([]byte(term), lsmkv.MapPair{
+			Key:   inverted.SparsePostingKey(weight, docID),
+			Value: inverted.SparsePostingValue(weight),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "add posting of term %q", term)
+		}
+	}
+
+	return nil
+}
+
+// deleteFromSparseVectorPostingsLSM removes the doc id from the postings of
+// every term of its previous sparse vector, the weights are part of the keys
+func (s *Shard) deleteFromSparseVectorPostingsLSM(vector map[string]float32,
+	docID uint64) error {
+	bucket := s.store.Bucket(helpers.SparseVectorPostingsBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	for term, weight := range vector {
+		err := bucket.MapDeleteKey([]byte(term),
+			inverted.SparsePostingKey(weight, docID))
+		if err != nil {
+			return errors.Wrapf(err, "delete posting of term %q", term)
+		}
+	}
+
+	return nil
+}
+
+// objectSparseSearch ranks the objects of the shard by the dot product of
+// their sparse vectors with the sparse query vector. The scores are returned
+// in place of the distances of a vector search.
+func (s *Shard) objectSparseSearch(ctx context.Context,
+	sparseRanking *searchparams.SparseRanking, limit int,
+	filters *filters.LocalFilter,
+	additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	view, err := s.readView(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if view.store.Bucket(helpers.SparseVectorPostingsBucketLSM) == nil {
+		return nil, nil, errors.Errorf("sparse is not enabled for shard %s", s.ID())
+	}
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		list, err := inverted.NewSearcher(view.store, s.index.getSchema.GetSchemaSkipAuth(),
+			s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
+			view.deletedDocIDs).
+			DocIDs(ctx, filters, additional, s.index.Config.ClassName)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "build inverted filter allow list")
+		}
+
+		allowList = list
+	}
+
+	ids, scores, err := inverted.NewSparseSearcher(view.store, view.deletedDocIDs).
+		Search(ctx, sparseRanking.Vector, allowList, limit)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "sparse search")
+	}
+
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	objs, err := s.objectsByDocID(ctx, ids, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, scores, nil
+}
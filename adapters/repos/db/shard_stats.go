@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// ShardStats describes the size of a shard, so capacity can be planned
+// without inspecting the data path of the node
+type ShardStats struct {
+	// ObjectCount is the number of objects in the shard
+	ObjectCount int64
+	// LSMStoreBytes is the size of the disk segments of the lsmkv store
+	LSMStoreBytes int64
+	// WALBytes is the size of the write-ahead logs of the memtables of the
+	// lsmkv store which have not been flushed yet
+	WALBytes int64
+	// VectorIndexCommitLogBytes is the size of the commit logs of the vector
+	// index, 0 if the vector index has none
+	VectorIndexCommitLogBytes int64
+}
+
+// Stats counts the objects of the shard and sums up the sizes of its files.
+// Counting iterates over all objects, so the result should not be requested
+// frequently.
+func (s *Shard) Stats(ctx context.Context) (ShardStats, error) {
+	count, err := s.objectCount(ctx)
+	if err != nil {
+		return ShardStats{}, errors.Wrap(err, "count objects")
+	}
+
+	return s.statsWithObjectCount(count)
+}
+
+// statsWithObjectCount completes the stats for an object count which was
+// determined by the caller, e.g. a cached one
+func (s *Shard) statsWithObjectCount(count int64) (ShardStats, error) {
+	usage, err := s.store.DiskUsage()
+	if err != nil {
+		return ShardStats{}, errors.Wrap(err, "lsmkv disk usage")
+	}
+
+	stats := ShardStats{
+		ObjectCount:   count,
+		LSMStoreBytes: usage.SegmentBytes,
+		WALBytes:      usage.WALBytes,
+	}
+
+	if statser, ok := s.vectorIndex.(commitLogStatser); ok {
+		stats.VectorIndexCommitLogBytes = statser.CommitLogStats().Bytes
+	}
+
+	return stats, nil
+}
+
+// collectShardStatsMetrics reports the object counts and the sizes of the
+// lsmkv stores of every shard loaded on this node. The object counts are the
+// ones cached for keyword searches, so scrapes do not iterate over all objects
+// every time. The sizes of the vector index commit logs are part of the
+// commit log metrics. A shard whose stats cannot be determined is left out of
+// the scrape rather than failing it.
+func (d *DB) collectShardStatsMetrics() []*monitoring.Family {
+	objects := monitoring.NewGauge("weaviate_shard_objects",
+		"Number of objects in a shard, counted at most every 30 seconds",
+		"class", "shard")
+	lsmStore := monitoring.NewGauge("weaviate_shard_lsm_store_bytes",
+		"Size of the lsmkv disk segments of a shard", "class", "shard")
+	wal := monitoring.NewGauge("weaviate_shard_wal_bytes",
+		"Size of the write-ahead logs of the unflushed lsmkv memtables of a shard",
+		"class", "shard")
+
+	for _, index := range d.indices {
+		class := index.Config.ClassName.String()
+		index.shardsLock.RLock()
+		for name, shard := range index.Shards {
+			stats, err := shard.metricsStats()
+			if err != nil {
+				d.logger.WithField("action", "collect_metrics").
+					WithField("class", class).
+					WithField("shard", name).
+					WithError(err).
+					Warn("shard stats are left out of the metrics")
+				continue
+			}
+
+			objects.Add(float64(stats.ObjectCount), class, name)
+			lsmStore.Add(float64(stats.LSMStoreBytes), class, name)
+			wal.Add(float64(stats.WALBytes), class, name)
+		}
+		index.shardsLock.RUnlock()
+	}
+
+	return []*monitoring.Family{objects, lsmStore, wal}
+}
+
+// metricsStats are the stats of the shard with the object count cached for
+// keyword searches
+func (s *Shard) metricsStats() (ShardStats, error) {
+	count, err := s.objectCountForKeywordSearch(context.Background())
+	if err != nil {
+		return ShardStats{}, errors.Wrap(err, "count objects")
+	}
+
+	return s.statsWithObjectCount(count)
+}
@@ -0,0 +1,312 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// An atomic batch is written to a per-shard journal before it is applied.
+// The journal is first written to a temporary file, which is synced and then
+// renamed. The rename is the commit point: A batch without a journal has
+// never been applied, a batch with a journal is rolled forward - either
+// right away or, if that fails, before the next atomic batch of the shard and
+// when the shard is loaded the next time. Since imports are upserts by id,
+// re-applying objects which had already been imported before is safe. Only
+// once the batch has been applied in full, the journal is removed.
+const batchJournalSuffix = ".batchjournal"
+
+func (s *Shard) batchJournalPath() string {
+	return fmt.Sprintf("%s/%s%s", s.index.Config.RootPath, s.ID(), batchJournalSuffix)
+}
+
+// atomicBatchStatus is whether a committed atomic batch has not been applied
+// in full yet and why. It has its own lock, so the status of the shard can be
+// read while an atomic batch is being applied.
+type atomicBatchStatus struct {
+	sync.Mutex
+	pending bool
+	err     error
+}
+
+func (a *atomicBatchStatus) set(pending bool, err error) {
+	a.Lock()
+	defer a.Unlock()
+	a.pending = pending
+	a.err = err
+}
+
+func (a *atomicBatchStatus) get() (bool, error) {
+	a.Lock()
+	defer a.Unlock()
+	return a.pending, a.err
+}
+
+// putObjectBatchAtomic imports the objects all-or-nothing. Errors which are
+// known before applying the batch, such as an invalid id, fail the entire
+// batch without writing anything. Once the journal is written, the batch is
+// committed and reported as such, even if applying it has to be completed
+// later on.
+func (s *Shard) putObjectBatchAtomic(ctx context.Context,
+	objects []*storobj.Object) []error {
+	// only a single journal can exist per shard at any time
+	s.atomicBatchLock.Lock()
+	defer s.atomicBatchLock.Unlock()
+
+	if err := validateAtomicBatch(objects); err != nil {
+		return duplicateErr(errors.Wrap(err, "atomic batch"), len(objects))
+	}
+
+	if err := ctx.Err(); err != nil {
+		return duplicateErr(errors.Wrap(err, "begin atomic batch"), len(objects))
+	}
+
+	// a previous batch which could not be applied in full must be completed
+	// first, its journal would otherwise be replaced by the new one
+	if err := s.rollForwardBatchJournal(); err != nil {
+		return duplicateErr(errors.Wrap(err, "roll forward previous atomic batch"),
+			len(objects))
+	}
+
+	if err := writeBatchJournal(s.batchJournalPath(), objects); err != nil {
+		return duplicateErr(errors.Wrap(err, "journal atomic batch"), len(objects))
+	}
+
+	// the batch is committed, from here on it must be applied in full, even if
+	// the caller is no longer interested in the result
+	if err := s.applyBatchJournal(objects); err != nil {
+		s.index.logger.WithField("action", "atomic_batch_apply").
+			WithField("shard", s.ID()).
+			WithError(err).
+			Error("atomic batch was committed, but could not be applied in full, " +
+				"it is rolled forward before the next atomic batch")
+	}
+
+	return make([]error, len(objects))
+}
+
+// rollForwardBatchJournal applies the journal of a batch which was committed,
+// but not applied in full. It is a no-op if there is no journal. If the
+// journal still can't be applied, it is kept and the error is returned.
+func (s *Shard) rollForwardBatchJournal() error {
+	// a temporary journal belongs to a batch which was never committed, and
+	// therefore never applied
+	os.Remove(s.batchJournalPath() + ".tmp")
+
+	path := s.batchJournalPath()
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			s.atomicBatchStatus.set(false, nil)
+			return nil
+		}
+		return err
+	}
+
+	objects, err := readBatchJournal(path)
+	if err != nil {
+		err = errors.Wrapf(err, "read journal %s", path)
+		s.atomicBatchStatus.set(true, err)
+		return err
+	}
+
+	if err := s.applyBatchJournal(objects); err != nil {
+		return err
+	}
+
+	s.index.logger.WithField("action", "atomic_batch_roll_forward").
+		WithField("shard", s.ID()).
+		WithField("objects", len(objects)).
+		Info("rolled forward atomic batch which had not been fully applied")
+	return nil
+}
+
+// applyBatchJournal applies the objects of the journal and removes the
+// journal once all of them have been applied. Otherwise the journal is kept
+// and the batch is marked as pending.
+func (s *Shard) applyBatchJournal(objects []*storobj.Object) error {
+	errs := newObjectsBatcher(s).Objects(context.Background(), objects)
+	if err := firstError(errs); err != nil {
+		err = errors.Wrapf(err, "apply journal %s", s.batchJournalPath())
+		s.atomicBatchStatus.set(true, err)
+		return err
+	}
+
+	if err := os.Remove(s.batchJournalPath()); err != nil {
+		err = errors.Wrapf(err, "remove applied journal %s", s.batchJournalPath())
+		s.atomicBatchStatus.set(true, err)
+		return err
+	}
+
+	if err := syncDir(s.index.Config.RootPath); err != nil {
+		err = errors.Wrapf(err, "sync removal of journal %s", s.batchJournalPath())
+		s.atomicBatchStatus.set(true, err)
+		return err
+	}
+
+	s.atomicBatchStatus.set(false, nil)
+	return nil
+}
+
+// validateAtomicBatch checks everything which would otherwise only fail
+// while applying the batch, when it is too late to back out
+func validateAtomicBatch(objects []*storobj.Object) error {
+	vectorLength := -1
+	for i, obj := range objects {
+		if _, err := uuid.Parse(obj.ID().String()); err != nil {
+			return errors.Wrapf(err, "object %d: invalid id", i)
+		}
+
+		if len(obj.Vector) == 0 {
+			continue
+		}
+
+		if vectorLength == -1 {
+			vectorLength = len(obj.Vector)
+		} else if len(obj.Vector) != vectorLength {
+			return errors.Errorf("object %d: vector has length %d, but other "+
+				"objects in the batch have length %d", i, len(obj.Vector),
+				vectorLength)
+		}
+	}
+
+	return nil
+}
+
+func writeBatchJournal(path string, objects []*storobj.Object) error {
+	tmpPath := path + ".tmp"
+
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	lengthBuf := make([]byte, 8)
+	for _, obj := range objects {
+		objBytes, err := obj.MarshalBinary()
+		if err != nil {
+			f.Close()
+			return errors.Wrapf(err, "marshal object %s", obj.ID())
+		}
+
+		binary.LittleEndian.PutUint64(lengthBuf, uint64(len(objBytes)))
+		if _, err := w.Write(lengthBuf); err != nil {
+			f.Close()
+			return err
+		}
+		if _, err := w.Write(objBytes); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	// the rename is only durable once the directory has been synced
+	return syncDir(filepath.Dir(path))
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func readBatchJournal(path string) ([]*storobj.Object, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []*storobj.Object
+	var read uint64
+	for read < uint64(len(data)) {
+		if uint64(len(data))-read < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		length := binary.LittleEndian.Uint64(data[read : read+8])
+		read += 8
+
+		if uint64(len(data))-read < length {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		obj, err := storobj.FromBinary(data[read : read+length])
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal object %d", len(objects))
+		}
+		read += length
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// replayBatchJournal rolls forward an atomic batch which was committed, but
+// not fully applied before the shard was shut down. A journal which still
+// can't be applied does not block the startup, it is kept, reported in the
+// status of the shard, and rolled forward before the next atomic batch.
+func (s *Shard) replayBatchJournal() {
+	if err := s.rollForwardBatchJournal(); err != nil {
+		s.index.logger.WithField("action", "atomic_batch_replay_journal").
+			WithField("shard", s.ID()).
+			WithError(err).
+			Error("could not roll forward atomic batch on startup")
+	}
+}
+
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchJournal(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer os.RemoveAll(dirName)
+
+	in := []*storobj.Object{
+		storobj.FromObject(&models.Object{
+			Class:      "ThingForBatching",
+			ID:         "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+			Properties: map[string]interface{}{"stringProp": "first element"},
+		}, []float32{1, 2, 3}),
+		storobj.FromObject(&models.Object{
+			Class:      "ThingForBatching",
+			ID:         "86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+			Properties: map[string]interface{}{"stringProp": "second element"},
+		}, []float32{4, 5, 6}),
+	}
+
+	path := filepath.Join(dirName, "shard"+batchJournalSuffix)
+	require.Nil(t, writeBatchJournal(path, in))
+
+	_, err := os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err), "temporary journal is renamed")
+
+	out, err := readBatchJournal(path)
+	require.Nil(t, err)
+	require.Len(t, out, 2)
+	for i := range in {
+		assert.Equal(t, in[i].ID(), out[i].ID())
+		assert.Equal(t, in[i].Vector, out[i].Vector)
+		assert.Equal(t, in[i].Properties(), out[i].Properties())
+	}
+
+	t.Run("a truncated journal is rejected", func(t *testing.T) {
+		data, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		require.Nil(t, ioutil.WriteFile(path, data[:len(data)-3], 0o666))
+
+		_, err = readBatchJournal(path)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestBatchPutObjectsAtomicPerShard(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer os.RemoveAll(dirName)
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the thing class", testAddBatchObjectClass(repo, migrator,
+		schemaGetter))
+
+	ctx := objects.WithAtomicShardBatches(context.Background())
+	batchObject := func(pos int, id strfmt.UUID, vector []float32) objects.BatchObject {
+		return objects.BatchObject{
+			OriginalIndex: pos,
+			Object: &models.Object{
+				Class:      "ThingForBatching",
+				Properties: map[string]interface{}{"stringProp": "element"},
+				ID:         id,
+			},
+			UUID:   id,
+			Vector: vector,
+		}
+	}
+
+	t.Run("a single invalid object fails the entire batch", func(t *testing.T) {
+		batch := objects.BatchObjects{
+			batchObject(0, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a01", []float32{1, 2, 3}),
+			batchObject(1, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a02", []float32{1, 2}),
+		}
+
+		res, err := repo.BatchPutObjects(ctx, batch)
+		require.Nil(t, err)
+		for _, obj := range res {
+			assert.NotNil(t, obj.Err)
+		}
+
+		for _, obj := range batch {
+			exists, err := repo.Exists(context.Background(), obj.UUID)
+			require.Nil(t, err)
+			assert.False(t, exists)
+		}
+	})
+
+	t.Run("a valid batch is imported and its journal removed", func(t *testing.T) {
+		batch := objects.BatchObjects{
+			batchObject(0, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a03", []float32{1, 2, 3}),
+			batchObject(1, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a04", []float32{4, 5, 6}),
+		}
+
+		res, err := repo.BatchPutObjects(ctx, batch)
+		require.Nil(t, err)
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+		}
+
+		for _, obj := range batch {
+			exists, err := repo.Exists(context.Background(), obj.UUID)
+			require.Nil(t, err)
+			assert.True(t, exists)
+		}
+
+		journals, err := filepath.Glob(filepath.Join(dirName, "*"+batchJournalSuffix+"*"))
+		require.Nil(t, err)
+		assert.Len(t, journals, 0)
+	})
+
+	var shard *Shard
+	for _, s := range repo.GetIndex("ThingForBatching").Shards {
+		shard = s
+	}
+	require.NotNil(t, shard)
+
+	t.Run("a pending batch is rolled forward before the next one", func(t *testing.T) {
+		pending := storobj.FromObject(&models.Object{
+			Class:      "ThingForBatching",
+			Properties: map[string]interface{}{"stringProp": "element"},
+			ID:         "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a05",
+		}, []float32{1, 2, 3})
+		require.Nil(t, writeBatchJournal(shard.batchJournalPath(),
+			[]*storobj.Object{pending}))
+
+		batch := objects.BatchObjects{
+			batchObject(0, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a06", []float32{1, 2, 3}),
+		}
+
+		res, err := repo.BatchPutObjects(ctx, batch)
+		require.Nil(t, err)
+		assert.Nil(t, res[0].Err)
+
+		for _, id := range []strfmt.UUID{pending.ID(), batch[0].UUID} {
+			exists, err := repo.Exists(context.Background(), id)
+			require.Nil(t, err)
+			assert.True(t, exists)
+		}
+
+		_, err = os.Stat(shard.batchJournalPath())
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("a pending batch which can't be applied refuses the next one", func(t *testing.T) {
+		require.Nil(t, ioutil.WriteFile(shard.batchJournalPath(), []byte{1, 2, 3}, 0o666))
+
+		batch := objects.BatchObjects{
+			batchObject(0, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a07", []float32{1, 2, 3}),
+		}
+
+		res, err := repo.BatchPutObjects(ctx, batch)
+		require.Nil(t, err)
+		assert.NotNil(t, res[0].Err)
+
+		exists, err := repo.Exists(context.Background(), batch[0].UUID)
+		require.Nil(t, err)
+		assert.False(t, exists)
+
+		_, err = os.Stat(shard.batchJournalPath())
+		assert.Nil(t, err, "the journal is kept")
+
+		status, err := repo.LocalNodeShards(context.Background())
+		require.Nil(t, err)
+		require.Len(t, status, 1)
+		assert.True(t, status[0].AtomicBatchPending)
+		assert.Contains(t, status[0].AtomicBatchError, "read journal")
+
+		require.Nil(t, os.Remove(shard.batchJournalPath()))
+
+		batch = objects.BatchObjects{
+			batchObject(0, "2d1c6d8e-8d2c-4a0b-a8a8-5f0a8a8c3a07", []float32{1, 2, 3}),
+		}
+		res, err = repo.BatchPutObjects(ctx, batch)
+		require.Nil(t, err)
+		assert.Nil(t, res[0].Err)
+
+		status, err = repo.LocalNodeShards(context.Background())
+		require.Nil(t, err)
+		assert.False(t, status[0].AtomicBatchPending)
+		assert.Empty(t, status[0].AtomicBatchError)
+	})
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+)
+
+// findDocIDs returns the doc ids of all objects matching the filter in
+// ascending order, so that a limit applied by the caller is deterministic
+func (s *Shard) findDocIDs(ctx context.Context,
+	filters *filters.LocalFilter) ([]uint64, error) {
+	allowList, err := inverted.NewSearcher(s.store, s.index.getSchema.GetSchemaSkipAuth(),
+		s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
+		s.deletedDocIDs).
+		DocIDs(ctx, filters, additional.Properties{}, s.index.Config.ClassName)
+	if err != nil {
+		return nil, errors.Wrap(err, "build inverted filter allow list")
+	}
+
+	out := make([]uint64, 0, len(allowList))
+	for docID := range allowList {
+		out = append(out, docID)
+	}
+
+	sort.Slice(out, func(a, b int) bool { return out[a] < out[b] })
+	return out, nil
+}
+
+// deleteObjectBatch deletes the objects with the specified doc ids. On a dry
+// run the objects are only looked up, so the caller can list them. Objects
+// which no longer exist, e.g. because they were deleted concurrently, are
+// skipped.
+func (s *Shard) deleteObjectBatch(ctx context.Context, docIDs []uint64,
+	dryRun bool) objects.BatchSimpleObjects {
+	out := make(objects.BatchSimpleObjects, 0, len(docIDs))
+	for _, docID := range docIDs {
+		obj, err := s.objectByIndexID(ctx, docID, false)
+		if err != nil {
+			if _, ok := err.(storobj.ErrNotFound); ok {
+				continue
+			}
+
+			out = append(out, objects.BatchSimpleObject{
+				Err: errors.Wrapf(err, "find object with doc id %d", docID),
+			})
+			continue
+		}
+
+		if dryRun {
+			out = append(out, objects.BatchSimpleObject{UUID: obj.ID()})
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			out = append(out, objects.BatchSimpleObject{
+				UUID: obj.ID(),
+				Err:  errors.Wrap(err, "abort batch delete"),
+			})
+			continue
+		}
+
+		out = append(out, objects.BatchSimpleObject{
+			UUID: obj.ID(),
+			Err:  s.deleteObjectWithoutFlush(obj.ID()),
+		})
+	}
+
+	if dryRun {
+		return out
+	}
+
+	if err := s.flushWALsAfterDelete(); err != nil {
+		for i := range out {
+			if out[i].Err == nil {
+				out[i].Err = err
+			}
+		}
+	}
+
+	return out
+}
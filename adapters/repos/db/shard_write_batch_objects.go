@@ -20,12 +20,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/objects"
 )
 
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) putObjectBatch(ctx context.Context,
-	objects []*storobj.Object) []error {
-	return newObjectsBatcher(s).Objects(ctx, objects)
+	objs []*storobj.Object) []error {
+	if objects.AtomicShardBatches(ctx) {
+		return s.putObjectBatchAtomic(ctx, objs)
+	}
+
+	return newObjectsBatcher(s).Objects(ctx, objs)
 }
 
 // objectsBatcher is a helper type wrapping around an underlying shard that can
@@ -126,6 +131,9 @@ func (b *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 		return err
 	}
 
+	// batches are not coalesced, but must not be overtaken by an earlier put
+	b.shard.coalescer.wait(object.ID())
+
 	status, err := b.shard.putObjectLSM(object, idBytes, false)
 	if err != nil {
 		return err
@@ -203,6 +211,11 @@ func (b *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Contex
 		return
 	}
 
+	if err := b.shard.updateMultiVectors(object.MultiVector, status); err != nil {
+		b.setErrorAtIndex(errors.Wrap(err, "insert to multi vector index"), index)
+		return
+	}
+
 	if err := b.shard.updatePropertySpecificIndices(object, status); err != nil {
 		b.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
@@ -238,14 +238,28 @@ func (b *referencesBatcher) writeInvertedAdditions(
 
 func (b *referencesBatcher) analyzeRef(obj *storobj.Object,
 	ref objects.BatchReference) ([]inverted.Property, error) {
+	a := inverted.NewAnalyzer()
+
+	// adding a reference updates the last update time of the object, so its
+	// index entry needs to be replaced just like the ref count
+	var out []inverted.Property
+	if b.shard.index.invertedIndexConfig.IndexTimestamps {
+		timestamps, err := a.Timestamps(obj.CreationTimeUnix(), obj.LastUpdateTimeUnix())
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, timestamps...)
+	}
+
 	props := obj.Properties()
 	if props == nil {
-		return nil, nil
+		return out, nil
 	}
 
 	propMap, ok := props.(map[string]interface{})
 	if !ok {
-		return nil, nil
+		return out, nil
 	}
 
 	var refs models.MultipleRef
@@ -261,8 +275,6 @@ func (b *referencesBatcher) analyzeRef(obj *storobj.Object,
 		refs = parsed
 	}
 
-	a := inverted.NewAnalyzer()
-
 	countItems, err := a.RefCount(refs)
 	if err != nil {
 		return nil, err
@@ -273,15 +285,30 @@ func (b *referencesBatcher) analyzeRef(obj *storobj.Object,
 		return nil, err
 	}
 
-	return []inverted.Property{{
+	out = append(out, inverted.Property{
 		Name:         helpers.MetaCountProp(ref.From.Property.String()),
 		Items:        countItems,
 		HasFrequency: false,
-	}, {
+	}, inverted.Property{
 		Name:         ref.From.Property.String(),
 		Items:        valueItems,
 		HasFrequency: false,
-	}}, nil
+	})
+
+	if !b.shard.index.invertedIndexConfig.IndexNullState {
+		return out, nil
+	}
+
+	nullStateItems, err := a.Bool(len(refs) == 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(out, inverted.Property{
+		Name:         helpers.MetaNullStateProp(ref.From.Property.String()),
+		Items:        nullStateItems,
+		HasFrequency: false,
+	}), nil
 }
 
 func (b *referencesBatcher) setErrorAtIndex(err error, i int) {
@@ -296,7 +323,7 @@ func mergeDocFromBatchReference(ref objects.BatchReference) objects.MergeDocumen
 	return objects.MergeDocument{
 		Class:      ref.From.Class.String(),
 		ID:         ref.From.TargetID,
-		UpdateTime: time.Now().UnixNano(),
+		UpdateTime: time.Now().UnixNano() / int64(time.Millisecond),
 		References: objects.BatchReferences{ref},
 	}
 }
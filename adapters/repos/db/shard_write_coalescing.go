@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// maxWriteCoalescingWindow bounds the coalescing window, every put waits for
+// up to this long before it is written
+const maxWriteCoalescingWindow = time.Second
+
+// writeCoalescer merges bursts of puts of the same object into a single
+// write. The first put of an object waits for the coalescing window, puts of
+// the same object arriving in the meantime replace the pending object and
+// share the result of the one write. This way the inverted and vector indices
+// are only updated once per burst instead of once per put, which avoids
+// needless vector index tombstones.
+type writeCoalescer struct {
+	sync.Mutex
+	window time.Duration
+
+	// pending are the puts still waiting for the window to pass, writing the
+	// puts whose window has passed and which are currently written
+	pending map[strfmt.UUID]*coalescedPut
+	writing map[strfmt.UUID]*coalescedPut
+
+	coalesced uint64
+}
+
+type coalescedPut struct {
+	object *storobj.Object
+	done   chan struct{}
+	err    error
+}
+
+// newWriteCoalescer returns nil if the window is 0, puts are then written
+// right away
+func newWriteCoalescer(window time.Duration) *writeCoalescer {
+	if window <= 0 {
+		return nil
+	}
+
+	return &writeCoalescer{
+		window:  window,
+		pending: map[strfmt.UUID]*coalescedPut{},
+		writing: map[strfmt.UUID]*coalescedPut{},
+	}
+}
+
+// put writes the object using write, possibly replaced by a later put of the
+// same object which arrives within the coalescing window. The returned error
+// is the error of the write which was eventually made. The write is made even
+// if the caller is no longer interested in the result, as other puts might
+// depend on it.
+func (c *writeCoalescer) put(object *storobj.Object,
+	write func(object *storobj.Object) error) error {
+	id := object.ID()
+
+	c.Lock()
+	if p, ok := c.pending[id]; ok {
+		p.object = object
+		c.coalesced++
+		c.Unlock()
+		<-p.done
+		return p.err
+	}
+
+	p := &coalescedPut{object: object, done: make(chan struct{})}
+	c.pending[id] = p
+	c.Unlock()
+
+	time.Sleep(c.window)
+
+	// a previous burst of the same object could still be written, the writes
+	// must not overtake each other
+	c.waitFor(id, c.writing)
+
+	c.Lock()
+	delete(c.pending, id)
+	c.writing[id] = p
+	object = p.object
+	c.Unlock()
+
+	p.err = write(object)
+
+	c.Lock()
+	delete(c.writing, id)
+	c.Unlock()
+	close(p.done)
+
+	return p.err
+}
+
+// wait blocks until all pending and in-flight puts of the object are written,
+// it is used by writes which are not coalesced, so they cannot be overtaken
+// by a put which arrived before them
+func (c *writeCoalescer) wait(id strfmt.UUID) {
+	if c == nil {
+		return
+	}
+
+	c.waitFor(id, c.pending)
+	c.waitFor(id, c.writing)
+}
+
+func (c *writeCoalescer) waitFor(id strfmt.UUID,
+	puts map[strfmt.UUID]*coalescedPut) {
+	for {
+		c.Lock()
+		p, ok := puts[id]
+		c.Unlock()
+
+		if !ok {
+			return
+		}
+
+		<-p.done
+	}
+}
+
+// coalescedPuts is the number of puts which were merged into another put
+// of the same object rather than written on their own
+func (c *writeCoalescer) coalescedPuts() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	return c.coalesced
+}
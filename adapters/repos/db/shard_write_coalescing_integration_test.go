@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCoalescer(t *testing.T) {
+	id := strfmt.UUID("8d5a3aa2-3c8d-4ce1-8f5d-5c6f0a3f4a2e")
+	objectWithVersion := func(version string) *storobj.Object {
+		return storobj.FromObject(&models.Object{
+			ID:         id,
+			Class:      "Thing",
+			Properties: map[string]interface{}{"version": version},
+		}, nil)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		c := newWriteCoalescer(0)
+		assert.Nil(t, c)
+
+		// must not block or panic
+		c.wait(id)
+		assert.Equal(t, uint64(0), c.coalescedPuts())
+	})
+
+	t.Run("a burst of puts results in a single write of the latest object", func(t *testing.T) {
+		c := newWriteCoalescer(100 * time.Millisecond)
+
+		var lock sync.Mutex
+		var written []string
+		write := func(object *storobj.Object) error {
+			lock.Lock()
+			defer lock.Unlock()
+			props := object.Properties().(map[string]interface{})
+			written = append(written, props["version"].(string))
+			return nil
+		}
+
+		wg := sync.WaitGroup{}
+		for _, version := range []string{"1", "2", "3"} {
+			wg.Add(1)
+			go func(version string) {
+				defer wg.Done()
+				assert.Nil(t, c.put(objectWithVersion(version), write))
+			}(version)
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// a delete or merge has to wait for the pending put
+		c.wait(id)
+		lock.Lock()
+		assert.Equal(t, []string{"3"}, written)
+		lock.Unlock()
+
+		wg.Wait()
+		assert.Equal(t, uint64(2), c.coalescedPuts())
+	})
+
+	t.Run("puts after the window are written separately", func(t *testing.T) {
+		c := newWriteCoalescer(10 * time.Millisecond)
+
+		writes := 0
+		write := func(object *storobj.Object) error {
+			writes++
+			return nil
+		}
+
+		require.Nil(t, c.put(objectWithVersion("1"), write))
+		require.Nil(t, c.put(objectWithVersion("2"), write))
+		assert.Equal(t, 2, writes)
+		assert.Equal(t, uint64(0), c.coalescedPuts())
+	})
+}
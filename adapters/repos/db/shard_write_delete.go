@@ -22,11 +22,29 @@ import (
 )
 
 func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
+	if err := s.deleteObjectWithoutFlush(id); err != nil {
+		return err
+	}
+
+	return s.flushWALsAfterDelete()
+}
+
+// deleteObjectWithoutFlush removes the object from all indices, but leaves
+// flushing the WALs to the caller, so that a batch of deletes only needs to
+// flush once
+func (s *Shard) deleteObjectWithoutFlush(id strfmt.UUID) error {
+	// a put which is still held back for coalescing would otherwise bring the
+	// object back after it was deleted
+	s.coalescer.wait(id)
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
 
+	s.readViewLock.RLock()
+	defer s.readViewLock.RUnlock()
+
 	var docID uint64
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	existing, err := bucket.Get([]byte(idBytes))
@@ -64,6 +82,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
+	return nil
+}
+
+func (s *Shard) flushWALsAfterDelete() error {
 	if err := s.store.WriteWALs(); err != nil {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
@@ -91,5 +113,15 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return errors.Wrap(err, "put inverted indices props")
 	}
 
+	err = s.deleteFromSparseVectorPostingsLSM(previousObject.SparseVector, docID)
+	if err != nil {
+		return errors.Wrap(err, "delete sparse vector postings")
+	}
+
+	err = s.updateContinuousAggregatesLSM(previousObject, -1)
+	if err != nil {
+		return errors.Wrap(err, "remove from continuous aggregates")
+	}
+
 	return nil
 }
@@ -20,7 +20,9 @@ import (
 )
 
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, error) {
-	if object.Properties() == nil {
+	indexNullState := s.index.invertedIndexConfig.IndexNullState
+	indexTimestamps := s.index.invertedIndexConfig.IndexTimestamps
+	if object.Properties() == nil && !indexNullState && !indexTimestamps {
 		return nil, nil
 	}
 
@@ -30,10 +32,47 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, erro
 		return nil, err
 	}
 
-	schemaMap, ok := object.Properties().(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("expected schema to be map, but got %T", object.Properties())
+	schemaMap := map[string]interface{}{}
+	if object.Properties() != nil {
+		asMap, ok := object.Properties().(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected schema to be map, but got %T", object.Properties())
+		}
+		schemaMap = asMap
 	}
 
-	return inverted.NewAnalyzer().Object(schemaMap, c.Properties, object.ID())
+	a := inverted.NewAnalyzer()
+	if s.index.invertedIndexConfig.StrictTypes {
+		a = inverted.NewStrictAnalyzer()
+	}
+
+	props, err := a.Object(schemaMap, c.Properties, object.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if composites := s.index.invertedIndexConfig.CompositeIndexes; len(composites) > 0 {
+		props = append(props, a.Composite(props, composites)...)
+	}
+
+	if indexNullState {
+		nullState, err := a.NullState(schemaMap, c.Properties)
+		if err != nil {
+			return nil, err
+		}
+
+		props = append(props, nullState...)
+	}
+
+	if indexTimestamps {
+		timestamps, err := a.Timestamps(object.CreationTimeUnix(),
+			object.LastUpdateTimeUnix())
+		if err != nil {
+			return nil, err
+		}
+
+		props = append(props, timestamps...)
+	}
+
+	return props, nil
 }
@@ -16,7 +16,6 @@ import (
 	"encoding/binary"
 
 	"github.com/pkg/errors"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 )
@@ -24,26 +23,21 @@ import (
 func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property,
 	docID uint64) error {
 	for _, prop := range props {
-		b := s.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
-		if b == nil {
-			return errors.Errorf("no bucket for prop '%s' found", prop.Name)
-		}
-
-		hashBucket := s.store.Bucket(helpers.HashBucketFromPropNameLSM(prop.Name))
-		if b == nil {
-			return errors.Errorf("no hash bucket for prop '%s' found", prop.Name)
+		b, hashBucket, items, err := s.invertedBucketsLSM(prop)
+		if err != nil {
+			return err
 		}
 
 		if prop.HasFrequency {
-			for _, item := range prop.Items {
+			for _, item := range items {
 				if err := s.extendInvertedIndexItemWithFrequencyLSM(b, hashBucket, item,
-					docID, item.TermFrequency); err != nil {
+					docID, prop.Length); err != nil {
 					return errors.Wrapf(err, "extend index with item '%s'",
 						string(item.Data))
 				}
 			}
 		} else {
-			for _, item := range prop.Items {
+			for _, item := range items {
 				if err := s.extendInvertedIndexItemLSM(b, hashBucket, item, docID); err != nil {
 					return errors.Wrapf(err, "extend index with item '%s'",
 						string(item.Data))
@@ -56,7 +50,7 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property,
 }
 
 func (s *Shard) extendInvertedIndexItemWithFrequencyLSM(b, hashBucket *lsmkv.Bucket,
-	item inverted.Countable, docID uint64, frequency float64) error {
+	item inverted.Countable, docID uint64, propLength int) error {
 	if b.Strategy() != lsmkv.StrategyMapCollection {
 		panic("prop has frequency, but bucket does not have 'Map' strategy")
 	}
@@ -70,13 +64,12 @@ func (s *Shard) extendInvertedIndexItemWithFrequencyLSM(b, hashBucket *lsmkv.Buc
 		return err
 	}
 
-	buf := make([]byte, 16) // 8 bytes for doc id, 8 bytes for frequency
-	binary.LittleEndian.PutUint64(buf[:8], docID)
-	binary.LittleEndian.PutUint64(buf[8:], uint64(item.TermFrequency))
+	docIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docIDBytes, docID)
 
 	pair := lsmkv.MapPair{
-		Key:   buf[:8],
-		Value: buf[8:],
+		Key:   docIDBytes,
+		Value: inverted.FrequencyValue(item, propLength),
 	}
 
 	return b.MapSet(item.Data, pair)
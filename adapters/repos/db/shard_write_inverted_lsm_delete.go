@@ -13,10 +13,8 @@ package db
 
 import (
 	"encoding/binary"
-	"fmt"
 
 	"github.com/pkg/errors"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 )
@@ -24,18 +22,13 @@ import (
 func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 	docID uint64) error {
 	for _, prop := range props {
-		b := s.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
-		if b == nil {
-			return fmt.Errorf("no bucket for prop '%s' found", prop.Name)
-		}
-
-		hashBucket := s.store.Bucket(helpers.HashBucketFromPropNameLSM(prop.Name))
-		if b == nil {
-			return fmt.Errorf("no hash bucket for prop '%s' found", prop.Name)
+		b, hashBucket, items, err := s.invertedBucketsLSM(prop)
+		if err != nil {
+			return err
 		}
 
 		if prop.HasFrequency {
-			for _, item := range prop.Items {
+			for _, item := range items {
 				if err := s.deleteInvertedIndexItemWithFrequencyLSM(b, hashBucket, item,
 					docID); err != nil {
 					return errors.Wrapf(err, "extend index with item '%s'",
@@ -43,7 +36,7 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 				}
 			}
 		} else {
-			for _, item := range prop.Items {
+			for _, item := range items {
 				if err := s.deleteInvertedIndexItemLSM(b, hashBucket, item, docID); err != nil {
 					return errors.Wrapf(err, "extend index with item '%s'",
 						string(item.Data))
@@ -23,6 +23,10 @@ import (
 )
 
 func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
+	// the merge is based on the stored object, so a put of the same object
+	// which is still held back for coalescing must be written first
+	s.coalescer.wait(merge.ID)
+
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -37,6 +41,10 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateMultiVectors(next.MultiVector, status); err != nil {
+		return errors.Wrap(err, "update multi vector index")
+	}
+
 	if err := s.store.WriteWALs(); err != nil {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
@@ -50,6 +58,9 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 
 func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 	idBytes []byte) (*storobj.Object, objectInsertStatus, error) {
+	s.readViewLock.RLock()
+	defer s.readViewLock.RUnlock()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	previous, err := bucket.Get([]byte(idBytes))
 	if err != nil {
@@ -199,6 +210,18 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	if merge.MultiVector != nil {
+		next.MultiVector = merge.MultiVector
+	}
+
+	if merge.SparseVector != nil {
+		next.SparseVector = merge.SparseVector
+	}
+
+	if merge.UpdateTime != 0 {
+		next.Object.LastUpdateTimeUnix = merge.UpdateTime
+	}
+
 	next.SetProperties(properties)
 
 	return next
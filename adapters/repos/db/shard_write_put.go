@@ -25,6 +25,14 @@ import (
 )
 
 func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
+	if s.coalescer != nil {
+		return s.coalescer.put(object, s.putObjectNow)
+	}
+
+	return s.putObjectNow(object)
+}
+
+func (s *Shard) putObjectNow(object *storobj.Object) error {
 	idBytes, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -41,6 +49,10 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateMultiVectors(object.MultiVector, status); err != nil {
+		return errors.Wrap(err, "update multi vector index")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -76,6 +88,9 @@ func (s *Shard) putObjectLSM(object *storobj.Object,
 	before := time.Now()
 	defer s.metrics.PutObject(before)
 
+	s.readViewLock.RLock()
+	defer s.readViewLock.RUnlock()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	previous, err := bucket.Get([]byte(idBytes))
 	if err != nil {
@@ -207,6 +222,15 @@ func (s Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 	s.metrics.InvertedExtend(before, len(props))
 
+	if err := s.extendSparseVectorPostingsLSM(object.SparseVector,
+		status.docID); err != nil {
+		return errors.Wrap(err, "put sparse vector postings")
+	}
+
+	if err := s.updateContinuousAggregatesLSM(object, 1); err != nil {
+		return errors.Wrap(err, "add to continuous aggregates")
+	}
+
 	return nil
 }
 
@@ -239,5 +263,16 @@ func (s Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 		return errors.Wrap(err, "put inverted indices props")
 	}
 
+	err = s.deleteFromSparseVectorPostingsLSM(previousObject.SparseVector,
+		status.oldDocID)
+	if err != nil {
+		return errors.Wrap(err, "delete sparse vector postings")
+	}
+
+	err = s.updateContinuousAggregatesLSM(previousObject, -1)
+	if err != nil {
+		return errors.Wrap(err, "remove from continuous aggregates")
+	}
+
 	return nil
 }
@@ -11,7 +11,14 @@
 
 package db
 
-import "github.com/semi-technologies/weaviate/entities/storobj"
+import (
+	"bytes"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
 
 type sortObjsByDist struct {
 	objects   []*storobj.Object
@@ -22,11 +29,101 @@ func (sbd sortObjsByDist) Len() int {
 	return len(sbd.objects)
 }
 
+// Less breaks ties between equal distances by id, so that the merged order
+// of results from multiple shards is the same on every query. Otherwise
+// consecutive pages could contain duplicates or miss results.
 func (sbd sortObjsByDist) Less(i, j int) bool {
-	return sbd.distances[i] < sbd.distances[j]
+	if sbd.distances[i] != sbd.distances[j] {
+		return sbd.distances[i] < sbd.distances[j]
+	}
+
+	return sbd.objects[i].ID() < sbd.objects[j].ID()
 }
 
 func (sbd sortObjsByDist) Swap(i, j int) {
 	sbd.distances[i], sbd.distances[j] = sbd.distances[j], sbd.distances[i]
 	sbd.objects[i], sbd.objects[j] = sbd.objects[j], sbd.objects[i]
 }
+
+// sortObjsByScore sorts by descending score, ties are broken by id just like
+// in sortObjsByDist
+type sortObjsByScore struct {
+	objects []*storobj.Object
+	scores  []float32
+}
+
+func (sbs sortObjsByScore) Len() int {
+	return len(sbs.objects)
+}
+
+func (sbs sortObjsByScore) Less(i, j int) bool {
+	if sbs.scores[i] != sbs.scores[j] {
+		return sbs.scores[i] > sbs.scores[j]
+	}
+
+	return sbs.objects[i].ID() < sbs.objects[j].ID()
+}
+
+func (sbs sortObjsByScore) Swap(i, j int) {
+	sbs.scores[i], sbs.scores[j] = sbs.scores[j], sbs.scores[i]
+	sbs.objects[i], sbs.objects[j] = sbs.objects[j], sbs.objects[i]
+}
+
+// sortByUUID sorts by the binary representation of the ids, which is the
+// order in which a shard's cursor lists them
+func sortByUUID(objects []*storobj.Object) {
+	keys := make([][]byte, len(objects))
+	for i, obj := range objects {
+		parsed, err := uuid.Parse(obj.ID().String())
+		if err != nil {
+			keys[i] = []byte(obj.ID())
+			continue
+		}
+		keys[i] = parsed[:]
+	}
+
+	sort.Sort(sortObjsByUUID{objects, keys})
+}
+
+type sortObjsByUUID struct {
+	objects []*storobj.Object
+	keys    [][]byte
+}
+
+func (s sortObjsByUUID) Len() int {
+	return len(s.objects)
+}
+
+func (s sortObjsByUUID) Less(i, j int) bool {
+	return bytes.Compare(s.keys[i], s.keys[j]) < 0
+}
+
+func (s sortObjsByUUID) Swap(i, j int) {
+	s.objects[i], s.objects[j] = s.objects[j], s.objects[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// sortByTimestamp sorts by the timestamp at the path of the sort, which is the
+// order in which a shard lists them for that sort. Ties are broken by id, so
+// that the merged order of results from multiple shards is stable.
+func sortByTimestamp(objects []*storobj.Object, s filters.Sort) {
+	timestamp := func(obj *storobj.Object) int64 {
+		if s.Path[0] == filters.InternalPropCreationTimeUnix {
+			return obj.CreationTimeUnix()
+		}
+		return obj.LastUpdateTimeUnix()
+	}
+
+	desc := s.Order == filters.SortOrderDesc
+	sort.Slice(objects, func(i, j int) bool {
+		a, b := timestamp(objects[i]), timestamp(objects[j])
+		if a != b {
+			if desc {
+				return a > b
+			}
+			return a < b
+		}
+
+		return objects[i].ID() < objects[j].ID()
+	})
+}
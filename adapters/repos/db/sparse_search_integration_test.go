@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseSearch(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	sparseConfig := hnsw.NewDefaultUserConfig()
+	sparseConfig.Sparse = true
+	class := &models.Class{
+		Class:               "SparseSearchClass",
+		VectorIndexConfig:   sparseConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	denseClass := &models.Class{
+		Class:               "DenseSearchClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{RootPath: dirName, QueryMaximumResults: 10000}, &fakeRemoteClient{},
+		&fakeNodeResolver{})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the classes", func(t *testing.T) {
+		for _, c := range []*models.Class{class, denseClass} {
+			require.Nil(t,
+				migrator.AddClass(context.Background(), c, schemaGetter.shardState))
+		}
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class, denseClass},
+			},
+		}
+	})
+
+	cars := strfmt.UUID("9b4a0f0e-3a52-4c3b-8f0d-5d8d2c1e0a01")
+	trucks := strfmt.UUID("9b4a0f0e-3a52-4c3b-8f0d-5d8d2c1e0a02")
+	boats := strfmt.UUID("9b4a0f0e-3a52-4c3b-8f0d-5d8d2c1e0a03")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objs := []*models.Object{{
+			ID:           cars,
+			Class:        "SparseSearchClass",
+			Properties:   map[string]interface{}{"title": "cars"},
+			SparseVector: models.SparseVector{"car": 2, "vehicle": 1},
+		}, {
+			ID:           trucks,
+			Class:        "SparseSearchClass",
+			Properties:   map[string]interface{}{"title": "trucks"},
+			SparseVector: models.SparseVector{"truck": 2, "vehicle": 1.5},
+		}, {
+			ID:           boats,
+			Class:        "SparseSearchClass",
+			Properties:   map[string]interface{}{"title": "boats"},
+			SparseVector: models.SparseVector{"boat": 2, "water": 1},
+		}}
+
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	rank := func(t *testing.T, vector map[string]float32,
+		filter *filters.LocalFilter) []search.Result {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:     "SparseSearchClass",
+			Pagination:    &filters.Pagination{Limit: 10},
+			SparseRanking: &searchparams.SparseRanking{Query: "q", Vector: vector},
+			Filters:       filter,
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	ids := func(res []search.Result) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			out[i] = obj.ID
+		}
+		return out
+	}
+
+	t.Run("ranking by the dot product", func(t *testing.T) {
+		res := rank(t, map[string]float32{"vehicle": 1, "car": 0.5}, nil)
+
+		require.Equal(t, []strfmt.UUID{cars, trucks}, ids(res))
+		assert.Equal(t, float32(2), res[0].Score)
+		assert.Equal(t, float32(1.5), res[1].Score)
+	})
+
+	t.Run("ranking with a filter", func(t *testing.T) {
+		res := rank(t, map[string]float32{"vehicle": 1},
+			buildFilter("title", "cars", eq, dtString))
+		assert.Equal(t, []strfmt.UUID{cars}, ids(res))
+	})
+
+	t.Run("the sparse vector is stored with the object", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), boats, nil,
+			additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, map[string]float32{"boat": 2, "water": 1}, res.SparseVector)
+	})
+
+	t.Run("updated objects are ranked by their new sparse vector", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:           trucks,
+			Class:        "SparseSearchClass",
+			Properties:   map[string]interface{}{"title": "trucks"},
+			SparseVector: models.SparseVector{"truck": 2},
+		}, []float32{1, 3, 5, 0.4}))
+
+		res := rank(t, map[string]float32{"vehicle": 1}, nil)
+		assert.Equal(t, []strfmt.UUID{cars}, ids(res))
+	})
+
+	t.Run("deleted objects are not ranked", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), "SparseSearchClass", cars))
+
+		res := rank(t, map[string]float32{"vehicle": 1, "boat": 1}, nil)
+		assert.Equal(t, []strfmt.UUID{boats}, ids(res))
+	})
+
+	t.Run("a class without sparse", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:           cars,
+			Class:        "DenseSearchClass",
+			Properties:   map[string]interface{}{"title": "cars"},
+			SparseVector: models.SparseVector{"car": 2},
+		}, []float32{1, 3, 5, 0.4})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "sparse is not enabled")
+	})
+
+	t.Run("non-positive weights", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:           cars,
+			Class:        "SparseSearchClass",
+			Properties:   map[string]interface{}{"title": "cars"},
+			SparseVector: models.SparseVector{"car": -1},
+		}, []float32{1, 3, 5, 0.4})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must be positive")
+	})
+}
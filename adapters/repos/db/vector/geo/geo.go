@@ -17,7 +17,9 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -44,6 +46,10 @@ type vectorIndex interface {
 	Delete(id uint64) error
 	Dump(...string)
 	Drop() error
+	PauseMaintenance()
+	ResumeMaintenance()
+	SwitchCommitLogs() error
+	ListFiles() ([]string, error)
 }
 
 // Config is passed to the GeoIndex when its created
@@ -53,6 +59,14 @@ type Config struct {
 	DisablePersistence bool
 	RootPath           string
 	Logger             logrus.FieldLogger
+
+	// IOThrottle optionally limits the disk throughput of the maintenance of
+	// the underlying hnsw index
+	IOThrottle *iothrottle.Throttle
+
+	// Encryption optionally encrypts the commit logs of the underlying hnsw
+	// index
+	Encryption *encryption.Cipher
 }
 
 func NewIndex(config Config) (*Index, error) {
@@ -62,6 +76,8 @@ func NewIndex(config Config) (*Index, error) {
 		RootPath:              config.RootPath,
 		MakeCommitLoggerThunk: makeCommitLoggerFromConfig(config),
 		DistanceProvider:      distancer.NewGeoProvider(),
+		IOThrottle:            config.IOThrottle,
+		Encryption:            config.Encryption,
 	}, hnsw.UserConfig{
 		MaxConnections:         64,
 		EFConstruction:         128,
@@ -93,7 +109,8 @@ func makeCommitLoggerFromConfig(config Config) hnsw.MakeCommitLogger {
 	if !config.DisablePersistence {
 		makeCL = func() (hnsw.CommitLogger, error) {
 			return hnsw.NewCommitLogger(config.RootPath, config.ID, 10*time.Second,
-				config.Logger)
+				config.Logger, hnsw.WithIOThrottle(config.IOThrottle),
+				hnsw.WithEncryption(config.Encryption))
 		}
 	}
 	return makeCL
@@ -129,3 +146,23 @@ func (i *Index) WithinRange(ctx context.Context,
 func (i *Index) Delete(id uint64) error {
 	return i.vectorIndex.Delete(id)
 }
+
+// PauseMaintenance pauses the maintenance of the underlying vector index,
+// see ListFiles
+func (i *Index) PauseMaintenance() {
+	i.vectorIndex.PauseMaintenance()
+}
+
+func (i *Index) ResumeMaintenance() {
+	i.vectorIndex.ResumeMaintenance()
+}
+
+func (i *Index) SwitchCommitLogs() error {
+	return i.vectorIndex.SwitchCommitLogs()
+}
+
+// ListFiles returns the completed commit log files of the underlying vector
+// index
+func (i *Index) ListFiles() ([]string, error) {
+	return i.vectorIndex.ListFiles()
+}
@@ -12,11 +12,14 @@
 package hnsw
 
 import (
+	"context"
 	"io"
 	"os"
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/sirupsen/logrus"
 )
 
@@ -25,6 +28,17 @@ type CommitLogCombiner struct {
 	id        string
 	threshold int64
 	logger    logrus.FieldLogger
+
+	// throttle limits the rate at which the combined file is written, it may
+	// be nil
+	throttle *iothrottle.Throttle
+
+	// cipher encrypts the combined file and decrypts encrypted sources, it
+	// may be nil
+	cipher *encryption.Cipher
+
+	// combined is the number of pairs of files combined by Do
+	combined int
 }
 
 func NewCommitLogCombiner(rootPath, id string, threshold int64,
@@ -100,6 +114,7 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 		if err := c.combine(fileName, fileNames[i+1]); err != nil {
 			return false, errors.Wrapf(err, "combine %q and %q", fileName, fileNames[i+1])
 		}
+		c.combined++
 
 		return true, nil
 	}
@@ -155,18 +170,49 @@ func (c *CommitLogCombiner) mergeFiles(outName, first, second string) error {
 		return errors.Wrapf(err, "open second source file %q", second)
 	}
 
-	_, err = io.Copy(out, source1)
+	var target io.Writer = out
+	var encrypted *encryption.Writer
+	if c.cipher != nil {
+		encrypted, err = encryption.NewWriter(out, c.cipher)
+		if err != nil {
+			return errors.Wrapf(err, "encrypt target file %q", outName)
+		}
+		target = encrypted
+	}
+
+	w := iothrottle.NewWriter(context.Background(), target, c.throttle,
+		iothrottle.PriorityHNSW)
+
+	// encrypted blocks can not be concatenated, so the sources are always
+	// copied as plain text
+	plain1, err := encryption.NewLogReader(source1, c.cipher)
+	if err != nil {
+		return errors.Wrapf(err, "read first source file %q", first)
+	}
+
+	plain2, err := encryption.NewLogReader(source2, c.cipher)
+	if err != nil {
+		return errors.Wrapf(err, "read second source file %q", second)
+	}
+
+	_, err = io.Copy(w, plain1)
 	if err != nil {
 		return errors.Wrapf(err, "copy first source (%q) into target (%q)", first,
 			outName)
 	}
 
-	_, err = io.Copy(out, source2)
+	_, err = io.Copy(w, plain2)
 	if err != nil {
 		return errors.Wrapf(err, "copy second source (%q) into target (%q)", second,
 			outName)
 	}
 
+	if encrypted != nil {
+		if err := encrypted.Close(); err != nil {
+			return errors.Wrapf(err, "close target file %q", outName)
+		}
+	}
+
 	err = out.Close()
 	if err != nil {
 		return errors.Wrapf(err, "close target file %q", outName)
@@ -21,9 +21,12 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/commitlog"
 	"github.com/sirupsen/logrus"
 )
@@ -38,31 +41,79 @@ func commitLogDirectory(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, name)
 }
 
+type CommitLoggerOption func(l *hnswCommitLogger)
+
+// WithIOThrottle makes condensing and combining the commit logs use the disk
+// throughput budget, which is typically shared by all background work of a
+// node
+func WithIOThrottle(t *iothrottle.Throttle) CommitLoggerOption {
+	return func(l *hnswCommitLogger) {
+		l.throttle = t
+	}
+}
+
+// WithEncryption encrypts new commit log files, including the condensed and
+// combined ones. Existing plain text logs remain readable.
+func WithEncryption(c *encryption.Cipher) CommitLoggerOption {
+	return func(l *hnswCommitLogger) {
+		l.cipher = c
+	}
+}
+
+// WithMaxLogSize sets the size up to which condensed commit logs are
+// combined, the log currently written to is switched to a new file at a fifth
+// of that size. Fewer, larger files mean fewer redundant entries to replay on
+// startup, but each condensing cycle has to hold a larger file in memory. A
+// size of 0 keeps the default.
+func WithMaxLogSize(size int64) CommitLoggerOption {
+	return func(l *hnswCommitLogger) {
+		if size <= 0 {
+			return
+		}
+
+		l.maxSizeIndividual = size / 5
+		l.maxSizeCombining = size
+	}
+}
+
 func NewCommitLogger(rootPath, name string,
 	maintainenceInterval time.Duration,
-	logger logrus.FieldLogger) (*hnswCommitLogger, error) {
+	logger logrus.FieldLogger, opts ...CommitLoggerOption) (*hnswCommitLogger, error) {
 	l := &hnswCommitLogger{
 		cancel:               make(chan struct{}),
 		rootPath:             rootPath,
 		id:                   name,
 		maintainenceInterval: maintainenceInterval,
-		condensor:            NewMemoryCondensor2(logger),
 		logger:               logger,
-		maxSizeIndividual:    maxUncondensedCommitLogSize / 5, // TODO: make configurable
-		maxSizeCombining:     maxUncondensedCommitLogSize,     // TODO: make configurable
+		maxSizeIndividual:    maxUncondensedCommitLogSize / 5,
+		maxSizeCombining:     maxUncondensedCommitLogSize,
 	}
 
-	fd, err := getLatestCommitFileOrCreate(rootPath, name)
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	condensor := NewMemoryCondensor2(logger)
+	condensor.throttle = l.throttle
+	condensor.cipher = l.cipher
+	l.condensor = condensor
+
+	fd, err := getLatestCommitFileOrCreate(rootPath, name, l.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	l.commitLogger, err = newCommitLogWithFile(fd, l.cipher)
 	if err != nil {
 		return nil, err
 	}
 
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
 	l.StartLogging()
 	return l, nil
 }
 
-func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
+func getLatestCommitFileOrCreate(rootPath, name string,
+	cipher *encryption.Cipher) (*os.File, error) {
 	dir := commitLogDirectory(rootPath, name)
 	err := os.MkdirAll(dir, os.ModePerm)
 	if err != nil {
@@ -77,10 +128,26 @@ func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 	if !ok {
 		// this is a new commit log, initialize with the current time stamp
 		fileName = fmt.Sprintf("%d", time.Now().Unix())
+	} else {
+		matches, err := matchesEncryption(commitLogFileName(rootPath, name,
+			fileName), cipher)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matches {
+			// encryption was switched on or off since the last log was written,
+			// plain text and encrypted records can not share a file
+			ts, err := asTimeStamp(fileName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse commit log name %q", fileName)
+			}
+			fileName = fmt.Sprintf("%d", ts+1)
+		}
 	}
 
 	fd, err := os.OpenFile(commitLogFileName(rootPath, name, fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		commitLogFileFlags(cipher), 0o666)
 	if err != nil {
 		return nil, errors.Wrap(err, "create commit log file")
 	}
@@ -88,6 +155,60 @@ func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 	return fd, nil
 }
 
+// matchesEncryption returns true if the log file at path can be appended to
+// with or without a cipher, i.e. if it is empty or if it is encrypted
+// exactly when a cipher is set
+func matchesEncryption(path string, cipher *encryption.Cipher) (bool, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return false, errors.Wrap(err, "open commit log file")
+	}
+	defer fd.Close()
+
+	info, err := fd.Stat()
+	if err != nil {
+		return false, errors.Wrap(err, "stat commit log file")
+	}
+
+	if info.Size() == 0 {
+		return true, nil
+	}
+
+	encrypted, err := encryption.IsEncryptedFile(fd)
+	if err != nil {
+		return false, errors.Wrap(err, "check commit log for encryption")
+	}
+
+	return encrypted == (cipher != nil), nil
+}
+
+// commitLogFileFlags opens plain text logs for appending. Encrypted logs
+// reseal their last block when appending, which needs positioned writes.
+func commitLogFileFlags(cipher *encryption.Cipher) int {
+	if cipher != nil {
+		return os.O_RDWR | os.O_CREATE
+	}
+
+	return os.O_WRONLY | os.O_APPEND | os.O_CREATE
+}
+
+// newCommitLogWithFile writes the commit log into fd, encrypted if a cipher
+// is set
+func newCommitLogWithFile(fd *os.File,
+	cipher *encryption.Cipher) (*commitlog.Logger, error) {
+	if cipher == nil {
+		return commitlog.NewLoggerWithFile(fd), nil
+	}
+
+	w, err := encryption.OpenWriter(fd, cipher)
+	if err != nil {
+		fd.Close()
+		return nil, errors.Wrap(err, "open encrypted commit log file")
+	}
+
+	return commitlog.NewEncryptedLoggerWithFile(fd, w), nil
+}
+
 // getCommitFileNames in order, from old to new
 func getCommitFileNames(rootPath, name string) ([]string, error) {
 	dir := commitLogDirectory(rootPath, name)
@@ -233,6 +354,24 @@ type hnswCommitLogger struct {
 	maxSizeIndividual    int64
 	maxSizeCombining     int64
 	commitLogger         *commitlog.Logger
+
+	// throttle limits the disk throughput of condensing and combining, it may
+	// be nil
+	throttle *iothrottle.Throttle
+
+	// cipher encrypts new log files, it may be nil
+	cipher *encryption.Cipher
+
+	// maintenanceLock is held while combining and condensing logs, holding it
+	// from the outside (e.g. for a backup) pauses those operations, so that
+	// the list of completed log files remains stable
+	maintenanceLock sync.Mutex
+
+	// the counters of the maintenance since startup, accessed atomically
+	condensings          int64
+	condensedInputBytes  int64
+	condensedOutputBytes int64
+	combinings           int64
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -408,6 +547,7 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs() chan struct{} {
 			case <-cancel:
 				return
 			case <-maintenance:
+				l.maintenanceLock.Lock()
 				if err := l.combineLogs(); err != nil {
 					l.logger.WithError(err).
 						WithField("action", "hsnw_commit_log_combining").
@@ -419,6 +559,7 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs() chan struct{} {
 						WithField("action", "hsnw_commit_log_condensing").
 						Error("hnsw commit log maintenance (condensing) failed")
 				}
+				l.maintenanceLock.Unlock()
 			}
 		}
 	}(cancelFromOutside)
@@ -444,13 +585,11 @@ func (l *hnswCommitLogger) maintenance() error {
 		return err
 	}
 
-	if err := l.commitLogger.Close(); err != nil {
+	fileName, err := l.switchCommitLog(oldFileName)
+	if err != nil {
 		return err
 	}
 
-	// this is a new commit log, initialize with the current time stamp
-	fileName := fmt.Sprintf("%d", time.Now().Unix())
-
 	l.logger.WithField("action", "commit_log_file_switched").
 		WithField("id", l.id).
 		WithField("old_file_name", oldFileName).
@@ -458,17 +597,91 @@ func (l *hnswCommitLogger) maintenance() error {
 		WithField("new_file_name", fileName).
 		Info("commit log size crossed threshold, switching to new file")
 
+	return nil
+}
+
+// switchCommitLog closes the current commit log and starts a new one,
+// initialized with the current time stamp. It does not lock on its own, the
+// caller must hold the lock.
+func (l *hnswCommitLogger) switchCommitLog(oldFileName string) (string, error) {
+	if err := l.commitLogger.Close(); err != nil {
+		return "", err
+	}
+
+	// file names have a resolution of one second, make sure we never end up
+	// appending to the file we just closed
+	ts := time.Now().Unix()
+	if oldTs, err := asTimeStamp(filepath.Base(oldFileName)); err == nil &&
+		ts <= oldTs {
+		ts = oldTs + 1
+	}
+	fileName := fmt.Sprintf("%d", ts)
+
 	fd, err := os.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		commitLogFileFlags(l.cipher), 0o666)
+	if err != nil {
+		return "", errors.Wrap(err, "create commit log file")
+	}
+
+	commitLogger, err := newCommitLogWithFile(fd, l.cipher)
+	if err != nil {
+		return "", err
+	}
+
+	l.commitLogger = commitLogger
+
+	return fileName, nil
+}
+
+// PauseMaintenance prevents combining and condensing of commit logs until
+// ResumeMaintenance is called. It blocks until a running maintenance cycle
+// has completed.
+func (l *hnswCommitLogger) PauseMaintenance() {
+	l.maintenanceLock.Lock()
+}
+
+func (l *hnswCommitLogger) ResumeMaintenance() {
+	l.maintenanceLock.Unlock()
+}
+
+// SwitchCommitLogs forces a switch to a new commit log file, so that all
+// changes logged so far are contained in files which are no longer written
+// to
+func (l *hnswCommitLogger) SwitchCommitLogs() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if err := l.commitLogger.Flush(); err != nil {
+		return errors.Wrap(err, "flush commit log")
+	}
+
+	oldFileName, err := l.commitLogger.FileName()
 	if err != nil {
-		return errors.Wrap(err, "create commit log file")
+		return err
 	}
 
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	if _, err := l.switchCommitLog(oldFileName); err != nil {
+		return errors.Wrap(err, "switch commit log")
+	}
 
 	return nil
 }
 
+// ListFiles returns the paths of all commit log files except for the one
+// currently written to
+func (l *hnswCommitLogger) ListFiles() ([]string, error) {
+	files, err := getCommitFileNames(l.rootPath, l.id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) <= 1 {
+		return nil, nil
+	}
+
+	return files[:len(files)-1], nil
+}
+
 func (l *hnswCommitLogger) condenseOldLogs() error {
 	files, err := getCommitFileNames(l.rootPath, l.id)
 	if err != nil {
@@ -485,13 +698,37 @@ func (l *hnswCommitLogger) condenseOldLogs() error {
 	// cut off last element, as that's never a candidate
 	candidates := files[:len(files)-1]
 
+	// every log which is no longer written to is condensed right away, as the
+	// redundant entries of uncondensed logs make up most of the time it takes
+	// to restore the index on startup
 	for _, candidate := range candidates {
 		if strings.HasSuffix(candidate, ".condensed") {
 			// don't attempt to condense logs which are already condensed
 			continue
 		}
 
-		return l.condensor.Do(candidate)
+		if err := l.condense(candidate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *hnswCommitLogger) condense(fileName string) error {
+	before, err := os.Stat(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "stat commit log %q", fileName)
+	}
+
+	if err := l.condensor.Do(fileName); err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&l.condensings, 1)
+	atomic.AddInt64(&l.condensedInputBytes, before.Size())
+	if after, err := os.Stat(fileName + ".condensed"); err == nil {
+		atomic.AddInt64(&l.condensedOutputBytes, after.Size())
 	}
 
 	return nil
@@ -503,7 +740,54 @@ func (l *hnswCommitLogger) combineLogs() error {
 	// assumption that the combined file will be considerably smaller than the
 	// sum of both input files
 	threshold := int64(float64(l.maxSizeCombining) * 1.75)
-	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger).Do()
+	combiner := NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger)
+	combiner.throttle = l.throttle
+	combiner.cipher = l.cipher
+	err := combiner.Do()
+	atomic.AddInt64(&l.combinings, int64(combiner.combined))
+	return err
+}
+
+// CommitLogStats describes the commit log files of an index and the
+// maintenance which condensed and combined them since startup
+type CommitLogStats struct {
+	// Files and Bytes are the number and total size of the commit log files
+	// currently on disk, all of them are read when the index is restored
+	Files int64
+	Bytes int64
+
+	Condensings          int64
+	CondensedInputBytes  int64
+	CondensedOutputBytes int64
+	Combinings           int64
+}
+
+// Stats reports the current commit log files and the maintenance counters.
+// Unlike getCommitFileNames it only reads the directory, so it can be called
+// while a combining is in progress, whose temporary file is not counted.
+func (l *hnswCommitLogger) Stats() CommitLogStats {
+	out := CommitLogStats{
+		Condensings:          atomic.LoadInt64(&l.condensings),
+		CondensedInputBytes:  atomic.LoadInt64(&l.condensedInputBytes),
+		CondensedOutputBytes: atomic.LoadInt64(&l.condensedOutputBytes),
+		Combinings:           atomic.LoadInt64(&l.combinings),
+	}
+
+	files, err := ioutil.ReadDir(commitLogDirectory(l.rootPath, l.id))
+	if err != nil {
+		return out
+	}
+
+	for _, info := range files {
+		if strings.HasSuffix(info.Name(), ".tmp") {
+			continue
+		}
+
+		out.Files++
+		out.Bytes += info.Size()
+	}
+
+	return out
 }
 
 func (l *hnswCommitLogger) Drop() error {
@@ -63,6 +63,18 @@ func (n *NoopCommitLogger) Drop() error {
 	return nil
 }
 
+func (n *NoopCommitLogger) PauseMaintenance() {}
+
+func (n *NoopCommitLogger) ResumeMaintenance() {}
+
+func (n *NoopCommitLogger) SwitchCommitLogs() error {
+	return nil
+}
+
+func (n *NoopCommitLogger) ListFiles() ([]string, error) {
+	return nil, nil
+}
+
 func MakeNoopCommitLogger() (CommitLogger, error) {
 	return &NoopCommitLogger{}, nil
 }
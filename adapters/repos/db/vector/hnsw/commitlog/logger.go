@@ -16,11 +16,16 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 )
 
 type Logger struct {
 	file *os.File
 	bufw *bufWriter
+
+	// encrypted is set if the log is encrypted, everything buffered in bufw
+	// is then written through it into file
+	encrypted *encryption.Writer
 }
 
 // TODO: these are duplicates with the hnsw package, unify them
@@ -54,6 +59,12 @@ func NewLoggerWithFile(file *os.File) *Logger {
 	return &Logger{file: file, bufw: NewWriterSize(file, 1024*1024)}
 }
 
+// NewEncryptedLoggerWithFile writes the commit log through w, which encrypts
+// it into file
+func NewEncryptedLoggerWithFile(file *os.File, w *encryption.Writer) *Logger {
+	return &Logger{file: file, bufw: NewWriterSize(w, 1024*1024), encrypted: w}
+}
+
 func (l *Logger) SetEntryPointWithMaxLayer(id uint64, level int) error {
 	toWrite := make([]byte, 11)
 	toWrite[0] = byte(SetEntryPointMaxLevel)
@@ -211,11 +222,19 @@ func (l *Logger) FileName() (string, error) {
 }
 
 func (l *Logger) Flush() error {
-	return l.bufw.Flush()
+	if err := l.bufw.Flush(); err != nil {
+		return err
+	}
+
+	if l.encrypted != nil {
+		return l.encrypted.Flush()
+	}
+
+	return nil
 }
 
 func (l *Logger) Close() error {
-	if err := l.bufw.Flush(); err != nil {
+	if err := l.Flush(); err != nil {
 		return err
 	}
 
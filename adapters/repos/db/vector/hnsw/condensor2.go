@@ -13,12 +13,15 @@ package hnsw
 
 import (
 	"bufio"
+	"context"
 	"encoding/binary"
 	"fmt"
 	"math"
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/sirupsen/logrus"
 )
 
@@ -26,6 +29,16 @@ type MemoryCondensor2 struct {
 	newLogFile *os.File
 	newLog     *bufWriter
 	logger     logrus.FieldLogger
+
+	// cipher encrypts the condensed log and decrypts an encrypted log to be
+	// condensed, it may be nil
+	cipher    *encryption.Cipher
+	encrypted *encryption.Writer
+
+	// throttle limits the rate at which the log to be condensed is read, the
+	// condensed log is always considerably smaller, so reading dominates the
+	// disk usage. It may be nil.
+	throttle *iothrottle.Throttle
 }
 
 func (c *MemoryCondensor2) Do(fileName string) error {
@@ -33,7 +46,12 @@ func (c *MemoryCondensor2) Do(fileName string) error {
 	if err != nil {
 		return errors.Wrap(err, "open commit log to be condensed")
 	}
-	fdBuf := bufio.NewReaderSize(fd, 256*1024)
+	plain, err := encryption.NewLogReader(iothrottle.NewReader(context.Background(),
+		fd, c.throttle, iothrottle.PriorityHNSW), c.cipher)
+	if err != nil {
+		return errors.Wrap(err, "read commit log to be condensed")
+	}
+	fdBuf := bufio.NewReaderSize(plain, 256*1024)
 
 	res, _, err := NewDeserializer2(c.logger).Do(fdBuf, nil, true)
 	if err != nil {
@@ -41,14 +59,22 @@ func (c *MemoryCondensor2) Do(fileName string) error {
 	}
 
 	newLogFile, err := os.OpenFile(fmt.Sprintf("%s.condensed", fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		commitLogFileFlags(c.cipher), 0o666)
 	if err != nil {
 		return errors.Wrap(err, "open new commit log file for writing")
 	}
 
 	c.newLogFile = newLogFile
 
-	c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
+	if c.cipher == nil {
+		c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
+	} else {
+		c.encrypted, err = encryption.NewWriter(c.newLogFile, c.cipher)
+		if err != nil {
+			return errors.Wrap(err, "encrypt new commit log")
+		}
+		c.newLog = NewWriterSize(c.encrypted, 1*1024*1024)
+	}
 
 	for _, node := range res.Nodes {
 		if node == nil {
@@ -98,6 +124,12 @@ func (c *MemoryCondensor2) Do(fileName string) error {
 		return errors.Wrap(err, "close new commit log")
 	}
 
+	if c.encrypted != nil {
+		if err := c.encrypted.Close(); err != nil {
+			return errors.Wrap(err, "close new commit log")
+		}
+	}
+
 	if err := c.newLogFile.Close(); err != nil {
 		return errors.Wrap(err, "close new commit log")
 	}
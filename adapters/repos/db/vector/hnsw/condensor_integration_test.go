@@ -346,3 +346,48 @@ func removeTrailingNilNodes(in []*vertex) []*vertex {
 
 	return in[:pos+1]
 }
+
+func TestCommitLoggerCondensesAllCompletedLogs(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	rootPath := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(rootPath, 0o777)
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	l, err := NewCommitLogger(rootPath, "stats", 0, logger)
+	require.Nil(t, err)
+
+	writeRedundantLog := func(t *testing.T) {
+		l.AddNode(&vertex{id: 0, level: 1})
+		l.AddNode(&vertex{id: 1, level: 1})
+		for i := 0; i < 100; i++ {
+			l.ReplaceLinksAtLevel(0, 0, []uint64{1})
+			l.ReplaceLinksAtLevel(1, 0, []uint64{0})
+		}
+		require.Nil(t, l.SwitchCommitLogs())
+	}
+
+	t.Run("complete two redundant logs", func(t *testing.T) {
+		writeRedundantLog(t)
+		writeRedundantLog(t)
+
+		stats := l.Stats()
+		assert.Equal(t, int64(3), stats.Files)
+		assert.Equal(t, int64(0), stats.Condensings)
+	})
+
+	t.Run("condense both in a single cycle", func(t *testing.T) {
+		before := l.Stats()
+		require.Nil(t, l.condenseOldLogs())
+
+		stats := l.Stats()
+		assert.Equal(t, int64(3), stats.Files)
+		assert.Equal(t, int64(2), stats.Condensings)
+		assert.Equal(t, before.Bytes, stats.CondensedInputBytes)
+		assert.True(t, stats.CondensedOutputBytes < stats.CondensedInputBytes)
+		assert.Equal(t, stats.CondensedOutputBytes, stats.Bytes)
+	})
+}
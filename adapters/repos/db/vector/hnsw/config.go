@@ -17,8 +17,11 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/sirupsen/logrus"
 )
 
@@ -33,6 +36,14 @@ type Config struct {
 	VectorForIDThunk      VectorForID
 	Logger                logrus.FieldLogger
 	DistanceProvider      distancer.Provider
+
+	// IOThrottle optionally limits the disk throughput of the tombstone
+	// cleanup, the commit logs are throttled by the CommitLogger itself
+	IOThrottle *iothrottle.Throttle
+
+	// Encryption decrypts encrypted commit logs on startup, it may be nil.
+	// Writing encrypted logs is configured on the CommitLogger.
+	Encryption *encryption.Cipher
 }
 
 func (c Config) Validate() error {
@@ -100,6 +111,11 @@ const (
 	DefaultVectorCacheMaxObjects  = 2000000
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
+	DefaultSegments               = 1
+	DefaultMultiVector            = false
+	DefaultSparse                 = false
+	DefaultBinary                 = false
+	DefaultDistance               = distancer.MetricCosine
 )
 
 // UserConfig bundles all values settable by a user in the per-class settings
@@ -111,6 +127,30 @@ type UserConfig struct {
 	EF                     int  `json:"ef"`
 	VectorCacheMaxObjects  int  `json:"vectorCacheMaxObjects"`
 	FlatSearchCutoff       int  `json:"flatSearchCutoff"`
+
+	// Segments is the number of independent graphs the vectors of a single
+	// shard are split across. Each segment is searched in parallel.
+	Segments int `json:"segments"`
+
+	// Projection is applied to every vector before it is indexed, nil if the
+	// vectors are indexed with their original dimensions
+	Projection *vectorizer.Projection `json:"projection,omitempty"`
+
+	// Distance is the metric the vectors are compared with, the distances
+	// reported for search results are raw distances in this metric
+	Distance string `json:"distance"`
+
+	// MultiVector additionally indexes the token vectors of the objects in a
+	// separate graph, so they can be searched by late interaction
+	MultiVector bool `json:"multiVector"`
+
+	// Sparse additionally indexes the sparse term-weight vectors produced by
+	// a sparse vectorizer module in impact-ordered postings of the shards
+	Sparse bool `json:"sparse"`
+
+	// Binary indexes vectors consisting of zeros and ones, such as
+	// locality-sensitive hashes, bit-packed and compared by hamming distance
+	Binary bool `json:"binary"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -119,6 +159,12 @@ func (u UserConfig) IndexType() string {
 	return "hnsw"
 }
 
+// DistanceName returns the distance metric of the index, thus making sure the
+// schema.VectorIndexConfig interface is implemented
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (c *UserConfig) SetDefaults() {
 	c.MaxConnections = DefaultMaxConnections
@@ -128,6 +174,11 @@ func (c *UserConfig) SetDefaults() {
 	c.EF = DefaultEF
 	c.Skip = DefaultSkip
 	c.FlatSearchCutoff = DefaultFlatSearchCutoff
+	c.Segments = DefaultSegments
+	c.Distance = DefaultDistance
+	c.MultiVector = DefaultMultiVector
+	c.Sparse = DefaultSparse
+	c.Binary = DefaultBinary
 }
 
 // ParseUserConfig from an unknown input value, as this is not further
@@ -157,6 +208,11 @@ func ParseUserConfig(input interface{}) (schema.VectorIndexConfig, error) {
 		return uc, err
 	}
 
+	if uc.CleanupIntervalSeconds < 0 {
+		return uc, fmt.Errorf("cleanupIntervalSeconds must not be negative, got %d",
+			uc.CleanupIntervalSeconds)
+	}
+
 	if err := optionalIntFromMap(asMap, "efConstruction", func(v int) {
 		uc.EFConstruction = v
 	}); err != nil {
@@ -181,12 +237,76 @@ func ParseUserConfig(input interface{}) (schema.VectorIndexConfig, error) {
 		return uc, err
 	}
 
+	if err := optionalIntFromMap(asMap, "segments", func(v int) {
+		uc.Segments = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if uc.Segments < 1 {
+		return uc, fmt.Errorf("segments must be at least 1, got %d", uc.Segments)
+	}
+
 	if err := optionalBoolFromMap(asMap, "skip", func(v bool) {
 		uc.Skip = v
 	}); err != nil {
 		return uc, err
 	}
 
+	if err := optionalBoolFromMap(asMap, "multiVector", func(v bool) {
+		uc.MultiVector = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalBoolFromMap(asMap, "sparse", func(v bool) {
+		uc.Sparse = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalBoolFromMap(asMap, "binary", func(v bool) {
+		uc.Binary = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if uc.MultiVector && uc.Skip {
+		return uc, fmt.Errorf("multiVector can not be combined with skip")
+	}
+
+	if uc.Binary && uc.MultiVector {
+		return uc, fmt.Errorf("binary can not be combined with multiVector")
+	}
+
+	if err := optionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if _, err := distancer.ProviderForMetric(uc.Distance); err != nil {
+		return uc, err
+	}
+
+	if uc.Binary && uc.Distance != distancer.MetricHamming {
+		return uc, fmt.Errorf("binary vectors are compared by distance %q, got %q",
+			distancer.MetricHamming, uc.Distance)
+	}
+
+	if value, ok := asMap["projection"]; ok && value != nil {
+		projection, err := vectorizer.ParseProjection(value)
+		if err != nil {
+			return uc, err
+		}
+		uc.Projection = projection
+	}
+
+	if uc.Binary && uc.Projection != nil {
+		return uc, fmt.Errorf("binary can not be combined with a projection, " +
+			"as projected vectors are no longer binary")
+	}
+
 	return uc, nil
 }
 
@@ -232,6 +352,22 @@ func optionalBoolFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func optionalStringFromMap(in map[string]interface{}, name string,
+	setFn func(v string)) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return errors.Errorf("%s must be a string, got %T", name, value)
+	}
+
+	setFn(asString)
+	return nil
+}
+
 func NewDefaultUserConfig() UserConfig {
 	uc := UserConfig{}
 	uc.SetDefaults()
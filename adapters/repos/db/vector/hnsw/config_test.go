@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -104,6 +105,8 @@ func Test_UserConfig(t *testing.T) {
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Segments:               DefaultSegments,
+				Distance:               DefaultDistance,
 			},
 		},
 
@@ -119,6 +122,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Segments:               DefaultSegments,
+				Distance:               DefaultDistance,
 			},
 		},
 
@@ -131,7 +136,9 @@ func Test_UserConfig(t *testing.T) {
 				"vectorCacheMaxObjects":  json.Number("14"),
 				"ef":                     json.Number("15"),
 				"flatSearchCutoff":       json.Number("16"),
+				"segments":               json.Number("4"),
 				"skip":                   true,
+				"distance":               "manhattan",
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
@@ -140,6 +147,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				Segments:               4,
+				Distance:               "manhattan",
 				Skip:                   true,
 			},
 		},
@@ -155,6 +164,7 @@ func Test_UserConfig(t *testing.T) {
 				"vectorCacheMaxObjects":  float64(14),
 				"ef":                     float64(15),
 				"flatSearchCutoff":       float64(16),
+				"segments":               float64(4),
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
@@ -163,6 +173,90 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				Segments:               4,
+				Distance:               DefaultDistance,
+			},
+		},
+
+		test{
+			name: "with a random projection",
+			input: map[string]interface{}{
+				"projection": map[string]interface{}{
+					"type":            "random",
+					"inputDimensions": json.Number("1536"),
+					"dimensions":      json.Number("256"),
+					"seed":            json.Number("7"),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Segments:               DefaultSegments,
+				Distance:               DefaultDistance,
+				Projection: &vectorizer.Projection{
+					Type:            "random",
+					InputDimensions: 1536,
+					Dimensions:      256,
+					Seed:            7,
+				},
+			},
+		},
+		test{
+			name: "with multi vectors",
+			input: map[string]interface{}{
+				"multiVector": true,
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Segments:               DefaultSegments,
+				Distance:               DefaultDistance,
+				MultiVector:            true,
+			},
+		},
+		test{
+			name: "with sparse vectors on a skipped index",
+			input: map[string]interface{}{
+				"sparse": true,
+				"skip":   true,
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Segments:               DefaultSegments,
+				Distance:               DefaultDistance,
+				Skip:                   true,
+				Sparse:                 true,
+			},
+		},
+		test{
+			name: "with binary vectors",
+			input: map[string]interface{}{
+				"binary":   true,
+				"distance": "hamming",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Segments:               DefaultSegments,
+				Distance:               "hamming",
+				Binary:                 true,
 			},
 		},
 	}
@@ -176,3 +270,45 @@ func Test_UserConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_UserConfig_InvalidDistance(t *testing.T) {
+	_, err := ParseUserConfig(map[string]interface{}{
+		"distance": "chebyshev",
+	})
+	assert.NotNil(t, err)
+
+	_, err = ParseUserConfig(map[string]interface{}{
+		"distance": json.Number("1"),
+	})
+	assert.EqualError(t, err, "distance must be a string, got json.Number")
+}
+
+func Test_UserConfig_InvalidSegments(t *testing.T) {
+	_, err := ParseUserConfig(map[string]interface{}{
+		"segments": json.Number("0"),
+	})
+	assert.EqualError(t, err, "segments must be at least 1, got 0")
+}
+
+func Test_UserConfig_MultiVectorWithSkip(t *testing.T) {
+	_, err := ParseUserConfig(map[string]interface{}{
+		"multiVector": true,
+		"skip":        true,
+	})
+	assert.EqualError(t, err, "multiVector can not be combined with skip")
+}
+
+func Test_UserConfig_BinaryWithOtherDistance(t *testing.T) {
+	_, err := ParseUserConfig(map[string]interface{}{
+		"binary": true,
+	})
+	assert.EqualError(t, err,
+		"binary vectors are compared by distance \"hamming\", got \"cosine\"")
+}
+
+func Test_UserConfig_NegativeCleanupInterval(t *testing.T) {
+	_, err := ParseUserConfig(map[string]interface{}{
+		"cleanupIntervalSeconds": json.Number("-1"),
+	})
+	assert.EqualError(t, err, "cleanupIntervalSeconds must not be negative, got -1")
+}
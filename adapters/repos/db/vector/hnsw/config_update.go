@@ -13,6 +13,7 @@ package hnsw
 
 import (
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -39,12 +40,9 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 			accessor: func(c UserConfig) int { return c.MaxConnections },
 		},
 		{
-			// NOTE: There isn't a technical reason for this to be immutable, it
-			// simply hasn't been implemented yet. It would require to stop the
-			// current timer and start a new one. Certainly possible, but let's see
-			// if anyone actually needs this before implementing it.
-			name:     "cleanupIntervalSeconds",
-			accessor: func(c UserConfig) int { return c.CleanupIntervalSeconds },
+			// the segment of a vector is derived from the number of segments
+			name:     "segments",
+			accessor: func(c UserConfig) int { return c.Segments },
 		},
 	}
 
@@ -54,6 +52,38 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	// the graph was built based on the distances in the initial metric
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from %q to %q",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	// the token vectors are only indexed if multiVector was enabled from the
+	// start
+	if initialParsed.MultiVector != updatedParsed.MultiVector {
+		return errors.Errorf("multiVector is immutable: attempted change from %t to %t",
+			initialParsed.MultiVector, updatedParsed.MultiVector)
+	}
+
+	// the sparse postings are only written if sparse was enabled from the
+	// start
+	if initialParsed.Sparse != updatedParsed.Sparse {
+		return errors.Errorf("sparse is immutable: attempted change from %t to %t",
+			initialParsed.Sparse, updatedParsed.Sparse)
+	}
+
+	// the vectors in the index were packed and compared as binary vectors
+	if initialParsed.Binary != updatedParsed.Binary {
+		return errors.Errorf("binary is immutable: attempted change from %t to %t",
+			initialParsed.Binary, updatedParsed.Binary)
+	}
+
+	// the vectors in the index were projected with the initial settings, any
+	// new vectors need to end up in the same space
+	if !initialParsed.Projection.Equal(updatedParsed.Projection) {
+		return errors.Errorf("projection is immutable")
+	}
+
 	return nil
 }
 
@@ -86,6 +116,7 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig) error {
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
 	h.cache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
+	h.updateCleanupInterval(time.Duration(parsed.CleanupIntervalSeconds) * time.Second)
 
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,12 +48,73 @@ func TestUserConfigUpdates(t *testing.T) {
 						"attempted change from \"10\" to \"15\""),
 			},
 			{
-				name:    "attempting to change cleanup interval seconds",
+				name:    "changing cleanup interval seconds",
 				initial: UserConfig{CleanupIntervalSeconds: 60},
 				update:  UserConfig{CleanupIntervalSeconds: 90},
+			},
+			{
+				name:    "attempting to change the number of segments",
+				initial: UserConfig{Segments: 1},
+				update:  UserConfig{Segments: 4},
+				expectedError: errors.Errorf(
+					"segments is immutable: " +
+						"attempted change from \"1\" to \"4\""),
+			},
+			{
+				name:    "attempting to change the distance",
+				initial: UserConfig{Distance: "cosine"},
+				update:  UserConfig{Distance: "l2-squared"},
+				expectedError: errors.Errorf(
+					"distance is immutable: " +
+						"attempted change from \"cosine\" to \"l2-squared\""),
+			},
+			{
+				name:    "attempting to enable multi vectors",
+				initial: UserConfig{},
+				update:  UserConfig{MultiVector: true},
+				expectedError: errors.Errorf(
+					"multiVector is immutable: attempted change from false to true"),
+			},
+			{
+				name:    "attempting to disable sparse vectors",
+				initial: UserConfig{Sparse: true},
+				update:  UserConfig{},
 				expectedError: errors.Errorf(
-					"cleanupIntervalSeconds is immutable: " +
-						"attempted change from \"60\" to \"90\""),
+					"sparse is immutable: attempted change from true to false"),
+			},
+			{
+				name:    "attempting to enable binary vectors",
+				initial: UserConfig{},
+				update:  UserConfig{Binary: true},
+				expectedError: errors.Errorf(
+					"binary is immutable: attempted change from false to true"),
+			},
+			{
+				name:    "attempting to add a projection",
+				initial: UserConfig{},
+				update: UserConfig{Projection: &vectorizer.Projection{
+					Type: "random", InputDimensions: 8, Dimensions: 4,
+				}},
+				expectedError: errors.Errorf("projection is immutable"),
+			},
+			{
+				name: "attempting to change the projection seed",
+				initial: UserConfig{Projection: &vectorizer.Projection{
+					Type: "random", InputDimensions: 8, Dimensions: 4, Seed: 1,
+				}},
+				update: UserConfig{Projection: &vectorizer.Projection{
+					Type: "random", InputDimensions: 8, Dimensions: 4, Seed: 2,
+				}},
+				expectedError: errors.Errorf("projection is immutable"),
+			},
+			{
+				name: "keeping the same projection",
+				initial: UserConfig{Projection: &vectorizer.Projection{
+					Type: "random", InputDimensions: 8, Dimensions: 4, Seed: 1,
+				}},
+				update: UserConfig{Projection: &vectorizer.Projection{
+					Type: "random", InputDimensions: 8, Dimensions: 4, Seed: 1,
+				}},
 			},
 		}
 
@@ -17,6 +17,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 )
 
@@ -69,7 +70,9 @@ func (h *hnsw) reset() error {
 	h.entryPointID = 0
 	h.currentMaximumLayer = 0
 	h.initialInsertOnce = &sync.Once{}
+	h.nodeLocks.LockAll()
 	h.nodes = make([]*vertex, initialSize)
+	h.nodeLocks.UnlockAll()
 
 	return h.commitLog.Reset()
 }
@@ -88,8 +91,8 @@ func (h *hnsw) tombstonesAsDenyList() helpers.AllowList {
 }
 
 func (h *hnsw) getEntrypoint() uint64 {
-	h.Lock()
-	defer h.Unlock()
+	h.RLock()
+	defer h.RUnlock()
 
 	return h.entryPointID
 }
@@ -99,7 +102,7 @@ func (h *hnsw) copyTombstonesToAllowList() helpers.AllowList {
 	defer h.tombstoneLock.Unlock()
 
 	deleteList := helpers.AllowList{}
-	lenOfNodes := uint64(len(h.nodes))
+	lenOfNodes := uint64(h.nodesLen())
 
 	for id := range h.tombstones {
 		if lenOfNodes <= id {
@@ -113,9 +116,23 @@ func (h *hnsw) copyTombstonesToAllowList() helpers.AllowList {
 	return deleteList
 }
 
+// TombstoneCount returns the number of deleted nodes which are still part of
+// the graph, as they have not been cleaned up yet
+func (h *hnsw) TombstoneCount() int {
+	h.tombstoneLock.RLock()
+	defer h.tombstoneLock.RUnlock()
+
+	return len(h.tombstones)
+}
+
 // CleanUpTombstonedNodes removes nodes with a tombstone and reassignes edges
-// that were previously pointing to the tombstoned nodes
+// that were previously pointing to the tombstoned nodes. It is called
+// periodically according to cleanupIntervalSeconds, but can also be triggered
+// manually. Concurrent calls run one after the other.
 func (h *hnsw) CleanUpTombstonedNodes() error {
+	h.cleanupLock.Lock()
+	defer h.cleanupLock.Unlock()
+
 	deleteList := h.copyTombstonesToAllowList()
 	if len(deleteList) == 0 {
 		return nil
@@ -133,9 +150,7 @@ func (h *hnsw) CleanUpTombstonedNodes() error {
 			// level, we need to find an entyrpoint on a lower level
 			// 2. there is a risk that this is the only node in the entire graph. In
 			// this case we must reset the graph
-			h.Lock()
-			node := h.nodes[id]
-			h.Unlock()
+			node := h.nodeByID(id)
 			if err := h.deleteEntrypoint(node, deleteList); err != nil {
 				return errors.Wrap(err, "delete entrypoint")
 			}
@@ -143,8 +158,9 @@ func (h *hnsw) CleanUpTombstonedNodes() error {
 	}
 
 	for id := range deleteList {
+		h.setNode(id, nil)
+
 		h.tombstoneLock.Lock()
-		h.nodes[id] = nil
 		delete(h.tombstones, id)
 		h.tombstoneLock.Unlock()
 
@@ -167,16 +183,14 @@ func (h *hnsw) CleanUpTombstonedNodes() error {
 }
 
 func (h *hnsw) reassignNeighborsOf(deleteList helpers.AllowList) error {
-	h.Lock()
-	size := len(h.nodes)
+	size := h.nodesLen()
+	h.RLock()
 	currentEntrypoint := h.entryPointID
-	h.Unlock()
+	h.RUnlock()
 
 	for n := 0; n < size; n++ {
 		neighbor := uint64(n)
-		h.Lock()
-		neighborNode := h.nodes[neighbor]
-		h.Unlock()
+		neighborNode := h.nodeByID(neighbor)
 
 		if neighborNode == nil || deleteList.Contains(neighborNode.id) {
 			continue
@@ -193,10 +207,18 @@ func (h *hnsw) reassignNeighborsOf(deleteList helpers.AllowList) error {
 				return errors.Wrap(err, "get neighbor vec")
 			}
 		}
-		neighborNode.Lock()
+
+		// the cleanup visits every node of the graph, vectors which are not
+		// cached have to be read from disk, so each one is charged against the
+		// budget of background work
+		if err := h.throttle.Wait(context.Background(), iothrottle.PriorityCleanup,
+			4*len(neighborVec)); err != nil {
+			return errors.Wrap(err, "wait for io budget")
+		}
+		neighborNode.RLock()
 		neighborLevel := neighborNode.level
 		connections := neighborNode.connections
-		neighborNode.Unlock()
+		neighborNode.RUnlock()
 
 		if !connectionsPointTo(connections, deleteList) {
 			// nothing needs to be changed, skip
@@ -276,10 +298,10 @@ func (h *hnsw) deleteEntrypoint(node *vertex, denyList helpers.AllowList) error
 		return nil
 	}
 
-	node.Lock()
+	node.RLock()
 	level := node.level
 	id := node.id
-	node.Unlock()
+	node.RUnlock()
 
 	newEntrypoint, level, ok := h.findNewGlobalEntrypoint(denyList, level, id)
 	if !ok {
@@ -312,9 +334,7 @@ func (h *hnsw) findNewGlobalEntrypoint(denyList helpers.AllowList, targetLevel i
 		// that level, in that case we need to look at the next lower level for a
 		// better candidate
 
-		h.Lock()
-		maxNodes := len(h.nodes)
-		h.Unlock()
+		maxNodes := h.nodesLen()
 
 		for i := 0; i < maxNodes; i++ {
 			if h.getEntrypoint() != oldEntrypoint {
@@ -326,17 +346,15 @@ func (h *hnsw) findNewGlobalEntrypoint(denyList helpers.AllowList, targetLevel i
 			if denyList.Contains(uint64(i)) {
 				continue
 			}
-			h.Lock()
-			candidate := h.nodes[i]
-			h.Unlock()
+			candidate := h.nodeByID(uint64(i))
 
 			if candidate == nil {
 				continue
 			}
 
-			candidate.Lock()
+			candidate.RLock()
 			candidateLevel := candidate.level
-			candidate.Unlock()
+			candidate.RUnlock()
 
 			if candidateLevel != l {
 				// not reaching up to the current level, skip in hope of finding another candidate
@@ -365,9 +383,7 @@ func (h *hnsw) findNewLocalEntrypoint(denyList helpers.AllowList, targetLevel in
 		return h.getEntrypoint(), h.currentMaximumLayer
 	}
 
-	h.Lock()
-	maxNodes := len(h.nodes)
-	h.Unlock()
+	maxNodes := h.nodesLen()
 
 	for l := targetLevel; l >= 0; l-- {
 		// ideally we can find a new entrypoint at the same level of the
@@ -378,17 +394,15 @@ func (h *hnsw) findNewLocalEntrypoint(denyList helpers.AllowList, targetLevel in
 			if denyList.Contains(uint64(i)) {
 				continue
 			}
-			h.Lock()
-			candidate := h.nodes[i]
-			h.Unlock()
+			candidate := h.nodeByID(uint64(i))
 
 			if candidate == nil {
 				continue
 			}
 
-			candidate.Lock()
+			candidate.RLock()
 			candidateLevel := candidate.level
-			candidate.Unlock()
+			candidate.RUnlock()
 
 			if candidateLevel != l {
 				// not reaching up to the current level, skip in hope of finding another candidate
@@ -404,8 +418,8 @@ func (h *hnsw) findNewLocalEntrypoint(denyList helpers.AllowList, targetLevel in
 }
 
 func (h *hnsw) isOnlyNode(needle *vertex, denyList helpers.AllowList) bool {
-	h.Lock()
-	defer h.Unlock()
+	h.nodeLocks.RLockAll()
+	defer h.nodeLocks.RUnlockAll()
 
 	for _, node := range h.nodes {
 		if node == nil || node.id == needle.id || denyList.Contains(node.id) {
@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistancers(t *testing.T) {
+	// 5 dimensions, so both the unrolled loop and the tail are covered
+	a := []float32{1, 2, 3, 4, 5}
+	b := []float32{2, 2, 1, 4, 9}
+
+	type test struct {
+		metric   string
+		expected float32
+	}
+
+	tests := []test{
+		{metric: MetricDot, expected: -(2 + 4 + 3 + 16 + 45)},
+		{metric: MetricL2Squared, expected: 1 + 0 + 4 + 0 + 16},
+		{metric: MetricManhattan, expected: 1 + 0 + 2 + 0 + 4},
+		{metric: MetricHamming, expected: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.metric, func(t *testing.T) {
+			provider, err := ProviderForMetric(test.metric)
+			require.Nil(t, err)
+			assert.Equal(t, test.metric, provider.Type())
+
+			dist, ok, err := provider.SingleDist(a, b)
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, dist)
+
+			dist, ok, err = provider.New(a).Distance(b)
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, dist)
+
+			_, _, err = provider.SingleDist(a, b[:4])
+			assert.NotNil(t, err)
+			_, _, err = provider.New(a).Distance(b[:4])
+			assert.NotNil(t, err)
+		})
+	}
+
+	t.Run("cosine", func(t *testing.T) {
+		provider, err := ProviderForMetric(MetricCosine)
+		require.Nil(t, err)
+		assert.Equal(t, "cosine-dot", provider.Type())
+
+		dist, _, err := provider.SingleDist(Normalize(a), Normalize(a))
+		require.Nil(t, err)
+		assert.InDelta(t, 0, dist, 0.00001)
+	})
+
+	t.Run("unsupported metric", func(t *testing.T) {
+		_, err := ProviderForMetric("chebyshev")
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// DotDistance is the negative dot product of two vectors. Contrary to
+// DotProduct the vectors are not expected to be normalized, so the magnitude
+// of a vector is taken into account.
+type DotDistance struct {
+	a []float32
+}
+
+func (d *DotDistance) Distance(b []float32) (float32, bool, error) {
+	if len(d.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(d.a), len(b))
+	}
+
+	return -dotProductImplementation(d.a, b), true, nil
+}
+
+type DotDistanceProvider struct{}
+
+func NewDotDistanceProvider() DotDistanceProvider {
+	return DotDistanceProvider{}
+}
+
+func (d DotDistanceProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return -dotProductImplementation(a, b), true, nil
+}
+
+func (d DotDistanceProvider) Type() string {
+	return MetricDot
+}
+
+func (d DotDistanceProvider) New(a []float32) Distancer {
+	return &DotDistance{a: a}
+}
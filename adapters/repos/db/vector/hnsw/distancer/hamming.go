@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// hammingImplementation can be replaced depending on the architecture, see
+// l2SquaredImplementation for why the default is unrolled
+var hammingImplementation func(a, b []float32) float32 = HammingGo
+
+// HammingGo counts the positions in which the two vectors differ
+func HammingGo(a, b []float32) float32 {
+	var sum0, sum1, sum2, sum3 float32
+
+	i := 0
+	for ; i+4 <= len(a); i += 4 {
+		sum0 += differs(a[i], b[i])
+		sum1 += differs(a[i+1], b[i+1])
+		sum2 += differs(a[i+2], b[i+2])
+		sum3 += differs(a[i+3], b[i+3])
+	}
+
+	for ; i < len(a); i++ {
+		sum0 += differs(a[i], b[i])
+	}
+
+	return sum0 + sum1 + sum2 + sum3
+}
+
+func differs(a, b float32) float32 {
+	if a != b {
+		return 1
+	}
+	return 0
+}
+
+type Hamming struct {
+	a []float32
+}
+
+func (h *Hamming) Distance(b []float32) (float32, bool, error) {
+	if len(h.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(h.a), len(b))
+	}
+
+	return hammingImplementation(h.a, b), true, nil
+}
+
+type HammingProvider struct{}
+
+func NewHammingProvider() HammingProvider {
+	return HammingProvider{}
+}
+
+func (h HammingProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return hammingImplementation(a, b), true, nil
+}
+
+func (h HammingProvider) Type() string {
+	return MetricHamming
+}
+
+func (h HammingProvider) New(a []float32) Distancer {
+	return &Hamming{a: a}
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+// binaryWordSize is the number of dimensions of a binary vector which are
+// packed into a single float32
+const binaryWordSize = 32
+
+// PackBinary packs a binary vector, i.e. one consisting of zeros and ones, so
+// that every float32 of the result holds the bits of 32 dimensions. Every
+// non-zero dimension is treated as a one. Packed vectors must only be compared
+// with the HammingBinary distancer, their values are not meaningful floats.
+func PackBinary(v []float32) []float32 {
+	out := make([]float32, (len(v)+binaryWordSize-1)/binaryWordSize)
+	var word uint32
+	for i := range v {
+		if v[i] != 0 {
+			word |= 1 << (uint(i) % binaryWordSize)
+		}
+
+		if i%binaryWordSize == binaryWordSize-1 || i == len(v)-1 {
+			out[i/binaryWordSize] = math.Float32frombits(word)
+			word = 0
+		}
+	}
+
+	return out
+}
+
+// HammingBinaryGo counts the bits in which the two packed vectors differ,
+// which is the hamming distance of the binary vectors they were packed from
+func HammingBinaryGo(a, b []float32) float32 {
+	var sum int
+	for i := range a {
+		sum += bits.OnesCount32(math.Float32bits(a[i]) ^ math.Float32bits(b[i]))
+	}
+
+	return float32(sum)
+}
+
+type HammingBinary struct {
+	a []float32
+}
+
+func (h *HammingBinary) Distance(b []float32) (float32, bool, error) {
+	if len(h.a) != len(b) {
+		return 0, false, errors.Errorf("packed vector lengths don't match: %d vs %d",
+			len(h.a), len(b))
+	}
+
+	return HammingBinaryGo(h.a, b), true, nil
+}
+
+// HammingBinaryProvider compares vectors packed by PackBinary, it is used
+// instead of the HammingProvider for indexes of binary vectors. The index
+// must pack vectors if the provider's type is "hamming-binary".
+type HammingBinaryProvider struct{}
+
+func NewHammingBinaryProvider() HammingBinaryProvider {
+	return HammingBinaryProvider{}
+}
+
+func (h HammingBinaryProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("packed vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return HammingBinaryGo(a, b), true, nil
+}
+
+func (h HammingBinaryProvider) Type() string {
+	return "hamming-binary"
+}
+
+func (h HammingBinaryProvider) New(a []float32) Distancer {
+	return &HammingBinary{a: a}
+}
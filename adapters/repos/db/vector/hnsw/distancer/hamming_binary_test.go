@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHammingBinaryDistance(t *testing.T) {
+	t.Run("packing", func(t *testing.T) {
+		assert.Len(t, PackBinary(make([]float32, 32)), 1)
+		assert.Len(t, PackBinary(make([]float32, 33)), 2)
+		assert.Len(t, PackBinary(make([]float32, 768)), 24)
+	})
+
+	t.Run("matches the hamming distance of the unpacked vectors", func(t *testing.T) {
+		for _, dims := range []int{1, 7, 32, 33, 100, 768} {
+			a := randomBinaryVector(dims)
+			b := randomBinaryVector(dims)
+
+			expected := HammingGo(a, b)
+			dist, ok, err := NewHammingBinaryProvider().New(PackBinary(a)).
+				Distance(PackBinary(b))
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.Equal(t, expected, dist, "dims %d", dims)
+
+			dist, _, err = NewHammingBinaryProvider().SingleDist(PackBinary(a),
+				PackBinary(b))
+			require.Nil(t, err)
+			assert.Equal(t, expected, dist, "dims %d", dims)
+		}
+	})
+
+	t.Run("with mismatching lengths", func(t *testing.T) {
+		_, _, err := NewHammingBinaryProvider().SingleDist(make([]float32, 1),
+			make([]float32, 2))
+		assert.NotNil(t, err)
+	})
+}
+
+func randomBinaryVector(dims int) []float32 {
+	out := make([]float32, dims)
+	for i := range out {
+		out[i] = float32(rand.Intn(2))
+	}
+	return out
+}
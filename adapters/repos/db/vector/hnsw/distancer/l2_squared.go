@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// l2SquaredImplementation can be replaced depending on the architecture, the
+// default uses four independent accumulators which keeps the loop free of
+// dependencies between iterations, so the compiler and CPU can pipeline it
+var l2SquaredImplementation func(a, b []float32) float32 = L2SquaredGo
+
+func L2SquaredGo(a, b []float32) float32 {
+	var sum0, sum1, sum2, sum3 float32
+
+	i := 0
+	for ; i+4 <= len(a); i += 4 {
+		d0 := a[i] - b[i]
+		d1 := a[i+1] - b[i+1]
+		d2 := a[i+2] - b[i+2]
+		d3 := a[i+3] - b[i+3]
+		sum0 += d0 * d0
+		sum1 += d1 * d1
+		sum2 += d2 * d2
+		sum3 += d3 * d3
+	}
+
+	for ; i < len(a); i++ {
+		d := a[i] - b[i]
+		sum0 += d * d
+	}
+
+	return sum0 + sum1 + sum2 + sum3
+}
+
+type L2Squared struct {
+	a []float32
+}
+
+func (l *L2Squared) Distance(b []float32) (float32, bool, error) {
+	if len(l.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(l.a), len(b))
+	}
+
+	return l2SquaredImplementation(l.a, b), true, nil
+}
+
+type L2SquaredProvider struct{}
+
+func NewL2SquaredProvider() L2SquaredProvider {
+	return L2SquaredProvider{}
+}
+
+func (l L2SquaredProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return l2SquaredImplementation(a, b), true, nil
+}
+
+func (l L2SquaredProvider) Type() string {
+	return MetricL2Squared
+}
+
+func (l L2SquaredProvider) New(a []float32) Distancer {
+	return &L2Squared{a: a}
+}
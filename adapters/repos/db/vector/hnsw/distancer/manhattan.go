@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// manhattanImplementation can be replaced depending on the architecture, see
+// l2SquaredImplementation for why the default is unrolled
+var manhattanImplementation func(a, b []float32) float32 = ManhattanGo
+
+func ManhattanGo(a, b []float32) float32 {
+	var sum0, sum1, sum2, sum3 float32
+
+	i := 0
+	for ; i+4 <= len(a); i += 4 {
+		sum0 += abs(a[i] - b[i])
+		sum1 += abs(a[i+1] - b[i+1])
+		sum2 += abs(a[i+2] - b[i+2])
+		sum3 += abs(a[i+3] - b[i+3])
+	}
+
+	for ; i < len(a); i++ {
+		sum0 += abs(a[i] - b[i])
+	}
+
+	return sum0 + sum1 + sum2 + sum3
+}
+
+// abs is small enough to be inlined and compiles to a branch-free sequence,
+// as opposed to converting to float64 for math.Abs
+func abs(f float32) float32 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+type Manhattan struct {
+	a []float32
+}
+
+func (m *Manhattan) Distance(b []float32) (float32, bool, error) {
+	if len(m.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(m.a), len(b))
+	}
+
+	return manhattanImplementation(m.a, b), true, nil
+}
+
+type ManhattanProvider struct{}
+
+func NewManhattanProvider() ManhattanProvider {
+	return ManhattanProvider{}
+}
+
+func (m ManhattanProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return manhattanImplementation(a, b), true, nil
+}
+
+func (m ManhattanProvider) Type() string {
+	return MetricManhattan
+}
+
+func (m ManhattanProvider) New(a []float32) Distancer {
+	return &Manhattan{a: a}
+}
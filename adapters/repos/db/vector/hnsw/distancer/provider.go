@@ -11,6 +11,17 @@
 
 package distancer
 
+import "github.com/pkg/errors"
+
+// Distance metrics a user can pick for a vector index
+const (
+	MetricCosine    = "cosine"
+	MetricDot       = "dot"
+	MetricL2Squared = "l2-squared"
+	MetricManhattan = "manhattan"
+	MetricHamming   = "hamming"
+)
+
 type Provider interface {
 	New(vec []float32) Distancer
 	SingleDist(vec1, vec2 []float32) (float32, bool, error)
@@ -20,3 +31,25 @@ type Provider interface {
 type Distancer interface {
 	Distance(vec []float32) (float32, bool, error)
 }
+
+// ProviderForMetric returns the provider for the specified distance metric.
+// Cosine is calculated as the dot product of normalized vectors, so the index
+// must normalize vectors if the provider's type is "cosine-dot".
+func ProviderForMetric(metric string) (Provider, error) {
+	switch metric {
+	case MetricCosine:
+		return NewDotProductProvider(), nil
+	case MetricDot:
+		return NewDotDistanceProvider(), nil
+	case MetricL2Squared:
+		return NewL2SquaredProvider(), nil
+	case MetricManhattan:
+		return NewManhattanProvider(), nil
+	case MetricHamming:
+		return NewHammingProvider(), nil
+	default:
+		return nil, errors.Errorf("unsupported distance metric %q, must be one of "+
+			"%q, %q, %q, %q or %q", metric, MetricCosine, MetricDot, MetricL2Squared,
+			MetricManhattan, MetricHamming)
+	}
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilteredSearch_SatisfiesLimit(t *testing.T) {
+	// every tenth vector lives in a cluster far away from the query, all others
+	// are close to it. Filtering on the far cluster means the graph search has
+	// to traverse through many disallowed nodes before finding matches.
+	r := rand.New(rand.NewSource(11))
+	vectors := make([][]float32, 1000)
+	allowList := helpers.AllowList{}
+	for i := range vectors {
+		offset := float32(0)
+		if i%10 == 0 {
+			offset = 10
+			allowList.Insert(uint64(i))
+		}
+
+		vec := make([]float32, 16)
+		for j := range vec {
+			vec[j] = offset + r.Float32()
+		}
+		vectors[i] = vec
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "filtered-search-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, UserConfig{
+		MaxConnections: 16,
+		EFConstruction: 64,
+	})
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	query := make([]float32, 16)
+	for j := range query {
+		query[j] = 0.5
+	}
+
+	t.Run("graph search only", func(t *testing.T) {
+		index.forbidFlat = true
+		defer func() { index.forbidFlat = false }()
+
+		res, _, err := index.SearchByVector(query, 50, allowList)
+		require.Nil(t, err)
+		assert.Len(t, res, 50)
+		for _, id := range res {
+			assert.True(t, allowList.Contains(id), "result %d not in allow list", id)
+		}
+	})
+
+	t.Run("limit larger than the number of matches", func(t *testing.T) {
+		res, _, err := index.SearchByVector(query, 200, allowList)
+		require.Nil(t, err)
+		assert.Len(t, res, len(allowList))
+		for _, id := range res {
+			assert.True(t, allowList.Contains(id), "result %d not in allow list", id)
+		}
+	})
+}
@@ -21,13 +21,9 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 	results := priorityqueue.NewMax(limit)
 
 	for candidate := range allowList {
-		h.Lock()
-		c := h.nodes[candidate]
-		if c == nil || h.hasTombstone(candidate) {
-			h.Unlock()
+		if h.nodeByID(candidate) == nil || h.hasTombstone(candidate) {
 			continue
 		}
-		h.Unlock()
 		dist, ok, err := h.distBetweenNodeAndVec(candidate, queryVector)
 		if err != nil {
 			return nil, nil, err
@@ -16,10 +16,13 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 	"github.com/semi-technologies/weaviate/entities/storobj"
@@ -27,8 +30,16 @@ import (
 )
 
 type hnsw struct {
-	// global lock to prevent concurrent map read/write, etc.
-	sync.Mutex
+	// global lock for the entrypoint, the maximum layer and the visited lists
+	// pool. Reading them only requires a read lock, the write lock is only
+	// taken when the index grows or a node becomes the new entrypoint. The
+	// nodes list is guarded by nodeLocks and individual nodes by their own
+	// locks.
+	sync.RWMutex
+
+	// striped locks for the slots of the nodes list, see nodeByID and setNode.
+	// Replacing the list takes the global lock first and then all stripes.
+	nodeLocks nodeLocks
 
 	// certain operations related to deleting, such as finding a new entrypoint
 	// can only run sequentially, this separate lock helps assuring this without
@@ -96,7 +107,23 @@ type hnsw struct {
 	logger            logrus.FieldLogger
 	distancerProvider distancer.Provider
 
-	cleanupInterval time.Duration
+	// cleanupInterval is the time.Duration between two periodic tombstone
+	// cleanups, 0 disables them. It can be changed at runtime, so it is
+	// accessed atomically and every change is signalled on
+	// cleanupIntervalChanged
+	cleanupInterval        int64
+	cleanupIntervalChanged chan struct{}
+
+	// cleanups can be triggered manually while a periodic one is running, this
+	// lock makes sure they run one after the other
+	cleanupLock *sync.Mutex
+
+	// throttle limits the rate at which the tombstone cleanup reads vectors,
+	// it may be nil
+	throttle *iothrottle.Throttle
+
+	// cipher decrypts encrypted commit logs when restoring from disk
+	cipher *encryption.Cipher
 
 	pools *pools
 
@@ -116,6 +143,10 @@ type CommitLogger interface {
 	Reset() error
 	Drop() error
 	Flush() error
+	PauseMaintenance()
+	ResumeMaintenance()
+	SwitchCommitLogs() error
+	ListFiles() ([]string, error)
 }
 
 type BufferedLinksLogger interface {
@@ -150,8 +181,13 @@ func New(cfg Config, uc UserConfig) (*hnsw, error) {
 		normalizeOnRead = true
 	}
 
+	packOnRead := false
+	if cfg.DistanceProvider.Type() == "hamming-binary" {
+		packOnRead = true
+	}
+
 	vectorCache := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead)
+		cfg.Logger, normalizeOnRead, packOnRead)
 
 	index := &hnsw{
 		maximumConnections: uc.MaxConnections,
@@ -160,23 +196,27 @@ func New(cfg Config, uc UserConfig) (*hnsw, error) {
 		maximumConnectionsLayerZero: 2 * uc.MaxConnections,
 
 		// inspired by c++ implementation
-		levelNormalizer:   1 / math.Log(float64(uc.MaxConnections)),
-		efConstruction:    uc.EFConstruction,
-		ef:                int64(uc.EF),
-		flatSearchCutoff:  int64(uc.FlatSearchCutoff),
-		nodes:             make([]*vertex, initialSize),
-		cache:             vectorCache,
-		vectorForID:       vectorCache.get,
-		id:                cfg.ID,
-		rootPath:          cfg.RootPath,
-		tombstones:        map[uint64]struct{}{},
-		logger:            cfg.Logger,
-		distancerProvider: cfg.DistanceProvider,
-		cancel:            make(chan struct{}),
-		deleteLock:        &sync.Mutex{},
-		tombstoneLock:     &sync.RWMutex{},
-		initialInsertOnce: &sync.Once{},
-		cleanupInterval:   time.Duration(uc.CleanupIntervalSeconds) * time.Second,
+		levelNormalizer:        1 / math.Log(float64(uc.MaxConnections)),
+		efConstruction:         uc.EFConstruction,
+		ef:                     int64(uc.EF),
+		flatSearchCutoff:       int64(uc.FlatSearchCutoff),
+		nodes:                  make([]*vertex, initialSize),
+		cache:                  vectorCache,
+		vectorForID:            vectorCache.get,
+		id:                     cfg.ID,
+		rootPath:               cfg.RootPath,
+		tombstones:             map[uint64]struct{}{},
+		logger:                 cfg.Logger,
+		distancerProvider:      cfg.DistanceProvider,
+		cancel:                 make(chan struct{}),
+		deleteLock:             &sync.Mutex{},
+		tombstoneLock:          &sync.RWMutex{},
+		initialInsertOnce:      &sync.Once{},
+		cleanupInterval:        int64(time.Duration(uc.CleanupIntervalSeconds) * time.Second),
+		cleanupIntervalChanged: make(chan struct{}, 1),
+		cleanupLock:            &sync.Mutex{},
+		throttle:               cfg.IOThrottle,
+		cipher:                 cfg.Encryption,
 	}
 
 	if err := index.init(cfg); err != nil {
@@ -322,9 +362,12 @@ func (h *hnsw) findBestEntrypointForNode(currentMaxLevel, targetLevel int,
 	return entryPointID, nil
 }
 
+// vertex is guarded by its own lock. Searches only read the connections, so
+// they take the read lock, which lets them pass through highly connected
+// nodes concurrently.
 type vertex struct {
 	id uint64
-	sync.Mutex
+	sync.RWMutex
 	level       int
 	connections map[int][]uint64 // map[level][]connectedId
 	maintenance bool
@@ -345,8 +388,8 @@ func (v *vertex) unmarkAsMaintenance() {
 }
 
 func (v *vertex) isUnderMaintenance() bool {
-	v.Lock()
-	defer v.Unlock()
+	v.RLock()
+	defer v.RUnlock()
 
 	return v.maintenance
 }
@@ -455,6 +498,9 @@ func (h *hnsw) Stats() {
 
 	perLevelCount := map[int]uint{}
 
+	h.nodeLocks.RLockAll()
+	defer h.nodeLocks.RUnlockAll()
+
 	for _, node := range h.nodes {
 		if node == nil {
 			continue
@@ -478,8 +524,8 @@ func (h *hnsw) Stats() {
 }
 
 func (h *hnsw) isEmpty() bool {
-	h.Lock()
-	defer h.Unlock()
+	h.nodeLocks.RLockAll()
+	defer h.nodeLocks.RUnlockAll()
 
 	for _, node := range h.nodes {
 		if node != nil {
@@ -490,19 +536,50 @@ func (h *hnsw) isEmpty() bool {
 	return true
 }
 
+// nodeByID returns nil if there is no node with the id, including if the id
+// is outside the nodes list, e.g. because the graph was reset in the meantime
 func (h *hnsw) nodeByID(id uint64) *vertex {
-	h.Lock()
-	defer h.Unlock()
+	h.nodeLocks.RLock(id)
+	defer h.nodeLocks.RUnlock(id)
+
+	if id >= uint64(len(h.nodes)) {
+		return nil
+	}
 
 	return h.nodes[id]
 }
 
+// setNode sets the slot of the id, which must fit in the nodes list, see
+// growIndexIfRequired
+func (h *hnsw) setNode(id uint64, node *vertex) {
+	h.nodeLocks.Lock(id)
+	defer h.nodeLocks.Unlock(id)
+
+	h.nodes[id] = node
+}
+
+// nodesLen is the current length of the nodes list. The list is only ever
+// replaced with all stripes locked, so holding any one of them is enough.
+func (h *hnsw) nodesLen() int {
+	h.nodeLocks.RLock(0)
+	defer h.nodeLocks.RUnlock(0)
+
+	return len(h.nodes)
+}
+
 func (h *hnsw) Drop() error {
 	// cancel commit log goroutine
 	err := h.commitLog.Drop()
 	if err != nil {
 		return errors.Wrap(err, "commit log drop")
 	}
+
+	snapshot := snapshotFileName(h.rootPath, h.id)
+	for _, path := range []string{snapshot, snapshot + ".tmp"} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "delete snapshot")
+		}
+	}
 	// cancel vector cache goroutine
 	h.cache.drop()
 	// cancel tombstone cleanup goroutine
@@ -514,9 +591,60 @@ func (h *hnsw) Flush() error {
 	return h.commitLog.Flush()
 }
 
+// PauseMaintenance pauses the background maintenance of the commit logs, so
+// that the files returned by ListFiles remain unchanged until
+// ResumeMaintenance is called
+func (h *hnsw) PauseMaintenance() {
+	h.commitLog.PauseMaintenance()
+}
+
+func (h *hnsw) ResumeMaintenance() {
+	h.commitLog.ResumeMaintenance()
+}
+
+// SwitchCommitLogs makes sure all changes so far are contained in commit log
+// files which are no longer written to
+func (h *hnsw) SwitchCommitLogs() error {
+	return h.commitLog.SwitchCommitLogs()
+}
+
+// ListFiles returns the snapshot, if there is one, and all completed commit
+// log files of this index
+func (h *hnsw) ListFiles() ([]string, error) {
+	files, err := h.commitLog.ListFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := snapshotFileName(h.rootPath, h.id)
+	if _, err := os.Stat(snapshot); err == nil {
+		files = append([]string{snapshot}, files...)
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "stat snapshot")
+	}
+
+	return files, nil
+}
+
+type commitLogStatser interface {
+	Stats() CommitLogStats
+}
+
+// CommitLogStats reports the size of the commit logs which have to be read to
+// restore the index on startup and how much they were condensed so far. A
+// commit logger without files, such as the noop logger, reports nothing.
+func (h *hnsw) CommitLogStats() CommitLogStats {
+	statser, ok := h.commitLog.(commitLogStatser)
+	if !ok {
+		return CommitLogStats{}
+	}
+
+	return statser.Stats()
+}
+
 func (h *hnsw) Entrypoint() uint64 {
-	h.Lock()
-	defer h.Unlock()
+	h.RLock()
+	defer h.RUnlock()
 
 	return h.entryPointID
 }
@@ -34,6 +34,11 @@ func (h *hnsw) Add(id uint64, vector []float32) error {
 		vector = distancer.Normalize(vector)
 	}
 
+	if h.distancerProvider.Type() == "hamming-binary" {
+		// the vectors of a binary index are compared in their packed form
+		vector = distancer.PackBinary(vector)
+	}
+
 	return h.insert(node, vector)
 }
 
@@ -55,7 +60,7 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 		return err
 	}
 
-	h.nodes[node.id] = node
+	h.setNode(node.id, node)
 
 	// go h.insertHook(node.id, 0, node.connections)
 	return nil
@@ -76,14 +81,14 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 
 	node.markAsMaintenance()
 
-	h.Lock()
+	h.RLock()
 	// initially use the "global" entrypoint which is guaranteed to be on the
 	// currently highest layer
 	entryPointID := h.entryPointID
 	// initially use the level of the entrypoint which is the highest level of
 	// the h-graph in the first iteration
 	currentMaximumLayer := h.currentMaximumLayer
-	h.Unlock()
+	h.RUnlock()
 
 	targetLevel := int(math.Floor(-math.Log(rand.Float64()) * h.levelNormalizer))
 
@@ -107,25 +112,17 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 
 	nodeId := node.id
 
-	// before = time.Now()
-	h.Lock()
-	// m.addBuildingLocking(before)
-	err := h.growIndexToAccomodateNode(node.id, h.logger)
-	if err != nil {
-		h.Unlock()
+	if err := h.growIndexIfRequired(node.id); err != nil {
 		return errors.Wrapf(err, "grow HNSW index to accommodate node %d", node.id)
 	}
-	h.Unlock()
 
 	// // make sure this new vec is immediately present in the cache, so we don't
 	// // have to read it from disk again
 	h.cache.preload(node.id, nodeVec)
 
-	h.Lock()
-	h.nodes[nodeId] = node
-	h.Unlock()
+	h.setNode(nodeId, node)
 
-	entryPointID, err = h.findBestEntrypointForNode(currentMaximumLayer, targetLevel,
+	entryPointID, err := h.findBestEntrypointForNode(currentMaximumLayer, targetLevel,
 		entryPointID, nodeVec)
 	if err != nil {
 		return errors.Wrap(err, "find best entrypoint")
@@ -139,19 +136,28 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	// go h.insertHook(nodeId, targetLevel, neighborsAtLevel)
 	node.unmarkAsMaintenance()
 
+	// only very few nodes become the new entrypoint, so the exclusive lock is
+	// only taken if the read lock shows that the node is a candidate
+	h.RLock()
+	isCandidate := targetLevel > h.currentMaximumLayer
+	h.RUnlock()
+	if !isCandidate {
+		return nil
+	}
+
 	h.Lock()
+	defer h.Unlock()
+	// check again, a concurrent insert may have raised the maximum layer since
 	if targetLevel > h.currentMaximumLayer {
 		// before = time.Now()
 		// m.addBuildingLocking(before)
 		if err := h.commitLog.SetEntryPointWithMaxLayer(nodeId, targetLevel); err != nil {
-			h.Unlock()
 			return err
 		}
 
 		h.entryPointID = nodeId
 		h.currentMaximumLayer = targetLevel
 	}
-	h.Unlock()
 
 	return nil
 }
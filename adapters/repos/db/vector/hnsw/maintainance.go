@@ -23,6 +23,23 @@ const (
 	defaultIndexGrowthDelta = 25000
 )
 
+// growIndexIfRequired makes sure the index can hold the specified id. As the
+// index only needs to grow on a small fraction of inserts, it first checks
+// with a single stripe of the node locks, so that concurrent inserts don't
+// serialize on the exclusive locks.
+func (h *hnsw) growIndexIfRequired(id uint64) error {
+	if id < uint64(h.nodesLen()) {
+		return nil
+	}
+
+	h.Lock()
+	defer h.Unlock()
+	h.nodeLocks.LockAll()
+	defer h.nodeLocks.UnlockAll()
+
+	return h.growIndexToAccomodateNode(id, h.logger)
+}
+
 // growIndexToAccomodateNode is a wrapper around the growIndexToAccomodateNode
 // function growing the index of the hnsw struct. It does not do any locking on
 // its own, make sure that this function is called from a single-thread or
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// nodeLockStripes is the number of locks guarding the nodes list. Node ids are
+// assigned sequentially, so with a power of two, concurrent inserts spread
+// evenly across the stripes.
+const nodeLockStripes = 512
+
+// paddedRWMutex takes up an entire cache line, otherwise readers of
+// neighboring stripes would still contend on the same line when they update
+// the reader count.
+type paddedRWMutex struct {
+	sync.RWMutex
+	_ [64 - unsafe.Sizeof(sync.RWMutex{})%64]byte
+}
+
+// nodeLocks guard the slots of the nodes list of the graph. Reading or
+// writing a single slot only takes the stripe lock of its id, so that
+// searches and inserts on different nodes don't all go through one lock.
+// Replacing or iterating the entire list takes all stripes. The locks are
+// always obtained after the lock of the graph itself, never before it. The
+// zero value is ready to use.
+type nodeLocks struct {
+	stripes [nodeLockStripes]paddedRWMutex
+}
+
+func (l *nodeLocks) RLock(id uint64) {
+	l.stripes[id%nodeLockStripes].RLock()
+}
+
+func (l *nodeLocks) RUnlock(id uint64) {
+	l.stripes[id%nodeLockStripes].RUnlock()
+}
+
+func (l *nodeLocks) Lock(id uint64) {
+	l.stripes[id%nodeLockStripes].Lock()
+}
+
+func (l *nodeLocks) Unlock(id uint64) {
+	l.stripes[id%nodeLockStripes].Unlock()
+}
+
+// RLockAll and LockAll obtain the stripes in order, so two of them can't
+// deadlock on each other
+func (l *nodeLocks) RLockAll() {
+	for i := range l.stripes {
+		l.stripes[i].RLock()
+	}
+}
+
+func (l *nodeLocks) RUnlockAll() {
+	for i := range l.stripes {
+		l.stripes[i].RUnlock()
+	}
+}
+
+func (l *nodeLocks) LockAll() {
+	for i := range l.stripes {
+		l.stripes[i].Lock()
+	}
+}
+
+func (l *nodeLocks) UnlockAll() {
+	for i := range l.stripes {
+		l.stripes[i].Unlock()
+	}
+}
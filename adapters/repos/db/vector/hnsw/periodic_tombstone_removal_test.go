@@ -66,3 +66,46 @@ func TestPeriodicTombstoneRemoval(t *testing.T) {
 		}, "wait until tombstones have been cleaned up")
 	})
 }
+
+func TestTombstoneRemovalAfterIntervalUpdate(t *testing.T) {
+	uc := UserConfig{
+		CleanupIntervalSeconds: 0,
+		MaxConnections:         30,
+		EFConstruction:         128,
+	}
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "tombstone-removal-after-interval-update",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineProvider(),
+		VectorForIDThunk:      testVectorForID,
+	}, uc)
+	require.Nil(t, err)
+
+	for i, vec := range testVectors {
+		err := index.Add(uint64(i), vec)
+		require.Nil(t, err)
+	}
+
+	for i := range testVectors {
+		if i%2 != 0 {
+			continue
+		}
+
+		err := index.Delete(uint64(i))
+		require.Nil(t, err)
+	}
+
+	t.Run("verify the tombstones are not cleaned up periodically", func(t *testing.T) {
+		assert.True(t, index.TombstoneCount() > 0)
+	})
+
+	t.Run("enable the periodic cleanup and wait for tombstones to disappear", func(t *testing.T) {
+		uc.CleanupIntervalSeconds = 1
+		require.Nil(t, index.UpdateUserConfig(uc))
+
+		testhelper.AssertEventuallyEqual(t, 0, func() interface{} {
+			return index.TombstoneCount()
+		}, "wait until tombstones have been cleaned up")
+	})
+}
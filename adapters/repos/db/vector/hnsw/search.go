@@ -60,19 +60,38 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 		vector = distancer.Normalize(vector)
 	}
 
+	if h.distancerProvider.Type() == "hamming-binary" {
+		// the vectors of a binary index are compared in their packed form
+		vector = distancer.PackBinary(vector)
+	}
+
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && len(allowList) < flatSearchCutoff {
 		return h.flatSearch(vector, k, allowList)
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	ids, dists, err := h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if allowList != nil && !h.forbidFlat && len(ids) < k && len(ids) < len(allowList) {
+		// the allowed nodes are not all reachable through the graph (e.g. a
+		// filter matching an isolated region, or one that has been cut off by
+		// deletes), so the limit could not be satisfied. Fall back to a flat
+		// search over the allow list which is guaranteed to find all matches.
+		return h.flatSearch(vector, k, allowList)
+	}
+
+	return ids, dists, nil
 }
 
 func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error) {
-	h.Lock()
+	h.RLock()
 	visited := h.pools.visitedLists.Borrow()
-	h.Unlock()
+	h.RUnlock()
 
 	candidates := h.pools.pqCandidates.GetMin(ef)
 	results := h.pools.pqResults.GetMax(ef)
@@ -93,26 +112,34 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 		}
 
 		if !ok {
+			// the candidate was deleted in the underlying object store, drop it so
+			// we don't keep looking at the same top element
+			candidates.Pop()
 			continue
 		}
 
-		if dist > worstResultDistance {
+		// With an allow list (or tombstones) not every visited node makes it into
+		// the results, so the worst result distance alone is not a valid stop
+		// criterion: we would terminate as soon as we reach the first disallowed
+		// neighborhood and return fewer than ef results even though further
+		// matches are reachable. Only stop once the result set is full.
+		if dist > worstResultDistance && results.Len() >= ef {
 			break
 		}
 		candidate := candidates.Pop()
-		candidateNode := h.nodes[candidate.ID]
+		candidateNode := h.nodeByID(candidate.ID)
 		if candidateNode == nil {
 			// could have been a node that already had a tombstone attached and was
 			// just cleaned up while we were waiting for a read lock
 			continue
 		}
 
-		candidateNode.Lock()
+		candidateNode.RLock()
 		connections := make([]uint64, len(candidateNode.connections[level]))
 		for i, conn := range candidateNode.connections[level] {
 			connections[i] = conn
 		}
-		candidateNode.Unlock()
+		candidateNode.RUnlock()
 
 		for _, neighborID := range connections {
 
@@ -168,9 +195,9 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 
 	h.pools.pqCandidates.Put(candidates)
 
-	h.Lock()
+	h.RLock()
 	h.pools.visitedLists.Return(visited)
-	h.Unlock()
+	h.RUnlock()
 
 	// results are passed on, so it's in the callers responsibility to return the
 	// list to the pool after using it
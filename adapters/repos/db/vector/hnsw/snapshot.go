@@ -0,0 +1,452 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/sirupsen/logrus"
+)
+
+// snapshotMagic identifies snapshot files, it is followed by a version byte
+// so that the layout can be changed later on
+var snapshotMagic = []byte("HNSWSNAP")
+
+const snapshotVersion uint8 = 1
+
+// maxSnapshotConnections is far above any sensible maxConnections, it only
+// prevents huge allocations for corrupt files, which are otherwise only
+// detected once the checksum at the end is read
+const maxSnapshotConnections = 1 << 20
+
+func snapshotFileName(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.snapshot", rootPath, name)
+}
+
+// CreateSnapshot writes the complete graph to a single snapshot file and
+// removes the commit logs it replaces. On the next startup the snapshot is
+// loaded directly and only the commit logs written after it are replayed,
+// which makes cold starts of large indexes much faster.
+//
+// The snapshot is built from the previous snapshot and the completed commit
+// logs rather than from the graph in memory, so that it is consistent with
+// the logs which follow it without blocking writes. Building it temporarily
+// needs as much memory as a second copy of the graph.
+func (h *hnsw) CreateSnapshot() error {
+	started := time.Now()
+
+	// condensing or combining logs while the snapshot is built could merge a
+	// log which the snapshot covers with one it does not
+	h.commitLog.PauseMaintenance()
+	defer h.commitLog.ResumeMaintenance()
+
+	if err := h.commitLog.SwitchCommitLogs(); err != nil {
+		return errors.Wrap(err, "switch commit logs")
+	}
+
+	fileNames, err := h.commitLog.ListFiles()
+	if err != nil {
+		return errors.Wrap(err, "list commit logs")
+	}
+
+	if len(fileNames) == 0 {
+		// the previous snapshot is still complete
+		return nil
+	}
+
+	state, _, err := loadSnapshot(h.rootPath, h.id, h.cipher)
+	if err != nil {
+		return errors.Wrap(err, "load previous snapshot")
+	}
+
+	for _, fileName := range fileNames {
+		state, err = deserializeCommitLog(fileName, state, h.cipher, h.logger)
+		if err != nil {
+			return errors.Wrapf(err, "deserialize commit log %q", fileName)
+		}
+	}
+
+	covered, err := asTimeStamp(filepath.Base(fileNames[len(fileNames)-1]))
+	if err != nil {
+		return errors.Wrap(err, "parse time stamp of last commit log")
+	}
+
+	if err := writeSnapshot(snapshotFileName(h.rootPath, h.id), state, covered,
+		h.cipher); err != nil {
+		return errors.Wrap(err, "write snapshot")
+	}
+
+	// the snapshot is complete, if we crash before all logs are removed, the
+	// remaining ones are removed on startup based on the covered time stamp
+	for _, fileName := range fileNames {
+		if err := os.Remove(fileName); err != nil {
+			return errors.Wrapf(err, "remove commit log %q covered by snapshot", fileName)
+		}
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action":       "hnsw_create_snapshot",
+		"id":           h.id,
+		"commit_logs":  len(fileNames),
+		"nodes":        countNodes(state.Nodes),
+		"took":         time.Since(started).String(),
+		"covered_time": covered,
+	}).Info("created snapshot of hnsw index")
+
+	return nil
+}
+
+func deserializeCommitLog(fileName string, state *DeserializationResult,
+	cipher *encryption.Cipher, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	plain, err := encryption.NewReader(fd, cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	// completed logs were repaired on startup and written in full afterwards,
+	// so unlike on startup an incomplete log is an error
+	state, _, err = NewDeserializer2(logger).Do(bufio.NewReaderSize(plain, 256*1024),
+		state, false)
+	return state, err
+}
+
+// removeSnapshottedCommitLogs removes the commit logs up to and including the
+// time stamp covered by the snapshot, which are left over if a crash occurred
+// right after the snapshot was written, and returns the remaining ones
+func removeSnapshottedCommitLogs(fileNames []string, covered int64) ([]string, error) {
+	out := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		if ts > covered {
+			out = append(out, fileName)
+			continue
+		}
+
+		if err := os.Remove(fileName); err != nil {
+			return nil, errors.Wrapf(err, "remove commit log %q covered by snapshot", fileName)
+		}
+	}
+
+	return out, nil
+}
+
+func countNodes(nodes []*vertex) int {
+	count := 0
+	for _, node := range nodes {
+		if node != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// writeSnapshot writes the state to a temporary file first, so that a crash
+// never leaves an incomplete snapshot behind. The layout is:
+//
+//	magic, version, covered time stamp, entrypoint, level,
+//	tombstone count, tombstone ids,
+//	length of the node list, node count, for every node:
+//	  id, level, connection level count, for every connection level:
+//	    level, connection count, connected ids
+//	crc32 checksum of everything before
+func writeSnapshot(path string, state *DeserializationResult, covered int64,
+	cipher *encryption.Cipher) error {
+	tmpPath := path + ".tmp"
+	fd, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	var target io.Writer = fd
+	var encrypted *encryption.Writer
+	if cipher != nil {
+		encrypted, err = encryption.NewWriter(fd, cipher)
+		if err != nil {
+			return err
+		}
+		target = encrypted
+	}
+
+	buf := bufio.NewWriterSize(target, 256*1024)
+	w := newSnapshotWriter(buf)
+	w.write(snapshotMagic)
+	w.write([]byte{snapshotVersion})
+	w.uint64(uint64(covered))
+	w.uint64(state.Entrypoint)
+	w.uint16(state.Level)
+
+	w.uint64(uint64(len(state.Tombstones)))
+	for id := range state.Tombstones {
+		w.uint64(id)
+	}
+
+	w.uint64(uint64(len(state.Nodes)))
+	w.uint64(uint64(countNodes(state.Nodes)))
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+
+		w.uint64(node.id)
+		w.uint16(uint16(node.level))
+		w.uint16(uint16(len(node.connections)))
+		for level, conns := range node.connections {
+			w.uint16(uint16(level))
+			w.uint32(uint32(len(conns)))
+			for _, conn := range conns {
+				w.uint64(conn)
+			}
+		}
+	}
+
+	w.writeChecksum()
+	if w.err != nil {
+		return w.err
+	}
+
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+
+	if encrypted != nil {
+		if err := encrypted.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := fd.Sync(); err != nil {
+		return err
+	}
+
+	if err := fd.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// loadSnapshot returns nil and a covered time stamp of -1 if there is no
+// snapshot
+func loadSnapshot(rootPath, name string,
+	cipher *encryption.Cipher) (*DeserializationResult, int64, error) {
+	fd, err := os.Open(snapshotFileName(rootPath, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, -1, nil
+		}
+		return nil, -1, err
+	}
+	defer fd.Close()
+
+	plain, err := encryption.NewReader(fd, cipher)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return readSnapshot(bufio.NewReaderSize(plain, 256*1024))
+}
+
+func readSnapshot(in io.Reader) (*DeserializationResult, int64, error) {
+	r := newSnapshotReader(in)
+
+	magic := r.bytes(len(snapshotMagic))
+	version := r.bytes(1)
+	if r.err != nil {
+		return nil, -1, errors.Wrap(r.err, "read header")
+	}
+
+	if !bytes.Equal(magic, snapshotMagic) {
+		return nil, -1, errors.New("not an hnsw snapshot")
+	}
+
+	if version[0] != snapshotVersion {
+		return nil, -1, errors.Errorf("unsupported snapshot version %d", version[0])
+	}
+
+	covered := int64(r.uint64())
+	state := &DeserializationResult{
+		Entrypoint:    r.uint64(),
+		Level:         r.uint16(),
+		Tombstones:    map[uint64]struct{}{},
+		LinksReplaced: map[uint64]map[uint16]struct{}{},
+	}
+
+	tombstones := r.uint64()
+	for i := uint64(0); i < tombstones && r.err == nil; i++ {
+		state.Tombstones[r.uint64()] = struct{}{}
+	}
+
+	length := r.uint64()
+	count := r.uint64()
+	if r.err != nil {
+		return nil, -1, errors.Wrap(r.err, "read header")
+	}
+
+	if count > length {
+		return nil, -1, errors.Errorf("snapshot holds %d nodes, but only has room "+
+			"for %d", count, length)
+	}
+
+	state.Nodes = make([]*vertex, length)
+	for i := uint64(0); i < count && r.err == nil; i++ {
+		node := &vertex{
+			id:          r.uint64(),
+			level:       int(r.uint16()),
+			connections: map[int][]uint64{},
+		}
+
+		levels := r.uint16()
+		for l := uint16(0); l < levels && r.err == nil; l++ {
+			level := int(r.uint16())
+			connCount := r.uint32()
+			if connCount > maxSnapshotConnections {
+				return nil, -1, errors.Errorf("node %d has %d connections at level %d, "+
+					"the snapshot is corrupt", node.id, connCount, level)
+			}
+			conns := make([]uint64, connCount)
+			for c := range conns {
+				conns[c] = r.uint64()
+			}
+			node.connections[level] = conns
+		}
+
+		if r.err == nil && node.id >= length {
+			return nil, -1, errors.Errorf("node %d is out of range", node.id)
+		}
+		state.Nodes[node.id] = node
+	}
+
+	if r.err != nil {
+		return nil, -1, errors.Wrap(r.err, "read nodes")
+	}
+
+	if err := r.verifyChecksum(); err != nil {
+		return nil, -1, err
+	}
+
+	return state, covered, nil
+}
+
+// snapshotWriter keeps the first error and the checksum of everything
+// written, so that the encoding is not interrupted by error handling
+type snapshotWriter struct {
+	w       io.Writer
+	hash    hash.Hash32
+	scratch [8]byte
+	err     error
+}
+
+func newSnapshotWriter(w io.Writer) *snapshotWriter {
+	return &snapshotWriter{w: w, hash: crc32.NewIEEE()}
+}
+
+func (w *snapshotWriter) write(p []byte) {
+	if w.err != nil {
+		return
+	}
+
+	w.hash.Write(p)
+	_, w.err = w.w.Write(p)
+}
+
+func (w *snapshotWriter) uint16(v uint16) {
+	binary.LittleEndian.PutUint16(w.scratch[:2], v)
+	w.write(w.scratch[:2])
+}
+
+func (w *snapshotWriter) uint32(v uint32) {
+	binary.LittleEndian.PutUint32(w.scratch[:4], v)
+	w.write(w.scratch[:4])
+}
+
+func (w *snapshotWriter) uint64(v uint64) {
+	binary.LittleEndian.PutUint64(w.scratch[:8], v)
+	w.write(w.scratch[:8])
+}
+
+func (w *snapshotWriter) writeChecksum() {
+	binary.LittleEndian.PutUint32(w.scratch[:4], w.hash.Sum32())
+	if w.err == nil {
+		_, w.err = w.w.Write(w.scratch[:4])
+	}
+}
+
+// snapshotReader is the counterpart of snapshotWriter
+type snapshotReader struct {
+	r    io.Reader
+	hash hash.Hash32
+	err  error
+}
+
+func newSnapshotReader(r io.Reader) *snapshotReader {
+	hash := crc32.NewIEEE()
+	return &snapshotReader{r: io.TeeReader(r, hash), hash: hash}
+}
+
+func (r *snapshotReader) bytes(n int) []byte {
+	p := make([]byte, n)
+	if r.err != nil {
+		return p
+	}
+
+	_, r.err = io.ReadFull(r.r, p)
+	return p
+}
+
+func (r *snapshotReader) uint16() uint16 {
+	return binary.LittleEndian.Uint16(r.bytes(2))
+}
+
+func (r *snapshotReader) uint32() uint32 {
+	return binary.LittleEndian.Uint32(r.bytes(4))
+}
+
+func (r *snapshotReader) uint64() uint64 {
+	return binary.LittleEndian.Uint64(r.bytes(8))
+}
+
+func (r *snapshotReader) verifyChecksum() error {
+	expected := r.hash.Sum32()
+
+	var actual [4]byte
+	if _, err := io.ReadFull(r.r, actual[:]); err != nil {
+		return errors.Wrap(err, "read checksum")
+	}
+
+	if binary.LittleEndian.Uint32(actual[:]) != expected {
+		return errors.New("snapshot checksum mismatch, the file is corrupt")
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package hnsw
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHnswSnapshot(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	indexID := "integrationtest_snapshot"
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	makeIndex := func() *hnsw {
+		index, err := New(Config{
+			RootPath: dirName,
+			ID:       indexID,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(dirName, indexID, 0, logger)
+			},
+			DistanceProvider: distancer.NewCosineProvider(),
+			VectorForIDThunk: testVectorForID,
+		}, UserConfig{
+			MaxConnections: 30,
+			EFConstruction: 60,
+		})
+		require.Nil(t, err)
+		return index
+	}
+
+	index := makeIndex()
+	for i, vec := range testVectors[:5] {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("create a snapshot", func(t *testing.T) {
+		require.Nil(t, index.CreateSnapshot())
+
+		files, err := index.ListFiles()
+		require.Nil(t, err)
+		assert.Equal(t, []string{snapshotFileName(dirName, indexID)}, files)
+	})
+
+	for i, vec := range testVectors[5:] {
+		require.Nil(t, index.Add(uint64(i+5), vec))
+	}
+	require.Nil(t, index.Delete(2))
+	require.Nil(t, index.Flush())
+
+	expectedResults, _, err := index.knnSearchByVector(testVectors[3], 50, 36, nil)
+	require.Nil(t, err)
+
+	t.Run("restore from the snapshot and the newer commit logs", func(t *testing.T) {
+		restored := makeIndex()
+		assert.Equal(t, index.Entrypoint(), restored.Entrypoint())
+		assert.Equal(t, index.TombstoneCount(), restored.TombstoneCount())
+
+		res, _, err := restored.knnSearchByVector(testVectors[3], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+	})
+
+	t.Run("a second snapshot builds on the first one", func(t *testing.T) {
+		require.Nil(t, index.CreateSnapshot())
+
+		restored := makeIndex()
+		res, _, err := restored.knnSearchByVector(testVectors[3], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+	})
+
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	state := &DeserializationResult{
+		Entrypoint: 3,
+		Level:      2,
+		Tombstones: map[uint64]struct{}{1: {}},
+		Nodes: []*vertex{
+			{id: 0, level: 0, connections: map[int][]uint64{0: {1, 3}}},
+			{id: 1, level: 0, connections: map[int][]uint64{0: {0}}},
+			nil,
+			{id: 3, level: 2, connections: map[int][]uint64{0: {0}, 1: {}, 2: {}}},
+			nil,
+		},
+	}
+
+	cipher, err := encryption.New(bytes.Repeat([]byte{7}, 32))
+	require.Nil(t, err)
+
+	for name, cipher := range map[string]*encryption.Cipher{
+		"plain":     nil,
+		"encrypted": cipher,
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.Nil(t, writeSnapshot(snapshotFileName(dir, "main"), state, 1234, cipher))
+
+			restored, covered, err := loadSnapshot(dir, "main", cipher)
+			require.Nil(t, err)
+			assert.Equal(t, int64(1234), covered)
+			assert.Equal(t, state.Entrypoint, restored.Entrypoint)
+			assert.Equal(t, state.Level, restored.Level)
+			assert.Equal(t, state.Tombstones, restored.Tombstones)
+			assert.Equal(t, state.Nodes, restored.Nodes)
+
+			_, err = os.Stat(snapshotFileName(dir, "main") + ".tmp")
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+
+	t.Run("without a snapshot", func(t *testing.T) {
+		restored, covered, err := loadSnapshot(t.TempDir(), "main", nil)
+		require.Nil(t, err)
+		assert.Nil(t, restored)
+		assert.Equal(t, int64(-1), covered)
+	})
+
+	t.Run("a corrupt snapshot is detected", func(t *testing.T) {
+		dir := t.TempDir()
+		path := snapshotFileName(dir, "main")
+		require.Nil(t, writeSnapshot(path, state, 1234, nil))
+
+		contents, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		contents[len(contents)-10] ^= 0xff
+		require.Nil(t, ioutil.WriteFile(path, contents, 0o666))
+
+		_, _, err = loadSnapshot(dir, "main", nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRemoveSnapshottedCommitLogs(t *testing.T) {
+	dir := t.TempDir()
+	var fileNames []string
+	for _, name := range []string{"1000.condensed", "1001", "1002"} {
+		fileName := filepath.Join(dir, name)
+		require.Nil(t, ioutil.WriteFile(fileName, nil, 0o666))
+		fileNames = append(fileNames, fileName)
+	}
+
+	remaining, err := removeSnapshottedCommitLogs(fileNames, 1001)
+	require.Nil(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "1002")}, remaining)
+
+	files, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "1002", files[0].Name())
+}
@@ -16,9 +16,11 @@ import (
 	"context"
 	"io"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/encryption"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/visited"
 )
 
@@ -41,15 +43,28 @@ func (h *hnsw) init(cfg Config) error {
 	return nil
 }
 
-// if a commit log is already present it will be read into memory, if not we
-// start with an empty model
+// if a snapshot or a commit log is already present it will be read into
+// memory, if not we start with an empty model. Only the commit logs written
+// after the snapshot are replayed on top of it.
 func (h *hnsw) restoreFromDisk() error {
+	state, covered, err := loadSnapshot(h.rootPath, h.id, h.cipher)
+	if err != nil {
+		return errors.Wrap(err, "load snapshot")
+	}
+
 	fileNames, err := getCommitFileNames(h.rootPath, h.id)
 	if err != nil {
 		return err
 	}
 
-	if len(fileNames) == 0 {
+	if state != nil {
+		fileNames, err = removeSnapshottedCommitLogs(fileNames, covered)
+		if err != nil {
+			return err
+		}
+	}
+
+	if state == nil && len(fileNames) == 0 {
 		// nothing to do
 		return nil
 	}
@@ -59,7 +74,6 @@ func (h *hnsw) restoreFromDisk() error {
 		return errors.Wrap(err, "corrupted commit log fixer")
 	}
 
-	var state *DeserializationResult
 	for _, fileName := range fileNames {
 		fd, err := os.Open(fileName)
 		if err != nil {
@@ -68,7 +82,12 @@ func (h *hnsw) restoreFromDisk() error {
 
 		defer fd.Close()
 
-		fdBuf := bufio.NewReaderSize(fd, 256*1024)
+		plain, err := encryption.NewLogReader(fd, h.cipher)
+		if err != nil {
+			return errors.Wrapf(err, "read commit log %q", fileName)
+		}
+
+		fdBuf := bufio.NewReaderSize(plain, 256*1024)
 
 		var valid int
 		state, valid, err = NewDeserializer2(h.logger).Do(fdBuf, state, false)
@@ -85,7 +104,7 @@ func (h *hnsw) restoreFromDisk() error {
 					Error("write-ahead-log ended abruptly, some elements may not have been recovered")
 
 				// we need to truncate the file to its valid length!
-				if err := os.Truncate(fileName, int64(valid)); err != nil {
+				if err := truncateCommitLog(fd, h.cipher, int64(valid)); err != nil {
 					return errors.Wrapf(err, "truncate corrupt commit log %q", fileName)
 				}
 			} else {
@@ -112,25 +131,59 @@ func (h *hnsw) restoreFromDisk() error {
 	return nil
 }
 
+// truncateCommitLog cuts the log read through fd to its valid length of size
+// bytes of plain text
+func truncateCommitLog(fd *os.File, cipher *encryption.Cipher,
+	size int64) error {
+	encrypted, err := encryption.IsEncryptedFile(fd)
+	if err != nil {
+		return err
+	}
+
+	if encrypted {
+		return encryption.Truncate(fd.Name(), cipher, size)
+	}
+
+	return os.Truncate(fd.Name(), size)
+}
+
 func (h *hnsw) registerMaintainence() {
 	h.registerTombstoneCleanup()
 }
 
 func (h *hnsw) registerTombstoneCleanup() {
-	if h.cleanupInterval == 0 {
-		// user is not interested in periodically cleaning up tombstones, clean up
-		// will be manual. (This is also helpful in tests where we want to
-		// explicitly control the point at which a cleanup happens)
-		return
-	}
-
 	go func() {
-		t := time.Tick(h.cleanupInterval)
+		var ticker *time.Ticker
+		var tick <-chan time.Time
+		resetTicker := func() {
+			if ticker != nil {
+				ticker.Stop()
+				ticker, tick = nil, nil
+			}
+
+			interval := time.Duration(atomic.LoadInt64(&h.cleanupInterval))
+			if interval == 0 {
+				// user is not interested in periodically cleaning up tombstones, clean
+				// up will be manual. (This is also helpful in tests where we want to
+				// explicitly control the point at which a cleanup happens)
+				return
+			}
+
+			ticker = time.NewTicker(interval)
+			tick = ticker.C
+		}
+		resetTicker()
+
 		for {
 			select {
 			case <-h.cancel:
+				if ticker != nil {
+					ticker.Stop()
+				}
 				return
-			case <-t:
+			case <-h.cleanupIntervalChanged:
+				resetTicker()
+			case <-tick:
 				err := h.CleanUpTombstonedNodes()
 				if err != nil {
 					h.logger.WithField("action", "hnsw_tombstone_cleanup").
@@ -141,6 +194,21 @@ func (h *hnsw) registerTombstoneCleanup() {
 	}()
 }
 
+// updateCleanupInterval changes the interval of the periodic tombstone
+// cleanup, the next cleanup happens one full interval after the change
+func (h *hnsw) updateCleanupInterval(interval time.Duration) {
+	if atomic.SwapInt64(&h.cleanupInterval, int64(interval)) == int64(interval) {
+		return
+	}
+
+	select {
+	case h.cleanupIntervalChanged <- struct{}{}:
+	default:
+		// a change is already pending, the cleanup goroutine reads the latest
+		// interval once it picks it up
+	}
+}
+
 // PostStartup triggers routines that should happen after startup. The startup
 // process is triggered during the creation which in turn happens as part of
 // the shard creation. Some post-startup routines, such as prefilling the
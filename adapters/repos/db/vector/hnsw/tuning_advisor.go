@@ -0,0 +1,372 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+)
+
+const (
+	DefaultTuningTargetRecall = 0.95
+	DefaultTuningK            = 10
+	DefaultTuningQueries      = 100
+)
+
+var (
+	DefaultTuningEFs             = []int{16, 32, 64, 128, 256}
+	DefaultTuningEFConstructions = []int{64, 128, 256}
+	DefaultTuningMaxConnections  = []int{16, 32, 64}
+)
+
+// TuningAdvisorConfig describes the grid explored by the TuningAdvisor as
+// well as the sample data it is explored on. Any grid dimension which is left
+// empty falls back to its default.
+type TuningAdvisorConfig struct {
+	// Vectors is the sampled data, every configuration in the grid builds a
+	// fresh in-memory index containing all of them
+	Vectors [][]float32
+
+	// Queries are optional. If none are set, the first vectors of the sample
+	// are used as queries.
+	Queries [][]float32
+
+	K                int
+	TargetRecall     float64
+	EFs              []int
+	EFConstructions  []int
+	MaxConnections   []int
+	DistanceProvider distancer.Provider
+}
+
+func (c *TuningAdvisorConfig) setDefaults() {
+	if c.K <= 0 {
+		c.K = DefaultTuningK
+	}
+
+	if c.TargetRecall <= 0 {
+		c.TargetRecall = DefaultTuningTargetRecall
+	}
+
+	if len(c.EFs) == 0 {
+		c.EFs = DefaultTuningEFs
+	}
+
+	if len(c.EFConstructions) == 0 {
+		c.EFConstructions = DefaultTuningEFConstructions
+	}
+
+	if len(c.MaxConnections) == 0 {
+		c.MaxConnections = DefaultTuningMaxConnections
+	}
+
+	if c.DistanceProvider == nil {
+		c.DistanceProvider = distancer.NewDotProductProvider()
+	}
+
+	if len(c.Queries) == 0 {
+		n := DefaultTuningQueries
+		if n > len(c.Vectors) {
+			n = len(c.Vectors)
+		}
+		c.Queries = c.Vectors[:n]
+	}
+}
+
+func (c TuningAdvisorConfig) validate() error {
+	ec := &errorCompounder{}
+
+	if len(c.Vectors) == 0 {
+		ec.addf("vectors cannot be empty")
+	}
+
+	if c.TargetRecall > 1 {
+		ec.addf("targetRecall must be between 0 and 1, got %f", c.TargetRecall)
+	}
+
+	for _, v := range append(append(append([]int{}, c.EFs...),
+		c.EFConstructions...), c.MaxConnections...) {
+		if v < 1 {
+			ec.addf("grid values must be positive, got %d", v)
+		}
+	}
+
+	return ec.toError()
+}
+
+// TuningResult is the measured outcome of a single grid point
+type TuningResult struct {
+	EF               int           `json:"ef"`
+	EFConstruction   int           `json:"efConstruction"`
+	MaxConnections   int           `json:"maxConnections"`
+	Recall           float64       `json:"recall"`
+	MeanQueryLatency time.Duration `json:"meanQueryLatency"`
+	BuildDuration    time.Duration `json:"buildDuration"`
+}
+
+// dominates is true if r is at least as good as other in both recall and
+// latency and strictly better in at least one of them
+func (r TuningResult) dominates(other TuningResult) bool {
+	if r.Recall < other.Recall || r.MeanQueryLatency > other.MeanQueryLatency {
+		return false
+	}
+
+	return r.Recall > other.Recall || r.MeanQueryLatency < other.MeanQueryLatency
+}
+
+// TuningReport is the outcome of a TuningAdvisor run. ParetoOptimal contains
+// all results which are not dominated by any other result in terms of recall
+// and query latency, ordered by ascending latency. Recommended is the fastest
+// Pareto-optimal setting which meets the target recall, it is nil if no
+// setting in the grid could reach the target.
+type TuningReport struct {
+	TargetRecall  float64        `json:"targetRecall"`
+	K             int            `json:"k"`
+	SampleSize    int            `json:"sampleSize"`
+	QueryCount    int            `json:"queryCount"`
+	Results       []TuningResult `json:"results"`
+	ParetoOptimal []TuningResult `json:"paretoOptimal"`
+	Recommended   *TuningResult  `json:"recommended"`
+}
+
+// TuningAdvisor explores a small grid of ef/efConstruction/maxConnections
+// values on sampled data and compares the results of each setting against a
+// brute-force search to measure recall. The indexes built by the advisor are
+// purely in-memory and independent of any existing index.
+type TuningAdvisor struct {
+	config TuningAdvisorConfig
+	truths [][]uint64
+}
+
+func NewTuningAdvisor(config TuningAdvisorConfig) (*TuningAdvisor, error) {
+	config.setDefaults()
+	if err := config.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid tuning advisor config")
+	}
+
+	return &TuningAdvisor{config: config}, nil
+}
+
+func (a *TuningAdvisor) Run(ctx context.Context) (*TuningReport, error) {
+	if err := a.computeTruths(ctx); err != nil {
+		return nil, errors.Wrap(err, "compute ground truth")
+	}
+
+	var results []TuningResult
+	for _, maxConn := range a.config.MaxConnections {
+		for _, efC := range a.config.EFConstructions {
+			res, err := a.evaluate(ctx, maxConn, efC)
+			if err != nil {
+				return nil, errors.Wrapf(err,
+					"evaluate maxConnections=%d efConstruction=%d", maxConn, efC)
+			}
+
+			results = append(results, res...)
+		}
+	}
+
+	return a.report(results), nil
+}
+
+func (a *TuningAdvisor) computeTruths(ctx context.Context) error {
+	a.truths = make([][]uint64, len(a.config.Queries))
+	for i, query := range a.config.Queries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if a.config.DistanceProvider.Type() == "cosine-dot" {
+			query = distancer.Normalize(query)
+		}
+		if a.config.DistanceProvider.Type() == "hamming-binary" {
+			query = distancer.PackBinary(query)
+		}
+
+		results := priorityqueue.NewMax(a.config.K)
+		for id, vec := range a.config.Vectors {
+			if a.config.DistanceProvider.Type() == "cosine-dot" {
+				vec = distancer.Normalize(vec)
+			}
+			if a.config.DistanceProvider.Type() == "hamming-binary" {
+				vec = distancer.PackBinary(vec)
+			}
+			dist, _, err := a.config.DistanceProvider.SingleDist(query, vec)
+			if err != nil {
+				return errors.Wrapf(err, "distance to vector %d", id)
+			}
+
+			if results.Len() < a.config.K {
+				results.Insert(uint64(id), dist)
+			} else if results.Top().Dist > dist {
+				results.Pop()
+				results.Insert(uint64(id), dist)
+			}
+		}
+
+		ids := make([]uint64, results.Len())
+		for results.Len() > 0 {
+			ids[results.Len()-1] = results.Pop().ID
+		}
+		a.truths[i] = ids
+	}
+
+	return nil
+}
+
+// evaluate builds a single index for the given construction parameters and
+// then measures every search-time ef on it, as ef can be changed without
+// rebuilding
+func (a *TuningAdvisor) evaluate(ctx context.Context, maxConn,
+	efC int) ([]TuningResult, error) {
+	uc := NewDefaultUserConfig()
+	uc.MaxConnections = maxConn
+	uc.EFConstruction = efC
+	// the advisor's index is short-lived, there is nothing to clean up
+	uc.CleanupIntervalSeconds = 0
+
+	vectors := a.config.Vectors
+	index, err := New(Config{
+		RootPath:              "not-used-as-commit-logger-is-noop",
+		ID:                    "tuning-advisor",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      a.config.DistanceProvider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, uc)
+	if err != nil {
+		return nil, errors.Wrap(err, "init index")
+	}
+	defer index.cache.drop()
+
+	before := time.Now()
+	for id, vec := range vectors {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := index.Add(uint64(id), vec); err != nil {
+			return nil, errors.Wrapf(err, "add vector %d", id)
+		}
+	}
+	buildDuration := time.Since(before)
+
+	out := make([]TuningResult, len(a.config.EFs))
+	for i, ef := range a.config.EFs {
+		atomic.StoreInt64(&index.ef, int64(ef))
+
+		var relevant, retrieved int
+		before := time.Now()
+		for q, query := range a.config.Queries {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			ids, _, err := index.SearchByVector(query, a.config.K, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "search query %d", q)
+			}
+
+			retrieved += len(a.truths[q])
+			relevant += matchingIDs(a.truths[q], ids)
+		}
+		took := time.Since(before)
+
+		recall := 1.0
+		if retrieved > 0 {
+			recall = float64(relevant) / float64(retrieved)
+		}
+
+		out[i] = TuningResult{
+			EF:               ef,
+			EFConstruction:   efC,
+			MaxConnections:   maxConn,
+			Recall:           recall,
+			MeanQueryLatency: took / time.Duration(len(a.config.Queries)),
+			BuildDuration:    buildDuration,
+		}
+	}
+
+	return out, nil
+}
+
+func (a *TuningAdvisor) report(results []TuningResult) *TuningReport {
+	report := &TuningReport{
+		TargetRecall: a.config.TargetRecall,
+		K:            a.config.K,
+		SampleSize:   len(a.config.Vectors),
+		QueryCount:   len(a.config.Queries),
+		Results:      results,
+	}
+
+	report.ParetoOptimal = paretoFrontier(results)
+	for i := range report.ParetoOptimal {
+		if report.ParetoOptimal[i].Recall >= a.config.TargetRecall {
+			rec := report.ParetoOptimal[i]
+			report.Recommended = &rec
+			break
+		}
+	}
+
+	return report
+}
+
+// paretoFrontier returns all non-dominated results ordered by ascending
+// latency. Ties in latency are broken by cheaper construction parameters.
+func paretoFrontier(results []TuningResult) []TuningResult {
+	var out []TuningResult
+
+outer:
+	for i, candidate := range results {
+		for j, other := range results {
+			if i != j && other.dominates(candidate) {
+				continue outer
+			}
+		}
+
+		out = append(out, candidate)
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].MeanQueryLatency != out[b].MeanQueryLatency {
+			return out[a].MeanQueryLatency < out[b].MeanQueryLatency
+		}
+		if out[a].BuildDuration != out[b].BuildDuration {
+			return out[a].BuildDuration < out[b].BuildDuration
+		}
+		return out[a].Recall > out[b].Recall
+	})
+
+	return out
+}
+
+func matchingIDs(control, results []uint64) int {
+	desired := map[uint64]struct{}{}
+	for _, id := range control {
+		desired[id] = struct{}{}
+	}
+
+	matches := 0
+	for _, id := range results {
+		if _, ok := desired[id]; ok {
+			matches++
+		}
+	}
+
+	return matches
+}
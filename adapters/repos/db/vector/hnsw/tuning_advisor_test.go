@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTuningAdvisor(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	vectors := make([][]float32, 300)
+	for i := range vectors {
+		vec := make([]float32, 16)
+		for j := range vec {
+			vec[j] = r.Float32()
+		}
+		vectors[i] = distancer.Normalize(vec)
+	}
+
+	advisor, err := NewTuningAdvisor(TuningAdvisorConfig{
+		Vectors:         vectors,
+		K:               5,
+		TargetRecall:    0.5,
+		EFs:             []int{8, 64},
+		EFConstructions: []int{32},
+		MaxConnections:  []int{8, 16},
+	})
+	require.Nil(t, err)
+
+	report, err := advisor.Run(context.Background())
+	require.Nil(t, err)
+
+	assert.Len(t, report.Results, 4)
+	assert.Equal(t, 300, report.SampleSize)
+	assert.Equal(t, DefaultTuningQueries, report.QueryCount)
+	require.NotNil(t, report.Recommended)
+	assert.True(t, report.Recommended.Recall >= 0.5)
+
+	for _, a := range report.ParetoOptimal {
+		for _, b := range report.Results {
+			assert.False(t, b.dominates(a), "%v is dominated by %v", a, b)
+		}
+	}
+}
+
+func TestTuningAdvisorInvalidConfig(t *testing.T) {
+	_, err := NewTuningAdvisor(TuningAdvisorConfig{})
+	assert.NotNil(t, err)
+
+	_, err = NewTuningAdvisor(TuningAdvisorConfig{
+		Vectors: [][]float32{{1, 2, 3}},
+		EFs:     []int{0},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestParetoFrontier(t *testing.T) {
+	results := []TuningResult{
+		{EF: 1, Recall: 0.80, MeanQueryLatency: 1 * time.Millisecond},
+		{EF: 2, Recall: 0.90, MeanQueryLatency: 2 * time.Millisecond},
+		{EF: 3, Recall: 0.85, MeanQueryLatency: 3 * time.Millisecond}, // dominated by 2
+		{EF: 4, Recall: 0.99, MeanQueryLatency: 4 * time.Millisecond},
+	}
+
+	frontier := paretoFrontier(results)
+	require.Len(t, frontier, 3)
+	assert.Equal(t, 1, frontier[0].EF)
+	assert.Equal(t, 2, frontier[1].EF)
+	assert.Equal(t, 4, frontier[2].EF)
+}
@@ -27,6 +27,7 @@ type shardedLockCache struct {
 	cache           [][]float32
 	vectorForID     VectorForID
 	normalizeOnRead bool
+	packOnRead      bool
 	maxSize         int64
 	count           int64
 	cancel          chan bool
@@ -36,11 +37,12 @@ type shardedLockCache struct {
 var shardFactor = uint64(512)
 
 func newShardedLockCache(vecForID VectorForID, maxSize int,
-	logger logrus.FieldLogger, normalizeOnRead bool) *shardedLockCache {
+	logger logrus.FieldLogger, normalizeOnRead, packOnRead bool) *shardedLockCache {
 	vc := &shardedLockCache{
 		vectorForID:     vecForID,
 		cache:           make([][]float32, initialSize),
 		normalizeOnRead: normalizeOnRead,
+		packOnRead:      packOnRead,
 		count:           0,
 		maxSize:         int64(maxSize),
 		cancel:          make(chan bool),
@@ -73,6 +75,12 @@ func (n *shardedLockCache) get(ctx context.Context, id uint64) ([]float32, error
 		vec = distancer.Normalize(vec)
 	}
 
+	if n.packOnRead {
+		// binary vectors are held bit-packed, which needs 32 times less memory
+		// than the float32 vectors they are stored as
+		vec = distancer.PackBinary(vec)
+	}
+
 	atomic.AddInt64(&n.count, 1)
 	n.shardedLocks[id%shardFactor].Lock()
 	n.cache[id] = vec
@@ -91,10 +99,12 @@ func (n *shardedLockCache) prefetch(id uint64) {
 }
 
 func (n *shardedLockCache) preload(id uint64, vec []float32) {
-	n.shardedLocks[id%shardFactor].RLock()
-	defer n.shardedLocks[id%shardFactor].RUnlock()
+	n.shardedLocks[id%shardFactor].Lock()
+	defer n.shardedLocks[id%shardFactor].Unlock()
 
-	atomic.AddInt64(&n.count, 1)
+	if n.cache[id] == nil {
+		atomic.AddInt64(&n.count, 1)
+	}
 	n.cache[id] = vec
 }
 
@@ -130,17 +140,20 @@ func (c *shardedLockCache) watchForDeletion() {
 	}()
 }
 
+// replaceIfFull drops all cached vectors once the cache holds more than
+// vectorCacheMaxObjects vectors. A cache which holds exactly the maximum, e.g.
+// after it was prefilled, is kept.
 func (c *shardedLockCache) replaceIfFull() {
-	if atomic.LoadInt64(&c.count) >= atomic.LoadInt64(&c.maxSize) {
+	if atomic.LoadInt64(&c.count) > atomic.LoadInt64(&c.maxSize) {
 		c.obtainAllLocks()
 		c.logger.WithField("action", "hnsw_delete_vector_cache").
 			Debug("deleting full vector cache")
 		for i := range c.cache {
 			c.cache[i] = nil
 		}
+		atomic.StoreInt64(&c.count, 0)
 		c.releaseAllLocks()
 	}
-	atomic.StoreInt64(&c.count, 0)
 }
 
 func (c *shardedLockCache) obtainAllLocks() {
@@ -171,6 +184,27 @@ func (c *shardedLockCache) copyMaxSize() int64 {
 	return sizeCopy
 }
 
+// countVectors is the number of vectors currently held in the cache, as
+// opposed to len which is the number of slots
+func (c *shardedLockCache) countVectors() int64 {
+	return atomic.LoadInt64(&c.count)
+}
+
+// VectorCacheStats is the current size of the vector cache of an index
+type VectorCacheStats struct {
+	Objects    int64
+	MaxObjects int64
+}
+
+// VectorCacheStats reports how many vectors are currently held in memory by
+// the vector cache and how many it may hold, see vectorCacheMaxObjects
+func (h *hnsw) VectorCacheStats() VectorCacheStats {
+	return VectorCacheStats{
+		Objects:    h.cache.countVectors(),
+		MaxObjects: h.cache.copyMaxSize(),
+	}
+}
+
 // noopCache can be helpful in debugging situations, where we want to
 // explicitly pass through each vectorForID call to the underlying vectorForID
 // function without caching in between.
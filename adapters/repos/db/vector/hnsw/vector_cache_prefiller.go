@@ -33,6 +33,7 @@ type cache interface {
 	drop()
 	updateMaxSize(size int64)
 	copyMaxSize() int64
+	countVectors() int64
 }
 
 func newVectorCachePrefiller(cache cache, index *hnsw,
@@ -57,7 +58,7 @@ func (pf *vectorCachePrefiller) Prefill(ctx context.Context, limit int) error {
 		}
 	}
 
-	pf.logTotal(int(pf.cache.len()), limit, before)
+	pf.logTotal(int(pf.cache.countVectors()), limit, before)
 	return nil
 }
 
@@ -70,12 +71,10 @@ func (pf *vectorCachePrefiller) prefillLevel(ctx context.Context,
 	before := time.Now()
 	layerCount := 0
 
-	pf.index.Lock()
-	nodesLen := len(pf.index.nodes)
-	pf.index.Unlock()
+	nodesLen := pf.index.nodesLen()
 
 	for i := 0; i < nodesLen; i++ {
-		if int(pf.cache.len()) >= limit {
+		if int(pf.cache.countVectors()) >= limit {
 			break
 		}
 
@@ -83,9 +82,7 @@ func (pf *vectorCachePrefiller) prefillLevel(ctx context.Context,
 			return false, err
 		}
 
-		pf.index.Lock()
-		node := pf.index.nodes[i]
-		pf.index.Unlock()
+		node := pf.index.nodeByID(uint64(i))
 
 		if node == nil {
 			continue
@@ -128,8 +125,8 @@ func (pf *vectorCachePrefiller) logTotal(count, limit int, before time.Time) {
 }
 
 func levelOfNode(node *vertex) int {
-	node.Lock()
-	defer node.Unlock()
+	node.RLock()
+	defer node.RUnlock()
 
 	return node.level
 }
@@ -118,6 +118,10 @@ func (f *fakeCache) len() int32 {
 	return int32(len(f.store))
 }
 
+func (f *fakeCache) countVectors() int64 {
+	return int64(len(f.store))
+}
+
 func generateDummyVertices(amount int) []*vertex {
 	out := make([]*vertex, amount)
 	for i := range out {
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorCacheMaxObjects(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return []float32{float32(id)}, nil
+	}
+
+	newCache := func(maxSize int) *shardedLockCache {
+		cache := newShardedLockCache(vecForID, maxSize, logger, false, false)
+		t.Cleanup(cache.drop)
+		return cache
+	}
+
+	t.Run("counts every vector once", func(t *testing.T) {
+		cache := newCache(10)
+		for i := uint64(0); i < 5; i++ {
+			_, err := cache.get(context.Background(), i)
+			require.Nil(t, err)
+		}
+		cache.preload(3, []float32{3})
+		cache.preload(7, []float32{7})
+
+		assert.Equal(t, int64(6), cache.countVectors())
+	})
+
+	t.Run("keeps a cache which holds exactly the maximum", func(t *testing.T) {
+		cache := newCache(5)
+		for i := uint64(0); i < 5; i++ {
+			_, err := cache.get(context.Background(), i)
+			require.Nil(t, err)
+		}
+
+		cache.replaceIfFull()
+		cache.replaceIfFull()
+
+		assert.Equal(t, int64(5), cache.countVectors())
+		assert.Equal(t, []float32{4}, cache.cache[4])
+	})
+
+	t.Run("drops a cache which holds more than the maximum", func(t *testing.T) {
+		cache := newCache(5)
+		for i := uint64(0); i < 6; i++ {
+			_, err := cache.get(context.Background(), i)
+			require.Nil(t, err)
+		}
+
+		cache.replaceIfFull()
+
+		assert.Equal(t, int64(0), cache.countVectors())
+		assert.Nil(t, cache.cache[4])
+	})
+
+	t.Run("reports its size", func(t *testing.T) {
+		index := &hnsw{cache: newCache(5)}
+		_, err := index.cache.get(context.Background(), 2)
+		require.Nil(t, err)
+
+		assert.Equal(t, VectorCacheStats{Objects: 1, MaxObjects: 5},
+			index.VectorCacheStats())
+	})
+}
+
+func TestVectorCacheBinaryVectors(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors := [][]float32{
+		{1, 0, 1, 1, 0, 0, 0, 1},
+		{0, 0, 1, 1, 0, 1, 0, 1},
+	}
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return vectors[id], nil
+	}
+
+	cache := newShardedLockCache(vecForID, 10, logger, false, true)
+	t.Cleanup(cache.drop)
+
+	a, err := cache.get(context.Background(), 0)
+	require.Nil(t, err)
+	b, err := cache.get(context.Background(), 1)
+	require.Nil(t, err)
+
+	assert.Len(t, a, 1, "eight dimensions are packed into a single float32")
+	dist, _, err := distancer.NewHammingBinaryProvider().SingleDist(a, b)
+	require.Nil(t, err)
+	assert.Equal(t, float32(2), dist)
+}
@@ -0,0 +1,481 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package multivector indexes multiple token-level vectors per object next to
+// its regular vector and ranks objects by late interaction, as popularized by
+// ColBERT: For every query vector the distance to the closest token vector of
+// an object is taken, the distance of the object is the mean of those. The
+// token vectors are indexed in a separate vector index to find candidates,
+// which are then scored exactly.
+package multivector
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// minTokenCandidates is the minimum number of token vectors retrieved for
+// each query vector. Late interaction depends on every query vector finding
+// its closest tokens, so a small limit would miss relevant objects.
+const minTokenCandidates = 100
+
+// VectorIndex is a vector index holding either the regular vectors of the
+// objects or their token vectors
+type VectorIndex interface {
+	Add(id uint64, vector []float32) error
+	Delete(id uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schema.VectorIndexConfig) error
+	Drop() error
+	Flush() error
+	PauseMaintenance()
+	ResumeMaintenance()
+	SwitchCommitLogs() error
+	ListFiles() ([]string, error)
+}
+
+// Store persists which token ids belong to which doc. The token ids of a doc
+// are consecutive, so only the first one and their number need to be stored.
+type Store interface {
+	// AllocateTokens reserves count consecutive token ids for the doc and
+	// returns the first one
+	AllocateTokens(docID uint64, count int) (uint64, error)
+	// Tokens returns the first token id of the doc and the number of its
+	// tokens, which is 0 if the doc has none
+	Tokens(docID uint64) (uint64, int, error)
+	// DeleteTokens removes the token ids of the doc
+	DeleteTokens(docID uint64) error
+	// DocID returns the doc the token belongs to, false if the token does not
+	// exist (anymore)
+	DocID(tokenID uint64) (uint64, bool, error)
+	// Vectors returns the token vectors of the doc in the order of its token
+	// ids
+	Vectors(docID uint64) ([][]float32, error)
+}
+
+type Index struct {
+	main      VectorIndex
+	tokens    VectorIndex
+	store     Store
+	distancer distancer.Provider
+}
+
+// New creates an index which adds the regular vectors to main and the token
+// vectors to tokens. The distancer must be the one both indexes use.
+func New(main, tokens VectorIndex, store Store,
+	distProv distancer.Provider) *Index {
+	return &Index{
+		main:      main,
+		tokens:    tokens,
+		store:     store,
+		distancer: distProv,
+	}
+}
+
+// Add adds the regular vector of the doc. Objects of a multi vector class may
+// only have token vectors, so an empty vector is ignored.
+func (i *Index) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return nil
+	}
+
+	return i.main.Add(id, vector)
+}
+
+// AddMulti replaces the token vectors of the doc, if vectors is empty the
+// existing ones are only removed
+func (i *Index) AddMulti(id uint64, vectors [][]float32) error {
+	if err := i.deleteTokens(id); err != nil {
+		return err
+	}
+
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	if err := validateVectors(vectors); err != nil {
+		return err
+	}
+
+	first, err := i.store.AllocateTokens(id, len(vectors))
+	if err != nil {
+		return errors.Wrapf(err, "allocate token ids for doc id %d", id)
+	}
+
+	for pos, vector := range vectors {
+		if err := i.tokens.Add(first+uint64(pos), vector); err != nil {
+			return errors.Wrapf(err, "add token %d of doc id %d", pos, id)
+		}
+	}
+
+	return nil
+}
+
+func validateVectors(vectors [][]float32) error {
+	for pos, vector := range vectors {
+		if len(vector) == 0 {
+			return errors.Errorf("multi vector: token vector %d is empty", pos)
+		}
+
+		if len(vector) != len(vectors[0]) {
+			return errors.Errorf("multi vector: token vector %d has %d dimensions, "+
+				"but token vector 0 has %d", pos, len(vector), len(vectors[0]))
+		}
+	}
+
+	return nil
+}
+
+// Delete removes both the regular and the token vectors of the doc
+func (i *Index) Delete(id uint64) error {
+	if err := i.main.Delete(id); err != nil {
+		return err
+	}
+
+	return i.deleteTokens(id)
+}
+
+func (i *Index) deleteTokens(id uint64) error {
+	first, count, err := i.store.Tokens(id)
+	if err != nil {
+		return errors.Wrapf(err, "get token ids of doc id %d", id)
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	for pos := 0; pos < count; pos++ {
+		if err := i.tokens.Delete(first + uint64(pos)); err != nil {
+			return errors.Wrapf(err, "delete token %d of doc id %d", pos, id)
+		}
+	}
+
+	if err := i.store.DeleteTokens(id); err != nil {
+		return errors.Wrapf(err, "delete token ids of doc id %d", id)
+	}
+
+	return nil
+}
+
+// MultiVectors returns the token vectors of the doc
+func (i *Index) MultiVectors(id uint64) ([][]float32, error) {
+	return i.store.Vectors(id)
+}
+
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList) ([]uint64, []float32, error) {
+	return i.main.SearchByVector(vector, k, allow)
+}
+
+// SearchByMultiVector finds the candidates of every query vector in the token
+// index and ranks them by their exact late interaction distance
+func (i *Index) SearchByMultiVector(queries [][]float32, k int,
+	allow helpers.AllowList) ([]uint64, []float32, error) {
+	if err := validateVectors(queries); err != nil {
+		return nil, nil, err
+	}
+
+	tokenAllow, err := i.tokenAllowList(allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tokenAllow != nil && len(tokenAllow) == 0 {
+		return nil, nil, nil
+	}
+
+	limit := k
+	if limit < minTokenCandidates {
+		limit = minTokenCandidates
+	}
+
+	candidates := map[uint64]struct{}{}
+	for pos, query := range queries {
+		tokenIDs, _, err := i.tokens.SearchByVector(query, limit, tokenAllow)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "search token index for query vector %d", pos)
+		}
+
+		for _, tokenID := range tokenIDs {
+			docID, ok, err := i.store.DocID(tokenID)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "get doc id of token %d", tokenID)
+			}
+
+			if ok {
+				candidates[docID] = struct{}{}
+			}
+		}
+	}
+
+	return i.rank(queries, candidates, k)
+}
+
+// tokenAllowList translates an allow list of doc ids to one of their token
+// ids, nil if all tokens are allowed
+func (i *Index) tokenAllowList(allow helpers.AllowList) (helpers.AllowList, error) {
+	if allow == nil {
+		return nil, nil
+	}
+
+	out := helpers.AllowList{}
+	for docID := range allow {
+		first, count, err := i.store.Tokens(docID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get token ids of doc id %d", docID)
+		}
+
+		for pos := 0; pos < count; pos++ {
+			out.Insert(first + uint64(pos))
+		}
+	}
+
+	return out, nil
+}
+
+type result struct {
+	id   uint64
+	dist float32
+}
+
+func (i *Index) rank(queries [][]float32,
+	candidates map[uint64]struct{}, k int) ([]uint64, []float32, error) {
+	normalize := i.distancer.Type() == "cosine-dot"
+	if normalize {
+		normalized := make([][]float32, len(queries))
+		for pos, query := range queries {
+			normalized[pos] = distancer.Normalize(query)
+		}
+		queries = normalized
+	}
+
+	results := make([]result, 0, len(candidates))
+	for docID := range candidates {
+		vectors, err := i.store.Vectors(docID)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "get token vectors of doc id %d", docID)
+		}
+
+		if len(vectors) == 0 {
+			// deleted after its tokens were found
+			continue
+		}
+
+		if normalize {
+			for pos, vector := range vectors {
+				vectors[pos] = distancer.Normalize(vector)
+			}
+		}
+
+		dist, err := i.lateInteractionDistance(queries, vectors)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "doc id %d", docID)
+		}
+
+		results = append(results, result{id: docID, dist: dist})
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].dist != results[b].dist {
+			return results[a].dist < results[b].dist
+		}
+		return results[a].id < results[b].id
+	})
+
+	if len(results) > k {
+		results = results[:k]
+	}
+
+	ids := make([]uint64, len(results))
+	dists := make([]float32, len(results))
+	for pos, res := range results {
+		ids[pos] = res.id
+		dists[pos] = res.dist
+	}
+
+	return ids, dists, nil
+}
+
+// lateInteractionDistance is the mean distance of every query vector to its
+// closest token vector. Using the mean rather than the sum keeps the distance
+// in the range of the metric, so it can still be turned into a certainty.
+func (i *Index) lateInteractionDistance(queries,
+	vectors [][]float32) (float32, error) {
+	var sum float32
+	for _, query := range queries {
+		var closest float32
+		for pos, vector := range vectors {
+			dist, ok, err := i.distancer.SingleDist(query, vector)
+			if err != nil {
+				return 0, err
+			}
+
+			if !ok {
+				return 0, errors.Errorf("token vector %d has %d dimensions, "+
+					"but the query vectors have %d", pos, len(vector), len(query))
+			}
+
+			if pos == 0 || dist < closest {
+				closest = dist
+			}
+		}
+		sum += closest
+	}
+
+	return sum / float32(len(queries)), nil
+}
+
+func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig) error {
+	if err := i.main.UpdateUserConfig(updated); err != nil {
+		return err
+	}
+
+	return errors.Wrap(i.tokens.UpdateUserConfig(updated), "token index")
+}
+
+func (i *Index) Drop() error {
+	if err := i.main.Drop(); err != nil {
+		return err
+	}
+
+	return errors.Wrap(i.tokens.Drop(), "token index")
+}
+
+func (i *Index) Flush() error {
+	if err := i.main.Flush(); err != nil {
+		return err
+	}
+
+	return errors.Wrap(i.tokens.Flush(), "token index")
+}
+
+func (i *Index) PauseMaintenance() {
+	i.main.PauseMaintenance()
+	i.tokens.PauseMaintenance()
+}
+
+func (i *Index) ResumeMaintenance() {
+	i.main.ResumeMaintenance()
+	i.tokens.ResumeMaintenance()
+}
+
+func (i *Index) SwitchCommitLogs() error {
+	if err := i.main.SwitchCommitLogs(); err != nil {
+		return err
+	}
+
+	return errors.Wrap(i.tokens.SwitchCommitLogs(), "token index")
+}
+
+func (i *Index) ListFiles() ([]string, error) {
+	files, err := i.main.ListFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenFiles, err := i.tokens.ListFiles()
+	if err != nil {
+		return nil, errors.Wrap(err, "token index")
+	}
+
+	return append(files, tokenFiles...), nil
+}
+
+type vectorCacheStatser interface {
+	VectorCacheStats() hnsw.VectorCacheStats
+}
+
+// VectorCacheStats sums up the vector caches of the regular and the token
+// index
+func (i *Index) VectorCacheStats() hnsw.VectorCacheStats {
+	var out hnsw.VectorCacheStats
+	for _, index := range []VectorIndex{i.main, i.tokens} {
+		statser, ok := index.(vectorCacheStatser)
+		if !ok {
+			continue
+		}
+
+		stats := statser.VectorCacheStats()
+		out.Objects += stats.Objects
+		out.MaxObjects += stats.MaxObjects
+	}
+
+	return out
+}
+
+type tombstoneCleaner interface {
+	TombstoneCount() int
+	CleanUpTombstonedNodes() error
+}
+
+// TombstoneCount sums up the deleted vectors of the regular and the token
+// index which have not been cleaned up yet
+func (i *Index) TombstoneCount() int {
+	count := 0
+	for _, index := range []VectorIndex{i.main, i.tokens} {
+		cleaner, ok := index.(tombstoneCleaner)
+		if !ok {
+			continue
+		}
+
+		count += cleaner.TombstoneCount()
+	}
+
+	return count
+}
+
+// CleanUpTombstonedNodes cleans up the regular index before the token index
+func (i *Index) CleanUpTombstonedNodes() error {
+	if cleaner, ok := i.main.(tombstoneCleaner); ok {
+		if err := cleaner.CleanUpTombstonedNodes(); err != nil {
+			return err
+		}
+	}
+
+	if cleaner, ok := i.tokens.(tombstoneCleaner); ok {
+		if err := cleaner.CleanUpTombstonedNodes(); err != nil {
+			return errors.Wrap(err, "token index")
+		}
+	}
+
+	return nil
+}
+
+type commitLogStatser interface {
+	CommitLogStats() hnsw.CommitLogStats
+}
+
+// CommitLogStats sums up the commit logs of the regular and the token index
+func (i *Index) CommitLogStats() hnsw.CommitLogStats {
+	var out hnsw.CommitLogStats
+	for _, index := range []VectorIndex{i.main, i.tokens} {
+		statser, ok := index.(commitLogStatser)
+		if !ok {
+			continue
+		}
+
+		stats := statser.CommitLogStats()
+		out.Files += stats.Files
+		out.Bytes += stats.Bytes
+		out.Condensings += stats.Condensings
+		out.CondensedInputBytes += stats.CondensedInputBytes
+		out.CondensedOutputBytes += stats.CondensedOutputBytes
+		out.Combinings += stats.Combinings
+	}
+
+	return out
+}
@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package multivector
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiVectorIndex(t *testing.T) {
+	main := newFakeIndex()
+	tokens := newFakeIndex()
+	store := newFakeStore(tokens)
+	index := New(main, tokens, store, distancer.NewL2SquaredProvider())
+
+	t.Run("adding regular and token vectors", func(t *testing.T) {
+		require.Nil(t, index.Add(0, []float32{1, 1}))
+		require.Nil(t, index.AddMulti(0, [][]float32{{0, 0}, {10, 10}}))
+		require.Nil(t, index.AddMulti(1, [][]float32{{1, 0}, {9, 9}}))
+		require.Nil(t, index.AddMulti(2, [][]float32{{5, 5}}))
+
+		assert.Len(t, main.vectors, 1)
+		assert.Len(t, tokens.vectors, 5)
+	})
+
+	t.Run("an empty regular vector is ignored", func(t *testing.T) {
+		require.Nil(t, index.Add(1, nil))
+		assert.Len(t, main.vectors, 1)
+	})
+
+	t.Run("searching ranks by late interaction", func(t *testing.T) {
+		ids, dists, err := index.SearchByMultiVector([][]float32{{0, 0}, {10, 10}}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1, 2}, ids)
+		// doc 0 matches both query vectors exactly, doc 1 is off by 1 and 2,
+		// doc 2 is 50 away from each query vector
+		assert.InDeltaSlice(t, []float32{0, 1.5, 50}, dists, 0.0001)
+	})
+
+	t.Run("searching with a limit", func(t *testing.T) {
+		ids, _, err := index.SearchByMultiVector([][]float32{{5, 5}}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2}, ids)
+	})
+
+	t.Run("searching with an allow list", func(t *testing.T) {
+		allow := helpers.AllowList{}
+		allow.Insert(1)
+		allow.Insert(2)
+
+		ids, _, err := index.SearchByMultiVector([][]float32{{0, 0}}, 3, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2}, ids)
+	})
+
+	t.Run("searching with an empty allow list", func(t *testing.T) {
+		tokens.searches = 0
+		ids, _, err := index.SearchByMultiVector([][]float32{{0, 0}}, 3,
+			helpers.AllowList{})
+		require.Nil(t, err)
+		assert.Len(t, ids, 0)
+		assert.Equal(t, 0, tokens.searches, "token index should not be searched")
+	})
+
+	t.Run("replacing the token vectors of a doc", func(t *testing.T) {
+		require.Nil(t, index.AddMulti(2, [][]float32{{0, 0}, {10, 10}, {3, 3}}))
+		assert.Len(t, tokens.vectors, 7)
+
+		ids, dists, err := index.SearchByMultiVector([][]float32{{0, 0}, {10, 10}}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 2}, ids)
+		assert.InDeltaSlice(t, []float32{0, 0}, dists, 0.0001)
+	})
+
+	t.Run("deleting a doc removes its token vectors", func(t *testing.T) {
+		require.Nil(t, index.Delete(0))
+		assert.Len(t, main.vectors, 0)
+		assert.Len(t, tokens.vectors, 5)
+
+		vectors, err := index.MultiVectors(0)
+		require.Nil(t, err)
+		assert.Len(t, vectors, 0)
+
+		ids, _, err := index.SearchByMultiVector([][]float32{{0, 0}, {10, 10}}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+	})
+
+	t.Run("token vectors with different dimensions", func(t *testing.T) {
+		err := index.AddMulti(3, [][]float32{{1, 2}, {1, 2, 3}})
+		assert.EqualError(t, err, "multi vector: token vector 1 has 3 dimensions, "+
+			"but token vector 0 has 2")
+	})
+
+	t.Run("query vectors with the wrong dimensions", func(t *testing.T) {
+		_, _, err := index.SearchByMultiVector([][]float32{{1, 2, 3}}, 3, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("listing files of both indexes", func(t *testing.T) {
+		main.files = []string{"a"}
+		tokens.files = []string{"b"}
+
+		files, err := index.ListFiles()
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a", "b"}, files)
+	})
+}
+
+type fakeIndex struct {
+	vectors  map[uint64][]float32
+	files    []string
+	searches int
+}
+
+func newFakeIndex() *fakeIndex {
+	return &fakeIndex{vectors: map[uint64][]float32{}}
+}
+
+func (f *fakeIndex) Add(id uint64, vector []float32) error {
+	f.vectors[id] = vector
+	return nil
+}
+
+func (f *fakeIndex) Delete(id uint64) error {
+	delete(f.vectors, id)
+	return nil
+}
+
+// SearchByVector is an exact search based on l2-squared distances
+func (f *fakeIndex) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList) ([]uint64, []float32, error) {
+	f.searches++
+
+	var results []result
+	for id, vec := range f.vectors {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+
+		dist, ok, err := distancer.NewL2SquaredProvider().SingleDist(vector, vec)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+		results = append(results, result{id: id, dist: dist})
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		return results[a].dist < results[b].dist
+	})
+	if len(results) > k {
+		results = results[:k]
+	}
+
+	ids := make([]uint64, len(results))
+	dists := make([]float32, len(results))
+	for i, res := range results {
+		ids[i] = res.id
+		dists[i] = res.dist
+	}
+	return ids, dists, nil
+}
+
+func (f *fakeIndex) UpdateUserConfig(updated schema.VectorIndexConfig) error {
+	return nil
+}
+
+func (f *fakeIndex) Drop() error {
+	return nil
+}
+
+func (f *fakeIndex) Flush() error {
+	return nil
+}
+
+func (f *fakeIndex) PauseMaintenance() {}
+
+func (f *fakeIndex) ResumeMaintenance() {}
+
+func (f *fakeIndex) SwitchCommitLogs() error {
+	return nil
+}
+
+func (f *fakeIndex) ListFiles() ([]string, error) {
+	return f.files, nil
+}
+
+type fakeTokens struct {
+	first uint64
+	count int
+}
+
+// fakeStore reads the token vectors from the token index, the shard reads
+// them from the stored object instead
+type fakeStore struct {
+	index *fakeIndex
+	next  uint64
+	docs  map[uint64]fakeTokens
+	// tokens contains the doc id of every token
+	tokens map[uint64]uint64
+}
+
+func newFakeStore(index *fakeIndex) *fakeStore {
+	return &fakeStore{
+		index:  index,
+		docs:   map[uint64]fakeTokens{},
+		tokens: map[uint64]uint64{},
+	}
+}
+
+func (f *fakeStore) AllocateTokens(docID uint64, count int) (uint64, error) {
+	first := f.next
+	f.next += uint64(count)
+	f.docs[docID] = fakeTokens{first: first, count: count}
+	for pos := 0; pos < count; pos++ {
+		f.tokens[first+uint64(pos)] = docID
+	}
+	return first, nil
+}
+
+func (f *fakeStore) Tokens(docID uint64) (uint64, int, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return 0, 0, nil
+	}
+	return doc.first, doc.count, nil
+}
+
+func (f *fakeStore) DeleteTokens(docID uint64) error {
+	doc := f.docs[docID]
+	for pos := 0; pos < doc.count; pos++ {
+		delete(f.tokens, doc.first+uint64(pos))
+	}
+	delete(f.docs, docID)
+	return nil
+}
+
+func (f *fakeStore) DocID(tokenID uint64) (uint64, bool, error) {
+	docID, ok := f.tokens[tokenID]
+	return docID, ok, nil
+}
+
+func (f *fakeStore) Vectors(docID uint64) ([][]float32, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return nil, nil
+	}
+
+	out := make([][]float32, doc.count)
+	for pos := range out {
+		out[pos] = f.index.vectors[doc.first+uint64(pos)]
+	}
+	return out, nil
+}
@@ -49,3 +49,15 @@ func (i *Index) Drop() error {
 func (i *Index) Flush() error {
 	return nil
 }
+
+func (i *Index) PauseMaintenance() {}
+
+func (i *Index) ResumeMaintenance() {}
+
+func (i *Index) SwitchCommitLogs() error {
+	return nil
+}
+
+func (i *Index) ListFiles() ([]string, error) {
+	return nil, nil
+}
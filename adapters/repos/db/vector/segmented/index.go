@@ -0,0 +1,309 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package segmented splits the vectors of a single shard across multiple
+// independent vector indexes. This keeps the size of each graph - and the
+// contention on its insert lock - bounded for very large shards. Searches are
+// run on all segments in parallel and their results are merged.
+package segmented
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"golang.org/x/sync/errgroup"
+)
+
+// Segment is a vector index holding a part of the vectors of a shard
+type Segment interface {
+	Add(id uint64, vector []float32) error
+	Delete(id uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schema.VectorIndexConfig) error
+	Drop() error
+	Flush() error
+	PauseMaintenance()
+	ResumeMaintenance()
+	SwitchCommitLogs() error
+	ListFiles() ([]string, error)
+}
+
+type Index struct {
+	segments []Segment
+}
+
+// New creates an index which distributes the doc ids across the segments. As
+// the segment of a doc id is derived from the number of segments, the same
+// segments have to be passed in the same order every time.
+func New(segments []Segment) *Index {
+	return &Index{segments: segments}
+}
+
+func (i *Index) segmentPos(id uint64) int {
+	return int(id % uint64(len(i.segments)))
+}
+
+func (i *Index) Add(id uint64, vector []float32) error {
+	return i.segments[i.segmentPos(id)].Add(id, vector)
+}
+
+func (i *Index) Delete(id uint64) error {
+	return i.segments[i.segmentPos(id)].Delete(id)
+}
+
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList) ([]uint64, []float32, error) {
+	allowPerSegment := i.splitAllowList(allow)
+
+	ids := make([][]uint64, len(i.segments))
+	dists := make([][]float32, len(i.segments))
+	eg := &errgroup.Group{}
+	for pos := range i.segments {
+		pos := pos
+		eg.Go(func() error {
+			var allow helpers.AllowList
+			if allowPerSegment != nil {
+				allow = allowPerSegment[pos]
+				if len(allow) == 0 {
+					// nothing in this segment can match
+					return nil
+				}
+			}
+
+			var err error
+			ids[pos], dists[pos], err = i.segments[pos].SearchByVector(vector, k, allow)
+			if err != nil {
+				return errors.Wrapf(err, "segment %d", pos)
+			}
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	return merge(ids, dists, k)
+}
+
+// splitAllowList assigns each allowed doc id to its segment. Otherwise a
+// segment could return vectors which are not part of its graph, e.g. in a
+// flat search, and the same object would be contained in the results multiple
+// times.
+func (i *Index) splitAllowList(allow helpers.AllowList) []helpers.AllowList {
+	if allow == nil {
+		return nil
+	}
+
+	out := make([]helpers.AllowList, len(i.segments))
+	for pos := range out {
+		out[pos] = helpers.AllowList{}
+	}
+
+	for id := range allow {
+		out[i.segmentPos(id)].Insert(id)
+	}
+
+	return out
+}
+
+type result struct {
+	id   uint64
+	dist float32
+}
+
+// merge combines the results of all segments, each of which is already
+// sorted by distance, into the k closest ones overall
+func merge(ids [][]uint64, dists [][]float32, k int) ([]uint64, []float32, error) {
+	var results []result
+	for pos := range ids {
+		if len(ids[pos]) != len(dists[pos]) {
+			return nil, nil, errors.Errorf("segment %d: got %d ids, but %d distances",
+				pos, len(ids[pos]), len(dists[pos]))
+		}
+
+		for j := range ids[pos] {
+			results = append(results, result{id: ids[pos][j], dist: dists[pos][j]})
+		}
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		return results[a].dist < results[b].dist
+	})
+
+	if len(results) > k {
+		results = results[:k]
+	}
+
+	outIDs := make([]uint64, len(results))
+	outDists := make([]float32, len(results))
+	for j, res := range results {
+		outIDs[j] = res.id
+		outDists[j] = res.dist
+	}
+
+	return outIDs, outDists, nil
+}
+
+func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig) error {
+	for pos, segment := range i.segments {
+		if err := segment.UpdateUserConfig(updated); err != nil {
+			return errors.Wrapf(err, "segment %d", pos)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) Drop() error {
+	for pos, segment := range i.segments {
+		if err := segment.Drop(); err != nil {
+			return errors.Wrapf(err, "segment %d", pos)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) Flush() error {
+	for pos, segment := range i.segments {
+		if err := segment.Flush(); err != nil {
+			return errors.Wrapf(err, "segment %d", pos)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) PauseMaintenance() {
+	for _, segment := range i.segments {
+		segment.PauseMaintenance()
+	}
+}
+
+func (i *Index) ResumeMaintenance() {
+	for _, segment := range i.segments {
+		segment.ResumeMaintenance()
+	}
+}
+
+func (i *Index) SwitchCommitLogs() error {
+	for pos, segment := range i.segments {
+		if err := segment.SwitchCommitLogs(); err != nil {
+			return errors.Wrapf(err, "segment %d", pos)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) ListFiles() ([]string, error) {
+	var out []string
+	for pos, segment := range i.segments {
+		files, err := segment.ListFiles()
+		if err != nil {
+			return nil, errors.Wrapf(err, "segment %d", pos)
+		}
+		out = append(out, files...)
+	}
+
+	return out, nil
+}
+
+type vectorCacheStatser interface {
+	VectorCacheStats() hnsw.VectorCacheStats
+}
+
+// VectorCacheStats sums up the vector caches of all segments, every segment
+// has its own cache which may hold up to vectorCacheMaxObjects vectors
+func (i *Index) VectorCacheStats() hnsw.VectorCacheStats {
+	var out hnsw.VectorCacheStats
+	for _, segment := range i.segments {
+		statser, ok := segment.(vectorCacheStatser)
+		if !ok {
+			continue
+		}
+
+		stats := statser.VectorCacheStats()
+		out.Objects += stats.Objects
+		out.MaxObjects += stats.MaxObjects
+	}
+
+	return out
+}
+
+type tombstoneCleaner interface {
+	TombstoneCount() int
+	CleanUpTombstonedNodes() error
+}
+
+// TombstoneCount sums up the deleted vectors of all segments which have not
+// been cleaned up yet
+func (i *Index) TombstoneCount() int {
+	count := 0
+	for _, segment := range i.segments {
+		cleaner, ok := segment.(tombstoneCleaner)
+		if !ok {
+			continue
+		}
+
+		count += cleaner.TombstoneCount()
+	}
+
+	return count
+}
+
+// CleanUpTombstonedNodes cleans up the segments one after the other, so the
+// cleanup of a segmented index causes no more background load than the cleanup
+// of a single graph
+func (i *Index) CleanUpTombstonedNodes() error {
+	for pos, segment := range i.segments {
+		cleaner, ok := segment.(tombstoneCleaner)
+		if !ok {
+			continue
+		}
+
+		if err := cleaner.CleanUpTombstonedNodes(); err != nil {
+			return errors.Wrapf(err, "segment %d", pos)
+		}
+	}
+
+	return nil
+}
+
+type commitLogStatser interface {
+	CommitLogStats() hnsw.CommitLogStats
+}
+
+// CommitLogStats sums up the commit logs of all segments, every segment
+// has its own commit log
+func (i *Index) CommitLogStats() hnsw.CommitLogStats {
+	var out hnsw.CommitLogStats
+	for _, segment := range i.segments {
+		statser, ok := segment.(commitLogStatser)
+		if !ok {
+			continue
+		}
+
+		stats := statser.CommitLogStats()
+		out.Files += stats.Files
+		out.Bytes += stats.Bytes
+		out.Condensings += stats.Condensings
+		out.CondensedInputBytes += stats.CondensedInputBytes
+		out.CondensedOutputBytes += stats.CondensedOutputBytes
+		out.Combinings += stats.Combinings
+	}
+
+	return out
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package segmented
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentedIndex(t *testing.T) {
+	segments := []*fakeSegment{newFakeSegment(), newFakeSegment()}
+	index := New([]Segment{segments[0], segments[1]})
+
+	t.Run("adding vectors", func(t *testing.T) {
+		for id := uint64(0); id < 6; id++ {
+			require.Nil(t, index.Add(id, []float32{float32(id)}))
+		}
+	})
+
+	t.Run("vectors are distributed across segments", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{0, 2, 4}, segments[0].ids())
+		assert.ElementsMatch(t, []uint64{1, 3, 5}, segments[1].ids())
+	})
+
+	t.Run("searching merges the results of all segments", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector([]float32{2.1}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3, 1}, ids)
+		assert.InDeltaSlice(t, []float32{0.1, 0.9, 1.1}, dists, 0.0001)
+	})
+
+	t.Run("searching with an allow list", func(t *testing.T) {
+		allow := helpers.AllowList{}
+		allow.Insert(0)
+		allow.Insert(4)
+		searchesBefore := segments[1].searches
+
+		ids, _, err := index.SearchByVector([]float32{2.1}, 3, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{4, 0}, ids)
+		assert.Equal(t, searchesBefore, segments[1].searches,
+			"segment without allowed ids should not be searched")
+	})
+
+	t.Run("deleting a vector", func(t *testing.T) {
+		require.Nil(t, index.Delete(3))
+		assert.ElementsMatch(t, []uint64{1, 5}, segments[1].ids())
+
+		ids, _, err := index.SearchByVector([]float32{2.1}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+	})
+
+	t.Run("listing files of all segments", func(t *testing.T) {
+		segments[0].files = []string{"a"}
+		segments[1].files = []string{"b", "c"}
+
+		files, err := index.ListFiles()
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, files)
+	})
+
+	t.Run("a failing segment", func(t *testing.T) {
+		segments[1].err = errors.Errorf("oops")
+
+		_, _, err := index.SearchByVector([]float32{2.1}, 2, nil)
+		assert.EqualError(t, err, "segment 1: oops")
+
+		assert.EqualError(t, index.Flush(), "segment 1: oops")
+	})
+}
+
+type fakeSegment struct {
+	vectors  map[uint64][]float32
+	files    []string
+	err      error
+	searches int
+}
+
+func newFakeSegment() *fakeSegment {
+	return &fakeSegment{vectors: map[uint64][]float32{}}
+}
+
+func (f *fakeSegment) ids() []uint64 {
+	var out []uint64
+	for id := range f.vectors {
+		out = append(out, id)
+	}
+	return out
+}
+
+func (f *fakeSegment) Add(id uint64, vector []float32) error {
+	f.vectors[id] = vector
+	return nil
+}
+
+func (f *fakeSegment) Delete(id uint64) error {
+	delete(f.vectors, id)
+	return nil
+}
+
+// SearchByVector uses the absolute difference of the first dimension as the
+// distance
+func (f *fakeSegment) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList) ([]uint64, []float32, error) {
+	f.searches++
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	var ids [][]uint64
+	var dists [][]float32
+	for id, vec := range f.vectors {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+
+		dist := vec[0] - vector[0]
+		if dist < 0 {
+			dist = -dist
+		}
+		ids = append(ids, []uint64{id})
+		dists = append(dists, []float32{dist})
+	}
+
+	return merge(ids, dists, k)
+}
+
+func (f *fakeSegment) UpdateUserConfig(updated schema.VectorIndexConfig) error {
+	return f.err
+}
+
+func (f *fakeSegment) Drop() error {
+	return f.err
+}
+
+func (f *fakeSegment) Flush() error {
+	return f.err
+}
+
+func (f *fakeSegment) PauseMaintenance() {}
+
+func (f *fakeSegment) ResumeMaintenance() {}
+
+func (f *fakeSegment) SwitchCommitLogs() error {
+	return f.err
+}
+
+func (f *fakeSegment) ListFiles() ([]string, error) {
+	return f.files, f.err
+}
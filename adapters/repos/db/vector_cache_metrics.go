@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// vectorCacheStatser is implemented by the vector indexes which keep vectors
+// in memory, an index without a cache, such as the noop index, reports
+// nothing
+type vectorCacheStatser interface {
+	VectorCacheStats() hnsw.VectorCacheStats
+}
+
+func (d *DB) collectVectorCacheMetrics() []*monitoring.Family {
+	objects := monitoring.NewGauge("weaviate_vector_cache_objects",
+		"Number of vectors of a shard currently held in memory by the vector cache",
+		"class", "shard")
+	maxObjects := monitoring.NewGauge("weaviate_vector_cache_max_objects",
+		"Number of vectors of a shard the vector cache may hold, see vectorCacheMaxObjects",
+		"class", "shard")
+
+	for _, index := range d.indices {
+		class := index.Config.ClassName.String()
+		index.shardsLock.RLock()
+		for name, shard := range index.Shards {
+			statser, ok := shard.vectorIndex.(vectorCacheStatser)
+			if !ok {
+				continue
+			}
+
+			stats := statser.VectorCacheStats()
+			objects.Add(float64(stats.Objects), class, name)
+			maxObjects.Add(float64(stats.MaxObjects), class, name)
+		}
+		index.shardsLock.RUnlock()
+	}
+
+	return []*monitoring.Family{objects, maxObjects}
+}
@@ -25,4 +25,8 @@ type VectorIndex interface {
 	UpdateUserConfig(updated schema.VectorIndexConfig) error
 	Drop() error
 	Flush() error
+	PauseMaintenance()
+	ResumeMaintenance()
+	SwitchCommitLogs() error
+	ListFiles() ([]string, error)
 }
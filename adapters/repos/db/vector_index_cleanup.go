@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// tombstoneCleaner is implemented by the vector indexes which keep deleted
+// vectors as tombstones until they are cleaned up, such as hnsw. An index
+// without a graph, such as the noop index, has nothing to clean up.
+type tombstoneCleaner interface {
+	TombstoneCount() int
+	CleanUpTombstonedNodes() error
+}
+
+// vectorIndexTombstones returns the number of deleted vectors which are still
+// part of the vector index of the shard
+func (s *Shard) vectorIndexTombstones() int64 {
+	cleaner, ok := s.vectorIndex.(tombstoneCleaner)
+	if !ok {
+		return 0
+	}
+
+	return int64(cleaner.TombstoneCount())
+}
+
+func (s *Shard) cleanupVectorIndex() (*models.VectorIndexCleanupResponse, error) {
+	cleaner, ok := s.vectorIndex.(tombstoneCleaner)
+	if !ok {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"the vector index of shard %q has no tombstones to clean up", s.name)
+	}
+
+	before := cleaner.TombstoneCount()
+	if err := cleaner.CleanUpTombstonedNodes(); err != nil {
+		return nil, err
+	}
+
+	return &models.VectorIndexCleanupResponse{
+		TombstonesBefore: int64(before),
+		TombstonesAfter:  int64(cleaner.TombstoneCount()),
+	}, nil
+}
+
+// cleanupVectorIndex removes the tombstones from the vector index of a local
+// shard, it blocks until the cleanup is complete
+func (i *Index) cleanupVectorIndex(ctx context.Context,
+	shardName string) (*models.VectorIndexCleanupResponse, error) {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"shard %q is not loaded on this node", shardName)
+	}
+
+	res, err := shard.cleanupVectorIndex()
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shardName)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// writeStalls are the writes to any bucket of the shard which had to wait
+// for a memtable flush, see lsmkv.WriteStalls
+func (s *Shard) writeStalls() lsmkv.WriteStalls {
+	return s.store.WriteStalls()
+}
+
+// collectWriteStallMetrics reports the write stalls and coalesced puts of
+// every shard loaded on this node
+func (d *DB) collectWriteStallMetrics() []*monitoring.Family {
+	stalled := monitoring.NewGauge("weaviate_shard_stalled_writes",
+		"Number of writes to a shard currently waiting for a memtable flush",
+		"class", "shard")
+	stalls := monitoring.NewCounter("weaviate_shard_write_stalls_total",
+		"Number of writes to a shard which had to wait for a memtable flush",
+		"class", "shard")
+	stallSeconds := monitoring.NewCounter("weaviate_shard_write_stall_seconds_total",
+		"Time writes to a shard spent waiting for memtable flushes",
+		"class", "shard")
+	coalesced := monitoring.NewCounter("weaviate_shard_coalesced_puts_total",
+		"Number of puts to a shard which were merged into a later put of the same object",
+		"class", "shard")
+
+	for _, index := range d.indices {
+		class := index.Config.ClassName.String()
+		index.shardsLock.RLock()
+		for name, shard := range index.Shards {
+			writeStalls := shard.writeStalls()
+			stalled.Add(float64(writeStalls.Stalled), class, name)
+			stalls.Add(float64(writeStalls.Total), class, name)
+			stallSeconds.Add(writeStalls.Duration.Seconds(), class, name)
+			coalesced.Add(float64(shard.coalescer.coalescedPuts()), class, name)
+		}
+		index.shardsLock.RUnlock()
+	}
+
+	return []*monitoring.Family{stalled, stalls, stallSeconds, coalesced}
+}
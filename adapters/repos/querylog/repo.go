@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package querylog
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var queryLogBucket = []byte("querylog")
+
+// Repo stores the most recent recorded queries in a dedicated bucket. Once
+// maxEntries are stored, every new entry replaces the oldest one.
+type Repo struct {
+	logger     logrus.FieldLogger
+	baseDir    string
+	maxEntries int
+	db         *bolt.DB
+}
+
+func NewRepo(baseDir string, maxEntries int, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir:    baseDir,
+		maxEntries: maxEntries,
+		logger:     logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/querylog.db", r.baseDir)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(queryLogBucket); err != nil {
+			return errors.Wrapf(err, "create query log bucket '%s'",
+				string(queryLogBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+// keyFromSeq keeps the entries in the order they were recorded
+func keyFromSeq(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func (r *Repo) Put(entry traverser.QueryLogEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "marshal query log entry to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(queryLogBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		if r.maxEntries > 0 && seq > uint64(r.maxEntries) {
+			if err := b.Delete(keyFromSeq(seq - uint64(r.maxEntries))); err != nil {
+				return err
+			}
+		}
+
+		return b.Put(keyFromSeq(seq), entryJSON)
+	})
+}
+
+// Iterate calls fn with every stored entry from the oldest to the most recent
+// one, it stops at the first error
+func (r *Repo) Iterate(fn func(entry traverser.QueryLogEntry) error) error {
+	return r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(queryLogBucket).ForEach(func(k, v []byte) error {
+			var entry traverser.QueryLogEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return errors.Wrap(err, "parse query log entry from JSON")
+			}
+
+			return fn(entry)
+		})
+	})
+}
+
+// Clear removes all entries, so that a new workload can be recorded
+func (r *Repo) Clear() error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(queryLogBucket); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateBucket(queryLogBucket)
+		return err
+	})
+}
+
+func (r *Repo) Shutdown() error {
+	return r.db.Close()
+}
+
+var _ = traverser.QueryLogRepo(&Repo{})
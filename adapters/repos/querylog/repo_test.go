@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package querylog
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepo(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	repo, err := NewRepo(t.TempDir(), 3, logger)
+	require.Nil(t, err)
+	defer repo.Shutdown()
+
+	stored := func() []int64 {
+		var times []int64
+		err := repo.Iterate(func(entry traverser.QueryLogEntry) error {
+			times = append(times, entry.Time)
+			return nil
+		})
+		require.Nil(t, err)
+		return times
+	}
+
+	t.Run("entries are kept in the order they were recorded", func(t *testing.T) {
+		for i := int64(1); i <= 2; i++ {
+			require.Nil(t, repo.Put(traverser.QueryLogEntry{Time: i}))
+		}
+		assert.Equal(t, []int64{1, 2}, stored())
+	})
+
+	t.Run("the oldest entries are replaced once full", func(t *testing.T) {
+		for i := int64(3); i <= 5; i++ {
+			require.Nil(t, repo.Put(traverser.QueryLogEntry{Time: i}))
+		}
+		assert.Equal(t, []int64{3, 4, 5}, stored())
+	})
+
+	t.Run("clearing the log", func(t *testing.T) {
+		require.Nil(t, repo.Clear())
+		assert.Len(t, stored(), 0)
+
+		require.Nil(t, repo.Put(traverser.QueryLogEntry{Time: 6}))
+		assert.Equal(t, []int64{6}, stored())
+	})
+}
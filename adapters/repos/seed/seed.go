@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package seed initializes an empty data directory from a published
+// snapshot, so that demo and CI environments can start with a known dataset
+// instead of having to run a lengthy import.
+package seed
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Seeder downloads a snapshot archive (tar or gzipped tar) of a previously
+// exported data directory and extracts it into the configured data path. A
+// snapshot is only ever restored into an empty data path, an existing
+// dataset is never overwritten.
+type Seeder struct {
+	dataPath string
+	client   *http.Client
+	logger   logrus.FieldLogger
+}
+
+func New(dataPath string, client *http.Client,
+	logger logrus.FieldLogger) *Seeder {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Seeder{
+		dataPath: dataPath,
+		client:   client,
+		logger:   logger,
+	}
+}
+
+// Seed restores the snapshot at source into the data path. The source can
+// either be a http(s) URL, a file:// URL or a plain path on the local
+// filesystem. The returned bool indicates whether a snapshot was restored, it
+// is false without an error if the data path already contains data.
+func (s *Seeder) Seed(ctx context.Context, source string) (bool, error) {
+	empty, err := isEmptyDir(s.dataPath)
+	if err != nil {
+		return false, errors.Wrapf(err, "inspect data path %s", s.dataPath)
+	}
+
+	if !empty {
+		s.logger.WithField("action", "seed_snapshot").
+			WithField("source", source).
+			Info("data path is not empty, skipping snapshot seeding")
+		return false, nil
+	}
+
+	s.logger.WithField("action", "seed_snapshot").
+		WithField("source", source).
+		Info("seeding empty data path from snapshot")
+
+	body, err := s.open(ctx, source)
+	if err != nil {
+		return false, errors.Wrap(err, "open snapshot")
+	}
+	defer body.Close()
+
+	if err := s.extract(body); err != nil {
+		// never leave a half-restored snapshot behind, the next startup would
+		// otherwise consider the data path as already seeded
+		if cleanupErr := clearDir(s.dataPath); cleanupErr != nil {
+			s.logger.WithField("action", "seed_snapshot").
+				WithError(cleanupErr).Error("could not clean up partial snapshot")
+		}
+		return false, errors.Wrap(err, "extract snapshot")
+	}
+
+	s.logger.WithField("action", "seed_snapshot").
+		WithField("source", source).
+		Info("successfully seeded data path from snapshot")
+
+	return true, nil
+}
+
+func (s *Seeder) open(ctx context.Context, source string) (io.ReadCloser, error) {
+	switch {
+	case strings.HasPrefix(source, "http://"), strings.HasPrefix(source, "https://"):
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := s.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return nil, errors.Errorf("unexpected status code %d", res.StatusCode)
+		}
+
+		return res.Body, nil
+	case strings.HasPrefix(source, "file://"):
+		return os.Open(strings.TrimPrefix(source, "file://"))
+	case strings.Contains(source, "://"):
+		return nil, errors.Errorf("unsupported snapshot source scheme in %q", source)
+	default:
+		return os.Open(source)
+	}
+}
+
+func (s *Seeder) extract(in io.Reader) error {
+	buffered := bufio.NewReader(in)
+	var r io.Reader = buffered
+	if isGzipped(buffered) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return errors.Wrap(err, "init gzip reader")
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	if err := os.MkdirAll(s.dataPath, 0o777); err != nil {
+		return errors.Wrapf(err, "create data path %s", s.dataPath)
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "read archive")
+		}
+
+		target, err := s.targetPath(hdr.Name)
+		if err != nil {
+			return err
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o777); err != nil {
+				return errors.Wrapf(err, "create dir %s", target)
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, tr); err != nil {
+				return errors.Wrapf(err, "write file %s", target)
+			}
+		default:
+			// links and special files are never part of a data directory
+			return errors.Errorf("unsupported entry %q of type %c", hdr.Name,
+				hdr.Typeflag)
+		}
+	}
+}
+
+// targetPath makes sure an entry can never be written outside of the data
+// path, e.g. through a "../" in the entry name
+func (s *Seeder) targetPath(name string) (string, error) {
+	target := filepath.Join(s.dataPath, name)
+	root := filepath.Clean(s.dataPath) + string(os.PathSeparator)
+	if !strings.HasPrefix(target+string(os.PathSeparator), root) {
+		return "", errors.Errorf("illegal entry %q outside of data path", name)
+	}
+
+	return target, nil
+}
+
+func writeFile(target string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o777); err != nil {
+		return err
+	}
+
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// isGzipped sniffs the gzip magic bytes, as neither URLs nor content types
+// are a reliable indicator of the compression used
+func isGzipped(r *bufio.Reader) bool {
+	magic, err := r.Peek(2)
+	return err == nil && magic[0] == 0x1f && magic[1] == 0x8b
+}
+
+func isEmptyDir(path string) (bool, error) {
+	entries, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return len(entries) == 0, nil
+}
+
+func clearDir(path string) error {
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(path, entry.Name())); err != nil {
+			return fmt.Errorf("remove %s: %v", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package seed
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedFromURL(t *testing.T) {
+	archive := buildArchive(t, map[string]string{
+		"schema.db":               "schema",
+		"myclass_abc_lsm/segment": "segment",
+	}, true)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write(archive)
+		}))
+	defer server.Close()
+
+	dataPath := filepath.Join(t.TempDir(), "data")
+	logger, _ := test.NewNullLogger()
+
+	seeded, err := New(dataPath, nil, logger).
+		Seed(context.Background(), server.URL+"/snapshot?version=1")
+	require.Nil(t, err)
+	assert.True(t, seeded)
+
+	assertFileContent(t, filepath.Join(dataPath, "schema.db"), "schema")
+	assertFileContent(t, filepath.Join(dataPath, "myclass_abc_lsm", "segment"),
+		"segment")
+}
+
+func TestSeedFromFile(t *testing.T) {
+	archive := buildArchive(t, map[string]string{"schema.db": "schema"}, false)
+	archivePath := filepath.Join(t.TempDir(), "snapshot.tar")
+	require.Nil(t, ioutil.WriteFile(archivePath, archive, 0o666))
+
+	dataPath := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	seeded, err := New(dataPath, nil, logger).
+		Seed(context.Background(), "file://"+archivePath)
+	require.Nil(t, err)
+	assert.True(t, seeded)
+	assertFileContent(t, filepath.Join(dataPath, "schema.db"), "schema")
+}
+
+func TestSeedSkipsNonEmptyDataPath(t *testing.T) {
+	dataPath := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dataPath, "schema.db"),
+		[]byte("existing"), 0o666))
+	logger, _ := test.NewNullLogger()
+
+	seeded, err := New(dataPath, nil, logger).
+		Seed(context.Background(), "/does/not/exist.tar")
+	require.Nil(t, err)
+	assert.False(t, seeded)
+	assertFileContent(t, filepath.Join(dataPath, "schema.db"), "existing")
+}
+
+func TestSeedRejectsPathTraversal(t *testing.T) {
+	archive := buildArchive(t, map[string]string{
+		"schema.db":     "schema",
+		"../escaped.db": "evil",
+	}, true)
+	archivePath := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	require.Nil(t, ioutil.WriteFile(archivePath, archive, 0o666))
+
+	root := t.TempDir()
+	dataPath := filepath.Join(root, "data")
+	logger, _ := test.NewNullLogger()
+
+	seeded, err := New(dataPath, nil, logger).
+		Seed(context.Background(), archivePath)
+	assert.NotNil(t, err)
+	assert.False(t, seeded)
+
+	_, err = os.Stat(filepath.Join(root, "escaped.db"))
+	assert.True(t, os.IsNotExist(err))
+
+	empty, err := isEmptyDir(dataPath)
+	require.Nil(t, err)
+	assert.True(t, empty, "partially extracted snapshot must be removed")
+}
+
+func buildArchive(t *testing.T, files map[string]string, gzipped bool) []byte {
+	buf := &bytes.Buffer{}
+	var gz *gzip.Writer
+	tw := tar.NewWriter(buf)
+	if gzipped {
+		gz = gzip.NewWriter(buf)
+		tw = tar.NewWriter(gz)
+	}
+
+	// write in a deterministic order, so the traversal test always has a
+	// valid entry extracted before the illegal one
+	for _, name := range []string{"schema.db", "myclass_abc_lsm/segment",
+		"../escaped.db"} {
+		content, ok := files[name]
+		if !ok {
+			continue
+		}
+
+		require.Nil(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, tw.Close())
+	if gzipped {
+		require.Nil(t, gz.Close())
+	}
+
+	return buf.Bytes()
+}
+
+func assertFileContent(t *testing.T, path, expected string) {
+	content, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, expected, string(content))
+}
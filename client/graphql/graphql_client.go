@@ -42,6 +42,8 @@ type ClientService interface {
 
 	GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlPostOK, error)
 
+	GraphqlSandbox(params *GraphqlSandboxParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlSandboxOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -119,6 +121,43 @@ func (a *Client) GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+  GraphqlSandbox runs a graph q l query with resource caps
+
+  Resolves a GraphQL query in a sandbox meant for ad-hoc exploration. The query runs with hard caps on its duration, the memory it allocates and the size of its result, which are configured independently of the limits of regular queries. A query which hits a cap is not failed, instead the results which were complete at that point are returned along with the caps which were hit.
+*/
+func (a *Client) GraphqlSandbox(params *GraphqlSandboxParams, authInfo runtime.ClientAuthInfoWriter) (*GraphqlSandboxOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlSandboxParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "graphql.sandbox",
+		Method:             "POST",
+		PathPattern:        "/graphql/sandbox",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlSandboxReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlSandboxOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.sandbox: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
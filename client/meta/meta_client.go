@@ -40,6 +40,8 @@ type Client struct {
 type ClientService interface {
 	MetaGet(params *MetaGetParams, authInfo runtime.ClientAuthInfoWriter) (*MetaGetOK, error)
 
+	RuntimeConfigUpdate(params *RuntimeConfigUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*RuntimeConfigUpdateOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -80,6 +82,43 @@ func (a *Client) MetaGet(params *MetaGetParams, authInfo runtime.ClientAuthInfoW
 	panic(msg)
 }
 
+/*
+  RuntimeConfigUpdate updates the runtime config
+
+  Changes the settings which can be updated without a restart, such as the log level or read-only mode. The request replaces all runtime settings, the settings in effect afterwards are returned.
+*/
+func (a *Client) RuntimeConfigUpdate(params *RuntimeConfigUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*RuntimeConfigUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRuntimeConfigUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "runtime.config.update",
+		Method:             "PUT",
+		PathPattern:        "/config/runtime",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RuntimeConfigUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RuntimeConfigUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for runtime.config.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -38,10 +38,18 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ObjectsAggregates(params *ObjectsAggregatesParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsAggregatesOK, error)
+
 	ObjectsCreate(params *ObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsCreateOK, error)
 
 	ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsDeleteNoContent, error)
 
+	ObjectsDuplicates(params *ObjectsDuplicatesParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsDuplicatesOK, error)
+
+	ObjectsEstimate(params *ObjectsEstimateParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsEstimateOK, error)
+
+	ObjectsFacets(params *ObjectsFacetsParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsFacetsOK, error)
+
 	ObjectsGet(params *ObjectsGetParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsGetOK, error)
 
 	ObjectsList(params *ObjectsListParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsListOK, error)
@@ -61,6 +69,43 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  ObjectsAggregates reads the current groups of a continuous aggregate
+
+  Reads the current groups of a continuous aggregate of a class, see the continuousAggregates of the invertedIndexConfig. The aggregate is updated on every write, so reading it does not scan any Objects.
+*/
+func (a *Client) ObjectsAggregates(params *ObjectsAggregatesParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsAggregatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsAggregatesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "objects.aggregates",
+		Method:             "GET",
+		PathPattern:        "/objects/aggregates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsAggregatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsAggregatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.aggregates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ObjectsCreate creates objects between two objects object and subject
 
@@ -135,6 +180,117 @@ func (a *Client) ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+  ObjectsDuplicates finds groups of near duplicate objects
+
+  Finds groups of Objects of a class whose vectors are near-identical, e.g. to clean up a dataset after importing it twice. Every Object is compared to its nearest neighbors in the vector index, neighbors which are closer than the given distance are linked and linked Objects form a group. All Objects of the class are compared, so this can take a while on large classes.
+*/
+func (a *Client) ObjectsDuplicates(params *ObjectsDuplicatesParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsDuplicatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsDuplicatesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "objects.duplicates",
+		Method:             "GET",
+		PathPattern:        "/objects/duplicates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsDuplicatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsDuplicatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.duplicates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ObjectsEstimate estimates the number of objects matching a filter
+
+  Dry runs a where filter on a class and returns an upper bound of the number of matching Objects together with the indexes which serve each clause of the filter, and optionally also the exact number of matches. No Objects are read or changed, so e.g. the filter of a retention policy or of a batch delete can be validated before it is run.
+*/
+func (a *Client) ObjectsEstimate(params *ObjectsEstimateParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsEstimateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsEstimateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "objects.estimate",
+		Method:             "POST",
+		PathPattern:        "/objects/estimate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsEstimateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsEstimateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.estimate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ObjectsFacets searches objects and count the values of their properties
+
+  Returns the first Objects of a class matching the filter together with the number of matching Objects for the most common values of each faceted property. The filter is only resolved once for both, so the Objects and the facets are always consistent with one another, and a faceted search UI does not need to run the same filter as a Get and an Aggregate query.
+*/
+func (a *Client) ObjectsFacets(params *ObjectsFacetsParams, authInfo runtime.ClientAuthInfoWriter) (*ObjectsFacetsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsFacetsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "objects.facets",
+		Method:             "POST",
+		PathPattern:        "/objects/facets",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsFacetsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsFacetsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.facets: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ObjectsGet gets a specific object based on its UUID and a object UUID also available as websocket bus
 
@@ -84,6 +84,21 @@ for the objects list operation typically these are written to a http.Request
 */
 type ObjectsListParams struct {
 
+	/*After
+	  A cursor to iterate over all objects of a class. The results are ordered by id and start right after the object with this id. Requires class to be set and can not be combined with offset.
+
+	*/
+	After *string
+	/*Bm25
+	  Keywords to rank the objects of a class by their BM25 relevance in all indexed text and string properties, without involving any vector. Requires class to be set and can not be combined with after or scroll.
+
+	*/
+	Bm25 *string
+	/*Class
+	  The class to list the objects of. If set the objects are ordered by id, use after to continue after the last object of the previous page.
+
+	*/
+	Class *string
 	/*Include
 	  Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation
 
@@ -99,6 +114,16 @@ type ObjectsListParams struct {
 
 	*/
 	Offset *int64
+	/*Scroll
+	  Open or continue a scroll, which iterates over a snapshot of all objects of a class. Objects written while the scroll is open are neither skipped nor returned twice. The value is the duration to keep the scroll open until the next page is requested, e.g. 1m, at most 1h. A new scroll requires class to be set and can not be combined with offset or after.
+
+	*/
+	Scroll *string
+	/*ScrollID
+	  The scrollId returned with the previous page of a scroll. Requires scroll to be set.
+
+	*/
+	ScrollID *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -138,6 +163,39 @@ func (o *ObjectsListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAfter adds the after to the objects list params
+func (o *ObjectsListParams) WithAfter(after *string) *ObjectsListParams {
+	o.SetAfter(after)
+	return o
+}
+
+// SetAfter adds the after to the objects list params
+func (o *ObjectsListParams) SetAfter(after *string) {
+	o.After = after
+}
+
+// WithBm25 adds the bm25 to the objects list params
+func (o *ObjectsListParams) WithBm25(bm25 *string) *ObjectsListParams {
+	o.SetBm25(bm25)
+	return o
+}
+
+// SetBm25 adds the bm25 to the objects list params
+func (o *ObjectsListParams) SetBm25(bm25 *string) {
+	o.Bm25 = bm25
+}
+
+// WithClass adds the class to the objects list params
+func (o *ObjectsListParams) WithClass(class *string) *ObjectsListParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the objects list params
+func (o *ObjectsListParams) SetClass(class *string) {
+	o.Class = class
+}
+
 // WithInclude adds the include to the objects list params
 func (o *ObjectsListParams) WithInclude(include *string) *ObjectsListParams {
 	o.SetInclude(include)
@@ -171,6 +229,28 @@ func (o *ObjectsListParams) SetOffset(offset *int64) {
 	o.Offset = offset
 }
 
+// WithScroll adds the scroll to the objects list params
+func (o *ObjectsListParams) WithScroll(scroll *string) *ObjectsListParams {
+	o.SetScroll(scroll)
+	return o
+}
+
+// SetScroll adds the scroll to the objects list params
+func (o *ObjectsListParams) SetScroll(scroll *string) {
+	o.Scroll = scroll
+}
+
+// WithScrollID adds the scrollID to the objects list params
+func (o *ObjectsListParams) WithScrollID(scrollID *string) *ObjectsListParams {
+	o.SetScrollID(scrollID)
+	return o
+}
+
+// SetScrollID adds the scrollId to the objects list params
+func (o *ObjectsListParams) SetScrollID(scrollID *string) {
+	o.ScrollID = scrollID
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -179,6 +259,54 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.After != nil {
+
+		// query param after
+		var qrAfter string
+		if o.After != nil {
+			qrAfter = *o.After
+		}
+		qAfter := qrAfter
+		if qAfter != "" {
+			if err := r.SetQueryParam("after", qAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Bm25 != nil {
+
+		// query param bm25
+		var qrBm25 string
+		if o.Bm25 != nil {
+			qrBm25 = *o.Bm25
+		}
+		qBm25 := qrBm25
+		if qBm25 != "" {
+			if err := r.SetQueryParam("bm25", qBm25); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Class != nil {
+
+		// query param class
+		var qrClass string
+		if o.Class != nil {
+			qrClass = *o.Class
+		}
+		qClass := qrClass
+		if qClass != "" {
+			if err := r.SetQueryParam("class", qClass); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Include != nil {
 
 		// query param include
@@ -227,6 +355,38 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 
 	}
 
+	if o.Scroll != nil {
+
+		// query param scroll
+		var qrScroll string
+		if o.Scroll != nil {
+			qrScroll = *o.Scroll
+		}
+		qScroll := qrScroll
+		if qScroll != "" {
+			if err := r.SetQueryParam("scroll", qScroll); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ScrollID != nil {
+
+		// query param scrollId
+		var qrScrollID string
+		if o.ScrollID != nil {
+			qrScrollID = *o.ScrollID
+		}
+		qScrollID := qrScrollID
+		if qScrollID != "" {
+			if err := r.SetQueryParam("scrollId", qScrollID); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -48,8 +48,22 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsStatus(params *SchemaObjectsStatusParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsStatusOK, error)
+
+	SchemaObjectsStatusVectorIndexAdvice(params *SchemaObjectsStatusVectorIndexAdviceParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsStatusVectorIndexAdviceAccepted, error)
+
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsUpdateOK, error)
 
+	SchemaShardsVectorIndexCleanup(params *SchemaShardsVectorIndexCleanupParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaShardsVectorIndexCleanupOK, error)
+
+	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsCreateOK, error)
+
+	TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsDeleteOK, error)
+
+	TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsGetOK, error)
+
+	TenantsUpdate(params *TenantsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsUpdateOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -228,6 +242,80 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+  SchemaObjectsStatus gets the runtime status of a class
+
+  Runtime information about a class which is not part of the schema, such as the state of the most recent vector index advisor run on the node receiving the request.
+*/
+func (a *Client) SchemaObjectsStatus(params *SchemaObjectsStatusParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStatusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.status",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/status",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaObjectsStatusVectorIndexAdvice starts a vector index advisor run
+
+  Explores a grid of hnsw parameters on a sample of the vectors in the local shards of the class in the background. Use GET /schema/{className}/status to retrieve the report. Only one run per class can be active at a time. The recommendation is never applied automatically.
+*/
+func (a *Client) SchemaObjectsStatusVectorIndexAdvice(params *SchemaObjectsStatusVectorIndexAdviceParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsStatusVectorIndexAdviceAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStatusVectorIndexAdviceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.status.vectorIndexAdvice",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/status/vector-index-advice",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsStatusVectorIndexAdviceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsStatusVectorIndexAdviceAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.status.vectorIndexAdvice: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaObjectsUpdate updates settings of an existing schema class
 
@@ -265,6 +353,187 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+  SchemaShardsVectorIndexCleanup cleans up the vector index of a shard
+
+  Removes the tombstones of deleted vectors from the vector index of a shard right away instead of waiting for the periodic cleanup configured with cleanupIntervalSeconds. The shard has to be located on the node receiving the request.
+*/
+func (a *Client) SchemaShardsVectorIndexCleanup(params *SchemaShardsVectorIndexCleanupParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaShardsVectorIndexCleanupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaShardsVectorIndexCleanupParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.shards.vectorIndex.cleanup",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/shards/{shardName}/vector-index/cleanup",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaShardsVectorIndexCleanupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaShardsVectorIndexCleanupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.shards.vectorIndex.cleanup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsCreate creates a new tenant
+
+  Create a new tenant for a specific class
+*/
+func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsDelete deletes tenants from a specific class
+*/
+func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsGet gets all tenants of a class
+*/
+func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsUpdate updates a tenant
+
+  Update the activity status of the specified tenants of a class
+*/
+func (a *Client) TenantsUpdate(params *TenantsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -21,13 +21,18 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/semi-technologies/weaviate/client/backups"
 	"github.com/semi-technologies/weaviate/client/batch"
 	"github.com/semi-technologies/weaviate/client/classifications"
+	"github.com/semi-technologies/weaviate/client/clusterings"
 	"github.com/semi-technologies/weaviate/client/graphql"
+	"github.com/semi-technologies/weaviate/client/imports"
 	"github.com/semi-technologies/weaviate/client/meta"
+	"github.com/semi-technologies/weaviate/client/nodes"
 	"github.com/semi-technologies/weaviate/client/objects"
 	"github.com/semi-technologies/weaviate/client/operations"
 	"github.com/semi-technologies/weaviate/client/schema"
+	"github.com/semi-technologies/weaviate/client/usage"
 	"github.com/semi-technologies/weaviate/client/well_known"
 )
 
@@ -73,13 +78,18 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 
 	cli := new(Weaviate)
 	cli.Transport = transport
+	cli.Backups = backups.New(transport, formats)
 	cli.Batch = batch.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
+	cli.Clusterings = clusterings.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
+	cli.Imports = imports.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
+	cli.Nodes = nodes.New(transport, formats)
 	cli.Objects = objects.New(transport, formats)
 	cli.Operations = operations.New(transport, formats)
 	cli.Schema = schema.New(transport, formats)
+	cli.Usage = usage.New(transport, formats)
 	cli.WellKnown = well_known.New(transport, formats)
 	return cli
 }
@@ -125,20 +135,30 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // Weaviate is a client for weaviate
 type Weaviate struct {
+	Backups backups.ClientService
+
 	Batch batch.ClientService
 
 	Classifications classifications.ClientService
 
+	Clusterings clusterings.ClientService
+
 	Graphql graphql.ClientService
 
+	Imports imports.ClientService
+
 	Meta meta.ClientService
 
+	Nodes nodes.ClientService
+
 	Objects objects.ClientService
 
 	Operations operations.ClientService
 
 	Schema schema.ClientService
 
+	Usage usage.ClientService
+
 	WellKnown well_known.ClientService
 
 	Transport runtime.ClientTransport
@@ -147,12 +167,17 @@ type Weaviate struct {
 // SetTransport changes the transport on the client and all its subresources
 func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Backups.SetTransport(transport)
 	c.Batch.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
+	c.Clusterings.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
+	c.Imports.SetTransport(transport)
 	c.Meta.SetTransport(transport)
+	c.Nodes.SetTransport(transport)
 	c.Objects.SetTransport(transport)
 	c.Operations.SetTransport(transport)
 	c.Schema.SetTransport(transport)
+	c.Usage.SetTransport(transport)
 	c.WellKnown.SetTransport(transport)
 }
@@ -53,15 +53,6 @@ var ByID = map[string]models.Deprecation{
 		RemovedIn:    ptString("0.23.0"),
 		RemovedTime:  timeMustPtr(time.Parse(time.RFC3339, "2020-06-15T16:18:06.000Z")),
 	},
-	"config-files": models.Deprecation{
-		ID:           "config-files",
-		Status:       "deprecated",
-		APIType:      "Configuration",
-		Mitigation:   "Configure Weaviate using environment variables.",
-		Msg:          "use of deprecated command line argument --config-file",
-		SinceVersion: "0.22.16",
-		SinceTime:    timeMust(time.Parse(time.RFC3339, "2020-09-08T09:46:00.000Z")),
-	},
 	"cardinality": models.Deprecation{
 		ID:           "cardinality",
 		Status:       "deprecated",
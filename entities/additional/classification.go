@@ -26,6 +26,9 @@ type Properties struct {
 	RefMeta        bool                   `json:"refMeta"`
 	Vector         bool                   `json:"vector"`
 	Certainty      bool                   `json:"certainty"`
+	Distance       bool                   `json:"distance"`
+	Score          bool                   `json:"score"`
 	ID             bool                   `json:"id"`
+	Group          bool                   `json:"group"`
 	ModuleParams   map[string]interface{} `json:"moduleParams"`
 }
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregation
+
+import (
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// ContinuousParams identify a continuous aggregate of a class. Continuous
+// aggregates are configured in the inverted index config of the class and
+// updated on every write, so reading them does not scan any objects.
+type ContinuousParams struct {
+	ClassName schema.ClassName `json:"className"`
+	Name      string           `json:"name"`
+}
+
+// ContinuousGroup contains the number of objects with one value of the
+// grouped by property and the sums of the summed up properties over those
+// objects, in the order the properties are listed in the aggregate
+type ContinuousGroup struct {
+	Value string    `json:"value"`
+	Count int64     `json:"count"`
+	Sums  []float64 `json:"sums"`
+}
+
+// CombineContinuous merges the groups of several shards. The counts and sums
+// of the groups with the same value are added up and the groups are ordered
+// by their value.
+func CombineContinuous(in [][]ContinuousGroup) []ContinuousGroup {
+	byValue := map[string]*ContinuousGroup{}
+	for _, groups := range in {
+		for _, group := range groups {
+			combined, ok := byValue[group.Value]
+			if !ok {
+				combined = &ContinuousGroup{
+					Value: group.Value,
+					Sums:  make([]float64, len(group.Sums)),
+				}
+				byValue[group.Value] = combined
+			}
+
+			combined.Count += group.Count
+			for i := range group.Sums {
+				if i < len(combined.Sums) {
+					combined.Sums[i] += group.Sums[i]
+				}
+			}
+		}
+	}
+
+	out := make([]ContinuousGroup, 0, len(byValue))
+	for _, group := range byValue {
+		out = append(out, *group)
+	}
+
+	sort.Slice(out, func(a, b int) bool { return out[a].Value < out[b].Value })
+	return out
+}
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombineContinuous(t *testing.T) {
+	t.Run("with groups on several shards", func(t *testing.T) {
+		res := CombineContinuous([][]ContinuousGroup{
+			{
+				{Value: "books", Count: 2, Sums: []float64{10, 1.5}},
+				{Value: "games", Count: 1, Sums: []float64{20, 3}},
+			},
+			{
+				{Value: "books", Count: 3, Sums: []float64{5, 0.5}},
+				{Value: "apps", Count: 1, Sums: []float64{1, 1}},
+			},
+		})
+
+		assert.Equal(t, []ContinuousGroup{
+			{Value: "apps", Count: 1, Sums: []float64{1, 1}},
+			{Value: "books", Count: 5, Sums: []float64{15, 2}},
+			{Value: "games", Count: 1, Sums: []float64{20, 3}},
+		}, res)
+	})
+
+	t.Run("without any shards", func(t *testing.T) {
+		res := CombineContinuous(nil)
+
+		assert.Equal(t, []ContinuousGroup{}, res)
+	})
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregation
+
+import (
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// FacetParams describe a faceted search. It returns the first Limit objects
+// matching the filters together with the number of matching objects for the
+// most common values of each of the faceted properties. The filters are only
+// resolved once, so the objects and the facets are always based on exactly
+// the same matches.
+type FacetParams struct {
+	ClassName  schema.ClassName      `json:"className"`
+	Filters    *filters.LocalFilter  `json:"filters"`
+	Limit      int                   `json:"limit"`
+	Properties []schema.PropertyName `json:"properties"`
+	Additional additional.Properties `json:"additional"`
+
+	// FacetLimit is the maximum number of values per faceted property
+	FacetLimit int `json:"facetLimit"`
+}
+
+// FacetResult contains the total number of objects matching the filters of a
+// faceted search and one facet for each faceted property, in the order they
+// were requested
+type FacetResult struct {
+	Count  int     `json:"count"`
+	Facets []Facet `json:"facets"`
+}
+
+// Facet contains one group per value of the property, ordered by the number
+// of matching objects with that value
+type Facet struct {
+	Property string  `json:"property"`
+	Groups   []Group `json:"groups"`
+}
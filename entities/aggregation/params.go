@@ -13,9 +13,12 @@ package aggregation
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 )
 
 type Params struct {
@@ -25,6 +28,33 @@ type Params struct {
 	GroupBy          *filters.Path        `json:"groupBy"`
 	IncludeMetaCount bool                 `json:"includeMetaCount"`
 	Limit            *int                 `json:"limit"`
+
+	// NearVector, NearObject and ModuleParams restrict the aggregation to the
+	// objects closest to the search. They are resolved into SearchVector and
+	// either Certainty or Distance before the params reach the database, so
+	// ModuleParams never have to be sent to other nodes.
+	NearVector   *searchparams.NearVector `json:"nearVector"`
+	NearObject   *searchparams.NearObject `json:"nearObject"`
+	ModuleParams map[string]interface{}   `json:"-"`
+	SearchVector []float32                `json:"searchVector"`
+	Certainty    float64                  `json:"certainty"`
+	Distance     float64                  `json:"distance"`
+	WithDistance bool                     `json:"withDistance"`
+
+	// ObjectLimit is the maximum number of objects of a vector search to
+	// aggregate over, it is not to be confused with the limit of groups
+	ObjectLimit *int `json:"objectLimit"`
+
+	// ObjectIDs restricts the aggregation to exactly these objects, it takes
+	// the place of SearchVector and Filters. It is set when a vector search
+	// spans multiple shards: The closest objects are picked across all shards
+	// first, so that the objectLimit and the threshold apply to the class as
+	// a whole, and every shard then aggregates over its share of them.
+	ObjectIDs []strfmt.UUID `json:"objectIds"`
+
+	// Tenant scopes the aggregation to a single tenant of a class with
+	// multi-tenancy enabled
+	Tenant string `json:"tenant"`
 }
 
 type ParamProperty struct {
@@ -33,8 +63,9 @@ type ParamProperty struct {
 }
 
 type Aggregator struct {
-	Type  string `json:"type"`
-	Limit *int   `json:"limit"` // used on TopOccurrence Agg
+	Type       string   `json:"type"`
+	Limit      *int     `json:"limit"`                // used on TopOccurrence Agg
+	Percentile *float64 `json:"percentile,omitempty"` // used on Percentile Agg
 }
 
 func (a Aggregator) String() string {
@@ -57,6 +88,22 @@ var (
 	MinimumAggregator = Aggregator{Type: "minimum"}
 )
 
+const PercentileType = "percentile"
+
+// NewPercentileAggregator creates a PercentileAggregator for the percentile p
+// between 0 and 100, similar to the TopOccurrencesAggregator it cannot be a
+// singleton, as each query can ask for different percentiles
+func NewPercentileAggregator(p float64) Aggregator {
+	return Aggregator{Type: PercentileType, Percentile: &p}
+}
+
+// PercentileKey is the key under which the percentile p is stored in the
+// numerical aggregations of a property. Since a query can ask for several
+// percentiles at once, each of them needs its own key.
+func PercentileKey(p float64) string {
+	return PercentileType + "_" + strconv.FormatFloat(p, 'f', -1, 64)
+}
+
 // Aggregators used in boolean props
 var (
 	TotalTrueAggregator       = Aggregator{Type: "totalTrue"}
@@ -99,6 +146,8 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 		return MinimumAggregator, nil
 	case SumAggregator.String():
 		return SumAggregator, nil
+	case PercentileType:
+		return NewPercentileAggregator(50), nil // default to the median, can be overwritten
 
 	// boolean
 	case TotalTrueAggregator.String():
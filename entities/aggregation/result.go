@@ -26,6 +26,7 @@ type Property struct {
 	NumericalAggregations map[string]float64 `json:"numericalAggregations"`
 	TextAggregation       Text               `json:"textAggregation"`
 	BooleanAggregation    Boolean            `json:"booleanAggregation"`
+	DateAggregation       Date               `json:"dateAggregation"`
 	SchemaType            string             `json:"schemaType"`
 	ReferenceAggregation  Reference          `json:"referenceAggregation"`
 }
@@ -41,6 +42,7 @@ const (
 	PropertyTypeNumerical PropertyType = "numerical"
 	PropertyTypeBoolean   PropertyType = "boolean"
 	PropertyTypeText      PropertyType = "text"
+	PropertyTypeDate      PropertyType = "date"
 	PropertyTypeReference PropertyType = "cref"
 )
 
@@ -65,3 +67,23 @@ type Boolean struct {
 type Reference struct {
 	PointingTo []string `json:"pointingTo"`
 }
+
+// Date holds the aggregations of a date property. The dates are unix
+// nanoseconds, so that the results of several shards can be combined. They
+// are only formatted as RFC3339 when they are served to the user. The
+// occurrences of every distinct date are kept, so that the median and mode of
+// several shards can be combined exactly.
+type Date struct {
+	Count       int              `json:"count"`
+	Minimum     int64            `json:"minimum"`
+	Maximum     int64            `json:"maximum"`
+	Median      int64            `json:"median"`
+	Mode        int64            `json:"mode"`
+	ModeOccurs  int              `json:"modeOccurs"`
+	Occurrences []DateOccurrence `json:"occurrences"`
+}
+
+type DateOccurrence struct {
+	Value  int64 `json:"value"`
+	Occurs int   `json:"occurs"`
+}
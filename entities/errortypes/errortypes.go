@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package errortypes contains the kinds of errors which are distinguished by
+// all APIs, i.e. REST, GraphQL and the internal cluster API. Each kind maps to
+// exactly one HTTP status code, so that clients - including other nodes of
+// the cluster - can tell apart errors which are worth retrying from those
+// which are not.
+package errortypes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Kind string
+
+const (
+	// KindNotFound indicates the requested resource does not exist
+	KindNotFound Kind = "NOT_FOUND"
+	// KindConflict indicates the request conflicts with the current state,
+	// such as an ID that already exists
+	KindConflict Kind = "CONFLICT"
+	// KindValidation indicates a client-side error, the request should not be
+	// retried without changes
+	KindValidation Kind = "VALIDATION"
+	// KindOverloaded indicates the request could not be served right now and
+	// should be retried after backing off
+	KindOverloaded Kind = "OVERLOADED"
+	// KindInternal indicates something went wrong during processing
+	KindInternal Kind = "INTERNAL"
+)
+
+// Kinded is implemented by all errors which know their kind. Errors from the
+// usecases can implement it to control how they are presented to the user
+// without depending on this package's Error type.
+type Kinded interface {
+	error
+	ErrorKind() Kind
+}
+
+// Error is a generic error of a specific kind
+type Error struct {
+	kind Kind
+	err  error
+}
+
+// New creates an error of the specified kind with Errorf semantics
+func New(kind Kind, format string, args ...interface{}) error {
+	return Error{kind: kind, err: fmt.Errorf(format, args...)}
+}
+
+// Wrap assigns a kind to an existing error, the original error can still be
+// retrieved using errors.Unwrap
+func Wrap(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return Error{kind: kind, err: err}
+}
+
+func (e Error) Error() string {
+	return e.err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.err
+}
+
+func (e Error) ErrorKind() Kind {
+	return e.kind
+}
+
+// Extensions makes the kind of the error visible to GraphQL clients
+func (e Error) Extensions() map[string]interface{} {
+	return Extensions(e)
+}
+
+// KindOf returns the kind of the first error in the chain which knows its
+// kind. Errors without a kind are considered internal errors.
+func KindOf(err error) Kind {
+	var kinded Kinded
+	if errors.As(err, &kinded) {
+		return kinded.ErrorKind()
+	}
+
+	return KindInternal
+}
+
+// Is returns true if any error in the chain is of the specified kind
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
+
+// HTTPStatus returns the status code which represents the kind of err
+func HTTPStatus(err error) int {
+	switch KindOf(err) {
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindValidation:
+		return http.StatusUnprocessableEntity
+	case KindOverloaded:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// FromHTTPStatus is the inverse of HTTPStatus and can be used by clients to
+// restore the kind of an error that was sent over the wire
+func FromHTTPStatus(statusCode int, body []byte) error {
+	msg := strings.TrimSpace(string(body))
+	if msg == "" {
+		msg = http.StatusText(statusCode)
+	}
+
+	var kind Kind
+	switch statusCode {
+	case http.StatusNotFound:
+		kind = KindNotFound
+	case http.StatusConflict:
+		kind = KindConflict
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		kind = KindValidation
+	case http.StatusServiceUnavailable, http.StatusTooManyRequests:
+		kind = KindOverloaded
+	default:
+		kind = KindInternal
+	}
+
+	return New(kind, "unexpected status code %d (%s)", statusCode, msg)
+}
+
+// Retryable indicates whether the request which lead to err can be retried
+// unchanged
+func Retryable(err error) bool {
+	return Is(err, KindOverloaded)
+}
+
+// Extensions returns the GraphQL error extensions for err
+func Extensions(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"code": string(KindOf(err)),
+	}
+}
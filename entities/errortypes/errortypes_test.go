@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package errortypes
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorTypes(t *testing.T) {
+	t.Run("kind survives wrapping", func(t *testing.T) {
+		err := New(KindOverloaded, "queue full")
+		err = errors.Wrap(err, "vectorize object")
+		err = fmt.Errorf("import: %w", err)
+
+		assert.Equal(t, KindOverloaded, KindOf(err))
+		assert.Equal(t, http.StatusServiceUnavailable, HTTPStatus(err))
+		assert.True(t, Retryable(err))
+		assert.Equal(t, "import: vectorize object: queue full", err.Error())
+	})
+
+	t.Run("errors without kind are internal", func(t *testing.T) {
+		err := errors.New("something broke")
+
+		assert.Equal(t, KindInternal, KindOf(err))
+		assert.Equal(t, http.StatusInternalServerError, HTTPStatus(err))
+		assert.False(t, Retryable(err))
+	})
+
+	t.Run("round trip through http status codes", func(t *testing.T) {
+		for _, kind := range []Kind{KindNotFound, KindConflict, KindValidation,
+			KindOverloaded, KindInternal} {
+			sent := New(kind, "some error")
+			received := FromHTTPStatus(HTTPStatus(sent), []byte("some error\n"))
+
+			assert.Equal(t, kind, KindOf(received))
+			assert.Contains(t, received.Error(), "(some error)")
+		}
+	})
+
+	t.Run("graphql extensions", func(t *testing.T) {
+		err := Wrap(KindValidation, errors.New("invalid filter"))
+
+		assert.Equal(t, map[string]interface{}{"code": "VALIDATION"},
+			Extensions(errors.Wrap(err, "resolve")))
+	})
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import "github.com/semi-technologies/weaviate/entities/schema"
+
+// CrossClassRoot is the placeholder root class of a filter which is not bound
+// to a single class, such as the where filter of Explore. Such a filter has to
+// be bound to each candidate class using ForClass before it can be applied.
+const CrossClassRoot = "CrossClassCandidate"
+
+// ForClass returns a deep copy of the filter with the root class of every
+// path replaced by className. Nested reference paths are left untouched.
+func (f *LocalFilter) ForClass(className schema.ClassName) *LocalFilter {
+	if f == nil {
+		return nil
+	}
+
+	return &LocalFilter{Root: clauseForClass(f.Root, className)}
+}
+
+func clauseForClass(in *Clause, className schema.ClassName) *Clause {
+	if in == nil {
+		return nil
+	}
+
+	out := &Clause{
+		Operator: in.Operator,
+		Value:    in.Value,
+	}
+
+	if in.On != nil {
+		on := *in.On
+		on.Class = className
+		out.On = &on
+	}
+
+	if in.Operands != nil {
+		out.Operands = make([]Clause, len(in.Operands))
+		for i := range in.Operands {
+			out.Operands[i] = *clauseForClass(&in.Operands[i], className)
+		}
+	}
+
+	return out
+}
+
+// RootProperties returns the distinct properties of the root class the
+// filter refers to
+func (f *LocalFilter) RootProperties() []schema.PropertyName {
+	if f == nil {
+		return nil
+	}
+
+	seen := map[schema.PropertyName]struct{}{}
+	var out []schema.PropertyName
+	var walk func(c *Clause)
+	walk = func(c *Clause) {
+		if c == nil {
+			return
+		}
+
+		if c.On != nil {
+			if _, ok := seen[c.On.Property]; !ok {
+				seen[c.On.Property] = struct{}{}
+				out = append(out, c.On.Property)
+			}
+		}
+
+		for i := range c.Operands {
+			walk(&c.Operands[i])
+		}
+	}
+	walk(f.Root)
+
+	return out
+}
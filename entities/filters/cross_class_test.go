@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossClassFilter(t *testing.T) {
+	filter := &LocalFilter{
+		Root: &Clause{
+			Operator: OperatorAnd,
+			Operands: []Clause{
+				{
+					Operator: OperatorEqual,
+					On:       &Path{Class: CrossClassRoot, Property: "language"},
+					Value:    &Value{Value: "en", Type: schema.DataTypeString},
+				},
+				{
+					Operator: OperatorEqual,
+					On: &Path{
+						Class:    CrossClassRoot,
+						Property: "inTenant",
+						Child:    &Path{Class: "Tenant", Property: "name"},
+					},
+					Value: &Value{Value: "acme", Type: schema.DataTypeString},
+				},
+				{
+					Operator: OperatorNotEqual,
+					On:       &Path{Class: CrossClassRoot, Property: "language"},
+					Value:    &Value{Value: "de", Type: schema.DataTypeString},
+				},
+			},
+		},
+	}
+
+	t.Run("root properties", func(t *testing.T) {
+		assert.Equal(t, []schema.PropertyName{"language", "inTenant"},
+			filter.RootProperties())
+	})
+
+	t.Run("binding to a class", func(t *testing.T) {
+		bound := filter.ForClass("Article")
+
+		assert.Equal(t, schema.ClassName("Article"), bound.Root.Operands[0].On.Class)
+		assert.Equal(t, schema.ClassName("Article"), bound.Root.Operands[1].On.Class)
+		assert.Equal(t, schema.ClassName("Tenant"), bound.Root.Operands[1].On.Child.Class)
+
+		// the original filter must not be modified, so it can be bound to the
+		// next class
+		assert.Equal(t, schema.ClassName(CrossClassRoot), filter.Root.Operands[0].On.Class)
+	})
+
+	t.Run("nil filter", func(t *testing.T) {
+		var nilFilter *LocalFilter
+		assert.Nil(t, nilFilter.ForClass("Article"))
+		assert.Nil(t, nilFilter.RootProperties())
+	})
+}
@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+// Cursor is the position in an exhaustive listing of a class. Objects are
+// listed in ascending order of their UUID starting right after After, or at
+// the very first object if After is empty. Unlike an offset, a cursor stays
+// cheap no matter how deep into the class it points.
+type Cursor struct {
+	After string `json:"after"`
+}
+
+// ExtractCursorFromArgs gets the after key out of a map. Not specific to
+// GQL, but can be used from GQL
+func ExtractCursorFromArgs(args map[string]interface{}) *Cursor {
+	after, ok := args["after"]
+	if !ok {
+		return nil
+	}
+
+	return &Cursor{After: after.(string)}
+}
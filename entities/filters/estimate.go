@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// EstimateParams describe a dry run of a filter on a class. The filter is
+// not used to search, but only to estimate how many objects it matches and
+// which indexes serve it.
+type EstimateParams struct {
+	ClassName schema.ClassName `json:"className"`
+	Filters   *LocalFilter     `json:"filters"`
+
+	// Exact additionally counts the matches exactly, which resolves the
+	// filter just like a search would
+	Exact bool `json:"exact"`
+}
+
+// The kinds of indexes which can serve a clause of a filter
+const (
+	IndexInverted  = "inverted"
+	IndexComposite = "composite"
+	IndexShared    = "shared"
+	IndexCount     = "count"
+	IndexNullState = "nullState"
+	IndexGeo       = "geo"
+)
+
+// Estimate is the result of a dry run of a filter. Count is an upper bound
+// of the matches: every clause is counted on its own, the counts of an And
+// are combined by their minimum and the counts of an Or by their sum.
+// ExactCount is only set if it was requested.
+type Estimate struct {
+	Count      int          `json:"count"`
+	ExactCount *int         `json:"exactCount,omitempty"`
+	Indexes    []IndexUsage `json:"indexes"`
+}
+
+// IndexUsage describes the index which serves a clause of the filter. It
+// covers more than one property if the clauses of several properties are
+// served by a composite index.
+type IndexUsage struct {
+	Properties []string `json:"properties"`
+	Operator   string   `json:"operator"`
+	Index      string   `json:"index"`
+}
+
+func (u IndexUsage) key() string {
+	return strings.Join(u.Properties, ",") + "/" + u.Operator + "/" + u.Index
+}
+
+// CombineEstimates sums up the estimates of several shards. An exact count
+// is only set if every shard has one. The index usages of all shards are
+// merged, as the shards of a class may differ in e.g. their composite indexes.
+func CombineEstimates(in []*Estimate) *Estimate {
+	out := &Estimate{Indexes: []IndexUsage{}}
+	exact := len(in) > 0
+	exactCount := 0
+	seen := map[string]struct{}{}
+
+	for _, estimate := range in {
+		out.Count += estimate.Count
+		if estimate.ExactCount == nil {
+			exact = false
+		} else {
+			exactCount += *estimate.ExactCount
+		}
+
+		for _, usage := range estimate.Indexes {
+			if _, ok := seen[usage.key()]; ok {
+				continue
+			}
+
+			seen[usage.key()] = struct{}{}
+			out.Indexes = append(out.Indexes, usage)
+		}
+	}
+
+	if exact {
+		out.ExactCount = &exactCount
+	}
+
+	return out
+}
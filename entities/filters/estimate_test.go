@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombineEstimates(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+	category := IndexUsage{
+		Properties: []string{"category"},
+		Operator:   "Equal",
+		Index:      IndexInverted,
+	}
+	composite := IndexUsage{
+		Properties: []string{"category", "year"},
+		Operator:   "And",
+		Index:      IndexComposite,
+	}
+
+	t.Run("with exact counts on every shard", func(t *testing.T) {
+		res := CombineEstimates([]*Estimate{
+			{Count: 4, ExactCount: intPtr(3), Indexes: []IndexUsage{category}},
+			{Count: 6, ExactCount: intPtr(2), Indexes: []IndexUsage{category}},
+		})
+
+		assert.Equal(t, &Estimate{
+			Count:      10,
+			ExactCount: intPtr(5),
+			Indexes:    []IndexUsage{category},
+		}, res)
+	})
+
+	t.Run("with an exact count missing on a shard", func(t *testing.T) {
+		res := CombineEstimates([]*Estimate{
+			{Count: 4, ExactCount: intPtr(3), Indexes: []IndexUsage{composite}},
+			{Count: 6, Indexes: []IndexUsage{category}},
+		})
+
+		assert.Equal(t, &Estimate{
+			Count:   10,
+			Indexes: []IndexUsage{composite, category},
+		}, res)
+	})
+
+	t.Run("without any shards", func(t *testing.T) {
+		res := CombineEstimates(nil)
+
+		assert.Equal(t, &Estimate{Indexes: []IndexUsage{}}, res)
+	})
+}
@@ -18,6 +18,19 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
+// The internal props can be used as a path to filter and sort by the
+// timestamps of an object, if the class has indexTimestamps enabled
+const (
+	InternalPropCreationTimeUnix   = "_creationTimeUnix"
+	InternalPropLastUpdateTimeUnix = "_lastUpdateTimeUnix"
+)
+
+// IsTimestampProp is true for the internal props of the timestamps
+func IsTimestampProp(propName string) bool {
+	return propName == InternalPropCreationTimeUnix ||
+		propName == InternalPropLastUpdateTimeUnix
+}
+
 type Operator int
 
 const (
@@ -32,6 +45,7 @@ const (
 	OperatorNot              Operator = 9
 	OperatorWithinGeoRange   Operator = 10
 	OperatorLike             Operator = 11
+	OperatorIsNull           Operator = 12
 )
 
 func (o Operator) OnValue() bool {
@@ -43,7 +57,8 @@ func (o Operator) OnValue() bool {
 		OperatorLessThan,
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
-		OperatorLike:
+		OperatorLike,
+		OperatorIsNull:
 		return true
 	default:
 		return false
@@ -74,6 +89,8 @@ func (o Operator) Name() string {
 		return "WithinGeoRange"
 	case OperatorLike:
 		return "Like"
+	case OperatorIsNull:
+		return "IsNull"
 	default:
 		panic("Unknown operator")
 	}
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// Sort orders the results of a listing by the value of the property at Path,
+// either ascending or descending
+type Sort struct {
+	Path  []string `json:"path"`
+	Order string   `json:"order"`
+}
+
+// ExtractSortFromArgs gets the sort key out of a map. Not specific to GQL,
+// but can be used from GQL
+func ExtractSortFromArgs(args map[string]interface{}) []Sort {
+	sort, ok := args["sort"]
+	if !ok {
+		return nil
+	}
+
+	var out []Sort
+	for _, raw := range sort.([]interface{}) {
+		asMap := raw.(map[string]interface{})
+
+		var path []string
+		if rawPath, ok := asMap["path"].([]interface{}); ok {
+			for _, elem := range rawPath {
+				path = append(path, elem.(string))
+			}
+		}
+
+		order := SortOrderAsc
+		if rawOrder, ok := asMap["order"].(string); ok {
+			order = rawOrder
+		}
+
+		out = append(out, Sort{Path: path, Order: order})
+	}
+
+	return out
+}
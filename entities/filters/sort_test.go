@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractSort(t *testing.T) {
+	t.Run("without a sort present", func(t *testing.T) {
+		s := ExtractSortFromArgs(map[string]interface{}{})
+		assert.Nil(t, s)
+	})
+
+	t.Run("with a sort present", func(t *testing.T) {
+		s := ExtractSortFromArgs(map[string]interface{}{
+			"sort": []interface{}{
+				map[string]interface{}{
+					"path":  []interface{}{"_lastUpdateTimeUnix"},
+					"order": "desc",
+				},
+			},
+		})
+		assert.Equal(t, []Sort{{
+			Path:  []string{"_lastUpdateTimeUnix"},
+			Order: SortOrderDesc,
+		}}, s)
+	})
+
+	t.Run("without an order", func(t *testing.T) {
+		s := ExtractSortFromArgs(map[string]interface{}{
+			"sort": []interface{}{
+				map[string]interface{}{
+					"path": []interface{}{"_creationTimeUnix"},
+				},
+			},
+		})
+		assert.Equal(t, []Sort{{
+			Path:  []string{"_creationTimeUnix"},
+			Order: SortOrderAsc,
+		}}, s)
+	})
+}
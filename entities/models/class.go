@@ -41,12 +41,24 @@ type Class struct {
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
+	// multi tenancy config
+	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// replication config
+	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
+
+	// shadow config
+	ShadowConfig *ShadowConfig `json:"shadowConfig,omitempty"`
+
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// template config
+	TemplateConfig *TemplateConfig `json:"templateConfig,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
@@ -65,10 +77,26 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMultiTenancyConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateReplicationConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShadowConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTemplateConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -93,6 +121,24 @@ func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultiTenancyConfig) { // not required
+		return nil
+	}
+
+	if m.MultiTenancyConfig != nil {
+		if err := m.MultiTenancyConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("multiTenancyConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Properties) { // not required
@@ -118,6 +164,60 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ReplicationConfig) { // not required
+		return nil
+	}
+
+	if m.ReplicationConfig != nil {
+		if err := m.ReplicationConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("replicationConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateShadowConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ShadowConfig) { // not required
+		return nil
+	}
+
+	if m.ShadowConfig != nil {
+		if err := m.ShadowConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shadowConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateTemplateConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TemplateConfig) { // not required
+		return nil
+	}
+
+	if m.TemplateConfig != nil {
+		if err := m.TemplateConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("templateConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
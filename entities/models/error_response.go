@@ -97,6 +97,15 @@ type ErrorResponseErrorItems0 struct {
 
 	// message
 	Message string `json:"message,omitempty"`
+
+	// The module whose rule the object violates, only set for data quality rule violations.
+	Module string `json:"module,omitempty"`
+
+	// The property which violates a rule, only set for data quality rule violations.
+	Property string `json:"property,omitempty"`
+
+	// The name of the violated rule, only set for data quality rule violations.
+	Rule string `json:"rule,omitempty"`
 }
 
 // Validate validates this error response error items0
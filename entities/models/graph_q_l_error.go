@@ -29,6 +29,9 @@ import (
 // swagger:model GraphQLError
 type GraphQLError struct {
 
+	// Machine-readable details about the error. Contains the kind of the error in 'code', one of NOT_FOUND, CONFLICT, VALIDATION, OVERLOADED, or INTERNAL.
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+
 	// locations
 	Locations []*GraphQLErrorLocationsItems0 `json:"locations"`
 
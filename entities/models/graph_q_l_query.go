@@ -26,6 +26,9 @@ import (
 // swagger:model GraphQLQuery
 type GraphQLQuery struct {
 
+	// Read all classes the query refers to as of the same point in time. Writes which happen while the query runs are not visible to it, so e.g. a Get and an Aggregate of the same class agree with each other.
+	ConsistentSnapshot bool `json:"consistentSnapshot,omitempty"`
+
 	// The name of the operation if multiple exist in the query.
 	OperationName string `json:"operationName,omitempty"`
 
@@ -17,6 +17,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -28,10 +31,59 @@ type InvertedIndexConfig struct {
 
 	// Asynchronous index clean up happens every n seconds
 	CleanupIntervalSeconds int64 `json:"cleanupIntervalSeconds,omitempty"`
+
+	// Property combinations which are frequently filtered together with the Equal operator. Each is indexed with a single composite key, so such filters can be served by a single lookup
+	CompositeIndexes [][]string `json:"compositeIndexes"`
+
+	// Counts and sums per group which are updated on every write, so they can be read without an Aggregate scan of all Objects
+	ContinuousAggregates []*ContinuousAggregate `json:"continuousAggregates"`
+
+	// Index the null state of each property, which is required to filter with the IsNull operator
+	IndexNullState bool `json:"indexNullState,omitempty"`
+
+	// Index the creation and last update time of each object, which is required to filter and sort by _creationTimeUnix and _lastUpdateTimeUnix
+	IndexTimestamps bool `json:"indexTimestamps,omitempty"`
+
+	// Reject writes with property values which do not match the data type of their property or which set a property that is not part of the schema, instead of coercing or skipping them
+	StrictTypes bool `json:"strictTypes,omitempty"`
 }
 
 // Validate validates this inverted index config
 func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateContinuousAggregates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InvertedIndexConfig) validateContinuousAggregates(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ContinuousAggregates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ContinuousAggregates); i++ {
+		if swag.IsZero(m.ContinuousAggregates[i]) { // not required
+			continue
+		}
+
+		if m.ContinuousAggregates[i] != nil {
+			if err := m.ContinuousAggregates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("continuousAggregates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
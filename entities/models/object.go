@@ -17,6 +17,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -44,9 +46,18 @@ type Object struct {
 	// Timestamp of the last Object update in milliseconds since epoch UTC.
 	LastUpdateTimeUnix int64 `json:"lastUpdateTimeUnix,omitempty"`
 
+	// Token-level vectors of the Object, which are searched by late interaction. Only indexed if multiVector is enabled in the vectorIndexConfig of the class.
+	MultiVector []C11yVector `json:"multiVector,omitempty"`
+
 	// properties
 	Properties PropertySchema `json:"properties,omitempty"`
 
+	// Sparse term weights of the Object, which are searched by their dot product with a sparse query. Only indexed if sparse is enabled in the vectorIndexConfig of the class.
+	SparseVector SparseVector `json:"sparseVector,omitempty"`
+
+	// Name of the tenant the object belongs to. Required for classes with multi-tenancy enabled, must not be set otherwise.
+	Tenant string `json:"tenant,omitempty"`
+
 	// This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.
 	Vector C11yVector `json:"vector,omitempty"`
 
@@ -66,6 +77,14 @@ func (m *Object) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMultiVector(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSparseVector(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVector(formats); err != nil {
 		res = append(res, err)
 	}
@@ -105,6 +124,42 @@ func (m *Object) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Object) validateMultiVector(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultiVector) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MultiVector); i++ {
+
+		if err := m.MultiVector[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("multiVector" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Object) validateSparseVector(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SparseVector) { // not required
+		return nil
+	}
+
+	if err := m.SparseVector.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("sparseVector")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Object) validateVector(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Vector) { // not required
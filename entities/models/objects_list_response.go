@@ -35,6 +35,9 @@ type ObjectsListResponse struct {
 	// The actual list of Objects.
 	Objects []*Object `json:"objects"`
 
+	// The id to pass as scrollId to get the next page of a scroll. Empty once all objects have been returned.
+	ScrollID string `json:"scrollId,omitempty"`
+
 	// The total number of Objects for the query. The number of items in a response may be smaller due to paging.
 	TotalResults int64 `json:"totalResults,omitempty"`
 }
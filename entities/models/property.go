@@ -17,8 +17,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Property property
@@ -35,15 +39,128 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Determines how the inverted index of a boolean property or a string property with "field" tokenization is stored. "dedicated" stores it in buckets of its own, "shared" stores it in buckets shared with all other properties of the class using this option, which reduces the number of open files for classes with many low-cardinality properties. Defaults to "dedicated"
+	// Enum: [dedicated shared]
+	InvertedIndexStorage string `json:"invertedIndexStorage,omitempty"`
+
 	// Configuratino specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
+
+	// Optional. Determines how the value of a string or text property is split into tokens for the inverted index. "word" splits on any non-alphanumerical character and lowercases, "lowercase" splits on whitespace and lowercases, "whitespace" splits on whitespace only and "field" indexes the entire trimmed value as a single token. Defaults to "word" for text and "whitespace" for string properties
+	// Enum: [word lowercase whitespace field]
+	Tokenization string `json:"tokenization,omitempty"`
 }
 
 // Validate validates this property
 func (m *Property) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInvertedIndexStorage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenization(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var propertyTypeInvertedIndexStoragePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["dedicated","shared"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeInvertedIndexStoragePropEnum = append(propertyTypeInvertedIndexStoragePropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyInvertedIndexStorageDedicated captures enum value "dedicated"
+	PropertyInvertedIndexStorageDedicated string = "dedicated"
+
+	// PropertyInvertedIndexStorageShared captures enum value "shared"
+	PropertyInvertedIndexStorageShared string = "shared"
+)
+
+// prop value enum
+func (m *Property) validateInvertedIndexStorageEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeInvertedIndexStoragePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateInvertedIndexStorage(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.InvertedIndexStorage) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateInvertedIndexStorageEnum("invertedIndexStorage", "body", m.InvertedIndexStorage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var propertyTypeTokenizationPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeTokenizationPropEnum = append(propertyTypeTokenizationPropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyTokenizationWord captures enum value "word"
+	PropertyTokenizationWord string = "word"
+
+	// PropertyTokenizationLowercase captures enum value "lowercase"
+	PropertyTokenizationLowercase string = "lowercase"
+
+	// PropertyTokenizationWhitespace captures enum value "whitespace"
+	PropertyTokenizationWhitespace string = "whitespace"
+
+	// PropertyTokenizationField captures enum value "field"
+	PropertyTokenizationField string = "field"
+)
+
+// prop value enum
+func (m *Property) validateTokenizationEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeTokenizationPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateTokenization(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Tokenization) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTokenizationEnum("tokenization", "body", m.Tokenization); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -39,6 +39,9 @@ type Schema struct {
 
 	// Name of the schema.
 	Name string `json:"name,omitempty"`
+
+	// Templates other classes can be derived from, see TemplateConfig
+	Templates []*Class `json:"templates"`
 }
 
 // Validate validates this schema
@@ -53,6 +56,10 @@ func (m *Schema) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTemplates(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -97,6 +104,31 @@ func (m *Schema) validateMaintainer(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Schema) validateTemplates(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Templates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Templates); i++ {
+		if swag.IsZero(m.Templates[i]) { // not required
+			continue
+		}
+
+		if m.Templates[i] != nil {
+			if err := m.Templates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("templates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Schema) MarshalBinary() ([]byte, error) {
 	if m == nil {
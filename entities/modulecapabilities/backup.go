@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+	"io"
+)
+
+// BackupBackend is an optional capability interface which a module MAY
+// implement to act as a storage backend for backups, such as a local
+// filesystem or an object store. A backup consists of objects identified by
+// slash-separated keys, all objects of a backup are grouped by the backup
+// id.
+type BackupBackend interface {
+	// HomeDir describes where the backup with the given id is stored, it is
+	// presented to the user and not interpreted by Weaviate
+	HomeDir(backupID string) string
+
+	// PutObject stores the contents of r under the given key, overwriting a
+	// previous object with the same key
+	PutObject(ctx context.Context, backupID, key string, r io.Reader) error
+
+	// GetObject returns the contents stored under the given key. If no such
+	// object exists, an error of kind errortypes.KindNotFound MUST be
+	// returned.
+	GetObject(ctx context.Context, backupID, key string) (io.ReadCloser, error)
+}
@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// Chunker is an optional capability interface which a module MAY implement.
+// It is called at import time for every object of a class which has a
+// moduleConfig for the module and splits (a part of) the object into
+// separate chunk objects. The chunk objects MUST have their class and
+// properties set, ids are assigned by the caller. The parent object MUST NOT
+// be mutated.
+type Chunker interface {
+	ChunkObject(ctx context.Context, obj *models.Object,
+		cfg moduletools.ClassConfig) ([]*models.Object, error)
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import "context"
+
+// SourceConnector is an optional capability interface which a module MAY
+// implement to read records from an external source, such as files or a
+// database, so they can be imported into a class. A record is a JSON-like
+// map of field names to values. The settings which describe the source are
+// passed as part of the import request and are only interpreted by the
+// connector.
+type SourceConnector interface {
+	// ValidateSource checks the source settings before an import is started,
+	// invalid settings MUST be reported as an error of kind
+	// errortypes.KindValidation
+	ValidateSource(source map[string]interface{}) error
+
+	// ReadRecords calls fn for every record of the source in order. If fn
+	// returns an error, reading stops and the error is returned.
+	ReadRecords(ctx context.Context, source map[string]interface{},
+		fn func(record map[string]interface{}) error) error
+}
@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import "context"
+
+// EncryptionKeyProvider is an optional capability interface which a module
+// MAY implement to provide the key which encrypts the data at rest, such as
+// a key fetched from a key management service. The key is needed to open the
+// data path, so it is requested on startup before the modules are
+// initialized. The module has to obtain it from its own configuration,
+// typically environment variables.
+type EncryptionKeyProvider interface {
+	// EncryptionKey returns an AES key of 16, 24 or 32 bytes
+	EncryptionKey(ctx context.Context) ([]byte, error)
+}
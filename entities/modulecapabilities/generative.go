@@ -0,0 +1,47 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+)
+
+// GenerateResult is the text a generative module produced
+type GenerateResult struct {
+	Result *string
+}
+
+// AdditionalGenerativeProperty is an optional capability interface which a
+// module MAY implement. Generative modules post-process the results of a
+// query, e.g. by prompting a large language model with them. The modules
+// provider turns them into the "generate" additional property of every
+// class, so a module only needs to produce the text:
+//
+//	_additional {
+//	  generate(
+//	    singleResult: { prompt: "Summarize {title}" }
+//	    groupedResult: { task: "Which of these fit best?" }
+//	  ) { singleResult groupedResult error }
+//	}
+//
+// GenerateSingleResult is called once per result. Its prompt has every
+// {property} placeholder replaced by the text of the result's property
+// already, textProperties holds all text properties of the result.
+//
+// GenerateAllResults is called once per query with the text properties of
+// all results, its result is returned on the first result.
+type AdditionalGenerativeProperty interface {
+	GenerateSingleResult(ctx context.Context, prompt string,
+		textProperties map[string]string) (*GenerateResult, error)
+	GenerateAllResults(ctx context.Context, task string,
+		textProperties []map[string]string) (*GenerateResult, error)
+}
@@ -18,15 +18,22 @@ import (
 // GetArgumentsFn generates get graphql config for a given classname
 type GetArgumentsFn = func(classname string) *graphql.ArgumentConfig
 
+// AggregateArgumentsFn generates aggregate graphql config for a given classname
+type AggregateArgumentsFn = func(classname string) *graphql.ArgumentConfig
+
 // ExploreArgumentsFn generates explore graphql config
 type ExploreArgumentsFn = func() *graphql.ArgumentConfig
 
 // ExtractFn extracts graphql params to given struct implementation
 type ExtractFn = func(param map[string]interface{}) interface{}
 
-// NearParam defines params with certainty information
+// NearParam defines params with certainty or distance information, at most
+// one of which is set. A distance of 0 is a valid limit, HasDistance tells
+// whether the distance is set.
 type NearParam interface {
 	GetCertainty() float64
+	GetDistance() float64
+	HasDistance() bool
 }
 
 // ValidateFn validates a given module param
@@ -35,10 +42,11 @@ type ValidateFn = func(param interface{}) error
 // GraphQLArgument defines all the needed settings / methods
 // to add a module specific graphql argument
 type GraphQLArgument struct {
-	GetArgumentsFunction     GetArgumentsFn
-	ExploreArgumentsFunction ExploreArgumentsFn
-	ExtractFunction          ExtractFn
-	ValidateFunction         ValidateFn
+	GetArgumentsFunction       GetArgumentsFn
+	AggregateArgumentsFunction AggregateArgumentsFn
+	ExploreArgumentsFunction   ExploreArgumentsFn
+	ExtractFunction            ExtractFn
+	ValidateFunction           ValidateFn
 }
 
 // GraphQLArguments defines the capabilities of modules to add their
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// Masker is an optional capability interface which a module MAY implement.
+// It replaces sensitive values in the text properties of objects of a class
+// which has a moduleConfig for the module. MaskObject is called at import
+// time before the object is vectorized, so values masked there are neither
+// stored nor part of the vector. MaskResult is called for every object
+// returned to a principal, values masked there can still be searched for.
+// Both MAY change the values of properties, but MUST NOT add or remove any.
+type Masker interface {
+	MaskObject(ctx context.Context, props map[string]interface{},
+		cfg moduletools.ClassConfig) error
+	MaskResult(ctx context.Context, props map[string]interface{},
+		principal *models.Principal, cfg moduletools.ClassConfig) error
+}
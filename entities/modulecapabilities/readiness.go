@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import "context"
+
+// ReadinessChecker is implemented by modules which depend on an external
+// service, such as a remote inference container. CheckReady is polled by the
+// module provider after Init until it returns nil. It must not block for
+// longer than a single check takes.
+type ReadinessChecker interface {
+	CheckReady(ctx context.Context) error
+}
+
+// NotRecoverableError can be returned from CheckReady to indicate that the
+// dependency will never become ready, e.g. because its version is
+// incompatible. Polling stops immediately.
+type NotRecoverableError struct {
+	Err error
+}
+
+func (e NotRecoverableError) Error() string {
+	return e.Err.Error()
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// TransformArgument is a Get argument with which a query configures a
+// ResponseTransformer, e.g. the unit to convert to
+type TransformArgument struct {
+	GetArgumentsFunction GetArgumentsFn
+	ExtractFunction      ExtractFn
+}
+
+// ResponseTransformer is an optional capability interface which a module MAY
+// implement. It changes the values of the properties of objects returned by
+// Get queries, e.g. to convert units, truncate texts or render templates.
+// It is called for every object of a class which has a moduleConfig for the
+// module, after the results are hydrated and before the resolver returns
+// them, so it only changes the response and never the stored object.
+//
+// The arguments of TransformArguments are added to the Get queries of those
+// classes. TransformResult is called with the extracted values of the
+// arguments set in the query, keyed by argument name, which is empty if the
+// query sets none. It MAY change the values of properties, but MUST NOT add
+// or remove any.
+type ResponseTransformer interface {
+	TransformArguments() map[string]TransformArgument
+	TransformResult(ctx context.Context, props map[string]interface{},
+		params map[string]interface{}, cfg moduletools.ClassConfig) error
+}
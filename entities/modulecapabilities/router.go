@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// Router is an optional capability interface which a module MAY implement.
+// It is called at import time for every object of a class which has a
+// moduleConfig for the module, before the object is validated. It returns
+// the name of the class the object should be stored in instead, or an empty
+// string to keep the object's class. It MAY set properties on the object,
+// but MUST NOT change anything else.
+type Router interface {
+	RouteObject(ctx context.Context, obj *models.Object,
+		cfg moduletools.ClassConfig) (string, error)
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// SparseVectorizer is an optional capability interface which a module MAY
+// implement. It produces learned sparse representations, such as SPLADE or
+// ELSER term weights, which are stored alongside the dense vector of an object
+// and searched by their dot product. A class uses the sparse vectorizer which
+// has a moduleConfig in the class, its vectorIndexConfig must enable sparse.
+// All weights MUST be positive, terms without weight are simply omitted.
+type SparseVectorizer interface {
+	VectorizeObjectSparse(ctx context.Context, obj *models.Object,
+		cfg moduletools.ClassConfig) (models.SparseVector, error)
+	VectorizeQuerySparse(ctx context.Context, query string,
+		cfg moduletools.ClassConfig) (models.SparseVector, error)
+}
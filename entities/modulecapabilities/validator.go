@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// Validator is an optional capability interface which a module MAY
+// implement. It enforces data quality rules, which are declared in the
+// moduleConfig of a class, on every object written to the class.
+// ValidateRules is called when a class with a moduleConfig for the module is
+// created and MUST reject rules which can not be evaluated, such as rules on
+// properties the class does not have. ValidateObject is called at write time
+// after the object passed the schema validation, but before it is masked,
+// vectorized or stored. It returns the rules the object violates, an error
+// means the rules could not be evaluated at all. The props MUST NOT be
+// changed.
+type Validator interface {
+	ValidateRules(ctx context.Context, class *models.Class,
+		cfg moduletools.ClassConfig) error
+	ValidateObject(ctx context.Context, props map[string]interface{},
+		cfg moduletools.ClassConfig) ([]RuleViolation, error)
+}
+
+// RuleViolation is a rule which an object violates. Module is set by the
+// modules provider, a Validator only needs to set the other fields.
+type RuleViolation struct {
+	Module   string
+	Rule     string
+	Property string
+	Message  string
+}
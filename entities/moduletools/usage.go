@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package moduletools
+
+import "context"
+
+// UsageReporter receives the token usage of a single module call
+type UsageReporter interface {
+	AddTokens(tokens int)
+}
+
+type usageReporterKey struct{}
+
+// WithUsageReporter is used by the modules provider to attach a reporter to
+// the context of each module call it tracks
+func WithUsageReporter(ctx context.Context, r UsageReporter) context.Context {
+	return context.WithValue(ctx, usageReporterKey{}, r)
+}
+
+// ReportTokens can be called by a module to report the number of tokens an
+// inference call consumed, e.g. as returned by a paid inference API. It is a
+// no-op if the call is not tracked.
+func ReportTokens(ctx context.Context, tokens int) {
+	r, ok := ctx.Value(usageReporterKey{}).(UsageReporter)
+	if !ok || r == nil {
+		return
+	}
+
+	r.AddTokens(tokens)
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package readview carries the consistent read views of a request. A
+// request which should read all of its data as of the same point in time
+// creates a Set, places it in its context and releases it once it is done.
+// Readers look up their view in the set and create it on first use.
+package readview
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// View is a read-only state of some storage, e.g. a shard, which holds on to
+// resources until it is released
+type View interface {
+	Release() error
+}
+
+// Set holds the views of a single request by an arbitrary key, such as the
+// name of a shard
+type Set struct {
+	sync.Mutex
+	views    map[string]View
+	released bool
+}
+
+func NewSet() *Set {
+	return &Set{views: map[string]View{}}
+}
+
+// Get returns the view for the key. If there is none yet, it is created with
+// create, so that all readers of the request share the same view.
+func (s *Set) Get(key string, create func() (View, error)) (View, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.released {
+		return nil, errors.Errorf("read views have already been released")
+	}
+
+	if view, ok := s.views[key]; ok {
+		return view, nil
+	}
+
+	view, err := create()
+	if err != nil {
+		return nil, err
+	}
+
+	s.views[key] = view
+	return view, nil
+}
+
+// Release releases all views of the set. Views can no longer be obtained
+// from the set afterwards.
+func (s *Set) Release() error {
+	s.Lock()
+	defer s.Unlock()
+
+	var err error
+	for key, view := range s.views {
+		if releaseErr := view.Release(); releaseErr != nil && err == nil {
+			err = errors.Wrapf(releaseErr, "release view %q", key)
+		}
+	}
+
+	s.views = nil
+	s.released = true
+	return err
+}
+
+type setKey struct{}
+
+// NewContext returns a context in which all reads use the views of the set
+func NewContext(ctx context.Context, set *Set) context.Context {
+	return context.WithValue(ctx, setKey{}, set)
+}
+
+// FromContext returns the set placed in the context with NewContext, or nil
+// if reads should use the latest state
+func FromContext(ctx context.Context) *Set {
+	set, _ := ctx.Value(setKey{}).(*Set)
+	return set
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package readview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet(t *testing.T) {
+	set := NewSet()
+	ctx := NewContext(context.Background(), set)
+
+	assert.Nil(t, FromContext(context.Background()))
+	require.Equal(t, set, FromContext(ctx))
+
+	created := 0
+	create := func() (View, error) {
+		created++
+		return &fakeView{}, nil
+	}
+
+	first, err := FromContext(ctx).Get("shard-1", create)
+	require.Nil(t, err)
+	second, err := FromContext(ctx).Get("shard-1", create)
+	require.Nil(t, err)
+	other, err := FromContext(ctx).Get("shard-2", create)
+	require.Nil(t, err)
+
+	assert.True(t, first == second, "the same view is shared")
+	assert.False(t, first == other)
+	assert.Equal(t, 2, created)
+
+	require.Nil(t, set.Release())
+	assert.Equal(t, 1, first.(*fakeView).released)
+	assert.Equal(t, 1, other.(*fakeView).released)
+
+	_, err = set.Get("shard-3", create)
+	assert.NotNil(t, err, "no views after the set has been released")
+}
+
+type fakeView struct {
+	released int
+}
+
+func (v *fakeView) Release() error {
+	v.released++
+	return nil
+}
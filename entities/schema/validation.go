@@ -27,7 +27,8 @@ func init() {
 	validateClassNameRegex = regexp.MustCompile(`^([A-Z][a-z]+)+$`)
 	validatePropertyNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
 	validateNetworkClassRegex = regexp.MustCompile(`^([A-Za-z]+)+/([A-Z][a-z]+)+$`)
-	reservedPropertyNames = []string{"_additional", "_id", "id"}
+	reservedPropertyNames = []string{"_additional", "_id", "id",
+		"_creationTimeUnix", "_lastUpdateTimeUnix", "_shared"}
 }
 
 // ValidateClassName validates that this string is a valid class name (formate
@@ -27,6 +27,8 @@ type Result struct {
 	Score                float32
 	Dist                 float32
 	Vector               []float32
+	MultiVector          [][]float32
+	SparseVector         map[string]float32
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -63,6 +65,12 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 
 	if includeVector {
 		t.Vector = r.Vector
+		for _, vec := range r.MultiVector {
+			t.MultiVector = append(t.MultiVector, vec)
+		}
+		if len(r.SparseVector) > 0 {
+			t.SparseVector = r.SparseVector
+		}
 	}
 
 	return t
@@ -98,7 +106,12 @@ func (rs Results) SortByDistanceToVector(vector []float32) (Results, error) {
 			hasErrored = true
 		}
 
-		return distA < distB
+		if distA != distB {
+			return distA < distB
+		}
+
+		// break ties deterministically, so that pagination is stable
+		return rs[a].ID < rs[b].ID
 	})
 
 	if hasErrored {
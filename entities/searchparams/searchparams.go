@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package searchparams contains the parameters of vector and keyword searches
+// which are shared by several APIs, such as Get and Aggregate
+package searchparams
+
+// Both NearVector and NearObject limit the results either by their minimum
+// Certainty or by their maximum raw Distance in the metric of the class. A
+// Distance of 0 is a valid limit, so it is only used if WithDistance is set.
+
+type NearVector struct {
+	Vector       []float32 `json:"vector"`
+	Certainty    float64   `json:"certainty"`
+	Distance     float64   `json:"distance"`
+	WithDistance bool      `json:"withDistance"`
+
+	// Vectors are the query vectors of a late interaction search, they are
+	// set instead of Vector
+	Vectors [][]float32 `json:"vectors,omitempty"`
+}
+
+type NearObject struct {
+	ID           string  `json:"id"`
+	Beacon       string  `json:"beacon"`
+	Certainty    float64 `json:"certainty"`
+	Distance     float64 `json:"distance"`
+	WithDistance bool    `json:"withDistance"`
+}
+
+// KeywordRanking ranks objects by the BM25 relevance of their text and string
+// properties for the Query, it never involves a vector. If no Properties are
+// set, all searchable properties of the class are used.
+type KeywordRanking struct {
+	Properties []string `json:"properties"`
+	Query      string   `json:"query"`
+}
+
+// SparseRanking ranks objects by the dot product of their sparse vectors with
+// the sparse vector of the Query, which the sparse vectorizer module of the
+// class produces. Vector is set once the query has been vectorized.
+type SparseRanking struct {
+	Query  string             `json:"query"`
+	Vector map[string]float32 `json:"vector,omitempty"`
+}
@@ -30,8 +30,10 @@ import (
 
 type Object struct {
 	MarshallerVersion uint8
-	Object            models.Object `json:"object"`
-	Vector            []float32     `json:"vector"`
+	Object            models.Object      `json:"object"`
+	Vector            []float32          `json:"vector"`
+	MultiVector       [][]float32        `json:"multiVector"`
+	SparseVector      map[string]float32 `json:"sparseVector"`
 	docID             uint64
 }
 
@@ -43,9 +45,27 @@ func New(docID uint64) *Object {
 }
 
 func FromObject(object *models.Object, vector []float32) *Object {
+	var multiVector [][]float32
+	if len(object.MultiVector) > 0 {
+		multiVector = make([][]float32, len(object.MultiVector))
+		for i, vec := range object.MultiVector {
+			multiVector[i] = vec
+		}
+	}
+
+	var sparseVector map[string]float32
+	if len(object.SparseVector) > 0 {
+		sparseVector = make(map[string]float32, len(object.SparseVector))
+		for term, weight := range object.SparseVector {
+			sparseVector[term] = weight
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
+		MultiVector:       multiVector,
+		SparseVector:      sparseVector,
 		MarshallerVersion: 1,
 	}
 }
@@ -59,8 +79,36 @@ func FromBinary(data []byte) (*Object, error) {
 	return ko, nil
 }
 
+// DecodeOptions select which optional parts of a binary object are decoded.
+// The zero value decodes the entire object, like FromBinary.
+type DecodeOptions struct {
+	// SkipVector leaves the vector and the multi vector empty. They are the
+	// largest part of most objects, but not needed to analyze their
+	// properties.
+	SkipVector bool
+	// SkipAdditional leaves the additional properties, such as the results
+	// of a classification, empty
+	SkipAdditional bool
+}
+
+// DecodePropertiesOnly decodes everything needed to analyze the properties of
+// objects, e.g. to aggregate or group them, but nothing else
+var DecodePropertiesOnly = DecodeOptions{SkipVector: true, SkipAdditional: true}
+
+// FromBinaryOptional only decodes the vector and the additional properties
+// if they were requested
 func FromBinaryOptional(data []byte,
 	addProp additional.Properties) (*Object, error) {
+	return FromBinaryWithOptions(data, DecodeOptions{
+		SkipVector:     !addProp.Vector,
+		SkipAdditional: !addProp.Classification && len(addProp.ModuleParams) == 0,
+	})
+}
+
+// FromBinaryWithOptions decodes an object, skipping the parts excluded by the
+// options. Skipped parts are not copied out of data at all, which saves both
+// allocations and CPU when scanning many objects.
+func FromBinaryWithOptions(data []byte, opts DecodeOptions) (*Object, error) {
 	ko := &Object{}
 
 	var version uint8
@@ -96,11 +144,12 @@ func FromBinaryOptional(data []byte,
 	ec.add(binary.Read(r, le, &createTime), "create time")
 	ec.add(binary.Read(r, le, &updateTime), "update time")
 	ec.add(binary.Read(r, le, &vectorLength), "vector length")
-	if addProp.Vector {
+	if !opts.SkipVector {
 		ko.Vector = make([]float32, vectorLength)
 		ec.add(binary.Read(r, le, &ko.Vector), "read vector")
 	} else {
-		io.CopyN(io.Discard, r, int64(vectorLength*4))
+		_, err = r.Seek(int64(vectorLength)*4, io.SeekCurrent)
+		ec.add(err, "skip vector")
 	}
 	ec.add(binary.Read(r, le, &classNameLength), "class name length")
 	className := make([]byte, classNameLength)
@@ -112,12 +161,13 @@ func FromBinaryOptional(data []byte,
 	ec.add(err, "schema")
 	ec.add(binary.Read(r, le, &metaLength), "additional length")
 	var meta []byte
-	if addProp.Classification || len(addProp.ModuleParams) > 0 {
+	if !opts.SkipAdditional {
 		meta = make([]byte, metaLength)
 		_, err = r.Read(meta)
 		ec.add(err, "read additional")
 	} else {
-		io.CopyN(io.Discard, r, int64(metaLength))
+		_, err = r.Seek(int64(metaLength), io.SeekCurrent)
+		ec.add(err, "skip additional")
 	}
 
 	ec.add(binary.Read(r, le, &vectorWeightsLength), "vector weights length")
@@ -125,6 +175,13 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.add(err, "vector weights")
 
+	if !opts.SkipVector {
+		ko.MultiVector, err = readMultiVector(r)
+		ec.add(err, "multi vector")
+		ko.SparseVector, err = readSparseVector(r)
+		ec.add(err, "sparse vector")
+	}
+
 	if err := ec.toError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -251,10 +308,12 @@ func (ko *Object) SearchResult(additional additional.Properties) *search.Result
 	}
 
 	return &search.Result{
-		ID:        ko.ID(),
-		ClassName: ko.Class().String(),
-		Schema:    ko.Properties(),
-		Vector:    ko.Vector,
+		ID:           ko.ID(),
+		ClassName:    ko.Class().String(),
+		Schema:       ko.Properties(),
+		Vector:       ko.Vector,
+		MultiVector:  ko.MultiVector,
+		SparseVector: ko.SparseVector,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
@@ -291,6 +350,18 @@ func SearchResultsWithDists(in []*Object, additional additional.Properties,
 	return out
 }
 
+func SearchResultsWithScores(in []*Object, additional additional.Properties,
+	scores []float32) search.Results {
+	out := make(search.Results, len(in))
+
+	for i, elem := range in {
+		out[i] = *(elem.SearchResult(additional))
+		out[i].Score = scores[i]
+	}
+
+	return out
+}
+
 func DocIDFromBinary(in []byte) (uint64, error) {
 	var version uint8
 	r := bytes.NewReader(in)
@@ -331,6 +402,16 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+//
+// Only if the object has a multi vector, older objects simply end here:
+// 2          | uint16    | number of token vectors n
+// 2          | uint16    | dimensions of the token vectors d
+// n*d*4      | []float32 | token vectors
+//
+// Only if the object has a sparse vector, the multi vector section is then
+// always present, but may have zero token vectors:
+// 4          | uint32    | number of terms n
+// n*(2+l+4)  | []term    | each a uint16 length l, the term and its float32 weight
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -392,6 +473,33 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	_, err = buf.Write(vectorWeights)
 	ec.add(err)
 
+	if len(ko.MultiVector) > 0 || len(ko.SparseVector) > 0 {
+		dims := 0
+		if len(ko.MultiVector) > 0 {
+			dims = len(ko.MultiVector[0])
+		}
+		ec.add(binary.Write(buf, le, uint16(len(ko.MultiVector))))
+		ec.add(binary.Write(buf, le, uint16(dims)))
+		for i, vec := range ko.MultiVector {
+			if len(vec) != dims {
+				ec.add(errors.Errorf("multi vector: token vector %d has %d dimensions, "+
+					"but token vector 0 has %d", i, len(vec), dims))
+				continue
+			}
+			ec.add(binary.Write(buf, le, vec))
+		}
+	}
+
+	if len(ko.SparseVector) > 0 {
+		ec.add(binary.Write(buf, le, uint32(len(ko.SparseVector))))
+		for term, weight := range ko.SparseVector {
+			ec.add(binary.Write(buf, le, uint16(len(term))))
+			_, err = buf.WriteString(term)
+			ec.add(err)
+			ec.add(binary.Write(buf, le, weight))
+		}
+	}
+
 	return buf.Bytes(), ec.toError()
 }
 
@@ -449,6 +557,10 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	vectorWeights := make([]byte, vectorWeightsLength)
 	_, err = r.Read(vectorWeights)
 	ec.add(err)
+	ko.MultiVector, err = readMultiVector(r)
+	ec.add(err)
+	ko.SparseVector, err = readSparseVector(r)
+	ec.add(err)
 
 	if err := ec.toError(); err != nil {
 		return err
@@ -488,7 +600,7 @@ func VectorFromBinary(in []byte) ([]float32, error) {
 
 	out := make([]float32, vecLen)
 	vecStart := 44
-	vecEnd := vecStart + int(vecLen*4)
+	vecEnd := vecStart + int(vecLen)*4
 
 	i := 0
 	for start := vecStart; start < vecEnd; start += 4 {
@@ -500,6 +612,97 @@ func VectorFromBinary(in []byte) ([]float32, error) {
 	return out, nil
 }
 
+// readMultiVector reads the optional multi vector at the end of a binary
+// object, it is nil if the object has none
+func readMultiVector(r *bytes.Reader) ([][]float32, error) {
+	if r.Len() == 0 {
+		return nil, nil
+	}
+
+	var count, dims uint16
+	le := binary.LittleEndian
+	if err := binary.Read(r, le, &count); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(r, le, &dims); err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		// only written as a placeholder before a sparse vector
+		return nil, nil
+	}
+
+	out := make([][]float32, count)
+	for i := range out {
+		out[i] = make([]float32, dims)
+		if err := binary.Read(r, le, out[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// readSparseVector reads the optional sparse vector after the multi vector
+// section of a binary object, it is nil if the object has none
+func readSparseVector(r *bytes.Reader) (map[string]float32, error) {
+	if r.Len() == 0 {
+		return nil, nil
+	}
+
+	var count uint32
+	le := binary.LittleEndian
+	if err := binary.Read(r, le, &count); err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]float32, count)
+	for i := uint32(0); i < count; i++ {
+		var termLength uint16
+		if err := binary.Read(r, le, &termLength); err != nil {
+			return nil, err
+		}
+		term := make([]byte, termLength)
+		if _, err := io.ReadFull(r, term); err != nil {
+			return nil, err
+		}
+		var weight float32
+		if err := binary.Read(r, le, &weight); err != nil {
+			return nil, err
+		}
+		out[string(term)] = weight
+	}
+
+	return out, nil
+}
+
+// MultiVectorFromBinary only decodes the multi vector of a binary object,
+// skipping everything before it
+func MultiVectorFromBinary(in []byte) ([][]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	// the fixed size header is followed by the vector, see VectorFromBinary
+	le := binary.LittleEndian
+	pos := 44 + int(le.Uint16(in[42:44]))*4
+	classNameLength := int(le.Uint16(in[pos : pos+2]))
+	pos += 2 + classNameLength
+	for i := 0; i < 3; i++ {
+		// schema, meta and vector weights
+		length := int(le.Uint32(in[pos : pos+4]))
+		pos += 4 + length
+	}
+
+	return readMultiVector(bytes.NewReader(in[pos:]))
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
 	schemaB []byte, additionalB []byte, vectorWeightsB []byte) error {
 	var schema map[string]interface{}
@@ -563,7 +766,33 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		MultiVector:       deepCopyMultiVector(ko.MultiVector),
+		SparseVector:      deepCopySparseVector(ko.SparseVector),
+	}
+}
+
+func deepCopySparseVector(orig map[string]float32) map[string]float32 {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(map[string]float32, len(orig))
+	for term, weight := range orig {
+		out[term] = weight
+	}
+	return out
+}
+
+func deepCopyMultiVector(orig [][]float32) [][]float32 {
+	if orig == nil {
+		return nil
 	}
+
+	out := make([][]float32, len(orig))
+	for i, vec := range orig {
+		out[i] = deepCopyVector(vec)
+	}
+	return out
 }
 
 func deepCopyVector(orig []float32) []float32 {
@@ -127,6 +127,187 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 	})
 }
 
+func TestStorageObjectDecodeOptions(t *testing.T) {
+	// a vector with more than 16k dimensions makes sure the length of the
+	// skipped bytes does not overflow
+	vector := make([]float32, 20000)
+	for i := range vector {
+		vector[i] = float32(i)
+	}
+
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Additional: models.AdditionalProperties{
+				"classification": &additional.Classification{
+					BasedOn: []string{"some", "fields"},
+				},
+			},
+			Properties: map[string]interface{}{
+				"name": "MyName",
+				"foo":  float64(17),
+			},
+		},
+		vector,
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("decode everything", func(t *testing.T) {
+		after, err := FromBinaryWithOptions(asBinary, DecodeOptions{})
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("properties only", func(t *testing.T) {
+		after, err := FromBinaryWithOptions(asBinary, DecodePropertiesOnly)
+		require.Nil(t, err)
+
+		assert.Nil(t, after.Vector)
+		assert.Nil(t, after.Object.Additional)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+		assert.Equal(t, before.ID(), after.ID())
+		assert.Equal(t, before.DocID(), after.DocID())
+		assert.Equal(t, before.Class(), after.Class())
+	})
+
+	t.Run("skip the vector only", func(t *testing.T) {
+		after, err := FromBinaryWithOptions(asBinary, DecodeOptions{SkipVector: true})
+		require.Nil(t, err)
+
+		assert.Nil(t, after.Vector)
+		assert.Equal(t, before.Object.Additional, after.Object.Additional)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+}
+
+func TestStorageObjectMultiVector(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			MultiVector: []models.C11yVector{{1, 2, 3}, {4, 5, 6}},
+		},
+		[]float32{1, 2},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("decode everything", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		assert.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}}, after.MultiVector)
+		assert.Equal(t, before.Vector, after.Vector)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("skip the vectors", func(t *testing.T) {
+		after, err := FromBinaryWithOptions(asBinary, DecodePropertiesOnly)
+		require.Nil(t, err)
+
+		assert.Nil(t, after.MultiVector)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("extract only the multi vector", func(t *testing.T) {
+		multiVector, err := MultiVectorFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}}, multiVector)
+	})
+
+	t.Run("an object without a multi vector", func(t *testing.T) {
+		before.MultiVector = nil
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		multiVector, err := MultiVectorFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, multiVector)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, after.MultiVector)
+	})
+
+	t.Run("token vectors with different dimensions", func(t *testing.T) {
+		before.MultiVector = [][]float32{{1, 2}, {1}}
+		_, err := before.MarshalBinary()
+		assert.NotNil(t, err)
+	})
+}
+
+func TestStorageObjectSparseVector(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			SparseVector: models.SparseVector{"car": 1.5, "vehicle": 0.25},
+		},
+		[]float32{1, 2},
+	)
+	before.SetDocID(7)
+
+	t.Run("without a multi vector", func(t *testing.T) {
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]float32{"car": 1.5, "vehicle": 0.25}, after.SparseVector)
+		assert.Nil(t, after.MultiVector)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+
+		multiVector, err := MultiVectorFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, multiVector)
+	})
+
+	t.Run("together with a multi vector", func(t *testing.T) {
+		before.MultiVector = [][]float32{{1, 2, 3}, {4, 5, 6}}
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]float32{"car": 1.5, "vehicle": 0.25}, after.SparseVector)
+		assert.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}}, after.MultiVector)
+	})
+
+	t.Run("skip the vectors", func(t *testing.T) {
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinaryWithOptions(asBinary, DecodePropertiesOnly)
+		require.Nil(t, err)
+
+		assert.Nil(t, after.SparseVector)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("a deep copy does not share the sparse vector", func(t *testing.T) {
+		copied := before.DeepCopyDangerous()
+		copied.SparseVector["car"] = 7
+
+		assert.Equal(t, float32(1.5), before.SparseVector["car"])
+	})
+}
+
 func TestNewStorageObject(t *testing.T) {
 	t.Run("objects", func(t *testing.T) {
 		so := New(12)
@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package tenant carries the tenant a request targets. Classes with
+// multi-tenancy enabled store the data of each tenant in a shard of its own,
+// named after the tenant.
+package tenant
+
+import "context"
+
+type tenantKey struct{}
+
+// NewContext returns a context which scopes all reads and deletes to the
+// specified tenant. Writes are scoped by the tenant set on the object itself.
+func NewContext(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, tenantKey{}, name)
+}
+
+// FromContext returns the tenant set with NewContext, or an empty string if
+// the request is not scoped to a tenant
+func FromContext(ctx context.Context) string {
+	name, _ := ctx.Value(tenantKey{}).(string)
+	return name
+}
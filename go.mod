@@ -21,6 +21,7 @@ require (
 	github.com/graphql-go/graphql v0.7.9
 	github.com/hashicorp/memberlist v0.2.4
 	github.com/jessevdk/go-flags v1.4.0
+	github.com/klauspost/compress v1.13.6
 	github.com/mailru/easyjson v0.7.7 // indirect
 	github.com/mitchellh/mapstructure v1.4.3 // indirect
 	github.com/nyaruka/phonenumbers v1.0.54
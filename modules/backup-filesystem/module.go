@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modbackupfs
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+const Name = "backup-filesystem"
+
+func New() *BackupFilesystemModule {
+	return &BackupFilesystemModule{}
+}
+
+// BackupFilesystemModule stores backups in a directory on the local
+// filesystem, typically a mounted volume which survives the node. In a
+// cluster every node writes the files of its own shards, so the directory
+// needs to be shared between the nodes for a backup to be restorable.
+type BackupFilesystemModule struct {
+	rootPath string
+}
+
+func (m *BackupFilesystemModule) Name() string {
+	return Name
+}
+
+func (m *BackupFilesystemModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	rootPath := os.Getenv("BACKUP_FILESYSTEM_PATH")
+	if rootPath == "" {
+		return errors.Errorf("required variable BACKUP_FILESYSTEM_PATH is not set")
+	}
+
+	return m.init(rootPath)
+}
+
+func (m *BackupFilesystemModule) init(rootPath string) error {
+	if err := os.MkdirAll(rootPath, 0o755); err != nil {
+		return errors.Wrapf(err, "create backup root path %q", rootPath)
+	}
+
+	m.rootPath = rootPath
+	return nil
+}
+
+func (m *BackupFilesystemModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *BackupFilesystemModule) HomeDir(backupID string) string {
+	return filepath.Join(m.rootPath, backupID)
+}
+
+func (m *BackupFilesystemModule) PutObject(ctx context.Context, backupID,
+	key string, r io.Reader) error {
+	path, err := m.objectPath(backupID, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrapf(err, "create directory for %q", key)
+	}
+
+	// write to a temporary file first, so that a partially written object is
+	// never mistaken for a complete one
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Wrapf(err, "create file for %q", key)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "write %q", key)
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "sync %q", key)
+	}
+
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "close %q", key)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return errors.Wrapf(err, "rename %q", key)
+	}
+
+	return nil
+}
+
+func (m *BackupFilesystemModule) GetObject(ctx context.Context, backupID,
+	key string) (io.ReadCloser, error) {
+	path, err := m.objectPath(backupID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errortypes.New(errortypes.KindNotFound,
+				"object %q of backup %q does not exist", key, backupID)
+		}
+		return nil, errors.Wrapf(err, "open %q", key)
+	}
+
+	return f, nil
+}
+
+// objectPath makes sure the object can never end up outside of the home dir
+// of the backup
+func (m *BackupFilesystemModule) objectPath(backupID, key string) (string, error) {
+	home := m.HomeDir(backupID)
+	path := filepath.Join(home, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, home+string(filepath.Separator)) {
+		return "", errortypes.New(errortypes.KindValidation,
+			"invalid key %q for backup %q", key, backupID)
+	}
+
+	return path, nil
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.BackupBackend(New())
+)
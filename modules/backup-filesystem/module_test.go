@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modbackupfs
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupFilesystem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup-filesystem")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	m := New()
+	require.Nil(t, m.init(dir))
+	ctx := context.Background()
+
+	t.Run("home dir", func(t *testing.T) {
+		assert.Equal(t, filepath.Join(dir, "my-backup"), m.HomeDir("my-backup"))
+	})
+
+	t.Run("put and get an object", func(t *testing.T) {
+		err := m.PutObject(ctx, "my-backup", "data/shard_lsm/objects/segment-1.db",
+			bytes.NewReader([]byte("hello")))
+		require.Nil(t, err)
+
+		r, err := m.GetObject(ctx, "my-backup", "data/shard_lsm/objects/segment-1.db")
+		require.Nil(t, err)
+		defer r.Close()
+
+		contents, err := ioutil.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, "hello", string(contents))
+
+		files, err := ioutil.ReadDir(filepath.Join(dir, "my-backup", "data",
+			"shard_lsm", "objects"))
+		require.Nil(t, err)
+		assert.Len(t, files, 1, "no temporary files are left behind")
+	})
+
+	t.Run("overwrite an object", func(t *testing.T) {
+		err := m.PutObject(ctx, "my-backup", "backup.json",
+			bytes.NewReader([]byte("first")))
+		require.Nil(t, err)
+		err = m.PutObject(ctx, "my-backup", "backup.json",
+			bytes.NewReader([]byte("second")))
+		require.Nil(t, err)
+
+		r, err := m.GetObject(ctx, "my-backup", "backup.json")
+		require.Nil(t, err)
+		defer r.Close()
+
+		contents, err := ioutil.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, "second", string(contents))
+	})
+
+	t.Run("get a missing object", func(t *testing.T) {
+		_, err := m.GetObject(ctx, "other-backup", "backup.json")
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+
+	t.Run("keys cannot escape the backup", func(t *testing.T) {
+		err := m.PutObject(ctx, "my-backup", "../other-backup/backup.json",
+			bytes.NewReader(nil))
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package moddatavalidator
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/modules/data-validator/rules"
+)
+
+func New() *DataValidatorModule {
+	return &DataValidatorModule{validator: rules.New()}
+}
+
+// DataValidatorModule enforces data quality rules, such as regex
+// constraints, numeric ranges and rules comparing several properties, which
+// are declared in the moduleConfig of a class. Objects violating any rule are
+// rejected at write time. It does not rely on any inference container.
+type DataValidatorModule struct {
+	validator *rules.Validator
+}
+
+func (m *DataValidatorModule) Name() string {
+	return "data-validator"
+}
+
+func (m *DataValidatorModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *DataValidatorModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *DataValidatorModule) ValidateRules(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig) error {
+	return m.validator.ValidateRules(ctx, class, cfg)
+}
+
+func (m *DataValidatorModule) ValidateObject(ctx context.Context,
+	props map[string]interface{},
+	cfg moduletools.ClassConfig) ([]modulecapabilities.RuleViolation, error) {
+	return m.validator.ValidateObject(ctx, props, cfg)
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Validator(New())
+)
@@ -0,0 +1,327 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// The operators of cross-property rules, they compare the value of the
+// rule's property with the value of another property of the same object
+const (
+	OperatorLessThan           = "lessThan"
+	OperatorLessThanOrEqual    = "lessThanOrEqual"
+	OperatorGreaterThan        = "greaterThan"
+	OperatorGreaterThanOrEqual = "greaterThanOrEqual"
+)
+
+var operators = []string{
+	OperatorLessThan, OperatorLessThanOrEqual,
+	OperatorGreaterThan, OperatorGreaterThanOrEqual,
+}
+
+// Rule constrains the values of a single property, which may be compared to
+// other properties of the same object. All constraints which are set must
+// be met. Values which are not set only violate Required, every other
+// constraint only applies to values which are set.
+type Rule struct {
+	Name     string
+	Property string
+
+	// Required means the property must be set
+	Required bool
+
+	// Pattern is a regular expression which every text value of the
+	// property must match. It is not anchored implicitly.
+	Pattern string
+
+	// Minimum and Maximum are the inclusive bounds of every numeric value of
+	// the property, nil means unbounded
+	Minimum *float64
+	Maximum *float64
+
+	// Operator compares the value of the property with the value of Other,
+	// both must be numbers or both dates
+	Operator string
+	Other    string
+
+	// Requires are the properties which must be set if the property is set
+	Requires []string
+}
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+// Rules parses the rules of the class, e.g.
+//
+//	{"rules": [
+//	  {"name": "sku-format", "property": "sku", "required": true, "pattern": "^[A-Z]{3}-[0-9]+$"},
+//	  {"name": "price-range", "property": "price", "minimum": 0, "maximum": 10000},
+//	  {"name": "sale-below-price", "property": "salePrice", "lessThan": "price"},
+//	  {"name": "currency-with-price", "property": "price", "requires": ["currency"]}
+//	]}
+//
+// Rules without a name are named after their position.
+func (cs *classSettings) Rules() ([]Rule, error) {
+	if cs.cfg == nil {
+		return nil, errors.Errorf("empty config")
+	}
+
+	raw, ok := cs.cfg.Class()["rules"]
+	if !ok {
+		return nil, errors.Errorf("rules must be set")
+	}
+
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("rules must be a list of rules, got %T", raw)
+	}
+
+	out := make([]Rule, len(list))
+	names := map[string]struct{}{}
+	for i, item := range list {
+		asMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("rules[%d] must be an object, got %T", i, item)
+		}
+
+		rule, err := parseRule(asMap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rules[%d]", i)
+		}
+
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rules[%d]", i)
+		}
+
+		if _, ok := names[rule.Name]; ok {
+			return nil, errors.Errorf("rules[%d]: name %q is not unique", i, rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		out[i] = rule
+	}
+
+	return out, nil
+}
+
+func parseRule(in map[string]interface{}) (Rule, error) {
+	var rule Rule
+	for key, value := range in {
+		var err error
+		switch key {
+		case "name":
+			rule.Name, err = asString(key, value)
+		case "property":
+			rule.Property, err = asString(key, value)
+		case "required":
+			var ok bool
+			if rule.Required, ok = value.(bool); !ok {
+				err = errors.Errorf("required must be a bool, got %T", value)
+			}
+		case "pattern":
+			rule.Pattern, err = asString(key, value)
+			if err == nil {
+				_, err = regexp.Compile(rule.Pattern)
+				err = errors.Wrap(err, "pattern")
+			}
+		case "minimum":
+			rule.Minimum, err = asNumber(key, value)
+		case "maximum":
+			rule.Maximum, err = asNumber(key, value)
+		case OperatorLessThan, OperatorLessThanOrEqual, OperatorGreaterThan,
+			OperatorGreaterThanOrEqual:
+			if rule.Operator != "" {
+				return rule, errors.Errorf("only one of %v can be set", operators)
+			}
+			rule.Operator = key
+			rule.Other, err = asString(key, value)
+		case "requires":
+			rule.Requires, err = asStrings(key, value)
+		default:
+			err = errors.Errorf("unknown field %q", key)
+		}
+
+		if err != nil {
+			return rule, err
+		}
+	}
+
+	if rule.Property == "" {
+		return rule, errors.Errorf("property must be set")
+	}
+
+	if !rule.Required && rule.Pattern == "" && rule.Minimum == nil &&
+		rule.Maximum == nil && rule.Operator == "" && len(rule.Requires) == 0 {
+		return rule, errors.Errorf("rule on property %q has no constraints",
+			rule.Property)
+	}
+
+	if rule.Minimum != nil && rule.Maximum != nil && *rule.Minimum > *rule.Maximum {
+		return rule, errors.Errorf("minimum %v is greater than maximum %v",
+			*rule.Minimum, *rule.Maximum)
+	}
+
+	return rule, nil
+}
+
+// Validate parses the rules and makes sure they can be evaluated on the
+// objects of the class
+func (cs *classSettings) Validate(class *models.Class) error {
+	rules, err := cs.Rules()
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if err := validateRule(class, rule); err != nil {
+			return errors.Wrapf(err, "rule %q", rule.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateRule(class *models.Class, rule Rule) error {
+	dt, err := propertyDataType(class, rule.Property)
+	if err != nil {
+		return err
+	}
+
+	if rule.Pattern != "" && !isText(dt) {
+		return errors.Errorf("pattern requires a text property, but %q is of "+
+			"type %s", rule.Property, dt)
+	}
+
+	if (rule.Minimum != nil || rule.Maximum != nil) && !isNumeric(dt) {
+		return errors.Errorf("minimum and maximum require a numeric property, but "+
+			"%q is of type %s", rule.Property, dt)
+	}
+
+	if rule.Operator != "" {
+		otherDt, err := propertyDataType(class, rule.Other)
+		if err != nil {
+			return err
+		}
+
+		comparable := (isNumber(dt) && isNumber(otherDt)) ||
+			(dt == schema.DataTypeDate && otherDt == schema.DataTypeDate)
+		if !comparable {
+			return errors.Errorf("%s requires two number or two date properties, "+
+				"but %q is of type %s and %q of type %s", rule.Operator,
+				rule.Property, dt, rule.Other, otherDt)
+		}
+	}
+
+	for _, prop := range rule.Requires {
+		if _, err := propertyDataType(class, prop); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func propertyDataType(class *models.Class, propName string) (schema.DataType, error) {
+	dt, err := schema.GetPropertyDataType(class, propName)
+	if err != nil {
+		return "", errors.Errorf("class %s has no property %q", class.Class, propName)
+	}
+
+	return *dt, nil
+}
+
+func isText(dt schema.DataType) bool {
+	switch dt {
+	case schema.DataTypeString, schema.DataTypeText,
+		schema.DataTypeStringArray, schema.DataTypeTextArray:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumeric(dt schema.DataType) bool {
+	switch dt {
+	case schema.DataTypeInt, schema.DataTypeNumber,
+		schema.DataTypeIntArray, schema.DataTypeNumberArray:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNumber is true for properties holding a single number
+func isNumber(dt schema.DataType) bool {
+	return dt == schema.DataTypeInt || dt == schema.DataTypeNumber
+}
+
+func asString(key string, value interface{}) (string, error) {
+	asString, ok := value.(string)
+	if !ok || asString == "" {
+		return "", errors.Errorf("%s must be a non-empty string, got %v", key, value)
+	}
+
+	return asString, nil
+}
+
+func asStrings(key string, value interface{}) ([]string, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("%s must be a list of strings, got %T", key, value)
+	}
+
+	out := make([]string, len(list))
+	for i, item := range list {
+		asString, ok := item.(string)
+		if !ok {
+			return nil, errors.Errorf("%s must be a list of strings, got %T at "+
+				"position %d", key, item, i)
+		}
+		out[i] = asString
+	}
+
+	return out, nil
+}
+
+func asNumber(key string, value interface{}) (*float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return &v, nil
+	case int:
+		f := float64(v)
+		return &f, nil
+	case int64:
+		f := float64(v)
+		return &f, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, errors.Wrap(err, key)
+		}
+		return &f, nil
+	default:
+		return nil, errors.Errorf("%s must be a number, got %T", key, value)
+	}
+}
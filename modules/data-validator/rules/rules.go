@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+type Validator struct {
+	// patterns are compiled once and kept for as long as the module runs,
+	// the number of distinct patterns is bounded by the schema
+	sync.Mutex
+	patterns map[string]*regexp.Regexp
+}
+
+func New() *Validator {
+	return &Validator{patterns: map[string]*regexp.Regexp{}}
+}
+
+// ValidateRules makes sure the rules of a class which is about to be
+// created can be evaluated on its objects
+func (v *Validator) ValidateRules(ctx context.Context, class *models.Class,
+	cfg moduletools.ClassConfig) error {
+	return NewClassSettings(cfg).Validate(class)
+}
+
+// ValidateObject evaluates every rule of the class on the properties of an
+// object which is about to be written and returns the violated ones. A rule
+// is only reported once, at its first violating value.
+func (v *Validator) ValidateObject(ctx context.Context,
+	props map[string]interface{},
+	cfg moduletools.ClassConfig) ([]modulecapabilities.RuleViolation, error) {
+	rules, err := NewClassSettings(cfg).Rules()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid data-validator config")
+	}
+
+	var out []modulecapabilities.RuleViolation
+	for _, rule := range rules {
+		msg, err := v.evaluate(rule, props)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule %q", rule.Name)
+		}
+
+		if msg != "" {
+			out = append(out, modulecapabilities.RuleViolation{
+				Rule:     rule.Name,
+				Property: rule.Property,
+				Message:  msg,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// evaluate returns why the props violate the rule, or an empty string
+func (v *Validator) evaluate(rule Rule, props map[string]interface{}) (string, error) {
+	value, ok := props[rule.Property]
+	if !ok || value == nil {
+		if rule.Required {
+			return "is required", nil
+		}
+		return "", nil
+	}
+
+	values := elements(value)
+
+	if rule.Pattern != "" {
+		re, err := v.pattern(rule.Pattern)
+		if err != nil {
+			return "", err
+		}
+
+		for _, elem := range values {
+			text, ok := elem.(string)
+			if !ok {
+				return fmt.Sprintf("value %v is not a text", elem), nil
+			}
+
+			if !re.MatchString(text) {
+				return fmt.Sprintf("value %q does not match pattern %q", text,
+					rule.Pattern), nil
+			}
+		}
+	}
+
+	if rule.Minimum != nil || rule.Maximum != nil {
+		for _, elem := range values {
+			number, ok := toNumber(elem)
+			if !ok {
+				return fmt.Sprintf("value %v is not a number", elem), nil
+			}
+
+			if rule.Minimum != nil && number < *rule.Minimum {
+				return fmt.Sprintf("value %v is less than the minimum of %v", number,
+					*rule.Minimum), nil
+			}
+
+			if rule.Maximum != nil && number > *rule.Maximum {
+				return fmt.Sprintf("value %v is greater than the maximum of %v",
+					number, *rule.Maximum), nil
+			}
+		}
+	}
+
+	if rule.Operator != "" {
+		if msg := compare(rule, value, props[rule.Other]); msg != "" {
+			return msg, nil
+		}
+	}
+
+	for _, other := range rule.Requires {
+		if props[other] == nil {
+			return fmt.Sprintf("requires %q to be set", other), nil
+		}
+	}
+
+	return "", nil
+}
+
+// compare checks value against the value of the other property of the rule,
+// which is not evaluated if the other property is not set
+func compare(rule Rule, value, other interface{}) string {
+	if other == nil {
+		return ""
+	}
+
+	a, aIsDate, ok := toOrdered(value)
+	if !ok {
+		return fmt.Sprintf("value %v can not be compared", value)
+	}
+
+	b, bIsDate, ok := toOrdered(other)
+	if !ok || aIsDate != bIsDate {
+		return fmt.Sprintf("value %v can not be compared to %q (%v)", value,
+			rule.Other, other)
+	}
+
+	var holds bool
+	var relation string
+	switch rule.Operator {
+	case OperatorLessThan:
+		holds, relation = a < b, "less than"
+	case OperatorLessThanOrEqual:
+		holds, relation = a <= b, "less than or equal to"
+	case OperatorGreaterThan:
+		holds, relation = a > b, "greater than"
+	case OperatorGreaterThanOrEqual:
+		holds, relation = a >= b, "greater than or equal to"
+	}
+
+	if holds {
+		return ""
+	}
+
+	return fmt.Sprintf("value %v must be %s %q (%v)", value, relation,
+		rule.Other, other)
+}
+
+func (v *Validator) pattern(pattern string) (*regexp.Regexp, error) {
+	v.Lock()
+	defer v.Unlock()
+
+	if re, ok := v.patterns[pattern]; ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	v.patterns[pattern] = re
+	return re, nil
+}
+
+// elements returns the values of an array property or the single value of
+// any other property
+func elements(value interface{}) []interface{} {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return []interface{}{value}
+	}
+
+	out := make([]interface{}, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// toOrdered maps numbers and dates onto a float64 which preserves their
+// order. Dates may be parsed already or RFC3339 strings, as they are when
+// read back from the database.
+func toOrdered(value interface{}) (float64, bool, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return float64(v.UnixNano()), true, true
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return 0, false, false
+		}
+		return float64(t.UnixNano()), true, true
+	default:
+		number, ok := toNumber(value)
+		return number, false, ok
+	}
+}
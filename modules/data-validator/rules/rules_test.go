@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateObject(t *testing.T) {
+	cfg := fakeClassConfig{
+		"rules": []interface{}{
+			map[string]interface{}{
+				"name":     "sku-format",
+				"property": "sku",
+				"required": true,
+				"pattern":  "^[A-Z]{3}-[0-9]+$",
+			},
+			map[string]interface{}{
+				"name":     "price-range",
+				"property": "price",
+				"minimum":  0.0,
+				"maximum":  1000.0,
+			},
+			map[string]interface{}{
+				"name":     "sale-below-price",
+				"property": "salePrice",
+				"lessThan": "price",
+			},
+			map[string]interface{}{
+				"property": "price",
+				"requires": []interface{}{"currency"},
+			},
+			map[string]interface{}{
+				"name":        "ends-after-start",
+				"property":    "end",
+				"greaterThan": "start",
+			},
+			map[string]interface{}{
+				"name":     "tags-format",
+				"property": "tags",
+				"pattern":  "^[a-z]+$",
+			},
+		},
+	}
+
+	start := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	validate := func(t *testing.T,
+		props map[string]interface{}) []modulecapabilities.RuleViolation {
+		violations, err := New().ValidateObject(context.Background(), props, cfg)
+		require.Nil(t, err)
+		return violations
+	}
+
+	t.Run("a valid object", func(t *testing.T) {
+		violations := validate(t, map[string]interface{}{
+			"sku":       "ABC-123",
+			"price":     int64(100),
+			"salePrice": 80.0,
+			"currency":  "EUR",
+			"start":     start,
+			"end":       start.Add(time.Hour),
+			"tags":      []string{"new", "sale"},
+		})
+		assert.Len(t, violations, 0)
+	})
+
+	t.Run("only the required rules apply to unset properties", func(t *testing.T) {
+		violations := validate(t, map[string]interface{}{})
+		assert.Equal(t, []modulecapabilities.RuleViolation{{
+			Rule:     "sku-format",
+			Property: "sku",
+			Message:  "is required",
+		}}, violations)
+	})
+
+	t.Run("every violated rule is reported", func(t *testing.T) {
+		violations := validate(t, map[string]interface{}{
+			"sku":       "abc-123",
+			"price":     2000.0,
+			"salePrice": 2500.0,
+			// read back from the database dates are strings
+			"start": "2021-10-02T00:00:00Z",
+			"end":   start,
+			"tags":  []interface{}{"new", "Sale"},
+		})
+		assert.Equal(t, []modulecapabilities.RuleViolation{
+			{
+				Rule:     "sku-format",
+				Property: "sku",
+				Message:  `value "abc-123" does not match pattern "^[A-Z]{3}-[0-9]+$"`,
+			},
+			{
+				Rule:     "price-range",
+				Property: "price",
+				Message:  "value 2000 is greater than the maximum of 1000",
+			},
+			{
+				Rule:     "sale-below-price",
+				Property: "salePrice",
+				Message:  `value 2500 must be less than "price" (2000)`,
+			},
+			{
+				Rule:     "rules[3]",
+				Property: "price",
+				Message:  `requires "currency" to be set`,
+			},
+			{
+				Rule:     "ends-after-start",
+				Property: "end",
+				Message: `value 2021-10-01 00:00:00 +0000 UTC must be greater than ` +
+					`"start" (2021-10-02T00:00:00Z)`,
+			},
+			{
+				Rule:     "tags-format",
+				Property: "tags",
+				Message:  `value "Sale" does not match pattern "^[a-z]+$"`,
+			},
+		}, violations)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := New().ValidateObject(context.Background(),
+			map[string]interface{}{}, fakeClassConfig{})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestValidateRules(t *testing.T) {
+	class := &models.Class{
+		Class: "Product",
+		Properties: []*models.Property{
+			{Name: "sku", DataType: []string{"string"}},
+			{Name: "price", DataType: []string{"number"}},
+			{Name: "stock", DataType: []string{"int"}},
+			{Name: "released", DataType: []string{"date"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		rule        map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name: "valid rule",
+			rule: map[string]interface{}{
+				"property": "stock", "minimum": 0.0, "lessThan": "price",
+			},
+		},
+		{
+			name:        "without property",
+			rule:        map[string]interface{}{"required": true},
+			expectedErr: "property must be set",
+		},
+		{
+			name:        "without constraints",
+			rule:        map[string]interface{}{"property": "sku"},
+			expectedErr: "has no constraints",
+		},
+		{
+			name:        "unknown field",
+			rule:        map[string]interface{}{"property": "sku", "maxLength": 3.0},
+			expectedErr: `unknown field "maxLength"`,
+		},
+		{
+			name:        "invalid pattern",
+			rule:        map[string]interface{}{"property": "sku", "pattern": "("},
+			expectedErr: "pattern",
+		},
+		{
+			name:        "unknown property",
+			rule:        map[string]interface{}{"property": "name", "required": true},
+			expectedErr: `class Product has no property "name"`,
+		},
+		{
+			name:        "pattern on a number",
+			rule:        map[string]interface{}{"property": "price", "pattern": "^1"},
+			expectedErr: "pattern requires a text property",
+		},
+		{
+			name:        "range of a text",
+			rule:        map[string]interface{}{"property": "sku", "minimum": 1.0},
+			expectedErr: "minimum and maximum require a numeric property",
+		},
+		{
+			name: "empty range",
+			rule: map[string]interface{}{
+				"property": "price", "minimum": 2.0, "maximum": 1.0,
+			},
+			expectedErr: "minimum 2 is greater than maximum 1",
+		},
+		{
+			name: "comparing a date to a number",
+			rule: map[string]interface{}{
+				"property": "released", "lessThan": "price",
+			},
+			expectedErr: "lessThan requires two number or two date properties",
+		},
+		{
+			name: "several operators",
+			rule: map[string]interface{}{
+				"property": "stock", "lessThan": "price", "greaterThan": "price",
+			},
+			expectedErr: "only one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := fakeClassConfig{"rules": []interface{}{test.rule}}
+			err := New().ValidateRules(context.Background(), class, cfg)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+
+	t.Run("rule names must be unique", func(t *testing.T) {
+		rule := map[string]interface{}{"name": "a", "property": "sku", "required": true}
+		cfg := fakeClassConfig{"rules": []interface{}{rule, rule}}
+		err := New().ValidateRules(context.Background(), class, cfg)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `name "a" is not unique`)
+	})
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
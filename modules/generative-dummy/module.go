@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modgenerativedummy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+func New() *GenerativeDummyModule {
+	return &GenerativeDummyModule{}
+}
+
+// GenerativeDummyModule is a reference implementation of a generative module.
+// Instead of prompting a language model, it echoes its input, which makes it
+// possible to test the generate additional property without any inference
+// container.
+type GenerativeDummyModule struct{}
+
+func (m *GenerativeDummyModule) Name() string {
+	return "generative-dummy"
+}
+
+func (m *GenerativeDummyModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *GenerativeDummyModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+// GenerateSingleResult returns the prompt, in which the properties of the
+// result were filled in already
+func (m *GenerativeDummyModule) GenerateSingleResult(ctx context.Context,
+	prompt string, textProperties map[string]string,
+) (*modulecapabilities.GenerateResult, error) {
+	return &modulecapabilities.GenerateResult{Result: &prompt}, nil
+}
+
+// GenerateAllResults returns the task followed by one line per result, which
+// lists its text properties sorted by name
+func (m *GenerativeDummyModule) GenerateAllResults(ctx context.Context,
+	task string, textProperties []map[string]string,
+) (*modulecapabilities.GenerateResult, error) {
+	lines := []string{task}
+	for i, props := range textProperties {
+		names := make([]string, 0, len(props))
+		for name := range props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		pairs := make([]string, len(names))
+		for j, name := range names {
+			pairs[j] = fmt.Sprintf("%s: %s", name, props[name])
+		}
+
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, strings.Join(pairs, ", ")))
+	}
+
+	result := strings.Join(lines, "\n")
+	return &modulecapabilities.GenerateResult{Result: &result}, nil
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.AdditionalGenerativeProperty(New())
+)
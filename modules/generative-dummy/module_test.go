@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modgenerativedummy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerativeDummy(t *testing.T) {
+	m := New()
+
+	t.Run("single result", func(t *testing.T) {
+		res, err := m.GenerateSingleResult(context.Background(),
+			"Summarize Hello World", map[string]string{"title": "Hello World"})
+		require.Nil(t, err)
+		require.NotNil(t, res.Result)
+		assert.Equal(t, "Summarize Hello World", *res.Result)
+	})
+
+	t.Run("grouped result", func(t *testing.T) {
+		res, err := m.GenerateAllResults(context.Background(), "Pick one",
+			[]map[string]string{
+				{"title": "First", "author": "Jane"},
+				{"title": "Second"},
+			})
+		require.Nil(t, err)
+		require.NotNil(t, res.Result)
+		assert.Equal(t, "Pick one\n1. author: Jane, title: First\n2. title: Second",
+			*res.Result)
+	})
+}
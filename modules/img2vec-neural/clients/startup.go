@@ -27,7 +27,7 @@ func (c *vectorizer) WaitForStartup(initCtx context.Context,
 	for {
 		select {
 		case <-t:
-			lastErr = c.checkReady(initCtx)
+			lastErr = c.CheckReady(initCtx)
 			if lastErr == nil {
 				return nil
 			}
@@ -40,7 +40,9 @@ func (c *vectorizer) WaitForStartup(initCtx context.Context,
 	}
 }
 
-func (c *vectorizer) checkReady(initCtx context.Context) error {
+// CheckReady performs a single readiness check against the remote
+// inference service
+func (c *vectorizer) CheckReady(initCtx context.Context) error {
 	// spawn a new context (derived on the overall context) which is used to
 	// consider an individual request timed out
 	requestCtx, cancel := context.WithTimeout(initCtx, 500*time.Millisecond)
@@ -15,12 +15,13 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/modules/img2vec-neural/additional"
+	"github.com/semi-technologies/weaviate/modules/img2vec-neural/additional/projector"
 	"github.com/semi-technologies/weaviate/modules/img2vec-neural/clients"
 	"github.com/semi-technologies/weaviate/modules/img2vec-neural/vectorizer"
 	"github.com/sirupsen/logrus"
@@ -31,9 +32,11 @@ func New() *ImageModule {
 }
 
 type ImageModule struct {
-	vectorizer      imageVectorizer
-	graphqlProvider modulecapabilities.GraphQLArguments
-	searcher        modulecapabilities.Searcher
+	vectorizer                   imageVectorizer
+	graphqlProvider              modulecapabilities.GraphQLArguments
+	searcher                     modulecapabilities.Searcher
+	readinessChecker             modulecapabilities.ReadinessChecker
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
 }
 
 type imageVectorizer interface {
@@ -53,6 +56,10 @@ func (m *ImageModule) Init(ctx context.Context,
 		return errors.Wrap(err, "init vectorizer")
 	}
 
+	if err := m.initAdditionalPropertiesProvider(); err != nil {
+		return errors.Wrap(err, "init additional properties provider")
+	}
+
 	if err := m.initNearImage(); err != nil {
 		return errors.Wrap(err, "init near text")
 	}
@@ -69,15 +76,18 @@ func (m *ImageModule) initVectorizer(ctx context.Context,
 	}
 
 	client := clients.New(uri, logger)
-	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
-		return errors.Wrap(err, "init remote vectorizer")
-	}
-
+	m.readinessChecker = client
 	m.vectorizer = vectorizer.New(client)
 
 	return nil
 }
 
+func (m *ImageModule) initAdditionalPropertiesProvider() error {
+	projector := projector.New()
+	m.additionalPropertiesProvider = additional.New(projector)
+	return nil
+}
+
 func (m *ImageModule) RootHandler() http.Handler {
 	// TODO: remove once this is a capability interface
 	return nil
@@ -93,8 +103,18 @@ func (m *ImageModule) MetaInfo() (map[string]interface{}, error) {
 	return map[string]interface{}{}, nil
 }
 
+func (m *ImageModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+func (m *ImageModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.AdditionalProperties(New())
 )
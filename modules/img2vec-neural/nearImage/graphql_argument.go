@@ -22,6 +22,10 @@ func getNearImageArgumentFn(classname string) *graphql.ArgumentConfig {
 	return nearImageArgument("GetObjects", classname)
 }
 
+func aggregateNearImageArgumentFn(classname string) *graphql.ArgumentConfig {
+	return nearImageArgument("AggregateObjects", classname)
+}
+
 func exploreNearImageArgumentFn() *graphql.ArgumentConfig {
 	return nearImageArgument("Explore", "")
 }
@@ -49,5 +53,9 @@ func nearImageFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
 		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceThreshold,
+			Type:        graphql.Float,
+		},
 	}
 }
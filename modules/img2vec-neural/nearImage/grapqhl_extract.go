@@ -11,7 +11,7 @@
 
 package nearImage
 
-// extractNearImageFn arguments, such as "image" and "certainty"
+// extractNearImageFn arguments, such as "image" and "certainty" or "distance"
 func extractNearImageFn(source map[string]interface{}) interface{} {
 	var args NearImageParams
 
@@ -25,5 +25,11 @@ func extractNearImageFn(source map[string]interface{}) interface{} {
 		args.Certainty = certainty.(float64)
 	}
 
+	distance, ok := source["distance"]
+	if ok {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
 	return &args
 }
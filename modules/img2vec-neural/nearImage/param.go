@@ -16,14 +16,24 @@ import (
 )
 
 type NearImageParams struct {
-	Image     string
-	Certainty float64
+	Image        string
+	Certainty    float64
+	Distance     float64
+	WithDistance bool
 }
 
 func (n NearImageParams) GetCertainty() float64 {
 	return n.Certainty
 }
 
+func (n NearImageParams) GetDistance() float64 {
+	return n.Distance
+}
+
+func (n NearImageParams) HasDistance() bool {
+	return n.WithDistance
+}
+
 func validateNearImageFn(param interface{}) error {
 	nearImage, ok := param.(*NearImageParams)
 	if !ok {
@@ -0,0 +1,305 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modimportfs
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+const Name = "import-filesystem"
+
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+	formatCSV    = "csv"
+)
+
+func New() *ImportFilesystemModule {
+	return &ImportFilesystemModule{}
+}
+
+// ImportFilesystemModule reads records from files in a directory on the
+// local filesystem, typically a mounted volume. The source of an import
+// names the file relative to that directory:
+//
+//	{
+//	  "path": "articles.csv",   // required
+//	  "format": "csv",          // json, ndjson or csv, derived from the extension if not set
+//	  "delimiter": ";"          // csv only, defaults to ","
+//	}
+//
+// A JSON file contains an array of records, an NDJSON file contains one
+// record per line. The first line of a CSV file names the fields, every
+// value of a CSV record is text.
+type ImportFilesystemModule struct {
+	rootPath string
+}
+
+type source struct {
+	path      string
+	format    string
+	delimiter rune
+}
+
+func (m *ImportFilesystemModule) Name() string {
+	return Name
+}
+
+func (m *ImportFilesystemModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	rootPath := os.Getenv("IMPORT_FILESYSTEM_PATH")
+	if rootPath == "" {
+		return errors.Errorf("required variable IMPORT_FILESYSTEM_PATH is not set")
+	}
+
+	return m.init(rootPath)
+}
+
+func (m *ImportFilesystemModule) init(rootPath string) error {
+	info, err := os.Stat(rootPath)
+	if err != nil {
+		return errors.Wrapf(err, "import root path %q", rootPath)
+	}
+
+	if !info.IsDir() {
+		return errors.Errorf("import root path %q is not a directory", rootPath)
+	}
+
+	m.rootPath = filepath.Clean(rootPath)
+	return nil
+}
+
+func (m *ImportFilesystemModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *ImportFilesystemModule) ValidateSource(settings map[string]interface{}) error {
+	src, err := m.parseSource(settings)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(src.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errortypes.New(errortypes.KindValidation,
+				"file %q does not exist", settings["path"])
+		}
+		return errors.Wrapf(err, "stat %q", settings["path"])
+	}
+
+	if info.IsDir() {
+		return errortypes.New(errortypes.KindValidation,
+			"%q is a directory, not a file", settings["path"])
+	}
+
+	return nil
+}
+
+func (m *ImportFilesystemModule) ReadRecords(ctx context.Context,
+	settings map[string]interface{},
+	fn func(record map[string]interface{}) error) error {
+	src, err := m.parseSource(settings)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(src.path)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", settings["path"])
+	}
+	defer f.Close()
+
+	// stop reading once the import is cancelled
+	ctxFn := func(record map[string]interface{}) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(record)
+	}
+
+	switch src.format {
+	case formatJSON:
+		return readJSON(f, ctxFn)
+	case formatNDJSON:
+		return readNDJSON(f, ctxFn)
+	default:
+		return readCSV(f, src.delimiter, ctxFn)
+	}
+}
+
+func (m *ImportFilesystemModule) parseSource(settings map[string]interface{}) (source, error) {
+	var out source
+
+	path, ok := settings["path"].(string)
+	if !ok || path == "" {
+		return out, errortypes.New(errortypes.KindValidation,
+			"source: field 'path' is required")
+	}
+
+	// the file can never be outside of the root path
+	out.path = filepath.Join(m.rootPath, filepath.FromSlash(path))
+	if !strings.HasPrefix(out.path, m.rootPath+string(filepath.Separator)) {
+		return out, errortypes.New(errortypes.KindValidation,
+			"source: invalid path %q", path)
+	}
+
+	out.format = formatFromExtension(path)
+	if format, ok := settings["format"]; ok {
+		str, ok := format.(string)
+		if !ok {
+			return out, errortypes.New(errortypes.KindValidation,
+				"source: field 'format' must be a string")
+		}
+		out.format = strings.ToLower(str)
+	}
+
+	switch out.format {
+	case formatJSON, formatNDJSON, formatCSV:
+	case "":
+		return out, errortypes.New(errortypes.KindValidation,
+			"source: cannot derive the format of %q from its extension, "+
+				"set field 'format' to one of json, ndjson or csv", path)
+	default:
+		return out, errortypes.New(errortypes.KindValidation,
+			"source: unsupported format %q, must be one of json, ndjson or csv",
+			out.format)
+	}
+
+	out.delimiter = ','
+	if delimiter, ok := settings["delimiter"]; ok {
+		str, ok := delimiter.(string)
+		if !ok || len([]rune(str)) != 1 {
+			return out, errortypes.New(errortypes.KindValidation,
+				"source: field 'delimiter' must be a single character")
+		}
+		out.delimiter = []rune(str)[0]
+	}
+
+	return out, nil
+}
+
+func formatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return formatJSON
+	case ".ndjson", ".jsonl":
+		return formatNDJSON
+	case ".csv":
+		return formatCSV
+	default:
+		return ""
+	}
+}
+
+// readJSON reads the elements of an array one at a time, so the file never
+// needs to fit into memory
+func readJSON(r io.Reader, fn func(record map[string]interface{}) error) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return errors.Wrap(err, "read json")
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return errors.New("read json: file must contain an array of records")
+	}
+
+	for i := 1; dec.More(); i++ {
+		var record map[string]interface{}
+		if err := dec.Decode(&record); err != nil {
+			return errors.Wrapf(err, "read json: record %d", i)
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readNDJSON(r io.Reader, fn func(record map[string]interface{}) error) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	for i := 1; ; i++ {
+		var record map[string]interface{}
+		if err := dec.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrapf(err, "read ndjson: record %d", i)
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
+
+func readCSV(r io.Reader, delimiter rune,
+	fn func(record map[string]interface{}) error) error {
+	reader := csv.NewReader(r)
+	reader.Comma = delimiter
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return errors.Wrap(err, "read csv: header")
+	}
+	fields := append([]string{}, header...)
+	reader.FieldsPerRecord = len(fields)
+
+	for i := 1; ; i++ {
+		values, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrapf(err, "read csv: record %d", i)
+		}
+
+		record := make(map[string]interface{}, len(fields))
+		for j, field := range fields {
+			record[field] = values[j]
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.SourceConnector(New())
+)
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modimportfs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportFilesystem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import-filesystem")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"articles.json":   `[{"title": "First", "words": 120}, {"title": "Second", "words": 80}]`,
+		"articles.ndjson": "{\"title\": \"First\", \"words\": 120}\n{\"title\": \"Second\", \"words\": 80}\n",
+		"articles.csv":    "title,words\nFirst,120\nSecond,80\n",
+		"articles.txt":    "title;words\nFirst;120\nSecond;80\n",
+		"broken.csv":      "title,words\nFirst\n",
+	}
+	for name, contents := range files {
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+	}
+	require.Nil(t, os.Mkdir(filepath.Join(dir, "folder.json"), 0o755))
+
+	m := New()
+	require.Nil(t, m.init(dir))
+	ctx := context.Background()
+
+	readAll := func(t *testing.T, source map[string]interface{}) []map[string]interface{} {
+		require.Nil(t, m.ValidateSource(source))
+
+		var records []map[string]interface{}
+		err := m.ReadRecords(ctx, source, func(record map[string]interface{}) error {
+			records = append(records, record)
+			return nil
+		})
+		require.Nil(t, err)
+		return records
+	}
+
+	t.Run("json", func(t *testing.T) {
+		expected := []map[string]interface{}{
+			{"title": "First", "words": json.Number("120")},
+			{"title": "Second", "words": json.Number("80")},
+		}
+
+		assert.Equal(t, expected, readAll(t, map[string]interface{}{"path": "articles.json"}))
+		assert.Equal(t, expected, readAll(t, map[string]interface{}{"path": "articles.ndjson"}))
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		expected := []map[string]interface{}{
+			{"title": "First", "words": "120"},
+			{"title": "Second", "words": "80"},
+		}
+
+		assert.Equal(t, expected, readAll(t, map[string]interface{}{"path": "articles.csv"}))
+		assert.Equal(t, expected, readAll(t, map[string]interface{}{
+			"path":      "articles.txt",
+			"format":    "csv",
+			"delimiter": ";",
+		}))
+	})
+
+	t.Run("malformed records fail the read", func(t *testing.T) {
+		err := m.ReadRecords(ctx, map[string]interface{}{"path": "broken.csv"},
+			func(record map[string]interface{}) error { return nil })
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid sources", func(t *testing.T) {
+		sources := []map[string]interface{}{
+			{},
+			{"path": "missing.json"},
+			{"path": "folder.json"},
+			{"path": "../articles.json"},
+			{"path": "articles.txt"},
+			{"path": "articles.csv", "format": "xml"},
+			{"path": "articles.csv", "delimiter": ";;"},
+		}
+
+		for _, source := range sources {
+			err := m.ValidateSource(source)
+			assert.True(t, errortypes.Is(err, errortypes.KindValidation),
+				"source %v: %v", source, err)
+		}
+	})
+}
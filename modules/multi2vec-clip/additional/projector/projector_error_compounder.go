@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package projector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type errorCompounder struct {
+	errors []error
+}
+
+func (ec *errorCompounder) addf(msg string, args ...interface{}) {
+	ec.errors = append(ec.errors, fmt.Errorf(msg, args...))
+}
+
+func (ec *errorCompounder) toError() error {
+	if len(ec.errors) == 0 {
+		return nil
+	}
+
+	var msg strings.Builder
+	for i, err := range ec.errors {
+		if i != 0 {
+			msg.WriteString(", ")
+		}
+
+		msg.WriteString(err.Error())
+	}
+
+	return errors.New(msg.String())
+}
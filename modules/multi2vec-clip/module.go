@@ -15,12 +15,13 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/modules/multi2vec-clip/additional"
+	"github.com/semi-technologies/weaviate/modules/multi2vec-clip/additional/projector"
 	"github.com/semi-technologies/weaviate/modules/multi2vec-clip/clients"
 	"github.com/semi-technologies/weaviate/modules/multi2vec-clip/vectorizer"
 	"github.com/sirupsen/logrus"
@@ -31,14 +32,16 @@ func New() *ClipModule {
 }
 
 type ClipModule struct {
-	imageVectorizer          imageVectorizer
-	nearImageGraphqlProvider modulecapabilities.GraphQLArguments
-	nearImageSearcher        modulecapabilities.Searcher
-	textVectorizer           textVectorizer
-	nearTextGraphqlProvider  modulecapabilities.GraphQLArguments
-	nearTextSearcher         modulecapabilities.Searcher
-	nearTextTransformer      modulecapabilities.TextTransform
-	metaClient               metaClient
+	imageVectorizer              imageVectorizer
+	nearImageGraphqlProvider     modulecapabilities.GraphQLArguments
+	nearImageSearcher            modulecapabilities.Searcher
+	textVectorizer               textVectorizer
+	nearTextGraphqlProvider      modulecapabilities.GraphQLArguments
+	nearTextSearcher             modulecapabilities.Searcher
+	nearTextTransformer          modulecapabilities.TextTransform
+	metaClient                   metaClient
+	readinessChecker             modulecapabilities.ReadinessChecker
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
 }
 
 type metaClient interface {
@@ -69,6 +72,10 @@ func (m *ClipModule) Init(ctx context.Context,
 		return errors.Wrap(err, "init vectorizer")
 	}
 
+	if err := m.initAdditionalPropertiesProvider(); err != nil {
+		return errors.Wrap(err, "init additional properties provider")
+	}
+
 	if err := m.initNearImage(); err != nil {
 		return errors.Wrap(err, "init near text")
 	}
@@ -104,10 +111,7 @@ func (m *ClipModule) initVectorizer(ctx context.Context,
 	}
 
 	client := clients.New(uri, logger)
-	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
-		return errors.Wrap(err, "init remote vectorizer")
-	}
-
+	m.readinessChecker = client
 	m.imageVectorizer = vectorizer.New(client)
 	m.textVectorizer = vectorizer.New(client)
 	m.metaClient = client
@@ -115,6 +119,12 @@ func (m *ClipModule) initVectorizer(ctx context.Context,
 	return nil
 }
 
+func (m *ClipModule) initAdditionalPropertiesProvider() error {
+	projector := projector.New()
+	m.additionalPropertiesProvider = additional.New(projector)
+	return nil
+}
+
 func (m *ClipModule) RootHandler() http.Handler {
 	// TODO: remove once this is a capability interface
 	return nil
@@ -130,8 +140,18 @@ func (m *ClipModule) MetaInfo() (map[string]interface{}, error) {
 	return m.metaClient.MetaInfo()
 }
 
+func (m *ClipModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+func (m *ClipModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.AdditionalProperties(New())
 )
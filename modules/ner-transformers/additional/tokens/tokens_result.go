@@ -23,70 +23,63 @@ import (
 
 func (p *TokenProvider) findTokens(ctx context.Context,
 	in []search.Result, params *Params) ([]search.Result, error) {
-	if len(in) > 0 {
+	if len(in) == 0 {
+		return in, nil
+	}
 
-		if len(in) == 0 {
-			return nil, nil
-		}
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
 
-		if params == nil {
-			return nil, fmt.Errorf("no params provided")
-		}
+	properties := params.GetProperties()
+
+	// check if user parameter values are valid
+	if len(properties) == 0 {
+		return in, errors.New("no properties provided")
+	}
 
-		properties := params.GetProperties()
+	certainty := params.GetCertainty()
+	limit := params.GetLimit()
 
-		// check if user parameter values are valid
-		if len(properties) == 0 {
-			return in, errors.New("no properties provided")
+	for i := range in { // for each result of the general GraphQL Query
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
 		}
 
-		for i := range in { // for each result of the general GraphQL Query
-			ap := in[i].AdditionalProperties
-			if ap == nil {
-				ap = models.AdditionalProperties{}
-			}
+		schema, _ := in[i].Object().Properties.(map[string]interface{})
+		tokensList := []ent.TokenResult{}
 
-			// check if the schema of the GraphQL data object contains the properties and they are text or string values
-			textProperties := map[string]string{}
-			schema := in[i].Object().Properties.(map[string]interface{})
-			for property, value := range schema {
-				if p.containsProperty(property, properties) {
-					if valueString, ok := value.(string); ok && len(valueString) > 0 {
-						textProperties[property] = valueString
-					}
-				}
+		// call the NER function for each text property in the order the
+		// properties were requested in, so the limit cuts off the same tokens
+		// on every request. Properties which are not set or not text are skipped
+		for _, property := range properties {
+			if limit != nil && len(tokensList) >= *limit {
+				break
 			}
 
-			certainty := params.GetCertainty()
-			limit := params.GetLimit()
-			tokensList := []ent.TokenResult{}
-
-			// for each text property result, call the NER function and add to additional result
-			for property, value := range textProperties {
-
-				if limit != nil && len(tokensList) > *limit {
-					break
-				}
-
-				tokens, err := p.ner.GetTokens(ctx, property, value)
-				if err != nil {
-					return in, err
-				}
-
-				tokens = cutOffByCertainty(tokens, certainty)
-
-				tokensList = append(tokensList, tokens...)
+			value, ok := schema[property].(string)
+			if !ok || len(value) == 0 {
+				continue
 			}
 
-			if limit != nil && len(tokensList) > *limit {
-				ap["tokens"] = tokensList[:*limit]
-			} else {
-				ap["tokens"] = tokensList
+			tokens, err := p.ner.GetTokens(ctx, property, value)
+			if err != nil {
+				return in, err
 			}
 
-			in[i].AdditionalProperties = ap
+			tokensList = append(tokensList, cutOffByCertainty(tokens, certainty)...)
+		}
+
+		if limit != nil && len(tokensList) > *limit {
+			ap["tokens"] = tokensList[:*limit]
+		} else {
+			ap["tokens"] = tokensList
 		}
+
+		in[i].AdditionalProperties = ap
 	}
+
 	return in, nil
 }
 
@@ -106,15 +99,3 @@ func cutOffByCertainty(tokens []ent.TokenResult, certainty *float64) []ent.Token
 
 	return tokens
 }
-
-func (p *TokenProvider) containsProperty(property string, properties []string) bool {
-	if len(properties) == 0 {
-		return true
-	}
-	for i := range properties {
-		if properties[i] == property {
-			return true
-		}
-	}
-	return false
-}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package tokens
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/ner-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findTokens(t *testing.T) {
+	in := func() []search.Result {
+		return []search.Result{
+			{
+				Schema: map[string]interface{}{
+					"title":   "Paris",
+					"content": "Paris is the capital of France",
+					"year":    float64(2021),
+				},
+			},
+		}
+	}
+
+	t.Run("extracts the tokens of the requested properties in order", func(t *testing.T) {
+		p := New(&fakeNERClient{})
+
+		res, err := p.findTokens(context.Background(), in(),
+			&Params{Properties: []string{"title", "content", "year"}})
+		require.Nil(t, err)
+
+		assert.Equal(t, []ent.TokenResult{
+			{Property: "title", Word: "Paris", Entity: "I-LOC", Certainty: 0.9, EndPosition: 5},
+			{Property: "content", Word: "Paris", Entity: "I-LOC", Certainty: 0.9, EndPosition: 5},
+			{Property: "content", Word: "France", Entity: "I-LOC", Certainty: 0.6, StartPosition: 24, EndPosition: 30},
+		}, res[0].AdditionalProperties["tokens"])
+	})
+
+	t.Run("with certainty and limit", func(t *testing.T) {
+		p := New(&fakeNERClient{})
+		certainty := 0.8
+
+		res, err := p.findTokens(context.Background(), in(),
+			&Params{Properties: []string{"content"}, Certainty: &certainty})
+		require.Nil(t, err)
+		assert.Equal(t, []ent.TokenResult{
+			{Property: "content", Word: "Paris", Entity: "I-LOC", Certainty: 0.9, EndPosition: 5},
+		}, res[0].AdditionalProperties["tokens"])
+
+		res, err = p.findTokens(context.Background(), in(),
+			&Params{Properties: []string{"content", "title"}, Limit: ptInt(1)})
+		require.Nil(t, err)
+		assert.Equal(t, []ent.TokenResult{
+			{Property: "content", Word: "Paris", Entity: "I-LOC", Certainty: 0.9, EndPosition: 5},
+		}, res[0].AdditionalProperties["tokens"])
+	})
+
+	t.Run("without properties", func(t *testing.T) {
+		p := New(&fakeNERClient{})
+
+		_, err := p.findTokens(context.Background(), in(), &Params{})
+		assert.NotNil(t, err)
+	})
+}
+
+type fakeNERClient struct{}
+
+func (c *fakeNERClient) GetTokens(ctx context.Context, property,
+	text string) ([]ent.TokenResult, error) {
+	out := []ent.TokenResult{
+		{Property: property, Word: "Paris", Entity: "I-LOC", Certainty: 0.9, EndPosition: 5},
+	}
+
+	if text == "Paris is the capital of France" {
+		out = append(out, ent.TokenResult{
+			Property: property, Word: "France", Entity: "I-LOC", Certainty: 0.6,
+			StartPosition: 24, EndPosition: 30,
+		})
+	}
+
+	return out, nil
+}
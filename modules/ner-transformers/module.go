@@ -15,7 +15,6 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
@@ -34,6 +33,7 @@ func New() *NERModule {
 type NERModule struct {
 	ner                          nerClient
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	readinessChecker             modulecapabilities.ReadinessChecker
 }
 
 type nerClient interface {
@@ -61,10 +61,7 @@ func (m *NERModule) initAdditional(ctx context.Context,
 	}
 
 	client := clients.New(uri, logger)
-	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
-		return errors.Wrap(err, "init remote ner module")
-	}
-
+	m.readinessChecker = client
 	m.ner = client
 
 	tokenProvider := neradditionaltoken.New(m.ner)
@@ -86,9 +83,14 @@ func (m *NERModule) AdditionalProperties() map[string]modulecapabilities.Additio
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func (m *NERModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
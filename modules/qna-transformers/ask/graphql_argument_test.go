@@ -38,7 +38,7 @@ func TestAskGraphQLArgument(t *testing.T) {
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 3, len(askFields.Fields()))
+		assert.Equal(t, 4, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -46,6 +46,7 @@ func TestAskGraphQLArgument(t *testing.T) {
 		assert.Equal(t, "String", questionNonNull.OfType.Name())
 		assert.NotNil(t, question)
 		assert.NotNil(t, fields["certainty"])
+		assert.NotNil(t, fields["distance"])
 		properties := fields["properties"]
 		propertiesList, propertiesListOK := properties.Type.(*graphql.List)
 		assert.True(t, propertiesListOK)
@@ -74,7 +75,7 @@ func TestAskGraphQLArgumentWithAutocorrect(t *testing.T) {
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 4, len(askFields.Fields()))
+		assert.Equal(t, 5, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -82,6 +83,7 @@ func TestAskGraphQLArgumentWithAutocorrect(t *testing.T) {
 		assert.Equal(t, "String", questionNonNull.OfType.Name())
 		assert.NotNil(t, question)
 		assert.NotNil(t, fields["certainty"])
+		assert.NotNil(t, fields["distance"])
 		properties := fields["properties"]
 		propertiesList, propertiesListOK := properties.Type.(*graphql.List)
 		assert.True(t, propertiesListOK)
@@ -16,16 +16,26 @@ import (
 )
 
 type AskParams struct {
-	Question    string
-	Certainty   float64
-	Properties  []string
-	Autocorrect bool
+	Question     string
+	Certainty    float64
+	Distance     float64
+	WithDistance bool
+	Properties   []string
+	Autocorrect  bool
 }
 
 func (n AskParams) GetCertainty() float64 {
 	return n.Certainty
 }
 
+func (n AskParams) GetDistance() float64 {
+	return n.Distance
+}
+
+func (n AskParams) HasDistance() bool {
+	return n.WithDistance
+}
+
 func (g *GraphQLArgumentsProvider) validateAskFn(param interface{}) error {
 	ask, ok := param.(*AskParams)
 	if !ok {
@@ -15,7 +15,6 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
@@ -40,6 +39,7 @@ type QnAModule struct {
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
 	nearTextDependency           modulecapabilities.Dependency
 	askTextTransformer           modulecapabilities.TextTransform
+	readinessChecker             modulecapabilities.ReadinessChecker
 }
 
 type qnaClient interface {
@@ -112,10 +112,7 @@ func (m *QnAModule) initAdditional(ctx context.Context,
 	}
 
 	client := clients.New(uri, logger)
-	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
-		return errors.Wrap(err, "init remote vectorizer")
-	}
-
+	m.readinessChecker = client
 	m.qna = client
 
 	answerProvider := qnaadditionalanswer.New(m.qna, qnaask.NewParamsHelper())
@@ -137,9 +134,14 @@ func (m *QnAModule) AdditionalProperties() map[string]modulecapabilities.Additio
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func (m *QnAModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rerank
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/reranker-transformers/ent"
+)
+
+type rerankerClient interface {
+	Rank(ctx context.Context, query string, documents []string) ([]ent.RankResult, error)
+}
+
+type RerankProvider struct {
+	reranker rerankerClient
+}
+
+func New(reranker rerankerClient) *RerankProvider {
+	return &RerankProvider{reranker}
+}
+
+func (p *RerankProvider) AdditionalPropertyDefaultValue() interface{} {
+	return &Params{}
+}
+
+func (p *RerankProvider) ExtractAdditionalFn(param []*ast.Argument) interface{} {
+	return p.parseRerankArguments(param)
+}
+
+func (p *RerankProvider) AdditionalFieldFn(classname string) *graphql.Field {
+	return p.additionalRerankField(classname)
+}
+
+func (p *RerankProvider) AdditionalPropertyFn(ctx context.Context,
+	in []search.Result, params interface{}, limit *int,
+	argumentModuleParams map[string]interface{}) ([]search.Result, error) {
+	if parameters, ok := params.(*Params); ok {
+		return p.rerank(ctx, in, parameters)
+	}
+	return nil, errors.New("wrong parameters")
+}
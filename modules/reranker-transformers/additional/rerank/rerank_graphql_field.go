@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rerank
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+)
+
+func (p *RerankProvider) additionalRerankField(classname string) *graphql.Field {
+	return &graphql.Field{
+		Args: graphql.FieldConfigArgument{
+			"property": &graphql.ArgumentConfig{
+				Description:  "Text property the results are reranked by",
+				Type:         graphql.NewNonNull(graphql.String),
+				DefaultValue: nil,
+			},
+			"query": &graphql.ArgumentConfig{
+				Description:  "Query the results are reranked against",
+				Type:         graphql.NewNonNull(graphql.String),
+				DefaultValue: nil,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalRerank", classname),
+			Fields: graphql.Fields{
+				"score": &graphql.Field{Type: graphql.Float},
+			},
+		})),
+	}
+}
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rerank
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func (p *RerankProvider) parseRerankArguments(args []*ast.Argument) *Params {
+	out := &Params{}
+
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "property":
+			out.Property = arg.Value.(*ast.StringValue).Value
+		case "query":
+			out.Query = arg.Value.(*ast.StringValue).Value
+		default:
+			// ignore what we don't recognize
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rerank
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseRerankArguments(t *testing.T) {
+	tests := []struct {
+		name string
+		args []*ast.Argument
+		want *Params
+	}{
+		{
+			name: "Should create with no params",
+			want: &Params{},
+		},
+		{
+			name: "Should create with all params",
+			args: []*ast.Argument{
+				createArg("property", "content"),
+				createArg("query", "capital of France"),
+			},
+			want: &Params{
+				Property: "content",
+				Query:    "capital of France",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &RerankProvider{}
+			assert.Equal(t, tt.want, p.parseRerankArguments(tt.args))
+		})
+	}
+}
+
+func createArg(name string, value string) *ast.Argument {
+	n := ast.Name{
+		Value: name,
+	}
+	val := ast.StringValue{
+		Kind:  "Kind",
+		Value: value,
+	}
+	arg := ast.Argument{
+		Name:  ast.NewName(&n),
+		Kind:  "Kind",
+		Value: ast.NewStringValue(&val),
+	}
+	return ast.NewArgument(&arg)
+}
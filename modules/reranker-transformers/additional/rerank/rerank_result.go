@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	rerankmodels "github.com/semi-technologies/weaviate/modules/reranker-transformers/additional/models"
+)
+
+// rerank scores the text of the property of every result against the query
+// and sorts the results by that score. The results are sent to the
+// cross-encoder in a single request, so the number of results which are
+// reranked is the limit of the query. Results without a value for the
+// property are scored as an empty text.
+func (p *RerankProvider) rerank(ctx context.Context,
+	in []search.Result, params *Params) ([]search.Result, error) {
+	if len(in) == 0 {
+		return in, nil
+	}
+
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
+
+	property := params.GetProperty()
+	if property == "" {
+		return in, errors.New("no property provided")
+	}
+
+	query := params.GetQuery()
+	if query == "" {
+		return in, errors.New("no query provided")
+	}
+
+	documents := make([]string, len(in))
+	for i := range in {
+		documents[i] = propertyText(in[i], property)
+	}
+
+	ranks, err := p.reranker.Rank(ctx, query, documents)
+	if err != nil {
+		return in, errors.Wrap(err, "rerank")
+	}
+
+	if len(ranks) != len(in) {
+		return in, errors.Errorf("rerank: expected %d scores, got %d",
+			len(in), len(ranks))
+	}
+
+	scores := make([]float64, len(in))
+	for i := range in {
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+
+		score := ranks[i].Score
+		scores[i] = score
+		ap["rerank"] = []*rerankmodels.RankResult{{Score: &score}}
+		in[i].AdditionalProperties = ap
+	}
+
+	sort.Stable(byScore{results: in, scores: scores})
+
+	return in, nil
+}
+
+func propertyText(res search.Result, property string) string {
+	props, ok := res.Schema.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	text, _ := props[property].(string)
+	return text
+}
+
+// byScore sorts results by their score in descending order, results with the
+// same score keep their original order
+type byScore struct {
+	results []search.Result
+	scores  []float64
+}
+
+func (s byScore) Len() int {
+	return len(s.results)
+}
+
+func (s byScore) Less(a, b int) bool {
+	return s.scores[a] > s.scores[b]
+}
+
+func (s byScore) Swap(a, b int) {
+	s.results[a], s.results[b] = s.results[b], s.results[a]
+	s.scores[a], s.scores[b] = s.scores[b], s.scores[a]
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rerank
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+	rerankmodels "github.com/semi-technologies/weaviate/modules/reranker-transformers/additional/models"
+	"github.com/semi-technologies/weaviate/modules/reranker-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRerank(t *testing.T) {
+	results := func() []search.Result {
+		return []search.Result{
+			{ID: "1", Schema: map[string]interface{}{"content": "Berlin is the capital of Germany"}},
+			{ID: "2", Schema: map[string]interface{}{"content": "Paris is the capital of France"}},
+			{ID: "3", Schema: map[string]interface{}{"title": "no content"}},
+			{ID: "4", Schema: map[string]interface{}{"content": "France borders Germany"}},
+		}
+	}
+
+	t.Run("results are sorted by their score", func(t *testing.T) {
+		client := &fakeReranker{scores: map[string]float64{
+			"Berlin is the capital of Germany": 0.2,
+			"Paris is the capital of France":   0.9,
+			"":                                 0.01,
+			"France borders Germany":           0.5,
+		}}
+		p := New(client)
+
+		res, err := p.AdditionalPropertyFn(context.Background(), results(),
+			&Params{Property: "content", Query: "capital of France"}, nil, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, "capital of France", client.query)
+		assert.Equal(t, []string{
+			"Berlin is the capital of Germany", "Paris is the capital of France",
+			"", "France borders Germany",
+		}, client.documents)
+
+		require.Len(t, res, 4)
+		ids := make([]string, len(res))
+		for i := range res {
+			ids[i] = res[i].ID.String()
+		}
+		assert.Equal(t, []string{"2", "4", "1", "3"}, ids)
+
+		rank, ok := res[0].AdditionalProperties["rerank"].([]*rerankmodels.RankResult)
+		require.True(t, ok)
+		require.Len(t, rank, 1)
+		assert.Equal(t, 0.9, *rank[0].Score)
+	})
+
+	t.Run("results with the same score keep their order", func(t *testing.T) {
+		p := New(&fakeReranker{scores: map[string]float64{}})
+
+		res, err := p.AdditionalPropertyFn(context.Background(), results(),
+			&Params{Property: "content", Query: "capital of France"}, nil, nil)
+		require.Nil(t, err)
+
+		ids := make([]string, len(res))
+		for i := range res {
+			ids[i] = res[i].ID.String()
+		}
+		assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	})
+
+	t.Run("without a property", func(t *testing.T) {
+		p := New(&fakeReranker{})
+
+		_, err := p.AdditionalPropertyFn(context.Background(), results(),
+			&Params{Query: "capital of France"}, nil, nil)
+		assert.EqualError(t, err, "no property provided")
+	})
+
+	t.Run("without a query", func(t *testing.T) {
+		p := New(&fakeReranker{})
+
+		_, err := p.AdditionalPropertyFn(context.Background(), results(),
+			&Params{Property: "content"}, nil, nil)
+		assert.EqualError(t, err, "no query provided")
+	})
+
+	t.Run("when the reranker fails", func(t *testing.T) {
+		p := New(&fakeReranker{err: errors.New("service unavailable")})
+
+		_, err := p.AdditionalPropertyFn(context.Background(), results(),
+			&Params{Property: "content", Query: "capital of France"}, nil, nil)
+		assert.EqualError(t, err, "rerank: service unavailable")
+	})
+}
+
+type fakeReranker struct {
+	scores    map[string]float64
+	err       error
+	query     string
+	documents []string
+}
+
+func (f *fakeReranker) Rank(ctx context.Context, query string,
+	documents []string) ([]ent.RankResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.query = query
+	f.documents = documents
+	out := make([]ent.RankResult, len(documents))
+	for i, doc := range documents {
+		out[i] = ent.RankResult{Document: doc, Score: f.scores[doc]}
+	}
+	return out, nil
+}
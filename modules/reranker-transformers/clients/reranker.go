@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/modules/reranker-transformers/ent"
+	"github.com/sirupsen/logrus"
+)
+
+type reranker struct {
+	origin     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+type rankInput struct {
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+}
+
+type documentScore struct {
+	Document string  `json:"document"`
+	Score    float64 `json:"score"`
+}
+
+type rankResponse struct {
+	Error string
+	rankInput
+	Scores []documentScore `json:"scores"`
+}
+
+func New(origin string, logger logrus.FieldLogger) *reranker {
+	return &reranker{
+		origin:     origin,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}
+}
+
+// Rank scores every document against the query. The scores are returned in
+// the same order as the documents.
+func (v *reranker) Rank(ctx context.Context, query string,
+	documents []string) ([]ent.RankResult, error) {
+	body, err := json.Marshal(rankInput{
+		Query:     query,
+		Documents: documents,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", v.url("/rerank"),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody rankResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		return nil, errors.Errorf("fail with status %d: %s", res.StatusCode, resBody.Error)
+	}
+
+	if len(resBody.Scores) != len(documents) {
+		return nil, errors.Errorf("expected %d scores, got %d",
+			len(documents), len(resBody.Scores))
+	}
+
+	out := make([]ent.RankResult, len(resBody.Scores))
+	for i, elem := range resBody.Scores {
+		out[i].Document = elem.Document
+		out[i].Score = elem.Score
+	}
+
+	return out, nil
+}
+
+func (v *reranker) url(path string) string {
+	return fmt.Sprintf("%s%s", v.origin, path)
+}
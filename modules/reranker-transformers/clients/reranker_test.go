@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/modules/reranker-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRank(t *testing.T) {
+	t.Run("when the server has a successful answer", func(t *testing.T) {
+		server := httptest.NewServer(&testRerankerHandler{
+			t: t,
+			res: rankResponse{
+				Scores: []documentScore{
+					{Document: "Paris is the capital of France", Score: 0.9},
+					{Document: "Berlin is the capital of Germany", Score: 0.1},
+				},
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		res, err := c.Rank(context.Background(), "capital of France",
+			[]string{"Paris is the capital of France", "Berlin is the capital of Germany"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []ent.RankResult{
+			{Document: "Paris is the capital of France", Score: 0.9},
+			{Document: "Berlin is the capital of Germany", Score: 0.1},
+		}, res)
+	})
+
+	t.Run("when the server returns fewer scores than documents", func(t *testing.T) {
+		server := httptest.NewServer(&testRerankerHandler{
+			t: t,
+			res: rankResponse{
+				Scores: []documentScore{{Document: "Paris", Score: 0.9}},
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.Rank(context.Background(), "capital of France",
+			[]string{"Paris", "Berlin"})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "expected 2 scores, got 1")
+	})
+
+	t.Run("when the server has a an error", func(t *testing.T) {
+		server := httptest.NewServer(&testRerankerHandler{
+			t: t,
+			res: rankResponse{
+				Error: "some error from the server",
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.Rank(context.Background(), "capital of France",
+			[]string{"Paris"})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "some error from the server")
+	})
+}
+
+type testRerankerHandler struct {
+	t   *testing.T
+	res rankResponse
+}
+
+func (f *testRerankerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/rerank", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	if f.res.Error != "" {
+		w.WriteHeader(500)
+	}
+
+	jsonBytes, _ := json.Marshal(f.res)
+	w.Write(jsonBytes)
+}
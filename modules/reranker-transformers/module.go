@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modreranker
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	rerankeradditional "github.com/semi-technologies/weaviate/modules/reranker-transformers/additional"
+	rerankeradditionalrerank "github.com/semi-technologies/weaviate/modules/reranker-transformers/additional/rerank"
+	"github.com/semi-technologies/weaviate/modules/reranker-transformers/clients"
+	"github.com/semi-technologies/weaviate/modules/reranker-transformers/ent"
+	"github.com/sirupsen/logrus"
+)
+
+func New() *RerankerModule {
+	return &RerankerModule{}
+}
+
+type RerankerModule struct {
+	reranker                     rerankerClient
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	readinessChecker             modulecapabilities.ReadinessChecker
+}
+
+type rerankerClient interface {
+	Rank(ctx context.Context, query string, documents []string) ([]ent.RankResult, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *RerankerModule) Name() string {
+	return "reranker-transformers"
+}
+
+func (m *RerankerModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	if err := m.initAdditional(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init additional")
+	}
+	return nil
+}
+
+func (m *RerankerModule) initAdditional(ctx context.Context,
+	logger logrus.FieldLogger) error {
+	uri := os.Getenv("RERANKER_INFERENCE_API")
+	if uri == "" {
+		return errors.Errorf("required variable RERANKER_INFERENCE_API is not set")
+	}
+
+	client := clients.New(uri, logger)
+	m.readinessChecker = client
+	m.reranker = client
+
+	rerankProvider := rerankeradditionalrerank.New(m.reranker)
+	m.additionalPropertiesProvider = rerankeradditional.New(rerankProvider)
+
+	return nil
+}
+
+func (m *RerankerModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *RerankerModule) MetaInfo() (map[string]interface{}, error) {
+	return m.reranker.MetaInfo()
+}
+
+func (m *RerankerModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+func (m *RerankerModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
+	_ = modulecapabilities.AdditionalProperties(New())
+	_ = modulecapabilities.MetaProvider(New())
+)
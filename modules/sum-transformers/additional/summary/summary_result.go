@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package summary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/sum-transformers/ent"
+)
+
+func (p *SummaryProvider) findSummary(ctx context.Context,
+	in []search.Result, params *Params) ([]search.Result, error) {
+	if len(in) == 0 {
+		return in, nil
+	}
+
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
+
+	properties := params.GetProperties()
+
+	// check if user parameter values are valid
+	if len(properties) == 0 {
+		return in, errors.New("no properties provided")
+	}
+
+	for i := range in { // for each result of the general GraphQL Query
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+
+		schema, _ := in[i].Object().Properties.(map[string]interface{})
+		summaryList := []ent.SummaryResult{}
+
+		// summarize the text properties in the order they were requested in,
+		// properties which are not set or not text are skipped
+		for _, property := range properties {
+			value, ok := schema[property].(string)
+			if !ok || len(value) == 0 {
+				continue
+			}
+
+			summary, err := p.sum.GetSummary(ctx, property, value)
+			if err != nil {
+				return in, err
+			}
+
+			summaryList = append(summaryList, summary...)
+		}
+
+		ap["summary"] = summaryList
+		in[i].AdditionalProperties = ap
+	}
+
+	return in, nil
+}
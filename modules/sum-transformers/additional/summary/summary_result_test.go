@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package summary
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/sum-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findSummary(t *testing.T) {
+	in := func() []search.Result {
+		return []search.Result{
+			{
+				Schema: map[string]interface{}{
+					"title":   "Paris",
+					"content": "Paris is the capital of France.",
+					"year":    float64(2021),
+				},
+			},
+			{
+				Schema: map[string]interface{}{
+					"title": "Berlin",
+				},
+			},
+		}
+	}
+
+	t.Run("summarizes the requested text properties", func(t *testing.T) {
+		p := New(&fakeSumClient{})
+
+		res, err := p.findSummary(context.Background(), in(),
+			&Params{Properties: []string{"content", "title", "year"}})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		assert.Equal(t, []ent.SummaryResult{
+			{Property: "content", Result: "summary of Paris is the capital of France."},
+			{Property: "title", Result: "summary of Paris"},
+		}, res[0].AdditionalProperties["summary"])
+		assert.Equal(t, []ent.SummaryResult{
+			{Property: "title", Result: "summary of Berlin"},
+		}, res[1].AdditionalProperties["summary"])
+	})
+
+	t.Run("without properties", func(t *testing.T) {
+		p := New(&fakeSumClient{})
+
+		_, err := p.findSummary(context.Background(), in(), &Params{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when the inference service errors", func(t *testing.T) {
+		p := New(&fakeSumClient{err: errors.New("inference failed")})
+
+		_, err := p.findSummary(context.Background(), in(),
+			&Params{Properties: []string{"content"}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "inference failed")
+	})
+}
+
+type fakeSumClient struct {
+	err error
+}
+
+func (c *fakeSumClient) GetSummary(ctx context.Context, property,
+	text string) ([]ent.SummaryResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return []ent.SummaryResult{{Property: property, Result: "summary of " + text}}, nil
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMeta(t *testing.T) {
+	t.Run("when the server is providing meta", func(t *testing.T) {
+		server := httptest.NewServer(&testMetaHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		meta, err := c.MetaInfo()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, meta)
+		metaModel := meta["model"]
+		assert.True(t, metaModel != nil)
+		model, modelOK := metaModel.(map[string]interface{})
+		assert.True(t, modelOK)
+		assert.True(t, model["_name_or_path"] != nil)
+		assert.True(t, model["architectures"] != nil)
+	})
+}
+
+type testMetaHandler struct {
+	t *testing.T
+}
+
+func (f *testMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/meta", r.URL.String())
+	assert.Equal(f.t, http.MethodGet, r.Method)
+
+	w.Write([]byte(f.metaInfo()))
+}
+
+func (f *testMetaHandler) metaInfo() string {
+	return `{
+		"model": {
+		"_name_or_path": "facebook/bart-large-cnn",
+		"architectures": [
+		"BartForConditionalGeneration"
+		],
+		"d_model": 1024,
+		"early_stopping": true,
+		"length_penalty": 2,
+		"max_length": 142,
+		"max_position_embeddings": 1024,
+		"min_length": 56,
+		"model_type": "bart",
+		"no_repeat_ngram_size": 3,
+		"num_beams": 4,
+		"transformers_version": "4.6.1",
+		"vocab_size": 50264
+		}
+		}`
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/modules/sum-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSummary(t *testing.T) {
+	t.Run("when the server has a successful answer", func(t *testing.T) {
+		server := httptest.NewServer(&testSumHandler{
+			t: t,
+			res: sumResponse{
+				sumInput: sumInput{
+					Text: "The Eiffel Tower was completed in 1889 and is 330 metres tall.",
+				},
+				Summary: []summaryResponse{
+					{
+						Result: "The Eiffel Tower is 330 metres tall.",
+					},
+				},
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		res, err := c.GetSummary(context.Background(), "prop",
+			"The Eiffel Tower was completed in 1889 and is 330 metres tall.")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []ent.SummaryResult{
+			{
+				Result:   "The Eiffel Tower is 330 metres tall.",
+				Property: "prop",
+			},
+		}, res)
+	})
+
+	t.Run("when the server has a an error", func(t *testing.T) {
+		server := httptest.NewServer(&testSumHandler{
+			t: t,
+			res: sumResponse{
+				Error: "some error from the server",
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.GetSummary(context.Background(), "prop",
+			"The Eiffel Tower was completed in 1889 and is 330 metres tall.")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "some error from the server")
+	})
+}
+
+type testSumHandler struct {
+	t   *testing.T
+	res sumResponse
+}
+
+func (f *testSumHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/sum/", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	var input sumInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	require.Nil(f.t, err)
+	assert.NotEmpty(f.t, input.Text)
+
+	if f.res.Error != "" {
+		w.WriteHeader(500)
+	}
+
+	jsonBytes, _ := json.Marshal(f.res)
+	w.Write(jsonBytes)
+}
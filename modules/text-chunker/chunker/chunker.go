@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package chunker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+type Chunker struct{}
+
+func New() *Chunker {
+	return &Chunker{}
+}
+
+// ChunkObject splits the configured source property of obj into
+// word-based, optionally overlapping chunks. Each chunk becomes an object of
+// the target class which references obj through the parent property.
+func (c *Chunker) ChunkObject(ctx context.Context, obj *models.Object,
+	cfg moduletools.ClassConfig) ([]*models.Object, error) {
+	settings := NewClassSettings(cfg)
+	if err := settings.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid text-chunker config")
+	}
+
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	text, ok := props[settings.SourceProperty()].(string)
+	if !ok || text == "" {
+		return nil, nil
+	}
+
+	chunks := Split(text, settings.ChunkSize(), settings.Overlap())
+	beacon := fmt.Sprintf("weaviate://localhost/%s", obj.ID)
+
+	out := make([]*models.Object, len(chunks))
+	for i, chunk := range chunks {
+		chunkProps := map[string]interface{}{
+			settings.TextProperty(): chunk,
+			settings.ParentProperty(): []interface{}{
+				map[string]interface{}{"beacon": beacon},
+			},
+		}
+
+		if indexProp := settings.IndexProperty(); indexProp != "" {
+			chunkProps[indexProp] = float64(i)
+		}
+
+		out[i] = &models.Object{
+			Class:      settings.TargetClass(),
+			Properties: chunkProps,
+		}
+	}
+
+	return out, nil
+}
+
+// Split splits text into chunks of size words, where consecutive chunks
+// share overlap words. Whitespace is normalized to single spaces.
+func Split(text string, size, overlap int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	step := size - overlap
+	var out []string
+	for start := 0; start < len(words); start += step {
+		end := start + size
+		if end > len(words) {
+			end = len(words)
+		}
+
+		out = append(out, strings.Join(words[start:end], " "))
+		if end == len(words) {
+			break
+		}
+	}
+
+	return out
+}
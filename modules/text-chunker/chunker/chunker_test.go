@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package chunker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		size     int
+		overlap  int
+		expected []string
+	}{
+		{
+			name:     "empty text",
+			text:     "  ",
+			size:     3,
+			expected: nil,
+		},
+		{
+			name:     "shorter than a single chunk",
+			text:     "one two",
+			size:     3,
+			expected: []string{"one two"},
+		},
+		{
+			name:     "without overlap",
+			text:     "one two three four five six seven",
+			size:     3,
+			expected: []string{"one two three", "four five six", "seven"},
+		},
+		{
+			name:    "with overlap",
+			text:    "one two\nthree  four five six",
+			size:    3,
+			overlap: 1,
+			expected: []string{
+				"one two three", "three four five", "five six",
+			},
+		},
+		{
+			name:     "with overlap and exact fit",
+			text:     "one two three four five",
+			size:     3,
+			overlap:  1,
+			expected: []string{"one two three", "three four five"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Split(test.text, test.size, test.overlap))
+		})
+	}
+}
+
+func TestChunkObject(t *testing.T) {
+	cfg := fakeClassConfig{
+		"sourceProperty": "body",
+		"targetClass":    "Passage",
+		"chunkSize":      float64(2),
+		"overlap":        float64(0),
+		"indexProperty":  "position",
+	}
+
+	obj := &models.Object{
+		Class: "Article",
+		ID:    "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		Properties: map[string]interface{}{
+			"body": "one two three",
+		},
+	}
+
+	chunks, err := New().ChunkObject(context.Background(), obj, cfg)
+	require.Nil(t, err)
+	require.Len(t, chunks, 2)
+
+	assert.Equal(t, "Passage", chunks[1].Class)
+	assert.Equal(t, map[string]interface{}{
+		"text":     "three",
+		"position": float64(1),
+		"parent": []interface{}{
+			map[string]interface{}{
+				"beacon": "weaviate://localhost/8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+			},
+		},
+	}, chunks[1].Properties)
+}
+
+func TestChunkObjectInvalidConfig(t *testing.T) {
+	cfg := fakeClassConfig{
+		"sourceProperty": "body",
+		"targetClass":    "Passage",
+		"chunkSize":      float64(10),
+		"overlap":        float64(10),
+	}
+
+	_, err := New().ChunkObject(context.Background(), &models.Object{}, cfg)
+	assert.NotNil(t, err)
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package chunker
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+const (
+	DefaultChunkSize      = 200
+	DefaultOverlap        = 20
+	DefaultTextProperty   = "text"
+	DefaultParentProperty = "parent"
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+// SourceProperty is the (text) property of the parent object to be chunked
+func (cs *classSettings) SourceProperty() string {
+	return cs.getString("sourceProperty", "")
+}
+
+// TargetClass is the class the chunk objects are created in
+func (cs *classSettings) TargetClass() string {
+	return cs.getString("targetClass", "")
+}
+
+// TextProperty is the property of the target class holding the chunk text
+func (cs *classSettings) TextProperty() string {
+	return cs.getString("textProperty", DefaultTextProperty)
+}
+
+// ParentProperty is the cross-reference property of the target class
+// pointing back to the parent object
+func (cs *classSettings) ParentProperty() string {
+	return cs.getString("parentProperty", DefaultParentProperty)
+}
+
+// IndexProperty is an optional int property of the target class holding the
+// position of the chunk within the parent. It is not set if empty.
+func (cs *classSettings) IndexProperty() string {
+	return cs.getString("indexProperty", "")
+}
+
+// ChunkSize is the number of words per chunk
+func (cs *classSettings) ChunkSize() int {
+	return cs.getInt("chunkSize", DefaultChunkSize)
+}
+
+// Overlap is the number of words shared by two consecutive chunks
+func (cs *classSettings) Overlap() int {
+	return cs.getInt("overlap", DefaultOverlap)
+}
+
+func (cs *classSettings) Validate() error {
+	if cs.SourceProperty() == "" {
+		return errors.Errorf("sourceProperty must be set")
+	}
+
+	if cs.TargetClass() == "" {
+		return errors.Errorf("targetClass must be set")
+	}
+
+	if cs.ChunkSize() < 1 {
+		return errors.Errorf("chunkSize must be a positive integer, got %d",
+			cs.ChunkSize())
+	}
+
+	if cs.Overlap() < 0 || cs.Overlap() >= cs.ChunkSize() {
+		return errors.Errorf("overlap must be between 0 and chunkSize (%d), got %d",
+			cs.ChunkSize(), cs.Overlap())
+	}
+
+	return nil
+}
+
+func (cs *classSettings) getString(name, defaultValue string) string {
+	if cs.cfg == nil {
+		return defaultValue
+	}
+
+	value, ok := cs.cfg.Class()[name]
+	if !ok {
+		return defaultValue
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return defaultValue
+	}
+
+	return asString
+}
+
+func (cs *classSettings) getInt(name string, defaultValue int) int {
+	if cs.cfg == nil {
+		return defaultValue
+	}
+
+	value, ok := cs.cfg.Class()[name]
+	if !ok {
+		return defaultValue
+	}
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asInt, err := typed.Int64()
+		if err != nil {
+			return defaultValue
+		}
+		return int(asInt)
+	case float64:
+		return int(typed)
+	case int:
+		return typed
+	default:
+		return defaultValue
+	}
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modchunker
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/modules/text-chunker/chunker"
+)
+
+func New() *ChunkerModule {
+	return &ChunkerModule{chunker: chunker.New()}
+}
+
+// ChunkerModule splits a long text property into separate chunk objects at
+// import time. It does not rely on any inference container.
+type ChunkerModule struct {
+	chunker *chunker.Chunker
+}
+
+func (m *ChunkerModule) Name() string {
+	return "text-chunker"
+}
+
+func (m *ChunkerModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *ChunkerModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *ChunkerModule) ChunkObject(ctx context.Context, obj *models.Object,
+	cfg moduletools.ClassConfig) ([]*models.Object, error) {
+	return m.chunker.ChunkObject(ctx, obj, cfg)
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Chunker(New())
+)
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modlangrouter
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/modules/text-language-router/router"
+)
+
+func New() *LanguageRouterModule {
+	return &LanguageRouterModule{router: router.New()}
+}
+
+// LanguageRouterModule detects the language of objects at import time and
+// routes them to a language-specific class or tags them with their
+// language. It does not rely on any inference container.
+type LanguageRouterModule struct {
+	router *router.Router
+}
+
+func (m *LanguageRouterModule) Name() string {
+	return "text-language-router"
+}
+
+func (m *LanguageRouterModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *LanguageRouterModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *LanguageRouterModule) RouteObject(ctx context.Context, obj *models.Object,
+	cfg moduletools.ClassConfig) (string, error) {
+	return m.router.RouteObject(ctx, obj, cfg)
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Router(New())
+)
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package router
+
+import (
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+// SourceProperties are the text properties the language is detected from.
+// If none are set, all text properties of the object are used.
+func (cs *classSettings) SourceProperties() []string {
+	if cs.cfg == nil {
+		return nil
+	}
+
+	values, ok := cs.cfg.Class()["sourceProperties"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if asString, ok := value.(string); ok {
+			out = append(out, asString)
+		}
+	}
+
+	return out
+}
+
+// LanguageProperty is an optional text property the detected language is
+// stored in. It is not set if empty.
+func (cs *classSettings) LanguageProperty() string {
+	return cs.getString("languageProperty", "")
+}
+
+// DefaultLanguage is used for objects whose language could not be detected.
+// Such objects are neither routed nor tagged if it is empty.
+func (cs *classSettings) DefaultLanguage() string {
+	return cs.getString("defaultLanguage", "")
+}
+
+// Routes maps a language code to the class objects in that language are
+// stored in. Objects in any other language stay in their class.
+func (cs *classSettings) Routes() map[string]string {
+	out := map[string]string{}
+	if cs.cfg == nil {
+		return out
+	}
+
+	routes, ok := cs.cfg.Class()["routes"].(map[string]interface{})
+	if !ok {
+		return out
+	}
+
+	for language, class := range routes {
+		if asString, ok := class.(string); ok {
+			out[language] = asString
+		}
+	}
+
+	return out
+}
+
+func (cs *classSettings) Validate() error {
+	if cs.cfg == nil {
+		return errors.Errorf("empty config")
+	}
+
+	class := cs.cfg.Class()
+	if routes, ok := class["routes"]; ok {
+		asMap, ok := routes.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("routes must be an object mapping languages to "+
+				"classes, got %T", routes)
+		}
+
+		for language, target := range asMap {
+			if asString, ok := target.(string); !ok || asString == "" {
+				return errors.Errorf("route for language %q must be a class name",
+					language)
+			}
+		}
+	}
+
+	if sources, ok := class["sourceProperties"]; ok {
+		if _, ok := sources.([]interface{}); !ok {
+			return errors.Errorf("sourceProperties must be a list of property "+
+				"names, got %T", sources)
+		}
+	}
+
+	if len(cs.Routes()) == 0 && cs.LanguageProperty() == "" {
+		return errors.Errorf("at least one of routes or languageProperty must be set")
+	}
+
+	return nil
+}
+
+func (cs *classSettings) getString(name, defaultValue string) string {
+	if cs.cfg == nil {
+		return defaultValue
+	}
+
+	value, ok := cs.cfg.Class()[name]
+	if !ok {
+		return defaultValue
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return defaultValue
+	}
+
+	return asString
+}
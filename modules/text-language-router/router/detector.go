@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package router
+
+import (
+	"strings"
+	"unicode"
+)
+
+// scriptLanguages maps scripts which are (almost) exclusively used by a
+// single language to that language. Han is handled separately, as it is
+// shared by Chinese and Japanese.
+var scriptLanguages = []struct {
+	table    *unicode.RangeTable
+	language string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+}
+
+// stopwords are the most frequent words of each language written in the
+// Latin script. Some of them are shared between languages, the language
+// with the most matches wins.
+var stopwords = map[string][]string{
+	"en": {
+		"the", "and", "of", "to", "is", "in", "that", "it", "with", "for",
+		"was", "on", "are", "this", "be", "as", "have", "not", "you", "he",
+	},
+	"de": {
+		"der", "die", "und", "das", "ist", "nicht", "mit", "den", "ein", "eine",
+		"zu", "auf", "ich", "sich", "dem", "auch", "es", "von", "sie", "wir",
+	},
+	"fr": {
+		"le", "la", "les", "et", "est", "des", "une", "un", "du", "que",
+		"pas", "pour", "dans", "qui", "sur", "au", "ce", "il", "nous", "je",
+	},
+	"es": {
+		"el", "los", "las", "y", "es", "del", "que", "una", "por", "con",
+		"para", "se", "no", "un", "lo", "como", "pero", "su", "al", "muy",
+	},
+	"it": {
+		"il", "che", "di", "è", "non", "per", "una", "gli", "con", "della",
+		"sono", "del", "anche", "ma", "nel", "questo", "come", "ho", "lo", "le",
+	},
+	"nl": {
+		"de", "het", "een", "en", "van", "is", "niet", "dat", "op", "te",
+		"zijn", "met", "voor", "ook", "maar", "ik", "je", "wij", "er", "naar",
+	},
+	"pt": {
+		"o", "os", "as", "e", "do", "da", "que", "não", "um", "uma",
+		"para", "com", "em", "dos", "se", "mais", "ao", "na", "no", "foi",
+	},
+}
+
+// stopwordLanguages holds the languages of the stopwords in a fixed order,
+// so that ties are always resolved the same way
+var stopwordLanguages = []string{"en", "de", "fr", "es", "it", "nl", "pt"}
+
+var stopwordIndex = buildStopwordIndex()
+
+func buildStopwordIndex() map[string][]string {
+	index := map[string][]string{}
+	for _, language := range stopwordLanguages {
+		for _, word := range stopwords[language] {
+			index[word] = append(index[word], language)
+		}
+	}
+
+	return index
+}
+
+// Detect returns the ISO 639-1 code of the language the text is written in.
+// Texts in a non-Latin script are identified by their script, texts in the
+// Latin script by the frequency of common words. It returns false if the
+// language could not be determined, e.g. because the text is too short.
+func Detect(text string) (string, bool) {
+	var latin, han, kana int
+	scripts := make([]int, len(scriptLanguages))
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Han, r):
+			han++
+		default:
+			for i, script := range scriptLanguages {
+				if unicode.Is(script.table, r) {
+					scripts[i]++
+					break
+				}
+			}
+		}
+	}
+
+	// Japanese mixes Han with Kana, whereas Chinese never uses Kana
+	best, bestCount := "", latin
+	if kana > 0 && han+kana > bestCount {
+		best, bestCount = "ja", han+kana
+	} else if han > bestCount {
+		best, bestCount = "zh", han
+	}
+	for i, count := range scripts {
+		if count > bestCount {
+			best, bestCount = scriptLanguages[i].language, count
+		}
+	}
+
+	if bestCount == 0 {
+		return "", false
+	}
+
+	if best != "" {
+		return best, true
+	}
+
+	return detectLatin(text)
+}
+
+func detectLatin(text string) (string, bool) {
+	counts := map[string]int{}
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		for _, language := range stopwordIndex[word] {
+			counts[language]++
+		}
+	}
+
+	best, bestCount := "", 0
+	for _, language := range stopwordLanguages {
+		if counts[language] > bestCount {
+			best, bestCount = language, counts[language]
+		}
+	}
+
+	return best, bestCount > 0
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package router
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+type Router struct{}
+
+func New() *Router {
+	return &Router{}
+}
+
+// RouteObject detects the language of the object's text and returns the
+// class configured for that language. If a language property is configured,
+// the detected language is stored on the object as well. Since the object is
+// vectorized as part of the class it is routed to, the vectorizer config of
+// that class selects the model used for the language.
+func (r *Router) RouteObject(ctx context.Context, obj *models.Object,
+	cfg moduletools.ClassConfig) (string, error) {
+	settings := NewClassSettings(cfg)
+	if err := settings.Validate(); err != nil {
+		return "", errors.Wrap(err, "invalid text-language-router config")
+	}
+
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+
+	language, ok := Detect(sourceText(props, settings.SourceProperties()))
+	if !ok {
+		language = settings.DefaultLanguage()
+	}
+
+	if language == "" {
+		return "", nil
+	}
+
+	if langProp := settings.LanguageProperty(); langProp != "" {
+		props[langProp] = language
+	}
+
+	return settings.Routes()[language], nil
+}
+
+// sourceText joins the values of the source properties. Without any source
+// properties, all text properties are used in the order of their names.
+func sourceText(props map[string]interface{}, sources []string) string {
+	if len(sources) == 0 {
+		for name := range props {
+			sources = append(sources, name)
+		}
+		sort.Strings(sources)
+	}
+
+	var texts []string
+	for _, name := range sources {
+		switch value := props[name].(type) {
+		case string:
+			texts = append(texts, value)
+		case []interface{}:
+			for _, elem := range value {
+				if asString, ok := elem.(string); ok {
+					texts = append(texts, asString)
+				}
+			}
+		}
+	}
+
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package router
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{text: "The quick brown fox jumps over the lazy dog and it is fast", expected: "en"},
+		{text: "Der Hund ist nicht mit dem Ball auf die Wiese gelaufen", expected: "de"},
+		{text: "Le chat est dans la maison et il ne sort pas", expected: "fr"},
+		{text: "El perro de los vecinos es muy grande y no come con nosotros", expected: "es"},
+		{text: "Il gatto della nonna non è mai uscito di casa", expected: "it"},
+		{text: "De hond is niet naar het park gegaan, maar ook niet naar huis", expected: "nl"},
+		{text: "O cão não foi para a praia com os amigos", expected: "pt"},
+		{text: "Собака бежит по улице", expected: "ru"},
+		{text: "我喜欢吃苹果", expected: "zh"},
+		{text: "私はりんごが好きです", expected: "ja"},
+		{text: "나는 사과를 좋아한다", expected: "ko"},
+		{text: "Ο σκύλος τρέχει", expected: "el"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			language, ok := Detect(test.text)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, language)
+		})
+	}
+
+	t.Run("undetectable", func(t *testing.T) {
+		for _, text := range []string{"", "1234 !?", "xyzzy plugh"} {
+			_, ok := Detect(text)
+			assert.False(t, ok, text)
+		}
+	})
+}
+
+func TestRouteObject(t *testing.T) {
+	cfg := fakeClassConfig{
+		"sourceProperties": []interface{}{"body"},
+		"languageProperty": "language",
+		"routes": map[string]interface{}{
+			"de": "ArticleDE",
+			"fr": "ArticleFR",
+		},
+	}
+
+	t.Run("routed to the class of the language", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"title": "The article",
+				"body":  "Der Hund ist nicht mit dem Ball auf die Wiese gelaufen",
+			},
+		}
+
+		class, err := New().RouteObject(context.Background(), obj, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, "ArticleDE", class)
+		assert.Equal(t, "de", obj.Properties.(map[string]interface{})["language"])
+	})
+
+	t.Run("tagged only without a route", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"body": "The quick brown fox jumps over the lazy dog",
+			},
+		}
+
+		class, err := New().RouteObject(context.Background(), obj, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, "", class)
+		assert.Equal(t, "en", obj.Properties.(map[string]interface{})["language"])
+	})
+
+	t.Run("default language", func(t *testing.T) {
+		withDefault := fakeClassConfig{
+			"routes":          map[string]interface{}{"fr": "ArticleFR"},
+			"defaultLanguage": "fr",
+		}
+		obj := &models.Object{
+			Class:      "Article",
+			Properties: map[string]interface{}{"body": "42"},
+		}
+
+		class, err := New().RouteObject(context.Background(), obj, withDefault)
+		require.Nil(t, err)
+		assert.Equal(t, "ArticleFR", class)
+		assert.Equal(t, map[string]interface{}{"body": "42"}, obj.Properties)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		for _, invalid := range []fakeClassConfig{
+			{},
+			{"routes": map[string]interface{}{"de": ""}},
+			{"routes": "ArticleDE"},
+			{"languageProperty": "language", "sourceProperties": "body"},
+		} {
+			_, err := New().RouteObject(context.Background(), &models.Object{}, invalid)
+			assert.NotNil(t, err)
+		}
+	})
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
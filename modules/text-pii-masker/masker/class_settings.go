@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package masker
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+const (
+	// MaskAtIngest masks values before objects are vectorized and stored, the
+	// original values are lost
+	MaskAtIngest = "ingest"
+
+	// MaskAtRead stores and indexes the original values, but masks them in
+	// every object returned to a principal outside of the unmasked groups
+	MaskAtRead = "read"
+
+	DefaultMaskAt = MaskAtRead
+)
+
+// DefaultPatterns are masked if a class doesn't list patterns explicitly
+var DefaultPatterns = []string{PatternEmail, PatternPhone}
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+// Properties are the text properties whose values are masked. If none are
+// set, all text properties of the object are masked.
+func (cs *classSettings) Properties() []string {
+	return cs.getStrings("properties")
+}
+
+// Patterns are the names of the built-in patterns which are masked
+func (cs *classSettings) Patterns() []string {
+	if cs.cfg == nil {
+		return DefaultPatterns
+	}
+
+	if _, ok := cs.cfg.Class()["patterns"]; !ok {
+		return DefaultPatterns
+	}
+
+	return cs.getStrings("patterns")
+}
+
+// CustomPatterns maps the name of a pattern to a regular expression, every
+// match of which is masked in addition to the built-in patterns
+func (cs *classSettings) CustomPatterns() map[string]string {
+	out := map[string]string{}
+	if cs.cfg == nil {
+		return out
+	}
+
+	patterns, ok := cs.cfg.Class()["customPatterns"].(map[string]interface{})
+	if !ok {
+		return out
+	}
+
+	for name, pattern := range patterns {
+		if asString, ok := pattern.(string); ok {
+			out[name] = asString
+		}
+	}
+
+	return out
+}
+
+// MaskAt is either MaskAtIngest or MaskAtRead
+func (cs *classSettings) MaskAt() string {
+	return cs.getString("maskAt", DefaultMaskAt)
+}
+
+// UnmaskedGroups are the groups of principals which see the original values
+// if the values are masked at read time
+func (cs *classSettings) UnmaskedGroups() []string {
+	return cs.getStrings("unmaskedGroups")
+}
+
+// Replacement replaces every match of every pattern if set. Otherwise a
+// match is replaced with the upper-cased name of its pattern in brackets,
+// e.g. "[EMAIL]".
+func (cs *classSettings) Replacement() string {
+	return cs.getString("replacement", "")
+}
+
+func (cs *classSettings) Validate() error {
+	if cs.cfg == nil {
+		return errors.Errorf("empty config")
+	}
+
+	class := cs.cfg.Class()
+	for _, name := range []string{"properties", "patterns", "unmaskedGroups"} {
+		if value, ok := class[name]; ok {
+			if _, ok := value.([]interface{}); !ok {
+				return errors.Errorf("%s must be a list of strings, got %T", name, value)
+			}
+		}
+	}
+
+	for _, name := range cs.Patterns() {
+		if _, ok := builtinPatterns[name]; !ok {
+			return errors.Errorf("unknown pattern %q, use customPatterns for "+
+				"anything other than %q and %q", name, PatternEmail, PatternPhone)
+		}
+	}
+
+	if custom, ok := class["customPatterns"]; ok {
+		asMap, ok := custom.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("customPatterns must be an object mapping names "+
+				"to regular expressions, got %T", custom)
+		}
+
+		for name, pattern := range asMap {
+			asString, ok := pattern.(string)
+			if !ok || asString == "" {
+				return errors.Errorf("custom pattern %q must be a regular expression",
+					name)
+			}
+
+			if _, err := regexp.Compile(asString); err != nil {
+				return errors.Wrapf(err, "custom pattern %q", name)
+			}
+		}
+	}
+
+	if len(cs.Patterns()) == 0 && len(cs.CustomPatterns()) == 0 {
+		return errors.Errorf("at least one of patterns or customPatterns must be set")
+	}
+
+	if maskAt := cs.MaskAt(); maskAt != MaskAtIngest && maskAt != MaskAtRead {
+		return errors.Errorf("maskAt must be %q or %q, got %q", MaskAtIngest,
+			MaskAtRead, maskAt)
+	}
+
+	return nil
+}
+
+func (cs *classSettings) getStrings(name string) []string {
+	if cs.cfg == nil {
+		return nil
+	}
+
+	values, ok := cs.cfg.Class()[name].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if asString, ok := value.(string); ok {
+			out = append(out, asString)
+		}
+	}
+
+	return out
+}
+
+func (cs *classSettings) getString(name, defaultValue string) string {
+	if cs.cfg == nil {
+		return defaultValue
+	}
+
+	value, ok := cs.cfg.Class()[name]
+	if !ok {
+		return defaultValue
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return defaultValue
+	}
+
+	return asString
+}
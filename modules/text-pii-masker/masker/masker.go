@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package masker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+const (
+	PatternEmail = "email"
+	PatternPhone = "phone"
+)
+
+var builtinPatterns = map[string]*regexp.Regexp{
+	PatternEmail: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`),
+	// an optional country code, an area code of 2-4 digits, optionally in
+	// parentheses, and at least 6 more digits, optionally separated. Dates
+	// such as 2021-10-16 don't match, as the groups after the area code are
+	// too short.
+	PatternPhone: regexp.MustCompile(`(?:\+\d{1,3}[\s.\-]?)?(?:\(\d{2,4}\)[\s.\-]?|\d{2,4}[\s.\-])\d{3,4}[\s.\-]?\d{3,4}\b`),
+}
+
+type Masker struct {
+	// custom patterns are compiled once and kept for as long as the
+	// module runs, the number of distinct patterns is bounded by the schema
+	sync.Mutex
+	custom map[string]*regexp.Regexp
+}
+
+func New() *Masker {
+	return &Masker{custom: map[string]*regexp.Regexp{}}
+}
+
+// MaskObject masks the configured properties of an object which is about to
+// be imported, if the class masks values at ingest
+func (m *Masker) MaskObject(ctx context.Context, props map[string]interface{},
+	cfg moduletools.ClassConfig) error {
+	settings := NewClassSettings(cfg)
+	if err := settings.Validate(); err != nil {
+		return errors.Wrap(err, "invalid text-pii-masker config")
+	}
+
+	if settings.MaskAt() != MaskAtIngest {
+		return nil
+	}
+
+	return m.mask(props, settings)
+}
+
+// MaskResult masks the configured properties of an object which is returned
+// to the principal, if the class masks values at read time and the principal
+// isn't part of any of the unmasked groups. Anonymous principals always see
+// masked values.
+func (m *Masker) MaskResult(ctx context.Context, props map[string]interface{},
+	principal *models.Principal, cfg moduletools.ClassConfig) error {
+	settings := NewClassSettings(cfg)
+	if err := settings.Validate(); err != nil {
+		return errors.Wrap(err, "invalid text-pii-masker config")
+	}
+
+	if settings.MaskAt() != MaskAtRead {
+		return nil
+	}
+
+	if isUnmasked(principal, settings.UnmaskedGroups()) {
+		return nil
+	}
+
+	return m.mask(props, settings)
+}
+
+func isUnmasked(principal *models.Principal, groups []string) bool {
+	if principal == nil {
+		return false
+	}
+
+	for _, group := range principal.Groups {
+		for _, unmasked := range groups {
+			if group == unmasked {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type namedPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+func (m *Masker) mask(props map[string]interface{}, settings *classSettings) error {
+	patterns, err := m.patterns(settings)
+	if err != nil {
+		return err
+	}
+
+	replacement := settings.Replacement()
+	maskText := func(text string) string {
+		for _, p := range patterns {
+			repl := replacement
+			if repl == "" {
+				repl = fmt.Sprintf("[%s]", strings.ToUpper(p.name))
+			}
+			text = p.pattern.ReplaceAllLiteralString(text, repl)
+		}
+		return text
+	}
+
+	names := settings.Properties()
+	if len(names) == 0 {
+		for name := range props {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		switch value := props[name].(type) {
+		case string:
+			props[name] = maskText(value)
+		case []interface{}:
+			for i, elem := range value {
+				if asString, ok := elem.(string); ok {
+					value[i] = maskText(asString)
+				}
+			}
+		case []string:
+			for i, elem := range value {
+				value[i] = maskText(elem)
+			}
+		}
+	}
+
+	return nil
+}
+
+// patterns returns the built-in patterns followed by the custom ones, both
+// in the order of their names so that overlapping patterns are applied
+// consistently
+func (m *Masker) patterns(settings *classSettings) ([]namedPattern, error) {
+	var out []namedPattern
+	builtin := settings.Patterns()
+	sort.Strings(builtin)
+	for _, name := range builtin {
+		out = append(out, namedPattern{name: name, pattern: builtinPatterns[name]})
+	}
+
+	custom := settings.CustomPatterns()
+	names := make([]string, 0, len(custom))
+	for name := range custom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	m.Lock()
+	defer m.Unlock()
+	for _, name := range names {
+		compiled, ok := m.custom[custom[name]]
+		if !ok {
+			var err error
+			compiled, err = regexp.Compile(custom[name])
+			if err != nil {
+				return nil, errors.Wrapf(err, "custom pattern %q", name)
+			}
+			m.custom[custom[name]] = compiled
+		}
+
+		out = append(out, namedPattern{name: name, pattern: compiled})
+	}
+
+	return out, nil
+}
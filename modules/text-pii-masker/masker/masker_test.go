@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package masker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskResult(t *testing.T) {
+	cfg := fakeClassConfig{
+		"properties":     []interface{}{"body", "tags"},
+		"unmaskedGroups": []interface{}{"compliance"},
+		"customPatterns": map[string]interface{}{
+			"ssn": `\b\d{3}-\d{2}-\d{4}\b`,
+		},
+	}
+
+	newProps := func() map[string]interface{} {
+		return map[string]interface{}{
+			"title": "Call jane.doe@example.com",
+			"body": "Reach Jane at jane.doe@example.com or +1 555-123-4567, " +
+				"SSN 123-45-6789, born 1990-01-02",
+			"tags": []interface{}{"(030) 1234 5678", "vip"},
+		}
+	}
+
+	t.Run("anonymous principal", func(t *testing.T) {
+		props := newProps()
+		err := New().MaskResult(context.Background(), props, nil, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"title": "Call jane.doe@example.com",
+			"body":  "Reach Jane at [EMAIL] or [PHONE], SSN [SSN], born 1990-01-02",
+			"tags":  []interface{}{"[PHONE]", "vip"},
+		}, props)
+	})
+
+	t.Run("principal outside of the unmasked groups", func(t *testing.T) {
+		props := newProps()
+		principal := &models.Principal{Username: "john", Groups: []string{"sales"}}
+		err := New().MaskResult(context.Background(), props, principal, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, "Reach Jane at [EMAIL] or [PHONE], SSN [SSN], born 1990-01-02",
+			props["body"])
+	})
+
+	t.Run("principal in an unmasked group", func(t *testing.T) {
+		props := newProps()
+		principal := &models.Principal{Username: "jim", Groups: []string{"compliance"}}
+		err := New().MaskResult(context.Background(), props, principal, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, newProps(), props)
+	})
+
+	t.Run("not masked at ingest", func(t *testing.T) {
+		props := newProps()
+		err := New().MaskObject(context.Background(), props, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, newProps(), props)
+	})
+}
+
+func TestMaskObject(t *testing.T) {
+	cfg := fakeClassConfig{
+		"maskAt":      "ingest",
+		"patterns":    []interface{}{"email"},
+		"replacement": "***",
+	}
+
+	props := map[string]interface{}{
+		"title": "Contact: jane.doe@mail.example.co.uk",
+		"body":  "Phone 555-123-4567",
+		"count": float64(7),
+	}
+
+	err := New().MaskObject(context.Background(), props, cfg)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"title": "Contact: ***",
+		"body":  "Phone 555-123-4567",
+		"count": float64(7),
+	}, props)
+
+	t.Run("not masked again at read time", func(t *testing.T) {
+		props := map[string]interface{}{"title": "jane.doe@example.com"}
+		err := New().MaskResult(context.Background(), props, nil, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, "jane.doe@example.com", props["title"])
+	})
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for _, invalid := range []fakeClassConfig{
+		{"patterns": []interface{}{"creditcard"}},
+		{"patterns": []interface{}{}},
+		{"patterns": "email"},
+		{"properties": "body"},
+		{"customPatterns": map[string]interface{}{"broken": "(["}},
+		{"customPatterns": []interface{}{`\d+`}},
+		{"maskAt": "write"},
+	} {
+		err := New().MaskResult(context.Background(), map[string]interface{}{},
+			nil, invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modpiimasker
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/modules/text-pii-masker/masker"
+)
+
+func New() *PIIMaskerModule {
+	return &PIIMaskerModule{masker: masker.New()}
+}
+
+// PIIMaskerModule masks personally identifiable information, such as email
+// addresses and phone numbers, in the text properties of objects either at
+// import time or when they are returned to a principal. It does not rely on
+// any inference container.
+type PIIMaskerModule struct {
+	masker *masker.Masker
+}
+
+func (m *PIIMaskerModule) Name() string {
+	return "text-pii-masker"
+}
+
+func (m *PIIMaskerModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *PIIMaskerModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *PIIMaskerModule) MaskObject(ctx context.Context,
+	props map[string]interface{}, cfg moduletools.ClassConfig) error {
+	return m.masker.MaskObject(ctx, props, cfg)
+}
+
+func (m *PIIMaskerModule) MaskResult(ctx context.Context,
+	props map[string]interface{}, principal *models.Principal,
+	cfg moduletools.ClassConfig) error {
+	return m.masker.MaskResult(ctx, props, principal, cfg)
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Masker(New())
+)
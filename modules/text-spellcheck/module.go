@@ -15,7 +15,6 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
@@ -36,6 +35,7 @@ type SpellCheckModule struct {
 	spellCheck                   spellCheckClient
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
 	textTransformersProvider     modulecapabilities.TextTransformers
+	readinessChecker             modulecapabilities.ReadinessChecker
 }
 
 type spellCheckClient interface {
@@ -56,10 +56,7 @@ func (m *SpellCheckModule) Init(ctx context.Context,
 
 	client := clients.New(uri, params.GetLogger())
 
-	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
-		return errors.Wrap(err, "init remote spell check module")
-	}
-
+	m.readinessChecker = client
 	m.spellCheck = client
 
 	m.initTextTransformers()
@@ -95,9 +92,14 @@ func (m *SpellCheckModule) TextTransformers() map[string]modulecapabilities.Text
 	return m.textTransformersProvider.TextTransformers()
 }
 
+func (m *SpellCheckModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
 	_ = modulecapabilities.TextTransformers(New())
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modtext2sparsedummy
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+func New() *Text2SparseDummyModule {
+	return &Text2SparseDummyModule{}
+}
+
+// Text2SparseDummyModule is a reference implementation of a sparse
+// vectorizer. Instead of a learned model such as SPLADE, it weighs the
+// lowercased words of the text and string properties of an object by their
+// log-scaled frequency, which makes it possible to test sparse searches
+// without any inference container.
+type Text2SparseDummyModule struct{}
+
+func (m *Text2SparseDummyModule) Name() string {
+	return "text2sparse-dummy"
+}
+
+func (m *Text2SparseDummyModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *Text2SparseDummyModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+// VectorizeObjectSparse weighs every word of the object by log(1+tf), where
+// tf is the number of its occurrences across all text and string properties
+func (m *Text2SparseDummyModule) VectorizeObjectSparse(ctx context.Context,
+	obj *models.Object, cfg moduletools.ClassConfig) (models.SparseVector, error) {
+	counts := map[string]int{}
+	props, _ := obj.Properties.(map[string]interface{})
+	for _, value := range props {
+		for _, text := range texts(value) {
+			for _, word := range words(text) {
+				counts[word]++
+			}
+		}
+	}
+
+	out := make(models.SparseVector, len(counts))
+	for word, count := range counts {
+		out[word] = float32(math.Log1p(float64(count)))
+	}
+
+	return out, nil
+}
+
+// VectorizeQuerySparse weighs every word of the query with 1, so the score of
+// an object is the sum of the weights of the query words it contains
+func (m *Text2SparseDummyModule) VectorizeQuerySparse(ctx context.Context,
+	query string, cfg moduletools.ClassConfig) (models.SparseVector, error) {
+	out := models.SparseVector{}
+	for _, word := range words(query) {
+		out[word] = 1
+	}
+
+	return out, nil
+}
+
+func texts(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var out []string
+		for _, elem := range v {
+			if text, ok := elem.(string); ok {
+				out = append(out, text)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.SparseVectorizer(New())
+)
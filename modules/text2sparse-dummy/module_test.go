@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modtext2sparsedummy
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestText2SparseDummy(t *testing.T) {
+	m := New()
+
+	t.Run("objects", func(t *testing.T) {
+		vector, err := m.VectorizeObjectSparse(context.Background(), &models.Object{
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"title": "Fast cars",
+				"tags":  []interface{}{"cars", "Racing"},
+				"views": 3.0,
+			},
+		}, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, models.SparseVector{
+			"fast":   float32(math.Log1p(1)),
+			"cars":   float32(math.Log1p(2)),
+			"racing": float32(math.Log1p(1)),
+		}, vector)
+	})
+
+	t.Run("queries", func(t *testing.T) {
+		vector, err := m.VectorizeQuerySparse(context.Background(),
+			"Fast, fast cars!", nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, models.SparseVector{"fast": 1, "cars": 1}, vector)
+	})
+}
@@ -30,7 +30,9 @@ func (p *Params) validate(inputSize, dims int) error {
 	}
 
 	if p.SearchVector == nil || len(p.SearchVector) == 0 {
-		ec.addf("no valid search vector present, got: %v", p.SearchVector)
+		ec.addf("no valid search vector present: semantic path explains the " +
+			"relation between the query and the results, use it together with " +
+			"nearText or nearVector")
 	}
 
 	return ec.toError()
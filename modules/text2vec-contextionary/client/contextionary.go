@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	pb "github.com/semi-technologies/contextionary/contextionary"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	txt2vecmodels "github.com/semi-technologies/weaviate/modules/text2vec-contextionary/additional/models"
 	"github.com/semi-technologies/weaviate/modules/text2vec-contextionary/vectorizer"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
@@ -286,46 +287,42 @@ func vectorToProto(in []float32) *pb.Vector {
 	return &pb.Vector{Entries: output}
 }
 
-func (c *Client) WaitForStartupAndValidateVersion(startupCtx context.Context,
-	requiredMinimumVersion string, interval time.Duration) error {
-	for {
-		if err := startupCtx.Err(); err != nil {
-			return errors.Wrap(err, "wait for contextionary remote inference service")
-		}
-
-		time.Sleep(interval)
+// CheckReadyAndValidateVersion performs a single check whether the remote
+// contextionary is reachable and at least of the required version. An
+// insufficient version can never recover and is reported as such.
+func (c *Client) CheckReadyAndValidateVersion(ctx context.Context,
+	requiredMinimumVersion string) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
 
-		ctx, cancel := context.WithTimeout(startupCtx, 2*time.Second)
-		defer cancel()
-		v, err := c.version(ctx)
-		if err != nil {
-			c.logger.WithField("action", "startup_check_contextionary").WithError(err).
-				Warnf("could not connect to contextionary at startup, trying again in 1 sec")
-			continue
-		}
+	v, err := c.version(ctx)
+	if err != nil {
+		return errors.Wrap(err, "connect to contextionary")
+	}
 
-		ok, err := extractVersionAndCompare(v, requiredMinimumVersion)
-		if err != nil {
-			c.logger.WithField("action", "startup_check_contextionary").
-				WithField("requiredMinimumContextionaryVersion", requiredMinimumVersion).
-				WithField("contextionaryVersion", v).
-				WithError(err).
-				Warnf("cannot determine if contextionary version is compatible. " +
-					"This is fine in development, but probelematic if you see this production")
-			return nil
-		}
+	ok, err := extractVersionAndCompare(v, requiredMinimumVersion)
+	if err != nil {
+		c.logger.WithField("action", "startup_check_contextionary").
+			WithField("requiredMinimumContextionaryVersion", requiredMinimumVersion).
+			WithField("contextionaryVersion", v).
+			WithError(err).
+			Warnf("cannot determine if contextionary version is compatible. " +
+				"This is fine in development, but probelematic if you see this production")
+		return nil
+	}
 
-		if ok {
-			c.logger.WithField("action", "startup_check_contextionary").
-				WithField("requiredMinimumContextionaryVersion", requiredMinimumVersion).
-				WithField("contextionaryVersion", v).
-				Infof("found a valid contextionary version")
-			return nil
-		} else {
-			return errors.Errorf("insuffcient contextionary version: need at least %s, got %s",
-				requiredMinimumVersion, v)
+	if !ok {
+		return modulecapabilities.NotRecoverableError{
+			Err: errors.Errorf("insuffcient contextionary version: need at least %s, got %s",
+				requiredMinimumVersion, v),
 		}
 	}
+
+	c.logger.WithField("action", "startup_check_contextionary").
+		WithField("requiredMinimumContextionaryVersion", requiredMinimumVersion).
+		WithField("contextionaryVersion", v).
+		Infof("found a valid contextionary version")
+	return nil
 }
 
 func overridesFromMap(in map[string]string) []*pb.Override {
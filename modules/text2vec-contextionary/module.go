@@ -14,7 +14,6 @@ package modcontextionary
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
@@ -69,8 +68,7 @@ type remoteClient interface {
 	text2vecsempath.Remote
 	modulecapabilities.MetaProvider
 	modulecapabilities.VectorizerClient
-	WaitForStartupAndValidateVersion(ctx context.Context, version string,
-		interval time.Duration) error
+	CheckReadyAndValidateVersion(ctx context.Context, version string) error
 }
 
 type configValidator interface {
@@ -99,11 +97,6 @@ func (m *ContextionaryModule) Init(ctx context.Context,
 	}
 	m.remote = remote
 
-	if err := m.remote.WaitForStartupAndValidateVersion(ctx,
-		MinimumRequiredRemoteVersion, 1*time.Second); err != nil {
-		return errors.Wrap(err, "validate remote inference api")
-	}
-
 	if err := m.initExtensions(); err != nil {
 		return errors.Wrap(err, "init extensions")
 	}
@@ -230,8 +223,13 @@ func (m *ContextionaryModule) MetaInfo() (map[string]interface{}, error) {
 	return m.remote.MetaInfo()
 }
 
+func (m *ContextionaryModule) CheckReady(ctx context.Context) error {
+	return m.remote.CheckReadyAndValidateVersion(ctx, MinimumRequiredRemoteVersion)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.Vectorizer(New())
 )
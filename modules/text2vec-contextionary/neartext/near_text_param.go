@@ -21,6 +21,8 @@ type NearTextParams struct {
 	MoveTo       ExploreMove
 	MoveAwayFrom ExploreMove
 	Certainty    float64
+	Distance     float64
+	WithDistance bool
 	Network      bool
 	Autocorrect  bool
 }
@@ -29,6 +31,14 @@ func (n NearTextParams) GetCertainty() float64 {
 	return n.Certainty
 }
 
+func (n NearTextParams) GetDistance() float64 {
+	return n.Distance
+}
+
+func (n NearTextParams) HasDistance() bool {
+	return n.WithDistance
+}
+
 // ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
 type ExploreMove struct {
 	Values  []string
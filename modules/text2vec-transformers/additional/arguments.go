@@ -49,3 +49,20 @@ func additionalFeatureProjectionField(classname string) *graphql.Field {
 		}),
 	}
 }
+
+func additionalAttributionField(classname string) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalAttribution", classname),
+			Fields: graphql.Fields{
+				"properties": &graphql.Field{Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalAttributionProperties", classname),
+					Fields: graphql.Fields{
+						"property": &graphql.Field{Type: graphql.String},
+						"weight":   &graphql.Field{Type: graphql.Float},
+					},
+				}))},
+			},
+		}),
+	}
+}
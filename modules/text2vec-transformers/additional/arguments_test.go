@@ -53,3 +53,19 @@ func TestFeatureProjectionField(t *testing.T) {
 		assert.NotNil(t, featureProjectionObject.Fields()["vector"])
 	})
 }
+
+func TestAttributionField(t *testing.T) {
+	t.Run("should generate attribution field properly", func(t *testing.T) {
+		attribution := additionalAttributionField("Class")
+
+		assert.NotNil(t, attribution)
+		assert.Equal(t, "ClassAdditionalAttribution", attribution.Type.Name())
+		assert.Nil(t, attribution.Args)
+		attributionObject, ok := attribution.Type.(*graphql.Object)
+		assert.True(t, ok)
+		assert.Equal(t, 1, len(attributionObject.Fields()))
+		properties, ok := attributionObject.Fields()["properties"].Type.(*graphql.List)
+		assert.True(t, ok)
+		assert.Equal(t, "ClassAdditionalAttributionProperties", properties.OfType.Name())
+	})
+}
@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package attribution
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/ent"
+	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/vectorizer"
+)
+
+// Attribution explains which properties of a result contributed to its
+// similarity with the query. The weight of a property is the drop in
+// similarity when the property is left out of the vectorized text, so
+// properties with a negative weight made the result less similar.
+type Attribution struct {
+	Properties []*PropertyAttribution `json:"properties"`
+}
+
+type PropertyAttribution struct {
+	Property string  `json:"property"`
+	Weight   float64 `json:"weight"`
+}
+
+type Remote interface {
+	Vectorize(ctx context.Context, input string,
+		cfg ent.VectorizationConfig) (*ent.VectorizationResult, error)
+}
+
+func New(remote Remote) *Attributor {
+	return &Attributor{remote: remote}
+}
+
+type Attributor struct {
+	remote Remote
+}
+
+func (a *Attributor) AdditonalPropertyDefaultValue() interface{} {
+	return &Params{}
+}
+
+func (a *Attributor) AdditionalPropertyFn(ctx context.Context,
+	in []search.Result, params interface{}, limit *int,
+	argumentModuleParams map[string]interface{}) ([]search.Result, error) {
+	if parameters, ok := params.(*Params); ok {
+		return a.Attribute(ctx, in, parameters)
+	}
+	return nil, errors.New("unknown params")
+}
+
+func (a *Attributor) ExtractAdditionalFn(param []*ast.Argument) interface{} {
+	return &Params{}
+}
+
+func (a *Attributor) Attribute(ctx context.Context, in []search.Result,
+	params *Params) ([]search.Result, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
+
+	if err := params.SetDefaultsAndValidate(len(in)); err != nil {
+		return nil, errors.Wrap(err, "invalid params")
+	}
+
+	for i, obj := range in {
+		attribution, err := a.attributeObject(ctx, obj, params.SearchVector)
+		if err != nil {
+			return nil, fmt.Errorf("object %d: %v", i, err)
+		}
+
+		if in[i].AdditionalProperties == nil {
+			in[i].AdditionalProperties = models.AdditionalProperties{}
+		}
+
+		in[i].AdditionalProperties["attribution"] = attribution
+	}
+
+	return in, nil
+}
+
+// attributeObject occludes one text property of the object at a time and
+// compares the similarity of the remaining text to the one of the full text
+func (a *Attributor) attributeObject(ctx context.Context, obj search.Result,
+	searchVector []float32) (*Attribution, error) {
+	texts := textProperties(obj.Schema)
+	out := &Attribution{Properties: []*PropertyAttribution{}}
+	if len(texts) == 0 {
+		return out, nil
+	}
+
+	full, err := a.similarity(ctx, texts, "", searchVector)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, text := range texts {
+		occluded, err := a.similarity(ctx, texts, text.property, searchVector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "occlude property %s", text.property)
+		}
+
+		out.Properties = append(out.Properties, &PropertyAttribution{
+			Property: text.property,
+			Weight:   full - occluded,
+		})
+	}
+
+	sort.SliceStable(out.Properties, func(i, j int) bool {
+		return out.Properties[i].Weight > out.Properties[j].Weight
+	})
+
+	return out, nil
+}
+
+// similarity vectorizes the texts without the excluded property and returns
+// the cosine similarity to the search vector. Nothing is left to vectorize
+// if the only property is excluded, this is treated as no similarity at all.
+func (a *Attributor) similarity(ctx context.Context, texts []propertyText,
+	exclude string, searchVector []float32) (float64, error) {
+	var parts []string
+	for _, text := range texts {
+		if text.property == exclude {
+			continue
+		}
+		parts = append(parts, text.value)
+	}
+
+	if len(parts) == 0 {
+		return 0, nil
+	}
+
+	res, err := a.remote.Vectorize(ctx, strings.Join(parts, " "),
+		ent.VectorizationConfig{PoolingStrategy: vectorizer.DefaultPoolingStrategy})
+	if err != nil {
+		return 0, errors.Wrap(err, "remote client vectorize")
+	}
+
+	return cosineSimilarity(res.Vector, searchVector)
+}
+
+type propertyText struct {
+	property string
+	value    string
+}
+
+// textProperties extracts the string and text values of the object ordered by
+// property name, arrays are joined into a single value
+func textProperties(schema interface{}) []propertyText {
+	props, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var out []propertyText
+	for name, value := range props {
+		var values []string
+		switch v := value.(type) {
+		case string:
+			values = append(values, v)
+		case []interface{}:
+			for _, elem := range v {
+				if asString, ok := elem.(string); ok {
+					values = append(values, asString)
+				}
+			}
+		}
+
+		text := strings.TrimSpace(strings.Join(values, " "))
+		if text == "" {
+			continue
+		}
+
+		out = append(out, propertyText{property: name, value: strings.ToLower(text)})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].property < out[j].property })
+	return out
+}
+
+func cosineSimilarity(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("vectors have different dimensions: %d vs %d",
+			len(a), len(b))
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0, nil
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
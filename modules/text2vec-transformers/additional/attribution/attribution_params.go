@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package attribution
+
+import "fmt"
+
+// maxResults limits the number of results, as each text property of each
+// result requires an additional call to the inference service
+const maxResults = 25
+
+type Params struct {
+	SearchVector []float32
+}
+
+func (p *Params) SetSearchVector(vector []float32) {
+	p.SearchVector = vector
+}
+
+func (p *Params) SetDefaultsAndValidate(inputSize int) error {
+	return p.validate(inputSize)
+}
+
+func (p *Params) validate(inputSize int) error {
+	if inputSize > maxResults {
+		return fmt.Errorf("result length %d is larger than %d items: attribution "+
+			"is only supported up to %d items, set a limit to <= %d",
+			inputSize, maxResults, maxResults, maxResults)
+	}
+
+	if len(p.SearchVector) == 0 {
+		return fmt.Errorf("no valid search vector present: attribution explains " +
+			"the similarity to the query, use it together with nearText or nearVector")
+	}
+
+	return nil
+}
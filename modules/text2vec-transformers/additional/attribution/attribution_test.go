@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package attribution
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttribution(t *testing.T) {
+	t.Run("with a single result", func(t *testing.T) {
+		remote := &fakeRemote{}
+		a := New(remote)
+		in := []search.Result{
+			{
+				Schema: map[string]interface{}{
+					"title":       "Foo",
+					"description": "bar",
+					"count":       7.0,
+				},
+			},
+		}
+
+		res, err := a.Attribute(context.Background(), in,
+			&Params{SearchVector: []float32{1, 0}})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		attr, ok := res[0].AdditionalProperties["attribution"].(*Attribution)
+		require.True(t, ok)
+		require.Len(t, attr.Properties, 2)
+		assert.Equal(t, "title", attr.Properties[0].Property)
+		assert.InDelta(t, 0.7071, attr.Properties[0].Weight, 1e-4)
+		assert.Equal(t, "description", attr.Properties[1].Property)
+		assert.InDelta(t, -0.2929, attr.Properties[1].Weight, 1e-4)
+
+		assert.ElementsMatch(t, []string{"bar foo", "foo", "bar"}, remote.inputs)
+	})
+
+	t.Run("with a result without text properties", func(t *testing.T) {
+		a := New(&fakeRemote{})
+		in := []search.Result{
+			{
+				Schema:               map[string]interface{}{"count": 7.0},
+				AdditionalProperties: models.AdditionalProperties{"id": "foo"},
+			},
+		}
+
+		res, err := a.Attribute(context.Background(), in,
+			&Params{SearchVector: []float32{1, 0}})
+		require.Nil(t, err)
+		assert.Equal(t, &Attribution{Properties: []*PropertyAttribution{}},
+			res[0].AdditionalProperties["attribution"])
+		assert.Equal(t, "foo", res[0].AdditionalProperties["id"])
+	})
+
+	t.Run("without a search vector", func(t *testing.T) {
+		a := New(&fakeRemote{})
+		in := []search.Result{{Schema: map[string]interface{}{"title": "foo"}}}
+
+		_, err := a.Attribute(context.Background(), in, &Params{})
+		assert.EqualError(t, err, "invalid params: no valid search vector present: "+
+			"attribution explains the similarity to the query, use it together "+
+			"with nearText or nearVector")
+	})
+
+	t.Run("with too many results", func(t *testing.T) {
+		a := New(&fakeRemote{})
+		in := make([]search.Result, 26)
+
+		_, err := a.Attribute(context.Background(), in,
+			&Params{SearchVector: []float32{1, 0}})
+		assert.EqualError(t, err, "invalid params: result length 26 is larger "+
+			"than 25 items: attribution is only supported up to 25 items, "+
+			"set a limit to <= 25")
+	})
+}
+
+// fakeRemote vectorizes the input as the sum of its known words
+type fakeRemote struct {
+	inputs []string
+}
+
+func (f *fakeRemote) Vectorize(ctx context.Context, input string,
+	cfg ent.VectorizationConfig) (*ent.VectorizationResult, error) {
+	f.inputs = append(f.inputs, input)
+
+	words := map[string][]float32{
+		"foo": {1, 0},
+		"bar": {0, 1},
+	}
+
+	vector := []float32{0, 0}
+	for _, word := range strings.Fields(input) {
+		for i, v := range words[word] {
+			vector[i] += v
+		}
+	}
+
+	return &ent.VectorizationResult{Vector: vector, Dimensions: 2, Text: input}, nil
+}
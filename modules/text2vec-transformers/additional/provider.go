@@ -13,20 +13,24 @@ package additional
 
 import (
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/additional/attribution"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/additional/projector"
 )
 
 type GraphQLAdditionalArgumentsProvider struct {
-	projector *projector.FeatureProjector
+	projector  *projector.FeatureProjector
+	attributor *attribution.Attributor
 }
 
-func New(projector *projector.FeatureProjector) *GraphQLAdditionalArgumentsProvider {
-	return &GraphQLAdditionalArgumentsProvider{projector}
+func New(projector *projector.FeatureProjector,
+	attributor *attribution.Attributor) *GraphQLAdditionalArgumentsProvider {
+	return &GraphQLAdditionalArgumentsProvider{projector, attributor}
 }
 
 func (p *GraphQLAdditionalArgumentsProvider) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	additionalProperties := map[string]modulecapabilities.AdditionalProperty{}
 	additionalProperties["featureProjection"] = p.getFeatureProjection()
+	additionalProperties["attribution"] = p.getAttribution()
 	return additionalProperties
 }
 
@@ -49,3 +53,15 @@ func (p *GraphQLAdditionalArgumentsProvider) getFeatureProjection() modulecapabi
 		},
 	}
 }
+
+func (p *GraphQLAdditionalArgumentsProvider) getAttribution() modulecapabilities.AdditionalProperty {
+	return modulecapabilities.AdditionalProperty{
+		DefaultValue:           p.attributor.AdditonalPropertyDefaultValue(),
+		GraphQLNames:           []string{"attribution"},
+		GraphQLFieldFunction:   additionalAttributionField,
+		GraphQLExtractFunction: p.attributor.ExtractAdditionalFn,
+		SearchFunctions: modulecapabilities.AdditionalSearch{
+			ExploreGet: p.attributor.AdditionalPropertyFn,
+		},
+	}
+}
@@ -15,13 +15,13 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/additional"
+	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/additional/attribution"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/additional/projector"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/clients"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/vectorizer"
@@ -40,6 +40,8 @@ type TransformersModule struct {
 	nearTextTransformer          modulecapabilities.TextTransform
 	logger                       logrus.FieldLogger
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	readinessChecker             modulecapabilities.ReadinessChecker
+	inferenceClient              attribution.Remote
 }
 
 type textVectorizer interface {
@@ -105,19 +107,18 @@ func (m *TransformersModule) initVectorizer(ctx context.Context,
 	}
 
 	client := clients.New(uri, logger)
-	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
-		return errors.Wrap(err, "init remote vectorizer")
-	}
-
+	m.readinessChecker = client
 	m.vectorizer = vectorizer.New(client)
 	m.metaProvider = client
+	m.inferenceClient = client
 
 	return nil
 }
 
 func (m *TransformersModule) initAdditionalPropertiesProvider() error {
 	projector := projector.New()
-	m.additionalPropertiesProvider = additional.New(projector)
+	attributor := attribution.New(m.inferenceClient)
+	m.additionalPropertiesProvider = additional.New(projector, attributor)
 	return nil
 }
 
@@ -140,9 +141,14 @@ func (m *TransformersModule) AdditionalProperties() map[string]modulecapabilitie
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+func (m *TransformersModule) CheckReady(ctx context.Context) error {
+	return m.readinessChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.ReadinessChecker(New())
 	_ = modulecapabilities.Vectorizer(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
@@ -42,6 +42,7 @@ func testGetSchemaWithoutClient(t *testing.T) {
 					"cleanupIntervalSeconds": float64(300),
 					"efConstruction":         float64(128),
 					"flatSearchCutoff":       float64(40000),
+					"segments":               float64(1),
 					"ef":                     float64(-1),
 					"maxConnections":         float64(64),
 					"vectorCacheMaxObjects":  float64(2e6),
@@ -33,6 +33,12 @@ fi
 if [[ "$*" == *--clip* ]]; then
   ADDITIONAL_SERVICES+=('multi2vec-clip')
 fi
+if [[ "$*" == *--reranker* ]]; then
+  ADDITIONAL_SERVICES+=('reranker-transformers')
+fi
+if [[ "$*" == *--sum* ]]; then
+  ADDITIONAL_SERVICES+=('sum-transformers')
+fi
 
 docker-compose -f $DOCKER_COMPOSE_FILE down --remove-orphans
 
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package admission limits how many operations of each class are executed
+// concurrently, so a burst of expensive operations, such as aggregations,
+// cannot starve cheap ones, such as reading a single object.
+package admission
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// Class groups operations of a similar cost, each class has its own limit
+type Class int
+
+const (
+	// ClassRead are cheap reads, such as object lookups by id, listing
+	// objects and queries without a vector search
+	ClassRead Class = iota
+	// ClassVectorSearch are queries which search the vector index
+	ClassVectorSearch
+	// ClassAggregation are aggregations, which potentially visit every object
+	// of a class
+	ClassAggregation
+	// ClassBatch are batch imports and batch deletes
+	ClassBatch
+
+	numClasses
+)
+
+func (c Class) String() string {
+	switch c {
+	case ClassRead:
+		return "read"
+	case ClassVectorSearch:
+		return "vector_search"
+	case ClassAggregation:
+		return "aggregation"
+	case ClassBatch:
+		return "batch"
+	default:
+		return "unknown"
+	}
+}
+
+// Controller admits operations as long as their class has a free slot.
+// Otherwise they wait in the order they arrived until a slot is released,
+// the timeout has passed or their context is cancelled. A nil Controller
+// admits every operation immediately.
+type Controller struct {
+	sync.Mutex
+	limits   [numClasses]int
+	timeout  time.Duration
+	inFlight [numClasses]int
+	waiting  [numClasses]*list.List
+	admitted [numClasses]uint64
+	rejected [numClasses]uint64
+}
+
+// New creates a Controller from the config. A limit of 0 leaves the class
+// unlimited, if all limits are 0, admission control is disabled and nil is
+// returned.
+func New(cfg config.QueryAdmission) *Controller {
+	limits := [numClasses]int{
+		ClassRead:         cfg.MaxConcurrentReads,
+		ClassVectorSearch: cfg.MaxConcurrentVectorSearches,
+		ClassAggregation:  cfg.MaxConcurrentAggregations,
+		ClassBatch:        cfg.MaxConcurrentBatches,
+	}
+
+	enabled := false
+	for _, limit := range limits {
+		if limit > 0 {
+			enabled = true
+		}
+	}
+	if !enabled {
+		return nil
+	}
+
+	c := &Controller{
+		limits:  limits,
+		timeout: time.Duration(cfg.QueueTimeoutMs) * time.Millisecond,
+	}
+	for i := range c.waiting {
+		c.waiting[i] = list.New()
+	}
+
+	return c
+}
+
+// Acquire blocks until the operation is admitted. It fails with an
+// errortypes.KindOverloaded error if no slot became free within the queue
+// timeout, or with the context's error if it was cancelled first. The
+// returned release function must be called exactly once when the operation
+// has completed.
+func (c *Controller) Acquire(ctx context.Context, class Class) (func(), error) {
+	if c == nil || c.limits[class] <= 0 {
+		return func() {}, nil
+	}
+
+	release := func() { c.release(class) }
+
+	c.Lock()
+	if c.inFlight[class] < c.limits[class] && c.waiting[class].Len() == 0 {
+		c.inFlight[class]++
+		c.admitted[class]++
+		c.Unlock()
+		return release, nil
+	}
+
+	ready := make(chan struct{})
+	elem := c.waiting[class].PushBack(ready)
+	c.Unlock()
+
+	var timeout <-chan time.Time
+	if c.timeout > 0 {
+		timer := time.NewTimer(c.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-ready:
+		return release, nil
+	case <-timeout:
+		if c.abandon(class, elem, ready) {
+			return release, nil
+		}
+		return nil, errortypes.New(errortypes.KindOverloaded,
+			"too many concurrent %s operations, no slot became free within %s",
+			class, c.timeout)
+	case <-ctx.Done():
+		if c.abandon(class, elem, ready) {
+			return release, nil
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// abandon removes a waiting operation from the queue. If it was admitted
+// concurrently, it is kept and true is returned.
+func (c *Controller) abandon(class Class, elem *list.Element,
+	ready chan struct{}) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	select {
+	case <-ready:
+		return true
+	default:
+		c.waiting[class].Remove(elem)
+		c.rejected[class]++
+		return false
+	}
+}
+
+func (c *Controller) release(class Class) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.inFlight[class]--
+	for c.inFlight[class] < c.limits[class] && c.waiting[class].Len() > 0 {
+		front := c.waiting[class].Front()
+		c.waiting[class].Remove(front)
+		c.inFlight[class]++
+		c.admitted[class]++
+		close(front.Value.(chan struct{}))
+	}
+}
+
+// Collect reports the operations in flight, the queue depth and the
+// admission counters of every class to the monitoring registry
+func (c *Controller) Collect() []*monitoring.Family {
+	if c == nil {
+		return nil
+	}
+
+	c.Lock()
+	limits := c.limits
+	inFlight := c.inFlight
+	var depth [numClasses]int
+	for class := range c.waiting {
+		depth[class] = c.waiting[class].Len()
+	}
+	admitted := c.admitted
+	rejected := c.rejected
+	c.Unlock()
+
+	limitFamily := monitoring.NewGauge("weaviate_admission_limit",
+		"Maximum number of concurrent operations, 0 is unlimited", "class")
+	inFlightFamily := monitoring.NewGauge("weaviate_admission_in_flight",
+		"Number of operations currently being executed", "class")
+	depthFamily := monitoring.NewGauge("weaviate_admission_queue_depth",
+		"Number of operations waiting for a free slot", "class")
+	admittedFamily := monitoring.NewCounter("weaviate_admission_admitted_total",
+		"Number of operations admitted", "class")
+	rejectedFamily := monitoring.NewCounter("weaviate_admission_rejected_total",
+		"Number of operations which timed out or were cancelled while waiting", "class")
+	for class := Class(0); class < numClasses; class++ {
+		limitFamily.Add(float64(limits[class]), class.String())
+		inFlightFamily.Add(float64(inFlight[class]), class.String())
+		depthFamily.Add(float64(depth[class]), class.String())
+		admittedFamily.Add(float64(admitted[class]), class.String())
+		rejectedFamily.Add(float64(rejected[class]), class.String())
+	}
+
+	return []*monitoring.Family{
+		limitFamily, inFlightFamily, depthFamily, admittedFamily, rejectedFamily,
+	}
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package admission
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController(t *testing.T) {
+	t.Run("disabled without any limit", func(t *testing.T) {
+		c := New(config.QueryAdmission{QueueTimeoutMs: 100})
+		require.Nil(t, c)
+
+		release, err := c.Acquire(context.Background(), ClassAggregation)
+		require.Nil(t, err)
+		release()
+		assert.Nil(t, c.Collect())
+	})
+
+	t.Run("classes without a limit are always admitted", func(t *testing.T) {
+		c := New(config.QueryAdmission{MaxConcurrentAggregations: 1})
+
+		release, err := c.Acquire(context.Background(), ClassAggregation)
+		require.Nil(t, err)
+		defer release()
+
+		for i := 0; i < 10; i++ {
+			_, err := c.Acquire(context.Background(), ClassRead)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("heavy classes cannot starve cheap ones", func(t *testing.T) {
+		c := New(config.QueryAdmission{
+			MaxConcurrentReads:        1,
+			MaxConcurrentAggregations: 1,
+			QueueTimeoutMs:            20,
+		})
+
+		releaseAgg, err := c.Acquire(context.Background(), ClassAggregation)
+		require.Nil(t, err)
+		defer releaseAgg()
+
+		_, err = c.Acquire(context.Background(), ClassAggregation)
+		require.NotNil(t, err)
+		assert.True(t, errortypes.Is(err, errortypes.KindOverloaded))
+
+		releaseRead, err := c.Acquire(context.Background(), ClassRead)
+		require.Nil(t, err)
+		releaseRead()
+	})
+
+	t.Run("waiting operations are admitted in order once a slot is released",
+		func(t *testing.T) {
+			c := New(config.QueryAdmission{MaxConcurrentBatches: 1})
+
+			release, err := c.Acquire(context.Background(), ClassBatch)
+			require.Nil(t, err)
+
+			admitted := make(chan int, 2)
+			for i := 0; i < 2; i++ {
+				i := i
+				go func() {
+					release, err := c.Acquire(context.Background(), ClassBatch)
+					if err == nil {
+						admitted <- i
+						release()
+					}
+				}()
+				// make sure the operations queue up in order
+				waitForQueueDepth(t, c, ClassBatch, i+1)
+			}
+
+			select {
+			case <-admitted:
+				t.Fatalf("operation admitted while all slots are taken")
+			case <-time.After(20 * time.Millisecond):
+			}
+
+			release()
+			assert.Equal(t, 0, <-admitted)
+			assert.Equal(t, 1, <-admitted)
+		})
+
+	t.Run("cancelled operations leave the queue", func(t *testing.T) {
+		c := New(config.QueryAdmission{MaxConcurrentVectorSearches: 1})
+
+		release, err := c.Acquire(context.Background(), ClassVectorSearch)
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = c.Acquire(ctx, ClassVectorSearch)
+		assert.Equal(t, context.DeadlineExceeded, err)
+
+		release()
+		release, err = c.Acquire(context.Background(), ClassVectorSearch)
+		require.Nil(t, err)
+		release()
+
+		registry := monitoring.NewRegistry()
+		registry.Register(c)
+		buf := &bytes.Buffer{}
+		require.Nil(t, registry.WriteText(buf))
+		assert.Contains(t, buf.String(),
+			`weaviate_admission_admitted_total{class="vector_search"} 2`)
+		assert.Contains(t, buf.String(),
+			`weaviate_admission_rejected_total{class="vector_search"} 1`)
+		assert.Contains(t, buf.String(),
+			`weaviate_admission_in_flight{class="vector_search"} 0`)
+	})
+}
+
+func waitForQueueDepth(t *testing.T, c *Controller, class Class, depth int) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		c.Lock()
+		current := c.waiting[class].Len()
+		c.Unlock()
+		if current == depth {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("expected %d waiting operations", depth)
+}
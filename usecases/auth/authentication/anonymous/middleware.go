@@ -51,7 +51,7 @@ func (c *Client) Middleware(next http.Handler) http.Handler {
 
 		w.WriteHeader(401)
 		w.Write([]byte(
-			`{"code":401,"message":"anonymous access not enabled, please provide an auth scheme such as OIDC"}`,
+			`{"code":401,"message":"anonymous access not enabled, please provide an auth scheme such as OIDC or an API key"}`,
 		))
 	})
 }
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	errors "github.com/go-openapi/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// Client validates static API keys and maps them to the configured users
+type Client struct {
+	config config.APIKey
+	// keys holds the hashes of the allowed keys, so they can be compared in
+	// constant time regardless of their length
+	keys [][sha256.Size]byte
+}
+
+// New API key client. It fails if the keys and users are not configured
+// correctly, so a misconfiguration is detected at startup.
+func New(cfg config.Config) (*Client, error) {
+	client := &Client{
+		config: cfg.Authentication.APIKey,
+	}
+
+	if !client.config.Enabled {
+		// as with OIDC, a disabled client is still valuable to deny any requests
+		// which try to use an API key
+		return client, nil
+	}
+
+	if err := client.validateConfig(); err != nil {
+		return nil, fmt.Errorf("invalid apikey config: %v", err)
+	}
+
+	for _, key := range client.config.AllowedKeys {
+		client.keys = append(client.keys, sha256.Sum256([]byte(key)))
+	}
+
+	return client, nil
+}
+
+func (c *Client) validateConfig() error {
+	if len(c.config.AllowedKeys) == 0 {
+		return fmt.Errorf("need at least one valid allowed key")
+	}
+
+	for _, key := range c.config.AllowedKeys {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("keys cannot be empty")
+		}
+	}
+
+	if len(c.config.Users) == 0 {
+		return fmt.Errorf("need at least one user")
+	}
+
+	if len(c.config.Users) > 1 && len(c.config.Users) != len(c.config.AllowedKeys) {
+		return fmt.Errorf("array length mismatch: there are %d keys and %d users, "+
+			"either set a single user for all keys or one user per key",
+			len(c.config.AllowedKeys), len(c.config.Users))
+	}
+
+	return nil
+}
+
+// ValidateAndExtract can be used as a middleware for go-swagger
+func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Principal, error) {
+	if !c.config.Enabled {
+		return nil, errors.New(401, "apikey auth is not configured, please try another auth scheme or set up weaviate with apikey configured")
+	}
+
+	pos, ok := c.keyPosition(token)
+	if !ok {
+		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+	}
+
+	return &models.Principal{Username: c.userForKey(pos)}, nil
+}
+
+// keyPosition compares the token against all allowed keys without returning
+// early, so the timing does not reveal which key matched or how much of it
+func (c *Client) keyPosition(token string) (int, bool) {
+	hashed := sha256.Sum256([]byte(token))
+
+	pos := -1
+	for i, key := range c.keys {
+		if subtle.ConstantTimeCompare(hashed[:], key[:]) == 1 && pos == -1 {
+			pos = i
+		}
+	}
+
+	return pos, pos >= 0
+}
+
+func (c *Client) userForKey(pos int) string {
+	if len(c.config.Users) == 1 {
+		return c.config.Users[0]
+	}
+
+	return c.config.Users[pos]
+}
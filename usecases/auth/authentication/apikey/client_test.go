@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APIKeyClient(t *testing.T) {
+	type test struct {
+		name            string
+		config          config.APIKey
+		expectConfigErr bool
+		token           string
+		expectedUser    string
+		expectAuthErr   bool
+	}
+
+	tests := []test{
+		{
+			name: "disabled",
+			config: config.APIKey{
+				Enabled: false,
+			},
+			token:         "secret-key",
+			expectAuthErr: true,
+		},
+		{
+			name: "no keys",
+			config: config.APIKey{
+				Enabled: true,
+				Users:   []string{"jane"},
+			},
+			expectConfigErr: true,
+		},
+		{
+			name: "empty key",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"secret-key", " "},
+				Users:       []string{"jane"},
+			},
+			expectConfigErr: true,
+		},
+		{
+			name: "no users",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"secret-key"},
+			},
+			expectConfigErr: true,
+		},
+		{
+			name: "user count does not match key count",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2", "key3"},
+				Users:       []string{"jane", "john"},
+			},
+			expectConfigErr: true,
+		},
+		{
+			name: "single user for all keys",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane"},
+			},
+			token:        "key2",
+			expectedUser: "jane",
+		},
+		{
+			name: "one user per key",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane", "john"},
+			},
+			token:        "key2",
+			expectedUser: "john",
+		},
+		{
+			name: "invalid key",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane", "john"},
+			},
+			token:         "key3",
+			expectAuthErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := New(config.Config{
+				Authentication: config.Authentication{APIKey: test.config},
+			})
+			if test.expectConfigErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+
+			principal, err := client.ValidateAndExtract(test.token, nil)
+			if test.expectAuthErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedUser, principal.Username)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package authorization
+
+import (
+	"sync/atomic"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+)
+
+// RuntimeConfigResource is the resource the runtime config is authorized on.
+// It can be updated in read-only mode, so that the mode can be left again.
+const RuntimeConfigResource = "config/runtime"
+
+// ReadOnly wraps another Authorizer. While read-only mode is enabled, it
+// denies everything but reads, regardless of the principal. Otherwise every
+// decision is left to the wrapped Authorizer.
+type ReadOnly struct {
+	upstream Authorizer
+	enabled  int32
+}
+
+// NewReadOnly wraps the upstream Authorizer, read-only mode starts out
+// disabled
+func NewReadOnly(upstream Authorizer) *ReadOnly {
+	return &ReadOnly{upstream: upstream}
+}
+
+// SetReadOnly enables or disables read-only mode at runtime
+func (r *ReadOnly) SetReadOnly(enabled bool) {
+	var value int32
+	if enabled {
+		value = 1
+	}
+
+	atomic.StoreInt32(&r.enabled, value)
+}
+
+// IsReadOnly indicates whether read-only mode is currently enabled
+func (r *ReadOnly) IsReadOnly() bool {
+	return atomic.LoadInt32(&r.enabled) == 1
+}
+
+// Authorize denies all verbs other than "get" and "list" in read-only mode,
+// then asks the wrapped Authorizer
+func (r *ReadOnly) Authorize(principal *models.Principal, verb, resource string) error {
+	if r.IsReadOnly() && verb != "get" && verb != "list" &&
+		resource != RuntimeConfigResource {
+		if principal == nil {
+			principal = &models.Principal{Username: "anonymous"}
+		}
+
+		return errors.NewForbidden(principal, verb, resource)
+	}
+
+	return r.upstream.Authorize(principal, verb, resource)
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReadOnlyAuthorizer(t *testing.T) {
+	authorizer := NewReadOnly(&DummyAuthorizer{})
+
+	t.Run("writes are allowed by default", func(t *testing.T) {
+		assert.Nil(t, authorizer.Authorize(nil, "create", "objects"))
+	})
+
+	authorizer.SetReadOnly(true)
+
+	t.Run("writes are denied in read-only mode", func(t *testing.T) {
+		err := authorizer.Authorize(nil, "create", "objects")
+		assert.IsType(t, errors.Forbidden{}, err)
+	})
+
+	t.Run("reads are still allowed in read-only mode", func(t *testing.T) {
+		assert.Nil(t, authorizer.Authorize(nil, "get", "objects"))
+		assert.Nil(t, authorizer.Authorize(nil, "list", "objects"))
+	})
+
+	t.Run("the runtime config can be updated in read-only mode", func(t *testing.T) {
+		assert.Nil(t, authorizer.Authorize(nil, "update", RuntimeConfigResource))
+	})
+
+	authorizer.SetReadOnly(false)
+
+	t.Run("writes are allowed again", func(t *testing.T) {
+		assert.Nil(t, authorizer.Authorize(nil, "delete", "objects"))
+	})
+}
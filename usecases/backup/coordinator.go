@@ -0,0 +1,625 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+var validBackupID = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	ShardingState(className string) *sharding.State
+	RestoreClass(ctx context.Context, principal *models.Principal,
+		class *models.Class, shardState *sharding.State) error
+}
+
+type nodeResolver interface {
+	LocalName() string
+	NodeHostname(nodeName string) (string, bool)
+}
+
+type localShards interface {
+	BackupShards(ctx context.Context, req *NodeRequest) ([]ShardDescriptor, error)
+	RestoreShards(ctx context.Context, req *NodeRequest) error
+}
+
+// RemoteShards sends node requests to other nodes of the cluster
+type RemoteShards interface {
+	BackupShards(ctx context.Context, host string,
+		req *NodeRequest) ([]ShardDescriptor, error)
+	RestoreShards(ctx context.Context, host string, req *NodeRequest) error
+}
+
+// Coordinator runs backups and restores in the background and keeps track
+// of their status. Only a single backup or restore can run at a time.
+type Coordinator struct {
+	sync.Mutex
+	authorizer authorizer
+	schema     schemaManager
+	nodes      nodeResolver
+	backends   backendProvider
+	local      localShards
+	remote     RemoteShards
+	logger     logrus.FieldLogger
+
+	inProgress bool
+	backups    map[string]*models.BackupCreateResponse
+	restores   map[string]*models.BackupRestoreResponse
+}
+
+func NewCoordinator(authorizer authorizer, schema schemaManager,
+	nodes nodeResolver, backends backendProvider, local localShards,
+	remote RemoteShards, logger logrus.FieldLogger) *Coordinator {
+	return &Coordinator{
+		authorizer: authorizer,
+		schema:     schema,
+		nodes:      nodes,
+		backends:   backends,
+		local:      local,
+		remote:     remote,
+		logger:     logger,
+		backups:    map[string]*models.BackupCreateResponse{},
+		restores:   map[string]*models.BackupRestoreResponse{},
+	}
+}
+
+// Backup validates the request and starts the backup in the background. The
+// returned status is STARTED, use BackupStatus to poll for completion.
+func (c *Coordinator) Backup(ctx context.Context, principal *models.Principal,
+	backendName string, req *models.BackupCreateRequest) (*models.BackupCreateResponse, error) {
+	err := c.authorizer.Authorize(principal, "create", "backups/"+backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validBackupID.MatchString(req.ID) {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"invalid backup id %q: must only contain lowercase letters, numbers, "+
+				"underscores and dashes", req.ID)
+	}
+
+	backend, err := c.backends.BackupBackend(backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := c.newDescriptor(backendName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.readDescriptor(ctx, backend, req.ID)
+	if err == nil {
+		return nil, errortypes.New(errortypes.KindConflict,
+			"backup %q already exists in backend %q", req.ID, backendName)
+	}
+	if !errortypes.Is(err, errortypes.KindNotFound) {
+		return nil, err
+	}
+
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+
+	if err := c.writeDescriptor(ctx, backend, desc); err != nil {
+		c.end()
+		return nil, err
+	}
+
+	status := &models.BackupCreateResponse{
+		ID:      desc.ID,
+		Backend: backendName,
+		Path:    backend.HomeDir(desc.ID),
+		Classes: desc.classNames(),
+		Status:  string(StatusStarted),
+	}
+	c.setBackupStatus(status)
+
+	go c.backup(backend, desc, *status)
+
+	return status, nil
+}
+
+// BackupStatus returns the status of a backup started by this node. Backups
+// started by other nodes or before a restart are looked up in the backend.
+func (c *Coordinator) BackupStatus(ctx context.Context,
+	principal *models.Principal, backendName,
+	id string) (*models.BackupCreateResponse, error) {
+	err := c.authorizer.Authorize(principal, "get", "backups/"+backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	status, ok := c.backups[statusKey(backendName, id)]
+	c.Unlock()
+	if ok {
+		return status, nil
+	}
+
+	backend, err := c.backends.BackupBackend(backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := c.readDescriptor(ctx, backend, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.BackupCreateResponse{
+		ID:      desc.ID,
+		Backend: backendName,
+		Path:    backend.HomeDir(desc.ID),
+		Classes: desc.classNames(),
+		Status:  string(desc.Status),
+		Error:   desc.Error,
+	}, nil
+}
+
+// Restore validates the request and starts restoring the classes of a backup
+// in the background. None of the classes may exist. The returned status is
+// STARTED, use RestoreStatus to poll for completion.
+func (c *Coordinator) Restore(ctx context.Context, principal *models.Principal,
+	backendName, id string,
+	req *models.BackupRestoreRequest) (*models.BackupRestoreResponse, error) {
+	err := c.authorizer.Authorize(principal, "create", "backups/"+backendName+"/restore")
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := c.backends.BackupBackend(backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := c.readDescriptor(ctx, backend, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if desc.Status != StatusSuccess {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"backup %q has status %s and cannot be restored", id, desc.Status)
+	}
+
+	classes, err := c.classesToRestore(desc, req.Include)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+
+	status := &models.BackupRestoreResponse{
+		ID:      id,
+		Backend: backendName,
+		Path:    backend.HomeDir(id),
+		Classes: make([]string, len(classes)),
+		Status:  string(StatusStarted),
+	}
+	for i, class := range classes {
+		status.Classes[i] = class.Name
+	}
+	c.setRestoreStatus(status)
+
+	go c.restore(principal, desc, classes, *status)
+
+	return status, nil
+}
+
+// RestoreStatus returns the status of a restore started by this node
+func (c *Coordinator) RestoreStatus(ctx context.Context,
+	principal *models.Principal, backendName,
+	id string) (*models.BackupRestoreResponse, error) {
+	err := c.authorizer.Authorize(principal, "get", "backups/"+backendName+"/restore")
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	status, ok := c.restores[statusKey(backendName, id)]
+	if !ok {
+		return nil, errortypes.New(errortypes.KindNotFound,
+			"no restore of backup %q from backend %q was started on this node",
+			id, backendName)
+	}
+
+	return status, nil
+}
+
+func (c *Coordinator) newDescriptor(backendName string,
+	req *models.BackupCreateRequest) (*Descriptor, error) {
+	sch := c.schema.GetSchemaSkipAuth()
+
+	names := req.Include
+	if len(names) == 0 && sch.Objects != nil {
+		for _, class := range sch.Objects.Classes {
+			names = append(names, class.Class)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"there are no classes to back up")
+	}
+
+	desc := &Descriptor{
+		ID:        req.ID,
+		Backend:   backendName,
+		Status:    StatusStarted,
+		StartedAt: time.Now(),
+	}
+
+	for _, name := range names {
+		class := sch.FindClassByName(schema.ClassName(name))
+		if class == nil {
+			return nil, errortypes.New(errortypes.KindValidation,
+				"class %q does not exist", name)
+		}
+
+		shardState := c.schema.ShardingState(class.Class)
+		if shardState == nil {
+			return nil, errors.Errorf("class %q has no sharding state", name)
+		}
+
+		classDesc := ClassDescriptor{
+			Name:          class.Class,
+			Schema:        class,
+			ShardingState: shardState,
+		}
+		for _, shardName := range shardState.AllPhysicalShards() {
+			classDesc.Shards = append(classDesc.Shards, ShardDescriptor{
+				Name: shardName,
+				Node: shardState.Physical[shardName].BelongsToNode,
+			})
+		}
+
+		desc.Classes = append(desc.Classes, classDesc)
+	}
+
+	return desc, nil
+}
+
+func (c *Coordinator) classesToRestore(desc *Descriptor,
+	include []string) ([]ClassDescriptor, error) {
+	names := include
+	if len(names) == 0 {
+		names = desc.classNames()
+	}
+
+	sch := c.schema.GetSchemaSkipAuth()
+
+	out := make([]ClassDescriptor, len(names))
+	for i, name := range names {
+		class := desc.class(name)
+		if class == nil {
+			return nil, errortypes.New(errortypes.KindValidation,
+				"class %q is not contained in backup %q", name, desc.ID)
+		}
+
+		if sch.FindClassByName(schema.ClassName(name)) != nil {
+			return nil, errortypes.New(errortypes.KindConflict,
+				"class %q already exists, delete it before restoring it", name)
+		}
+
+		for _, shard := range class.Shards {
+			if err := c.checkNode(shard.Node); err != nil {
+				return nil, errortypes.Wrap(errortypes.KindValidation,
+					errors.Wrapf(err, "class %q: shard %q", name, shard.Name))
+			}
+		}
+
+		out[i] = *class
+	}
+
+	return out, nil
+}
+
+func (c *Coordinator) backup(backend modulecapabilities.BackupBackend,
+	desc *Descriptor, status models.BackupCreateResponse) {
+	ctx := context.Background()
+	err := c.backupClasses(ctx, desc)
+
+	desc.CompletedAt = time.Now()
+	desc.Status = StatusSuccess
+	if err != nil {
+		desc.Status = StatusFailed
+		desc.Error = err.Error()
+	}
+
+	if err := c.writeDescriptor(ctx, backend, desc); err != nil {
+		desc.Status = StatusFailed
+		desc.Error = err.Error()
+	}
+
+	logger := c.logger.WithField("action", "backup").
+		WithField("backup_id", desc.ID).
+		WithField("backend", desc.Backend).
+		WithField("took", desc.CompletedAt.Sub(desc.StartedAt))
+	if desc.Status == StatusFailed {
+		logger.WithField("error", desc.Error).Error("backup failed")
+	} else {
+		logger.Info("backup completed")
+	}
+
+	status.Status = string(desc.Status)
+	status.Error = desc.Error
+	c.finishBackup(&status)
+}
+
+func (c *Coordinator) backupClasses(ctx context.Context, desc *Descriptor) error {
+	for i := range desc.Classes {
+		class := &desc.Classes[i]
+
+		var lock sync.Mutex
+		var shards []ShardDescriptor
+		eg := &errgroup.Group{}
+		for node, req := range nodeRequests(desc.ID, desc.Backend, class) {
+			node, req := node, req
+			eg.Go(func() error {
+				res, err := c.backupOnNode(ctx, node, req)
+				if err != nil {
+					return errors.Wrapf(err, "class %q: node %q", class.Name, node)
+				}
+
+				lock.Lock()
+				defer lock.Unlock()
+				shards = append(shards, res...)
+				return nil
+			})
+		}
+
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+
+		sort.Slice(shards, func(a, b int) bool {
+			return shards[a].Name < shards[b].Name
+		})
+		class.Shards = shards
+	}
+
+	return nil
+}
+
+func (c *Coordinator) backupOnNode(ctx context.Context, node string,
+	req *NodeRequest) ([]ShardDescriptor, error) {
+	if node == c.nodes.LocalName() {
+		return c.local.BackupShards(ctx, req)
+	}
+
+	host, ok := c.nodes.NodeHostname(node)
+	if !ok {
+		return nil, errors.Errorf("node %q is not part of the cluster", node)
+	}
+
+	return c.remote.BackupShards(ctx, host, req)
+}
+
+func (c *Coordinator) restore(principal *models.Principal, desc *Descriptor,
+	classes []ClassDescriptor, status models.BackupRestoreResponse) {
+	before := time.Now()
+	ctx := context.Background()
+	err := c.restoreClasses(ctx, principal, desc, classes)
+
+	logger := c.logger.WithField("action", "backup_restore").
+		WithField("backup_id", desc.ID).
+		WithField("backend", desc.Backend).
+		WithField("took", time.Since(before))
+
+	status.Status = string(StatusSuccess)
+	if err != nil {
+		status.Status = string(StatusFailed)
+		status.Error = err.Error()
+		logger.WithError(err).Error("restore failed")
+	} else {
+		logger.Info("restore completed")
+	}
+
+	c.finishRestore(&status)
+}
+
+func (c *Coordinator) restoreClasses(ctx context.Context,
+	principal *models.Principal, desc *Descriptor,
+	classes []ClassDescriptor) error {
+	for i := range classes {
+		class := &classes[i]
+
+		eg := &errgroup.Group{}
+		for node, req := range nodeRequests(desc.ID, desc.Backend, class) {
+			node, req := node, req
+			eg.Go(func() error {
+				if err := c.restoreOnNode(ctx, node, req); err != nil {
+					return errors.Wrapf(err, "class %q: node %q", class.Name, node)
+				}
+				return nil
+			})
+		}
+
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+
+		// only once the files are present on every node, the class can be added
+		// to the schema, which makes the nodes initialize the shards from disk
+		if err := c.schema.RestoreClass(ctx, principal, class.Schema,
+			class.ShardingState); err != nil {
+			return errors.Wrapf(err, "class %q: add to schema", class.Name)
+		}
+	}
+
+	return nil
+}
+
+func (c *Coordinator) restoreOnNode(ctx context.Context, node string,
+	req *NodeRequest) error {
+	if node == c.nodes.LocalName() {
+		return c.local.RestoreShards(ctx, req)
+	}
+
+	host, ok := c.nodes.NodeHostname(node)
+	if !ok {
+		return errors.Errorf("node %q is not part of the cluster", node)
+	}
+
+	return c.remote.RestoreShards(ctx, host, req)
+}
+
+func (c *Coordinator) checkNode(node string) error {
+	if node == c.nodes.LocalName() {
+		return nil
+	}
+
+	if _, ok := c.nodes.NodeHostname(node); !ok {
+		return errors.Errorf("node %q is not part of the cluster", node)
+	}
+
+	return nil
+}
+
+// nodeRequests groups the shards of a class by the node which owns them
+func nodeRequests(backupID, backend string,
+	class *ClassDescriptor) map[string]*NodeRequest {
+	out := map[string]*NodeRequest{}
+	for _, shard := range class.Shards {
+		req, ok := out[shard.Node]
+		if !ok {
+			req = &NodeRequest{
+				BackupID: backupID,
+				Backend:  backend,
+				Class:    class.Name,
+			}
+			out[shard.Node] = req
+		}
+
+		req.Shards = append(req.Shards, shard)
+	}
+
+	return out
+}
+
+func (c *Coordinator) begin() error {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.inProgress {
+		return errortypes.New(errortypes.KindConflict,
+			"another backup or restore is already in progress")
+	}
+
+	c.inProgress = true
+	return nil
+}
+
+func (c *Coordinator) end() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.inProgress = false
+}
+
+func (c *Coordinator) setBackupStatus(status *models.BackupCreateResponse) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.backups[statusKey(status.Backend, status.ID)] = status
+}
+
+func (c *Coordinator) setRestoreStatus(status *models.BackupRestoreResponse) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.restores[statusKey(status.Backend, status.ID)] = status
+}
+
+// finishBackup sets the final status and allows the next backup or restore
+// to start, a client polling the status can start the next one right away
+func (c *Coordinator) finishBackup(status *models.BackupCreateResponse) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.backups[statusKey(status.Backend, status.ID)] = status
+	c.inProgress = false
+}
+
+func (c *Coordinator) finishRestore(status *models.BackupRestoreResponse) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.restores[statusKey(status.Backend, status.ID)] = status
+	c.inProgress = false
+}
+
+func statusKey(backend, id string) string {
+	return fmt.Sprintf("%s/%s", backend, id)
+}
+
+func (c *Coordinator) readDescriptor(ctx context.Context,
+	backend modulecapabilities.BackupBackend, id string) (*Descriptor, error) {
+	r, err := backend.GetObject(ctx, id, descriptorKey)
+	if err != nil {
+		if errortypes.Is(err, errortypes.KindNotFound) {
+			return nil, errortypes.New(errortypes.KindNotFound,
+				"backup %q does not exist", id)
+		}
+		return nil, errors.Wrapf(err, "read descriptor of backup %q", id)
+	}
+	defer r.Close()
+
+	var desc Descriptor
+	if err := json.NewDecoder(r).Decode(&desc); err != nil {
+		return nil, errors.Wrapf(err, "decode descriptor of backup %q", id)
+	}
+
+	return &desc, nil
+}
+
+func (c *Coordinator) writeDescriptor(ctx context.Context,
+	backend modulecapabilities.BackupBackend, desc *Descriptor) error {
+	descJSON, err := json.Marshal(desc)
+	if err != nil {
+		return errors.Wrapf(err, "marshal descriptor of backup %q", desc.ID)
+	}
+
+	if err := backend.PutObject(ctx, desc.ID, descriptorKey,
+		bytes.NewReader(descJSON)); err != nil {
+		return errors.Wrapf(err, "write descriptor of backup %q", desc.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSchemaManager() *fakeSchemaManager {
+	return &fakeSchemaManager{
+		schema: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{{Class: "Article"}, {Class: "Author"}},
+			},
+		},
+		states: map[string]*sharding.State{
+			"Article": {
+				Physical: map[string]sharding.Physical{
+					"shard1": {Name: "shard1", BelongsToNode: "node1"},
+					"shard2": {Name: "shard2", BelongsToNode: "node2"},
+				},
+			},
+			"Author": {
+				Physical: map[string]sharding.Physical{
+					"shard3": {Name: "shard3", BelongsToNode: "node1"},
+				},
+			},
+		},
+	}
+}
+
+func newTestCoordinator(sm *fakeSchemaManager, backend *fakeBackend,
+	local *fakeNodeShards, remote *fakeRemoteShards) *Coordinator {
+	logger, _ := test.NewNullLogger()
+	nodes := &fakeNodes{local: "node1", hosts: map[string]string{"node2": "host2"}}
+	return NewCoordinator(&fakeAuthorizer{}, sm, nodes,
+		&fakeBackends{backend: backend}, local, remote, logger)
+}
+
+func TestCoordinatorBackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	sm := newTestSchemaManager()
+	backend := newFakeBackend()
+	local := &fakeNodeShards{}
+	remote := &fakeRemoteShards{}
+	c := newTestCoordinator(sm, backend, local, remote)
+
+	t.Run("invalid backup id", func(t *testing.T) {
+		_, err := c.Backup(ctx, nil, "fake", &models.BackupCreateRequest{ID: "My Backup"})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		_, err := c.Backup(ctx, nil, "other", &models.BackupCreateRequest{ID: "backup1"})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := c.Backup(ctx, nil, "fake", &models.BackupCreateRequest{
+			ID:      "backup1",
+			Include: []string{"Unknown"},
+		})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+
+	t.Run("back up all classes", func(t *testing.T) {
+		status, err := c.Backup(ctx, nil, "fake", &models.BackupCreateRequest{ID: "backup1"})
+		require.Nil(t, err)
+		assert.Equal(t, "STARTED", status.Status)
+		assert.Equal(t, "/backups/backup1", status.Path)
+		assert.ElementsMatch(t, []string{"Article", "Author"}, status.Classes)
+
+		assert.Eventually(t, func() bool {
+			status, err := c.BackupStatus(ctx, nil, "fake", "backup1")
+			require.Nil(t, err)
+			return status.Status == "SUCCESS"
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("the shards were backed up by the nodes which own them", func(t *testing.T) {
+		require.Len(t, local.backupRequests, 2)
+		require.Len(t, remote.backupRequests, 1)
+		assert.Equal(t, []string{"host2"}, remote.hosts)
+		assert.Equal(t, "Article", remote.backupRequests[0].Class)
+		assert.Equal(t, "shard2", remote.backupRequests[0].Shards[0].Name)
+	})
+
+	t.Run("the descriptor lists the files of every shard", func(t *testing.T) {
+		var desc Descriptor
+		require.Nil(t, json.Unmarshal(backend.objects["backup1/backup.json"], &desc))
+		assert.Equal(t, StatusSuccess, desc.Status)
+		require.Len(t, desc.Classes, 2)
+
+		article := desc.class("Article")
+		require.NotNil(t, article)
+		assert.Equal(t, []ShardDescriptor{
+			{Name: "shard1", Node: "node1", Files: []string{"shard1.db"}},
+			{Name: "shard2", Node: "node2", Files: []string{"shard2.db"}},
+		}, article.Shards)
+		assert.Equal(t, "Article", article.Schema.Class)
+		assert.Len(t, article.ShardingState.Physical, 2)
+	})
+
+	t.Run("the status of an unknown backup", func(t *testing.T) {
+		_, err := c.BackupStatus(ctx, nil, "fake", "backup2")
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+
+	t.Run("the backup id is taken", func(t *testing.T) {
+		_, err := c.Backup(ctx, nil, "fake", &models.BackupCreateRequest{ID: "backup1"})
+		assert.True(t, errortypes.Is(err, errortypes.KindConflict))
+	})
+
+	t.Run("restoring a class which still exists", func(t *testing.T) {
+		_, err := c.Restore(ctx, nil, "fake", "backup1", &models.BackupRestoreRequest{})
+		assert.True(t, errortypes.Is(err, errortypes.KindConflict))
+	})
+
+	t.Run("restoring a class which is not part of the backup", func(t *testing.T) {
+		_, err := c.Restore(ctx, nil, "fake", "backup1", &models.BackupRestoreRequest{
+			Include: []string{"Unknown"},
+		})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+
+	t.Run("restore a deleted class", func(t *testing.T) {
+		sm.Lock()
+		sm.schema.Objects.Classes = sm.schema.Objects.Classes[:1]
+		sm.Unlock()
+
+		status, err := c.Restore(ctx, nil, "fake", "backup1", &models.BackupRestoreRequest{
+			Include: []string{"Author"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "STARTED", status.Status)
+		assert.Equal(t, []string{"Author"}, status.Classes)
+
+		assert.Eventually(t, func() bool {
+			status, err := c.RestoreStatus(ctx, nil, "fake", "backup1")
+			require.Nil(t, err)
+			return status.Status == "SUCCESS"
+		}, time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, []string{"Author"}, sm.restored)
+		require.Len(t, local.restoreRequests, 1)
+		assert.Equal(t, []ShardDescriptor{
+			{Name: "shard3", Node: "node1", Files: []string{"shard3.db"}},
+		}, local.restoreRequests[0].Shards)
+		assert.Len(t, remote.restoreRequests, 0)
+	})
+
+	t.Run("the status of an unknown restore", func(t *testing.T) {
+		_, err := c.RestoreStatus(ctx, nil, "fake", "backup2")
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+}
+
+func TestCoordinatorFailedBackup(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeBackend()
+	remote := &fakeRemoteShards{}
+	remote.err = errors.New("node is down")
+	c := newTestCoordinator(newTestSchemaManager(), backend, &fakeNodeShards{},
+		remote)
+
+	_, err := c.Backup(ctx, nil, "fake", &models.BackupCreateRequest{ID: "backup1"})
+	require.Nil(t, err)
+
+	var status *models.BackupCreateResponse
+	assert.Eventually(t, func() bool {
+		status, err = c.BackupStatus(ctx, nil, "fake", "backup1")
+		require.Nil(t, err)
+		return status.Status != "STARTED"
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "FAILED", status.Status)
+	assert.Contains(t, status.Error, "node is down")
+
+	t.Run("a failed backup cannot be restored", func(t *testing.T) {
+		_, err := c.Restore(ctx, nil, "fake", "backup1", &models.BackupRestoreRequest{})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+
+	t.Run("a new backup can be started", func(t *testing.T) {
+		remote.err = nil
+		_, err := c.Backup(ctx, nil, "fake", &models.BackupCreateRequest{ID: "backup2"})
+		assert.Nil(t, err)
+	})
+}
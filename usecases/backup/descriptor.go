@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package backup creates backups of classes in a pluggable storage backend
+// and restores classes from them. A backup of a class consists of its schema,
+// its sharding state and the files of all its shards, i.e. the LSM segments,
+// the vector index commit logs and the index counter. The node which receives
+// the request coordinates the backup, every node uploads or downloads the
+// files of the shards it owns.
+package backup
+
+import (
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+type Status string
+
+const (
+	StatusStarted Status = "STARTED"
+	StatusSuccess Status = "SUCCESS"
+	StatusFailed  Status = "FAILED"
+)
+
+const (
+	// descriptorKey is the object which describes a backup, it is written
+	// when the backup starts and updated when it completes
+	descriptorKey = "backup.json"
+
+	// dataPrefix is prepended to the path of every shard file relative to the
+	// data path of the node
+	dataPrefix = "data/"
+)
+
+// Descriptor is stored alongside the files of a backup and contains
+// everything needed to restore it
+type Descriptor struct {
+	ID          string            `json:"id"`
+	Backend     string            `json:"backend"`
+	Status      Status            `json:"status"`
+	Error       string            `json:"error,omitempty"`
+	StartedAt   time.Time         `json:"startedAt"`
+	CompletedAt time.Time         `json:"completedAt,omitempty"`
+	Classes     []ClassDescriptor `json:"classes"`
+}
+
+func (d *Descriptor) classNames() []string {
+	out := make([]string, len(d.Classes))
+	for i, class := range d.Classes {
+		out[i] = class.Name
+	}
+
+	return out
+}
+
+func (d *Descriptor) class(name string) *ClassDescriptor {
+	for i := range d.Classes {
+		if d.Classes[i].Name == name {
+			return &d.Classes[i]
+		}
+	}
+
+	return nil
+}
+
+// ClassDescriptor contains the schema state of a class at the time of the
+// backup, as well as the files of its shards
+type ClassDescriptor struct {
+	Name          string            `json:"name"`
+	Schema        *models.Class     `json:"schema"`
+	ShardingState *sharding.State   `json:"shardingState"`
+	Shards        []ShardDescriptor `json:"shards"`
+}
+
+// ShardDescriptor lists the files of a shard, relative to the data path of
+// the node which owns the shard
+type ShardDescriptor struct {
+	Name  string   `json:"name"`
+	Node  string   `json:"node"`
+	Files []string `json:"files"`
+}
+
+// NodeRequest asks a single node to back up or restore its shards of a
+// class. For a restore the files of every shard must be set, for a backup
+// they are filled in by the node.
+type NodeRequest struct {
+	BackupID string            `json:"backupID"`
+	Backend  string            `json:"backend"`
+	Class    string            `json:"class"`
+	Shards   []ShardDescriptor `json:"shards"`
+}
@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+type fakeAuthorizer struct{}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb,
+	resource string) error {
+	return nil
+}
+
+type fakeBackend struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{objects: map[string][]byte{}}
+}
+
+func (b *fakeBackend) HomeDir(backupID string) string {
+	return "/backups/" + backupID
+}
+
+func (b *fakeBackend) PutObject(ctx context.Context, backupID, key string,
+	r io.Reader) error {
+	contents, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	b.objects[backupID+"/"+key] = contents
+	return nil
+}
+
+func (b *fakeBackend) GetObject(ctx context.Context, backupID,
+	key string) (io.ReadCloser, error) {
+	b.Lock()
+	defer b.Unlock()
+	contents, ok := b.objects[backupID+"/"+key]
+	if !ok {
+		return nil, errortypes.New(errortypes.KindNotFound, "not found")
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(contents)), nil
+}
+
+type fakeBackends struct {
+	backend *fakeBackend
+}
+
+func (p *fakeBackends) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	if name != "fake" {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"backup backend %q is not enabled", name)
+	}
+
+	return p.backend, nil
+}
+
+type fakeSchemaManager struct {
+	sync.Mutex
+	schema   schema.Schema
+	states   map[string]*sharding.State
+	restored []string
+}
+
+func (m *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	m.Lock()
+	defer m.Unlock()
+	return m.schema
+}
+
+func (m *fakeSchemaManager) ShardingState(className string) *sharding.State {
+	return m.states[className]
+}
+
+func (m *fakeSchemaManager) RestoreClass(ctx context.Context,
+	principal *models.Principal, class *models.Class,
+	shardState *sharding.State) error {
+	m.Lock()
+	defer m.Unlock()
+	m.restored = append(m.restored, class.Class)
+	return nil
+}
+
+type fakeNodes struct {
+	local string
+	hosts map[string]string
+}
+
+func (n *fakeNodes) LocalName() string {
+	return n.local
+}
+
+func (n *fakeNodes) NodeHostname(nodeName string) (string, bool) {
+	host, ok := n.hosts[nodeName]
+	return host, ok
+}
+
+// fakeNodeShards pretends every shard consists of a single file named after
+// the shard
+type fakeNodeShards struct {
+	sync.Mutex
+	backupRequests  []*NodeRequest
+	restoreRequests []*NodeRequest
+	err             error
+}
+
+func (s *fakeNodeShards) BackupShards(ctx context.Context,
+	req *NodeRequest) ([]ShardDescriptor, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.backupRequests = append(s.backupRequests, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	out := make([]ShardDescriptor, len(req.Shards))
+	for i, shard := range req.Shards {
+		out[i] = shard
+		out[i].Files = []string{shard.Name + ".db"}
+	}
+
+	return out, nil
+}
+
+func (s *fakeNodeShards) RestoreShards(ctx context.Context,
+	req *NodeRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	s.restoreRequests = append(s.restoreRequests, req)
+	return s.err
+}
+
+// fakeRemoteShards records which host each request was sent to
+type fakeRemoteShards struct {
+	fakeNodeShards
+	hosts []string
+}
+
+func (s *fakeRemoteShards) BackupShards(ctx context.Context, host string,
+	req *NodeRequest) ([]ShardDescriptor, error) {
+	s.Lock()
+	s.hosts = append(s.hosts, host)
+	s.Unlock()
+	return s.fakeNodeShards.BackupShards(ctx, req)
+}
+
+func (s *fakeRemoteShards) RestoreShards(ctx context.Context, host string,
+	req *NodeRequest) error {
+	s.Lock()
+	s.hosts = append(s.hosts, host)
+	s.Unlock()
+	return s.fakeNodeShards.RestoreShards(ctx, req)
+}
+
+type fakeShardSource struct {
+	files map[string][]string
+	ended []string
+}
+
+func (s *fakeShardSource) BeginShardBackup(ctx context.Context, className,
+	shardName string) ([]string, error) {
+	files, ok := s.files[shardName]
+	if !ok {
+		return nil, errortypes.New(errortypes.KindNotFound, "no such shard")
+	}
+
+	return files, nil
+}
+
+func (s *fakeShardSource) EndShardBackup(className, shardName string) error {
+	s.ended = append(s.ended, shardName)
+	return nil
+}
@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/iothrottle"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/sirupsen/logrus"
+)
+
+type shardSource interface {
+	BeginShardBackup(ctx context.Context, className, shardName string) ([]string, error)
+	EndShardBackup(className, shardName string) error
+}
+
+type backendProvider interface {
+	BackupBackend(name string) (modulecapabilities.BackupBackend, error)
+}
+
+// Shards backs up and restores the shards owned by the local node. It is
+// called by the coordinator, either directly if the coordinator runs on the
+// same node or through the cluster API.
+type Shards struct {
+	source   shardSource
+	backends backendProvider
+	dataPath string
+	logger   logrus.FieldLogger
+
+	// throttle limits the rate at which shard files are read and written, so
+	// that a backup or restore cannot saturate the disk. It may be nil.
+	throttle *iothrottle.Throttle
+}
+
+func NewShards(source shardSource, backends backendProvider, dataPath string,
+	throttle *iothrottle.Throttle, logger logrus.FieldLogger) *Shards {
+	return &Shards{
+		source:   source,
+		backends: backends,
+		dataPath: dataPath,
+		throttle: throttle,
+		logger:   logger,
+	}
+}
+
+// BackupShards uploads the files of every requested shard and returns the
+// shards with their files set
+func (s *Shards) BackupShards(ctx context.Context,
+	req *NodeRequest) ([]ShardDescriptor, error) {
+	backend, err := s.backends.BackupBackend(req.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ShardDescriptor, len(req.Shards))
+	for i, shard := range req.Shards {
+		files, err := s.backupShard(ctx, backend, req.BackupID, req.Class,
+			shard.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "class %q: shard %q", req.Class, shard.Name)
+		}
+
+		out[i] = ShardDescriptor{Name: shard.Name, Node: shard.Node, Files: files}
+	}
+
+	return out, nil
+}
+
+func (s *Shards) backupShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, backupID, className,
+	shardName string) ([]string, error) {
+	files, err := s.source.BeginShardBackup(ctx, className, shardName)
+	if err != nil {
+		return nil, errors.Wrap(err, "begin backup")
+	}
+	defer func() {
+		if err := s.source.EndShardBackup(className, shardName); err != nil {
+			s.logger.WithField("action", "backup_end_shard").
+				WithField("class", className).
+				WithField("shard", shardName).
+				WithError(err).
+				Error("could not resume shard maintenance after backup")
+		}
+	}()
+
+	for _, file := range files {
+		if err := s.uploadFile(ctx, backend, backupID, file); err != nil {
+			return nil, errors.Wrapf(err, "upload %q", file)
+		}
+	}
+
+	return files, nil
+}
+
+func (s *Shards) uploadFile(ctx context.Context,
+	backend modulecapabilities.BackupBackend, backupID, file string) error {
+	path, err := s.localPath(file)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return backend.PutObject(ctx, backupID, dataPrefix+file,
+		iothrottle.NewReader(ctx, f, s.throttle, iothrottle.PriorityBackup))
+}
+
+// RestoreShards downloads the files of every requested shard to the data
+// path. It must be called before the class is added to the schema, so the
+// shards pick up the files when they are initialized.
+func (s *Shards) RestoreShards(ctx context.Context, req *NodeRequest) error {
+	backend, err := s.backends.BackupBackend(req.Backend)
+	if err != nil {
+		return err
+	}
+
+	for _, shard := range req.Shards {
+		for _, file := range shard.Files {
+			if err := s.downloadFile(ctx, backend, req.BackupID, file); err != nil {
+				return errors.Wrapf(err, "class %q: shard %q: download %q",
+					req.Class, shard.Name, file)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Shards) downloadFile(ctx context.Context,
+	backend modulecapabilities.BackupBackend, backupID, file string) error {
+	path, err := s.localPath(file)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return errortypes.New(errortypes.KindConflict,
+			"file %q already exists", file)
+	}
+
+	r, err := backend.GetObject(ctx, backupID, dataPrefix+file)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so that a failed restore never leaves
+	// a partial file which could be mistaken for a complete one
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := iothrottle.NewWriter(ctx, f, s.throttle, iothrottle.PriorityBackup)
+	if _, err := io.Copy(w, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// localPath makes sure a file from a backup descriptor can never be written
+// outside of the data path
+func (s *Shards) localPath(file string) (string, error) {
+	root := filepath.Clean(s.dataPath)
+	path := filepath.Join(root, filepath.FromSlash(file))
+	if !strings.HasPrefix(path, root+string(filepath.Separator)) {
+		return "", errortypes.New(errortypes.KindValidation,
+			"file %q is outside of the data path", file)
+	}
+
+	return path, nil
+}
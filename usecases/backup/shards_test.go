@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardsBackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	sourceDir, err := ioutil.TempDir("", "backup-source")
+	require.Nil(t, err)
+	defer os.RemoveAll(sourceDir)
+
+	targetDir, err := ioutil.TempDir("", "backup-target")
+	require.Nil(t, err)
+	defer os.RemoveAll(targetDir)
+
+	files := []string{"abc_shard1_lsm/objects/segment-1.db", "abc_shard1.indexcount"}
+	for _, file := range files {
+		path := filepath.Join(sourceDir, file)
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o777))
+		require.Nil(t, ioutil.WriteFile(path, []byte(file), 0o666))
+	}
+
+	logger, _ := test.NewNullLogger()
+	backends := &fakeBackends{backend: newFakeBackend()}
+	source := &fakeShardSource{files: map[string][]string{"shard1": files}}
+
+	var shards []ShardDescriptor
+	t.Run("back up a shard", func(t *testing.T) {
+		s := NewShards(source, backends, sourceDir, nil, logger)
+		shards, err = s.BackupShards(ctx, &NodeRequest{
+			BackupID: "backup1",
+			Backend:  "fake",
+			Class:    "Article",
+			Shards:   []ShardDescriptor{{Name: "shard1", Node: "node1"}},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []ShardDescriptor{
+			{Name: "shard1", Node: "node1", Files: files},
+		}, shards)
+		assert.Equal(t, []string{"shard1"}, source.ended,
+			"maintenance of the shard must be resumed")
+		assert.Equal(t, []byte(files[0]),
+			backends.backend.objects["backup1/data/"+files[0]])
+	})
+
+	t.Run("back up an unknown shard", func(t *testing.T) {
+		s := NewShards(source, backends, sourceDir, nil, logger)
+		_, err := s.BackupShards(ctx, &NodeRequest{
+			BackupID: "backup1",
+			Backend:  "fake",
+			Class:    "Article",
+			Shards:   []ShardDescriptor{{Name: "shard2", Node: "node1"}},
+		})
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+
+	t.Run("restore the shard", func(t *testing.T) {
+		s := NewShards(source, backends, targetDir, nil, logger)
+		err := s.RestoreShards(ctx, &NodeRequest{
+			BackupID: "backup1",
+			Backend:  "fake",
+			Class:    "Article",
+			Shards:   shards,
+		})
+		require.Nil(t, err)
+
+		for _, file := range files {
+			contents, err := ioutil.ReadFile(filepath.Join(targetDir, file))
+			require.Nil(t, err)
+			assert.Equal(t, file, string(contents))
+		}
+	})
+
+	t.Run("existing files are never overwritten", func(t *testing.T) {
+		s := NewShards(source, backends, targetDir, nil, logger)
+		err := s.RestoreShards(ctx, &NodeRequest{
+			BackupID: "backup1",
+			Backend:  "fake",
+			Class:    "Article",
+			Shards:   shards,
+		})
+		assert.True(t, errortypes.Is(err, errortypes.KindConflict))
+	})
+
+	t.Run("files outside of the data path are rejected", func(t *testing.T) {
+		s := NewShards(source, backends, targetDir, nil, logger)
+		err := s.RestoreShards(ctx, &NodeRequest{
+			BackupID: "backup1",
+			Backend:  "fake",
+			Class:    "Article",
+			Shards: []ShardDescriptor{
+				{Name: "shard1", Files: []string{"../outside.db"}},
+			},
+		})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -29,6 +30,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
@@ -54,7 +56,7 @@ func singleShardState() *sharding.State {
 	}
 
 	s, err := sharding.InitState("test-index", config,
-		fakeNodes{[]string{"node1"}})
+		fakeNodes{[]string{"node1"}}, 1)
 	if err != nil {
 		panic(err)
 	}
@@ -332,8 +334,16 @@ func (f *fakeRemoteClient) Exists(ctx context.Context, hostName, indexName,
 	return false, nil
 }
 
+func (f *fakeRemoteClient) DeleteObject(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID) error {
+	return nil
+}
+
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
+	shardName string, vector []float32, vectors [][]float32,
+	keywordRanking *searchparams.KeywordRanking,
+	sparseRanking *searchparams.SparseRanking, limit int,
+	filters *filters.LocalFilter, cursor *filters.Cursor, sort []filters.Sort,
 	additional additional.Properties) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -358,6 +368,38 @@ func (f *fakeRemoteClient) Aggregate(ctx context.Context, hostName, indexName,
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) FacetedSearch(ctx context.Context, hostName, indexName,
+	shardName string, params aggregation.FacetParams) ([]*storobj.Object,
+	*aggregation.FacetResult, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeRemoteClient) EstimateFilter(ctx context.Context, hostName, indexName,
+	shardName string, params filters.EstimateParams) (*filters.Estimate, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) ContinuousAggregate(ctx context.Context, hostName, indexName,
+	shardName string, params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) FindDocIDs(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter) ([]uint64, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) DeleteObjectBatch(ctx context.Context, hostName, indexName,
+	shardName string, docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) ScrollShard(ctx context.Context, hostName, indexName,
+	shardName, id string, ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, string, error) {
+	return nil, "", nil
+}
+
 type fakeNodeResolver struct{}
 
 func (f *fakeNodeResolver) NodeHostname(string) (string, bool) {
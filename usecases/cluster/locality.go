@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package cluster
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/memberlist"
+)
+
+// maxLocalityLength keeps the encoded labels well within the metadata limit
+// of memberlist.MetaMaxSize
+const maxLocalityLength = 256
+
+// Locality places a node in the infrastructure, so that reads can prefer
+// replicas which are close by. Both labels are optional.
+type Locality struct {
+	Zone string `json:"zone,omitempty"`
+	Rack string `json:"rack,omitempty"`
+}
+
+// Distance ranks how far away the other node is from the point of view of a
+// node at l. A different zone weighs more than a different rack, labels
+// which are not set on l are ignored.
+func (l Locality) Distance(other Locality) int {
+	dist := 0
+	if l.Zone != "" && l.Zone != other.Zone {
+		dist += 2
+	}
+
+	if l.Rack != "" && l.Rack != other.Rack {
+		dist++
+	}
+
+	return dist
+}
+
+// localityDelegate gossips the locality of the local node as its metadata,
+// it does not make use of any of the other delegate features
+type localityDelegate struct {
+	meta []byte
+}
+
+func newLocalityDelegate(locality Locality) (*localityDelegate, error) {
+	meta, err := json.Marshal(locality)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localityDelegate{meta: meta}, nil
+}
+
+func (d *localityDelegate) NodeMeta(limit int) []byte {
+	if len(d.meta) > limit {
+		return nil
+	}
+
+	return d.meta
+}
+
+func (d *localityDelegate) NotifyMsg([]byte) {}
+
+func (d *localityDelegate) GetBroadcasts(overhead, limit int) [][]byte {
+	return nil
+}
+
+func (d *localityDelegate) LocalState(join bool) []byte {
+	return nil
+}
+
+func (d *localityDelegate) MergeRemoteState(buf []byte, join bool) {}
+
+func parseLocality(meta []byte) Locality {
+	var locality Locality
+	if len(meta) == 0 {
+		return locality
+	}
+
+	// a node which sends metadata we do not understand is treated like a node
+	// without labels
+	json.Unmarshal(meta, &locality)
+	return locality
+}
+
+// LocalLocality returns the zone and rack labels of the local node
+func (s *State) LocalLocality() Locality {
+	return parseLocality(s.list.LocalNode().Meta)
+}
+
+// NodeLocality returns the zone and rack labels of a live member, it is
+// false if the node is not a member
+func (s *State) NodeLocality(nodeName string) (Locality, bool) {
+	for _, mem := range s.list.Members() {
+		if mem.Name == nodeName {
+			return parseLocality(mem.Meta), true
+		}
+	}
+
+	return Locality{}, false
+}
+
+var _ = memberlist.Delegate(&localityDelegate{})
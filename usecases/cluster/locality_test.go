@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalityDistance(t *testing.T) {
+	local := Locality{Zone: "eu-west-1a", Rack: "r1"}
+
+	tests := []struct {
+		name     string
+		other    Locality
+		expected int
+	}{
+		{"same zone and rack", Locality{Zone: "eu-west-1a", Rack: "r1"}, 0},
+		{"same zone, other rack", Locality{Zone: "eu-west-1a", Rack: "r2"}, 1},
+		{"other zone, same rack", Locality{Zone: "eu-west-1b", Rack: "r1"}, 2},
+		{"other zone and rack", Locality{Zone: "eu-west-1b", Rack: "r2"}, 3},
+		{"without labels", Locality{}, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, local.Distance(test.other))
+		})
+	}
+
+	t.Run("labels which are not set locally are ignored", func(t *testing.T) {
+		zoneOnly := Locality{Zone: "eu-west-1a"}
+		assert.Equal(t, 0, zoneOnly.Distance(Locality{Zone: "eu-west-1a", Rack: "r2"}))
+		assert.Equal(t, 2, zoneOnly.Distance(Locality{Zone: "eu-west-1b", Rack: "r2"}))
+	})
+}
+
+func TestLocalityMeta(t *testing.T) {
+	delegate, err := newLocalityDelegate(Locality{Zone: "eu-west-1a", Rack: "r1"})
+	assert.Nil(t, err)
+
+	meta := delegate.NodeMeta(512)
+	assert.Equal(t, Locality{Zone: "eu-west-1a", Rack: "r1"}, parseLocality(meta))
+	assert.Nil(t, delegate.NodeMeta(4))
+	assert.Equal(t, Locality{}, parseLocality([]byte("not json")))
+}
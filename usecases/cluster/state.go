@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/memberlist"
 	"github.com/pkg/errors"
@@ -30,6 +31,36 @@ type Config struct {
 	GossipBindPort int    `json:"gossipBindPort" yaml:"gossipBindPort"`
 	DataBindPort   int    `json:"dataBindPort" yaml:"dataBindPort"`
 	Join           string `json:"join" yaml:"join"`
+
+	// Zone and Rack label the node, so that reads are served by a replica in
+	// the same zone if possible, see Locality
+	Zone string `json:"zone" yaml:"zone"`
+	Rack string `json:"rack" yaml:"rack"`
+}
+
+// Validate the ports, a port of 0 means the default is used
+func (c Config) Validate() error {
+	for name, port := range map[string]int{
+		"gossipBindPort": c.GossipBindPort,
+		"dataBindPort":   c.DataBindPort,
+	} {
+		if port < 0 || port > 65535 {
+			return errors.Errorf("cluster.%s must be between 0 and 65535, got %d",
+				name, port)
+		}
+	}
+
+	if c.GossipBindPort != 0 && c.GossipBindPort == c.DataBindPort {
+		return errors.Errorf("cluster.gossipBindPort and cluster.dataBindPort "+
+			"must not be the same, got %d", c.GossipBindPort)
+	}
+
+	if len(c.Zone)+len(c.Rack) > maxLocalityLength {
+		return errors.Errorf("cluster.zone and cluster.rack must not be longer "+
+			"than %d characters combined", maxLocalityLength)
+	}
+
+	return nil
 }
 
 func Init(userConfig Config, logger logrus.FieldLogger) (*State, error) {
@@ -44,6 +75,15 @@ func Init(userConfig Config, logger logrus.FieldLogger) (*State, error) {
 		cfg.BindPort = userConfig.GossipBindPort
 	}
 
+	delegate, err := newLocalityDelegate(Locality{
+		Zone: userConfig.Zone,
+		Rack: userConfig.Rack,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "encode node locality")
+	}
+	cfg.Delegate = delegate
+
 	list, err := memberlist.Create(cfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "create member list")
@@ -140,3 +180,10 @@ func (s *State) NodeHostname(nodeName string) (string, bool) {
 
 	return "", false
 }
+
+// Leave announces to the other members that this node is leaving the
+// cluster, so they remove it right away instead of waiting for it to become
+// unreachable. The node does not rejoin afterwards.
+func (s *State) Leave(timeout time.Duration) error {
+	return s.list.Leave(timeout)
+}
@@ -13,18 +13,33 @@ package cluster
 
 import (
 	"context"
-	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/sirupsen/logrus"
 )
 
 type TransactionType string
 
+const (
+	// DefaultTransactionTTL is the time a transaction can stay open before it
+	// is aborted automatically. It needs to cover broadcasting the transaction
+	// to every node, as well as committing it everywhere.
+	DefaultTransactionTTL = 60 * time.Second
+
+	// abortTimeout limits the abort broadcast. Aborts are sent independently
+	// of the context of the original request, as it is typically the
+	// expiration of that context which requires the abort.
+	abortTimeout = 10 * time.Second
+)
+
 var (
-	ErrConcurrentTransaction = errors.New("concurrent transaction")
+	ErrConcurrentTransaction = errortypes.New(errortypes.KindConflict, "concurrent transaction")
 	ErrInvalidTransaction    = errors.New("invalid transaction")
+	ErrExpiredTransaction    = errors.New("transaction expired")
 )
 
 type Remote interface {
@@ -40,16 +55,38 @@ type TxManager struct {
 	currentTransaction *Transaction
 	remote             Remote
 	commitFn           CommitFn
+	logger             logrus.FieldLogger
+	ttl                time.Duration
+
+	// expiry aborts the current transaction once it exceeds its deadline, so
+	// that a transaction whose coordinator (or any other participant) is gone
+	// can't block all future transactions.
+	expiry *time.Timer
+
+	// coordinating indicates that the current transaction was started by this
+	// node, committing is set while the commit is broadcast
+	coordinating bool
+	committing   bool
 }
 
-func NewTxManager(remote Remote) *TxManager {
-	return &TxManager{remote: remote}
+func NewTxManager(remote Remote, logger logrus.FieldLogger) *TxManager {
+	return &TxManager{
+		remote: remote,
+		logger: logger,
+		ttl:    DefaultTransactionTTL,
+	}
 }
 
 func (c *TxManager) SetCommitFn(fn CommitFn) {
 	c.commitFn = fn
 }
 
+// SetTTL overrides the DefaultTransactionTTL for transactions started by this
+// node. Incoming transactions use the deadline set by their coordinator.
+func (c *TxManager) SetTTL(ttl time.Duration) {
+	c.ttl = ttl
+}
+
 func (c *TxManager) BeginTransaction(ctx context.Context, trType TransactionType,
 	payload interface{}) (*Transaction, error) {
 	c.Lock()
@@ -59,30 +96,33 @@ func (c *TxManager) BeginTransaction(ctx context.Context, trType TransactionType
 		return nil, ErrConcurrentTransaction
 	}
 
-	c.currentTransaction = &Transaction{
-		Type:    trType,
-		ID:      uuid.New().String(),
-		Payload: payload,
+	tx := &Transaction{
+		Type:     trType,
+		ID:       uuid.New().String(),
+		Payload:  payload,
+		Deadline: time.Now().Add(c.ttl),
 	}
+	c.setCurrentTransaction(tx, true)
 	c.Unlock()
 
-	if err := c.remote.BroadcastTransaction(ctx, c.currentTransaction); err != nil {
+	// no participant is going to wait for this transaction past its deadline,
+	// so neither should we
+	ctx, cancel := context.WithDeadline(ctx, tx.Deadline)
+	defer cancel()
+
+	if err := c.remote.BroadcastTransaction(ctx, tx); err != nil {
 		// we could not open the transaction on every node, therefore we need to
 		// abort it everywhere.
-
-		if err := c.remote.BroadcastAbortTransaction(ctx, c.currentTransaction); err != nil {
-			// TODO WARN with structured logging
-			fmt.Println(err)
-		}
+		c.broadcastAbort(tx)
 
 		c.Lock()
-		c.currentTransaction = nil
+		c.clearCurrentTransaction(tx.ID)
 		c.Unlock()
 
 		return nil, errors.Wrap(err, "broadcast open transaction")
 	}
 
-	return c.currentTransaction, nil
+	return tx, nil
 }
 
 func (c *TxManager) CommitTransaction(ctx context.Context, tx *Transaction) error {
@@ -92,24 +132,35 @@ func (c *TxManager) CommitTransaction(ctx context.Context, tx *Transaction) erro
 		return ErrInvalidTransaction
 	}
 
+	if c.currentTransaction.expired() {
+		// the participants have given up on the transaction already or are
+		// about to, committing it now would only succeed on some of them
+		c.clearCurrentTransaction(tx.ID)
+		c.Unlock()
+		c.broadcastAbort(tx)
+		return ErrExpiredTransaction
+	}
+
+	c.committing = true
+	deadline := c.currentTransaction.Deadline
 	c.Unlock()
 
 	// now that we know we are dealing with a valid transaction: no  matter the
 	// outcome, after this call, we should not have a local transaction anymore
 	defer func() {
 		c.Lock()
-		c.currentTransaction = nil
+		c.clearCurrentTransaction(tx.ID)
 		c.Unlock()
 	}()
 
-	if err := c.remote.BroadcastCommitTransaction(ctx, tx); err != nil {
-		// we could not open the transaction on every node, therefore we need to
-		// abort it everywhere.
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
 
-		if err := c.remote.BroadcastAbortTransaction(ctx, tx); err != nil {
-			// TODO WARN with structured logging
-			fmt.Println(err)
-		}
+	if err := c.remote.BroadcastCommitTransaction(ctx, tx); err != nil {
+		// we could not commit the transaction on every node, therefore we need
+		// to abort it everywhere. Nodes which have committed already ignore the
+		// abort, they are reconciled with the rest of the cluster on startup.
+		c.broadcastAbort(tx)
 
 		return errors.Wrap(err, "broadcast commit transaction")
 	}
@@ -122,11 +173,18 @@ func (c *TxManager) IncomingBeginTransaction(ctx context.Context,
 	c.Lock()
 	defer c.Unlock()
 
-	if c.currentTransaction != nil && c.currentTransaction.ID != tx.ID {
+	if c.currentTransaction != nil && c.currentTransaction.ID != tx.ID &&
+		!c.currentTransaction.expired() {
 		return ErrConcurrentTransaction
 	}
 
-	c.currentTransaction = tx
+	if tx.Deadline.IsZero() {
+		// the coordinator did not set a deadline, the transaction must still
+		// not stay open forever
+		tx.Deadline = time.Now().Add(c.ttl)
+	}
+
+	c.setCurrentTransaction(tx, false)
 	return nil
 }
 
@@ -140,7 +198,7 @@ func (c *TxManager) IncomingAbortTransaction(ctx context.Context,
 		return
 	}
 
-	c.currentTransaction = nil
+	c.clearCurrentTransaction(tx.ID)
 }
 
 func (c *TxManager) IncomingCommitTransaction(ctx context.Context,
@@ -152,6 +210,11 @@ func (c *TxManager) IncomingCommitTransaction(ctx context.Context,
 		return ErrInvalidTransaction
 	}
 
+	if c.currentTransaction.expired() {
+		c.clearCurrentTransaction(tx.ID)
+		return ErrExpiredTransaction
+	}
+
 	// use transaction from cache, not passed in for two reason: a.) protect
 	// against the transaction being manipulated after being created, b.) allow
 	// an "empty" transaction that only contains the id for less network overhead
@@ -159,16 +222,96 @@ func (c *TxManager) IncomingCommitTransaction(ctx context.Context,
 	// opened - ever node has a copy of the payload now)
 	err := c.commitFn(ctx, c.currentTransaction)
 	if err != nil {
+		// the transaction stays open, so the coordinator can still abort it. If
+		// it doesn't, it expires.
 		return err
 	}
 
-	// TODO: only clean up on success - does this make sense?
-	c.currentTransaction = nil
+	c.clearCurrentTransaction(tx.ID)
 	return nil
 }
 
+// setCurrentTransaction must be called with the lock held
+func (c *TxManager) setCurrentTransaction(tx *Transaction, coordinating bool) {
+	if c.expiry != nil {
+		c.expiry.Stop()
+	}
+
+	c.currentTransaction = tx
+	c.coordinating = coordinating
+	c.committing = false
+	c.expiry = time.AfterFunc(time.Until(tx.Deadline), func() {
+		c.expire(tx)
+	})
+}
+
+// clearCurrentTransaction must be called with the lock held, it is a no-op if
+// the specified transaction is no longer the current one
+func (c *TxManager) clearCurrentTransaction(id string) {
+	if c.currentTransaction == nil || c.currentTransaction.ID != id {
+		return
+	}
+
+	if c.expiry != nil {
+		c.expiry.Stop()
+		c.expiry = nil
+	}
+
+	c.currentTransaction = nil
+	c.coordinating = false
+	c.committing = false
+}
+
+func (c *TxManager) expire(tx *Transaction) {
+	c.Lock()
+	if c.currentTransaction == nil || c.currentTransaction.ID != tx.ID {
+		c.Unlock()
+		return
+	}
+
+	// a commit in progress is bounded by the same deadline, it aborts the
+	// transaction itself if it fails
+	abort := c.coordinating && !c.committing
+	coordinating := c.coordinating
+	c.clearCurrentTransaction(tx.ID)
+	c.Unlock()
+
+	c.logger.WithField("action", "transaction_expired").
+		WithField("id", tx.ID).
+		WithField("type", tx.Type).
+		WithField("coordinator", coordinating).
+		Warn("transaction was neither committed nor aborted before its deadline, " +
+			"aborting it")
+
+	if abort {
+		c.broadcastAbort(tx)
+	}
+}
+
+func (c *TxManager) broadcastAbort(tx *Transaction) {
+	ctx, cancel := context.WithTimeout(context.Background(), abortTimeout)
+	defer cancel()
+
+	if err := c.remote.BroadcastAbortTransaction(ctx, tx); err != nil {
+		// nodes which missed the abort are going to let the transaction expire
+		c.logger.WithField("action", "broadcast_abort_transaction").
+			WithField("id", tx.ID).
+			WithField("type", tx.Type).
+			WithError(err).
+			Warn("could not abort transaction on every node")
+	}
+}
+
 type Transaction struct {
 	ID      string
 	Type    TransactionType
 	Payload interface{}
+
+	// Deadline is set by the coordinator, every participant aborts the
+	// transaction if it has not been committed by then
+	Deadline time.Time
+}
+
+func (t *Transaction) expired() bool {
+	return !t.Deadline.IsZero() && time.Now().After(t.Deadline)
 }
@@ -13,18 +13,22 @@ package cluster
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestSuccesfulOutgoingTransaction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
 	payload := "my-payload"
 	trType := TransactionType("my-type")
 	ctx := context.Background()
 
-	man := NewTxManager(&fakeBroadcaster{})
+	man := NewTxManager(&fakeBroadcaster{}, logger)
 
 	tx, err := man.BeginTransaction(ctx, trType, payload)
 	require.Nil(t, err)
@@ -34,11 +38,12 @@ func TestSuccesfulOutgoingTransaction(t *testing.T) {
 }
 
 func TestTryingToOpenTwoTransactions(t *testing.T) {
+	logger, _ := test.NewNullLogger()
 	payload := "my-payload"
 	trType := TransactionType("my-type")
 	ctx := context.Background()
 
-	man := NewTxManager(&fakeBroadcaster{})
+	man := NewTxManager(&fakeBroadcaster{}, logger)
 
 	tx1, err := man.BeginTransaction(ctx, trType, payload)
 	require.Nil(t, err)
@@ -53,11 +58,12 @@ func TestTryingToOpenTwoTransactions(t *testing.T) {
 }
 
 func TestTryingToCommitInvalidTransaction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
 	payload := "my-payload"
 	trType := TransactionType("my-type")
 	ctx := context.Background()
 
-	man := NewTxManager(&fakeBroadcaster{})
+	man := NewTxManager(&fakeBroadcaster{}, logger)
 
 	tx1, err := man.BeginTransaction(ctx, trType, payload)
 	require.Nil(t, err)
@@ -73,6 +79,7 @@ func TestTryingToCommitInvalidTransaction(t *testing.T) {
 }
 
 func TestRemoteDoesntAllowOpeningTransaction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
 	payload := "my-payload"
 	trType := TransactionType("my-type")
 	ctx := context.Background()
@@ -80,17 +87,68 @@ func TestRemoteDoesntAllowOpeningTransaction(t *testing.T) {
 		openErr: ErrConcurrentTransaction,
 	}
 
-	man := NewTxManager(broadcaster)
+	man := NewTxManager(broadcaster, logger)
 
 	tx1, err := man.BeginTransaction(ctx, trType, payload)
 	require.Nil(t, tx1)
 	require.NotNil(t, err)
 	assert.Contains(t, err.Error(), "open transaction")
 
-	assert.Len(t, broadcaster.abortCalledId, 36, "a valid uuid was aborted")
+	assert.Len(t, broadcaster.abortedID(), 36, "a valid uuid was aborted")
+}
+
+func TestCommittingExpiredTransaction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+	broadcaster := &fakeBroadcaster{}
+
+	man := NewTxManager(broadcaster, logger)
+	man.SetTTL(10 * time.Millisecond)
+
+	tx, err := man.BeginTransaction(ctx, TransactionType("my-type"), "my-payload")
+	require.Nil(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	err = man.CommitTransaction(ctx, tx)
+	assert.NotNil(t, err)
+	assert.Equal(t, tx.ID, broadcaster.abortedID(), "expired tx was aborted")
+
+	_, err = man.BeginTransaction(ctx, TransactionType("my-type"), "my-payload")
+	assert.Nil(t, err, "expired tx no longer blocks new transactions")
+}
+
+func TestIncomingTransactionExpires(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	man := NewTxManager(&fakeBroadcaster{}, logger)
+	man.SetCommitFn(func(ctx context.Context, tx *Transaction) error {
+		return nil
+	})
+
+	abandoned := &Transaction{
+		ID:       "abandoned",
+		Deadline: time.Now().Add(10 * time.Millisecond),
+	}
+	require.Nil(t, man.IncomingBeginTransaction(ctx, abandoned))
+
+	err := man.IncomingBeginTransaction(ctx, &Transaction{ID: "concurrent"})
+	assert.Equal(t, ErrConcurrentTransaction, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	err = man.IncomingCommitTransaction(ctx, &Transaction{ID: "abandoned"})
+	assert.Equal(t, ErrInvalidTransaction, err)
+
+	next := &Transaction{ID: "next"}
+	require.Nil(t, man.IncomingBeginTransaction(ctx, next))
+	assert.False(t, next.Deadline.IsZero(), "a deadline is always set")
+	require.Nil(t, man.IncomingCommitTransaction(ctx, &Transaction{ID: "next"}))
 }
 
 type fakeBroadcaster struct {
+	sync.Mutex
 	openErr       error
 	commitErr     error
 	abortCalledId string
@@ -103,25 +161,34 @@ func (f *fakeBroadcaster) BroadcastTransaction(ctx context.Context,
 
 func (f *fakeBroadcaster) BroadcastAbortTransaction(ctx context.Context,
 	tx *Transaction) error {
+	f.Lock()
+	defer f.Unlock()
 	f.abortCalledId = tx.ID
 	return nil
 }
 
+func (f *fakeBroadcaster) abortedID() string {
+	f.Lock()
+	defer f.Unlock()
+	return f.abortCalledId
+}
+
 func (f *fakeBroadcaster) BroadcastCommitTransaction(ctx context.Context,
 	tx *Transaction) error {
 	return f.commitErr
 }
 
 func TestSuccessfulDistributedTransaction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
 	ctx := context.Background()
 
 	var remoteState interface{}
-	remote := NewTxManager(&fakeBroadcaster{})
+	remote := NewTxManager(&fakeBroadcaster{}, logger)
 	remote.SetCommitFn(func(ctx context.Context, tx *Transaction) error {
 		remoteState = tx.Payload
 		return nil
 	})
-	local := NewTxManager(&wrapTxManagerAsBroadcaster{remote})
+	local := NewTxManager(&wrapTxManagerAsBroadcaster{remote}, logger)
 
 	payload := "my-payload"
 	trType := TransactionType("my-type")
@@ -136,15 +203,16 @@ func TestSuccessfulDistributedTransaction(t *testing.T) {
 }
 
 func TestConcurrentDistributedTransaction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
 	ctx := context.Background()
 
 	var remoteState interface{}
-	remote := NewTxManager(&fakeBroadcaster{})
+	remote := NewTxManager(&fakeBroadcaster{}, logger)
 	remote.SetCommitFn(func(ctx context.Context, tx *Transaction) error {
 		remoteState = tx.Payload
 		return nil
 	})
-	local := NewTxManager(&wrapTxManagerAsBroadcaster{remote})
+	local := NewTxManager(&wrapTxManagerAsBroadcaster{remote}, logger)
 
 	payload := "my-payload"
 	trType := TransactionType("my-type")
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clustering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultProperty      = "cluster"
+	DefaultMaxIterations = 100
+)
+
+// Clusterer groups the objects of a class into k clusters of similar vectors
+// in the background. The cluster of each object is stored in an int property
+// of the class, the centroids are part of the clustering status.
+type Clusterer struct {
+	schemaManager schemaManager
+	repo          Repo
+	vectorRepo    VectorRepo
+	authorizer    authorizer
+	logger        logrus.FieldLogger
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	AddClassProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
+}
+
+// Repo to manage clustering state, not used to store the cluster assignments
+// themselves, those are stored on the objects
+type Repo interface {
+	Put(ctx context.Context, clustering models.Clustering) error
+	Get(ctx context.Context, id strfmt.UUID) (*models.Clustering, error)
+}
+
+type VectorRepo interface {
+	ObjectCursorSearch(ctx context.Context, className string,
+		cursor filters.Cursor, limit int,
+		additional additional.Properties) (search.Results, error)
+	BatchPutObjects(ctx context.Context, objs objects.BatchObjects) (objects.BatchObjects, error)
+}
+
+func New(sm schemaManager, repo Repo, vr VectorRepo, authorizer authorizer,
+	logger logrus.FieldLogger) *Clusterer {
+	return &Clusterer{
+		schemaManager: sm,
+		repo:          repo,
+		vectorRepo:    vr,
+		authorizer:    authorizer,
+		logger:        logger,
+	}
+}
+
+func (c *Clusterer) Schedule(ctx context.Context, principal *models.Principal,
+	params models.Clustering) (*models.Clustering, error) {
+	err := c.authorizer.Authorize(principal, "create", "clusterings/*")
+	if err != nil {
+		return nil, err
+	}
+
+	setDefaults(&params)
+
+	prop, err := c.validate(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid clustering")
+	}
+
+	if prop == nil {
+		if err := c.addProperty(ctx, principal, params); err != nil {
+			return nil, errors.Wrapf(err, "add property '%s'", params.Property)
+		}
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("clustering: assign id: %v", err)
+	}
+
+	params.ID = strfmt.UUID(id.String())
+	params.Status = models.ClusteringStatusRunning
+	params.Meta = &models.ClusteringMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+
+	if err := c.repo.Put(ctx, params); err != nil {
+		return nil, fmt.Errorf("clustering: put: %v", err)
+	}
+
+	go c.run(params)
+
+	return &params, nil
+}
+
+func (c *Clusterer) Get(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (*models.Clustering, error) {
+	err := c.authorizer.Authorize(principal, "get", "clusterings/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return c.repo.Get(ctx, id)
+}
+
+func setDefaults(params *models.Clustering) {
+	if params.Property == "" {
+		params.Property = DefaultProperty
+	}
+
+	if params.MaxIterations == 0 {
+		params.MaxIterations = DefaultMaxIterations
+	}
+
+	if params.IndexProperty == nil {
+		indexed := true
+		params.IndexProperty = &indexed
+	}
+
+	params.Centroids = nil
+	params.Error = ""
+}
+
+// validate the params against the current schema. The property which will
+// hold the clusters is returned if it already exists.
+func (c *Clusterer) validate(params models.Clustering) (*models.Property, error) {
+	if params.Class == "" {
+		return nil, errors.New("field 'class' is required")
+	}
+
+	if params.K < 2 {
+		return nil, errors.Errorf("field 'k' must be at least 2, got %d", params.K)
+	}
+
+	if params.MaxIterations < 1 {
+		return nil, errors.Errorf("field 'maxIterations' must be at least 1, got %d",
+			params.MaxIterations)
+	}
+
+	s := c.schemaManager.GetSchemaSkipAuth()
+	class := s.FindClassByName(schema.ClassName(params.Class))
+	if class == nil {
+		return nil, errors.Errorf("class '%s' not found in schema", params.Class)
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name != params.Property {
+			continue
+		}
+
+		if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeInt) {
+			return nil, errors.Errorf("property '%s' must be of type int, got %v",
+				prop.Name, prop.DataType)
+		}
+
+		return prop, nil
+	}
+
+	return nil, nil
+}
+
+func (c *Clusterer) addProperty(ctx context.Context, principal *models.Principal,
+	params models.Clustering) error {
+	return c.schemaManager.AddClassProperty(ctx, principal, params.Class,
+		&models.Property{
+			Name:          params.Property,
+			DataType:      []string{string(schema.DataTypeInt)},
+			Description:   "cluster of the object, set by a clustering",
+			IndexInverted: params.IndexProperty,
+		})
+}
@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clustering
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/sirupsen/logrus"
+)
+
+// pageSize is the number of objects which are read and written at once
+const pageSize = 100
+
+func (c *Clusterer) run(params models.Clustering) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	c.logBase(params, "clustering_begin").Debug("clustering started")
+
+	params, err := c.runClustering(ctx, params,
+		rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		c.failRunWithError(params, err)
+		return
+	}
+
+	c.succeedRun(params)
+}
+
+// runClustering clusters the vectors of the class, stores the cluster of each
+// object and returns the params with the centroids and meta info set
+func (c *Clusterer) runClustering(ctx context.Context, params models.Clustering,
+	rnd *rand.Rand) (models.Clustering, error) {
+	ids, vectors, err := c.loadVectors(ctx, params.Class)
+	if err != nil {
+		return params, errors.Wrap(err, "load vectors")
+	}
+
+	res, err := kmeans(vectors, int(params.K), int(params.MaxIterations), rnd)
+	if err != nil {
+		return params, err
+	}
+
+	assignments := make(map[strfmt.UUID]int, len(ids))
+	for i, id := range ids {
+		assignments[id] = res.assignments[i]
+	}
+
+	count, err := c.storeAssignments(ctx, params, assignments, res.centroids)
+	if err != nil {
+		return params, errors.Wrap(err, "store clusters")
+	}
+
+	params.Centroids = make([]*models.ClusteringCentroid, len(res.centroids))
+	for i, centroid := range res.centroids {
+		params.Centroids[i] = &models.ClusteringCentroid{
+			Cluster: int64(i),
+			Size:    int64(res.sizes[i]),
+			Vector:  centroid,
+		}
+	}
+	params.Meta.Count = count
+	params.Meta.Iterations = int64(res.iterations)
+	params.Meta.Completed = strfmt.DateTime(time.Now())
+
+	return params, nil
+}
+
+// loadVectors reads the ids and vectors of all objects of the class. Objects
+// without a vector can't be clustered and are skipped.
+func (c *Clusterer) loadVectors(ctx context.Context,
+	className string) ([]strfmt.UUID, [][]float32, error) {
+	var ids []strfmt.UUID
+	var vectors [][]float32
+
+	err := c.forEachPage(ctx, className, func(page search.Results) error {
+		for _, obj := range page {
+			if len(obj.Vector) == 0 {
+				continue
+			}
+			ids = append(ids, obj.ID)
+			vectors = append(vectors, obj.Vector)
+		}
+		return nil
+	})
+
+	return ids, vectors, err
+}
+
+// storeAssignments writes the cluster of each object to the clustering's
+// property. Objects which were added after the vectors were loaded are put
+// into the cluster with the closest centroid. It returns the number of
+// objects which were assigned a cluster.
+func (c *Clusterer) storeAssignments(ctx context.Context, params models.Clustering,
+	assignments map[strfmt.UUID]int, centroids [][]float32) (int64, error) {
+	var count int64
+
+	err := c.forEachPage(ctx, params.Class, func(page search.Results) error {
+		batch := make(objects.BatchObjects, 0, len(page))
+		for _, obj := range page {
+			if len(obj.Vector) == 0 {
+				continue
+			}
+
+			cluster, ok := assignments[obj.ID]
+			if !ok {
+				cluster, _ = nearestCentroid(centroids, normalize(obj.Vector))
+			}
+
+			props, ok := obj.Schema.(map[string]interface{})
+			if !ok || props == nil {
+				props = map[string]interface{}{}
+			}
+			props[params.Property] = int64(cluster)
+			obj.Schema = props
+
+			batch = append(batch, objects.BatchObject{
+				UUID:          obj.ID,
+				Object:        obj.Object(),
+				Vector:        obj.Vector,
+				OriginalIndex: len(batch),
+			})
+		}
+
+		if len(batch) == 0 {
+			return nil
+		}
+
+		res, err := c.vectorRepo.BatchPutObjects(ctx, batch)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range res {
+			if obj.Err != nil {
+				return errors.Wrapf(obj.Err, "object %s", obj.UUID)
+			}
+		}
+
+		count += int64(len(batch))
+		return nil
+	})
+
+	return count, err
+}
+
+func (c *Clusterer) forEachPage(ctx context.Context, className string,
+	fn func(page search.Results) error) error {
+	cursor := filters.Cursor{}
+	for {
+		page, err := c.vectorRepo.ObjectCursorSearch(ctx, className, cursor,
+			pageSize, additional.Properties{Vector: true})
+		if err != nil {
+			return err
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		if len(page) < pageSize {
+			return nil
+		}
+		cursor.After = page[len(page)-1].ID.String()
+	}
+}
+
+func (c *Clusterer) succeedRun(params models.Clustering) {
+	params.Status = models.ClusteringStatusCompleted
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := c.repo.Put(ctx, params); err != nil {
+		c.logBase(params, "clustering_failed").WithError(err).
+			Error("store succeeded run")
+	}
+	c.logBase(params, "clustering_finish").Debug("clustering completed")
+}
+
+func (c *Clusterer) failRunWithError(params models.Clustering, err error) {
+	params.Status = models.ClusteringStatusFailed
+	params.Error = fmt.Sprintf("clustering failed: %v", err)
+	if err := c.repo.Put(context.Background(), params); err != nil {
+		c.logBase(params, "clustering_failed").WithError(err).
+			Error("store failed run")
+	}
+	c.logBase(params, "clustering_finish").Debug("clustering failed")
+}
+
+func (c *Clusterer) logBase(params models.Clustering, event string) *logrus.Entry {
+	return c.logger.WithField("action", "clustering_run").
+		WithField("event", event).
+		WithField("id", params.ID).
+		WithField("class", params.Class).
+		WithField("k", params.K)
+}
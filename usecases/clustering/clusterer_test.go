@@ -0,0 +1,304 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clustering
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	testhelper "github.com/semi-technologies/weaviate/test/helper"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newNullLogger() *logrus.Logger {
+	log, _ := test.NewNullLogger()
+	return log
+}
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{
+							Name:     "title",
+							DataType: []string{string(schema.DataTypeString)},
+						},
+						{
+							Name:     "category",
+							DataType: []string{string(schema.DataTypeString)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	idFoodOne     strfmt.UUID = "06a1e824-889c-4649-97f9-1ed3fa401d8e"
+	idFoodTwo     strfmt.UUID = "6402e649-b1e0-40ea-b192-a64eab0d5e56"
+	idPoliticsOne strfmt.UUID = "75ba35af-6a08-40ae-b442-3bec69b355f9"
+	idPoliticsTwo strfmt.UUID = "f850439a-d3cd-4f17-8fbf-5a64405645cd"
+	idSocietyOne  strfmt.UUID = "a2bbcbdc-76e1-477d-9e72-a6d2cfb50109"
+	idSocietyTwo  strfmt.UUID = "069410c3-4b9e-4f68-8034-32a066cb7997"
+	idNoVector    strfmt.UUID = "8c2e6a3b-4f1d-4a52-9c1e-2b7d0f4e9a11"
+)
+
+func testData() search.Results {
+	obj := func(id strfmt.UUID, vector []float32) search.Result {
+		return search.Result{
+			ID:        id,
+			ClassName: "Article",
+			Vector:    vector,
+			Schema: map[string]interface{}{
+				"title": string(id),
+			},
+		}
+	}
+
+	return search.Results{
+		obj(idFoodOne, []float32{1, 0.1, 0}),
+		obj(idFoodTwo, []float32{1, 0, 0.1}),
+		obj(idPoliticsOne, []float32{0, 1, 0.1}),
+		obj(idPoliticsTwo, []float32{0.1, 1, 0}),
+		obj(idSocietyOne, []float32{0.1, 0, 1}),
+		obj(idSocietyTwo, []float32{0, 0.1, 1}),
+		obj(idNoVector, nil),
+	}
+}
+
+func Test_Clusterer(t *testing.T) {
+	t.Run("with valid params", func(t *testing.T) {
+		sm := &fakeSchemaManager{schema: testSchema()}
+		repo := newFakeClusteringRepo()
+		vectorRepo := newFakeVectorRepo(testData())
+		clusterer := New(sm, repo, vectorRepo, &fakeAuthorizer{}, newNullLogger())
+
+		var id strfmt.UUID
+
+		t.Run("scheduling a clustering", func(t *testing.T) {
+			c, err := clusterer.Schedule(context.Background(), nil, models.Clustering{
+				Class: "Article",
+				K:     3,
+			})
+			require.Nil(t, err)
+			require.NotNil(t, c)
+
+			assert.Len(t, c.ID, 36, "an id was assigned")
+			assert.Equal(t, DefaultProperty, c.Property)
+			assert.Equal(t, int64(DefaultMaxIterations), c.MaxIterations)
+			id = c.ID
+		})
+
+		t.Run("the property was added to the class", func(t *testing.T) {
+			current := sm.GetSchemaSkipAuth()
+			class := current.FindClassByName("Article")
+			require.Len(t, class.Properties, 3)
+			prop := class.Properties[2]
+			assert.Equal(t, DefaultProperty, prop.Name)
+			assert.Equal(t, []string{string(schema.DataTypeInt)}, prop.DataType)
+			require.NotNil(t, prop.IndexInverted)
+			assert.True(t, *prop.IndexInverted)
+		})
+
+		t.Run("the status is eventually completed", func(t *testing.T) {
+			testhelper.AssertEventuallyEqual(t, models.ClusteringStatusCompleted,
+				func() interface{} {
+					c, err := clusterer.Get(context.Background(), nil, id)
+					require.Nil(t, err)
+					require.NotNil(t, c)
+					return c.Status
+				})
+		})
+
+		t.Run("the centroids and meta info are set", func(t *testing.T) {
+			c, err := clusterer.Get(context.Background(), nil, id)
+			require.Nil(t, err)
+			require.NotNil(t, c)
+
+			assert.Equal(t, "", c.Error)
+			require.Len(t, c.Centroids, 3)
+			for i, centroid := range c.Centroids {
+				assert.Equal(t, int64(i), centroid.Cluster)
+				assert.Equal(t, int64(2), centroid.Size)
+				assert.Len(t, centroid.Vector, 3)
+			}
+
+			require.NotNil(t, c.Meta)
+			assert.Equal(t, int64(6), c.Meta.Count)
+			assert.GreaterOrEqual(t, c.Meta.Iterations, int64(1))
+			assert.False(t, time.Time(c.Meta.Completed).Before(time.Time(c.Meta.Started)))
+		})
+
+		t.Run("the objects were assigned their clusters", func(t *testing.T) {
+			cluster := func(id strfmt.UUID) interface{} {
+				props, ok := vectorRepo.get(id).Schema.(map[string]interface{})
+				require.True(t, ok)
+				return props[DefaultProperty]
+			}
+
+			assert.Equal(t, cluster(idFoodOne), cluster(idFoodTwo))
+			assert.Equal(t, cluster(idPoliticsOne), cluster(idPoliticsTwo))
+			assert.Equal(t, cluster(idSocietyOne), cluster(idSocietyTwo))
+			assert.NotEqual(t, cluster(idFoodOne), cluster(idPoliticsOne))
+			assert.NotEqual(t, cluster(idFoodOne), cluster(idSocietyOne))
+			assert.NotEqual(t, cluster(idPoliticsOne), cluster(idSocietyOne))
+			assert.IsType(t, int64(0), cluster(idFoodOne))
+			assert.Nil(t, cluster(idNoVector), "objects without a vector are skipped")
+		})
+	})
+
+	t.Run("with an existing int property", func(t *testing.T) {
+		sch := testSchema()
+		class := sch.FindClassByName("Article")
+		class.Properties = append(class.Properties, &models.Property{
+			Name:     "topic",
+			DataType: []string{string(schema.DataTypeInt)},
+		})
+		sm := &fakeSchemaManager{schema: sch}
+		repo := newFakeClusteringRepo()
+		vectorRepo := newFakeVectorRepo(testData())
+		clusterer := New(sm, repo, vectorRepo, &fakeAuthorizer{}, newNullLogger())
+
+		c, err := clusterer.Schedule(context.Background(), nil, models.Clustering{
+			Class:    "Article",
+			K:        2,
+			Property: "topic",
+		})
+		require.Nil(t, err)
+		require.NotNil(t, c)
+		current := sm.GetSchemaSkipAuth()
+		assert.Len(t, current.FindClassByName("Article").Properties, 3,
+			"no property was added")
+
+		testhelper.AssertEventuallyEqual(t, models.ClusteringStatusCompleted,
+			func() interface{} {
+				c, err := clusterer.Get(context.Background(), nil, c.ID)
+				require.Nil(t, err)
+				return c.Status
+			})
+
+		props := vectorRepo.get(idFoodOne).Schema.(map[string]interface{})
+		assert.IsType(t, int64(0), props["topic"])
+	})
+
+	t.Run("with invalid params", func(t *testing.T) {
+		type test struct {
+			name          string
+			params        models.Clustering
+			expectedError string
+		}
+
+		tests := []test{
+			{
+				name:          "without a class",
+				params:        models.Clustering{K: 2},
+				expectedError: "invalid clustering: field 'class' is required",
+			},
+			{
+				name:          "with a class which doesn't exist",
+				params:        models.Clustering{Class: "Foo", K: 2},
+				expectedError: "invalid clustering: class 'Foo' not found in schema",
+			},
+			{
+				name:          "with k below 2",
+				params:        models.Clustering{Class: "Article", K: 1},
+				expectedError: "invalid clustering: field 'k' must be at least 2, got 1",
+			},
+			{
+				name:          "with negative max iterations",
+				params:        models.Clustering{Class: "Article", K: 2, MaxIterations: -1},
+				expectedError: "invalid clustering: field 'maxIterations' must be at least 1, got -1",
+			},
+			{
+				name:          "with a property which isn't an int",
+				params:        models.Clustering{Class: "Article", K: 2, Property: "category"},
+				expectedError: "invalid clustering: property 'category' must be of type int, got [string]",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				sm := &fakeSchemaManager{schema: testSchema()}
+				clusterer := New(sm, newFakeClusteringRepo(), newFakeVectorRepo(testData()),
+					&fakeAuthorizer{}, newNullLogger())
+
+				_, err := clusterer.Schedule(context.Background(), nil, test.params)
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			})
+		}
+	})
+
+	t.Run("with fewer objects than clusters", func(t *testing.T) {
+		sm := &fakeSchemaManager{schema: testSchema()}
+		repo := newFakeClusteringRepo()
+		clusterer := New(sm, repo, newFakeVectorRepo(testData()), &fakeAuthorizer{},
+			newNullLogger())
+
+		c, err := clusterer.Schedule(context.Background(), nil, models.Clustering{
+			Class: "Article",
+			K:     7,
+		})
+		require.Nil(t, err)
+
+		testhelper.AssertEventuallyEqual(t, models.ClusteringStatusFailed,
+			func() interface{} {
+				c, err := clusterer.Get(context.Background(), nil, c.ID)
+				require.Nil(t, err)
+				return c.Status
+			})
+
+		c, err = clusterer.Get(context.Background(), nil, c.ID)
+		require.Nil(t, err)
+		assert.Equal(t, "clustering failed: need at least k=7 vectors, got 6", c.Error)
+	})
+
+	t.Run("when storing the clusters fails", func(t *testing.T) {
+		sm := &fakeSchemaManager{schema: testSchema()}
+		repo := newFakeClusteringRepo()
+		vectorRepo := newFakeVectorRepo(testData())
+		vectorRepo.putErr = errors.New("something went wrong")
+		clusterer := New(sm, repo, vectorRepo, &fakeAuthorizer{}, newNullLogger())
+
+		c, err := clusterer.Schedule(context.Background(), nil, models.Clustering{
+			Class: "Article",
+			K:     3,
+		})
+		require.Nil(t, err)
+
+		testhelper.AssertEventuallyEqual(t, models.ClusteringStatusFailed,
+			func() interface{} {
+				c, err := clusterer.Get(context.Background(), nil, c.ID)
+				require.Nil(t, err)
+				return c.Status
+			})
+
+		c, err = clusterer.Get(context.Background(), nil, c.ID)
+		require.Nil(t, err)
+		assert.Contains(t, c.Error, "store clusters")
+		assert.Contains(t, c.Error, "something went wrong")
+		assert.Nil(t, c.Centroids)
+	})
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clustering
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+)
+
+type fakeClusteringRepo struct {
+	sync.Mutex
+	db map[strfmt.UUID]models.Clustering
+}
+
+func newFakeClusteringRepo() *fakeClusteringRepo {
+	return &fakeClusteringRepo{
+		db: map[strfmt.UUID]models.Clustering{},
+	}
+}
+
+func (f *fakeClusteringRepo) Put(ctx context.Context, c models.Clustering) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.db[c.ID] = c
+	return nil
+}
+
+func (f *fakeClusteringRepo) Get(ctx context.Context, id strfmt.UUID) (*models.Clustering, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	c, ok := f.db[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &c, nil
+}
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeSchemaManager struct {
+	sync.Mutex
+	schema schema.Schema
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.schema
+}
+
+func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property) error {
+	f.Lock()
+	defer f.Unlock()
+
+	c := f.schema.FindClassByName(schema.ClassName(class))
+	c.Properties = append(c.Properties, property)
+	return nil
+}
+
+// fakeVectorRepo holds the objects of a single class ordered by id, like the
+// cursor search of the real repo
+type fakeVectorRepo struct {
+	sync.Mutex
+	objects search.Results
+	putErr  error
+}
+
+func newFakeVectorRepo(objs search.Results) *fakeVectorRepo {
+	sort.Slice(objs, func(a, b int) bool { return objs[a].ID < objs[b].ID })
+	return &fakeVectorRepo{objects: objs}
+}
+
+func (f *fakeVectorRepo) ObjectCursorSearch(ctx context.Context, className string,
+	cursor filters.Cursor, limit int,
+	additional additional.Properties) (search.Results, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out search.Results
+	for _, obj := range f.objects {
+		if obj.ID.String() <= cursor.After {
+			continue
+		}
+		if len(out) == limit {
+			break
+		}
+		out = append(out, obj)
+	}
+
+	return out, nil
+}
+
+func (f *fakeVectorRepo) BatchPutObjects(ctx context.Context,
+	batch objects.BatchObjects) (objects.BatchObjects, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	for i, obj := range batch {
+		if f.putErr != nil {
+			batch[i].Err = f.putErr
+			continue
+		}
+
+		for j := range f.objects {
+			if f.objects[j].ID == obj.UUID {
+				f.objects[j].Schema = obj.Object.Properties
+			}
+		}
+	}
+
+	return batch, nil
+}
+
+func (f *fakeVectorRepo) get(id strfmt.UUID) search.Result {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, obj := range f.objects {
+		if obj.ID == id {
+			return obj
+		}
+	}
+
+	return search.Result{}
+}
@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clustering
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/pkg/errors"
+)
+
+type kmeansResult struct {
+	// assignments holds the cluster of each input vector, in the same order
+	assignments []int
+	centroids   [][]float32
+	sizes       []int
+	iterations  int
+}
+
+// kmeans groups the vectors into k clusters using Lloyd's algorithm with a
+// k-means++ initialization. The vectors are normalized first, so that the
+// clusters reflect the cosine distance that is used in the vector index.
+func kmeans(vectors [][]float32, k, maxIterations int,
+	rnd *rand.Rand) (*kmeansResult, error) {
+	if k < 1 {
+		return nil, errors.Errorf("k must be at least 1, got %d", k)
+	}
+
+	if len(vectors) < k {
+		return nil, errors.Errorf("need at least k=%d vectors, got %d", k, len(vectors))
+	}
+
+	dims := len(vectors[0])
+	normalized := make([][]float32, len(vectors))
+	for i, vec := range vectors {
+		if len(vec) != dims {
+			return nil, errors.Errorf("vector %d has %d dimensions, expected %d",
+				i, len(vec), dims)
+		}
+		normalized[i] = normalize(vec)
+	}
+
+	res := &kmeansResult{
+		assignments: make([]int, len(vectors)),
+		centroids:   initCentroids(normalized, k, rnd),
+		sizes:       make([]int, k),
+	}
+
+	for i := range res.assignments {
+		res.assignments[i] = -1
+	}
+
+	for res.iterations < maxIterations {
+		res.iterations++
+		changed := assign(normalized, res)
+		if reseeded := updateCentroids(normalized, res); reseeded {
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// initCentroids picks the initial centroids with k-means++: the first one is
+// picked at random, every further one is picked with a probability
+// proportional to its squared distance to the closest centroid so far.
+func initCentroids(vectors [][]float32, k int, rnd *rand.Rand) [][]float32 {
+	centroids := make([][]float32, 0, k)
+	centroids = append(centroids, copyVector(vectors[rnd.Intn(len(vectors))]))
+
+	dists := make([]float64, len(vectors))
+	for len(centroids) < k {
+		var sum float64
+		for i, vec := range vectors {
+			_, dist := nearestCentroid(centroids, vec)
+			dists[i] = float64(dist)
+			sum += dists[i]
+		}
+
+		if sum == 0 {
+			// all remaining vectors are identical to a centroid, any pick is as
+			// good as another one
+			centroids = append(centroids, copyVector(vectors[rnd.Intn(len(vectors))]))
+			continue
+		}
+
+		target := rnd.Float64() * sum
+		picked := len(vectors) - 1
+		for i, dist := range dists {
+			target -= dist
+			if target <= 0 {
+				picked = i
+				break
+			}
+		}
+		centroids = append(centroids, copyVector(vectors[picked]))
+	}
+
+	return centroids
+}
+
+// assign moves every vector to its closest centroid and reports whether any
+// vector changed its cluster
+func assign(vectors [][]float32, res *kmeansResult) bool {
+	changed := false
+	for i := range res.sizes {
+		res.sizes[i] = 0
+	}
+
+	for i, vec := range vectors {
+		cluster, _ := nearestCentroid(res.centroids, vec)
+		if res.assignments[i] != cluster {
+			res.assignments[i] = cluster
+			changed = true
+		}
+		res.sizes[cluster]++
+	}
+
+	return changed
+}
+
+// updateCentroids sets every centroid to the mean of its vectors. A cluster
+// which ended up empty is restarted with the vector that is furthest away
+// from its own centroid, in which case true is returned.
+func updateCentroids(vectors [][]float32, res *kmeansResult) bool {
+	reseeded := false
+	dims := len(vectors[0])
+	for c := range res.centroids {
+		res.centroids[c] = make([]float32, dims)
+	}
+
+	for i, vec := range vectors {
+		centroid := res.centroids[res.assignments[i]]
+		for d := range vec {
+			centroid[d] += vec[d]
+		}
+	}
+
+	for c, centroid := range res.centroids {
+		if res.sizes[c] == 0 {
+			continue
+		}
+		for d := range centroid {
+			centroid[d] /= float32(res.sizes[c])
+		}
+	}
+
+	for c := range res.centroids {
+		if res.sizes[c] > 0 {
+			continue
+		}
+
+		furthest, furthestDist := -1, float32(-1)
+		for i, vec := range vectors {
+			if res.sizes[res.assignments[i]] < 2 {
+				continue
+			}
+			dist := squaredDistance(res.centroids[res.assignments[i]], vec)
+			if dist > furthestDist {
+				furthest, furthestDist = i, dist
+			}
+		}
+
+		if furthest == -1 {
+			continue
+		}
+
+		res.sizes[res.assignments[furthest]]--
+		res.assignments[furthest] = c
+		res.sizes[c] = 1
+		res.centroids[c] = copyVector(vectors[furthest])
+		reseeded = true
+	}
+
+	return reseeded
+}
+
+func nearestCentroid(centroids [][]float32, vec []float32) (int, float32) {
+	nearest, nearestDist := 0, float32(math.MaxFloat32)
+	for c, centroid := range centroids {
+		dist := squaredDistance(centroid, vec)
+		if dist < nearestDist {
+			nearest, nearestDist = c, dist
+		}
+	}
+
+	return nearest, nearestDist
+}
+
+func squaredDistance(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		diff := a[i] - b[i]
+		sum += diff * diff
+	}
+	return sum
+}
+
+func normalize(vec []float32) []float32 {
+	var norm float64
+	for _, v := range vec {
+		norm += float64(v) * float64(v)
+	}
+
+	out := make([]float32, len(vec))
+	if norm == 0 {
+		return out
+	}
+
+	norm = math.Sqrt(norm)
+	for i, v := range vec {
+		out[i] = float32(float64(v) / norm)
+	}
+	return out
+}
+
+func copyVector(vec []float32) []float32 {
+	out := make([]float32, len(vec))
+	copy(out, vec)
+	return out
+}
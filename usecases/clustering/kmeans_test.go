@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clustering
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMeans(t *testing.T) {
+	t.Run("with well separated groups", func(t *testing.T) {
+		vectors := [][]float32{
+			{1, 0.1, 0},
+			{0, 1, 0.1},
+			{1, 0, 0.1},
+			{0.1, 0, 1},
+			{0, 0.1, 1},
+			{0.1, 1, 0},
+			{1, 0.05, 0.05},
+		}
+
+		res, err := kmeans(vectors, 3, 100, rand.New(rand.NewSource(7)))
+		require.Nil(t, err)
+
+		a := res.assignments
+		assert.Equal(t, a[0], a[2])
+		assert.Equal(t, a[0], a[6])
+		assert.Equal(t, a[1], a[5])
+		assert.Equal(t, a[3], a[4])
+		assert.NotEqual(t, a[0], a[1])
+		assert.NotEqual(t, a[0], a[3])
+		assert.NotEqual(t, a[1], a[3])
+
+		assert.Equal(t, 3, res.sizes[a[0]])
+		assert.Equal(t, 2, res.sizes[a[1]])
+		assert.Equal(t, 2, res.sizes[a[3]])
+		assert.LessOrEqual(t, res.iterations, 100)
+	})
+
+	t.Run("clusters by direction rather than length", func(t *testing.T) {
+		vectors := [][]float32{
+			{1, 0},
+			{10, 0},
+			{0, 1},
+			{0, 10},
+		}
+
+		res, err := kmeans(vectors, 2, 100, rand.New(rand.NewSource(1)))
+		require.Nil(t, err)
+
+		a := res.assignments
+		assert.Equal(t, a[0], a[1])
+		assert.Equal(t, a[2], a[3])
+		assert.NotEqual(t, a[0], a[2])
+		assert.InDeltaSlice(t, []float32{1, 0}, res.centroids[a[0]], 1e-6)
+	})
+
+	t.Run("with identical vectors no cluster stays empty", func(t *testing.T) {
+		vectors := [][]float32{{1, 1}, {1, 1}, {1, 1}}
+
+		res, err := kmeans(vectors, 3, 100, rand.New(rand.NewSource(1)))
+		require.Nil(t, err)
+
+		for c, size := range res.sizes {
+			assert.Equal(t, 1, size, "cluster %d", c)
+		}
+	})
+
+	t.Run("stops after max iterations", func(t *testing.T) {
+		vectors := [][]float32{{1, 0}, {0.9, 0.1}, {0, 1}, {0.1, 0.9}}
+
+		res, err := kmeans(vectors, 2, 1, rand.New(rand.NewSource(1)))
+		require.Nil(t, err)
+		assert.Equal(t, 1, res.iterations)
+	})
+
+	t.Run("with fewer vectors than clusters", func(t *testing.T) {
+		_, err := kmeans([][]float32{{1, 0}}, 2, 100, rand.New(rand.NewSource(1)))
+		assert.EqualError(t, err, "need at least k=2 vectors, got 1")
+	})
+
+	t.Run("with mismatching dimensions", func(t *testing.T) {
+		_, err := kmeans([][]float32{{1, 0}, {1, 0, 0}}, 2, 100,
+			rand.New(rand.NewSource(1)))
+		assert.EqualError(t, err, "vector 1 has 3 dimensions, expected 2")
+	})
+}
@@ -17,6 +17,7 @@ import "fmt"
 type Authentication struct {
 	OIDC            OIDC            `json:"oidc" yaml:"oidc"`
 	AnonymousAccess AnonymousAccess `json:"anonymous_access" yaml:"anonymous_access"`
+	APIKey          APIKey          `json:"apikey" yaml:"apikey"`
 }
 
 // Validate the Authentication configuration. This only validates at a general
@@ -31,7 +32,7 @@ func (a Authentication) Validate() error {
 }
 
 func (a Authentication) anyAuthMethodSelected() bool {
-	return a.AnonymousAccess.Enabled || a.OIDC.Enabled
+	return a.AnonymousAccess.Enabled || a.OIDC.Enabled || a.APIKey.Enabled
 }
 
 // AnonymousAccess considers users without any auth information as
@@ -51,3 +52,12 @@ type OIDC struct {
 	UsernameClaim     string `yaml:"username_claim" json:"username_claim"`
 	GroupsClaim       string `yaml:"groups_claim" json:"groups_claim"`
 }
+
+// APIKey configures static API keys which are sent as a Bearer token. Every
+// key maps to a user: either there is exactly one user for all keys, or there
+// is one user per key in the same order as the keys.
+type APIKey struct {
+	Enabled     bool     `json:"enabled" yaml:"enabled"`
+	AllowedKeys []string `json:"allowed_keys" yaml:"allowed_keys"`
+	Users       []string `json:"users" yaml:"users"`
+}
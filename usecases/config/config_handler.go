@@ -12,14 +12,18 @@
 package config
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"regexp"
+	"strings"
 
 	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
-	"github.com/semi-technologies/weaviate/deprecations"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -33,7 +37,8 @@ const DefaultCleanupIntervalSeconds = int64(60)
 
 // Flags are input options
 type Flags struct {
-	ConfigFile string `long:"config-file" description:"path to config file (default: ./weaviate.conf.json)"`
+	ConfigFile     string `long:"config-file" description:"path to config file (default: ./weaviate.conf.json)"`
+	ValidateConfig bool   `long:"validate-config" description:"validate the config file and environment, print the effective configuration and exit"`
 }
 
 // Config outline of the config file
@@ -42,6 +47,8 @@ type Config struct {
 	Debug                   bool           `json:"debug" yaml:"debug"`
 	QueryDefaults           QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
 	QueryMaximumResults     int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
+	QueryParallelism        int            `json:"query_parallelism" yaml:"query_parallelism"`
+	BatchDeleteMaximum      int64          `json:"batch_delete_maximum_objects" yaml:"batch_delete_maximum_objects"`
 	Contextionary           Contextionary  `json:"contextionary" yaml:"contextionary"`
 	Authentication          Authentication `json:"authentication" yaml:"authentication"`
 	Authorization           Authorization  `json:"authorization" yaml:"authorization"`
@@ -52,6 +59,15 @@ type Config struct {
 	ModulesPath             string         `json:"modules_path" yaml:"modules_path"`
 	AutoSchema              AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
 	Cluster                 cluster.Config `json:"cluster" yaml:"cluster"`
+	InferenceQueue          InferenceQueue `json:"inference_queue" yaml:"inference_queue"`
+	Runtime                 Runtime        `json:"runtime" yaml:"runtime"`
+	Diagnostics             Diagnostics    `json:"diagnostics" yaml:"diagnostics"`
+	Quotas                  Quotas         `json:"quotas" yaml:"quotas"`
+	QueryAdmission          QueryAdmission `json:"query_admission" yaml:"query_admission"`
+	QuerySandbox            QuerySandbox   `json:"query_sandbox" yaml:"query_sandbox"`
+	Deduplication           Deduplication  `json:"deduplication" yaml:"deduplication"`
+	QueryLog                QueryLog       `json:"query_log" yaml:"query_log"`
+	PropertyUsage           PropertyUsage  `json:"property_usage" yaml:"property_usage"`
 }
 
 type moduleProvider interface {
@@ -102,12 +118,389 @@ type QueryDefaults struct {
 	Limit int64 `json:"limit" yaml:"limit"`
 }
 
+func (c Config) validateQueryLimits() error {
+	if c.QueryMaximumResults <= 0 {
+		return fmt.Errorf("query_maximum_results must be greater than 0")
+	}
+
+	if c.QueryDefaults.Limit < 0 {
+		return fmt.Errorf("query_defaults.limit must not be negative")
+	}
+
+	if c.QueryDefaults.Limit > c.QueryMaximumResults {
+		return fmt.Errorf("query_defaults.limit (%d) must not exceed "+
+			"query_maximum_results (%d)", c.QueryDefaults.Limit, c.QueryMaximumResults)
+	}
+
+	if c.QueryParallelism <= 0 {
+		return fmt.Errorf("query_parallelism must be greater than 0")
+	}
+
+	if c.BatchDeleteMaximum <= 0 {
+		return fmt.Errorf("batch_delete_maximum_objects must be greater than 0")
+	}
+
+	return nil
+}
+
 type Contextionary struct {
 	URL string `json:"url" yaml:"url"`
 }
 
+// InferenceQueue limits the number of concurrent module calls. Once all
+// slots are taken, waiting calls are admitted according to the weights of
+// their priority class. A MaxConcurrency of 0 disables the queue.
+type InferenceQueue struct {
+	MaxConcurrency    int `json:"maxConcurrency" yaml:"maxConcurrency"`
+	InteractiveWeight int `json:"interactiveWeight" yaml:"interactiveWeight"`
+	ImportWeight      int `json:"importWeight" yaml:"importWeight"`
+}
+
+func (q InferenceQueue) Validate() error {
+	if q.MaxConcurrency < 0 {
+		return fmt.Errorf("inference_queue.maxConcurrency must not be negative")
+	}
+
+	if q.InteractiveWeight < 0 || q.ImportWeight < 0 {
+		return fmt.Errorf("inference_queue weights must not be negative")
+	}
+
+	return nil
+}
+
+// QueryAdmission limits the number of concurrently executed operations per
+// class of operation. Once all slots of a class are taken, further operations
+// of that class wait for up to QueueTimeoutMs and are rejected afterwards. A
+// limit of 0 leaves the class unlimited, a timeout of 0 waits until the
+// request is cancelled.
+type QueryAdmission struct {
+	MaxConcurrentReads          int `json:"maxConcurrentReads" yaml:"maxConcurrentReads"`
+	MaxConcurrentVectorSearches int `json:"maxConcurrentVectorSearches" yaml:"maxConcurrentVectorSearches"`
+	MaxConcurrentAggregations   int `json:"maxConcurrentAggregations" yaml:"maxConcurrentAggregations"`
+	MaxConcurrentBatches        int `json:"maxConcurrentBatches" yaml:"maxConcurrentBatches"`
+	QueueTimeoutMs              int `json:"queueTimeoutMs" yaml:"queueTimeoutMs"`
+}
+
+func (q QueryAdmission) Validate() error {
+	if q.MaxConcurrentReads < 0 || q.MaxConcurrentVectorSearches < 0 ||
+		q.MaxConcurrentAggregations < 0 || q.MaxConcurrentBatches < 0 {
+		return fmt.Errorf("query_admission limits must not be negative")
+	}
+
+	if q.QueueTimeoutMs < 0 {
+		return fmt.Errorf("query_admission.queueTimeoutMs must not be negative")
+	}
+
+	return nil
+}
+
+// QuerySandbox configures the /graphql/sandbox endpoint meant for ad-hoc
+// exploration. Its caps are independent of the production query limits.
+// Once a cap is hit, the query stops and whatever was resolved up to that
+// point is returned together with the caps which were hit.
+type QuerySandbox struct {
+	Enabled   bool `json:"enabled" yaml:"enabled"`
+	TimeoutMs int  `json:"timeoutMs" yaml:"timeoutMs"`
+
+	// MaxMemoryMB caps how much the heap may grow while a sandbox query runs.
+	// The growth is measured for the whole node, so concurrent requests count
+	// towards it as well.
+	MaxMemoryMB    int `json:"maxMemoryMB" yaml:"maxMemoryMB"`
+	MaxResultBytes int `json:"maxResultBytes" yaml:"maxResultBytes"`
+}
+
+func (q QuerySandbox) Validate() error {
+	if !q.Enabled {
+		return nil
+	}
+
+	if q.TimeoutMs <= 0 || q.MaxMemoryMB <= 0 || q.MaxResultBytes <= 0 {
+		return fmt.Errorf("query_sandbox caps must be positive")
+	}
+
+	return nil
+}
+
+// Runtime contains the settings which can be changed while Weaviate is
+// running, either by sending SIGHUP to re-read the config file or through the
+// runtime config API. Together with inference_queue.maxConcurrency, they are
+// the only settings which are reloaded, everything else requires a restart.
+type Runtime struct {
+	// LogLevel is one of the logrus levels, e.g. "info" or "debug". If empty,
+	// the level set through LOG_LEVEL on startup is kept.
+	LogLevel string `json:"logLevel" yaml:"logLevel"`
+
+	// SlowQueryThresholdMs logs every query which takes longer than the
+	// threshold. A value of 0 disables slow query logging.
+	SlowQueryThresholdMs int64 `json:"slowQueryThresholdMs" yaml:"slowQueryThresholdMs"`
+
+	// RowCacheMaxSize is the size in bytes of the inverted index row cache of
+	// each shard
+	RowCacheMaxSize int64 `json:"rowCacheMaxSize" yaml:"rowCacheMaxSize"`
+
+	// ReadOnly rejects every request which would alter data or the schema
+	ReadOnly bool `json:"readOnly" yaml:"readOnly"`
+}
+
+func (r Runtime) Validate() error {
+	if r.LogLevel != "" {
+		if _, err := logrus.ParseLevel(r.LogLevel); err != nil {
+			return fmt.Errorf("runtime.logLevel: %v", err)
+		}
+	}
+
+	if r.SlowQueryThresholdMs < 0 {
+		return fmt.Errorf("runtime.slowQueryThresholdMs must not be negative")
+	}
+
+	if r.RowCacheMaxSize <= 0 {
+		return fmt.Errorf("runtime.rowCacheMaxSize must be greater than 0")
+	}
+
+	return nil
+}
+
+// Diagnostics configures the server exposing pprof profiles, goroutine and
+// heap dumps, the prometheus metrics as well as diagnostics bundles for
+// support cases. It listens on its own address, so that it is never reachable
+// through the public API.
+type Diagnostics struct {
+	Enabled     bool   `json:"enabled" yaml:"enabled"`
+	BindAddress string `json:"bindAddress" yaml:"bindAddress"`
+
+	// Token must be sent as a bearer token with every request if set. It is
+	// required unless the server is bound to a loopback address.
+	Token string `json:"token" yaml:"token"`
+}
+
+func (d Diagnostics) Validate() error {
+	if !d.Enabled {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(d.BindAddress)
+	if err != nil {
+		return fmt.Errorf("diagnostics.bindAddress: %v", err)
+	}
+
+	if d.Token != "" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return fmt.Errorf("diagnostics.token must be set if diagnostics.bindAddress " +
+			"is not a loopback address")
+	}
+
+	return nil
+}
+
+// Quotas limit how much data a single class may hold on a node, so that a
+// runaway import into one class cannot fill up the node for everyone else.
+// The limits apply to every class, unless they are overwritten for a class in
+// Classes. A limit of 0 means unlimited.
+//
+// The quotas are enforced by every node on its own, there is no cluster-wide
+// limit. A node counts the objects and the disk usage of the shards it holds
+// and adds the objects it admitted since. In a cluster of N nodes a class
+// can therefore hold up to N times the limits, so they have to be sized per
+// node.
+type Quotas struct {
+	MaxObjects   int64 `json:"maxObjects" yaml:"maxObjects"`
+	MaxDiskBytes int64 `json:"maxDiskBytes" yaml:"maxDiskBytes"`
+
+	// Classes overwrites the limits per class name. A limit of 0 keeps the
+	// limit which applies to every class, -1 lifts it for the class.
+	Classes map[string]ClassQuota `json:"classes" yaml:"classes"`
+}
+
+type ClassQuota struct {
+	MaxObjects   int64 `json:"maxObjects" yaml:"maxObjects"`
+	MaxDiskBytes int64 `json:"maxDiskBytes" yaml:"maxDiskBytes"`
+}
+
+// ForClass returns the limits which apply to the specified class. Unlimited
+// quotas are always reported as 0.
+func (q Quotas) ForClass(className string) ClassQuota {
+	out := ClassQuota{MaxObjects: q.MaxObjects, MaxDiskBytes: q.MaxDiskBytes}
+
+	override := q.Classes[className]
+	if override.MaxObjects != 0 {
+		out.MaxObjects = override.MaxObjects
+	}
+	if override.MaxDiskBytes != 0 {
+		out.MaxDiskBytes = override.MaxDiskBytes
+	}
+
+	if out.MaxObjects < 0 {
+		out.MaxObjects = 0
+	}
+	if out.MaxDiskBytes < 0 {
+		out.MaxDiskBytes = 0
+	}
+
+	return out
+}
+
+func (q Quotas) Validate() error {
+	if q.MaxObjects < 0 || q.MaxDiskBytes < 0 {
+		return fmt.Errorf("quotas.maxObjects and quotas.maxDiskBytes must not be negative")
+	}
+
+	for className, quota := range q.Classes {
+		if quota.MaxObjects < -1 || quota.MaxDiskBytes < -1 {
+			return fmt.Errorf("quotas.classes.%s: limits must be -1 (unlimited), "+
+				"0 (default) or greater", className)
+		}
+	}
+
+	return nil
+}
+
+const (
+	// DeduplicationReject rejects a new object which is a near-duplicate
+	DeduplicationReject = "reject"
+	// DeduplicationFlag stores a near-duplicate, but references the object
+	// it duplicates in its additional properties
+	DeduplicationFlag = "flag"
+)
+
+// Deduplication checks new objects of the configured classes for
+// near-duplicates before they are inserted. An object is a near-duplicate if
+// the nearest neighbor of its vector is within MaxDistance.
+type Deduplication struct {
+	Classes map[string]ClassDeduplication `json:"classes" yaml:"classes"`
+}
+
+type ClassDeduplication struct {
+	MaxDistance float32 `json:"maxDistance" yaml:"maxDistance"`
+
+	// Action is either DeduplicationReject (default) or DeduplicationFlag
+	Action string `json:"action" yaml:"action"`
+}
+
+func (d Deduplication) Validate() error {
+	for className, class := range d.Classes {
+		if class.MaxDistance < 0 || class.MaxDistance > 2 {
+			return fmt.Errorf("deduplication.classes.%s: maxDistance must be "+
+				"between 0 and 2", className)
+		}
+
+		switch class.Action {
+		case "", DeduplicationReject, DeduplicationFlag:
+		default:
+			return fmt.Errorf("deduplication.classes.%s: action must be %q or %q",
+				className, DeduplicationReject, DeduplicationFlag)
+		}
+	}
+
+	return nil
+}
+
+// QueryLog records a sample of the vector searches anonymized, so that the
+// workload can be exported and replayed through the diagnostics server, e.g.
+// to test the capacity of a class after a config change. Only the most
+// recent MaxEntries queries are kept.
+type QueryLog struct {
+	// SampleRate is the share of queries which are recorded, 0 disables the
+	// query log
+	SampleRate float64 `json:"sampleRate" yaml:"sampleRate"`
+	MaxEntries int     `json:"maxEntries" yaml:"maxEntries"`
+}
+
+func (q QueryLog) Validate() error {
+	if q.SampleRate < 0 || q.SampleRate > 1 {
+		return fmt.Errorf("query_log.sampleRate must be between 0 and 1")
+	}
+
+	if q.MaxEntries <= 0 {
+		return fmt.Errorf("query_log.maxEntries must be greater than 0")
+	}
+
+	return nil
+}
+
+// PropertyUsage samples which properties queries filter on and return, so
+// that the diagnostics server can report the indexed properties which are
+// never filtered on within the window.
+type PropertyUsage struct {
+	// SampleRate is the share of queries which are recorded, 0 disables the
+	// tracking
+	SampleRate  float64 `json:"sampleRate" yaml:"sampleRate"`
+	WindowHours int     `json:"windowHours" yaml:"windowHours"`
+}
+
+func (p PropertyUsage) Validate() error {
+	if p.SampleRate < 0 || p.SampleRate > 1 {
+		return fmt.Errorf("property_usage.sampleRate must be between 0 and 1")
+	}
+
+	if p.WindowHours <= 0 {
+		return fmt.Errorf("property_usage.windowHours must be greater than 0")
+	}
+
+	return nil
+}
+
 type Persistence struct {
 	DataPath string `json:"dataPath" yaml:"dataPath"`
+
+	// SeedSnapshotURL optionally points to a snapshot archive (http(s) URL,
+	// file:// URL or local path) which is restored on startup if the data path
+	// is still empty
+	SeedSnapshotURL string `json:"seedSnapshotURL" yaml:"seedSnapshotURL"`
+
+	// MaxOpenSegments limits the number of disk segments which are mapped into
+	// memory at the same time, to stay within the limits of open files and
+	// memory maps of the OS. Cold segments are closed and reopened on demand.
+	// 0 means unlimited.
+	MaxOpenSegments int `json:"maxOpenSegments" yaml:"maxOpenSegments"`
+
+	// MemtablesMaxMemoryBytes is the memory shared by the memtables of all
+	// lsmkv buckets of the node. Every bucket flushes its memtable once it
+	// reaches an equal share, so setups with many classes and shards do not
+	// run out of memory. 0 flushes every memtable at a fixed size of 10MiB.
+	MemtablesMaxMemoryBytes int64 `json:"memtablesMaxMemoryBytes" yaml:"memtablesMaxMemoryBytes"`
+
+	// BackgroundIOBytesPerSecond limits the disk throughput of compactions,
+	// vector index maintenance, tombstone cleanups and backups, so they cannot
+	// saturate the disk used by queries. 0 means unlimited.
+	BackgroundIOBytesPerSecond int64 `json:"backgroundIOBytesPerSecond" yaml:"backgroundIOBytesPerSecond"`
+
+	// CompactionIntervalSeconds is how often every lsmkv bucket checks for
+	// disk segments to compact. CompactionMinSegments is the number of
+	// adjacent segments of the same level which triggers a compaction,
+	// CompactionMaxSegments the number of segments which are merged into one
+	// at most. 0 uses the defaults of 3 seconds, 2 and 4 segments.
+	CompactionIntervalSeconds int `json:"compactionIntervalSeconds" yaml:"compactionIntervalSeconds"`
+	CompactionMinSegments     int `json:"compactionMinSegments" yaml:"compactionMinSegments"`
+	CompactionMaxSegments     int `json:"compactionMaxSegments" yaml:"compactionMaxSegments"`
+
+	// SegmentCompression is the algorithm the segments of the objects and
+	// inverted buckets are compressed with, "none" (the default), "snappy" or
+	// "zstd". Compression trades CPU for a smaller disk footprint. Existing
+	// segments take on a changed compression as they are compacted.
+	SegmentCompression string `json:"segmentCompression" yaml:"segmentCompression"`
+
+	// HNSWMaxLogSize is the size in bytes up to which the condensed commit
+	// logs of a vector index are combined. Larger logs mean fewer files to
+	// read on startup, but more memory while condensing. 0 uses the default
+	// of 500MiB.
+	HNSWMaxLogSize int64 `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+
+	// WriteCoalescingWindowMs is how long a put of an object is held back, so
+	// that further puts of the same object arriving in the meantime are merged
+	// into a single write. This reduces index churn for clients which upsert
+	// the same objects in quick succession, at the cost of put latency. 0
+	// disables coalescing.
+	WriteCoalescingWindowMs int `json:"writeCoalescingWindowMs" yaml:"writeCoalescingWindowMs"`
+
+	// EncryptionKey optionally encrypts the segments, write-ahead logs and
+	// vector index commit logs with AES-GCM. It is a base64 encoded key of 16,
+	// 24 or 32 bytes. Alternatively EncryptionKeyProvider names an enabled
+	// module which provides the key, e.g. from a key management service.
+	EncryptionKey         string `json:"encryptionKey" yaml:"encryptionKey"`
+	EncryptionKeyProvider string `json:"encryptionKeyProvider" yaml:"encryptionKeyProvider"`
 }
 
 func (p Persistence) Validate() error {
@@ -115,9 +508,81 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	if p.MaxOpenSegments < 0 {
+		return fmt.Errorf("persistence.maxOpenSegments must not be negative")
+	}
+
+	if p.MemtablesMaxMemoryBytes < 0 {
+		return fmt.Errorf("persistence.memtablesMaxMemoryBytes must not be negative")
+	}
+
+	if p.BackgroundIOBytesPerSecond < 0 {
+		return fmt.Errorf("persistence.backgroundIOBytesPerSecond must not be negative")
+	}
+
+	if p.CompactionIntervalSeconds < 0 {
+		return fmt.Errorf("persistence.compactionIntervalSeconds must not be negative")
+	}
+
+	if p.CompactionMinSegments < 0 || p.CompactionMinSegments == 1 {
+		return fmt.Errorf("persistence.compactionMinSegments must be at least 2")
+	}
+
+	if p.CompactionMaxSegments < 0 || p.CompactionMaxSegments == 1 {
+		return fmt.Errorf("persistence.compactionMaxSegments must be at least 2")
+	}
+
+	if p.CompactionMinSegments > 0 && p.CompactionMaxSegments > 0 &&
+		p.CompactionMaxSegments < p.CompactionMinSegments {
+		return fmt.Errorf("persistence.compactionMaxSegments must not be smaller " +
+			"than persistence.compactionMinSegments")
+	}
+
+	switch p.SegmentCompression {
+	case "", "none", "snappy", "zstd":
+	default:
+		return fmt.Errorf("persistence.segmentCompression must be one of " +
+			"none, snappy or zstd")
+	}
+
+	if p.HNSWMaxLogSize < 0 {
+		return fmt.Errorf("persistence.hnswMaxLogSize must not be negative")
+	}
+
+	if p.WriteCoalescingWindowMs < 0 || p.WriteCoalescingWindowMs > 1000 {
+		return fmt.Errorf("persistence.writeCoalescingWindowMs must be between 0 and 1000")
+	}
+
+	if p.EncryptionKey != "" && p.EncryptionKeyProvider != "" {
+		return fmt.Errorf("persistence.encryptionKey and persistence.encryptionKeyProvider " +
+			"are mutually exclusive")
+	}
+
+	if p.EncryptionKey != "" {
+		if _, err := p.DecodedEncryptionKey(); err != nil {
+			return errors.Wrap(err, "persistence.encryptionKey")
+		}
+	}
+
 	return nil
 }
 
+// DecodedEncryptionKey returns the raw bytes of the base64 encoded
+// EncryptionKey
+func (p Persistence) DecodedEncryptionKey() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(p.EncryptionKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode base64")
+	}
+
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("key must be 16, 24 or 32 bytes long, got %d", len(key))
+	}
+}
+
 // GetConfigOptionGroup creates a option group for swagger
 func GetConfigOptionGroup() *swag.CommandLineOptionsGroup {
 	commandLineOptionsGroup := swag.CommandLineOptionsGroup{
@@ -141,7 +606,8 @@ func (f *WeaviateConfig) GetHostAddress() string {
 	return fmt.Sprintf("%s://%s", f.Scheme, f.Hostname)
 }
 
-// LoadConfig from config locations
+// LoadConfig from config locations. Every option starts out with its default,
+// is then overwritten by the config file and finally by the environment.
 func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger logrus.FieldLogger) error {
 	// Get command line flags
 	configFileName := flags.Options.(*Flags).ConfigFile
@@ -153,65 +619,100 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 			Info("no config file specified, using default or environment based")
 	}
 
+	f.Config = Defaults()
+
 	// Read config file
 	file, err := ioutil.ReadFile(configFileName)
 	_ = err // explicitly ignore
 
 	if len(file) > 0 {
-		config, err := f.parseConfigFile(file, configFileName)
-		if err != nil {
+		if err := f.parseConfigFile(file, configFileName, &f.Config); err != nil {
 			return err
 		}
-		f.Config = config
-
-		deprecations.Log(logger, "config-files")
 	}
 
 	if err := FromEnv(&f.Config); err != nil {
 		return err
 	}
 
-	if err := f.Config.Authentication.Validate(); err != nil {
+	if err := f.Config.validateSettings(); err != nil {
 		return fmt.Errorf("invalid config: %v", err)
 	}
 
-	if err := f.Config.Authorization.Validate(); err != nil {
-		return fmt.Errorf("invalid config: %v", err)
+	return nil
+}
+
+// validateSettings validates everything which does not depend on the modules,
+// see Validate for the module dependent validation. All violations are
+// reported at once, so they can be fixed at once.
+func (c Config) validateSettings() error {
+	validations := []func() error{
+		c.Authentication.Validate,
+		c.Authorization.Validate,
+		c.Persistence.Validate,
+		c.AutoSchema.Validate,
+		c.Cluster.Validate,
+		c.InferenceQueue.Validate,
+		c.Runtime.Validate,
+		c.Diagnostics.Validate,
+		c.Quotas.Validate,
+		c.QueryAdmission.Validate,
+		c.QuerySandbox.Validate,
+		c.Deduplication.Validate,
+		c.QueryLog.Validate,
+		c.PropertyUsage.Validate,
+		c.validateQueryLimits,
 	}
 
-	if err := f.Config.Persistence.Validate(); err != nil {
-		return fmt.Errorf("invalid config: %v", err)
+	var msgs []string
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			msgs = append(msgs, err.Error())
+		}
 	}
 
-	if err := f.Config.AutoSchema.Validate(); err != nil {
-		return fmt.Errorf("invalid config: %v", err)
+	if len(msgs) > 0 {
+		return errors.New(strings.Join(msgs, ", "))
 	}
 
 	return nil
 }
 
-func (f *WeaviateConfig) parseConfigFile(file []byte, name string) (Config, error) {
-	var config Config
+// WriteYAML writes the config in the format of a config file, so the effective
+// configuration can be inspected or used as the starting point of a file
+func (c Config) WriteYAML(w io.Writer) error {
+	out, err := yaml.Marshal(c)
+	if err != nil {
+		return errors.Wrap(err, "marshal config")
+	}
 
+	_, err = w.Write(out)
+	return err
+}
+
+// parseConfigFile applies the file on top of config. Unknown keys are
+// rejected, so that a typo doesn't silently leave an option at its default.
+func (f *WeaviateConfig) parseConfigFile(file []byte, name string,
+	config *Config) error {
 	m := regexp.MustCompile(`.*\.(\w+)$`).FindStringSubmatch(name)
 	if len(m) < 2 {
-		return config, fmt.Errorf("config file does not have a file ending, got '%s'", name)
+		return fmt.Errorf("config file does not have a file ending, got '%s'", name)
 	}
 
 	switch m[1] {
 	case "json":
-		err := json.Unmarshal(file, &config)
-		if err != nil {
-			return config, fmt.Errorf("error unmarshalling the json config file: %s", err)
+		dec := json.NewDecoder(bytes.NewReader(file))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(config); err != nil {
+			return fmt.Errorf("error unmarshalling the json config file: %s", err)
 		}
-	case "yaml":
-		err := yaml.Unmarshal(file, &config)
-		if err != nil {
-			return config, fmt.Errorf("error unmarshalling the yaml config file: %s", err)
+	case "yaml", "yml":
+		if err := yaml.UnmarshalStrict(file, config); err != nil {
+			return fmt.Errorf("error unmarshalling the yaml config file: %s", err)
 		}
 	default:
-		return config, fmt.Errorf("unsupported config file extension '%s', use .yaml or .json", m[1])
+		return fmt.Errorf("unsupported config file extension '%s', use .yaml or .json", m[1])
 	}
 
-	return config, nil
+	return nil
 }
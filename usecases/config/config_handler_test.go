@@ -0,0 +1,328 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestLoadConfig(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	// every config needs an authentication scheme, which none of the files
+	// below is about
+	os.Setenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+	defer os.Unsetenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED")
+
+	load := func(t *testing.T, fileName, contents string) (Config, error) {
+		dir, err := ioutil.TempDir("", "weaviate-config")
+		require.Nil(t, err)
+		defer os.RemoveAll(dir)
+
+		filePath := path.Join(dir, fileName)
+		require.Nil(t, ioutil.WriteFile(filePath, []byte(contents), 0o600))
+
+		f := &WeaviateConfig{}
+		err = f.LoadConfig(&swag.CommandLineOptionsGroup{
+			Options: &Flags{ConfigFile: filePath},
+		}, logger)
+		return f.Config, err
+	}
+
+	t.Run("defaults, file and env in order of precedence", func(t *testing.T) {
+		os.Setenv("QUERY_MAXIMUM_RESULTS", "500")
+		defer os.Unsetenv("QUERY_MAXIMUM_RESULTS")
+
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: /var/lib/weaviate
+query_maximum_results: 100
+query_defaults:
+  limit: 20
+cluster:
+  gossipBindPort: 7946
+`)
+		require.Nil(t, err)
+
+		assert.Equal(t, "/var/lib/weaviate", cfg.Persistence.DataPath)
+		assert.Equal(t, int64(20), cfg.QueryDefaults.Limit)
+		assert.Equal(t, 7946, cfg.Cluster.GossipBindPort)
+		// set in the file, overwritten by the env
+		assert.Equal(t, int64(500), cfg.QueryMaximumResults)
+		// set in neither, so the defaults are kept
+		assert.Equal(t, Defaults().AutoSchema, cfg.AutoSchema)
+		assert.Equal(t, DefaultQueryParallelism, cfg.QueryParallelism)
+		assert.Equal(t, VectorizerModuleNone, cfg.DefaultVectorizerModule)
+	})
+
+	t.Run("json file", func(t *testing.T) {
+		cfg, err := load(t, "weaviate.conf.json",
+			`{"persistence": {"dataPath": "./data"}, "origin": "http://localhost"}`)
+		require.Nil(t, err)
+
+		assert.Equal(t, "./data", cfg.Persistence.DataPath)
+		assert.Equal(t, "http://localhost", cfg.Origin)
+	})
+
+	t.Run("unknown keys are rejected", func(t *testing.T) {
+		_, err := load(t, "weaviate.conf.yml", `
+persistence:
+  dataPth: ./data
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "dataPth")
+
+		_, err = load(t, "weaviate.conf.json", `{"origni": "http://localhost"}`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "origni")
+	})
+
+	t.Run("all invalid values are reported", func(t *testing.T) {
+		_, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+query_maximum_results: 10
+query_defaults:
+  limit: 20
+cluster:
+  gossipBindPort: 7946
+  dataBindPort: 7946
+inference_queue:
+  maxConcurrency: -1
+runtime:
+  logLevel: verbose
+diagnostics:
+  enabled: true
+  bindAddress: 0.0.0.0:6060
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not exceed query_maximum_results")
+		assert.Contains(t, err.Error(), "must not be the same")
+		assert.Contains(t, err.Error(), "maxConcurrency must not be negative")
+		assert.Contains(t, err.Error(), "runtime.logLevel")
+		assert.Contains(t, err.Error(), "diagnostics.token must be set")
+	})
+
+	t.Run("runtime settings", func(t *testing.T) {
+		os.Setenv("READ_ONLY", "true")
+		defer os.Unsetenv("READ_ONLY")
+
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+runtime:
+  logLevel: debug
+  slowQueryThresholdMs: 500
+`)
+		require.Nil(t, err)
+
+		assert.Equal(t, Runtime{
+			LogLevel:             "debug",
+			SlowQueryThresholdMs: 500,
+			RowCacheMaxSize:      DefaultRowCacheMaxSize,
+			ReadOnly:             true,
+		}, cfg.Runtime)
+	})
+
+	t.Run("quotas", func(t *testing.T) {
+		os.Setenv("QUOTA_MAX_OBJECTS", "1000")
+		defer os.Unsetenv("QUOTA_MAX_OBJECTS")
+
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+quotas:
+  maxDiskBytes: 5000
+  classes:
+    Article:
+      maxObjects: 50
+    Log:
+      maxDiskBytes: -1
+`)
+		require.Nil(t, err)
+
+		assert.Equal(t, ClassQuota{MaxObjects: 1000, MaxDiskBytes: 5000},
+			cfg.Quotas.ForClass("Product"))
+		assert.Equal(t, ClassQuota{MaxObjects: 50, MaxDiskBytes: 5000},
+			cfg.Quotas.ForClass("Article"))
+		assert.Equal(t, ClassQuota{MaxObjects: 1000},
+			cfg.Quotas.ForClass("Log"))
+
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+quotas:
+  classes:
+    Article:
+      maxObjects: -2
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "quotas.classes.Article")
+	})
+
+	t.Run("query sandbox", func(t *testing.T) {
+		os.Setenv("QUERY_SANDBOX_TIMEOUT_MS", "2000")
+		defer os.Unsetenv("QUERY_SANDBOX_TIMEOUT_MS")
+
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+query_sandbox:
+  enabled: true
+  maxMemoryMB: 64
+`)
+		require.Nil(t, err)
+
+		assert.Equal(t, QuerySandbox{
+			Enabled:        true,
+			TimeoutMs:      2000,
+			MaxMemoryMB:    64,
+			MaxResultBytes: DefaultQuerySandboxMaxResultBytes,
+		}, cfg.QuerySandbox)
+
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+query_sandbox:
+  enabled: true
+  maxResultBytes: 0
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "query_sandbox")
+	})
+
+	t.Run("deduplication", func(t *testing.T) {
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+deduplication:
+  classes:
+    Article:
+      maxDistance: 0.05
+      action: flag
+`)
+		require.Nil(t, err)
+		assert.Equal(t, ClassDeduplication{MaxDistance: 0.05, Action: DeduplicationFlag},
+			cfg.Deduplication.Classes["Article"])
+
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+deduplication:
+  classes:
+    Article:
+      maxDistance: 0.05
+      action: merge
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "deduplication.classes.Article")
+	})
+
+	t.Run("encryption at rest", func(t *testing.T) {
+		os.Setenv("PERSISTENCE_ENCRYPTION_KEY", "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+		defer os.Unsetenv("PERSISTENCE_ENCRYPTION_KEY")
+
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+`)
+		require.Nil(t, err)
+
+		key, err := cfg.Persistence.DecodedEncryptionKey()
+		require.Nil(t, err)
+		assert.Equal(t, []byte("0123456789abcdef0123456789abcdef"), key)
+
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+  encryptionKeyProvider: kms-dummy
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "mutually exclusive")
+
+		os.Setenv("PERSISTENCE_ENCRYPTION_KEY", "c2hvcnQ=")
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "16, 24 or 32 bytes")
+	})
+
+	t.Run("compaction policy", func(t *testing.T) {
+		os.Setenv("PERSISTENCE_COMPACTION_MAX_SEGMENTS", "8")
+		defer os.Unsetenv("PERSISTENCE_COMPACTION_MAX_SEGMENTS")
+
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+  compactionMinSegments: 3
+`)
+		require.Nil(t, err)
+		assert.Equal(t, 3, cfg.Persistence.CompactionMinSegments)
+		assert.Equal(t, 8, cfg.Persistence.CompactionMaxSegments)
+
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+  compactionMinSegments: 10
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "compactionMaxSegments")
+	})
+
+	t.Run("segment compression", func(t *testing.T) {
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+  segmentCompression: zstd
+`)
+		require.Nil(t, err)
+		assert.Equal(t, "zstd", cfg.Persistence.SegmentCompression)
+
+		os.Setenv("PERSISTENCE_SEGMENT_COMPRESSION", "lz4")
+		defer os.Unsetenv("PERSISTENCE_SEGMENT_COMPRESSION")
+		_, err = load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "segmentCompression")
+	})
+
+	t.Run("the effective config can be read back", func(t *testing.T) {
+		cfg, err := load(t, "weaviate.conf.yaml", `
+persistence:
+  dataPath: ./data
+`)
+		require.Nil(t, err)
+
+		buf := &bytes.Buffer{}
+		require.Nil(t, cfg.WriteYAML(buf))
+
+		var parsed Config
+		require.Nil(t, yaml.UnmarshalStrict(buf.Bytes(), &parsed))
+		assert.Equal(t, cfg.Persistence, parsed.Persistence)
+		assert.Equal(t, cfg.AutoSchema, parsed.AutoSchema)
+		assert.Equal(t, cfg.QueryMaximumResults, parsed.QueryMaximumResults)
+	})
+}
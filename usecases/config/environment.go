@@ -21,7 +21,8 @@ import (
 
 // FromEnv takes a *Config as it will respect initial config that has been
 // provided by other means (e.g. a config file) and will only extend those that
-// are set
+// are set. Every option which can be set through the environment can also be
+// set in the config file, an environment variable always takes precedence.
 func FromEnv(config *Config) error {
 	if enabled(os.Getenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED")) {
 		config.Authentication.AnonymousAccess.Enabled = true
@@ -29,41 +30,67 @@ func FromEnv(config *Config) error {
 
 	if enabled(os.Getenv("AUTHENTICATION_OIDC_ENABLED")) {
 		config.Authentication.OIDC.Enabled = true
+	}
 
-		if enabled(os.Getenv("AUTHENTICATION_OIDC_SKIP_CLIENT_ID_CHECK")) {
-			config.Authentication.OIDC.SkipClientIDCheck = true
-		}
+	if enabled(os.Getenv("AUTHENTICATION_OIDC_SKIP_CLIENT_ID_CHECK")) {
+		config.Authentication.OIDC.SkipClientIDCheck = true
+	}
 
-		if v := os.Getenv("AUTHENTICATION_OIDC_ISSUER"); v != "" {
-			config.Authentication.OIDC.Issuer = v
-		}
+	if v := os.Getenv("AUTHENTICATION_OIDC_ISSUER"); v != "" {
+		config.Authentication.OIDC.Issuer = v
+	}
 
-		if v := os.Getenv("AUTHENTICATION_OIDC_CLIENT_ID"); v != "" {
-			config.Authentication.OIDC.ClientID = v
-		}
+	if v := os.Getenv("AUTHENTICATION_OIDC_CLIENT_ID"); v != "" {
+		config.Authentication.OIDC.ClientID = v
+	}
 
-		if v := os.Getenv("AUTHENTICATION_OIDC_USERNAME_CLAIM"); v != "" {
-			config.Authentication.OIDC.UsernameClaim = v
-		}
+	if v := os.Getenv("AUTHENTICATION_OIDC_USERNAME_CLAIM"); v != "" {
+		config.Authentication.OIDC.UsernameClaim = v
+	}
 
-		if v := os.Getenv("AUTHENTICATION_OIDC_GROUPS_CLAIM"); v != "" {
-			config.Authentication.OIDC.GroupsClaim = v
-		}
+	if v := os.Getenv("AUTHENTICATION_OIDC_GROUPS_CLAIM"); v != "" {
+		config.Authentication.OIDC.GroupsClaim = v
+	}
+
+	if enabled(os.Getenv("AUTHENTICATION_APIKEY_ENABLED")) {
+		config.Authentication.APIKey.Enabled = true
+	}
+
+	if v := os.Getenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS"); v != "" {
+		config.Authentication.APIKey.AllowedKeys = strings.Split(v, ",")
+	}
+
+	if v := os.Getenv("AUTHENTICATION_APIKEY_USERS"); v != "" {
+		config.Authentication.APIKey.Users = strings.Split(v, ",")
 	}
 
 	if enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
 		config.Authorization.AdminList.Enabled = true
+	}
+
+	if v := os.Getenv("AUTHORIZATION_ADMINLIST_USERS"); v != "" {
+		config.Authorization.AdminList.Users = strings.Split(v, ",")
+	}
+
+	if v := os.Getenv("AUTHORIZATION_ADMINLIST_READONLY_USERS"); v != "" {
+		config.Authorization.AdminList.ReadOnlyUsers = strings.Split(v, ",")
+	}
 
-		users := strings.Split(os.Getenv("AUTHORIZATION_ADMINLIST_USERS"), ",")
-		roUsers := strings.Split(os.Getenv("AUTHORIZATION_ADMINLIST_READONLY_USERS"),
-			",")
+	if v := os.Getenv("CLUSTER_HOSTNAME"); v != "" {
+		config.Cluster.Hostname = v
+	}
+
+	if v := os.Getenv("CLUSTER_JOIN"); v != "" {
+		config.Cluster.Join = v
+	}
 
-		config.Authorization.AdminList.ReadOnlyUsers = roUsers
-		config.Authorization.AdminList.Users = users
+	if v := os.Getenv("CLUSTER_ZONE"); v != "" {
+		config.Cluster.Zone = v
 	}
 
-	config.Cluster.Hostname = os.Getenv("CLUSTER_HOSTNAME")
-	config.Cluster.Join = os.Getenv("CLUSTER_JOIN")
+	if v := os.Getenv("CLUSTER_RACK"); v != "" {
+		config.Cluster.Rack = v
+	}
 
 	if v := os.Getenv("CLUSTER_GOSSIP_BIND_PORT"); v != "" {
 		asInt, err := strconv.Atoi(v)
@@ -87,6 +114,94 @@ func FromEnv(config *Config) error {
 		config.Persistence.DataPath = v
 	}
 
+	if v := os.Getenv("PERSISTENCE_SEED_SNAPSHOT_URL"); v != "" {
+		config.Persistence.SeedSnapshotURL = v
+	}
+
+	if v := os.Getenv("PERSISTENCE_MAX_OPEN_SEGMENTS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_MAX_OPEN_SEGMENTS as int")
+		}
+
+		config.Persistence.MaxOpenSegments = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_MEMTABLES_MAX_MEMORY_BYTES"); v != "" {
+		asInt, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_MEMTABLES_MAX_MEMORY_BYTES as int")
+		}
+
+		config.Persistence.MemtablesMaxMemoryBytes = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_BACKGROUND_IO_BYTES_PER_SECOND"); v != "" {
+		asInt, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_BACKGROUND_IO_BYTES_PER_SECOND as int")
+		}
+
+		config.Persistence.BackgroundIOBytesPerSecond = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_COMPACTION_INTERVAL_SECONDS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_COMPACTION_INTERVAL_SECONDS as int")
+		}
+
+		config.Persistence.CompactionIntervalSeconds = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_COMPACTION_MIN_SEGMENTS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_COMPACTION_MIN_SEGMENTS as int")
+		}
+
+		config.Persistence.CompactionMinSegments = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_COMPACTION_MAX_SEGMENTS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_COMPACTION_MAX_SEGMENTS as int")
+		}
+
+		config.Persistence.CompactionMaxSegments = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_SEGMENT_COMPRESSION"); v != "" {
+		config.Persistence.SegmentCompression = v
+	}
+
+	if v := os.Getenv("PERSISTENCE_HNSW_MAX_LOG_SIZE"); v != "" {
+		asInt, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_HNSW_MAX_LOG_SIZE as int")
+		}
+
+		config.Persistence.HNSWMaxLogSize = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_WRITE_COALESCING_WINDOW_MS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse PERSISTENCE_WRITE_COALESCING_WINDOW_MS as int")
+		}
+
+		config.Persistence.WriteCoalescingWindowMs = asInt
+	}
+
+	if v := os.Getenv("PERSISTENCE_ENCRYPTION_KEY"); v != "" {
+		config.Persistence.EncryptionKey = v
+	}
+
+	if v := os.Getenv("PERSISTENCE_ENCRYPTION_KEY_PROVIDER"); v != "" {
+		config.Persistence.EncryptionKeyProvider = v
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -111,37 +226,234 @@ func FromEnv(config *Config) error {
 		}
 
 		config.QueryMaximumResults = int64(asInt)
-	} else {
-		config.QueryMaximumResults = DefaultQueryMaximumResults
+	}
+
+	if v := os.Getenv("QUERY_PARALLELISM"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_PARALLELISM as int")
+		}
+
+		config.QueryParallelism = asInt
+	}
+
+	if v := os.Getenv("BATCH_DELETE_MAXIMUM_OBJECTS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse BATCH_DELETE_MAXIMUM_OBJECTS as int")
+		}
+
+		config.BatchDeleteMaximum = int64(asInt)
 	}
 
 	if v := os.Getenv("DEFAULT_VECTORIZER_MODULE"); v != "" {
 		config.DefaultVectorizerModule = v
-	} else {
-		// env not set, this could either mean, we already have a value from a file
-		// or we explicitly want to set the value to "none"
-		if config.DefaultVectorizerModule == "" {
-			config.DefaultVectorizerModule = VectorizerModuleNone
-		}
 	}
 
 	if v := os.Getenv("ENABLE_MODULES"); v != "" {
 		config.EnableModules = v
 	}
 
-	config.AutoSchema.Enabled = true
+	if v := os.Getenv("MODULES_INFERENCE_MAX_CONCURRENCY"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse MODULES_INFERENCE_MAX_CONCURRENCY as int")
+		}
+
+		config.InferenceQueue.MaxConcurrency = asInt
+	}
+
+	if v := os.Getenv("MODULES_INFERENCE_INTERACTIVE_WEIGHT"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse MODULES_INFERENCE_INTERACTIVE_WEIGHT as int")
+		}
+
+		config.InferenceQueue.InteractiveWeight = asInt
+	}
+
+	if v := os.Getenv("MODULES_INFERENCE_IMPORT_WEIGHT"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse MODULES_INFERENCE_IMPORT_WEIGHT as int")
+		}
+
+		config.InferenceQueue.ImportWeight = asInt
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_MAX_READS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_ADMISSION_MAX_READS as int")
+		}
+
+		config.QueryAdmission.MaxConcurrentReads = asInt
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_MAX_VECTOR_SEARCHES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_ADMISSION_MAX_VECTOR_SEARCHES as int")
+		}
+
+		config.QueryAdmission.MaxConcurrentVectorSearches = asInt
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_MAX_AGGREGATIONS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_ADMISSION_MAX_AGGREGATIONS as int")
+		}
+
+		config.QueryAdmission.MaxConcurrentAggregations = asInt
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_MAX_BATCHES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_ADMISSION_MAX_BATCHES as int")
+		}
+
+		config.QueryAdmission.MaxConcurrentBatches = asInt
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_QUEUE_TIMEOUT_MS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_ADMISSION_QUEUE_TIMEOUT_MS as int")
+		}
+
+		config.QueryAdmission.QueueTimeoutMs = asInt
+	}
+
+	if v := os.Getenv("QUERY_SANDBOX_ENABLED"); v != "" {
+		config.QuerySandbox.Enabled = enabled(v)
+	}
+
+	if v := os.Getenv("QUERY_SANDBOX_TIMEOUT_MS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_SANDBOX_TIMEOUT_MS as int")
+		}
+
+		config.QuerySandbox.TimeoutMs = asInt
+	}
+
+	if v := os.Getenv("QUERY_SANDBOX_MAX_MEMORY_MB"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_SANDBOX_MAX_MEMORY_MB as int")
+		}
+
+		config.QuerySandbox.MaxMemoryMB = asInt
+	}
+
+	if v := os.Getenv("QUERY_SANDBOX_MAX_RESULT_BYTES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_SANDBOX_MAX_RESULT_BYTES as int")
+		}
+
+		config.QuerySandbox.MaxResultBytes = asInt
+	}
+
+	if v := os.Getenv("SLOW_QUERY_THRESHOLD_MS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse SLOW_QUERY_THRESHOLD_MS as int")
+		}
+
+		config.Runtime.SlowQueryThresholdMs = int64(asInt)
+	}
+
+	if v := os.Getenv("ROW_CACHE_MAX_SIZE"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse ROW_CACHE_MAX_SIZE as int")
+		}
+
+		config.Runtime.RowCacheMaxSize = int64(asInt)
+	}
+
+	if v := os.Getenv("READ_ONLY"); v != "" {
+		config.Runtime.ReadOnly = enabled(v)
+	}
+
+	if v := os.Getenv("DIAGNOSTICS_ENABLED"); v != "" {
+		config.Diagnostics.Enabled = enabled(v)
+	}
+
+	if v := os.Getenv("DIAGNOSTICS_BIND_ADDRESS"); v != "" {
+		config.Diagnostics.BindAddress = v
+	}
+
+	if v := os.Getenv("DIAGNOSTICS_TOKEN"); v != "" {
+		config.Diagnostics.Token = v
+	}
+
+	if v := os.Getenv("QUERY_LOG_SAMPLE_RATE"); v != "" {
+		asFloat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_LOG_SAMPLE_RATE as float")
+		}
+
+		config.QueryLog.SampleRate = asFloat
+	}
+
+	if v := os.Getenv("QUERY_LOG_MAX_ENTRIES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_LOG_MAX_ENTRIES as int")
+		}
+
+		config.QueryLog.MaxEntries = asInt
+	}
+
+	if v := os.Getenv("PROPERTY_USAGE_SAMPLE_RATE"); v != "" {
+		asFloat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse PROPERTY_USAGE_SAMPLE_RATE as float")
+		}
+
+		config.PropertyUsage.SampleRate = asFloat
+	}
+
+	if v := os.Getenv("PROPERTY_USAGE_WINDOW_HOURS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse PROPERTY_USAGE_WINDOW_HOURS as int")
+		}
+
+		config.PropertyUsage.WindowHours = asInt
+	}
+
+	if v := os.Getenv("QUOTA_MAX_OBJECTS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUOTA_MAX_OBJECTS as int")
+		}
+
+		config.Quotas.MaxObjects = int64(asInt)
+	}
+
+	if v := os.Getenv("QUOTA_MAX_DISK_BYTES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUOTA_MAX_DISK_BYTES as int")
+		}
+
+		config.Quotas.MaxDiskBytes = int64(asInt)
+	}
+
 	if v := os.Getenv("AUTOSCHEMA_ENABLED"); v != "" {
 		config.AutoSchema.Enabled = !(strings.ToLower(v) == "false")
 	}
-	config.AutoSchema.DefaultString = "text"
 	if v := os.Getenv("AUTOSCHEMA_DEFAULT_STRING"); v != "" {
 		config.AutoSchema.DefaultString = v
 	}
-	config.AutoSchema.DefaultNumber = "number"
 	if v := os.Getenv("AUTOSCHEMA_DEFAULT_NUMBER"); v != "" {
 		config.AutoSchema.DefaultNumber = v
 	}
-	config.AutoSchema.DefaultDate = "date"
 	if v := os.Getenv("AUTOSCHEMA_DEFAULT_DATE"); v != "" {
 		config.AutoSchema.DefaultDate = v
 	}
@@ -151,6 +463,61 @@ func FromEnv(config *Config) error {
 
 const DefaultQueryMaximumResults = int64(10000)
 
+// DefaultQueryParallelism is the number of top-level Get and Aggregate
+// selections of a single GraphQL query which are resolved at the same time
+const DefaultQueryParallelism = 4
+
+const DefaultBatchDeleteMaximum = int64(10000)
+
+const DefaultRowCacheMaxSize = int64(500 * 1024 * 1024)
+
+const DefaultDiagnosticsBindAddress = "127.0.0.1:6060"
+
+const DefaultQueryLogMaxEntries = 10000
+
+const DefaultPropertyUsageWindowHours = 7 * 24
+
+const (
+	DefaultQuerySandboxTimeoutMs      = 10000
+	DefaultQuerySandboxMaxMemoryMB    = 256
+	DefaultQuerySandboxMaxResultBytes = 1024 * 1024
+)
+
+// Defaults returns the configuration which is in effect if neither the config
+// file nor the environment set an option
+func Defaults() Config {
+	return Config{
+		QueryMaximumResults:     DefaultQueryMaximumResults,
+		QueryParallelism:        DefaultQueryParallelism,
+		BatchDeleteMaximum:      DefaultBatchDeleteMaximum,
+		DefaultVectorizerModule: VectorizerModuleNone,
+		AutoSchema: AutoSchema{
+			Enabled:       true,
+			DefaultString: "text",
+			DefaultNumber: "number",
+			DefaultDate:   "date",
+		},
+		Runtime: Runtime{
+			RowCacheMaxSize: DefaultRowCacheMaxSize,
+		},
+		Diagnostics: Diagnostics{
+			Enabled:     true,
+			BindAddress: DefaultDiagnosticsBindAddress,
+		},
+		QuerySandbox: QuerySandbox{
+			TimeoutMs:      DefaultQuerySandboxTimeoutMs,
+			MaxMemoryMB:    DefaultQuerySandboxMaxMemoryMB,
+			MaxResultBytes: DefaultQuerySandboxMaxResultBytes,
+		},
+		QueryLog: QueryLog{
+			MaxEntries: DefaultQueryLogMaxEntries,
+		},
+		PropertyUsage: PropertyUsage{
+			WindowHours: DefaultPropertyUsageWindowHours,
+		},
+	}
+}
+
 const VectorizerModuleNone = "none"
 
 // TODO: This should be retrieved dynamically from all installed modules
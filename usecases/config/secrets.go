@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package config
+
+// redactedValue replaces the value of a secret setting wherever the config
+// is exposed, e.g. in diagnostics bundles or by --validate-config
+const redactedValue = "<redacted>"
+
+// secretSetting is a setting which must never be exposed in clear text. The
+// name is the setting flattened like the JSON of the config.
+type secretSetting struct {
+	name   string
+	redact func(c *Config)
+}
+
+var secretSettings = []secretSetting{
+	{
+		name: "authentication.apikey.allowed_keys",
+		redact: func(c *Config) {
+			if len(c.Authentication.APIKey.AllowedKeys) == 0 {
+				return
+			}
+			keys := make([]string, len(c.Authentication.APIKey.AllowedKeys))
+			for i := range keys {
+				keys[i] = redactedValue
+			}
+			c.Authentication.APIKey.AllowedKeys = keys
+		},
+	},
+	{
+		name:   "diagnostics.token",
+		redact: func(c *Config) { redactString(&c.Diagnostics.Token) },
+	},
+	{
+		name:   "persistence.encryptionKey",
+		redact: func(c *Config) { redactString(&c.Persistence.EncryptionKey) },
+	},
+	{
+		// the URL of a snapshot may be pre-signed and carry credentials
+		name:   "persistence.seedSnapshotURL",
+		redact: func(c *Config) { redactString(&c.Persistence.SeedSnapshotURL) },
+	},
+}
+
+func redactString(s *string) {
+	if *s != "" {
+		*s = redactedValue
+	}
+}
+
+// SecretSettings returns the names of all secret settings, flattened like
+// the JSON of the config, e.g. "diagnostics.token"
+func SecretSettings() []string {
+	out := make([]string, len(secretSettings))
+	for i, setting := range secretSettings {
+		out[i] = setting.name
+	}
+
+	return out
+}
+
+// Redacted returns a copy of the config in which the value of every secret
+// setting is replaced, so that it can be printed or shared
+func (c Config) Redacted() Config {
+	for _, setting := range secretSettings {
+		setting.redact(&c)
+	}
+
+	return c
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedacted(t *testing.T) {
+	cfg := Defaults()
+	cfg.Authentication.APIKey = APIKey{
+		Enabled:     true,
+		AllowedKeys: []string{"secret-api-key-1", "secret-api-key-2"},
+		Users:       []string{"jane"},
+	}
+	cfg.Diagnostics.Token = "secret-token"
+	cfg.Persistence.EncryptionKey = "secret-encryption-key"
+	cfg.Persistence.SeedSnapshotURL = "https://bucket/snapshot.tar.gz?secret-signature"
+
+	redacted := cfg.Redacted()
+
+	t.Run("no secret is left", func(t *testing.T) {
+		raw, err := json.Marshal(redacted)
+		require.Nil(t, err)
+		assert.NotContains(t, string(raw), "secret")
+		assert.Equal(t, []string{redactedValue, redactedValue},
+			redacted.Authentication.APIKey.AllowedKeys)
+		assert.Equal(t, []string{"jane"}, redacted.Authentication.APIKey.Users)
+	})
+
+	t.Run("the original config is unchanged", func(t *testing.T) {
+		assert.Equal(t, "secret-api-key-1", cfg.Authentication.APIKey.AllowedKeys[0])
+		assert.Equal(t, "secret-token", cfg.Diagnostics.Token)
+	})
+
+	t.Run("every secret setting names a setting of the config", func(t *testing.T) {
+		raw, err := json.Marshal(cfg)
+		require.Nil(t, err)
+		var nested map[string]interface{}
+		require.Nil(t, json.Unmarshal(raw, &nested))
+
+		for _, setting := range SecretSettings() {
+			var value interface{} = nested
+			for _, key := range strings.Split(setting, ".") {
+				m, ok := value.(map[string]interface{})
+				require.True(t, ok, setting)
+				value, ok = m[key]
+				require.True(t, ok, setting)
+			}
+		}
+	})
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package imports
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+)
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeSchemaManager struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+// fakeConnector returns its records, a nil record makes the read fail
+type fakeConnector struct {
+	records []map[string]interface{}
+}
+
+func (f *fakeConnector) ValidateSource(source map[string]interface{}) error {
+	if _, ok := source["invalid"]; ok {
+		return errortypes.New(errortypes.KindValidation, "invalid source")
+	}
+	return nil
+}
+
+func (f *fakeConnector) ReadRecords(ctx context.Context, source map[string]interface{},
+	fn func(record map[string]interface{}) error) error {
+	for _, record := range f.records {
+		if record == nil {
+			return errors.New("source is gone")
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type fakeConnectorProvider struct {
+	connector *fakeConnector
+}
+
+func (f *fakeConnectorProvider) SourceConnector(name string) (modulecapabilities.SourceConnector, error) {
+	if name != "import-fake" {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"source connector %q is not enabled", name)
+	}
+	return f.connector, nil
+}
+
+// fakeBatchManager stores the objects it receives, objects with a title of
+// "invalid" are rejected
+type fakeBatchManager struct {
+	sync.Mutex
+	batches [][]*models.Object
+}
+
+func (f *fakeBatchManager) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string) (objects.BatchObjects, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	// the importer reuses the slice for the next batch
+	f.batches = append(f.batches, append([]*models.Object{}, objs...))
+
+	// return the results out of order, as the real batch manager does
+	out := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res := objects.BatchObject{OriginalIndex: i, Object: obj}
+		if props, ok := obj.Properties.(map[string]interface{}); ok && props["title"] == "invalid" {
+			res.Err = errors.New("invalid title")
+		}
+		out[len(objs)-1-i] = res
+	}
+
+	return out, nil
+}
+
+func (f *fakeBatchManager) objects() []*models.Object {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*models.Object
+	for _, batch := range f.batches {
+		out = append(out, batch...)
+	}
+	return out
+}
+
+func (f *fakeBatchManager) batchCount() int {
+	f.Lock()
+	defer f.Unlock()
+
+	return len(f.batches)
+}
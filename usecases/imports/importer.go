@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package imports reads the records of external sources through source
+// connector modules and adds them to a class through the batch manager, so
+// simple loads do not require any ETL tooling outside of Weaviate.
+package imports
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultBatchSize = 100
+
+// Importer runs imports in the background and keeps track of their status.
+// The status is only kept in memory, so it can only be retrieved from the
+// node which runs the import and not after a restart.
+type Importer struct {
+	sync.Mutex
+	schemaManager schemaManager
+	connectors    connectorProvider
+	batchManager  batchManager
+	authorizer    authorizer
+	logger        logrus.FieldLogger
+
+	imports map[strfmt.UUID]*models.Import
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+type connectorProvider interface {
+	SourceConnector(name string) (modulecapabilities.SourceConnector, error)
+}
+
+// batchManager adds the imported objects, it authorizes the principal which
+// started the import for every batch
+type batchManager interface {
+	AddObjects(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, fields []*string) (objects.BatchObjects, error)
+}
+
+func New(sm schemaManager, connectors connectorProvider, bm batchManager,
+	authorizer authorizer, logger logrus.FieldLogger) *Importer {
+	return &Importer{
+		schemaManager: sm,
+		connectors:    connectors,
+		batchManager:  bm,
+		authorizer:    authorizer,
+		logger:        logger,
+		imports:       map[strfmt.UUID]*models.Import{},
+	}
+}
+
+// Schedule validates the params and starts the import in the background.
+// The returned status is running, use Get to poll for completion.
+func (i *Importer) Schedule(ctx context.Context, principal *models.Principal,
+	params models.Import) (*models.Import, error) {
+	err := i.authorizer.Authorize(principal, "create", "imports/*")
+	if err != nil {
+		return nil, err
+	}
+
+	setDefaults(&params)
+
+	class, connector, source, err := i.validate(params)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("import: assign id: %v", err)
+	}
+
+	params.ID = strfmt.UUID(id.String())
+	params.Status = models.ImportStatusRunning
+	params.Meta = &models.ImportMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+	i.setStatus(params)
+
+	// the running import updates its meta, so it must not be shared
+	out := copyStatus(params)
+	go i.run(principal, class, connector, source, params)
+
+	return out, nil
+}
+
+// Get returns the status of an import started on this node, nil is returned
+// if there is no such import
+func (i *Importer) Get(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (*models.Import, error) {
+	err := i.authorizer.Authorize(principal, "get", "imports/*")
+	if err != nil {
+		return nil, err
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	status, ok := i.imports[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyStatus(*status), nil
+}
+
+func setDefaults(params *models.Import) {
+	if params.BatchSize == 0 {
+		params.BatchSize = DefaultBatchSize
+	}
+
+	params.Error = ""
+}
+
+// validate the params against the current schema and the enabled connectors
+func (i *Importer) validate(params models.Import) (*models.Class,
+	modulecapabilities.SourceConnector, map[string]interface{}, error) {
+	if params.Connector == "" {
+		return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+			"field 'connector' is required")
+	}
+
+	if params.Class == "" {
+		return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+			"field 'class' is required")
+	}
+
+	if params.BatchSize < 1 {
+		return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+			"field 'batchSize' must be at least 1, got %d", params.BatchSize)
+	}
+
+	s := i.schemaManager.GetSchemaSkipAuth()
+	class := s.FindClassByName(schema.ClassName(params.Class))
+	if class == nil {
+		return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+			"class '%s' not found in schema", params.Class)
+	}
+
+	if class.MultiTenancyConfig != nil && class.MultiTenancyConfig.Enabled {
+		return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+			"class '%s' has multi-tenancy enabled, imports into multi-tenant "+
+				"classes are not supported", params.Class)
+	}
+
+	for prop, field := range params.Mapping {
+		if findProperty(class, prop) == nil {
+			return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+				"mapping: class '%s' has no property '%s'", params.Class, prop)
+		}
+
+		if field == "" {
+			return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+				"mapping: no field set for property '%s'", prop)
+		}
+	}
+
+	source := map[string]interface{}{}
+	if params.Source != nil {
+		asMap, ok := params.Source.(map[string]interface{})
+		if !ok {
+			return nil, nil, nil, errortypes.New(errortypes.KindValidation,
+				"field 'source' must be an object, got %T", params.Source)
+		}
+		source = asMap
+	}
+
+	connector, err := i.connectors.SourceConnector(params.Connector)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := connector.ValidateSource(source); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return class, connector, source, nil
+}
+
+func (i *Importer) setStatus(params models.Import) {
+	i.Lock()
+	defer i.Unlock()
+
+	i.imports[params.ID] = copyStatus(params)
+}
+
+// copyStatus makes sure the status returned to a client is never modified
+// by the running import
+func copyStatus(in models.Import) *models.Import {
+	if in.Meta != nil {
+		meta := *in.Meta
+		meta.Errors = append([]string{}, in.Meta.Errors...)
+		in.Meta = &meta
+	}
+
+	return &in
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return prop
+		}
+	}
+
+	return nil
+}
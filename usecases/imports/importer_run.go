@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package imports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/sirupsen/logrus"
+)
+
+// maxErrors is the number of record errors which are kept in the status,
+// the remaining ones are only counted
+const maxErrors = 20
+
+func (i *Importer) run(principal *models.Principal, class *models.Class,
+	connector modulecapabilities.SourceConnector,
+	source map[string]interface{}, params models.Import) {
+	ctx := context.Background()
+
+	i.logBase(params, "import_begin").Debug("import started")
+
+	err := i.runImport(ctx, principal, class, connector, source, &params)
+	params.Meta.Completed = strfmt.DateTime(time.Now())
+	if err != nil {
+		params.Status = models.ImportStatusFailed
+		params.Error = err.Error()
+		i.setStatus(params)
+		i.logBase(params, "import_failed").WithError(err).Error("import failed")
+		return
+	}
+
+	params.Status = models.ImportStatusCompleted
+	i.setStatus(params)
+	i.logBase(params, "import_succeeded").Info("import completed")
+}
+
+// runImport reads all records of the source and adds them in batches. Records
+// which cannot be mapped or added are counted as failed, but do not stop the
+// import. Only errors of the source or of the batch as a whole fail it.
+func (i *Importer) runImport(ctx context.Context, principal *models.Principal,
+	class *models.Class, connector modulecapabilities.SourceConnector,
+	source map[string]interface{}, params *models.Import) error {
+	batch := make([]*models.Object, 0, params.BatchSize)
+	// the number of the record each object of the batch was read from
+	recordNumbers := make([]int64, 0, params.BatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		res, err := i.batchManager.AddObjects(ctx, principal, batch, nil)
+		if err != nil {
+			return errors.Wrap(err, "add batch")
+		}
+
+		for _, obj := range res {
+			if obj.Err != nil {
+				recordFailed(params.Meta, recordNumbers[obj.OriginalIndex], obj.Err)
+				continue
+			}
+			params.Meta.Imported++
+		}
+
+		batch = batch[:0]
+		recordNumbers = recordNumbers[:0]
+		i.setStatus(*params)
+		return nil
+	}
+
+	err := connector.ReadRecords(ctx, source, func(record map[string]interface{}) error {
+		params.Meta.Read++
+
+		obj, err := mapRecord(class, params.Mapping, params.IDField, record)
+		if err != nil {
+			recordFailed(params.Meta, params.Meta.Read, err)
+			return nil
+		}
+
+		batch = append(batch, obj)
+		recordNumbers = append(recordNumbers, params.Meta.Read)
+		if int64(len(batch)) < params.BatchSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if err != nil {
+		return errors.Wrap(err, "read source")
+	}
+
+	return flush()
+}
+
+// recordFailed counts the failed record, the error is only kept for the
+// first records to limit the size of the status
+func recordFailed(meta *models.ImportMeta, record int64, err error) {
+	meta.Failed++
+	if len(meta.Errors) < maxErrors {
+		meta.Errors = append(meta.Errors, fmt.Sprintf("record %d: %v", record, err))
+	}
+}
+
+func (i *Importer) logBase(params models.Import, event string) *logrus.Entry {
+	return i.logger.WithField("action", "import_run").
+		WithField("event", event).
+		WithField("id", params.ID).
+		WithField("connector", params.Connector).
+		WithField("class", params.Class).
+		WithField("read", params.Meta.Read).
+		WithField("imported", params.Meta.Imported).
+		WithField("failed", params.Meta.Failed)
+}
@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package imports
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	testhelper "github.com/semi-technologies/weaviate/test/helper"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newNullLogger() *logrus.Logger {
+	log, _ := test.NewNullLogger()
+	return log
+}
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{
+							Name:     "title",
+							DataType: []string{string(schema.DataTypeText)},
+						},
+						{
+							Name:     "words",
+							DataType: []string{string(schema.DataTypeInt)},
+						},
+					},
+				},
+				{
+					Class: "TenantArticle",
+					MultiTenancyConfig: &models.MultiTenancyConfig{
+						Enabled: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func testRecords() []map[string]interface{} {
+	return []map[string]interface{}{
+		{"headline": "First", "meta": map[string]interface{}{"words": "120"}},
+		{"headline": "invalid", "meta": map[string]interface{}{"words": "80"}},
+		{"headline": "Third", "meta": map[string]interface{}{"words": "many"}},
+		{"headline": "Fourth"},
+		{"headline": "Fifth", "meta": map[string]interface{}{"words": "50"}},
+	}
+}
+
+func Test_Importer(t *testing.T) {
+	newImporter := func(records []map[string]interface{}) (*Importer, *fakeBatchManager) {
+		bm := &fakeBatchManager{}
+		connectors := &fakeConnectorProvider{connector: &fakeConnector{records: records}}
+		return New(&fakeSchemaManager{schema: testSchema()}, connectors, bm,
+			&fakeAuthorizer{}, newNullLogger()), bm
+	}
+
+	awaitStatus := func(t *testing.T, importer *Importer, imp *models.Import,
+		expected string) *models.Import {
+		testhelper.AssertEventuallyEqual(t, expected, func() interface{} {
+			status, err := importer.Get(context.Background(), nil, imp.ID)
+			require.Nil(t, err)
+			require.NotNil(t, status)
+			return status.Status
+		})
+
+		status, err := importer.Get(context.Background(), nil, imp.ID)
+		require.Nil(t, err)
+		return status
+	}
+
+	t.Run("importing records", func(t *testing.T) {
+		importer, bm := newImporter(testRecords())
+
+		imp, err := importer.Schedule(context.Background(), nil, models.Import{
+			Connector: "import-fake",
+			Class:     "Article",
+			Mapping: map[string]string{
+				"title": "headline",
+				"words": "meta.words",
+			},
+			BatchSize: 2,
+		})
+		require.Nil(t, err)
+		assert.Len(t, imp.ID, 36, "an id was assigned")
+		assert.Equal(t, models.ImportStatusRunning, imp.Status)
+
+		status := awaitStatus(t, importer, imp, models.ImportStatusCompleted)
+		assert.Equal(t, "", status.Error)
+		require.NotNil(t, status.Meta)
+		assert.Equal(t, int64(5), status.Meta.Read)
+		assert.Equal(t, int64(3), status.Meta.Imported)
+		assert.Equal(t, int64(2), status.Meta.Failed)
+		assert.Equal(t, []string{
+			"record 2: invalid title",
+			"record 3: field 'meta.words': 'many' is not a valid int",
+		}, status.Meta.Errors)
+		assert.False(t, time.Time(status.Meta.Completed).Before(time.Time(status.Meta.Started)))
+
+		assert.Equal(t, 2, bm.batchCount())
+		objs := bm.objects()
+		require.Len(t, objs, 4)
+		assert.Equal(t, "Article", objs[0].Class)
+		assert.Equal(t, map[string]interface{}{
+			"title": "First",
+			"words": json.Number("120"),
+		}, objs[0].Properties)
+		assert.Equal(t, map[string]interface{}{"title": "Fourth"}, objs[2].Properties,
+			"missing fields are skipped")
+	})
+
+	t.Run("a failing source fails the import", func(t *testing.T) {
+		records := append(testRecords()[:1], nil)
+		importer, bm := newImporter(records)
+
+		imp, err := importer.Schedule(context.Background(), nil, models.Import{
+			Connector: "import-fake",
+			Class:     "Article",
+		})
+		require.Nil(t, err)
+
+		status := awaitStatus(t, importer, imp, models.ImportStatusFailed)
+		assert.Equal(t, "read source: source is gone", status.Error)
+		assert.Equal(t, int64(1), status.Meta.Read)
+		assert.Equal(t, 0, bm.batchCount(), "the incomplete batch is not added")
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		importer, _ := newImporter(nil)
+
+		tests := []models.Import{
+			{Class: "Article"},
+			{Connector: "import-fake"},
+			{Connector: "import-other", Class: "Article"},
+			{Connector: "import-fake", Class: "Missing"},
+			{Connector: "import-fake", Class: "TenantArticle"},
+			{Connector: "import-fake", Class: "Article", BatchSize: -1},
+			{Connector: "import-fake", Class: "Article", Mapping: map[string]string{"missing": "field"}},
+			{Connector: "import-fake", Class: "Article", Mapping: map[string]string{"title": ""}},
+			{Connector: "import-fake", Class: "Article", Source: "path"},
+			{Connector: "import-fake", Class: "Article", Source: map[string]interface{}{"invalid": true}},
+		}
+
+		for _, params := range tests {
+			_, err := importer.Schedule(context.Background(), nil, params)
+			assert.True(t, errortypes.Is(err, errortypes.KindValidation),
+				"params %v: %v", params, err)
+		}
+	})
+
+	t.Run("unknown imports", func(t *testing.T) {
+		importer, _ := newImporter(nil)
+
+		status, err := importer.Get(context.Background(), nil,
+			"8c2e6a3b-4f1d-4a52-9c1e-2b7d0f4e9a11")
+		require.Nil(t, err)
+		assert.Nil(t, status)
+	})
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package imports
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// mapRecord turns a record of the source into an object of the class. If no
+// mapping is set, every field which has the name of a property of the class
+// is mapped to it, other fields are ignored. Missing and null values are
+// skipped, so the property is not set on the object.
+func mapRecord(class *models.Class, mapping map[string]string, idField string,
+	record map[string]interface{}) (*models.Object, error) {
+	if len(mapping) == 0 {
+		mapping = make(map[string]string, len(class.Properties))
+		for _, prop := range class.Properties {
+			mapping[prop.Name] = prop.Name
+		}
+	}
+
+	props := map[string]interface{}{}
+	for name, field := range mapping {
+		value, ok := lookupField(record, field)
+		if !ok || value == nil {
+			continue
+		}
+
+		prop := findProperty(class, name)
+		if prop == nil {
+			return nil, errors.Errorf("class '%s' has no property '%s'", class.Class, name)
+		}
+
+		converted, err := convertValue(prop, value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field '%s'", field)
+		}
+
+		if converted != nil {
+			props[name] = converted
+		}
+	}
+
+	obj := &models.Object{
+		Class:      class.Class,
+		Properties: props,
+	}
+
+	if idField != "" {
+		value, ok := lookupField(record, idField)
+		if !ok || value == nil {
+			return nil, errors.Errorf("id field '%s' is not set", idField)
+		}
+
+		id, ok := value.(string)
+		if !ok || !strfmt.IsUUID(id) {
+			return nil, errors.Errorf("id field '%s' must contain a uuid, got %v",
+				idField, value)
+		}
+
+		obj.ID = strfmt.UUID(id)
+	}
+
+	return obj, nil
+}
+
+// lookupField returns the value of the field, fields of nested records are
+// addressed with dots
+func lookupField(record map[string]interface{}, field string) (interface{}, bool) {
+	if value, ok := record[field]; ok {
+		return value, true
+	}
+
+	parts := strings.SplitN(field, ".", 2)
+	if len(parts) != 2 {
+		return nil, false
+	}
+
+	nested, ok := record[parts[0]].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	return lookupField(nested, parts[1])
+}
+
+// convertValue parses text values of sources without types, such as CSV
+// files, into the type of the property. All other values are passed on
+// unchanged and validated by the batch manager. Empty text values of
+// non-text properties are treated as missing, so nil is returned.
+func convertValue(prop *models.Property, value interface{}) (interface{}, error) {
+	text, ok := value.(string)
+	if !ok || len(prop.DataType) != 1 {
+		return value, nil
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeInt:
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+		if _, err := strconv.ParseInt(text, 10, 64); err != nil {
+			return nil, errors.Errorf("'%s' is not a valid int", text)
+		}
+		return json.Number(text), nil
+	case schema.DataTypeNumber:
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return nil, errors.Errorf("'%s' is not a valid number", text)
+		}
+		return json.Number(text), nil
+	case schema.DataTypeBoolean:
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, errors.Errorf("'%s' is not a valid boolean", text)
+		}
+		return b, nil
+	default:
+		return value, nil
+	}
+}
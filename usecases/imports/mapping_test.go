@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package imports
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MapRecord(t *testing.T) {
+	class := &models.Class{
+		Class: "Product",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{string(schema.DataTypeText)}},
+			{Name: "stock", DataType: []string{string(schema.DataTypeInt)}},
+			{Name: "price", DataType: []string{string(schema.DataTypeNumber)}},
+			{Name: "available", DataType: []string{string(schema.DataTypeBoolean)}},
+		},
+	}
+
+	t.Run("without a mapping", func(t *testing.T) {
+		obj, err := mapRecord(class, nil, "", map[string]interface{}{
+			"name":      "Chair",
+			"stock":     "12",
+			"price":     "49.95",
+			"available": "true",
+			"unknown":   "ignored",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, "Product", obj.Class)
+		assert.Equal(t, strfmt.UUID(""), obj.ID)
+		assert.Equal(t, map[string]interface{}{
+			"name":      "Chair",
+			"stock":     json.Number("12"),
+			"price":     json.Number("49.95"),
+			"available": true,
+		}, obj.Properties)
+	})
+
+	t.Run("with a mapping and an id field", func(t *testing.T) {
+		obj, err := mapRecord(class, map[string]string{
+			"name":  "product.title",
+			"stock": "inventory",
+		}, "uuid", map[string]interface{}{
+			"uuid":      "8c2e6a3b-4f1d-4a52-9c1e-2b7d0f4e9a11",
+			"product":   map[string]interface{}{"title": "Table"},
+			"inventory": json.Number("3"),
+			"name":      "not mapped",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, strfmt.UUID("8c2e6a3b-4f1d-4a52-9c1e-2b7d0f4e9a11"), obj.ID)
+		assert.Equal(t, map[string]interface{}{
+			"name":  "Table",
+			"stock": json.Number("3"),
+		}, obj.Properties)
+	})
+
+	t.Run("empty and null values are skipped", func(t *testing.T) {
+		obj, err := mapRecord(class, nil, "", map[string]interface{}{
+			"name":  "",
+			"stock": " ",
+			"price": nil,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"name": ""}, obj.Properties)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		records := []map[string]interface{}{
+			{"stock": "1.5"},
+			{"price": "cheap"},
+			{"available": "maybe"},
+		}
+
+		for _, record := range records {
+			_, err := mapRecord(class, nil, "", record)
+			assert.NotNil(t, err, "record %v", record)
+		}
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		records := []map[string]interface{}{
+			{},
+			{"uuid": "not-a-uuid"},
+			{"uuid": json.Number("7")},
+		}
+
+		for _, record := range records {
+			_, err := mapRecord(class, nil, "uuid", record)
+			assert.NotNil(t, err, "record %v", record)
+		}
+	})
+}
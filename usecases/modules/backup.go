@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+)
+
+// BackupBackend returns the enabled module with the BackupBackend
+// capability which matches the name. Backends can be referred to by their
+// module name, such as "backup-filesystem", or without the "backup-" prefix.
+func (m *Provider) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	for _, candidate := range []string{name, "backup-" + name} {
+		mod := m.GetByName(candidate)
+		if mod == nil {
+			continue
+		}
+
+		backend, ok := mod.(modulecapabilities.BackupBackend)
+		if !ok {
+			return nil, errortypes.New(errortypes.KindValidation,
+				"module %q is not a backup backend", candidate)
+		}
+
+		return backend, nil
+	}
+
+	return nil, errortypes.New(errortypes.KindValidation,
+		"backup backend %q is not enabled", name)
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+)
+
+// ChunkObject runs every module with the Chunker capability which is
+// configured in the moduleConfig of the object's class. Classes without any
+// chunker config produce no chunks.
+func (m *Provider) ChunkObject(ctx context.Context,
+	obj *models.Object) ([]*models.Object, error) {
+	class, err := m.getClass(obj.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var out []*models.Object
+	for _, mod := range m.GetAll() {
+		chunker, ok := mod.(modulecapabilities.Chunker)
+		if !ok {
+			continue
+		}
+
+		if _, ok := moduleConfig[mod.Name()]; !ok {
+			continue
+		}
+
+		chunks, err := chunker.ChunkObject(ctx, obj,
+			NewClassBasedModuleConfig(class, mod.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "module %q", mod.Name())
+		}
+
+		out = append(out, chunks...)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+)
+
+// SourceConnector returns the enabled module with the SourceConnector
+// capability which matches the name. Connectors can be referred to by their
+// module name, such as "import-filesystem", or without the "import-" prefix.
+func (m *Provider) SourceConnector(name string) (modulecapabilities.SourceConnector, error) {
+	for _, candidate := range []string{name, "import-" + name} {
+		mod := m.GetByName(candidate)
+		if mod == nil {
+			continue
+		}
+
+		connector, ok := mod.(modulecapabilities.SourceConnector)
+		if !ok {
+			return nil, errortypes.New(errortypes.KindValidation,
+				"module %q is not a source connector", candidate)
+		}
+
+		return connector, nil
+	}
+
+	return nil, errortypes.New(errortypes.KindValidation,
+		"source connector %q is not enabled", name)
+}
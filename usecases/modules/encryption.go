@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+)
+
+// EncryptionKeyProvider returns the enabled module with the
+// EncryptionKeyProvider capability which matches the name
+func (m *Provider) EncryptionKeyProvider(name string) (modulecapabilities.EncryptionKeyProvider, error) {
+	mod := m.GetByName(name)
+	if mod == nil {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"encryption key provider %q is not enabled", name)
+	}
+
+	provider, ok := mod.(modulecapabilities.EncryptionKeyProvider)
+	if !ok {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"module %q is not an encryption key provider", name)
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+const generateAdditionalProperty = "generate"
+
+var promptPlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// generateParams are the arguments of the generate additional property. An
+// empty Prompt or Task means the respective result is not generated.
+type generateParams struct {
+	Prompt string
+	Task   string
+
+	// Properties limits the text properties sent along with the task, all
+	// text properties are sent if it is empty
+	Properties []string
+}
+
+// generateResult is the generate additional property of a single result
+type generateResult struct {
+	SingleResult  *string `json:"singleResult"`
+	GroupedResult *string `json:"groupedResult"`
+	Error         *string `json:"error"`
+}
+
+// generator provides the generate additional property for a generative
+// module, see modulecapabilities.AdditionalGenerativeProperty
+type generator struct {
+	module modulecapabilities.AdditionalGenerativeProperty
+}
+
+func newGenerateAdditionalProperty(
+	module modulecapabilities.AdditionalGenerativeProperty,
+) modulecapabilities.AdditionalProperty {
+	g := &generator{module: module}
+	return modulecapabilities.AdditionalProperty{
+		GraphQLNames:           []string{generateAdditionalProperty},
+		GraphQLFieldFunction:   generateField,
+		GraphQLExtractFunction: extractGenerateParams,
+		SearchFunctions: modulecapabilities.AdditionalSearch{
+			ExploreGet:  g.generate,
+			ExploreList: g.generate,
+		},
+	}
+}
+
+func generateField(classname string) *graphql.Field {
+	return &graphql.Field{
+		Args: graphql.FieldConfigArgument{
+			"singleResult": &graphql.ArgumentConfig{
+				Description: "Generate a text for every result. The prompt may " +
+					"contain text properties of the result as {propertyName}",
+				Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalGenerateSingleResultInpObj", classname),
+					Fields: graphql.InputObjectConfigFieldMap{
+						"prompt": &graphql.InputObjectFieldConfig{
+							Type: graphql.NewNonNull(graphql.String),
+						},
+					},
+				}),
+			},
+			"groupedResult": &graphql.ArgumentConfig{
+				Description: "Generate a single text from all results, it is " +
+					"returned on the first result",
+				Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalGenerateGroupedResultInpObj", classname),
+					Fields: graphql.InputObjectConfigFieldMap{
+						"task": &graphql.InputObjectFieldConfig{
+							Type: graphql.NewNonNull(graphql.String),
+						},
+						"properties": &graphql.InputObjectFieldConfig{
+							Type: graphql.NewList(graphql.String),
+						},
+					},
+				}),
+			},
+		},
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalGenerate", classname),
+			Fields: graphql.Fields{
+				"singleResult":  &graphql.Field{Type: graphql.String},
+				"groupedResult": &graphql.Field{Type: graphql.String},
+				"error":         &graphql.Field{Type: graphql.String},
+			},
+		}),
+	}
+}
+
+func extractGenerateParams(args []*ast.Argument) interface{} {
+	out := &generateParams{}
+
+	for _, arg := range args {
+		obj, ok := arg.Value.(*ast.ObjectValue)
+		if !ok {
+			continue
+		}
+
+		for _, field := range obj.Fields {
+			switch arg.Name.Value + "." + field.Name.Value {
+			case "singleResult.prompt":
+				out.Prompt = stringValue(field.Value)
+			case "groupedResult.task":
+				out.Task = stringValue(field.Value)
+			case "groupedResult.properties":
+				if list, ok := field.Value.(*ast.ListValue); ok {
+					for _, value := range list.Values {
+						out.Properties = append(out.Properties, stringValue(value))
+					}
+				}
+			default:
+				// ignore what we don't recognize
+			}
+		}
+	}
+
+	return out
+}
+
+func stringValue(value ast.Value) string {
+	if str, ok := value.(*ast.StringValue); ok {
+		return str.Value
+	}
+
+	return ""
+}
+
+// generate calls the module for every result and once for all results
+// together. A failed call does not fail the query, its error is returned as
+// part of the generate additional property of the affected result.
+func (g *generator) generate(ctx context.Context, in []search.Result,
+	params interface{}, limit *int,
+	argumentModuleParams map[string]interface{}) ([]search.Result, error) {
+	p, ok := params.(*generateParams)
+	if !ok {
+		return nil, errors.New("wrong parameters")
+	}
+
+	if p.Prompt == "" && p.Task == "" {
+		return nil, errors.New("either singleResult or groupedResult needs to be set")
+	}
+
+	if len(in) == 0 {
+		return in, nil
+	}
+
+	texts := make([]map[string]string, len(in))
+	results := make([]*generateResult, len(in))
+	for i := range in {
+		texts[i] = textProperties(in[i])
+		results[i] = &generateResult{}
+	}
+
+	if p.Prompt != "" {
+		for i := range in {
+			res, err := g.module.GenerateSingleResult(ctx,
+				fillPrompt(p.Prompt, texts[i]), texts[i])
+			if err != nil {
+				results[i].addError(err)
+				continue
+			}
+			results[i].SingleResult = res.Result
+		}
+	}
+
+	if p.Task != "" {
+		selected := make([]map[string]string, len(texts))
+		for i := range texts {
+			selected[i] = selectProperties(texts[i], p.Properties)
+		}
+
+		res, err := g.module.GenerateAllResults(ctx, p.Task, selected)
+		if err != nil {
+			results[0].addError(err)
+		} else {
+			results[0].GroupedResult = res.Result
+		}
+	}
+
+	for i := range in {
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+		ap[generateAdditionalProperty] = results[i]
+		in[i].AdditionalProperties = ap
+	}
+
+	return in, nil
+}
+
+func (r *generateResult) addError(err error) {
+	msg := err.Error()
+	if r.Error != nil {
+		msg = *r.Error + ", " + msg
+	}
+	r.Error = &msg
+}
+
+// textProperties of the result. Text arrays are joined into a single text.
+func textProperties(in search.Result) map[string]string {
+	out := map[string]string{}
+	props, ok := in.Schema.(map[string]interface{})
+	if !ok {
+		return out
+	}
+
+	for name, value := range props {
+		switch v := value.(type) {
+		case string:
+			out[name] = v
+		case []string:
+			out[name] = strings.Join(v, ", ")
+		case []interface{}:
+			var texts []string
+			for _, elem := range v {
+				if text, ok := elem.(string); ok {
+					texts = append(texts, text)
+				}
+			}
+			if len(texts) > 0 {
+				out[name] = strings.Join(texts, ", ")
+			}
+		}
+	}
+
+	return out
+}
+
+// fillPrompt replaces every {property} placeholder with the text of the
+// property. Placeholders which do not match a text property are kept as they
+// are, as the prompt might contain braces for other reasons.
+func fillPrompt(prompt string, texts map[string]string) string {
+	return promptPlaceholder.ReplaceAllStringFunc(prompt, func(match string) string {
+		if text, ok := texts[match[1:len(match)-1]]; ok {
+			return text
+		}
+		return match
+	})
+}
+
+func selectProperties(texts map[string]string, names []string) map[string]string {
+	if len(names) == 0 {
+		return texts
+	}
+
+	out := map[string]string{}
+	for _, name := range names {
+		if text, ok := texts[name]; ok {
+			out[name] = text
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateExtractParams(t *testing.T) {
+	args := []*ast.Argument{
+		{
+			Name: &ast.Name{Value: "singleResult"},
+			Value: &ast.ObjectValue{Fields: []*ast.ObjectField{
+				{
+					Name:  &ast.Name{Value: "prompt"},
+					Value: &ast.StringValue{Value: "Describe {title}"},
+				},
+			}},
+		},
+		{
+			Name: &ast.Name{Value: "groupedResult"},
+			Value: &ast.ObjectValue{Fields: []*ast.ObjectField{
+				{
+					Name:  &ast.Name{Value: "task"},
+					Value: &ast.StringValue{Value: "Summarize"},
+				},
+				{
+					Name: &ast.Name{Value: "properties"},
+					Value: &ast.ListValue{Values: []ast.Value{
+						&ast.StringValue{Value: "title"},
+						&ast.StringValue{Value: "body"},
+					}},
+				},
+			}},
+		},
+	}
+
+	expected := &generateParams{
+		Prompt:     "Describe {title}",
+		Task:       "Summarize",
+		Properties: []string{"title", "body"},
+	}
+	assert.Equal(t, expected, extractGenerateParams(args))
+}
+
+func TestGenerateFillPrompt(t *testing.T) {
+	texts := map[string]string{"title": "Hello", "body": "World"}
+
+	assert.Equal(t, "Hello World", fillPrompt("{title} {body}", texts))
+	assert.Equal(t, "Hello {unknown} {}", fillPrompt("{title} {unknown} {}", texts))
+}
+
+func TestGenerate(t *testing.T) {
+	results := func() []search.Result {
+		return []search.Result{
+			{Schema: map[string]interface{}{
+				"title": "First",
+				"tags":  []interface{}{"a", "b"},
+				"count": float64(3),
+			}},
+			{Schema: map[string]interface{}{"title": "Second"}},
+		}
+	}
+
+	generated := func(t *testing.T, res search.Result) *generateResult {
+		out, ok := res.AdditionalProperties[generateAdditionalProperty].(*generateResult)
+		require.True(t, ok)
+		return out
+	}
+
+	t.Run("without a prompt or task", func(t *testing.T) {
+		g := &generator{module: &fakeGenerativeModule{}}
+		_, err := g.generate(context.Background(), results(), &generateParams{}, nil, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("single results", func(t *testing.T) {
+		g := &generator{module: &fakeGenerativeModule{}}
+		res, err := g.generate(context.Background(), results(),
+			&generateParams{Prompt: "Describe {title} ({tags})"}, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		first := generated(t, res[0])
+		require.NotNil(t, first.SingleResult)
+		assert.Equal(t, "Describe First (a, b)", *first.SingleResult)
+		assert.Nil(t, first.GroupedResult)
+		assert.Nil(t, first.Error)
+
+		second := generated(t, res[1])
+		require.NotNil(t, second.SingleResult)
+		assert.Equal(t, "Describe Second ({tags})", *second.SingleResult)
+	})
+
+	t.Run("grouped result is returned on the first result", func(t *testing.T) {
+		g := &generator{module: &fakeGenerativeModule{}}
+		res, err := g.generate(context.Background(), results(),
+			&generateParams{Task: "Summarize", Properties: []string{"title"}}, nil, nil)
+		require.Nil(t, err)
+
+		first := generated(t, res[0])
+		require.NotNil(t, first.GroupedResult)
+		assert.Equal(t, "Summarize: First, Second", *first.GroupedResult)
+		assert.Nil(t, first.SingleResult)
+
+		second := generated(t, res[1])
+		assert.Nil(t, second.GroupedResult)
+	})
+
+	t.Run("failures are returned per result", func(t *testing.T) {
+		g := &generator{module: &fakeGenerativeModule{failOn: "Second"}}
+		res, err := g.generate(context.Background(), results(),
+			&generateParams{Prompt: "{title}", Task: "Summarize"}, nil, nil)
+		require.Nil(t, err)
+
+		first := generated(t, res[0])
+		require.NotNil(t, first.SingleResult)
+		assert.Equal(t, "First", *first.SingleResult)
+		require.NotNil(t, first.Error)
+		assert.Contains(t, *first.Error, "cannot generate")
+
+		second := generated(t, res[1])
+		assert.Nil(t, second.SingleResult)
+		require.NotNil(t, second.Error)
+		assert.Contains(t, *second.Error, "cannot generate")
+	})
+}
+
+func TestGenerateProvider(t *testing.T) {
+	p := NewProvider()
+	p.Register(&fakeGenerativeModule{})
+
+	assert.Contains(t, p.GraphQLAdditionalFieldNames(), generateAdditionalProperty)
+	assert.True(t, p.isDefaultModule("generative-fake"))
+}
+
+type fakeGenerativeModule struct {
+	failOn string
+}
+
+func (m *fakeGenerativeModule) Name() string {
+	return "generative-fake"
+}
+
+func (m *fakeGenerativeModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *fakeGenerativeModule) RootHandler() http.Handler {
+	return nil
+}
+
+func (m *fakeGenerativeModule) GenerateSingleResult(ctx context.Context,
+	prompt string, textProperties map[string]string,
+) (*modulecapabilities.GenerateResult, error) {
+	if m.failOn != "" && textProperties["title"] == m.failOn {
+		return nil, errors.New("cannot generate")
+	}
+	return &modulecapabilities.GenerateResult{Result: &prompt}, nil
+}
+
+func (m *fakeGenerativeModule) GenerateAllResults(ctx context.Context,
+	task string, textProperties []map[string]string,
+) (*modulecapabilities.GenerateResult, error) {
+	titles := make([]string, len(textProperties))
+	for i, props := range textProperties {
+		if m.failOn != "" && props["title"] == m.failOn {
+			return nil, errors.New("cannot generate")
+		}
+		titles[i] = props["title"]
+	}
+
+	result := fmt.Sprintf("%s: %s", task, strings.Join(titles, ", "))
+	return &modulecapabilities.GenerateResult{Result: &result}, nil
+}
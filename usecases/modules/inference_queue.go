@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// InferencePriority determines in which queue a module call waits once the
+// inference queue is saturated
+type InferencePriority int
+
+const (
+	// PriorityInteractive is used for everything a user is actively waiting
+	// on, such as vectorizing a query
+	PriorityInteractive InferencePriority = iota
+
+	// PriorityImport is used for vectorizing objects at import time
+	PriorityImport
+
+	numInferencePriorities
+)
+
+func (p InferencePriority) String() string {
+	switch p {
+	case PriorityInteractive:
+		return "interactive"
+	case PriorityImport:
+		return "import"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	DefaultInferenceInteractiveWeight = 4
+	DefaultInferenceImportWeight      = 1
+)
+
+// InferenceQueue limits the number of concurrent calls into modules. As long
+// as there are free slots, calls are admitted immediately. Once saturated,
+// waiting calls are admitted in weighted round-robin order across the
+// priorities, so that a large import can never starve interactive queries,
+// while queries can't starve an import either.
+type InferenceQueue struct {
+	sync.Mutex
+	slots    int
+	inFlight int
+	weights  [numInferencePriorities]int
+	credits  [numInferencePriorities]int
+	waiting  [numInferencePriorities]*list.List
+	admitted [numInferencePriorities]int64
+}
+
+// NewInferenceQueue creates a queue with the given number of concurrent
+// slots. A non-positive slot count disables queueing entirely, non-positive
+// weights fall back to their defaults.
+func NewInferenceQueue(slots, interactiveWeight, importWeight int) *InferenceQueue {
+	if slots <= 0 {
+		return nil
+	}
+
+	if interactiveWeight <= 0 {
+		interactiveWeight = DefaultInferenceInteractiveWeight
+	}
+
+	if importWeight <= 0 {
+		importWeight = DefaultInferenceImportWeight
+	}
+
+	q := &InferenceQueue{slots: slots}
+	q.weights[PriorityInteractive] = interactiveWeight
+	q.weights[PriorityImport] = importWeight
+	q.credits = q.weights
+	for i := range q.waiting {
+		q.waiting[i] = list.New()
+	}
+
+	return q
+}
+
+// Acquire blocks until the call is admitted or the context is cancelled. The
+// returned release function must be called exactly once when the call has
+// completed. Acquire on a nil queue always admits immediately.
+func (q *InferenceQueue) Acquire(ctx context.Context,
+	priority InferencePriority) (func(), error) {
+	if q == nil {
+		return func() {}, nil
+	}
+
+	q.Lock()
+	if q.inFlight < q.slots && q.totalWaiting() == 0 {
+		q.inFlight++
+		q.admitted[priority]++
+		q.Unlock()
+		return q.release, nil
+	}
+
+	ready := make(chan struct{})
+	elem := q.waiting[priority].PushBack(ready)
+	q.Unlock()
+
+	select {
+	case <-ready:
+		return q.release, nil
+	case <-ctx.Done():
+		q.Lock()
+		defer q.Unlock()
+		select {
+		case <-ready:
+			// we were admitted concurrently with the cancellation, hand the
+			// slot on to the next waiter
+			q.inFlight--
+			q.admitNext()
+		default:
+			q.waiting[priority].Remove(elem)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// SetSlots changes the number of concurrent slots at runtime. If the queue is
+// grown, waiting calls are admitted right away. If it is shrunk, calls which
+// are already in flight are not affected, but no new calls are admitted until
+// enough of them have completed.
+func (q *InferenceQueue) SetSlots(slots int) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.slots = slots
+	q.admitNext()
+}
+
+// Slots returns the number of concurrent slots, a nil queue has none
+func (q *InferenceQueue) Slots() int {
+	if q == nil {
+		return 0
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	return q.slots
+}
+
+func (q *InferenceQueue) release() {
+	q.Lock()
+	defer q.Unlock()
+
+	q.inFlight--
+	q.admitNext()
+}
+
+// admitNext must be called with the lock held
+func (q *InferenceQueue) admitNext() {
+	for q.inFlight < q.slots && q.totalWaiting() > 0 {
+		priority := q.nextPriority()
+		front := q.waiting[priority].Front()
+		q.waiting[priority].Remove(front)
+		q.credits[priority]--
+		q.inFlight++
+		q.admitted[priority]++
+		close(front.Value.(chan struct{}))
+	}
+}
+
+// nextPriority picks the highest priority which has both waiters and
+// remaining credits. Once no waiting priority has credits left, all credits
+// are refilled according to the weights.
+func (q *InferenceQueue) nextPriority() InferencePriority {
+	for {
+		for p := InferencePriority(0); p < numInferencePriorities; p++ {
+			if q.waiting[p].Len() > 0 && q.credits[p] > 0 {
+				return p
+			}
+		}
+
+		q.credits = q.weights
+	}
+}
+
+func (q *InferenceQueue) totalWaiting() int {
+	total := 0
+	for _, waiting := range q.waiting {
+		total += waiting.Len()
+	}
+	return total
+}
+
+// Collect reports the queue depth and the admission counters to the
+// monitoring registry
+func (q *InferenceQueue) Collect() []*monitoring.Family {
+	if q == nil {
+		return nil
+	}
+
+	q.Lock()
+	inFlight := q.inFlight
+	var depth [numInferencePriorities]int
+	for p := range q.waiting {
+		depth[p] = q.waiting[p].Len()
+	}
+	admitted := q.admitted
+	q.Unlock()
+
+	depthFamily := monitoring.NewGauge("weaviate_inference_queue_depth",
+		"Number of module calls waiting for a free slot", "priority")
+	admittedFamily := monitoring.NewCounter("weaviate_inference_admitted_total",
+		"Number of module calls admitted by the inference queue", "priority")
+	for p := InferencePriority(0); p < numInferencePriorities; p++ {
+		depthFamily.Add(float64(depth[p]), p.String())
+		admittedFamily.Add(float64(admitted[p]), p.String())
+	}
+
+	return []*monitoring.Family{
+		monitoring.NewGauge("weaviate_inference_in_flight",
+			"Number of module calls currently being processed").
+			Add(float64(inFlight)),
+		depthFamily,
+		admittedFamily,
+	}
+}
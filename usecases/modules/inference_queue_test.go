@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferenceQueueDisabled(t *testing.T) {
+	q := NewInferenceQueue(0, 0, 0)
+	require.Nil(t, q)
+
+	release, err := q.Acquire(context.Background(), PriorityImport)
+	require.Nil(t, err)
+	release()
+}
+
+func TestInferenceQueueWeightedAdmission(t *testing.T) {
+	q := NewInferenceQueue(1, 2, 1)
+
+	// occupy the only slot, so that all following calls have to queue
+	blocker, err := q.Acquire(context.Background(), PriorityImport)
+	require.Nil(t, err)
+
+	var order []InferencePriority
+	orderLock := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	queued := 0
+
+	enqueue := func(p InferencePriority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := q.Acquire(context.Background(), p)
+			require.Nil(t, err)
+			orderLock.Lock()
+			order = append(order, p)
+			orderLock.Unlock()
+			release()
+		}()
+
+		// make sure the goroutine is queued before the next one
+		queued++
+		waitForDepth(t, q, queued)
+	}
+
+	for i := 0; i < 3; i++ {
+		enqueue(PriorityImport)
+	}
+	for i := 0; i < 3; i++ {
+		enqueue(PriorityInteractive)
+	}
+
+	blocker()
+	wg.Wait()
+
+	assert.Equal(t, []InferencePriority{
+		PriorityInteractive, PriorityInteractive, PriorityImport,
+		PriorityInteractive, PriorityImport, PriorityImport,
+	}, order)
+}
+
+func TestInferenceQueueCancellation(t *testing.T) {
+	q := NewInferenceQueue(1, 0, 0)
+	blocker, err := q.Acquire(context.Background(), PriorityImport)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Acquire(ctx, PriorityInteractive)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	blocker()
+
+	release, err := q.Acquire(context.Background(), PriorityInteractive)
+	require.Nil(t, err)
+	release()
+
+	registry := monitoring.NewRegistry()
+	registry.Register(q)
+	buf := &bytes.Buffer{}
+	require.Nil(t, registry.WriteText(buf))
+	assert.Contains(t, buf.String(), "weaviate_inference_in_flight 0\n")
+	assert.Contains(t, buf.String(),
+		`weaviate_inference_queue_depth{priority="interactive"} 0`)
+	assert.Contains(t, buf.String(),
+		`weaviate_inference_admitted_total{priority="interactive"} 1`)
+}
+
+func TestInferenceQueueSetSlots(t *testing.T) {
+	q := NewInferenceQueue(1, 0, 0)
+	blocker, err := q.Acquire(context.Background(), PriorityImport)
+	require.Nil(t, err)
+
+	admitted := make(chan func())
+	go func() {
+		release, err := q.Acquire(context.Background(), PriorityInteractive)
+		require.Nil(t, err)
+		admitted <- release
+	}()
+	waitForDepth(t, q, 1)
+
+	// growing the queue admits the waiting call without a release
+	q.SetSlots(2)
+	assert.Equal(t, 2, q.Slots())
+	release := <-admitted
+
+	// shrinking the queue does not affect calls in flight
+	q.SetSlots(1)
+	blocker()
+	release()
+
+	release, err = q.Acquire(context.Background(), PriorityImport)
+	require.Nil(t, err)
+	release()
+}
+
+func waitForDepth(t *testing.T, q *InferenceQueue, expected int) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		q.Lock()
+		depth := q.totalWaiting()
+		q.Unlock()
+		if depth == expected {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("queue did not reach depth %d", expected)
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// MaskObject runs the modules with the Masker capability which are
+// configured in the moduleConfig of the object's class on the properties of
+// an object that is about to be imported
+func (m *Provider) MaskObject(ctx context.Context, obj *models.Object) error {
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return m.forEachMasker(obj.Class, func(name string, masker modulecapabilities.Masker,
+		cfg moduletools.ClassConfig) error {
+		return errors.Wrapf(masker.MaskObject(ctx, props, cfg), "module %q", name)
+	})
+}
+
+// MaskResult runs the modules with the Masker capability which are
+// configured in the moduleConfig of the class on the properties of an object
+// that is returned to the principal
+func (m *Provider) MaskResult(ctx context.Context, principal *models.Principal,
+	className string, props map[string]interface{}) error {
+	if props == nil {
+		return nil
+	}
+
+	return m.forEachMasker(className, func(name string, masker modulecapabilities.Masker,
+		cfg moduletools.ClassConfig) error {
+		return errors.Wrapf(masker.MaskResult(ctx, props, principal, cfg),
+			"module %q", name)
+	})
+}
+
+func (m *Provider) forEachMasker(className string, fn func(name string,
+	masker modulecapabilities.Masker, cfg moduletools.ClassConfig) error) error {
+	sch := m.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil
+	}
+
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, mod := range m.GetAll() {
+		masker, ok := mod.(modulecapabilities.Masker)
+		if !ok {
+			continue
+		}
+
+		if _, ok := moduleConfig[mod.Name()]; !ok {
+			continue
+		}
+
+		if err := fn(mod.Name(), masker,
+			NewClassBasedModuleConfig(class, mod.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -83,6 +83,21 @@ func (p *Provider) setPerPropertyConfigDefaults(class *models.Class,
 }
 
 func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error {
+	if err := p.validateVectorizerConfig(ctx, class); err != nil {
+		return err
+	}
+
+	// sparse vectorizers and the rules of validation modules are independent
+	// of the vectorizer
+	if err := p.validateSparseVectorizers(class); err != nil {
+		return err
+	}
+
+	return p.validateRules(ctx, class)
+}
+
+func (p *Provider) validateVectorizerConfig(ctx context.Context,
+	class *models.Class) error {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return nil
@@ -35,6 +35,9 @@ type Provider struct {
 	registered             map[string]modulecapabilities.Module
 	schemaGetter           schemaGetter
 	hasMultipleVectorizers bool
+	usage                  *Usage
+	inferenceQueue         *InferenceQueue
+	startup                *StartupOrchestrator
 }
 
 type schemaGetter interface {
@@ -44,9 +47,52 @@ type schemaGetter interface {
 func NewProvider() *Provider {
 	return &Provider{
 		registered: map[string]modulecapabilities.Module{},
+		usage:      NewUsage(),
 	}
 }
 
+// Usage returns the per-class usage counters of all module calls
+func (m *Provider) Usage() *Usage {
+	return m.usage
+}
+
+// SetInferenceQueue limits the concurrency of module calls, a nil queue
+// (the default) admits all calls immediately
+func (m *Provider) SetInferenceQueue(q *InferenceQueue) {
+	m.inferenceQueue = q
+}
+
+func (m *Provider) InferenceQueue() *InferenceQueue {
+	return m.inferenceQueue
+}
+
+// Readiness returns the status of all module dependencies which are waited
+// for at startup. It is empty until Init has been called.
+func (m *Provider) Readiness() []DependencyStatus {
+	if m.startup == nil {
+		return nil
+	}
+
+	return m.startup.Status()
+}
+
+// beginCall must wrap every call into a module. It waits for admission by
+// the inference queue and tracks the call's usage. The returned function
+// must be called with the outcome of the call once it has completed.
+func (m *Provider) beginCall(ctx context.Context, class, module, operation string,
+	priority InferencePriority) (context.Context, func(err error), error) {
+	release, err := m.inferenceQueue.Acquire(ctx, priority)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "wait for inference queue")
+	}
+
+	ctx, done := m.usage.track(ctx, class, module, operation)
+	return ctx, func(err error) {
+		release()
+		done(err)
+	}, nil
+}
+
 func (m *Provider) Register(mod modulecapabilities.Module) {
 	m.registered[mod.Name()] = mod
 }
@@ -87,6 +133,9 @@ func (m *Provider) Init(ctx context.Context,
 			return errors.Wrapf(err, "init module %d (%q)", i, mod.Name())
 		}
 	}
+	if err := m.waitForReadiness(ctx, logger); err != nil {
+		return errors.Wrap(err, "wait for module dependencies")
+	}
 	for i, mod := range m.GetAll() {
 		if modDependency, ok := mod.(modulecapabilities.ModuleDependency); ok {
 			if err := modDependency.InitDependency(m.GetAllExclude(mod.Name())); err != nil {
@@ -104,6 +153,20 @@ func (m *Provider) Init(ctx context.Context,
 	return nil
 }
 
+func (m *Provider) waitForReadiness(ctx context.Context,
+	logger logrus.FieldLogger) error {
+	checkers := map[string]modulecapabilities.ReadinessChecker{}
+	for _, mod := range m.GetAll() {
+		if checker, ok := mod.(modulecapabilities.ReadinessChecker); ok {
+			checkers[mod.Name()] = checker
+		}
+	}
+
+	m.startup = NewStartupOrchestrator(DefaultStartupInitialBackoff,
+		DefaultStartupMaxBackoff, logger)
+	return m.startup.Wait(ctx, checkers)
+}
+
 func (m *Provider) validate() error {
 	searchers := map[string][]string{}
 	additionalGraphQLProps := map[string][]string{}
@@ -118,8 +181,8 @@ func (m *Provider) validate() error {
 			}
 			searchers = m.scanProperties(searchers, allArguments, mod.Name())
 		}
-		if module, ok := mod.(modulecapabilities.AdditionalProperties); ok {
-			allAdditionalRestAPIProps, allAdditionalGrapQLProps := m.getAdditionalProps(module.AdditionalProperties())
+		if additionalProps := m.additionalProperties(mod); additionalProps != nil {
+			allAdditionalRestAPIProps, allAdditionalGrapQLProps := m.getAdditionalProps(additionalProps)
 			additionalGraphQLProps = m.scanProperties(additionalGraphQLProps,
 				allAdditionalGrapQLProps, mod.Name())
 			additionalRestAPIProps = m.scanProperties(additionalRestAPIProps,
@@ -186,7 +249,14 @@ func (m *Provider) validateModules(name string, properties map[string][]string,
 }
 
 func (m *Provider) isDefaultModule(module string) bool {
-	return module == "qna-transformers" || module == "text-spellcheck" || module == "ner-transformers"
+	if _, ok := m.registered[module].(modulecapabilities.AdditionalGenerativeProperty); ok {
+		// generative modules can post-process the results of any class
+		return true
+	}
+
+	return module == "qna-transformers" || module == "text-spellcheck" ||
+		module == "ner-transformers" || module == "reranker-transformers" ||
+		module == "sum-transformers"
 }
 
 func (m *Provider) shouldIncludeClassArgument(class *models.Class, module string) bool {
@@ -226,6 +296,23 @@ func (m *Provider) GetArguments(class *models.Class) map[string]*graphql.Argumen
 	return arguments
 }
 
+// AggregateArguments provides GraphQL Aggregate arguments
+func (m *Provider) AggregateArguments(class *models.Class) map[string]*graphql.ArgumentConfig {
+	arguments := map[string]*graphql.ArgumentConfig{}
+	for _, module := range m.GetAll() {
+		if m.shouldIncludeClassArgument(class, module.Name()) {
+			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
+				for name, argument := range arg.Arguments() {
+					if argument.AggregateArgumentsFunction != nil {
+						arguments[name] = argument.AggregateArgumentsFunction(class.Class)
+					}
+				}
+			}
+		}
+	}
+	return arguments
+}
+
 // ExploreArguments provides GraphQL Explore arguments
 func (m *Provider) ExploreArguments(schema *models.Schema) map[string]*graphql.ArgumentConfig {
 	arguments := map[string]*graphql.ArgumentConfig{}
@@ -310,16 +397,36 @@ func (m *Provider) validateSearchParam(name string, value interface{}, class *mo
 	panic("ValidateParam was called without any known params present")
 }
 
+// additionalProperties of the module. Generative modules do not provide the
+// generate additional property themselves, it is added for them.
+func (m *Provider) additionalProperties(
+	module modulecapabilities.Module,
+) map[string]modulecapabilities.AdditionalProperty {
+	var out map[string]modulecapabilities.AdditionalProperty
+	if arg, ok := module.(modulecapabilities.AdditionalProperties); ok && arg != nil {
+		out = arg.AdditionalProperties()
+	}
+
+	if generative, ok := module.(modulecapabilities.AdditionalGenerativeProperty); ok {
+		merged := make(map[string]modulecapabilities.AdditionalProperty, len(out)+1)
+		for name, additionalProperty := range out {
+			merged[name] = additionalProperty
+		}
+		merged[generateAdditionalProperty] = newGenerateAdditionalProperty(generative)
+		out = merged
+	}
+
+	return out
+}
+
 // GetAdditionalFields provides GraphQL Get additional fields
 func (m *Provider) GetAdditionalFields(class *models.Class) map[string]*graphql.Field {
 	additionalProperties := map[string]*graphql.Field{}
 	for _, module := range m.GetAll() {
 		if m.shouldIncludeClassArgument(class, module.Name()) {
-			if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
-				for name, additionalProperty := range arg.AdditionalProperties() {
-					if additionalProperty.GraphQLFieldFunction != nil {
-						additionalProperties[name] = additionalProperty.GraphQLFieldFunction(class.Class)
-					}
+			for name, additionalProperty := range m.additionalProperties(module) {
+				if additionalProperty.GraphQLFieldFunction != nil {
+					additionalProperties[name] = additionalProperty.GraphQLFieldFunction(class.Class)
 				}
 			}
 		}
@@ -335,13 +442,9 @@ func (m *Provider) ExtractAdditionalField(className, name string, params []*ast.
 	}
 	for _, module := range m.GetAll() {
 		if m.shouldIncludeClassArgument(class, module.Name()) {
-			if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
-				if additionalProperties := arg.AdditionalProperties(); len(additionalProperties) > 0 {
-					if additionalProperty, ok := additionalProperties[name]; ok {
-						if additionalProperty.GraphQLExtractFunction != nil {
-							return additionalProperty.GraphQLExtractFunction(params)
-						}
-					}
+			if additionalProperty, ok := m.additionalProperties(module)[name]; ok {
+				if additionalProperty.GraphQLExtractFunction != nil {
+					return additionalProperty.GraphQLExtractFunction(params)
 				}
 			}
 		}
@@ -389,14 +492,12 @@ func (m *Provider) additionalExtend(ctx context.Context, in []search.Result,
 			return nil, err
 		}
 		allAdditionalProperties := map[string]modulecapabilities.AdditionalProperty{}
+		additionalPropertyModules := map[string]string{}
 		for _, module := range m.GetAll() {
 			if m.shouldIncludeClassArgument(class, module.Name()) {
-				if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
-					if arg != nil && arg.AdditionalProperties() != nil {
-						for name, additionalProperty := range arg.AdditionalProperties() {
-							allAdditionalProperties[name] = additionalProperty
-						}
-					}
+				for name, additionalProperty := range m.additionalProperties(module) {
+					allAdditionalProperties[name] = additionalProperty
+					additionalPropertyModules[name] = module.Name()
 				}
 			}
 		}
@@ -412,7 +513,13 @@ func (m *Provider) additionalExtend(ctx context.Context, in []search.Result,
 						searchVectorValue.SetSearchVector(searchVector)
 						searchValue = searchVectorValue
 					}
-					resArray, err := additionalPropertyFn(ctx, toBeExtended, searchValue, nil, argumentModuleParams)
+					trackedCtx, done, err := m.beginCall(ctx, class.Class,
+						additionalPropertyModules[name], name, PriorityInteractive)
+					if err != nil {
+						return nil, errors.Errorf("extend %s: %v", name, err)
+					}
+					resArray, err := additionalPropertyFn(trackedCtx, toBeExtended, searchValue, nil, argumentModuleParams)
+					done(err)
 					if err != nil {
 						return nil, errors.Errorf("extend %s: %v", name, err)
 					}
@@ -466,11 +573,9 @@ func (m *Provider) getAdditionalPropertyFn(
 func (m *Provider) GraphQLAdditionalFieldNames() []string {
 	additionalPropertiesNames := []string{}
 	for _, module := range m.GetAll() {
-		if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
-			for _, additionalProperty := range arg.AdditionalProperties() {
-				if additionalProperty.GraphQLNames != nil {
-					additionalPropertiesNames = append(additionalPropertiesNames, additionalProperty.GraphQLNames...)
-				}
+		for _, additionalProperty := range m.additionalProperties(module) {
+			if additionalProperty.GraphQLNames != nil {
+				additionalPropertiesNames = append(additionalPropertiesNames, additionalProperty.GraphQLNames...)
 			}
 		}
 	}
@@ -483,12 +588,10 @@ func (m *Provider) RestApiAdditionalProperties(includeProp string, class *models
 	moduleParams := map[string]interface{}{}
 	for _, module := range m.GetAll() {
 		if m.shouldCrossClassIncludeClassArgument(class, module.Name()) {
-			if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
-				for name, additionalProperty := range arg.AdditionalProperties() {
-					for _, includePropName := range additionalProperty.RestNames {
-						if includePropName == includeProp && moduleParams[name] == nil {
-							moduleParams[name] = additionalProperty.DefaultValue
-						}
+			for name, additionalProperty := range m.additionalProperties(module) {
+				for _, includePropName := range additionalProperty.RestNames {
+					if includePropName == includeProp && moduleParams[name] == nil {
+						moduleParams[name] = additionalProperty.DefaultValue
 					}
 				}
 			}
@@ -513,7 +616,13 @@ func (m *Provider) VectorFromSearchParam(ctx context.Context,
 				if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 					if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 						cfg := NewClassBasedModuleConfig(class, mod.Name())
-						vector, err := searchVectorFn(ctx, params, findVectorFn, cfg)
+						trackedCtx, done, err := m.beginCall(ctx, class.Class, mod.Name(),
+							UsageOperationVectorizeQuery, PriorityInteractive)
+						if err != nil {
+							return nil, errors.Errorf("vectorize params: %v", err)
+						}
+						vector, err := searchVectorFn(trackedCtx, params, findVectorFn, cfg)
+						done(err)
 						if err != nil {
 							return nil, errors.Errorf("vectorize params: %v", err)
 						}
@@ -537,7 +646,14 @@ func (m *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 		if searcher, ok := mod.(modulecapabilities.Searcher); ok {
 			if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
-					vector, err := searchVectorFn(ctx, params, findVectorFn, nil)
+					// cross-class searches are not attributable to a single class
+					trackedCtx, done, err := m.beginCall(ctx, "", mod.Name(),
+						UsageOperationVectorizeQuery, PriorityInteractive)
+					if err != nil {
+						return nil, errors.Errorf("vectorize params: %v", err)
+					}
+					vector, err := searchVectorFn(trackedCtx, params, findVectorFn, nil)
+					done(err)
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
 					}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// RouteObject runs the modules with the Router capability which are
+// configured in the moduleConfig of the object's class. The first module
+// which picks another class changes the class of the object, the object is
+// not routed again from there. Objects of unknown classes are left untouched,
+// they are either created by auto-schema or rejected by the validation.
+func (m *Provider) RouteObject(ctx context.Context, obj *models.Object) error {
+	sch := m.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(obj.Class))
+	if class == nil {
+		return nil
+	}
+
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, mod := range m.GetAll() {
+		router, ok := mod.(modulecapabilities.Router)
+		if !ok {
+			continue
+		}
+
+		if _, ok := moduleConfig[mod.Name()]; !ok {
+			continue
+		}
+
+		target, err := router.RouteObject(ctx, obj,
+			NewClassBasedModuleConfig(class, mod.Name()))
+		if err != nil {
+			return errors.Wrapf(err, "module %q", mod.Name())
+		}
+
+		if target != "" && target != obj.Class {
+			obj.Class = target
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+)
+
+// SparseVectorizer returns the vectorizer which sets the sparse vector of the
+// objects of the class. It is nil if no module with the SparseVectorizer
+// capability is configured in the moduleConfig of the class.
+func (m *Provider) SparseVectorizer(className string) (objects.Vectorizer, error) {
+	class, err := m.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	name, vec, err := m.sparseVectorizerOfClass(class)
+	if err != nil {
+		return nil, err
+	}
+	if vec == nil {
+		return nil, nil
+	}
+
+	return &objectsSparseVectorizer{
+		modVectorizer: vec,
+		cfg:           NewClassBasedModuleConfig(class, name),
+		beginCall:     m.beginCall,
+		moduleName:    name,
+	}, nil
+}
+
+// SparseVectorFromQuery turns the query of a sparse search into a sparse
+// vector using the sparse vectorizer of the class
+func (m *Provider) SparseVectorFromQuery(ctx context.Context, className,
+	query string) (map[string]float32, error) {
+	class, err := m.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	name, vec, err := m.sparseVectorizerOfClass(class)
+	if err != nil {
+		return nil, err
+	}
+	if vec == nil {
+		return nil, errors.Errorf("class %q has no module with the "+
+			"SparseVectorizer capability configured", className)
+	}
+
+	ctx, done, err := m.beginCall(ctx, class.Class, name,
+		UsageOperationVectorizeQuery, PriorityInteractive)
+	if err != nil {
+		return nil, errors.Errorf("vectorize sparse query: %v", err)
+	}
+
+	vector, err := vec.VectorizeQuerySparse(ctx, query,
+		NewClassBasedModuleConfig(class, name))
+	done(err)
+	if err != nil {
+		return nil, errors.Errorf("vectorize sparse query: %v", err)
+	}
+
+	return vector, nil
+}
+
+// validateSparseVectorizers makes sure a class has at most one sparse
+// vectorizer, the sparse vectors of different modules are not comparable
+func (m *Provider) validateSparseVectorizers(class *models.Class) error {
+	_, _, err := m.sparseVectorizerOfClass(class)
+	return err
+}
+
+func (m *Provider) sparseVectorizerOfClass(class *models.Class) (string,
+	modulecapabilities.SparseVectorizer, error) {
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return "", nil, nil
+	}
+
+	var (
+		name string
+		out  modulecapabilities.SparseVectorizer
+	)
+	for _, mod := range m.GetAll() {
+		vec, ok := mod.(modulecapabilities.SparseVectorizer)
+		if !ok {
+			continue
+		}
+
+		if _, ok := moduleConfig[mod.Name()]; !ok {
+			continue
+		}
+
+		if out != nil {
+			return "", nil, errors.Errorf("class %q has more than one sparse "+
+				"vectorizer configured: %q and %q", class.Class, name, mod.Name())
+		}
+		name, out = mod.Name(), vec
+	}
+
+	return name, out, nil
+}
+
+type objectsSparseVectorizer struct {
+	modVectorizer modulecapabilities.SparseVectorizer
+	cfg           *ClassBasedModuleConfig
+	beginCall     beginCallFn
+	moduleName    string
+}
+
+func (ov *objectsSparseVectorizer) UpdateObject(ctx context.Context,
+	obj *models.Object) error {
+	ctx, done, err := ov.beginCall(ctx, obj.Class, ov.moduleName,
+		UsageOperationVectorize, PriorityImport)
+	if err != nil {
+		return err
+	}
+
+	vector, err := ov.modVectorizer.VectorizeObjectSparse(ctx, obj, ov.cfg)
+	done(err)
+	if err != nil {
+		return err
+	}
+
+	obj.SparseVector = vector
+	return nil
+}
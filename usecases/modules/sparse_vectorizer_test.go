@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseVectorizers(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "Sparse",
+					Vectorizer: "none",
+					ModuleConfig: map[string]interface{}{
+						"sparse-words": map[string]interface{}{"weight": 2.0},
+					},
+				},
+				{
+					Class:      "Dense",
+					Vectorizer: "none",
+				},
+			},
+		},
+	}
+
+	newProvider := func() *Provider {
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.Register(&fakeSparseVectorizerModule{
+			dummyModuleNoCapabilities: newDummyModuleWithName("sparse-words"),
+		})
+		p.Register(&fakeSparseVectorizerModule{
+			dummyModuleNoCapabilities: newDummyModuleWithName("other-sparse-words"),
+		})
+		return p
+	}
+
+	t.Run("objects of configured classes", func(t *testing.T) {
+		p := newProvider()
+
+		vectorizer, err := p.SparseVectorizer("Sparse")
+		require.Nil(t, err)
+		require.NotNil(t, vectorizer)
+
+		obj := &models.Object{
+			Class:      "Sparse",
+			Properties: map[string]interface{}{"name": "Some Car"},
+		}
+		require.Nil(t, vectorizer.UpdateObject(context.Background(), obj))
+		assert.Equal(t, models.SparseVector{"some": 2, "car": 2}, obj.SparseVector)
+	})
+
+	t.Run("objects of other classes", func(t *testing.T) {
+		p := newProvider()
+
+		vectorizer, err := p.SparseVectorizer("Dense")
+		require.Nil(t, err)
+		assert.Nil(t, vectorizer)
+	})
+
+	t.Run("queries", func(t *testing.T) {
+		p := newProvider()
+
+		vector, err := p.SparseVectorFromQuery(context.Background(), "Sparse", "car")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]float32{"car": 2}, vector)
+
+		_, err = p.SparseVectorFromQuery(context.Background(), "Dense", "car")
+		assert.EqualError(t, err, "class \"Dense\" has no module with the "+
+			"SparseVectorizer capability configured")
+	})
+
+	t.Run("a class with two sparse vectorizers", func(t *testing.T) {
+		p := newProvider()
+
+		class := &models.Class{
+			Class:      "TwoSparse",
+			Vectorizer: "none",
+			ModuleConfig: map[string]interface{}{
+				"sparse-words":       map[string]interface{}{},
+				"other-sparse-words": map[string]interface{}{},
+			},
+		}
+		err := p.ValidateClass(context.Background(), class)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "more than one sparse vectorizer")
+	})
+}
+
+// fakeSparseVectorizerModule weighs every lowercased word of the name
+// property with the weight of its class config
+type fakeSparseVectorizerModule struct {
+	dummyModuleNoCapabilities
+}
+
+func (m *fakeSparseVectorizerModule) VectorizeObjectSparse(ctx context.Context,
+	obj *models.Object, cfg moduletools.ClassConfig) (models.SparseVector, error) {
+	name, _ := obj.Properties.(map[string]interface{})["name"].(string)
+	return m.vectorize(name, cfg), nil
+}
+
+func (m *fakeSparseVectorizerModule) VectorizeQuerySparse(ctx context.Context,
+	query string, cfg moduletools.ClassConfig) (models.SparseVector, error) {
+	return m.vectorize(query, cfg), nil
+}
+
+func (m *fakeSparseVectorizerModule) vectorize(text string,
+	cfg moduletools.ClassConfig) models.SparseVector {
+	weight, _ := cfg.Class()["weight"].(float64)
+	out := models.SparseVector{}
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		out[word] = float32(weight)
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultStartupInitialBackoff = 250 * time.Millisecond
+	DefaultStartupMaxBackoff     = 5 * time.Second
+)
+
+// DependencyStatus is the readiness of a single module which depends on an
+// external service
+type DependencyStatus struct {
+	Module    string `json:"module"`
+	Ready     bool   `json:"ready"`
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// StartupOrchestrator waits for the external dependencies of all modules in
+// parallel. Each dependency is polled with its own exponential backoff, so
+// that a slow container (e.g. one still loading a large model) neither
+// delays the checks of the others nor gets flooded with requests.
+type StartupOrchestrator struct {
+	sync.Mutex
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	status         map[string]*DependencyStatus
+	logger         logrus.FieldLogger
+}
+
+func NewStartupOrchestrator(initialBackoff, maxBackoff time.Duration,
+	logger logrus.FieldLogger) *StartupOrchestrator {
+	return &StartupOrchestrator{
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		status:         map[string]*DependencyStatus{},
+		logger:         logger,
+	}
+}
+
+// Wait blocks until all checkers have reported ready once. It fails as soon
+// as a checker returns a NotRecoverableError or the context expires. In the
+// latter case the error names every dependency which is still blocking.
+func (o *StartupOrchestrator) Wait(ctx context.Context,
+	checkers map[string]modulecapabilities.ReadinessChecker) error {
+	o.Lock()
+	for name := range checkers {
+		o.status[name] = &DependencyStatus{Module: name}
+	}
+	o.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, len(checkers))
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker modulecapabilities.ReadinessChecker) {
+			defer wg.Done()
+			if err := o.waitFor(ctx, name, checker); err != nil {
+				errs <- err
+				cancel()
+			}
+		}(name, checker)
+	}
+	wg.Wait()
+	close(errs)
+
+	var notRecoverable error
+	for err := range errs {
+		if _, ok := err.(modulecapabilities.NotRecoverableError); ok {
+			notRecoverable = err
+		}
+	}
+
+	if notRecoverable != nil {
+		return notRecoverable
+	}
+
+	if blocking := o.Blocking(); len(blocking) > 0 {
+		msgs := make([]string, len(blocking))
+		for i, dep := range blocking {
+			msgs[i] = dep.Module
+			if dep.LastError != "" {
+				msgs[i] += ": " + dep.LastError
+			}
+		}
+		return errors.Errorf("dependencies not ready: %s", strings.Join(msgs, ", "))
+	}
+
+	return nil
+}
+
+func (o *StartupOrchestrator) waitFor(ctx context.Context, name string,
+	checker modulecapabilities.ReadinessChecker) error {
+	backoff := o.initialBackoff
+	for {
+		err := checker.CheckReady(ctx)
+		o.record(name, err)
+		if err == nil {
+			return nil
+		}
+
+		if nre, ok := err.(modulecapabilities.NotRecoverableError); ok {
+			return modulecapabilities.NotRecoverableError{
+				Err: errors.Wrapf(nre.Err, "module %q", name),
+			}
+		}
+
+		o.logger.WithField("action", "startup_wait_for_dependency").
+			WithField("module", name).
+			WithField("retry_in", backoff).
+			WithError(err).Warn("module dependency not ready")
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff *= 2
+		if backoff > o.maxBackoff {
+			backoff = o.maxBackoff
+		}
+	}
+}
+
+func (o *StartupOrchestrator) record(name string, err error) {
+	o.Lock()
+	defer o.Unlock()
+
+	status := o.status[name]
+	status.Attempts++
+	status.Ready = err == nil
+	status.LastError = ""
+	if err != nil {
+		status.LastError = err.Error()
+	}
+}
+
+// Status returns the readiness of all dependencies ordered by module name
+func (o *StartupOrchestrator) Status() []DependencyStatus {
+	o.Lock()
+	defer o.Unlock()
+
+	out := make([]DependencyStatus, 0, len(o.status))
+	for _, status := range o.status {
+		out = append(out, *status)
+	}
+
+	sort.Slice(out, func(a, b int) bool { return out[a].Module < out[b].Module })
+	return out
+}
+
+// Blocking returns only those dependencies which are not ready yet
+func (o *StartupOrchestrator) Blocking() []DependencyStatus {
+	var out []DependencyStatus
+	for _, status := range o.Status() {
+		if !status.Ready {
+			out = append(out, status)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartupOrchestrator(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("all dependencies become ready", func(t *testing.T) {
+		o := NewStartupOrchestrator(time.Millisecond, 5*time.Millisecond, logger)
+		err := o.Wait(context.Background(), map[string]modulecapabilities.ReadinessChecker{
+			"fast": &fakeReadinessChecker{readyAfter: 0},
+			"slow": &fakeReadinessChecker{readyAfter: 3},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []DependencyStatus{
+			{Module: "fast", Ready: true, Attempts: 1},
+			{Module: "slow", Ready: true, Attempts: 4},
+		}, o.Status())
+		assert.Len(t, o.Blocking(), 0)
+	})
+
+	t.Run("a dependency never becomes ready", func(t *testing.T) {
+		o := NewStartupOrchestrator(time.Millisecond, 5*time.Millisecond, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := o.Wait(ctx, map[string]modulecapabilities.ReadinessChecker{
+			"fast":   &fakeReadinessChecker{readyAfter: 0},
+			"broken": &fakeReadinessChecker{readyAfter: -1},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "broken: connection refused")
+		assert.NotContains(t, err.Error(), "fast")
+
+		blocking := o.Blocking()
+		require.Len(t, blocking, 1)
+		assert.Equal(t, "broken", blocking[0].Module)
+		assert.Equal(t, "connection refused", blocking[0].LastError)
+		assert.Greater(t, blocking[0].Attempts, 1)
+	})
+
+	t.Run("a dependency can not recover", func(t *testing.T) {
+		o := NewStartupOrchestrator(time.Millisecond, 5*time.Millisecond, logger)
+
+		err := o.Wait(context.Background(), map[string]modulecapabilities.ReadinessChecker{
+			"never": &fakeReadinessChecker{readyAfter: -1},
+			"incompatible": &fakeReadinessChecker{
+				err: modulecapabilities.NotRecoverableError{
+					Err: errors.New("insufficient version"),
+				},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `module "incompatible": insufficient version`)
+	})
+}
+
+type fakeReadinessChecker struct {
+	sync.Mutex
+	// readyAfter is the number of failed checks before the first successful
+	// one, a negative value never becomes ready
+	readyAfter int
+	err        error
+	calls      int
+}
+
+func (f *fakeReadinessChecker) CheckReady(ctx context.Context) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.calls++
+	if f.err != nil {
+		return f.err
+	}
+
+	if f.readyAfter < 0 || f.calls <= f.readyAfter {
+		return fmt.Errorf("connection refused")
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+)
+
+// TransformArguments provides the GraphQL Get arguments of the modules with
+// the ResponseTransformer capability which are configured in the
+// moduleConfig of the class
+func (m *Provider) TransformArguments(class *models.Class) map[string]*graphql.ArgumentConfig {
+	arguments := map[string]*graphql.ArgumentConfig{}
+	m.forEachTransformer(class, func(name string,
+		transformer modulecapabilities.ResponseTransformer,
+		cfg moduletools.ClassConfig) error {
+		for argName, argument := range transformer.TransformArguments() {
+			if argument.GetArgumentsFunction != nil {
+				arguments[argName] = argument.GetArgumentsFunction(class.Class)
+			}
+		}
+		return nil
+	})
+	return arguments
+}
+
+// ExtractTransformParams extracts the GraphQL Get arguments of the response
+// transformers of the class, keyed by argument name
+func (m *Provider) ExtractTransformParams(arguments map[string]interface{},
+	className string) map[string]interface{} {
+	extractedParams := map[string]interface{}{}
+	class, err := m.getClass(className)
+	if err != nil {
+		return extractedParams
+	}
+
+	m.forEachTransformer(class, func(name string,
+		transformer modulecapabilities.ResponseTransformer,
+		cfg moduletools.ClassConfig) error {
+		for argName, argument := range transformer.TransformArguments() {
+			if param, ok := arguments[argName]; ok && argument.ExtractFunction != nil {
+				extractedParams[argName] = argument.ExtractFunction(param.(map[string]interface{}))
+			}
+		}
+		return nil
+	})
+	return extractedParams
+}
+
+// TransformResult runs the modules with the ResponseTransformer capability
+// which are configured in the moduleConfig of the class on the properties of
+// an object that is about to be returned. Every module only receives the
+// params of its own arguments.
+func (m *Provider) TransformResult(ctx context.Context, className string,
+	props map[string]interface{}, params map[string]interface{}) error {
+	if props == nil {
+		return nil
+	}
+
+	class, err := m.getClass(className)
+	if err != nil {
+		return nil
+	}
+
+	return m.forEachTransformer(class, func(name string,
+		transformer modulecapabilities.ResponseTransformer,
+		cfg moduletools.ClassConfig) error {
+		moduleParams := map[string]interface{}{}
+		for argName := range transformer.TransformArguments() {
+			if param, ok := params[argName]; ok {
+				moduleParams[argName] = param
+			}
+		}
+
+		return errors.Wrapf(transformer.TransformResult(ctx, props, moduleParams, cfg),
+			"module %q", name)
+	})
+}
+
+func (m *Provider) forEachTransformer(class *models.Class, fn func(name string,
+	transformer modulecapabilities.ResponseTransformer, cfg moduletools.ClassConfig) error) error {
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, mod := range m.GetAll() {
+		transformer, ok := mod.(modulecapabilities.ResponseTransformer)
+		if !ok {
+			continue
+		}
+
+		if _, ok := moduleConfig[mod.Name()]; !ok {
+			continue
+		}
+
+		if err := fn(mod.Name(), transformer,
+			NewClassBasedModuleConfig(class, mod.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseTransformers(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Transformed",
+					ModuleConfig: map[string]interface{}{
+						"text-truncate": map[string]interface{}{"suffix": "..."},
+					},
+				},
+				{
+					Class: "Untouched",
+				},
+			},
+		},
+	}
+
+	newProvider := func() *Provider {
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.Register(&fakeTransformerModule{
+			dummyModuleNoCapabilities: newDummyModuleWithName("text-truncate"),
+		})
+		p.Register(newDummyModuleWithName("other-module"))
+		return p
+	}
+
+	t.Run("arguments of configured classes", func(t *testing.T) {
+		p := newProvider()
+
+		args := p.TransformArguments(sch.FindClassByName("Transformed"))
+		assert.Len(t, args, 1)
+		assert.NotNil(t, args["truncate"])
+
+		args = p.TransformArguments(sch.FindClassByName("Untouched"))
+		assert.Len(t, args, 0)
+	})
+
+	t.Run("extracting params", func(t *testing.T) {
+		p := newProvider()
+		arguments := map[string]interface{}{
+			"truncate":   map[string]interface{}{"length": 3},
+			"nearVector": map[string]interface{}{},
+		}
+
+		params := p.ExtractTransformParams(arguments, "Transformed")
+		assert.Equal(t, map[string]interface{}{"truncate": 3}, params)
+
+		params = p.ExtractTransformParams(arguments, "Untouched")
+		assert.Len(t, params, 0)
+	})
+
+	t.Run("transforming a result", func(t *testing.T) {
+		p := newProvider()
+		params := map[string]interface{}{"truncate": 3, "other": true}
+
+		props := map[string]interface{}{"name": "Foobar"}
+		err := p.TransformResult(context.Background(), "Transformed", props, params)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"name": "Foo..."}, props)
+
+		props = map[string]interface{}{"name": "Foobar"}
+		err = p.TransformResult(context.Background(), "Untouched", props, params)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"name": "Foobar"}, props)
+	})
+
+	t.Run("without arguments set in the query", func(t *testing.T) {
+		p := newProvider()
+
+		props := map[string]interface{}{"name": "Foobar"}
+		err := p.TransformResult(context.Background(), "Transformed", props, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"name": "Foobar"}, props)
+	})
+
+	t.Run("with a failing module", func(t *testing.T) {
+		p := newProvider()
+		params := map[string]interface{}{"truncate": -1}
+
+		props := map[string]interface{}{"name": "Foobar"}
+		err := p.TransformResult(context.Background(), "Transformed", props, params)
+		require.NotNil(t, err)
+		assert.Equal(t, "module \"text-truncate\": length must not be negative",
+			err.Error())
+	})
+}
+
+type fakeTransformerModule struct {
+	dummyModuleNoCapabilities
+}
+
+func (m *fakeTransformerModule) TransformArguments() map[string]modulecapabilities.TransformArgument {
+	return map[string]modulecapabilities.TransformArgument{
+		"truncate": {
+			GetArgumentsFunction: func(classname string) *graphql.ArgumentConfig {
+				return &graphql.ArgumentConfig{}
+			},
+			ExtractFunction: func(param map[string]interface{}) interface{} {
+				return param["length"]
+			},
+		},
+	}
+}
+
+func (m *fakeTransformerModule) TransformResult(ctx context.Context,
+	props map[string]interface{}, params map[string]interface{},
+	cfg moduletools.ClassConfig) error {
+	if _, ok := params["other"]; ok {
+		return errors.New("received params of another module")
+	}
+
+	length, ok := params["truncate"].(int)
+	if !ok {
+		return nil
+	}
+
+	if length < 0 {
+		return errors.New("length must not be negative")
+	}
+
+	suffix := cfg.Class()["suffix"].(string)
+	for name, value := range props {
+		if text, ok := value.(string); ok && len(text) > length {
+			props[name] = strings.TrimSpace(text[:length]) + suffix
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+const (
+	UsageOperationVectorize      = "vectorize"
+	UsageOperationVectorizeQuery = "vectorizeQuery"
+)
+
+// UsageRecord contains the accumulated usage of one operation of one module
+// for one class. Tokens are only counted for modules which report them.
+type UsageRecord struct {
+	Class     string `json:"class"`
+	Module    string `json:"module"`
+	Operation string `json:"operation"`
+	Calls     int64  `json:"calls"`
+	Errors    int64  `json:"errors"`
+	Tokens    int64  `json:"tokens"`
+}
+
+type usageKey struct {
+	class     string
+	module    string
+	operation string
+}
+
+type usageCounters struct {
+	calls  int64
+	errors int64
+	tokens int64
+}
+
+// Usage counts calls into modules per class, so that cost of paid inference
+// APIs behind modules can be attributed. Counters live in memory and are
+// reset on restart, they are meant to be scraped.
+type Usage struct {
+	sync.RWMutex
+	counters map[usageKey]*usageCounters
+}
+
+func NewUsage() *Usage {
+	return &Usage{counters: map[usageKey]*usageCounters{}}
+}
+
+type usageCall struct {
+	counters *usageCounters
+}
+
+func (c *usageCall) AddTokens(tokens int) {
+	atomic.AddInt64(&c.counters.tokens, int64(tokens))
+}
+
+// track counts a call and returns a context through which the module can
+// report its token usage, as well as a function to record the outcome
+func (u *Usage) track(ctx context.Context, class, module,
+	operation string) (context.Context, func(err error)) {
+	counters := u.countersFor(usageKey{class, module, operation})
+	atomic.AddInt64(&counters.calls, 1)
+
+	ctx = moduletools.WithUsageReporter(ctx, &usageCall{counters})
+	return ctx, func(err error) {
+		if err != nil {
+			atomic.AddInt64(&counters.errors, 1)
+		}
+	}
+}
+
+func (u *Usage) countersFor(key usageKey) *usageCounters {
+	u.RLock()
+	counters, ok := u.counters[key]
+	u.RUnlock()
+	if ok {
+		return counters
+	}
+
+	u.Lock()
+	defer u.Unlock()
+	if counters, ok := u.counters[key]; ok {
+		return counters
+	}
+
+	counters = &usageCounters{}
+	u.counters[key] = counters
+	return counters
+}
+
+// Records returns a consistently ordered copy of all counters
+func (u *Usage) Records() []UsageRecord {
+	u.RLock()
+	out := make([]UsageRecord, 0, len(u.counters))
+	for key, counters := range u.counters {
+		out = append(out, UsageRecord{
+			Class:     key.class,
+			Module:    key.module,
+			Operation: key.operation,
+			Calls:     atomic.LoadInt64(&counters.calls),
+			Errors:    atomic.LoadInt64(&counters.errors),
+			Tokens:    atomic.LoadInt64(&counters.tokens),
+		})
+	}
+	u.RUnlock()
+
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].Class != out[b].Class {
+			return out[a].Class < out[b].Class
+		}
+		if out[a].Module != out[b].Module {
+			return out[a].Module < out[b].Module
+		}
+		return out[a].Operation < out[b].Operation
+	})
+
+	return out
+}
+
+// Collect reports all counters to the monitoring registry
+func (u *Usage) Collect() []*monitoring.Family {
+	calls := monitoring.NewCounter("weaviate_module_calls_total",
+		"Number of calls into a module per class and operation",
+		"class", "module", "operation")
+	errors := monitoring.NewCounter("weaviate_module_call_errors_total",
+		"Number of failed calls into a module per class and operation",
+		"class", "module", "operation")
+	tokens := monitoring.NewCounter("weaviate_module_tokens_total",
+		"Number of tokens reported by a module per class and operation",
+		"class", "module", "operation")
+
+	for _, r := range u.Records() {
+		calls.Add(float64(r.Calls), r.Class, r.Module, r.Operation)
+		errors.Add(float64(r.Errors), r.Class, r.Module, r.Operation)
+		tokens.Add(float64(r.Tokens), r.Class, r.Module, r.Operation)
+	}
+
+	return []*monitoring.Family{calls, errors, tokens}
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsage(t *testing.T) {
+	usage := NewUsage()
+
+	ctx, done := usage.track(context.Background(), "Article", "my-module",
+		UsageOperationVectorize)
+	moduletools.ReportTokens(ctx, 12)
+	moduletools.ReportTokens(ctx, 3)
+	done(nil)
+
+	_, done = usage.track(context.Background(), "Article", "my-module",
+		UsageOperationVectorize)
+	done(errors.New("inference failed"))
+
+	_, done = usage.track(context.Background(), "", "my-module",
+		UsageOperationVectorizeQuery)
+	done(nil)
+
+	// a call without a tracked context must not panic
+	moduletools.ReportTokens(context.Background(), 7)
+
+	assert.Equal(t, []UsageRecord{
+		{
+			Module:    "my-module",
+			Operation: UsageOperationVectorizeQuery,
+			Calls:     1,
+		},
+		{
+			Class:     "Article",
+			Module:    "my-module",
+			Operation: UsageOperationVectorize,
+			Calls:     2,
+			Errors:    1,
+			Tokens:    15,
+		},
+	}, usage.Records())
+
+	registry := monitoring.NewRegistry()
+	registry.Register(usage)
+	buf := &bytes.Buffer{}
+	assert.Nil(t, registry.WriteText(buf))
+	assert.Contains(t, buf.String(), "# TYPE weaviate_module_tokens_total counter\n")
+	assert.Contains(t, buf.String(),
+		`weaviate_module_tokens_total{class="Article",module="my-module",operation="vectorize"} 15`)
+	assert.Contains(t, buf.String(),
+		`weaviate_module_calls_total{class="",module="my-module",operation="vectorizeQuery"} 1`)
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// ValidateObjectRules runs the modules with the Validator capability which
+// are configured in the moduleConfig of the class on the properties of an
+// object that is about to be written and returns all rules it violates
+func (m *Provider) ValidateObjectRules(ctx context.Context, className string,
+	props map[string]interface{}) ([]modulecapabilities.RuleViolation, error) {
+	if props == nil {
+		props = map[string]interface{}{}
+	}
+
+	var out []modulecapabilities.RuleViolation
+	sch := m.schemaGetter.GetSchemaSkipAuth()
+	err := m.forEachValidator(sch.FindClassByName(schema.ClassName(className)),
+		func(name string, validator modulecapabilities.Validator,
+			cfg *ClassBasedModuleConfig) error {
+			violations, err := validator.ValidateObject(ctx, props, cfg)
+			if err != nil {
+				return errors.Wrapf(err, "module %q", name)
+			}
+
+			for _, violation := range violations {
+				violation.Module = name
+				out = append(out, violation)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// validateRules lets the modules with the Validator capability which are
+// configured in the moduleConfig of the class validate their rules
+func (m *Provider) validateRules(ctx context.Context, class *models.Class) error {
+	return m.forEachValidator(class, func(name string,
+		validator modulecapabilities.Validator, cfg *ClassBasedModuleConfig) error {
+		return errors.Wrapf(validator.ValidateRules(ctx, class, cfg),
+			"module '%s'", name)
+	})
+}
+
+func (m *Provider) forEachValidator(class *models.Class, fn func(name string,
+	validator modulecapabilities.Validator, cfg *ClassBasedModuleConfig) error) error {
+	if class == nil {
+		return nil
+	}
+
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, mod := range m.GetAll() {
+		validator, ok := mod.(modulecapabilities.Validator)
+		if !ok {
+			continue
+		}
+
+		if _, ok := moduleConfig[mod.Name()]; !ok {
+			continue
+		}
+
+		if err := fn(mod.Name(), validator,
+			NewClassBasedModuleConfig(class, mod.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidators(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "Validated",
+					Vectorizer: "none",
+					ModuleConfig: map[string]interface{}{
+						"required-name": map[string]interface{}{"property": "name"},
+					},
+				},
+				{
+					Class:      "Unvalidated",
+					Vectorizer: "none",
+				},
+			},
+		},
+	}
+
+	newProvider := func() *Provider {
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.Register(&fakeValidatorModule{
+			dummyModuleNoCapabilities: newDummyModuleWithName("required-name"),
+		})
+		p.Register(newDummyModuleWithName("other-module"))
+		return p
+	}
+
+	t.Run("objects of configured classes", func(t *testing.T) {
+		p := newProvider()
+
+		violations, err := p.ValidateObjectRules(context.Background(),
+			"Validated", map[string]interface{}{"name": "Foo"})
+		require.Nil(t, err)
+		assert.Len(t, violations, 0)
+
+		violations, err = p.ValidateObjectRules(context.Background(),
+			"Validated", nil)
+		require.Nil(t, err)
+		assert.Equal(t, []modulecapabilities.RuleViolation{{
+			Module:   "required-name",
+			Rule:     "required",
+			Property: "name",
+			Message:  "is required",
+		}}, violations)
+	})
+
+	t.Run("objects of other classes", func(t *testing.T) {
+		p := newProvider()
+
+		violations, err := p.ValidateObjectRules(context.Background(),
+			"Unvalidated", nil)
+		require.Nil(t, err)
+		assert.Len(t, violations, 0)
+	})
+
+	t.Run("validating the rules of a class", func(t *testing.T) {
+		p := newProvider()
+
+		assert.Nil(t, p.ValidateClass(context.Background(),
+			sch.FindClassByName("Validated")))
+
+		invalid := &models.Class{
+			Class:      "Invalid",
+			Vectorizer: "none",
+			ModuleConfig: map[string]interface{}{
+				"required-name": map[string]interface{}{},
+			},
+		}
+		err := p.ValidateClass(context.Background(), invalid)
+		require.NotNil(t, err)
+		assert.Equal(t, "module 'required-name': property must be set", err.Error())
+	})
+}
+
+// fakeValidatorModule requires the property named in its class config
+type fakeValidatorModule struct {
+	dummyModuleNoCapabilities
+}
+
+func (m *fakeValidatorModule) ValidateRules(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig) error {
+	if _, ok := cfg.Class()["property"].(string); !ok {
+		return errors.Errorf("property must be set")
+	}
+
+	return nil
+}
+
+func (m *fakeValidatorModule) ValidateObject(ctx context.Context,
+	props map[string]interface{},
+	cfg moduletools.ClassConfig) ([]modulecapabilities.RuleViolation, error) {
+	prop := cfg.Class()["property"].(string)
+	if _, ok := props[prop]; ok {
+		return nil, nil
+	}
+
+	return []modulecapabilities.RuleViolation{{
+		Rule:     "required",
+		Property: prop,
+		Message:  "is required",
+	}}, nil
+}
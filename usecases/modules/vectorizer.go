@@ -55,20 +55,39 @@ func (m *Provider) Vectorizer(moduleName, className string) (objects.Vectorizer,
 	}
 
 	cfg := NewClassBasedModuleConfig(class, moduleName)
-	return NewObjectsVectorizer(vec, cfg), nil
+	return NewObjectsVectorizer(vec, cfg, m.beginCall, moduleName), nil
 }
 
+type beginCallFn func(ctx context.Context, class, module, operation string,
+	priority InferencePriority) (context.Context, func(err error), error)
+
 type ObjectsVectorizer struct {
 	modVectorizer modulecapabilities.Vectorizer
 	cfg           *ClassBasedModuleConfig
+	beginCall     beginCallFn
+	moduleName    string
 }
 
 func NewObjectsVectorizer(vec modulecapabilities.Vectorizer,
-	cfg *ClassBasedModuleConfig) *ObjectsVectorizer {
-	return &ObjectsVectorizer{modVectorizer: vec, cfg: cfg}
+	cfg *ClassBasedModuleConfig, beginCall beginCallFn,
+	moduleName string) *ObjectsVectorizer {
+	return &ObjectsVectorizer{
+		modVectorizer: vec,
+		cfg:           cfg,
+		beginCall:     beginCall,
+		moduleName:    moduleName,
+	}
 }
 
 func (ov *ObjectsVectorizer) UpdateObject(ctx context.Context,
 	obj *models.Object) error {
-	return ov.modVectorizer.VectorizeObject(ctx, obj, ov.cfg)
+	ctx, done, err := ov.beginCall(ctx, obj.Class, ov.moduleName,
+		UsageOperationVectorize, PriorityImport)
+	if err != nil {
+		return err
+	}
+
+	err = ov.modVectorizer.VectorizeObject(ctx, obj, ov.cfg)
+	done(err)
+	return err
 }
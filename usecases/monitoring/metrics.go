@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package monitoring collects the metrics of the components of this node and
+// exposes them in the prometheus text exposition format. Components never
+// format metrics themselves, they describe them as families of samples and
+// register as a Collector.
+package monitoring
+
+type MetricType string
+
+const (
+	Counter MetricType = "counter"
+	Gauge   MetricType = "gauge"
+)
+
+// Family is a metric with all of its samples. Every sample has one value for
+// each label of the family, in the same order.
+type Family struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Labels  []string
+	Samples []Sample
+}
+
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+func NewCounter(name, help string, labels ...string) *Family {
+	return &Family{Name: name, Help: help, Type: Counter, Labels: labels}
+}
+
+func NewGauge(name, help string, labels ...string) *Family {
+	return &Family{Name: name, Help: help, Type: Gauge, Labels: labels}
+}
+
+// Add appends a sample with the specified label values to the family
+func (f *Family) Add(value float64, labelValues ...string) *Family {
+	f.Samples = append(f.Samples, Sample{LabelValues: labelValues, Value: value})
+	return f
+}
+
+// Collector is implemented by every component which exposes metrics. Collect
+// is called on every scrape, so it should only take a snapshot of counters
+// the component maintains anyway.
+type Collector interface {
+	Collect() []*Family
+}
+
+// CollectorFunc turns a function into a Collector
+type CollectorFunc func() []*Family
+
+func (f CollectorFunc) Collect() []*Family {
+	return f()
+}
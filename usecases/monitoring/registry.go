@@ -0,0 +1,242 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package monitoring
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const contentTypeText = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegexp  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// Registry gathers the metrics of all registered collectors. It implements
+// http.Handler to serve them to a prometheus scraper.
+type Registry struct {
+	sync.Mutex
+	collectors []Collector
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a collector which is asked for its metrics on every scrape
+func (r *Registry) Register(c Collector) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.collectors = append(r.collectors, c)
+}
+
+// Gather collects the families of all collectors, sorted by name. Families
+// with the same name reported by different collectors are merged, as long as
+// they are described identically. The samples of every family are sorted by
+// their label values.
+func (r *Registry) Gather() ([]*Family, error) {
+	r.Lock()
+	collectors := make([]Collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.Unlock()
+
+	byName := map[string]*Family{}
+	for _, c := range collectors {
+		for _, f := range c.Collect() {
+			if err := validate(f); err != nil {
+				return nil, err
+			}
+
+			existing, ok := byName[f.Name]
+			if !ok {
+				merged := *f
+				merged.Samples = append([]Sample(nil), f.Samples...)
+				byName[f.Name] = &merged
+				continue
+			}
+
+			if existing.Type != f.Type || existing.Help != f.Help ||
+				!equalStrings(existing.Labels, f.Labels) {
+				return nil, errors.Errorf("metric %s is described inconsistently "+
+					"by different collectors", f.Name)
+			}
+			existing.Samples = append(existing.Samples, f.Samples...)
+		}
+	}
+
+	out := make([]*Family, 0, len(byName))
+	for _, f := range byName {
+		sort.Slice(f.Samples, func(a, b int) bool {
+			return lessStrings(f.Samples[a].LabelValues, f.Samples[b].LabelValues)
+		})
+		for i := 1; i < len(f.Samples); i++ {
+			if equalStrings(f.Samples[i-1].LabelValues, f.Samples[i].LabelValues) {
+				return nil, errors.Errorf("metric %s has more than one sample "+
+					"with labels %v", f.Name, f.Samples[i].LabelValues)
+			}
+		}
+		out = append(out, f)
+	}
+
+	sort.Slice(out, func(a, b int) bool { return out[a].Name < out[b].Name })
+	return out, nil
+}
+
+// WriteText writes the metrics of all collectors in the prometheus text
+// exposition format
+func (r *Registry) WriteText(w io.Writer) error {
+	families, err := r.Gather()
+	if err != nil {
+		return err
+	}
+
+	for _, f := range families {
+		if err := writeFamily(w, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// render into a buffer first, so a failing collector results in an error
+	// instead of a truncated scrape
+	buf := &bytes.Buffer{}
+	if err := r.WriteText(buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("content-type", contentTypeText)
+	w.Write(buf.Bytes())
+}
+
+func validate(f *Family) error {
+	if !metricNameRegexp.MatchString(f.Name) {
+		return errors.Errorf("invalid metric name %q", f.Name)
+	}
+
+	if f.Type != Counter && f.Type != Gauge {
+		return errors.Errorf("metric %s has unsupported type %q", f.Name, f.Type)
+	}
+
+	for _, label := range f.Labels {
+		if !labelNameRegexp.MatchString(label) {
+			return errors.Errorf("metric %s has invalid label name %q", f.Name, label)
+		}
+	}
+
+	for _, s := range f.Samples {
+		if len(s.LabelValues) != len(f.Labels) {
+			return errors.Errorf("metric %s has labels %v, but a sample has "+
+				"values %v", f.Name, f.Labels, s.LabelValues)
+		}
+	}
+
+	return nil
+}
+
+func writeFamily(w io.Writer, f *Family) error {
+	var b strings.Builder
+	b.WriteString("# HELP ")
+	b.WriteString(f.Name)
+	b.WriteByte(' ')
+	b.WriteString(helpEscaper.Replace(f.Help))
+	b.WriteString("\n# TYPE ")
+	b.WriteString(f.Name)
+	b.WriteByte(' ')
+	b.WriteString(string(f.Type))
+	b.WriteByte('\n')
+
+	for _, s := range f.Samples {
+		b.WriteString(f.Name)
+		if len(f.Labels) > 0 {
+			b.WriteByte('{')
+			for i, label := range f.Labels {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				b.WriteString(label)
+				b.WriteString(`="`)
+				b.WriteString(labelValueEscaper.Replace(s.LabelValues[i]))
+				b.WriteByte('"')
+			}
+			b.WriteByte('}')
+		}
+		b.WriteByte(' ')
+		b.WriteString(formatValue(s.Value))
+		b.WriteByte('\n')
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func lessStrings(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+
+	return len(a) < len(b)
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package monitoring
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("families are sorted by name and samples by labels", func(t *testing.T) {
+		r := NewRegistry()
+		r.Register(CollectorFunc(func() []*Family {
+			return []*Family{
+				NewGauge("b_gauge", "a gauge", "class").
+					Add(2, "Zebra").
+					Add(1, "Apple"),
+				NewCounter("a_total", "a counter").Add(1000000),
+			}
+		}))
+
+		buf := &bytes.Buffer{}
+		require.Nil(t, r.WriteText(buf))
+
+		expected := "# HELP a_total a counter\n" +
+			"# TYPE a_total counter\n" +
+			"a_total 1e+06\n" +
+			"# HELP b_gauge a gauge\n" +
+			"# TYPE b_gauge gauge\n" +
+			"b_gauge{class=\"Apple\"} 1\n" +
+			"b_gauge{class=\"Zebra\"} 2\n"
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("label values and help are escaped", func(t *testing.T) {
+		r := NewRegistry()
+		r.Register(CollectorFunc(func() []*Family {
+			return []*Family{
+				NewGauge("escaped", "back\\slash and\nnewline", "value").
+					Add(0.5, "quote\" back\\slash\nnewline\ttab ü"),
+			}
+		}))
+
+		buf := &bytes.Buffer{}
+		require.Nil(t, r.WriteText(buf))
+
+		expected := "# HELP escaped back\\\\slash and\\nnewline\n" +
+			"# TYPE escaped gauge\n" +
+			"escaped{value=\"quote\\\" back\\\\slash\\nnewline\ttab ü\"} 0.5\n"
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("special float values", func(t *testing.T) {
+		r := NewRegistry()
+		r.Register(CollectorFunc(func() []*Family {
+			return []*Family{
+				NewGauge("special", "special values", "kind").
+					Add(math.Inf(1), "a").
+					Add(math.Inf(-1), "b").
+					Add(math.NaN(), "c"),
+			}
+		}))
+
+		buf := &bytes.Buffer{}
+		require.Nil(t, r.WriteText(buf))
+		assert.Contains(t, buf.String(), "special{kind=\"a\"} +Inf\n")
+		assert.Contains(t, buf.String(), "special{kind=\"b\"} -Inf\n")
+		assert.Contains(t, buf.String(), "special{kind=\"c\"} NaN\n")
+	})
+
+	t.Run("identical families of different collectors are merged", func(t *testing.T) {
+		r := NewRegistry()
+		r.Register(CollectorFunc(func() []*Family {
+			return []*Family{NewCounter("calls_total", "calls", "class").Add(1, "A")}
+		}))
+		r.Register(CollectorFunc(func() []*Family {
+			return []*Family{NewCounter("calls_total", "calls", "class").Add(2, "B")}
+		}))
+
+		families, err := r.Gather()
+		require.Nil(t, err)
+		require.Len(t, families, 1)
+		assert.Equal(t, []Sample{
+			{LabelValues: []string{"A"}, Value: 1},
+			{LabelValues: []string{"B"}, Value: 2},
+		}, families[0].Samples)
+	})
+
+	t.Run("invalid families are rejected", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			families []*Family
+		}{
+			{
+				name:     "invalid metric name",
+				families: []*Family{NewGauge("not-valid", "help")},
+			},
+			{
+				name:     "invalid label name",
+				families: []*Family{NewGauge("valid", "help", "not-valid")},
+			},
+			{
+				name:     "wrong number of label values",
+				families: []*Family{NewGauge("valid", "help", "class").Add(1, "A", "B")},
+			},
+			{
+				name: "duplicate sample",
+				families: []*Family{
+					NewGauge("valid", "help", "class").Add(1, "A").Add(2, "A"),
+				},
+			},
+			{
+				name: "inconsistent types",
+				families: []*Family{
+					NewGauge("valid", "help"),
+					NewCounter("valid", "help"),
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				r := NewRegistry()
+				r.Register(CollectorFunc(func() []*Family { return test.families }))
+
+				_, err := r.Gather()
+				assert.NotNil(t, err)
+			})
+		}
+	})
+
+	t.Run("serving over http", func(t *testing.T) {
+		r := NewRegistry()
+		r.Register(CollectorFunc(func() []*Family {
+			return []*Family{NewCounter("served_total", "served").Add(3)}
+		}))
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, contentTypeText, rec.Header().Get("content-type"))
+		assert.Contains(t, rec.Body.String(), "served_total 3\n")
+
+		rec = httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/metrics", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package nodes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// nodeSpecificSettings are expected to differ between the nodes of a cluster
+// and are never reported as divergent
+var nodeSpecificSettings = map[string]struct{}{
+	"cluster.hostname":     {},
+	"cluster.join":         {},
+	"cluster.zone":         {},
+	"cluster.rack":         {},
+	"persistence.dataPath": {},
+}
+
+// NodeConfig is the effective configuration of a node, flattened into
+// dot-separated settings, e.g. "query_defaults.limit". Besides the config it
+// contains the version, the enabled modules and the resources of the node.
+// Error is set instead if the config of the node could not be requested.
+type NodeConfig struct {
+	Name     string                 `json:"name"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// ConfigDivergence is a setting which is not the same on all nodes. Values
+// contains the value of every node which could be reached, a node which does
+// not have the setting at all maps to nil.
+type ConfigDivergence struct {
+	Setting string                 `json:"setting"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+// ConfigReport compares the configs of all nodes of the cluster
+type ConfigReport struct {
+	Consistent  bool               `json:"consistent"`
+	Nodes       []*NodeConfig      `json:"nodes"`
+	Divergences []ConfigDivergence `json:"divergences"`
+}
+
+// SetConfig makes the effective config and the names of the enabled modules
+// of this node available to the consistency check, see ClusterConfig
+func (m *Manager) SetConfig(cfg config.Config, modules []string) {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+
+	m.config = &cfg
+	m.modules = modules
+}
+
+// LocalNodeConfig reports the effective configuration of this node
+func (m *Manager) LocalNodeConfig() (*NodeConfig, error) {
+	m.configLock.Lock()
+	cfg, modules := m.config, m.modules
+	m.configLock.Unlock()
+
+	out := &NodeConfig{Name: m.nodes.LocalName()}
+	settings := map[string]interface{}{}
+	if cfg != nil {
+		var err error
+		settings, err = flattenConfig(*cfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "flatten config")
+		}
+	}
+
+	sortedModules := append([]string{}, modules...)
+	sort.Strings(sortedModules)
+	settings["node.modules"] = strings.Join(sortedModules, ",")
+	settings["node.version"] = m.version
+	settings["node.gitHash"] = m.gitHash
+	settings["node.goVersion"] = runtime.Version()
+	settings["node.cpus"] = float64(runtime.NumCPU())
+	settings["node.gomaxprocs"] = float64(runtime.GOMAXPROCS(0))
+	if limit, ok := memoryLimit(); ok {
+		settings["node.memoryLimitBytes"] = float64(limit)
+	}
+
+	out.Settings = settings
+	return out, nil
+}
+
+// ClusterConfig requests the effective configuration of every node of the
+// cluster and reports the settings which differ between them, e.g. a module
+// which is only enabled on some of the nodes. Nodes which cannot be reached
+// are listed with an error and ignored in the comparison.
+func (m *Manager) ClusterConfig(ctx context.Context) (*ConfigReport, error) {
+	names := m.nodes.AllNames()
+	out := make([]*NodeConfig, len(names))
+
+	wg := &sync.WaitGroup{}
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			out[i] = m.nodeConfig(ctx, name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Name < out[b].Name
+	})
+
+	divergences := compareConfigs(out)
+	return &ConfigReport{
+		Consistent:  len(divergences) == 0,
+		Nodes:       out,
+		Divergences: divergences,
+	}, nil
+}
+
+func (m *Manager) nodeConfig(ctx context.Context, name string) *NodeConfig {
+	var cfg *NodeConfig
+	var err error
+	if name == m.nodes.LocalName() {
+		cfg, err = m.LocalNodeConfig()
+	} else if host, ok := m.nodes.NodeHostname(name); !ok {
+		err = errors.New("node has no known host")
+	} else {
+		cfg, err = m.remote.NodeConfig(ctx, host)
+	}
+
+	if err != nil {
+		m.logger.WithField("action", "nodes_config").
+			WithField("node", name).
+			WithError(err).
+			Warn("could not get config of node")
+		return &NodeConfig{Name: name, Error: err.Error()}
+	}
+
+	cfg.Name = name
+	return cfg
+}
+
+// compareConfigs lists every setting which is not the same on all nodes
+// with a config, ordered by setting
+func compareConfigs(configs []*NodeConfig) []ConfigDivergence {
+	settings := map[string]struct{}{}
+	var reachable []*NodeConfig
+	for _, cfg := range configs {
+		if cfg.Error != "" {
+			continue
+		}
+
+		reachable = append(reachable, cfg)
+		for setting := range cfg.Settings {
+			settings[setting] = struct{}{}
+		}
+	}
+
+	out := []ConfigDivergence{}
+	for setting := range settings {
+		if _, ok := nodeSpecificSettings[setting]; ok {
+			continue
+		}
+
+		values := make(map[string]interface{}, len(reachable))
+		diverges := false
+		for i, cfg := range reachable {
+			values[cfg.Name] = cfg.Settings[setting]
+			if i > 0 && !reflect.DeepEqual(cfg.Settings[setting],
+				reachable[0].Settings[setting]) {
+				diverges = true
+			}
+		}
+
+		if diverges {
+			out = append(out, ConfigDivergence{Setting: setting, Values: values})
+		}
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Setting < out[b].Setting
+	})
+
+	return out
+}
+
+// flattenConfig turns the config into a flat map with the same values a
+// node receives when decoding the JSON of another node, so they can be
+// compared directly. Secrets are replaced by a hash.
+func flattenConfig(cfg config.Config) (map[string]interface{}, error) {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var nested map[string]interface{}
+	if err := json.Unmarshal(raw, &nested); err != nil {
+		return nil, err
+	}
+
+	out := map[string]interface{}{}
+	flatten("", nested, out)
+
+	// secrets are only reported as a hash, so that nodes with different
+	// secrets can be found without exposing them
+	for _, setting := range config.SecretSettings() {
+		value, ok := out[setting]
+		if !ok || isEmptySetting(value) {
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(encoded)
+		out[setting] = "sha256:" + hex.EncodeToString(hash[:8])
+	}
+
+	return out, nil
+}
+
+func flatten(prefix string, in map[string]interface{}, out map[string]interface{}) {
+	for key, value := range in {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			flatten(key, nested, out)
+			continue
+		}
+
+		out[key] = value
+	}
+}
+
+func isEmptySetting(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// memoryLimit reads the memory limit of the cgroup the process runs in, e.g.
+// the limit of its container
+func memoryLimit() (int64, bool) {
+	for _, path := range []string{
+		"/sys/fs/cgroup/memory.max",
+		"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+	} {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		limit, err := strconv.ParseInt(strings.TrimSpace(string(raw)), 10, 64)
+		if err != nil {
+			// cgroup v2 reports "max" without a limit
+			return 0, false
+		}
+		return limit, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package nodes
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterConfig(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	nodes := &fakeNodeResolver{
+		local: "node1",
+		hosts: map[string]string{
+			"node2": "10.0.0.2:7947",
+			"node3": "10.0.0.3:7947",
+		},
+	}
+
+	newConfig := func(hostname, modules string, apiKeys ...string) config.Config {
+		cfg := config.Defaults()
+		cfg.Cluster.Hostname = hostname
+		cfg.EnableModules = modules
+		cfg.Authentication.APIKey.AllowedKeys = apiKeys
+		return cfg
+	}
+
+	// the config of a remote node is requested as JSON
+	remoteConfig := func(cfg config.Config, modules []string) *NodeConfig {
+		m := NewManager(&fakeAuthorizer{}, &fakeNodeResolver{local: "node2"},
+			&fakeLocalShards{}, &fakeRemoteNodes{}, &fakePlacement{}, "1.2.3", "abc",
+			logger)
+		m.SetConfig(cfg, modules)
+		local, err := m.LocalNodeConfig()
+		require.Nil(t, err)
+
+		raw, err := json.Marshal(local)
+		require.Nil(t, err)
+		var out NodeConfig
+		require.Nil(t, json.Unmarshal(raw, &out))
+		return &out
+	}
+
+	t.Run("with consistent configs", func(t *testing.T) {
+		m := NewManager(&fakeAuthorizer{}, nodes, &fakeLocalShards{},
+			&fakeRemoteNodes{configByHost: map[string]*NodeConfig{
+				"10.0.0.2:7947": remoteConfig(newConfig("node2", "text2vec-openai", "secret"),
+					[]string{"text2vec-openai"}),
+			}},
+			&fakePlacement{}, "1.2.3", "abc", logger)
+		m.SetConfig(newConfig("node1", "text2vec-openai", "secret"),
+			[]string{"text2vec-openai"})
+
+		report, err := m.ClusterConfig(ctx)
+		require.Nil(t, err)
+
+		assert.True(t, report.Consistent)
+		assert.Empty(t, report.Divergences)
+		require.Len(t, report.Nodes, 3)
+		assert.Equal(t, "node3", report.Nodes[2].Name)
+		assert.NotEmpty(t, report.Nodes[2].Error)
+
+		key := report.Nodes[0].Settings["authentication.apikey.allowed_keys"]
+		assert.Contains(t, key, "sha256:")
+		assert.NotContains(t, key, "secret")
+	})
+
+	t.Run("with diverging configs", func(t *testing.T) {
+		m := NewManager(&fakeAuthorizer{}, nodes, &fakeLocalShards{},
+			&fakeRemoteNodes{configByHost: map[string]*NodeConfig{
+				"10.0.0.2:7947": remoteConfig(newConfig("node2", "text2vec-openai", "other"),
+					[]string{"text2vec-openai"}),
+				"10.0.0.3:7947": remoteConfig(newConfig("node3", "", "secret"), nil),
+			}},
+			&fakePlacement{}, "1.2.3", "abc", logger)
+		m.SetConfig(newConfig("node1", "text2vec-openai", "secret"),
+			[]string{"text2vec-openai"})
+
+		report, err := m.ClusterConfig(ctx)
+		require.Nil(t, err)
+
+		assert.False(t, report.Consistent)
+		settings := make([]string, len(report.Divergences))
+		for i, divergence := range report.Divergences {
+			settings[i] = divergence.Setting
+		}
+		assert.Equal(t, []string{
+			"authentication.apikey.allowed_keys",
+			"enable_modules",
+			"node.modules",
+		}, settings)
+
+		modules := report.Divergences[2].Values
+		assert.Equal(t, map[string]interface{}{
+			"node1": "text2vec-openai",
+			"node2": "text2vec-openai",
+			"node3": "",
+		}, modules)
+	})
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package nodes
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+// drainLeaveTimeout is how long a drained node waits for the other members
+// to learn that it is leaving the cluster
+const drainLeaveTimeout = 10 * time.Second
+
+// shardPlacement moves replicas of shards between the nodes of the cluster
+type shardPlacement interface {
+	GetSchemaSkipAuth() schema.Schema
+	ShardingState(class string) *sharding.State
+	AddShardReplicas(ctx context.Context, className string,
+		replicas map[string]string) error
+	RemoveShardReplicas(ctx context.Context, className, node string,
+		shards []string) error
+}
+
+// DrainNode moves the shards of the node to the remaining nodes of the
+// cluster and makes it leave the cluster afterwards. The node has to drain
+// itself, as it holds the data which needs to be copied, so the request is
+// forwarded if another node is specified.
+func (m *Manager) DrainNode(ctx context.Context, principal *models.Principal,
+	name string) (*models.NodeDrainResponse, error) {
+	err := m.authorizer.Authorize(principal, "update", "nodes")
+	if err != nil {
+		return nil, err
+	}
+
+	if name == m.nodes.LocalName() {
+		return m.DrainLocalNode(ctx)
+	}
+
+	host, ok := m.nodes.NodeHostname(name)
+	if !ok {
+		return nil, errortypes.New(errortypes.KindNotFound,
+			"node %q is not part of the cluster", name)
+	}
+
+	return m.remote.DrainNode(ctx, host)
+}
+
+// DrainLocalNode moves every shard replica of this node to another node. For
+// each class, the new replicas are added first, so they receive all writes
+// while the existing objects are copied to them. Only then the replicas are
+// removed from this node, their files are kept on disk. If the drain fails
+// part-way, the shards which were not moved yet stay on this node and the
+// drain can be retried.
+func (m *Manager) DrainLocalNode(ctx context.Context) (*models.NodeDrainResponse, error) {
+	m.drainLock.Lock()
+	if m.draining {
+		m.drainLock.Unlock()
+		return nil, errortypes.New(errortypes.KindConflict,
+			"node is already being drained")
+	}
+	m.draining = true
+	m.drainLock.Unlock()
+
+	defer func() {
+		m.drainLock.Lock()
+		m.draining = false
+		m.drainLock.Unlock()
+	}()
+
+	local := m.nodes.LocalName()
+	plan, err := m.planDrain(local)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &models.NodeDrainResponse{
+		Name:   local,
+		Shards: []*models.NodeDrainShard{},
+	}
+
+	for _, class := range plan.classes() {
+		replicas := plan[class]
+		if err := m.placement.AddShardReplicas(ctx, class, replicas); err != nil {
+			return nil, errors.Wrapf(err, "class %s: place new replicas", class)
+		}
+
+		shards := make([]string, 0, len(replicas))
+		for shard := range replicas {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+
+		for _, shard := range shards {
+			count, err := m.local.CopyShard(ctx, class, shard, replicas[shard])
+			if err != nil {
+				return nil, errors.Wrapf(err, "class %s: copy shard %s", class, shard)
+			}
+
+			out.Shards = append(out.Shards, &models.NodeDrainShard{
+				Class:       class,
+				Name:        shard,
+				Target:      replicas[shard],
+				ObjectCount: count,
+			})
+		}
+
+		if err := m.placement.RemoveShardReplicas(ctx, class, local, shards); err != nil {
+			return nil, errors.Wrapf(err, "class %s: remove local replicas", class)
+		}
+
+		m.logger.WithField("action", "nodes_drain").
+			WithField("class", class).
+			WithField("shards", len(shards)).
+			Info("moved shards off node")
+	}
+
+	if err := m.nodes.Leave(drainLeaveTimeout); err != nil {
+		return nil, errors.Wrap(err, "leave cluster")
+	}
+
+	return out, nil
+}
+
+// drainPlan maps the name of every class to the shards which need to be
+// moved and the node each of them is moved to
+type drainPlan map[string]map[string]string
+
+func (p drainPlan) classes() []string {
+	out := make([]string, 0, len(p))
+	for class := range p {
+		out = append(out, class)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// planDrain assigns every shard replica of the node to one of the remaining
+// nodes. Each replica goes to the node with the fewest replicas which does not
+// hold a replica of the same shard yet. The entire plan is made upfront, so a
+// drain which cannot complete is rejected before any shard is moved.
+func (m *Manager) planDrain(node string) (drainPlan, error) {
+	load := map[string]int{}
+	for _, name := range m.nodes.AllNames() {
+		if name != node {
+			load[name] = 0
+		}
+	}
+
+	if len(load) == 0 {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"cannot drain node %q, it is the only node of the cluster", node)
+	}
+
+	var classes []string
+	states := map[string]*sharding.State{}
+	sch := m.placement.GetSchemaSkipAuth()
+	if sch.Objects != nil {
+		for _, class := range sch.Objects.Classes {
+			state := m.placement.ShardingState(class.Class)
+			if state == nil {
+				continue
+			}
+
+			classes = append(classes, class.Class)
+			states[class.Class] = state
+			for _, physical := range state.Physical {
+				for _, owner := range physical.Nodes() {
+					if _, ok := load[owner]; ok {
+						load[owner]++
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(classes)
+
+	plan := drainPlan{}
+	for _, class := range classes {
+		state := states[class]
+		for _, shard := range state.AllPhysicalShards() {
+			physical := state.Physical[shard]
+			owners := physical.Nodes()
+			if !containsNode(owners, node) {
+				continue
+			}
+
+			if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+				return nil, errortypes.New(errortypes.KindValidation,
+					"tenant %q of class %q is inactive, activate it before draining "+
+						"node %q", shard, class, node)
+			}
+
+			target := leastLoaded(load, owners)
+			if target == "" {
+				return nil, errortypes.New(errortypes.KindValidation,
+					"cannot drain node %q, shard %q of class %q already has a replica "+
+						"on every other node", node, shard, class)
+			}
+
+			if plan[class] == nil {
+				plan[class] = map[string]string{}
+			}
+			plan[class][shard] = target
+			load[target]++
+		}
+	}
+
+	return plan, nil
+}
+
+// leastLoaded returns the node with the fewest replicas which is not one of
+// the excluded nodes. Ties are broken by name, so the plan is deterministic.
+func leastLoaded(load map[string]int, exclude []string) string {
+	best := ""
+	for name, count := range load {
+		if containsNode(exclude, name) {
+			continue
+		}
+
+		if best == "" || count < load[best] || (count == load[best] && name < best) {
+			best = name
+		}
+	}
+
+	return best
+}
+
+func containsNode(nodes []string, node string) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainNode(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	newNodes := func() *fakeNodeResolver {
+		return &fakeNodeResolver{
+			local: "node1",
+			hosts: map[string]string{
+				"node2": "10.0.0.2:7947",
+				"node3": "10.0.0.3:7947",
+			},
+		}
+	}
+
+	physical := func(name string, nodes ...string) sharding.Physical {
+		return sharding.Physical{
+			Name:           name,
+			BelongsToNode:  nodes[0],
+			BelongsToNodes: nodes,
+		}
+	}
+
+	newPlacement := func() *fakePlacement {
+		return &fakePlacement{states: map[string]*sharding.State{
+			"Article": {Physical: map[string]sharding.Physical{
+				"shard1": physical("shard1", "node1"),
+				"shard2": physical("shard2", "node2"),
+				"shard3": physical("shard3", "node1", "node2"),
+			}},
+			"Author": {Physical: map[string]sharding.Physical{
+				"shard1": physical("shard1", "node3"),
+			}},
+		}}
+	}
+
+	t.Run("moves the local shards to the least loaded nodes", func(t *testing.T) {
+		nodes := newNodes()
+		local := &fakeLocalShards{}
+		placement := newPlacement()
+		m := NewManager(&fakeAuthorizer{}, nodes, local, &fakeRemoteNodes{},
+			placement, "1.2.3", "abc", logger)
+
+		res, err := m.DrainNode(ctx, nil, "node1")
+		require.Nil(t, err)
+
+		assert.Equal(t, &models.NodeDrainResponse{
+			Name: "node1",
+			Shards: []*models.NodeDrainShard{
+				{Class: "Article", Name: "shard1", Target: "node3", ObjectCount: 10},
+				{Class: "Article", Name: "shard3", Target: "node3", ObjectCount: 10},
+			},
+		}, res)
+		assert.Equal(t, []string{
+			"Article/shard1->node3",
+			"Article/shard3->node3",
+		}, local.copied)
+
+		state := placement.states["Article"]
+		assert.Equal(t, []string{"node3"}, state.Physical["shard1"].Nodes())
+		assert.Equal(t, []string{"node2"}, state.Physical["shard2"].Nodes())
+		assert.Equal(t, []string{"node2", "node3"}, state.Physical["shard3"].Nodes())
+		assert.True(t, nodes.left)
+	})
+
+	t.Run("other nodes drain themselves", func(t *testing.T) {
+		drained := &models.NodeDrainResponse{Name: "node2"}
+		remote := &fakeRemoteNodes{
+			drainedByHost: map[string]*models.NodeDrainResponse{
+				"10.0.0.2:7947": drained,
+			},
+		}
+		nodes := newNodes()
+		m := NewManager(&fakeAuthorizer{}, nodes, &fakeLocalShards{}, remote,
+			newPlacement(), "1.2.3", "abc", logger)
+
+		res, err := m.DrainNode(ctx, nil, "node2")
+		require.Nil(t, err)
+		assert.Equal(t, drained, res)
+		assert.False(t, nodes.left)
+
+		_, err = m.DrainNode(ctx, nil, "node4")
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+
+	t.Run("no node can take over a shard", func(t *testing.T) {
+		placement := newPlacement()
+		placement.states["Author"].Physical["shard1"] = physical("shard1",
+			"node1", "node2", "node3")
+
+		nodes := newNodes()
+		local := &fakeLocalShards{}
+		m := NewManager(&fakeAuthorizer{}, nodes, local, &fakeRemoteNodes{},
+			placement, "1.2.3", "abc", logger)
+
+		_, err := m.DrainNode(ctx, nil, "node1")
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+		assert.Len(t, local.copied, 0, "nothing is moved if the drain cannot complete")
+		assert.Equal(t, []string{"node1"},
+			placement.states["Article"].Physical["shard1"].Nodes())
+		assert.False(t, nodes.left)
+	})
+
+	t.Run("inactive tenants are not moved", func(t *testing.T) {
+		placement := newPlacement()
+		cold := physical("tenant1", "node1")
+		cold.Status = models.TenantActivityStatusCOLD
+		placement.states["Article"].Physical["tenant1"] = cold
+
+		m := NewManager(&fakeAuthorizer{}, newNodes(), &fakeLocalShards{},
+			&fakeRemoteNodes{}, placement, "1.2.3", "abc", logger)
+
+		_, err := m.DrainNode(ctx, nil, "node1")
+		require.NotNil(t, err)
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+		assert.Contains(t, err.Error(), "tenant1")
+	})
+
+	t.Run("the only node of the cluster", func(t *testing.T) {
+		nodes := &fakeNodeResolver{local: "node1"}
+		m := NewManager(&fakeAuthorizer{}, nodes, &fakeLocalShards{},
+			&fakeRemoteNodes{}, newPlacement(), "1.2.3", "abc", logger)
+
+		_, err := m.DrainNode(ctx, nil, "node1")
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+		assert.False(t, nodes.left)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m := NewManager(&fakeAuthorizer{err: errors.New("forbidden")}, newNodes(),
+			&fakeLocalShards{}, &fakeRemoteNodes{}, newPlacement(), "1.2.3", "abc",
+			logger)
+
+		_, err := m.DrainNode(ctx, nil, "node1")
+		assert.EqualError(t, err, "forbidden")
+	})
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb,
+	resource string) error {
+	return a.err
+}
+
+type fakeNodeResolver struct {
+	local string
+	hosts map[string]string
+	left  bool
+}
+
+func (r *fakeNodeResolver) AllNames() []string {
+	names := []string{r.local}
+	for name := range r.hosts {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (r *fakeNodeResolver) LocalName() string {
+	return r.local
+}
+
+func (r *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
+	host, ok := r.hosts[nodeName]
+	return host, ok
+}
+
+func (r *fakeNodeResolver) Leave(timeout time.Duration) error {
+	r.left = true
+	return nil
+}
+
+type fakeLocalShards struct {
+	shards []*models.NodeShardStatus
+	err    error
+
+	// copied contains "class/shard->node" for every copied shard
+	copied []string
+}
+
+func (s *fakeLocalShards) LocalNodeShards(ctx context.Context) ([]*models.NodeShardStatus, error) {
+	return s.shards, s.err
+}
+
+func (s *fakeLocalShards) CopyShard(ctx context.Context, className, shardName,
+	node string) (int64, error) {
+	s.copied = append(s.copied, fmt.Sprintf("%s/%s->%s", className, shardName, node))
+	return 10, nil
+}
+
+// fakeRemoteNodes knows the status of the nodes by host, all other hosts
+// cannot be reached
+type fakeRemoteNodes struct {
+	statusByHost  map[string]*models.NodeStatus
+	drainedByHost map[string]*models.NodeDrainResponse
+	configByHost  map[string]*NodeConfig
+}
+
+func (r *fakeRemoteNodes) NodeStatus(ctx context.Context,
+	host string) (*models.NodeStatus, error) {
+	status, ok := r.statusByHost[host]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+
+	return status, nil
+}
+
+func (r *fakeRemoteNodes) DrainNode(ctx context.Context,
+	host string) (*models.NodeDrainResponse, error) {
+	res, ok := r.drainedByHost[host]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+
+	return res, nil
+}
+
+func (r *fakeRemoteNodes) NodeConfig(ctx context.Context,
+	host string) (*NodeConfig, error) {
+	cfg, ok := r.configByHost[host]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+
+	return cfg, nil
+}
+
+// fakePlacement applies replica changes directly to the sharding states
+type fakePlacement struct {
+	states map[string]*sharding.State
+}
+
+func (p *fakePlacement) GetSchemaSkipAuth() schema.Schema {
+	sch := schema.Empty()
+	for name := range p.states {
+		sch.Objects.Classes = append(sch.Objects.Classes, &models.Class{Class: name})
+	}
+	return sch
+}
+
+func (p *fakePlacement) ShardingState(class string) *sharding.State {
+	return p.states[class]
+}
+
+func (p *fakePlacement) AddShardReplicas(ctx context.Context, className string,
+	replicas map[string]string) error {
+	for shard, node := range replicas {
+		if !p.states[className].AddReplica(shard, node) {
+			return fmt.Errorf("cannot add replica of %s to %s", shard, node)
+		}
+	}
+	return nil
+}
+
+func (p *fakePlacement) RemoveShardReplicas(ctx context.Context, className,
+	node string, shards []string) error {
+	for _, shard := range shards {
+		if !p.states[className].RemoveReplica(shard, node) {
+			return fmt.Errorf("cannot remove replica of %s from %s", shard, node)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package nodes reports the status of the nodes of the cluster
+package nodes
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type nodeResolver interface {
+	AllNames() []string
+	LocalName() string
+	NodeHostname(nodeName string) (string, bool)
+	Leave(timeout time.Duration) error
+}
+
+type localShards interface {
+	LocalNodeShards(ctx context.Context) ([]*models.NodeShardStatus, error)
+	CopyShard(ctx context.Context, className, shardName, node string) (int64, error)
+}
+
+// RemoteNodes requests the status and the config of other nodes of the
+// cluster and asks them to drain themselves
+type RemoteNodes interface {
+	NodeStatus(ctx context.Context, host string) (*models.NodeStatus, error)
+	DrainNode(ctx context.Context, host string) (*models.NodeDrainResponse, error)
+	NodeConfig(ctx context.Context, host string) (*NodeConfig, error)
+}
+
+// Manager aggregates the status of all nodes. Every node reports its own
+// status, which other nodes request through the cluster API.
+type Manager struct {
+	authorizer authorizer
+	nodes      nodeResolver
+	local      localShards
+	remote     RemoteNodes
+	placement  shardPlacement
+	version    string
+	gitHash    string
+	logger     logrus.FieldLogger
+
+	drainLock sync.Mutex
+	draining  bool
+
+	// config and modules are compared with the other nodes, see SetConfig
+	configLock sync.Mutex
+	config     *config.Config
+	modules    []string
+}
+
+func NewManager(authorizer authorizer, nodes nodeResolver, local localShards,
+	remote RemoteNodes, placement shardPlacement, version, gitHash string,
+	logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		nodes:      nodes,
+		local:      local,
+		remote:     remote,
+		placement:  placement,
+		version:    version,
+		gitHash:    gitHash,
+		logger:     logger,
+	}
+}
+
+// GetNodeStatus of all nodes of the cluster, sorted by name. The nodes are
+// queried in parallel, nodes which cannot be reached are reported as
+// UNAVAILABLE instead of failing the entire request.
+func (m *Manager) GetNodeStatus(ctx context.Context,
+	principal *models.Principal) ([]*models.NodeStatus, error) {
+	err := m.authorizer.Authorize(principal, "list", "nodes")
+	if err != nil {
+		return nil, err
+	}
+
+	names := m.nodes.AllNames()
+	out := make([]*models.NodeStatus, len(names))
+
+	wg := &sync.WaitGroup{}
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			out[i] = m.nodeStatus(ctx, name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Name < out[b].Name
+	})
+
+	return out, nil
+}
+
+func (m *Manager) nodeStatus(ctx context.Context, name string) *models.NodeStatus {
+	if name == m.nodes.LocalName() {
+		return m.LocalNodeStatus(ctx)
+	}
+
+	unavailable := &models.NodeStatus{
+		Name:   name,
+		Status: models.NodeStatusStatusUNAVAILABLE,
+	}
+
+	host, ok := m.nodes.NodeHostname(name)
+	if !ok {
+		return unavailable
+	}
+
+	status, err := m.remote.NodeStatus(ctx, host)
+	if err != nil {
+		m.logger.WithField("action", "nodes_status").
+			WithField("node", name).
+			WithError(err).
+			Warn("could not get status of node")
+		return unavailable
+	}
+
+	return status
+}
+
+// LocalNodeStatus reports the status of this node. If the local shards
+// cannot be inspected, the node is reported as UNHEALTHY.
+func (m *Manager) LocalNodeStatus(ctx context.Context) *models.NodeStatus {
+	status := &models.NodeStatus{
+		Name:    m.nodes.LocalName(),
+		Status:  models.NodeStatusStatusHEALTHY,
+		Version: m.version,
+		GitHash: m.gitHash,
+	}
+
+	shards, err := m.local.LocalNodeShards(ctx)
+	if err != nil {
+		m.logger.WithField("action", "nodes_status").
+			WithError(err).
+			Error("could not inspect local shards")
+		status.Status = models.NodeStatusStatusUNHEALTHY
+		return status
+	}
+
+	stats := &models.NodeStats{ShardCount: int64(len(shards))}
+	for _, shard := range shards {
+		stats.ObjectCount += shard.ObjectCount
+		stats.LsmStoreBytes += shard.LsmStoreBytes
+		stats.WalBytes += shard.WalBytes
+		stats.VectorIndexCommitLogBytes += shard.VectorIndexCommitLogBytes
+	}
+
+	status.Shards = shards
+	status.Stats = stats
+	return status
+}
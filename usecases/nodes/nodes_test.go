@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNodeStatus(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	nodes := &fakeNodeResolver{
+		local: "node1",
+		hosts: map[string]string{
+			"node2": "10.0.0.2:7947",
+			"node3": "10.0.0.3:7947",
+		},
+	}
+	local := &fakeLocalShards{
+		shards: []*models.NodeShardStatus{
+			{
+				Class: "Article", Name: "shard1", ObjectCount: 3,
+				LsmStoreBytes: 1000, WalBytes: 10, VectorIndexCommitLogBytes: 500,
+			},
+			{
+				Class: "Author", Name: "shard1", ObjectCount: 4,
+				LsmStoreBytes: 2000, WalBytes: 20, VectorIndexCommitLogBytes: 700,
+			},
+		},
+	}
+	node2 := &models.NodeStatus{
+		Name:    "node2",
+		Status:  models.NodeStatusStatusHEALTHY,
+		Version: "1.2.3",
+		GitHash: "abc",
+		Stats:   &models.NodeStats{},
+	}
+	remote := &fakeRemoteNodes{
+		statusByHost: map[string]*models.NodeStatus{"10.0.0.2:7947": node2},
+	}
+
+	t.Run("reports all nodes sorted by name", func(t *testing.T) {
+		m := NewManager(&fakeAuthorizer{}, nodes, local, remote, &fakePlacement{},
+			"1.2.3", "abc", logger)
+
+		res, err := m.GetNodeStatus(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		assert.Equal(t, &models.NodeStatus{
+			Name:    "node1",
+			Status:  models.NodeStatusStatusHEALTHY,
+			Version: "1.2.3",
+			GitHash: "abc",
+			Stats: &models.NodeStats{
+				ShardCount: 2, ObjectCount: 7, LsmStoreBytes: 3000, WalBytes: 30,
+				VectorIndexCommitLogBytes: 1200,
+			},
+			Shards: local.shards,
+		}, res[0])
+		assert.Equal(t, node2, res[1])
+		assert.Equal(t, &models.NodeStatus{
+			Name:   "node3",
+			Status: models.NodeStatusStatusUNAVAILABLE,
+		}, res[2])
+	})
+
+	t.Run("local shards cannot be inspected", func(t *testing.T) {
+		failing := &fakeLocalShards{err: errors.New("disk on fire")}
+		m := NewManager(&fakeAuthorizer{}, nodes, failing, remote, &fakePlacement{},
+			"1.2.3", "abc", logger)
+
+		status := m.LocalNodeStatus(ctx)
+		assert.Equal(t, models.NodeStatusStatusUNHEALTHY, status.Status)
+		assert.Equal(t, "node1", status.Name)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m := NewManager(&fakeAuthorizer{err: errors.New("forbidden")}, nodes,
+			local, remote, &fakePlacement{}, "1.2.3", "abc", logger)
+
+		_, err := m.GetNodeStatus(ctx, nil)
+		assert.EqualError(t, err, "forbidden")
+	})
+}
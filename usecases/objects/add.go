@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 	"github.com/semi-technologies/weaviate/usecases/objects/validation"
 )
 
@@ -27,6 +28,7 @@ type schemaManager interface {
 		class *models.Class) error
 	AddClassProperty(ctx context.Context, principal *models.Principal,
 		class string, property *models.Property) error
+	ValidateTenant(className, tenant string) error
 }
 
 // AddObject Class Instance to the connected DB. If the class contains a network
@@ -69,12 +71,18 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context,
 
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object) (*models.Object, error) {
+	// the id is only required to be unique within the tenant
+	ctx = tenant.NewContext(ctx, object.Tenant)
 	id, err := m.checkIDOrAssignNew(ctx, object.ID)
 	if err != nil {
 		return nil, err
 	}
 	object.ID = id
 
+	if err := routeObject(ctx, m.router, object); err != nil {
+		return nil, err
+	}
+
 	err = m.autoSchemaManager.autoSchema(ctx, principal, object)
 	if err != nil {
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
@@ -85,27 +93,74 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
+	err = validateRules(ctx, m.ruleValidator, object.Class, object.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := m.quotas.admit(ctx, object.Class, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.putAdmittedObject(ctx, principal, object); err != nil {
+		// the object does not count against the quota if it was not added
+		reserved.release(1)
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// putAdmittedObject adds an object which was validated and admitted by the
+// quotas of its class
+func (m *Manager) putAdmittedObject(ctx context.Context, principal *models.Principal,
+	object *models.Object) error {
 	now := m.timeSource.Now()
 	object.CreationTimeUnix = now
 	object.LastUpdateTimeUnix = now
 
-	err = m.vectorizeAndPutObject(ctx, object, principal)
+	if err := maskObject(ctx, m.masker, object); err != nil {
+		return err
+	}
+
+	err := m.vectorizeObject(ctx, object, principal)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return object, nil
+	err = checkDuplicate(ctx, m.vectorRepo, m.config.Config.Deduplication, object)
+	if err != nil {
+		return err
+	}
+
+	err = m.putObject(ctx, object)
+	if err != nil {
+		return err
+	}
+
+	m.shadower.putObjects(principal, object.Class, []*models.Object{object})
+
+	return nil
 }
 
 func (m *Manager) vectorizeAndPutObject(ctx context.Context, object *models.Object,
 	principal *models.Principal) error {
-	err := newVectorObtainer(m.vectorizerProvider, m.schemaManager,
-		m.logger).Do(ctx, object, principal)
-	if err != nil {
+	if err := m.vectorizeObject(ctx, object, principal); err != nil {
 		return err
 	}
 
-	err = m.vectorRepo.PutObject(ctx, object, object.Vector)
+	return m.putObject(ctx, object)
+}
+
+func (m *Manager) vectorizeObject(ctx context.Context, object *models.Object,
+	principal *models.Principal) error {
+	return newVectorObtainer(m.vectorizerProvider, m.schemaManager,
+		m.logger).Do(ctx, object, principal)
+}
+
+func (m *Manager) putObject(ctx context.Context, object *models.Object) error {
+	err := m.vectorRepo.PutObject(ctx, object, object.Vector)
 	if err != nil {
 		return NewErrInternal("store: %v", err)
 	}
@@ -124,6 +179,10 @@ func (m *Manager) validateObject(ctx context.Context, principal *models.Principa
 		return err
 	}
 
+	if err := m.schemaManager.ValidateTenant(object.Class, object.Tenant); err != nil {
+		return err
+	}
+
 	return validation.New(s, m.exists, m.config).Object(ctx, object)
 }
 
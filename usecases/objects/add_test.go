@@ -40,6 +40,14 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 					Vectorizer:        config.VectorizerModuleNone,
 					VectorIndexConfig: hnsw.UserConfig{},
 				},
+				{
+					Class:      "FooBinary",
+					Vectorizer: config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{
+						Binary:   true,
+						Distance: "hamming",
+					},
+				},
 				{
 					Class:      "FooSkipped",
 					Vectorizer: config.VectorizerModuleNone,
@@ -183,6 +191,34 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Contains(t, err.Error(), "vector must be present")
 	})
 
+	t.Run("with a binary vector", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		class := &models.Object{
+			Vector: []float32{1, 0, 0, 1},
+			Class:  "FooBinary",
+		}
+
+		_, err := manager.AddObject(ctx, nil, class)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with a non-binary vector on a binary class", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		class := &models.Object{
+			Vector: []float32{1, 0, 0.5, 1},
+			Class:  "FooBinary",
+		}
+
+		_, err := manager.AddObject(ctx, nil, class)
+		_, ok := err.(ErrInvalidUserInput)
+		assert.True(t, ok)
+		assert.Contains(t, err.Error(), "must be 0 or 1, got 0.5 at position 2")
+	})
+
 	t.Run("without a vector, but indexing skipped", func(t *testing.T) {
 		reset()
 
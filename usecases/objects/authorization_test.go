@@ -90,6 +90,48 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:     "list",
 			expectedResource: "objects",
 		},
+		testCase{
+			methodName:       "GetObjectsAfter",
+			additionalArgs:   []interface{}{"SomeClass", (*string)(nil), (*int64)(nil), additional.Properties{}},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
+		testCase{
+			methodName:       "GetObjectsByKeywords",
+			additionalArgs:   []interface{}{"SomeClass", "some query", (*int64)(nil), (*int64)(nil), additional.Properties{}},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
+		testCase{
+			methodName:       "ScrollObjects",
+			additionalArgs:   []interface{}{(*string)(nil), (*string)(nil), "1m", (*int64)(nil), additional.Properties{}},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
+		testCase{
+			methodName:       "FindDuplicates",
+			additionalArgs:   []interface{}{"SomeClass", float32(0.05), int64(100)},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
+		testCase{
+			methodName:       "FacetedSearch",
+			additionalArgs:   []interface{}{&models.FacetedSearchRequest{Class: "SomeClass"}},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
+		testCase{
+			methodName:       "EstimateFilter",
+			additionalArgs:   []interface{}{&models.FilterEstimateRequest{Class: "SomeClass"}},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
+		testCase{
+			methodName:       "GetContinuousAggregate",
+			additionalArgs:   []interface{}{"SomeClass", "byCategory", ""},
+			expectedVerb:     "list",
+			expectedResource: "objects",
+		},
 
 		// reference on kinds
 		testCase{
@@ -118,7 +160,8 @@ func Test_Kinds_Authorization(t *testing.T) {
 			testedMethods[i] = test.methodName
 		}
 
-		for _, method := range allExportedMethods(&Manager{}) {
+		for _, method := range allExportedMethods(&Manager{}, "SetQuotas", "SetShadower",
+			"SetAdmission", "SetRouter", "SetMasker", "SetRuleValidator") {
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -180,6 +223,13 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "batch/*",
 		},
+
+		testCase{
+			methodName:       "DeleteObjects",
+			additionalArgs:   []interface{}{&models.BatchDeleteMatch{}, (*bool)(nil), (*string)(nil)},
+			expectedVerb:     "delete",
+			expectedResource: "batch/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -188,7 +238,8 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			testedMethods[i] = test.methodName
 		}
 
-		for _, method := range allExportedMethods(&BatchManager{}) {
+		for _, method := range allExportedMethods(&BatchManager{}, "SetQuotas", "SetShadower",
+			"SetAdmission", "SetRouter", "SetMasker", "SetPropertyUsage", "SetRuleValidator") {
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -204,7 +255,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			vectorRepo := &fakeVectorRepo{}
 			vectorizer := &fakeVectorizer{}
 			vecProvider := &fakeVectorizerProvider{vectorizer}
-			manager := NewBatchManager(vectorRepo, vecProvider, locks, schemaManager, cfg, logger, authorizer)
+			manager := NewBatchManager(vectorRepo, vecProvider, nil, locks, schemaManager, cfg, logger, authorizer)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -248,11 +299,20 @@ func callFuncByName(manager interface{}, funcName string, params ...interface{})
 	return
 }
 
-func allExportedMethods(subject interface{}) []string {
+// allExportedMethods of the subject, except the ones listed in skip, such as
+// setters used during startup, which are not exposed to users
+func allExportedMethods(subject interface{}, skip ...string) []string {
 	var methods []string
 	subjectType := reflect.TypeOf(subject)
+outer:
 	for i := 0; i < subjectType.NumMethod(); i++ {
 		name := subjectType.Method(i).Name
+		for _, s := range skip {
+			if name == s {
+				continue outer
+			}
+		}
+
 		if name[0] >= 'A' && name[0] <= 'Z' {
 			methods = append(methods, name)
 		}
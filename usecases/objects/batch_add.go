@@ -25,6 +25,8 @@ import (
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 	"github.com/semi-technologies/weaviate/usecases/objects/validation"
 )
 
@@ -36,6 +38,12 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 		return nil, err
 	}
 
+	release, err := b.admission.Acquire(ctx, admission.ClassBatch)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -52,16 +60,21 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	}
 
 	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields)
+	// chunks are not counted, they are part of the usage once it is measured
+	// the next time
+	reserved := b.quotas.admitBatch(ctx, batchObjects, b.exists)
+	batchObjects = b.addChunks(ctx, principal, batchObjects)
 
-	var (
-		res BatchObjects
-		err error
-	)
-	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects); err != nil {
+	res, err := b.vectorRepo.BatchPutObjects(ctx, batchObjects)
+	if err != nil {
+		reserved.releaseFailed(batchObjects, err)
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
+	reserved.releaseFailed(res, nil)
+
+	b.shadowObjects(principal, res)
 
-	return res, nil
+	return removeChunks(res, len(classes)), nil
 }
 
 func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
@@ -98,8 +111,14 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 
 	ec := &errorCompounder{}
 
+	// the id is only required to be unique within the tenant
+	ctx = tenant.NewContext(ctx, concept.Tenant)
+
+	err := routeObject(ctx, b.router, concept)
+	ec.add(err)
+
 	// Auto Schema
-	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
+	err = b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.add(err)
 
 	if concept.ID == "" {
@@ -123,6 +142,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	object.LastUpdateTimeUnix = 0
 	object.ID = id
 	object.Vector = concept.Vector
+	object.Tenant = concept.Tenant
 
 	if _, ok := fieldsToKeep["class"]; ok {
 		object.Class = concept.Class
@@ -134,13 +154,27 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 		object.CreationTimeUnix = unixNow()
 	}
 
+	err = b.schemaManager.ValidateTenant(object.Class, object.Tenant)
+	ec.add(err)
+
 	err = validation.New(s, b.exists, b.config).Object(ctx, object)
 	ec.add(err)
 
+	if err == nil {
+		ec.add(validateRules(ctx, b.ruleValidator, object.Class, object.Properties))
+	}
+
+	err = maskObject(ctx, b.masker, object)
+	ec.add(err)
+
 	err = newVectorObtainer(b.vectorizerProvider, b.schemaManager,
 		b.logger).Do(ctx, object, principal)
 	ec.add(err)
 
+	if err == nil {
+		ec.add(checkDuplicate(ctx, b.vectorRepo, b.config.Config.Deduplication, object))
+	}
+
 	*resultsC <- BatchObject{
 		UUID:          id,
 		Object:        object,
@@ -179,6 +213,12 @@ func (ec *errorCompounder) toError() error {
 		return nil
 	}
 
+	if len(ec.errors) == 1 {
+		// keep the type of a single error, e.g. so rule violations stay
+		// structured
+		return ec.errors[0]
+	}
+
 	var msg strings.Builder
 	for i, err := range ec.errors {
 		if i != 0 {
@@ -194,3 +234,29 @@ func (ec *errorCompounder) toError() error {
 func unixNow() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
 }
+
+// shadowObjects mirrors the successfully added objects of every class with a
+// shadow config
+func (b *BatchManager) shadowObjects(principal *models.Principal,
+	objects BatchObjects) {
+	if b.shadower == nil {
+		return
+	}
+
+	var classes []string
+	byClass := map[string][]*models.Object{}
+	for _, obj := range objects {
+		if obj.Err != nil || obj.Object == nil {
+			continue
+		}
+
+		if _, ok := byClass[obj.Object.Class]; !ok {
+			classes = append(classes, obj.Object.Class)
+		}
+		byClass[obj.Object.Class] = append(byClass[obj.Object.Class], obj.Object)
+	}
+
+	for _, class := range classes {
+		b.shadower.putObjects(principal, class, byClass[class])
+	}
+}
@@ -69,7 +69,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
-		manager = NewBatchManager(vectorRepo, vecProvider, locks,
+		manager = NewBatchManager(vectorRepo, vecProvider, nil, locks,
 			schemaManager, config, logger, authorizer)
 	}
 
@@ -264,7 +264,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
 		vectorizer.On("UpdateObject", mock.Anything).Return([]float32{0, 1, 2}, nil)
-		manager = NewBatchManager(vectorRepo, vecProvider, locks,
+		manager = NewBatchManager(vectorRepo, vecProvider, nil, locks,
 			schemaManager, config, logger, authorizer)
 	}
 
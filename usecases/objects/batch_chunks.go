@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/objects/validation"
+)
+
+// ChunkerProvider splits an object into additional chunk objects which are
+// imported alongside the object itself. Implemented by the modules provider.
+type ChunkerProvider interface {
+	ChunkObject(ctx context.Context, obj *models.Object) ([]*models.Object, error)
+}
+
+// addChunks extends the batch with the chunk objects of every valid object.
+// Chunks are appended after the original objects, so the original indices
+// stay intact. If any chunk of an object is invalid, neither the object nor
+// any of its chunks are imported.
+func (b *BatchManager) addChunks(ctx context.Context, principal *models.Principal,
+	batch BatchObjects) BatchObjects {
+	if b.chunkerProvider == nil {
+		return batch
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		// the same error has already been recorded on each object during
+		// validation, so there is nothing left to import
+		return batch
+	}
+
+	inBatch := map[strfmt.UUID]struct{}{}
+	for _, obj := range batch {
+		if obj.Err == nil {
+			inBatch[obj.UUID] = struct{}{}
+		}
+	}
+
+	// chunks reference their parent which is only created as part of the
+	// same batch, so the parent is not yet present in the db
+	exists := func(ctx context.Context, id strfmt.UUID) (bool, error) {
+		if _, ok := inBatch[id]; ok {
+			return true, nil
+		}
+		return b.exists(ctx, id)
+	}
+
+	originalLen := len(batch)
+	for i := 0; i < originalLen; i++ {
+		parent := batch[i]
+		if parent.Err != nil {
+			continue
+		}
+
+		chunks, err := b.chunkerProvider.ChunkObject(ctx, parent.Object)
+		if err != nil {
+			batch[i].Err = NewErrInternal("chunk object: %v", err)
+			continue
+		}
+
+		chunkObjects := make(BatchObjects, len(chunks))
+		for pos, chunk := range chunks {
+			chunk.ID = chunkID(parent.UUID, pos)
+			chunk.CreationTimeUnix = parent.Object.CreationTimeUnix
+
+			if err := validation.New(s, exists, b.config).Object(ctx, chunk); err != nil {
+				batch[i].Err = NewErrInvalidUserInput("chunk %d: %v", pos, err)
+				break
+			}
+
+			err := newVectorObtainer(b.vectorizerProvider, b.schemaManager,
+				b.logger).Do(ctx, chunk, principal)
+			if err != nil {
+				batch[i].Err = errors.Wrapf(err, "chunk %d", pos)
+				break
+			}
+
+			chunkObjects[pos] = BatchObject{
+				UUID:          chunk.ID,
+				Object:        chunk,
+				Vector:        chunk.Vector,
+				OriginalIndex: len(batch) + pos,
+				parentIndex:   i,
+			}
+		}
+
+		if batch[i].Err != nil {
+			continue
+		}
+
+		batch = append(batch, chunkObjects...)
+	}
+
+	return batch
+}
+
+// removeChunks strips the chunk objects from an imported batch again, so
+// that the response matches the request. Import errors of chunks are
+// attributed to their parent object.
+func removeChunks(batch BatchObjects, originalLen int) BatchObjects {
+	for _, chunk := range batch[originalLen:] {
+		if chunk.Err != nil && batch[chunk.parentIndex].Err == nil {
+			batch[chunk.parentIndex].Err = errors.Wrapf(chunk.Err,
+				"import chunk %s", chunk.UUID)
+		}
+	}
+
+	return batch[:originalLen]
+}
+
+// chunkID is deterministic, so that re-importing the same parent object
+// overwrites its previous chunks rather than duplicating them
+func chunkID(parent strfmt.UUID, pos int) strfmt.UUID {
+	parentUUID, err := uuid.Parse(parent.String())
+	if err != nil {
+		parentUUID = uuid.Nil
+	}
+
+	return strfmt.UUID(uuid.NewSHA1(parentUUID, []byte(strconv.Itoa(pos))).String())
+}
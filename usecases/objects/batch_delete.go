@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/admission"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
+)
+
+const (
+	// OutputMinimal only lists the objects which could not be deleted
+	OutputMinimal = "minimal"
+	// OutputVerbose lists every object selected for deletion
+	OutputVerbose = "verbose"
+)
+
+// DeleteObjects deletes all objects of a class matching the where filter of
+// the match in batch. If dryRun is set the matching objects are only listed.
+func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Principal,
+	match *models.BatchDeleteMatch, dryRun *bool, output *string) (*BatchDeleteResponse, error) {
+	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := b.admission.Acquire(ctx, admission.ClassBatch)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.deleteObjects(ctx, principal, match, dryRun, output)
+}
+
+func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
+	match *models.BatchDeleteMatch, dryRun *bool, output *string) (*BatchDeleteResponse, error) {
+	params, err := b.validateBatchDelete(principal, match, dryRun, output)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("validate: %v", err)
+	}
+
+	res, err := b.vectorRepo.BatchDeleteObjects(ctx, params)
+	if err != nil {
+		return nil, NewErrInternal("batch delete objects: %v", err)
+	}
+	b.propertyUsage.Record(propertyusage.Query{
+		Class:   params.ClassName.String(),
+		Filters: params.Filters,
+	})
+
+	out := &BatchDeleteResponse{
+		Match:  match,
+		DryRun: params.DryRun,
+		Output: OutputMinimal,
+		Result: res,
+	}
+	if output != nil {
+		out.Output = *output
+	}
+
+	return out, nil
+}
+
+func (b *BatchManager) validateBatchDelete(principal *models.Principal,
+	match *models.BatchDeleteMatch, dryRun *bool, output *string) (BatchDeleteParams, error) {
+	if match == nil {
+		return BatchDeleteParams{}, fmt.Errorf("empty match clause")
+	}
+
+	if match.Class == "" {
+		return BatchDeleteParams{}, fmt.Errorf("empty match.class clause")
+	}
+
+	if match.Where == nil {
+		return BatchDeleteParams{}, fmt.Errorf("empty match.where clause")
+	}
+
+	if output != nil && *output != OutputMinimal && *output != OutputVerbose {
+		return BatchDeleteParams{}, fmt.Errorf("invalid output: %q, possible values "+
+			"are: %q, %q", *output, OutputMinimal, OutputVerbose)
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return BatchDeleteParams{}, err
+	}
+
+	class := s.FindClassByName(schema.ClassName(match.Class))
+	if class == nil {
+		return BatchDeleteParams{}, fmt.Errorf("class %q not found in schema",
+			match.Class)
+	}
+
+	filter, err := filterext.Parse(match.Where)
+	if err != nil {
+		return BatchDeleteParams{}, err
+	}
+
+	if err := validateDeleteFilterProps(class, filter.Root); err != nil {
+		return BatchDeleteParams{}, err
+	}
+
+	params := BatchDeleteParams{
+		ClassName: schema.ClassName(class.Class),
+		Filters:   filter,
+		Limit:     b.config.Config.BatchDeleteMaximum,
+	}
+	if dryRun != nil {
+		params.DryRun = *dryRun
+	}
+
+	return params, nil
+}
+
+// validateDeleteFilterProps makes sure that every property the filter is
+// applied on exists on the class, so that a typo cannot lead to an empty
+// match. Properties of referenced classes are validated by the searcher.
+func validateDeleteFilterProps(class *models.Class, clause *filters.Clause) error {
+	if clause.Operands != nil {
+		for i := range clause.Operands {
+			if err := validateDeleteFilterProps(class, &clause.Operands[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	propName := clause.On.Property.String()
+	if propName == "id" {
+		return nil
+	}
+
+	if _, err := schema.GetPropertyByName(class, propName); err != nil {
+		return err
+	}
+
+	return nil
+}
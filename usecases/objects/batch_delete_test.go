@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_DeleteObjects(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *BatchManager
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{
+							Name:     "name",
+							DataType: []string{string(schema.DataTypeString)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		config := &config.WeaviateConfig{
+			Config: config.Config{
+				BatchDeleteMaximum: 100,
+			},
+		}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: sch,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		manager = NewBatchManager(vectorRepo, nil, nil, locks,
+			schemaManager, config, logger, authorizer)
+	}
+
+	ctx := context.Background()
+	value := "bar"
+	where := &models.WhereFilter{
+		Operator:    "Equal",
+		Path:        []string{"name"},
+		ValueString: &value,
+	}
+
+	t.Run("with a valid match", func(t *testing.T) {
+		reset()
+		id := strfmt.UUID("a0b55b05-bc5b-4cc9-b646-1452d1390a62")
+		vectorRepo.On("BatchDeleteObjects", mock.Anything).Return(BatchDeleteResult{
+			Matches: 1,
+			Limit:   100,
+			Objects: BatchSimpleObjects{{UUID: id}},
+		}, nil).Once()
+
+		match := &models.BatchDeleteMatch{Class: "Foo", Where: where}
+		res, err := manager.DeleteObjects(ctx, nil, match, nil, nil)
+		require.Nil(t, err)
+
+		params := vectorRepo.Calls[0].Arguments[0].(BatchDeleteParams)
+		assert.Equal(t, schema.ClassName("Foo"), params.ClassName)
+		assert.Equal(t, int64(100), params.Limit)
+		assert.False(t, params.DryRun)
+		require.NotNil(t, params.Filters)
+		assert.Equal(t, filters.OperatorEqual, params.Filters.Root.Operator)
+		assert.Equal(t, schema.PropertyName("name"), params.Filters.Root.On.Property)
+
+		assert.Equal(t, OutputMinimal, res.Output)
+		assert.False(t, res.DryRun)
+		assert.Equal(t, match, res.Match)
+		assert.Equal(t, int64(1), res.Result.Matches)
+		assert.Equal(t, BatchSimpleObjects{{UUID: id}}, res.Result.Objects)
+	})
+
+	t.Run("with dry run and verbose output", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchDeleteObjects", mock.Anything).
+			Return(BatchDeleteResult{}, nil).Once()
+
+		match := &models.BatchDeleteMatch{Class: "Foo", Where: where}
+		dryRun := true
+		output := OutputVerbose
+		res, err := manager.DeleteObjects(ctx, nil, match, &dryRun, &output)
+		require.Nil(t, err)
+
+		params := vectorRepo.Calls[0].Arguments[0].(BatchDeleteParams)
+		assert.True(t, params.DryRun)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, OutputVerbose, res.Output)
+	})
+
+	t.Run("with invalid input", func(t *testing.T) {
+		invalidOutput := "everything"
+
+		tests := []struct {
+			name   string
+			match  *models.BatchDeleteMatch
+			output *string
+		}{
+			{
+				name: "without a match",
+			},
+			{
+				name:  "without a class",
+				match: &models.BatchDeleteMatch{Where: where},
+			},
+			{
+				name:  "without a where filter",
+				match: &models.BatchDeleteMatch{Class: "Foo"},
+			},
+			{
+				name:  "with a non-existing class",
+				match: &models.BatchDeleteMatch{Class: "Bar", Where: where},
+			},
+			{
+				name: "with a non-existing property",
+				match: &models.BatchDeleteMatch{Class: "Foo", Where: &models.WhereFilter{
+					Operator:    "Equal",
+					Path:        []string{"nmae"},
+					ValueString: &value,
+				}},
+			},
+			{
+				name:   "with an invalid output",
+				match:  &models.BatchDeleteMatch{Class: "Foo", Where: where},
+				output: &invalidOutput,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				reset()
+				_, err := manager.DeleteObjects(ctx, nil, test.match, nil, test.output)
+				require.NotNil(t, err)
+				assert.IsType(t, ErrInvalidUserInput{}, err)
+				vectorRepo.AssertNotCalled(t, "BatchDeleteObjects", mock.Anything)
+			})
+		}
+	})
+}
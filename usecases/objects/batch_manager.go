@@ -14,7 +14,9 @@ package objects
 import (
 	"context"
 
+	"github.com/semi-technologies/weaviate/usecases/admission"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,7 +30,15 @@ type BatchManager struct {
 	authorizer         authorizer
 	vectorRepo         BatchVectorRepo
 	vectorizerProvider VectorizerProvider
+	chunkerProvider    ChunkerProvider
 	autoSchemaManager  *autoSchemaManager
+	quotas             *Quotas
+	shadower           *Shadower
+	admission          *admission.Controller
+	router             RouterProvider
+	masker             MaskerProvider
+	ruleValidator      RuleValidator
+	propertyUsage      *propertyusage.Tracker
 }
 
 type BatchVectorRepo interface {
@@ -39,12 +49,14 @@ type BatchVectorRepo interface {
 type batchRepoNew interface {
 	BatchPutObjects(ctx context.Context, objects BatchObjects) (BatchObjects, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences) (BatchReferences, error)
+	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams) (BatchDeleteResult, error)
 }
 
 // NewBatchManager creates a new manager
 func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer VectorizerProvider,
-	locks locks, schemaManager schemaManager, config *config.WeaviateConfig,
-	logger logrus.FieldLogger, authorizer authorizer) *BatchManager {
+	chunker ChunkerProvider, locks locks, schemaManager schemaManager,
+	config *config.WeaviateConfig, logger logrus.FieldLogger,
+	authorizer authorizer) *BatchManager {
 	return &BatchManager{
 		config:             config,
 		locks:              locks,
@@ -52,7 +64,46 @@ func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer VectorizerProvider,
 		logger:             logger,
 		vectorRepo:         vectorRepo,
 		vectorizerProvider: vectorizer,
+		chunkerProvider:    chunker,
 		authorizer:         authorizer,
 		autoSchemaManager:  newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 	}
 }
+
+// SetQuotas enables enforcing the per-class quotas when objects are added
+func (b *BatchManager) SetQuotas(quotas *Quotas) {
+	b.quotas = quotas
+}
+
+// SetShadower enables mirroring the objects added to classes with a shadow
+// config
+func (b *BatchManager) SetShadower(shadower *Shadower) {
+	b.shadower = shadower
+}
+
+// SetAdmission enables limiting the number of concurrent batches
+func (b *BatchManager) SetAdmission(controller *admission.Controller) {
+	b.admission = controller
+}
+
+// SetRouter enables routing the objects of a batch to another class
+func (b *BatchManager) SetRouter(router RouterProvider) {
+	b.router = router
+}
+
+// SetMasker enables masking sensitive values of the objects of a batch
+func (b *BatchManager) SetMasker(masker MaskerProvider) {
+	b.masker = masker
+}
+
+// SetRuleValidator enables enforcing the data quality rules of classes on
+// the objects of a batch
+func (b *BatchManager) SetRuleValidator(validator RuleValidator) {
+	b.ruleValidator = validator
+}
+
+// SetPropertyUsage records which properties the filters of batch deletes
+// filter on
+func (b *BatchManager) SetPropertyUsage(tracker *propertyusage.Tracker) {
+	b.propertyUsage = tracker
+}
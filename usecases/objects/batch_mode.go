@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import "context"
+
+type atomicShardBatchesKey struct{}
+
+// WithAtomicShardBatches marks a batch import as all-or-nothing per shard:
+// Each shard either imports its entire share of the batch or none of it. The
+// mode is carried in the context, so it reaches the shards - including those
+// on remote nodes - without changing the signature of every batch method in
+// between.
+func WithAtomicShardBatches(ctx context.Context) context.Context {
+	return context.WithValue(ctx, atomicShardBatchesKey{}, true)
+}
+
+// AtomicShardBatches indicates whether the batch import in this context was
+// requested to be all-or-nothing per shard
+func AtomicShardBatches(ctx context.Context) bool {
+	atomic, _ := ctx.Value(atomicShardBatchesKey{}).(bool)
+	return atomic
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 )
 
 // AddReferences Class Instances in batch to the connected DB
@@ -29,6 +30,12 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 		return nil, err
 	}
 
+	release, err := b.admission.Acquire(ctx, admission.ClassBatch)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := b.locks.LockSchema()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -13,7 +13,9 @@ package objects
 
 import (
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 )
 
@@ -32,6 +34,10 @@ type BatchObject struct {
 	Object        *models.Object
 	UUID          strfmt.UUID
 	Vector        []float32
+
+	// parentIndex is only set on chunk objects which were added to the batch
+	// by a Chunker, it points to the object they were derived from
+	parentIndex int
 }
 
 // BatchObjects groups many Object items together. The order matches the
@@ -59,3 +65,41 @@ type BatchReference struct {
 // order from the original request. It can be turned into the expected response
 // type using the .Response() method
 type BatchReferences []BatchReference
+
+// BatchSimpleObject is the result of an operation on a single object in a
+// batch which does not need to return the object itself, such as a delete.
+type BatchSimpleObject struct {
+	UUID strfmt.UUID
+	Err  error
+}
+
+// BatchSimpleObjects groups many BatchSimpleObject items together
+type BatchSimpleObjects []BatchSimpleObject
+
+// BatchDeleteParams describes which objects should be deleted in a batch
+// delete. At most Limit of the objects matching the filter are deleted.
+type BatchDeleteParams struct {
+	ClassName schema.ClassName
+	Filters   *filters.LocalFilter
+	DryRun    bool
+	Limit     int64
+}
+
+// BatchDeleteResult contains the outcome for every object which was selected
+// for deletion. Matches is the total number of objects matching the filter,
+// which can be higher than the number of objects in Objects if it exceeds
+// the Limit.
+type BatchDeleteResult struct {
+	Matches int64
+	Limit   int64
+	Objects BatchSimpleObjects
+}
+
+// BatchDeleteResponse is the BatchDeleteResult together with the resolved
+// request parameters, so the response does not need to reapply defaults
+type BatchDeleteResponse struct {
+	Match  *models.BatchDeleteMatch
+	DryRun bool
+	Output string
+	Result BatchDeleteResult
+}
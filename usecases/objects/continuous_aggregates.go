@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+)
+
+// GetContinuousAggregate returns the current state of a continuous aggregate
+// registered in the inverted index config of a class. The counters are
+// maintained incrementally on every write, so no objects are scanned.
+func (m *Manager) GetContinuousAggregate(ctx context.Context, principal *models.Principal,
+	className, name, tenantName string) (*models.ContinuousAggregateResponse, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	class := s.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil, NewErrNotFound("class %q does not exist", className)
+	}
+
+	agg := continuousAggregateByName(class, name)
+	if agg == nil {
+		return nil, NewErrNotFound("class %q has no continuous aggregate %q",
+			className, name)
+	}
+
+	if err := m.schemaManager.ValidateTenant(class.Class, tenantName); err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, tenantName)
+	groups, err := m.vectorRepo.ContinuousAggregate(ctx, aggregation.ContinuousParams{
+		ClassName: schema.ClassName(class.Class),
+		Name:      agg.Name,
+	})
+	if err != nil {
+		return nil, NewErrInternal("continuous aggregate: %v", err)
+	}
+
+	out := &models.ContinuousAggregateResponse{
+		Class:  class.Class,
+		Name:   agg.Name,
+		Groups: make([]*models.ContinuousAggregateGroup, len(groups)),
+	}
+	for i, group := range groups {
+		out.Groups[i] = &models.ContinuousAggregateGroup{
+			Value: group.Value,
+			Count: group.Count,
+		}
+		if len(agg.Sum) == 0 {
+			continue
+		}
+
+		out.Groups[i].Sums = make(map[string]float64, len(agg.Sum))
+		for j, prop := range agg.Sum {
+			if j < len(group.Sums) {
+				out.Groups[i].Sums[prop] = group.Sums[j]
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func continuousAggregateByName(class *models.Class,
+	name string) *models.ContinuousAggregate {
+	if class.InvertedIndexConfig == nil {
+		return nil
+	}
+
+	for _, agg := range class.InvertedIndexConfig.ContinuousAggregates {
+		if agg != nil && agg.Name == name {
+			return agg
+		}
+	}
+
+	return nil
+}
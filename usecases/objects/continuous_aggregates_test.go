@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetContinuousAggregate(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	ctx := context.Background()
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{{
+						Class: "Article",
+						Properties: []*models.Property{
+							{Name: "category", DataType: []string{"string"}},
+							{Name: "wordCount", DataType: []string{"int"}},
+						},
+						InvertedIndexConfig: &models.InvertedIndexConfig{
+							ContinuousAggregates: []*models.ContinuousAggregate{
+								{Name: "byCategory", GroupBy: "category", Sum: []string{"wordCount"}},
+								{Name: "total"},
+							},
+						},
+					}},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+			vectorRepo, getFakeModulesProvider())
+	}
+
+	t.Run("with sums", func(t *testing.T) {
+		reset()
+
+		vectorRepo.On("ContinuousAggregate", mock.MatchedBy(func(p aggregation.ContinuousParams) bool {
+			return p.ClassName == "Article" && p.Name == "byCategory"
+		})).Return([]aggregation.ContinuousGroup{
+			{Value: "news", Count: 3, Sums: []float64{1200}},
+			{Value: "sports", Count: 1, Sums: []float64{300}},
+		}, nil).Once()
+
+		res, err := manager.GetContinuousAggregate(ctx, nil, "Article", "byCategory", "")
+		require.Nil(t, err)
+
+		assert.Equal(t, &models.ContinuousAggregateResponse{
+			Class: "Article",
+			Name:  "byCategory",
+			Groups: []*models.ContinuousAggregateGroup{
+				{Value: "news", Count: 3, Sums: map[string]float64{"wordCount": 1200}},
+				{Value: "sports", Count: 1, Sums: map[string]float64{"wordCount": 300}},
+			},
+		}, res)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("without sums or groups", func(t *testing.T) {
+		reset()
+
+		vectorRepo.On("ContinuousAggregate", mock.MatchedBy(func(p aggregation.ContinuousParams) bool {
+			return p.Name == "total"
+		})).Return([]aggregation.ContinuousGroup{{Count: 4}}, nil).Once()
+
+		res, err := manager.GetContinuousAggregate(ctx, nil, "Article", "total", "")
+		require.Nil(t, err)
+
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, &models.ContinuousAggregateGroup{Count: 4}, res.Groups[0])
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with an unknown class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetContinuousAggregate(ctx, nil, "Unknown", "total", "")
+		assert.IsType(t, ErrNotFound{}, err)
+		vectorRepo.AssertNotCalled(t, "ContinuousAggregate", mock.Anything)
+	})
+
+	t.Run("with an unknown aggregate", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetContinuousAggregate(ctx, nil, "Article", "unknown", "")
+		assert.IsType(t, ErrNotFound{}, err)
+		vectorRepo.AssertNotCalled(t, "ContinuousAggregate", mock.Anything)
+	})
+}
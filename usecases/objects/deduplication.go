@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// duplicateOfProp is the additional property in which a flagged
+// near-duplicate references the object it duplicates. It is stored with the
+// object.
+const duplicateOfProp = "duplicateOf"
+
+// checkDuplicate looks up the nearest neighbor of a new object if
+// near-duplicate detection is configured for its class. A near-duplicate is
+// either rejected or flagged, depending on the configured action. Objects of
+// the same batch are not yet indexed, so they are not compared to one
+// another.
+func checkDuplicate(ctx context.Context, repo VectorRepo,
+	cfg config.Deduplication, object *models.Object) error {
+	class, ok := cfg.Classes[object.Class]
+	if !ok || len(object.Vector) == 0 {
+		return nil
+	}
+
+	// an object which is imported again with the same id finds itself, so one
+	// more neighbor is needed
+	neighbors, err := repo.ObjectNeighbors(ctx, object.Class, object.Vector, 2)
+	if err != nil {
+		return NewErrInternal("search near-duplicates: %v", err)
+	}
+
+	for _, neighbor := range neighbors {
+		if neighbor.ID == object.ID {
+			continue
+		}
+
+		if neighbor.Dist > class.MaxDistance {
+			return nil
+		}
+
+		if class.Action == config.DeduplicationFlag {
+			if object.Additional == nil {
+				object.Additional = models.AdditionalProperties{}
+			}
+			object.Additional[duplicateOfProp] = map[string]interface{}{
+				"id":       neighbor.ID,
+				"distance": neighbor.Dist,
+			}
+			return nil
+		}
+
+		return NewErrInvalidUserInput("object is a near-duplicate of %s "+
+			"(distance %v)", neighbor.ID, neighbor.Dist)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Add_Object_Deduplication(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	class := func(name string) *models.Class {
+		return &models.Class{
+			Class:             name,
+			Vectorizer:        config.VectorizerModuleNone,
+			VectorIndexConfig: hnsw.UserConfig{},
+		}
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						class("Rejecting"), class("Flagging"), class("Unchecked"),
+					},
+				},
+			},
+		}
+		cfg := &config.WeaviateConfig{
+			Config: config.Config{
+				Deduplication: config.Deduplication{
+					Classes: map[string]config.ClassDeduplication{
+						"Rejecting": {MaxDistance: 0.05},
+						"Flagging": {
+							MaxDistance: 0.05,
+							Action:      config.DeduplicationFlag,
+						},
+					},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, cfg, logger,
+			&fakeAuthorizer{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+			vectorRepo, getFakeModulesProvider())
+	}
+
+	vector := []float32{0.1, 0.2, 0.3}
+	existing := search.Result{ID: "00000000-0000-0000-0000-000000000001"}
+
+	t.Run("a near-duplicate is rejected", func(t *testing.T) {
+		reset()
+		existing.Dist = 0.01
+		vectorRepo.On("ObjectNeighbors", "Rejecting", vector, 2).
+			Return([]search.Result{existing}, nil).Once()
+
+		_, err := manager.AddObject(context.Background(), nil,
+			&models.Object{Class: "Rejecting", Vector: vector})
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "near-duplicate of "+existing.ID.String())
+		vectorRepo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("an object which is far enough from its neighbor is added", func(t *testing.T) {
+		reset()
+		existing.Dist = 0.2
+		vectorRepo.On("ObjectNeighbors", "Rejecting", vector, 2).
+			Return([]search.Result{existing}, nil).Once()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddObject(context.Background(), nil,
+			&models.Object{Class: "Rejecting", Vector: vector})
+		require.Nil(t, err)
+		assert.Nil(t, res.Additional)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("a near-duplicate is flagged", func(t *testing.T) {
+		reset()
+		existing.Dist = 0.01
+		vectorRepo.On("ObjectNeighbors", "Flagging", vector, 2).
+			Return([]search.Result{existing}, nil).Once()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddObject(context.Background(), nil,
+			&models.Object{Class: "Flagging", Vector: vector})
+		require.Nil(t, err)
+		expected := models.AdditionalProperties{
+			"duplicateOf": map[string]interface{}{
+				"id":       existing.ID,
+				"distance": float32(0.01),
+			},
+		}
+		assert.Equal(t, expected, res.Additional)
+
+		stored := vectorRepo.Mock.Calls[1].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, expected, stored.Additional)
+	})
+
+	t.Run("classes without deduplication are not searched", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddObject(context.Background(), nil,
+			&models.Object{Class: "Unchecked", Vector: vector})
+		require.Nil(t, err)
+		vectorRepo.AssertNotCalled(t, "ObjectNeighbors", mock.Anything,
+			mock.Anything, mock.Anything)
+	})
+}
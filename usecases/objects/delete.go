@@ -48,5 +48,7 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) erro
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
 
+	m.shadower.deleteObject(object.Class, id)
+
 	return nil
 }
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+const (
+	// duplicatesPageSize is the number of objects which are compared to their
+	// neighbors at once
+	duplicatesPageSize = 100
+
+	// duplicatesNeighbors is the number of nearest neighbors each object is
+	// compared to. Larger groups are still found, as long as every member is
+	// linked to one of the others through its neighbors.
+	duplicatesNeighbors = 10
+)
+
+// FindDuplicates compares every object of the class to its nearest neighbors
+// and groups objects which are closer than maxDistance to one another. The
+// largest groups are returned first.
+func (m *Manager) FindDuplicates(ctx context.Context, principal *models.Principal,
+	className string, maxDistance float32, limit int64) (*models.DuplicatesResponse, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if maxDistance < 0 || maxDistance > 2 {
+		return nil, NewErrInvalidUserInput("distance must be between 0 and 2, got %v", maxDistance)
+	}
+
+	if limit < 1 {
+		return nil, NewErrInvalidUserInput("limit must be at least 1, got %d", limit)
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.GetClass(schema.ClassName(className)) == nil {
+		return nil, NewErrNotFound("class %q does not exist", className)
+	}
+
+	groups := newDuplicateGroups()
+	total, err := m.linkDuplicates(ctx, className, maxDistance, groups)
+	if err != nil {
+		return nil, NewErrInternal("find duplicates: %v", err)
+	}
+
+	return &models.DuplicatesResponse{
+		Class:        className,
+		Distance:     maxDistance,
+		Groups:       groups.result(int(limit)),
+		TotalObjects: total,
+	}, nil
+}
+
+// linkDuplicates iterates over all objects of the class and links each of
+// them to all its neighbors within maxDistance. It returns the number of
+// objects which were compared.
+func (m *Manager) linkDuplicates(ctx context.Context, className string,
+	maxDistance float32, groups *duplicateGroups) (int64, error) {
+	pageSize := duplicatesPageSize
+	if max := int(m.config.Config.QueryMaximumResults); max > 0 && max < pageSize {
+		pageSize = max
+	}
+
+	var total int64
+	cursor := filters.Cursor{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		page, err := m.vectorRepo.ObjectCursorSearch(ctx, className, cursor,
+			pageSize, additional.Properties{Vector: true})
+		if err != nil {
+			return total, err
+		}
+
+		for _, obj := range page {
+			total++
+			if len(obj.Vector) == 0 {
+				continue
+			}
+
+			neighbors, err := m.vectorRepo.ObjectNeighbors(ctx, className,
+				obj.Vector, duplicatesNeighbors)
+			if err != nil {
+				return total, err
+			}
+
+			for _, neighbor := range neighbors {
+				if neighbor.ID == obj.ID || neighbor.Dist > maxDistance {
+					continue
+				}
+
+				groups.link(obj.ID, neighbor.ID, neighbor.Dist)
+			}
+		}
+
+		if len(page) < pageSize {
+			return total, nil
+		}
+		cursor.After = page[len(page)-1].ID.String()
+	}
+}
+
+// duplicateGroups is a union-find over object ids. Each set is a group of
+// objects which are linked to one another by pairs of near-duplicates.
+type duplicateGroups struct {
+	parents map[strfmt.UUID]strfmt.UUID
+	maxDist map[strfmt.UUID]float32
+}
+
+func newDuplicateGroups() *duplicateGroups {
+	return &duplicateGroups{
+		parents: map[strfmt.UUID]strfmt.UUID{},
+		maxDist: map[strfmt.UUID]float32{},
+	}
+}
+
+func (g *duplicateGroups) find(id strfmt.UUID) strfmt.UUID {
+	parent, ok := g.parents[id]
+	if !ok {
+		g.parents[id] = id
+		return id
+	}
+
+	if parent == id {
+		return id
+	}
+
+	root := g.find(parent)
+	g.parents[id] = root
+	return root
+}
+
+func (g *duplicateGroups) link(a, b strfmt.UUID, dist float32) {
+	rootA, rootB := g.find(a), g.find(b)
+	maxDist := g.maxDist[rootA]
+	if g.maxDist[rootB] > maxDist {
+		maxDist = g.maxDist[rootB]
+	}
+	if dist > maxDist {
+		maxDist = dist
+	}
+
+	if rootA != rootB {
+		g.parents[rootB] = rootA
+		delete(g.maxDist, rootB)
+	}
+	g.maxDist[rootA] = maxDist
+}
+
+// result returns up to limit groups, largest groups first. Groups of the same
+// size are ordered by their first id, so the result is stable.
+func (g *duplicateGroups) result(limit int) []*models.DuplicateGroup {
+	members := map[strfmt.UUID][]strfmt.UUID{}
+	for id := range g.parents {
+		root := g.find(id)
+		members[root] = append(members[root], id)
+	}
+
+	out := make([]*models.DuplicateGroup, 0, len(members))
+	for root, ids := range members {
+		if len(ids) < 2 {
+			continue
+		}
+
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		out = append(out, &models.DuplicateGroup{
+			Ids:         ids,
+			MaxDistance: g.maxDist[root],
+		})
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		if len(out[a].Ids) != len(out[b].Ids) {
+			return len(out[a].Ids) > len(out[b].Ids)
+		}
+		return out[a].Ids[0] < out[b].Ids[0]
+	})
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindDuplicates(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{{Class: "Article"}},
+				},
+			},
+		}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryMaximumResults = 200
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, cfg, logger,
+			&fakeAuthorizer{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+			vectorRepo, getFakeModulesProvider())
+	}
+
+	ids := []strfmt.UUID{
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+		"00000000-0000-0000-0000-000000000003",
+		"00000000-0000-0000-0000-000000000004",
+		"00000000-0000-0000-0000-000000000005",
+		"00000000-0000-0000-0000-000000000006",
+	}
+
+	neighbor := func(id strfmt.UUID, dist float32) search.Result {
+		return search.Result{ID: id, Dist: dist}
+	}
+
+	t.Run("with groups of duplicates", func(t *testing.T) {
+		reset()
+
+		var page []search.Result
+		for i, id := range ids {
+			page = append(page, search.Result{ID: id, Vector: []float32{float32(i)}})
+		}
+		vectorRepo.On("ObjectCursorSearch", "Article", filters.Cursor{},
+			100, mock.Anything).Return(page, nil).Once()
+
+		// 1, 2 and 3 form a chain, 4 and 5 a pair, 6 is only far from the rest
+		neighbors := [][]search.Result{
+			{neighbor(ids[0], 0), neighbor(ids[1], 0.01), neighbor(ids[5], 0.3)},
+			{neighbor(ids[1], 0), neighbor(ids[0], 0.01), neighbor(ids[2], 0.04)},
+			{neighbor(ids[2], 0), neighbor(ids[1], 0.04)},
+			{neighbor(ids[3], 0), neighbor(ids[4], 0.02)},
+			{neighbor(ids[4], 0), neighbor(ids[3], 0.02)},
+			{neighbor(ids[5], 0), neighbor(ids[0], 0.3)},
+		}
+		for i := range ids {
+			vectorRepo.On("ObjectNeighbors", "Article", []float32{float32(i)},
+				duplicatesNeighbors).Return(neighbors[i], nil).Once()
+		}
+
+		res, err := manager.FindDuplicates(context.Background(), nil,
+			"Article", 0.05, 100)
+		require.Nil(t, err)
+
+		expected := &models.DuplicatesResponse{
+			Class:        "Article",
+			Distance:     0.05,
+			TotalObjects: 6,
+			Groups: []*models.DuplicateGroup{
+				{Ids: ids[0:3], MaxDistance: 0.04},
+				{Ids: ids[3:5], MaxDistance: 0.02},
+			},
+		}
+		assert.Equal(t, expected, res)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("limiting the groups", func(t *testing.T) {
+		reset()
+
+		page := []search.Result{
+			{ID: ids[0], Vector: []float32{0}},
+			{ID: ids[1], Vector: []float32{1}},
+			{ID: ids[2], Vector: []float32{2}},
+			{ID: ids[3], Vector: []float32{3}},
+		}
+		vectorRepo.On("ObjectCursorSearch", "Article", filters.Cursor{},
+			100, mock.Anything).Return(page, nil).Once()
+		vectorRepo.On("ObjectNeighbors", "Article", []float32{0}, mock.Anything).
+			Return([]search.Result{neighbor(ids[1], 0)}, nil)
+		vectorRepo.On("ObjectNeighbors", "Article", []float32{1}, mock.Anything).
+			Return([]search.Result{neighbor(ids[0], 0)}, nil)
+		vectorRepo.On("ObjectNeighbors", "Article", []float32{2}, mock.Anything).
+			Return([]search.Result{neighbor(ids[3], 0)}, nil)
+		vectorRepo.On("ObjectNeighbors", "Article", []float32{3}, mock.Anything).
+			Return([]search.Result{neighbor(ids[2], 0)}, nil)
+
+		res, err := manager.FindDuplicates(context.Background(), nil,
+			"Article", 0.05, 1)
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, ids[0:2], res.Groups[0].Ids)
+	})
+
+	t.Run("paging through the class", func(t *testing.T) {
+		reset()
+
+		var first []search.Result
+		for i := 0; i < duplicatesPageSize; i++ {
+			first = append(first, search.Result{ID: ids[0]})
+		}
+		vectorRepo.On("ObjectCursorSearch", "Article", filters.Cursor{},
+			100, mock.Anything).Return(first, nil).Once()
+		vectorRepo.On("ObjectCursorSearch", "Article",
+			filters.Cursor{After: ids[0].String()}, 100, mock.Anything).
+			Return([]search.Result{}, nil).Once()
+
+		res, err := manager.FindDuplicates(context.Background(), nil,
+			"Article", 0.05, 1)
+		require.Nil(t, err)
+		assert.Equal(t, int64(duplicatesPageSize), res.TotalObjects)
+		assert.Len(t, res.Groups, 0)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with a non-existing class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.FindDuplicates(context.Background(), nil,
+			"Unknown", 0.05, 100)
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("with an invalid distance", func(t *testing.T) {
+		reset()
+
+		_, err := manager.FindDuplicates(context.Background(), nil,
+			"Article", -0.1, 100)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("with an invalid limit", func(t *testing.T) {
+		reset()
+
+		_, err := manager.FindDuplicates(context.Background(), nil,
+			"Article", 0.05, 0)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
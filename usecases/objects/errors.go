@@ -11,7 +11,11 @@
 
 package objects
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+)
 
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
@@ -22,6 +26,10 @@ func (e ErrInvalidUserInput) Error() string {
 	return e.msg
 }
 
+func (e ErrInvalidUserInput) ErrorKind() errortypes.Kind {
+	return errortypes.KindValidation
+}
+
 // NewErrInvalidUserInput with Errorf signature
 func NewErrInvalidUserInput(format string, args ...interface{}) ErrInvalidUserInput {
 	return ErrInvalidUserInput{msg: fmt.Sprintf(format, args...)}
@@ -36,6 +44,10 @@ func (e ErrInternal) Error() string {
 	return e.msg
 }
 
+func (e ErrInternal) ErrorKind() errortypes.Kind {
+	return errortypes.KindInternal
+}
+
 // NewErrInternal with Errorf signature
 func NewErrInternal(format string, args ...interface{}) ErrInternal {
 	return ErrInternal{msg: fmt.Sprintf(format, args...)}
@@ -50,7 +62,31 @@ func (e ErrNotFound) Error() string {
 	return e.msg
 }
 
+func (e ErrNotFound) ErrorKind() errortypes.Kind {
+	return errortypes.KindNotFound
+}
+
 // NewErrNotFound with Errorf signature
 func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
+
+// ErrQuotaExceeded indicates that an object could not be added, because its
+// class has reached one of its quotas. The request should not be retried
+// before objects were deleted or the quota was raised.
+type ErrQuotaExceeded struct {
+	msg string
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return e.msg
+}
+
+func (e ErrQuotaExceeded) ErrorKind() errortypes.Kind {
+	return errortypes.KindValidation
+}
+
+// NewErrQuotaExceeded with Errorf signature
+func NewErrQuotaExceeded(format string, args ...interface{}) ErrQuotaExceeded {
+	return ErrQuotaExceeded{msg: fmt.Sprintf(format, args...)}
+}
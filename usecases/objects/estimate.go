@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+)
+
+// EstimateFilter dry runs a filter on a class. It returns an upper bound of
+// the number of matching objects, which is read from the lengths of the
+// posting lists without merging them, together with the indexes which serve
+// each clause of the filter. This allows validating the filter of e.g. a
+// retention policy or a batch delete before it is run. If requested, the
+// matches are additionally counted exactly.
+func (m *Manager) EstimateFilter(ctx context.Context, principal *models.Principal,
+	req *models.FilterEstimateRequest) (*models.FilterEstimateResponse, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Class == "" {
+		return nil, NewErrInvalidUserInput("class is required")
+	}
+
+	if req.Where == nil {
+		return nil, NewErrInvalidUserInput("where is required")
+	}
+
+	filter, err := filterext.Parse(req.Where)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("%v", err)
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	class := s.GetClass(schema.ClassName(req.Class))
+	if class == nil {
+		return nil, NewErrNotFound("class %q does not exist", req.Class)
+	}
+
+	if err := m.schemaManager.ValidateTenant(req.Class, req.Tenant); err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, req.Tenant)
+	estimate, err := m.vectorRepo.EstimateFilter(ctx, filters.EstimateParams{
+		ClassName: schema.ClassName(class.Class),
+		Filters:   filter,
+		Exact:     req.Exact,
+	})
+	if err != nil {
+		return nil, NewErrInternal("estimate filter: %v", err)
+	}
+
+	out := &models.FilterEstimateResponse{
+		EstimatedCount: int64(estimate.Count),
+		Indexes:        make([]*models.FilterIndexUsage, len(estimate.Indexes)),
+	}
+	if estimate.ExactCount != nil {
+		exact := int64(*estimate.ExactCount)
+		out.ExactCount = &exact
+	}
+	for i, usage := range estimate.Indexes {
+		out.Indexes[i] = &models.FilterIndexUsage{
+			Properties: usage.Properties,
+			Operator:   usage.Operator,
+			Index:      usage.Index,
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EstimateFilter(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	ctx := context.Background()
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{{
+						Class: "Article",
+						Properties: []*models.Property{
+							{Name: "category", DataType: []string{"string"}},
+						},
+					}},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+			vectorRepo, getFakeModulesProvider())
+	}
+
+	valueText := "news"
+	where := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"category"},
+		ValueText: &valueText,
+	}
+
+	t.Run("with an exact count", func(t *testing.T) {
+		reset()
+
+		exact := 5
+		estimate := &filters.Estimate{
+			Count:      7,
+			ExactCount: &exact,
+			Indexes: []filters.IndexUsage{{
+				Properties: []string{"category"},
+				Operator:   "Equal",
+				Index:      filters.IndexInverted,
+			}},
+		}
+		vectorRepo.On("EstimateFilter", mock.MatchedBy(func(p filters.EstimateParams) bool {
+			return p.ClassName == "Article" && p.Exact &&
+				p.Filters != nil && p.Filters.Root.Operator == filters.OperatorEqual
+		})).Return(estimate, nil).Once()
+
+		res, err := manager.EstimateFilter(ctx, nil, &models.FilterEstimateRequest{
+			Class: "Article",
+			Exact: true,
+			Where: where,
+		})
+		require.Nil(t, err)
+
+		exactCount := int64(5)
+		assert.Equal(t, &models.FilterEstimateResponse{
+			EstimatedCount: 7,
+			ExactCount:     &exactCount,
+			Indexes: []*models.FilterIndexUsage{{
+				Properties: []string{"category"},
+				Operator:   "Equal",
+				Index:      "inverted",
+			}},
+		}, res)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("without an exact count", func(t *testing.T) {
+		reset()
+
+		vectorRepo.On("EstimateFilter", mock.MatchedBy(func(p filters.EstimateParams) bool {
+			return !p.Exact
+		})).Return(&filters.Estimate{Count: 3}, nil).Once()
+
+		res, err := manager.EstimateFilter(ctx, nil, &models.FilterEstimateRequest{
+			Class: "Article",
+			Where: where,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(3), res.EstimatedCount)
+		assert.Nil(t, res.ExactCount)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *models.FilterEstimateRequest
+		}{
+			{"without a class", &models.FilterEstimateRequest{Where: where}},
+			{"without a filter", &models.FilterEstimateRequest{Class: "Article"}},
+			{"with an invalid filter", &models.FilterEstimateRequest{
+				Class: "Article",
+				Where: &models.WhereFilter{Operator: "Equal"},
+			}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				reset()
+
+				_, err := manager.EstimateFilter(ctx, nil, test.req)
+				assert.IsType(t, ErrInvalidUserInput{}, err)
+				vectorRepo.AssertNotCalled(t, "EstimateFilter", mock.Anything)
+			})
+		}
+	})
+
+	t.Run("with an unknown class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.EstimateFilter(ctx, nil, &models.FilterEstimateRequest{
+			Class: "Unknown",
+			Where: where,
+		})
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
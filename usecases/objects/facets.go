@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+)
+
+// defaultFacetLimit is the number of values per faceted property if the
+// request does not set a facetLimit
+const defaultFacetLimit = 10
+
+// FacetedSearch returns the first objects of a class matching the filter
+// together with the most common values of the faceted properties among all
+// matching objects. The filter is only resolved once and the facets are
+// counted from the same matches, so unlike a Get and an Aggregate query sent
+// one after another, the facets always describe exactly the objects that
+// were searched. Where possible the facets are counted from the inverted
+// index without reading any objects.
+func (m *Manager) FacetedSearch(ctx context.Context, principal *models.Principal,
+	req *models.FacetedSearchRequest) (*models.FacetedSearchResponse, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Class == "" {
+		return nil, NewErrInvalidUserInput("class is required")
+	}
+
+	if req.Limit < 0 {
+		return nil, NewErrInvalidUserInput("limit must not be negative, got %d", req.Limit)
+	}
+
+	facetLimit := defaultFacetLimit
+	if req.FacetLimit < 0 {
+		return nil, NewErrInvalidUserInput(
+			"facetLimit must not be negative, got %d", req.FacetLimit)
+	} else if req.FacetLimit > 0 {
+		facetLimit = int(req.FacetLimit)
+	}
+
+	var limit *int64
+	if req.Limit > 0 {
+		limit = &req.Limit
+	}
+	_, smartLimit, err := m.localOffsetLimit(nil, limit)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("faceted search: %v", err)
+	}
+
+	filter, err := filterext.Parse(req.Where)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("%v", err)
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	class := s.GetClass(schema.ClassName(req.Class))
+	if class == nil {
+		return nil, NewErrNotFound("class %q does not exist", req.Class)
+	}
+
+	props, err := facetProperties(class, req.Facets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.schemaManager.ValidateTenant(req.Class, req.Tenant); err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, req.Tenant)
+	res, facets, err := m.vectorRepo.FacetedSearch(ctx, aggregation.FacetParams{
+		ClassName:  schema.ClassName(class.Class),
+		Filters:    filter,
+		Limit:      smartLimit,
+		Properties: props,
+		Additional: additional.Properties{},
+		FacetLimit: facetLimit,
+	})
+	if err != nil {
+		return nil, NewErrInternal("faceted search: %v", err)
+	}
+
+	out := &models.FacetedSearchResponse{
+		Objects:      res.ObjectsWithVector(false),
+		TotalResults: int64(facets.Count),
+		Facets:       make([]*models.Facet, len(facets.Facets)),
+	}
+	for i, facet := range facets.Facets {
+		out.Facets[i] = facetToModel(facet)
+	}
+
+	return out, nil
+}
+
+// facetProperties makes sure that every faceted property exists on the class
+// and holds values which can be counted
+func facetProperties(class *models.Class,
+	names []string) ([]schema.PropertyName, error) {
+	out := make([]schema.PropertyName, len(names))
+	seen := map[string]struct{}{}
+	for i, name := range names {
+		if _, ok := seen[name]; ok {
+			return nil, NewErrInvalidUserInput("property %q is faceted twice", name)
+		}
+		seen[name] = struct{}{}
+
+		var prop *models.Property
+		for _, p := range class.Properties {
+			if p.Name == name {
+				prop = p
+				break
+			}
+		}
+		if prop == nil {
+			return nil, NewErrInvalidUserInput("class %q has no property %q",
+				class.Class, name)
+		}
+
+		switch schema.DataType(prop.DataType[0]) {
+		case schema.DataTypeText, schema.DataTypeString, schema.DataTypeInt,
+			schema.DataTypeNumber, schema.DataTypeBoolean,
+			schema.DataTypeTextArray, schema.DataTypeStringArray,
+			schema.DataTypeIntArray, schema.DataTypeNumberArray,
+			schema.DataTypeBooleanArray:
+		default:
+			return nil, NewErrInvalidUserInput(
+				"property %q of data type %v can not be faceted", name, prop.DataType)
+		}
+
+		out[i] = schema.PropertyName(name)
+	}
+
+	return out, nil
+}
+
+func facetToModel(facet aggregation.Facet) *models.Facet {
+	out := &models.Facet{
+		Property: facet.Property,
+		Values:   make([]*models.FacetValue, len(facet.Groups)),
+	}
+	for i, group := range facet.Groups {
+		out.Values[i] = &models.FacetValue{
+			Value: group.GroupedBy.Value,
+			Count: int64(group.Count),
+		}
+	}
+
+	return out
+}
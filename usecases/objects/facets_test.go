@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FacetedSearch(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	ctx := context.Background()
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{{
+						Class: "Article",
+						Properties: []*models.Property{
+							{Name: "category", DataType: []string{"string"}},
+							{Name: "tags", DataType: []string{"text[]"}},
+							{Name: "wordCount", DataType: []string{"int"}},
+							{Name: "location", DataType: []string{"geoCoordinates"}},
+							{Name: "author", DataType: []string{"Author"}},
+						},
+					}},
+				},
+			},
+		}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryDefaults.Limit = 20
+		cfg.Config.QueryMaximumResults = 200
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, cfg, logger,
+			&fakeAuthorizer{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+			vectorRepo, getFakeModulesProvider())
+	}
+
+	t.Run("with a filter and facets", func(t *testing.T) {
+		reset()
+
+		valueText := "news"
+		req := &models.FacetedSearchRequest{
+			Class:  "Article",
+			Facets: []string{"category", "wordCount"},
+			Limit:  2,
+			Where: &models.WhereFilter{
+				Operator:  "Equal",
+				Path:      []string{"category"},
+				ValueText: &valueText,
+			},
+		}
+
+		facets := &aggregation.FacetResult{
+			Count: 7,
+			Facets: []aggregation.Facet{
+				{
+					Property: "category",
+					Groups: []aggregation.Group{{
+						GroupedBy: &aggregation.GroupedBy{Path: []string{"category"}, Value: "news"},
+						Count:     7,
+					}},
+				},
+				{
+					Property: "wordCount",
+					Groups: []aggregation.Group{
+						{
+							GroupedBy: &aggregation.GroupedBy{Path: []string{"wordCount"}, Value: 300.0},
+							Count:     4,
+						},
+						{
+							GroupedBy: &aggregation.GroupedBy{Path: []string{"wordCount"}, Value: 500.0},
+							Count:     3,
+						},
+					},
+				},
+			},
+		}
+		results := []search.Result{
+			{ClassName: "Article", ID: "00000000-0000-0000-0000-000000000001"},
+			{ClassName: "Article", ID: "00000000-0000-0000-0000-000000000002"},
+		}
+
+		vectorRepo.On("FacetedSearch", mock.MatchedBy(func(p aggregation.FacetParams) bool {
+			return p.ClassName == "Article" && p.Limit == 2 && p.FacetLimit == 10 &&
+				assert.ObjectsAreEqual([]schema.PropertyName{"category", "wordCount"}, p.Properties) &&
+				p.Filters != nil && p.Filters.Root.Operator == filters.OperatorEqual
+		})).Return(results, facets, nil).Once()
+
+		res, err := manager.FacetedSearch(ctx, nil, req)
+		require.Nil(t, err)
+
+		assert.Len(t, res.Objects, 2)
+		assert.Equal(t, int64(7), res.TotalResults)
+		assert.Equal(t, []*models.Facet{
+			{
+				Property: "category",
+				Values:   []*models.FacetValue{{Value: "news", Count: 7}},
+			},
+			{
+				Property: "wordCount",
+				Values: []*models.FacetValue{
+					{Value: 300.0, Count: 4},
+					{Value: 500.0, Count: 3},
+				},
+			},
+		}, res.Facets)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("without a limit", func(t *testing.T) {
+		reset()
+
+		vectorRepo.On("FacetedSearch", mock.MatchedBy(func(p aggregation.FacetParams) bool {
+			return p.Limit == 20 && p.FacetLimit == 3 && p.Filters == nil
+		})).Return([]search.Result{}, &aggregation.FacetResult{}, nil).Once()
+
+		_, err := manager.FacetedSearch(ctx, nil, &models.FacetedSearchRequest{
+			Class:      "Article",
+			FacetLimit: 3,
+		})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *models.FacetedSearchRequest
+		}{
+			{"without a class", &models.FacetedSearchRequest{}},
+			{"with a negative limit", &models.FacetedSearchRequest{Class: "Article", Limit: -1}},
+			{"with a negative facetLimit", &models.FacetedSearchRequest{Class: "Article", FacetLimit: -1}},
+			{"above the maximum results", &models.FacetedSearchRequest{Class: "Article", Limit: 201}},
+			{"with an unknown property", &models.FacetedSearchRequest{Class: "Article", Facets: []string{"title"}}},
+			{"with a geo property", &models.FacetedSearchRequest{Class: "Article", Facets: []string{"location"}}},
+			{"with a reference property", &models.FacetedSearchRequest{Class: "Article", Facets: []string{"author"}}},
+			{"with a property faceted twice", &models.FacetedSearchRequest{Class: "Article", Facets: []string{"tags", "tags"}}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				reset()
+
+				_, err := manager.FacetedSearch(ctx, nil, test.req)
+				assert.IsType(t, ErrInvalidUserInput{}, err)
+				vectorRepo.AssertNotCalled(t, "FacetedSearch", mock.Anything)
+			})
+		}
+	})
+
+	t.Run("with an unknown class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.FacetedSearch(ctx, nil, &models.FacetedSearchRequest{Class: "Unknown"})
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
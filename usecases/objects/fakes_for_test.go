@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/graphql-go/graphql"
@@ -23,12 +24,14 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -59,6 +62,10 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 	return f.GetSchemaResponse, nil
 }
 
+func (f *fakeSchemaManager) ValidateTenant(className, tenant string) error {
+	return nil
+}
+
 func (f *fakeSchemaManager) AddClass(ctx context.Context, principal *models.Principal,
 	class *models.Class) error {
 	if f.GetSchemaResponse.Objects == nil {
@@ -112,6 +119,10 @@ func (f *fakeVectorizerProvider) Vectorizer(modName, className string) (Vectoriz
 	return f.vectorizer, nil
 }
 
+func (f *fakeVectorizerProvider) SparseVectorizer(className string) (Vectorizer, error) {
+	return nil, nil
+}
+
 type fakeVectorizer struct {
 	mock.Mock
 }
@@ -154,6 +165,51 @@ func (f *fakeVectorRepo) ObjectSearch(ctx context.Context, offset, limit int,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorRepo) ObjectCursorSearch(ctx context.Context, className string,
+	cursor filters.Cursor, limit int, additional additional.Properties) (search.Results, error) {
+	args := f.Called(className, cursor, limit, additional)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ObjectKeywordSearch(ctx context.Context, className string,
+	keywordRanking searchparams.KeywordRanking, offset, limit int,
+	additional additional.Properties) (search.Results, error) {
+	args := f.Called(className, keywordRanking, offset, limit, additional)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ObjectScroll(ctx context.Context, className,
+	scrollID string, ttl time.Duration, limit int,
+	additional additional.Properties) (search.Results, string, error) {
+	args := f.Called(className, scrollID, ttl, limit, additional)
+	return args.Get(0).([]search.Result), args.String(1), args.Error(2)
+}
+
+func (f *fakeVectorRepo) ObjectNeighbors(ctx context.Context, className string,
+	vector []float32, limit int) (search.Results, error) {
+	args := f.Called(className, vector, limit)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
+func (f *fakeVectorRepo) FacetedSearch(ctx context.Context,
+	params aggregation.FacetParams) (search.Results, *aggregation.FacetResult, error) {
+	args := f.Called(params)
+	return args.Get(0).([]search.Result), args.Get(1).(*aggregation.FacetResult),
+		args.Error(2)
+}
+
+func (f *fakeVectorRepo) EstimateFilter(ctx context.Context,
+	params filters.EstimateParams) (*filters.Estimate, error) {
+	args := f.Called(params)
+	return args.Get(0).(*filters.Estimate), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ContinuousAggregate(ctx context.Context,
+	params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	args := f.Called(params)
+	return args.Get(0).([]aggregation.ContinuousGroup), args.Error(1)
+}
+
 func (f *fakeVectorRepo) PutObject(ctx context.Context,
 	concept *models.Object, vector []float32) error {
 	args := f.Called(concept, vector)
@@ -170,6 +226,12 @@ func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchRefe
 	return batch, args.Error(0)
 }
 
+func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context,
+	params BatchDeleteParams) (BatchDeleteResult, error) {
+	args := f.Called(params)
+	return args.Get(0).(BatchDeleteResult), args.Error(1)
+}
+
 func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument) error {
 	args := f.Called(merge)
 	return args.Error(0)
@@ -15,12 +15,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 )
 
 // GetObject Class from the connected DB
@@ -31,6 +36,12 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	release, err := m.admission.Acquire(ctx, admission.ClassRead)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -42,7 +53,12 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	obj := res.ObjectWithVector(additional.Vector)
+	if err := maskResults(ctx, m.masker, principal, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
 }
 
 // GetObjects Class from the connected DB
@@ -53,13 +69,244 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	release, err := m.admission.Acquire(ctx, admission.ClassRead)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
-	return m.getObjectsFromRepo(ctx, offset, limit, additional)
+	objs, err := m.getObjectsFromRepo(ctx, offset, limit, additional)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := maskResults(ctx, m.masker, principal, objs...); err != nil {
+		return nil, err
+	}
+
+	return objs, nil
+}
+
+// GetObjectsAfter lists the objects of a single class in ascending order of
+// their id, starting right after the id in after, or at the very first object
+// if after is nil. To iterate over an entire class use the id of the last
+// object of a page as the cursor for the next one, until a page is empty.
+func (m *Manager) GetObjectsAfter(ctx context.Context, principal *models.Principal,
+	className string, after *string, limit *int64,
+	additional additional.Properties) ([]*models.Object, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := filters.Cursor{}
+	if after != nil {
+		if !strfmt.IsUUID(*after) {
+			return nil, NewErrInvalidUserInput("after must be a uuid, got %q", *after)
+		}
+		cursor.After = *after
+	}
+
+	release, err := m.admission.Acquire(ctx, admission.ClassRead)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.GetClass(schema.ClassName(className)) == nil {
+		return nil, NewErrInvalidUserInput("class %q does not exist", className)
+	}
+
+	_, smartLimit, err := m.localOffsetLimit(nil, limit)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("list objects: %v", err)
+	}
+
+	res, err := m.vectorRepo.ObjectCursorSearch(ctx, className, cursor,
+		smartLimit, additional)
+	if err != nil {
+		return nil, NewErrInternal("list objects: %v", err)
+	}
+
+	if m.modulesProvider != nil {
+		res, err = m.modulesProvider.ListObjectsAdditionalExtend(ctx, res, additional.ModuleParams)
+		if err != nil {
+			return nil, NewErrInternal("list extend: %v", err)
+		}
+	}
+
+	objs := res.ObjectsWithVector(additional.Vector)
+	if err := maskResults(ctx, m.masker, principal, objs...); err != nil {
+		return nil, err
+	}
+
+	return objs, nil
+}
+
+// GetObjectsByKeywords ranks the objects of a single class by the BM25
+// relevance of all their indexed text and string properties for the query.
+// Neither the vector index nor any vectorizer is involved.
+func (m *Manager) GetObjectsByKeywords(ctx context.Context,
+	principal *models.Principal, className, query string, offset, limit *int64,
+	additional additional.Properties) ([]*models.Object, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := m.admission.Acquire(ctx, admission.ClassRead)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.GetClass(schema.ClassName(className)) == nil {
+		return nil, NewErrInvalidUserInput("class %q does not exist", className)
+	}
+
+	smartOffset, smartLimit, err := m.localOffsetLimit(offset, limit)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("list objects: %v", err)
+	}
+
+	res, err := m.vectorRepo.ObjectKeywordSearch(ctx, className,
+		searchparams.KeywordRanking{Query: query}, smartOffset, smartLimit,
+		additional)
+	if err != nil {
+		return nil, NewErrInternal("list objects: %v", err)
+	}
+
+	if additional.Score {
+		for i := range res {
+			if res[i].AdditionalProperties == nil {
+				res[i].AdditionalProperties = models.AdditionalProperties{}
+			}
+			res[i].AdditionalProperties["score"] = res[i].Score
+		}
+	}
+
+	objs := res.ObjectsWithVector(additional.Vector)
+	if err := maskResults(ctx, m.masker, principal, objs...); err != nil {
+		return nil, err
+	}
+
+	return objs, nil
+}
+
+// maxScrollTTL bounds how long a scroll is kept open between two pages, as an
+// open scroll keeps the disk segments of its shard from being reclaimed
+const maxScrollTTL = time.Hour
+
+// ScrollObjects returns the next page of a scroll over a single class along
+// with the id of the scroll for the page after it. In contrast to
+// GetObjectsAfter every shard is read from a snapshot, so objects written
+// while the scroll is open are neither skipped nor returned twice. A new
+// scroll is opened if scrollID is nil, in which case the class is required.
+// The scroll is kept open for ttl after every page, the returned id is empty
+// once all objects have been returned.
+func (m *Manager) ScrollObjects(ctx context.Context, principal *models.Principal,
+	className, scrollID *string, ttl string, limit *int64,
+	additional additional.Properties) ([]*models.Object, string, error) {
+	err := m.authorizer.Authorize(principal, "list", "objects")
+	if err != nil {
+		return nil, "", err
+	}
+
+	keepAlive, err := time.ParseDuration(ttl)
+	if err != nil {
+		return nil, "", NewErrInvalidUserInput("invalid scroll ttl %q: %v", ttl, err)
+	}
+
+	if keepAlive <= 0 || keepAlive > maxScrollTTL {
+		return nil, "", NewErrInvalidUserInput(
+			"scroll ttl must be positive and at most %s, got %s", maxScrollTTL, ttl)
+	}
+
+	if className == nil && scrollID == nil {
+		return nil, "", NewErrInvalidUserInput(
+			"opening a scroll requires class to be set")
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, "", NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	var class, id string
+	if className != nil {
+		s, err := m.schemaManager.GetSchema(principal)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if s.GetClass(schema.ClassName(*className)) == nil {
+			return nil, "", NewErrInvalidUserInput("class %q does not exist", *className)
+		}
+		class = *className
+	}
+
+	if scrollID != nil {
+		id = *scrollID
+	}
+
+	_, smartLimit, err := m.localOffsetLimit(nil, limit)
+	if err != nil {
+		return nil, "", NewErrInvalidUserInput("scroll objects: %v", err)
+	}
+
+	res, next, err := m.vectorRepo.ObjectScroll(ctx, class, id, keepAlive,
+		smartLimit, additional)
+	if err != nil {
+		if errortypes.KindOf(err) == errortypes.KindInternal {
+			return nil, "", NewErrInternal("scroll objects: %v", err)
+		}
+
+		// e.g. an expired scroll or a scroll id of another class
+		return nil, "", err
+	}
+
+	if m.modulesProvider != nil {
+		res, err = m.modulesProvider.ListObjectsAdditionalExtend(ctx, res, additional.ModuleParams)
+		if err != nil {
+			return nil, "", NewErrInternal("list extend: %v", err)
+		}
+	}
+
+	objs := res.ObjectsWithVector(additional.Vector)
+	if err := maskResults(ctx, m.masker, principal, objs...); err != nil {
+		return nil, "", err
+	}
+
+	return objs, next, nil
 }
 
 func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Principal,
@@ -15,9 +15,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
@@ -182,6 +184,113 @@ func Test_GetAction(t *testing.T) {
 		assert.Contains(t, err.Error(), "query maximum results exceeded")
 	})
 
+	t.Run("list a class after a cursor", func(t *testing.T) {
+		reset()
+		after := "99ee9968-22ec-416a-9032-cff80f2f7fdf"
+		id := strfmt.UUID("a1ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+		results := []search.Result{
+			{
+				ID:        id,
+				ClassName: "ActionClass",
+				Schema:    map[string]interface{}{"foo": "bar"},
+			},
+		}
+		vectorRepo.On("ObjectCursorSearch", "ActionClass",
+			filters.Cursor{After: after}, 150, mock.Anything).Return(results, nil).Once()
+
+		res, err := manager.GetObjectsAfter(context.Background(), &models.Principal{},
+			"ActionClass", &after, ptInt64(150), additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, id, res[0].ID)
+	})
+
+	t.Run("list a class from the start", func(t *testing.T) {
+		reset()
+
+		vectorRepo.On("ObjectCursorSearch", "ActionClass",
+			filters.Cursor{}, 20, mock.Anything).Return([]search.Result{}, nil).Once()
+
+		res, err := manager.GetObjectsAfter(context.Background(), &models.Principal{},
+			"ActionClass", nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("list a class after an invalid cursor", func(t *testing.T) {
+		reset()
+		after := "not-a-uuid"
+
+		_, err := manager.GetObjectsAfter(context.Background(), &models.Principal{},
+			"ActionClass", &after, nil, additional.Properties{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("list a non-existing class after a cursor", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetObjectsAfter(context.Background(), &models.Principal{},
+			"NoSuchClass", nil, nil, additional.Properties{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("open a scroll on a class", func(t *testing.T) {
+		reset()
+		class := "ActionClass"
+		id := strfmt.UUID("a1ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+		results := []search.Result{
+			{
+				ID:        id,
+				ClassName: "ActionClass",
+				Schema:    map[string]interface{}{"foo": "bar"},
+			},
+		}
+		vectorRepo.On("ObjectScroll", "ActionClass", "", time.Minute, 20,
+			mock.Anything).Return(results, "next-page", nil).Once()
+
+		res, next, err := manager.ScrollObjects(context.Background(), &models.Principal{},
+			&class, nil, "1m", nil, additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, id, res[0].ID)
+		assert.Equal(t, "next-page", next)
+	})
+
+	t.Run("continue a scroll", func(t *testing.T) {
+		reset()
+		scrollID := "next-page"
+
+		vectorRepo.On("ObjectScroll", "", "next-page", 30*time.Second, 150,
+			mock.Anything).Return([]search.Result{}, "", nil).Once()
+
+		res, next, err := manager.ScrollObjects(context.Background(), &models.Principal{},
+			nil, &scrollID, "30s", ptInt64(150), additional.Properties{})
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+		assert.Equal(t, "", next)
+	})
+
+	t.Run("open a scroll without a class", func(t *testing.T) {
+		reset()
+
+		_, _, err := manager.ScrollObjects(context.Background(), &models.Principal{},
+			nil, nil, "1m", nil, additional.Properties{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("open a scroll with an invalid ttl", func(t *testing.T) {
+		for _, ttl := range []string{"soon", "-1m", "2h"} {
+			reset()
+			class := "ActionClass"
+
+			_, _, err := manager.ScrollObjects(context.Background(), &models.Principal{},
+				&class, nil, ttl, nil, additional.Properties{})
+			assert.IsType(t, ErrInvalidUserInput{}, err, ttl)
+		}
+	})
+
 	t.Run("additional props", func(t *testing.T) {
 		t.Run("on get single requests", func(t *testing.T) {
 			t.Run("feature projection", func(t *testing.T) {
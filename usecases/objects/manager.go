@@ -23,9 +23,12 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus"
 )
@@ -43,6 +46,12 @@ type Manager struct {
 	timeSource         timeSource
 	modulesProvider    ModulesProvider
 	autoSchemaManager  *autoSchemaManager
+	quotas             *Quotas
+	shadower           *Shadower
+	admission          *admission.Controller
+	router             RouterProvider
+	masker             MaskerProvider
+	ruleValidator      RuleValidator
 }
 
 type timeSource interface {
@@ -51,6 +60,9 @@ type timeSource interface {
 
 type VectorizerProvider interface {
 	Vectorizer(moduleName, className string) (Vectorizer, error)
+
+	// SparseVectorizer is nil if the class has no sparse vectorizer
+	SparseVectorizer(className string) (Vectorizer, error)
 }
 
 type Vectorizer interface {
@@ -74,6 +86,22 @@ type VectorRepo interface {
 		additional additional.Properties) (*search.Result, error)
 	ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 		additional additional.Properties) (search.Results, error)
+	ObjectCursorSearch(ctx context.Context, className string, cursor filters.Cursor,
+		limit int, additional additional.Properties) (search.Results, error)
+	ObjectKeywordSearch(ctx context.Context, className string,
+		keywordRanking searchparams.KeywordRanking, offset, limit int,
+		additional additional.Properties) (search.Results, error)
+	ObjectScroll(ctx context.Context, className, scrollID string,
+		ttl time.Duration, limit int,
+		additional additional.Properties) (search.Results, string, error)
+	ObjectNeighbors(ctx context.Context, className string, vector []float32,
+		limit int) (search.Results, error)
+	FacetedSearch(ctx context.Context,
+		params aggregation.FacetParams) (search.Results, *aggregation.FacetResult, error)
+	EstimateFilter(ctx context.Context,
+		params filters.EstimateParams) (*filters.Estimate, error)
+	ContinuousAggregate(ctx context.Context,
+		params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error)
 
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 
@@ -108,6 +136,37 @@ func NewManager(locks locks, schemaManager schemaManager,
 	}
 }
 
+// SetQuotas enables enforcing the per-class quotas when objects are added
+func (m *Manager) SetQuotas(quotas *Quotas) {
+	m.quotas = quotas
+}
+
+// SetShadower enables mirroring the writes to classes with a shadow config
+func (m *Manager) SetShadower(shadower *Shadower) {
+	m.shadower = shadower
+}
+
+// SetAdmission enables limiting the number of concurrent reads
+func (m *Manager) SetAdmission(controller *admission.Controller) {
+	m.admission = controller
+}
+
+// SetRouter enables routing added objects to another class
+func (m *Manager) SetRouter(router RouterProvider) {
+	m.router = router
+}
+
+// SetMasker enables masking sensitive values of objects on import and read
+func (m *Manager) SetMasker(masker MaskerProvider) {
+	m.masker = masker
+}
+
+// SetRuleValidator enables enforcing the data quality rules of classes on
+// every object written to them
+func (m *Manager) SetRuleValidator(validator RuleValidator) {
+	m.ruleValidator = validator
+}
+
 func generateUUID() (strfmt.UUID, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
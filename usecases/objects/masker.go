@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// MaskerProvider replaces sensitive values, such as email addresses, in the
+// text properties of objects. Objects are masked either before they are
+// vectorized and stored or when they are returned to a principal, depending
+// on the class. Implemented by the modules provider.
+type MaskerProvider interface {
+	MaskObject(ctx context.Context, obj *models.Object) error
+	MaskResult(ctx context.Context, principal *models.Principal,
+		className string, props map[string]interface{}) error
+}
+
+func maskObject(ctx context.Context, masker MaskerProvider,
+	obj *models.Object) error {
+	if masker == nil {
+		return nil
+	}
+
+	if err := masker.MaskObject(ctx, obj); err != nil {
+		return NewErrInternal("mask object: %v", err)
+	}
+
+	return nil
+}
+
+func maskResults(ctx context.Context, masker MaskerProvider,
+	principal *models.Principal, objs ...*models.Object) error {
+	if masker == nil {
+		return nil
+	}
+
+	for _, obj := range objs {
+		props, ok := obj.Properties.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := masker.MaskResult(ctx, principal, obj.Class, props); err != nil {
+			return NewErrInternal("mask object %s: %v", obj.ID, err)
+		}
+	}
+
+	return nil
+}
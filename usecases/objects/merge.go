@@ -21,14 +21,18 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 )
 
 type MergeDocument struct {
 	Class                string
 	ID                   strfmt.UUID
+	Tenant               string
 	PrimitiveSchema      map[string]interface{}
 	References           BatchReferences
 	Vector               []float32
+	MultiVector          [][]float32
+	SparseVector         map[string]float32
 	UpdateTime           int64
 	AdditionalProperties models.AdditionalProperties
 }
@@ -40,6 +44,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
+	ctx = tenant.NewContext(ctx, updated.Tenant)
 	previous, err := m.retrievePreviousAndValidateMergeObject(ctx, principal, id, updated)
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
@@ -52,6 +57,21 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	primitive, refs := m.splitPrimitiveAndRefs(updated.Properties.(map[string]interface{}),
 		updated.Class, id)
 
+	// rules may span several properties, so they are validated against the
+	// merged object rather than just the changed properties
+	err = validateRules(ctx, m.ruleValidator, updated.Class,
+		mergedProperties(previous.Schema, updated.Properties))
+	if err != nil {
+		return err
+	}
+
+	// only the new values need to be masked, the previous ones already were
+	err = maskObject(ctx, m.masker, &models.Object{Class: updated.Class,
+		Properties: primitive})
+	if err != nil {
+		return err
+	}
+
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, previous.ClassName, previous.Schema,
 		primitive, principal, previous.Vector, updated.Vector)
 	if err != nil {
@@ -60,6 +80,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	mergeDoc := MergeDocument{
 		Class:           updated.Class,
 		ID:              id,
+		Tenant:          updated.Tenant,
 		PrimitiveSchema: primitive,
 		References:      refs,
 		Vector:          objWithVec.Vector,
@@ -70,11 +91,25 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		mergeDoc.AdditionalProperties = objWithVec.Additional
 	}
 
+	for _, vec := range updated.MultiVector {
+		mergeDoc.MultiVector = append(mergeDoc.MultiVector, vec)
+	}
+
+	// a sparse vectorizer always produces a sparse vector for the merged
+	// properties, otherwise only a user-provided one replaces the previous
+	if objWithVec.SparseVector != nil {
+		mergeDoc.SparseVector = objWithVec.SparseVector
+	} else if updated.SparseVector != nil {
+		mergeDoc.SparseVector = updated.SparseVector
+	}
+
 	err = m.vectorRepo.Merge(ctx, mergeDoc)
 	if err != nil {
 		return NewErrInternal("repo: %v", err)
 	}
 
+	m.shadower.syncObject(principal, updated.Class, id)
+
 	return nil
 }
 
@@ -107,6 +142,26 @@ func (m *Manager) retrievePreviousAndValidateMergeObject(ctx context.Context, pr
 	return object, nil
 }
 
+// mergedProperties returns the properties of the previous object with the
+// updated ones applied, without changing either
+func mergedProperties(previous interface{},
+	updated interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	if asMap, ok := previous.(map[string]interface{}); ok {
+		for key, value := range asMap {
+			out[key] = value
+		}
+	}
+
+	if asMap, ok := updated.(map[string]interface{}); ok {
+		for key, value := range asMap {
+			out[key] = value
+		}
+	}
+
+	return out
+}
+
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
 	old interface{}, new map[string]interface{},
 	principal *models.Principal, oldVec, newVec []float32) (*models.Object, error) {
@@ -77,6 +77,37 @@ func (vo *vectorObtainer) Do(ctx context.Context, obj *models.Object,
 		}
 	}
 
+	if hnswConfig.Binary && !hnswConfig.Skip {
+		if err := validateBinaryVector(obj.Vector); err != nil {
+			return NewErrInvalidUserInput("%v", err)
+		}
+	}
+
+	if hnswConfig.Sparse {
+		if err := vo.obtainSparseVector(ctx, obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// obtainSparseVector sets the sparse vector of the object if the class has a
+// sparse vectorizer, otherwise a user-provided sparse vector is kept as is
+func (vo *vectorObtainer) obtainSparseVector(ctx context.Context,
+	obj *models.Object) error {
+	vectorizer, err := vo.vectorizerProvider.SparseVectorizer(obj.Class)
+	if err != nil {
+		return err
+	}
+	if vectorizer == nil {
+		return nil
+	}
+
+	if err := vectorizer.UpdateObject(ctx, obj); err != nil {
+		return NewErrInternal("sparse vectorizer: %v", err)
+	}
+
 	return nil
 }
 
@@ -117,3 +148,18 @@ func (vo *vectorObtainer) validateVectorPresent(obj *models.Object,
 
 	return nil
 }
+
+// validateBinaryVector makes sure the vector of a class with binary vectors
+// only consists of zeros and ones, as any other value would be lost when the
+// vector is bit-packed
+func validateBinaryVector(vector []float32) error {
+	for i, value := range vector {
+		if value != 0 && value != 1 {
+			return errors.Errorf("this class is configured to use binary vectors, "+
+				"thus every dimension of the vector must be 0 or 1, got %v at "+
+				"position %d", value, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/sirupsen/logrus"
+)
+
+// usageRefreshInterval is how long the measured usage of a class is trusted
+// before the shards are scanned again
+const usageRefreshInterval = 10 * time.Second
+
+const (
+	quotaObjects   = "objects"
+	quotaDiskBytes = "diskBytes"
+)
+
+// ClassUsage is the amount of data a class holds on this node
+type ClassUsage struct {
+	Objects   int64
+	DiskBytes int64
+}
+
+type usageRepo interface {
+	ClassUsage(ctx context.Context, className string) (ClassUsage, error)
+}
+
+// Quotas enforces the per-class limits of config.Quotas when objects are
+// added. Measuring the usage of a class means scanning its shards, so the
+// measurement is reused for usageRefreshInterval. Objects admitted in the
+// meantime are counted on top of it, so even a fast importer cannot overshoot
+// the object limit. Objects which could not be added after all are released
+// again. The disk limit can be overshot by whatever is written within one
+// interval. Updates of existing objects are not limited.
+//
+// Every node enforces the quotas on its own, see config.Quotas.
+type Quotas struct {
+	sync.Mutex
+	config  config.Quotas
+	repo    usageRepo
+	logger  logrus.FieldLogger
+	now     func() time.Time
+	classes map[string]*classQuotaState
+}
+
+type classQuotaState struct {
+	sync.Mutex
+	usage      ClassUsage
+	measuredAt time.Time
+	admitted   int64
+	exceeded   map[string]int64
+}
+
+func NewQuotas(cfg config.Quotas, repo usageRepo,
+	logger logrus.FieldLogger) *Quotas {
+	return &Quotas{
+		config:  cfg,
+		repo:    repo,
+		logger:  logger,
+		now:     time.Now,
+		classes: map[string]*classQuotaState{},
+	}
+}
+
+// admit reserves count new objects in the class or returns an
+// ErrQuotaExceeded if they do not fit in its quotas. If the objects are not
+// added after all, the reservation has to be released. A nil Quotas admits
+// everything.
+func (q *Quotas) admit(ctx context.Context, className string,
+	count int64) (*reservation, error) {
+	_, res, err := q.reserve(ctx, className, count, false)
+	return res, err
+}
+
+// admitBatch reserves the valid objects of a batch in the quotas of their
+// classes in the order of the batch. Objects which do not fit anymore are
+// marked with an ErrQuotaExceeded and are not imported. Only objects which
+// do not exist yet are charged, updates of existing ones always fit. Once
+// the batch was imported, the objects which failed have to be released
+// again, see batchReservation.releaseFailed.
+func (q *Quotas) admitBatch(ctx context.Context, batch BatchObjects,
+	exists func(ctx context.Context, id strfmt.UUID) (bool, error)) *batchReservation {
+	if q == nil {
+		return nil
+	}
+
+	perClass := map[string][]int{}
+	var classes []string
+	for i, obj := range batch {
+		if obj.Err != nil || obj.Object == nil || !q.limited(obj.Object.Class) {
+			continue
+		}
+
+		if _, ok := perClass[obj.Object.Class]; !ok {
+			classes = append(classes, obj.Object.Class)
+		}
+		perClass[obj.Object.Class] = append(perClass[obj.Object.Class], i)
+	}
+
+	out := &batchReservation{reservations: map[string]*reservation{}}
+	for _, className := range classes {
+		positions := newObjects(ctx, batch, perClass[className], exists)
+		granted, res, err := q.reserve(ctx, className, int64(len(positions)), true)
+		for _, pos := range positions[granted:] {
+			batch[pos].Err = err
+		}
+
+		out.reservations[className] = res
+		out.charged = append(out.charged, positions[:granted]...)
+	}
+
+	return out
+}
+
+// newObjects returns the positions of the objects which would be added to
+// their class rather than update an existing object. An object which is
+// contained more than once is only added the first time. If it can't be
+// determined whether an object exists, it is assumed to be new.
+func newObjects(ctx context.Context, batch BatchObjects, positions []int,
+	exists func(ctx context.Context, id strfmt.UUID) (bool, error)) []int {
+	out := make([]int, 0, len(positions))
+	seen := map[string]struct{}{}
+	for _, pos := range positions {
+		obj := batch[pos].Object
+		key := obj.Tenant + "/" + obj.ID.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		ok, err := exists(tenant.NewContext(ctx, obj.Tenant), obj.ID)
+		if err == nil && ok {
+			continue
+		}
+
+		out = append(out, pos)
+	}
+
+	return out
+}
+
+// limited is true if there is a quota for the class which admitting objects
+// has to check
+func (q *Quotas) limited(className string) bool {
+	limits := q.config.ForClass(className)
+	return limits.MaxObjects != 0 || limits.MaxDiskBytes != 0
+}
+
+// reserve returns how many of count new objects fit in the quotas of the
+// class and counts them as admitted. If partial is false, either all or none
+// of the objects are admitted. If fewer than count objects are admitted, the
+// returned error explains why.
+func (q *Quotas) reserve(ctx context.Context, className string, count int64,
+	partial bool) (int64, *reservation, error) {
+	if q == nil || !q.limited(className) {
+		return count, nil, nil
+	}
+
+	limits := q.config.ForClass(className)
+
+	state := q.stateFor(className)
+	state.Lock()
+	defer state.Unlock()
+
+	if now := q.now(); now.Sub(state.measuredAt) > usageRefreshInterval {
+		usage, err := q.repo.ClassUsage(ctx, className)
+		if err != nil {
+			return 0, nil, NewErrInternal("measure usage of class %q for quota: %v",
+				className, err)
+		}
+
+		state.usage = usage
+		state.measuredAt = now
+		state.admitted = 0
+	}
+
+	if limits.MaxDiskBytes > 0 && state.usage.DiskBytes >= limits.MaxDiskBytes {
+		state.exceeded[quotaDiskBytes]++
+		return 0, nil, NewErrQuotaExceeded("disk quota of class %q exceeded: "+
+			"the class occupies %d bytes on this node, the limit is %d bytes",
+			className, state.usage.DiskBytes, limits.MaxDiskBytes)
+	}
+
+	if limits.MaxObjects == 0 {
+		// nothing to reserve, the disk usage is only known once measured
+		return count, nil, nil
+	}
+
+	current := state.usage.Objects + state.admitted
+	available := limits.MaxObjects - current
+	if available < 0 {
+		available = 0
+	}
+
+	granted := count
+	if granted > available {
+		if !partial {
+			granted = 0
+		} else {
+			granted = available
+		}
+	}
+	state.admitted += granted
+	res := &reservation{state: state, measuredAt: state.measuredAt}
+
+	if granted < count {
+		state.exceeded[quotaObjects]++
+		return granted, res, NewErrQuotaExceeded("object quota of class %q exceeded: "+
+			"the class holds %d objects on this node, adding %d would exceed the "+
+			"limit of %d objects", className, current, count, limits.MaxObjects)
+	}
+
+	return granted, res, nil
+}
+
+// reservation is the part of the object quota of a class which was taken up
+// by admitting objects
+type reservation struct {
+	state      *classQuotaState
+	measuredAt time.Time
+}
+
+// release gives back the quota of count admitted objects which were not
+// added after all. If the usage was measured again in the meantime, they
+// are no longer counted on top of it and there is nothing to give back. A
+// nil reservation releases nothing.
+func (r *reservation) release(count int64) {
+	if r == nil {
+		return
+	}
+
+	r.state.Lock()
+	defer r.state.Unlock()
+
+	if !r.state.measuredAt.Equal(r.measuredAt) {
+		return
+	}
+
+	r.state.admitted -= count
+	if r.state.admitted < 0 {
+		r.state.admitted = 0
+	}
+}
+
+// batchReservation holds the reservations of the classes of a batch and the
+// positions of the objects which were charged against them
+type batchReservation struct {
+	reservations map[string]*reservation
+	charged      []int
+}
+
+// releaseFailed releases the quota of the charged objects which could not be
+// imported. If the import failed as a whole, all of them are released.
+func (r *batchReservation) releaseFailed(batch BatchObjects, importErr error) {
+	if r == nil {
+		return
+	}
+
+	for _, pos := range r.charged {
+		if importErr != nil || batch[pos].Err != nil {
+			r.reservations[batch[pos].Object.Class].release(1)
+		}
+	}
+}
+
+func (q *Quotas) stateFor(className string) *classQuotaState {
+	q.Lock()
+	defer q.Unlock()
+
+	state, ok := q.classes[className]
+	if !ok {
+		state = &classQuotaState{exceeded: map[string]int64{}}
+		q.classes[className] = state
+	}
+
+	return state
+}
+
+// Collect reports the usage, the limits and the number of rejected requests
+// of every class with a quota to the monitoring registry. The usage includes
+// the objects admitted since the last measurement.
+func (q *Quotas) Collect() []*monitoring.Family {
+	if q == nil {
+		return nil
+	}
+
+	objects := monitoring.NewGauge("weaviate_class_objects",
+		"Number of objects of a class with a quota on this node", "class")
+	disk := monitoring.NewGauge("weaviate_class_disk_bytes",
+		"Size on disk of a class with a quota on this node", "class")
+	maxObjects := monitoring.NewGauge("weaviate_class_quota_max_objects",
+		"Object quota of a class, 0 if unlimited", "class")
+	maxDisk := monitoring.NewGauge("weaviate_class_quota_max_disk_bytes",
+		"Disk quota of a class in bytes, 0 if unlimited", "class")
+	exceeded := monitoring.NewCounter("weaviate_class_quota_exceeded_total",
+		"Number of requests rejected because of a quota", "class", "quota")
+
+	q.Lock()
+	for className, state := range q.classes {
+		limits := q.config.ForClass(className)
+		state.Lock()
+		objects.Add(float64(state.usage.Objects+state.admitted), className)
+		disk.Add(float64(state.usage.DiskBytes), className)
+		maxObjects.Add(float64(limits.MaxObjects), className)
+		maxDisk.Add(float64(limits.MaxDiskBytes), className)
+		for _, quota := range []string{quotaObjects, quotaDiskBytes} {
+			exceeded.Add(float64(state.exceeded[quota]), className, quota)
+		}
+		state.Unlock()
+	}
+	q.Unlock()
+
+	return []*monitoring.Family{objects, disk, maxObjects, maxDisk, exceeded}
+}
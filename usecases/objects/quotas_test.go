@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUsageRepo struct {
+	usage map[string]ClassUsage
+	calls int
+}
+
+func (f *fakeUsageRepo) ClassUsage(ctx context.Context,
+	className string) (ClassUsage, error) {
+	f.calls++
+	return f.usage[className], nil
+}
+
+func TestQuotas(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	cfg := config.Quotas{
+		MaxObjects: 10,
+		Classes: map[string]config.ClassQuota{
+			"Big":       {MaxObjects: 100, MaxDiskBytes: 1000},
+			"Unlimited": {MaxObjects: -1},
+		},
+	}
+
+	newQuotas := func(usage map[string]ClassUsage) (*Quotas, *fakeUsageRepo, *time.Time) {
+		repo := &fakeUsageRepo{usage: usage}
+		now := time.Now()
+		q := NewQuotas(cfg, repo, logger)
+		q.now = func() time.Time { return now }
+		return q, repo, &now
+	}
+
+	admit := func(q *Quotas, className string, count int64) error {
+		_, err := q.admit(ctx, className, count)
+		return err
+	}
+
+	existing := map[strfmt.UUID]bool{}
+	exists := func(ctx context.Context, id strfmt.UUID) (bool, error) {
+		return existing[id], nil
+	}
+
+	t.Run("limits per class", func(t *testing.T) {
+		assert.Equal(t, config.ClassQuota{MaxObjects: 10}, cfg.ForClass("Foo"))
+		assert.Equal(t, config.ClassQuota{MaxObjects: 100, MaxDiskBytes: 1000},
+			cfg.ForClass("Big"))
+		assert.Equal(t, config.ClassQuota{}, cfg.ForClass("Unlimited"))
+	})
+
+	t.Run("objects are admitted until the limit is reached", func(t *testing.T) {
+		q, repo, _ := newQuotas(map[string]ClassUsage{"Foo": {Objects: 8}})
+
+		require.Nil(t, admit(q, "Foo", 1))
+		require.Nil(t, admit(q, "Foo", 1))
+
+		err := admit(q, "Foo", 1)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrQuotaExceeded{}, err)
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+		assert.Contains(t, err.Error(), "object quota of class \"Foo\" exceeded")
+		assert.Equal(t, 1, repo.calls, "usage is only measured once per interval")
+	})
+
+	t.Run("usage is measured again after the interval", func(t *testing.T) {
+		q, repo, now := newQuotas(map[string]ClassUsage{"Foo": {Objects: 10}})
+
+		require.NotNil(t, admit(q, "Foo", 1))
+
+		repo.usage["Foo"] = ClassUsage{Objects: 5}
+		*now = now.Add(usageRefreshInterval + time.Second)
+		require.Nil(t, admit(q, "Foo", 5))
+		require.NotNil(t, admit(q, "Foo", 1))
+		assert.Equal(t, 2, repo.calls)
+	})
+
+	t.Run("disk quota", func(t *testing.T) {
+		q, _, _ := newQuotas(map[string]ClassUsage{"Big": {DiskBytes: 1000}})
+
+		err := admit(q, "Big", 1)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "disk quota of class \"Big\" exceeded")
+	})
+
+	t.Run("classes without quota are never measured", func(t *testing.T) {
+		q, repo, _ := newQuotas(nil)
+
+		require.Nil(t, admit(q, "Unlimited", 1000))
+		assert.Equal(t, 0, repo.calls)
+	})
+
+	t.Run("nil quotas admit everything", func(t *testing.T) {
+		var q *Quotas
+
+		require.Nil(t, admit(q, "Foo", 1000))
+		require.Nil(t, q.Collect())
+	})
+
+	t.Run("batches are admitted in order", func(t *testing.T) {
+		q, _, _ := newQuotas(map[string]ClassUsage{"Foo": {Objects: 8}})
+		invalid := NewErrInvalidUserInput("invalid")
+
+		batch := BatchObjects{
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000001"}},
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000002"}, Err: invalid},
+			{Object: &models.Object{Class: "Unlimited", ID: "00000000-0000-0000-0000-000000000003"}},
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000004"}},
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000005"}},
+		}
+		q.admitBatch(ctx, batch, exists)
+
+		assert.Nil(t, batch[0].Err)
+		assert.Equal(t, invalid, batch[1].Err)
+		assert.Nil(t, batch[2].Err)
+		assert.Nil(t, batch[3].Err)
+		require.NotNil(t, batch[4].Err)
+		assert.IsType(t, ErrQuotaExceeded{}, batch[4].Err)
+	})
+
+	t.Run("objects which were not added are released", func(t *testing.T) {
+		q, _, now := newQuotas(map[string]ClassUsage{"Foo": {Objects: 9}})
+
+		reserved, err := q.admit(ctx, "Foo", 1)
+		require.Nil(t, err)
+		require.NotNil(t, admit(q, "Foo", 1))
+
+		reserved.release(1)
+		reserved, err = q.admit(ctx, "Foo", 1)
+		require.Nil(t, err)
+
+		// once measured again, the usage no longer includes the reservation
+		*now = now.Add(usageRefreshInterval + time.Second)
+		require.Nil(t, admit(q, "Foo", 1))
+		reserved.release(1)
+		require.NotNil(t, admit(q, "Foo", 1))
+	})
+
+	t.Run("only new objects of a batch are charged", func(t *testing.T) {
+		q, _, _ := newQuotas(map[string]ClassUsage{"Foo": {Objects: 9}})
+		existing["00000000-0000-0000-0000-000000000001"] = true
+		defer delete(existing, "00000000-0000-0000-0000-000000000001")
+
+		batch := BatchObjects{
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000001"}},
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000002"}},
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000002"}},
+			{Object: &models.Object{Class: "Foo", ID: "00000000-0000-0000-0000-000000000003"}},
+		}
+		reserved := q.admitBatch(ctx, batch, exists)
+
+		assert.Nil(t, batch[0].Err, "updates an existing object")
+		assert.Nil(t, batch[1].Err)
+		assert.Nil(t, batch[2].Err, "updates the object added before")
+		require.NotNil(t, batch[3].Err)
+		assert.IsType(t, ErrQuotaExceeded{}, batch[3].Err)
+
+		t.Run("failed objects are released", func(t *testing.T) {
+			batch[1].Err = NewErrInternal("write failed")
+			reserved.releaseFailed(batch, nil)
+			require.Nil(t, admit(q, "Foo", 1))
+			require.NotNil(t, admit(q, "Foo", 1))
+		})
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		q, _, _ := newQuotas(map[string]ClassUsage{"Foo": {Objects: 9, DiskBytes: 50}})
+		require.Nil(t, admit(q, "Foo", 1))
+		require.NotNil(t, admit(q, "Foo", 1))
+
+		registry := monitoring.NewRegistry()
+		registry.Register(q)
+		buf := &bytes.Buffer{}
+		require.Nil(t, registry.WriteText(buf))
+
+		assert.Contains(t, buf.String(), "weaviate_class_objects{class=\"Foo\"} 10\n")
+		assert.Contains(t, buf.String(), "weaviate_class_disk_bytes{class=\"Foo\"} 50\n")
+		assert.Contains(t, buf.String(), "weaviate_class_quota_max_objects{class=\"Foo\"} 10\n")
+		assert.Contains(t, buf.String(),
+			"weaviate_class_quota_exceeded_total{class=\"Foo\",quota=\"objects\"} 1\n")
+		assert.Contains(t, buf.String(),
+			"weaviate_class_quota_exceeded_total{class=\"Foo\",quota=\"diskBytes\"} 0\n")
+	})
+}
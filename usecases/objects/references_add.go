@@ -67,6 +67,8 @@ func (m *Manager) addObjectReferenceToConnectorAndSchema(ctx context.Context, pr
 		return NewErrInternal("add reference to vector repo: %v", err)
 	}
 
+	m.shadower.syncObject(principal, object.Class, object.ID)
+
 	return nil
 }
 
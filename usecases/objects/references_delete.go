@@ -65,6 +65,8 @@ func (m *Manager) deleteObjectReferenceFromConnector(ctx context.Context, princi
 		return NewErrInternal("could not store object: %v", err)
 	}
 
+	m.shadower.syncObject(principal, object.Class, id)
+
 	return nil
 }
 
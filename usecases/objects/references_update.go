@@ -72,6 +72,8 @@ func (m *Manager) updateObjectReferenceToConnectorAndSchema(ctx context.Context,
 		return NewErrInternal("could not store object: %v", err)
 	}
 
+	m.shadower.syncObject(principal, object.Class, id)
+
 	return nil
 }
 
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RouterProvider may move an object to another class and set properties on
+// it before it is validated, e.g. based on its detected language.
+// Implemented by the modules provider.
+type RouterProvider interface {
+	RouteObject(ctx context.Context, obj *models.Object) error
+}
+
+func routeObject(ctx context.Context, router RouterProvider,
+	obj *models.Object) error {
+	if router == nil {
+		return nil
+	}
+
+	if err := router.RouteObject(ctx, obj); err != nil {
+		return NewErrInternal("route object: %v", err)
+	}
+
+	return nil
+}
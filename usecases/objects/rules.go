@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+)
+
+// RuleValidator enforces the data quality rules, such as regex constraints,
+// numeric ranges or cross-property rules, which are declared in the
+// moduleConfig of a class on every object written to it. Implemented by the
+// modules provider.
+type RuleValidator interface {
+	ValidateObjectRules(ctx context.Context, className string,
+		props map[string]interface{}) ([]modulecapabilities.RuleViolation, error)
+}
+
+// ErrRuleViolations indicates that an object violates the data quality rules
+// of its class. The request should not be retried without changing the
+// object.
+type ErrRuleViolations struct {
+	Violations []modulecapabilities.RuleViolation
+}
+
+func (e ErrRuleViolations) Error() string {
+	return "invalid object: " + strings.Join(e.Messages(), ", ")
+}
+
+// Messages describes every violation in a single line
+func (e ErrRuleViolations) Messages() []string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = fmt.Sprintf("property '%s' violates rule '%s' of module '%s': %s",
+			v.Property, v.Rule, v.Module, v.Message)
+	}
+
+	return msgs
+}
+
+func (e ErrRuleViolations) ErrorKind() errortypes.Kind {
+	return errortypes.KindValidation
+}
+
+func validateRules(ctx context.Context, validator RuleValidator,
+	className string, props interface{}) error {
+	if validator == nil {
+		return nil
+	}
+
+	asMap, _ := props.(map[string]interface{})
+	violations, err := validator.ValidateObjectRules(ctx, className, asMap)
+	if err != nil {
+		return NewErrInternal("validate rules: %v", err)
+	}
+
+	if len(violations) > 0 {
+		return ErrRuleViolations{Violations: violations}
+	}
+
+	return nil
+}
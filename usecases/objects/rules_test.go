@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRules(t *testing.T) {
+	ctx := context.Background()
+	props := map[string]interface{}{"price": -1.0}
+
+	t.Run("without a validator", func(t *testing.T) {
+		assert.Nil(t, validateRules(ctx, nil, "Product", props))
+	})
+
+	t.Run("without violations", func(t *testing.T) {
+		validator := &fakeRuleValidator{}
+		assert.Nil(t, validateRules(ctx, validator, "Product", props))
+		assert.Equal(t, "Product", validator.className)
+		assert.Equal(t, props, validator.props)
+	})
+
+	t.Run("with violations", func(t *testing.T) {
+		validator := &fakeRuleValidator{violations: []modulecapabilities.RuleViolation{
+			{Module: "data-validator", Rule: "price-range", Property: "price", Message: "must be at least 0"},
+			{Module: "data-validator", Rule: "sku-required", Property: "sku", Message: "is required"},
+		}}
+
+		err := validateRules(ctx, validator, "Product", props)
+		require.NotNil(t, err)
+
+		var violations ErrRuleViolations
+		require.True(t, errors.As(err, &violations))
+		assert.Equal(t, validator.violations, violations.Violations)
+		assert.Equal(t, errortypes.KindValidation, errortypes.KindOf(err))
+		assert.Equal(t, "invalid object: "+
+			"property 'price' violates rule 'price-range' of module 'data-validator': must be at least 0, "+
+			"property 'sku' violates rule 'sku-required' of module 'data-validator': is required",
+			err.Error())
+	})
+
+	t.Run("with rules which can not be evaluated", func(t *testing.T) {
+		validator := &fakeRuleValidator{err: errors.New("boom")}
+
+		err := validateRules(ctx, validator, "Product", props)
+		require.NotNil(t, err)
+		assert.Equal(t, errortypes.KindInternal, errortypes.KindOf(err))
+	})
+}
+
+type fakeRuleValidator struct {
+	violations []modulecapabilities.RuleViolation
+	err        error
+	className  string
+	props      map[string]interface{}
+}
+
+func (f *fakeRuleValidator) ValidateObjectRules(ctx context.Context,
+	className string,
+	props map[string]interface{}) ([]modulecapabilities.RuleViolation, error) {
+	f.className = className
+	f.props = props
+	return f.violations, f.err
+}
@@ -0,0 +1,261 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+)
+
+// shadowTimeout is how long a single mirrored write may take
+const shadowTimeout = 30 * time.Second
+
+// shadowMaxInFlight limits the mirrored writes which run at the same time.
+// Any write beyond it is not mirrored, so a slow shadow class can never slow
+// down the traffic of the class it shadows.
+const shadowMaxInFlight = 64
+
+type shadowSchemaManager interface {
+	schemaManager
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// RemoteShadow writes to the shadow class on another Weaviate instance
+type RemoteShadow interface {
+	PutObjects(ctx context.Context, endpoint string, objects []*models.Object) error
+	DeleteObject(ctx context.Context, endpoint, className string, id strfmt.UUID) error
+}
+
+// Shadower mirrors the writes to a class to the shadow class of its
+// models.ShadowConfig. Mirroring happens in the background once the original
+// write succeeded, failures are logged and never affect the original request.
+// Mirrored objects keep their ID. Their vector is only mirrored if the shadow
+// class cannot vectorize the object itself, so a shadow class with a
+// different vectorizer always holds vectors of its own. Batch deletes and
+// batch references are not mirrored.
+type Shadower struct {
+	schemaManager shadowSchemaManager
+	vectorRepo    BatchVectorRepo
+	vectorizer    VectorizerProvider
+	remote        RemoteShadow
+	logger        logrus.FieldLogger
+	inFlight      chan struct{}
+}
+
+func NewShadower(schemaManager shadowSchemaManager, vectorRepo BatchVectorRepo,
+	vectorizer VectorizerProvider, remote RemoteShadow,
+	logger logrus.FieldLogger) *Shadower {
+	return &Shadower{
+		schemaManager: schemaManager,
+		vectorRepo:    vectorRepo,
+		vectorizer:    vectorizer,
+		remote:        remote,
+		logger:        logger,
+		inFlight:      make(chan struct{}, shadowMaxInFlight),
+	}
+}
+
+// shadowTarget is where the writes to a shadowed class are mirrored to. An
+// empty endpoint means the shadow class is part of this instance.
+type shadowTarget struct {
+	class      string
+	endpoint   string
+	keepVector bool
+}
+
+// target returns false if the class is not shadowed. A nil Shadower never
+// shadows a class.
+func (s *Shadower) target(className string) (shadowTarget, bool) {
+	if s == nil {
+		return shadowTarget{}, false
+	}
+
+	sch := s.schemaManager.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(className))
+	if class == nil || class.ShadowConfig == nil {
+		return shadowTarget{}, false
+	}
+
+	cfg := class.ShadowConfig
+	if cfg.Endpoint != "" {
+		target := shadowTarget{
+			class:    cfg.Class,
+			endpoint: cfg.Endpoint,
+			// the vectorizer of the remote class is unknown, so the vector is
+			// mirrored whenever the client had to provide it
+			keepVector: class.Vectorizer == config.VectorizerModuleNone,
+		}
+		if target.class == "" {
+			target.class = className
+		}
+		return target, true
+	}
+
+	shadow := sch.FindClassByName(schema.ClassName(cfg.Class))
+	if shadow == nil {
+		// the shadow class was deleted in the meantime
+		return shadowTarget{}, false
+	}
+
+	return shadowTarget{
+		class:      shadow.Class,
+		keepVector: shadow.Vectorizer == config.VectorizerModuleNone,
+	}, true
+}
+
+// object copies the object into the shadow class
+func (t shadowTarget) object(in *models.Object) *models.Object {
+	out := *in
+	out.Class = t.class
+	out.Additional = nil
+
+	if props, ok := in.Properties.(map[string]interface{}); ok {
+		copied := make(map[string]interface{}, len(props))
+		for key, value := range props {
+			copied[key] = value
+		}
+		out.Properties = copied
+	}
+
+	if !t.keepVector {
+		out.Vector = nil
+	}
+
+	return &out
+}
+
+// putObjects mirrors objects which were added to or replaced in the class
+func (s *Shadower) putObjects(principal *models.Principal, className string,
+	objects []*models.Object) {
+	target, ok := s.target(className)
+	if !ok || len(objects) == 0 {
+		return
+	}
+
+	mirrored := make([]*models.Object, len(objects))
+	for i, obj := range objects {
+		mirrored[i] = target.object(obj)
+	}
+
+	s.run("put", className, func(ctx context.Context) error {
+		return s.write(ctx, principal, target, mirrored)
+	})
+}
+
+// syncObject mirrors the current state of an object which was changed in
+// place, such as by a merge or a reference update
+func (s *Shadower) syncObject(principal *models.Principal, className string,
+	id strfmt.UUID) {
+	target, ok := s.target(className)
+	if !ok {
+		return
+	}
+
+	s.run("sync", className, func(ctx context.Context) error {
+		res, err := s.vectorRepo.ObjectByID(ctx, id, nil,
+			additional.Properties{Vector: true})
+		if err != nil {
+			return err
+		}
+
+		if res == nil {
+			// deleted in the meantime, which is mirrored on its own
+			return nil
+		}
+
+		mirrored := target.object(res.ObjectWithVector(true))
+		return s.write(ctx, principal, target, []*models.Object{mirrored})
+	})
+}
+
+// deleteObject mirrors the deletion of an object of the class
+func (s *Shadower) deleteObject(className string, id strfmt.UUID) {
+	target, ok := s.target(className)
+	if !ok {
+		return
+	}
+
+	s.run("delete", className, func(ctx context.Context) error {
+		if target.endpoint != "" {
+			return s.remote.DeleteObject(ctx, target.endpoint, target.class, id)
+		}
+
+		return s.vectorRepo.DeleteObject(ctx, target.class, id)
+	})
+}
+
+func (s *Shadower) write(ctx context.Context, principal *models.Principal,
+	target shadowTarget, objects []*models.Object) error {
+	if target.endpoint != "" {
+		return s.remote.PutObjects(ctx, target.endpoint, objects)
+	}
+
+	obtainer := newVectorObtainer(s.vectorizer, s.schemaManager, s.logger)
+	batch := make(BatchObjects, len(objects))
+	for i, obj := range objects {
+		err := obtainer.Do(ctx, obj, principal)
+		batch[i] = BatchObject{
+			OriginalIndex: i,
+			Err:           err,
+			Object:        obj,
+			UUID:          obj.ID,
+			Vector:        obj.Vector,
+		}
+	}
+
+	res, err := s.vectorRepo.BatchPutObjects(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	ec := &errorCompounder{}
+	for _, obj := range res {
+		ec.add(obj.Err)
+	}
+
+	return ec.toError()
+}
+
+// run mirrors a write in the background, unless too many mirrored writes are
+// running already, in which case it is dropped
+func (s *Shadower) run(action, className string, fn func(ctx context.Context) error) {
+	select {
+	case s.inFlight <- struct{}{}:
+	default:
+		s.logger.WithField("action", "shadow_"+action).
+			WithField("class", className).
+			Warn("too many mirrored writes in flight, write is not mirrored to " +
+				"the shadow class")
+		return
+	}
+
+	go func() {
+		defer func() { <-s.inFlight }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), shadowTimeout)
+		defer cancel()
+
+		if err := fn(ctx); err != nil {
+			s.logger.WithField("action", "shadow_"+action).
+				WithField("class", className).
+				WithError(err).
+				Warn("failed to mirror write to the shadow class")
+		}
+	}()
+}
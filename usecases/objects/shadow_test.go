@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Shadower(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{Class: "Plain", Vectorizer: "none"},
+				{
+					Class:        "Production",
+					Vectorizer:   "text2vec-contextionary",
+					ShadowConfig: &models.ShadowConfig{Class: "Candidate"},
+				},
+				{Class: "Candidate", Vectorizer: "text2vec-transformers"},
+				{
+					Class:        "Imported",
+					Vectorizer:   "none",
+					ShadowConfig: &models.ShadowConfig{Class: "ImportedCopy"},
+				},
+				{Class: "ImportedCopy", Vectorizer: "none"},
+				{
+					Class:        "Remote",
+					Vectorizer:   "none",
+					ShadowConfig: &models.ShadowConfig{Endpoint: "http://shadow:8080"},
+				},
+				{
+					Class:        "Orphaned",
+					ShadowConfig: &models.ShadowConfig{Class: "Deleted"},
+				},
+			},
+		},
+	}
+
+	logger, _ := test.NewNullLogger()
+	remote := &fakeRemoteShadow{puts: make(chan []*models.Object, 1)}
+	shadower := NewShadower(&fakeShadowSchemaManager{schema: sch}, nil, nil,
+		remote, logger)
+
+	t.Run("a nil shadower does not shadow", func(t *testing.T) {
+		var s *Shadower
+		_, ok := s.target("Production")
+		assert.False(t, ok)
+	})
+
+	t.Run("classes without a shadow config are not shadowed", func(t *testing.T) {
+		_, ok := shadower.target("Plain")
+		assert.False(t, ok)
+
+		_, ok = shadower.target("Unknown")
+		assert.False(t, ok)
+	})
+
+	t.Run("shadow classes which no longer exist are skipped", func(t *testing.T) {
+		_, ok := shadower.target("Orphaned")
+		assert.False(t, ok)
+	})
+
+	t.Run("a local shadow class with a vectorizer", func(t *testing.T) {
+		target, ok := shadower.target("Production")
+		require.True(t, ok)
+		assert.Equal(t, shadowTarget{class: "Candidate"}, target)
+
+		props := map[string]interface{}{"name": "foo"}
+		in := &models.Object{
+			ID:         "8ffa4b6c-69d3-4d2d-a6a8-2f2bb2bb1a1e",
+			Class:      "Production",
+			Properties: props,
+			Vector:     []float32{1, 2, 3},
+		}
+		out := target.object(in)
+
+		assert.Equal(t, "Candidate", out.Class)
+		assert.Equal(t, in.ID, out.ID)
+		assert.Nil(t, out.Vector, "the shadow class vectorizes the object itself")
+		assert.Equal(t, props, out.Properties)
+
+		out.Properties.(map[string]interface{})["name"] = "bar"
+		assert.Equal(t, "foo", props["name"], "the original object is not modified")
+		assert.Equal(t, "Production", in.Class)
+	})
+
+	t.Run("a local shadow class without a vectorizer", func(t *testing.T) {
+		target, ok := shadower.target("Imported")
+		require.True(t, ok)
+
+		out := target.object(&models.Object{Class: "Imported", Vector: []float32{1, 2, 3}})
+		assert.Equal(t, models.C11yVector{1, 2, 3}, out.Vector)
+	})
+
+	t.Run("a remote endpoint", func(t *testing.T) {
+		target, ok := shadower.target("Remote")
+		require.True(t, ok)
+		assert.Equal(t, shadowTarget{
+			class:      "Remote",
+			endpoint:   "http://shadow:8080",
+			keepVector: true,
+		}, target)
+
+		shadower.putObjects(nil, "Remote", []*models.Object{
+			{ID: "8ffa4b6c-69d3-4d2d-a6a8-2f2bb2bb1a1e", Class: "Remote", Vector: []float32{1}},
+		})
+
+		select {
+		case objs := <-remote.puts:
+			require.Len(t, objs, 1)
+			assert.Equal(t, "Remote", objs[0].Class)
+			assert.Equal(t, models.C11yVector{1}, objs[0].Vector)
+		case <-time.After(5 * time.Second):
+			t.Fatal("objects were not mirrored to the endpoint")
+		}
+	})
+}
+
+type fakeShadowSchemaManager struct {
+	fakeSchemaManager
+	schema schema.Schema
+}
+
+func (f *fakeShadowSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+type fakeRemoteShadow struct {
+	puts chan []*models.Object
+}
+
+func (f *fakeRemoteShadow) PutObjects(ctx context.Context, endpoint string,
+	objects []*models.Object) error {
+	f.puts <- objects
+	return nil
+}
+
+func (f *fakeRemoteShadow) DeleteObject(ctx context.Context, endpoint,
+	className string, id strfmt.UUID) error {
+	return nil
+}
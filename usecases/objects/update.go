@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/tenant"
 )
 
 // UpdateObject Class Instance to the connected DB. If the class contains a network
@@ -45,6 +46,8 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principa
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
 	}
 
+	ctx = tenant.NewContext(ctx, class.Tenant)
+
 	originalObject, err := m.getObjectFromRepo(ctx, id, additional.Properties{})
 	if err != nil {
 		return nil, err
@@ -62,12 +65,23 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principa
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
+	err = validateRules(ctx, m.ruleValidator, class.Class, class.Properties)
+	if err != nil {
+		return nil, err
+	}
+
 	class.LastUpdateTimeUnix = m.timeSource.Now()
 
+	if err := maskObject(ctx, m.masker, class); err != nil {
+		return nil, err
+	}
+
 	err = m.vectorizeAndPutObject(ctx, class, principal)
 	if err != nil {
 		return nil, NewErrInternal("update object: %v", err)
 	}
 
+	m.shadower.putObjects(principal, class.Class, []*models.Object{class})
+
 	return class, nil
 }
@@ -37,5 +37,5 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 		return NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
-	return nil
+	return validateRules(ctx, m.ruleValidator, class.Class, class.Properties)
 }
@@ -0,0 +1,243 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package propertyusage samples which properties queries filter on and
+// return, so that properties can be found whose inverted index only costs
+// disk and write amplification, because no query ever filters on them.
+package propertyusage
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+type propKey struct {
+	class string
+	prop  string
+}
+
+type propAccess struct {
+	filtered     int64
+	returned     int64
+	lastFiltered time.Time
+	lastReturned time.Time
+}
+
+// Tracker records the properties accessed by a sample of the queries. The
+// counters live in memory and are reset on restart. A nil Tracker records
+// nothing.
+type Tracker struct {
+	sync.Mutex
+	sampleRate float64
+	window     time.Duration
+	started    time.Time
+	access     map[propKey]*propAccess
+
+	now    func() time.Time
+	sample func() float64
+}
+
+// New creates a Tracker from the config, it returns nil if the sample rate
+// is 0
+func New(cfg config.PropertyUsage) *Tracker {
+	if cfg.SampleRate <= 0 {
+		return nil
+	}
+
+	return &Tracker{
+		sampleRate: cfg.SampleRate,
+		window:     time.Duration(cfg.WindowHours) * time.Hour,
+		started:    time.Now(),
+		access:     map[propKey]*propAccess{},
+		now:        time.Now,
+		sample:     rand.Float64,
+	}
+}
+
+// Query is the access of a single query. Filters may be nil, Returned are
+// the names of the properties of Class which are returned or aggregated.
+type Query struct {
+	Class    string
+	Filters  *filters.LocalFilter
+	Returned []string
+
+	// ReturnedRefs are the properties selected on referenced classes, they
+	// are recorded as returned on the referenced class
+	ReturnedRefs search.SelectProperties
+}
+
+// Record records the properties accessed by the query if it is sampled
+func (t *Tracker) Record(q Query) {
+	if t == nil || t.sample() >= t.sampleRate {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.now()
+	if q.Filters != nil {
+		t.recordClause(q.Filters.Root, now)
+	}
+
+	for _, prop := range q.Returned {
+		t.recordReturned(q.Class, prop, now)
+	}
+	t.recordSelected(q.Class, q.ReturnedRefs, now)
+}
+
+func (t *Tracker) recordClause(clause *filters.Clause, now time.Time) {
+	if clause == nil {
+		return
+	}
+
+	for i := range clause.Operands {
+		t.recordClause(&clause.Operands[i], now)
+	}
+
+	// a filter on a reference is served by the inverted indexes of every
+	// property along its path
+	for path := clause.On; path != nil; path = path.Child {
+		access := t.accessFor(path.Class.String(), path.Property.String())
+		access.filtered++
+		access.lastFiltered = now
+	}
+}
+
+func (t *Tracker) recordSelected(class string, props search.SelectProperties,
+	now time.Time) {
+	for _, prop := range props {
+		t.recordReturned(class, prop.Name, now)
+		for _, ref := range prop.Refs {
+			t.recordSelected(ref.ClassName, ref.RefProperties, now)
+		}
+	}
+}
+
+func (t *Tracker) recordReturned(class, prop string, now time.Time) {
+	access := t.accessFor(class, prop)
+	access.returned++
+	access.lastReturned = now
+}
+
+func (t *Tracker) accessFor(class, prop string) *propAccess {
+	key := propKey{class, prop}
+	access, ok := t.access[key]
+	if !ok {
+		access = &propAccess{}
+		t.access[key] = access
+	}
+	return access
+}
+
+// Report lists the indexed properties which no sampled query filtered on
+// within the window
+type Report struct {
+	SampleRate    float64 `json:"sampleRate"`
+	WindowSeconds int64   `json:"windowSeconds"`
+
+	// ObservedSince is when the tracking started in unix milliseconds.
+	// Complete is only set if that is longer ago than the window, otherwise
+	// a property may simply not have been queried since the restart.
+	ObservedSince int64 `json:"observedSince"`
+	Complete      bool  `json:"complete"`
+
+	Unused []UnusedProperty `json:"unused"`
+}
+
+// UnusedProperty is an indexed property which was not filtered on within the
+// window. The counters are the sampled accesses since the tracking started,
+// the times are in unix milliseconds and 0 if there was no access. If the
+// property was not returned within the window either, it is not used at all.
+type UnusedProperty struct {
+	Class            string `json:"class"`
+	Property         string `json:"property"`
+	Filtered         int64  `json:"filtered"`
+	Returned         int64  `json:"returned"`
+	LastFiltered     int64  `json:"lastFiltered"`
+	LastReturned     int64  `json:"lastReturned"`
+	ReturnedInWindow bool   `json:"returnedInWindow"`
+}
+
+// Report compares the sampled accesses to the classes of the schema. A
+// window of 0 uses the configured one.
+func (t *Tracker) Report(sch *models.Schema, window time.Duration) Report {
+	if window <= 0 {
+		window = t.window
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.now()
+	cutoff := now.Add(-window)
+	out := Report{
+		SampleRate:    t.sampleRate,
+		WindowSeconds: int64(window / time.Second),
+		ObservedSince: unixMilli(t.started),
+		Complete:      !t.started.After(cutoff),
+		Unused:        []UnusedProperty{},
+	}
+
+	if sch == nil {
+		return out
+	}
+
+	for _, class := range sch.Classes {
+		for _, prop := range class.Properties {
+			if prop.IndexInverted != nil && !*prop.IndexInverted {
+				continue
+			}
+
+			access := t.access[propKey{class.Class, prop.Name}]
+			if access == nil {
+				access = &propAccess{}
+			}
+
+			if access.lastFiltered.After(cutoff) {
+				continue
+			}
+
+			out.Unused = append(out.Unused, UnusedProperty{
+				Class:            class.Class,
+				Property:         prop.Name,
+				Filtered:         access.filtered,
+				Returned:         access.returned,
+				LastFiltered:     unixMilli(access.lastFiltered),
+				LastReturned:     unixMilli(access.lastReturned),
+				ReturnedInWindow: access.lastReturned.After(cutoff),
+			})
+		}
+	}
+
+	sort.Slice(out.Unused, func(a, b int) bool {
+		if out.Unused[a].Class != out.Unused[b].Class {
+			return out.Unused[a].Class < out.Unused[b].Class
+		}
+		return out.Unused[a].Property < out.Unused[b].Property
+	})
+
+	return out
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
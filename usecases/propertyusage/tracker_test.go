@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package propertyusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	t.Run("disabled without a sample rate", func(t *testing.T) {
+		tracker := New(config.PropertyUsage{WindowHours: 1})
+		require.Nil(t, tracker)
+
+		// must not panic
+		tracker.Record(Query{Class: "Article", Returned: []string{"title"}})
+	})
+
+	falseVal := false
+	sch := &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "category", DataType: []string{"string"}},
+					{Name: "body", DataType: []string{"text"}, IndexInverted: &falseVal},
+					{Name: "wordCount", DataType: []string{"int"}},
+					{Name: "author", DataType: []string{"Author"}},
+				},
+			},
+			{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: []string{"string"}},
+				},
+			},
+		},
+	}
+
+	now := time.Unix(1000000, 0)
+	newTracker := func() *Tracker {
+		tracker := New(config.PropertyUsage{SampleRate: 1, WindowHours: 24})
+		tracker.started = now.Add(-48 * time.Hour)
+		tracker.now = func() time.Time { return now }
+		return tracker
+	}
+
+	t.Run("filtered and returned properties", func(t *testing.T) {
+		tracker := newTracker()
+
+		tracker.Record(Query{
+			Class: "Article",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					{
+						Operator: filters.OperatorEqual,
+						On:       &filters.Path{Class: "Article", Property: "category"},
+					},
+					{
+						Operator: filters.OperatorEqual,
+						On: &filters.Path{
+							Class:    "Article",
+							Property: "author",
+							Child:    &filters.Path{Class: "Author", Property: "name"},
+						},
+					},
+				},
+			}},
+			ReturnedRefs: search.SelectProperties{
+				{Name: "title", IsPrimitive: true},
+			},
+		})
+
+		report := tracker.Report(sch, 0)
+		assert.True(t, report.Complete)
+		assert.Equal(t, int64(24*3600), report.WindowSeconds)
+		assert.Equal(t, []UnusedProperty{
+			{
+				Class:            "Article",
+				Property:         "title",
+				Returned:         1,
+				LastReturned:     now.UnixNano() / int64(time.Millisecond),
+				ReturnedInWindow: true,
+			},
+			{Class: "Article", Property: "wordCount"},
+		}, report.Unused)
+	})
+
+	t.Run("accesses before the window", func(t *testing.T) {
+		tracker := newTracker()
+		tracker.Record(Query{
+			Class: "Article",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorGreaterThan,
+				On:       &filters.Path{Class: "Article", Property: "wordCount"},
+			}},
+			Returned: []string{"wordCount"},
+		})
+
+		now = now.Add(36 * time.Hour)
+		report := tracker.Report(sch, 0)
+		var unused []string
+		for _, prop := range report.Unused {
+			unused = append(unused, prop.Class+"."+prop.Property)
+			if prop.Property == "wordCount" {
+				assert.Equal(t, int64(1), prop.Filtered)
+				assert.False(t, prop.ReturnedInWindow)
+			}
+		}
+		assert.Contains(t, unused, "Article.wordCount")
+
+		report = tracker.Report(sch, 48*time.Hour)
+		for _, prop := range report.Unused {
+			assert.NotEqual(t, "wordCount", prop.Property)
+		}
+	})
+
+	t.Run("incomplete while tracked for less than the window", func(t *testing.T) {
+		tracker := newTracker()
+		tracker.started = now.Add(-time.Hour)
+
+		report := tracker.Report(sch, 0)
+		assert.False(t, report.Complete)
+		assert.Len(t, report.Unused, 5)
+	})
+
+	t.Run("only sampled queries are recorded", func(t *testing.T) {
+		tracker := newTracker()
+		tracker.sampleRate = 0.5
+		tracker.sample = func() float64 { return 0.7 }
+
+		tracker.Record(Query{Class: "Article", Returned: []string{"title"}})
+		assert.Len(t, tracker.access, 0)
+
+		tracker.sample = func() float64 { return 0.3 }
+		tracker.Record(Query{Class: "Article", Returned: []string{"title"}})
+		assert.Len(t, tracker.access, 1)
+	})
+}
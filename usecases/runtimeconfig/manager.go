@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package runtimeconfig applies the subset of the configuration which can be
+// changed while Weaviate is running. Changes either come from the runtime
+// config API or from re-reading the config file on SIGHUP. Neither requires a
+// restart, so no vector index has to be reloaded for an operational tweak.
+package runtimeconfig
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type logger interface {
+	logrus.FieldLogger
+	GetLevel() logrus.Level
+	SetLevel(level logrus.Level)
+}
+
+type readOnlySetter interface {
+	SetReadOnly(enabled bool)
+}
+
+type rowCacheSizer interface {
+	SetRowCacheMaxSize(size uint64)
+}
+
+type slowQueryLogger interface {
+	SetSlowQueryThreshold(threshold time.Duration)
+}
+
+type inferenceQueue interface {
+	SetSlots(slots int)
+}
+
+// Manager holds the runtime settings currently in effect and applies changes
+// to the components they belong to
+type Manager struct {
+	sync.Mutex
+	authorizer     authorizer
+	logger         logger
+	readOnly       readOnlySetter
+	rowCache       rowCacheSizer
+	slowQueries    slowQueryLogger
+	inferenceQueue inferenceQueue
+	current        models.RuntimeConfig
+}
+
+// New creates a Manager. The inferenceQueue is nil if the queue was disabled
+// on startup, in which case it can only be enabled through a restart.
+func New(authorizer authorizer, logger logger, readOnly readOnlySetter,
+	rowCache rowCacheSizer, slowQueries slowQueryLogger,
+	inferenceQueue inferenceQueue) *Manager {
+	return &Manager{
+		authorizer:     authorizer,
+		logger:         logger,
+		readOnly:       readOnly,
+		rowCache:       rowCache,
+		slowQueries:    slowQueries,
+		inferenceQueue: inferenceQueue,
+	}
+}
+
+// Init applies the runtime settings of the config loaded on startup
+func (m *Manager) Init(cfg config.Config) error {
+	return m.apply(fromConfig(cfg), "startup")
+}
+
+// Reload applies the runtime settings of a freshly loaded config, e.g. after
+// the config file was re-read on SIGHUP. All other settings of cfg are
+// ignored, changing them still requires a restart.
+func (m *Manager) Reload(cfg config.Config) error {
+	return m.apply(fromConfig(cfg), "reload")
+}
+
+// Update replaces the runtime settings with the ones specified by the user
+// and returns the settings in effect afterwards
+func (m *Manager) Update(principal *models.Principal,
+	updated *models.RuntimeConfig) (*models.RuntimeConfig, error) {
+	err := m.authorizer.Authorize(principal, "update", authorization.RuntimeConfigResource)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == nil {
+		return nil, errortypes.New(errortypes.KindValidation, "empty runtime config")
+	}
+
+	if err := m.apply(*updated, "api"); err != nil {
+		return nil, err
+	}
+
+	return m.Current(), nil
+}
+
+// Current returns a copy of the runtime settings in effect
+func (m *Manager) Current() *models.RuntimeConfig {
+	m.Lock()
+	defer m.Unlock()
+
+	current := m.current
+	return &current
+}
+
+func fromConfig(cfg config.Config) models.RuntimeConfig {
+	return models.RuntimeConfig{
+		LogLevel:                cfg.Runtime.LogLevel,
+		SlowQueryThresholdMs:    cfg.Runtime.SlowQueryThresholdMs,
+		RowCacheMaxSize:         cfg.Runtime.RowCacheMaxSize,
+		ReadOnly:                cfg.Runtime.ReadOnly,
+		InferenceMaxConcurrency: int64(cfg.InferenceQueue.MaxConcurrency),
+	}
+}
+
+func (m *Manager) apply(settings models.RuntimeConfig, source string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	level, err := m.validate(settings)
+	if err != nil {
+		return errortypes.Wrap(errortypes.KindValidation, err)
+	}
+
+	m.logger.SetLevel(level)
+	m.readOnly.SetReadOnly(settings.ReadOnly)
+	m.rowCache.SetRowCacheMaxSize(uint64(settings.RowCacheMaxSize))
+	m.slowQueries.SetSlowQueryThreshold(
+		time.Duration(settings.SlowQueryThresholdMs) * time.Millisecond)
+	if m.inferenceQueue != nil {
+		m.inferenceQueue.SetSlots(int(settings.InferenceMaxConcurrency))
+	}
+
+	settings.LogLevel = level.String()
+	previous := m.current
+	m.current = settings
+
+	if previous != settings {
+		m.logger.WithFields(logrus.Fields{
+			"action":                    "runtime_config_update",
+			"source":                    source,
+			"log_level":                 settings.LogLevel,
+			"slow_query_threshold_ms":   settings.SlowQueryThresholdMs,
+			"row_cache_max_size":        settings.RowCacheMaxSize,
+			"read_only":                 settings.ReadOnly,
+			"inference_max_concurrency": settings.InferenceMaxConcurrency,
+		}).Info("applied runtime config")
+	}
+
+	return nil
+}
+
+// validate returns the log level to apply, an empty level keeps the current
+// one
+func (m *Manager) validate(settings models.RuntimeConfig) (logrus.Level, error) {
+	level := m.logger.GetLevel()
+	if settings.LogLevel != "" {
+		parsed, err := logrus.ParseLevel(settings.LogLevel)
+		if err != nil {
+			return 0, fmt.Errorf("logLevel: %v", err)
+		}
+		level = parsed
+	}
+
+	if settings.SlowQueryThresholdMs < 0 {
+		return 0, fmt.Errorf("slowQueryThresholdMs must not be negative")
+	}
+
+	if settings.RowCacheMaxSize <= 0 {
+		return 0, fmt.Errorf("rowCacheMaxSize must be greater than 0")
+	}
+
+	if m.inferenceQueue == nil && settings.InferenceMaxConcurrency > 0 {
+		return 0, fmt.Errorf("inferenceMaxConcurrency: the inference queue was " +
+			"disabled on startup, enabling it requires a restart")
+	}
+
+	if m.inferenceQueue != nil && settings.InferenceMaxConcurrency <= 0 {
+		return 0, fmt.Errorf("inferenceMaxConcurrency must be greater than 0, " +
+			"disabling the inference queue requires a restart")
+	}
+
+	return level, nil
+}
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package runtimeconfig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Manager(t *testing.T) {
+	var (
+		logger      *logrus.Logger
+		authorizer  *fakeAuthorizer
+		components  *fakeComponents
+		manager     *Manager
+		startupConf config.Config
+	)
+
+	startupConf = config.Defaults()
+	startupConf.InferenceQueue.MaxConcurrency = 4
+
+	reset := func(withQueue bool) {
+		logger, _ = test.NewNullLogger()
+		authorizer = &fakeAuthorizer{}
+		components = &fakeComponents{}
+		var queue inferenceQueue
+		if withQueue {
+			queue = components
+		}
+		manager = New(authorizer, logger, components, components, components, queue)
+	}
+
+	t.Run("applying the config loaded on startup", func(t *testing.T) {
+		reset(true)
+		require.Nil(t, manager.Init(startupConf))
+
+		assert.Equal(t, uint64(config.DefaultRowCacheMaxSize), components.rowCacheMaxSize)
+		assert.Equal(t, time.Duration(0), components.slowQueryThreshold)
+		assert.False(t, components.readOnly)
+		assert.Equal(t, 4, components.slots)
+		assert.Equal(t, logrus.InfoLevel.String(), manager.Current().LogLevel)
+	})
+
+	t.Run("updating through the api", func(t *testing.T) {
+		reset(true)
+		require.Nil(t, manager.Init(startupConf))
+
+		res, err := manager.Update(nil, &models.RuntimeConfig{
+			LogLevel:                "debug",
+			SlowQueryThresholdMs:    250,
+			RowCacheMaxSize:         1024,
+			ReadOnly:                true,
+			InferenceMaxConcurrency: 2,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+		assert.Equal(t, 250*time.Millisecond, components.slowQueryThreshold)
+		assert.Equal(t, uint64(1024), components.rowCacheMaxSize)
+		assert.True(t, components.readOnly)
+		assert.Equal(t, 2, components.slots)
+		assert.Equal(t, "debug", res.LogLevel)
+	})
+
+	t.Run("reloading keeps the log level if none is set", func(t *testing.T) {
+		reset(true)
+		logger.SetLevel(logrus.TraceLevel)
+		require.Nil(t, manager.Reload(startupConf))
+
+		assert.Equal(t, logrus.TraceLevel, logger.GetLevel())
+		assert.Equal(t, "trace", manager.Current().LogLevel)
+	})
+
+	t.Run("updating without permissions", func(t *testing.T) {
+		reset(true)
+		authorizer.err = errors.New("forbidden")
+
+		_, err := manager.Update(nil, &models.RuntimeConfig{RowCacheMaxSize: 1})
+		assert.Equal(t, authorizer.err, err)
+		assert.Equal(t, uint64(0), components.rowCacheMaxSize)
+	})
+
+	t.Run("with invalid settings", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			withQueue bool
+			settings  models.RuntimeConfig
+		}{
+			{
+				name:      "invalid log level",
+				withQueue: true,
+				settings: models.RuntimeConfig{
+					LogLevel: "verbose", RowCacheMaxSize: 1, InferenceMaxConcurrency: 1,
+				},
+			},
+			{
+				name:      "negative slow query threshold",
+				withQueue: true,
+				settings: models.RuntimeConfig{
+					SlowQueryThresholdMs: -1, RowCacheMaxSize: 1, InferenceMaxConcurrency: 1,
+				},
+			},
+			{
+				name:      "no row cache",
+				withQueue: true,
+				settings:  models.RuntimeConfig{InferenceMaxConcurrency: 1},
+			},
+			{
+				name:      "disabling the inference queue",
+				withQueue: true,
+				settings:  models.RuntimeConfig{RowCacheMaxSize: 1},
+			},
+			{
+				name:      "enabling the inference queue",
+				withQueue: false,
+				settings: models.RuntimeConfig{
+					RowCacheMaxSize: 1, InferenceMaxConcurrency: 1,
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				reset(test.withQueue)
+				settings := test.settings
+
+				_, err := manager.Update(nil, &settings)
+				require.NotNil(t, err)
+				assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+				assert.Equal(t, uint64(0), components.rowCacheMaxSize,
+					"nothing is applied if any setting is invalid")
+			})
+		}
+	})
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeComponents struct {
+	readOnly           bool
+	rowCacheMaxSize    uint64
+	slowQueryThreshold time.Duration
+	slots              int
+}
+
+func (c *fakeComponents) SetReadOnly(enabled bool) {
+	c.readOnly = enabled
+}
+
+func (c *fakeComponents) SetRowCacheMaxSize(size uint64) {
+	c.rowCacheMaxSize = size
+}
+
+func (c *fakeComponents) SetSlowQueryThreshold(threshold time.Duration) {
+	c.slowQueryThreshold = threshold
+}
+
+func (c *fakeComponents) SetSlots(slots int) {
+	c.slots = slots
+}
@@ -30,17 +30,46 @@ func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	return m.addClass(ctx, principal, class)
+	return m.addClass(ctx, principal, class, nil)
 }
 
+// RestoreClass adds a class from a backup to the schema. Contrary to
+// AddClass, the sharding state is not initialized, but taken from the
+// backup, so that the restored shard files on disk are picked up by the
+// nodes which own them.
+func (m *Manager) RestoreClass(ctx context.Context, principal *models.Principal,
+	class *models.Class, shardState *sharding.State) error {
+	err := m.authorizer.Authorize(principal, "create", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	if shardState == nil {
+		return errors.Errorf("restore class %q: sharding state is required", class.Class)
+	}
+
+	return m.addClass(ctx, principal, class, shardState)
+}
+
+// addClass initializes a new sharding state for the class unless shardState
+// is set
 func (m *Manager) addClass(ctx context.Context, principal *models.Principal,
-	class *models.Class) error {
+	class *models.Class, shardState *sharding.State) error {
 	m.Lock()
 	defer m.Unlock()
 
 	class.Class = upperCaseClassName(class.Class)
+	if err := m.applyTemplate(class); err != nil {
+		return err
+	}
 	class.Properties = lowerCaseAllPropertyNames(class.Properties)
 	m.setClassDefaults(class)
+	lowerCaseCompositeIndexes(class.InvertedIndexConfig)
+	lowerCaseContinuousAggregates(class.InvertedIndexConfig)
+
+	if isTemplate(class) {
+		return m.addTemplate(ctx, principal, class)
+	}
 
 	err := m.validateCanAddClass(ctx, principal, class)
 	if err != nil {
@@ -57,10 +86,23 @@ func (m *Manager) addClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	shardState, err := sharding.InitState(class.Class,
-		class.ShardingConfig.(sharding.Config), m.clusterState)
-	if err != nil {
-		return errors.Wrap(err, "init sharding state")
+	if shardState == nil && multiTenancyEnabled(class) {
+		// tenants, and with them the shards, are added later on
+		shardState, err = sharding.InitPartitionedState(class.Class,
+			class.ShardingConfig.(sharding.Config), m.clusterState,
+			replicationFactor(class))
+		if err != nil {
+			return errors.Wrap(err, "init sharding state")
+		}
+	} else if shardState == nil {
+		shardState, err = sharding.InitState(class.Class,
+			class.ShardingConfig.(sharding.Config), m.clusterState,
+			replicationFactor(class))
+		if err != nil {
+			return errors.Wrap(err, "init sharding state")
+		}
+	} else {
+		shardState.SetLocalName(m.clusterState.LocalName())
 	}
 
 	tx, err := m.cluster.BeginTransaction(ctx, AddClass,
@@ -111,6 +153,14 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		class.InvertedIndexConfig.CleanupIntervalSeconds = config.DefaultCleanupIntervalSeconds
 	}
 
+	if class.ReplicationConfig == nil {
+		class.ReplicationConfig = &models.ReplicationConfig{}
+	}
+
+	if class.ReplicationConfig.Factor == 0 {
+		class.ReplicationConfig.Factor = sharding.DefaultReplicationFactor
+	}
+
 	m.moduleConfig.SetClassDefaults(class)
 }
 
@@ -156,6 +206,26 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		if err != nil {
 			return fmt.Errorf("property '%s': invalid dataType: %v", property.Name, err)
 		}
+
+		err = validatePropertyTokenization(property)
+		if err != nil {
+			return err
+		}
+
+		err = validatePropertyInvertedIndexStorage(property)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = validateCompositeIndexes(class)
+	if err != nil {
+		return errors.Wrap(err, "invertedIndexConfig")
+	}
+
+	err = validateContinuousAggregates(class)
+	if err != nil {
+		return errors.Wrap(err, "invertedIndexConfig")
 	}
 
 	err = m.validateVectorSettings(ctx, class)
@@ -163,6 +233,11 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		return err
 	}
 
+	err = m.validateShadowConfig(class)
+	if err != nil {
+		return err
+	}
+
 	err = m.moduleConfig.ValidateClass(ctx, class)
 	if err != nil {
 		return err
@@ -203,6 +278,16 @@ func (m *Manager) parseShardingConfig(ctx context.Context,
 	return nil
 }
 
+// replicationFactor of the class, classes created before replication was
+// supported do not have a replication config and exist only once
+func replicationFactor(class *models.Class) int {
+	if class.ReplicationConfig == nil || class.ReplicationConfig.Factor == 0 {
+		return sharding.DefaultReplicationFactor
+	}
+
+	return int(class.ReplicationConfig.Factor)
+}
+
 func upperCaseClassName(name string) string {
 	if len(name) < 1 {
 		return name
@@ -223,6 +308,27 @@ func lowerCaseAllPropertyNames(props []*models.Property) []*models.Property {
 	return props
 }
 
+func lowerCaseCompositeIndexes(cfg *models.InvertedIndexConfig) {
+	for _, composite := range cfg.CompositeIndexes {
+		for i, propName := range composite {
+			composite[i] = lowerCaseFirstLetter(propName)
+		}
+	}
+}
+
+func lowerCaseContinuousAggregates(cfg *models.InvertedIndexConfig) {
+	for _, agg := range cfg.ContinuousAggregates {
+		if agg == nil {
+			continue
+		}
+
+		agg.GroupBy = lowerCaseFirstLetter(agg.GroupBy)
+		for i, propName := range agg.Sum {
+			agg.Sum[i] = lowerCaseFirstLetter(propName)
+		}
+	}
+}
+
 func lowerCaseFirstLetter(name string) string {
 	if len(name) < 1 {
 		return name
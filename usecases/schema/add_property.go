@@ -36,8 +36,22 @@ func (m *Manager) addClassProperty(ctx context.Context, principal *models.Princi
 	m.Lock()
 	defer m.Unlock()
 
-	semanticSchema := m.state.SchemaFor()
-	class, err := schema.GetClassByName(semanticSchema, className)
+	if err := m.addClassPropertyLocked(ctx, principal, className, prop); err != nil {
+		return err
+	}
+
+	if m.templateByName(className) != nil {
+		return m.propagateTemplateProperty(ctx, principal, className, prop)
+	}
+
+	return nil
+}
+
+// addClassPropertyLocked must be called while holding the lock, the class
+// can be either a regular class or a template
+func (m *Manager) addClassPropertyLocked(ctx context.Context,
+	principal *models.Principal, className string, prop *models.Property) error {
+	class, err := m.classOrTemplateByName(className)
 	if err != nil {
 		return err
 	}
@@ -67,8 +81,7 @@ func (m *Manager) addClassProperty(ctx context.Context, principal *models.Princi
 
 func (m *Manager) addClassPropertyApplyChanges(ctx context.Context,
 	className string, prop *models.Property) error {
-	semanticSchema := m.state.SchemaFor()
-	class, err := schema.GetClassByName(semanticSchema, className)
+	class, err := m.classOrTemplateByName(className)
 	if err != nil {
 		return err
 	}
@@ -79,9 +92,22 @@ func (m *Manager) addClassPropertyApplyChanges(ctx context.Context,
 		return nil
 	}
 
+	if isTemplate(class) {
+		// templates have no index which would need to be migrated
+		return nil
+	}
+
 	return m.migrator.AddProperty(ctx, className, prop)
 }
 
+func (m *Manager) classOrTemplateByName(name string) (*models.Class, error) {
+	if template := m.templateByName(name); template != nil {
+		return template, nil
+	}
+
+	return schema.GetClassByName(m.state.SchemaFor(), name)
+}
+
 func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.Principal,
 	property *models.Property, class *models.Class) error {
 	// Verify format of property.
@@ -119,6 +145,16 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 		return fmt.Errorf("Data type of property '%s' is invalid; %v", property.Name, err)
 	}
 
+	err = validatePropertyTokenization(property)
+	if err != nil {
+		return err
+	}
+
+	err = validatePropertyInvertedIndexStorage(property)
+	if err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
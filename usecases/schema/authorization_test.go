@@ -20,6 +20,7 @@ import (
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +55,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "create",
 			expectedResource: "schema/objects",
 		},
+		testCase{
+			methodName:       "RestoreClass",
+			additionalArgs:   []interface{}{&models.Class{}, &sharding.State{}},
+			expectedVerb:     "create",
+			expectedResource: "schema/objects",
+		},
 		testCase{
 			methodName:       "UpdateClass",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
@@ -84,6 +91,48 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		testCase{
+			methodName:       "AddTenants",
+			additionalArgs:   []interface{}{"somename", []*models.Tenant{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		testCase{
+			methodName:       "GetTenants",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "UpdateTenants",
+			additionalArgs:   []interface{}{"somename", []*models.Tenant{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		testCase{
+			methodName:       "DeleteTenants",
+			additionalArgs:   []interface{}{"somename", []string{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		testCase{
+			methodName:       "CleanupVectorIndex",
+			additionalArgs:   []interface{}{"somename", "someshard"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		testCase{
+			methodName:       "GetClassStatus",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "StartVectorIndexAdvisor",
+			additionalArgs:   []interface{}{"somename", &models.VectorIndexAdviceRequest{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -97,7 +146,8 @@ func Test_Schema_Authorization(t *testing.T) {
 			switch method {
 			case "TriggerSchemaUpdateCallbacks", "RegisterSchemaUpdateCallback",
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "Lock", "Unlock",
-				"ShardingState", "TxManager":
+				"ShardingState", "TxManager", "CurrentState", "ValidateTenant",
+				"AddShardReplicas", "RemoveShardReplicas":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// GetClassStatus returns runtime information about a class on this node which
+// is not part of the schema, such as the outcome of the vector index advisor
+func (m *Manager) GetClassStatus(ctx context.Context, principal *models.Principal,
+	className string) (*models.ClassStatus, error) {
+	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if m.snapshotClassByName(className) == nil {
+		return nil, ErrNotFound
+	}
+
+	return m.migrator.ClassStatus(ctx, className)
+}
+
+// StartVectorIndexAdvisor explores a grid of hnsw parameters on a sample of the
+// vectors in the local shards of the class in the background. Only one run
+// per class can be active at a time, the recommendation is never applied
+// automatically.
+func (m *Manager) StartVectorIndexAdvisor(ctx context.Context, principal *models.Principal,
+	className string, params *models.VectorIndexAdviceRequest) (*models.ClassStatus, error) {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if m.snapshotClassByName(className) == nil {
+		return nil, ErrNotFound
+	}
+
+	return m.migrator.StartVectorIndexAdvisor(ctx, className, params)
+}
@@ -33,6 +33,10 @@ func (m *Manager) deleteClass(ctx context.Context, className string) error {
 	m.Lock()
 	defer m.Unlock()
 
+	if err := m.validateCanDeleteTemplate(className); err != nil {
+		return err
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, DeleteClass,
 		DeleteClassPayload{className})
 	if err != nil {
@@ -50,6 +54,10 @@ func (m *Manager) deleteClass(ctx context.Context, className string) error {
 }
 
 func (m *Manager) deleteClassApplyChanges(ctx context.Context, className string) error {
+	if deleted, err := m.deleteTemplateApplyChanges(ctx, className); deleted {
+		return err
+	}
+
 	semanticSchema := m.state.SchemaFor()
 	classIdx := -1
 	for idx, class := range semanticSchema.Classes {
@@ -11,6 +11,6 @@
 
 package schema
 
-import "errors"
+import "github.com/semi-technologies/weaviate/entities/errortypes"
 
-var ErrNotFound = errors.New("not found")
+var ErrNotFound = errortypes.New(errortypes.KindNotFound, "not found")
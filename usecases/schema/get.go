@@ -19,7 +19,8 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/sharding"
 )
 
-// GetSchema retrieves a locally cached copy of the schema
+// GetSchema retrieves a locally cached copy of the schema. The returned
+// schema is an immutable snapshot and must not be modified.
 func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error) {
 	err := m.authorizer.Authorize(principal, "list", "schema/*")
 	if err != nil {
@@ -27,21 +28,23 @@ func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error)
 	}
 
 	return schema.Schema{
-		Objects: m.state.ObjectSchema,
+		Objects: m.currentSnapshot().objects,
 	}, nil
 }
 
 // GetSchemaSkipAuth can never be used as a response to a user request as it
 // could leak the schema to an unauthorized user, is intended to be used for
-// non-user triggered processes, such as regular updates / maintenance / etc
+// non-user triggered processes, such as regular updates / maintenance / etc.
+// It never blocks on schema mutations, as it serves an immutable snapshot,
+// which must not be modified.
 func (m *Manager) GetSchemaSkipAuth() schema.Schema {
 	return schema.Schema{
-		Objects: m.state.ObjectSchema,
+		Objects: m.currentSnapshot().objects,
 	}
 }
 
 func (m *Manager) IndexedInverted(className, propertyName string) bool {
-	class := m.getClassByName(className)
+	class := m.snapshotClassByName(className)
 	if class == nil {
 		return false
 	}
@@ -66,9 +69,19 @@ func (m *Manager) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
-	return m.getClassByName(name), nil
+	return m.snapshotClassByName(name), nil
+}
+
+func (m *Manager) snapshotClassByName(name string) *models.Class {
+	s := schema.Schema{
+		Objects: m.currentSnapshot().objects,
+	}
+
+	return s.FindClassByName(schema.ClassName(name))
 }
 
+// getClassByName returns the class from the mutable working copy, it must
+// only be used while holding the lock
 func (m *Manager) getClassByName(name string) *models.Class {
 	s := schema.Schema{
 		Objects: m.state.ObjectSchema,
@@ -78,5 +91,16 @@ func (m *Manager) getClassByName(name string) *models.Class {
 }
 
 func (m *Manager) ShardingState(className string) *sharding.State {
-	return m.state.ShardingState[className]
+	return m.currentSnapshot().shardingState[className]
+}
+
+// ValidateTenant checks the tenant of an object against the sharding state
+// of its class. Unknown classes are left to the schema validation.
+func (m *Manager) ValidateTenant(className, tenant string) error {
+	state := m.ShardingState(className)
+	if state == nil {
+		return nil
+	}
+
+	return state.ValidateTenant(tenant)
 }
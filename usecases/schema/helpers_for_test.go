@@ -51,6 +51,10 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
+func (f fakeVectorConfig) DistanceName() string {
+	return "fake"
+}
+
 func dummyParseVectorConfig(in interface{}) (schema.VectorIndexConfig, error) {
 	return fakeVectorConfig{raw: in}, nil
 }
@@ -140,3 +144,7 @@ func (f *fakeTxClient) AbortTransaction(ctx context.Context, host string, tx *cl
 func (f *fakeTxClient) CommitTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
 	return nil
 }
+
+func (f *fakeTxClient) GetSchema(ctx context.Context, host string) (State, error) {
+	return State{}, nil
+}
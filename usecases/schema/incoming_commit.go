@@ -28,6 +28,12 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
 		return m.handleUpdateClassCommit(ctx, tx)
+	case AddTenants, UpdateTenants:
+		return m.handleTenantsCommit(ctx, tx)
+	case DeleteTenants:
+		return m.handleDeleteTenantsCommit(ctx, tx)
+	case UpdateShardReplicas:
+		return m.handleShardReplicasCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -43,6 +49,10 @@ func (m *Manager) handleAddClassCommit(ctx context.Context, tx *cluster.Transact
 			tx.Payload)
 	}
 
+	if isTemplate(pl.Class) {
+		return m.addTemplateApplyChanges(ctx, pl.Class)
+	}
+
 	err := m.parseShardingConfig(ctx, pl.Class)
 	if err != nil {
 		return err
@@ -106,3 +116,51 @@ func (m *Manager) handleUpdateClassCommit(ctx context.Context,
 
 	return m.updateClassApplyChanges(ctx, pl.ClassName, pl.Class)
 }
+
+func (m *Manager) handleTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(TenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be TenantsPayload, but got %T",
+			tx.Payload)
+	}
+
+	pl.State.SetLocalName(m.clusterState.LocalName())
+	if tx.Type == AddTenants {
+		return m.addTenantsApplyChanges(ctx, pl.ClassName, pl.Tenants, pl.State)
+	}
+
+	return m.updateTenantsApplyChanges(ctx, pl.ClassName, pl.Tenants, pl.State)
+}
+
+func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeleteTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteTenantsPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteTenantsApplyChanges(ctx, pl.ClassName, pl.Tenants)
+}
+
+func (m *Manager) handleShardReplicasCommit(ctx context.Context,
+	tx *cluster.Transaction) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(ShardReplicasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be ShardReplicasPayload, but got %T",
+			tx.Payload)
+	}
+
+	pl.State.SetLocalName(m.clusterState.LocalName())
+	return m.updateShardReplicasApplyChanges(ctx, pl.ClassName, pl.State)
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -40,8 +41,12 @@ type Manager struct {
 	moduleConfig        ModuleConfig
 	cluster             *cluster.TxManager
 	clusterState        clusterState
+	clusterClient       ClusterClient
 	sync.Mutex
 
+	// snapshot holds the *snapshot served to readers, see publishSnapshot
+	snapshot atomic.Value
+
 	hnswConfigParser VectorConfigParser
 }
 
@@ -88,7 +93,7 @@ func NewManager(migrator migrate.Migrator, repo Repo,
 	logger logrus.FieldLogger, authorizer authorizer, config config.Config,
 	hnswConfigParser VectorConfigParser, vectorizerValidator VectorizerValidator,
 	moduleConfig ModuleConfig, clusterState clusterState,
-	txClient cluster.Client) (*Manager, error) {
+	txClient ClusterClient) (*Manager, error) {
 	m := &Manager{
 		config:              config,
 		migrator:            migrator,
@@ -99,8 +104,9 @@ func NewManager(migrator migrate.Migrator, repo Repo,
 		hnswConfigParser:    hnswConfigParser,
 		vectorizerValidator: vectorizerValidator,
 		moduleConfig:        moduleConfig,
-		cluster:             cluster.NewTxManager(cluster.NewTxBroadcaster(clusterState, txClient)),
+		cluster:             cluster.NewTxManager(cluster.NewTxBroadcaster(clusterState, txClient), logger),
 		clusterState:        clusterState,
+		clusterClient:       txClient,
 	}
 
 	m.cluster.SetCommitFn(m.handleCommit)
@@ -138,6 +144,10 @@ func (m *Manager) saveSchema(ctx context.Context) error {
 		WithField("action", "schema_update").
 		Debug("saving updated schema to configuration store")
 
+	// the working copy has already been modified, so publish it even if
+	// persisting fails
+	m.publishSnapshot()
+
 	err := m.repo.SaveSchema(ctx, m.state)
 	if err != nil {
 		return err
@@ -176,6 +186,11 @@ func (m *Manager) loadOrInitializeSchema(ctx context.Context) error {
 		return errors.Wrap(err, "load schema")
 	}
 
+	schema, err = m.startupClusterSync(ctx, schema)
+	if err != nil {
+		return errors.Wrap(err, "reconcile schema with cluster")
+	}
+
 	// store in local cache
 	m.state = *schema
 
@@ -183,11 +198,15 @@ func (m *Manager) loadOrInitializeSchema(ctx context.Context) error {
 		return errors.Wrap(err, "migrating sharding state from previous version")
 	}
 
+	m.assignPhysicalIDs()
+
 	// store in remote repo
 	if err := m.repo.SaveSchema(ctx, m.state); err != nil {
 		return fmt.Errorf("initialized a new schema, but couldn't update remote: %v", err)
 	}
 
+	m.publishSnapshot()
+
 	return nil
 }
 
@@ -215,7 +234,8 @@ func (m *Manager) checkSingleShardMigration(ctx context.Context) error {
 		}
 
 		shardState, err := sharding.InitState(c.Class,
-			c.ShardingConfig.(sharding.Config), m.clusterState)
+			c.ShardingConfig.(sharding.Config), m.clusterState,
+			sharding.DefaultReplicationFactor)
 		if err != nil {
 			return errors.Wrap(err, "init sharding state")
 		}
@@ -229,6 +249,20 @@ func (m *Manager) checkSingleShardMigration(ctx context.Context) error {
 	return nil
 }
 
+// assignPhysicalIDs makes sure that sharding states created with an older
+// version have an immutable physical ID. The db adapter moves the existing
+// files, which were named after the class, once it loads the index.
+func (m *Manager) assignPhysicalIDs() {
+	for className, shardState := range m.state.ShardingState {
+		if shardState.EnsurePhysicalID() {
+			m.logger.WithField("className", className).
+				WithField("action", "initialize_schema").
+				WithField("physical_id", shardState.PhysicalID).
+				Info("assigned physical id to sharding state created with an older version")
+		}
+	}
+}
+
 func newSchema() *State {
 	return &State{
 		ObjectSchema: &models.Schema{
@@ -66,6 +66,37 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) AddTenants(ctx context.Context, className string, tenants []*models.Tenant) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdateTenants(ctx context.Context, className string, tenants []*models.Tenant) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdateShards(ctx context.Context, className string) error {
+	return nil
+}
+
+func (n *NilMigrator) CleanupVectorIndex(ctx context.Context, className,
+	shardName string) (*models.VectorIndexCleanupResponse, error) {
+	return &models.VectorIndexCleanupResponse{}, nil
+}
+
+func (n *NilMigrator) ClassStatus(ctx context.Context,
+	className string) (*models.ClassStatus, error) {
+	return &models.ClassStatus{Class: className}, nil
+}
+
+func (n *NilMigrator) StartVectorIndexAdvisor(ctx context.Context, className string,
+	params *models.VectorIndexAdviceRequest) (*models.ClassStatus, error) {
+	return &models.ClassStatus{Class: className}, nil
+}
+
 var schemaTests = []struct {
 	name string
 	fn   func(*testing.T, *Manager)
@@ -76,6 +107,7 @@ var schemaTests = []struct {
 	{name: "AddObjectClassWithImplicitVectorizer", fn: testAddObjectClassImplicitVectorizer},
 	{name: "AddObjectClassWithWrongVectorizer", fn: testAddObjectClassWrongVectorizer},
 	{name: "AddObjectClassWithWrongIndexType", fn: testAddObjectClassWrongIndexType},
+	{name: "AddObjectClassWithTooManyReplicas", fn: testAddObjectClassTooManyReplicas},
 	{name: "RemoveObjectClass", fn: testRemoveObjectClass},
 	{name: "CantAddSameClassTwice", fn: testCantAddSameClassTwice},
 	{name: "CantAddSameClassTwiceDifferentKind", fn: testCantAddSameClassTwiceDifferentKinds},
@@ -130,6 +162,8 @@ func testAddObjectClass(t *testing.T, lsm *Manager) {
 	}, objectClasses[0].VectorIndexConfig)
 	assert.Equal(t, int64(60), objectClasses[0].InvertedIndexConfig.CleanupIntervalSeconds,
 		"the default was set")
+	assert.Equal(t, int64(1), objectClasses[0].ReplicationConfig.Factor,
+		"the default was set")
 }
 
 func testAddObjectClassExplicitVectorizer(t *testing.T, lsm *Manager) {
@@ -225,6 +259,24 @@ func testAddObjectClassWrongIndexType(t *testing.T, lsm *Manager) {
 		"\"vector-index-2-million\"", err.Error())
 }
 
+func testAddObjectClassTooManyReplicas(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddClass(context.Background(), nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{{
+			DataType: []string{"string"},
+			Name:     "dummy",
+		}},
+		ReplicationConfig: &models.ReplicationConfig{Factor: 2},
+	})
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "replication factor of 2 is larger than "+
+		"the number of nodes in the cluster (1)")
+	assert.NotContains(t, testGetClassNames(lsm), "Car")
+}
+
 func testRemoveObjectClass(t *testing.T, lsm *Manager) {
 	t.Parallel()
 
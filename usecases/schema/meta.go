@@ -20,6 +20,9 @@ import (
 // UpdateMeta for object
 func (m *Manager) UpdateMeta(ctx context.Context,
 	atContext strfmt.URI, maintainer strfmt.Email, name string) error {
+	m.Lock()
+	defer m.Unlock()
+
 	semanticSchema := m.state.SchemaFor()
 	semanticSchema.Maintainer = maintainer
 	semanticSchema.Name = name
@@ -37,4 +37,30 @@ type Migrator interface {
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
 		updated schema.VectorIndexConfig) error
+
+	// AddTenants and UpdateTenants are called after the sharding state of the
+	// class has been updated, DeleteTenants before the tenants are removed from
+	// it, so the partitions of the tenants can always be looked up
+	AddTenants(ctx context.Context, className string,
+		tenants []*models.Tenant) error
+	UpdateTenants(ctx context.Context, className string,
+		tenants []*models.Tenant) error
+	DeleteTenants(ctx context.Context, className string,
+		tenants []string) error
+
+	// UpdateShards is called after replicas of the shards of the class were
+	// moved between nodes, so the local shards match the sharding state again
+	UpdateShards(ctx context.Context, className string) error
+
+	// CleanupVectorIndex removes the tombstones from the vector index of a
+	// shard located on this node
+	CleanupVectorIndex(ctx context.Context, className,
+		shardName string) (*models.VectorIndexCleanupResponse, error)
+
+	// ClassStatus returns the runtime status of the class on this node,
+	// StartVectorIndexAdvisor starts an advisor run for its local shards in
+	// the background and returns the status right after the run was started
+	ClassStatus(ctx context.Context, className string) (*models.ClassStatus, error)
+	StartVectorIndexAdvisor(ctx context.Context, className string,
+		params *models.VectorIndexAdviceRequest) (*models.ClassStatus, error)
 }
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+// AddShardReplicas places an additional replica of shards of the class on
+// other nodes, replicas maps the name of each shard to its new node. Writes
+// reach the new replicas as soon as the change is committed, existing
+// objects have to be copied separately.
+//
+// Moving replicas is not a user-facing schema change, so the caller is
+// responsible for authorization.
+func (m *Manager) AddShardReplicas(ctx context.Context, className string,
+	replicas map[string]string) error {
+	return m.updateShardReplicas(ctx, className, func(state *sharding.State) error {
+		for shard, node := range replicas {
+			if !state.AddReplica(shard, node) {
+				return errors.Errorf("cannot place replica of shard %q on node %q",
+					shard, node)
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveShardReplicas removes the replicas of shards of the class from the
+// node. The node shuts down the affected shards, but keeps their files.
+func (m *Manager) RemoveShardReplicas(ctx context.Context, className,
+	node string, shards []string) error {
+	return m.updateShardReplicas(ctx, className, func(state *sharding.State) error {
+		for _, shard := range shards {
+			if !state.RemoveReplica(shard, node) {
+				return errors.Errorf("cannot remove replica of shard %q from node %q",
+					shard, node)
+			}
+		}
+		return nil
+	})
+}
+
+func (m *Manager) updateShardReplicas(ctx context.Context, className string,
+	update func(state *sharding.State) error) error {
+	m.Lock()
+	defer m.Unlock()
+
+	state, ok := m.state.ShardingState[className]
+	if !ok {
+		return ErrNotFound
+	}
+
+	updated := state.DeepCopy()
+	if err := update(updated); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateShardReplicas,
+		ShardReplicasPayload{className, updated})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateShardReplicasApplyChanges(ctx, className, updated)
+}
+
+func (m *Manager) updateShardReplicasApplyChanges(ctx context.Context,
+	className string, state *sharding.State) error {
+	m.state.ShardingState[className] = state
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	return m.migrator.UpdateShards(ctx, className)
+}
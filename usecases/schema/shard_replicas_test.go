@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardReplicas(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	migrator := &shardReplicasMigrator{}
+	sm, err := NewManager(migrator, newFakeRepo(), logger, &fakeAuthorizer{},
+		config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+		dummyParseVectorConfig, &fakeVectorizerValidator{},
+		&fakeModuleConfig{}, &fakeClusterState{}, &fakeTxClient{})
+	require.Nil(t, err)
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "MyClass"}))
+	shards := sm.ShardingState("MyClass").AllPhysicalShards()
+	require.Len(t, shards, 1)
+	shard := shards[0]
+
+	t.Run("adding a replica on another node", func(t *testing.T) {
+		err := sm.AddShardReplicas(ctx, "MyClass", map[string]string{shard: "node2"})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"node1", "node2"},
+			sm.ShardingState("MyClass").Physical[shard].Nodes())
+		assert.Equal(t, []string{"MyClass"}, migrator.updated)
+	})
+
+	t.Run("a node cannot hold two replicas of the same shard", func(t *testing.T) {
+		err := sm.AddShardReplicas(ctx, "MyClass", map[string]string{shard: "node2"})
+		assert.NotNil(t, err)
+		assert.Len(t, migrator.updated, 1)
+	})
+
+	t.Run("removing the replica from the original node", func(t *testing.T) {
+		err := sm.RemoveShardReplicas(ctx, "MyClass", "node1", []string{shard})
+		require.Nil(t, err)
+
+		state := sm.ShardingState("MyClass")
+		assert.Equal(t, []string{"node2"}, state.Physical[shard].Nodes())
+		assert.False(t, state.IsShardLocal(shard))
+		assert.Len(t, migrator.updated, 2)
+	})
+
+	t.Run("the last replica cannot be removed", func(t *testing.T) {
+		err := sm.RemoveShardReplicas(ctx, "MyClass", "node2", []string{shard})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("unknown classes", func(t *testing.T) {
+		err := sm.AddShardReplicas(ctx, "Unknown", map[string]string{shard: "node1"})
+		assert.Equal(t, ErrNotFound, err)
+	})
+}
+
+type shardReplicasMigrator struct {
+	NilMigrator
+	updated []string
+}
+
+func (m *shardReplicasMigrator) UpdateShards(ctx context.Context,
+	className string) error {
+	m.updated = append(m.updated, className)
+	return nil
+}
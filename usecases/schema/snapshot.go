@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"reflect"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+// snapshot is an immutable copy of the schema state which is served to all
+// readers. Mutations are applied to the working copy in Manager.state while
+// holding the manager's lock and are then published as a new snapshot, so
+// readers never contend on the lock and never observe a partial update.
+//
+// The snapshot and everything it references must never be modified. The
+// classes are copied in full, including their configs. Only the sharding
+// states are shared with the working copy, as mutations always replace them
+// rather than modifying them in place.
+type snapshot struct {
+	objects       *models.Schema
+	shardingState map[string]*sharding.State
+}
+
+// publishSnapshot must be called after every mutation of m.state, while
+// still holding the lock
+func (m *Manager) publishSnapshot() {
+	m.snapshot.Store(&snapshot{
+		objects:       copySchema(m.state.ObjectSchema),
+		shardingState: copyShardingState(m.state.ShardingState),
+	})
+}
+
+func (m *Manager) currentSnapshot() *snapshot {
+	s, ok := m.snapshot.Load().(*snapshot)
+	if !ok {
+		// nothing published yet
+		return &snapshot{objects: &models.Schema{}}
+	}
+
+	return s
+}
+
+// copySchema deep copies the schema, so the snapshot shares nothing with the
+// working copy which a mutation could modify in place
+func copySchema(in *models.Schema) *models.Schema {
+	if in == nil {
+		return &models.Schema{}
+	}
+
+	out := *in
+	out.Classes = copyClasses(in.Classes)
+	if in.Templates != nil {
+		out.Templates = copyClasses(in.Templates)
+	}
+
+	return &out
+}
+
+func copyClasses(in []*models.Class) []*models.Class {
+	out := make([]*models.Class, len(in))
+	for i, class := range in {
+		out[i] = deepCopy(reflect.ValueOf(class)).Interface().(*models.Class)
+	}
+
+	return out
+}
+
+// deepCopy copies the value including everything it references. Besides the
+// models themselves this covers the configs of classes and properties, which
+// are either still the maps and slices they were unmarshalled into or have
+// been parsed into structs, such as the vector index and sharding configs.
+// Nil maps, slices and pointers remain nil, so a copy sent back in an update
+// still equals the working copy.
+func deepCopy(in reflect.Value) reflect.Value {
+	switch in.Kind() {
+	case reflect.Ptr:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.New(in.Type().Elem())
+		out.Elem().Set(deepCopy(in.Elem()))
+		return out
+	case reflect.Interface:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.New(in.Type()).Elem()
+		out.Set(deepCopy(in.Elem()))
+		return out
+	case reflect.Map:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.MakeMapWithSize(in.Type(), in.Len())
+		iter := in.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return out
+	case reflect.Slice:
+		if in.IsNil() {
+			return in
+		}
+		out := reflect.MakeSlice(in.Type(), in.Len(), in.Len())
+		for i := 0; i < in.Len(); i++ {
+			out.Index(i).Set(deepCopy(in.Index(i)))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(in.Type()).Elem()
+		// unexported fields can only be copied as they are
+		out.Set(in)
+		for i := 0; i < in.NumField(); i++ {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(deepCopy(in.Field(i)))
+			}
+		}
+		return out
+	default:
+		return in
+	}
+}
+
+func copyShardingState(in map[string]*sharding.State) map[string]*sharding.State {
+	out := make(map[string]*sharding.State, len(in))
+	for className, state := range in {
+		out[className] = state
+	}
+
+	return out
+}
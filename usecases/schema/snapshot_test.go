@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaSnapshots(t *testing.T) {
+	sm := newSchemaManager()
+	ctx := context.Background()
+
+	err := sm.AddClass(ctx, nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "color", DataType: []string{"string"}},
+		},
+	})
+	require.Nil(t, err)
+
+	before := sm.GetSchemaSkipAuth()
+	require.NotNil(t, sm.ShardingState("Car"))
+
+	t.Run("mutations do not alter earlier snapshots", func(t *testing.T) {
+		err := sm.AddClassProperty(ctx, nil, "Car",
+			&models.Property{Name: "brand", DataType: []string{"string"}})
+		require.Nil(t, err)
+
+		err = sm.AddClass(ctx, nil, &models.Class{Class: "Bike"})
+		require.Nil(t, err)
+
+		require.Len(t, before.Objects.Classes, 1)
+		assert.Len(t, before.Objects.Classes[0].Properties, 1)
+
+		after := sm.GetSchemaSkipAuth()
+		require.Len(t, after.Objects.Classes, 2)
+		assert.Len(t, after.FindClassByName("Car").Properties, 2)
+		assert.NotNil(t, sm.ShardingState("Bike"))
+	})
+
+	t.Run("reads do not block during concurrent mutations", func(t *testing.T) {
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					s := sm.GetSchemaSkipAuth()
+					assert.NotNil(t, s.FindClassByName("Car"))
+				}
+			}()
+		}
+
+		err := sm.DeleteClass(ctx, nil, "Bike")
+		require.Nil(t, err)
+		wg.Wait()
+
+		after := sm.GetSchemaSkipAuth()
+		assert.Nil(t, after.FindClassByName("Bike"))
+	})
+	t.Run("nested configs are not shared with the working copy", func(t *testing.T) {
+		type parsedConfig struct {
+			EF       int
+			Segments []int
+		}
+
+		in := &models.Schema{Classes: []*models.Class{{
+			Class: "Car",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				CompositeIndexes: [][]string{{"color", "brand"}},
+			},
+			ModuleConfig: map[string]interface{}{
+				"text2vec-contextionary": map[string]interface{}{
+					"vectorizeClassName": true,
+				},
+			},
+			VectorIndexConfig: &parsedConfig{EF: 100, Segments: []int{1}},
+			ShardingConfig:    map[string]interface{}{"desiredCount": 1},
+			Properties: []*models.Property{{
+				Name:         "color",
+				DataType:     []string{"string"},
+				ModuleConfig: map[string]interface{}{"skip": false},
+			}},
+		}}}
+
+		copied := copySchema(in)
+		assert.Equal(t, in, copied)
+
+		class := in.Classes[0]
+		class.InvertedIndexConfig.CompositeIndexes[0][0] = "wheels"
+		class.ModuleConfig.(map[string]interface{})["text2vec-contextionary"].(map[string]interface{})["vectorizeClassName"] = false
+		class.VectorIndexConfig.(*parsedConfig).EF = 200
+		class.VectorIndexConfig.(*parsedConfig).Segments[0] = 2
+		class.ShardingConfig.(map[string]interface{})["desiredCount"] = 2
+		class.Properties[0].ModuleConfig.(map[string]interface{})["skip"] = true
+
+		copiedClass := copied.Classes[0]
+		assert.Equal(t, "color", copiedClass.InvertedIndexConfig.CompositeIndexes[0][0])
+		assert.Equal(t, map[string]interface{}{
+			"text2vec-contextionary": map[string]interface{}{"vectorizeClassName": true},
+		}, copiedClass.ModuleConfig)
+		assert.Equal(t, &parsedConfig{EF: 100, Segments: []int{1}},
+			copiedClass.VectorIndexConfig)
+		assert.Equal(t, map[string]interface{}{"desiredCount": 1},
+			copiedClass.ShardingConfig)
+		assert.Equal(t, map[string]interface{}{"skip": false},
+			copiedClass.Properties[0].ModuleConfig)
+	})
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/cluster"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+// startupClusterSyncTimeout limits how long a starting node waits for the
+// other nodes to report their schema
+const startupClusterSyncTimeout = 30 * time.Second
+
+// ClusterClient opens, commits and aborts schema transactions on other nodes
+// and retrieves their schema to reconcile with on startup
+type ClusterClient interface {
+	cluster.Client
+	GetSchema(ctx context.Context, host string) (State, error)
+}
+
+// CurrentState returns the schema state as it is served to readers, it must
+// not be modified
+func (m *Manager) CurrentState() State {
+	snap := m.currentSnapshot()
+	return State{
+		ObjectSchema:  snap.objects,
+		ShardingState: snap.shardingState,
+	}
+}
+
+// startupClusterSync resolves transactions which are in doubt on this node.
+// A node might have missed a commit - or committed a transaction which was
+// later aborted on the remaining nodes - because it was down or the
+// transaction had expired locally. Such a node would otherwise keep serving a
+// diverged schema forever. If the majority of the cluster agrees on a schema
+// which differs from the local one, that schema replaces the local one. If
+// there is no majority, for example because too many nodes are unreachable,
+// the local schema is kept.
+func (m *Manager) startupClusterSync(ctx context.Context,
+	local *State) (*State, error) {
+	hosts := m.clusterState.Hostnames()
+	if len(hosts) == 0 || m.clusterClient == nil {
+		// single-node cluster, nothing to reconcile with
+		return local, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, startupClusterSyncTimeout)
+	defer cancel()
+
+	localFingerprint, err := schemaFingerprint(local)
+	if err != nil {
+		return nil, errors.Wrap(err, "fingerprint local schema")
+	}
+
+	candidates := map[string]*State{localFingerprint: local}
+	votes := map[string]int{localFingerprint: 1}
+	for _, host := range hosts {
+		remote, err := m.clusterClient.GetSchema(ctx, host)
+		if err != nil {
+			m.logger.WithField("action", "startup_cluster_schema_sync").
+				WithField("host", host).
+				WithError(err).
+				Warn("could not retrieve schema from node, it does not take " +
+					"part in the reconciliation")
+			continue
+		}
+
+		if err := m.parseConfigs(ctx, &remote); err != nil {
+			return nil, errors.Wrapf(err, "parse schema of node %q", host)
+		}
+
+		fingerprint, err := schemaFingerprint(&remote)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fingerprint schema of node %q", host)
+		}
+
+		if _, ok := candidates[fingerprint]; !ok {
+			candidates[fingerprint] = &remote
+		}
+		votes[fingerprint]++
+	}
+
+	quorum := m.clusterState.NodeCount()/2 + 1
+	if votes[localFingerprint] >= quorum {
+		return local, nil
+	}
+
+	for fingerprint, count := range votes {
+		if count < quorum {
+			continue
+		}
+
+		m.logger.WithField("action", "startup_cluster_schema_sync").
+			WithField("votes", count).
+			WithField("quorum", quorum).
+			Warn("local schema differs from the schema of the majority of the " +
+				"cluster, most likely because of a transaction which was interrupted, " +
+				"replacing local schema")
+		return candidates[fingerprint], nil
+	}
+
+	if len(votes) > 1 {
+		m.logger.WithField("action", "startup_cluster_schema_sync").
+			WithField("quorum", quorum).
+			Error("schema differs between nodes, but no schema is shared by a " +
+				"majority of the cluster, keeping local schema")
+	}
+
+	return local, nil
+}
+
+// schemaFingerprint identifies a schema state independently of the order in
+// which the classes were added
+func schemaFingerprint(state *State) (string, error) {
+	var classes []*models.Class
+	if state.ObjectSchema != nil {
+		classes = make([]*models.Class, len(state.ObjectSchema.Classes))
+		copy(classes, state.ObjectSchema.Classes)
+		sort.Slice(classes, func(a, b int) bool {
+			return classes[a].Class < classes[b].Class
+		})
+	}
+
+	shardingState := state.ShardingState
+	if shardingState == nil {
+		shardingState = map[string]*sharding.State{}
+	}
+
+	// maps are marshalled with sorted keys
+	marshalled, err := json.Marshal(struct {
+		Classes       []*models.Class            `json:"classes"`
+		ShardingState map[string]*sharding.State `json:"shardingState"`
+	}{classes, shardingState})
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(marshalled)
+	return hex.EncodeToString(hash[:]), nil
+}
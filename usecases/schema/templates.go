@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// isTemplate is true for classes which only serve as a template for other
+// classes. Templates are kept in the Templates of the schema rather than its
+// Classes, so they never get an index and cannot hold any objects.
+func isTemplate(class *models.Class) bool {
+	return class.TemplateConfig != nil && class.TemplateConfig.IsTemplate
+}
+
+func (m *Manager) templateByName(name string) *models.Class {
+	for _, template := range m.state.SchemaFor().Templates {
+		if template.Class == name {
+			return template
+		}
+	}
+
+	return nil
+}
+
+// derivedClasses returns all classes which were created from the template
+func (m *Manager) derivedClasses(templateName string) []*models.Class {
+	var out []*models.Class
+	for _, class := range m.state.SchemaFor().Classes {
+		if class.TemplateConfig != nil && class.TemplateConfig.Template == templateName {
+			out = append(out, class)
+		}
+	}
+
+	return out
+}
+
+// applyTemplate fills in everything which is not set on the class from the
+// template it is derived from. Properties of the class take precedence over
+// properties of the template with the same name. The template is copied, so
+// later changes to the class never affect the template or vice versa.
+func (m *Manager) applyTemplate(class *models.Class) error {
+	if class.TemplateConfig == nil || class.TemplateConfig.Template == "" {
+		return nil
+	}
+
+	if class.TemplateConfig.IsTemplate {
+		return fmt.Errorf("template %q cannot itself be derived from template %q",
+			class.Class, class.TemplateConfig.Template)
+	}
+
+	name := upperCaseClassName(class.TemplateConfig.Template)
+	template := m.templateByName(name)
+	if template == nil {
+		return fmt.Errorf("class %q is derived from template %q, but there is no "+
+			"such template", class.Class, name)
+	}
+	class.TemplateConfig.Template = name
+
+	copied, err := copyClass(template)
+	if err != nil {
+		return errors.Wrapf(err, "copy template %q", name)
+	}
+
+	own := map[string]bool{}
+	for _, prop := range class.Properties {
+		own[lowerCaseFirstLetter(prop.Name)] = true
+	}
+
+	props := make([]*models.Property, 0, len(copied.Properties)+len(class.Properties))
+	for _, prop := range copied.Properties {
+		if !own[prop.Name] {
+			props = append(props, prop)
+		}
+	}
+	class.Properties = append(props, class.Properties...)
+
+	if class.Description == "" {
+		class.Description = copied.Description
+	}
+	if class.Vectorizer == "" {
+		class.Vectorizer = copied.Vectorizer
+	}
+	if class.ModuleConfig == nil {
+		class.ModuleConfig = copied.ModuleConfig
+	}
+	if class.VectorIndexType == "" {
+		class.VectorIndexType = copied.VectorIndexType
+	}
+	if class.VectorIndexConfig == nil {
+		class.VectorIndexConfig = copied.VectorIndexConfig
+	}
+	if class.InvertedIndexConfig == nil {
+		class.InvertedIndexConfig = copied.InvertedIndexConfig
+	}
+	if class.ShardingConfig == nil {
+		class.ShardingConfig = copied.ShardingConfig
+	}
+	if class.ReplicationConfig == nil {
+		class.ReplicationConfig = copied.ReplicationConfig
+	}
+	if class.MultiTenancyConfig == nil {
+		class.MultiTenancyConfig = copied.MultiTenancyConfig
+	}
+
+	return nil
+}
+
+// addTemplate validates the template and adds it to the schema of all
+// nodes. Contrary to a class, neither its sharding nor its vector index config
+// are parsed, they are parsed for each class derived from it instead.
+func (m *Manager) addTemplate(ctx context.Context, principal *models.Principal,
+	template *models.Class) error {
+	if err := m.validateCanAddClass(ctx, principal, template); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, AddClass,
+		AddClassPayload{template, nil})
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.addTemplateApplyChanges(ctx, template)
+}
+
+func (m *Manager) addTemplateApplyChanges(ctx context.Context,
+	template *models.Class) error {
+	semanticSchema := m.state.SchemaFor()
+	semanticSchema.Templates = append(semanticSchema.Templates, template)
+	return m.saveSchema(ctx)
+}
+
+// deleteTemplateApplyChanges removes the template from the schema, it returns
+// false if there is no template with that name
+func (m *Manager) deleteTemplateApplyChanges(ctx context.Context,
+	name string) (bool, error) {
+	semanticSchema := m.state.SchemaFor()
+	for i, template := range semanticSchema.Templates {
+		if template.Class != name {
+			continue
+		}
+
+		semanticSchema.Templates = append(semanticSchema.Templates[:i],
+			semanticSchema.Templates[i+1:]...)
+		return true, m.saveSchema(ctx)
+	}
+
+	return false, nil
+}
+
+// validateCanDeleteTemplate prevents deleting templates which classes are
+// still derived from, as their updates could no longer be propagated
+func (m *Manager) validateCanDeleteTemplate(name string) error {
+	if m.templateByName(name) == nil {
+		return nil
+	}
+
+	if derived := m.derivedClasses(name); len(derived) > 0 {
+		return fmt.Errorf("template %q cannot be deleted, %d classes are derived "+
+			"from it, e.g. %q", name, len(derived), derived[0].Class)
+	}
+
+	return nil
+}
+
+// propagateTemplateProperty adds a property which was just added to a
+// template to every class derived from it which opted into updates. Each
+// class is migrated in its own transaction, so if one of them fails, the
+// property is still added to the remaining ones and the error lists every
+// class which could not be updated.
+func (m *Manager) propagateTemplateProperty(ctx context.Context,
+	principal *models.Principal, templateName string, prop *models.Property) error {
+	var failed []string
+	for _, class := range m.derivedClasses(templateName) {
+		if !class.TemplateConfig.PropagateUpdates {
+			continue
+		}
+
+		if validatePropertyNameUniqueness(prop.Name, class) != nil {
+			// the class already has a property of the same name which takes
+			// precedence over the one of the template
+			continue
+		}
+
+		copied := *prop
+		copied.DataType = append([]string(nil), prop.DataType...)
+		if err := m.addClassPropertyLocked(ctx, principal, class.Class,
+			&copied); err != nil {
+			m.logger.WithField("action", "schema_template_propagate").
+				WithField("template", templateName).
+				WithField("class", class.Class).
+				WithError(err).
+				Error("could not add template property to derived class")
+			failed = append(failed, class.Class)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("property %q was added to template %q, but could not be "+
+			"added to the derived classes %v", prop.Name, templateName, failed)
+	}
+
+	return nil
+}
+
+// copyClass creates a deep copy of the class, including its module and index
+// configs, which may be modified in place when defaults are set
+func copyClass(in *models.Class) (*models.Class, error) {
+	bytes, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var out models.Class
+	if err := json.Unmarshal(bytes, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
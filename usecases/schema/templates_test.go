@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		sm := newSchemaManager()
+
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:          "customerTemplate",
+			TemplateConfig: &models.TemplateConfig{IsTemplate: true},
+			Description:    "an order of a customer",
+			Properties: []*models.Property{
+				{Name: "Name", DataType: []string{"string"}},
+				{Name: "amount", DataType: []string{"number"}},
+			},
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+		}))
+
+		return sm
+	}
+
+	t.Run("a template is not a class", func(t *testing.T) {
+		sm := newManager(t)
+
+		sch, err := sm.GetSchema(nil)
+		require.Nil(t, err)
+		assert.Len(t, sch.Objects.Classes, 0)
+		require.Len(t, sch.Objects.Templates, 1)
+		assert.Equal(t, "CustomerTemplate", sch.Objects.Templates[0].Class)
+		assert.Nil(t, sm.ShardingState("CustomerTemplate"))
+
+		err = sm.AddClass(ctx, nil, &models.Class{Class: "CustomerTemplate"})
+		assert.NotNil(t, err, "the name of a template cannot be reused")
+	})
+
+	t.Run("a derived class inherits from the template", func(t *testing.T) {
+		sm := newManager(t)
+
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class: "CustomerAcme",
+			TemplateConfig: &models.TemplateConfig{
+				Template: "CustomerTemplate",
+			},
+			Properties: []*models.Property{
+				{Name: "amount", DataType: []string{"int"}},
+				{Name: "region", DataType: []string{"string"}},
+			},
+		})
+		require.Nil(t, err)
+
+		class, err := schema.GetClassByName(sm.GetSchemaSkipAuth().Objects,
+			"CustomerAcme")
+		require.Nil(t, err)
+		assert.Equal(t, "an order of a customer", class.Description)
+		assert.True(t, class.InvertedIndexConfig.IndexTimestamps)
+		assert.NotNil(t, sm.ShardingState("CustomerAcme"))
+
+		dataTypes := map[string][]string{}
+		for _, prop := range class.Properties {
+			dataTypes[prop.Name] = prop.DataType
+		}
+		assert.Equal(t, map[string][]string{
+			"name":   {"string"},
+			"amount": {"int"}, // the class takes precedence over the template
+			"region": {"string"},
+		}, dataTypes)
+
+		// the class owns a copy, so changing it does not change the template
+		class.Properties[0].Name = "changed"
+		assert.Equal(t, "name", sm.templateByName("CustomerTemplate").Properties[0].Name)
+	})
+
+	t.Run("deriving from a template that does not exist", func(t *testing.T) {
+		sm := newManager(t)
+
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class:          "CustomerAcme",
+			TemplateConfig: &models.TemplateConfig{Template: "Unknown"},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("properties added to a template are propagated", func(t *testing.T) {
+		sm := newManager(t)
+
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class: "Propagated",
+			TemplateConfig: &models.TemplateConfig{
+				Template:         "CustomerTemplate",
+				PropagateUpdates: true,
+			},
+		}))
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:          "NotPropagated",
+			TemplateConfig: &models.TemplateConfig{Template: "CustomerTemplate"},
+		}))
+
+		err := sm.AddClassProperty(ctx, nil, "CustomerTemplate", &models.Property{
+			Name:     "createdAt",
+			DataType: []string{"date"},
+		})
+		require.Nil(t, err)
+
+		objects := sm.GetSchemaSkipAuth().Objects
+		propagated, err := schema.GetClassByName(objects, "Propagated")
+		require.Nil(t, err)
+		notPropagated, err := schema.GetClassByName(objects, "NotPropagated")
+		require.Nil(t, err)
+
+		assert.Len(t, sm.templateByName("CustomerTemplate").Properties, 3)
+		assert.Len(t, propagated.Properties, 3)
+		assert.Len(t, notPropagated.Properties, 2)
+	})
+
+	t.Run("a template can only be deleted without derived classes", func(t *testing.T) {
+		sm := newManager(t)
+
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:          "CustomerAcme",
+			TemplateConfig: &models.TemplateConfig{Template: "CustomerTemplate"},
+		}))
+
+		assert.NotNil(t, sm.DeleteClass(ctx, nil, "CustomerTemplate"))
+
+		require.Nil(t, sm.DeleteClass(ctx, nil, "CustomerAcme"))
+		require.Nil(t, sm.DeleteClass(ctx, nil, "CustomerTemplate"))
+		assert.Len(t, sm.GetSchemaSkipAuth().Objects.Templates, 0)
+	})
+}
@@ -0,0 +1,295 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+// tenant names are used as shard names and therefore as part of file names
+var validateTenantNameRegex = regexp.MustCompile(`^[A-Za-z0-9\-_]{1,64}$`)
+
+// AddTenants to a class with multi-tenancy enabled. Every tenant is stored
+// in a shard of its own, tenants without an activity status are active.
+func (m *Manager) AddTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []*models.Tenant) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, state, err := m.multiTenantClass(className)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTenants(tenants); err != nil {
+		return err
+	}
+
+	updated := state.DeepCopy()
+	for _, tenant := range tenants {
+		if tenant.ActivityStatus == "" {
+			tenant.ActivityStatus = models.TenantActivityStatusHOT
+		}
+
+		if _, err := updated.AddPartition(tenant.Name, m.clusterState,
+			replicationFactor(class), tenant.ActivityStatus); err != nil {
+			return err
+		}
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, AddTenants,
+		TenantsPayload{className, tenants, updated})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.addTenantsApplyChanges(ctx, className, tenants, updated)
+}
+
+func (m *Manager) addTenantsApplyChanges(ctx context.Context, className string,
+	tenants []*models.Tenant, state *sharding.State) error {
+	m.state.ShardingState[className] = state
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	return m.migrator.AddTenants(ctx, className, tenants)
+}
+
+// GetTenants lists the tenants of a class with multi-tenancy enabled, sorted
+// by name
+func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal,
+	className string) ([]*models.Tenant, error) {
+	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	class := m.snapshotClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+
+	if !multiTenancyEnabled(class) {
+		return nil, errMultiTenancyDisabled(className)
+	}
+
+	state := m.ShardingState(className)
+	out := make([]*models.Tenant, 0, len(state.Physical))
+	for _, name := range state.AllPhysicalShards() {
+		out = append(out, &models.Tenant{
+			Name:           name,
+			ActivityStatus: state.Physical[name].ActivityStatus(),
+		})
+	}
+
+	return out, nil
+}
+
+// UpdateTenants changes the activity status of existing tenants. The shards
+// of inactive tenants are unloaded and cannot be queried until they are
+// activated again.
+func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []*models.Tenant) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	_, state, err := m.multiTenantClass(className)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTenants(tenants); err != nil {
+		return err
+	}
+
+	updated := state.DeepCopy()
+	for _, tenant := range tenants {
+		if tenant.ActivityStatus == "" {
+			return errortypes.New(errortypes.KindValidation,
+				"tenant %q: activity status is required", tenant.Name)
+		}
+
+		if !updated.SetPartitionStatus(tenant.Name, tenant.ActivityStatus) {
+			return errortypes.New(errortypes.KindValidation,
+				"tenant %q does not exist", tenant.Name)
+		}
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateTenants,
+		TenantsPayload{className, tenants, updated})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateTenantsApplyChanges(ctx, className, tenants, updated)
+}
+
+func (m *Manager) updateTenantsApplyChanges(ctx context.Context, className string,
+	tenants []*models.Tenant, state *sharding.State) error {
+	m.state.ShardingState[className] = state
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	return m.migrator.UpdateTenants(ctx, className, tenants)
+}
+
+// DeleteTenants removes tenants including all their data. Tenants which do
+// not exist are ignored.
+func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []string) error {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, _, err := m.multiTenantClass(className); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteTenants,
+		DeleteTenantsPayload{className, tenants})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.deleteTenantsApplyChanges(ctx, className, tenants)
+}
+
+func (m *Manager) deleteTenantsApplyChanges(ctx context.Context,
+	className string, tenants []string) error {
+	state, ok := m.state.ShardingState[className]
+	if !ok {
+		return ErrNotFound
+	}
+
+	// the shards of inactive tenants can only be found while the tenants are
+	// still part of the sharding state, so they are removed first
+	if err := m.migrator.DeleteTenants(ctx, className, tenants); err != nil {
+		return err
+	}
+
+	updated := state.DeepCopy()
+	for _, name := range tenants {
+		updated.DeletePartition(name)
+	}
+
+	m.state.ShardingState[className] = updated
+	return m.saveSchema(ctx)
+}
+
+// multiTenantClass returns the class and its sharding state from the working
+// copy, it must only be used while holding the lock
+func (m *Manager) multiTenantClass(className string) (*models.Class,
+	*sharding.State, error) {
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	if !multiTenancyEnabled(class) {
+		return nil, nil, errMultiTenancyDisabled(className)
+	}
+
+	state, ok := m.state.ShardingState[className]
+	if !ok {
+		return nil, nil, errors.Errorf("no sharding state for class %s", className)
+	}
+
+	return class, state, nil
+}
+
+func validateTenants(tenants []*models.Tenant) error {
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		if tenant == nil {
+			return errortypes.New(errortypes.KindValidation, "tenant must not be empty")
+		}
+
+		if !validateTenantNameRegex.MatchString(tenant.Name) {
+			return errortypes.New(errortypes.KindValidation,
+				"tenant name %q is invalid, it must match %s", tenant.Name,
+				validateTenantNameRegex.String())
+		}
+
+		switch tenant.ActivityStatus {
+		case "", models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD:
+		default:
+			return errortypes.New(errortypes.KindValidation,
+				"tenant %q: invalid activity status %q", tenant.Name,
+				tenant.ActivityStatus)
+		}
+
+		names = append(names, tenant.Name)
+	}
+
+	sort.Strings(names)
+	for i := 1; i < len(names); i++ {
+		if names[i] == names[i-1] {
+			return errortypes.New(errortypes.KindValidation,
+				"tenant %q is specified more than once", names[i])
+		}
+	}
+
+	return nil
+}
+
+func multiTenancyEnabled(class *models.Class) bool {
+	return class.MultiTenancyConfig != nil && class.MultiTenancyConfig.Enabled
+}
+
+func errMultiTenancyDisabled(className string) error {
+	return errortypes.New(errortypes.KindValidation,
+		"multi-tenancy is not enabled for class %s", className)
+}
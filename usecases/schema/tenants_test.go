@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenants(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) (*Manager, *tenantsMigrator) {
+		logger, _ := test.NewNullLogger()
+		migrator := &tenantsMigrator{}
+		sm, err := NewManager(migrator, newFakeRepo(), logger, &fakeAuthorizer{},
+			config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+			dummyParseVectorConfig, &fakeVectorizerValidator{},
+			&fakeModuleConfig{}, &fakeClusterState{}, &fakeTxClient{})
+		require.Nil(t, err)
+
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:              "MultiTenant",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		}))
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Regular"}))
+
+		return sm, migrator
+	}
+
+	t.Run("a multi-tenant class starts without shards", func(t *testing.T) {
+		sm, _ := newManager(t)
+
+		state := sm.ShardingState("MultiTenant")
+		assert.True(t, state.PartitioningEnabled)
+		assert.Len(t, state.AllPhysicalShards(), 0)
+		assert.False(t, sm.ShardingState("Regular").PartitioningEnabled)
+	})
+
+	t.Run("adding, listing, updating and deleting tenants", func(t *testing.T) {
+		sm, migrator := newManager(t)
+
+		err := sm.AddTenants(ctx, nil, "MultiTenant", []*models.Tenant{
+			{Name: "tenant1"},
+			{Name: "tenant2", ActivityStatus: models.TenantActivityStatusCOLD},
+		})
+		require.Nil(t, err)
+		assert.Len(t, migrator.added, 2)
+
+		tenants, err := sm.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Tenant{
+			{Name: "tenant1", ActivityStatus: models.TenantActivityStatusHOT},
+			{Name: "tenant2", ActivityStatus: models.TenantActivityStatusCOLD},
+		}, tenants)
+
+		err = sm.UpdateTenants(ctx, nil, "MultiTenant", []*models.Tenant{
+			{Name: "tenant1", ActivityStatus: models.TenantActivityStatusCOLD},
+		})
+		require.Nil(t, err)
+		assert.Len(t, migrator.updated, 1)
+		assert.NotNil(t, sm.ShardingState("MultiTenant").ValidateTenant("tenant1"))
+
+		err = sm.DeleteTenants(ctx, nil, "MultiTenant", []string{"tenant2"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"tenant2"}, migrator.deleted)
+		assert.Equal(t, []string{"tenant1"},
+			sm.ShardingState("MultiTenant").AllPhysicalShards())
+	})
+
+	t.Run("published snapshots are not modified", func(t *testing.T) {
+		sm, _ := newManager(t)
+
+		before := sm.ShardingState("MultiTenant")
+		err := sm.AddTenants(ctx, nil, "MultiTenant", []*models.Tenant{{Name: "tenant1"}})
+		require.Nil(t, err)
+
+		assert.Len(t, before.Physical, 0)
+		assert.Len(t, sm.ShardingState("MultiTenant").Physical, 1)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		sm, _ := newManager(t)
+		require.Nil(t, sm.AddTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "existing"}}))
+
+		tests := []struct {
+			name      string
+			className string
+			tenants   []*models.Tenant
+		}{
+			{"class without multi-tenancy", "Regular", []*models.Tenant{{Name: "t"}}},
+			{"invalid name", "MultiTenant", []*models.Tenant{{Name: "foo/bar"}}},
+			{"empty name", "MultiTenant", []*models.Tenant{{Name: ""}}},
+			{"duplicate name", "MultiTenant", []*models.Tenant{{Name: "t"}, {Name: "t"}}},
+			{"existing tenant", "MultiTenant", []*models.Tenant{{Name: "existing"}}},
+			{"invalid status", "MultiTenant", []*models.Tenant{{Name: "t", ActivityStatus: "WARM"}}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := sm.AddTenants(ctx, nil, test.className, test.tenants)
+				require.NotNil(t, err)
+				assert.NotEqual(t, errortypes.KindInternal, errortypes.KindOf(err))
+			})
+		}
+
+		err := sm.UpdateTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "unknown", ActivityStatus: models.TenantActivityStatusHOT}})
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+
+		err = sm.AddTenants(ctx, nil, "Unknown", []*models.Tenant{{Name: "t"}})
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("multi-tenancy cannot be changed on an existing class", func(t *testing.T) {
+		sm, _ := newManager(t)
+
+		class, err := sm.GetClass(ctx, nil, "Regular")
+		require.Nil(t, err)
+		updated := *class
+		updated.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+
+		err = sm.UpdateClass(ctx, nil, "Regular", &updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "multi-tenancy config is immutable")
+	})
+}
+
+type tenantsMigrator struct {
+	NilMigrator
+	added   []*models.Tenant
+	updated []*models.Tenant
+	deleted []string
+}
+
+func (m *tenantsMigrator) AddTenants(ctx context.Context, className string,
+	tenants []*models.Tenant) error {
+	m.added = append(m.added, tenants...)
+	return nil
+}
+
+func (m *tenantsMigrator) UpdateTenants(ctx context.Context, className string,
+	tenants []*models.Tenant) error {
+	m.updated = append(m.updated, tenants...)
+	return nil
+}
+
+func (m *tenantsMigrator) DeleteTenants(ctx context.Context, className string,
+	tenants []string) error {
+	m.deleted = append(m.deleted, tenants...)
+	return nil
+}
@@ -25,6 +25,12 @@ const (
 	AddProperty cluster.TransactionType = "add_property"
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
+
+	AddTenants    cluster.TransactionType = "add_tenants"
+	UpdateTenants cluster.TransactionType = "update_tenants"
+	DeleteTenants cluster.TransactionType = "delete_tenants"
+
+	UpdateShardReplicas cluster.TransactionType = "update_shard_replicas"
 )
 
 type AddClassPayload struct {
@@ -51,6 +57,26 @@ type UpdateClassPayload struct {
 	State *sharding.State `json:"state"`
 }
 
+// TenantsPayload carries the sharding state including the placement of the
+// tenants, so that every node ends up with the same state
+type TenantsPayload struct {
+	ClassName string           `json:"className"`
+	Tenants   []*models.Tenant `json:"tenants"`
+	State     *sharding.State  `json:"state"`
+}
+
+type DeleteTenantsPayload struct {
+	ClassName string   `json:"className"`
+	Tenants   []string `json:"tenants"`
+}
+
+// ShardReplicasPayload carries the sharding state after replicas of shards
+// were moved between nodes
+type ShardReplicasPayload struct {
+	ClassName string          `json:"className"`
+	State     *sharding.State `json:"state"`
+}
+
 func UnmarshalTransaction(txType cluster.TransactionType,
 	payload json.RawMessage) (interface{}, error) {
 	switch txType {
@@ -66,6 +92,15 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 	case UpdateClass:
 		return unmarshalUpdateClass(payload)
 
+	case AddTenants, UpdateTenants:
+		return unmarshalTenants(payload)
+
+	case DeleteTenants:
+		return unmarshalDeleteTenants(payload)
+
+	case UpdateShardReplicas:
+		return unmarshalShardReplicas(payload)
+
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
 
@@ -107,3 +142,30 @@ func unmarshalUpdateClass(payload json.RawMessage) (interface{}, error) {
 
 	return pl, nil
 }
+
+func unmarshalTenants(payload json.RawMessage) (interface{}, error) {
+	var pl TenantsPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalDeleteTenants(payload json.RawMessage) (interface{}, error) {
+	var pl DeleteTenantsPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalShardReplicas(payload json.RawMessage) (interface{}, error) {
+	var pl ShardReplicasPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
@@ -63,6 +63,15 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "sharding config")
 	}
 
+	if err := sharding.ValidateReplicationFactorUpdate(replicationFactor(initial),
+		replicationFactor(updated)); err != nil {
+		return errors.Wrap(err, "replication config")
+	}
+
+	if err := m.validateShadowConfig(updated); err != nil {
+		return err
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
 		UpdateClassPayload{className, updated, nil})
 	if err != nil {
@@ -135,6 +144,10 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if multiTenancyEnabled(initial) != multiTenancyEnabled(updated) {
+		return errors.Errorf("multi-tenancy config is immutable")
+	}
+
 	return nil
 }
 
@@ -14,6 +14,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -28,6 +29,10 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 		}
 	}
 
+	if m.templateByName(className) != nil {
+		return fmt.Errorf("Name '%s' already used as a name for a template", className)
+	}
+
 	return nil
 }
 
@@ -58,6 +63,252 @@ func (m *Manager) validateReservedPropertyName(propertyName string) error {
 	return schema.ValidateReservedPropertyName(propertyName)
 }
 
+// validatePropertyTokenization makes sure that a tokenization is only set for
+// string and text props, as those are the only ones that are tokenized
+func validatePropertyTokenization(property *models.Property) error {
+	if property.Tokenization == "" {
+		return nil
+	}
+
+	switch property.Tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationLowercase,
+		models.PropertyTokenizationWhitespace, models.PropertyTokenizationField:
+	default:
+		return errors.Errorf("property '%s': unsupported tokenization %q",
+			property.Name, property.Tokenization)
+	}
+
+	switch schema.DataType(property.DataType[0]) {
+	case schema.DataTypeString, schema.DataTypeText,
+		schema.DataTypeStringArray, schema.DataTypeTextArray:
+		return nil
+	default:
+		return errors.Errorf("property '%s': tokenization is only supported for "+
+			"string and text properties, got dataType %q", property.Name,
+			property.DataType[0])
+	}
+}
+
+// validatePropertyInvertedIndexStorage makes sure that the shared inverted
+// index storage is only used for props with very few distinct values, i.e.
+// booleans and strings which are indexed as a whole. Each value of such a prop
+// is a single key in the shared bucket, which would not scale to tokenized
+// text with a large vocabulary.
+func validatePropertyInvertedIndexStorage(property *models.Property) error {
+	switch property.InvertedIndexStorage {
+	case "", models.PropertyInvertedIndexStorageDedicated:
+		return nil
+	case models.PropertyInvertedIndexStorageShared:
+	default:
+		return errors.Errorf("property '%s': unsupported invertedIndexStorage %q",
+			property.Name, property.InvertedIndexStorage)
+	}
+
+	if property.IndexInverted != nil && !*property.IndexInverted {
+		return errors.Errorf("property '%s': invertedIndexStorage %q requires "+
+			"the property to be indexed", property.Name, property.InvertedIndexStorage)
+	}
+
+	switch schema.DataType(property.DataType[0]) {
+	case schema.DataTypeBoolean, schema.DataTypeBooleanArray:
+		return nil
+	case schema.DataTypeString, schema.DataTypeStringArray:
+		if property.Tokenization == models.PropertyTokenizationField {
+			return nil
+		}
+		return errors.Errorf("property '%s': invertedIndexStorage %q requires "+
+			"string properties to use tokenization %q", property.Name,
+			property.InvertedIndexStorage, models.PropertyTokenizationField)
+	default:
+		return errors.Errorf("property '%s': invertedIndexStorage %q is only "+
+			"supported for boolean and string properties, got dataType %q",
+			property.Name, property.InvertedIndexStorage, property.DataType[0])
+	}
+}
+
+// validateCompositeIndexes makes sure that each composite index combines at
+// least two distinct props of the class, which are indexed and of a primitive
+// data type that can be matched with the Equal operator
+func validateCompositeIndexes(class *models.Class) error {
+	if class.InvertedIndexConfig == nil {
+		return nil
+	}
+
+	propsByName := map[string]*models.Property{}
+	for _, prop := range class.Properties {
+		propsByName[prop.Name] = prop
+	}
+
+	for i, composite := range class.InvertedIndexConfig.CompositeIndexes {
+		if len(composite) < 2 {
+			return errors.Errorf("composite index %d: must combine at least two properties", i)
+		}
+
+		seen := map[string]struct{}{}
+		for _, propName := range composite {
+			if _, ok := seen[propName]; ok {
+				return errors.Errorf("composite index %d: property %q is listed more than once",
+					i, propName)
+			}
+			seen[propName] = struct{}{}
+
+			prop, ok := propsByName[propName]
+			if !ok {
+				return errors.Errorf("composite index %d: no such property %q", i, propName)
+			}
+
+			if prop.IndexInverted != nil && !*prop.IndexInverted {
+				return errors.Errorf("composite index %d: property %q is not indexed",
+					i, propName)
+			}
+
+			switch schema.DataType(prop.DataType[0]) {
+			case schema.DataTypeString, schema.DataTypeText, schema.DataTypeInt,
+				schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
+			default:
+				return errors.Errorf("composite index %d: property %q has unsupported "+
+					"data type %q", i, propName, prop.DataType[0])
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateContinuousAggregates makes sure that each continuous aggregate has
+// a unique name, is grouped by at most one primitive prop and only sums up
+// numeric props of the class
+func validateContinuousAggregates(class *models.Class) error {
+	if class.InvertedIndexConfig == nil {
+		return nil
+	}
+
+	propsByName := map[string]*models.Property{}
+	for _, prop := range class.Properties {
+		propsByName[prop.Name] = prop
+	}
+
+	names := map[string]struct{}{}
+	for i, agg := range class.InvertedIndexConfig.ContinuousAggregates {
+		if agg == nil || agg.Name == "" {
+			return errors.Errorf("continuous aggregate %d: name is required", i)
+		}
+
+		if _, ok := names[agg.Name]; ok {
+			return errors.Errorf("continuous aggregate %q: name is used more than once",
+				agg.Name)
+		}
+		names[agg.Name] = struct{}{}
+
+		if agg.GroupBy != "" {
+			prop, ok := propsByName[agg.GroupBy]
+			if !ok {
+				return errors.Errorf("continuous aggregate %q: no such property %q",
+					agg.Name, agg.GroupBy)
+			}
+
+			switch schema.DataType(prop.DataType[0]) {
+			case schema.DataTypeString, schema.DataTypeText, schema.DataTypeInt,
+				schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
+			default:
+				return errors.Errorf("continuous aggregate %q: can not group by property %q "+
+					"of data type %q", agg.Name, agg.GroupBy, prop.DataType[0])
+			}
+		}
+
+		seen := map[string]struct{}{}
+		for _, propName := range agg.Sum {
+			if _, ok := seen[propName]; ok {
+				return errors.Errorf("continuous aggregate %q: property %q is summed "+
+					"more than once", agg.Name, propName)
+			}
+			seen[propName] = struct{}{}
+
+			prop, ok := propsByName[propName]
+			if !ok {
+				return errors.Errorf("continuous aggregate %q: no such property %q",
+					agg.Name, propName)
+			}
+
+			switch schema.DataType(prop.DataType[0]) {
+			case schema.DataTypeInt, schema.DataTypeNumber:
+			default:
+				return errors.Errorf("continuous aggregate %q: can not sum property %q "+
+					"of data type %q", agg.Name, propName, prop.DataType[0])
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateShadowConfig makes sure the traffic of the class is mirrored to
+// exactly one place. A local shadow class must exist and may not shadow
+// another class itself, so mirrored writes can never go in circles.
+func (m *Manager) validateShadowConfig(class *models.Class) error {
+	cfg := class.ShadowConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.ReadSampleRate < 0 || cfg.ReadSampleRate > 1 {
+		return errors.Errorf("shadowConfig: readSampleRate must be between 0 and 1, got %v",
+			cfg.ReadSampleRate)
+	}
+
+	if multiTenancyEnabled(class) {
+		return errors.Errorf("shadowConfig: classes with multi-tenancy cannot be shadowed")
+	}
+
+	if cfg.Endpoint != "" {
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("shadowConfig: endpoint must be an http or https URL, got %q",
+				cfg.Endpoint)
+		}
+
+		if cfg.ReadSampleRate > 0 {
+			return errors.Errorf("shadowConfig: reads can only be mirrored to a class of " +
+				"this instance, not to an endpoint")
+		}
+
+		return nil
+	}
+
+	if cfg.Class == "" {
+		return errors.Errorf("shadowConfig: either class or endpoint must be set")
+	}
+
+	if cfg.Class == class.Class {
+		return errors.Errorf("shadowConfig: class %q cannot shadow itself", class.Class)
+	}
+
+	target := m.getClassByName(cfg.Class)
+	if target == nil {
+		return errors.Errorf("shadowConfig: no such class %q", cfg.Class)
+	}
+
+	if target.ShadowConfig != nil {
+		return errors.Errorf("shadowConfig: class %q is shadowed itself and cannot "+
+			"be used as a shadow class", cfg.Class)
+	}
+
+	if multiTenancyEnabled(target) {
+		return errors.Errorf("shadowConfig: class %q has multi-tenancy and cannot be "+
+			"used as a shadow class", cfg.Class)
+	}
+
+	for _, other := range m.state.SchemaFor().Classes {
+		if other.Class != class.Class && other.ShadowConfig != nil &&
+			other.ShadowConfig.Endpoint == "" && other.ShadowConfig.Class == class.Class {
+			return errors.Errorf("shadowConfig: class %q is the shadow class of %q and "+
+				"cannot be shadowed itself", class.Class, other.Class)
+		}
+	}
+
+	return nil
+}
+
 func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Class) error {
 	if err := m.validateVectorizer(ctx, class); err != nil {
 		return err
@@ -234,6 +234,21 @@ func Test_Validation_PropertyNames(t *testing.T) {
 			input: "_additional",
 			valid: false,
 		},
+		{
+			name:  "reserved prop name: _creationTimeUnix",
+			input: "_creationTimeUnix",
+			valid: false,
+		},
+		{
+			name:  "reserved prop name: _lastUpdateTimeUnix",
+			input: "_lastUpdateTimeUnix",
+			valid: false,
+		},
+		{
+			name:  "reserved prop name: _shared",
+			input: "_shared",
+			valid: false,
+		},
 	}
 
 	t.Run("when adding a new class", func(t *testing.T) {
@@ -368,3 +383,438 @@ func Test_Validation_PropertyNames(t *testing.T) {
 		})
 	})
 }
+
+func Test_Validation_CompositeIndexes(t *testing.T) {
+	noIndex := false
+	props := func() []*models.Property {
+		return []*models.Property{
+			{Name: "tenant", DataType: []string{"string"}},
+			{Name: "status", DataType: []string{"string"}},
+			{Name: "priority", DataType: []string{"int"}},
+			{Name: "tags", DataType: []string{"string[]"}},
+			{Name: "notIndexed", DataType: []string{"string"}, IndexInverted: &noIndex},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		composites [][]string
+		valid      bool
+		storedAs   [][]string
+	}{
+		{
+			name:       "two props",
+			composites: [][]string{{"tenant", "status"}},
+			valid:      true,
+			storedAs:   [][]string{{"tenant", "status"}},
+		},
+		{
+			name:       "three props of different types",
+			composites: [][]string{{"tenant", "status", "priority"}},
+			valid:      true,
+			storedAs:   [][]string{{"tenant", "status", "priority"}},
+		},
+		{
+			name:       "uppercase prop names, stored as lowercase",
+			composites: [][]string{{"Tenant", "Status"}},
+			valid:      true,
+			storedAs:   [][]string{{"tenant", "status"}},
+		},
+		{
+			name:       "a single prop",
+			composites: [][]string{{"tenant"}},
+			valid:      false,
+		},
+		{
+			name:       "the same prop twice",
+			composites: [][]string{{"tenant", "tenant"}},
+			valid:      false,
+		},
+		{
+			name:       "a non-existing prop",
+			composites: [][]string{{"tenant", "carrot"}},
+			valid:      false,
+		},
+		{
+			name:       "a prop which is not indexed",
+			composites: [][]string{{"tenant", "notIndexed"}},
+			valid:      false,
+		},
+		{
+			name:       "an array prop",
+			composites: [][]string{{"tenant", "tags"}},
+			valid:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer: "text2vec-contextionary",
+				Class:      "ValidName",
+				Properties: props(),
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					CompositeIndexes: test.composites,
+				},
+			}
+
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, class)
+			t.Log(err)
+			assert.Equal(t, test.valid, err == nil)
+
+			if !test.valid {
+				return
+			}
+
+			schema, _ := m.GetSchema(nil)
+			assert.Equal(t, test.storedAs,
+				schema.Objects.Classes[0].InvertedIndexConfig.CompositeIndexes)
+		})
+	}
+}
+
+func Test_Validation_ContinuousAggregates(t *testing.T) {
+	props := func() []*models.Property {
+		return []*models.Property{
+			{Name: "category", DataType: []string{"string"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "rating", DataType: []string{"number"}},
+			{Name: "tags", DataType: []string{"string[]"}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		aggregates []*models.ContinuousAggregate
+		valid      bool
+		storedAs   []*models.ContinuousAggregate
+	}{
+		{
+			name: "count and sums per group",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "byCategory", GroupBy: "category", Sum: []string{"wordCount", "rating"}},
+			},
+			valid: true,
+			storedAs: []*models.ContinuousAggregate{
+				{Name: "byCategory", GroupBy: "category", Sum: []string{"wordCount", "rating"}},
+			},
+		},
+		{
+			name:       "count without a group",
+			aggregates: []*models.ContinuousAggregate{{Name: "total"}},
+			valid:      true,
+			storedAs:   []*models.ContinuousAggregate{{Name: "total"}},
+		},
+		{
+			name: "uppercase prop names, stored as lowercase",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "byCategory", GroupBy: "Category", Sum: []string{"WordCount"}},
+			},
+			valid: true,
+			storedAs: []*models.ContinuousAggregate{
+				{Name: "byCategory", GroupBy: "category", Sum: []string{"wordCount"}},
+			},
+		},
+		{
+			name:       "without a name",
+			aggregates: []*models.ContinuousAggregate{{GroupBy: "category"}},
+			valid:      false,
+		},
+		{
+			name: "the same name twice",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "total"}, {Name: "total", GroupBy: "category"},
+			},
+			valid: false,
+		},
+		{
+			name: "grouped by a non-existing prop",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "byCarrot", GroupBy: "carrot"},
+			},
+			valid: false,
+		},
+		{
+			name: "grouped by an array prop",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "byTag", GroupBy: "tags"},
+			},
+			valid: false,
+		},
+		{
+			name: "summing a text prop",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "total", Sum: []string{"category"}},
+			},
+			valid: false,
+		},
+		{
+			name: "summing the same prop twice",
+			aggregates: []*models.ContinuousAggregate{
+				{Name: "total", Sum: []string{"wordCount", "wordCount"}},
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer: "text2vec-contextionary",
+				Class:      "ValidName",
+				Properties: props(),
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					ContinuousAggregates: test.aggregates,
+				},
+			}
+
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, class)
+			t.Log(err)
+			assert.Equal(t, test.valid, err == nil)
+
+			if !test.valid {
+				return
+			}
+
+			schema, _ := m.GetSchema(nil)
+			assert.Equal(t, test.storedAs,
+				schema.Objects.Classes[0].InvertedIndexConfig.ContinuousAggregates)
+		})
+	}
+}
+
+func Test_Validation_PropertyTokenization(t *testing.T) {
+	tests := []struct {
+		name         string
+		dataType     string
+		tokenization string
+		valid        bool
+	}{
+		{name: "no tokenization", dataType: "string", tokenization: "", valid: true},
+		{name: "word on text", dataType: "text", tokenization: "word", valid: true},
+		{name: "field on string", dataType: "string", tokenization: "field", valid: true},
+		{name: "lowercase on string array", dataType: "string[]", tokenization: "lowercase", valid: true},
+		{name: "whitespace on text array", dataType: "text[]", tokenization: "whitespace", valid: true},
+		{name: "unknown tokenization", dataType: "string", tokenization: "carrot", valid: false},
+		{name: "tokenization on int", dataType: "int", tokenization: "field", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prop := func() *models.Property {
+				return &models.Property{
+					Name:         "someProp",
+					DataType:     []string{test.dataType},
+					Tokenization: test.tokenization,
+				}
+			}
+
+			t.Run("when adding a new class", func(t *testing.T) {
+				class := &models.Class{
+					Vectorizer: "text2vec-contextionary",
+					Class:      "ValidName",
+					Properties: []*models.Property{prop()},
+				}
+
+				m := newSchemaManager()
+				err := m.AddClass(context.Background(), nil, class)
+				t.Log(err)
+				assert.Equal(t, test.valid, err == nil)
+			})
+
+			t.Run("when adding a property to an existing class", func(t *testing.T) {
+				class := &models.Class{
+					Vectorizer: "text2vec-contextionary",
+					Class:      "ValidName",
+					Properties: []*models.Property{
+						{
+							Name:     "dummyPropSoWeDontRunIntoAllNoindexedError",
+							DataType: []string{"string"},
+						},
+					},
+				}
+
+				m := newSchemaManager()
+				err := m.AddClass(context.Background(), nil, class)
+				require.Nil(t, err)
+
+				err = m.AddClassProperty(context.Background(), nil, "ValidName", prop())
+				t.Log(err)
+				assert.Equal(t, test.valid, err == nil)
+			})
+		})
+	}
+}
+
+func Test_Validation_PropertyInvertedIndexStorage(t *testing.T) {
+	tests := []struct {
+		name         string
+		dataType     string
+		tokenization string
+		storage      string
+		valid        bool
+	}{
+		{name: "no storage", dataType: "text", storage: "", valid: true},
+		{name: "dedicated on text", dataType: "text", storage: "dedicated", valid: true},
+		{name: "shared on boolean", dataType: "boolean", storage: "shared", valid: true},
+		{name: "shared on boolean array", dataType: "boolean[]", storage: "shared", valid: true},
+		{name: "shared on field string", dataType: "string", tokenization: "field", storage: "shared", valid: true},
+		{name: "shared on field string array", dataType: "string[]", tokenization: "field", storage: "shared", valid: true},
+		{name: "shared on word string", dataType: "string", tokenization: "word", storage: "shared", valid: false},
+		{name: "shared on text", dataType: "text", tokenization: "field", storage: "shared", valid: false},
+		{name: "shared on int", dataType: "int", storage: "shared", valid: false},
+		{name: "unknown storage", dataType: "boolean", storage: "carrot", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prop := func() *models.Property {
+				return &models.Property{
+					Name:                 "someProp",
+					DataType:             []string{test.dataType},
+					Tokenization:         test.tokenization,
+					InvertedIndexStorage: test.storage,
+				}
+			}
+
+			t.Run("when adding a new class", func(t *testing.T) {
+				class := &models.Class{
+					Vectorizer: "text2vec-contextionary",
+					Class:      "ValidName",
+					Properties: []*models.Property{
+						{
+							Name:     "dummyPropSoWeDontRunIntoAllNoindexedError",
+							DataType: []string{"string"},
+						},
+						prop(),
+					},
+				}
+
+				m := newSchemaManager()
+				err := m.AddClass(context.Background(), nil, class)
+				t.Log(err)
+				assert.Equal(t, test.valid, err == nil)
+			})
+
+			t.Run("when adding a property to an existing class", func(t *testing.T) {
+				class := &models.Class{
+					Vectorizer: "text2vec-contextionary",
+					Class:      "ValidName",
+					Properties: []*models.Property{
+						{
+							Name:     "dummyPropSoWeDontRunIntoAllNoindexedError",
+							DataType: []string{"string"},
+						},
+					},
+				}
+
+				m := newSchemaManager()
+				err := m.AddClass(context.Background(), nil, class)
+				require.Nil(t, err)
+
+				err = m.AddClassProperty(context.Background(), nil, "ValidName", prop())
+				t.Log(err)
+				assert.Equal(t, test.valid, err == nil)
+			})
+		})
+	}
+}
+
+func Test_Validation_ShadowConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *models.ShadowConfig
+		valid  bool
+	}{
+		{name: "local class", config: &models.ShadowConfig{Class: "Candidate"}, valid: true},
+		{
+			name:   "local class with sampled reads",
+			config: &models.ShadowConfig{Class: "Candidate", ReadSampleRate: 0.1},
+			valid:  true,
+		},
+		{name: "remote endpoint", config: &models.ShadowConfig{Endpoint: "http://shadow:8080"}, valid: true},
+		{
+			name:   "remote endpoint with another class",
+			config: &models.ShadowConfig{Endpoint: "https://shadow", Class: "Other"},
+			valid:  true,
+		},
+		{name: "neither class nor endpoint", config: &models.ShadowConfig{}, valid: false},
+		{name: "unknown class", config: &models.ShadowConfig{Class: "Unknown"}, valid: false},
+		{name: "itself", config: &models.ShadowConfig{Class: "Production"}, valid: false},
+		{name: "invalid endpoint", config: &models.ShadowConfig{Endpoint: "shadow:8080"}, valid: false},
+		{
+			name:   "sampled reads to an endpoint",
+			config: &models.ShadowConfig{Endpoint: "http://shadow:8080", ReadSampleRate: 0.5},
+			valid:  false,
+		},
+		{
+			name:   "sample rate out of range",
+			config: &models.ShadowConfig{Class: "Candidate", ReadSampleRate: 1.5},
+			valid:  false,
+		},
+	}
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		err := m.AddClass(context.Background(), nil, &models.Class{
+			Class:      "Candidate",
+			Vectorizer: "model2",
+		})
+		require.Nil(t, err)
+		return m
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newManager(t)
+			err := m.AddClass(context.Background(), nil, &models.Class{
+				Class:        "Production",
+				Vectorizer:   "model1",
+				ShadowConfig: test.config,
+			})
+			t.Log(err)
+			assert.Equal(t, test.valid, err == nil)
+		})
+	}
+
+	t.Run("a shadow class cannot be shadowed itself", func(t *testing.T) {
+		m := newManager(t)
+		err := m.AddClass(context.Background(), nil, &models.Class{
+			Class:        "Production",
+			ShadowConfig: &models.ShadowConfig{Class: "Candidate"},
+		})
+		require.Nil(t, err)
+
+		err = m.AddClass(context.Background(), nil, &models.Class{
+			Class: "Third",
+		})
+		require.Nil(t, err)
+
+		err = m.UpdateClass(context.Background(), nil, "Candidate", &models.Class{
+			Class:        "Candidate",
+			Vectorizer:   "model2",
+			ShadowConfig: &models.ShadowConfig{Class: "Third"},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "cannot be shadowed itself")
+
+		err = m.AddClass(context.Background(), nil, &models.Class{
+			Class:        "Fourth",
+			ShadowConfig: &models.ShadowConfig{Class: "Production"},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "cannot be used as a shadow class")
+	})
+
+	t.Run("classes with multi-tenancy cannot be shadowed", func(t *testing.T) {
+		m := newManager(t)
+		err := m.AddClass(context.Background(), nil, &models.Class{
+			Class:              "Production",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			ShadowConfig:       &models.ShadowConfig{Class: "Candidate"},
+		})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// CleanupVectorIndex removes the tombstones of deleted vectors from the vector
+// index of a shard right away instead of waiting for the periodic cleanup
+// configured by cleanupIntervalSeconds. The cleanup runs on this node only, so
+// the shard must be located here.
+func (m *Manager) CleanupVectorIndex(ctx context.Context, principal *models.Principal,
+	className, shardName string) (*models.VectorIndexCleanupResponse, error) {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if m.snapshotClassByName(className) == nil {
+		return nil, ErrNotFound
+	}
+
+	state := m.ShardingState(className)
+	physical, ok := state.Physical[shardName]
+	if !ok {
+		return nil, errortypes.New(errortypes.KindNotFound,
+			"class %s has no shard %q", className, shardName)
+	}
+
+	if !state.IsShardLocal(shardName) {
+		return nil, errortypes.New(errortypes.KindValidation,
+			"shard %q is not located on this node, but on %v", shardName,
+			physical.Nodes())
+	}
+
+	return m.migrator.CleanupVectorIndex(ctx, className, shardName)
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanupVectorIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	migrator := &cleanupMigrator{}
+	sm, err := NewManager(migrator, newFakeRepo(), logger, &fakeAuthorizer{},
+		config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+		dummyParseVectorConfig, &fakeVectorizerValidator{},
+		&fakeModuleConfig{}, &fakeClusterState{}, &fakeTxClient{})
+	require.Nil(t, err)
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Regular"}))
+
+	shards := sm.ShardingState("Regular").AllPhysicalShards()
+	require.Len(t, shards, 1)
+
+	t.Run("cleaning up a local shard", func(t *testing.T) {
+		res, err := sm.CleanupVectorIndex(ctx, nil, "Regular", shards[0])
+		require.Nil(t, err)
+		assert.Equal(t, &models.VectorIndexCleanupResponse{
+			TombstonesBefore: 7,
+		}, res)
+		assert.Equal(t, []string{shards[0]}, migrator.cleanedUp)
+	})
+
+	t.Run("cleaning up a shard of a non-existing class", func(t *testing.T) {
+		_, err := sm.CleanupVectorIndex(ctx, nil, "Missing", shards[0])
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("cleaning up a non-existing shard", func(t *testing.T) {
+		_, err := sm.CleanupVectorIndex(ctx, nil, "Regular", "missing")
+		require.NotNil(t, err)
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+
+	t.Run("cleaning up a shard on another node", func(t *testing.T) {
+		state := sm.state.ShardingState["Regular"].DeepCopy()
+		physical := state.Physical[shards[0]]
+		physical.BelongsToNode = "node2"
+		physical.BelongsToNodes = []string{"node2"}
+		state.Physical[shards[0]] = physical
+		sm.state.ShardingState["Regular"] = state
+		sm.publishSnapshot()
+
+		_, err := sm.CleanupVectorIndex(ctx, nil, "Regular", shards[0])
+		require.NotNil(t, err)
+		assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+		assert.Len(t, migrator.cleanedUp, 1)
+	})
+}
+
+type cleanupMigrator struct {
+	NilMigrator
+	cleanedUp []string
+}
+
+func (m *cleanupMigrator) CleanupVectorIndex(ctx context.Context, className,
+	shardName string) (*models.VectorIndexCleanupResponse, error) {
+	m.cleanedUp = append(m.cleanedUp, shardName)
+	return &models.VectorIndexCleanupResponse{TombstonesBefore: 7}, nil
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/cluster"
+)
+
+// InitPartitionedState creates the state of a class with multi-tenancy
+// enabled. Such a class has no virtual shards, instead each tenant is a
+// physical shard of its own, named after the tenant. The state starts out
+// empty, tenants are added with AddPartition.
+func InitPartitionedState(id string, config Config, nodes nodes,
+	replicationFactor int) (*State, error) {
+	if err := ValidateReplicationFactor(replicationFactor,
+		len(nodes.AllNames())); err != nil {
+		return nil, err
+	}
+
+	return &State{
+		Config:              config,
+		IndexID:             id,
+		PhysicalID:          generatePhysicalID(),
+		PartitioningEnabled: true,
+		Physical:            map[string]Physical{},
+		localNodeName:       nodes.LocalName(),
+	}, nil
+}
+
+// ActivityStatus of the tenant the shard holds, shards created without a
+// status are active
+func (p Physical) ActivityStatus() string {
+	if p.Status == "" {
+		return models.TenantActivityStatusHOT
+	}
+
+	return p.Status
+}
+
+// AddPartition adds the shard of a new tenant, its replicas are placed on
+// replicationFactor consecutive nodes starting at a random one
+func (s *State) AddPartition(name string, nodes nodes, replicationFactor int,
+	status string) (Physical, error) {
+	if _, ok := s.Physical[name]; ok {
+		return Physical{}, errortypes.New(errortypes.KindConflict,
+			"tenant %q already exists", name)
+	}
+
+	if err := ValidateReplicationFactor(replicationFactor,
+		len(nodes.AllNames())); err != nil {
+		return Physical{}, err
+	}
+
+	it, err := cluster.NewNodeIterator(nodes, cluster.StartRandom)
+	if err != nil {
+		return Physical{}, err
+	}
+
+	owners := make([]string, replicationFactor)
+	for i := range owners {
+		owners[i] = it.Next()
+	}
+
+	p := Physical{
+		Name:           name,
+		BelongsToNode:  owners[0],
+		BelongsToNodes: owners,
+		Status:         status,
+	}
+	s.Physical[name] = p
+
+	return p, nil
+}
+
+// DeletePartition removes the shard of a tenant, it returns false if the
+// tenant does not exist
+func (s *State) DeletePartition(name string) bool {
+	if _, ok := s.Physical[name]; !ok {
+		return false
+	}
+
+	delete(s.Physical, name)
+	return true
+}
+
+// SetPartitionStatus changes the activity status of a tenant, it returns
+// false if the tenant does not exist
+func (s *State) SetPartitionStatus(name, status string) bool {
+	p, ok := s.Physical[name]
+	if !ok {
+		return false
+	}
+
+	p.Status = status
+	s.Physical[name] = p
+	return true
+}
+
+// ValidateTenant checks that a request for the specified tenant can be
+// served. Classes with multi-tenancy require an existing and active tenant,
+// all other classes must not be used with a tenant.
+func (s *State) ValidateTenant(tenant string) error {
+	if !s.PartitioningEnabled {
+		if tenant != "" {
+			return errortypes.New(errortypes.KindValidation,
+				"class %s does not have multi-tenancy enabled, but request "+
+					"was made with tenant %q", s.IndexID, tenant)
+		}
+		return nil
+	}
+
+	if tenant == "" {
+		return errortypes.New(errortypes.KindValidation,
+			"class %s has multi-tenancy enabled, but request was made "+
+				"without a tenant", s.IndexID)
+	}
+
+	p, ok := s.Physical[tenant]
+	if !ok {
+		return errortypes.New(errortypes.KindValidation,
+			"tenant %q does not exist for class %s", tenant, s.IndexID)
+	}
+
+	if p.ActivityStatus() != models.TenantActivityStatusHOT {
+		return errortypes.New(errortypes.KindValidation,
+			"tenant %q of class %s is not active, its status is %s", tenant,
+			s.IndexID, p.ActivityStatus())
+	}
+
+	return nil
+}
+
+// DeepCopy returns a copy of the state which can be modified without
+// affecting the original. The schema shares sharding states between the
+// snapshot served to readers and its working copy, so a state must be copied
+// before it is changed.
+func (s *State) DeepCopy() *State {
+	out := *s
+
+	out.Physical = make(map[string]Physical, len(s.Physical))
+	for name, p := range s.Physical {
+		p.OwnsVirtual = append([]string(nil), p.OwnsVirtual...)
+		p.BelongsToNodes = append([]string(nil), p.BelongsToNodes...)
+		out.Physical[name] = p
+	}
+
+	out.Virtual = append([]Virtual(nil), s.Virtual...)
+
+	return &out
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionedState(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{}, 14)
+	require.Nil(t, err)
+
+	nodes := fakeNodes{[]string{"node1", "node2", "node3"}}
+
+	t.Run("starts without any shards", func(t *testing.T) {
+		state, err := InitPartitionedState("MyClass", cfg, nodes, 1)
+		require.Nil(t, err)
+
+		assert.True(t, state.PartitioningEnabled)
+		assert.Len(t, state.AllPhysicalShards(), 0)
+		assert.Len(t, state.PhysicalID, physicalIDLength)
+	})
+
+	t.Run("each tenant is a shard of its own", func(t *testing.T) {
+		state, err := InitPartitionedState("MyClass", cfg, nodes, 2)
+		require.Nil(t, err)
+
+		for _, name := range []string{"tenant1", "tenant2"} {
+			_, err := state.AddPartition(name, nodes, 2, "")
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, []string{"tenant1", "tenant2"}, state.AllPhysicalShards())
+		for _, p := range state.Physical {
+			require.Len(t, p.Nodes(), 2)
+			assert.NotEqual(t, p.Nodes()[0], p.Nodes()[1])
+			assert.Equal(t, models.TenantActivityStatusHOT, p.ActivityStatus())
+		}
+
+		_, err = state.AddPartition("tenant1", nodes, 2, "")
+		assert.True(t, errortypes.Is(err, errortypes.KindConflict))
+
+		assert.True(t, state.DeletePartition("tenant1"))
+		assert.False(t, state.DeletePartition("tenant1"))
+		assert.Equal(t, []string{"tenant2"}, state.AllPhysicalShards())
+	})
+
+	t.Run("survives a serialization round trip", func(t *testing.T) {
+		state, err := InitPartitionedState("MyClass", cfg, nodes, 1)
+		require.Nil(t, err)
+		_, err = state.AddPartition("tenant1", nodes, 1,
+			models.TenantActivityStatusCOLD)
+		require.Nil(t, err)
+
+		bytes, err := state.JSON()
+		require.Nil(t, err)
+
+		reloaded, err := StateFromJSON(bytes, nodes)
+		require.Nil(t, err)
+
+		assert.True(t, reloaded.PartitioningEnabled)
+		assert.Equal(t, models.TenantActivityStatusCOLD,
+			reloaded.Physical["tenant1"].ActivityStatus())
+	})
+
+	t.Run("copies do not affect the original", func(t *testing.T) {
+		state, err := InitPartitionedState("MyClass", cfg, nodes, 1)
+		require.Nil(t, err)
+		_, err = state.AddPartition("tenant1", nodes, 1, "")
+		require.Nil(t, err)
+
+		copied := state.DeepCopy()
+		_, err = copied.AddPartition("tenant2", nodes, 1, "")
+		require.Nil(t, err)
+		require.True(t, copied.SetPartitionStatus("tenant1",
+			models.TenantActivityStatusCOLD))
+
+		assert.Equal(t, []string{"tenant1"}, state.AllPhysicalShards())
+		assert.Equal(t, models.TenantActivityStatusHOT,
+			state.Physical["tenant1"].ActivityStatus())
+		assert.False(t, copied.SetPartitionStatus("tenant3",
+			models.TenantActivityStatusCOLD))
+	})
+}
+
+func TestValidateTenant(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{}, 14)
+	require.Nil(t, err)
+
+	nodes := fakeNodes{[]string{"node1"}}
+
+	regular, err := InitState("Regular", cfg, nodes, 1)
+	require.Nil(t, err)
+
+	partitioned, err := InitPartitionedState("Partitioned", cfg, nodes, 1)
+	require.Nil(t, err)
+	_, err = partitioned.AddPartition("hot", nodes, 1, models.TenantActivityStatusHOT)
+	require.Nil(t, err)
+	_, err = partitioned.AddPartition("cold", nodes, 1, models.TenantActivityStatusCOLD)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name    string
+		state   *State
+		tenant  string
+		wantErr bool
+	}{
+		{"regular class without tenant", regular, "", false},
+		{"regular class with tenant", regular, "hot", true},
+		{"multi-tenant class without tenant", partitioned, "", true},
+		{"multi-tenant class with unknown tenant", partitioned, "unknown", true},
+		{"multi-tenant class with cold tenant", partitioned, "cold", true},
+		{"multi-tenant class with hot tenant", partitioned, "hot", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.state.ValidateTenant(test.tenant)
+			if test.wantErr {
+				assert.True(t, errortypes.Is(err, errortypes.KindValidation))
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,9 @@ package sharding
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -20,8 +23,11 @@ import (
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/cluster"
 	"github.com/semi-technologies/weaviate/usecases/objects"
+	"golang.org/x/sync/errgroup"
 )
 
 type RemoteIndex struct {
@@ -50,6 +56,43 @@ type nodeResolver interface {
 	NodeHostname(nodeName string) (string, bool)
 }
 
+// localityResolver is implemented by node resolvers which know the zone and
+// rack of the nodes
+type localityResolver interface {
+	LocalLocality() cluster.Locality
+	NodeLocality(nodeName string) (cluster.Locality, bool)
+}
+
+// readOrder sorts the nodes holding replicas so that the ones closest to the
+// local node come first. Reads are thus served from the same zone if
+// possible, which saves cross-zone traffic and latency, and only fall back to
+// other zones if no replica in the zone can serve them. The order is kept if
+// the local node has no zone or rack label.
+func (ri *RemoteIndex) readOrder(nodes []string) []string {
+	resolver, ok := ri.nodeResolver.(localityResolver)
+	if !ok || len(nodes) < 2 {
+		return nodes
+	}
+
+	local := resolver.LocalLocality()
+	if local == (cluster.Locality{}) {
+		return nodes
+	}
+
+	dists := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		locality, _ := resolver.NodeLocality(node)
+		dists[node] = local.Distance(locality)
+	}
+
+	ordered := append([]string{}, nodes...)
+	sort.SliceStable(ordered, func(a, b int) bool {
+		return dists[ordered[a]] < dists[ordered[b]]
+	})
+
+	return ordered
+}
+
 type RemoteIndexClient interface {
 	PutObject(ctx context.Context, hostName, indexName, shardName string,
 		obj *storobj.Object) error
@@ -62,28 +105,151 @@ type RemoteIndexClient interface {
 		additional additional.Properties) (*storobj.Object, error)
 	Exists(ctx context.Context, hostname, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
+	DeleteObject(ctx context.Context, hostname, indexName, shardName string,
+		id strfmt.UUID) error
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, searchVectors [][]float32,
+		keywordRanking *searchparams.KeywordRanking,
+		sparseRanking *searchparams.SparseRanking,
+		limit int, filters *filters.LocalFilter,
+		cursor *filters.Cursor, sort []filters.Sort,
 		additional additional.Properties) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	FacetedSearch(ctx context.Context, hostname, indexName, shardName string,
+		params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error)
+	EstimateFilter(ctx context.Context, hostname, indexName, shardName string,
+		params filters.EstimateParams) (*filters.Estimate, error)
+	ContinuousAggregate(ctx context.Context, hostname, indexName, shardName string,
+		params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error)
+	FindDocIDs(ctx context.Context, hostname, indexName, shardName string,
+		filters *filters.LocalFilter) ([]uint64, error)
+	DeleteObjectBatch(ctx context.Context, hostname, indexName, shardName string,
+		docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error)
+	ScrollShard(ctx context.Context, hostname, indexName, shardName, id string,
+		ttl time.Duration, limit int,
+		additional additional.Properties) ([]*storobj.Object, string, error)
 }
 
-func (ri *RemoteIndex) PutObject(ctx context.Context, shardName string,
-	obj *storobj.Object) error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
+// remoteHosts resolves every node other than the local one which holds a
+// replica of the shard. Writes need to reach all of them, so a node which
+// cannot be resolved is an error.
+func (ri *RemoteIndex) remoteHosts(shardName string) ([]string, error) {
+	state := ri.stateGetter.ShardingState(ri.class)
+	if _, ok := state.Physical[shardName]; !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	nodes := state.RemoteNodes(shardName)
+	hosts := make([]string, len(nodes))
+	for i, node := range nodes {
+		host, ok := ri.nodeResolver.NodeHostname(node)
+		if !ok {
+			return nil, errors.Errorf("resolve node name %q to host", node)
+		}
+		hosts[i] = host
+	}
+
+	return hosts, nil
+}
+
+// writeToReplicas calls fn concurrently for every remote replica of the
+// shard. It does nothing if the local node holds the only replica.
+func (ri *RemoteIndex) writeToReplicas(shardName string,
+	fn func(host string) error) error {
+	hosts, err := ri.remoteHosts(shardName)
+	if err != nil {
+		return err
+	}
+
+	eg := &errgroup.Group{}
+	for _, host := range hosts {
+		host := host
+		eg.Go(func() error {
+			if err := fn(host); err != nil {
+				return errors.Wrapf(err, "replica %s", host)
+			}
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// batchToReplicas is the batch equivalent of writeToReplicas. An item is
+// only successful if it succeeded on every replica, otherwise the first error
+// is reported for it.
+func (ri *RemoteIndex) batchToReplicas(shardName string, count int,
+	fn func(host string) []error) []error {
+	hosts, err := ri.remoteHosts(shardName)
+	if err != nil {
+		return duplicateErr(err, count)
+	}
+
+	out := make([]error, count)
+	m := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+
+			errs := fn(host)
+
+			m.Lock()
+			defer m.Unlock()
+			for i, err := range errs {
+				if err != nil && out[i] == nil {
+					out[i] = errors.Wrapf(err, "replica %s", host)
+				}
+			}
+		}(host)
+	}
+	wg.Wait()
+
+	return out
+}
+
+// readFromReplica calls fn for the remote replicas of the shard in turn until
+// it succeeds for one of them, starting with the closest one, see readOrder.
+// Replicas on nodes which have left the cluster are skipped.
+func (ri *RemoteIndex) readFromReplica(ctx context.Context, shardName string,
+	fn func(host string) error) error {
+	state := ri.stateGetter.ShardingState(ri.class)
+	if _, ok := state.Physical[shardName]; !ok {
 		return errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	lastErr := errors.Errorf("shard %q has no remote replica", shardName)
+	for _, node := range ri.readOrder(state.RemoteNodes(shardName)) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		host, ok := ri.nodeResolver.NodeHostname(node)
+		if !ok {
+			lastErr = errors.Errorf("resolve node name %q to host", node)
+			continue
+		}
+
+		if err := fn(host); err != nil {
+			lastErr = errors.Wrapf(err, "replica %s", host)
+			continue
+		}
+
+		return nil
 	}
 
-	return ri.client.PutObject(ctx, host, ri.class, shardName, obj)
+	return lastErr
+}
+
+func (ri *RemoteIndex) PutObject(ctx context.Context, shardName string,
+	obj *storobj.Object) error {
+	return ri.writeToReplicas(shardName, func(host string) error {
+		return ri.client.PutObject(ctx, host, ri.class, shardName, obj)
+	})
 }
 
 // helper for single errors that affect the entire batch, assign the error to
@@ -98,16 +264,20 @@ func duplicateErr(in error, count int) []error {
 
 func (ri *RemoteIndex) BatchPutObjects(ctx context.Context, shardName string,
 	objs []*storobj.Object) []error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return duplicateErr(errors.Errorf("class %s has no physical shard %q",
-			ri.class, shardName), len(objs))
-	}
+	return ri.batchToReplicas(shardName, len(objs), func(host string) []error {
+		return ri.client.BatchPutObjects(ctx, host, ri.class, shardName, objs)
+	})
+}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
+// BatchPutObjectsOnNode sends the objects only to the replica of the shard
+// on the specified node. It is used to fill a new replica with the objects
+// which existed before it was placed on the node.
+func (ri *RemoteIndex) BatchPutObjectsOnNode(ctx context.Context, shardName,
+	node string, objs []*storobj.Object) []error {
+	host, ok := ri.nodeResolver.NodeHostname(node)
 	if !ok {
-		return duplicateErr(errors.Errorf("resolve node name %q to host",
-			shard.BelongsToNode), len(objs))
+		return duplicateErr(errors.Errorf("resolve node name %q to host", node),
+			len(objs))
 	}
 
 	return ri.client.BatchPutObjects(ctx, host, ri.class, shardName, objs)
@@ -115,95 +285,224 @@ func (ri *RemoteIndex) BatchPutObjects(ctx context.Context, shardName string,
 
 func (ri *RemoteIndex) BatchAddReferences(ctx context.Context, shardName string,
 	refs objects.BatchReferences) []error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return duplicateErr(errors.Errorf("class %s has no physical shard %q",
-			ri.class, shardName), len(refs))
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return duplicateErr(errors.Errorf("resolve node name %q to host",
-			shard.BelongsToNode), len(refs))
-	}
-
-	return ri.client.BatchAddReferences(ctx, host, ri.class, shardName, refs)
+	return ri.batchToReplicas(shardName, len(refs), func(host string) []error {
+		return ri.client.BatchAddReferences(ctx, host, ri.class, shardName, refs)
+	})
 }
 
 func (ri *RemoteIndex) Exists(ctx context.Context, shardName string,
 	id strfmt.UUID) (bool, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return false, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return false, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
+	var exists bool
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		exists, err = ri.client.Exists(ctx, host, ri.class, shardName, id)
+		return err
+	})
+
+	return exists, err
+}
 
-	return ri.client.Exists(ctx, host, ri.class, shardName, id)
+func (ri *RemoteIndex) DeleteObject(ctx context.Context, shardName string,
+	id strfmt.UUID) error {
+	return ri.writeToReplicas(shardName, func(host string) error {
+		return ri.client.DeleteObject(ctx, host, ri.class, shardName, id)
+	})
 }
 
 func (ri *RemoteIndex) GetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties) (*storobj.Object, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
-
-	return ri.client.GetObject(ctx, host, ri.class, shardName, id, props, additional)
+	var obj *storobj.Object
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		obj, err = ri.client.GetObject(ctx, host, ri.class, shardName, id, props,
+			additional)
+		return err
+	})
+
+	return obj, err
 }
 
 func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID) ([]*storobj.Object, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
-
-	return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
+	var objs []*storobj.Object
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		objs, err = ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
+		return err
+	})
+
+	return objs, err
 }
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
-	searchVector []float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, searchVectors [][]float32,
+	keywordRanking *searchparams.KeywordRanking,
+	sparseRanking *searchparams.SparseRanking,
+	limit int, filters *filters.LocalFilter,
+	cursor *filters.Cursor, sort []filters.Sort,
 	additional additional.Properties) ([]*storobj.Object, []float32, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	var objs []*storobj.Object
+	var dists []float32
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		objs, dists, err = ri.client.SearchShard(ctx, host, ri.class, shardName,
+			searchVector, searchVectors, keywordRanking, sparseRanking, limit, filters,
+			cursor, sort,
+			additional)
+		return err
+	})
+
+	return objs, dists, err
+}
+
+// ScrollShard continues the scroll on the node holding it. Unlike other
+// reads it cannot fall back to another replica, as the snapshot the scroll
+// reads from only exists on that node. A new scroll, indicated by an empty
+// node, is opened on the first remote replica which can be reached. The name
+// of the node holding the scroll is returned alongside the id for the next
+// page.
+func (ri *RemoteIndex) ScrollShard(ctx context.Context, shardName, node,
+	id string, ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, string, string, error) {
+	if node != "" {
+		host, ok := ri.nodeResolver.NodeHostname(node)
+		if !ok {
+			return nil, "", "", errors.Errorf("resolve node name %q to host", node)
+		}
+
+		objs, next, err := ri.client.ScrollShard(ctx, host, ri.class, shardName,
+			id, ttl, limit, additional)
+		return objs, next, node, err
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	state := ri.stateGetter.ShardingState(ri.class)
+	if _, ok := state.Physical[shardName]; !ok {
+		return nil, "", "", errors.Errorf("class %s has no physical shard %q",
+			ri.class, shardName)
+	}
+
+	lastErr := errors.Errorf("shard %q has no remote replica", shardName)
+	for _, node := range ri.readOrder(state.RemoteNodes(shardName)) {
+		if err := ctx.Err(); err != nil {
+			return nil, "", "", err
+		}
+
+		host, ok := ri.nodeResolver.NodeHostname(node)
+		if !ok {
+			lastErr = errors.Errorf("resolve node name %q to host", node)
+			continue
+		}
+
+		objs, next, err := ri.client.ScrollShard(ctx, host, ri.class, shardName,
+			"", ttl, limit, additional)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "replica %s", host)
+			continue
+		}
+
+		return objs, next, node, nil
 	}
 
-	return ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, limit,
-		filters, additional)
+	return nil, "", "", lastErr
 }
 
 func (ri *RemoteIndex) Aggregate(ctx context.Context, shardName string,
 	params aggregation.Params) (*aggregation.Result, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
+	var res *aggregation.Result
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		res, err = ri.client.Aggregate(ctx, host, ri.class, shardName, params)
+		return err
+	})
+
+	return res, err
+}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+func (ri *RemoteIndex) FacetedSearch(ctx context.Context, shardName string,
+	params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error) {
+	var objs []*storobj.Object
+	var res *aggregation.FacetResult
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		objs, res, err = ri.client.FacetedSearch(ctx, host, ri.class, shardName, params)
+		return err
+	})
+
+	return objs, res, err
+}
+
+func (ri *RemoteIndex) EstimateFilter(ctx context.Context, shardName string,
+	params filters.EstimateParams) (*filters.Estimate, error) {
+	var res *filters.Estimate
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		res, err = ri.client.EstimateFilter(ctx, host, ri.class, shardName, params)
+		return err
+	})
+
+	return res, err
+}
+
+func (ri *RemoteIndex) ContinuousAggregate(ctx context.Context, shardName string,
+	params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	var res []aggregation.ContinuousGroup
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		res, err = ri.client.ContinuousAggregate(ctx, host, ri.class, shardName, params)
+		return err
+	})
+
+	return res, err
+}
+
+func (ri *RemoteIndex) FindDocIDs(ctx context.Context, shardName string,
+	filters *filters.LocalFilter) ([]uint64, error) {
+	var docIDs []uint64
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		docIDs, err = ri.client.FindDocIDs(ctx, host, ri.class, shardName, filters)
+		return err
+	})
+
+	return docIDs, err
+}
+
+// DeleteObjectBatch deletes the doc ids on the first healthy remote replica,
+// which is the one FindDocIDs has read them from. Doc ids are specific to a
+// replica, so the other replicas delete the same objects by their UUID.
+func (ri *RemoteIndex) DeleteObjectBatch(ctx context.Context, shardName string,
+	docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error) {
+	var res objects.BatchSimpleObjects
+	var servedBy string
+	err := ri.readFromReplica(ctx, shardName, func(host string) error {
+		var err error
+		res, err = ri.client.DeleteObjectBatch(ctx, host, ri.class, shardName,
+			docIDs, dryRun)
+		servedBy = host
+		return err
+	})
+	if err != nil || dryRun {
+		return res, err
 	}
 
-	return ri.client.Aggregate(ctx, host, ri.class, shardName, params)
+	err = ri.writeToReplicas(shardName, func(host string) error {
+		if host == servedBy {
+			return nil
+		}
+
+		for _, obj := range res {
+			if obj.Err != nil {
+				continue
+			}
+
+			if err := ri.client.DeleteObject(ctx, host, ri.class, shardName,
+				obj.UUID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return res, err
 }
@@ -13,6 +13,7 @@ package sharding
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -21,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 )
@@ -41,13 +43,32 @@ type RemoteIndexIncomingRepo interface {
 		additional additional.Properties) (*storobj.Object, error)
 	IncomingExists(ctx context.Context, shardName string,
 		id strfmt.UUID) (bool, error)
+	IncomingDeleteObject(ctx context.Context, shardName string,
+		id strfmt.UUID) error
 	IncomingMultiGetObjects(ctx context.Context, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	IncomingSearch(ctx context.Context, shardName string,
-		vector []float32, limit int, filters *filters.LocalFilter,
+		vector []float32, vectors [][]float32,
+		keywordRanking *searchparams.KeywordRanking,
+		sparseRanking *searchparams.SparseRanking,
+		limit int, filters *filters.LocalFilter,
+		cursor *filters.Cursor, sort []filters.Sort,
 		additional additional.Properties) ([]*storobj.Object, []float32, error)
 	IncomingAggregate(ctx context.Context, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	IncomingFacetedSearch(ctx context.Context, shardName string,
+		params aggregation.FacetParams) ([]*storobj.Object, *aggregation.FacetResult, error)
+	IncomingEstimateFilter(ctx context.Context, shardName string,
+		params filters.EstimateParams) (*filters.Estimate, error)
+	IncomingContinuousAggregate(ctx context.Context, shardName string,
+		params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error)
+	IncomingFindDocIDs(ctx context.Context, shardName string,
+		filters *filters.LocalFilter) ([]uint64, error)
+	IncomingDeleteObjectBatch(ctx context.Context, shardName string,
+		docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error)
+	IncomingScroll(ctx context.Context, shardName, id string,
+		ttl time.Duration, limit int,
+		additional additional.Properties) ([]*storobj.Object, string, error)
 }
 
 type RemoteIndexIncoming struct {
@@ -113,6 +134,16 @@ func (rii *RemoteIndexIncoming) Exists(ctx context.Context, indexName,
 	return index.IncomingExists(ctx, shardName, id)
 }
 
+func (rii *RemoteIndexIncoming) DeleteObject(ctx context.Context, indexName,
+	shardName string, id strfmt.UUID) error {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingDeleteObject(ctx, shardName, id)
+}
+
 func (rii *RemoteIndexIncoming) MultiGetObjects(ctx context.Context, indexName,
 	shardName string, ids []strfmt.UUID) ([]*storobj.Object, error) {
 	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
@@ -124,14 +155,19 @@ func (rii *RemoteIndexIncoming) MultiGetObjects(ctx context.Context, indexName,
 }
 
 func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName string,
-	vector []float32, limit int, filters *filters.LocalFilter,
+	vector []float32, vectors [][]float32,
+	keywordRanking *searchparams.KeywordRanking,
+	sparseRanking *searchparams.SparseRanking,
+	limit int, filters *filters.LocalFilter,
+	cursor *filters.Cursor, sort []filters.Sort,
 	additional additional.Properties) ([]*storobj.Object, []float32, error) {
 	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
 	if index == nil {
 		return nil, nil, errors.Errorf("local index %q not found", indexName)
 	}
 
-	return index.IncomingSearch(ctx, shardName, vector, limit, filters, additional)
+	return index.IncomingSearch(ctx, shardName, vector, vectors, keywordRanking,
+		sparseRanking, limit, filters, cursor, sort, additional)
 }
 
 func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardName string,
@@ -143,3 +179,65 @@ func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardN
 
 	return index.IncomingAggregate(ctx, shardName, params)
 }
+
+func (rii *RemoteIndexIncoming) FacetedSearch(ctx context.Context, indexName,
+	shardName string, params aggregation.FacetParams) ([]*storobj.Object,
+	*aggregation.FacetResult, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingFacetedSearch(ctx, shardName, params)
+}
+
+func (rii *RemoteIndexIncoming) EstimateFilter(ctx context.Context, indexName,
+	shardName string, params filters.EstimateParams) (*filters.Estimate, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingEstimateFilter(ctx, shardName, params)
+}
+
+func (rii *RemoteIndexIncoming) ContinuousAggregate(ctx context.Context, indexName,
+	shardName string, params aggregation.ContinuousParams) ([]aggregation.ContinuousGroup, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingContinuousAggregate(ctx, shardName, params)
+}
+
+func (rii *RemoteIndexIncoming) FindDocIDs(ctx context.Context, indexName, shardName string,
+	filters *filters.LocalFilter) ([]uint64, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingFindDocIDs(ctx, shardName, filters)
+}
+
+func (rii *RemoteIndexIncoming) DeleteObjectBatch(ctx context.Context, indexName, shardName string,
+	docIDs []uint64, dryRun bool) (objects.BatchSimpleObjects, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingDeleteObjectBatch(ctx, shardName, docIDs, dryRun)
+}
+
+func (rii *RemoteIndexIncoming) Scroll(ctx context.Context, indexName,
+	shardName, id string, ttl time.Duration, limit int,
+	additional additional.Properties) ([]*storobj.Object, string, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, "", errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingScroll(ctx, shardName, id, ttl, limit, additional)
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteIndexReadOrder(t *testing.T) {
+	localities := map[string]cluster.Locality{
+		"node-a": {Zone: "zone-2", Rack: "r1"},
+		"node-b": {Zone: "zone-1", Rack: "r2"},
+		"node-c": {Zone: "zone-1", Rack: "r1"},
+		"node-d": {},
+	}
+	nodes := []string{"node-a", "node-b", "node-c", "node-d", "node-e"}
+
+	t.Run("replicas in the same zone and rack are preferred", func(t *testing.T) {
+		ri := NewRemoteIndex("Foo", nil, &fakeLocalityResolver{
+			local:      cluster.Locality{Zone: "zone-1", Rack: "r1"},
+			localities: localities,
+		}, nil)
+
+		assert.Equal(t, []string{"node-c", "node-b", "node-a", "node-d", "node-e"},
+			ri.readOrder(nodes))
+	})
+
+	t.Run("the order is kept without local labels", func(t *testing.T) {
+		ri := NewRemoteIndex("Foo", nil, &fakeLocalityResolver{
+			localities: localities,
+		}, nil)
+
+		assert.Equal(t, nodes, ri.readOrder(nodes))
+	})
+
+	t.Run("the order is kept if localities are unknown", func(t *testing.T) {
+		ri := NewRemoteIndex("Foo", nil, &fakeNodeResolver{}, nil)
+
+		assert.Equal(t, nodes, ri.readOrder(nodes))
+	})
+}
+
+type fakeNodeResolver struct{}
+
+func (f *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
+	return nodeName, true
+}
+
+type fakeLocalityResolver struct {
+	fakeNodeResolver
+	local      cluster.Locality
+	localities map[string]cluster.Locality
+}
+
+func (f *fakeLocalityResolver) LocalLocality() cluster.Locality {
+	return f.local
+}
+
+func (f *fakeLocalityResolver) NodeLocality(nodeName string) (cluster.Locality, bool) {
+	locality, ok := f.localities[nodeName]
+	return locality, ok
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import "github.com/pkg/errors"
+
+// DefaultReplicationFactor is used for classes which do not specify a
+// replicationConfig. Every shard exists exactly once in the cluster.
+const DefaultReplicationFactor = 1
+
+// ValidateReplicationFactor makes sure every replica of a shard can be placed
+// on a distinct node
+func ValidateReplicationFactor(factor, nodeCount int) error {
+	if factor < 1 {
+		return errors.Errorf("replication factor must be at least 1, got: %d", factor)
+	}
+
+	if factor > nodeCount {
+		return errors.Errorf("replication factor of %d is larger than the number "+
+			"of nodes in the cluster (%d)", factor, nodeCount)
+	}
+
+	return nil
+}
+
+// ValidateReplicationFactorUpdate rejects any change to the replication
+// factor, as existing shards are not re-replicated
+func ValidateReplicationFactorUpdate(old, updated int) error {
+	if old != updated {
+		return errors.Errorf("re-replication not supported yet: replication factor "+
+			"is immutable: attempted change from \"%d\" to \"%d\"", old, updated)
+	}
+
+	return nil
+}
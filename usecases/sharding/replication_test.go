@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateReplicationFactor(t *testing.T) {
+	type test struct {
+		name          string
+		factor        int
+		nodeCount     int
+		expectedError string
+	}
+
+	tests := []test{
+		{
+			name:      "no replication",
+			factor:    1,
+			nodeCount: 3,
+		},
+		{
+			name:      "one replica per node",
+			factor:    3,
+			nodeCount: 3,
+		},
+		{
+			name:          "zero replicas",
+			factor:        0,
+			nodeCount:     3,
+			expectedError: "replication factor must be at least 1, got: 0",
+		},
+		{
+			name:      "more replicas than nodes",
+			factor:    4,
+			nodeCount: 3,
+			expectedError: "replication factor of 4 is larger than the number " +
+				"of nodes in the cluster (3)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateReplicationFactor(test.factor, test.nodeCount)
+			if test.expectedError == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateReplicationFactorUpdate(t *testing.T) {
+	assert.Nil(t, ValidateReplicationFactorUpdate(2, 2))
+
+	err := ValidateReplicationFactorUpdate(1, 2)
+	require.NotNil(t, err)
+	assert.Equal(t, "re-replication not supported yet: replication factor is "+
+		"immutable: attempted change from \"1\" to \"2\"", err.Error())
+}
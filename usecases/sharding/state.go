@@ -12,6 +12,7 @@
 package sharding
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
 	"sort"
@@ -20,7 +21,10 @@ import (
 	"github.com/spaolacci/murmur3"
 )
 
-const shardNameLength = 12
+const (
+	shardNameLength  = 12
+	physicalIDLength = 16
+)
 
 type State struct {
 	IndexID  string              `json:"indexID"` // for monitoring, reporting purposes. Does not influence the shard-calculations
@@ -28,6 +32,16 @@ type State struct {
 	Physical map[string]Physical `json:"physical"`
 	Virtual  []Virtual           `json:"virtual"`
 
+	// PhysicalID is the immutable name under which the index is stored on disk.
+	// Contrary to the class name it never changes and only contains safe
+	// characters, so logical schema changes never require moving data.
+	PhysicalID string `json:"physicalID"`
+
+	// PartitioningEnabled is set for classes with multi-tenancy. Their objects
+	// are not distributed by their ID, but stored in the shard of their
+	// tenant, see InitPartitionedState.
+	PartitioningEnabled bool `json:"partitioningEnabled,omitempty"`
+
 	// different for each node, not to be serialized
 	localNodeName string
 }
@@ -44,6 +58,24 @@ type Physical struct {
 	OwnsVirtual    []string `json:"ownsVirtual"`
 	OwnsPercentage float64  `json:"ownsPercentage"`
 	BelongsToNode  string   `json:"belongsToNode"`
+
+	// BelongsToNodes lists every node holding a replica of the shard. The
+	// first entry is always the same as BelongsToNode. States created before
+	// replication was supported do not have it set.
+	BelongsToNodes []string `json:"belongsToNodes,omitempty"`
+
+	// Status is the activity status of the tenant a shard of a partitioned
+	// state holds, see ActivityStatus
+	Status string `json:"status,omitempty"`
+}
+
+// Nodes returns the names of all nodes holding a replica of the shard
+func (p Physical) Nodes() []string {
+	if len(p.BelongsToNodes) == 0 {
+		return []string{p.BelongsToNode}
+	}
+
+	return p.BelongsToNodes
 }
 
 type nodes interface {
@@ -51,10 +83,21 @@ type nodes interface {
 	LocalName() string
 }
 
-func InitState(id string, config Config, nodes nodes) (*State, error) {
-	out := &State{Config: config, IndexID: id, localNodeName: nodes.LocalName()}
+func InitState(id string, config Config, nodes nodes,
+	replicationFactor int) (*State, error) {
+	if err := ValidateReplicationFactor(replicationFactor,
+		len(nodes.AllNames())); err != nil {
+		return nil, err
+	}
 
-	if err := out.initPhysical(nodes); err != nil {
+	out := &State{
+		Config:        config,
+		IndexID:       id,
+		PhysicalID:    generatePhysicalID(),
+		localNodeName: nodes.LocalName(),
+	}
+
+	if err := out.initPhysical(nodes, replicationFactor); err != nil {
 		return nil, err
 	}
 
@@ -119,11 +162,113 @@ func (s *State) SetLocalName(name string) {
 	s.localNodeName = name
 }
 
+func (s *State) LocalName() string {
+	return s.localNodeName
+}
+
+// IsShardLocal returns true if the local node holds a replica of the shard
 func (s *State) IsShardLocal(name string) bool {
-	return s.Physical[name].BelongsToNode == s.localNodeName
+	physical, ok := s.Physical[name]
+	if !ok {
+		return false
+	}
+
+	for _, node := range physical.Nodes() {
+		if node == s.localNodeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoteNodes returns the names of all nodes other than the local one which
+// hold a replica of the shard
+func (s *State) RemoteNodes(name string) []string {
+	var out []string
+	for _, node := range s.Physical[name].Nodes() {
+		if node != s.localNodeName {
+			out = append(out, node)
+		}
+	}
+
+	return out
+}
+
+// AddReplica places an additional replica of the shard on the node. It
+// returns false if the shard does not exist or the node already holds a
+// replica of it.
+func (s *State) AddReplica(shard, node string) bool {
+	physical, ok := s.Physical[shard]
+	if !ok {
+		return false
+	}
+
+	nodes := physical.Nodes()
+	for _, existing := range nodes {
+		if existing == node {
+			return false
+		}
+	}
+
+	physical.BelongsToNodes = append(append([]string{}, nodes...), node)
+	physical.BelongsToNode = physical.BelongsToNodes[0]
+	s.Physical[shard] = physical
+	return true
+}
+
+// RemoveReplica removes the replica of the shard from the node. It returns
+// false if the node does not hold a replica of the shard or if it is the only
+// replica, as the shard would no longer exist anywhere otherwise.
+func (s *State) RemoveReplica(shard, node string) bool {
+	physical, ok := s.Physical[shard]
+	if !ok {
+		return false
+	}
+
+	nodes := physical.Nodes()
+	remaining := make([]string, 0, len(nodes))
+	for _, existing := range nodes {
+		if existing != node {
+			remaining = append(remaining, existing)
+		}
+	}
+
+	if len(remaining) == len(nodes) || len(remaining) == 0 {
+		return false
+	}
+
+	physical.BelongsToNodes = remaining
+	physical.BelongsToNode = remaining[0]
+	s.Physical[shard] = physical
+	return true
+}
+
+// EnsurePhysicalID assigns a physical ID to a state created in a previous
+// version which did not have one yet. It returns true if an ID was assigned,
+// in which case the state needs to be persisted.
+//
+// The ID is derived from the physical shard names rather than generated
+// randomly, so that every node of a cluster assigns the same ID without
+// having to coordinate.
+func (s *State) EnsurePhysicalID() bool {
+	if s.PhysicalID != "" {
+		return false
+	}
+
+	h := murmur3.New64()
+	for _, name := range s.AllPhysicalShards() {
+		h.Write([]byte(name))
+	}
+
+	s.PhysicalID = fmt.Sprintf("%016x", h.Sum64())
+	return true
 }
 
-func (s *State) initPhysical(nodes nodes) error {
+// initPhysical assigns every shard to replicationFactor consecutive nodes.
+// Since the iterator wraps around and the factor can never be larger than the
+// number of nodes, the replicas of a shard always end up on distinct nodes.
+func (s *State) initPhysical(nodes nodes, replicationFactor int) error {
 	it, err := cluster.NewNodeIterator(nodes, cluster.StartRandom)
 	if err != nil {
 		return err
@@ -133,7 +278,16 @@ func (s *State) initPhysical(nodes nodes) error {
 
 	for i := 0; i < s.Config.DesiredCount; i++ {
 		name := generateShardName()
-		s.Physical[name] = Physical{Name: name, BelongsToNode: it.Next()}
+		owners := make([]string, replicationFactor)
+		for j := range owners {
+			owners[j] = it.Next()
+		}
+
+		s.Physical[name] = Physical{
+			Name:           name,
+			BelongsToNode:  owners[0],
+			BelongsToNodes: owners,
+		}
 	}
 
 	return nil
@@ -226,6 +380,19 @@ func (s *State) virtualByToken(token uint64) *Virtual {
 
 const shardNameChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
+const physicalIDChars = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// generatePhysicalID only uses lowercase characters, so that two IDs can
+// never collide on case-insensitive file systems
+func generatePhysicalID() string {
+	b := make([]byte, physicalIDLength)
+	for i := range b {
+		b[i] = physicalIDChars[rand.Intn(len(physicalIDChars))]
+	}
+
+	return string(b)
+}
+
 func generateShardName() string {
 	b := make([]byte, shardNameLength)
 	for i := range b {
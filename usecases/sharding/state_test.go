@@ -26,7 +26,7 @@ func TestState(t *testing.T) {
 	require.Nil(t, err)
 
 	nodes := fakeNodes{[]string{"node1", "node2"}}
-	state, err := InitState("my-index", cfg, nodes)
+	state, err := InitState("my-index", cfg, nodes, 1)
 	require.Nil(t, err)
 
 	physicalCount := map[string]int{}
@@ -72,6 +72,136 @@ func TestState(t *testing.T) {
 	assert.Equal(t, physicalCount, physicalCountReloaded)
 }
 
+func TestStatePhysicalID(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{"desiredCount": float64(2)}, 14)
+	require.Nil(t, err)
+
+	nodes := fakeNodes{[]string{"node1", "node2"}}
+
+	t.Run("new states have a physical id", func(t *testing.T) {
+		state, err := InitState("MyClass", cfg, nodes, 1)
+		require.Nil(t, err)
+
+		assert.Len(t, state.PhysicalID, physicalIDLength)
+		assert.False(t, state.EnsurePhysicalID())
+	})
+
+	t.Run("states from a previous version are assigned a stable id", func(t *testing.T) {
+		state, err := InitState("MyClass", cfg, nodes, 1)
+		require.Nil(t, err)
+
+		// simulate a state without a physical ID, as persisted by a previous
+		// version
+		state.PhysicalID = ""
+		bytes, err := state.JSON()
+		require.Nil(t, err)
+
+		// every node must come up with the same ID
+		first, err := StateFromJSON(bytes, nodes)
+		require.Nil(t, err)
+		second, err := StateFromJSON(bytes, fakeNodes{[]string{"node2", "node1"}})
+		require.Nil(t, err)
+
+		assert.True(t, first.EnsurePhysicalID())
+		assert.True(t, second.EnsurePhysicalID())
+		assert.NotEmpty(t, first.PhysicalID)
+		assert.Equal(t, first.PhysicalID, second.PhysicalID)
+	})
+}
+
+func TestStateReplication(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{"desiredCount": float64(3)}, 14)
+	require.Nil(t, err)
+
+	nodes := fakeNodes{[]string{"node1", "node2", "node3"}}
+
+	t.Run("every shard is placed on distinct nodes", func(t *testing.T) {
+		state, err := InitState("MyClass", cfg, nodes, 2)
+		require.Nil(t, err)
+
+		for name, physical := range state.Physical {
+			owners := physical.Nodes()
+			require.Len(t, owners, 2)
+			assert.NotEqual(t, owners[0], owners[1])
+			assert.Equal(t, physical.BelongsToNode, owners[0])
+
+			assert.Len(t, state.RemoteNodes(name), 2-localReplicas(owners, "node1"))
+			assert.Equal(t, localReplicas(owners, "node1") == 1,
+				state.IsShardLocal(name))
+		}
+	})
+
+	t.Run("replicas survive a serialization round trip", func(t *testing.T) {
+		state, err := InitState("MyClass", cfg, nodes, 3)
+		require.Nil(t, err)
+
+		bytes, err := state.JSON()
+		require.Nil(t, err)
+
+		reloaded, err := StateFromJSON(bytes, nodes)
+		require.Nil(t, err)
+
+		for name := range state.Physical {
+			assert.ElementsMatch(t, nodes.nodes, reloaded.Physical[name].Nodes())
+			assert.True(t, reloaded.IsShardLocal(name))
+			assert.Len(t, reloaded.RemoteNodes(name), 2)
+		}
+	})
+
+	t.Run("states from a previous version have a single replica", func(t *testing.T) {
+		physical := Physical{Name: "shard", BelongsToNode: "node2"}
+		state := &State{
+			Physical:      map[string]Physical{"shard": physical},
+			localNodeName: "node1",
+		}
+
+		assert.Equal(t, []string{"node2"}, physical.Nodes())
+		assert.False(t, state.IsShardLocal("shard"))
+		assert.Equal(t, []string{"node2"}, state.RemoteNodes("shard"))
+	})
+
+	t.Run("the factor must fit into the cluster", func(t *testing.T) {
+		_, err := InitState("MyClass", cfg, nodes, 4)
+		assert.NotNil(t, err)
+
+		_, err = InitState("MyClass", cfg, nodes, 0)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("moving a replica to another node", func(t *testing.T) {
+		state := &State{
+			Physical: map[string]Physical{
+				"shard": {Name: "shard", BelongsToNode: "node1"},
+			},
+			localNodeName: "node3",
+		}
+
+		assert.False(t, state.AddReplica("shard", "node1"))
+		assert.False(t, state.AddReplica("unknown", "node3"))
+		assert.False(t, state.RemoveReplica("shard", "node1"),
+			"the only replica cannot be removed")
+
+		require.True(t, state.AddReplica("shard", "node3"))
+		assert.Equal(t, []string{"node1", "node3"}, state.Physical["shard"].Nodes())
+		assert.True(t, state.IsShardLocal("shard"))
+
+		require.True(t, state.RemoveReplica("shard", "node1"))
+		assert.Equal(t, []string{"node3"}, state.Physical["shard"].Nodes())
+		assert.Equal(t, "node3", state.Physical["shard"].BelongsToNode)
+		assert.False(t, state.RemoveReplica("shard", "node1"))
+	})
+}
+
+func localReplicas(owners []string, node string) int {
+	count := 0
+	for _, owner := range owners {
+		if owner == node {
+			count++
+		}
+	}
+	return count
+}
+
 type fakeNodes struct {
 	nodes []string
 }
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// Distance metrics a vector index can use to compare vectors
+const (
+	DistanceCosine    = "cosine"
+	DistanceDot       = "dot"
+	DistanceL2Squared = "l2-squared"
+	DistanceManhattan = "manhattan"
+	DistanceHamming   = "hamming"
+)
+
+// CertaintyFromDistance converts a raw distance in the given metric into a
+// certainty between 0 (opposite) and 1 (identical). The conversion is chosen
+// so that for normalized vectors every metric results in the same certainty
+// as cosine, which means certainty thresholds keep working when the metric of
+// a class changes.
+//
+// For unnormalized vectors dot and l2-squared distances are unbounded, the
+// certainty is then clamped to 0..1 and only of limited use. The raw distance
+// is always exposed alongside it and should be preferred in that case.
+func CertaintyFromDistance(metric string, dist float32) (float32, error) {
+	var certainty float32
+	switch metric {
+	case DistanceCosine:
+		// cosine distance is 1-cos(a,b), so between 0 and 2
+		certainty = 1 - dist/2
+	case DistanceDot:
+		// dot distance is the negative dot product, so between -1 and 1 for
+		// normalized vectors
+		certainty = (1 - dist) / 2
+	case DistanceL2Squared:
+		// between 0 and 4 for normalized vectors
+		certainty = 1 - dist/4
+	default:
+		return 0, errors.Errorf("no certainty defined for distance metric %q", metric)
+	}
+
+	if certainty < 0 {
+		return 0, nil
+	}
+
+	if certainty > 1 {
+		return 1, nil
+	}
+
+	return certainty, nil
+}
+
+// CertaintySupported is true if the raw distances of the metric can be
+// converted into a certainty. Manhattan and hamming distances have no
+// meaningful upper bound, so results can only be compared by distance.
+func CertaintySupported(metric string) bool {
+	switch metric {
+	case DistanceCosine, DistanceDot, DistanceL2Squared:
+		return true
+	default:
+		return false
+	}
+}
+
+// DistanceMetric returns the metric the vector index of the specified class
+// uses. Classes without an explicitly configured metric use cosine.
+func DistanceMetric(sch schema.Schema, className string) string {
+	class := sch.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return DistanceCosine
+	}
+
+	vectorIndexConfig, ok := class.VectorIndexConfig.(schema.VectorIndexConfig)
+	if !ok || vectorIndexConfig.DistanceName() == "" {
+		return DistanceCosine
+	}
+
+	return vectorIndexConfig.DistanceName()
+}
+
+func (e *Explorer) distanceMetric(className string) string {
+	if e.schemaGetter == nil {
+		return DistanceCosine
+	}
+
+	return DistanceMetric(e.schemaGetter.GetSchemaSkipAuth(), className)
+}
+
+// SimilarityThreshold limits the results of a vector search either by their
+// minimum certainty or by their maximum raw distance. As opposed to certainty,
+// distance works with every metric. At most one of them is set, a distance
+// of 0 is a valid limit so it is only used if WithDistance is set.
+type SimilarityThreshold struct {
+	Certainty    float64
+	Distance     float64
+	WithDistance bool
+}
+
+// excludesDistance is true if a result with the raw distance is further away
+// than the maximum distance
+func (t SimilarityThreshold) excludesDistance(dist float32) bool {
+	return t.WithDistance && dist > float32(t.Distance)
+}
+
+func validateThreshold(param string, certainty float64, withDistance bool) error {
+	if certainty != 0 && withDistance {
+		return errors.Errorf("found both 'certainty' and 'distance' in '%s' "+
+			"which are conflicting, choose one instead", param)
+	}
+
+	return nil
+}
+
+// validateNoCertainty makes sure certainty is neither used as a threshold nor
+// requested as an additional property for a metric that doesn't support it
+func (e *Explorer) validateNoCertainty(params GetParams, metric string) error {
+	if e.extractThresholdFromParams(params).Certainty == 0 &&
+		!params.AdditionalProperties.Certainty {
+		return nil
+	}
+
+	return errors.Errorf("certainty is not supported for distance metric %q "+
+		"of class %s, use distance instead", metric, params.ClassName)
+}
@@ -0,0 +1,274 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertaintyFromDistance(t *testing.T) {
+	type test struct {
+		name              string
+		metric            string
+		dist              float32
+		expectedCertainty float32
+	}
+
+	// for normalized vectors with a cosine similarity of 0.5, every metric
+	// must result in the same certainty
+	tests := []test{
+		{name: "cosine identical", metric: DistanceCosine, dist: 0, expectedCertainty: 1},
+		{name: "cosine opposite", metric: DistanceCosine, dist: 2, expectedCertainty: 0},
+		{name: "cosine", metric: DistanceCosine, dist: 0.5, expectedCertainty: 0.75},
+		{name: "dot", metric: DistanceDot, dist: -0.5, expectedCertainty: 0.75},
+		{name: "l2-squared", metric: DistanceL2Squared, dist: 1, expectedCertainty: 0.75},
+		{name: "dot unnormalized", metric: DistanceDot, dist: -7, expectedCertainty: 1},
+		{name: "l2-squared unnormalized", metric: DistanceL2Squared, dist: 9, expectedCertainty: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certainty, err := CertaintyFromDistance(test.metric, test.dist)
+			require.Nil(t, err)
+			assert.InDelta(t, test.expectedCertainty, certainty, 0.000001)
+		})
+	}
+
+	t.Run("unknown metric", func(t *testing.T) {
+		_, err := CertaintyFromDistance("manhattan", 1)
+		assert.NotNil(t, err)
+	})
+}
+
+type fakeVectorIndexConfig struct {
+	distance string
+}
+
+func (f fakeVectorIndexConfig) IndexType() string {
+	return "fake"
+}
+
+func (f fakeVectorIndexConfig) DistanceName() string {
+	return f.distance
+}
+
+func Test_Explorer_GetClass_DistanceMetrics(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "L2Class",
+					VectorIndexConfig: fakeVectorIndexConfig{distance: DistanceL2Squared},
+				},
+				{
+					Class:             "ManhattanClass",
+					VectorIndexConfig: fakeVectorIndexConfig{distance: DistanceManhattan},
+				},
+			},
+		},
+	}
+
+	t.Run("distance metrics of classes", func(t *testing.T) {
+		assert.Equal(t, DistanceL2Squared, DistanceMetric(sch, "L2Class"))
+		assert.Equal(t, DistanceManhattan, DistanceMetric(sch, "ManhattanClass"))
+		assert.Equal(t, DistanceCosine, DistanceMetric(sch, "UnknownClass"))
+	})
+
+	type testCase struct {
+		name               string
+		className          string
+		certainty          float64
+		additional         additional.Properties
+		expectedAdditional map[string]interface{}
+		expectedError      string
+	}
+
+	tests := []testCase{
+		{
+			name:       "raw l2-squared distance and certainty",
+			className:  "L2Class",
+			additional: additional.Properties{Distance: true, Certainty: true},
+			expectedAdditional: map[string]interface{}{
+				"distance":  float32(1),
+				"certainty": float32(0.75),
+			},
+		},
+		{
+			name:       "raw manhattan distance",
+			className:  "ManhattanClass",
+			additional: additional.Properties{Distance: true},
+			expectedAdditional: map[string]interface{}{
+				"distance": float32(1),
+			},
+		},
+		{
+			name:       "manhattan with certainty",
+			className:  "ManhattanClass",
+			additional: additional.Properties{Certainty: true},
+			expectedError: "certainty is not supported for distance metric " +
+				"\"manhattan\" of class ManhattanClass, use distance instead",
+		},
+		{
+			name:      "manhattan with a certainty threshold",
+			className: "ManhattanClass",
+			certainty: 0.7,
+			expectedError: "certainty is not supported for distance metric " +
+				"\"manhattan\" of class ManhattanClass, use distance instead",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := GetParams{
+				ClassName: test.className,
+				NearVector: &NearVectorParams{
+					Vector:    []float32{0.8, 0.2, 0.7},
+					Certainty: test.certainty,
+				},
+				Pagination:           &filters.Pagination{Limit: 100},
+				AdditionalProperties: test.additional,
+			}
+
+			searcher := &fakeVectorSearcher{}
+			searcher.
+				On("VectorClassSearch", mock.Anything).
+				Return([]search.Result{{
+					ID: "id1", Dist: 1, Schema: map[string]interface{}{},
+				}}, nil)
+			explorer := NewExplorer(searcher, newFakeDistancer(), log,
+				getFakeModulesProvider())
+			explorer.SetSchemaGetter(&fakeSchemaGetter{schema: sch})
+
+			res, err := explorer.GetClass(context.Background(), params)
+			if test.expectedError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+				return
+			}
+
+			require.Nil(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, test.expectedAdditional,
+				res[0].(map[string]interface{})["_additional"])
+		})
+	}
+}
+
+func Test_Explorer_GetClass_DistanceThreshold(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "L2Class",
+					VectorIndexConfig: fakeVectorIndexConfig{distance: DistanceL2Squared},
+				},
+			},
+		},
+	}
+
+	type testCase struct {
+		name          string
+		nearVector    *NearVectorParams
+		expectedIDs   []strfmt.UUID
+		expectedError string
+	}
+
+	tests := []testCase{
+		{
+			name: "without a threshold",
+			nearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			expectedIDs: []strfmt.UUID{"id1", "id2", "id3"},
+		},
+		{
+			name: "with a distance threshold",
+			nearVector: &NearVectorParams{
+				Vector:       []float32{0.8, 0.2, 0.7},
+				Distance:     1,
+				WithDistance: true,
+			},
+			expectedIDs: []strfmt.UUID{"id1", "id2"},
+		},
+		{
+			name: "with a distance threshold of zero",
+			nearVector: &NearVectorParams{
+				Vector:       []float32{0.8, 0.2, 0.7},
+				WithDistance: true,
+			},
+			expectedIDs: []strfmt.UUID{},
+		},
+		{
+			name: "with both certainty and distance",
+			nearVector: &NearVectorParams{
+				Vector:       []float32{0.8, 0.2, 0.7},
+				Certainty:    0.7,
+				Distance:     1,
+				WithDistance: true,
+			},
+			expectedError: "explorer: get class: vectorize params: found both " +
+				"'certainty' and 'distance' in 'nearVector' which are conflicting, " +
+				"choose one instead",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := GetParams{
+				ClassName:            "L2Class",
+				NearVector:           test.nearVector,
+				Pagination:           &filters.Pagination{Limit: 100},
+				AdditionalProperties: additional.Properties{ID: true},
+			}
+
+			searcher := &fakeVectorSearcher{}
+			searcher.
+				On("VectorClassSearch", mock.Anything).
+				Return([]search.Result{
+					{ID: "id1", Dist: 0.5, Schema: map[string]interface{}{}},
+					{ID: "id2", Dist: 1, Schema: map[string]interface{}{}},
+					{ID: "id3", Dist: 1.5, Schema: map[string]interface{}{}},
+				}, nil)
+			explorer := NewExplorer(searcher, newFakeDistancer(), log,
+				getFakeModulesProvider())
+			explorer.SetSchemaGetter(&fakeSchemaGetter{schema: sch})
+
+			res, err := explorer.GetClass(context.Background(), params)
+			if test.expectedError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+				return
+			}
+
+			require.Nil(t, err)
+			ids := make([]strfmt.UUID, len(res))
+			for i, obj := range res {
+				ids[i] = obj.(map[string]interface{})["_additional"].(map[string]interface{})["id"].(strfmt.UUID)
+			}
+			assert.Equal(t, test.expectedIDs, ids)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
@@ -52,8 +53,14 @@ type ModulesProvider interface {
 	ListExploreAdditionalExtend(ctx context.Context, in []search.Result,
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
+	TransformResult(ctx context.Context, className string,
+		props map[string]interface{}, params map[string]interface{}) error
+	SparseVectorFromQuery(ctx context.Context, className,
+		query string) (map[string]float32, error)
 }
 
+// distancer returns the raw distance between two vectors, see
+// CertaintyFromDistance for how it is normalized
 type distancer func(a, b []float32) (float32, error)
 
 type vectorClassSearch interface {
@@ -90,6 +97,30 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
+	if err := e.validateCursor(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'after' cursor")
+	}
+
+	if err := e.validateSort(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'sort' argument")
+	}
+
+	if err := e.validateGroupBy(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'groupBy' argument")
+	}
+
+	if err := e.validateKeywordRanking(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'bm25' argument")
+	}
+
+	if err := e.validateSparseRanking(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'sparse' argument")
+	}
+
+	if err := e.validateAutocut(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'autocut' argument")
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		return e.getClassExploration(ctx, params)
 	}
@@ -97,6 +128,36 @@ func (e *Explorer) GetClass(ctx context.Context,
 	return e.getClassList(ctx, params)
 }
 
+// validateCursor makes sure the cursor is used for plain listings only. The
+// results of a cursor are ordered by UUID, which neither a filter nor a
+// vector search can provide efficiently.
+func (e *Explorer) validateCursor(params GetParams) error {
+	if params.Cursor == nil {
+		return nil
+	}
+
+	if params.Cursor.After != "" && !strfmt.IsUUID(params.Cursor.After) {
+		return errortypes.New(errortypes.KindValidation,
+			"after must be a uuid, got %q", params.Cursor.After)
+	}
+
+	if params.Filters != nil || params.NearVector != nil ||
+		params.NearObject != nil || len(params.ModuleParams) > 0 || params.Group != nil ||
+		params.GroupBy != nil || params.KeywordRanking != nil ||
+		params.SparseRanking != nil {
+		return errortypes.New(errortypes.KindValidation,
+			"after can not be combined with where, near, bm25, sparse, group or "+
+				"groupBy arguments")
+	}
+
+	if params.Pagination != nil && params.Pagination.Offset != 0 {
+		return errortypes.New(errortypes.KindValidation,
+			"after can not be combined with offset")
+	}
+
+	return nil
+}
+
 func (e *Explorer) getClassExploration(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
 	searchVector, err := e.vectorFromParams(ctx, params)
@@ -105,6 +166,10 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 	}
 
 	params.SearchVector = searchVector
+	if params.NearVector != nil {
+		params.SearchVectors = params.NearVector.Vectors
+	}
+	recordQueryVector(ctx, params, e.extractThresholdFromParams(params))
 
 	if len(params.AdditionalProperties.ModuleParams) > 0 {
 		// if a module-specific additional prop is set, assume it needs the vector
@@ -118,6 +183,8 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	res = autocutResults(res, params.Autocut, false)
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -135,16 +202,32 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 		}
 	}
 
+	if params.GroupBy != nil {
+		return e.groupedGetResponse(ctx, res, searchVector, params)
+	}
+
 	return e.searchResultsToGetResponse(ctx, res, searchVector, params)
 }
 
 func (e *Explorer) getClassList(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
+	if params.SparseRanking != nil {
+		ranking, err := e.vectorizeSparseRanking(ctx, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: list class: vectorize sparse query: %v", err)
+		}
+		params.SparseRanking = ranking
+	}
+
 	res, err := e.search.ClassSearch(ctx, params)
 	if err != nil {
 		return nil, errors.Errorf("explorer: list class: search: %v", err)
 	}
 
+	if params.KeywordRanking != nil || params.SparseRanking != nil {
+		res = autocutResults(res, params.Autocut, true)
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -162,6 +245,10 @@ func (e *Explorer) getClassList(ctx context.Context,
 		}
 	}
 
+	if params.GroupBy != nil {
+		return e.groupedGetResponse(ctx, res, nil, params)
+	}
+
 	return e.searchResultsToGetResponse(ctx, res, nil, params)
 }
 
@@ -181,19 +268,40 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			}
 		}
 
-		if searchVector != nil {
-			// Dist is between 0..2, we need to reduce to the user space of 0..1
-			normalizedDist := res.Dist / 2
-			certainty := e.extractCertaintyFromParams(params)
-			if 1-(normalizedDist) < float32(certainty) {
+		if searchVector != nil || params.SearchVectors != nil {
+			metric := e.distanceMetric(params.ClassName)
+			threshold := e.extractThresholdFromParams(params)
+			if threshold.excludesDistance(res.Dist) {
 				continue
 			}
 
-			if params.AdditionalProperties.Certainty {
-				additionalProperties["certainty"] = 1 - normalizedDist
+			if CertaintySupported(metric) {
+				certainty, err := CertaintyFromDistance(metric, res.Dist)
+				if err != nil {
+					return nil, errors.Wrapf(err, "res %s", res.ID)
+				}
+
+				if certainty < float32(threshold.Certainty) {
+					continue
+				}
+
+				if params.AdditionalProperties.Certainty {
+					additionalProperties["certainty"] = certainty
+				}
+			} else if err := e.validateNoCertainty(params, metric); err != nil {
+				return nil, err
+			}
+
+			if params.AdditionalProperties.Distance {
+				additionalProperties["distance"] = res.Dist
 			}
 		}
 
+		if (params.KeywordRanking != nil || params.SparseRanking != nil) &&
+			params.AdditionalProperties.Score {
+			additionalProperties["score"] = res.Score
+		}
+
 		if params.AdditionalProperties.ID {
 			additionalProperties["id"] = res.ID
 		}
@@ -202,6 +310,14 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			additionalProperties["vector"] = res.Vector
 		}
 
+		if e.modulesProvider != nil {
+			props, _ := res.Schema.(map[string]interface{})
+			if err := e.modulesProvider.TransformResult(ctx, params.ClassName,
+				props, params.TransformParams); err != nil {
+				return nil, errors.Wrapf(err, "transform res %s", res.ID)
+			}
+		}
+
 		if len(additionalProperties) > 0 {
 			res.Schema.(map[string]interface{})["_additional"] = additionalProperties
 		}
@@ -265,46 +381,66 @@ func (e *Explorer) exctractAdditionalPropertiesFromRef(ref interface{},
 	}
 }
 
-func (e *Explorer) extractCertaintyFromParams(params GetParams) float64 {
+func (e *Explorer) extractThresholdFromParams(params GetParams) SimilarityThreshold {
 	if params.NearVector != nil {
-		return params.NearVector.Certainty
+		return SimilarityThreshold{
+			Certainty:    params.NearVector.Certainty,
+			Distance:     params.NearVector.Distance,
+			WithDistance: params.NearVector.WithDistance,
+		}
 	}
 
 	if params.NearObject != nil {
-		return params.NearObject.Certainty
+		return SimilarityThreshold{
+			Certainty:    params.NearObject.Certainty,
+			Distance:     params.NearObject.Distance,
+			WithDistance: params.NearObject.WithDistance,
+		}
 	}
 
 	if len(params.ModuleParams) == 1 {
-		return e.extractCertaintyFromModuleParams(params.ModuleParams)
+		return e.extractThresholdFromModuleParams(params.ModuleParams)
 	}
 
-	panic("extractCertainty was called without any known params present")
+	panic("extractThreshold was called without any known params present")
 }
 
-func (e *Explorer) extractCertaintyFromExploreParams(params ExploreParams) float64 {
+func (e *Explorer) extractThresholdFromExploreParams(params ExploreParams) SimilarityThreshold {
 	if params.NearVector != nil {
-		return params.NearVector.Certainty
+		return SimilarityThreshold{
+			Certainty:    params.NearVector.Certainty,
+			Distance:     params.NearVector.Distance,
+			WithDistance: params.NearVector.WithDistance,
+		}
 	}
 
 	if params.NearObject != nil {
-		return params.NearObject.Certainty
+		return SimilarityThreshold{
+			Certainty:    params.NearObject.Certainty,
+			Distance:     params.NearObject.Distance,
+			WithDistance: params.NearObject.WithDistance,
+		}
 	}
 
 	if len(params.ModuleParams) == 1 {
-		return e.extractCertaintyFromModuleParams(params.ModuleParams)
+		return e.extractThresholdFromModuleParams(params.ModuleParams)
 	}
 
-	panic("extractCertainty was called without any known params present")
+	panic("extractThreshold was called without any known params present")
 }
 
-func (e *Explorer) extractCertaintyFromModuleParams(moduleParams map[string]interface{}) float64 {
+func (e *Explorer) extractThresholdFromModuleParams(moduleParams map[string]interface{}) SimilarityThreshold {
 	for _, param := range moduleParams {
 		if nearParam, ok := param.(modulecapabilities.NearParam); ok {
-			return nearParam.GetCertainty()
+			return SimilarityThreshold{
+				Certainty:    nearParam.GetCertainty(),
+				Distance:     nearParam.GetDistance(),
+				WithDistance: nearParam.HasDistance(),
+			}
 		}
 	}
 
-	panic("extractCertaintyFromModuleParams was called without any known module near param present")
+	panic("extractThresholdFromModuleParams was called without any known module near param present")
 }
 
 func (e *Explorer) Concepts(ctx context.Context,
@@ -318,7 +454,8 @@ func (e *Explorer) Concepts(ctx context.Context,
 		return nil, errors.Errorf("vectorize params: %v", err)
 	}
 
-	res, err := e.search.VectorSearch(ctx, vector, params.Offset, params.Limit, nil)
+	res, err := e.search.VectorSearch(ctx, vector, params.Offset, params.Limit,
+		params.Filters)
 	if err != nil {
 		return nil, errors.Errorf("vector search: %v", err)
 	}
@@ -330,9 +467,19 @@ func (e *Explorer) Concepts(ctx context.Context,
 		if err != nil {
 			return nil, errors.Errorf("res %s: %v", item.Beacon, err)
 		}
-		item.Certainty = 1 - dist
-		certainty := e.extractCertaintyFromExploreParams(params)
-		if item.Certainty >= float32(certainty) {
+		item.Dist = dist
+		// the distancer of cross-class searches always calculates cosine
+		// distances, regardless of the metric of the individual classes
+		item.Certainty, err = CertaintyFromDistance(DistanceCosine, dist)
+		if err != nil {
+			return nil, errors.Errorf("res %s: %v", item.Beacon, err)
+		}
+		threshold := e.extractThresholdFromExploreParams(params)
+		if threshold.excludesDistance(dist) {
+			continue
+		}
+
+		if item.Certainty >= float32(threshold.Certainty) {
 			results = append(results, item)
 		}
 	}
@@ -380,6 +527,32 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 	panic("vectorFromParams was called without any known params present")
 }
 
+// NearParamsVector resolves the near params of a search in a single class into
+// the vector to search with and the minimum certainty or maximum distance of
+// the results
+func (e *Explorer) NearParamsVector(ctx context.Context, className string,
+	nearVector *NearVectorParams, nearObject *NearObjectParams,
+	moduleParams map[string]interface{}) ([]float32, SimilarityThreshold, error) {
+	params := GetParams{
+		ClassName:    className,
+		NearVector:   nearVector,
+		NearObject:   nearObject,
+		ModuleParams: moduleParams,
+	}
+
+	err := e.validateNearParams(nearVector, nearObject, moduleParams, className)
+	if err != nil {
+		return nil, SimilarityThreshold{}, errors.Wrap(err, "invalid params")
+	}
+
+	vector, err := e.vectorFromParams(ctx, params)
+	if err != nil {
+		return nil, SimilarityThreshold{}, err
+	}
+
+	return vector, e.extractThresholdFromParams(params), nil
+}
+
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	params ExploreParams) ([]float32, error) {
 	err := e.validateNearParams(params.NearVector, params.NearObject, params.ModuleParams)
@@ -462,6 +635,17 @@ func (e *Explorer) validateNearParams(nearVector *NearVectorParams, nearObject *
 			"which are conflicting, choose one instead")
 	}
 
+	if nearVector != nil {
+		if len(nearVector.Vector) > 0 && len(nearVector.Vectors) > 0 {
+			return errors.Errorf("found both 'vector' and 'vectors' in 'nearVector' " +
+				"which are conflicting, choose one instead")
+		}
+
+		if len(nearVector.Vector) == 0 && len(nearVector.Vectors) == 0 {
+			return errors.Errorf("'nearVector' requires either 'vector' or 'vectors'")
+		}
+	}
+
 	if e.modulesProvider != nil {
 		if len(moduleParams) > 1 {
 			params := []string{}
@@ -487,6 +671,29 @@ func (e *Explorer) validateNearParams(nearVector *NearVectorParams, nearObject *
 		}
 	}
 
+	if nearVector != nil {
+		if err := validateThreshold("nearVector", nearVector.Certainty,
+			nearVector.WithDistance); err != nil {
+			return err
+		}
+	}
+
+	if nearObject != nil {
+		if err := validateThreshold("nearObject", nearObject.Certainty,
+			nearObject.WithDistance); err != nil {
+			return err
+		}
+	}
+
+	for name, value := range moduleParams {
+		if nearParam, ok := value.(modulecapabilities.NearParam); ok {
+			if err := validateThreshold(name, nearParam.GetCertainty(),
+				nearParam.HasDistance()); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// validateAutocut makes sure autocut is only used on results which are
+// ranked by a distance or a score, a plain listing has no jumps to cut at
+func (e *Explorer) validateAutocut(params GetParams) error {
+	if params.Autocut == 0 {
+		return nil
+	}
+
+	if params.Autocut < 0 {
+		return errortypes.New(errortypes.KindValidation,
+			"autocut must be positive, got %d", params.Autocut)
+	}
+
+	if params.NearVector == nil && params.NearObject == nil &&
+		len(params.ModuleParams) == 0 && params.KeywordRanking == nil &&
+		params.SparseRanking == nil {
+		return errortypes.New(errortypes.KindValidation,
+			"autocut requires a near, bm25 or sparse argument to rank the results")
+	}
+
+	if len(params.Sort) > 0 || params.Group != nil {
+		return errortypes.New(errortypes.KindValidation,
+			"autocut can not be combined with sort or group arguments")
+	}
+
+	return nil
+}
+
+// autocutResults truncates the results after the cutOff-th jump in their
+// distances, or in their scores for a keyword search
+func autocutResults(res []search.Result, cutOff int, byScore bool) []search.Result {
+	if cutOff <= 0 {
+		return res
+	}
+
+	values := make([]float32, len(res))
+	for i := range res {
+		if byScore {
+			// scores are descending, negate them so all values are ascending
+			values[i] = -res[i].Score
+		} else {
+			values[i] = res[i].Dist
+		}
+	}
+
+	return res[:autocut(values, cutOff)]
+}
+
+// autocut returns how many of the ascending values to keep so the list ends
+// before the cutOff-th significant jump. The values and their positions are
+// both normalized to [0, 1], a jump ends at a local maximum of the difference
+// between the two, i.e. right after the values rose steeper than the average
+// of the whole list.
+func autocut(values []float32, cutOff int) int {
+	if len(values) <= 2 {
+		return len(values)
+	}
+
+	first, last := values[0], values[len(values)-1]
+	if last <= first {
+		// all values are equal, there is no jump
+		return len(values)
+	}
+
+	step := 1 / float32(len(values)-1)
+	diff := make([]float32, len(values))
+	for i, value := range values {
+		diff[i] = (value-first)/(last-first) - float32(i)*step
+	}
+
+	jumps := 0
+	for i := 1; i < len(diff)-1; i++ {
+		if diff[i] > diff[i-1] && diff[i] > diff[i+1] {
+			jumps++
+			if jumps >= cutOff {
+				return i
+			}
+		}
+	}
+
+	return len(values)
+}
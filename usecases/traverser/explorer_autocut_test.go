@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutocut(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float32
+		cutOff   int
+		expected int
+	}{
+		{
+			name:     "empty",
+			values:   []float32{},
+			cutOff:   1,
+			expected: 0,
+		},
+		{
+			name:     "all equal",
+			values:   []float32{0.3, 0.3, 0.3, 0.3},
+			cutOff:   1,
+			expected: 4,
+		},
+		{
+			name:     "linear without jumps",
+			values:   []float32{1, 2, 3, 4, 5},
+			cutOff:   1,
+			expected: 5,
+		},
+		{
+			name:     "cut at the first jump",
+			values:   []float32{0.1, 0.11, 0.12, 0.5, 0.51, 0.52},
+			cutOff:   1,
+			expected: 3,
+		},
+		{
+			name:     "cut at the second jump",
+			values:   []float32{0.1, 0.11, 0.4, 0.41, 0.42, 0.8, 0.81},
+			cutOff:   2,
+			expected: 5,
+		},
+		{
+			name:     "fewer jumps than the cut off",
+			values:   []float32{0.1, 0.11, 0.4, 0.41, 0.42, 0.8, 0.81},
+			cutOff:   3,
+			expected: 7,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, autocut(test.values, test.cutOff))
+		})
+	}
+}
+
+func TestAutocutResults_ByScore(t *testing.T) {
+	res := []search.Result{
+		{ID: "id1", Score: 9.1},
+		{ID: "id2", Score: 9.0},
+		{ID: "id3", Score: 2.1},
+		{ID: "id4", Score: 2.0},
+		{ID: "id5", Score: 1.9},
+	}
+
+	cut := autocutResults(res, 1, true)
+	require.Len(t, cut, 2)
+	assert.Equal(t, strfmt.UUID("id2"), cut[1].ID)
+}
+
+func Test_Explorer_GetClass_WithAutocut(t *testing.T) {
+	log, _ := test.NewNullLogger()
+
+	newExplorer := func(search *fakeVectorSearcher) *Explorer {
+		explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schemaForFiltersValidation(),
+		})
+		return explorer
+	}
+
+	t.Run("vector search results are cut at the first jump", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "ClassOne",
+			Pagination: &filters.Pagination{Limit: 100},
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Autocut:              1,
+			AdditionalProperties: additional.Properties{ID: true},
+		}
+
+		searchResults := []search.Result{
+			{ID: "id1", Dist: 0.1, Schema: map[string]interface{}{}},
+			{ID: "id2", Dist: 0.12, Schema: map[string]interface{}{}},
+			{ID: "id3", Dist: 0.6, Schema: map[string]interface{}{}},
+			{ID: "id4", Dist: 0.61, Schema: map[string]interface{}{}},
+		}
+
+		search := &fakeVectorSearcher{}
+		search.On("VectorClassSearch", mock.Anything).Return(searchResults, nil)
+
+		res, err := newExplorer(search).GetClass(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		last := res[1].(map[string]interface{})["_additional"].(map[string]interface{})
+		assert.Equal(t, strfmt.UUID("id2"), last["id"])
+	})
+
+	t.Run("autocut without a ranking", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "ClassOne",
+			Pagination: &filters.Pagination{Limit: 100},
+			Autocut:    1,
+		}
+
+		_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Equal(t, errortypes.KindValidation, errortypes.KindOf(err))
+	})
+
+	t.Run("negative autocut", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "ClassOne",
+			Pagination: &filters.Pagination{Limit: 100},
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Autocut: -1,
+		}
+
+		_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Equal(t, errortypes.KindValidation, errortypes.KindOf(err))
+	})
+}
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// validateGroupBy makes sure the results are grouped by a single primitive
+// property of the class
+func (e *Explorer) validateGroupBy(params GetParams) error {
+	if params.GroupBy == nil {
+		return nil
+	}
+
+	groupBy := params.GroupBy
+	if len(groupBy.Path) != 1 {
+		return errortypes.New(errortypes.KindValidation,
+			"path must point to a property of the class, got %v", groupBy.Path)
+	}
+
+	if groupBy.Groups <= 0 || groupBy.ObjectsPerGroup <= 0 {
+		return errortypes.New(errortypes.KindValidation,
+			"groups and objectsPerGroup must be positive, got %d and %d",
+			groupBy.Groups, groupBy.ObjectsPerGroup)
+	}
+
+	if params.Group != nil || params.Cursor != nil {
+		return errortypes.New(errortypes.KindValidation,
+			"groupBy can not be combined with group or after arguments")
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(params.ClassName))
+	if class == nil {
+		return errortypes.New(errortypes.KindValidation,
+			"class %q does not exist in schema", params.ClassName)
+	}
+
+	prop, err := schema.GetPropertyByName(class, groupBy.Path[0])
+	if err != nil {
+		return errortypes.New(errortypes.KindValidation,
+			"property %q does not exist on class %q", groupBy.Path[0], params.ClassName)
+	}
+
+	dataType, err := sch.FindPropertyDataType(prop.DataType)
+	if err != nil {
+		return errors.Wrapf(err, "property %q", prop.Name)
+	}
+
+	if !dataType.IsPrimitive() {
+		return errortypes.New(errortypes.KindValidation,
+			"grouping by the reference property %q is not supported", prop.Name)
+	}
+
+	return nil
+}
+
+type resultGroup struct {
+	value string
+	hits  []search.Result
+}
+
+// groupedGetResponse groups the results, which are already in the order of
+// their relevance, by the value of the groupBy property. Array properties are
+// grouped by their entire value, objects without a value are left out. Every
+// group is represented by its first hit, which lists all hits of the group
+// in _additional { group }. The hits carry the same properties as their
+// group.
+func (e *Explorer) groupedGetResponse(ctx context.Context, input []search.Result,
+	searchVector []float32, params GetParams) ([]interface{}, error) {
+	groupBy := params.GroupBy
+
+	var groups []*resultGroup
+	byValue := map[string]*resultGroup{}
+	for _, res := range input {
+		if searchVector != nil || params.SearchVectors != nil {
+			metric := e.distanceMetric(params.ClassName)
+			threshold := e.extractThresholdFromParams(params)
+			if threshold.excludesDistance(res.Dist) {
+				continue
+			}
+
+			if CertaintySupported(metric) {
+				certainty, err := CertaintyFromDistance(metric, res.Dist)
+				if err != nil {
+					return nil, errors.Wrapf(err, "res %s", res.ID)
+				}
+
+				if certainty < float32(threshold.Certainty) {
+					continue
+				}
+			} else if err := e.validateNoCertainty(params, metric); err != nil {
+				return nil, err
+			}
+		}
+
+		props, ok := res.Schema.(map[string]interface{})
+		if !ok || props[groupBy.Path[0]] == nil {
+			continue
+		}
+
+		value := fmt.Sprint(props[groupBy.Path[0]])
+		group, ok := byValue[value]
+		if !ok {
+			if len(groups) == groupBy.Groups {
+				continue
+			}
+
+			group = &resultGroup{value: value}
+			byValue[value] = group
+			groups = append(groups, group)
+		}
+
+		if len(group.hits) < groupBy.ObjectsPerGroup {
+			group.hits = append(group.hits, res)
+		}
+	}
+
+	output := make([]interface{}, 0, len(groups))
+	for i, group := range groups {
+		hits, err := e.searchResultsToGetResponse(ctx, group.hits, searchVector, params)
+		if err != nil {
+			return nil, err
+		}
+
+		details := map[string]interface{}{
+			"id": i,
+			"groupedBy": map[string]interface{}{
+				"path":  groupBy.Path,
+				"value": group.value,
+			},
+			"count": len(hits),
+			"hits":  hits,
+		}
+
+		if searchVector != nil || params.SearchVectors != nil {
+			minDist, maxDist := group.hits[0].Dist, group.hits[0].Dist
+			for _, hit := range group.hits[1:] {
+				if hit.Dist < minDist {
+					minDist = hit.Dist
+				}
+				if hit.Dist > maxDist {
+					maxDist = hit.Dist
+				}
+			}
+			details["minDistance"] = minDist
+			details["maxDistance"] = maxDist
+		}
+
+		output = append(output, groupRepresentative(hits[0], details))
+	}
+
+	return output, nil
+}
+
+// groupRepresentative copies the first hit of a group and adds the group to
+// its _additional properties. The hit itself is not changed, as it is also
+// part of the group.
+func groupRepresentative(first interface{}, group map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for key, value := range first.(map[string]interface{}) {
+		out[key] = value
+	}
+
+	additional := map[string]interface{}{}
+	if existing, ok := out["_additional"].(map[string]interface{}); ok {
+		for key, value := range existing {
+			additional[key] = value
+		}
+	}
+	additional["group"] = group
+	out["_additional"] = additional
+
+	return out
+}
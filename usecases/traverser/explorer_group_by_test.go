@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_GetClass_WithGroupBy(t *testing.T) {
+	log, _ := test.NewNullLogger()
+
+	newExplorer := func(search *fakeVectorSearcher) *Explorer {
+		explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schemaForFiltersValidation(),
+		})
+		return explorer
+	}
+
+	t.Run("results are grouped in the order of their relevance", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "ClassOne",
+			Pagination: &filters.Pagination{Limit: 100},
+			GroupBy: &GroupByParams{
+				Path:            []string{"string_prop"},
+				Groups:          2,
+				ObjectsPerGroup: 2,
+			},
+			AdditionalProperties: additional.Properties{ID: true, Group: true},
+		}
+
+		searchResults := []search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"string_prop": "b"}},
+			{ID: "id2", Schema: map[string]interface{}{"string_prop": "a"}},
+			{ID: "id3", Schema: map[string]interface{}{}},
+			{ID: "id4", Schema: map[string]interface{}{"string_prop": "b"}},
+			{ID: "id5", Schema: map[string]interface{}{"string_prop": "c"}},
+			{ID: "id6", Schema: map[string]interface{}{"string_prop": "b"}},
+		}
+
+		search := &fakeVectorSearcher{}
+		search.On("ClassSearch", mock.Anything).Return(searchResults, nil)
+
+		res, err := newExplorer(search).GetClass(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		first := res[0].(map[string]interface{})["_additional"].(map[string]interface{})
+		assert.Equal(t, strfmt.UUID("id1"), first["id"])
+		group := first["group"].(map[string]interface{})
+		assert.Equal(t, 0, group["id"])
+		assert.Equal(t, map[string]interface{}{
+			"path":  []string{"string_prop"},
+			"value": "b",
+		}, group["groupedBy"])
+		assert.Equal(t, 2, group["count"])
+		hits := group["hits"].([]interface{})
+		require.Len(t, hits, 2)
+		hit := hits[1].(map[string]interface{})["_additional"].(map[string]interface{})
+		assert.Equal(t, strfmt.UUID("id4"), hit["id"])
+		assert.Nil(t, hits[0].(map[string]interface{})["_additional"].(map[string]interface{})["group"],
+			"the hits themselves are not changed")
+
+		second := res[1].(map[string]interface{})["_additional"].(map[string]interface{})
+		group = second["group"].(map[string]interface{})
+		assert.Equal(t, "a", group["groupedBy"].(map[string]interface{})["value"])
+		assert.Equal(t, 1, group["count"])
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			groupBy       *GroupByParams
+			expectedError string
+		}{
+			{
+				name:    "a nested path",
+				groupBy: &GroupByParams{Path: []string{"ref_prop", "ClassTwo", "string_prop"}, Groups: 1, ObjectsPerGroup: 1},
+				expectedError: "invalid 'groupBy' argument: path must point to a property " +
+					"of the class, got [ref_prop ClassTwo string_prop]",
+			},
+			{
+				name:    "no groups",
+				groupBy: &GroupByParams{Path: []string{"string_prop"}, Groups: 0, ObjectsPerGroup: 1},
+				expectedError: "invalid 'groupBy' argument: groups and objectsPerGroup " +
+					"must be positive, got 0 and 1",
+			},
+			{
+				name:          "an unknown property",
+				groupBy:       &GroupByParams{Path: []string{"unknown"}, Groups: 1, ObjectsPerGroup: 1},
+				expectedError: "invalid 'groupBy' argument: property \"unknown\" does not exist on class \"ClassOne\"",
+			},
+			{
+				name:    "a reference property",
+				groupBy: &GroupByParams{Path: []string{"ref_prop"}, Groups: 1, ObjectsPerGroup: 1},
+				expectedError: "invalid 'groupBy' argument: grouping by the reference " +
+					"property \"ref_prop\" is not supported",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := GetParams{
+					ClassName:  "ClassOne",
+					Pagination: &filters.Pagination{Limit: 100},
+					GroupBy:    test.groupBy,
+				}
+
+				_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			})
+		}
+	})
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// validateKeywordRanking makes sure a bm25 search stays a pure keyword
+// search. It is never combined with a vector search, so it can be served
+// without the vector index or any vectorizer module.
+func (e *Explorer) validateKeywordRanking(params GetParams) error {
+	ranking := params.KeywordRanking
+	if ranking == nil {
+		return nil
+	}
+
+	if params.NearVector != nil || params.NearObject != nil ||
+		len(params.ModuleParams) > 0 || params.Cursor != nil ||
+		len(params.Sort) > 0 || params.Group != nil {
+		return errortypes.New(errortypes.KindValidation,
+			"bm25 can not be combined with near, after, sort or group arguments")
+	}
+
+	if ranking.Query == "" {
+		return errortypes.New(errortypes.KindValidation, "query must not be empty")
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(params.ClassName))
+	if class == nil {
+		return errortypes.New(errortypes.KindValidation,
+			"class %q does not exist in schema", params.ClassName)
+	}
+
+	for _, name := range ranking.Properties {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return errortypes.New(errortypes.KindValidation, "%v", err)
+		}
+
+		if !keywordSearchable(prop) {
+			return errortypes.New(errortypes.KindValidation,
+				"property %q can not be searched by keywords, only indexed text and "+
+					"string properties which are not in the shared storage can", name)
+		}
+	}
+
+	return nil
+}
+
+func keywordSearchable(prop *models.Property) bool {
+	if len(prop.DataType) != 1 {
+		return false
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeString,
+		schema.DataTypeTextArray, schema.DataTypeStringArray:
+	default:
+		return false
+	}
+
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return false
+	}
+
+	return prop.InvertedIndexStorage != models.PropertyInvertedIndexStorageShared
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_GetClass_WithKeywordRanking(t *testing.T) {
+	log, _ := test.NewNullLogger()
+
+	tests := []struct {
+		name          string
+		ranking       *searchparams.KeywordRanking
+		nearVector    *NearVectorParams
+		expectedError string
+	}{
+		{
+			name:    "on all properties",
+			ranking: &searchparams.KeywordRanking{Query: "quick fox"},
+		},
+		{
+			name: "on text and string properties",
+			ranking: &searchparams.KeywordRanking{
+				Query:      "quick fox",
+				Properties: []string{"text_prop", "string_array_prop"},
+			},
+		},
+		{
+			name:       "combined with a vector search",
+			ranking:    &searchparams.KeywordRanking{Query: "quick fox"},
+			nearVector: &NearVectorParams{Vector: []float32{0.8, 0.2, 0.7}},
+			expectedError: "invalid 'bm25' argument: bm25 can not be combined " +
+				"with near, after, sort or group arguments",
+		},
+		{
+			name:          "without a query",
+			ranking:       &searchparams.KeywordRanking{},
+			expectedError: "invalid 'bm25' argument: query must not be empty",
+		},
+		{
+			name: "on a number property",
+			ranking: &searchparams.KeywordRanking{
+				Query:      "quick fox",
+				Properties: []string{"number_prop"},
+			},
+			expectedError: "invalid 'bm25' argument: property \"number_prop\" can not " +
+				"be searched by keywords, only indexed text and string properties " +
+				"which are not in the shared storage can",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := GetParams{
+				ClassName:      "ClassOne",
+				Pagination:     &filters.Pagination{Limit: 100},
+				KeywordRanking: test.ranking,
+				NearVector:     test.nearVector,
+				AdditionalProperties: additional.Properties{
+					Score: true,
+				},
+			}
+
+			searchResults := []search.Result{
+				{ID: "id1", Score: 1.7, Schema: map[string]interface{}{}},
+			}
+			search := &fakeVectorSearcher{}
+			explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schemaForFiltersValidation(),
+			})
+
+			if test.expectedError == "" {
+				search.
+					On("ClassSearch", mock.Anything).
+					Return(searchResults, nil)
+
+				res, err := explorer.GetClass(context.Background(), params)
+				require.Nil(t, err)
+				require.Len(t, res, 1)
+				search.AssertExpectations(t)
+
+				additional := res[0].(map[string]interface{})["_additional"]
+				assert.Equal(t, map[string]interface{}{"score": float32(1.7)}, additional)
+			} else {
+				_, err := explorer.GetClass(context.Background(), params)
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
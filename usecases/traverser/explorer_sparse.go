@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+)
+
+// validateSparseRanking makes sure a sparse search stays a pure sparse
+// search. Just like bm25 it is never combined with another ranking, as the
+// dot products of sparse vectors are not comparable to any distance.
+func (e *Explorer) validateSparseRanking(params GetParams) error {
+	ranking := params.SparseRanking
+	if ranking == nil {
+		return nil
+	}
+
+	if params.NearVector != nil || params.NearObject != nil ||
+		len(params.ModuleParams) > 0 || params.KeywordRanking != nil ||
+		params.Cursor != nil || len(params.Sort) > 0 || params.Group != nil {
+		return errortypes.New(errortypes.KindValidation,
+			"sparse can not be combined with near, bm25, after, sort or group arguments")
+	}
+
+	if ranking.Query == "" {
+		return errortypes.New(errortypes.KindValidation, "query must not be empty")
+	}
+
+	return nil
+}
+
+// vectorizeSparseRanking returns a copy of the sparse ranking with the query
+// turned into a sparse vector by the sparse vectorizer of the class
+func (e *Explorer) vectorizeSparseRanking(ctx context.Context,
+	params GetParams) (*searchparams.SparseRanking, error) {
+	if e.modulesProvider == nil {
+		return nil, errors.Errorf("sparse search requires a sparse vectorizer module")
+	}
+
+	vector, err := e.modulesProvider.SparseVectorFromQuery(ctx, params.ClassName,
+		params.SparseRanking.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	ranking := *params.SparseRanking
+	ranking.Vector = vector
+	return &ranking, nil
+}
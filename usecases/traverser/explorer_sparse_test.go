@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_GetClass_WithSparseRanking(t *testing.T) {
+	log, _ := test.NewNullLogger()
+
+	tests := []struct {
+		name           string
+		ranking        *searchparams.SparseRanking
+		keywordRanking *searchparams.KeywordRanking
+		nearVector     *NearVectorParams
+		expectedError  string
+	}{
+		{
+			name:    "with a query",
+			ranking: &searchparams.SparseRanking{Query: "quick fox"},
+		},
+		{
+			name:       "combined with a vector search",
+			ranking:    &searchparams.SparseRanking{Query: "quick fox"},
+			nearVector: &NearVectorParams{Vector: []float32{0.8, 0.2, 0.7}},
+			expectedError: "invalid 'sparse' argument: sparse can not be combined " +
+				"with near, bm25, after, sort or group arguments",
+		},
+		{
+			name:           "combined with a keyword search",
+			ranking:        &searchparams.SparseRanking{Query: "quick fox"},
+			keywordRanking: &searchparams.KeywordRanking{Query: "quick fox"},
+			expectedError: "invalid 'sparse' argument: sparse can not be combined " +
+				"with near, bm25, after, sort or group arguments",
+		},
+		{
+			name:          "without a query",
+			ranking:       &searchparams.SparseRanking{},
+			expectedError: "invalid 'sparse' argument: query must not be empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := GetParams{
+				ClassName:      "ClassOne",
+				Pagination:     &filters.Pagination{Limit: 100},
+				SparseRanking:  test.ranking,
+				KeywordRanking: test.keywordRanking,
+				NearVector:     test.nearVector,
+				AdditionalProperties: additional.Properties{
+					Score: true,
+				},
+			}
+
+			searchResults := []search.Result{
+				{ID: "id1", Score: 2.5, Schema: map[string]interface{}{}},
+			}
+			search := &fakeVectorSearcher{}
+			explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schemaForFiltersValidation(),
+			})
+
+			if test.expectedError == "" {
+				search.
+					On("ClassSearch", mock.MatchedBy(func(params GetParams) bool {
+						return assert.ObjectsAreEqual(map[string]float32{
+							"quick": 1, "fox": 1,
+						}, params.SparseRanking.Vector)
+					})).
+					Return(searchResults, nil)
+
+				res, err := explorer.GetClass(context.Background(), params)
+				require.Nil(t, err)
+				require.Len(t, res, 1)
+				search.AssertExpectations(t)
+
+				additional := res[0].(map[string]interface{})["_additional"]
+				assert.Equal(t, map[string]interface{}{"score": float32(2.5)}, additional)
+				assert.Nil(t, test.ranking.Vector, "the params are not mutated")
+			} else {
+				_, err := explorer.GetClass(context.Background(), params)
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ package traverser
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -84,6 +85,68 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when an explore param is set for nearVector with several vectors", func(t *testing.T) {
+		params := GetParams{
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vectors: [][]float32{{0.8, 0.2, 0.7}, {0.1, 0.9, 0.3}},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+		}
+
+		searchResults := []search.Result{
+			{
+				ID: "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVectors = [][]float32{{0.8, 0.2, 0.7}, {0.1, 0.9, 0.3}}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("vector search must be called with right params", func(t *testing.T) {
+			assert.Nil(t, err)
+			search.AssertExpectations(t)
+		})
+
+		t.Run("response must contain concepts", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo",
+				}, res[0])
+		})
+	})
+
+	t.Run("when nearVector has both a vector and several vectors", func(t *testing.T) {
+		params := GetParams{
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vector:  []float32{0.8, 0.2, 0.7},
+				Vectors: [][]float32{{0.8, 0.2, 0.7}},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "found both 'vector' and 'vectors'")
+	})
+
 	t.Run("when an explore param is set for nearObject without id and beacon", func(t *testing.T) {
 		// TODO: this is a module specific test case, which relies on the
 		// text2vec-contextionary module
@@ -1721,6 +1784,7 @@ func Test_Explorer_GetClass_With_Modules(t *testing.T) {
 			SearchVector: []float32{1.0, 2.0, 3.0},
 			AdditionalProperties: additional.Properties{
 				Certainty: true,
+				Distance:  true,
 			},
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -1770,6 +1834,8 @@ func Test_Explorer_GetClass_With_Modules(t *testing.T) {
 			assert.Contains(t, additionalMap, "certainty")
 			// Certainty is fixed to 0.69 in this mock
 			assert.InEpsilon(t, 0.31, additionalMap.(map[string]interface{})["certainty"], 0.000001)
+			// the raw cosine distance is reported alongside
+			assert.InEpsilon(t, 1.38, additionalMap.(map[string]interface{})["distance"], 0.000001)
 		})
 	})
 
@@ -1934,9 +2000,10 @@ func Test_Explorer_GetClass_With_Modules(t *testing.T) {
 	})
 }
 
+// newFakeDistancer returns a cosine distance of 1, i.e. a certainty of 0.5
 func newFakeDistancer() func(a, b []float32) (float32, error) {
 	return func(source, target []float32) (float32, error) {
-		return 0.5, nil
+		return 1, nil
 	}
 }
 
@@ -1953,6 +2020,8 @@ func ptFloat32(in float32) *float32 {
 
 type fakeModulesProvider struct {
 	customC11yModule *fakeText2vecContextionaryModule
+	transformFn      func(className string, props map[string]interface{},
+		params map[string]interface{}) error
 }
 
 func (p *fakeModulesProvider) VectorFromSearchParam(ctx context.Context, className,
@@ -1993,6 +2062,23 @@ func (p *fakeModulesProvider) ListExploreAdditionalExtend(ctx context.Context, i
 	return p.additionalExtend(ctx, in, moduleParams, nil, "ExploreList")
 }
 
+func (p *fakeModulesProvider) TransformResult(ctx context.Context, className string,
+	props map[string]interface{}, params map[string]interface{}) error {
+	if p.transformFn == nil {
+		return nil
+	}
+	return p.transformFn(className, props, params)
+}
+
+func (p *fakeModulesProvider) SparseVectorFromQuery(ctx context.Context,
+	className, query string) (map[string]float32, error) {
+	out := map[string]float32{}
+	for _, word := range strings.Fields(query) {
+		out[word] = 1
+	}
+	return out, nil
+}
+
 func (p *fakeModulesProvider) additionalExtend(ctx context.Context,
 	in search.Results, moduleParams map[string]interface{},
 	searchVector []float32, capability string) (search.Results, error) {
@@ -2067,7 +2153,7 @@ func getFakeModulesProviderWithCustomExtenders(
 	customPathBuilder *fakePathBuilder,
 ) ModulesProvider {
 	return &fakeModulesProvider{
-		newFakeText2vecContextionaryModuleWithCustomExtender(customExtender, customProjector, customPathBuilder),
+		customC11yModule: newFakeText2vecContextionaryModuleWithCustomExtender(customExtender, customProjector, customPathBuilder),
 	}
 }
 
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_GetClass_TransformResult(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	params := GetParams{
+		ClassName:            "BestClass",
+		Pagination:           &filters.Pagination{Limit: 100},
+		AdditionalProperties: additional.Properties{ID: true},
+		TransformParams:      map[string]interface{}{"truncate": 3},
+	}
+
+	searchResults := func() []search.Result {
+		return []search.Result{
+			{
+				ID:     "id1",
+				Schema: map[string]interface{}{"name": "Foobar"},
+			},
+			{
+				ID:     "id2",
+				Schema: map[string]interface{}{"name": "Baz"},
+			},
+		}
+	}
+
+	t.Run("transforms the properties of every result", func(t *testing.T) {
+		var classNames []string
+		modulesProvider := &fakeModulesProvider{
+			transformFn: func(className string, props map[string]interface{},
+				params map[string]interface{}) error {
+				classNames = append(classNames, className)
+				assert.NotContains(t, props, "_additional")
+				name := props["name"].(string)
+				if limit := params["truncate"].(int); len(name) > limit {
+					props["name"] = name[:limit] + "..."
+				}
+				return nil
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		searcher.On("ClassSearch", mock.Anything).Return(searchResults(), nil)
+		explorer := NewExplorer(searcher, newFakeDistancer(), log, modulesProvider)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"name":        "Foo...",
+				"_additional": map[string]interface{}{"id": searchResults()[0].ID},
+			},
+			map[string]interface{}{
+				"name":        "Baz",
+				"_additional": map[string]interface{}{"id": searchResults()[1].ID},
+			},
+		}, res)
+		assert.Equal(t, []string{"BestClass", "BestClass"}, classNames)
+	})
+
+	t.Run("fails the query if a transformation fails", func(t *testing.T) {
+		modulesProvider := &fakeModulesProvider{
+			transformFn: func(className string, props map[string]interface{},
+				params map[string]interface{}) error {
+				return fmt.Errorf("cannot render template")
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		searcher.On("ClassSearch", mock.Anything).Return(searchResults(), nil)
+		explorer := NewExplorer(searcher, newFakeDistancer(), log, modulesProvider)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "cannot render template")
+	})
+}
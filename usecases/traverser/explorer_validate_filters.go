@@ -48,9 +48,17 @@ func (e *Explorer) validateClause(sch schema.Schema, clause *filters.Clause) err
 
 	// validate current
 
+	if clause.Operator == filters.OperatorIsNull {
+		return e.validateIsNullClause(sch, clause)
+	}
+
 	className := clause.On.GetInnerMost().Class
 	propName := clause.On.GetInnerMost().Property
 
+	if filters.IsTimestampProp(string(propName)) {
+		return e.validateTimestampClause(sch, clause)
+	}
+
 	if propName == "id" {
 		// special case for the uuid search
 		if clause.Value.Type == schema.DataTypeString {
@@ -102,6 +110,69 @@ func (e *Explorer) validateClause(sch schema.Schema, clause *filters.Clause) err
 	return nil
 }
 
+// validateIsNullClause validates a filter with the IsNull operator. It can be
+// used on props of any type, but always takes a boolean and requires the
+// null state of the class to be indexed.
+func (e *Explorer) validateIsNullClause(sch schema.Schema, clause *filters.Clause) error {
+	className := clause.On.GetInnerMost().Class
+	propName := clause.On.GetInnerMost().Property
+
+	if clause.Value == nil || clause.Value.Type != schema.DataTypeBoolean {
+		return errors.Errorf("operator IsNull on %q: must use \"valueBoolean\" "+
+			"to specify whether the prop is null", propName)
+	}
+
+	if propName == "id" {
+		return errors.Errorf("operator IsNull cannot be used on special path " +
+			"[\"id\"]: every object has an id")
+	}
+
+	class := sch.FindClassByName(className)
+	if class == nil {
+		return errors.Errorf("class %q does not exist in schema",
+			className)
+	}
+
+	if _, err := sch.GetProperty(className, propName); err != nil {
+		return err
+	}
+
+	if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexNullState {
+		return errors.Errorf("operator IsNull on %q: class %q must be created "+
+			"with invertedIndexConfig.indexNullState enabled", propName, className)
+	}
+
+	return nil
+}
+
+// validateTimestampClause validates a filter on the special paths of the
+// creation and last update time. The time is either specified as a date or as
+// a string of the milliseconds since the epoch.
+func (e *Explorer) validateTimestampClause(sch schema.Schema, clause *filters.Clause) error {
+	className := clause.On.GetInnerMost().Class
+	propName := clause.On.GetInnerMost().Property
+
+	if clause.Value == nil || (clause.Value.Type != schema.DataTypeDate &&
+		clause.Value.Type != schema.DataTypeString) {
+		return errors.Errorf("using special path [\"%s\"] to filter by timestamp: "+
+			"must use \"valueDate\" or \"valueString\" with the milliseconds "+
+			"since the epoch to specify the time", propName)
+	}
+
+	class := sch.FindClassByName(className)
+	if class == nil {
+		return errors.Errorf("class %q does not exist in schema",
+			className)
+	}
+
+	if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+		return errors.Errorf("using special path [\"%s\"]: class %q must be "+
+			"created with invertedIndexConfig.indexTimestamps enabled", propName, className)
+	}
+
+	return nil
+}
+
 func valueNameFromDataType(dt schema.DataType) string {
 	return "value" + strings.ToUpper(string(dt[0])) + string(dt[1:])
 }
@@ -315,6 +315,44 @@ func Test_Explorer_GetClass_WithFilters(t *testing.T) {
 		buildInvalidRefCountTests(filters.OperatorEqual, []interface{}{"ref_prop"},
 			schema.DataTypeInt, allValueTypesExcept(schema.DataTypeInt), "foo"),
 
+		// null state filters
+		{
+			{
+				name: "is null on a primitive prop",
+				filters: buildFilter(filters.OperatorIsNull, []interface{}{"string_prop"},
+					schema.DataTypeBoolean, true),
+				expectedError: nil,
+			},
+			{
+				name: "is null on a ref prop",
+				filters: buildFilter(filters.OperatorIsNull, []interface{}{"ref_prop"},
+					schema.DataTypeBoolean, false),
+				expectedError: nil,
+			},
+			{
+				name: "is null with a non-boolean value",
+				filters: buildFilter(filters.OperatorIsNull, []interface{}{"int_prop"},
+					schema.DataTypeInt, 5),
+				expectedError: errors.Errorf("invalid 'where' filter: operator IsNull " +
+					"on \"int_prop\": must use \"valueBoolean\" to specify whether the prop is null"),
+			},
+			{
+				name: "is null on the id",
+				filters: buildFilter(filters.OperatorIsNull, []interface{}{"id"},
+					schema.DataTypeBoolean, true),
+				expectedError: errors.Errorf("invalid 'where' filter: operator IsNull " +
+					"cannot be used on special path [\"id\"]: every object has an id"),
+			},
+			{
+				name: "is null on a class without null state index",
+				filters: buildFilter(filters.OperatorIsNull, []interface{}{"ref_prop", "ClassTwo", "string_prop"},
+					schema.DataTypeBoolean, true),
+				expectedError: errors.Errorf("invalid 'where' filter: operator IsNull " +
+					"on \"string_prop\": class \"ClassTwo\" must be created with " +
+					"invertedIndexConfig.indexNullState enabled"),
+			},
+		},
+
 		// id filters
 		{
 			{
@@ -331,6 +369,38 @@ func Test_Explorer_GetClass_WithFilters(t *testing.T) {
 					"[\"id\"] to filter by uuid: must use \"valueString\" to specify the id"),
 			},
 		},
+
+		// timestamp filters
+		{
+			{
+				name: "filter by creation time as a date",
+				filters: buildFilter(filters.OperatorGreaterThan, []interface{}{"_creationTimeUnix"},
+					schema.DataTypeDate, "2021-01-01T00:00:00Z"),
+				expectedError: nil,
+			},
+			{
+				name: "filter by last update time as a string",
+				filters: buildFilter(filters.OperatorGreaterThanEqual, []interface{}{"_lastUpdateTimeUnix"},
+					schema.DataTypeString, "1609459200000"),
+				expectedError: nil,
+			},
+			{
+				name: "filter by timestamp with wrong type",
+				filters: buildFilter(filters.OperatorEqual, []interface{}{"_lastUpdateTimeUnix"},
+					schema.DataTypeInt, 1609459200),
+				expectedError: errors.Errorf("invalid 'where' filter: using special path " +
+					"[\"_lastUpdateTimeUnix\"] to filter by timestamp: must use \"valueDate\" " +
+					"or \"valueString\" with the milliseconds since the epoch to specify the time"),
+			},
+			{
+				name: "filter by timestamp on a class without timestamp index",
+				filters: buildFilter(filters.OperatorEqual, []interface{}{"ref_prop", "ClassTwo", "_creationTimeUnix"},
+					schema.DataTypeString, "1609459200000"),
+				expectedError: errors.Errorf("invalid 'where' filter: using special path " +
+					"[\"_creationTimeUnix\"]: class \"ClassTwo\" must be created with " +
+					"invertedIndexConfig.indexTimestamps enabled"),
+			},
+		},
 	}
 
 	for _, outertest := range tests {
@@ -398,6 +468,10 @@ func schemaForFiltersValidation() schema.Schema {
 			Classes: []*models.Class{
 				{
 					Class: "ClassOne",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						IndexNullState:  true,
+						IndexTimestamps: true,
+					},
 					Properties: []*models.Property{
 						{
 							Name:     "string_prop",
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// validateSort makes sure the results are only sorted by the timestamps of
+// plain listings, with or without a filter. The timestamps are served from
+// their index, which only exists if the class has indexTimestamps enabled.
+func (e *Explorer) validateSort(params GetParams) error {
+	if len(params.Sort) == 0 {
+		return nil
+	}
+
+	if len(params.Sort) > 1 {
+		return errortypes.New(errortypes.KindValidation,
+			"only a single sort clause is supported, got %d", len(params.Sort))
+	}
+
+	sort := params.Sort[0]
+	if len(sort.Path) != 1 || !filters.IsTimestampProp(sort.Path[0]) {
+		return errortypes.New(errortypes.KindValidation,
+			"sorting is only supported on the special paths [\"%s\"] and [\"%s\"], got %v",
+			filters.InternalPropCreationTimeUnix, filters.InternalPropLastUpdateTimeUnix,
+			sort.Path)
+	}
+
+	if sort.Order != filters.SortOrderAsc && sort.Order != filters.SortOrderDesc {
+		return errortypes.New(errortypes.KindValidation,
+			"order must be %q or %q, got %q", filters.SortOrderAsc,
+			filters.SortOrderDesc, sort.Order)
+	}
+
+	if params.Cursor != nil || params.NearVector != nil ||
+		params.NearObject != nil || len(params.ModuleParams) > 0 {
+		return errortypes.New(errortypes.KindValidation,
+			"sort can not be combined with after or near arguments")
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(params.ClassName))
+	if class == nil {
+		return errortypes.New(errortypes.KindValidation,
+			"class %q does not exist in schema", params.ClassName)
+	}
+
+	if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+		return errortypes.New(errortypes.KindValidation,
+			"sorting by %q requires the class %q to be created with "+
+				"invertedIndexConfig.indexTimestamps enabled", sort.Path[0], params.ClassName)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explorer_GetClass_WithSort(t *testing.T) {
+	log, _ := test.NewNullLogger()
+
+	tests := []struct {
+		name          string
+		className     string
+		sort          []filters.Sort
+		nearVector    *NearVectorParams
+		expectedError string
+	}{
+		{
+			name:      "sort by last update time",
+			className: "ClassOne",
+			sort: []filters.Sort{
+				{Path: []string{"_lastUpdateTimeUnix"}, Order: "desc"},
+			},
+		},
+		{
+			name:      "sort by a regular prop",
+			className: "ClassOne",
+			sort: []filters.Sort{
+				{Path: []string{"string_prop"}, Order: "asc"},
+			},
+			expectedError: "invalid 'sort' argument: sorting is only supported on " +
+				"the special paths [\"_creationTimeUnix\"] and [\"_lastUpdateTimeUnix\"], " +
+				"got [string_prop]",
+		},
+		{
+			name:      "sort with an invalid order",
+			className: "ClassOne",
+			sort: []filters.Sort{
+				{Path: []string{"_creationTimeUnix"}, Order: "newest"},
+			},
+			expectedError: "invalid 'sort' argument: order must be \"asc\" or " +
+				"\"desc\", got \"newest\"",
+		},
+		{
+			name:      "sort combined with a vector search",
+			className: "ClassOne",
+			sort: []filters.Sort{
+				{Path: []string{"_creationTimeUnix"}, Order: "asc"},
+			},
+			nearVector: &NearVectorParams{Vector: []float32{0.8, 0.2, 0.7}},
+			expectedError: "invalid 'sort' argument: sort can not be combined " +
+				"with after or near arguments",
+		},
+		{
+			name:      "sort on a class without timestamp index",
+			className: "ClassTwo",
+			sort: []filters.Sort{
+				{Path: []string{"_creationTimeUnix"}, Order: "asc"},
+			},
+			expectedError: "invalid 'sort' argument: sorting by \"_creationTimeUnix\" " +
+				"requires the class \"ClassTwo\" to be created with " +
+				"invertedIndexConfig.indexTimestamps enabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := GetParams{
+				ClassName:  test.className,
+				Pagination: &filters.Pagination{Limit: 100},
+				Sort:       test.sort,
+				NearVector: test.nearVector,
+			}
+
+			searchResults := []search.Result{{ID: "id1"}}
+			search := &fakeVectorSearcher{}
+			explorer := NewExplorer(search, newFakeDistancer(), log, getFakeModulesProvider())
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schemaForFiltersValidation(),
+			})
+
+			if test.expectedError == "" {
+				search.
+					On("ClassSearch", mock.Anything).
+					Return(searchResults, nil)
+
+				res, err := explorer.GetClass(context.Background(), params)
+				require.Nil(t, err)
+				assert.Len(t, res, 1)
+				search.AssertExpectations(t)
+			} else {
+				_, err := explorer.GetClass(context.Background(), params)
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -33,16 +33,6 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-type fakeLocks struct{}
-
-func (f *fakeLocks) LockConnector() (func() error, error) {
-	return func() error { return nil }, nil
-}
-
-func (f *fakeLocks) LockSchema() (func() error, error) {
-	return func() error { return nil }, nil
-}
-
 type ClassIndexCheck interface {
 	PropertyIndexed(property string) bool
 	VectorizeClassName() bool
@@ -158,6 +148,20 @@ func (f *fakeExplorer) Concepts(ctx context.Context, p ExploreParams) ([]search.
 	return nil, nil
 }
 
+func (f *fakeExplorer) NearParamsVector(ctx context.Context, className string,
+	nearVector *NearVectorParams, nearObject *NearObjectParams,
+	moduleParams map[string]interface{}) ([]float32, SimilarityThreshold, error) {
+	if nearVector != nil {
+		return nearVector.Vector, SimilarityThreshold{
+			Certainty:    nearVector.Certainty,
+			Distance:     nearVector.Distance,
+			WithDistance: nearVector.WithDistance,
+		}, nil
+	}
+
+	return []float32{1, 2, 3}, SimilarityThreshold{}, nil
+}
+
 type fakeSchemaGetter struct {
 	schema schema.Schema
 }
@@ -167,7 +171,7 @@ func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
 }
 
 func (f *fakeSchemaGetter) ShardingState(class string) *sharding.State {
-	panic("not implemented")
+	return nil
 }
 
 type fakeInterpretation struct{}
@@ -325,12 +329,22 @@ type nearCustomTextParams struct {
 	MoveTo       nearExploreMove
 	MoveAwayFrom nearExploreMove
 	Certainty    float64
+	Distance     float64
+	WithDistance bool
 }
 
 func (p nearCustomTextParams) GetCertainty() float64 {
 	return p.Certainty
 }
 
+func (p nearCustomTextParams) GetDistance() float64 {
+	return p.Distance
+}
+
+func (p nearCustomTextParams) HasDistance() bool {
+	return p.WithDistance
+}
+
 type nearExploreMove struct {
 	Values  []string
 	Force   float32
@@ -456,6 +470,10 @@ func (m *nearCustomTextModule) getNearCustomTextArgument(classname string) *grap
 						Description: descriptions.Certainty,
 						Type:        graphql.Float,
 					},
+					"distance": &graphql.InputObjectFieldConfig{
+						Description: descriptions.DistanceThreshold,
+						Type:        graphql.Float,
+					},
 				},
 				Description: descriptions.GetWhereInpObj,
 			},
@@ -477,6 +495,12 @@ func (m *nearCustomTextModule) extractNearCustomTextArgument(source map[string]i
 		args.Certainty = certainty.(float64)
 	}
 
+	distance, ok := source["distance"]
+	if ok {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
 	// moveTo is an optional arg, so it could be nil
 	moveTo, ok := source["moveTo"]
 	if ok {
@@ -13,21 +13,19 @@ package traverser
 
 import (
 	"context"
+	"math/rand"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
 	"github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
 
-type locks interface {
-	LockConnector() (func() error, error)
-	LockSchema() (func() error, error)
-}
-
 type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
@@ -35,12 +33,36 @@ type authorizer interface {
 // Traverser can be used to dynamically traverse the knowledge graph
 type Traverser struct {
 	config         *config.WeaviateConfig
-	locks          locks
 	logger         logrus.FieldLogger
 	authorizer     authorizer
 	vectorSearcher VectorSearcher
 	explorer       explorer
 	schemaGetter   schema.SchemaGetter
+
+	// slowQueryThreshold is a time.Duration which is accessed atomically, as
+	// it can be changed at runtime
+	slowQueryThreshold int64
+
+	// shadowReads limits the queries mirrored to shadow classes, see shadowGet
+	shadowReads chan struct{}
+	sample      func() float64
+
+	// admission limits the concurrent queries per class of query, it is nil
+	// if admission control is disabled
+	admission *admission.Controller
+
+	// masker replaces sensitive values in the results of Get queries, it is
+	// nil if no module can mask values
+	masker MaskerProvider
+
+	// queryLog records a sample of the vector searches, it is nil if the
+	// query log is disabled
+	queryLog           QueryLogRepo
+	queryLogSampleRate float64
+
+	// propertyUsage records the properties accessed by a sample of the
+	// queries, it is nil if the tracking is disabled
+	propertyUsage *propertyusage.Tracker
 }
 
 type VectorSearcher interface {
@@ -52,24 +74,40 @@ type VectorSearcher interface {
 type explorer interface {
 	GetClass(ctx context.Context, params GetParams) ([]interface{}, error)
 	Concepts(ctx context.Context, params ExploreParams) ([]search.Result, error)
+	NearParamsVector(ctx context.Context, className string,
+		nearVector *NearVectorParams, nearObject *NearObjectParams,
+		moduleParams map[string]interface{}) ([]float32, SimilarityThreshold, error)
 }
 
 // NewTraverser to traverse the knowledge graph
-func NewTraverser(config *config.WeaviateConfig, locks locks,
+func NewTraverser(config *config.WeaviateConfig,
 	logger logrus.FieldLogger, authorizer authorizer,
 	vectorSearcher VectorSearcher,
 	explorer explorer, schemaGetter schema.SchemaGetter) *Traverser {
 	return &Traverser{
 		config:         config,
-		locks:          locks,
 		logger:         logger,
 		authorizer:     authorizer,
 		vectorSearcher: vectorSearcher,
 		explorer:       explorer,
 		schemaGetter:   schemaGetter,
+		shadowReads:    make(chan struct{}, shadowMaxReadsInFlight),
+		sample:         rand.Float64,
 	}
 }
 
+// SetAdmission enables limiting the number of concurrent queries per class of
+// query
+func (t *Traverser) SetAdmission(controller *admission.Controller) {
+	t.admission = controller
+}
+
+// SetPropertyUsage records which properties the Get and Aggregate queries
+// filter on and return
+func (t *Traverser) SetPropertyUsage(tracker *propertyusage.Tracker) {
+	t.propertyUsage = tracker
+}
+
 // TraverserRepo describes the dependencies of the Traverser UC to the
 // connected database
 type TraverserRepo interface {
@@ -13,10 +13,15 @@ package traverser
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/errortypes"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+	"github.com/semi-technologies/weaviate/usecases/admission"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
 )
 
 // Aggregate resolves meta queries
@@ -27,11 +32,23 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
-	unlock, err := t.locks.LockConnector()
+	release, err := t.admission.Acquire(ctx, admission.ClassAggregation)
 	if err != nil {
-		return nil, fmt.Errorf("could not acquire lock: %v", err)
+		return nil, err
+	}
+	defer release()
+
+	defer t.logIfSlow("aggregate", params.ClassName.String(), time.Now())
+
+	if err := t.validateTenant(params.ClassName.String(), params.Tenant); err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, params.Tenant)
+
+	if err := t.resolveAggregateSearchVector(ctx, params); err != nil {
+		return nil, err
 	}
-	defer unlock()
 
 	inspector := newTypeInspector(t.schemaGetter)
 
@@ -40,5 +57,59 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	aggregated := make([]string, len(params.Properties))
+	for i, prop := range params.Properties {
+		aggregated[i] = prop.Name.String()
+	}
+	t.propertyUsage.Record(propertyusage.Query{
+		Class:    params.ClassName.String(),
+		Filters:  params.Filters,
+		Returned: aggregated,
+	})
+
 	return inspector.WithTypes(res, *params)
 }
+
+// resolveAggregateSearchVector turns the near params of an aggregation into
+// the search vector and certainty cutoff the database aggregates over
+func (t *Traverser) resolveAggregateSearchVector(ctx context.Context,
+	params *aggregation.Params) error {
+	hasNearParams := params.NearVector != nil || params.NearObject != nil ||
+		len(params.ModuleParams) > 0
+
+	if params.ObjectLimit != nil {
+		if !hasNearParams {
+			return errortypes.New(errortypes.KindValidation,
+				"objectLimit can only be used together with a near<Media> argument")
+		}
+
+		if *params.ObjectLimit <= 0 {
+			return errortypes.New(errortypes.KindValidation,
+				"objectLimit must be a positive number, got %d", *params.ObjectLimit)
+		}
+	}
+
+	if !hasNearParams {
+		return nil
+	}
+
+	vector, threshold, err := t.explorer.NearParamsVector(ctx,
+		params.ClassName.String(), params.NearVector, params.NearObject,
+		params.ModuleParams)
+	if err != nil {
+		return errors.Wrap(err, "aggregate: vectorize params")
+	}
+
+	if params.ObjectLimit == nil && threshold.Certainty <= 0 &&
+		!threshold.WithDistance {
+		return errortypes.New(errortypes.KindValidation,
+			"a near<Media> argument in an aggregation requires objectLimit, "+
+				"a certainty or a distance to limit the objects to aggregate over")
+	}
+
+	params.SearchVector = vector
+	params.Certainty = threshold.Certainty
+	params.Distance = threshold.Distance
+	params.WithDistance = threshold.WithDistance
+	return nil
+}
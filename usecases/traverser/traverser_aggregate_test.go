@@ -28,13 +28,12 @@ func Test_Traverser_Aggregate(t *testing.T) {
 	t.Run("with aggregation only", func(t *testing.T) {
 		principal := &models.Principal{}
 		logger, _ := test.NewNullLogger()
-		locks := &fakeLocks{}
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := &fakeVectorRepo{}
 		explorer := &fakeExplorer{}
 		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
 
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorRepo, explorer, schemaGetter)
 
 		params := aggregation.Params{
@@ -111,13 +110,12 @@ func Test_Traverser_Aggregate(t *testing.T) {
 	t.Run("with a mix of aggregation and type inspection", func(t *testing.T) {
 		principal := &models.Principal{}
 		logger, _ := test.NewNullLogger()
-		locks := &fakeLocks{}
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := &fakeVectorRepo{}
 		explorer := &fakeExplorer{}
 		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
 
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorRepo, explorer, schemaGetter)
 
 		params := aggregation.Params{
@@ -260,6 +258,133 @@ func Test_Traverser_Aggregate(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, &expectedResult, res)
 	})
+
+	t.Run("with a near param", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := &fakeVectorRepo{}
+		explorer := &fakeExplorer{}
+		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
+
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
+			vectorRepo, explorer, schemaGetter)
+
+		objectLimit := 10
+		params := aggregation.Params{
+			ClassName:        "MyClass",
+			IncludeMetaCount: true,
+			NearVector: &NearVectorParams{
+				Vector:    []float32{0.1, 0.2},
+				Certainty: 0.8,
+			},
+			ObjectLimit: &objectLimit,
+		}
+
+		expectedParams := params
+		expectedParams.SearchVector = []float32{0.1, 0.2}
+		expectedParams.Certainty = 0.8
+
+		agg := aggregation.Result{
+			Groups: []aggregation.Group{
+				aggregation.Group{
+					Count:      7,
+					Properties: map[string]aggregation.Property{},
+				},
+			},
+		}
+
+		vectorRepo.On("Aggregate", expectedParams).Return(&agg, nil)
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+	})
+
+	t.Run("with nearVector and a distance", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := &fakeVectorRepo{}
+		explorer := &fakeExplorer{}
+		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
+
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
+			vectorRepo, explorer, schemaGetter)
+
+		params := aggregation.Params{
+			ClassName:        "MyClass",
+			IncludeMetaCount: true,
+			NearVector: &NearVectorParams{
+				Vector:       []float32{0.1, 0.2},
+				Distance:     0.3,
+				WithDistance: true,
+			},
+		}
+
+		expectedParams := params
+		expectedParams.SearchVector = []float32{0.1, 0.2}
+		expectedParams.Distance = 0.3
+		expectedParams.WithDistance = true
+
+		agg := aggregation.Result{}
+		vectorRepo.On("Aggregate", expectedParams).Return(&agg, nil)
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+	})
+
+	t.Run("with invalid combinations of near params and objectLimit", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger,
+			&fakeAuthorizer{}, &fakeVectorRepo{}, &fakeExplorer{},
+			&fakeSchemaGetter{aggregateTestSchema})
+
+		objectLimit := 10
+		negativeObjectLimit := -1
+
+		tests := []struct {
+			name          string
+			params        aggregation.Params
+			expectedError string
+		}{
+			{
+				name: "objectLimit without a near param",
+				params: aggregation.Params{
+					ClassName:   "MyClass",
+					ObjectLimit: &objectLimit,
+				},
+				expectedError: "objectLimit can only be used together with a " +
+					"near<Media> argument",
+			},
+			{
+				name: "negative objectLimit",
+				params: aggregation.Params{
+					ClassName:   "MyClass",
+					NearVector:  &NearVectorParams{Vector: []float32{0.1, 0.2}},
+					ObjectLimit: &negativeObjectLimit,
+				},
+				expectedError: "objectLimit must be a positive number, got -1",
+			},
+			{
+				name: "near param without objectLimit, certainty or distance",
+				params: aggregation.Params{
+					ClassName:  "MyClass",
+					NearVector: &NearVectorParams{Vector: []float32{0.1, 0.2}},
+				},
+				expectedError: "a near<Media> argument in an aggregation requires " +
+					"objectLimit, a certainty or a distance to limit the objects to " +
+					"aggregate over",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := traverser.Aggregate(context.Background(), principal, &test.params)
+				assert.EqualError(t, err, test.expectedError)
+			})
+		}
+	})
 }
 
 var aggregateTestSchema = schema.Schema{
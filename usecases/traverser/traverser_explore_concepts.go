@@ -13,9 +13,13 @@ package traverser
 
 import (
 	"context"
+	"time"
 
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/usecases/admission"
 )
 
 // Explore through unstructured search terms
@@ -30,19 +34,20 @@ func (t *Traverser) Explore(ctx context.Context,
 		return nil, err
 	}
 
-	return t.explorer.Concepts(ctx, params)
-}
+	release, err := t.admission.Acquire(ctx, admission.ClassVectorSearch)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
-type NearVectorParams struct {
-	Vector    []float32
-	Certainty float64
+	defer t.logIfSlow("explore", "", time.Now())
+	return t.explorer.Concepts(ctx, params)
 }
 
-type NearObjectParams struct {
-	ID        string
-	Beacon    string
-	Certainty float64
-}
+type (
+	NearVectorParams = searchparams.NearVector
+	NearObjectParams = searchparams.NearObject
+)
 
 // ExploreParams are the parameters used by the GraphQL `Explore { }` API
 type ExploreParams struct {
@@ -51,4 +56,9 @@ type ExploreParams struct {
 	Offset       int
 	Limit        int
 	ModuleParams map[string]interface{}
+
+	// Filters are applied to each candidate class individually, classes which
+	// do not have all of the filtered properties are excluded from the search.
+	// The root class of all paths is filters.CrossClassRoot.
+	Filters *filters.LocalFilter
 }
@@ -26,13 +26,12 @@ import (
 func Test_ExploreConcepts(t *testing.T) {
 	t.Run("without any near searchers", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{}
 
@@ -42,13 +41,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("with two searchers set at the same time", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			NearVector: &NearVectorParams{},
@@ -62,13 +60,12 @@ func Test_ExploreConcepts(t *testing.T) {
 	})
 	t.Run("nearCustomText with no movements set", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
@@ -96,12 +93,14 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "123-456-789",
 				Beacon:    "weaviate://localhost/123-456-789",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 			search.Result{
 				ClassName: "AnAction",
 				ID:        "987-654-321",
 				Beacon:    "weaviate://localhost/987-654-321",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 		}, res)
 
@@ -112,13 +111,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("nearCustomText without optional params", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			NearVector: &NearVectorParams{
@@ -144,12 +142,14 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "123-456-789",
 				Beacon:    "weaviate://localhost/123-456-789",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 			search.Result{
 				ClassName: "AnAction",
 				ID:        "987-654-321",
 				Beacon:    "weaviate://localhost/987-654-321",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 		}, res)
 
@@ -160,13 +160,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("nearObject with id param", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			NearObject: &NearObjectParams{
@@ -199,12 +198,14 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
 				Beacon:    "weaviate://localhost/bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 			search.Result{
 				ClassName: "AnAction",
 				ID:        "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23b",
 				Beacon:    "weaviate://localhost/bd3d1560-3f0e-4b39-9d62-38b4a3c4f23b",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 		}, res)
 
@@ -214,13 +215,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("nearObject with beacon param", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			NearObject: &NearObjectParams{
@@ -253,12 +253,14 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
 				Beacon:    "weaviate://localhost/bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 			search.Result{
 				ClassName: "AnAction",
 				ID:        "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23b",
 				Beacon:    "weaviate://localhost/bd3d1560-3f0e-4b39-9d62-38b4a3c4f23b",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 		}, res)
 
@@ -268,13 +270,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("nearCustomText with limit and certainty set", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			Limit: 100,
@@ -305,13 +306,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("nearCustomText with minimum certainty set to 0.6", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
@@ -342,13 +342,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("near text with movements set", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 		params := ExploreParams{
 			Limit: 100,
@@ -385,12 +384,14 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "123-456-789",
 				Beacon:    "weaviate://localhost/123-456-789",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 			search.Result{
 				ClassName: "AnAction",
 				ID:        "987-654-321",
 				Beacon:    "weaviate://localhost/987-654-321",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 		}, res)
 
@@ -403,13 +404,12 @@ func Test_ExploreConcepts(t *testing.T) {
 
 	t.Run("near text with movements and objects set", func(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
-		locks := &fakeLocks{}
 		logger, _ := test.NewNullLogger()
 		vectorSearcher := &fakeVectorSearcher{}
 		log, _ := test.NewNullLogger()
 		explorer := NewExplorer(vectorSearcher, newFakeDistancer(), log, getFakeModulesProvider())
 		schemaGetter := &fakeSchemaGetter{}
-		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
 			vectorSearcher, explorer, schemaGetter)
 
 		params := ExploreParams{
@@ -492,12 +492,14 @@ func Test_ExploreConcepts(t *testing.T) {
 				ID:        "123-456-789",
 				Beacon:    "weaviate://localhost/123-456-789",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 			search.Result{
 				ClassName: "AnAction",
 				ID:        "987-654-321",
 				Beacon:    "weaviate://localhost/987-654-321",
 				Certainty: 0.5,
+				Dist:      1,
 			},
 		}, res)
 
@@ -13,9 +13,12 @@ package traverser
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/tenant"
+	"github.com/semi-technologies/weaviate/usecases/admission"
+	"github.com/semi-technologies/weaviate/usecases/propertyusage"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -25,11 +28,59 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
-	unlock, err := t.locks.LockConnector()
+	release, err := t.admission.Acquire(ctx, getAdmissionClass(params))
 	if err != nil {
-		return nil, fmt.Errorf("could not acquire lock: %v", err)
+		return nil, err
+	}
+	defer release()
+
+	defer t.logIfSlow("get", params.ClassName, time.Now())
+
+	if err := t.validateTenant(params.ClassName, params.Tenant); err != nil {
+		return nil, err
+	}
+
+	ctx = tenant.NewContext(ctx, params.Tenant)
+	ctx, queryLogEntry := t.startQueryLog(ctx, params)
+	started := time.Now()
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	t.finishQueryLog(queryLogEntry, started)
+	t.propertyUsage.Record(propertyusage.Query{
+		Class:        params.ClassName,
+		Filters:      params.Filters,
+		ReturnedRefs: params.Properties,
+	})
+
+	if err := t.maskResults(ctx, principal, params.ClassName, res); err != nil {
+		return nil, err
+	}
+
+	t.shadowGet(params)
+	return res, nil
+}
+
+// getAdmissionClass treats every query which searches the vector index as a
+// vector search, everything else only reads from the inverted index
+func getAdmissionClass(params GetParams) admission.Class {
+	if params.NearVector != nil || params.NearObject != nil ||
+		params.SearchVector != nil || len(params.ModuleParams) > 0 {
+		return admission.ClassVectorSearch
+	}
+
+	return admission.ClassRead
+}
+
+// validateTenant makes sure queries against classes with multi-tenancy are
+// limited to an active tenant and all other classes are queried without one
+func (t *Traverser) validateTenant(className, tenant string) error {
+	state := t.schemaGetter.ShardingState(className)
+	if state == nil {
+		// the class does not exist, which is reported further down
+		return nil
 	}
-	defer unlock()
 
-	return t.explorer.GetClass(ctx, params)
+	return state.ValidateTenant(tenant)
 }
@@ -15,22 +15,44 @@ import (
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 )
 
 type GetParams struct {
 	Filters              *filters.LocalFilter
 	ClassName            string
 	Pagination           *filters.Pagination
+	Cursor               *filters.Cursor
+	Sort                 []filters.Sort
 	Properties           search.SelectProperties
 	NearVector           *NearVectorParams
 	NearObject           *NearObjectParams
+	KeywordRanking       *searchparams.KeywordRanking
+	SparseRanking        *searchparams.SparseRanking
 	SearchVector         []float32
+	SearchVectors        [][]float32
 	Group                *GroupParams
+	GroupBy              *GroupByParams
 	ModuleParams         map[string]interface{}
+	TransformParams      map[string]interface{}
 	AdditionalProperties additional.Properties
+	Tenant               string
+
+	// Autocut truncates the results at the Autocut-th jump in their distances
+	// or scores, 0 keeps all results
+	Autocut int
 }
 
 type GroupParams struct {
 	Strategy string
 	Force    float32
 }
+
+// GroupByParams group the search results by the value of the property at
+// Path. Only the first Groups distinct values in order of the results form
+// a group and each group holds at most ObjectsPerGroup objects.
+type GroupByParams struct {
+	Path            []string
+	Groups          int
+	ObjectsPerGroup int
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// MaskerProvider replaces sensitive values, such as email addresses, in the
+// properties of the objects returned to a principal. Implemented by the
+// modules provider.
+type MaskerProvider interface {
+	MaskResult(ctx context.Context, principal *models.Principal,
+		className string, props map[string]interface{}) error
+}
+
+// SetMasker enables masking sensitive values in the results of Get queries
+func (t *Traverser) SetMasker(masker MaskerProvider) {
+	t.masker = masker
+}
+
+// maskResults masks the properties of every result of a Get query, including
+// the objects of resolved references and the hits of groups
+func (t *Traverser) maskResults(ctx context.Context, principal *models.Principal,
+	className string, results []interface{}) error {
+	if t.masker == nil {
+		return nil
+	}
+
+	for _, res := range results {
+		props, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := t.maskResult(ctx, principal, className, props); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Traverser) maskResult(ctx context.Context, principal *models.Principal,
+	className string, props map[string]interface{}) error {
+	if err := t.masker.MaskResult(ctx, principal, className, props); err != nil {
+		return errors.Wrapf(err, "mask %s result", className)
+	}
+
+	for _, value := range props {
+		refs, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, ref := range refs {
+			localRef, ok := ref.(search.LocalRef)
+			if !ok {
+				continue
+			}
+
+			if err := t.maskResult(ctx, principal, localRef.Class,
+				localRef.Fields); err != nil {
+				return err
+			}
+		}
+	}
+
+	additional, _ := props["_additional"].(map[string]interface{})
+	group, ok := additional["group"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	hits, _ := group["hits"].([]interface{})
+	if err := t.maskResults(ctx, principal, className, hits); err != nil {
+		return err
+	}
+
+	// all hits of a group share the value of the grouped by property, it must
+	// not reveal a value which was masked in the hits themselves
+	if groupedBy, ok := group["groupedBy"].(map[string]interface{}); ok {
+		if path, ok := groupedBy["path"].([]string); ok && len(path) > 0 {
+			if value, ok := props[path[0]]; ok {
+				groupedBy["value"] = fmt.Sprint(value)
+			}
+		}
+	}
+
+	return nil
+}
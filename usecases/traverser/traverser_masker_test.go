@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Traverser_MaskResults(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(nil, logger, nil, nil, nil, nil)
+	masker := &fakeMasker{}
+	traverser.SetMasker(masker)
+
+	hit := map[string]interface{}{
+		"email": "jane@example.com",
+		"author": []interface{}{
+			search.LocalRef{
+				Class:  "Author",
+				Fields: map[string]interface{}{"email": "john@example.com"},
+			},
+		},
+	}
+	representative := groupRepresentative(hit, map[string]interface{}{
+		"groupedBy": map[string]interface{}{
+			"path":  []string{"email"},
+			"value": "jane@example.com",
+		},
+		"hits": []interface{}{hit},
+	})
+
+	err := traverser.maskResults(context.Background(), nil, "Article",
+		[]interface{}{representative})
+	require.Nil(t, err)
+
+	group := representative["_additional"].(map[string]interface{})["group"].(map[string]interface{})
+	author := hit["author"].([]interface{})[0].(search.LocalRef)
+	assert.Equal(t, "[MASKED]", representative["email"])
+	assert.Equal(t, "[MASKED]", hit["email"])
+	assert.Equal(t, "[MASKED]", author.Fields["email"])
+	assert.Equal(t, "[MASKED]", group["groupedBy"].(map[string]interface{})["value"])
+	assert.ElementsMatch(t, []string{"Article", "Author", "Article", "Author"},
+		masker.classes)
+}
+
+// fakeMasker masks every string containing an @
+type fakeMasker struct {
+	classes []string
+}
+
+func (f *fakeMasker) MaskResult(ctx context.Context, principal *models.Principal,
+	className string, props map[string]interface{}) error {
+	f.classes = append(f.classes, className)
+	for key, value := range props {
+		if asString, ok := value.(string); ok && strings.Contains(asString, "@") {
+			props[key] = "[MASKED]"
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/admission"
+	"github.com/sirupsen/logrus"
+)
+
+// QueryLogEntry is a vector search recorded in the query log. It is
+// anonymized: it holds the vector which was searched with, but neither the
+// text or object the vector was derived from, nor the values of a filter, nor
+// who sent the query. A recorded workload can thus be exported and replayed
+// without exposing the data it was recorded on.
+type QueryLogEntry struct {
+	// Time is when the query started in unix milliseconds
+	Time         int64     `json:"time"`
+	ClassName    string    `json:"className"`
+	Vector       []float32 `json:"vector"`
+	Offset       int       `json:"offset"`
+	Limit        int       `json:"limit"`
+	Certainty    float64   `json:"certainty,omitempty"`
+	Distance     float64   `json:"distance,omitempty"`
+	WithDistance bool      `json:"withDistance,omitempty"`
+
+	// Filtered is set if the query had a where filter. As its values are not
+	// recorded, the query is replayed without it.
+	Filtered bool  `json:"filtered,omitempty"`
+	TookMs   int64 `json:"tookMs"`
+}
+
+// QueryLogRepo stores the recorded queries
+type QueryLogRepo interface {
+	Put(entry QueryLogEntry) error
+}
+
+type queryLogContextKey struct{}
+
+// SetQueryLog records the share of Get queries with a vector search set by
+// sampleRate, which is between 0 and 1, in the repo
+func (t *Traverser) SetQueryLog(repo QueryLogRepo, sampleRate float64) {
+	t.queryLog = repo
+	t.queryLogSampleRate = sampleRate
+}
+
+// startQueryLog decides whether the query is recorded. If so, the returned
+// context carries the entry, so that the explorer can add the vector once it
+// is known.
+func (t *Traverser) startQueryLog(ctx context.Context,
+	params GetParams) (context.Context, *QueryLogEntry) {
+	if t.queryLog == nil || getAdmissionClass(params) != admission.ClassVectorSearch {
+		return ctx, nil
+	}
+
+	if t.queryLogSampleRate <= 0 || t.sample() >= t.queryLogSampleRate {
+		return ctx, nil
+	}
+
+	entry := &QueryLogEntry{
+		ClassName: params.ClassName,
+		Filtered:  params.Filters != nil,
+	}
+	return context.WithValue(ctx, queryLogContextKey{}, entry), entry
+}
+
+// finishQueryLog stores the entry of a successful query, it is a no-op if
+// the query was not sampled
+func (t *Traverser) finishQueryLog(entry *QueryLogEntry, started time.Time) {
+	if entry == nil || entry.Vector == nil {
+		return
+	}
+
+	entry.Time = started.UnixNano() / int64(time.Millisecond)
+	entry.TookMs = time.Since(started).Milliseconds()
+	if err := t.queryLog.Put(*entry); err != nil {
+		t.logger.WithField("action", "query_log").WithError(err).
+			Warn("could not record query")
+	}
+}
+
+// recordQueryVector adds the search vector and the parameters of the vector
+// search to the entry of a sampled query
+func recordQueryVector(ctx context.Context, params GetParams,
+	threshold SimilarityThreshold) {
+	entry, ok := ctx.Value(queryLogContextKey{}).(*QueryLogEntry)
+	if !ok {
+		return
+	}
+
+	entry.Vector = params.SearchVector
+	entry.Certainty = threshold.Certainty
+	entry.Distance = threshold.Distance
+	entry.WithDistance = threshold.WithDistance
+	if params.Pagination != nil {
+		entry.Offset = params.Pagination.Offset
+		entry.Limit = params.Pagination.Limit
+	}
+}
+
+// ReplayReport summarizes how a replayed workload performed
+type ReplayReport struct {
+	Queries          int     `json:"queries"`
+	Failed           int     `json:"failed"`
+	TookMs           int64   `json:"tookMs"`
+	QueriesPerSecond float64 `json:"queriesPerSecond"`
+	LatencyP50Ms     float64 `json:"latencyP50Ms"`
+	LatencyP95Ms     float64 `json:"latencyP95Ms"`
+	LatencyP99Ms     float64 `json:"latencyP99Ms"`
+}
+
+// Replay re-executes recorded queries as vector searches against a class, so
+// that its capacity can be tested after a config change. If className is
+// empty, every query runs against the class it was recorded on. The queries
+// skip admission control and are never recorded themselves. Filters are not
+// part of the recording, so filtered queries are replayed without them.
+func (t *Traverser) Replay(ctx context.Context, className string,
+	entries []QueryLogEntry, concurrency int) (*ReplayReport, error) {
+	if className != "" {
+		sch := t.schemaGetter.GetSchemaSkipAuth()
+		if sch.FindClassByName(schema.ClassName(className)) == nil {
+			return nil, errortypes.New(errortypes.KindNotFound,
+				"class %q not found", className)
+		}
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	latencies := make([]time.Duration, len(entries))
+	failed := make([]bool, len(entries))
+	work := make(chan int)
+	wg := &sync.WaitGroup{}
+
+	started := time.Now()
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pos := range work {
+				queryStarted := time.Now()
+				err := t.replayQuery(ctx, className, entries[pos])
+				latencies[pos] = time.Since(queryStarted)
+				failed[pos] = err != nil
+			}
+		}()
+	}
+
+	for pos := range entries {
+		if ctx.Err() != nil {
+			break
+		}
+		work <- pos
+	}
+	close(work)
+	wg.Wait()
+	took := time.Since(started)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &ReplayReport{
+		Queries: len(entries),
+		TookMs:  took.Milliseconds(),
+	}
+	for _, f := range failed {
+		if f {
+			report.Failed++
+		}
+	}
+	if took > 0 {
+		report.QueriesPerSecond = float64(len(entries)) / took.Seconds()
+	}
+
+	sort.Slice(latencies, func(a, b int) bool { return latencies[a] < latencies[b] })
+	report.LatencyP50Ms = latencyPercentileMs(latencies, 0.5)
+	report.LatencyP95Ms = latencyPercentileMs(latencies, 0.95)
+	report.LatencyP99Ms = latencyPercentileMs(latencies, 0.99)
+
+	return report, nil
+}
+
+func (t *Traverser) replayQuery(ctx context.Context, className string,
+	entry QueryLogEntry) error {
+	if className == "" {
+		className = entry.ClassName
+	}
+
+	_, err := t.explorer.GetClass(ctx, GetParams{
+		ClassName: className,
+		Pagination: &filters.Pagination{
+			Offset: entry.Offset,
+			Limit:  entry.Limit,
+		},
+		NearVector: &NearVectorParams{
+			Vector:       entry.Vector,
+			Certainty:    entry.Certainty,
+			Distance:     entry.Distance,
+			WithDistance: entry.WithDistance,
+		},
+	})
+	if err != nil {
+		t.logger.WithFields(logrus.Fields{
+			"action":     "query_log_replay",
+			"class_name": className,
+		}).WithError(err).Debug("replayed query failed")
+	}
+
+	return err
+}
+
+// latencyPercentileMs expects the latencies to be sorted
+func latencyPercentileMs(latencies []time.Duration, percentile float64) float64 {
+	if len(latencies) == 0 {
+		return 0
+	}
+
+	pos := int(float64(len(latencies)-1) * percentile)
+	return float64(latencies[pos]) / float64(time.Millisecond)
+}
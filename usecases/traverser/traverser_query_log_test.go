@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/errortypes"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Traverser_QueryLog(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	repo := &fakeQueryLogRepo{}
+	traverser := NewTraverser(nil, logger, nil, nil, nil, nil)
+	traverser.SetQueryLog(repo, 0.1)
+
+	vectorSearch := GetParams{
+		ClassName:  "Foo",
+		Filters:    &filters.LocalFilter{},
+		NearVector: &NearVectorParams{Vector: []float32{1, 2, 3}, Certainty: 0.8},
+	}
+
+	record := func(params GetParams) {
+		ctx, entry := traverser.startQueryLog(context.Background(), params)
+		params.SearchVector = []float32{1, 2, 3}
+		params.Pagination = &filters.Pagination{Offset: 5, Limit: 10}
+		recordQueryVector(ctx, params, SimilarityThreshold{Certainty: 0.8})
+		traverser.finishQueryLog(entry, time.Now())
+	}
+
+	t.Run("a query which is not sampled is not recorded", func(t *testing.T) {
+		traverser.sample = func() float64 { return 0.5 }
+		record(vectorSearch)
+		assert.Len(t, repo.entries, 0)
+	})
+
+	t.Run("a query without a vector search is not recorded", func(t *testing.T) {
+		traverser.sample = func() float64 { return 0.05 }
+		record(GetParams{ClassName: "Foo"})
+		assert.Len(t, repo.entries, 0)
+	})
+
+	t.Run("a sampled vector search is recorded anonymized", func(t *testing.T) {
+		traverser.sample = func() float64 { return 0.05 }
+		record(vectorSearch)
+		require.Len(t, repo.entries, 1)
+
+		entry := repo.entries[0]
+		assert.Equal(t, "Foo", entry.ClassName)
+		assert.Equal(t, []float32{1, 2, 3}, entry.Vector)
+		assert.Equal(t, 5, entry.Offset)
+		assert.Equal(t, 10, entry.Limit)
+		assert.Equal(t, 0.8, entry.Certainty)
+		assert.True(t, entry.Filtered)
+		assert.NotZero(t, entry.Time)
+	})
+}
+
+func Test_Traverser_Replay(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{{Class: "Foo"}, {Class: "Bar"}},
+		},
+	}
+
+	logger, _ := test.NewNullLogger()
+	explorer := &replayRecordingExplorer{}
+	traverser := NewTraverser(nil, logger, nil, nil, explorer,
+		&fakeSchemaGetter{schema: sch})
+
+	entries := []QueryLogEntry{
+		{ClassName: "Foo", Vector: []float32{1}, Limit: 10},
+		{ClassName: "Foo", Vector: []float32{2}, Limit: 10},
+		{ClassName: "Foo", Vector: []float32{3}, Limit: 10},
+		{ClassName: "Foo", Vector: nil, Limit: 10},
+	}
+
+	t.Run("against the recorded class", func(t *testing.T) {
+		report, err := traverser.Replay(context.Background(), "", entries, 2)
+		require.Nil(t, err)
+		assert.Equal(t, 4, report.Queries)
+		assert.Equal(t, 1, report.Failed)
+		assert.ElementsMatch(t, []string{"Foo", "Foo", "Foo", "Foo"}, explorer.reset())
+	})
+
+	t.Run("against another class", func(t *testing.T) {
+		report, err := traverser.Replay(context.Background(), "Bar", entries, 1)
+		require.Nil(t, err)
+		assert.Equal(t, 4, report.Queries)
+		assert.ElementsMatch(t, []string{"Bar", "Bar", "Bar", "Bar"}, explorer.reset())
+	})
+
+	t.Run("against a class which does not exist", func(t *testing.T) {
+		_, err := traverser.Replay(context.Background(), "Baz", entries, 1)
+		assert.True(t, errortypes.Is(err, errortypes.KindNotFound))
+	})
+}
+
+type fakeQueryLogRepo struct {
+	entries []QueryLogEntry
+}
+
+func (f *fakeQueryLogRepo) Put(entry QueryLogEntry) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+type replayRecordingExplorer struct {
+	fakeExplorer
+	sync.Mutex
+	classes []string
+}
+
+func (f *replayRecordingExplorer) GetClass(ctx context.Context,
+	p GetParams) ([]interface{}, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.classes = append(f.classes, p.ClassName)
+	if len(p.NearVector.Vector) == 0 {
+		return nil, errors.New("empty vector")
+	}
+
+	return nil, nil
+}
+
+func (f *replayRecordingExplorer) reset() []string {
+	f.Lock()
+	defer f.Unlock()
+
+	classes := f.classes
+	f.classes = nil
+	return classes
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus"
+)
+
+// shadowReadTimeout is how long a mirrored query may take
+const shadowReadTimeout = 30 * time.Second
+
+// shadowMaxReadsInFlight limits the mirrored queries which run at the same
+// time. Sampled queries beyond it are not mirrored, so a slow shadow class
+// never competes with the original traffic for long.
+const shadowMaxReadsInFlight = 16
+
+// shadowGet runs a sample of the Get queries on a class with a shadow config
+// against its shadow class as well. The query runs in the background and its
+// results are discarded, only failures and the duration are logged, so the
+// shadow class can be compared with the original one.
+func (t *Traverser) shadowGet(params GetParams) {
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(params.ClassName))
+	if class == nil || class.ShadowConfig == nil || class.ShadowConfig.Endpoint != "" {
+		return
+	}
+
+	rate := class.ShadowConfig.ReadSampleRate
+	if rate <= 0 || t.sample() >= rate {
+		return
+	}
+
+	select {
+	case t.shadowReads <- struct{}{}:
+	default:
+		return
+	}
+
+	params.ClassName = class.ShadowConfig.Class
+	go func() {
+		defer func() { <-t.shadowReads }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), shadowReadTimeout)
+		defer cancel()
+
+		started := time.Now()
+		_, err := t.explorer.GetClass(ctx, params)
+		logger := t.logger.WithFields(logrus.Fields{
+			"action":       "shadow_get",
+			"class_name":   class.Class,
+			"shadow_class": params.ClassName,
+			"took":         time.Since(started).String(),
+		})
+		if err != nil {
+			logger.WithError(err).Warn("mirrored query against the shadow class failed")
+			return
+		}
+
+		logger.Debug("mirrored query against the shadow class")
+	}()
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Traverser_ShadowGet(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:        "Production",
+					ShadowConfig: &models.ShadowConfig{Class: "Candidate", ReadSampleRate: 0.1},
+				},
+				{
+					Class:        "WritesOnly",
+					ShadowConfig: &models.ShadowConfig{Class: "Candidate"},
+				},
+				{Class: "Candidate"},
+			},
+		},
+	}
+
+	logger, _ := test.NewNullLogger()
+	explorer := &shadowRecordingExplorer{classes: make(chan string, 1)}
+	traverser := NewTraverser(nil, logger, nil, nil, explorer,
+		&fakeSchemaGetter{schema: sch})
+
+	mirrored := func() string {
+		select {
+		case class := <-explorer.classes:
+			return class
+		case <-time.After(100 * time.Millisecond):
+			return ""
+		}
+	}
+
+	t.Run("a sampled query is mirrored", func(t *testing.T) {
+		traverser.sample = func() float64 { return 0.05 }
+		traverser.shadowGet(GetParams{ClassName: "Production"})
+		assert.Equal(t, "Candidate", mirrored())
+	})
+
+	t.Run("a query which is not sampled is not mirrored", func(t *testing.T) {
+		traverser.sample = func() float64 { return 0.5 }
+		traverser.shadowGet(GetParams{ClassName: "Production"})
+		assert.Equal(t, "", mirrored())
+	})
+
+	t.Run("reads are not mirrored without a sample rate", func(t *testing.T) {
+		traverser.sample = func() float64 { return 0 }
+		traverser.shadowGet(GetParams{ClassName: "WritesOnly"})
+		assert.Equal(t, "", mirrored())
+	})
+}
+
+type shadowRecordingExplorer struct {
+	fakeExplorer
+	classes chan string
+}
+
+func (f *shadowRecordingExplorer) GetClass(ctx context.Context,
+	p GetParams) ([]interface{}, error) {
+	f.classes <- p.ClassName
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SetSlowQueryThreshold changes the duration after which a query is logged
+// as slow. A threshold of 0 disables slow query logging.
+func (t *Traverser) SetSlowQueryThreshold(threshold time.Duration) {
+	atomic.StoreInt64(&t.slowQueryThreshold, int64(threshold))
+}
+
+func (t *Traverser) logIfSlow(queryType, className string, started time.Time) {
+	threshold := time.Duration(atomic.LoadInt64(&t.slowQueryThreshold))
+	if threshold <= 0 {
+		return
+	}
+
+	took := time.Since(started)
+	if took < threshold {
+		return
+	}
+
+	t.logger.WithFields(logrus.Fields{
+		"action":     "slow_query",
+		"query_type": queryType,
+		"class_name": className,
+		"took":       took.String(),
+		"threshold":  threshold.String(),
+	}).Warn("query exceeded the slow query threshold")
+}
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Traverser_SlowQueryLog(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	traverser := NewTraverser(nil, logger, nil, nil, nil, nil)
+
+	t.Run("disabled by default", func(t *testing.T) {
+		traverser.logIfSlow("get", "Foo", time.Now().Add(-time.Hour))
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+
+	traverser.SetSlowQueryThreshold(time.Second)
+
+	t.Run("a fast query is not logged", func(t *testing.T) {
+		traverser.logIfSlow("get", "Foo", time.Now())
+		assert.Len(t, hook.AllEntries(), 0)
+	})
+
+	t.Run("a slow query is logged", func(t *testing.T) {
+		traverser.logIfSlow("get", "Foo", time.Now().Add(-2*time.Second))
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, "slow_query", hook.LastEntry().Data["action"])
+		assert.Equal(t, "Foo", hook.LastEntry().Data["class_name"])
+	})
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package usage reports how much the modules of this node were used on
+// behalf of each class
+package usage
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/modules"
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// recordsSource provides the usage counters of the modules, see
+// modules.Usage
+type recordsSource interface {
+	Records() []modules.UsageRecord
+}
+
+type Manager struct {
+	authorizer authorizer
+	source     recordsSource
+}
+
+func NewManager(authorizer authorizer, source recordsSource) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		source:     source,
+	}
+}
+
+// GetUsage returns the usage of every module operation on this node since it
+// was started, sorted by class, module and operation
+func (m *Manager) GetUsage(ctx context.Context,
+	principal *models.Principal) (*models.UsageResponse, error) {
+	err := m.authorizer.Authorize(principal, "list", "usage")
+	if err != nil {
+		return nil, err
+	}
+
+	records := m.source.Records()
+	out := make([]*models.UsageRecord, len(records))
+	for i, record := range records {
+		out[i] = &models.UsageRecord{
+			Class:     record.Class,
+			Module:    record.Module,
+			Operation: record.Operation,
+			Calls:     record.Calls,
+			Errors:    record.Errors,
+			Tokens:    record.Tokens,
+		}
+	}
+
+	return &models.UsageResponse{Modules: out}, nil
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/modules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUsage(t *testing.T) {
+	source := &fakeRecordsSource{
+		records: []modules.UsageRecord{
+			{
+				Class: "Article", Module: "my-module", Operation: "vectorize",
+				Calls: 2, Errors: 1, Tokens: 15,
+			},
+			{Module: "my-module", Operation: "vectorizeQuery", Calls: 1},
+		},
+	}
+
+	t.Run("reports the usage of every module operation", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		m := NewManager(authorizer, source)
+
+		res, err := m.GetUsage(context.Background(), nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, &models.UsageResponse{
+			Modules: []*models.UsageRecord{
+				{
+					Class: "Article", Module: "my-module", Operation: "vectorize",
+					Calls: 2, Errors: 1, Tokens: 15,
+				},
+				{Module: "my-module", Operation: "vectorizeQuery", Calls: 1},
+			},
+		}, res)
+		assert.Equal(t, "list", authorizer.verb)
+		assert.Equal(t, "usage", authorizer.resource)
+	})
+
+	t.Run("the principal is not authorized", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		m := NewManager(&fakeAuthorizer{err: forbidden}, source)
+
+		_, err := m.GetUsage(context.Background(), nil)
+		assert.Equal(t, forbidden, err)
+	})
+}
+
+type fakeAuthorizer struct {
+	err      error
+	verb     string
+	resource string
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb,
+	resource string) error {
+	a.verb = verb
+	a.resource = resource
+	return a.err
+}
+
+type fakeRecordsSource struct {
+	records []modules.UsageRecord
+}
+
+func (s *fakeRecordsSource) Records() []modules.UsageRecord {
+	return s.records
+}
@@ -27,6 +27,17 @@ func NormalizedDistance(a, b []float32) (float32, error) {
 	return (1 - sim) / 2, nil
 }
 
+// CosineDistance between two arbitrary vectors, errors if dimensions don't
+// match, will return results between 0 (no distance) and 2 (maximum distance)
+func CosineDistance(a, b []float32) (float32, error) {
+	sim, err := cosineSim(a, b)
+	if err != nil {
+		return 2, fmt.Errorf("cosine distance: %v", err)
+	}
+
+	return 1 - sim, nil
+}
+
 func cosineSim(a, b []float32) (float32, error) {
 	if len(a) != len(b) {
 		return 0, fmt.Errorf("vectors have different dimensions")
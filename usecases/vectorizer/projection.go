@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProjectionRandom multiplies every vector with a gaussian random matrix.
+	// The matrix is derived from the seed, so only the seed is stored.
+	ProjectionRandom = "random"
+
+	// ProjectionPCA multiplies every vector with a matrix which was learned
+	// outside of Weaviate, e.g. by a principal component analysis of a
+	// representative sample of the vectors. The matrix is stored with the
+	// class.
+	ProjectionPCA = "pca"
+)
+
+// Projection reduces the dimensionality of the vectors of a class before
+// they are indexed. Both imported vectors and search vectors are projected,
+// so the index only ever sees the reduced dimensions.
+type Projection struct {
+	Type            string `json:"type"`
+	InputDimensions int    `json:"inputDimensions"`
+	Dimensions      int    `json:"dimensions"`
+	Seed            int64  `json:"seed"`
+
+	// Matrix has one row of InputDimensions entries for each of the
+	// Dimensions output dimensions. Only used for ProjectionPCA.
+	Matrix [][]float32 `json:"matrix,omitempty"`
+
+	// Mean is subtracted from every vector before it is projected. Only used
+	// for ProjectionPCA and optional.
+	Mean []float32 `json:"mean,omitempty"`
+
+	init   sync.Once
+	matrix [][]float32
+}
+
+// Project a vector to the output dimensions. Vectors which already have the
+// output dimensions are returned unchanged, which makes it safe to project a
+// vector more than once, e.g. when it is passed on to a replica or read back
+// from the index for a nearObject search. A nil projection is a no-op.
+func (p *Projection) Project(vector []float32) ([]float32, error) {
+	if p == nil || len(vector) == 0 || len(vector) == p.Dimensions {
+		return vector, nil
+	}
+
+	if len(vector) != p.InputDimensions {
+		return nil, errors.Errorf("vector has %d dimensions, but the projection "+
+			"expects %d input dimensions", len(vector), p.InputDimensions)
+	}
+
+	p.init.Do(p.initMatrix)
+
+	out := make([]float32, p.Dimensions)
+	for i, row := range p.matrix {
+		var sum float32
+		for j, weight := range row {
+			value := vector[j]
+			if p.Mean != nil {
+				value -= p.Mean[j]
+			}
+			sum += weight * value
+		}
+		out[i] = sum
+	}
+
+	return out, nil
+}
+
+func (p *Projection) initMatrix() {
+	if p.Type == ProjectionPCA {
+		p.matrix = p.Matrix
+		return
+	}
+
+	// entries are drawn from N(0, 1/dimensions), so that distances are
+	// preserved in expectation (Johnson-Lindenstrauss)
+	scale := 1 / math.Sqrt(float64(p.Dimensions))
+	rnd := rand.New(rand.NewSource(p.Seed))
+	p.matrix = make([][]float32, p.Dimensions)
+	for i := range p.matrix {
+		p.matrix[i] = make([]float32, p.InputDimensions)
+		for j := range p.matrix[i] {
+			p.matrix[i][j] = float32(rnd.NormFloat64() * scale)
+		}
+	}
+}
+
+// Validate the projection settings
+func (p *Projection) Validate() error {
+	if p.Type != ProjectionRandom && p.Type != ProjectionPCA {
+		return errors.Errorf("projection type must be one of %q or %q, got: %q",
+			ProjectionRandom, ProjectionPCA, p.Type)
+	}
+
+	if p.InputDimensions < 1 {
+		return errors.Errorf("projection inputDimensions must be at least 1, "+
+			"got: %d", p.InputDimensions)
+	}
+
+	if p.Dimensions < 1 || p.Dimensions >= p.InputDimensions {
+		return errors.Errorf("projection dimensions must be between 1 and "+
+			"inputDimensions (%d) exclusive, got: %d", p.InputDimensions, p.Dimensions)
+	}
+
+	if p.Type == ProjectionRandom {
+		if p.Matrix != nil || p.Mean != nil {
+			return errors.Errorf("projection of type %q does not accept a matrix "+
+				"or mean, they are derived from the seed", ProjectionRandom)
+		}
+		return nil
+	}
+
+	if len(p.Matrix) != p.Dimensions {
+		return errors.Errorf("projection matrix must have %d rows (dimensions), "+
+			"got: %d", p.Dimensions, len(p.Matrix))
+	}
+
+	for i, row := range p.Matrix {
+		if len(row) != p.InputDimensions {
+			return errors.Errorf("projection matrix row %d must have %d entries "+
+				"(inputDimensions), got: %d", i, p.InputDimensions, len(row))
+		}
+	}
+
+	if p.Mean != nil && len(p.Mean) != p.InputDimensions {
+		return errors.Errorf("projection mean must have %d entries "+
+			"(inputDimensions), got: %d", p.InputDimensions, len(p.Mean))
+	}
+
+	return nil
+}
+
+// Equal is true if both projections produce the same vectors. Two nil
+// projections are equal.
+func (p *Projection) Equal(other *Projection) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return p.Type == other.Type &&
+		p.InputDimensions == other.InputDimensions &&
+		p.Dimensions == other.Dimensions &&
+		p.Seed == other.Seed &&
+		reflect.DeepEqual(p.Matrix, other.Matrix) &&
+		reflect.DeepEqual(p.Mean, other.Mean)
+}
+
+// ParseProjection from the user-specified vector index config. The input is
+// the raw map, which may come from the API or from the schema on disk, so
+// numbers are either json.Number or float64.
+func ParseProjection(input interface{}) (*Projection, error) {
+	asMap, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("projection must be an object, got: %T", input)
+	}
+
+	// the raw map is plain json, so re-encoding it is the simplest way to
+	// parse the nested matrix without hand-written conversions
+	raw, err := json.Marshal(asMap)
+	if err != nil {
+		return nil, errors.Wrap(err, "projection")
+	}
+
+	p := &Projection{}
+	if err := json.Unmarshal(raw, p); err != nil {
+		return nil, errors.Wrap(err, "projection")
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjection(t *testing.T) {
+	t.Run("pca with mean", func(t *testing.T) {
+		p := &Projection{
+			Type:            ProjectionPCA,
+			InputDimensions: 3,
+			Dimensions:      2,
+			Matrix:          [][]float32{{1, 0, 0}, {0, 1, 1}},
+			Mean:            []float32{1, 1, 1},
+		}
+		require.Nil(t, p.Validate())
+
+		res, err := p.Project([]float32{2, 3, 4})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 5}, res)
+	})
+
+	t.Run("random projection is deterministic per seed", func(t *testing.T) {
+		vector := randomVector(64)
+
+		first, err := (&Projection{Type: ProjectionRandom, InputDimensions: 64,
+			Dimensions: 16, Seed: 3}).Project(vector)
+		require.Nil(t, err)
+		second, err := (&Projection{Type: ProjectionRandom, InputDimensions: 64,
+			Dimensions: 16, Seed: 3}).Project(vector)
+		require.Nil(t, err)
+		other, err := (&Projection{Type: ProjectionRandom, InputDimensions: 64,
+			Dimensions: 16, Seed: 4}).Project(vector)
+		require.Nil(t, err)
+
+		assert.Len(t, first, 16)
+		assert.Equal(t, first, second)
+		assert.NotEqual(t, first, other)
+	})
+
+	t.Run("random projection roughly preserves distances", func(t *testing.T) {
+		p := &Projection{Type: ProjectionRandom, InputDimensions: 1536,
+			Dimensions: 256}
+		near := randomVector(1536)
+		query := make([]float32, len(near))
+		for i := range near {
+			query[i] = near[i] + 0.1*float32(rand.NormFloat64())
+		}
+		far := randomVector(1536)
+
+		pq, err := p.Project(query)
+		require.Nil(t, err)
+		pn, err := p.Project(near)
+		require.Nil(t, err)
+		pf, err := p.Project(far)
+		require.Nil(t, err)
+
+		distNear, err := CosineDistance(pq, pn)
+		require.Nil(t, err)
+		distFar, err := CosineDistance(pq, pf)
+		require.Nil(t, err)
+		assert.Less(t, distNear, distFar)
+	})
+
+	t.Run("vectors which are already projected are unchanged", func(t *testing.T) {
+		p := &Projection{Type: ProjectionRandom, InputDimensions: 8, Dimensions: 4}
+		vector := []float32{1, 2, 3, 4}
+
+		res, err := p.Project(vector)
+		require.Nil(t, err)
+		assert.Equal(t, vector, res)
+	})
+
+	t.Run("nil projection and empty vectors are no-ops", func(t *testing.T) {
+		var p *Projection
+		res, err := p.Project([]float32{1, 2})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2}, res)
+
+		res, err = (&Projection{Type: ProjectionRandom, InputDimensions: 8,
+			Dimensions: 4}).Project(nil)
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("vectors with unexpected dimensions", func(t *testing.T) {
+		p := &Projection{Type: ProjectionRandom, InputDimensions: 8, Dimensions: 4}
+		_, err := p.Project([]float32{1, 2, 3})
+		require.NotNil(t, err)
+		assert.Equal(t, "vector has 3 dimensions, but the projection expects "+
+			"8 input dimensions", err.Error())
+	})
+}
+
+func TestParseProjection(t *testing.T) {
+	t.Run("pca from the api", func(t *testing.T) {
+		var input interface{}
+		dec := json.NewDecoder(strings.NewReader(`{"type":"pca","inputDimensions":2,` +
+			`"dimensions":1,"matrix":[[0.5,0.5]]}`))
+		dec.UseNumber()
+		require.Nil(t, dec.Decode(&input))
+
+		p, err := ParseProjection(input)
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.5, 0.5}}, p.Matrix)
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		tests := []struct {
+			input         map[string]interface{}
+			expectedError string
+		}{
+			{
+				input:         map[string]interface{}{"type": "lda"},
+				expectedError: `projection type must be one of "random" or "pca", got: "lda"`,
+			},
+			{
+				input: map[string]interface{}{"type": "random",
+					"inputDimensions": float64(8), "dimensions": float64(8)},
+				expectedError: "projection dimensions must be between 1 and " +
+					"inputDimensions (8) exclusive, got: 8",
+			},
+			{
+				input: map[string]interface{}{"type": "pca",
+					"inputDimensions": float64(2), "dimensions": float64(1)},
+				expectedError: "projection matrix must have 1 rows (dimensions), got: 0",
+			},
+			{
+				input: map[string]interface{}{"type": "pca",
+					"inputDimensions": float64(2), "dimensions": float64(1),
+					"matrix": []interface{}{[]interface{}{float64(1)}}},
+				expectedError: "projection matrix row 0 must have 2 entries " +
+					"(inputDimensions), got: 1",
+			},
+		}
+
+		for _, test := range tests {
+			_, err := ParseProjection(test.input)
+			require.NotNil(t, err)
+			assert.Equal(t, test.expectedError, err.Error())
+		}
+	})
+}
+
+func randomVector(dims int) []float32 {
+	out := make([]float32, dims)
+	for i := range out {
+		out[i] = float32(rand.NormFloat64())
+	}
+	return out
+}